@@ -0,0 +1,136 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthzAccessRequestPhase is the lifecycle phase of an AuthzAccessRequest.
+// +kubebuilder:validation:Enum=Pending;Approved;Denied;Expired
+type AuthzAccessRequestPhase string
+
+const (
+	// AuthzAccessRequestPending indicates the request awaits review.
+	AuthzAccessRequestPending AuthzAccessRequestPhase = "Pending"
+	// AuthzAccessRequestApproved indicates the request was approved and its binding grants access until it expires.
+	AuthzAccessRequestApproved AuthzAccessRequestPhase = "Approved"
+	// AuthzAccessRequestDenied indicates the request was denied.
+	AuthzAccessRequestDenied AuthzAccessRequestPhase = "Denied"
+	// AuthzAccessRequestExpired indicates the granted access expired and its binding was removed.
+	AuthzAccessRequestExpired AuthzAccessRequestPhase = "Expired"
+)
+
+// AuthzAccessRequestSpec defines the desired state of AuthzAccessRequest.
+// An AuthzAccessRequest asks for a role to be granted to an entitlement for a limited time.
+// Once approved, an AuthzRoleBinding with the same name that expires after the requested
+// duration is created for it.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.scope) || !has(self.scope.component) || has(self.scope.project)",message="scope.component requires scope.project"
+// +kubebuilder:validation:XValidation:rule="!has(self.scope) || !has(self.scope.resource) || has(self.scope.project)",message="scope.resource requires scope.project"
+// +kubebuilder:validation:XValidation:rule="!has(self.scope) || !has(self.scope.component) || !has(self.scope.resource)",message="scope.component and scope.resource are mutually exclusive"
+type AuthzAccessRequestSpec struct {
+	// Requester is the ID of the subject that requested access. It is recorded by openchoreo-api
+	// and an approver cannot review their own request.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Requester string `json:"requester"`
+
+	// Entitlement is the subject (from JWT claims) the role is granted to.
+	// +required
+	Entitlement EntitlementClaim `json:"entitlement"`
+
+	// RoleRef references the AuthzRole or ClusterAuthzRole to grant.
+	// +required
+	RoleRef RoleRef `json:"roleRef"`
+
+	// Scope narrows the grant to a project, component or resource of the namespace.
+	// +optional
+	Scope TargetScope `json:"scope,omitempty"`
+
+	// Duration is how long access is granted for once the request is approved.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m') && duration(self) <= duration('168h')",message="duration must be between 1m and 168h"
+	Duration metav1.Duration `json:"duration"`
+
+	// Reason explains why access is needed, e.g. an incident or ticket reference.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason"`
+}
+
+// AuthzAccessRequestStatus defines the observed state of AuthzAccessRequest.
+type AuthzAccessRequestStatus struct {
+	// Phase is the lifecycle phase of the request.
+	// +optional
+	Phase AuthzAccessRequestPhase `json:"phase,omitempty"`
+
+	// ReviewedBy is the ID of the subject that approved or denied the request.
+	// +optional
+	ReviewedBy string `json:"reviewedBy,omitempty"`
+
+	// ReviewedAt is the time the request was approved or denied.
+	// +optional
+	ReviewedAt *metav1.Time `json:"reviewedAt,omitempty"`
+
+	// ReviewComment is the comment given by the reviewer.
+	// +optional
+	ReviewComment string `json:"reviewComment,omitempty"`
+
+	// ExpiresAt is the time the granted access expires, set on approval.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// BindingName is the name of the AuthzRoleBinding created for the approved request.
+	// +optional
+	BindingName string `json:"bindingName,omitempty"`
+
+	// Conditions represent the latest available observations of the request's state.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=azar
+// +kubebuilder:printcolumn:name="Requester",type=string,JSONPath=`.spec.requester`
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.spec.roleRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.status.expiresAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AuthzAccessRequest is the Schema for the authzaccessrequests API.
+type AuthzAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthzAccessRequestSpec   `json:"spec,omitempty"`
+	Status AuthzAccessRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthzAccessRequestList contains a list of AuthzAccessRequest.
+type AuthzAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthzAccessRequest `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (r *AuthzAccessRequest) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (r *AuthzAccessRequest) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&AuthzAccessRequest{}, &AuthzAccessRequestList{})
+}
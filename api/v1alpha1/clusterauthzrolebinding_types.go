@@ -64,10 +64,16 @@ type ClusterAuthzRoleBindingSpec struct {
 	// +kubebuilder:default=allow
 	// +optional
 	Effect EffectType `json:"effect,omitempty"`
+
+	// ExpiresAt is the time after which the binding is no longer honoured by the authorizer.
+	// Bindings created by an approved AuthzAccessRequest set it; unset means the binding never expires.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.spec.expiresAt`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ClusterAuthzRoleBinding is the Schema for the clusterauthzrolebindings API
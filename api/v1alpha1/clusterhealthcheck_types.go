@@ -0,0 +1,105 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterHealthCheckSpec defines the desired state of ClusterHealthCheck.
+type ClusterHealthCheckSpec struct {
+	// Target selects the resource kind whose health this check determines.
+	Target HealthCheckTarget `json:"target"`
+
+	// Rules are evaluated in order against the live resource. The status of the
+	// first rule whose expression evaluates to true is reported.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Rules []HealthCheckRule `json:"rules"`
+
+	// DefaultStatus is reported when no rule matches.
+	// +optional
+	// +kubebuilder:default=Progressing
+	// +kubebuilder:validation:Enum=Healthy;Progressing;Degraded;Suspended;Unknown
+	DefaultStatus HealthStatus `json:"defaultStatus,omitempty"`
+}
+
+// HealthCheckTarget identifies a resource kind by API group and kind.
+type HealthCheckTarget struct {
+	// Group is the API group of the resource. Empty for the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind is the kind of the resource, e.g. "HTTPRoute" or "Cluster".
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+}
+
+// HealthCheckRule maps a CEL condition to a health status.
+type HealthCheckRule struct {
+	// Status is reported when Expression evaluates to true.
+	// +kubebuilder:validation:Enum=Healthy;Progressing;Degraded;Suspended
+	Status HealthStatus `json:"status"`
+
+	// Expression is a CEL expression that must evaluate to a boolean.
+	// The live resource is available as `object` and its status conditions
+	// (an empty list when absent) as `conditions`.
+	// Example: "conditions.exists(c, c.type == 'Ready' && c.status == 'True')"
+	// +kubebuilder:validation:MinLength=1
+	Expression string `json:"expression"`
+}
+
+// ClusterHealthCheckStatus defines the observed state of ClusterHealthCheck.
+type ClusterHealthCheckStatus struct {
+	// ObservedGeneration is the generation of the spec that was last validated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the check's state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=chc;chcs
+// +kubebuilder:printcolumn:name="Group",type="string",JSONPath=".spec.target.group"
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.target.kind"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterHealthCheck is the Schema for the clusterhealthchecks API.
+// A ClusterHealthCheck teaches the RenderedRelease controller how to derive the
+// health of a resource kind it has no built-in knowledge of, such as custom
+// resources installed by operators.
+type ClusterHealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterHealthCheckSpec   `json:"spec,omitempty"`
+	Status ClusterHealthCheckStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the conditions from the status
+func (c *ClusterHealthCheck) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (c *ClusterHealthCheck) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterHealthCheckList contains a list of ClusterHealthCheck.
+type ClusterHealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterHealthCheck `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterHealthCheck{}, &ClusterHealthCheckList{})
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageVerification configures how container image signatures are verified.
+// Signatures are verified offline against the cosign signature bundle recorded in the
+// build provenance of the release, so the registry is never contacted.
+// +kubebuilder:validation:XValidation:rule="(has(self.publicKeys) && size(self.publicKeys) > 0) || has(self.keyless)",message="at least one of publicKeys or keyless must be set"
+type ImageVerification struct {
+	// Images restricts verification to images whose repository matches one of these glob
+	// patterns (e.g. "registry.example.com/acme/*"). Empty matches all images.
+	// +optional
+	// +listType=atomic
+	Images []string `json:"images,omitempty"`
+
+	// PublicKeys are PEM-encoded public keys (ECDSA, RSA or Ed25519) trusted to sign images.
+	// A signature made by any of the keys is accepted.
+	// +optional
+	// +listType=atomic
+	PublicKeys []string `json:"publicKeys,omitempty"`
+
+	// Keyless trusts signatures made with short-lived certificates issued to the listed identities.
+	// +optional
+	Keyless *KeylessVerification `json:"keyless,omitempty"`
+}
+
+// KeylessVerification configures verification of keyless (certificate based) signatures.
+type KeylessVerification struct {
+	// Roots are the PEM-encoded CA certificates, including intermediates, that issue
+	// signing certificates (e.g. the Fulcio root and intermediate certificates).
+	// +kubebuilder:validation:MinLength=1
+	Roots string `json:"roots"`
+
+	// TransparencyLogPublicKeys are the PEM-encoded public keys of the transparency logs
+	// (e.g. Rekor) whose signed entry timestamps are trusted. The entry timestamp proves the
+	// signature was made while the short-lived signing certificate was valid.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	TransparencyLogPublicKeys []string `json:"transparencyLogPublicKeys"`
+
+	// Identities are the signer identities that are trusted. A signature is accepted
+	// when its certificate matches any of them.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Identities []KeylessIdentity `json:"identities"`
+}
+
+// KeylessIdentity identifies a keyless signer by the OIDC issuer and subject of its certificate.
+// +kubebuilder:validation:XValidation:rule="has(self.subject) != has(self.subjectRegExp)",message="exactly one of subject or subjectRegExp must be set"
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer that authenticated the signer,
+	// e.g. "https://token.actions.githubusercontent.com".
+	// +kubebuilder:validation:MinLength=1
+	Issuer string `json:"issuer"`
+
+	// Subject is the exact signer identity (email or URI) in the certificate.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// SubjectRegExp is a regular expression the signer identity must fully match.
+	// +optional
+	SubjectRegExp string `json:"subjectRegExp,omitempty"`
+}
+
+// ClusterImageVerificationPolicySpec defines the desired state of ClusterImageVerificationPolicy.
+type ClusterImageVerificationPolicySpec struct {
+	// EnvironmentSelector selects the environments, in any namespace, the policy applies to
+	// by their labels. When omitted, the policy applies to all environments.
+	// +optional
+	EnvironmentSelector *metav1.LabelSelector `json:"environmentSelector,omitempty"`
+
+	// Verification configures how the images deployed to the selected environments are verified.
+	Verification ImageVerification `json:"verification"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=civp;civps
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterImageVerificationPolicy is the Schema for the clusterimageverificationpolicies API.
+// A ClusterImageVerificationPolicy requires the images of releases bound to the selected
+// environments to be signed by trusted keys or identities.
+type ClusterImageVerificationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterImageVerificationPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterImageVerificationPolicyList contains a list of ClusterImageVerificationPolicy.
+type ClusterImageVerificationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterImageVerificationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterImageVerificationPolicy{}, &ClusterImageVerificationPolicyList{})
+}
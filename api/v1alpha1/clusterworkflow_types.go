@@ -54,6 +54,14 @@ type ClusterWorkflowSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// Artifacts declares the artifacts produced by runs of this workflow, such as the built image,
+	// its SBOM and its provenance attestation.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Artifacts []WorkflowArtifact `json:"artifacts,omitempty"`
 }
 
 // ClusterWorkflowStatus defines the observed state of ClusterWorkflow.
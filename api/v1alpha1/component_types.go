@@ -75,6 +75,11 @@ type ComponentSpec struct {
 	// The Workflow must be in the allowedWorkflows list of the ComponentType.
 	// +optional
 	Workflow *ComponentWorkflowConfig `json:"workflow,omitempty"`
+
+	// RegistryAuthentication references the credentials used to resolve the component's image
+	// tags to digests when a ComponentRelease is created. Public images need no credentials.
+	// +optional
+	RegistryAuthentication *RegistryAuthentication `json:"registryAuthentication,omitempty"`
 }
 
 // ComponentWorkflowConfig defines the workflow configuration for a component.
@@ -203,6 +208,7 @@ type ContainerRegistry struct {
 // RegistryAuthentication defines the authentication configuration for container registry
 type RegistryAuthentication struct {
 	// Reference to the secret that contains the container registry authentication info.
+	// The secret is a kubernetes.io/dockerconfigjson Secret in the component's namespace.
 	SecretRef string `json:"secretRef,omitempty"`
 }
 
@@ -43,6 +43,25 @@ type ComponentReleaseSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.workload is immutable"
 	Workload WorkloadTemplateSpec `json:"workload"`
+
+	// Image records how the workload container image was pinned at release time.
+	// The workload image is pinned to Digest; Reference keeps the image as authored for display.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.image is immutable"
+	Image *ReleaseImage `json:"image,omitempty"`
+}
+
+// ReleaseImage records the resolution of the workload container image of a ComponentRelease.
+type ReleaseImage struct {
+	// Reference is the image reference as authored in the Workload, e.g. "registry.example.com/app:v1".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reference string `json:"reference"`
+
+	// Digest is the manifest digest the reference resolved to, e.g. "sha256:...".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Digest string `json:"digest"`
 }
 
 // ComponentReleaseComponentType is the frozen snapshot of a ComponentType or ClusterComponentType
@@ -171,6 +171,19 @@ type ResourceTemplate struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Template *runtime.RawExtension `json:"template"`
+
+	// ApplyWave orders when this resource is applied relative to the others in the release.
+	// Lower waves are applied first, and a wave is only applied once every resource in the
+	// previous waves is healthy. Negative values are allowed. Defaults to 0.
+	// +optional
+	ApplyWave int32 `json:"applyWave,omitempty"`
+
+	// Hook makes this resource a sync hook. PreSync hooks are applied and must become healthy
+	// before any regular resource is applied; PostSync hooks are applied after every regular
+	// resource is healthy. A hook is re-created whenever its rendered content changes.
+	// +optional
+	// +kubebuilder:validation:Enum=PreSync;PostSync
+	Hook SyncPhase `json:"hook,omitempty"`
 }
 
 // ComponentTypeTrait represents a pre-configured trait instance embedded in a ComponentType.
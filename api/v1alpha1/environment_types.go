@@ -19,6 +19,71 @@ type EnvironmentSpec struct {
 	DataPlaneRef *DataPlaneRef `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewaySpec   `json:"gateway,omitempty"`
+
+	// DriftPolicy controls how changes made directly to resources in the data plane,
+	// outside of OpenChoreo, are handled. Defaults to auto-correcting drift.
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// ProvenancePolicy lists the build provenance a ComponentRelease must carry before it can
+	// be bound to this environment. Enforced when a ReleaseBinding is created or its release changes.
+	// +optional
+	ProvenancePolicy *ProvenancePolicy `json:"provenancePolicy,omitempty"`
+}
+
+// ProvenancePolicy defines the supply chain requirements for releases deployed to an environment.
+type ProvenancePolicy struct {
+	// RequireSignedImage requires the release image to have a recorded signature.
+	// +optional
+	RequireSignedImage bool `json:"requireSignedImage,omitempty"`
+
+	// RequireSBOM requires the release image to have a recorded SBOM.
+	// +optional
+	RequireSBOM bool `json:"requireSBOM,omitempty"`
+
+	// RequireAttestation requires the release image to have a recorded provenance attestation.
+	// +optional
+	RequireAttestation bool `json:"requireAttestation,omitempty"`
+
+	// ImageVerification verifies the signature of the release image against trusted keys or
+	// keyless identities. ClusterImageVerificationPolicies selecting this environment apply as well.
+	// +optional
+	ImageVerification *ImageVerification `json:"imageVerification,omitempty"`
+}
+
+// DriftPolicyMode defines how drift between the desired and the live state of deployed resources is handled.
+// +kubebuilder:validation:Enum=AutoCorrect;ReportOnly;Alert
+type DriftPolicyMode string
+
+const (
+	// DriftPolicyModeAutoCorrect re-applies the desired state, overwriting any drift.
+	DriftPolicyModeAutoCorrect DriftPolicyMode = "AutoCorrect"
+	// DriftPolicyModeReportOnly leaves drifted resources untouched and reports the drifted fields.
+	DriftPolicyModeReportOnly DriftPolicyMode = "ReportOnly"
+	// DriftPolicyModeAlert behaves like ReportOnly and additionally notifies the environment's
+	// notification channels when drift is detected.
+	DriftPolicyModeAlert DriftPolicyMode = "Alert"
+)
+
+// DriftPolicy configures drift handling for the resources deployed to an environment.
+type DriftPolicy struct {
+	// Mode is how drift is handled.
+	// +kubebuilder:default=AutoCorrect
+	// +optional
+	Mode DriftPolicyMode `json:"mode,omitempty"`
+
+	// NotificationChannels are the ObservabilityAlertsNotificationChannels notified when drift is
+	// detected in Alert mode. Defaults to the default notification channel of the environment.
+	// +optional
+	NotificationChannels []string `json:"notificationChannels,omitempty"`
+}
+
+// GetMode returns the drift policy mode, defaulting to AutoCorrect.
+func (p *DriftPolicy) GetMode() DriftPolicyMode {
+	if p == nil || p.Mode == "" {
+		return DriftPolicyModeAutoCorrect
+	}
+	return p.Mode
 }
 
 // EnvironmentStatus defines the observed state of Environment.
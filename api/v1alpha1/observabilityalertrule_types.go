@@ -23,7 +23,7 @@ const (
 )
 
 // ObservabilityAlertSourceType identifies the origin of the telemetry data.
-// +kubebuilder:validation:Enum=log;metric;budget
+// +kubebuilder:validation:Enum=log;metric;budget;logMetric
 type ObservabilityAlertSourceType string
 
 const (
@@ -33,6 +33,9 @@ const (
 	ObservabilityAlertSourceTypeMetric ObservabilityAlertSourceType = "metric"
 	// ObservabilityAlertSourceTypeBudget represents budget-based alerting.
 	ObservabilityAlertSourceTypeBudget ObservabilityAlertSourceType = "budget"
+	// ObservabilityAlertSourceTypeLogMetric represents alerting on a log-derived metric
+	// defined in the observer.
+	ObservabilityAlertSourceTypeLogMetric ObservabilityAlertSourceType = "logMetric"
 )
 
 // ObservabilityAlertConditionOperator describes how a computed signal is evaluated.
@@ -54,7 +57,7 @@ const (
 
 // ObservabilityAlertSource describes where and how events are pulled for evaluation.
 type ObservabilityAlertSource struct {
-	// Type specifies the telemetry source type (log, metric).
+	// Type specifies the telemetry source type (log, metric, budget, logMetric).
 	// +kubebuilder:validation:Required
 	Type ObservabilityAlertSourceType `json:"type"`
 
@@ -67,6 +70,11 @@ type ObservabilityAlertSource struct {
 	// This is required for metric-based alerting.
 	// +optional
 	Metric string `json:"metric,omitempty"`
+
+	// LogMetric names a log metric of the component's project in the observer.
+	// This is required for logMetric-based alerting.
+	// +optional
+	LogMetric string `json:"logMetric,omitempty"`
 }
 
 // ObservabilityAlertCondition represents the evaluation window of the alert.
@@ -54,11 +54,30 @@ type RenderedReleaseStatus struct {
 	// +optional
 	Resources []RenderedManifestStatus `json:"resources,omitempty"`
 
+	// CurrentWave is the apply step the controller is waiting on when resources declare
+	// apply waves or sync hooks. Unset once every step has been applied and is healthy.
+	// +optional
+	CurrentWave *RenderedReleaseWave `json:"currentWave,omitempty"`
+
 	// Conditions represent the latest available observations of the RenderedRelease's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// RenderedReleaseWave identifies an apply step of a RenderedRelease.
+type RenderedReleaseWave struct {
+	// Phase is the sync phase of the step.
+	// +kubebuilder:validation:Enum=PreSync;Sync;PostSync
+	Phase SyncPhase `json:"phase"`
+
+	// Wave is the apply wave of the step within its phase.
+	Wave int32 `json:"wave"`
+
+	// PendingResources lists the IDs of the resources in this step that are not healthy yet.
+	// +optional
+	PendingResources []string `json:"pendingResources,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
@@ -156,6 +175,21 @@ type RenderedManifestStatus struct {
 	// LastObservedTime stores the last time the status was observed
 	// +optional
 	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+
+	// Drift reports the fields of the live resource that differ from the desired manifest.
+	// Only tracked when the environment's drift policy does not auto-correct drift.
+	// +optional
+	Drift *ResourceDrift `json:"drift,omitempty"`
+}
+
+// ResourceDrift describes how a live resource differs from its desired manifest.
+type ResourceDrift struct {
+	// Fields lists the paths of the drifted fields,
+	// e.g. "spec.replicas" or "spec.template.spec.containers[0].image".
+	Fields []string `json:"fields"`
+
+	// DetectedTime is when the drift was first detected.
+	DetectedTime metav1.Time `json:"detectedTime"`
 }
 
 // HealthStatus represents the health of a resource
@@ -107,8 +107,37 @@ type SecretStoreReference struct {
 
 	// Kind of resource (ExternalSecret, ClusterExternalSecret)
 	Kind string `json:"kind"`
+
+	// Plane is the plane whose secret store backs the ExternalSecret
+	// +optional
+	Plane *TargetPlaneRef `json:"plane,omitempty"`
+
+	// SyncStatus is the last observed sync state of the ExternalSecret
+	// +optional
+	SyncStatus SecretSyncStatus `json:"syncStatus,omitempty"`
+
+	// Message gives details about the sync state, such as the error reported by the store
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastSyncTime is when the secret was last fetched from the store
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 }
 
+// SecretSyncStatus is the sync state of a secret materialized from an external secret store.
+// +kubebuilder:validation:Enum=Synced;Pending;Failed
+type SecretSyncStatus string
+
+const (
+	// SecretSyncStatusSynced indicates the secret was fetched from the store.
+	SecretSyncStatusSynced SecretSyncStatus = "Synced"
+	// SecretSyncStatusPending indicates the secret has not been fetched yet.
+	SecretSyncStatusPending SecretSyncStatus = "Pending"
+	// SecretSyncStatusFailed indicates the last fetch from the store failed.
+	SecretSyncStatusFailed SecretSyncStatus = "Failed"
+)
+
 // SecretReferenceStatus defines the observed state of SecretReference.
 type SecretReferenceStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -129,6 +158,9 @@ type SecretReferenceStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // SecretReference is the Schema for the secretreferences API.
 type SecretReference struct {
@@ -151,3 +183,11 @@ type SecretReferenceList struct {
 func init() {
 	SchemeBuilder.Register(&SecretReference{}, &SecretReferenceList{})
 }
+
+func (s *SecretReference) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+func (s *SecretReference) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
@@ -531,3 +531,16 @@ const (
 	// ResourceRetainPolicyRetain keeps the underlying provisioned data after deletion.
 	ResourceRetainPolicyRetain ResourceRetainPolicy = "Retain"
 )
+
+// SyncPhase is a phase of applying a RenderedRelease. Resources are applied
+// PreSync first, then Sync, then PostSync, and within a phase by ascending apply wave.
+type SyncPhase string
+
+const (
+	// SyncPhasePreSync runs before any regular resource is applied, e.g. a database migration Job.
+	SyncPhasePreSync SyncPhase = "PreSync"
+	// SyncPhaseSync is the phase of every resource that is not a hook.
+	SyncPhaseSync SyncPhase = "Sync"
+	// SyncPhasePostSync runs once every regular resource is healthy, e.g. a smoke test Job.
+	SyncPhasePostSync SyncPhase = "PostSync"
+)
@@ -68,6 +68,54 @@ type WorkflowSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// Artifacts declares the artifacts produced by runs of this workflow, such as the built image,
+	// its SBOM and its provenance attestation. Their references are read from the workflow-level
+	// outputs of the run resource (for Argo Workflows, output parameters exported with a globalName)
+	// once a run succeeds, and recorded in the WorkflowRun status.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Artifacts []WorkflowArtifact `json:"artifacts,omitempty"`
+}
+
+// WorkflowArtifactType is the kind of artifact a workflow run produces.
+// +kubebuilder:validation:Enum=Image;SBOM;Provenance;Signature
+type WorkflowArtifactType string
+
+const (
+	// WorkflowArtifactTypeImage is a built container image. The reference includes the image digest.
+	WorkflowArtifactTypeImage WorkflowArtifactType = "Image"
+	// WorkflowArtifactTypeSBOM is a software bill of materials of the built image.
+	WorkflowArtifactTypeSBOM WorkflowArtifactType = "SBOM"
+	// WorkflowArtifactTypeProvenance is a build provenance attestation (e.g., SLSA) of the built image.
+	WorkflowArtifactTypeProvenance WorkflowArtifactType = "Provenance"
+	// WorkflowArtifactTypeSignature is a signature of the built image.
+	WorkflowArtifactTypeSignature WorkflowArtifactType = "Signature"
+)
+
+// WorkflowArtifact declares an artifact produced by a workflow run.
+type WorkflowArtifact struct {
+	// Name uniquely identifies this artifact within the workflow.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Type is the kind of artifact.
+	// +kubebuilder:validation:Required
+	Type WorkflowArtifactType `json:"type"`
+
+	// OutputParameter is the name of the workflow-level output parameter that holds the
+	// artifact reference (e.g., an image reference with digest or an OCI attestation reference).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	OutputParameter string `json:"outputParameter"`
+
+	// Format describes the artifact encoding, e.g. "spdx-json" or "cyclonedx-json" for SBOMs
+	// and the predicate type (e.g., "https://slsa.dev/provenance/v1") for attestations.
+	// +optional
+	Format string `json:"format,omitempty"`
 }
 
 // WorkflowResource defines a template for generating Kubernetes resources
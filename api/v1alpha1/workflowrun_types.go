@@ -26,6 +26,21 @@ type WorkflowRunSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// Cancel requests the termination of this workflow run.
+	// The controller terminates the underlying workflow (e.g., Argo Workflow) referenced by
+	// status.runReference and completes the run with the WorkflowCancelled reason.
+	// Once set, cancel cannot be unset.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!oldSelf || self",message="cancel cannot be unset"
+	Cancel bool `json:"cancel,omitempty"`
+
+	// Resume requests that a failed workflow run is resumed from its failed tasks.
+	// Tasks that already succeeded are kept and only the failed tasks are re-executed.
+	// Resuming is supported for Argo Workflows only. The controller resets this field once
+	// the resume has been started.
+	// +optional
+	Resume bool `json:"resume,omitempty"`
 }
 
 // WorkflowRunConfig defines the workflow configuration for execution.
@@ -108,6 +123,25 @@ type WorkflowTask struct {
 	Message string `json:"message,omitempty"`
 }
 
+// WorkflowRunArtifact is an artifact produced by a workflow run.
+type WorkflowRunArtifact struct {
+	// Name is the name of the artifact declared by the Workflow.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type is the kind of artifact.
+	// +kubebuilder:validation:Required
+	Type WorkflowArtifactType `json:"type"`
+
+	// Reference locates the artifact, e.g. an image reference with digest.
+	// +kubebuilder:validation:Required
+	Reference string `json:"reference"`
+
+	// Format describes the artifact encoding.
+	// +optional
+	Format string `json:"format,omitempty"`
+}
+
 // WorkflowRunStatus defines the observed state of WorkflowRun.
 type WorkflowRunStatus struct {
 	// Conditions represent the current state of the WorkflowRun resource.
@@ -133,6 +167,11 @@ type WorkflowRunStatus struct {
 	// +optional
 	Tasks []WorkflowTask `json:"tasks,omitempty"`
 
+	// Artifacts contains the artifacts produced by this workflow run, as declared by the
+	// artifacts of the referenced Workflow. Populated once the run succeeds.
+	// +optional
+	Artifacts []WorkflowRunArtifact `json:"artifacts,omitempty"`
+
 	// StartedAt is the timestamp when this workflow run started execution.
 	// +optional
 	StartedAt *metav1.Time `json:"startedAt,omitempty"`
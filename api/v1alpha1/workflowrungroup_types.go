@@ -0,0 +1,181 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkflowRunGroupFailurePolicy specifies how a WorkflowRunGroup reacts to a failed run.
+// +kubebuilder:validation:Enum=Continue;FailFast
+type WorkflowRunGroupFailurePolicy string
+
+const (
+	// WorkflowRunGroupFailurePolicyContinue keeps starting and running the remaining runs.
+	WorkflowRunGroupFailurePolicyContinue WorkflowRunGroupFailurePolicy = "Continue"
+	// WorkflowRunGroupFailurePolicyFailFast cancels the active runs and skips the runs that
+	// have not started once a run fails.
+	WorkflowRunGroupFailurePolicyFailFast WorkflowRunGroupFailurePolicy = "FailFast"
+)
+
+// WorkflowRunGroupRunPhase is the phase of a run of a WorkflowRunGroup.
+type WorkflowRunGroupRunPhase string
+
+const (
+	// WorkflowRunGroupRunPending indicates the run has not been started yet.
+	WorkflowRunGroupRunPending WorkflowRunGroupRunPhase = "Pending"
+	// WorkflowRunGroupRunRunning indicates the WorkflowRun was created and has not completed.
+	WorkflowRunGroupRunRunning WorkflowRunGroupRunPhase = "Running"
+	// WorkflowRunGroupRunSucceeded indicates the WorkflowRun succeeded.
+	WorkflowRunGroupRunSucceeded WorkflowRunGroupRunPhase = "Succeeded"
+	// WorkflowRunGroupRunFailed indicates the WorkflowRun failed or was cancelled.
+	WorkflowRunGroupRunFailed WorkflowRunGroupRunPhase = "Failed"
+	// WorkflowRunGroupRunSkipped indicates the run was not started because the group failed fast.
+	WorkflowRunGroupRunSkipped WorkflowRunGroupRunPhase = "Skipped"
+)
+
+// WorkflowRunGroupSpec defines the desired state of WorkflowRunGroup.
+// WorkflowRunGroup is one logical build of several components, typically triggered by the same
+// commit of a monorepo. The controller creates a WorkflowRun for each entry of runs.
+type WorkflowRunGroupSpec struct {
+	// Commit is the commit the runs of the group build, for display.
+	// +optional
+	Commit string `json:"commit,omitempty"`
+
+	// MaxConcurrency caps the number of runs of the group that execute on the workflow plane at
+	// the same time. The remaining runs are started as earlier runs complete. Unlimited when unset.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+
+	// FailurePolicy specifies how the group reacts to a failed run: Continue runs the remaining
+	// runs, and FailFast cancels the active runs and skips the runs that have not started.
+	// +optional
+	// +kubebuilder:default=Continue
+	FailurePolicy WorkflowRunGroupFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Runs are the workflow runs of the group, started in the listed order.
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="runs are immutable"
+	Runs []WorkflowRunGroupRun `json:"runs"`
+}
+
+// WorkflowRunGroupRun is a workflow run of a WorkflowRunGroup.
+type WorkflowRunGroupRun struct {
+	// Name identifies the run within the group, typically the component name.
+	// The WorkflowRun is named after the group and this name.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	WorkflowRunTemplate `json:",inline"`
+}
+
+// WorkflowRunGroupRunStatus is the observed state of a run of a WorkflowRunGroup.
+type WorkflowRunGroupRunStatus struct {
+	// Name is the name of the run within the group.
+	Name string `json:"name"`
+
+	// WorkflowRunName is the name of the created WorkflowRun. Empty until the run is started.
+	// +optional
+	WorkflowRunName string `json:"workflowRunName,omitempty"`
+
+	// Phase is the phase of the run.
+	// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed;Skipped
+	Phase WorkflowRunGroupRunPhase `json:"phase"`
+}
+
+// WorkflowRunGroupStatus defines the observed state of WorkflowRunGroup.
+type WorkflowRunGroupStatus struct {
+	// ObservedGeneration is the generation most recently observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the aggregate state of the group. WorkflowRunGroupCompleted is true
+	// once every run has completed or was skipped, and WorkflowRunGroupSucceeded tells whether
+	// all runs succeeded.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Runs is the observed state of each run, in the order of spec.runs.
+	// +optional
+	Runs []WorkflowRunGroupRunStatus `json:"runs,omitempty"`
+
+	// Total is the number of runs in the group.
+	// +optional
+	Total int32 `json:"total,omitempty"`
+	// Pending is the number of runs that have not been started.
+	// +optional
+	Pending int32 `json:"pending,omitempty"`
+	// Running is the number of started runs that have not completed.
+	// +optional
+	Running int32 `json:"running,omitempty"`
+	// Succeeded is the number of runs that succeeded.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of runs that failed or were cancelled.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+	// Skipped is the number of runs skipped after the group failed fast.
+	// +optional
+	Skipped int32 `json:"skipped,omitempty"`
+
+	// StartedAt is the time the first run was started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is the time the group completed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=wfrg
+// +kubebuilder:printcolumn:name="Commit",type=string,JSONPath=`.spec.commit`
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Succeeded",type=integer,JSONPath=`.status.succeeded`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Completed",type=string,JSONPath=`.status.conditions[?(@.type=="WorkflowRunGroupCompleted")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// WorkflowRunGroup is the Schema for the workflowrungroups API.
+type WorkflowRunGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowRunGroupSpec   `json:"spec,omitempty"`
+	Status WorkflowRunGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowRunGroupList contains a list of WorkflowRunGroup.
+type WorkflowRunGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowRunGroup `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (g *WorkflowRunGroup) GetConditions() []metav1.Condition {
+	return g.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (g *WorkflowRunGroup) SetConditions(conditions []metav1.Condition) {
+	g.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowRunGroup{}, &WorkflowRunGroupList{})
+}
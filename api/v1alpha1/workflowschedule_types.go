@@ -0,0 +1,175 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WorkflowScheduleConcurrencyPolicy specifies how a WorkflowSchedule treats a run that is due
+// while an earlier run is still active.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type WorkflowScheduleConcurrencyPolicy string
+
+const (
+	// WorkflowScheduleConcurrencyAllow starts the new run alongside the active runs.
+	WorkflowScheduleConcurrencyAllow WorkflowScheduleConcurrencyPolicy = "Allow"
+	// WorkflowScheduleConcurrencyForbid skips the new run while an earlier run is active.
+	WorkflowScheduleConcurrencyForbid WorkflowScheduleConcurrencyPolicy = "Forbid"
+	// WorkflowScheduleConcurrencyReplace cancels the active runs and starts the new run.
+	WorkflowScheduleConcurrencyReplace WorkflowScheduleConcurrencyPolicy = "Replace"
+)
+
+// WorkflowScheduleSpec defines the desired state of WorkflowSchedule.
+// WorkflowSchedule creates WorkflowRuns of a Workflow or ClusterWorkflow on a cron schedule.
+type WorkflowScheduleSpec struct {
+	// Schedule is the cron schedule of the runs in standard five-field cron format,
+	// e.g. "0 2 * * *". Descriptors such as "@daily" and "@every 6h" are also accepted.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// TimeZone is the IANA time zone the schedule is interpreted in, e.g. "Europe/Berlin".
+	// Defaults to the time zone of the controller manager, which is UTC in the default installation.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// StartingDeadlineSeconds is the deadline in seconds for starting a run that missed its
+	// scheduled time, e.g. while the controller manager was unavailable. Runs that miss the
+	// deadline are skipped. When unset, a missed run is started however late it is.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy specifies how a run that is due while an earlier run of this schedule
+	// is still active is treated: Allow starts it anyway, Forbid skips it, and Replace cancels
+	// the active runs and starts it.
+	// +optional
+	// +kubebuilder:default=Allow
+	ConcurrencyPolicy WorkflowScheduleConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend stops the schedule from starting new runs. Active runs are not affected.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// SuccessfulRunsHistoryLimit is the number of succeeded runs to keep. Older succeeded runs are deleted.
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	SuccessfulRunsHistoryLimit *int32 `json:"successfulRunsHistoryLimit,omitempty"`
+
+	// FailedRunsHistoryLimit is the number of failed or cancelled runs to keep. Older ones are deleted.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	FailedRunsHistoryLimit *int32 `json:"failedRunsHistoryLimit,omitempty"`
+
+	// RunTemplate describes the WorkflowRuns created by the schedule.
+	// +required
+	RunTemplate WorkflowRunTemplate `json:"runTemplate"`
+}
+
+// WorkflowRunTemplate describes the WorkflowRuns created by a WorkflowSchedule.
+type WorkflowRunTemplate struct {
+	// Labels are added to every created WorkflowRun. Component builds set the
+	// openchoreo.dev/project and openchoreo.dev/component labels here so that the runs
+	// are associated with the component.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Workflow references the Workflow or ClusterWorkflow to run and provides its parameter values.
+	// +required
+	Workflow ScheduledWorkflowConfig `json:"workflow"`
+}
+
+// ScheduledWorkflowConfig references the workflow run by a WorkflowSchedule.
+// Unlike WorkflowRunConfig, the reference can be changed after creation.
+type ScheduledWorkflowConfig struct {
+	// Kind is the kind of workflow (Workflow or ClusterWorkflow).
+	// +optional
+	// +kubebuilder:default=ClusterWorkflow
+	Kind WorkflowRefKind `json:"kind,omitempty"`
+
+	// Name references the Workflow or ClusterWorkflow CR to run.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Parameters contains the values for the parameter schema defined in the referenced workflow.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// WorkflowScheduleStatus defines the observed state of WorkflowSchedule.
+type WorkflowScheduleStatus struct {
+	// ObservedGeneration is the generation most recently observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the current state of the WorkflowSchedule.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Active lists the names of the runs of this schedule that have not completed.
+	// +optional
+	Active []string `json:"active,omitempty"`
+
+	// LastScheduleTime is the scheduled time of the most recently started run.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSuccessfulTime is the completion time of the most recent successful run.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// NextScheduleTime is the time the next run is due.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=wfsched
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Workflow",type=string,JSONPath=`.spec.runTemplate.workflow.name`
+// +kubebuilder:printcolumn:name="Suspend",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="Last Schedule",type=date,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// WorkflowSchedule is the Schema for the workflowschedules API.
+type WorkflowSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowScheduleSpec   `json:"spec,omitempty"`
+	Status WorkflowScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowScheduleList contains a list of WorkflowSchedule.
+type WorkflowScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowSchedule `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (s *WorkflowSchedule) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (s *WorkflowSchedule) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowSchedule{}, &WorkflowScheduleList{})
+}
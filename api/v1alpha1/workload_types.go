@@ -266,6 +266,54 @@ type WorkloadTemplateSpec struct {
 	// Dependencies define the dependencies of this workload on other components.
 	// +optional
 	Dependencies *WorkloadDependencies `json:"dependencies,omitempty"`
+
+	// Provenance records how the container image was built. It is set by the workflow run that
+	// built the image and is carried into ComponentReleases together with the rest of the workload.
+	// Provenance policies and image verification only trust it as far as the named WorkflowRun,
+	// which must belong to the same component and have built the image, confirms it.
+	// +optional
+	Provenance *BuildProvenance `json:"provenance,omitempty"`
+}
+
+// BuildProvenance links a workload image to the workflow run that built it and to the
+// supply chain artifacts published for it.
+type BuildProvenance struct {
+	// WorkflowRun is the name of the workflow run that built the image.
+	// +optional
+	WorkflowRun string `json:"workflowRun,omitempty"`
+
+	// ImageDigest is the digest of the built image (e.g., "sha256:...").
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// SBOM references the software bill of materials of the image.
+	// +optional
+	SBOM *ArtifactReference `json:"sbom,omitempty"`
+
+	// Attestation references the build provenance attestation (e.g., SLSA) of the image.
+	// +optional
+	Attestation *ArtifactReference `json:"attestation,omitempty"`
+
+	// Signature references the signature of the image.
+	// +optional
+	Signature *ArtifactReference `json:"signature,omitempty"`
+}
+
+// SignatureFormatCosignBundle is the ArtifactReference format of an image signature whose
+// reference holds the base64-encoded cosign signature bundle rather than a location, so the
+// signature can be verified without contacting the registry.
+const SignatureFormatCosignBundle = "cosign-bundle"
+
+// ArtifactReference locates a supply chain artifact.
+type ArtifactReference struct {
+	// Reference locates the artifact, e.g. an OCI reference or a URL.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reference string `json:"reference"`
+
+	// Format describes the artifact encoding, e.g. "spdx-json" or an attestation predicate type.
+	// +optional
+	Format string `json:"format,omitempty"`
 }
 
 // GetDependencyEndpoints returns the endpoint connections from dependencies, or nil if none.
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -36,6 +37,12 @@ func main() {
 		heartbeatInterval time.Duration
 		requestTimeout    time.Duration
 		logLevel          string
+		allowedAPIGroups  string
+		allowedResources  string
+		allowedNamespaces string
+		allowedVerbs      string
+		execEnabled       bool
+		auditLogPath      string
 	)
 
 	var kubeconfig string
@@ -64,6 +71,18 @@ func main() {
 	flag.DurationVar(&heartbeatInterval, "heartbeat-interval", defaultHeartbeatInterval, "Heartbeat message interval")
 	flag.DurationVar(&requestTimeout, "request-timeout", defaultRequestTimeout, "Request timeout duration")
 	flag.StringVar(&logLevel, "log-level", cmdutil.GetEnv("LOG_LEVEL", "info"), "Log level (debug, info, warn, error)")
+	flag.StringVar(&allowedAPIGroups, "allowed-api-groups", cmdutil.GetEnv("ALLOWED_API_GROUPS", ""),
+		"Comma-separated API groups the tunnel may access; use 'core' for the core group (empty allows all)")
+	flag.StringVar(&allowedResources, "allowed-resources", cmdutil.GetEnv("ALLOWED_RESOURCES", ""),
+		"Comma-separated resources the tunnel may access, e.g. pods,pods/log,deployments (empty allows all)")
+	flag.StringVar(&allowedNamespaces, "allowed-namespaces", cmdutil.GetEnv("ALLOWED_NAMESPACES", ""),
+		"Comma-separated namespace patterns the tunnel may access, e.g. dp-* (empty allows all)")
+	flag.StringVar(&allowedVerbs, "allowed-verbs", cmdutil.GetEnv("ALLOWED_VERBS", ""),
+		"Comma-separated Kubernetes verbs the tunnel may use, e.g. get,list,watch (empty allows all)")
+	flag.BoolVar(&execEnabled, "exec-enabled", cmdutil.GetEnvBool("EXEC_ENABLED", true),
+		"Allow pod exec, attach and port-forward, and nodes/proxy, through the tunnel")
+	flag.StringVar(&auditLogPath, "audit-log-path", cmdutil.GetEnv("AUDIT_LOG_PATH", ""),
+		"Path of the append-only audit log of tunneled requests (empty disables auditing)")
 	flag.Parse()
 
 	if planeType == "" {
@@ -92,6 +111,8 @@ func main() {
 		"clientKey", clientKeyPath,
 		"serverCA", serverCAPath,
 		"kubeconfig", kubeconfig,
+		"execEnabled", execEnabled,
+		"auditLogPath", auditLogPath,
 	)
 
 	// Create Kubernetes client (in-cluster or from kubeconfig)
@@ -119,6 +140,14 @@ func main() {
 		HeartbeatInterval: heartbeatInterval,
 		RequestTimeout:    requestTimeout,
 		Routes:            []agentclient.RouteConfig{}, // Empty for now, can be loaded from config file later
+		Policy: agentclient.PolicyConfig{
+			AllowedAPIGroups:  splitList(allowedAPIGroups),
+			AllowedResources:  splitList(allowedResources),
+			AllowedNamespaces: splitList(allowedNamespaces),
+			AllowedVerbs:      splitList(allowedVerbs),
+			ExecEnabled:       execEnabled,
+		},
+		AuditLogPath: auditLogPath,
 	}
 
 	agent, err := agentclient.New(config, k8sClient, k8sConfig, logger)
@@ -139,3 +168,14 @@ func main() {
 
 	logger.Info("agent shutdown completed")
 }
+
+// splitList parses a comma-separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	// +kubebuilder:scaffold:imports
@@ -28,9 +29,12 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	componentreleasespec "github.com/openchoreo/openchoreo/internal/componentrelease"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/authzaccessrequest"
 	"github.com/openchoreo/openchoreo/internal/controller/clustercomponenttype"
 	"github.com/openchoreo/openchoreo/internal/controller/clusterdataplane"
+	"github.com/openchoreo/openchoreo/internal/controller/clusterhealthcheck"
 	"github.com/openchoreo/openchoreo/internal/controller/clusterobservabilityplane"
 	"github.com/openchoreo/openchoreo/internal/controller/clusterprojecttype"
 	"github.com/openchoreo/openchoreo/internal/controller/clusterresourcetype"
@@ -61,11 +65,14 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/workflow"
 	"github.com/openchoreo/openchoreo/internal/controller/workflowplane"
 	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrungroup"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowschedule"
 	"github.com/openchoreo/openchoreo/internal/controller/workload"
 	argo "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 	ciliumv2 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/cilium.io/v2"
 	esv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
 	csisecretv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
+	"github.com/openchoreo/openchoreo/internal/healthcheck"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
 	"github.com/openchoreo/openchoreo/internal/version"
@@ -113,12 +120,19 @@ type controllerSetup interface {
 	SetupWithManager(mgr ctrl.Manager) error
 }
 
+// imagePinningConfig configures how auto-deployed ComponentReleases pin workload images to digests.
+type imagePinningConfig struct {
+	enabled            bool
+	insecureRegistries []string
+}
+
 // setupControlPlaneControllers sets up all control plane controllers with the manager
 func setupControlPlaneControllers(
 	mgr ctrl.Manager,
 	k8sClientMgr *kubernetesClient.KubeMultiClientManager,
 	clusterGatewayURL string,
 	gwTLS gatewayClient.TLSConfig,
+	imagePinning imagePinningConfig,
 ) error {
 	// Create gateway client for plane lifecycle notifications
 	var gwClient *gatewayClient.Client
@@ -151,6 +165,17 @@ func setupControlPlaneControllers(
 
 	c, s := mgr.GetClient(), mgr.GetScheme()
 
+	// Registry credentials are read with the uncached API reader so that no cluster-wide
+	// Secret informer is started for them.
+	var imageResolver componentreleasespec.ResolverFactory
+	if imagePinning.enabled {
+		imageResolver = componentreleasespec.RegistryResolverFactory(mgr.GetAPIReader(), imagePinning.insecureRegistries)
+	}
+
+	// Shared between the ClusterHealthCheck controller, which compiles the checks,
+	// and the RenderedRelease controller, which evaluates them.
+	healthChecks := healthcheck.NewRegistry()
+
 	reconcilers := []controllerSetup{
 		&deploymentpipeline.Reconciler{Client: c, Scheme: s},
 		&workload.Reconciler{Client: c, Scheme: s},
@@ -181,7 +206,7 @@ func setupControlPlaneControllers(
 		&projecttype.Reconciler{Client: c, Scheme: s},
 		&projectrelease.Reconciler{Client: c, Scheme: s},
 		&projectreleasebinding.Reconciler{Client: c, Scheme: s},
-		&component.Reconciler{Client: c, Scheme: s},
+		&component.Reconciler{Client: c, Scheme: s, ImageResolver: imageResolver},
 		&componenttype.Reconciler{Client: c, Scheme: s},
 		&clustercomponenttype.Reconciler{Client: c, Scheme: s},
 		&trait.Reconciler{Client: c, Scheme: s},
@@ -195,10 +220,13 @@ func setupControlPlaneControllers(
 		&resourcerelease.Reconciler{Client: c, Scheme: s},
 		&resourcereleasebinding.Reconciler{Client: c, Scheme: s},
 		&releasebinding.Reconciler{Client: c, Scheme: s, Pipeline: componentpipeline.NewPipeline()},
+		&clusterhealthcheck.Reconciler{Client: c, Scheme: s, Registry: healthChecks},
 		&renderedrelease.Reconciler{
 			Client:              c,
 			PlaneClientProvider: planeClientProvider,
 			Scheme:              s,
+			HealthChecks:        healthChecks,
+			DriftNotifier:       renderedrelease.NewObserverDriftNotifier(controller.GetObserverInternalBaseURL()),
 		},
 		&workflow.Reconciler{Client: c, Scheme: s},
 		&clusterworkflow.Reconciler{Client: c, Scheme: s},
@@ -208,6 +236,9 @@ func setupControlPlaneControllers(
 			PlaneClientProvider: planeClientProvider,
 			Pipeline:            workflowpipeline.NewPipeline(),
 		},
+		&workflowschedule.Reconciler{Client: c, Scheme: s},
+		&workflowrungroup.Reconciler{Client: c, Scheme: s},
+		&authzaccessrequest.Reconciler{Client: c, Scheme: s},
 		&workflowplane.Reconciler{
 			Client:        c,
 			Scheme:        s,
@@ -215,7 +246,7 @@ func setupControlPlaneControllers(
 			GatewayClient: gwClient,
 			CacheVersion:  "v2",
 		},
-		&secretreference.Reconciler{Client: c, Scheme: s},
+		&secretreference.Reconciler{Client: c, PlaneClientProvider: planeClientProvider, Scheme: s},
 		&observabilityplane.Reconciler{
 			Client:        c,
 			Scheme:        s,
@@ -283,6 +314,8 @@ func main() {
 	var clusterGatewayInsecure bool
 	var deploymentPlane string
 	var maxConcurrentReconciles int
+	var imagePinning bool
+	var insecureRegistries string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -314,6 +347,10 @@ func main() {
 		"The deployment plane this manager should serve. Supported values: controlplane, observabilityplane")
 	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
 		"Max concurrent reconciles per controller (manager-wide).")
+	flag.BoolVar(&imagePinning, "image-pinning", true,
+		"If set, auto-deployed ComponentReleases pin tagged workload images to the digests they resolve to.")
+	flag.StringVar(&insecureRegistries, "insecure-registries", "",
+		"Comma-separated registries (host[:port]) reached over plain HTTP when pinning images, e.g. a local k3d registry.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -429,7 +466,7 @@ func main() {
 			ClientCertFile:     clusterGatewayClientCert,
 			ClientKeyFile:      clusterGatewayClientKey,
 			InsecureSkipVerify: clusterGatewayInsecure,
-		})
+		}, imagePinningConfig{enabled: imagePinning, insecureRegistries: splitList(insecureRegistries)})
 		if err != nil {
 			setupLog.Error(err, "unable to setup control plane controllers")
 			os.Exit(1)
@@ -497,6 +534,17 @@ func main() {
 	}
 }
 
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // getEnv retrieves an environment variable value, returning a default if not set
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -26,6 +26,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/observer/service"
 	"github.com/openchoreo/openchoreo/internal/observer/store/alertentry"
 	"github.com/openchoreo/openchoreo/internal/observer/store/incidententry"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
 	apiconfig "github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
@@ -149,7 +150,7 @@ func main() {
 
 	// Initialize traces service
 	tracesService, tracesServiceErr := service.NewTracesService(
-		tracingAdapter, uidResolver, cfg, logger.With("component", "traces-service"),
+		tracingAdapter, logsAdapter, authzClient, uidResolver, cfg, logger.With("component", "traces-service"),
 	)
 	if tracesServiceErr != nil {
 		logger.Error("Failed to initialize traces service", "error", tracesServiceErr)
@@ -198,6 +199,34 @@ func main() {
 		}
 	}()
 
+	// Saved log queries and log metrics live next to the alert entries.
+	logQueryStore, err := logquery.New(
+		cfg.Alerting.AlertStoreBackend,
+		cfg.Alerting.AlertStoreDSN,
+		logger.With("component", "log-query-store"),
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize log query store: %v", err)
+	}
+	if err := logQueryStore.Initialize(context.Background()); err != nil {
+		log.Fatalf("Failed to initialize log query store schema: %v", err)
+	}
+	defer func() {
+		if closeErr := logQueryStore.Close(); closeErr != nil {
+			logger.Error("Failed to close log query store", "error", closeErr)
+		}
+	}()
+
+	logQueriesService, err := service.NewLogQueriesService(
+		logQueryStore, logsService, logsAdapter, uidResolver, cfg, logger.With("component", "log-queries-service"),
+	)
+	if err != nil {
+		logger.Error("Failed to initialize log queries service", "error", err)
+		os.Exit(1)
+	}
+	// Serve metric="log" queries from the evaluated log metrics
+	metricsService = service.NewMetricsQuerierWithLogMetrics(metricsService, logQueriesService)
+
 	// Initialize alert service for the internal v1alpha1 API
 	alertService := service.NewAlertService(
 		alertEntryStore,
@@ -213,12 +242,14 @@ func main() {
 		metricsAdapterClient,
 		cfg.Alerting.FinOpsAgentURL,
 		cfg.Alerting.FinOpsAgentEnabled,
+		logQueryStore,
 	)
 
 	// Wrap services with authorization checks.
 	// Both the API handler and MCP handler share the same authz-wrapped instances
 	// so authorization logic is enforced once, in the service layer.
 	authzLogsService := service.NewLogsServiceWithAuthz(logsService, authzClient, logger.With("component", "authz-logs"))
+	authzLogsTailer := service.NewLogsTailerWithAuthz(logsService, authzClient, logger.With("component", "authz-logs-tail"))
 	authzEventsService := service.NewEventsServiceWithAuthz(
 		eventsService, authzClient, logger.With("component", "authz-events"))
 	authzMetricsService := service.NewMetricsServiceWithAuthz(
@@ -229,16 +260,20 @@ func main() {
 		finopsAdapter, authzClient, logger.With("component", "authz-finops"))
 	authzAlertIncidentService := service.NewAlertIncidentServiceWithAuthz(
 		alertService, authzClient, logger.With("component", "authz-alerts-incidents"))
+	authzLogQueriesService := service.NewLogQueriesServiceWithAuthz(
+		logQueriesService, authzClient, logger.With("component", "authz-log-queries"))
 
 	// Initialize new API handler
 	newAPIHandler := apihandler.NewHandler(
 		healthService,
 		authzLogsService,
+		authzLogsTailer,
 		authzEventsService,
 		authzMetricsService,
 		authzAlertIncidentService,
 		authzTracesService,
 		authzFinOpsService,
+		authzLogQueriesService,
 		logger.With("component", "api-handler"),
 	)
 
@@ -275,12 +310,46 @@ func main() {
 
 	// ===== New API Routes (v1) =====
 	api.HandleFunc("POST /api/v1/logs/query", newAPIHandler.QueryLogs)
+	api.HandleFunc("POST /api/v1/logs/tail", newAPIHandler.TailLogs)
 	api.HandleFunc("POST /api/v1/events/query", newAPIHandler.QueryEvents)
 	api.HandleFunc("POST /api/v1/metrics/query", newAPIHandler.QueryMetrics)
 
+	// ===== New API Routes (v1alpha1) Saved log queries & log metrics =====
+	api.HandleFunc(
+		"GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries",
+		newAPIHandler.ListSavedLogQueries)
+	api.HandleFunc(
+		"POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries",
+		newAPIHandler.CreateSavedLogQuery)
+	api.HandleFunc(
+		"GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}",
+		newAPIHandler.GetSavedLogQuery)
+	api.HandleFunc(
+		"PUT /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}",
+		newAPIHandler.UpdateSavedLogQuery)
+	api.HandleFunc(
+		"DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}",
+		newAPIHandler.DeleteSavedLogQuery)
+	api.HandleFunc(
+		"POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}/run",
+		newAPIHandler.RunSavedLogQuery)
+	api.HandleFunc(
+		"GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics",
+		newAPIHandler.ListLogMetrics)
+	api.HandleFunc(
+		"POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics",
+		newAPIHandler.CreateLogMetric)
+	api.HandleFunc(
+		"GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name}",
+		newAPIHandler.GetLogMetric)
+	api.HandleFunc(
+		"DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name}",
+		newAPIHandler.DeleteLogMetric)
+
 	// ===== New API Routes (v1alpha1) Traces, Incidents & Runtime topology =====
 	api.HandleFunc("POST /api/v1alpha1/metrics/runtime-topology", newAPIHandler.QueryRuntimeTopology)
 	api.HandleFunc("POST /api/v1alpha1/traces/query", newAPIHandler.QueryTraces)
+	api.HandleFunc("POST /api/v1alpha1/traces/{traceId}/logs/query", newAPIHandler.QueryTraceLogs)
 	api.HandleFunc("POST /api/v1alpha1/traces/{traceId}/spans/query", newAPIHandler.QuerySpansForTrace)
 	api.HandleFunc("GET /api/v1alpha1/traces/{traceId}/spans/{spanId}", newAPIHandler.GetSpanDetailsForTrace)
 	api.HandleFunc("POST /api/v1alpha1/alerts/query", newAPIHandler.QueryAlerts)
@@ -342,6 +411,9 @@ func main() {
 	// ===== v1alpha1 Alert Webhook Endpoint  =====
 	internalRoutes.HandleFunc("POST /api/v1alpha1/alerts/webhook", internalHandler.HandleAlertWebhook)
 
+	// ===== v1alpha1 Drift Notification Endpoint  =====
+	internalRoutes.HandleFunc("POST /api/v1alpha1/notifications/drift", internalHandler.HandleDriftNotification)
+
 	internalAddr := fmt.Sprintf(":%d", cfg.Server.InternalPort)
 	internalServer := &http.Server{
 		Addr:         internalAddr,
@@ -370,6 +442,19 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Start the log metrics evaluator in the elected replica only, so that each alert
+	// fires once; it stops with the signal context
+	if cfg.LogMetrics.EvaluationEnabled {
+		go func() {
+			err := k8s.RunAsLeader(ctx, cfg.LogMetrics.EvaluationLeaseNamespace, cfg.LogMetrics.EvaluationLeaseName,
+				logger.With("component", "log-metrics-leader-election"),
+				func(leaderCtx context.Context) { logQueriesService.Run(leaderCtx, alertService) })
+			if err != nil {
+				logger.Error("Log metrics evaluator leader election failed", "error", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal
 	<-ctx.Done()
 
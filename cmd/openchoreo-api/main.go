@@ -25,6 +25,7 @@ import (
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/componentrelease"
 	coreconfig "github.com/openchoreo/openchoreo/internal/config"
 	"github.com/openchoreo/openchoreo/internal/logging"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
@@ -38,6 +39,7 @@ import (
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
 	"github.com/openchoreo/openchoreo/internal/server"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 	apilogger "github.com/openchoreo/openchoreo/internal/server/middleware/logger"
 	mcpmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/mcp"
@@ -178,11 +180,22 @@ func main() {
 	)
 
 	// Create the webhook processor that finds affected components and triggers workflow runs.
-	webhookProcessor := autobuildsvc.NewWebhookProcessor(k8sClient, baseWfRunSvc, logger.With("service", "webhook"))
+	// Pushes that affect several components of a namespace are built as one workflow run group.
+	webhookProcessor := autobuildsvc.NewWebhookProcessor(k8sClient, baseWfRunSvc, autobuildsvc.GroupOptions{
+		MaxConcurrency: cfg.AutoBuild.MaxConcurrency,
+		FailFast:       cfg.AutoBuild.FailFast,
+	}, logger.With("service", "webhook"))
+
+	// Pin workload images of component releases to digests, reading registry credentials
+	// with the API server's uncached client.
+	var imageResolver componentrelease.ResolverFactory
+	if cfg.ImagePinning.Enabled {
+		imageResolver = componentrelease.RegistryResolverFactory(k8sClient, cfg.ImagePinning.InsecureRegistries)
+	}
 
 	// Initialize all handler services
 	services := handlerservices.NewServices(
-		k8sClient, runtime.pap, runtime.pdp, planeClientProvider, logger, gwClient, webhookProcessor,
+		k8sClient, runtime.pap, runtime.pdp, planeClientProvider, logger, gwClient, webhookProcessor, imageResolver,
 	)
 
 	// Initialize OpenAPI handlers
@@ -208,11 +221,30 @@ func main() {
 		// Build MCP toolsets from config
 		toolsets := buildMCPToolsets(&cfg, services, mcpLogger)
 
+		mcpOpts := mcp.ServerOptions{
+			RequireConfirmation:     cfg.MCP.RequireConfirmation,
+			Clients:                 cfg.MCP.ClientScopes(),
+			ResolveComponentProject: mcphandlers.NewMCPHandler(services).ComponentProject,
+			AuditLogger:             audit.NewLogger(mcpLogger, "openchoreo-api"),
+		}
+		if cfg.MCP.ResourceSubscriptions {
+			mcpOpts.Notifier = mcp.NewResourceNotifier()
+			if err := watchMCPResources(ctx, mcpOpts.Notifier, mcpLogger); err != nil {
+				logger.Error("Failed to watch MCP resources", slog.Any("error", err))
+				os.Exit(1)
+			}
+		}
+		mcpServer, err := mcp.NewHTTPServer(toolsets, runtime.pdp, mcpOpts)
+		if err != nil {
+			logger.Error("Failed to create MCP server", slog.Any("error", err))
+			os.Exit(1)
+		}
+
 		// MCP middleware chain: logger → auth401 interceptor → JWT auth → handler
 		mcpLoggerMw := apilogger.LoggerMiddleware(mcpLogger)
 		resourceMetadataURL := cfg.Server.PublicURL + "/.well-known/oauth-protected-resource"
 		mcpAuth401Mw := mcpmiddleware.Auth401Interceptor(resourceMetadataURL, cfg.Identity.MCPOAuthScopes)
-		mcpHandler := middleware.Chain(mcpLoggerMw, mcpAuth401Mw, jwtMiddleware)(mcp.NewHTTPServer(toolsets, runtime.pdp))
+		mcpHandler := middleware.Chain(mcpLoggerMw, mcpAuth401Mw, jwtMiddleware)(mcpServer)
 
 		baseMux.Handle("/mcp", mcpHandler)
 	}
@@ -320,7 +352,8 @@ func buildMCPToolsets(cfg *config.Config, svc *handlerservices.Services, logger
 
 	handler := mcphandlers.NewMCPHandler(svc)
 
-	toolsets := &tools.Toolsets{}
+	// Resources are read through the same handler, whatever toolsets are enabled
+	toolsets := &tools.Toolsets{ResourceState: handler}
 	for toolsetType := range toolsetsMap {
 		switch toolsetType {
 		case tools.ToolsetNamespace:
@@ -377,10 +410,16 @@ func setupRuntime(
 			logger.Info("Informer resync enabled", "interval", authzCfg.ResyncInterval)
 		}
 
+		// Authorization metrics are served by the manager's metrics server
+		metricsAddr := authzCfg.MetricsBindAddress
+		if metricsAddr == "" {
+			metricsAddr = "0"
+		}
+
 		var err error
 		mgr, err = ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 			LeaderElection: false,
-			Metrics:        metricsserver.Options{BindAddress: "0"},
+			Metrics:        metricsserver.Options{BindAddress: metricsAddr},
 			Cache:          cacheOpts,
 		})
 		if err != nil {
@@ -0,0 +1,82 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/mcphandlers"
+	"github.com/openchoreo/openchoreo/pkg/mcp"
+)
+
+// watchMCPResources starts informers for the kinds exposed as MCP resources and
+// publishes a change event on the notifier for every resource whose state an
+// added, updated or deleted object belongs to.
+func watchMCPResources(ctx context.Context, notifier *mcp.ResourceNotifier, logger *slog.Logger) error {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+	scheme := k8sruntime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
+	}
+	informerCache, err := cache.New(restConfig, cache.Options{
+		Scheme:           scheme,
+		DefaultTransform: cache.TransformStripManagedFields(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create informer cache: %w", err)
+	}
+
+	notify := func(obj any) {
+		if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		o, ok := obj.(client.Object)
+		if !ok {
+			return
+		}
+		for _, uri := range mcphandlers.ResourceURIsForObject(o) {
+			notifier.ResourceChanged(ctx, uri)
+		}
+	}
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, newObj any) { notify(newObj) },
+		DeleteFunc: notify,
+	}
+
+	for _, obj := range []client.Object{
+		&openchoreov1alpha1.Project{},
+		&openchoreov1alpha1.Component{},
+		&openchoreov1alpha1.ReleaseBinding{},
+		&openchoreov1alpha1.Environment{},
+	} {
+		informer, err := informerCache.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %T: %w", obj, err)
+		}
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return fmt.Errorf("failed to add event handler for %T: %w", obj, err)
+		}
+	}
+
+	go func() {
+		if err := informerCache.Start(ctx); err != nil {
+			logger.Error("MCP resource informers stopped", slog.Any("error", err))
+		}
+	}()
+	logger.Info("Watching MCP resources for subscriptions")
+	return nil
+}
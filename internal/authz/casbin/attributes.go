@@ -0,0 +1,97 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+// conditionContext returns the request context completed with the attributes the PDP derives
+// itself: the subject, the request time, the project of the resource and whether the target
+// environment is a production environment. Attributes set by the caller are kept as-is.
+func (ce *CasbinEnforcer) conditionContext(ctx context.Context, request *authzcore.EvaluateRequest) (authzcore.Context, error) {
+	condCtx := request.Context
+
+	if condCtx.Resource.Project == "" {
+		condCtx.Resource.Project = request.Resource.Hierarchy.Project
+	}
+
+	if subjectCtx := request.SubjectContext; subjectCtx != nil {
+		if condCtx.Subject.Type == "" {
+			condCtx.Subject.Type = subjectCtx.Type
+		}
+		if condCtx.Subject.Entitlements == nil {
+			condCtx.Subject.Entitlements = subjectCtx.EntitlementValues
+		}
+		if condCtx.Subject.Claims == nil {
+			condCtx.Subject.Claims = subjectCtx.Claims
+		}
+	}
+
+	if condCtx.Request.Time.IsZero() {
+		// Truncated so that decisions within the same minute share a cache key
+		condCtx.Request.Time = ce.currentTime().UTC().Truncate(time.Minute)
+	}
+
+	if condCtx.Resource.Environment != "" && !condCtx.Resource.IsProduction &&
+		actionHasAttribute(request.Action, authzcore.AttrResourceIsProduction) {
+		isProduction, err := ce.isProductionEnvironment(ctx, condCtx.Resource.Environment, request.Resource.Hierarchy.Namespace)
+		if err != nil {
+			return authzcore.Context{}, err
+		}
+		condCtx.Resource.IsProduction = isProduction
+	}
+
+	return condCtx, nil
+}
+
+// isProductionEnvironment looks up the environment referenced by a condition attribute.
+// The name is either namespace-prefixed ("acme-corp/production") or relative to defaultNamespace.
+// A missing environment is not a production environment.
+func (ce *CasbinEnforcer) isProductionEnvironment(ctx context.Context, environment, defaultNamespace string) (bool, error) {
+	if ce.k8sClient == nil {
+		return false, nil
+	}
+
+	namespace, name, found := strings.Cut(environment, "/")
+	if !found {
+		namespace, name = defaultNamespace, environment
+	}
+	if namespace == "" || name == "" {
+		return false, nil
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := ce.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get environment %s/%s: %w", namespace, name, err)
+	}
+	return env.Spec.IsProduction, nil
+}
+
+func (ce *CasbinEnforcer) currentTime() time.Time {
+	if ce.now != nil {
+		return ce.now()
+	}
+	return time.Now()
+}
+
+// actionHasAttribute reports whether attr is registered as a condition attribute of action
+func actionHasAttribute(action string, attr authzcore.AttributeSpec) bool {
+	return slices.ContainsFunc(authzcore.LookupConditions(action), func(spec authzcore.AttributeSpec) bool {
+		return spec.Key == attr.Key
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+func setupAttributeEnforcer(t *testing.T, now time.Time) *CasbinEnforcer {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	fakeClient := fake.NewClientBuilder().WithScheme(getTestScheme()).WithObjects(
+		&openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "acme"},
+			Spec:       openchoreov1alpha1.EnvironmentSpec{IsProduction: true},
+		},
+		&openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "development", Namespace: "acme"},
+		},
+	).Build()
+
+	enforcer, err := NewEnforcer(context.Background(), CasbinConfig{K8sClient: fakeClient}, logger)
+	require.NoError(t, err)
+	enforcer.now = func() time.Time { return now }
+	return enforcer
+}
+
+func TestConditionContext(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 30, 45, 0, time.UTC)
+	enforcer := setupAttributeEnforcer(t, now)
+	ctx := context.Background()
+
+	t.Run("derives subject, project and time", func(t *testing.T) {
+		req := explainRequest("component:view", "", "dev", "qa")
+		req.SubjectContext.Claims = map[string]any{"department": "payments"}
+
+		got, err := enforcer.conditionContext(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "p1", got.Resource.Project)
+		assert.Equal(t, authzcore.SubjectAttribute{
+			Type:         user,
+			Entitlements: []string{"dev", "qa"},
+			Claims:       map[string]any{"department": "payments"},
+		}, got.Subject)
+		assert.Equal(t, time.Date(2026, 3, 10, 14, 30, 0, 0, time.UTC), got.Request.Time)
+	})
+
+	t.Run("caller supplied attributes are kept", func(t *testing.T) {
+		req := explainRequest("component:view", "", "dev")
+		req.Context.Resource.Project = "other"
+		req.Context.Request.Time = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		got, err := enforcer.conditionContext(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "other", got.Resource.Project)
+		assert.Equal(t, req.Context.Request.Time, got.Request.Time)
+	})
+
+	t.Run("resolves the production flag of the environment", func(t *testing.T) {
+		tests := []struct {
+			env  string
+			want bool
+		}{
+			{env: "acme/production", want: true},
+			{env: "production", want: true},
+			{env: "acme/development", want: false},
+			{env: "acme/missing", want: false},
+		}
+		for _, tt := range tests {
+			got, err := enforcer.conditionContext(ctx, explainRequest("releasebinding:create", tt.env, "dev"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Resource.IsProduction, "environment %q", tt.env)
+		}
+	})
+
+	t.Run("production flag is not resolved for actions without it", func(t *testing.T) {
+		got, err := enforcer.conditionContext(ctx, explainRequest("component:view", "acme/production", "dev"))
+		require.NoError(t, err)
+		assert.False(t, got.Resource.IsProduction)
+	})
+}
+
+func TestCasbinEnforcer_Evaluate_DerivedAttributes(t *testing.T) {
+	ctx := context.Background()
+	policies := [][]string{
+		{"groups:dev", "ns/acme", "deployer", "*", "allow",
+			`[{"actions":["releasebinding:create"],"expression":"!resource.isProduction || (request.time.getHours(\"UTC\") >= 9 && request.time.getHours(\"UTC\") < 17)"}]`,
+			"dev-deployer"},
+		{"groups:dev", "ns/acme", "viewer", "*", "allow",
+			`[{"actions":["component:view"],"expression":"subject.claims.department == \"payments\" && resource.project == \"p1\""}]`,
+			"payments-viewer"},
+	}
+	grouping := [][]string{
+		{"deployer", "releasebinding:create", "*"},
+		{"viewer", "component:view", "*"},
+	}
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		req    *authzcore.EvaluateRequest
+		claims map[string]any
+		want   bool
+	}{
+		{
+			name: "non-production deploy outside business hours",
+			now:  time.Date(2026, 3, 10, 22, 0, 0, 0, time.UTC),
+			req:  explainRequest("releasebinding:create", "acme/development", "dev"),
+			want: true,
+		},
+		{
+			name: "production deploy during business hours",
+			now:  time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC),
+			req:  explainRequest("releasebinding:create", "acme/production", "dev"),
+			want: true,
+		},
+		{
+			name: "production deploy outside business hours",
+			now:  time.Date(2026, 3, 10, 22, 0, 0, 0, time.UTC),
+			req:  explainRequest("releasebinding:create", "acme/production", "dev"),
+			want: false,
+		},
+		{
+			name:   "claim matches",
+			req:    explainRequest("component:view", "", "dev"),
+			claims: map[string]any{"department": "payments"},
+			want:   true,
+		},
+		{
+			name:   "claim does not match",
+			req:    explainRequest("component:view", "", "dev"),
+			claims: map[string]any{"department": "marketing"},
+			want:   false,
+		},
+		{
+			name: "missing claim fails the condition",
+			req:  explainRequest("component:view", "", "dev"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enforcer := setupAttributeEnforcer(t, tt.now)
+			syncGroupingPolicies(t, enforcer, grouping)
+			syncPolicies(t, enforcer, policies)
+			tt.req.SubjectContext.Claims = tt.claims
+
+			decision, err := enforcer.Evaluate(ctx, tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decision.Decision)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxDecisionCacheEntries bounds the memory used by the decision cache. When it is full,
+// expired entries are dropped first and the whole cache is reset if that is not enough.
+const maxDecisionCacheEntries = 100000
+
+// cachedDecision is a decision stored in the decision cache
+type cachedDecision struct {
+	decision    bool
+	reason      string
+	bindingName string
+	expiresAt   time.Time
+}
+
+// decisionCache caches authorization decisions by request. Entries expire after the TTL and
+// the whole cache is invalidated whenever roles or bindings change. Each invalidation starts a
+// new generation, so a decision computed before a policy change is never stored after it.
+type decisionCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]cachedDecision
+	gen     uint64
+}
+
+func newDecisionCache(ttl time.Duration, now func() time.Time) *decisionCache {
+	return &decisionCache{
+		ttl:     ttl,
+		now:     now,
+		entries: make(map[string]cachedDecision),
+	}
+}
+
+// decisionCacheKey identifies a request by everything the decision depends on. The serialized
+// context carries the request time truncated to the minute, so time-based conditions are
+// re-evaluated at least once per minute.
+func decisionCacheKey(claim string, values []string, resourcePath, action, ctxJSON string) string {
+	// Parts are quoted so that no combination of values can produce the key of another request
+	var b strings.Builder
+	b.WriteString(strconv.Quote(claim))
+	for _, v := range values {
+		b.WriteString(strconv.Quote(v))
+	}
+	for _, part := range []string{resourcePath, action, ctxJSON} {
+		b.WriteByte('|')
+		b.WriteString(strconv.Quote(part))
+	}
+	return b.String()
+}
+
+func (c *decisionCache) get(key string) (cachedDecision, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return cachedDecision{}, false
+	}
+	return entry, true
+}
+
+// generation returns the current generation; pass it to put with the decision computed after
+func (c *decisionCache) generation() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.gen
+}
+
+// put stores a decision computed in generation gen. It is dropped if the cache was invalidated
+// since, as the decision may predate the policy change.
+func (c *decisionCache) put(key string, entry cachedDecision, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	now := c.now()
+	if len(c.entries) >= maxDecisionCacheEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxDecisionCacheEntries {
+			clear(c.entries)
+		}
+	}
+	entry.expiresAt = now.Add(c.ttl)
+	c.entries[key] = entry
+}
+
+// invalidate drops all cached decisions and starts a new generation
+func (c *decisionCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.gen++
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDecisionCache(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	cache := newDecisionCache(time.Minute, func() time.Time { return now })
+	key := decisionCacheKey("groups", []string{"dev"}, "ns/acme", "component:view", "{}")
+
+	_, ok := cache.get(key)
+	assert.False(t, ok, "empty cache should miss")
+
+	cache.put(key, cachedDecision{decision: true, bindingName: "dev-viewer"}, cache.generation())
+	entry, ok := cache.get(key)
+	require.True(t, ok)
+	assert.True(t, entry.decision)
+	assert.Equal(t, "dev-viewer", entry.bindingName)
+
+	now = now.Add(time.Minute)
+	_, ok = cache.get(key)
+	assert.False(t, ok, "entry should expire after the TTL")
+
+	cache.put(key, cachedDecision{decision: true}, cache.generation())
+	cache.invalidate()
+	_, ok = cache.get(key)
+	assert.False(t, ok, "invalidate should drop all entries")
+
+	// A decision computed before an invalidation must not be stored after it
+	gen := cache.generation()
+	cache.invalidate()
+	cache.put(key, cachedDecision{decision: true}, gen)
+	_, ok = cache.get(key)
+	assert.False(t, ok, "decision from a previous generation should not be cached")
+}
+
+func TestDecisionCacheKey(t *testing.T) {
+	base := decisionCacheKey("groups", []string{"dev"}, "ns/acme", "component:view", "{}")
+	assert.NotEqual(t, base, decisionCacheKey("groups", []string{"dev", "qa"}, "ns/acme", "component:view", "{}"))
+	assert.NotEqual(t, base, decisionCacheKey("groups", []string{"dev"}, "ns/acme/project/p1", "component:view", "{}"))
+	assert.NotEqual(t, base, decisionCacheKey("groups", []string{"dev"}, "ns/acme", "component:create", "{}"))
+	assert.NotEqual(t, base, decisionCacheKey("groups", []string{"dev"}, "ns/acme", "component:view", `{"resource":{}}`))
+	assert.NotEqual(t,
+		decisionCacheKey("groups", []string{"a", "b"}, "r", "x", "{}"),
+		decisionCacheKey("groups", []string{"a\x00b"}, "r", "x", "{}"),
+		"entitlement boundaries must be part of the key")
+}
+
+func TestCasbinEnforcer_DecisionCacheInvalidation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	fakeClient := fake.NewClientBuilder().WithScheme(getTestScheme()).Build()
+	enforcer, err := NewEnforcer(context.Background(), CasbinConfig{K8sClient: fakeClient, CacheEnabled: true}, logger)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	syncGroupingPolicies(t, enforcer, [][]string{{"viewer", "component:view", "*"}})
+	req := explainRequest("component:view", "", "dev")
+
+	decision, err := enforcer.Evaluate(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, decision.Decision)
+
+	// The cached denial is served until the cache is invalidated
+	syncPolicies(t, enforcer, [][]string{{"groups:dev", "ns/acme", "viewer", "*", "allow", "", "dev-viewer"}})
+	decision, err = enforcer.Evaluate(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, decision.Decision)
+
+	enforcer.InvalidateDecisionCache()
+	decision, err = enforcer.Evaluate(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, decision.Decision)
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+// DecisionLogConfig configures the in-memory decision log
+type DecisionLogConfig struct {
+	Enabled bool
+	// SampleRate is the fraction (0-1) of allowed decisions that are logged; denied decisions are always logged
+	SampleRate float64
+	// Retention is how long entries are kept
+	Retention time.Duration
+	// MaxEntries caps the number of kept entries; the oldest are dropped first.
+	// Defaults to defaultDecisionLogMaxEntries when not positive.
+	MaxEntries int
+}
+
+const defaultDecisionLogMaxEntries = 10000
+
+// decisionLog keeps recent authorization decisions in memory and writes each recorded
+// decision as a structured log line.
+type decisionLog struct {
+	mu sync.Mutex
+	// records is a ring buffer of count records starting at start, oldest first. It grows
+	// up to maxEntries, after which the oldest record is overwritten.
+	records    []authzcore.DecisionRecord
+	start      int
+	count      int
+	sampleRate float64
+	retention  time.Duration
+	maxEntries int
+	logger     *slog.Logger
+	now        func() time.Time
+	sample     func() float64
+}
+
+func newDecisionLog(cfg DecisionLogConfig, logger *slog.Logger, now func() time.Time) *decisionLog {
+	maxEntries := cfg.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultDecisionLogMaxEntries
+	}
+	return &decisionLog{
+		sampleRate: cfg.SampleRate,
+		retention:  cfg.Retention,
+		maxEntries: maxEntries,
+		logger:     logger.With("component", "authz-decision-log"),
+		now:        now,
+		sample:     rand.Float64,
+	}
+}
+
+// record logs a decision, subject to sampling
+func (l *decisionLog) record(rec authzcore.DecisionRecord) {
+	if rec.Decision && l.sample() >= l.sampleRate {
+		return
+	}
+
+	l.logger.Info("authorization decision",
+		"subject_type", rec.Subject.Type,
+		"entitlement_claim", rec.Subject.EntitlementClaim,
+		"entitlements", rec.Subject.EntitlementValues,
+		"action", rec.Action,
+		"resource_type", rec.Resource.Type,
+		"resource", resourceHierarchyToPath(rec.Resource.Hierarchy),
+		"decision", rec.Decision,
+		"binding", rec.BindingName,
+		"cached", rec.Cached,
+		"duration", rec.Duration)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.push(rec)
+	l.prune()
+}
+
+// list returns the retained records matching the query, newest first
+func (l *decisionLog) list(query authzcore.DecisionLogQuery) []authzcore.DecisionRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune()
+
+	var result []authzcore.DecisionRecord
+	for i := l.count - 1; i >= 0; i-- {
+		if query.Limit > 0 && len(result) >= query.Limit {
+			break
+		}
+		rec := l.at(i)
+		if rec.Time.Before(query.Since) {
+			break
+		}
+		if matchesDecisionQuery(rec, query) {
+			result = append(result, rec)
+		}
+	}
+	return result
+}
+
+// push appends a record, overwriting the oldest one once maxEntries are kept.
+// Callers must hold l.mu.
+func (l *decisionLog) push(rec authzcore.DecisionRecord) {
+	if l.count == len(l.records) {
+		if len(l.records) == l.maxEntries {
+			l.records[l.start] = rec
+			l.start = (l.start + 1) % len(l.records)
+			return
+		}
+		l.grow()
+	}
+	l.records[(l.start+l.count)%len(l.records)] = rec
+	l.count++
+}
+
+// grow enlarges the ring buffer, up to maxEntries. Callers must hold l.mu.
+func (l *decisionLog) grow() {
+	grown := make([]authzcore.DecisionRecord, min(max(2*len(l.records), 64), l.maxEntries))
+	for i := range l.count {
+		grown[i] = l.at(i)
+	}
+	l.records, l.start = grown, 0
+}
+
+// at returns the i-th oldest record. Callers must hold l.mu.
+func (l *decisionLog) at(i int) authzcore.DecisionRecord {
+	return l.records[(l.start+i)%len(l.records)]
+}
+
+// prune drops records past their retention. Callers must hold l.mu.
+func (l *decisionLog) prune() {
+	if l.retention <= 0 {
+		return
+	}
+	cutoff := l.now().Add(-l.retention)
+	for l.count > 0 && l.at(0).Time.Before(cutoff) {
+		l.records[l.start] = authzcore.DecisionRecord{}
+		l.start = (l.start + 1) % len(l.records)
+		l.count--
+	}
+}
+
+func matchesDecisionQuery(rec authzcore.DecisionRecord, query authzcore.DecisionLogQuery) bool {
+	if query.Namespace != "" && rec.Resource.Hierarchy.Namespace != query.Namespace {
+		return false
+	}
+	if query.Action != "" && rec.Action != query.Action {
+		return false
+	}
+	if query.EntitlementValue != "" && !slices.Contains(rec.Subject.EntitlementValues, query.EntitlementValue) {
+		return false
+	}
+	if query.Decision != nil && rec.Decision != *query.Decision {
+		return false
+	}
+	return true
+}
+
+var _ authzcore.DecisionLogReader = (*CasbinEnforcer)(nil)
+
+// ListDecisions returns the logged decisions matching the query, newest first
+func (ce *CasbinEnforcer) ListDecisions(ctx context.Context, query authzcore.DecisionLogQuery) ([]authzcore.DecisionRecord, error) {
+	if ce.decisionLog == nil {
+		return nil, authzcore.ErrDecisionLogDisabled
+	}
+	return ce.decisionLog.list(query), nil
+}
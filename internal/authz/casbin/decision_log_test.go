@@ -0,0 +1,162 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+func newTestDecisionLog(cfg DecisionLogConfig, now *time.Time) *decisionLog {
+	return newDecisionLog(cfg, slog.New(slog.NewTextHandler(io.Discard, nil)), func() time.Time { return *now })
+}
+
+func testDecisionRecord(at time.Time, action string, allowed bool, groups ...string) authzcore.DecisionRecord {
+	return authzcore.DecisionRecord{
+		Time:     at,
+		Subject:  authzcore.SubjectContext{Type: user, EntitlementClaim: "groups", EntitlementValues: groups},
+		Action:   action,
+		Resource: authzcore.Resource{Type: "component", Hierarchy: authzcore.ResourceHierarchy{Namespace: "acme"}},
+		Decision: allowed,
+	}
+}
+
+func TestDecisionLog_Sampling(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	log := newTestDecisionLog(DecisionLogConfig{SampleRate: 0.5, Retention: time.Hour, MaxEntries: 10}, &now)
+
+	log.sample = func() float64 { return 0.7 }
+	log.record(testDecisionRecord(now, "component:view", true, "dev"))
+	log.record(testDecisionRecord(now, "component:create", false, "dev"))
+
+	log.sample = func() float64 { return 0.2 }
+	log.record(testDecisionRecord(now, "component:update", true, "dev"))
+
+	got := log.list(authzcore.DecisionLogQuery{})
+	require.Len(t, got, 2)
+	assert.Equal(t, "component:update", got[0].Action, "newest first")
+	assert.Equal(t, "component:create", got[1].Action, "denied decisions are never sampled out")
+}
+
+func TestDecisionLog_Retention(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	log := newTestDecisionLog(DecisionLogConfig{SampleRate: 1, Retention: time.Hour, MaxEntries: 3}, &now)
+
+	for i := range 4 {
+		log.record(testDecisionRecord(now.Add(time.Duration(i)*time.Minute), "component:view", false, "dev"))
+	}
+	assert.Len(t, log.list(authzcore.DecisionLogQuery{}), 3, "oldest entries beyond max entries are dropped")
+
+	now = now.Add(time.Hour + 2*time.Minute)
+	got := log.list(authzcore.DecisionLogQuery{})
+	require.Len(t, got, 2, "entries past retention are dropped")
+	assert.Equal(t, now.Add(-time.Hour), got[1].Time)
+}
+
+func TestDecisionLog_RingBuffer(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	log := newTestDecisionLog(DecisionLogConfig{SampleRate: 1, Retention: time.Hour, MaxEntries: 100}, &now)
+
+	// Wrap around the buffer more than once
+	for i := range 250 {
+		log.record(testDecisionRecord(now.Add(time.Duration(i)*time.Second), "component:view", false, "dev"))
+	}
+	got := log.list(authzcore.DecisionLogQuery{})
+	require.Len(t, got, 100)
+	assert.Len(t, log.records, 100, "buffer should not grow past max entries")
+	for i, rec := range got {
+		assert.Equal(t, now.Add(time.Duration(249-i)*time.Second), rec.Time, "newest first")
+	}
+
+	// Retention drops from the oldest end of the wrapped buffer
+	now = now.Add(time.Hour + 200*time.Second)
+	got = log.list(authzcore.DecisionLogQuery{})
+	require.Len(t, got, 50)
+	assert.Equal(t, now.Add(-time.Hour), got[len(got)-1].Time)
+}
+
+func TestDecisionLog_List(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	log := newTestDecisionLog(DecisionLogConfig{SampleRate: 1, Retention: time.Hour, MaxEntries: 100}, &now)
+
+	log.record(testDecisionRecord(now.Add(-30*time.Minute), "component:view", true, "dev"))
+	log.record(testDecisionRecord(now.Add(-20*time.Minute), "component:create", false, "dev"))
+	log.record(testDecisionRecord(now.Add(-10*time.Minute), "component:view", false, "qa"))
+	other := testDecisionRecord(now, "component:view", false, "dev")
+	other.Resource.Hierarchy.Namespace = "other"
+	log.record(other)
+
+	denied := false
+	tests := []struct {
+		name    string
+		query   authzcore.DecisionLogQuery
+		wantLen int
+	}{
+		{name: "all", query: authzcore.DecisionLogQuery{}, wantLen: 4},
+		{name: "namespace", query: authzcore.DecisionLogQuery{Namespace: "acme"}, wantLen: 3},
+		{name: "action", query: authzcore.DecisionLogQuery{Action: "component:view"}, wantLen: 3},
+		{name: "entitlement", query: authzcore.DecisionLogQuery{EntitlementValue: "qa"}, wantLen: 1},
+		{name: "denied", query: authzcore.DecisionLogQuery{Decision: &denied}, wantLen: 3},
+		{name: "since", query: authzcore.DecisionLogQuery{Since: now.Add(-15 * time.Minute)}, wantLen: 2},
+		{name: "limit", query: authzcore.DecisionLogQuery{Limit: 1}, wantLen: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, log.list(tt.query), tt.wantLen)
+		})
+	}
+}
+
+func TestCasbinEnforcer_ListDecisions(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	fakeClient := fake.NewClientBuilder().WithScheme(getTestScheme()).Build()
+
+	t.Run("disabled", func(t *testing.T) {
+		enforcer, err := NewEnforcer(ctx, CasbinConfig{K8sClient: fakeClient}, logger)
+		require.NoError(t, err)
+		_, err = enforcer.ListDecisions(ctx, authzcore.DecisionLogQuery{})
+		assert.ErrorIs(t, err, authzcore.ErrDecisionLogDisabled)
+	})
+
+	t.Run("records evaluated decisions", func(t *testing.T) {
+		enforcer, err := NewEnforcer(ctx, CasbinConfig{
+			K8sClient:    fakeClient,
+			CacheEnabled: true,
+			DecisionLog:  DecisionLogConfig{Enabled: true, SampleRate: 1, Retention: time.Hour, MaxEntries: 100},
+		}, logger)
+		require.NoError(t, err)
+		syncGroupingPolicies(t, enforcer, [][]string{{"viewer", "component:view", "*"}})
+		syncPolicies(t, enforcer, [][]string{{"groups:dev", "ns/acme", "viewer", "*", "allow", "", "dev-viewer"}})
+
+		req := explainRequest("component:view", "", "dev")
+		req.SubjectContext.Claims = map[string]any{"email": "dev@acme.io"}
+		for range 2 {
+			_, err = enforcer.Evaluate(ctx, req)
+			require.NoError(t, err)
+		}
+		_, err = enforcer.Evaluate(ctx, explainRequest("component:create", "", "dev"))
+		require.NoError(t, err)
+
+		got, err := enforcer.ListDecisions(ctx, authzcore.DecisionLogQuery{})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, "component:create", got[0].Action)
+		assert.False(t, got[0].Decision)
+		assert.True(t, got[1].Decision)
+		assert.True(t, got[1].Cached, "second evaluation is served from the cache")
+		assert.Equal(t, "dev-viewer", got[1].BindingName)
+		assert.False(t, got[2].Cached)
+		assert.Nil(t, got[2].Subject.Claims, "token claims are not logged")
+	})
+}
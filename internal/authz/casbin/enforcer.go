@@ -27,14 +27,22 @@ type CasbinEnforcer struct {
 	logger      *slog.Logger
 	enableCache bool
 	cacheTTL    int
+	// now returns the current time for the request.time condition attribute; defaults to time.Now
+	now func() time.Time
+
+	// decisionCache caches decisions when the cache is enabled; nil otherwise
+	decisionCache *decisionCache
+	// decisionLog records decisions when the decision log is enabled; nil otherwise
+	decisionLog *decisionLog
 }
 
 // CasbinConfig holds configuration for the Casbin enforcer.
 // Policies are loaded from ClusterAuthzRole, AuthzRole, ClusterAuthzRoleBinding, and AuthzRoleBinding CRDs.
 type CasbinConfig struct {
-	K8sClient    client.Client // Required: Kubernetes client
-	CacheEnabled bool          // Optional: Enable policy cache (default: false)
-	CacheTTL     time.Duration // Optional: Cache TTL (default: 5m)
+	K8sClient    client.Client     // Required: Kubernetes client
+	CacheEnabled bool              // Optional: Enable decision cache (default: false)
+	CacheTTL     time.Duration     // Optional: Cache TTL (default: 5m)
+	DecisionLog  DecisionLogConfig // Optional: In-memory decision log (default: disabled)
 }
 
 // policyInfo holds information about a filtered policy
@@ -59,45 +67,11 @@ func NewEnforcer(ctx context.Context, config CasbinConfig, logger *slog.Logger)
 		return nil, fmt.Errorf("failed to load embedded casbin model: %w", err)
 	}
 
-	var enforcer casbin.IEnforcer
-	if config.CacheEnabled {
-		syncedCachedEnforcer, err := casbin.NewSyncedCachedEnforcer(m)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create synced cached enforcer: %w", err)
-		}
-
-		// Fallback default if CacheTTL not configured
-		if config.CacheTTL == 0 {
-			config.CacheTTL = 5 * time.Minute
-		}
-		syncedCachedEnforcer.SetExpireTime(config.CacheTTL)
-		enforcer = syncedCachedEnforcer
-	} else {
-		enforcer, err = casbin.NewSyncedEnforcer(m)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create synced enforcer: %w", err)
-		}
-	}
-
-	// Register custom functions for the matcher
-	enforcer.AddFunction("resourceMatch", resourceMatchWrapper)
-	enforcer.AddFunction("condMatch", condMatchWrapper)
-
-	// Add custom role matcher function to support action wildcards
-	var baseEnforcer *casbin.Enforcer
-	switch e := enforcer.(type) {
-	case *casbin.SyncedEnforcer:
-		baseEnforcer = e.Enforcer
-	case *casbin.SyncedCachedEnforcer:
-		baseEnforcer = e.SyncedEnforcer.Enforcer
-	default:
-		return nil, fmt.Errorf("unknown enforcer type")
-	}
-	if baseEnforcer != nil {
-		// Use roleMatchWrapper for g to handle:
-		// - g: [role, action, namespace] - exact match for role/namespace, wildcard for action
-		baseEnforcer.AddNamedMatchingFunc("g", "", roleActionMatchWrapper)
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create synced enforcer: %w", err)
 	}
+	registerMatchFunctions(enforcer.Enforcer)
 
 	// turn off auto-save to prevent policy changes via enforcer APIs
 	enforcer.EnableAutoSave(false)
@@ -110,16 +84,46 @@ func NewEnforcer(ctx context.Context, config CasbinConfig, logger *slog.Logger)
 		k8sClient:   config.K8sClient,
 		logger:      logger,
 		enableCache: config.CacheEnabled,
-		cacheTTL:    int(config.CacheTTL),
+		now:         time.Now,
+	}
+	if config.CacheEnabled {
+		// Fallback default if CacheTTL not configured
+		if config.CacheTTL == 0 {
+			config.CacheTTL = 5 * time.Minute
+		}
+		ce.cacheTTL = int(config.CacheTTL)
+		ce.decisionCache = newDecisionCache(config.CacheTTL, ce.currentTime)
+	}
+	if config.DecisionLog.Enabled {
+		ce.decisionLog = newDecisionLog(config.DecisionLog, logger, ce.currentTime)
 	}
 
 	logger.Info("casbin enforcer initialized",
 		"cache_enabled", config.CacheEnabled,
-		"cache_ttl", config.CacheTTL)
+		"cache_ttl", config.CacheTTL,
+		"decision_log_enabled", config.DecisionLog.Enabled)
 
 	return ce, nil
 }
 
+// registerMatchFunctions registers the custom functions used by the model matcher
+func registerMatchFunctions(e *casbin.Enforcer) {
+	e.AddFunction("resourceMatch", resourceMatchWrapper)
+	e.AddFunction("condMatch", condMatchWrapper)
+
+	// Use roleActionMatchWrapper for g to handle:
+	// - g: [role, action, namespace] - exact match for role/namespace, wildcard for action
+	e.AddNamedMatchingFunc("g", "", roleActionMatchWrapper)
+}
+
+// InvalidateDecisionCache drops all cached decisions. It is called by the watchers whenever
+// roles or bindings change.
+func (ce *CasbinEnforcer) InvalidateDecisionCache() {
+	if ce.decisionCache != nil {
+		ce.decisionCache.invalidate()
+	}
+}
+
 // GetEnforcer returns the underlying Casbin enforcer for use by watchers.
 // This is needed to set up informer-based policy synchronization.
 func (ce *CasbinEnforcer) GetEnforcer() casbin.IEnforcer {
@@ -0,0 +1,310 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authzv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+var _ authzcore.PolicyExplainer = (*CasbinEnforcer)(nil)
+
+// Explain evaluates a single authorization request and returns the decision along with
+// every policy whose subject, resource scope and role actions match the request.
+func (ce *CasbinEnforcer) Explain(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Explanation, error) {
+	if err := validateEvaluateRequest(request); err != nil {
+		return nil, err
+	}
+	return ce.explain(ctx, request)
+}
+
+// Simulate explains a request against the current policies overlaid with the proposed roles and
+// bindings. The proposals are loaded into a throwaway enforcer; the live policies are never modified.
+func (ce *CasbinEnforcer) Simulate(ctx context.Context, request *authzcore.SimulateRequest) (*authzcore.Explanation, error) {
+	if request == nil {
+		return nil, fmt.Errorf("%w: simulate request is nil", authzcore.ErrInvalidRequest)
+	}
+	if err := validateEvaluateRequest(&request.Request); err != nil {
+		return nil, err
+	}
+
+	sim, err := newModelEnforcer()
+	if err != nil {
+		return nil, err
+	}
+
+	if !request.IgnoreExisting {
+		policies, err := ce.enforcer.GetPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policies: %w", err)
+		}
+		if _, err := sim.AddPoliciesEx(policies); err != nil {
+			return nil, fmt.Errorf("failed to copy policies: %w", err)
+		}
+		groupingPolicies, err := ce.enforcer.GetGroupingPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read role policies: %w", err)
+		}
+		if _, err := sim.AddGroupingPoliciesEx(groupingPolicies); err != nil {
+			return nil, fmt.Errorf("failed to copy role policies: %w", err)
+		}
+	}
+
+	logger := ce.logger.With("simulation", true)
+	handlers := make(map[string]*authzInformerHandler, 4)
+	handlerFor := func(crdType string) *authzInformerHandler {
+		if h, ok := handlers[crdType]; ok {
+			return h
+		}
+		h := &authzInformerHandler{enforcer: sim, logger: logger.With("crdType", crdType), crdType: crdType}
+		handlers[crdType] = h
+		return h
+	}
+	// Proposed bindings with an expiry schedule timers on the throwaway enforcer
+	defer func() {
+		for _, h := range handlers {
+			h.stopExpiryTimers()
+		}
+	}()
+
+	for i := range request.ClusterRoles {
+		if err := ce.simulateApply(ctx, handlerFor(CRDTypeClusterAuthzRole), &authzv1alpha1.ClusterAuthzRole{},
+			&request.ClusterRoles[i], !request.IgnoreExisting); err != nil {
+			return nil, err
+		}
+	}
+	for i := range request.Roles {
+		if err := ce.simulateApply(ctx, handlerFor(CRDTypeAuthzRole), &authzv1alpha1.AuthzRole{},
+			&request.Roles[i], !request.IgnoreExisting); err != nil {
+			return nil, err
+		}
+	}
+	for i := range request.ClusterRoleBindings {
+		if err := ce.simulateApply(ctx, handlerFor(CRDTypeClusterAuthzRoleBinding), &authzv1alpha1.ClusterAuthzRoleBinding{},
+			&request.ClusterRoleBindings[i], !request.IgnoreExisting); err != nil {
+			return nil, err
+		}
+	}
+	for i := range request.RoleBindings {
+		if err := ce.simulateApply(ctx, handlerFor(CRDTypeAuthzRoleBinding), &authzv1alpha1.AuthzRoleBinding{},
+			&request.RoleBindings[i], !request.IgnoreExisting); err != nil {
+			return nil, err
+		}
+	}
+
+	simulated := &CasbinEnforcer{enforcer: sim, k8sClient: ce.k8sClient, logger: logger, now: ce.now}
+	return simulated.explain(ctx, &request.Request)
+}
+
+// simulateApply loads a proposed object into the simulation enforcer. When replaceExisting is set,
+// the policies of the object currently stored under the same name are removed first.
+func (ce *CasbinEnforcer) simulateApply(ctx context.Context, h *authzInformerHandler, existing, proposed client.Object, replaceExisting bool) error {
+	if proposed.GetName() == "" {
+		return fmt.Errorf("%w: proposed %s must have a name", authzcore.ErrInvalidRequest, h.crdType)
+	}
+	if (h.crdType == CRDTypeAuthzRole || h.crdType == CRDTypeAuthzRoleBinding) && proposed.GetNamespace() == "" {
+		return fmt.Errorf("%w: proposed %s %q must have a namespace", authzcore.ErrInvalidRequest, h.crdType, proposed.GetName())
+	}
+
+	if replaceExisting {
+		err := ce.k8sClient.Get(ctx, client.ObjectKeyFromObject(proposed), existing)
+		switch {
+		case err == nil:
+			if err := h.handleDelete(existing); err != nil {
+				return fmt.Errorf("failed to remove existing %s %q from simulation: %w", h.crdType, proposed.GetName(), err)
+			}
+		case !apierrors.IsNotFound(err):
+			return fmt.Errorf("failed to get existing %s %q: %w", h.crdType, proposed.GetName(), err)
+		}
+	}
+
+	if err := h.handleAdd(proposed); err != nil {
+		return fmt.Errorf("%w: %v", authzcore.ErrInvalidRequest, err)
+	}
+	return nil
+}
+
+// newModelEnforcer creates a standalone enforcer for the embedded model with no policies loaded
+func newModelEnforcer() (*casbin.Enforcer, error) {
+	m, err := model.NewModelFromString(embeddedModel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedded casbin model: %w", err)
+	}
+	e, err := casbin.NewEnforcer(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create enforcer: %w", err)
+	}
+	registerMatchFunctions(e)
+	e.EnableAutoSave(false)
+	return e, nil
+}
+
+// explain evaluates the request like a regular check, bypassing the decision cache and log, then
+// collects the matching policies of each entitlement with their evaluated conditions.
+func (ce *CasbinEnforcer) explain(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Explanation, error) {
+	resourcePath := resourceHierarchyToPath(request.Resource.Hierarchy)
+	condCtx, err := ce.conditionContext(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve condition attributes: %w", err)
+	}
+	ctxJSON, err := serializeAuthzContext(condCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize request context: %w", err)
+	}
+
+	decision, _, err := ce.decide(request, resourcePath, ctxJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	subjectCtx := request.SubjectContext
+	roleGrants := make(map[authzcore.RoleRef]bool)
+	policies := []authzcore.PolicyMatch{}
+	for _, entitlementValue := range subjectCtx.EntitlementValues {
+		subject, err := formatSubject(subjectCtx.EntitlementClaim, entitlementValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format subject: %w", err)
+		}
+		subjectPolicies, err := ce.enforcer.GetFilteredPolicy(0, subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get policies for subject '%s': %w", subject, err)
+		}
+
+		for _, policy := range subjectPolicies {
+			if len(policy) != 7 {
+				ce.logger.Warn("skipping malformed policy", "policy", policy, "expected", 7, "got", len(policy))
+				continue
+			}
+			policyResource, roleName, roleNamespace, effect, conds, bindingName :=
+				policy[1], policy[2], policy[3], policy[4], policy[5], policy[6]
+
+			if !resourceMatch(resourcePath, policyResource) {
+				continue
+			}
+			roleKey := authzcore.RoleRef{Name: roleName, Namespace: roleNamespace}
+			grants, ok := roleGrants[roleKey]
+			if !ok {
+				grants, err = ce.roleGrantsAction(roleName, roleNamespace, request.Action)
+				if err != nil {
+					return nil, err
+				}
+				roleGrants[roleKey] = grants
+			}
+			if !grants {
+				continue
+			}
+
+			role := roleKey
+			if roleNamespace == "*" {
+				role.Namespace = ""
+			}
+			policies = append(policies, authzcore.PolicyMatch{
+				Entitlement: authzcore.Entitlement{Claim: subjectCtx.EntitlementClaim, Value: entitlementValue},
+				BindingName: bindingName,
+				Role:        role,
+				Scope:       resourcePathToHierarchy(policyResource),
+				Effect:      authzcore.PolicyEffectType(effect),
+				Conditions:  explainConditions(conds, request.Action, ctxJSON),
+				Applies:     ConditionMatcher(ctxJSON, request.Action, conds, effect, bindingName),
+			})
+		}
+	}
+
+	return &authzcore.Explanation{
+		Decision: decision.Decision,
+		Reason:   explanationReason(decision.Decision, policies),
+		Policies: policies,
+	}, nil
+}
+
+// roleGrantsAction reports whether the role's actions include the requested action
+func (ce *CasbinEnforcer) roleGrantsAction(roleName, roleNamespace, action string) (bool, error) {
+	rules, err := ce.enforcer.GetFilteredGroupingPolicy(0, roleName, "", roleNamespace)
+	if err != nil {
+		return false, fmt.Errorf("failed to get actions for role '%s' in namespace '%s': %w", roleName, roleNamespace, err)
+	}
+	for _, rule := range rules {
+		if len(rule) == 3 && actionMatch(action, rule[1]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// explainConditions evaluates each policy condition targeting the action and reports its result
+func explainConditions(policyCond, action, ctxJSON string) []authzcore.ConditionResult {
+	conds, err := decodeConditions(policyCond)
+	if err != nil {
+		return []authzcore.ConditionResult{{Result: authzcore.ConditionResultError, Error: err.Error()}}
+	}
+	matching := filterConditionsByAction(conds, action)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	activation, ok := buildActivationForRequest(ctxJSON, action)
+	results := make([]authzcore.ConditionResult, len(matching))
+	for i, c := range matching {
+		results[i] = authzcore.ConditionResult{Expression: c.Expression, Actions: c.Actions}
+		if !ok {
+			results[i].Result = authzcore.ConditionResultError
+			results[i].Error = "failed to build condition attributes from the request context"
+			continue
+		}
+		matched, _, err := runCondition(c.Expression, activation)
+		switch {
+		case err != nil:
+			results[i].Result = authzcore.ConditionResultError
+			results[i].Error = err.Error()
+		case matched:
+			results[i].Result = authzcore.ConditionResultTrue
+		default:
+			results[i].Result = authzcore.ConditionResultFalse
+		}
+	}
+	return results
+}
+
+// explanationReason names the policy that determined the decision. Entitlements are evaluated
+// independently, so an allow on one entitlement is not overridden by a deny on another.
+func explanationReason(allowed bool, policies []authzcore.PolicyMatch) string {
+	denied := make(map[authzcore.Entitlement]bool)
+	for _, p := range policies {
+		if p.Applies && p.Effect != authzcore.PolicyEffectAllow {
+			denied[p.Entitlement] = true
+		}
+	}
+
+	if allowed {
+		for _, p := range policies {
+			if p.Applies && p.Effect == authzcore.PolicyEffectAllow && !denied[p.Entitlement] {
+				return fmt.Sprintf("allowed by binding '%s' (role '%s') for entitlement '%s:%s'",
+					p.BindingName, p.Role.Name, p.Entitlement.Claim, p.Entitlement.Value)
+			}
+		}
+		return "allowed"
+	}
+
+	for _, p := range policies {
+		if p.Applies && p.Effect != authzcore.PolicyEffectAllow {
+			return fmt.Sprintf("denied by binding '%s' (role '%s') for entitlement '%s:%s'",
+				p.BindingName, p.Role.Name, p.Entitlement.Claim, p.Entitlement.Value)
+		}
+	}
+	for _, p := range policies {
+		if p.Effect == authzcore.PolicyEffectAllow {
+			return fmt.Sprintf("no allow policy applies: conditions of binding '%s' (role '%s') are not satisfied",
+				p.BindingName, p.Role.Name)
+		}
+	}
+	return "no matching policies found"
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+func explainRequest(action string, env string, groups ...string) *authzcore.EvaluateRequest {
+	return &authzcore.EvaluateRequest{
+		SubjectContext: &authzcore.SubjectContext{Type: user, EntitlementClaim: "groups", EntitlementValues: groups},
+		Resource: authzcore.Resource{
+			Type:      "component",
+			ID:        "c1",
+			Hierarchy: authzcore.ResourceHierarchy{Namespace: "acme", Project: "p1", Component: "c1"},
+		},
+		Action:  action,
+		Context: authzcore.Context{Resource: authzcore.ResourceAttribute{Environment: env}},
+	}
+}
+
+func TestCasbinEnforcer_Explain(t *testing.T) {
+	enforcer := setupTestEnforcer(t)
+	ctx := context.Background()
+
+	syncGroupingPolicies(t, enforcer, [][]string{
+		{"viewer", "component:view", "*"},
+		{"deployer", "releasebinding:create", "acme"},
+		{"project-admin", "project:*", "*"},
+	})
+	syncPolicies(t, enforcer, [][]string{
+		{"groups:dev", "ns/acme", "viewer", "*", "allow", "{}", "dev-viewer"},
+		{"groups:dev", "ns/acme/project/p1", "deployer", "acme", "allow",
+			`[{"actions":["releasebinding:create"],"expression":"resource.environment != \"prod\""}]`, "dev-deployer"},
+		{"groups:dev", "ns/acme", "project-admin", "*", "allow", "{}", "dev-project-admin"},
+		{"groups:dev", "ns/other", "viewer", "*", "allow", "{}", "other-viewer"},
+		{"groups:contractors", "ns/acme/project/p1", "viewer", "*", "deny", "{}", "contractor-deny"},
+	})
+
+	t.Run("allow lists the granting binding", func(t *testing.T) {
+		got, err := enforcer.Explain(ctx, explainRequest("component:view", "", "dev"))
+		require.NoError(t, err)
+		assert.True(t, got.Decision)
+		assert.Equal(t, "allowed by binding 'dev-viewer' (role 'viewer') for entitlement 'groups:dev'", got.Reason)
+		require.Len(t, got.Policies, 1)
+		assert.Equal(t, authzcore.PolicyMatch{
+			Entitlement: authzcore.Entitlement{Claim: "groups", Value: "dev"},
+			BindingName: "dev-viewer",
+			Role:        authzcore.RoleRef{Name: "viewer"},
+			Scope:       authzcore.ResourceHierarchy{Namespace: "acme"},
+			Effect:      authzcore.PolicyEffectAllow,
+			Applies:     true,
+		}, got.Policies[0])
+	})
+
+	t.Run("failed condition is reported", func(t *testing.T) {
+		got, err := enforcer.Explain(ctx, explainRequest("releasebinding:create", "prod", "dev"))
+		require.NoError(t, err)
+		assert.False(t, got.Decision)
+		assert.Equal(t, "no allow policy applies: conditions of binding 'dev-deployer' (role 'deployer') are not satisfied", got.Reason)
+		require.Len(t, got.Policies, 1)
+		policy := got.Policies[0]
+		assert.Equal(t, authzcore.RoleRef{Name: "deployer", Namespace: "acme"}, policy.Role)
+		assert.False(t, policy.Applies)
+		require.Len(t, policy.Conditions, 1)
+		assert.Equal(t, authzcore.ConditionResult{
+			Expression: `resource.environment != "prod"`,
+			Actions:    []string{"releasebinding:create"},
+			Result:     authzcore.ConditionResultFalse,
+		}, policy.Conditions[0])
+	})
+
+	t.Run("satisfied condition allows", func(t *testing.T) {
+		got, err := enforcer.Explain(ctx, explainRequest("releasebinding:create", "dev", "dev"))
+		require.NoError(t, err)
+		assert.True(t, got.Decision)
+		require.Len(t, got.Policies, 1)
+		assert.True(t, got.Policies[0].Applies)
+		assert.Equal(t, authzcore.ConditionResultTrue, got.Policies[0].Conditions[0].Result)
+	})
+
+	t.Run("deny names the deny binding", func(t *testing.T) {
+		got, err := enforcer.Explain(ctx, explainRequest("component:view", "", "contractors"))
+		require.NoError(t, err)
+		assert.False(t, got.Decision)
+		assert.Equal(t, "denied by binding 'contractor-deny' (role 'viewer') for entitlement 'groups:contractors'", got.Reason)
+		require.Len(t, got.Policies, 1)
+		assert.Equal(t, authzcore.PolicyEffectDeny, got.Policies[0].Effect)
+	})
+
+	t.Run("no matching policies", func(t *testing.T) {
+		got, err := enforcer.Explain(ctx, explainRequest("component:delete", "", "dev"))
+		require.NoError(t, err)
+		assert.False(t, got.Decision)
+		assert.Equal(t, "no matching policies found", got.Reason)
+		assert.Empty(t, got.Policies)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := enforcer.Explain(ctx, &authzcore.EvaluateRequest{Action: "component:view"})
+		require.ErrorIs(t, err, authzcore.ErrInvalidRequest)
+	})
+}
+
+func TestExplainConditions_Error(t *testing.T) {
+	results := explainConditions(`[{"actions":["component:*"],"expression":"resource.environment +"}]`, "component:view", "{}")
+	require.Len(t, results, 1)
+	assert.Equal(t, authzcore.ConditionResultError, results[0].Result)
+	assert.NotEmpty(t, results[0].Error)
+
+	results = explainConditions(`not-json`, "component:view", "{}")
+	require.Len(t, results, 1)
+	assert.Equal(t, authzcore.ConditionResultError, results[0].Result)
+}
+
+func TestCasbinEnforcer_Simulate(t *testing.T) {
+	existingBinding := &openchoreov1alpha1.AuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev-viewer", Namespace: "acme"},
+		Spec: openchoreov1alpha1.AuthzRoleBindingSpec{
+			Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "groups", Value: "dev"},
+			RoleMappings: []openchoreov1alpha1.RoleMapping{{
+				RoleRef: openchoreov1alpha1.RoleRef{Kind: CRDTypeClusterAuthzRole, Name: "viewer"},
+			}},
+			Effect: openchoreov1alpha1.EffectAllow,
+		},
+	}
+
+	newEnforcer := func(t *testing.T, objs ...client.Object) *CasbinEnforcer {
+		t.Helper()
+		logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+		fakeClient := fake.NewClientBuilder().WithScheme(getTestScheme()).WithObjects(objs...).Build()
+		ce, err := NewEnforcer(context.Background(), CasbinConfig{K8sClient: fakeClient}, logger)
+		require.NoError(t, err)
+		syncGroupingPolicies(t, ce, [][]string{{"viewer", "component:view", "*"}})
+		syncPolicies(t, ce, [][]string{{"groups:dev", "ns/acme", "viewer", "*", "allow", "{}", "dev-viewer"}})
+		return ce
+	}
+
+	t.Run("proposed binding grants access without touching live policies", func(t *testing.T) {
+		ce := newEnforcer(t)
+		got, err := ce.Simulate(context.Background(), &authzcore.SimulateRequest{
+			Request: *explainRequest("releasebinding:create", "", "dev"),
+			Roles: []openchoreov1alpha1.AuthzRole{{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployer", Namespace: "acme"},
+				Spec:       openchoreov1alpha1.AuthzRoleSpec{Actions: []string{"releasebinding:create"}},
+			}},
+			RoleBindings: []openchoreov1alpha1.AuthzRoleBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev-deployer", Namespace: "acme"},
+				Spec: openchoreov1alpha1.AuthzRoleBindingSpec{
+					Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "groups", Value: "dev"},
+					RoleMappings: []openchoreov1alpha1.RoleMapping{{
+						RoleRef: openchoreov1alpha1.RoleRef{Kind: CRDTypeAuthzRole, Name: "deployer"},
+					}},
+					Effect: openchoreov1alpha1.EffectAllow,
+				},
+			}},
+		})
+		require.NoError(t, err)
+		assert.True(t, got.Decision)
+		require.Len(t, got.Policies, 1)
+		assert.Equal(t, "dev-deployer", got.Policies[0].BindingName)
+
+		live, err := ce.Explain(context.Background(), explainRequest("releasebinding:create", "", "dev"))
+		require.NoError(t, err)
+		assert.False(t, live.Decision)
+	})
+
+	t.Run("proposed binding replaces the existing one", func(t *testing.T) {
+		ce := newEnforcer(t, existingBinding)
+		proposed := existingBinding.DeepCopy()
+		proposed.Spec.Effect = openchoreov1alpha1.EffectDeny
+
+		got, err := ce.Simulate(context.Background(), &authzcore.SimulateRequest{
+			Request:      *explainRequest("component:view", "", "dev"),
+			RoleBindings: []openchoreov1alpha1.AuthzRoleBinding{*proposed},
+		})
+		require.NoError(t, err)
+		assert.False(t, got.Decision)
+		require.Len(t, got.Policies, 1)
+		assert.Equal(t, authzcore.PolicyEffectDeny, got.Policies[0].Effect)
+	})
+
+	t.Run("ignore existing evaluates proposals only", func(t *testing.T) {
+		ce := newEnforcer(t)
+		got, err := ce.Simulate(context.Background(), &authzcore.SimulateRequest{
+			Request:        *explainRequest("component:view", "", "dev"),
+			IgnoreExisting: true,
+		})
+		require.NoError(t, err)
+		assert.False(t, got.Decision)
+		assert.Empty(t, got.Policies)
+	})
+
+	t.Run("namespaced proposal without namespace is invalid", func(t *testing.T) {
+		ce := newEnforcer(t)
+		_, err := ce.Simulate(context.Background(), &authzcore.SimulateRequest{
+			Request: *explainRequest("component:view", "", "dev"),
+			Roles:   []openchoreov1alpha1.AuthzRole{{ObjectMeta: metav1.ObjectMeta{Name: "deployer"}}},
+		})
+		require.ErrorIs(t, err, authzcore.ErrInvalidRequest)
+	})
+
+	t.Run("nil request", func(t *testing.T) {
+		ce := newEnforcer(t)
+		_, err := ce.Simulate(context.Background(), nil)
+		require.ErrorIs(t, err, authzcore.ErrInvalidRequest)
+	})
+}
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/interpreter"
 
 	authzv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -35,6 +37,10 @@ const (
 const (
 	// emptyContextJSON represents an empty context used when no contextual conditions are applied
 	emptyContextJSON = "{}"
+
+	// policyIdxBindingName is the index of the binding name in a policy tuple
+	// [subject, resourcePath, role, roleNamespace, effect, conditions, bindingName]
+	policyIdxBindingName = 6
 )
 
 // failClosed returns the value to use when condition evaluation cannot
@@ -213,29 +219,37 @@ func anyConditionMatches(entries []authzv1alpha1.AuthzCondition, activation inte
 
 // evalCondition compiles and evaluates a single CEL expression and returns the evalResult.
 func evalCondition(expr string, activation interpreter.Activation, policyEft, bindingName string) evalResult {
-	prg, err := compileCEL(expr)
+	result, reason, err := runCondition(expr, activation)
 	if err != nil {
-		logEvalError(policyEft, expr, bindingName, "compile_error", err)
+		logEvalError(policyEft, expr, bindingName, reason, err)
 		return evalError
 	}
 
+	slog.Default().Debug("condMatch: CEL eval result", "expression", expr, "result", result)
+	if result {
+		return evalAllow
+	}
+	return evalReject
+}
+
+// runCondition compiles and evaluates a single CEL expression. On failure it returns
+// the failure reason ("compile_error", "eval_error" or "non_bool_result") and the error.
+func runCondition(expr string, activation interpreter.Activation) (bool, string, error) {
+	prg, err := compileCEL(expr)
+	if err != nil {
+		return false, "compile_error", err
+	}
+
 	out, _, err := prg.Eval(activation)
 	if err != nil {
-		logEvalError(policyEft, expr, bindingName, "eval_error", err)
-		return evalError
+		return false, "eval_error", err
 	}
 
 	result, isBool := out.Value().(bool)
 	if !isBool {
-		logEvalError(policyEft, expr, bindingName, "non_bool_result", fmt.Errorf("got %T", out.Value()))
-		return evalError
-	}
-
-	slog.Default().Debug("condMatch: CEL eval result", "expression", expr, "result", result)
-	if result {
-		return evalAllow
+		return false, "non_bool_result", fmt.Errorf("got %T", out.Value())
 	}
-	return evalReject
+	return result, "", nil
 }
 
 func logEvalError(policyEft, expr, bindingName, reason string, err error) {
@@ -637,6 +651,11 @@ func buildCelActivation(authzCtx authzcore.Context, allowedAttrs []authzcore.Att
 		attrValue, ok := ctxAttrs[root][leaf]
 		if !ok {
 			attrValue = zeroForCELType(spec.CELType)
+		} else if spec.CELType.Kind() == types.TimestampKind {
+			// Timestamps come back from the JSON round-trip as RFC 3339 strings
+			if attrValue, err = parseTimestamp(attrValue); err != nil {
+				return nil, fmt.Errorf("invalid value for attribute %q: %w", spec.Key, err)
+			}
 		}
 		activationByRoot[root][leaf] = attrValue
 	}
@@ -662,17 +681,32 @@ func convertCtxToAttrMap(ctx authzcore.Context) (map[string]map[string]any, erro
 	return ctxAttrs, nil
 }
 
+// parseTimestamp converts a JSON-decoded timestamp attribute to a time.Time
+func parseTimestamp(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("expected an RFC 3339 timestamp, got %T", v)
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
 // zeroForCELType returns the Go zero value corresponding to a CEL type
 func zeroForCELType(t *cel.Type) any {
-	switch t {
-	case cel.StringType:
+	switch t.Kind() {
+	case types.StringKind:
 		return ""
-	case cel.BoolType:
+	case types.BoolKind:
 		return false
-	case cel.IntType:
+	case types.IntKind:
 		return int64(0)
-	case cel.DoubleType:
+	case types.DoubleKind:
 		return 0.0
+	case types.ListKind:
+		return []any{}
+	case types.MapKind:
+		return map[string]any{}
+	case types.TimestampKind:
+		return time.Time{}
 	default:
 		return nil
 	}
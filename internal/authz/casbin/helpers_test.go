@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/interpreter"
@@ -1849,6 +1850,9 @@ func TestZeroForCELType(t *testing.T) {
 		{name: "bool type returns false", celType: cel.BoolType, want: false},
 		{name: "int type returns int64 zero", celType: cel.IntType, want: int64(0)},
 		{name: "double type returns float64 zero", celType: cel.DoubleType, want: 0.0},
+		{name: "list type returns empty list", celType: cel.ListType(cel.StringType), want: []any{}},
+		{name: "map type returns empty map", celType: cel.MapType(cel.StringType, cel.DynType), want: map[string]any{}},
+		{name: "timestamp type returns zero time", celType: cel.TimestampType, want: time.Time{}},
 		{name: "unknown type returns nil", celType: cel.DynType, want: nil},
 	}
 	for _, tt := range tests {
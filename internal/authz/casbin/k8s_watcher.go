@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/casbin/casbin/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -21,32 +24,45 @@ type authzInformerHandler struct {
 	enforcer casbin.IEnforcer
 	logger   *slog.Logger
 	crdType  string // "AuthzRole", "ClusterAuthzRole", "AuthzRoleBinding", "ClusterAuthzRoleBinding"
+
+	// onPolicyChange is called after the policies of an object changed, e.g. to invalidate cached decisions
+	onPolicyChange func()
+
+	// now returns the current time; defaults to time.Now
+	now func() time.Time
+
+	// expiryTimers remove the policies of bindings with an expiry once it passes, keyed by binding key.
+	// Lazily initialized so handlers can be constructed with a struct literal.
+	expiryMu     sync.Mutex
+	expiryTimers map[string]*time.Timer
 }
 
 var _ cache.ResourceEventHandler = (*authzInformerHandler)(nil)
 
-// SetupAuthzWatchers sets up informer-based watchers with incremental updates
+// SetupAuthzWatchers sets up informer-based watchers with incremental updates.
+// onPolicyChange, if set, is called after every policy change.
 func SetupAuthzWatchers(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	enforcer casbin.IEnforcer,
+	onPolicyChange func(),
 	logger *slog.Logger,
 ) error {
 	logger = logger.With("watcher", "authz")
 
-	if err := setupAuthzRoleWatcher(ctx, mgr, enforcer, logger); err != nil {
+	if err := setupAuthzRoleWatcher(ctx, mgr, enforcer, onPolicyChange, logger); err != nil {
 		return err
 	}
 
-	if err := setupClusterAuthzRoleWatcher(ctx, mgr, enforcer, logger); err != nil {
+	if err := setupClusterAuthzRoleWatcher(ctx, mgr, enforcer, onPolicyChange, logger); err != nil {
 		return err
 	}
 
-	if err := setupAuthzRoleBindingWatcher(ctx, mgr, enforcer, logger); err != nil {
+	if err := setupAuthzRoleBindingWatcher(ctx, mgr, enforcer, onPolicyChange, logger); err != nil {
 		return err
 	}
 
-	if err := setupClusterAuthzRoleBindingWatcher(ctx, mgr, enforcer, logger); err != nil {
+	if err := setupClusterAuthzRoleBindingWatcher(ctx, mgr, enforcer, onPolicyChange, logger); err != nil {
 		return err
 	}
 
@@ -58,12 +74,14 @@ func setupAuthzRoleWatcher(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	enforcer casbin.IEnforcer,
+	onPolicyChange func(),
 	logger *slog.Logger,
 ) error {
 	handler := &authzInformerHandler{
-		enforcer: enforcer,
-		logger:   logger.With("crdType", "AuthzRole"),
-		crdType:  "AuthzRole",
+		enforcer:       enforcer,
+		logger:         logger.With("crdType", "AuthzRole"),
+		crdType:        "AuthzRole",
+		onPolicyChange: onPolicyChange,
 	}
 
 	informer, err := mgr.GetCache().GetInformer(ctx, &authzv1alpha1.AuthzRole{})
@@ -84,12 +102,14 @@ func setupClusterAuthzRoleWatcher(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	enforcer casbin.IEnforcer,
+	onPolicyChange func(),
 	logger *slog.Logger,
 ) error {
 	handler := &authzInformerHandler{
-		enforcer: enforcer,
-		logger:   logger.With("crdType", "ClusterAuthzRole"),
-		crdType:  "ClusterAuthzRole",
+		enforcer:       enforcer,
+		logger:         logger.With("crdType", "ClusterAuthzRole"),
+		crdType:        "ClusterAuthzRole",
+		onPolicyChange: onPolicyChange,
 	}
 
 	informer, err := mgr.GetCache().GetInformer(ctx, &authzv1alpha1.ClusterAuthzRole{})
@@ -110,12 +130,14 @@ func setupAuthzRoleBindingWatcher(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	enforcer casbin.IEnforcer,
+	onPolicyChange func(),
 	logger *slog.Logger,
 ) error {
 	handler := &authzInformerHandler{
-		enforcer: enforcer,
-		logger:   logger.With("crdType", "AuthzRoleBinding"),
-		crdType:  "AuthzRoleBinding",
+		enforcer:       enforcer,
+		logger:         logger.With("crdType", "AuthzRoleBinding"),
+		crdType:        "AuthzRoleBinding",
+		onPolicyChange: onPolicyChange,
 	}
 
 	informer, err := mgr.GetCache().GetInformer(ctx, &authzv1alpha1.AuthzRoleBinding{})
@@ -136,12 +158,14 @@ func setupClusterAuthzRoleBindingWatcher(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	enforcer casbin.IEnforcer,
+	onPolicyChange func(),
 	logger *slog.Logger,
 ) error {
 	handler := &authzInformerHandler{
-		enforcer: enforcer,
-		logger:   logger.With("crdType", "ClusterAuthzRoleBinding"),
-		crdType:  "ClusterAuthzRoleBinding",
+		enforcer:       enforcer,
+		logger:         logger.With("crdType", "ClusterAuthzRoleBinding"),
+		crdType:        "ClusterAuthzRoleBinding",
+		onPolicyChange: onPolicyChange,
 	}
 
 	informer, err := mgr.GetCache().GetInformer(ctx, &authzv1alpha1.ClusterAuthzRoleBinding{})
@@ -162,6 +186,7 @@ func (h *authzInformerHandler) OnAdd(obj interface{}, isInInitialList bool) {
 	if err := h.handleAdd(obj); err != nil {
 		h.logger.Error("Incremental add failed", "error", err)
 	}
+	h.policyChanged()
 }
 
 // OnUpdate handles UPDATE events by removing old and adding new
@@ -169,6 +194,7 @@ func (h *authzInformerHandler) OnUpdate(oldObj, newObj interface{}) {
 	if err := h.handleUpdate(oldObj, newObj); err != nil {
 		h.logger.Error("Incremental update failed", "error", err)
 	}
+	h.policyChanged()
 }
 
 // OnDelete handles DELETE events with incremental policy removal
@@ -176,6 +202,15 @@ func (h *authzInformerHandler) OnDelete(obj interface{}) {
 	if err := h.handleDelete(obj); err != nil {
 		h.logger.Warn("Incremental delete failed", "error", err)
 	}
+	h.policyChanged()
+}
+
+// policyChanged notifies the policy change callback. It is also called after failed updates,
+// since those may have applied part of the change.
+func (h *authzInformerHandler) policyChanged() {
+	if h.onPolicyChange != nil {
+		h.onPolicyChange()
+	}
 }
 
 func (h *authzInformerHandler) handleAdd(obj interface{}) error {
@@ -250,6 +285,11 @@ func (h *authzInformerHandler) handleAddBinding(obj interface{}) error {
 		return nil
 	}
 
+	if h.isExpired(binding.Spec.ExpiresAt) {
+		h.logger.Debug("Skipping expired binding", "binding", binding.Name, "namespace", binding.Namespace)
+		return nil
+	}
+
 	// Format subject as "claim:value"
 	subject, err := formatSubject(binding.Spec.Entitlement.Claim, binding.Spec.Entitlement.Value)
 	if err != nil {
@@ -288,6 +328,7 @@ func (h *authzInformerHandler) handleAddBinding(obj interface{}) error {
 	if _, err := h.enforcer.AddPoliciesEx(rules); err != nil {
 		return fmt.Errorf("failed to add policies for binding %s: %w", binding.Name, err)
 	}
+	h.scheduleExpiry(bindingKey(binding.Namespace, binding.Name), binding.Spec.ExpiresAt, rules)
 
 	h.logger.Debug("binding policies added successfully",
 		"binding", binding.Name,
@@ -304,6 +345,11 @@ func (h *authzInformerHandler) handleAddClusterBinding(obj interface{}) error {
 		return nil
 	}
 
+	if h.isExpired(binding.Spec.ExpiresAt) {
+		h.logger.Debug("Skipping expired cluster binding", "binding", binding.Name)
+		return nil
+	}
+
 	// Format subject as "claim:value"
 	subject, err := formatSubject(binding.Spec.Entitlement.Claim, binding.Spec.Entitlement.Value)
 	if err != nil {
@@ -338,6 +384,7 @@ func (h *authzInformerHandler) handleAddClusterBinding(obj interface{}) error {
 	if _, err := h.enforcer.AddPoliciesEx(rules); err != nil {
 		return fmt.Errorf("failed to add policies for cluster binding %s: %w", binding.Name, err)
 	}
+	h.scheduleExpiry(bindingKey("", binding.Name), binding.Spec.ExpiresAt, rules)
 
 	h.logger.Debug("cluster binding policies added successfully",
 		"binding", binding.Name,
@@ -507,6 +554,7 @@ func (h *authzInformerHandler) handleUpdateBinding(oldObj, newObj interface{}) e
 		}
 		oldPolicies = append(oldPolicies, []string{oldSubject, rp, m.RoleRef.Name, rns, oldEffect, conds, oldBinding.Name})
 	}
+	oldExpired := h.isExpired(oldBinding.Spec.ExpiresAt)
 
 	// Build new policy tuples
 	newSubject, err := formatSubject(newBinding.Spec.Entitlement.Claim, newBinding.Spec.Entitlement.Value)
@@ -532,16 +580,26 @@ func (h *authzInformerHandler) handleUpdateBinding(oldObj, newObj interface{}) e
 		}
 		newPolicies = append(newPolicies, []string{newSubject, rp, m.RoleRef.Name, rns, newEffect, conds, newBinding.Name})
 	}
+	if h.isExpired(newBinding.Spec.ExpiresAt) {
+		newPolicies = nil
+	}
 
 	// Compute diff and apply only the delta
+	key := bindingKey(newBinding.Namespace, newBinding.Name)
+	h.cancelExpiry(key)
 	added, removed := computePolicyDiff(oldPolicies, newPolicies)
+	if oldExpired {
+		// The expiry timer may or may not have removed the old policies yet: remove them
+		// all and add the new ones in full rather than applying a delta
+		added, removed = newPolicies, oldPolicies
+	}
 
 	if len(removed) > 0 {
 		ok, err := h.enforcer.RemovePolicies(removed)
 		if err != nil {
 			return fmt.Errorf("failed to remove old binding policies: %w", err)
 		}
-		if !ok {
+		if !ok && !oldExpired {
 			h.logger.Warn("binding update: no old policies matched for removal, possible key mismatch or stale policy",
 				"binding", newBinding.Name,
 				"namespace", newBinding.Namespace,
@@ -553,6 +611,7 @@ func (h *authzInformerHandler) handleUpdateBinding(oldObj, newObj interface{}) e
 			return fmt.Errorf("failed to add new binding policies: %w", err)
 		}
 	}
+	h.scheduleExpiry(key, newBinding.Spec.ExpiresAt, newPolicies)
 
 	h.logger.Debug("binding policy updated successfully",
 		"binding", newBinding.Name,
@@ -598,6 +657,7 @@ func (h *authzInformerHandler) handleUpdateClusterBinding(oldObj, newObj interfa
 		}
 		oldPolicies = append(oldPolicies, []string{oldSubject, rp, m.RoleRef.Name, "*", oldEffect, conds, oldBinding.Name})
 	}
+	oldExpired := h.isExpired(oldBinding.Spec.ExpiresAt)
 
 	// Build new policy tuples
 	newSubject, err := formatSubject(newBinding.Spec.Entitlement.Claim, newBinding.Spec.Entitlement.Value)
@@ -619,16 +679,26 @@ func (h *authzInformerHandler) handleUpdateClusterBinding(oldObj, newObj interfa
 		}
 		newPolicies = append(newPolicies, []string{newSubject, rp, m.RoleRef.Name, "*", newEffect, conds, newBinding.Name})
 	}
+	if h.isExpired(newBinding.Spec.ExpiresAt) {
+		newPolicies = nil
+	}
 
 	// Compute diff and apply only the delta
+	key := bindingKey("", newBinding.Name)
+	h.cancelExpiry(key)
 	added, removed := computePolicyDiff(oldPolicies, newPolicies)
+	if oldExpired {
+		// The expiry timer may or may not have removed the old policies yet: remove them
+		// all and add the new ones in full rather than applying a delta
+		added, removed = newPolicies, oldPolicies
+	}
 
 	if len(removed) > 0 {
 		ok, err := h.enforcer.RemovePolicies(removed)
 		if err != nil {
 			return fmt.Errorf("failed to remove old cluster binding policies: %w", err)
 		}
-		if !ok {
+		if !ok && !oldExpired {
 			h.logger.Warn("cluster binding update: no old policies matched for removal, possible key mismatch or stale policy",
 				"binding", newBinding.Name,
 				"rules", removed)
@@ -639,6 +709,7 @@ func (h *authzInformerHandler) handleUpdateClusterBinding(oldObj, newObj interfa
 			return fmt.Errorf("failed to add new cluster binding policies: %w", err)
 		}
 	}
+	h.scheduleExpiry(key, newBinding.Spec.ExpiresAt, newPolicies)
 
 	h.logger.Debug("cluster binding policy updated successfully",
 		"binding", newBinding.Name,
@@ -732,6 +803,11 @@ func (h *authzInformerHandler) handleDeleteBinding(obj interface{}) error {
 		return nil
 	}
 
+	// The policies of an expired binding are removed here too: the expiry timer may not have
+	// run yet, and cancelling it leaves this handler as the only one to remove them
+	h.cancelExpiry(bindingKey(binding.Namespace, binding.Name))
+	expired := h.isExpired(binding.Spec.ExpiresAt)
+
 	subject, err := formatSubject(binding.Spec.Entitlement.Claim, binding.Spec.Entitlement.Value)
 	if err != nil {
 		return fmt.Errorf("failed to format subject in binding %s: %w", binding.Name, err)
@@ -765,7 +841,7 @@ func (h *authzInformerHandler) handleDeleteBinding(obj interface{}) error {
 	if err != nil {
 		return fmt.Errorf("failed to remove binding policies: %w", err)
 	}
-	if !removed {
+	if !removed && !expired {
 		h.logger.Warn("binding delete: no policies matched for removal, possible key mismatch or stale policy",
 			"binding", binding.Name,
 			"namespace", binding.Namespace,
@@ -788,6 +864,10 @@ func (h *authzInformerHandler) handleDeleteClusterBinding(obj interface{}) error
 		return nil
 	}
 
+	// See handleDeleteBinding for why expired bindings are not skipped
+	h.cancelExpiry(bindingKey("", binding.Name))
+	expired := h.isExpired(binding.Spec.ExpiresAt)
+
 	subject, err := formatSubject(binding.Spec.Entitlement.Claim, binding.Spec.Entitlement.Value)
 	if err != nil {
 		return fmt.Errorf("failed to format subject in cluster binding %s: %w", binding.Name, err)
@@ -817,7 +897,7 @@ func (h *authzInformerHandler) handleDeleteClusterBinding(obj interface{}) error
 	if err != nil {
 		return fmt.Errorf("failed to remove cluster binding policies: %w", err)
 	}
-	if !removed {
+	if !removed && !expired {
 		h.logger.Warn("cluster binding delete: no policies matched for removal, possible key mismatch or stale policy",
 			"binding", binding.Name,
 			"rules", rules)
@@ -830,3 +910,89 @@ func (h *authzInformerHandler) handleDeleteClusterBinding(obj interface{}) error
 
 	return nil
 }
+
+// bindingKey returns the key expiry timers of a binding are tracked under
+func bindingKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+func (h *authzInformerHandler) currentTime() time.Time {
+	if h.now != nil {
+		return h.now()
+	}
+	return time.Now()
+}
+
+// isExpired reports whether a binding with the given expiry should no longer be honoured
+func (h *authzInformerHandler) isExpired(expiresAt *metav1.Time) bool {
+	return expiresAt != nil && !h.currentTime().Before(expiresAt.Time)
+}
+
+// scheduleExpiry removes the given policies once expiresAt passes. It is a no-op for bindings without an expiry.
+func (h *authzInformerHandler) scheduleExpiry(key string, expiresAt *metav1.Time, rules [][]string) {
+	if expiresAt == nil || len(rules) == 0 {
+		return
+	}
+
+	h.expiryMu.Lock()
+	defer h.expiryMu.Unlock()
+
+	if h.expiryTimers == nil {
+		h.expiryTimers = make(map[string]*time.Timer)
+	}
+	if t, ok := h.expiryTimers[key]; ok {
+		t.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(expiresAt.Sub(h.currentTime()), func() {
+		h.expireBinding(key, timer, rules)
+	})
+	h.expiryTimers[key] = timer
+}
+
+// cancelExpiry stops the expiry timer of a binding, if any
+func (h *authzInformerHandler) cancelExpiry(key string) {
+	h.expiryMu.Lock()
+	defer h.expiryMu.Unlock()
+
+	if t, ok := h.expiryTimers[key]; ok {
+		t.Stop()
+		delete(h.expiryTimers, key)
+	}
+}
+
+// stopExpiryTimers stops all pending expiry timers of the handler
+func (h *authzInformerHandler) stopExpiryTimers() {
+	h.expiryMu.Lock()
+	defer h.expiryMu.Unlock()
+
+	for key, t := range h.expiryTimers {
+		t.Stop()
+		delete(h.expiryTimers, key)
+	}
+}
+
+// expireBinding removes the policies of a binding whose expiry has passed
+func (h *authzInformerHandler) expireBinding(key string, timer *time.Timer, rules [][]string) {
+	h.expiryMu.Lock()
+	defer h.expiryMu.Unlock()
+
+	// The binding was updated or deleted after this timer fired but before it acquired the lock
+	if h.expiryTimers[key] != timer {
+		return
+	}
+	delete(h.expiryTimers, key)
+
+	if _, err := h.enforcer.RemovePolicies(rules); err != nil {
+		h.logger.Error("failed to remove policies of expired binding", "crdType", h.crdType, "binding", key, "error", err)
+		return
+	}
+	// Cached decisions may still reflect the expired grant
+	h.policyChanged()
+
+	h.logger.Info("binding expired, policies removed", "crdType", h.crdType, "binding", key, "count", len(rules))
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
@@ -2629,3 +2630,171 @@ func TestAuthzInformerHandler_HandleDeleteBinding_ClusterRoleRef(t *testing.T) {
 	hasP, _ := h.enforcer.HasPolicy("groups:devs", "ns/acme", "global-viewer", "*", "allow", "{}", "del-ns-binding-cluster-ref")
 	require.False(t, hasP, "policy with roleNamespace=\"*\" should be removed after handleDeleteBinding with ClusterAuthzRole ref")
 }
+
+// --- binding expiry ---
+
+func expiringBinding(name string, expiresAt time.Time) *authzv1alpha1.AuthzRoleBinding {
+	return &authzv1alpha1.AuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme", Generation: 1},
+		Spec: authzv1alpha1.AuthzRoleBindingSpec{
+			Entitlement: authzv1alpha1.EntitlementClaim{Claim: "groups", Value: "oncall"},
+			RoleMappings: []authzv1alpha1.RoleMapping{{
+				RoleRef: authzv1alpha1.RoleRef{Kind: CRDTypeAuthzRole, Name: "prod-debugger"},
+				Scope:   authzv1alpha1.TargetScope{Project: "crm"},
+			}},
+			Effect:    authzv1alpha1.EffectAllow,
+			ExpiresAt: &metav1.Time{Time: expiresAt},
+		},
+	}
+}
+
+func TestAuthzInformerHandler_HandleAddBinding_AlreadyExpired(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeAuthzRoleBinding)
+
+	binding := expiringBinding("expired", time.Now().Add(-time.Minute))
+	require.NoError(t, h.handleAddBinding(binding))
+
+	policies, err := enforcer.GetPolicy()
+	require.NoError(t, err)
+	require.Empty(t, policies, "expired binding must not contribute policies")
+}
+
+func TestAuthzInformerHandler_HandleAddBinding_RemovedOnExpiry(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeAuthzRoleBinding)
+
+	binding := expiringBinding("jit", time.Now().Add(50*time.Millisecond))
+	require.NoError(t, h.handleAddBinding(binding))
+
+	hasP, _ := enforcer.HasPolicy("groups:oncall", "ns/acme/project/crm", "prod-debugger", "acme", "allow", "{}", "jit")
+	require.True(t, hasP, "policy should be added before expiry")
+
+	require.Eventually(t, func() bool {
+		hasP, _ := enforcer.HasPolicy("groups:oncall", "ns/acme/project/crm", "prod-debugger", "acme", "allow", "{}", "jit")
+		return !hasP
+	}, 2*time.Second, 10*time.Millisecond, "policy should be removed once the binding expires")
+}
+
+func TestAuthzInformerHandler_HandleDeleteBinding_CancelsExpiry(t *testing.T) {
+	h, _ := setupTestHandler(t, CRDTypeAuthzRoleBinding)
+
+	binding := expiringBinding("jit", time.Now().Add(time.Hour))
+	require.NoError(t, h.handleAddBinding(binding))
+	require.Len(t, h.expiryTimers, 1)
+
+	require.NoError(t, h.handleDeleteBinding(binding))
+	require.Empty(t, h.expiryTimers, "expiry timer should be cancelled on delete")
+}
+
+func TestAuthzInformerHandler_HandleDeleteBinding_BetweenExpiryAndTimer(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeAuthzRoleBinding)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	binding := expiringBinding("jit", now.Add(time.Minute))
+	require.NoError(t, h.handleAddBinding(binding))
+
+	// The binding has expired, but its timer has not run yet when the delete arrives
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, h.handleDeleteBinding(binding))
+
+	hasP, _ := enforcer.HasPolicy("groups:oncall", "ns/acme/project/crm", "prod-debugger", "acme", "allow", "{}", "jit")
+	require.False(t, hasP, "policies of an expired binding must be removed on delete")
+	require.Empty(t, h.expiryTimers)
+}
+
+func TestAuthzInformerHandler_HandleDeleteClusterBinding_BetweenExpiryAndTimer(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeClusterAuthzRoleBinding)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	binding := &authzv1alpha1.ClusterAuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "jit", Generation: 1},
+		Spec: authzv1alpha1.ClusterAuthzRoleBindingSpec{
+			Entitlement: authzv1alpha1.EntitlementClaim{Claim: "groups", Value: "oncall"},
+			RoleMappings: []authzv1alpha1.ClusterRoleMapping{{
+				RoleRef: authzv1alpha1.RoleRef{Kind: CRDTypeClusterAuthzRole, Name: "admin"},
+			}},
+			Effect:    authzv1alpha1.EffectAllow,
+			ExpiresAt: &metav1.Time{Time: now.Add(time.Minute)},
+		},
+	}
+	require.NoError(t, h.handleAddClusterBinding(binding))
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, h.handleDeleteClusterBinding(binding))
+
+	hasP, _ := enforcer.HasPolicy("groups:oncall", "*", "admin", "*", "allow", "{}", "jit")
+	require.False(t, hasP, "policies of an expired cluster binding must be removed on delete")
+	require.Empty(t, h.expiryTimers)
+}
+
+func TestAuthzInformerHandler_HandleUpdateBinding_BetweenExpiryAndTimer(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeAuthzRoleBinding)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	oldBinding := expiringBinding("jit", now.Add(time.Minute))
+	require.NoError(t, h.handleAddBinding(oldBinding))
+
+	// A spec update of the expired binding arrives before its timer runs
+	now = now.Add(2 * time.Minute)
+	newBinding := oldBinding.DeepCopy()
+	newBinding.Generation = 2
+	newBinding.Spec.RoleMappings[0].Scope.Project = "billing"
+	require.NoError(t, h.handleUpdateBinding(oldBinding, newBinding))
+
+	policies, err := enforcer.GetPolicy()
+	require.NoError(t, err)
+	require.Empty(t, policies, "policies of an expired binding must be removed on update")
+	require.Empty(t, h.expiryTimers)
+}
+
+func TestAuthzInformerHandler_HandleUpdateBinding_ExtendsExpiry(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeAuthzRoleBinding)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	oldBinding := expiringBinding("jit", now.Add(-time.Minute))
+	require.NoError(t, h.handleAddBinding(oldBinding))
+
+	newBinding := expiringBinding("jit", now.Add(time.Hour))
+	newBinding.Generation = 2
+	require.NoError(t, h.handleUpdateBinding(oldBinding, newBinding))
+
+	hasP, _ := enforcer.HasPolicy("groups:oncall", "ns/acme/project/crm", "prod-debugger", "acme", "allow", "{}", "jit")
+	require.True(t, hasP, "extending an expired binding should grant access again")
+	require.Len(t, h.expiryTimers, 1)
+
+	h.cancelExpiry(bindingKey("acme", "jit"))
+}
+
+func TestAuthzInformerHandler_HandleUpdateClusterBinding_Expired(t *testing.T) {
+	h, enforcer := setupTestHandler(t, CRDTypeClusterAuthzRoleBinding)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	binding := func(gen int64, expiresAt *metav1.Time) *authzv1alpha1.ClusterAuthzRoleBinding {
+		return &authzv1alpha1.ClusterAuthzRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "jit", Generation: gen},
+			Spec: authzv1alpha1.ClusterAuthzRoleBindingSpec{
+				Entitlement: authzv1alpha1.EntitlementClaim{Claim: "groups", Value: "oncall"},
+				RoleMappings: []authzv1alpha1.ClusterRoleMapping{{
+					RoleRef: authzv1alpha1.RoleRef{Kind: CRDTypeClusterAuthzRole, Name: "admin"},
+				}},
+				Effect:    authzv1alpha1.EffectAllow,
+				ExpiresAt: expiresAt,
+			},
+		}
+	}
+
+	oldBinding := binding(1, nil)
+	require.NoError(t, h.handleAddClusterBinding(oldBinding))
+	hasP, _ := enforcer.HasPolicy("groups:oncall", "*", "admin", "*", "allow", "{}", "jit")
+	require.True(t, hasP)
+
+	newBinding := binding(2, &metav1.Time{Time: now.Add(-time.Second)})
+	require.NoError(t, h.handleUpdateClusterBinding(oldBinding, newBinding))
+	hasP, _ = enforcer.HasPolicy("groups:oncall", "*", "admin", "*", "allow", "{}", "jit")
+	require.False(t, hasP, "setting a past expiry should revoke the binding")
+	require.Empty(t, h.expiryTimers)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package casbin
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "openchoreo"
+	metricsSubsystem = "authz"
+)
+
+// Cache lookup results reported by decisionCacheLookups
+const (
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+)
+
+var (
+	evaluationDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "evaluation_duration_seconds",
+		Help:      "Time taken to evaluate an authorization request, including decision cache lookups.",
+		Buckets:   prometheus.ExponentialBuckets(0.00005, 2, 14),
+	})
+
+	decisionCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "decision_cache_lookups_total",
+		Help:      "Number of authorization decision cache lookups by result (hit or miss).",
+	}, []string{"result"})
+
+	deniedDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "denied_decisions_total",
+		Help:      "Number of denied authorization decisions by action.",
+	}, []string{"action"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(evaluationDuration, decisionCacheLookups, deniedDecisions)
+}
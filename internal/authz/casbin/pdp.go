@@ -21,7 +21,7 @@ func (ce *CasbinEnforcer) Evaluate(ctx context.Context, request *authzcore.Evalu
 	if err != nil {
 		return &authzcore.Decision{Decision: false}, err
 	}
-	return ce.check(request)
+	return ce.check(ctx, request)
 }
 
 // BatchEvaluate evaluates multiple authorization requests and returns corresponding decisions
@@ -38,7 +38,7 @@ func (ce *CasbinEnforcer) BatchEvaluate(ctx context.Context, request *authzcore.
 		if ctx.Err() != nil {
 			return &authzcore.BatchEvaluateResponse{}, ctx.Err()
 		}
-		decision, err := ce.check(&req)
+		decision, err := ce.check(ctx, &req)
 		if err != nil {
 			return &authzcore.BatchEvaluateResponse{}, fmt.Errorf("batch evaluate failed at index %d: %w", i, err)
 		}
@@ -230,8 +230,10 @@ func (ce *CasbinEnforcer) buildCapabilitiesFromPolicies(policies []policyInfo, a
 	return capabilities, nil
 }
 
-// check performs the actual authorization check using Casbin
-func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+// check performs the actual authorization check using Casbin. Decisions are served from the
+// decision cache when enabled, and recorded in the decision log and metrics.
+func (ce *CasbinEnforcer) check(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+	start := time.Now()
 	resourcePath := resourceHierarchyToPath(request.Resource.Hierarchy)
 	subjectCtx := request.SubjectContext
 
@@ -248,24 +250,65 @@ func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.
 		"action", request.Action,
 		"context", request.Context)
 
+	condCtx, err := ce.conditionContext(ctx, request)
+	if err != nil {
+		return &authzcore.Decision{Decision: false}, fmt.Errorf("failed to resolve condition attributes: %w", err)
+	}
+
 	// Serialize the request context once; it is invariant across entitlements.
-	ctxJSON, err := serializeAuthzContext(request.Context)
+	ctxJSON, err := serializeAuthzContext(condCtx)
 	if err != nil {
 		return &authzcore.Decision{Decision: false}, fmt.Errorf("failed to serialize request context: %w", err)
 	}
 
-	result := false
+	var cacheKey string
+	var cacheGen uint64
+	if ce.decisionCache != nil {
+		// Captured before deciding so a policy change made meanwhile discards the decision
+		cacheGen = ce.decisionCache.generation()
+		cacheKey = decisionCacheKey(subjectCtx.EntitlementClaim, subjectCtx.EntitlementValues, resourcePath, request.Action, ctxJSON)
+		if cached, ok := ce.decisionCache.get(cacheKey); ok {
+			decisionCacheLookups.WithLabelValues(cacheResultHit).Inc()
+			decision := &authzcore.Decision{Decision: cached.decision,
+				Context: &authzcore.DecisionContext{Reason: cached.reason}}
+			ce.observeDecision(request, decision, cached.bindingName, true, start)
+			return decision, nil
+		}
+		decisionCacheLookups.WithLabelValues(cacheResultMiss).Inc()
+	}
+
+	decision, bindingName, err := ce.decide(request, resourcePath, ctxJSON)
+	if err != nil {
+		return decision, err
+	}
+
+	if ce.decisionCache != nil {
+		ce.decisionCache.put(cacheKey, cachedDecision{
+			decision:    decision.Decision,
+			reason:      decision.Context.Reason,
+			bindingName: bindingName,
+		}, cacheGen)
+	}
+	ce.observeDecision(request, decision, bindingName, false, start)
+	return decision, nil
+}
+
+// decide enforces the request for each entitlement of the subject until one is allowed. It returns
+// the decision and the binding of the policy that decided it, if any.
+func (ce *CasbinEnforcer) decide(request *authzcore.EvaluateRequest, resourcePath, ctxJSON string) (*authzcore.Decision, string, error) {
+	subjectCtx := request.SubjectContext
 	decision := &authzcore.Decision{Decision: false,
 		Context: &authzcore.DecisionContext{
 			Reason: "no matching policies found",
 		}}
+	bindingName := ""
 	for _, entitlementValue := range subjectCtx.EntitlementValues {
 		entitlement, err := formatSubject(subjectCtx.EntitlementClaim, entitlementValue)
 		if err != nil {
 			ce.logger.Warn("failed to format subject", "error", err)
-			return &authzcore.Decision{Decision: false}, fmt.Errorf("failed to format subject: %w", err)
+			return &authzcore.Decision{Decision: false}, "", fmt.Errorf("failed to format subject: %w", err)
 		}
-		result, err = ce.enforcer.Enforce(
+		result, rule, err := ce.enforcer.EnforceEx(
 			entitlement,
 			resourcePath,
 			request.Action,
@@ -273,7 +316,11 @@ func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.
 		)
 		if err != nil {
 			ce.logger.Warn("enforcement failed", "error", err)
-			return &authzcore.Decision{Decision: false}, fmt.Errorf("enforcement failed: %w", err)
+			return &authzcore.Decision{Decision: false}, "", fmt.Errorf("enforcement failed: %w", err)
+		}
+		// rule is the policy that decided the outcome: the allowing policy, or a matching deny policy
+		if len(rule) > policyIdxBindingName && (result || bindingName == "") {
+			bindingName = rule[policyIdxBindingName]
 		}
 		if result {
 			decision.Decision = true
@@ -285,5 +332,31 @@ func (ce *CasbinEnforcer) check(request *authzcore.EvaluateRequest) (*authzcore.
 			break
 		}
 	}
-	return decision, nil
+	return decision, bindingName, nil
+}
+
+// observeDecision records an evaluated decision in the metrics and the decision log
+func (ce *CasbinEnforcer) observeDecision(request *authzcore.EvaluateRequest, decision *authzcore.Decision, bindingName string, cached bool, start time.Time) {
+	duration := time.Since(start)
+	evaluationDuration.Observe(duration.Seconds())
+	if !decision.Decision {
+		deniedDecisions.WithLabelValues(request.Action).Inc()
+	}
+
+	if ce.decisionLog == nil {
+		return
+	}
+	subject := *request.SubjectContext
+	subject.Claims = nil
+	ce.decisionLog.record(authzcore.DecisionRecord{
+		Time:        ce.currentTime(),
+		Subject:     subject,
+		Action:      request.Action,
+		Resource:    request.Resource,
+		Decision:    decision.Decision,
+		Reason:      decision.Context.Reason,
+		BindingName: bindingName,
+		Cached:      cached,
+		Duration:    duration,
+	})
 }
@@ -1836,7 +1836,7 @@ func TestCasbinEnforcer_GetSubjectProfile_MissingSubjectContext(t *testing.T) {
 func TestCasbinEnforcer_Check_NilSubjectContext(t *testing.T) {
 	enforcer := setupTestEnforcer(t)
 	// Call check directly to exercise the nil subjectCtx guard
-	decision, err := enforcer.check(&authzcore.EvaluateRequest{
+	decision, err := enforcer.check(context.Background(), &authzcore.EvaluateRequest{
 		SubjectContext: nil,
 		Resource:       authzcore.Resource{Type: "component"},
 		Action:         "component:view",
@@ -247,9 +247,17 @@ const (
 	ActionUpdateAuthzRoleBinding = "authzrolebinding:update"
 	ActionDeleteAuthzRoleBinding = "authzrolebinding:delete"
 
+	// AuthzAccessRequest actions
+	ActionCreateAuthzAccessRequest  = "authzaccessrequest:create"
+	ActionViewAuthzAccessRequest    = "authzaccessrequest:view"
+	ActionApproveAuthzAccessRequest = "authzaccessrequest:approve"
+
 	// Logs actions
 	ActionViewLogs = "logs:view"
 
+	// Saved log queries and log metrics actions
+	ActionUpdateLogQueries = "logqueries:update"
+
 	// Events actions
 	ActionViewEvents = "events:view"
 
@@ -514,9 +522,17 @@ var systemActions = []Action{
 	{Name: ActionUpdateAuthzRoleBinding, LowestScope: ScopeNamespace, IsInternal: false},
 	{Name: ActionDeleteAuthzRoleBinding, LowestScope: ScopeNamespace, IsInternal: false},
 
+	// AuthzAccessRequest (approve is evaluated against the requested scope, down to a component or resource)
+	{Name: ActionViewAuthzAccessRequest, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionCreateAuthzAccessRequest, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionApproveAuthzAccessRequest, LowestScope: ScopeResource, IsInternal: false},
+
 	// logs (dynamic scope: namespace or component depending on query)
 	{Name: ActionViewLogs, LowestScope: ScopeComponent, IsInternal: false},
 
+	// saved log queries and log metrics (project scope)
+	{Name: ActionUpdateLogQueries, LowestScope: ScopeProject, IsInternal: false},
+
 	// events (dynamic scope: namespace, project, component depending on query)
 	{Name: ActionViewEvents, LowestScope: ScopeComponent, IsInternal: false},
 
@@ -9,12 +9,18 @@ import (
 	"github.com/google/cel-go/cel"
 )
 
-const conditionTypeResource = "resource"
+const (
+	conditionTypeResource = "resource"
+	conditionTypeSubject  = "subject"
+	conditionTypeRequest  = "request"
+)
 
 // KnownCELRoots is the set of CEL root variable names declared in the shared env.
 // Add new roots here when extending the ABAC attribute model.
 var KnownCELRoots = map[string]bool{
 	conditionTypeResource: true,
+	conditionTypeSubject:  true,
+	conditionTypeRequest:  true,
 }
 
 var (
@@ -29,6 +35,8 @@ func GetCELEnv() (*cel.Env, error) {
 	sharedCELEnvOnce.Do(func() {
 		sharedCELEnv, sharedCELEnvErr = cel.NewEnv(
 			cel.Variable(conditionTypeResource, cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable(conditionTypeSubject, cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable(conditionTypeRequest, cel.MapType(cel.StringType, cel.DynType)),
 		)
 	})
 	return sharedCELEnv, sharedCELEnvErr
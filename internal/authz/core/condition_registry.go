@@ -4,6 +4,7 @@
 package core
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/google/cel-go/cel"
@@ -63,9 +64,69 @@ var AttrResourceWorkflow = AttributeSpec{
 	CELType:     cel.StringType,
 }
 
+// AttrResourceProject declares resource.project — the project the resource
+// belongs to. Registered for every action evaluated at project scope or below.
+var AttrResourceProject = AttributeSpec{
+	Key:         "resource.project",
+	Description: "Project the resource belongs to",
+	CELType:     cel.StringType,
+}
+
+// AttrResourceIsProduction declares resource.isProduction — whether the Environment
+// named by resource.environment is marked as a production environment.
+var AttrResourceIsProduction = AttributeSpec{
+	Key:         "resource.isProduction",
+	Description: "Whether the environment is a production environment",
+	CELType:     cel.BoolType,
+}
+
+// AttrSubjectType declares subject.type — the type of the subject making the
+// request (e.g. "user", "service_account").
+var AttrSubjectType = AttributeSpec{
+	Key:         "subject.type",
+	Description: "Type of the subject making the request",
+	CELType:     cel.StringType,
+}
+
+// AttrSubjectEntitlements declares subject.entitlements — all entitlement values
+// of the subject (e.g. its groups).
+var AttrSubjectEntitlements = AttributeSpec{
+	Key:         "subject.entitlements",
+	Description: "Entitlement values of the subject, such as its groups",
+	CELType:     cel.ListType(cel.StringType),
+}
+
+// AttrSubjectClaims declares subject.claims — the claims of the subject's token,
+// including custom claims (e.g. subject.claims.department).
+var AttrSubjectClaims = AttributeSpec{
+	Key:         "subject.claims",
+	Description: "Claims of the subject's token",
+	CELType:     cel.MapType(cel.StringType, cel.DynType),
+}
+
+// AttrRequestTime declares request.time — the time the request is evaluated at,
+// for rules such as business hours (e.g. request.time.getHours("Europe/Berlin") < 18).
+var AttrRequestTime = AttributeSpec{
+	Key:         "request.time",
+	Description: "Time the request is evaluated at",
+	CELType:     cel.TimestampType,
+}
+
+// commonAttributes are available to the conditions of every public action.
+var commonAttributes = []AttributeSpec{
+	AttrSubjectType,
+	AttrSubjectEntitlements,
+	AttrSubjectClaims,
+	AttrRequestTime,
+}
+
 // conditionRegistry maps concrete action names to the attributes available to CEL
 // expressions scoped to that action. Treat as immutable after init.
-var conditionRegistry = map[string][]AttributeSpec{
+var conditionRegistry = buildConditionRegistry(actionAttributes)
+
+// actionAttributes holds the attributes specific to each action; buildConditionRegistry
+// adds resource.project and the common attributes.
+var actionAttributes = map[string][]AttributeSpec{
 	ActionCreateComponent:              {AttrResourceComponentType},
 	ActionUpdateComponent:              {AttrResourceComponentType},
 	ActionDeleteComponent:              {AttrResourceComponentType},
@@ -75,23 +136,39 @@ var conditionRegistry = map[string][]AttributeSpec{
 	ActionCreateWorkflowRun:            {AttrResourceWorkflow},
 	ActionUpdateWorkflowRun:            {AttrResourceWorkflow},
 	ActionDeleteWorkflowRun:            {AttrResourceWorkflow},
-	ActionCreateReleaseBinding:         {AttrResourceEnvironment},
-	ActionViewReleaseBinding:           {AttrResourceEnvironment},
-	ActionUpdateReleaseBinding:         {AttrResourceEnvironment},
-	ActionDeleteReleaseBinding:         {AttrResourceEnvironment},
-	ActionCreateResourceReleaseBinding: {AttrResourceEnvironment},
-	ActionViewResourceReleaseBinding:   {AttrResourceEnvironment},
-	ActionUpdateResourceReleaseBinding: {AttrResourceEnvironment},
-	ActionDeleteResourceReleaseBinding: {AttrResourceEnvironment},
-	ActionCreateProjectReleaseBinding:  {AttrResourceEnvironment},
-	ActionViewProjectReleaseBinding:    {AttrResourceEnvironment},
-	ActionUpdateProjectReleaseBinding:  {AttrResourceEnvironment},
-	ActionDeleteProjectReleaseBinding:  {AttrResourceEnvironment},
-	ActionExecComponent:                {AttrResourceEnvironment},
-	ActionViewLogs:                     {AttrResourceEnvironment},
-	ActionViewWirelogs:                 {AttrResourceEnvironment},
-	ActionViewMetrics:                  {AttrResourceEnvironment},
-	ActionViewTraces:                   {AttrResourceEnvironment},
+	ActionCreateReleaseBinding:         {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewReleaseBinding:           {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionUpdateReleaseBinding:         {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionDeleteReleaseBinding:         {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionCreateResourceReleaseBinding: {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewResourceReleaseBinding:   {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionUpdateResourceReleaseBinding: {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionDeleteResourceReleaseBinding: {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionCreateProjectReleaseBinding:  {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewProjectReleaseBinding:    {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionUpdateProjectReleaseBinding:  {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionDeleteProjectReleaseBinding:  {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionExecComponent:                {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewLogs:                     {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewWirelogs:                 {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewMetrics:                  {AttrResourceEnvironment, AttrResourceIsProduction},
+	ActionViewTraces:                   {AttrResourceEnvironment, AttrResourceIsProduction},
+}
+
+// buildConditionRegistry registers, for every concrete public action, its specific
+// attributes followed by resource.project (for actions below namespace scope) and the
+// common attributes.
+func buildConditionRegistry(specific map[string][]AttributeSpec) map[string][]AttributeSpec {
+	registry := make(map[string][]AttributeSpec, len(specific))
+	for _, action := range concretePublicActions() {
+		specs := slices.Clone(specific[action.Name])
+		switch action.LowestScope {
+		case ScopeProject, ScopeComponent, ScopeResource:
+			specs = append(specs, AttrResourceProject)
+		}
+		registry[action.Name] = append(specs, commonAttributes...)
+	}
+	return registry
 }
 
 // LookupConditions returns the attribute specs available for a given concrete action.
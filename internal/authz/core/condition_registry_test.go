@@ -21,16 +21,39 @@ func SetConditionRegistryForTest(tb testing.TB, reg map[string][]AttributeSpec)
 	tb.Cleanup(func() { conditionRegistry = orig })
 }
 
+func specKeys(specs []AttributeSpec) []string {
+	keys := make([]string, 0, len(specs))
+	for _, s := range specs {
+		keys = append(keys, s.Key)
+	}
+	return keys
+}
+
 func TestLookupConditions(t *testing.T) {
+	commonKeys := []string{
+		AttrSubjectType.Key,
+		AttrSubjectEntitlements.Key,
+		AttrSubjectClaims.Key,
+		AttrRequestTime.Key,
+	}
+
 	t.Run("known action returns expected specs", func(t *testing.T) {
 		specs := LookupConditions(ActionCreateReleaseBinding)
 		require.NotNil(t, specs)
-		require.Len(t, specs, 1)
 		require.Equal(t, AttrResourceEnvironment.Key, specs[0].Key)
+		require.Subset(t, specKeys(specs), []string{AttrResourceIsProduction.Key, AttrResourceProject.Key})
+		require.Subset(t, specKeys(specs), commonKeys)
+	})
+
+	t.Run("action without specific conditions returns common attributes only", func(t *testing.T) {
+		keys := specKeys(LookupConditions(ActionViewComponent))
+		require.ElementsMatch(t, append([]string{AttrResourceProject.Key}, commonKeys...), keys)
 	})
 
-	t.Run("action without registered conditions returns nil", func(t *testing.T) {
-		require.Nil(t, LookupConditions(ActionViewComponent))
+	t.Run("namespace-scoped action has no project attribute", func(t *testing.T) {
+		keys := specKeys(LookupConditions(ActionViewNamespace))
+		require.NotContains(t, keys, AttrResourceProject.Key)
+		require.Subset(t, keys, commonKeys)
 	})
 
 	t.Run("empty action returns nil", func(t *testing.T) {
@@ -39,9 +62,9 @@ func TestLookupConditions(t *testing.T) {
 	})
 
 	t.Run("component:exec supports resource.environment", func(t *testing.T) {
-		specs := LookupConditions(ActionExecComponent)
-		require.Len(t, specs, 1)
-		require.Equal(t, AttrResourceEnvironment.Key, specs[0].Key)
+		keys := specKeys(LookupConditions(ActionExecComponent))
+		require.Contains(t, keys, AttrResourceEnvironment.Key)
+		require.Contains(t, keys, AttrResourceIsProduction.Key)
 	})
 
 	t.Run("resourcereleasebinding actions support resource.environment", func(t *testing.T) {
@@ -51,9 +74,9 @@ func TestLookupConditions(t *testing.T) {
 			ActionUpdateResourceReleaseBinding,
 			ActionDeleteResourceReleaseBinding,
 		} {
-			specs := LookupConditions(action)
-			require.Len(t, specs, 1, "action %q should expose one attribute", action)
-			require.Equal(t, AttrResourceEnvironment.Key, specs[0].Key, "action %q", action)
+			keys := specKeys(LookupConditions(action))
+			require.Contains(t, keys, AttrResourceEnvironment.Key, "action %q", action)
+			require.Contains(t, keys, AttrResourceIsProduction.Key, "action %q", action)
 		}
 	})
 
@@ -63,9 +86,9 @@ func TestLookupConditions(t *testing.T) {
 			ActionUpdateComponent,
 			ActionDeleteComponent,
 		} {
-			specs := LookupConditions(action)
-			require.Len(t, specs, 1, "action %q should expose one attribute", action)
-			require.Equal(t, AttrResourceComponentType.Key, specs[0].Key, "action %q", action)
+			keys := specKeys(LookupConditions(action))
+			require.Contains(t, keys, AttrResourceComponentType.Key, "action %q", action)
+			require.NotContains(t, keys, AttrResourceEnvironment.Key, "action %q", action)
 		}
 	})
 
@@ -75,9 +98,8 @@ func TestLookupConditions(t *testing.T) {
 			ActionUpdateResource,
 			ActionDeleteResource,
 		} {
-			specs := LookupConditions(action)
-			require.Len(t, specs, 1, "action %q should expose one attribute", action)
-			require.Equal(t, AttrResourceResourceType.Key, specs[0].Key, "action %q", action)
+			keys := specKeys(LookupConditions(action))
+			require.Contains(t, keys, AttrResourceResourceType.Key, "action %q", action)
 		}
 	})
 
@@ -87,9 +109,8 @@ func TestLookupConditions(t *testing.T) {
 			ActionUpdateWorkflowRun,
 			ActionDeleteWorkflowRun,
 		} {
-			specs := LookupConditions(action)
-			require.Len(t, specs, 1, "action %q should expose one attribute", action)
-			require.Equal(t, AttrResourceWorkflow.Key, specs[0].Key, "action %q", action)
+			keys := specKeys(LookupConditions(action))
+			require.Contains(t, keys, AttrResourceWorkflow.Key, "action %q", action)
 		}
 	})
 }
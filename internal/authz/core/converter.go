@@ -17,5 +17,6 @@ func GetAuthzSubjectContext(authCtx *auth.SubjectContext) *SubjectContext {
 		Type:              authCtx.Type,
 		EntitlementClaim:  authCtx.EntitlementClaim,
 		EntitlementValues: authCtx.EntitlementValues,
+		Claims:            authCtx.Claims,
 	}
 }
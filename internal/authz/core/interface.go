@@ -28,6 +28,23 @@ type PDP interface {
 	GetSubjectProfile(ctx context.Context, request *ProfileRequest) (*UserCapabilitiesResponse, error)
 }
 
+// PolicyExplainer is implemented by PDPs that can explain how a decision was reached
+type PolicyExplainer interface {
+	// Explain evaluates a request and returns the decision along with the policies that matched it
+	Explain(ctx context.Context, request *EvaluateRequest) (*Explanation, error)
+
+	// Simulate explains a request against the current policies overlaid with proposed roles and bindings,
+	// without applying them
+	Simulate(ctx context.Context, request *SimulateRequest) (*Explanation, error)
+}
+
+// DecisionLogReader is implemented by PDPs that keep a log of their decisions
+type DecisionLogReader interface {
+	// ListDecisions returns the logged decisions matching the query, newest first.
+	// Returns ErrDecisionLogDisabled when the decision log is not enabled.
+	ListDecisions(ctx context.Context, query DecisionLogQuery) ([]DecisionRecord, error)
+}
+
 // PAP (Policy Administration Point) interface defines the contract for policy management
 type PAP interface {
 	// ListActions lists all public actions in the system
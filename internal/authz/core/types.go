@@ -6,6 +6,8 @@ package core
 import (
 	"fmt"
 	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
 // PolicyEffectType defines the effect of a policy: allow or deny
@@ -21,6 +23,8 @@ type SubjectContext struct {
 	Type              string   `json:"type"`
 	EntitlementClaim  string   `json:"entitlement_claim"`
 	EntitlementValues []string `json:"entitlement_values"`
+	// Claims are the claims of the subject's token, exposed to conditions as subject.claims
+	Claims map[string]any `json:"claims,omitempty"`
 }
 
 // ResourceHierarchy represents a single item in a resource hierarchy.
@@ -47,6 +51,11 @@ type Resource struct {
 type Context struct {
 	// Resource holds attributes of the target resource instance.
 	Resource ResourceAttribute `json:"resource,omitempty"`
+	// Subject holds attributes of the subject making the request. Filled from the
+	// request's subject context at evaluation time.
+	Subject SubjectAttribute `json:"subject,omitzero"`
+	// Request holds attributes of the request itself.
+	Request RequestAttribute `json:"request,omitzero"`
 }
 
 // ResourceAttribute holds target-resource attributes exposed to CEL under the "resource" root.
@@ -59,6 +68,27 @@ type ResourceAttribute struct {
 	ResourceType string `json:"resourceType,omitempty"`
 	// Workflow is the Workflow (or ClusterWorkflow) name referenced by the WorkflowRun being acted upon.
 	Workflow string `json:"workflow,omitempty"`
+	// Project is the project the resource belongs to. Defaults to the project of the resource hierarchy.
+	Project string `json:"project,omitempty"`
+	// IsProduction reports whether Environment is a production environment. Resolved from the
+	// Environment at evaluation time when not set.
+	IsProduction bool `json:"isProduction,omitempty"`
+}
+
+// SubjectAttribute holds subject attributes exposed to CEL under the "subject" root.
+type SubjectAttribute struct {
+	// Type is the subject type (e.g. "user", "service_account").
+	Type string `json:"type,omitempty"`
+	// Entitlements are all entitlement values of the subject (e.g. its groups).
+	Entitlements []string `json:"entitlements,omitempty"`
+	// Claims are the claims of the subject's token, including custom claims.
+	Claims map[string]any `json:"claims,omitempty"`
+}
+
+// RequestAttribute holds request attributes exposed to CEL under the "request" root.
+type RequestAttribute struct {
+	// Time is the time the request is evaluated at. Defaults to the current time.
+	Time time.Time `json:"time,omitzero"`
 }
 
 // Decision represents the authorization decision response
@@ -119,6 +149,98 @@ type Entitlement struct {
 	Value string `json:"value" yaml:"value"`
 }
 
+// ConditionResult is the outcome of evaluating a single binding condition against a request
+type ConditionResult struct {
+	// Expression is the CEL expression of the condition
+	Expression string `json:"expression"`
+	// Actions are the action patterns the condition applies to
+	Actions []string `json:"actions,omitempty"`
+	// Result is "true", "false", or "error" when the expression could not be evaluated
+	Result string `json:"result"`
+	// Error describes why the expression could not be evaluated
+	Error string `json:"error,omitempty"`
+}
+
+// Condition evaluation results reported in ConditionResult.Result
+const (
+	ConditionResultTrue  = "true"
+	ConditionResultFalse = "false"
+	ConditionResultError = "error"
+)
+
+// PolicyMatch describes a policy whose subject, resource scope, and role actions match a request.
+type PolicyMatch struct {
+	// Entitlement is the subject entitlement the policy is bound to
+	Entitlement Entitlement `json:"entitlement"`
+	// BindingName is the AuthzRoleBinding or ClusterAuthzRoleBinding the policy comes from
+	BindingName string `json:"bindingName"`
+	// Role is the role granting the requested action
+	Role RoleRef `json:"role"`
+	// Scope is the resource hierarchy the binding applies to; empty means all resources
+	Scope ResourceHierarchy `json:"scope"`
+	// Effect is the effect of the binding
+	Effect PolicyEffectType `json:"effect"`
+	// Conditions are the binding conditions targeting the requested action, with their results
+	Conditions []ConditionResult `json:"conditions,omitempty"`
+	// Applies reports whether the policy took part in the decision once its conditions were evaluated
+	Applies bool `json:"applies"`
+}
+
+// Explanation is an authorization decision along with the policies that produced it
+type Explanation struct {
+	Decision bool          `json:"decision"`
+	Reason   string        `json:"reason"`
+	Policies []PolicyMatch `json:"policies"`
+}
+
+// SimulateRequest evaluates a request against the current policies overlaid with proposed
+// roles and bindings. Proposed objects replace existing objects with the same name.
+type SimulateRequest struct {
+	Request EvaluateRequest `json:"request"`
+
+	ClusterRoles        []openchoreov1alpha1.ClusterAuthzRole        `json:"clusterRoles,omitempty"`
+	Roles               []openchoreov1alpha1.AuthzRole               `json:"roles,omitempty"`
+	ClusterRoleBindings []openchoreov1alpha1.ClusterAuthzRoleBinding `json:"clusterRoleBindings,omitempty"`
+	RoleBindings        []openchoreov1alpha1.AuthzRoleBinding        `json:"roleBindings,omitempty"`
+
+	// IgnoreExisting evaluates against the proposed objects only
+	IgnoreExisting bool `json:"ignoreExisting,omitempty"`
+}
+
+// DecisionRecord is an entry of the decision log
+type DecisionRecord struct {
+	// Time is when the decision was made
+	Time time.Time `json:"time"`
+	// Subject is the subject the decision was made for; token claims are not recorded
+	Subject SubjectContext `json:"subject"`
+	Action  string         `json:"action"`
+	// Resource is the resource the action was evaluated on
+	Resource Resource `json:"resource"`
+	Decision bool     `json:"decision"`
+	Reason   string   `json:"reason,omitempty"`
+	// BindingName is the binding that allowed or denied the request; empty when no policy matched
+	BindingName string `json:"bindingName,omitempty"`
+	// Cached reports whether the decision was served from the decision cache
+	Cached bool `json:"cached"`
+	// Duration is how long the evaluation took
+	Duration time.Duration `json:"duration"`
+}
+
+// DecisionLogQuery filters the entries of the decision log. Zero-valued fields match all entries.
+type DecisionLogQuery struct {
+	// Namespace matches decisions on resources in the namespace
+	Namespace string
+	Action    string
+	// EntitlementValue matches decisions for subjects holding the entitlement value
+	EntitlementValue string
+	// Decision matches allowed (true) or denied (false) decisions
+	Decision *bool
+	// Since matches decisions made at or after the time
+	Since time.Time
+	// Limit caps the number of returned entries, newest first
+	Limit int
+}
+
 // ActionCapability represents capabilities for a specific action
 type ActionCapability struct {
 	Allowed []*CapabilityResource `json:"allowed"`
@@ -154,4 +276,6 @@ var (
 	ErrCannotDeleteSystemMapping = fmt.Errorf("cannot delete system mapping")
 	ErrCannotModifySystemMapping = fmt.Errorf("cannot modify system mapping")
 	ErrInvalidRequest            = fmt.Errorf("invalid request")
+	ErrExplainNotSupported       = fmt.Errorf("policy explanation is not supported by the configured authorizer")
+	ErrDecisionLogDisabled       = fmt.Errorf("the authorization decision log is not enabled")
 )
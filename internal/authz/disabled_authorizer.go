@@ -84,6 +84,25 @@ func (da *DisabledAuthorizer) GetSubjectProfile(ctx context.Context, request *au
 	}, nil
 }
 
+// Explain always returns a decision allowing access with no matching policies
+func (da *DisabledAuthorizer) Explain(ctx context.Context, request *authz.EvaluateRequest) (*authz.Explanation, error) {
+	da.logger.Debug("disabled authorizer: explain called (authorization disabled)",
+		"subject", request.SubjectContext,
+		"resource", request.Resource,
+		"action", request.Action)
+
+	return &authz.Explanation{
+		Decision: true,
+		Reason:   "Authorization disabled - all access granted",
+		Policies: []authz.PolicyMatch{},
+	}, nil
+}
+
+// Simulate always returns a decision allowing access, regardless of the proposed policies
+func (da *DisabledAuthorizer) Simulate(ctx context.Context, request *authz.SimulateRequest) (*authz.Explanation, error) {
+	return da.Explain(ctx, &request.Request)
+}
+
 // ============================================================================
 // PAP Implementation - All policy operations fail
 // ============================================================================
@@ -174,8 +193,9 @@ func (da *DisabledAuthorizer) DeleteNamespacedRoleBinding(ctx context.Context, n
 }
 
 // These var declarations enforce at compile-time that DisabledAuthorizer
-// implements the PDP and PAP interfaces correctly.
+// implements the PDP, PAP and PolicyExplainer interfaces correctly.
 var (
-	_ authz.PDP = (*DisabledAuthorizer)(nil)
-	_ authz.PAP = (*DisabledAuthorizer)(nil)
+	_ authz.PDP             = (*DisabledAuthorizer)(nil)
+	_ authz.PAP             = (*DisabledAuthorizer)(nil)
+	_ authz.PolicyExplainer = (*DisabledAuthorizer)(nil)
 )
@@ -49,6 +49,37 @@ func TestDisabledAuthorizer_Evaluate(t *testing.T) {
 	}
 }
 
+// TestDisabledAuthorizer_Explain verifies that explanations always allow with no matching policies
+func TestDisabledAuthorizer_Explain(t *testing.T) {
+	ctx := context.Background()
+
+	request := authzcore.EvaluateRequest{
+		SubjectContext: &authzcore.SubjectContext{
+			Type:              "user",
+			EntitlementClaim:  "groups",
+			EntitlementValues: []string{"test"},
+		},
+		Resource: authzcore.Resource{Hierarchy: authzcore.ResourceHierarchy{Namespace: "org1"}},
+		Action:   "component:delete",
+	}
+
+	explanation, err := disabledAuthorizer.Explain(ctx, &request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !explanation.Decision || len(explanation.Policies) != 0 {
+		t.Errorf("expected an allow decision with no policies, got %+v", explanation)
+	}
+
+	explanation, err = disabledAuthorizer.Simulate(ctx, &authzcore.SimulateRequest{Request: request, IgnoreExisting: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !explanation.Decision {
+		t.Error("expected simulated decision to be true (access granted)")
+	}
+}
+
 // TestDisabledAuthorizer_BatchEvaluate verifies that all batch requests return true
 func TestDisabledAuthorizer_BatchEvaluate(t *testing.T) {
 	ctx := context.Background()
@@ -25,6 +25,8 @@ type Config struct {
 	CacheEnabled bool
 	// CacheTTL is the cache time-to-live duration.
 	CacheTTL time.Duration
+	// DecisionLog configures the in-memory log of authorization decisions.
+	DecisionLog casbin.DecisionLogConfig
 	// ResyncInterval is the interval for informer cache resync.
 	// This triggers re-listing of resources and OnUpdate callbacks for all objects.
 	// Set to 0 to disable periodic resync (watch events still work).
@@ -55,6 +57,7 @@ func Initialize(ctx context.Context, mgr ctrl.Manager, cfg Config, k8sClient cli
 		K8sClient:    k8sClient,
 		CacheEnabled: cfg.CacheEnabled,
 		CacheTTL:     cfg.CacheTTL,
+		DecisionLog:  cfg.DecisionLog,
 	}
 
 	casbinAuthz, err := casbin.NewEnforcer(ctx, casbinConfig, logger)
@@ -63,7 +66,7 @@ func Initialize(ctx context.Context, mgr ctrl.Manager, cfg Config, k8sClient cli
 	}
 
 	// Set up informer-based watchers to sync policies from K8s CRDs
-	if err := casbin.SetupAuthzWatchers(ctx, mgr, casbinAuthz.GetEnforcer(), logger); err != nil {
+	if err := casbin.SetupAuthzWatchers(ctx, mgr, casbinAuthz.GetEnforcer(), casbinAuthz.InvalidateDecisionCache, logger); err != nil {
 		return nil, nil, fmt.Errorf("failed to set up authz watchers: %w", err)
 	}
 
@@ -40,6 +40,7 @@ type Agent struct {
 	k8sClient  client.Client
 	k8sConfig  *rest.Config
 	router     *Router
+	audit      *AuditLog
 	mu         sync.Mutex
 	logger     *slog.Logger
 	stopChan   chan struct{}
@@ -49,6 +50,11 @@ type Agent struct {
 	// hubbleStreams tracks active hubble flow streaming sessions indexed by requestID
 	hubbleStreams   map[string]*hubbleSession
 	hubbleStreamsMu sync.Mutex
+	// protocolVersion is the tunnel protocol version negotiated with the gateway
+	protocolVersion int
+	// tunnelStreams tracks in-flight protocol v2 tunnel requests indexed by requestID
+	tunnelStreams   map[string]*tunnelStream
+	tunnelStreamsMu sync.Mutex
 }
 
 func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *slog.Logger) (*Agent, error) {
@@ -86,9 +92,24 @@ func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *s
 		logger.Info("TLS disabled, connecting without mTLS")
 	}
 
+	policy, err := NewRequestPolicy(cfg.Policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid agent policy: %w", err)
+	}
+
+	var audit *AuditLog
+	if cfg.AuditLogPath != "" {
+		audit, err = OpenAuditLog(cfg.AuditLogPath, cfg.PlaneID)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("audit logging enabled", "path", cfg.AuditLogPath)
+	}
+
 	// Create router for HTTP proxy support
-	router, err := NewRouter(k8sConfig, cfg.Routes, logger)
+	router, err := NewRouter(k8sConfig, cfg.Routes, policy, audit, logger)
 	if err != nil {
+		_ = audit.Close()
 		return nil, fmt.Errorf("failed to create router: %w", err)
 	}
 
@@ -99,10 +120,12 @@ func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *s
 		k8sClient:     k8sClient,
 		k8sConfig:     k8sConfig,
 		router:        router,
+		audit:         audit,
 		logger:        logger.With("component", "agent", "planeID", cfg.PlaneID),
 		stopChan:      make(chan struct{}),
 		activeStreams: make(map[string]*execSession),
 		hubbleStreams: make(map[string]*hubbleSession),
+		tunnelStreams: make(map[string]*tunnelStream),
 	}, nil
 }
 
@@ -112,6 +135,7 @@ func (a *Agent) Start(ctx context.Context) error {
 		"planeID", a.config.PlaneID,
 		"serverURL", a.config.ServerURL,
 	)
+	defer a.closeAuditLog()
 
 	for {
 		// Check for cancellation before attempting connection
@@ -181,8 +205,12 @@ func (a *Agent) connect() error {
 	query.Set("planeID", a.config.PlaneID)
 	u.RawQuery = query.Encode()
 
+	// Offer the streaming protocol first; gateways that predate it do not select
+	// a subprotocol and the connection falls back to the JSON-only protocol.
 	dialer := websocket.Dialer{
-		HandshakeTimeout: 10 * time.Second,
+		HandshakeTimeout:  10 * time.Second,
+		Subprotocols:      messaging.SupportedProtocols,
+		EnableCompression: true,
 	}
 
 	if a.config.TLSEnabled {
@@ -208,8 +236,9 @@ func (a *Agent) connect() error {
 	// No lock needed here - connect() is only called from the single-threaded Start() loop
 	// and no other goroutine accesses a.conn during connection establishment
 	a.conn = conn
+	a.protocolVersion = messaging.ProtocolVersion(conn.Subprotocol())
 
-	a.logger.Info("connected to control plane")
+	a.logger.Info("connected to control plane", "protocolVersion", a.protocolVersion)
 	return nil
 }
 
@@ -231,7 +260,7 @@ func (a *Agent) handleConnection(ctx context.Context) {
 
 	// Main message processing loop
 	for {
-		_, message, err := a.conn.ReadMessage()
+		messageType, message, err := a.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				a.logger.Error("websocket error", "error", err)
@@ -241,6 +270,13 @@ func (a *Agent) handleConnection(ctx context.Context) {
 			return
 		}
 
+		// Binary messages are protocol v2 frames (streamed tunnel requests,
+		// cancellation and flow-control window updates)
+		if messageType == websocket.BinaryMessage {
+			a.handleFrame(ctx, message)
+			continue
+		}
+
 		// Try to parse as stream init (exec / hubble requests)
 		var streamInit messaging.HTTPTunnelStreamInit
 		if err := json.Unmarshal(message, &streamInit); err == nil && streamInit.IsUpgrade && streamInit.RequestID != "" {
@@ -332,3 +368,9 @@ func (a *Agent) closeConnection() {
 		a.conn = nil
 	}
 }
+
+func (a *Agent) closeAuditLog() {
+	if err := a.audit.Close(); err != nil {
+		a.logger.Warn("failed to close audit log", "error", err)
+	}
+}
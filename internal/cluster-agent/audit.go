@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audit decisions recorded for tunneled requests. An allowed request is
+// followed by a second entry with the same request ID once the backend has
+// answered (completed, with the status code) or could not be reached (failed,
+// with the error).
+const (
+	auditDecisionAllow     = "allow"
+	auditDecisionDeny      = "deny"
+	auditDecisionCompleted = "completed"
+	auditDecisionFailed    = "failed"
+)
+
+// auditEntry is a single line of the agent's local audit log.
+type auditEntry struct {
+	Time             time.Time             `json:"time"`
+	RequestID        string                `json:"requestID"`
+	GatewayRequestID string                `json:"gatewayRequestID,omitempty"`
+	PlaneID          string                `json:"planeID,omitempty"`
+	Target           string                `json:"target"`
+	Method           string                `json:"method"`
+	Path             string                `json:"path"`
+	Query            string                `json:"query,omitempty"`
+	Attributes       *k8sRequestAttributes `json:"attributes,omitempty"`
+	Decision         string                `json:"decision"`
+	Reason           string                `json:"reason,omitempty"`
+	StatusCode       int                   `json:"statusCode,omitempty"`
+	Error            string                `json:"error,omitempty"`
+}
+
+// AuditLog writes one JSON line per tunneled request to an append-only file.
+// Entries are written synchronously so that a request is on disk before the
+// agent forwards it.
+type AuditLog struct {
+	planeID string
+	mu      sync.Mutex
+	w       io.WriteCloser
+}
+
+// OpenAuditLog opens (creating if needed) the audit log file at path in append-only mode.
+func OpenAuditLog(path, planeID string) (*AuditLog, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &AuditLog{planeID: planeID, w: f}, nil
+}
+
+// record appends entry to the log. A nil AuditLog discards entries.
+func (l *AuditLog) record(entry *auditEntry) error {
+	if l == nil {
+		return nil
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+	entry.PlaneID = l.planeID
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(data); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return nil
+}
+
+// Close closes the underlying file.
+func (l *AuditLog) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Close()
+}
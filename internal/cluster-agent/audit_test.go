@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditEntries(t *testing.T, path string) []auditEntry {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []auditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry auditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestAuditLog_AppendsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	log, err := OpenAuditLog(path, "plane-a")
+	require.NoError(t, err)
+	require.NoError(t, log.record(&auditEntry{RequestID: "req-1", Method: http.MethodGet, Decision: auditDecisionAllow}))
+	require.NoError(t, log.Close())
+
+	log, err = OpenAuditLog(path, "plane-a")
+	require.NoError(t, err)
+	require.NoError(t, log.record(&auditEntry{RequestID: "req-2", Method: http.MethodDelete, Decision: auditDecisionDeny, Reason: "nope"}))
+	require.NoError(t, log.Close())
+
+	entries := readAuditEntries(t, path)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "req-1", entries[0].RequestID)
+	assert.Equal(t, "plane-a", entries[0].PlaneID)
+	assert.False(t, entries[0].Time.IsZero())
+	assert.Equal(t, "req-2", entries[1].RequestID)
+	assert.Equal(t, auditDecisionDeny, entries[1].Decision)
+	assert.Equal(t, "nope", entries[1].Reason)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestAuditLog_NilDiscards(t *testing.T) {
+	var log *AuditLog
+	assert.NoError(t, log.record(&auditEntry{RequestID: "req-1"}))
+	assert.NoError(t, log.Close())
+}
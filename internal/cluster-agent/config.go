@@ -17,4 +17,6 @@ type Config struct {
 	HeartbeatInterval time.Duration
 	RequestTimeout    time.Duration
 	Routes            []RouteConfig // Backend service routes for HTTP proxy
+	Policy            PolicyConfig  // Local restrictions on tunneled Kubernetes API requests
+	AuditLogPath      string        // Append-only audit log of tunneled requests; empty disables auditing
 }
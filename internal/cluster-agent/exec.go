@@ -169,6 +169,12 @@ func (a *Agent) handleHTTPTunnelStreamInit(init *messaging.HTTPTunnelStreamInit)
 	logger := a.logger.With("requestID", init.RequestID, "path", init.Path)
 	logger.Info("Received exec stream init")
 
+	if reason := a.router.AdmitStream(init); reason != "" {
+		logger.Warn("Exec stream rejected", "reason", reason)
+		a.sendStreamClose(init.RequestID, reason)
+		return
+	}
+
 	podNamespace, podName, err := parseExecPath(init.Path)
 	if err != nil {
 		logger.Error("Failed to parse exec path", "error", err)
@@ -132,6 +132,12 @@ func (a *Agent) handleHubbleStreamInit(parentCtx context.Context, init *messagin
 	logger := a.logger.With("requestID", init.RequestID, "target", "hubble")
 	logger.Info("Received hubble stream init")
 
+	if reason := a.router.AdmitFlows(init); reason != "" {
+		logger.Warn("hubble stream rejected", "reason", reason)
+		a.sendStreamClose(init.RequestID, reason)
+		return
+	}
+
 	params, err := url.ParseQuery(init.Query)
 	if err != nil {
 		logger.Warn("invalid hubble query", "error", err, "query", init.Query)
@@ -218,6 +224,9 @@ func (a *Agent) handleHubbleStreamInit(parentCtx context.Context, init *messagin
 			break
 		}
 
+		if !a.router.policy.flowAllowed(resp.GetFlow(), scope) {
+			continue
+		}
 		if !redactFlowResponse(resp, scope) {
 			continue
 		}
@@ -214,7 +214,7 @@ func TestHubbleSession_CloseIsIdempotent(t *testing.T) {
 // hubbleStreams map initialized, which newTestAgent leaves nil.
 func newHubbleTestAgent(t *testing.T) (*Agent, *mockConnection) {
 	t.Helper()
-	agent := newTestAgent(t, "ws://unused", nil)
+	agent := newTestAgent(t, "ws://unused", newTestRouter(t, map[string]*Route{}))
 	mock := &mockConnection{}
 	agent.conn = mock
 	agent.hubbleStreams = make(map[string]*hubbleSession)
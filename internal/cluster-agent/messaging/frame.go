@@ -0,0 +1,233 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// WebSocket subprotocols negotiated between the cluster gateway and agent.
+// Peers that do not offer a subprotocol (older agents and gateways) speak
+// ProtocolV1, where every message is a JSON text frame and HTTP bodies are
+// carried inline as base64-encoded byte arrays.
+const (
+	// ProtocolV1 is the original JSON-only tunnel protocol.
+	ProtocolV1 = "tunnel.openchoreo.dev/v1"
+	// ProtocolV2 adds binary framing, chunked response streaming, per-stream
+	// flow control and cancellation. The legacy JSON exec/hubble stream
+	// messages are still exchanged as text frames on a v2 connection.
+	ProtocolV2 = "tunnel.openchoreo.dev/v2"
+)
+
+// SupportedProtocols lists the subprotocols offered during the websocket
+// handshake, in order of preference.
+var SupportedProtocols = []string{ProtocolV2, ProtocolV1}
+
+// ProtocolVersion maps a negotiated websocket subprotocol to a protocol version.
+// An empty or unknown subprotocol is treated as version 1.
+func ProtocolVersion(subprotocol string) int {
+	if subprotocol == ProtocolV2 {
+		return 2
+	}
+	return 1
+}
+
+const (
+	// DefaultStreamWindow is the number of body bytes the agent may send on a
+	// stream before it must wait for a window update from the gateway.
+	DefaultStreamWindow = 1 << 20
+	// DefaultChunkSize is the maximum body payload carried by a single data frame.
+	DefaultChunkSize = 64 << 10
+	// CompressionThreshold is the frame size above which per-message
+	// compression is enabled when permessage-deflate has been negotiated.
+	CompressionThreshold = 1 << 10
+)
+
+// FrameType identifies the kind of a v2 binary frame.
+type FrameType byte
+
+const (
+	// FrameRequest carries an HTTPTunnelRequest header followed by the raw request body.
+	FrameRequest FrameType = iota + 1
+	// FrameResponseHeader carries the status code and headers of an HTTPTunnelResponse.
+	FrameResponseHeader
+	// FrameData carries a chunk of the response body.
+	FrameData
+	// FrameEnd terminates a stream. The payload is an optional JSON ErrorDetails.
+	FrameEnd
+	// FrameCancel asks the agent to abort an in-flight stream.
+	FrameCancel
+	// FrameWindowUpdate grants the agent additional body bytes for a stream.
+	FrameWindowUpdate
+)
+
+func (t FrameType) String() string {
+	switch t {
+	case FrameRequest:
+		return "request"
+	case FrameResponseHeader:
+		return "response-header"
+	case FrameData:
+		return "data"
+	case FrameEnd:
+		return "end"
+	case FrameCancel:
+		return "cancel"
+	case FrameWindowUpdate:
+		return "window-update"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(t))
+	}
+}
+
+var (
+	ErrFrameTooShort = errors.New("frame too short")
+
+	ErrRequestIDTooLong = errors.New("request ID exceeds 255 bytes")
+)
+
+// Frame is a single v2 protocol message. Frames are sent as websocket binary
+// messages with the layout:
+//
+//	byte 0        frame type
+//	byte 1        length of the request ID (n)
+//	bytes 2..2+n  request ID
+//	remaining     type-specific payload
+type Frame struct {
+	Type      FrameType
+	RequestID string
+	Payload   []byte
+}
+
+// Encode serializes the frame into its binary wire representation.
+func (f *Frame) Encode() ([]byte, error) {
+	if len(f.RequestID) > 255 {
+		return nil, ErrRequestIDTooLong
+	}
+	buf := make([]byte, 0, 2+len(f.RequestID)+len(f.Payload))
+	buf = append(buf, byte(f.Type), byte(len(f.RequestID)))
+	buf = append(buf, f.RequestID...)
+	buf = append(buf, f.Payload...)
+	return buf, nil
+}
+
+// DecodeFrame parses a binary websocket message into a Frame.
+func DecodeFrame(data []byte) (*Frame, error) {
+	if len(data) < 2 {
+		return nil, ErrFrameTooShort
+	}
+	idLen := int(data[1])
+	if len(data) < 2+idLen {
+		return nil, ErrFrameTooShort
+	}
+	return &Frame{
+		Type:      FrameType(data[0]),
+		RequestID: string(data[2 : 2+idLen]),
+		Payload:   data[2+idLen:],
+	}, nil
+}
+
+// NewRequestFrame builds a FrameRequest. The request body is carried as raw
+// bytes after the JSON header instead of being base64-encoded inside it.
+// window is the initial number of response body bytes the agent may send.
+func NewRequestFrame(req *HTTPTunnelRequest, window int) (*Frame, error) {
+	header := *req
+	header.Body = nil
+	header.Window = window
+	headerData, err := json.Marshal(&header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request header: %w", err)
+	}
+	payload := make([]byte, 4, 4+len(headerData)+len(req.Body))
+	binary.BigEndian.PutUint32(payload, uint32(len(headerData))) //nolint:gosec // header size is bounded by message size
+	payload = append(payload, headerData...)
+	payload = append(payload, req.Body...)
+	return &Frame{Type: FrameRequest, RequestID: req.RequestID, Payload: payload}, nil
+}
+
+// ParseRequestFrame decodes the HTTPTunnelRequest carried by a FrameRequest.
+func ParseRequestFrame(f *Frame) (*HTTPTunnelRequest, error) {
+	if len(f.Payload) < 4 {
+		return nil, ErrFrameTooShort
+	}
+	headerLen := int(binary.BigEndian.Uint32(f.Payload))
+	if len(f.Payload) < 4+headerLen {
+		return nil, ErrFrameTooShort
+	}
+	var req HTTPTunnelRequest
+	if err := json.Unmarshal(f.Payload[4:4+headerLen], &req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request header: %w", err)
+	}
+	if body := f.Payload[4+headerLen:]; len(body) > 0 {
+		req.Body = body
+	}
+	req.RequestID = f.RequestID
+	return &req, nil
+}
+
+// NewResponseHeaderFrame builds a FrameResponseHeader from the status code and
+// headers of resp. The body, if any, must be sent separately as data frames.
+func NewResponseHeaderFrame(resp *HTTPTunnelResponse) (*Frame, error) {
+	header := *resp
+	header.Body = nil
+	data, err := json.Marshal(&header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response header: %w", err)
+	}
+	return &Frame{Type: FrameResponseHeader, RequestID: resp.RequestID, Payload: data}, nil
+}
+
+// ParseResponseHeaderFrame decodes the HTTPTunnelResponse header carried by a FrameResponseHeader.
+func ParseResponseHeaderFrame(f *Frame) (*HTTPTunnelResponse, error) {
+	var resp HTTPTunnelResponse
+	if err := json.Unmarshal(f.Payload, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response header: %w", err)
+	}
+	resp.RequestID = f.RequestID
+	return &resp, nil
+}
+
+// NewEndFrame builds a FrameEnd. A non-nil errDetails signals that the stream
+// was aborted after the response header had already been sent.
+func NewEndFrame(requestID string, errDetails *ErrorDetails) (*Frame, error) {
+	f := &Frame{Type: FrameEnd, RequestID: requestID}
+	if errDetails != nil {
+		data, err := json.Marshal(errDetails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal end frame error: %w", err)
+		}
+		f.Payload = data
+	}
+	return f, nil
+}
+
+// ParseEndFrame returns the error carried by a FrameEnd, or nil on a clean end.
+func ParseEndFrame(f *Frame) (*ErrorDetails, error) {
+	if len(f.Payload) == 0 {
+		return nil, nil
+	}
+	var details ErrorDetails
+	if err := json.Unmarshal(f.Payload, &details); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal end frame error: %w", err)
+	}
+	return &details, nil
+}
+
+// NewWindowUpdateFrame builds a FrameWindowUpdate granting increment more body bytes.
+func NewWindowUpdateFrame(requestID string, increment int) *Frame {
+	payload := make([]byte, 4)
+	binary.BigEndian.PutUint32(payload, uint32(increment)) //nolint:gosec // increments are bounded by chunk size
+	return &Frame{Type: FrameWindowUpdate, RequestID: requestID, Payload: payload}
+}
+
+// ParseWindowUpdateFrame returns the increment carried by a FrameWindowUpdate.
+func ParseWindowUpdateFrame(f *Frame) (int, error) {
+	if len(f.Payload) < 4 {
+		return 0, ErrFrameTooShort
+	}
+	return int(binary.BigEndian.Uint32(f.Payload)), nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolVersion(t *testing.T) {
+	assert.Equal(t, 2, ProtocolVersion(ProtocolV2))
+	assert.Equal(t, 1, ProtocolVersion(ProtocolV1))
+	assert.Equal(t, 1, ProtocolVersion(""))
+	assert.Equal(t, 1, ProtocolVersion("unknown"))
+}
+
+func TestFrame_EncodeDecode(t *testing.T) {
+	f := &Frame{Type: FrameData, RequestID: "req-1", Payload: []byte("hello")}
+
+	data, err := f.Encode()
+	require.NoError(t, err)
+
+	got, err := DecodeFrame(data)
+	require.NoError(t, err)
+	assert.Equal(t, FrameData, got.Type)
+	assert.Equal(t, "req-1", got.RequestID)
+	assert.Equal(t, []byte("hello"), got.Payload)
+}
+
+func TestFrame_EncodeRequestIDTooLong(t *testing.T) {
+	f := &Frame{Type: FrameData, RequestID: strings.Repeat("x", 256)}
+
+	_, err := f.Encode()
+	assert.ErrorIs(t, err, ErrRequestIDTooLong)
+}
+
+func TestDecodeFrame_TooShort(t *testing.T) {
+	_, err := DecodeFrame([]byte{byte(FrameData)})
+	assert.ErrorIs(t, err, ErrFrameTooShort)
+
+	// Request ID length exceeds the remaining bytes
+	_, err = DecodeFrame([]byte{byte(FrameData), 5, 'a'})
+	assert.ErrorIs(t, err, ErrFrameTooShort)
+}
+
+func TestRequestFrame_RoundTrip(t *testing.T) {
+	req := NewHTTPTunnelRequest("k8s", http.MethodPost, "/api/v1/pods", "watch=false",
+		map[string][]string{"Content-Type": {"application/json"}}, []byte(`{"kind":"Pod"}`))
+	req.RequestID = "req-2"
+	req.GatewayRequestID = "gw-1"
+
+	f, err := NewRequestFrame(req, 4096)
+	require.NoError(t, err)
+	assert.Equal(t, FrameRequest, f.Type)
+	// The body is carried raw, not base64-encoded in the JSON header
+	assert.Contains(t, string(f.Payload), `{"kind":"Pod"}`)
+
+	got, err := ParseRequestFrame(f)
+	require.NoError(t, err)
+	assert.Equal(t, "req-2", got.RequestID)
+	assert.Equal(t, "gw-1", got.GatewayRequestID)
+	assert.Equal(t, req.Target, got.Target)
+	assert.Equal(t, req.Method, got.Method)
+	assert.Equal(t, req.Path, got.Path)
+	assert.Equal(t, req.Query, got.Query)
+	assert.Equal(t, req.Headers, got.Headers)
+	assert.Equal(t, req.Body, got.Body)
+	assert.Equal(t, 4096, got.Window)
+}
+
+func TestRequestFrame_NoBody(t *testing.T) {
+	req := NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil)
+	req.RequestID = "req-3"
+
+	f, err := NewRequestFrame(req, DefaultStreamWindow)
+	require.NoError(t, err)
+
+	got, err := ParseRequestFrame(f)
+	require.NoError(t, err)
+	assert.Nil(t, got.Body)
+}
+
+func TestParseRequestFrame_Truncated(t *testing.T) {
+	_, err := ParseRequestFrame(&Frame{Type: FrameRequest, Payload: []byte{0, 0}})
+	assert.ErrorIs(t, err, ErrFrameTooShort)
+
+	_, err = ParseRequestFrame(&Frame{Type: FrameRequest, Payload: []byte{0, 0, 0, 10, '{'}})
+	assert.ErrorIs(t, err, ErrFrameTooShort)
+}
+
+func TestResponseHeaderFrame_RoundTrip(t *testing.T) {
+	resp := NewHTTPTunnelSuccessResponse(&HTTPTunnelRequest{RequestID: "req-4"}, http.StatusOK,
+		map[string][]string{"Content-Type": {"application/json"}}, []byte("ignored"))
+
+	f, err := NewResponseHeaderFrame(resp)
+	require.NoError(t, err)
+
+	got, err := ParseResponseHeaderFrame(f)
+	require.NoError(t, err)
+	assert.Equal(t, "req-4", got.RequestID)
+	assert.Equal(t, http.StatusOK, got.StatusCode)
+	assert.Equal(t, resp.Headers, got.Headers)
+	assert.Nil(t, got.Body, "body must be sent as data frames")
+}
+
+func TestEndFrame_RoundTrip(t *testing.T) {
+	f, err := NewEndFrame("req-5", nil)
+	require.NoError(t, err)
+	details, err := ParseEndFrame(f)
+	require.NoError(t, err)
+	assert.Nil(t, details)
+
+	f, err = NewEndFrame("req-5", &ErrorDetails{Code: http.StatusBadGateway, Message: "boom"})
+	require.NoError(t, err)
+	details, err = ParseEndFrame(f)
+	require.NoError(t, err)
+	require.NotNil(t, details)
+	assert.Equal(t, http.StatusBadGateway, details.Code)
+	assert.Equal(t, "boom", details.Message)
+}
+
+func TestWindowUpdateFrame_RoundTrip(t *testing.T) {
+	f := NewWindowUpdateFrame("req-6", 65536)
+
+	got, err := ParseWindowUpdateFrame(f)
+	require.NoError(t, err)
+	assert.Equal(t, 65536, got)
+
+	_, err = ParseWindowUpdateFrame(&Frame{Type: FrameWindowUpdate})
+	assert.ErrorIs(t, err, ErrFrameTooShort)
+}
+
+func TestFrameType_String(t *testing.T) {
+	assert.Equal(t, "request", FrameRequest.String())
+	assert.Equal(t, "window-update", FrameWindowUpdate.String())
+	assert.Equal(t, "unknown(99)", FrameType(99).String())
+}
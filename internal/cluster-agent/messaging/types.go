@@ -37,6 +37,8 @@ type HTTPTunnelRequest struct {
 	Query   string              `json:"query,omitempty"`
 	Headers map[string][]string `json:"headers,omitempty"`
 	Body    []byte              `json:"body,omitempty"`
+	// Window is the initial response flow-control window in bytes (protocol v2 only)
+	Window int `json:"window,omitempty"`
 }
 
 // HTTPTunnelResponse represents an HTTP response from the data plane backend service
@@ -0,0 +1,318 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/flow"
+)
+
+// Kubernetes API verbs used by the agent request policy.
+const (
+	verbGet              = "get"
+	verbList             = "list"
+	verbWatch            = "watch"
+	verbCreate           = "create"
+	verbUpdate           = "update"
+	verbPatch            = "patch"
+	verbDelete           = "delete"
+	verbDeleteCollection = "deletecollection"
+)
+
+// coreAPIGroup is the name used in policy configuration for the Kubernetes
+// core ("") API group.
+const coreAPIGroup = "core"
+
+// execSubresources are pod subresources that give interactive access to a
+// container and are governed by PolicyConfig.ExecEnabled.
+var execSubresources = []string{"exec", "attach", "portforward"}
+
+// nodeProxySubresource reaches the kubelet API, whose exec, attach and
+// port-forward endpoints bypass the pod subresources; it is governed by
+// PolicyConfig.ExecEnabled as well.
+const nodeProxySubresource = "proxy"
+
+// namespaceSubresources are subresources of the Namespace object itself.
+var namespaceSubresources = []string{"status", "finalize"}
+
+// clusterScopedResources lists well-known cluster-scoped resources by API group.
+// The agent has no discovery information, so when namespaces are restricted any
+// other resource requested without a namespace is treated as a namespaced list
+// across all namespaces and denied.
+var clusterScopedResources = map[string][]string{
+	"": {
+		"namespaces", "nodes", "persistentvolumes", "componentstatuses",
+	},
+	"rbac.authorization.k8s.io": {"clusterroles", "clusterrolebindings"},
+	"apiextensions.k8s.io":      {"customresourcedefinitions"},
+	"apiregistration.k8s.io":    {"apiservices"},
+	"storage.k8s.io":            {"storageclasses", "csidrivers", "csinodes", "volumeattachments"},
+	"scheduling.k8s.io":         {"priorityclasses"},
+	"node.k8s.io":               {"runtimeclasses"},
+	"networking.k8s.io":         {"ingressclasses"},
+	"admissionregistration.k8s.io": {
+		"mutatingwebhookconfigurations", "validatingwebhookconfigurations",
+		"validatingadmissionpolicies", "validatingadmissionpolicybindings",
+	},
+	"certificates.k8s.io":          {"certificatesigningrequests"},
+	"authentication.k8s.io":        {"tokenreviews", "selfsubjectreviews"},
+	"authorization.k8s.io":         {"subjectaccessreviews", "selfsubjectaccessreviews", "selfsubjectrulesreviews"},
+	"flowcontrol.apiserver.k8s.io": {"flowschemas", "prioritylevelconfigurations"},
+}
+
+// PolicyConfig restricts which Kubernetes API requests the agent forwards for
+// the gateway. It gives data plane owners a local veto that holds even if the
+// control plane is compromised. An empty allowlist imposes no restriction on
+// that dimension.
+type PolicyConfig struct {
+	// AllowedAPIGroups lists the API groups that may be accessed. Use "core" for the core group.
+	AllowedAPIGroups []string
+	// AllowedResources lists the resources that may be accessed, e.g. "pods" or "pods/log".
+	// A bare resource name also permits its subresources, except exec-style subresources.
+	AllowedResources []string
+	// AllowedNamespaces lists namespace patterns (path.Match syntax, e.g. "dp-*") that may be
+	// accessed. When set, requests without a namespace are only permitted for well-known
+	// cluster-scoped resources; lists and watches of namespaced resources across all
+	// namespaces are denied.
+	AllowedNamespaces []string
+	// AllowedVerbs lists the Kubernetes verbs that may be used, e.g. "get", "list", "watch".
+	AllowedVerbs []string
+	// ExecEnabled allows pod exec, attach and port-forward through the tunnel, and
+	// nodes/proxy, which reaches the same operations on the kubelet.
+	ExecEnabled bool
+}
+
+// k8sRequestAttributes describes a Kubernetes API request in RBAC terms.
+type k8sRequestAttributes struct {
+	APIGroup    string `json:"apiGroup"`
+	Version     string `json:"version,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Verb        string `json:"verb"`
+	// NonResource is set for paths outside /api and /apis, such as /version or /healthz
+	NonResource bool `json:"nonResource,omitempty"`
+}
+
+// parseK8sRequest derives the RBAC attributes of a Kubernetes API request from its
+// method, path and query, following the URL layout of the API server:
+//
+//	/api/{version}/[namespaces/{ns}/]{resource}[/{name}[/{subresource}]]
+//	/apis/{group}/{version}/[namespaces/{ns}/]{resource}[/{name}[/{subresource}]]
+func parseK8sRequest(method, reqPath, rawQuery string) k8sRequestAttributes {
+	parts := strings.Split(strings.Trim(reqPath, "/"), "/")
+
+	var attrs k8sRequestAttributes
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		attrs.Version = parts[1]
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		attrs.APIGroup = parts[1]
+		attrs.Version = parts[2]
+		parts = parts[3:]
+	default:
+		attrs.NonResource = true
+		attrs.Verb = strings.ToLower(method)
+		return attrs
+	}
+
+	// Mirrors the API server's RequestInfoFactory: /namespaces/{ns} and its
+	// status/finalize subresources address the Namespace object itself.
+	if len(parts) >= 2 && parts[0] == "namespaces" {
+		attrs.Namespace = parts[1]
+		if len(parts) > 2 && !slices.Contains(namespaceSubresources, parts[2]) {
+			parts = parts[2:]
+		}
+	}
+
+	if len(parts) > 0 {
+		attrs.Resource = parts[0]
+	}
+	if len(parts) > 1 {
+		attrs.Name = parts[1]
+	}
+	if len(parts) > 2 {
+		attrs.Subresource = parts[2]
+	}
+
+	attrs.Verb = k8sVerb(method, attrs.Name != "", rawQuery)
+	return attrs
+}
+
+func k8sVerb(method string, hasName bool, rawQuery string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		if q, err := url.ParseQuery(rawQuery); err == nil && isWatch(q.Get("watch")) {
+			return verbWatch
+		}
+		if hasName {
+			return verbGet
+		}
+		return verbList
+	case http.MethodPost:
+		return verbCreate
+	case http.MethodPut:
+		return verbUpdate
+	case http.MethodPatch:
+		return verbPatch
+	case http.MethodDelete:
+		if hasName {
+			return verbDelete
+		}
+		return verbDeleteCollection
+	default:
+		return strings.ToLower(method)
+	}
+}
+
+// isWatch reports whether the watch query parameter asks for a watch. The API
+// server accepts any value strconv.ParseBool takes as true.
+func isWatch(value string) bool {
+	watch, err := strconv.ParseBool(value)
+	return err == nil && watch
+}
+
+// isExec reports whether the request opens an interactive session in a pod,
+// either through the pod subresources or through the kubelet via nodes/proxy.
+func (a k8sRequestAttributes) isExec() bool {
+	if a.APIGroup != "" {
+		return false
+	}
+	switch a.Resource {
+	case "pods":
+		return slices.Contains(execSubresources, a.Subresource)
+	case "nodes":
+		return a.Subresource == nodeProxySubresource
+	}
+	return false
+}
+
+// isClusterScoped reports whether the request addresses a well-known cluster-scoped resource.
+func (a k8sRequestAttributes) isClusterScoped() bool {
+	return slices.Contains(clusterScopedResources[a.APIGroup], a.Resource)
+}
+
+// RequestPolicy evaluates tunneled Kubernetes API requests against a PolicyConfig.
+type RequestPolicy struct {
+	config PolicyConfig
+}
+
+// NewRequestPolicy validates cfg and returns a policy that enforces it.
+func NewRequestPolicy(cfg PolicyConfig) (*RequestPolicy, error) {
+	for _, ns := range cfg.AllowedNamespaces {
+		if _, err := path.Match(ns, ""); err != nil {
+			return nil, fmt.Errorf("invalid allowed namespace pattern %q: %w", ns, err)
+		}
+	}
+	return &RequestPolicy{config: cfg}, nil
+}
+
+// Authorize returns a non-empty reason when the request is not permitted.
+func (p *RequestPolicy) Authorize(attrs k8sRequestAttributes) string {
+	if p == nil {
+		return ""
+	}
+	cfg := p.config
+
+	if attrs.isExec() && !cfg.ExecEnabled {
+		return fmt.Sprintf("%s/%s is disabled on this agent", attrs.Resource, attrs.Subresource)
+	}
+
+	if len(cfg.AllowedVerbs) > 0 && !slices.Contains(cfg.AllowedVerbs, attrs.Verb) {
+		return fmt.Sprintf("verb %q is not allowed", attrs.Verb)
+	}
+
+	// Non-resource paths (discovery, /version) are gated by verb only
+	if attrs.NonResource {
+		return ""
+	}
+
+	group := attrs.APIGroup
+	if group == "" {
+		group = coreAPIGroup
+	}
+	if len(cfg.AllowedAPIGroups) > 0 && !slices.Contains(cfg.AllowedAPIGroups, group) {
+		return fmt.Sprintf("API group %q is not allowed", group)
+	}
+
+	// Discovery requests for a group version (no resource) are permitted once the group is
+	if attrs.Resource == "" {
+		return ""
+	}
+
+	if len(cfg.AllowedResources) > 0 && !p.resourceAllowed(attrs) {
+		resource := attrs.Resource
+		if attrs.Subresource != "" {
+			resource += "/" + attrs.Subresource
+		}
+		return fmt.Sprintf("resource %q is not allowed", resource)
+	}
+
+	if len(cfg.AllowedNamespaces) > 0 {
+		if attrs.Namespace == "" && !attrs.isClusterScoped() {
+			return fmt.Sprintf("resource %q must be requested within an allowed namespace", attrs.Resource)
+		}
+		if attrs.Namespace != "" && !p.namespaceAllowed(attrs.Namespace) {
+			return fmt.Sprintf("namespace %q is not allowed", attrs.Namespace)
+		}
+	}
+
+	return ""
+}
+
+// AuthorizeFlows returns a non-empty reason when Hubble flow streams are not
+// permitted. A flow stream follows live traffic, so it needs the watch verb.
+func (p *RequestPolicy) AuthorizeFlows() string {
+	if p == nil {
+		return ""
+	}
+	if len(p.config.AllowedVerbs) > 0 && !slices.Contains(p.config.AllowedVerbs, verbWatch) {
+		return fmt.Sprintf("verb %q is not allowed", verbWatch)
+	}
+	return ""
+}
+
+// flowAllowed reports whether a Hubble flow may be forwarded. When namespaces are
+// restricted, the endpoints of the flow that match the requested scope must live
+// in an allowed namespace; peers outside the scope are redacted instead.
+func (p *RequestPolicy) flowAllowed(f *flow.Flow, scope wirelogsScope) bool {
+	if p == nil || f == nil || len(p.config.AllowedNamespaces) == 0 {
+		return true
+	}
+	for _, ep := range []*flow.Endpoint{f.GetSource(), f.GetDestination()} {
+		if endpointInScope(ep, scope) && !p.namespaceAllowed(ep.GetNamespace()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *RequestPolicy) resourceAllowed(attrs k8sRequestAttributes) bool {
+	if attrs.Subresource == "" {
+		return slices.Contains(p.config.AllowedResources, attrs.Resource)
+	}
+	if slices.Contains(p.config.AllowedResources, attrs.Resource+"/"+attrs.Subresource) {
+		return true
+	}
+	// Exec-style subresources must be listed explicitly
+	return !attrs.isExec() && slices.Contains(p.config.AllowedResources, attrs.Resource)
+}
+
+func (p *RequestPolicy) namespaceAllowed(namespace string) bool {
+	for _, pattern := range p.config.AllowedNamespaces {
+		if ok, _ := path.Match(pattern, namespace); ok {
+			return true
+		}
+	}
+	return false
+}
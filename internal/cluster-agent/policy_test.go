@@ -0,0 +1,242 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseK8sRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		query  string
+		want   k8sRequestAttributes
+	}{
+		{
+			name:   "core namespaced list",
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/dp-a/pods",
+			want:   k8sRequestAttributes{Version: "v1", Namespace: "dp-a", Resource: "pods", Verb: verbList},
+		},
+		{
+			name:   "core namespaced get subresource",
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/dp-a/pods/web-1/log",
+			want: k8sRequestAttributes{
+				Version: "v1", Namespace: "dp-a", Resource: "pods", Name: "web-1", Subresource: "log", Verb: verbGet,
+			},
+		},
+		{
+			name:   "group resource watch",
+			method: http.MethodGet,
+			path:   "/apis/apps/v1/namespaces/dp-a/deployments",
+			query:  "watch=true&resourceVersion=10",
+			want: k8sRequestAttributes{
+				APIGroup: "apps", Version: "v1", Namespace: "dp-a", Resource: "deployments", Verb: verbWatch,
+			},
+		},
+		{
+			name:   "watch given as a number",
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/dp-a/pods",
+			query:  "watch=1",
+			want: k8sRequestAttributes{
+				Version: "v1", Namespace: "dp-a", Resource: "pods", Verb: verbWatch,
+			},
+		},
+		{
+			name:   "cluster scoped create",
+			method: http.MethodPost,
+			path:   "/apis/rbac.authorization.k8s.io/v1/clusterroles",
+			want: k8sRequestAttributes{
+				APIGroup: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles", Verb: verbCreate,
+			},
+		},
+		{
+			name:   "namespace object",
+			method: http.MethodDelete,
+			path:   "/api/v1/namespaces/dp-a",
+			want:   k8sRequestAttributes{Version: "v1", Namespace: "dp-a", Resource: "namespaces", Name: "dp-a", Verb: verbDelete},
+		},
+		{
+			name:   "namespace status subresource",
+			method: http.MethodPut,
+			path:   "/api/v1/namespaces/dp-a/status",
+			want: k8sRequestAttributes{
+				Version: "v1", Namespace: "dp-a", Resource: "namespaces", Name: "dp-a", Subresource: "status", Verb: verbUpdate,
+			},
+		},
+		{
+			name:   "delete collection",
+			method: http.MethodDelete,
+			path:   "/api/v1/namespaces/dp-a/configmaps",
+			want:   k8sRequestAttributes{Version: "v1", Namespace: "dp-a", Resource: "configmaps", Verb: verbDeleteCollection},
+		},
+		{
+			name:   "patch",
+			method: http.MethodPatch,
+			path:   "/apis/apps/v1/namespaces/dp-a/deployments/web",
+			want: k8sRequestAttributes{
+				APIGroup: "apps", Version: "v1", Namespace: "dp-a", Resource: "deployments", Name: "web", Verb: verbPatch,
+			},
+		},
+		{
+			name:   "group discovery",
+			method: http.MethodGet,
+			path:   "/apis/apps/v1",
+			want:   k8sRequestAttributes{APIGroup: "apps", Version: "v1", Verb: verbList},
+		},
+		{
+			name:   "non resource",
+			method: http.MethodGet,
+			path:   "/version",
+			want:   k8sRequestAttributes{NonResource: true, Verb: "get"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseK8sRequest(tt.method, tt.path, tt.query))
+		})
+	}
+}
+
+func TestNewRequestPolicy_InvalidNamespacePattern(t *testing.T) {
+	_, err := NewRequestPolicy(PolicyConfig{AllowedNamespaces: []string{"dp-["}})
+	require.Error(t, err)
+}
+
+func TestRequestPolicy_Authorize(t *testing.T) {
+	policy, err := NewRequestPolicy(PolicyConfig{
+		AllowedAPIGroups:  []string{"core", "apps"},
+		AllowedResources:  []string{"pods", "deployments", "namespaces", "pods/exec"},
+		AllowedNamespaces: []string{"dp-*"},
+		AllowedVerbs:      []string{verbGet, verbList, verbWatch, verbCreate},
+		ExecEnabled:       true,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		query      string
+		wantReason string
+	}{
+		{name: "allowed list", method: http.MethodGet, path: "/api/v1/namespaces/dp-a/pods"},
+		{name: "allowed group resource", method: http.MethodGet, path: "/apis/apps/v1/namespaces/dp-a/deployments/web"},
+		{name: "bare resource permits log subresource", method: http.MethodGet, path: "/api/v1/namespaces/dp-a/pods/web/log"},
+		{name: "explicit exec subresource", method: http.MethodPost, path: "/api/v1/namespaces/dp-a/pods/web/exec"},
+		{name: "cluster scoped list", method: http.MethodGet, path: "/api/v1/namespaces"},
+		{name: "group discovery", method: http.MethodGet, path: "/apis/apps/v1"},
+		{name: "non resource", method: http.MethodGet, path: "/version"},
+		{
+			name: "verb denied", method: http.MethodDelete, path: "/api/v1/namespaces/dp-a/pods/web",
+			wantReason: `verb "delete" is not allowed`,
+		},
+		{
+			name: "group denied", method: http.MethodGet, path: "/apis/rbac.authorization.k8s.io/v1/clusterroles",
+			wantReason: `API group "rbac.authorization.k8s.io" is not allowed`,
+		},
+		{
+			name: "resource denied", method: http.MethodGet, path: "/api/v1/namespaces/dp-a/secrets",
+			wantReason: `resource "secrets" is not allowed`,
+		},
+		{
+			name: "namespace denied", method: http.MethodGet, path: "/api/v1/namespaces/kube-system/pods",
+			wantReason: `namespace "kube-system" is not allowed`,
+		},
+		{
+			name: "namespaced list across all namespaces", method: http.MethodGet, path: "/api/v1/pods",
+			wantReason: `resource "pods" must be requested within an allowed namespace`,
+		},
+		{
+			name: "namespaced watch across all namespaces", method: http.MethodGet, path: "/apis/apps/v1/deployments", query: "watch=true",
+			wantReason: `resource "deployments" must be requested within an allowed namespace`,
+		},
+		{
+			name: "namespace object denied", method: http.MethodGet, path: "/api/v1/namespaces/kube-system",
+			wantReason: `namespace "kube-system" is not allowed`,
+		},
+		{
+			name: "attach needs explicit entry", method: http.MethodPost, path: "/api/v1/namespaces/dp-a/pods/web/attach",
+			wantReason: `resource "pods/attach" is not allowed`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.Authorize(parseK8sRequest(tt.method, tt.path, tt.query))
+			assert.Equal(t, tt.wantReason, got)
+		})
+	}
+}
+
+func TestRequestPolicy_ExecDisabled(t *testing.T) {
+	policy, err := NewRequestPolicy(PolicyConfig{ExecEnabled: false})
+	require.NoError(t, err)
+
+	reason := policy.Authorize(parseK8sRequest(http.MethodPost, "/api/v1/namespaces/dp-a/pods/web/exec", ""))
+	assert.Equal(t, "pods/exec is disabled on this agent", reason)
+
+	reason = policy.Authorize(parseK8sRequest(http.MethodPost, "/api/v1/namespaces/dp-a/pods/web/portforward", ""))
+	assert.Equal(t, "pods/portforward is disabled on this agent", reason)
+
+	// The kubelet exec endpoint is reachable through nodes/proxy
+	reason = policy.Authorize(parseK8sRequest(http.MethodPost, "/api/v1/nodes/node-1/proxy/exec/dp-a/web/app", ""))
+	assert.Equal(t, "nodes/proxy is disabled on this agent", reason)
+
+	// Everything else is allowed with empty allowlists
+	assert.Empty(t, policy.Authorize(parseK8sRequest(http.MethodDelete, "/api/v1/namespaces/dp-a/pods/web", "")))
+}
+
+func TestRequestPolicy_NilAllowsAll(t *testing.T) {
+	var policy *RequestPolicy
+	assert.Empty(t, policy.Authorize(parseK8sRequest(http.MethodPost, "/api/v1/namespaces/dp-a/pods/web/exec", "")))
+}
+
+func TestRequestPolicy_NodeProxyDeniedWithNamespaceRestriction(t *testing.T) {
+	policy, err := NewRequestPolicy(PolicyConfig{AllowedNamespaces: []string{"dp-*"}})
+	require.NoError(t, err)
+
+	assert.Empty(t, policy.Authorize(parseK8sRequest(http.MethodGet, "/api/v1/nodes", "")))
+	assert.Equal(t, "nodes/proxy is disabled on this agent",
+		policy.Authorize(parseK8sRequest(http.MethodGet, "/api/v1/nodes/node-1/proxy/pods", "")))
+}
+
+func TestRequestPolicy_AuthorizeFlows(t *testing.T) {
+	policy, err := NewRequestPolicy(PolicyConfig{AllowedVerbs: []string{verbGet, verbList}})
+	require.NoError(t, err)
+	assert.Equal(t, `verb "watch" is not allowed`, policy.AuthorizeFlows())
+
+	policy, err = NewRequestPolicy(PolicyConfig{AllowedVerbs: []string{verbWatch}})
+	require.NoError(t, err)
+	assert.Empty(t, policy.AuthorizeFlows())
+}
+
+func TestRequestPolicy_FlowAllowed(t *testing.T) {
+	scope := wirelogsScope{namespace: "team-1", environment: "development"}
+	scoped := []string{"k8s:openchoreo.dev/namespace=team-1", "k8s:openchoreo.dev/environment=development"}
+	flowIn := func(namespace string) *flow.Flow {
+		return &flow.Flow{
+			Source:      &flow.Endpoint{Namespace: namespace, Labels: scoped},
+			Destination: &flow.Endpoint{Namespace: "kube-system"},
+		}
+	}
+
+	policy, err := NewRequestPolicy(PolicyConfig{AllowedNamespaces: []string{"dp-*"}})
+	require.NoError(t, err)
+	assert.True(t, policy.flowAllowed(flowIn("dp-team-1"), scope), "out-of-scope peers do not count")
+	assert.False(t, policy.flowAllowed(flowIn("other"), scope))
+
+	var unrestricted *RequestPolicy
+	assert.True(t, unrestricted.flowAllowed(flowIn("other"), scope))
+}
@@ -5,6 +5,7 @@ package clusteragent
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -51,14 +52,27 @@ type Route struct {
 type Router struct {
 	routes    map[string]*Route
 	k8sConfig *rest.Config
-	logger    *slog.Logger
+	// policy restricts the Kubernetes API requests that are forwarded; nil allows all
+	policy *RequestPolicy
+	// audit records every tunneled request before it is forwarded; nil disables auditing
+	audit  *AuditLog
+	logger *slog.Logger
 }
 
-// NewRouter creates a new router with configured routes
-func NewRouter(k8sConfig *rest.Config, routeConfigs []RouteConfig, logger *slog.Logger) (*Router, error) {
+// NewRouter creates a new router with configured routes. Requests to the Kubernetes
+// API are checked against policy and every request is recorded in audit; either may be nil.
+func NewRouter(
+	k8sConfig *rest.Config,
+	routeConfigs []RouteConfig,
+	policy *RequestPolicy,
+	audit *AuditLog,
+	logger *slog.Logger,
+) (*Router, error) {
 	router := &Router{
 		routes:    make(map[string]*Route),
 		k8sConfig: k8sConfig,
+		policy:    policy,
+		audit:     audit,
 		logger:    logger.With("component", "router"),
 	}
 
@@ -90,10 +104,40 @@ func NewRouter(k8sConfig *rest.Config, routeConfigs []RouteConfig, logger *slog.
 
 // Route routes an HTTP tunnel request to the appropriate backend service
 func (r *Router) Route(req *messaging.HTTPTunnelRequest) *messaging.HTTPTunnelResponse {
-	logger := r.logger
-	if req.GatewayRequestID != "" {
-		logger = r.logger.With("requestId", req.GatewayRequestID)
+	resp, errResp := r.RouteStream(context.Background(), req)
+	if errResp != nil {
+		return errResp
 	}
+	defer resp.Body.Close()
+
+	logger := r.requestLogger(req)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logger.Error("failed to read response body",
+			"target", req.Target,
+			"error", err,
+		)
+		return messaging.NewHTTPTunnelErrorResponse(req, http.StatusBadGateway,
+			fmt.Sprintf("failed to read response: %v", err))
+	}
+
+	logger.Info("agent request completed",
+		"target", req.Target,
+		"statusCode", resp.StatusCode,
+		"bodySize", len(body),
+	)
+
+	return messaging.NewHTTPTunnelSuccessResponse(req, resp.StatusCode, resp.Header, body)
+}
+
+// RouteStream routes an HTTP tunnel request to the appropriate backend service and
+// returns the live backend response so that the caller can stream its body. The
+// caller must close the response body. If the request cannot be routed, a nil
+// response and an error HTTPTunnelResponse are returned instead.
+// Canceling ctx aborts the backend request.
+func (r *Router) RouteStream(ctx context.Context, req *messaging.HTTPTunnelRequest) (*http.Response, *messaging.HTTPTunnelResponse) {
+	logger := r.requestLogger(req)
 
 	route, exists := r.routes[req.Target]
 	if !exists {
@@ -101,8 +145,18 @@ func (r *Router) Route(req *messaging.HTTPTunnelRequest) *messaging.HTTPTunnelRe
 			"target", req.Target,
 			"availableTargets", r.getAvailableTargets(),
 		)
-		return messaging.NewHTTPTunnelErrorResponse(req, http.StatusNotFound,
-			fmt.Sprintf("unknown target: %s", req.Target))
+	}
+
+	entry := &auditEntry{
+		RequestID:        req.RequestID,
+		GatewayRequestID: req.GatewayRequestID,
+		Target:           req.Target,
+		Method:           req.Method,
+		Path:             req.Path,
+		Query:            req.Query,
+	}
+	if status, reason := r.admit(route, entry); reason != "" {
+		return nil, messaging.NewHTTPTunnelErrorResponse(req, status, reason)
 	}
 
 	fullPath := req.Path
@@ -120,13 +174,16 @@ func (r *Router) Route(req *messaging.HTTPTunnelRequest) *messaging.HTTPTunnelRe
 	)
 
 	// Create HTTP request
-	httpReq, err := http.NewRequest(req.Method, targetURL, bytes.NewReader(req.Body))
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, targetURL, bytes.NewReader(req.Body))
 	if err != nil {
-		return messaging.NewHTTPTunnelErrorResponse(req, http.StatusInternalServerError,
+		return nil, messaging.NewHTTPTunnelErrorResponse(req, http.StatusInternalServerError,
 			fmt.Sprintf("failed to create request: %v", err))
 	}
 
 	httpReq.Header = req.Headers
+	if httpReq.Header == nil {
+		httpReq.Header = make(http.Header)
+	}
 
 	// Strip any client-supplied Authorization header for k8s requests. The
 	// transport returned by rest.TransportFor only injects the agent's
@@ -139,34 +196,126 @@ func (r *Router) Route(req *messaging.HTTPTunnelRequest) *messaging.HTTPTunnelRe
 	route.applyAuth(httpReq)
 
 	resp, err := route.Transport.RoundTrip(httpReq)
+	r.recordOutcome(entry, resp, err)
 	if err != nil {
 		logger.Error("backend request failed",
 			"target", req.Target,
 			"url", targetURL,
 			"error", err,
 		)
-		return messaging.NewHTTPTunnelErrorResponse(req, http.StatusBadGateway,
+		return nil, messaging.NewHTTPTunnelErrorResponse(req, http.StatusBadGateway,
 			fmt.Sprintf("backend request failed: %v", err))
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logger.Error("failed to read response body",
-			"target", req.Target,
-			"error", err,
+	return resp, nil
+}
+
+// AdmitStream checks a streaming request (pod exec) against the agent's local policy
+// and records it in the audit log. It returns a non-empty reason when the stream
+// must not be opened.
+func (r *Router) AdmitStream(init *messaging.HTTPTunnelStreamInit) string {
+	_, reason := r.admit(r.routes[init.Target], &auditEntry{
+		RequestID: init.RequestID,
+		Target:    init.Target,
+		Method:    init.Method,
+		Path:      init.Path,
+		Query:     init.Query,
+	})
+	return reason
+}
+
+// AdmitFlows checks a Hubble flow stream against the agent's local policy and
+// records it in the audit log. It returns a non-empty reason when the stream
+// must not be opened.
+func (r *Router) AdmitFlows(init *messaging.HTTPTunnelStreamInit) string {
+	entry := &auditEntry{
+		RequestID: init.RequestID,
+		Target:    init.Target,
+		Method:    init.Method,
+		Path:      init.Path,
+		Query:     init.Query,
+	}
+	if reason := r.policy.AuthorizeFlows(); reason != "" {
+		_, reason = r.decide(entry, http.StatusForbidden, "denied by agent policy: "+reason)
+		return reason
+	}
+	_, reason := r.decide(entry, http.StatusOK, "")
+	return reason
+}
+
+// admit checks a request for route against the agent's local policy and records the
+// decision in the audit log before anything is forwarded. A nil route stands for an
+// unknown target. It returns a non-empty reason, with the HTTP status to respond
+// with, when the request must not be forwarded.
+func (r *Router) admit(route *Route, entry *auditEntry) (int, string) {
+	switch {
+	case route == nil:
+		return r.decide(entry, http.StatusNotFound, fmt.Sprintf("unknown target: %s", entry.Target))
+	case route.Backend == backendKubernetes:
+		attrs := parseK8sRequest(entry.Method, entry.Path, entry.Query)
+		entry.Attributes = &attrs
+		if reason := r.policy.Authorize(attrs); reason != "" {
+			return r.decide(entry, http.StatusForbidden, "denied by agent policy: "+reason)
+		}
+	}
+	return r.decide(entry, http.StatusOK, "")
+}
+
+// decide records the admission decision for entry in the audit log: the request is
+// denied when reason is non-empty and allowed otherwise. Requests that cannot be
+// audited are rejected.
+func (r *Router) decide(entry *auditEntry, status int, reason string) (int, string) {
+	entry.Decision = auditDecisionAllow
+	if reason != "" {
+		entry.Decision = auditDecisionDeny
+		entry.Reason = reason
+		r.logger.Warn("request denied by agent",
+			"requestID", entry.RequestID,
+			"gatewayRequestID", entry.GatewayRequestID,
+			"target", entry.Target,
+			"method", entry.Method,
+			"path", entry.Path,
+			"reason", reason,
 		)
-		return messaging.NewHTTPTunnelErrorResponse(req, http.StatusBadGateway,
-			fmt.Sprintf("failed to read response: %v", err))
 	}
 
-	logger.Info("agent request completed",
-		"target", req.Target,
-		"statusCode", resp.StatusCode,
-		"bodySize", len(body),
-	)
+	if err := r.audit.record(entry); err != nil {
+		r.logger.Error("failed to write audit log entry", "requestID", entry.RequestID, "error", err)
+		if reason == "" {
+			return http.StatusInternalServerError, "request rejected: agent audit log is unavailable"
+		}
+	}
+	return status, reason
+}
 
-	return messaging.NewHTTPTunnelSuccessResponse(req, resp.StatusCode, resp.Header, body)
+// recordOutcome appends the backend's answer to an admitted request to the audit
+// log. The request has already been forwarded, so a failed write is only logged.
+func (r *Router) recordOutcome(admitted *auditEntry, resp *http.Response, err error) {
+	entry := &auditEntry{
+		RequestID:        admitted.RequestID,
+		GatewayRequestID: admitted.GatewayRequestID,
+		Target:           admitted.Target,
+		Method:           admitted.Method,
+		Path:             admitted.Path,
+		Query:            admitted.Query,
+		Decision:         auditDecisionCompleted,
+	}
+	if err != nil {
+		entry.Decision = auditDecisionFailed
+		entry.Error = err.Error()
+	} else {
+		entry.StatusCode = resp.StatusCode
+	}
+	if err := r.audit.record(entry); err != nil {
+		r.logger.Error("failed to write audit log entry", "requestID", entry.RequestID, "error", err)
+	}
+}
+
+func (r *Router) requestLogger(req *messaging.HTTPTunnelRequest) *slog.Logger {
+	if req.GatewayRequestID != "" {
+		return r.logger.With("requestId", req.GatewayRequestID)
+	}
+	return r.logger
 }
 
 func (r *Router) getAvailableTargets() []string {
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -526,6 +527,200 @@ func TestRoute_PreservesAuthorizationForHTTPBackend(t *testing.T) {
 		"Authorization header must pass through for non-k8s backends")
 }
 
+func TestRoute_DeniedByAgentPolicy(t *testing.T) {
+	called := false
+	route := newMockRoute("k8s", "https://kubernetes.svc", func(_ *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+	route.Backend = backendKubernetes
+
+	policy, err := NewRequestPolicy(PolicyConfig{AllowedVerbs: []string{verbGet, verbList}, ExecEnabled: true})
+	require.NoError(t, err)
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := OpenAuditLog(auditPath, "plane-a")
+	require.NoError(t, err)
+	defer audit.Close()
+
+	router := newTestRouter(t, map[string]*Route{"k8s": route})
+	router.policy = policy
+	router.audit = audit
+
+	resp := router.Route(&messaging.HTTPTunnelRequest{
+		RequestID: "req-denied",
+		Target:    "k8s",
+		Method:    http.MethodDelete,
+		Path:      "/api/v1/namespaces/dp-a/pods/web",
+	})
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Message, `verb "delete" is not allowed`)
+	assert.False(t, called, "denied request must not reach the backend")
+
+	resp = router.Route(&messaging.HTTPTunnelRequest{
+		RequestID: "req-allowed",
+		Target:    "k8s",
+		Method:    http.MethodGet,
+		Path:      "/api/v1/namespaces/dp-a/pods",
+	})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, called)
+
+	entries := readAuditEntries(t, auditPath)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "req-denied", entries[0].RequestID)
+	assert.Equal(t, auditDecisionDeny, entries[0].Decision)
+	require.NotNil(t, entries[0].Attributes)
+	assert.Equal(t, verbDelete, entries[0].Attributes.Verb)
+	assert.Equal(t, "req-allowed", entries[1].RequestID)
+	assert.Equal(t, auditDecisionAllow, entries[1].Decision)
+	assert.Equal(t, "pods", entries[1].Attributes.Resource)
+	assert.Equal(t, "req-allowed", entries[2].RequestID)
+	assert.Equal(t, auditDecisionCompleted, entries[2].Decision)
+	assert.Equal(t, http.StatusOK, entries[2].StatusCode)
+}
+
+func TestRoute_AuditsNonK8sTargets(t *testing.T) {
+	route := newMockRoute("monitoring", "https://prometheus.svc", func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+
+	// Kubernetes allowlists do not apply to other backends
+	policy, err := NewRequestPolicy(PolicyConfig{AllowedVerbs: []string{verbGet}})
+	require.NoError(t, err)
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := OpenAuditLog(auditPath, "plane-a")
+	require.NoError(t, err)
+	defer audit.Close()
+
+	router := newTestRouter(t, map[string]*Route{"monitoring": route})
+	router.policy = policy
+	router.audit = audit
+
+	resp := router.Route(&messaging.HTTPTunnelRequest{
+		RequestID: "req-1",
+		Target:    "monitoring",
+		Method:    http.MethodPost,
+		Path:      "/api/v1/query",
+	})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	entries := readAuditEntries(t, auditPath)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "monitoring", entries[0].Target)
+	assert.Nil(t, entries[0].Attributes)
+	assert.Equal(t, auditDecisionCompleted, entries[1].Decision)
+	assert.Equal(t, http.StatusOK, entries[1].StatusCode)
+}
+
+func TestRoute_AuditsBackendFailure(t *testing.T) {
+	route := newMockRoute("monitoring", "https://prometheus.svc", func(_ *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	})
+
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := OpenAuditLog(auditPath, "plane-a")
+	require.NoError(t, err)
+	defer audit.Close()
+
+	router := newTestRouter(t, map[string]*Route{"monitoring": route})
+	router.audit = audit
+
+	resp := router.Route(&messaging.HTTPTunnelRequest{RequestID: "req-1", Target: "monitoring", Method: http.MethodGet, Path: "/api/v1/query"})
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+	entries := readAuditEntries(t, auditPath)
+	require.Len(t, entries, 2)
+	assert.Equal(t, auditDecisionAllow, entries[0].Decision)
+	assert.Equal(t, "req-1", entries[1].RequestID)
+	assert.Equal(t, auditDecisionFailed, entries[1].Decision)
+	assert.Contains(t, entries[1].Error, "connection refused")
+	assert.Zero(t, entries[1].StatusCode)
+}
+
+func TestRoute_RejectedWhenAuditLogUnavailable(t *testing.T) {
+	called := false
+	route := newMockRoute("k8s", "https://kubernetes.svc", func(_ *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+	route.Backend = backendKubernetes
+
+	audit, err := OpenAuditLog(filepath.Join(t.TempDir(), "audit.log"), "plane-a")
+	require.NoError(t, err)
+	require.NoError(t, audit.Close())
+
+	router := newTestRouter(t, map[string]*Route{"k8s": route})
+	router.audit = audit
+
+	resp := router.Route(&messaging.HTTPTunnelRequest{RequestID: "req-1", Target: "k8s", Method: http.MethodGet, Path: "/api/v1/pods"})
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.False(t, called, "requests that cannot be audited must not be forwarded")
+}
+
+func TestAdmitStream_ExecDisabled(t *testing.T) {
+	route := newMockRoute("k8s", "https://kubernetes.svc", nil)
+	route.Backend = backendKubernetes
+	policy, err := NewRequestPolicy(PolicyConfig{ExecEnabled: false})
+	require.NoError(t, err)
+
+	router := newTestRouter(t, map[string]*Route{"k8s": route})
+	router.policy = policy
+
+	reason := router.AdmitStream(&messaging.HTTPTunnelStreamInit{
+		RequestID: "req-1",
+		Target:    "k8s",
+		Method:    http.MethodPost,
+		Path:      "/api/v1/namespaces/dp-a/pods/web/exec",
+		IsUpgrade: true,
+	})
+	assert.Contains(t, reason, "pods/exec is disabled on this agent")
+
+	assert.Equal(t, "unknown target: hubble", router.AdmitStream(&messaging.HTTPTunnelStreamInit{Target: "hubble"}))
+}
+
+func TestRoute_AuditsUnknownTarget(t *testing.T) {
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := OpenAuditLog(auditPath, "plane-a")
+	require.NoError(t, err)
+	defer audit.Close()
+
+	router := newTestRouter(t, map[string]*Route{})
+	router.audit = audit
+
+	resp := router.Route(&messaging.HTTPTunnelRequest{RequestID: "req-1", Target: "missing", Method: http.MethodGet, Path: "/"})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	entries := readAuditEntries(t, auditPath)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "missing", entries[0].Target)
+	assert.Equal(t, auditDecisionDeny, entries[0].Decision)
+	assert.Equal(t, "unknown target: missing", entries[0].Reason)
+}
+
+func TestAdmitFlows(t *testing.T) {
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := OpenAuditLog(auditPath, "plane-a")
+	require.NoError(t, err)
+	defer audit.Close()
+
+	policy, err := NewRequestPolicy(PolicyConfig{AllowedVerbs: []string{verbGet}})
+	require.NoError(t, err)
+	router := newTestRouter(t, map[string]*Route{})
+	router.policy = policy
+	router.audit = audit
+
+	reason := router.AdmitFlows(&messaging.HTTPTunnelStreamInit{RequestID: "req-1", Target: "hubble", Query: "namespace=team-1"})
+	assert.Equal(t, `denied by agent policy: verb "watch" is not allowed`, reason)
+
+	entries := readAuditEntries(t, auditPath)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "hubble", entries[0].Target)
+	assert.Equal(t, auditDecisionDeny, entries[0].Decision)
+}
+
 // errReader is an io.Reader that always returns an error.
 type errReader struct{}
 
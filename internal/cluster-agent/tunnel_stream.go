@@ -0,0 +1,278 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// compressionToggler is implemented by *websocket.Conn. It is used to enable
+// per-message compression for large frames when permessage-deflate has been
+// negotiated with the gateway.
+type compressionToggler interface {
+	EnableWriteCompression(enable bool)
+}
+
+// tunnelStream is a protocol v2 HTTP tunnel request whose response body is
+// streamed back to the gateway in chunks, subject to a per-stream flow-control
+// window that the gateway replenishes with window updates.
+type tunnelStream struct {
+	requestID string
+	cancel    context.CancelFunc
+
+	mu     sync.Mutex
+	window int
+	// windowCh is signaled whenever the gateway grants more window
+	windowCh chan struct{}
+}
+
+func newTunnelStream(requestID string, window int, cancel context.CancelFunc) *tunnelStream {
+	if window <= 0 {
+		window = messaging.DefaultStreamWindow
+	}
+	return &tunnelStream{
+		requestID: requestID,
+		cancel:    cancel,
+		window:    window,
+		windowCh:  make(chan struct{}, 1),
+	}
+}
+
+// grant adds increment bytes to the stream's send window.
+func (s *tunnelStream) grant(increment int) {
+	s.mu.Lock()
+	s.window += increment
+	s.mu.Unlock()
+
+	select {
+	case s.windowCh <- struct{}{}:
+	default:
+	}
+}
+
+// acquire blocks until at least one byte of window is available and reserves
+// up to want bytes of it. It returns the number of bytes reserved.
+func (s *tunnelStream) acquire(ctx context.Context, want int) (int, error) {
+	for {
+		s.mu.Lock()
+		if s.window > 0 {
+			n := min(want, s.window)
+			s.window -= n
+			s.mu.Unlock()
+			return n, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.windowCh:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// handleFrame dispatches a protocol v2 binary frame received from the gateway.
+func (a *Agent) handleFrame(ctx context.Context, data []byte) {
+	frame, err := messaging.DecodeFrame(data)
+	if err != nil {
+		a.logger.Warn("failed to decode tunnel frame", "error", err)
+		return
+	}
+
+	switch frame.Type {
+	case messaging.FrameRequest:
+		req, err := messaging.ParseRequestFrame(frame)
+		if err != nil {
+			a.logger.Warn("failed to parse tunnel request frame", "requestID", frame.RequestID, "error", err)
+			return
+		}
+		if req.RequestID == "" {
+			a.logger.Warn("received tunnel request frame without requestID")
+			return
+		}
+		go a.handleTunnelStreamRequest(ctx, req)
+	case messaging.FrameCancel:
+		if stream := a.getTunnelStream(frame.RequestID); stream != nil {
+			a.logger.Debug("tunnel stream canceled by gateway", "requestID", frame.RequestID)
+			stream.cancel()
+		}
+	case messaging.FrameWindowUpdate:
+		increment, err := messaging.ParseWindowUpdateFrame(frame)
+		if err != nil {
+			a.logger.Warn("failed to parse window update frame", "requestID", frame.RequestID, "error", err)
+			return
+		}
+		if stream := a.getTunnelStream(frame.RequestID); stream != nil {
+			stream.grant(increment)
+		}
+	default:
+		a.logger.Warn("unexpected tunnel frame from gateway", "type", frame.Type.String(), "requestID", frame.RequestID)
+	}
+}
+
+// handleTunnelStreamRequest routes a protocol v2 tunnel request to its backend and
+// streams the response back as a header frame, a sequence of data frames and an
+// end frame. parentCtx is the agent's message-loop context so a disconnect aborts
+// every in-flight backend request.
+func (a *Agent) handleTunnelStreamRequest(parentCtx context.Context, req *messaging.HTTPTunnelRequest) {
+	logger := a.logger.With("requestID", req.RequestID)
+	logger.Info("received HTTP tunnel stream request",
+		"target", req.Target,
+		"method", req.Method,
+		"path", req.Path,
+	)
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer cancel()
+
+	stream := newTunnelStream(req.RequestID, req.Window, cancel)
+	a.tunnelStreamsMu.Lock()
+	if _, exists := a.tunnelStreams[req.RequestID]; exists {
+		a.tunnelStreamsMu.Unlock()
+		logger.Warn("duplicate tunnel stream requestID; rejecting new request")
+		// Answer anyway so the gateway does not wait for a response that never comes
+		if err := a.sendResponseHeader(messaging.NewHTTPTunnelErrorResponse(req, http.StatusConflict,
+			"duplicate tunnel stream requestID")); err != nil {
+			logger.Error("failed to send tunnel error response", "error", err)
+			return
+		}
+		a.sendEnd(req.RequestID, nil)
+		return
+	}
+	a.tunnelStreams[req.RequestID] = stream
+	a.tunnelStreamsMu.Unlock()
+
+	defer func() {
+		a.tunnelStreamsMu.Lock()
+		delete(a.tunnelStreams, req.RequestID)
+		a.tunnelStreamsMu.Unlock()
+	}()
+
+	resp, errResp := a.router.RouteStream(ctx, req)
+	if errResp != nil {
+		if err := a.sendResponseHeader(errResp); err != nil {
+			logger.Error("failed to send tunnel error response", "error", err)
+			return
+		}
+		a.sendEnd(req.RequestID, nil)
+		return
+	}
+	defer resp.Body.Close()
+
+	if err := a.sendResponseHeader(messaging.NewHTTPTunnelSuccessResponse(req, resp.StatusCode, resp.Header, nil)); err != nil {
+		logger.Error("failed to send tunnel response header", "error", err)
+		return
+	}
+
+	written, err := a.streamResponseBody(ctx, stream, resp.Body)
+	switch {
+	case err == nil:
+		logger.Info("agent stream request completed",
+			"target", req.Target,
+			"statusCode", resp.StatusCode,
+			"bodySize", written,
+		)
+		a.sendEnd(req.RequestID, nil)
+	case errors.Is(err, context.Canceled):
+		logger.Info("tunnel stream canceled", "bytesSent", written)
+	default:
+		logger.Warn("tunnel stream aborted", "bytesSent", written, "error", err)
+		a.sendEnd(req.RequestID, &messaging.ErrorDetails{
+			Code:    http.StatusBadGateway,
+			Message: fmt.Sprintf("failed to read response: %v", err),
+		})
+	}
+}
+
+// streamResponseBody copies body to the gateway as data frames, never sending
+// more bytes than the stream's flow-control window allows.
+func (a *Agent) streamResponseBody(ctx context.Context, stream *tunnelStream, body io.Reader) (int64, error) {
+	var written int64
+	buf := make([]byte, messaging.DefaultChunkSize)
+	for {
+		n, err := stream.acquire(ctx, len(buf))
+		if err != nil {
+			return written, err
+		}
+
+		read, readErr := body.Read(buf[:n])
+		if unused := n - read; unused > 0 {
+			stream.grant(unused)
+		}
+		if read > 0 {
+			frame := &messaging.Frame{Type: messaging.FrameData, RequestID: stream.requestID, Payload: buf[:read]}
+			if err := a.sendFrame(frame); err != nil {
+				return written, err
+			}
+			written += int64(read)
+		}
+
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				return written, nil
+			}
+			if ctx.Err() != nil {
+				return written, ctx.Err()
+			}
+			return written, readErr
+		}
+	}
+}
+
+func (a *Agent) sendResponseHeader(resp *messaging.HTTPTunnelResponse) error {
+	frame, err := messaging.NewResponseHeaderFrame(resp)
+	if err != nil {
+		return err
+	}
+	return a.sendFrame(frame)
+}
+
+func (a *Agent) sendEnd(requestID string, errDetails *messaging.ErrorDetails) {
+	frame, err := messaging.NewEndFrame(requestID, errDetails)
+	if err != nil {
+		a.logger.Warn("failed to build end frame", "requestID", requestID, "error", err)
+		return
+	}
+	if err := a.sendFrame(frame); err != nil {
+		a.logger.Warn("failed to send end frame", "requestID", requestID, "error", err)
+	}
+}
+
+// sendFrame writes a protocol v2 frame as a binary websocket message, enabling
+// per-message compression for frames above messaging.CompressionThreshold.
+func (a *Agent) sendFrame(frame *messaging.Frame) error {
+	data, err := frame.Encode()
+	if err != nil {
+		return fmt.Errorf("sendFrame: failed to encode frame: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.conn == nil {
+		return messaging.ErrNotConnected
+	}
+	if c, ok := a.conn.(compressionToggler); ok {
+		c.EnableWriteCompression(len(data) >= messaging.CompressionThreshold)
+	}
+	if err := a.conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		return fmt.Errorf("sendFrame: failed to write message: %w", err)
+	}
+	return nil
+}
+
+func (a *Agent) getTunnelStream(requestID string) *tunnelStream {
+	a.tunnelStreamsMu.Lock()
+	defer a.tunnelStreamsMu.Unlock()
+	return a.tunnelStreams[requestID]
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+func newTestStreamingAgent(t *testing.T, router *Router) (*Agent, *mockConnection) {
+	t.Helper()
+	agent := newTestAgent(t, "ws://unused", router)
+	agent.tunnelStreams = make(map[string]*tunnelStream)
+	mock := &mockConnection{}
+	agent.conn = mock
+	return agent, mock
+}
+
+func encodeFrame(t *testing.T, f *messaging.Frame) []byte {
+	t.Helper()
+	data, err := f.Encode()
+	require.NoError(t, err)
+	return data
+}
+
+func decodeWrittenFrames(t *testing.T, msgs [][]byte) []*messaging.Frame {
+	t.Helper()
+	frames := make([]*messaging.Frame, 0, len(msgs))
+	for _, msg := range msgs {
+		f, err := messaging.DecodeFrame(msg)
+		require.NoError(t, err)
+		frames = append(frames, f)
+	}
+	return frames
+}
+
+func TestTunnelStream_AcquireWaitsForGrant(t *testing.T) {
+	stream := newTunnelStream("req-1", 10, func() {})
+
+	n, err := stream.acquire(context.Background(), 64)
+	require.NoError(t, err)
+	assert.Equal(t, 10, n)
+
+	acquired := make(chan int, 1)
+	go func() {
+		n, _ := stream.acquire(context.Background(), 64)
+		acquired <- n
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquire should block while the window is exhausted")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	stream.grant(20)
+	select {
+	case n := <-acquired:
+		assert.Equal(t, 20, n)
+	case <-time.After(time.Second):
+		t.Fatal("acquire did not resume after grant")
+	}
+}
+
+func TestTunnelStream_AcquireCanceled(t *testing.T) {
+	stream := newTunnelStream("req-1", 1, func() {})
+	_, err := stream.acquire(context.Background(), 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = stream.acquire(ctx, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAgent_HandleTunnelStreamRequest_StreamsChunks(t *testing.T) {
+	body := bytes.Repeat([]byte("a"), messaging.DefaultChunkSize*2+100)
+	route := newMockRoute("k8s", "https://kubernetes.svc", func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+		}, nil
+	})
+	agent, mock := newTestStreamingAgent(t, newTestRouter(t, map[string]*Route{"k8s": route}))
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil)
+	req.RequestID = "req-1"
+	agent.handleTunnelStreamRequest(context.Background(), req)
+
+	frames := decodeWrittenFrames(t, mock.getWrittenMessages())
+	require.Len(t, frames, 5)
+
+	assert.Equal(t, messaging.FrameResponseHeader, frames[0].Type)
+	header, err := messaging.ParseResponseHeaderFrame(frames[0])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, header.StatusCode)
+	assert.Equal(t, []string{"application/json"}, header.Headers["Content-Type"])
+
+	var got []byte
+	for _, f := range frames[1:4] {
+		assert.Equal(t, messaging.FrameData, f.Type)
+		assert.LessOrEqual(t, len(f.Payload), messaging.DefaultChunkSize)
+		got = append(got, f.Payload...)
+	}
+	assert.Equal(t, body, got)
+
+	assert.Equal(t, messaging.FrameEnd, frames[4].Type)
+	details, err := messaging.ParseEndFrame(frames[4])
+	require.NoError(t, err)
+	assert.Nil(t, details)
+
+	assert.Empty(t, agent.tunnelStreams, "stream should be unregistered after completion")
+}
+
+func TestAgent_HandleTunnelStreamRequest_UnknownTarget(t *testing.T) {
+	agent, mock := newTestStreamingAgent(t, newTestRouter(t, map[string]*Route{}))
+
+	req := messaging.NewHTTPTunnelRequest("missing", http.MethodGet, "/", "", nil, nil)
+	req.RequestID = "req-1"
+	agent.handleTunnelStreamRequest(context.Background(), req)
+
+	frames := decodeWrittenFrames(t, mock.getWrittenMessages())
+	require.Len(t, frames, 2)
+	header, err := messaging.ParseResponseHeaderFrame(frames[0])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, header.StatusCode)
+	require.NotNil(t, header.Error)
+	assert.Contains(t, header.Error.Message, "unknown target")
+	assert.Equal(t, messaging.FrameEnd, frames[1].Type)
+}
+
+func TestAgent_HandleTunnelStreamRequest_DuplicateRequestID(t *testing.T) {
+	agent, mock := newTestStreamingAgent(t, newTestRouter(t, map[string]*Route{}))
+	agent.tunnelStreams["req-1"] = newTunnelStream("req-1", 10, func() {})
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/", "", nil, nil)
+	req.RequestID = "req-1"
+	agent.handleTunnelStreamRequest(context.Background(), req)
+
+	frames := decodeWrittenFrames(t, mock.getWrittenMessages())
+	require.Len(t, frames, 2)
+	header, err := messaging.ParseResponseHeaderFrame(frames[0])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, header.StatusCode)
+	assert.Equal(t, messaging.FrameEnd, frames[1].Type)
+	assert.Contains(t, agent.tunnelStreams, "req-1", "the existing stream must be kept")
+}
+
+func TestAgent_HandleTunnelStreamRequest_RespectsWindowAndCancel(t *testing.T) {
+	body := bytes.Repeat([]byte("b"), 1000)
+	route := newMockRoute("k8s", "https://kubernetes.svc", func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+		}, nil
+	})
+	agent, mock := newTestStreamingAgent(t, newTestRouter(t, map[string]*Route{"k8s": route}))
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil)
+	req.RequestID = "req-1"
+	req.Window = 100
+
+	done := make(chan struct{})
+	go func() {
+		agent.handleTunnelStreamRequest(context.Background(), req)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return len(mock.getWrittenMessages()) == 2 }, time.Second, 5*time.Millisecond)
+
+	// Only the initial window is sent until the gateway grants more
+	frames := decodeWrittenFrames(t, mock.getWrittenMessages())
+	assert.Equal(t, messaging.FrameData, frames[1].Type)
+	assert.Len(t, frames[1].Payload, 100)
+
+	agent.handleFrame(context.Background(), encodeFrame(t, messaging.NewWindowUpdateFrame("req-1", 50)))
+	require.Eventually(t, func() bool { return len(mock.getWrittenMessages()) == 3 }, time.Second, 5*time.Millisecond)
+	frames = decodeWrittenFrames(t, mock.getWrittenMessages())
+	assert.Len(t, frames[2].Payload, 50)
+
+	agent.handleFrame(context.Background(), encodeFrame(t, &messaging.Frame{Type: messaging.FrameCancel, RequestID: "req-1"}))
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("stream did not stop after cancel")
+	}
+
+	// A canceled stream is not ended by the agent
+	frames = decodeWrittenFrames(t, mock.getWrittenMessages())
+	assert.NotEqual(t, messaging.FrameEnd, frames[len(frames)-1].Type)
+}
+
+func TestAgent_HandleFrame_Request(t *testing.T) {
+	route := newMockRoute("k8s", "https://kubernetes.svc", func(req *http.Request) (*http.Response, error) {
+		reqBody, _ := io.ReadAll(req.Body)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(bytes.NewReader(reqBody)),
+		}, nil
+	})
+	agent, mock := newTestStreamingAgent(t, newTestRouter(t, map[string]*Route{"k8s": route}))
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodPost, "/api/v1/pods", "", nil, []byte(`{"kind":"Pod"}`))
+	req.RequestID = "req-1"
+	f, err := messaging.NewRequestFrame(req, messaging.DefaultStreamWindow)
+	require.NoError(t, err)
+
+	agent.handleFrame(context.Background(), encodeFrame(t, f))
+
+	require.Eventually(t, func() bool { return len(mock.getWrittenMessages()) == 3 }, time.Second, 5*time.Millisecond)
+	frames := decodeWrittenFrames(t, mock.getWrittenMessages())
+	header, err := messaging.ParseResponseHeaderFrame(frames[0])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, header.StatusCode)
+	assert.Equal(t, []byte(`{"kind":"Pod"}`), frames[1].Payload)
+	assert.Equal(t, messaging.FrameEnd, frames[2].Type)
+}
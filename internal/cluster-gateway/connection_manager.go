@@ -30,6 +30,7 @@ type AgentConnection struct {
 	ConnectedAt     time.Time
 	LastSeen        time.Time
 	ValidCRs        []string          // List of CRs (namespace/name) this connection is authorized for
+	ProtocolVersion int               // Tunnel protocol version negotiated during the websocket handshake
 	clientCert      *x509.Certificate // Client certificate for re-validation on CR updates
 	intermediates   *x509.CertPool    // Handshake intermediate CAs, used to chain the client cert on re-validation
 	mu              sync.Mutex
@@ -185,6 +186,7 @@ func (cm *ConnectionManager) Register(
 		ConnectedAt:     now,
 		LastSeen:        now,
 		ValidCRs:        validCRs,
+		ProtocolVersion: negotiatedProtocolVersion(conn),
 		clientCert:      clientCert,
 		intermediates:   intermediates,
 	}
@@ -202,6 +204,7 @@ func (cm *ConnectionManager) Register(
 		"connectionID", connID,
 		"validCRs", validCRs,
 		"validCRCount", len(validCRs),
+		"protocolVersion", newConn.ProtocolVersion,
 		"connectionsForPlane", totalForPlane,
 		"totalConnections", totalConnections,
 	)
@@ -445,6 +448,32 @@ func (ac *AgentConnection) SendRawMessage(data []byte) error {
 	return nil
 }
 
+// SendFrame sends a protocol v2 frame through this connection as a binary message.
+// Per-message compression is enabled for frames above messaging.CompressionThreshold
+// when permessage-deflate has been negotiated with the agent.
+func (ac *AgentConnection) SendFrame(frame *messaging.Frame) error {
+	data, err := frame.Encode()
+	if err != nil {
+		return fmt.Errorf("SendFrame: failed to encode frame: %w", err)
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if c, ok := ac.Conn.(compressionToggler); ok {
+		c.EnableWriteCompression(len(data) >= messaging.CompressionThreshold)
+	}
+	if err := ac.Conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		return fmt.Errorf("SendFrame: failed to send frame: %w", err)
+	}
+	return nil
+}
+
+// SupportsStreaming reports whether the agent speaks the streaming tunnel protocol (v2).
+func (ac *AgentConnection) SupportsStreaming() bool {
+	return ac.ProtocolVersion >= 2
+}
+
 // Close closes the agent connection
 func (ac *AgentConnection) Close() error {
 	ac.mu.Lock()
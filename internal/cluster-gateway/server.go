@@ -33,6 +33,7 @@ const (
 	planeTypeWorkflowPlane        = "workflowplane"
 	planeTypeObservabilityPlane   = "observabilityplane"
 	crNamespaceClusterPlaceholder = "_cluster" // Special placeholder for cluster-scoped CRs (no namespace)
+	proxyRequestTimeout           = 30 * time.Second
 )
 
 // Connection abstracts a WebSocket connection for testability.
@@ -57,6 +58,8 @@ type Server struct {
 	requestsMu            sync.Mutex
 	pendingStreamSessions map[string]*streamSession
 	streamSessionsMu      sync.RWMutex
+	pendingTunnelStreams  map[string]*tunnelStream
+	tunnelStreamsMu       sync.RWMutex
 	validator             *RequestValidator
 	logger                *slog.Logger
 	k8sClient             client.Client // Kubernetes client for querying DataPlane/WorkflowPlane CRs
@@ -69,10 +72,14 @@ func New(config *Config, k8sClient client.Client, logger *slog.Logger) *Server {
 			CheckOrigin: func(r *http.Request) bool {
 				return true
 			},
+			// Agents that offer no subprotocol keep using the JSON-only protocol
+			Subprotocols:      messaging.SupportedProtocols,
+			EnableCompression: true,
 		},
 		connMgr:               NewConnectionManager(logger),
 		pendingHTTPRequests:   make(map[string]chan *messaging.HTTPTunnelResponse),
 		pendingStreamSessions: make(map[string]*streamSession),
+		pendingTunnelStreams:  make(map[string]*tunnelStream),
 		validator:             NewRequestValidator(),
 		logger:                logger.With("component", "agent-server"),
 		k8sClient:             k8sClient,
@@ -383,6 +390,7 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) handleConnection(planeName, connID string, conn Connection) {
+	defer s.resetConnectionTunnelStreams(connID)
 	defer s.connMgr.Unregister(planeName, connID)
 
 	if err := conn.SetReadDeadline(time.Now().Add(s.config.HeartbeatTimeout)); err != nil {
@@ -410,7 +418,7 @@ func (s *Server) handleConnection(planeName, connID string, conn Connection) {
 	}()
 
 	for {
-		_, data, err := conn.ReadMessage()
+		messageType, data, err := conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				s.logger.Error("websocket error", "plane", planeName, "error", err)
@@ -422,6 +430,12 @@ func (s *Server) handleConnection(planeName, connID string, conn Connection) {
 
 		s.connMgr.UpdateConnectionLastSeen(planeName, connID)
 
+		// Binary messages are protocol v2 frames carrying streamed responses
+		if messageType == websocket.BinaryMessage {
+			s.handleFrame(planeName, connID, data)
+			continue
+		}
+
 		// Try to route as a stream chunk (has "data" field and no "statusCode" at top level)
 		var streamChunk messaging.HTTPTunnelStreamChunk
 		if err := json.Unmarshal(data, &streamChunk); err == nil && streamChunk.RequestID != "" && (streamChunk.Data != nil || streamChunk.IsClose) {
@@ -570,7 +584,7 @@ func (s *Server) handleHTTPProxy(w http.ResponseWriter, r *http.Request) {
 	tunnelReq.GatewayRequestID = requestID
 
 	// Route request to agent authorized for this specific CR
-	response, err := s.SendHTTPTunnelRequestForCR(planeIdentifier, crKey, tunnelReq, 30*time.Second)
+	conn, err := s.connMgr.GetForCR(planeIdentifier, crKey)
 	if err != nil {
 		// Check if authorization error (no agents authorized for CR)
 		if strings.Contains(err.Error(), "no agents authorized for CR") {
@@ -593,6 +607,25 @@ func (s *Server) handleHTTPProxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Agents that speak the streaming protocol send the body in chunks, which
+	// are forwarded to the client as they arrive instead of being buffered.
+	if conn.SupportsStreaming() {
+		s.streamHTTPProxyResponse(w, r, conn, tunnelReq, proxyRequestTimeout, logger)
+		return
+	}
+
+	response, err := s.sendHTTPTunnelRequestOnConn(conn, tunnelReq, proxyRequestTimeout)
+	if err != nil {
+		logger.Error("HTTP tunnel request failed",
+			"plane", planeIdentifier,
+			"cr", crKey,
+			"target", target,
+			"error", err,
+		)
+		http.Error(w, fmt.Sprintf("proxy request failed: %v", err), http.StatusBadGateway)
+		return
+	}
+
 	for key, values := range response.Headers {
 		for _, value := range values {
 			w.Header().Add(key, value)
@@ -659,37 +692,19 @@ func (s *Server) handleStreamingProxy(w http.ResponseWriter, r *http.Request, pl
 
 // SendHTTPTunnelRequest sends an HTTP tunnel request to an agent and waits for the response
 func (s *Server) SendHTTPTunnelRequest(planeName string, req *messaging.HTTPTunnelRequest, timeout time.Duration) (*messaging.HTTPTunnelResponse, error) {
-	req.RequestID = messaging.GenerateMessageID()
-
-	replyChan := make(chan *messaging.HTTPTunnelResponse, 1)
-	s.requestsMu.Lock()
-	s.pendingHTTPRequests[req.RequestID] = replyChan
-	s.requestsMu.Unlock()
+	conn, err := s.connMgr.Get(planeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send HTTP tunnel request: %w", err)
+	}
 
 	s.logger.Debug("sending HTTP tunnel request",
-		"requestID", req.RequestID,
 		"target", req.Target,
 		"method", req.Method,
 		"path", req.Path,
 		"plane", planeName,
 	)
 
-	if err := s.connMgr.SendHTTPTunnelRequest(planeName, req); err != nil {
-		s.requestsMu.Lock()
-		delete(s.pendingHTTPRequests, req.RequestID)
-		s.requestsMu.Unlock()
-		return nil, fmt.Errorf("failed to send HTTP tunnel request: %w", err)
-	}
-
-	select {
-	case response := <-replyChan:
-		return response, nil
-	case <-time.After(timeout):
-		s.requestsMu.Lock()
-		delete(s.pendingHTTPRequests, req.RequestID)
-		s.requestsMu.Unlock()
-		return nil, fmt.Errorf("HTTP tunnel request timeout")
-	}
+	return s.sendHTTPTunnelRequestOnConn(conn, req, timeout)
 }
 
 // SendHTTPTunnelRequestForCR sends an HTTP tunnel request to an agent authorized for a specific CR
@@ -699,15 +714,12 @@ func (s *Server) SendHTTPTunnelRequestForCR(
 	req *messaging.HTTPTunnelRequest,
 	timeout time.Duration,
 ) (*messaging.HTTPTunnelResponse, error) {
-	req.RequestID = messaging.GenerateMessageID()
-
-	replyChan := make(chan *messaging.HTTPTunnelResponse, 1)
-	s.requestsMu.Lock()
-	s.pendingHTTPRequests[req.RequestID] = replyChan
-	s.requestsMu.Unlock()
+	conn, err := s.connMgr.GetForCR(planeName, crKey)
+	if err != nil {
+		return nil, err
+	}
 
 	s.logger.Debug("sending HTTP tunnel request with CR authorization",
-		"requestID", req.RequestID,
 		"target", req.Target,
 		"method", req.Method,
 		"path", req.Path,
@@ -715,14 +727,38 @@ func (s *Server) SendHTTPTunnelRequestForCR(
 		"cr", crKey,
 	)
 
-	conn, err := s.connMgr.GetForCR(planeName, crKey)
+	response, err := s.sendHTTPTunnelRequestOnConn(conn, req, timeout)
 	if err != nil {
-		s.requestsMu.Lock()
-		delete(s.pendingHTTPRequests, req.RequestID)
-		s.requestsMu.Unlock()
 		return nil, err
 	}
 
+	s.logger.Debug("received HTTP tunnel response",
+		"requestID", req.RequestID,
+		"plane", planeName,
+		"cr", crKey,
+		"statusCode", response.StatusCode,
+	)
+	return response, nil
+}
+
+// sendHTTPTunnelRequestOnConn sends req to the agent on conn and waits for the whole
+// response. Streaming (v2) agents send the body in chunks, which are reassembled here.
+func (s *Server) sendHTTPTunnelRequestOnConn(
+	conn *AgentConnection,
+	req *messaging.HTTPTunnelRequest,
+	timeout time.Duration,
+) (*messaging.HTTPTunnelResponse, error) {
+	if conn.SupportsStreaming() {
+		return s.collectTunnelStream(conn, req, timeout)
+	}
+
+	req.RequestID = messaging.GenerateMessageID()
+
+	replyChan := make(chan *messaging.HTTPTunnelResponse, 1)
+	s.requestsMu.Lock()
+	s.pendingHTTPRequests[req.RequestID] = replyChan
+	s.requestsMu.Unlock()
+
 	if err := conn.SendHTTPTunnelRequest(req); err != nil {
 		s.requestsMu.Lock()
 		delete(s.pendingHTTPRequests, req.RequestID)
@@ -732,12 +768,6 @@ func (s *Server) SendHTTPTunnelRequestForCR(
 
 	select {
 	case response := <-replyChan:
-		s.logger.Debug("received HTTP tunnel response",
-			"requestID", req.RequestID,
-			"plane", planeName,
-			"cr", crKey,
-			"statusCode", response.StatusCode,
-		)
 		return response, nil
 	case <-time.After(timeout):
 		s.requestsMu.Lock()
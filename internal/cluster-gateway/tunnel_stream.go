@@ -0,0 +1,422 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// compressionToggler is implemented by *websocket.Conn. It is used to enable
+// per-message compression for large frames when permessage-deflate has been
+// negotiated with the agent.
+type compressionToggler interface {
+	EnableWriteCompression(enable bool)
+}
+
+// subprotocolGetter is implemented by *websocket.Conn and returns the
+// subprotocol negotiated during the websocket handshake.
+type subprotocolGetter interface {
+	Subprotocol() string
+}
+
+// negotiatedProtocolVersion returns the tunnel protocol version of conn.
+// Connections that cannot report a subprotocol are treated as version 1.
+func negotiatedProtocolVersion(conn Connection) int {
+	if c, ok := conn.(subprotocolGetter); ok {
+		return messaging.ProtocolVersion(c.Subprotocol())
+	}
+	return 1
+}
+
+// maxQueuedTunnelFrames bounds the frames buffered for a stream, so that a
+// flood of empty data frames cannot grow the queue within the byte window.
+const maxQueuedTunnelFrames = 4096
+
+// errTunnelWindowExceeded is returned to the consumer of a stream the agent
+// sent more data on than the flow-control window allowed.
+var errTunnelWindowExceeded = errors.New("HTTP tunnel stream reset: agent exceeded the flow-control window")
+
+// errTunnelAgentDisconnected is returned to the consumers of the streams of an
+// agent connection that went away.
+var errTunnelAgentDisconnected = errors.New("HTTP tunnel stream reset: agent disconnected")
+
+// tunnelStream tracks a protocol v2 HTTP tunnel request whose response is
+// streamed back from the agent as a header frame followed by data frames.
+//
+// Frames are queued without ever blocking the connection read loop, which is
+// shared by every stream of the agent. The flow-control window bounds the body
+// bytes the agent may have in flight, so the queue stays bounded; a stream whose
+// agent exceeds it is reset on its own.
+type tunnelStream struct {
+	requestID string
+	conn      *AgentConnection
+	window    int
+
+	mu sync.Mutex
+	// queue holds response frames from the agent, in order
+	queue []*messaging.Frame
+	// unacked counts the body bytes received but not yet returned to the window
+	unacked int
+	err     error
+
+	// ready is signaled when a frame is queued
+	ready chan struct{}
+	done  chan struct{}
+	once  sync.Once
+}
+
+func (s *tunnelStream) close() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// enqueue queues a frame for the consumer. It reports an error, without
+// queuing, when the frame overflows the stream's flow-control window.
+func (s *tunnelStream) enqueue(frame *messaging.Frame) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if frame.Type == messaging.FrameData {
+		s.unacked += len(frame.Payload)
+		if s.unacked > s.window {
+			return errTunnelWindowExceeded
+		}
+	}
+	if len(s.queue) >= maxQueuedTunnelFrames {
+		return errTunnelWindowExceeded
+	}
+	s.queue = append(s.queue, frame)
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// reset closes the stream with err. It reports false if the stream was
+// already reset.
+func (s *tunnelStream) reset(err error) bool {
+	s.mu.Lock()
+	first := s.err == nil
+	if first {
+		s.err = err
+		s.queue = nil
+	}
+	s.mu.Unlock()
+	s.close()
+	return first
+}
+
+// pop waits for the next queued frame.
+func (s *tunnelStream) pop(ctx context.Context) (*messaging.Frame, error) {
+	for {
+		s.mu.Lock()
+		if s.err != nil {
+			err := s.err
+			s.mu.Unlock()
+			return nil, err
+		}
+		if len(s.queue) > 0 {
+			frame := s.queue[0]
+			s.queue[0] = nil
+			s.queue = s.queue[1:]
+			s.mu.Unlock()
+			return frame, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.ready:
+		case <-s.done:
+			return nil, errors.New("HTTP tunnel stream closed")
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// openTunnelStream registers a new stream for req and sends the request to the
+// agent on conn. The caller must call closeTunnelStream when done with it.
+func (s *Server) openTunnelStream(conn *AgentConnection, req *messaging.HTTPTunnelRequest) (*tunnelStream, error) {
+	req.RequestID = messaging.GenerateMessageID()
+
+	frame, err := messaging.NewRequestFrame(req, messaging.DefaultStreamWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &tunnelStream{
+		requestID: req.RequestID,
+		conn:      conn,
+		window:    messaging.DefaultStreamWindow,
+		ready:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+
+	s.tunnelStreamsMu.Lock()
+	s.pendingTunnelStreams[req.RequestID] = stream
+	s.tunnelStreamsMu.Unlock()
+
+	if err := conn.SendFrame(frame); err != nil {
+		s.unregisterTunnelStream(req.RequestID)
+		return nil, fmt.Errorf("failed to send HTTP tunnel request: %w", err)
+	}
+	return stream, nil
+}
+
+// closeTunnelStream unregisters the stream. If the stream did not complete,
+// the agent is asked to cancel the backend request.
+func (s *Server) closeTunnelStream(stream *tunnelStream, completed bool) {
+	s.unregisterTunnelStream(stream.requestID)
+	if !stream.reset(errors.New("HTTP tunnel stream closed")) || completed {
+		// Already reset (and canceled) by handleFrame, or finished cleanly
+		return
+	}
+	s.cancelTunnelStream(stream)
+}
+
+// cancelTunnelStream asks the agent to cancel the backend request of a stream.
+func (s *Server) cancelTunnelStream(stream *tunnelStream) {
+	if err := stream.conn.SendFrame(&messaging.Frame{Type: messaging.FrameCancel, RequestID: stream.requestID}); err != nil {
+		s.logger.Debug("failed to send tunnel stream cancel", "requestID", stream.requestID, "error", err)
+	}
+}
+
+func (s *Server) unregisterTunnelStream(requestID string) {
+	s.tunnelStreamsMu.Lock()
+	defer s.tunnelStreamsMu.Unlock()
+	delete(s.pendingTunnelStreams, requestID)
+}
+
+// resetConnectionTunnelStreams fails the open streams of an agent connection,
+// so their consumers do not wait for frames that will never arrive.
+func (s *Server) resetConnectionTunnelStreams(connID string) {
+	s.tunnelStreamsMu.Lock()
+	var streams []*tunnelStream
+	for requestID, stream := range s.pendingTunnelStreams {
+		if stream.conn.ID == connID {
+			streams = append(streams, stream)
+			delete(s.pendingTunnelStreams, requestID)
+		}
+	}
+	s.tunnelStreamsMu.Unlock()
+
+	for _, stream := range streams {
+		stream.reset(errTunnelAgentDisconnected)
+	}
+}
+
+// handleFrame routes a protocol v2 binary frame received on an agent
+// connection to its stream. Frames for streams opened on another connection
+// are dropped.
+func (s *Server) handleFrame(planeName, connID string, data []byte) {
+	frame, err := messaging.DecodeFrame(data)
+	if err != nil {
+		s.logger.Warn("failed to decode tunnel frame", "plane", planeName, "error", err)
+		return
+	}
+
+	s.tunnelStreamsMu.RLock()
+	stream, ok := s.pendingTunnelStreams[frame.RequestID]
+	s.tunnelStreamsMu.RUnlock()
+
+	if !ok || stream.conn.ID != connID {
+		s.logger.Debug("received tunnel frame for unknown stream",
+			"plane", planeName,
+			"connectionID", connID,
+			"requestID", frame.RequestID,
+			"type", frame.Type.String(),
+		)
+		return
+	}
+
+	if err := stream.enqueue(frame); err != nil {
+		// Only the offending stream is reset; the read loop carries on for the others
+		s.logger.Warn("resetting tunnel stream", "plane", planeName, "requestID", frame.RequestID, "error", err)
+		s.unregisterTunnelStream(stream.requestID)
+		if stream.reset(err) {
+			go s.cancelTunnelStream(stream)
+		}
+	}
+}
+
+// awaitHeader waits for the response header frame of a stream.
+func (st *tunnelStream) awaitHeader(ctx context.Context) (*messaging.HTTPTunnelResponse, error) {
+	frame, err := st.pop(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Type != messaging.FrameResponseHeader {
+		return nil, fmt.Errorf("unexpected %s frame before response header", frame.Type)
+	}
+	return messaging.ParseResponseHeaderFrame(frame)
+}
+
+// next returns the next chunk of the response body. It returns io.EOF once the
+// agent has ended the stream cleanly. Each chunk returned must be acknowledged
+// with ack after it has been consumed so the agent can send more.
+func (st *tunnelStream) next(ctx context.Context) ([]byte, error) {
+	frame, err := st.pop(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch frame.Type {
+	case messaging.FrameData:
+		return frame.Payload, nil
+	case messaging.FrameEnd:
+		details, err := messaging.ParseEndFrame(frame)
+		if err != nil {
+			return nil, err
+		}
+		if details != nil {
+			return nil, fmt.Errorf("agent aborted stream: %s", details.Message)
+		}
+		return nil, io.EOF
+	default:
+		return nil, fmt.Errorf("unexpected %s frame in response body", frame.Type)
+	}
+}
+
+// ack returns n consumed body bytes to the agent's flow-control window.
+func (st *tunnelStream) ack(n int) error {
+	if n == 0 {
+		return nil
+	}
+	st.mu.Lock()
+	st.unacked -= n
+	st.mu.Unlock()
+	return st.conn.SendFrame(messaging.NewWindowUpdateFrame(st.requestID, n))
+}
+
+// collectTunnelStream sends req over a protocol v2 connection and buffers the
+// streamed response into a single HTTPTunnelResponse, for callers that need
+// the whole body.
+func (s *Server) collectTunnelStream(conn *AgentConnection, req *messaging.HTTPTunnelRequest, timeout time.Duration) (*messaging.HTTPTunnelResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stream, err := s.openTunnelStream(conn, req)
+	if err != nil {
+		return nil, err
+	}
+	completed := false
+	defer func() { s.closeTunnelStream(stream, completed) }()
+
+	resp, err := stream.awaitHeader(ctx)
+	if err != nil {
+		return nil, tunnelStreamError(err)
+	}
+
+	var body bytes.Buffer
+	for {
+		chunk, err := stream.next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, tunnelStreamError(err)
+		}
+		body.Write(chunk)
+		if err := stream.ack(len(chunk)); err != nil {
+			return nil, fmt.Errorf("failed to send window update: %w", err)
+		}
+	}
+	completed = true
+
+	if body.Len() > 0 {
+		resp.Body = body.Bytes()
+	}
+	return resp, nil
+}
+
+// streamHTTPProxyResponse proxies req over a protocol v2 connection, writing the
+// response body to w as it arrives. The agent is told to cancel the backend
+// request if the client goes away or the header does not arrive within timeout.
+func (s *Server) streamHTTPProxyResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	conn *AgentConnection,
+	req *messaging.HTTPTunnelRequest,
+	timeout time.Duration,
+	logger *slog.Logger,
+) {
+	stream, err := s.openTunnelStream(conn, req)
+	if err != nil {
+		logger.Error("HTTP tunnel stream request failed", "target", req.Target, "error", err)
+		http.Error(w, fmt.Sprintf("proxy request failed: %v", err), http.StatusBadGateway)
+		return
+	}
+	completed := false
+	defer func() { s.closeTunnelStream(stream, completed) }()
+
+	headerCtx, cancel := context.WithTimeout(r.Context(), timeout)
+	resp, err := stream.awaitHeader(headerCtx)
+	cancel()
+	if err != nil {
+		logger.Error("HTTP tunnel stream request failed", "target", req.Target, "error", tunnelStreamError(err))
+		http.Error(w, fmt.Sprintf("proxy request failed: %v", tunnelStreamError(err)), http.StatusBadGateway)
+		return
+	}
+
+	for key, values := range resp.Headers {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	// The body length is not known up front; let net/http chunk the response.
+	w.Header().Del("Content-Length")
+	w.WriteHeader(resp.StatusCode)
+
+	flusher, _ := w.(http.Flusher)
+	var written int64
+	for {
+		chunk, err := stream.next(r.Context())
+		if errors.Is(err, io.EOF) {
+			completed = true
+			break
+		}
+		if err != nil {
+			logger.Warn("HTTP tunnel stream interrupted", "bytesWritten", written, "error", err)
+			return
+		}
+		if _, err := w.Write(chunk); err != nil {
+			logger.Warn("failed to write response body", "error", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		written += int64(len(chunk))
+		if err := stream.ack(len(chunk)); err != nil {
+			logger.Warn("failed to send window update", "error", err)
+			return
+		}
+	}
+
+	logger.Info("HTTP proxy stream completed",
+		"plane", conn.PlaneIdentifier,
+		"target", req.Target,
+		"statusCode", resp.StatusCode,
+		"bodySize", written,
+	)
+}
+
+// tunnelStreamError maps a deadline on the header wait to the same timeout
+// error returned by the non-streaming protocol.
+func tunnelStreamError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errors.New("HTTP tunnel request timeout")
+	}
+	return err
+}
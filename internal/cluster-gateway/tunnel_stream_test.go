@@ -0,0 +1,257 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// streamingAgentConn simulates a protocol v2 agent: when the gateway writes a
+// request frame, it replies with the frames produced by respond.
+type streamingAgentConn struct {
+	mockGatewayConn
+	server  *Server
+	connID  string
+	respond func(req *messaging.HTTPTunnelRequest) []*messaging.Frame
+
+	framesMu sync.Mutex
+	frames   []*messaging.Frame
+}
+
+func (c *streamingAgentConn) Subprotocol() string { return messaging.ProtocolV2 }
+
+func (c *streamingAgentConn) WriteMessage(messageType int, data []byte) error {
+	if err := c.mockGatewayConn.WriteMessage(messageType, data); err != nil {
+		return err
+	}
+	f, err := messaging.DecodeFrame(data)
+	if err != nil {
+		return err
+	}
+	c.framesMu.Lock()
+	c.frames = append(c.frames, f)
+	c.framesMu.Unlock()
+
+	if f.Type == messaging.FrameRequest {
+		req, err := messaging.ParseRequestFrame(f)
+		if err != nil {
+			return err
+		}
+		go func() {
+			for _, reply := range c.respond(req) {
+				data, _ := reply.Encode()
+				c.server.handleFrame("dataplane/prod", c.connID, data)
+			}
+		}()
+	}
+	return nil
+}
+
+func (c *streamingAgentConn) sentFrames(frameType messaging.FrameType) []*messaging.Frame {
+	c.framesMu.Lock()
+	defer c.framesMu.Unlock()
+	var out []*messaging.Frame
+	for _, f := range c.frames {
+		if f.Type == frameType {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func chunkedResponse(status int, chunks ...string) func(req *messaging.HTTPTunnelRequest) []*messaging.Frame {
+	return func(req *messaging.HTTPTunnelRequest) []*messaging.Frame {
+		header, _ := messaging.NewResponseHeaderFrame(&messaging.HTTPTunnelResponse{
+			RequestID:  req.RequestID,
+			StatusCode: status,
+			Headers:    map[string][]string{"Content-Type": {"application/json"}},
+		})
+		frames := []*messaging.Frame{header}
+		for _, c := range chunks {
+			frames = append(frames, &messaging.Frame{Type: messaging.FrameData, RequestID: req.RequestID, Payload: []byte(c)})
+		}
+		end, _ := messaging.NewEndFrame(req.RequestID, nil)
+		return append(frames, end)
+	}
+}
+
+func newStreamingTestServer(t *testing.T, respond func(req *messaging.HTTPTunnelRequest) []*messaging.Frame) (*Server, *streamingAgentConn) {
+	t.Helper()
+	s := New(&Config{}, fake.NewClientBuilder().WithScheme(testScheme()).Build(), testLogger())
+	conn := &streamingAgentConn{server: s, respond: respond}
+	connID, err := s.connMgr.Register("dataplane", "prod", conn, []string{"ns/dp1"}, nil, nil)
+	require.NoError(t, err)
+	conn.connID = connID
+	return s, conn
+}
+
+func TestRegister_NegotiatedProtocolVersion(t *testing.T) {
+	s, _ := newStreamingTestServer(t, chunkedResponse(http.StatusOK))
+	conn, err := s.connMgr.Get("dataplane/prod")
+	require.NoError(t, err)
+	assert.Equal(t, 2, conn.ProtocolVersion)
+	assert.True(t, conn.SupportsStreaming())
+
+	_, err = s.connMgr.Register("dataplane", "legacy", &mockGatewayConn{}, nil, nil, nil)
+	require.NoError(t, err)
+	legacy, err := s.connMgr.Get("dataplane/legacy")
+	require.NoError(t, err)
+	assert.Equal(t, 1, legacy.ProtocolVersion)
+	assert.False(t, legacy.SupportsStreaming())
+}
+
+func TestSendHTTPTunnelRequestForCR_CollectsStreamedResponse(t *testing.T) {
+	s, conn := newStreamingTestServer(t, chunkedResponse(http.StatusOK, `{"items":[`, `1,2`, `]}`))
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil)
+	resp, err := s.SendHTTPTunnelRequestForCR("dataplane/prod", "ns/dp1", req, time.Second)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []byte(`{"items":[1,2]}`), resp.Body)
+
+	// Each consumed chunk is returned to the agent's window; a completed stream is not canceled
+	assert.Len(t, conn.sentFrames(messaging.FrameWindowUpdate), 3)
+	assert.Empty(t, conn.sentFrames(messaging.FrameCancel))
+	assert.Empty(t, s.pendingTunnelStreams)
+}
+
+func TestSendHTTPTunnelRequestForCR_StreamAbortedByAgent(t *testing.T) {
+	s, _ := newStreamingTestServer(t, func(req *messaging.HTTPTunnelRequest) []*messaging.Frame {
+		header, _ := messaging.NewResponseHeaderFrame(&messaging.HTTPTunnelResponse{RequestID: req.RequestID, StatusCode: http.StatusOK})
+		end, _ := messaging.NewEndFrame(req.RequestID, &messaging.ErrorDetails{Code: http.StatusBadGateway, Message: "backend reset"})
+		return []*messaging.Frame{header, end}
+	})
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil)
+	_, err := s.SendHTTPTunnelRequestForCR("dataplane/prod", "ns/dp1", req, time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backend reset")
+}
+
+func TestSendHTTPTunnelRequestForCR_StreamTimeoutCancels(t *testing.T) {
+	s, conn := newStreamingTestServer(t, func(_ *messaging.HTTPTunnelRequest) []*messaging.Frame { return nil })
+
+	req := messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil)
+	_, err := s.SendHTTPTunnelRequestForCR("dataplane/prod", "ns/dp1", req, 50*time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout")
+
+	cancels := conn.sentFrames(messaging.FrameCancel)
+	require.Len(t, cancels, 1)
+	assert.Equal(t, req.RequestID, cancels[0].RequestID)
+	assert.Empty(t, s.pendingTunnelStreams)
+}
+
+func TestHandleHTTPProxy_StreamsResponse(t *testing.T) {
+	s, _ := newStreamingTestServer(t, chunkedResponse(http.StatusOK, "first,", "second"))
+
+	r := httptest.NewRequest(http.MethodGet, "/api/proxy/dataplane/prod/ns/dp1/k8s/api/v1/pods", nil)
+	w := httptest.NewRecorder()
+	s.handleHTTPProxy(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, "first,second", w.Body.String())
+	assert.True(t, w.Flushed)
+}
+
+func TestHandleFrame_UnknownStreamIgnored(t *testing.T) {
+	s, _ := newStreamingTestServer(t, chunkedResponse(http.StatusOK))
+	data, err := (&messaging.Frame{Type: messaging.FrameData, RequestID: "missing", Payload: []byte("x")}).Encode()
+	require.NoError(t, err)
+
+	s.handleFrame("dataplane/prod", "conn", data)
+	s.handleFrame("dataplane/prod", "conn", []byte{1})
+
+	assert.Empty(t, s.pendingTunnelStreams)
+}
+
+func TestHandleFrame_WindowOverrunResetsOnlyThatStream(t *testing.T) {
+	s, agent := newStreamingTestServer(t, func(_ *messaging.HTTPTunnelRequest) []*messaging.Frame { return nil })
+	conn, err := s.connMgr.Get("dataplane/prod")
+	require.NoError(t, err)
+
+	deliver := func(requestID string, payload []byte) {
+		data, err := (&messaging.Frame{Type: messaging.FrameData, RequestID: requestID, Payload: payload}).Encode()
+		require.NoError(t, err)
+		s.handleFrame("dataplane/prod", conn.ID, data)
+	}
+
+	overrun, err := s.openTunnelStream(conn, messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil))
+	require.NoError(t, err)
+	healthy, err := s.openTunnelStream(conn, messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil))
+	require.NoError(t, err)
+
+	// Nobody consumes either stream: filling the window must not block the reader
+	deliver(overrun.requestID, make([]byte, messaging.DefaultStreamWindow))
+	deliver(healthy.requestID, []byte("ok"))
+	deliver(overrun.requestID, []byte("x"))
+
+	_, err = overrun.next(t.Context())
+	require.ErrorIs(t, err, errTunnelWindowExceeded)
+	require.Eventually(t, func() bool { return len(agent.sentFrames(messaging.FrameCancel)) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, overrun.requestID, agent.sentFrames(messaging.FrameCancel)[0].RequestID)
+
+	chunk, err := healthy.next(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ok"), chunk)
+
+	s.closeTunnelStream(overrun, false)
+	s.closeTunnelStream(healthy, true)
+	assert.Len(t, agent.sentFrames(messaging.FrameCancel), 1)
+	assert.Empty(t, s.pendingTunnelStreams)
+}
+
+func TestHandleFrame_IgnoresFramesFromAnotherConnection(t *testing.T) {
+	s, _ := newStreamingTestServer(t, func(_ *messaging.HTTPTunnelRequest) []*messaging.Frame { return nil })
+	conn, err := s.connMgr.Get("dataplane/prod")
+	require.NoError(t, err)
+
+	stream, err := s.openTunnelStream(conn, messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil))
+	require.NoError(t, err)
+	defer s.closeTunnelStream(stream, false)
+
+	data, err := (&messaging.Frame{Type: messaging.FrameData, RequestID: stream.requestID, Payload: []byte("x")}).Encode()
+	require.NoError(t, err)
+	s.handleFrame("dataplane/prod", "other-connection", data)
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	assert.Empty(t, stream.queue)
+}
+
+func TestResetConnectionTunnelStreams_FailsStreamsOfDisconnectedAgent(t *testing.T) {
+	s, _ := newStreamingTestServer(t, func(_ *messaging.HTTPTunnelRequest) []*messaging.Frame { return nil })
+	conn, err := s.connMgr.Get("dataplane/prod")
+	require.NoError(t, err)
+
+	stream, err := s.openTunnelStream(conn, messaging.NewHTTPTunnelRequest("k8s", http.MethodGet, "/api/v1/pods", "", nil, nil))
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := stream.next(t.Context())
+		errCh <- err
+	}()
+	s.resetConnectionTunnelStreams(conn.ID)
+
+	select {
+	case err := <-errCh:
+		require.ErrorIs(t, err, errTunnelAgentDisconnected)
+	case <-time.After(time.Second):
+		t.Fatal("stream consumer was not released on disconnect")
+	}
+	assert.Empty(t, s.pendingTunnelStreams)
+}
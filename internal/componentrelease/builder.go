@@ -4,6 +4,7 @@
 package componentrelease
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -20,11 +21,17 @@ type BuildInput struct {
 	Traits        map[string]openchoreov1alpha1.TraitSpec
 	ClusterTraits map[string]openchoreov1alpha1.ClusterTraitSpec
 	Workload      *openchoreov1alpha1.WorkloadTemplateSpec
+	// ImageResolver, when set, provides the resolver used to pin the workload image to a digest.
+	ImageResolver ResolverFactory
 }
 
 // BuildSpec assembles a ComponentReleaseSpec from resolved resources.
-// Both the controller and API service use this to ensure consistent spec construction.
-func BuildSpec(input BuildInput) (*openchoreov1alpha1.ComponentReleaseSpec, error) {
+// The controller, the API service and file-system mode use this to ensure consistent spec construction.
+//
+// When input.ImageResolver is set, a tagged workload image is pinned to the digest it resolves
+// to. Pinning is best-effort: if it fails, BuildSpec returns the complete spec with the image as
+// authored together with an *ImagePinError, which callers report instead of failing the release.
+func BuildSpec(ctx context.Context, input BuildInput) (*openchoreov1alpha1.ComponentReleaseSpec, error) {
 	if input.Component == nil {
 		return nil, fmt.Errorf("component cannot be nil")
 	}
@@ -55,7 +62,7 @@ func BuildSpec(input BuildInput) (*openchoreov1alpha1.ComponentReleaseSpec, erro
 		ct.Kind = openchoreov1alpha1.ComponentTypeRefKindComponentType
 	}
 
-	return &openchoreov1alpha1.ComponentReleaseSpec{
+	spec := &openchoreov1alpha1.ComponentReleaseSpec{
 		Owner: openchoreov1alpha1.ComponentReleaseOwner{
 			ProjectName:   input.Component.Spec.Owner.ProjectName,
 			ComponentName: input.Component.Name,
@@ -64,7 +71,22 @@ func BuildSpec(input BuildInput) (*openchoreov1alpha1.ComponentReleaseSpec, erro
 		Traits:           traits,
 		ComponentProfile: buildComponentProfile(input.Component),
 		Workload:         *input.Workload,
-	}, nil
+	}
+	if input.ImageResolver != nil {
+		if err := pinWorkloadImage(ctx, spec, input); err != nil {
+			return spec, &ImagePinError{Image: spec.Workload.Container.Image, Err: err}
+		}
+	}
+	return spec, nil
+}
+
+// pinWorkloadImage pins the workload image of spec with the resolver of the input's component.
+func pinWorkloadImage(ctx context.Context, spec *openchoreov1alpha1.ComponentReleaseSpec, input BuildInput) error {
+	resolver, err := input.ImageResolver(ctx, input.Component)
+	if err != nil {
+		return err
+	}
+	return pinImage(ctx, spec, resolver)
 }
 
 // hasTraitByKind checks whether the named trait exists in the correct map based on its kind.
@@ -4,6 +4,7 @@
 package componentrelease
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -81,7 +82,7 @@ func TestBuildSpec_NilInputs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := BuildSpec(tt.input)
+			_, err := BuildSpec(context.Background(), tt.input)
 			if err == nil {
 				t.Fatal("expected error, got nil")
 			}
@@ -99,7 +100,7 @@ func TestBuildSpec_BasicFields(t *testing.T) {
 	}
 
 	t.Run("minimal input with no traits or parameters", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component:     makeComponent("my-project", "my-component", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: ct,
 			Workload:      workload,
@@ -134,7 +135,7 @@ func TestBuildSpec_BasicFields(t *testing.T) {
 	})
 
 	t.Run("default kind applied when kind is empty", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 				Name: "deployment/default-type",
@@ -151,7 +152,7 @@ func TestBuildSpec_BasicFields(t *testing.T) {
 	})
 
 	t.Run("cluster component type kind preserved", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 				Kind: openchoreov1alpha1.ComponentTypeRefKindClusterComponentType,
@@ -169,7 +170,7 @@ func TestBuildSpec_BasicFields(t *testing.T) {
 	})
 
 	t.Run("nil traits map produces nil", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: ct,
 			Workload:      workload,
@@ -190,7 +191,7 @@ func TestBuildSpec_WithTraits(t *testing.T) {
 	}
 
 	t.Run("with traits", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: ct,
 			Traits: map[string]openchoreov1alpha1.TraitSpec{
@@ -214,7 +215,7 @@ func TestBuildSpec_WithTraits(t *testing.T) {
 	})
 
 	t.Run("traits and cluster traits merged", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: ct,
 			Traits: map[string]openchoreov1alpha1.TraitSpec{
@@ -240,7 +241,7 @@ func TestBuildSpec_WithTraits(t *testing.T) {
 	})
 
 	t.Run("same-name Trait and ClusterTrait coexist as separate entries", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: ct,
 			Traits: map[string]openchoreov1alpha1.TraitSpec{
@@ -302,7 +303,7 @@ func TestBuildSpec_DeterministicTraitOrder(t *testing.T) {
 	// A single build could match the sorted order by chance, so require all N builds agree.
 	const iterations = 20
 	for i := 0; i < iterations; i++ {
-		spec, err := BuildSpec(input)
+		spec, err := BuildSpec(context.Background(), input)
 		if err != nil {
 			t.Fatalf("iteration %d: unexpected error: %v", i, err)
 		}
@@ -325,7 +326,7 @@ func TestBuildSpec_WithComponentTraits(t *testing.T) {
 	}
 
 	t.Run("with parameters and component traits", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{
 				Parameters: &runtime.RawExtension{Raw: []byte(`{"replicas": 3}`)},
 				Traits: []openchoreov1alpha1.ComponentTrait{
@@ -363,7 +364,7 @@ func TestBuildSpec_WithComponentTraits(t *testing.T) {
 	})
 
 	t.Run("missing component trait returns error", func(t *testing.T) {
-		_, err := BuildSpec(BuildInput{
+		_, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{
 				Traits: []openchoreov1alpha1.ComponentTrait{
 					{Name: "missing-trait"},
@@ -381,7 +382,7 @@ func TestBuildSpec_WithComponentTraits(t *testing.T) {
 	})
 
 	t.Run("cluster trait in wrong map returns error", func(t *testing.T) {
-		_, err := BuildSpec(BuildInput{
+		_, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{
 				Traits: []openchoreov1alpha1.ComponentTrait{
 					{Kind: openchoreov1alpha1.TraitRefKindClusterTrait, Name: "my-trait"},
@@ -402,7 +403,7 @@ func TestBuildSpec_WithComponentTraits(t *testing.T) {
 	})
 
 	t.Run("component trait in cluster traits passes validation", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{
 				Traits: []openchoreov1alpha1.ComponentTrait{
 					{Kind: openchoreov1alpha1.TraitRefKindClusterTrait, Name: "my-cluster-trait"},
@@ -432,7 +433,7 @@ func TestBuildSpec_EmbeddedTraits(t *testing.T) {
 	}
 
 	t.Run("missing embedded trait returns error", func(t *testing.T) {
-		_, err := BuildSpec(BuildInput{
+		_, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 				Kind: openchoreov1alpha1.ComponentTypeRefKindComponentType,
@@ -456,7 +457,7 @@ func TestBuildSpec_EmbeddedTraits(t *testing.T) {
 	})
 
 	t.Run("all embedded traits present passes validation", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 				Kind: openchoreov1alpha1.ComponentTypeRefKindComponentType,
@@ -485,7 +486,7 @@ func TestBuildSpec_EmbeddedTraits(t *testing.T) {
 	})
 
 	t.Run("embedded trait in cluster traits passes validation", func(t *testing.T) {
-		spec, err := BuildSpec(BuildInput{
+		spec, err := BuildSpec(context.Background(), BuildInput{
 			Component: makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 			ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 				Kind: openchoreov1alpha1.ComponentTypeRefKindComponentType,
@@ -518,7 +519,7 @@ func TestBuildSpec_EmbeddedTraits(t *testing.T) {
 // carrying the given traits map, failing the test on any build error.
 func buildSpecForTest(t *testing.T, traits map[string]openchoreov1alpha1.TraitSpec) *openchoreov1alpha1.ComponentReleaseSpec {
 	t.Helper()
-	out, err := BuildSpec(BuildInput{
+	out, err := BuildSpec(context.Background(), BuildInput{
 		Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 		ComponentType: makeCT(),
 		Traits:        traits,
@@ -559,7 +560,7 @@ func TestBuildSpec_PreservesComponentTypePostRenderValidations(t *testing.T) {
 		Rule:    "${resource.spec.replicas == 1}",
 		Message: "single replica",
 	}}
-	out, err := BuildSpec(BuildInput{
+	out, err := BuildSpec(context.Background(), BuildInput{
 		Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
 		ComponentType: ct,
 		Workload: &openchoreov1alpha1.WorkloadTemplateSpec{
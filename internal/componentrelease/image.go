@@ -0,0 +1,107 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/registry"
+)
+
+// DigestResolver resolves an image reference to the digest of the manifest it points to.
+type DigestResolver interface {
+	ResolveDigest(ctx context.Context, image string) (string, error)
+}
+
+// ResolverFactory returns the DigestResolver used for the images of a component.
+type ResolverFactory func(ctx context.Context, component *openchoreov1alpha1.Component) (DigestResolver, error)
+
+// RegistryResolverFactory returns a ResolverFactory that resolves digests against the image
+// registries, authenticating with the registry credentials referenced by the component.
+// Secrets are read with c, which should be an uncached reader so that no Secret informer is
+// started. Registries listed in insecureRegistries are reached over plain HTTP.
+func RegistryResolverFactory(c client.Reader, insecureRegistries []string) ResolverFactory {
+	return func(ctx context.Context, component *openchoreov1alpha1.Component) (DigestResolver, error) {
+		auth := component.Spec.RegistryAuthentication
+		if auth == nil || auth.SecretRef == "" {
+			return registry.NewResolver(nil, insecureRegistries), nil
+		}
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: component.Namespace, Name: auth.SecretRef}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get registry credentials secret %q: %w", auth.SecretRef, err)
+		}
+		creds, err := registry.CredentialsFromSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		return registry.NewResolver(creds, insecureRegistries), nil
+	}
+}
+
+// ReuseDigest returns a ResolverFactory that resolves pinned.Reference to pinned.Digest without
+// contacting the registry, and any other image with the resolver of factory. Callers pass the
+// image of the previous release so a tag is only resolved again when the authored image changes.
+func ReuseDigest(factory ResolverFactory, pinned *openchoreov1alpha1.ReleaseImage) ResolverFactory {
+	return func(ctx context.Context, component *openchoreov1alpha1.Component) (DigestResolver, error) {
+		return &reusedDigestResolver{pinned: pinned, factory: factory, component: component}, nil
+	}
+}
+
+type reusedDigestResolver struct {
+	pinned    *openchoreov1alpha1.ReleaseImage
+	factory   ResolverFactory
+	component *openchoreov1alpha1.Component
+}
+
+func (r *reusedDigestResolver) ResolveDigest(ctx context.Context, image string) (string, error) {
+	if image == r.pinned.Reference {
+		return r.pinned.Digest, nil
+	}
+	resolver, err := r.factory(ctx, r.component)
+	if err != nil {
+		return "", err
+	}
+	return resolver.ResolveDigest(ctx, image)
+}
+
+// ImagePinError reports that the workload image of a release could not be pinned to a digest.
+type ImagePinError struct {
+	Image string
+	Err   error
+}
+
+func (e *ImagePinError) Error() string {
+	return fmt.Sprintf("failed to pin image %q to a digest: %v", e.Image, e.Err)
+}
+
+func (e *ImagePinError) Unwrap() error {
+	return e.Err
+}
+
+// pinImage pins the workload container image of a release spec to the digest it currently
+// resolves to, so the release keeps running the same image when its tag is re-pushed.
+// The image as authored is kept in spec.image for display. The spec is left unchanged on error.
+func pinImage(ctx context.Context, spec *openchoreov1alpha1.ComponentReleaseSpec, resolver DigestResolver) error {
+	image := spec.Workload.Container.Image
+	if image == "" {
+		return nil
+	}
+	name, _, digest := registry.SplitReference(image)
+	if digest == "" {
+		var err error
+		if digest, err = resolver.ResolveDigest(ctx, image); err != nil {
+			return err
+		}
+		// Keep the image name as authored so the release renders the same registry host.
+		spec.Workload.Container.Image = name + "@" + digest
+	}
+	spec.Image = &openchoreov1alpha1.ReleaseImage{Reference: image, Digest: digest}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+type fakeResolver struct {
+	digest string
+	err    error
+	calls  int
+}
+
+func (f *fakeResolver) ResolveDigest(context.Context, string) (string, error) {
+	f.calls++
+	return f.digest, f.err
+}
+
+func releaseSpecWithImage(image string) *openchoreov1alpha1.ComponentReleaseSpec {
+	spec := &openchoreov1alpha1.ComponentReleaseSpec{}
+	spec.Workload.Container.Image = image
+	return spec
+}
+
+func TestPinImage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tag is pinned to the resolved digest", func(t *testing.T) {
+		spec := releaseSpecWithImage("registry.example.com:5000/acme/app:v1")
+		if err := pinImage(ctx, spec, &fakeResolver{digest: "sha256:0123"}); err != nil {
+			t.Fatalf("pinImage() error = %v", err)
+		}
+		if got, want := spec.Workload.Container.Image, "registry.example.com:5000/acme/app@sha256:0123"; got != want {
+			t.Errorf("image = %q, want %q", got, want)
+		}
+		if spec.Image == nil || spec.Image.Reference != "registry.example.com:5000/acme/app:v1" || spec.Image.Digest != "sha256:0123" {
+			t.Errorf("spec.image = %+v", spec.Image)
+		}
+	})
+
+	t.Run("image already pinned is not resolved", func(t *testing.T) {
+		spec := releaseSpecWithImage("acme/app:v1@sha256:abcd")
+		resolver := &fakeResolver{}
+		if err := pinImage(ctx, spec, resolver); err != nil {
+			t.Fatalf("pinImage() error = %v", err)
+		}
+		if resolver.calls != 0 {
+			t.Errorf("resolver called %d times", resolver.calls)
+		}
+		if spec.Workload.Container.Image != "acme/app:v1@sha256:abcd" || spec.Image.Digest != "sha256:abcd" {
+			t.Errorf("unexpected pinning: image %q, spec.image %+v", spec.Workload.Container.Image, spec.Image)
+		}
+	})
+
+	t.Run("resolution failure leaves the spec unchanged", func(t *testing.T) {
+		spec := releaseSpecWithImage("acme/app:v1")
+		if err := pinImage(ctx, spec, &fakeResolver{err: errors.New("manifest unknown")}); err == nil {
+			t.Fatal("expected error")
+		}
+		if spec.Workload.Container.Image != "acme/app:v1" || spec.Image != nil {
+			t.Errorf("spec was modified: image %q, spec.image %+v", spec.Workload.Container.Image, spec.Image)
+		}
+	})
+
+	t.Run("empty image", func(t *testing.T) {
+		spec := releaseSpecWithImage("")
+		if err := pinImage(ctx, spec, &fakeResolver{}); err != nil || spec.Image != nil {
+			t.Errorf("pinImage() = %v, spec.image %+v", err, spec.Image)
+		}
+	})
+}
+
+func TestBuildSpec_PinsImage(t *testing.T) {
+	ctx := context.Background()
+	input := BuildInput{
+		Component:     makeComponent("proj", "comp", openchoreov1alpha1.ComponentSpec{}),
+		ComponentType: makeCT(),
+		Workload:      &openchoreov1alpha1.WorkloadTemplateSpec{Container: openchoreov1alpha1.Container{Image: "acme/app:v1"}},
+	}
+	resolverFor := func(resolver DigestResolver, err error) ResolverFactory {
+		return func(context.Context, *openchoreov1alpha1.Component) (DigestResolver, error) {
+			return resolver, err
+		}
+	}
+
+	input.ImageResolver = resolverFor(&fakeResolver{digest: "sha256:0123"}, nil)
+	spec, err := BuildSpec(ctx, input)
+	if err != nil {
+		t.Fatalf("BuildSpec() error = %v", err)
+	}
+	if spec.Workload.Container.Image != "acme/app@sha256:0123" || input.Workload.Container.Image != "acme/app:v1" {
+		t.Errorf("image = %q, workload image = %q", spec.Workload.Container.Image, input.Workload.Container.Image)
+	}
+
+	// Pinning is best-effort: the spec is still built when the digest cannot be resolved
+	for name, factory := range map[string]ResolverFactory{
+		"resolution failure":  resolverFor(&fakeResolver{err: errors.New("manifest unknown")}, nil),
+		"credentials failure": resolverFor(nil, errors.New("secret not found")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			input.ImageResolver = factory
+			spec, err := BuildSpec(ctx, input)
+			var pinErr *ImagePinError
+			if !errors.As(err, &pinErr) || pinErr.Image != "acme/app:v1" {
+				t.Fatalf("BuildSpec() error = %v, want an ImagePinError", err)
+			}
+			if spec == nil || spec.Workload.Container.Image != "acme/app:v1" || spec.Image != nil {
+				t.Errorf("BuildSpec() spec = %+v, want the unpinned spec", spec)
+			}
+		})
+	}
+}
+
+func TestReuseDigest(t *testing.T) {
+	ctx := context.Background()
+	registry := &fakeResolver{digest: "sha256:4567"}
+	factory := ReuseDigest(func(context.Context, *openchoreov1alpha1.Component) (DigestResolver, error) {
+		return registry, nil
+	}, &openchoreov1alpha1.ReleaseImage{Reference: "acme/app:v1", Digest: "sha256:0123"})
+
+	resolver, err := factory(ctx, &openchoreov1alpha1.Component{})
+	if err != nil {
+		t.Fatalf("factory() error = %v", err)
+	}
+
+	// The previously pinned image is not resolved against the registry again
+	if got, err := resolver.ResolveDigest(ctx, "acme/app:v1"); err != nil || got != "sha256:0123" {
+		t.Errorf("ResolveDigest(acme/app:v1) = %q, %v, want sha256:0123", got, err)
+	}
+	if registry.calls != 0 {
+		t.Errorf("registry resolver called %d times", registry.calls)
+	}
+
+	if got, err := resolver.ResolveDigest(ctx, "acme/app:v2"); err != nil || got != "sha256:4567" {
+		t.Errorf("ResolveDigest(acme/app:v2) = %q, %v, want sha256:4567", got, err)
+	}
+	if registry.calls != 1 {
+		t.Errorf("registry resolver called %d times, want 1", registry.calls)
+	}
+}
+
+func TestRegistryResolverFactory(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-creds", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"username":"ci","password":"token"}}}`),
+		},
+	}
+	factory := RegistryResolverFactory(fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(), nil)
+
+	comp := &openchoreov1alpha1.Component{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	if _, err := factory(context.Background(), comp); err != nil {
+		t.Errorf("factory() without registry authentication error = %v", err)
+	}
+
+	comp.Spec.RegistryAuthentication = &openchoreov1alpha1.RegistryAuthentication{SecretRef: "registry-creds"}
+	if _, err := factory(context.Background(), comp); err != nil {
+		t.Errorf("factory() error = %v", err)
+	}
+
+	comp.Spec.RegistryAuthentication.SecretRef = "missing"
+	if _, err := factory(context.Background(), comp); err == nil {
+		t.Error("expected error for a missing secret")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// Reconciler reconciles an AuthzAccessRequest object
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// Now returns the current time. Defaults to time.Now; tests replace it to control the clock.
+	Now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=authzaccessrequests,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=authzaccessrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=authzaccessrequests/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=authzrolebindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates the expiring AuthzRoleBinding of an approved AuthzAccessRequest, and removes
+// it and marks the request expired once the granted duration has passed. Approval and denial are
+// recorded in the status by openchoreo-api, which checks and audits the reviewer.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	ar := &openchoreov1alpha1.AuthzAccessRequest{}
+	if err := r.Get(ctx, req.NamespacedName, ar); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	// The binding is owned by the request and garbage collected with it.
+	if !ar.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := ar.DeepCopy()
+	now := r.now()
+	var result ctrl.Result
+
+	switch ar.Status.Phase {
+	case "", openchoreov1alpha1.AuthzAccessRequestPending:
+		ar.Status.Phase = openchoreov1alpha1.AuthzAccessRequestPending
+		controller.MarkFalseCondition(ar, ConditionAccessGranted, ReasonPendingReview, "Awaiting approval")
+	case openchoreov1alpha1.AuthzAccessRequestDenied:
+		controller.MarkFalseCondition(ar, ConditionAccessGranted, ReasonDenied,
+			fmt.Sprintf("Denied by %s", ar.Status.ReviewedBy))
+	case openchoreov1alpha1.AuthzAccessRequestApproved:
+		if ar.Status.ExpiresAt == nil {
+			start := now
+			if ar.Status.ReviewedAt != nil {
+				start = ar.Status.ReviewedAt.Time
+			}
+			ar.Status.ExpiresAt = &metav1.Time{Time: start.Add(ar.Spec.Duration.Duration)}
+		}
+		if !now.Before(ar.Status.ExpiresAt.Time) {
+			if err := r.expire(ctx, ar); err != nil {
+				logger.Error(err, "Failed to remove the role binding of the expired access request")
+				return ctrl.Result{}, err
+			}
+			break
+		}
+		granted, err := r.grant(ctx, ar)
+		if err != nil {
+			logger.Error(err, "Failed to create the role binding of the access request")
+			return ctrl.Result{}, err
+		}
+		if granted {
+			// Requeue to revoke the access once it expires.
+			result.RequeueAfter = ar.Status.ExpiresAt.Sub(now)
+		}
+	case openchoreov1alpha1.AuthzAccessRequestExpired:
+		// Make sure the binding is gone, e.g. if it was recreated while the request expired.
+		if err := r.deleteBinding(ctx, ar); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(old.Status, ar.Status) {
+		if err := r.Status().Update(ctx, ar); err != nil {
+			logger.Error(err, "Failed to update AuthzAccessRequest status")
+			return ctrl.Result{}, err
+		}
+	}
+	return result, nil
+}
+
+// grant ensures the AuthzRoleBinding of an approved request exists. It returns false when a
+// binding with the name of the request exists but was not created for it.
+func (r *Reconciler) grant(ctx context.Context, ar *openchoreov1alpha1.AuthzAccessRequest) (bool, error) {
+	binding := &openchoreov1alpha1.AuthzRoleBinding{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: ar.Namespace, Name: ar.Name}, binding)
+	switch {
+	case apierrors.IsNotFound(err):
+		binding = buildBinding(ar)
+		if err := controllerutil.SetControllerReference(ar, binding, r.Scheme); err != nil {
+			return false, err
+		}
+		if err := r.Create(ctx, binding); err != nil {
+			return false, err
+		}
+		log.FromContext(ctx).Info("Created role binding of the access request",
+			"binding", binding.Name, "expiresAt", ar.Status.ExpiresAt)
+		r.recordEvent(ar, corev1.EventTypeNormal, EventReasonAccessGranted,
+			fmt.Sprintf("Granted %s %q to %s:%s until %s", ar.Spec.RoleRef.Kind, ar.Spec.RoleRef.Name,
+				ar.Spec.Entitlement.Claim, ar.Spec.Entitlement.Value, ar.Status.ExpiresAt.UTC().Format(time.RFC3339)))
+	case err != nil:
+		return false, err
+	case !metav1.IsControlledBy(binding, ar):
+		controller.MarkFalseCondition(ar, ConditionAccessGranted, ReasonBindingConflict,
+			fmt.Sprintf("AuthzRoleBinding %q exists and was not created for this request", binding.Name))
+		return false, nil
+	}
+
+	ar.Status.BindingName = binding.Name
+	controller.MarkTrueCondition(ar, ConditionAccessGranted, ReasonBindingCreated,
+		fmt.Sprintf("Access granted until %s", ar.Status.ExpiresAt.UTC().Format(time.RFC3339)))
+	return true, nil
+}
+
+// expire removes the binding of a request whose access has expired and marks the request expired.
+func (r *Reconciler) expire(ctx context.Context, ar *openchoreov1alpha1.AuthzAccessRequest) error {
+	if err := r.deleteBinding(ctx, ar); err != nil {
+		return err
+	}
+	ar.Status.Phase = openchoreov1alpha1.AuthzAccessRequestExpired
+	controller.MarkFalseCondition(ar, ConditionAccessGranted, ReasonExpired,
+		fmt.Sprintf("Access expired at %s", ar.Status.ExpiresAt.UTC().Format(time.RFC3339)))
+	log.FromContext(ctx).Info("Access request expired", "binding", ar.Status.BindingName)
+	r.recordEvent(ar, corev1.EventTypeNormal, EventReasonAccessExpired,
+		fmt.Sprintf("Access to %s %q expired", ar.Spec.RoleRef.Kind, ar.Spec.RoleRef.Name))
+	return nil
+}
+
+// deleteBinding deletes the binding created for the request, if any. Bindings not controlled by
+// the request are left alone.
+func (r *Reconciler) deleteBinding(ctx context.Context, ar *openchoreov1alpha1.AuthzAccessRequest) error {
+	binding := &openchoreov1alpha1.AuthzRoleBinding{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: ar.Namespace, Name: ar.Name}, binding); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(binding, ar) {
+		return nil
+	}
+	if err := r.Delete(ctx, binding); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete AuthzRoleBinding %q: %w", binding.Name, err)
+	}
+	return nil
+}
+
+// buildBinding returns the AuthzRoleBinding granting the role of an approved request until it expires.
+func buildBinding(ar *openchoreov1alpha1.AuthzAccessRequest) *openchoreov1alpha1.AuthzRoleBinding {
+	return &openchoreov1alpha1.AuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ar.Name,
+			Namespace: ar.Namespace,
+			Labels:    map[string]string{labels.LabelKeyAuthzAccessRequest: ar.Name},
+		},
+		Spec: openchoreov1alpha1.AuthzRoleBindingSpec{
+			Entitlement: ar.Spec.Entitlement,
+			RoleMappings: []openchoreov1alpha1.RoleMapping{{
+				RoleRef: ar.Spec.RoleRef,
+				Scope:   ar.Spec.Scope,
+			}},
+			Effect:    openchoreov1alpha1.EffectAllow,
+			ExpiresAt: ar.Status.ExpiresAt.DeepCopy(),
+		},
+	}
+}
+
+func (r *Reconciler) recordEvent(ar *openchoreov1alpha1.AuthzAccessRequest, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(ar, eventType, reason, message)
+	}
+}
+
+func (r *Reconciler) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("authzaccessrequest-controller")
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.AuthzAccessRequest{}).
+		Owns(&openchoreov1alpha1.AuthzRoleBinding{}).
+		Named("authzaccessrequest").
+		Complete(r)
+}
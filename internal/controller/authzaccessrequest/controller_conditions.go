@@ -0,0 +1,40 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionAccessGranted indicates whether the role binding of the request currently grants access.
+	ConditionAccessGranted controller.ConditionType = "AccessGranted"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonPendingReview indicates the request awaits approval.
+	ReasonPendingReview controller.ConditionReason = "PendingReview"
+
+	// ReasonDenied indicates the request was denied.
+	ReasonDenied controller.ConditionReason = "Denied"
+
+	// ReasonBindingCreated indicates the role binding of the approved request exists and has not expired.
+	ReasonBindingCreated controller.ConditionReason = "BindingCreated"
+
+	// ReasonBindingConflict indicates a role binding with the name of the request exists but was not created for it.
+	ReasonBindingConflict controller.ConditionReason = "BindingConflict"
+
+	// ReasonExpired indicates the granted access expired and the role binding was removed.
+	ReasonExpired controller.ConditionReason = "Expired"
+)
+
+// Event reasons recorded on the request.
+const (
+	EventReasonAccessGranted = "AccessGranted"
+	EventReasonAccessExpired = "AccessExpired"
+)
@@ -0,0 +1,205 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	testNamespace = "acme-org"
+	testRequest   = "oncall-crm-backend"
+)
+
+var testNow = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func newRequest(mutate ...func(*openchoreov1alpha1.AuthzAccessRequest)) *openchoreov1alpha1.AuthzAccessRequest {
+	ar := &openchoreov1alpha1.AuthzAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testRequest,
+			Namespace: testNamespace,
+			UID:       "request-uid",
+		},
+		Spec: openchoreov1alpha1.AuthzAccessRequestSpec{
+			Requester:   "alice",
+			Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "sub", Value: "alice"},
+			RoleRef:     openchoreov1alpha1.RoleRef{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "prod-debugger"},
+			Scope:       openchoreov1alpha1.TargetScope{Project: "crm", Component: "backend"},
+			Duration:    metav1.Duration{Duration: 2 * time.Hour},
+			Reason:      "INC-4211",
+		},
+	}
+	for _, m := range mutate {
+		m(ar)
+	}
+	return ar
+}
+
+func approved(ar *openchoreov1alpha1.AuthzAccessRequest) {
+	ar.Status.Phase = openchoreov1alpha1.AuthzAccessRequestApproved
+	ar.Status.ReviewedBy = "bob"
+	ar.Status.ReviewedAt = &metav1.Time{Time: testNow}
+	ar.Status.ExpiresAt = &metav1.Time{Time: testNow.Add(2 * time.Hour)}
+}
+
+func newReconciler(t *testing.T, now time.Time, objs ...client.Object) (*Reconciler, client.Client, *record.FakeRecorder) {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.AuthzAccessRequest{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	return &Reconciler{Client: c, Scheme: s, Recorder: recorder, Now: func() time.Time { return now }}, c, recorder
+}
+
+func reconcileRequest(t *testing.T, r *Reconciler, c client.Client) (*openchoreov1alpha1.AuthzAccessRequest, ctrl.Result) {
+	t.Helper()
+	key := types.NamespacedName{Name: testRequest, Namespace: testNamespace}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	got := &openchoreov1alpha1.AuthzAccessRequest{}
+	require.NoError(t, c.Get(context.Background(), key, got))
+	return got, result
+}
+
+func getBinding(t *testing.T, c client.Client) (*openchoreov1alpha1.AuthzRoleBinding, bool) {
+	t.Helper()
+	binding := &openchoreov1alpha1.AuthzRoleBinding{}
+	err := c.Get(context.Background(), types.NamespacedName{Name: testRequest, Namespace: testNamespace}, binding)
+	if apierrors.IsNotFound(err) {
+		return nil, false
+	}
+	require.NoError(t, err)
+	return binding, true
+}
+
+func TestReconcile_PendingRequestGrantsNothing(t *testing.T) {
+	r, c, _ := newReconciler(t, testNow, newRequest())
+
+	got, result := reconcileRequest(t, r, c)
+
+	assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestPending, got.Status.Phase)
+	cond := meta.FindStatusCondition(got.Status.Conditions, string(ConditionAccessGranted))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonPendingReview), cond.Reason)
+	assert.Zero(t, result.RequeueAfter)
+	_, found := getBinding(t, c)
+	assert.False(t, found)
+}
+
+func TestReconcile_ApprovedRequestCreatesExpiringBinding(t *testing.T) {
+	r, c, recorder := newReconciler(t, testNow.Add(30*time.Minute), newRequest(approved))
+
+	got, result := reconcileRequest(t, r, c)
+
+	binding, found := getBinding(t, c)
+	require.True(t, found)
+	assert.True(t, metav1.IsControlledBy(binding, got))
+	assert.Equal(t, testRequest, binding.Labels[labels.LabelKeyAuthzAccessRequest])
+	assert.Equal(t, got.Spec.Entitlement, binding.Spec.Entitlement)
+	require.Len(t, binding.Spec.RoleMappings, 1)
+	assert.Equal(t, got.Spec.RoleRef, binding.Spec.RoleMappings[0].RoleRef)
+	assert.Equal(t, got.Spec.Scope, binding.Spec.RoleMappings[0].Scope)
+	assert.Equal(t, openchoreov1alpha1.EffectAllow, binding.Spec.Effect)
+	require.NotNil(t, binding.Spec.ExpiresAt)
+	assert.True(t, binding.Spec.ExpiresAt.Equal(got.Status.ExpiresAt))
+
+	assert.Equal(t, testRequest, got.Status.BindingName)
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, string(ConditionAccessGranted)))
+	assert.Equal(t, 90*time.Minute, result.RequeueAfter)
+	assert.Contains(t, <-recorder.Events, EventReasonAccessGranted)
+}
+
+func TestReconcile_ApprovedWithoutExpiryUsesReviewTime(t *testing.T) {
+	r, c, _ := newReconciler(t, testNow, newRequest(approved, func(ar *openchoreov1alpha1.AuthzAccessRequest) {
+		ar.Status.ExpiresAt = nil
+	}))
+
+	got, _ := reconcileRequest(t, r, c)
+
+	require.NotNil(t, got.Status.ExpiresAt)
+	assert.True(t, got.Status.ExpiresAt.Time.Equal(testNow.Add(2*time.Hour)))
+}
+
+func TestReconcile_ExpiredRequestRemovesBinding(t *testing.T) {
+	ar := newRequest(approved)
+	r, c, recorder := newReconciler(t, testNow, ar)
+	reconcileRequest(t, r, c)
+	_, found := getBinding(t, c)
+	require.True(t, found)
+	<-recorder.Events
+
+	r.Now = func() time.Time { return testNow.Add(2 * time.Hour) }
+	got, result := reconcileRequest(t, r, c)
+
+	assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestExpired, got.Status.Phase)
+	cond := meta.FindStatusCondition(got.Status.Conditions, string(ConditionAccessGranted))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonExpired), cond.Reason)
+	assert.Zero(t, result.RequeueAfter)
+	_, found = getBinding(t, c)
+	assert.False(t, found)
+	assert.Contains(t, <-recorder.Events, EventReasonAccessExpired)
+}
+
+func TestReconcile_DeniedRequestGrantsNothing(t *testing.T) {
+	r, c, _ := newReconciler(t, testNow, newRequest(func(ar *openchoreov1alpha1.AuthzAccessRequest) {
+		ar.Status.Phase = openchoreov1alpha1.AuthzAccessRequestDenied
+		ar.Status.ReviewedBy = "bob"
+	}))
+
+	got, _ := reconcileRequest(t, r, c)
+
+	cond := meta.FindStatusCondition(got.Status.Conditions, string(ConditionAccessGranted))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonDenied), cond.Reason)
+	_, found := getBinding(t, c)
+	assert.False(t, found)
+}
+
+func TestReconcile_ExistingUnownedBindingIsLeftAlone(t *testing.T) {
+	existing := &openchoreov1alpha1.AuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: testRequest, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.AuthzRoleBindingSpec{
+			Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "groups", Value: "admins"},
+			RoleMappings: []openchoreov1alpha1.RoleMapping{{
+				RoleRef: openchoreov1alpha1.RoleRef{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "admin"},
+			}},
+			Effect: openchoreov1alpha1.EffectAllow,
+		},
+	}
+	r, c, _ := newReconciler(t, testNow, newRequest(approved), existing)
+
+	got, result := reconcileRequest(t, r, c)
+
+	cond := meta.FindStatusCondition(got.Status.Conditions, string(ConditionAccessGranted))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonBindingConflict), cond.Reason)
+	assert.Empty(t, got.Status.BindingName)
+	assert.Zero(t, result.RequeueAfter)
+	binding, found := getBinding(t, c)
+	require.True(t, found)
+	assert.Equal(t, "admins", binding.Spec.Entitlement.Value)
+}
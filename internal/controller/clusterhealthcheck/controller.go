@@ -0,0 +1,90 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterhealthcheck
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/healthcheck"
+)
+
+// Reconciler reconciles a ClusterHealthCheck object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Registry receives the compiled health checks. It is shared with the
+	// RenderedRelease controller, which evaluates them.
+	Registry *healthcheck.Registry
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterhealthchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterhealthchecks/status,verbs=get;update;patch
+
+// Reconcile compiles the CEL rules of a ClusterHealthCheck into the shared registry
+// and reports whether they are valid in the Ready condition. Deleted checks are
+// removed from the registry.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	hc := &openchoreov1alpha1.ClusterHealthCheck{}
+	if err := r.Get(ctx, req.NamespacedName, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Registry.Delete(req.Name)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ClusterHealthCheck")
+		return ctrl.Result{}, err
+	}
+
+	if !hc.DeletionTimestamp.IsZero() {
+		r.Registry.Delete(hc.Name)
+		return ctrl.Result{}, nil
+	}
+
+	old := hc.DeepCopy()
+	hc.Status.ObservedGeneration = hc.Generation
+
+	if err := r.Registry.Set(hc.Name, hc.Spec); err != nil {
+		logger.Info("ClusterHealthCheck has invalid rules", "error", err.Error())
+		controller.MarkFalseCondition(hc, ConditionReady, ReasonInvalidExpression, err.Error())
+	} else {
+		controller.MarkTrueCondition(hc, ConditionReady, ReasonValid,
+			fmt.Sprintf("Health check is active for %s", targetString(hc.Spec.Target)))
+	}
+
+	if apiequality.Semantic.DeepEqual(old.Status, hc.Status) {
+		return ctrl.Result{}, nil
+	}
+	if err := r.Status().Update(ctx, hc); err != nil {
+		logger.Error(err, "Failed to update ClusterHealthCheck status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// targetString formats a target as Kind.group, or just Kind for the core group.
+func targetString(target openchoreov1alpha1.HealthCheckTarget) string {
+	if target.Group == "" {
+		return target.Kind
+	}
+	return target.Kind + "." + target.Group
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.ClusterHealthCheck{}).
+		Named("clusterhealthcheck").
+		Complete(r)
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterhealthcheck
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates the health check compiled and is in effect.
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonValid indicates every rule compiled.
+	ReasonValid controller.ConditionReason = "Valid"
+
+	// ReasonInvalidExpression indicates a rule expression does not compile or does not
+	// evaluate to a boolean. The check is not used until it is fixed.
+	ReasonInvalidExpression controller.ConditionReason = "InvalidExpression"
+)
@@ -0,0 +1,95 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterhealthcheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/healthcheck"
+)
+
+var cnpgCluster = schema.GroupKind{Group: "postgresql.cnpg.io", Kind: "Cluster"}
+
+func newHealthCheck(expression string) *openchoreov1alpha1.ClusterHealthCheck {
+	return &openchoreov1alpha1.ClusterHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "cnpg-cluster", Generation: 1},
+		Spec: openchoreov1alpha1.ClusterHealthCheckSpec{
+			Target: openchoreov1alpha1.HealthCheckTarget{Group: cnpgCluster.Group, Kind: cnpgCluster.Kind},
+			Rules: []openchoreov1alpha1.HealthCheckRule{
+				{Status: openchoreov1alpha1.HealthStatusHealthy, Expression: expression},
+			},
+		},
+	}
+}
+
+func newReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.ClusterHealthCheck{}).
+		Build()
+	return &Reconciler{Client: c, Scheme: s, Registry: healthcheck.NewRegistry()}, c
+}
+
+func reconcileHealthCheck(t *testing.T, r *Reconciler, c client.Client) *openchoreov1alpha1.ClusterHealthCheck {
+	t.Helper()
+	key := types.NamespacedName{Name: "cnpg-cluster"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	got := &openchoreov1alpha1.ClusterHealthCheck{}
+	require.NoError(t, c.Get(context.Background(), key, got))
+	return got
+}
+
+func TestReconcile_ValidCheck(t *testing.T) {
+	r, c := newReconciler(t, newHealthCheck("conditions.exists(c, c.type == 'Ready' && c.status == 'True')"))
+
+	got := reconcileHealthCheck(t, r, c)
+
+	cond := meta.FindStatusCondition(got.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonValid), cond.Reason)
+	assert.Equal(t, "Health check is active for Cluster.postgresql.cnpg.io", cond.Message)
+	assert.Equal(t, int64(1), got.Status.ObservedGeneration)
+	assert.NotNil(t, r.Registry.Lookup(cnpgCluster))
+}
+
+func TestReconcile_InvalidExpression(t *testing.T) {
+	r, c := newReconciler(t, newHealthCheck("size(conditions)"))
+	require.NoError(t, r.Registry.Set("cnpg-cluster", newHealthCheck("true").Spec))
+
+	got := reconcileHealthCheck(t, r, c)
+
+	cond := meta.FindStatusCondition(got.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonInvalidExpression), cond.Reason)
+	assert.Nil(t, r.Registry.Lookup(cnpgCluster), "an invalid update must not leave the previous rules in effect")
+}
+
+func TestReconcile_DeletedCheckIsUnregistered(t *testing.T) {
+	r, _ := newReconciler(t)
+	require.NoError(t, r.Registry.Set("cnpg-cluster", newHealthCheck("true").Spec))
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "cnpg-cluster"}})
+	require.NoError(t, err)
+	assert.Nil(t, r.Registry.Lookup(cnpgCluster))
+}
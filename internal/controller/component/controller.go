@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,11 +31,20 @@ import (
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// ImageResolver returns the resolver used to pin workload images to digests in
+	// auto-deployed ComponentReleases. Images are not pinned when nil. Pinning is best-effort:
+	// failures are reported in the ImagePinned condition and do not block the first release.
+	ImageResolver componentrelease.ResolverFactory
 }
 
+// imagePinRetryInterval is how long to wait before resolving a changed workload image again
+// when the latest release is kept because the image could not be pinned.
+const imagePinRetryInterval = time.Minute
+
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=components,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=components/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=components/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componenttypes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clustercomponenttypes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=traits,verbs=get;list;watch
@@ -173,7 +183,8 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 
 	// Handle autoDeploy if enabled
 	if comp.Spec.AutoDeploy {
-		if err := r.handleAutoDeploy(ctx, comp, ct, workload, traits, clusterTraits, firstEnv); err != nil {
+		result, err = r.handleAutoDeploy(ctx, comp, ct, workload, traits, clusterTraits, firstEnv)
+		if err != nil {
 			msg := fmt.Sprintf("Failed to handle autoDeploy: %v", err)
 			controller.MarkFalseCondition(comp, ConditionReady, ReasonAutoDeployFailed, msg)
 			logger.Error(err, "Failed to handle autoDeploy")
@@ -202,7 +213,7 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 			"component", comp.Name)
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 // validateAndFetchComponentType parses, fetches, and validates the ComponentType.
@@ -583,6 +594,11 @@ func findRootEnvironment(pipeline *openchoreov1alpha1.DeploymentPipeline) (strin
 // handleAutoDeploy handles automatic deployment when autoDeploy is enabled.
 // It computes the hash of the current release spec and creates/updates ComponentRelease
 // and ReleaseBinding if the hash has changed.
+//
+// The workload image is pinned to the digest of the latest release while the authored image
+// is unchanged, so the registry is only queried when the image changes. If a changed image
+// cannot be pinned, the pinned latest release stays bound and the image is resolved again
+// after imagePinRetryInterval, rather than swapping in an unpinned release with a new hash.
 func (r *Reconciler) handleAutoDeploy(
 	ctx context.Context,
 	comp *openchoreov1alpha1.Component,
@@ -591,13 +607,27 @@ func (r *Reconciler) handleAutoDeploy(
 	traits map[string]openchoreov1alpha1.TraitSpec,
 	clusterTraits map[string]openchoreov1alpha1.ClusterTraitSpec,
 	firstEnv string,
-) error {
+) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// ReleaseBinding name to create releaseBinding if not exits
 	bindingName := fmt.Sprintf("%s-%s", comp.Name, firstEnv)
 
-	crSpec, err := componentrelease.BuildSpec(componentrelease.BuildInput{
+	latest, err := r.getLatestComponentRelease(ctx, comp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var latestImage *openchoreov1alpha1.ReleaseImage
+	if latest != nil {
+		latestImage = latest.Spec.Image
+	}
+
+	imageResolver := r.ImageResolver
+	if imageResolver != nil && latestImage != nil && latestImage.Reference == workload.Spec.Container.Image {
+		imageResolver = componentrelease.ReuseDigest(imageResolver, latestImage)
+	}
+
+	crSpec, err := componentrelease.BuildSpec(ctx, componentrelease.BuildInput{
 		Component: comp,
 		ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 			Kind: comp.Spec.ComponentType.Kind,
@@ -607,9 +637,24 @@ func (r *Reconciler) handleAutoDeploy(
 		Traits:        traits,
 		ClusterTraits: clusterTraits,
 		Workload:      &workload.Spec.WorkloadTemplateSpec,
+		ImageResolver: imageResolver,
 	})
-	if err != nil {
-		return fmt.Errorf("failed to build ComponentReleaseSpec: %w", err)
+	var pinErr *componentrelease.ImagePinError
+	switch {
+	case errors.As(err, &pinErr):
+		controller.MarkFalseCondition(comp, ConditionImagePinned, ReasonImagePinningFailed, pinErr.Error())
+		logger.Info("Workload image not pinned to a digest", "component", comp.Name, "reason", pinErr.Err.Error())
+		if latestImage != nil {
+			// Keep the pinned latest release until the new image resolves
+			return ctrl.Result{RequeueAfter: imagePinRetryInterval},
+				r.ensureReleaseBinding(ctx, comp, latest.Name, firstEnv, bindingName)
+		}
+		// Nothing pinned to keep: the release is created with the image as authored
+	case err != nil:
+		return ctrl.Result{}, fmt.Errorf("failed to build ComponentReleaseSpec: %w", err)
+	case crSpec.Image != nil:
+		controller.MarkTrueCondition(comp, ConditionImagePinned, ReasonImagePinned,
+			fmt.Sprintf("Workload image %q pinned to %s", crSpec.Image.Reference, crSpec.Image.Digest))
 	}
 
 	releaseSpec := ReleaseSpecFromComponentReleaseSpec(crSpec)
@@ -621,7 +666,7 @@ func (r *Reconciler) handleAutoDeploy(
 		releaseName := comp.Status.LatestRelease.Name
 		exists, err := r.ensureComponentRelease(ctx, comp, crSpec, releaseName, currentHash)
 		if err != nil {
-			return err
+			return ctrl.Result{}, err
 		}
 		if exists {
 			// ComponentRelease already existed, nothing more to do
@@ -629,7 +674,7 @@ func (r *Reconciler) handleAutoDeploy(
 				"component", comp.Name,
 				"hash", currentHash,
 				"release", releaseName)
-			return r.ensureReleaseBinding(ctx, comp, releaseName, firstEnv, bindingName)
+			return ctrl.Result{}, r.ensureReleaseBinding(ctx, comp, releaseName, firstEnv, bindingName)
 		}
 		// ComponentRelease was recreated, continue to update status and binding
 		logger.Info("ComponentRelease recreated after being missing",
@@ -650,7 +695,7 @@ func (r *Reconciler) handleAutoDeploy(
 
 		releaseName := fmt.Sprintf("%s-%s", comp.Name, currentHash)
 		if _, err := r.ensureComponentRelease(ctx, comp, crSpec, releaseName, currentHash); err != nil {
-			return err
+			return ctrl.Result{}, err
 		}
 	}
 
@@ -663,7 +708,27 @@ func (r *Reconciler) handleAutoDeploy(
 		ReleaseHash: currentHash,
 	}
 
-	return r.ensureReleaseBinding(ctx, comp, releaseName, firstEnv, bindingName)
+	return ctrl.Result{}, r.ensureReleaseBinding(ctx, comp, releaseName, firstEnv, bindingName)
+}
+
+// getLatestComponentRelease returns the ComponentRelease recorded in status.latestRelease,
+// or nil if there is none or it no longer exists.
+func (r *Reconciler) getLatestComponentRelease(
+	ctx context.Context,
+	comp *openchoreov1alpha1.Component,
+) (*openchoreov1alpha1.ComponentRelease, error) {
+	if comp.Status.LatestRelease == nil {
+		return nil, nil
+	}
+	release := &openchoreov1alpha1.ComponentRelease{}
+	key := types.NamespacedName{Name: comp.Status.LatestRelease.Name, Namespace: comp.Namespace}
+	if err := r.Get(ctx, key, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ComponentRelease %q: %w", key.Name, err)
+	}
+	return release, nil
 }
 
 // ensureComponentRelease ensures a ComponentRelease with the given name exists.
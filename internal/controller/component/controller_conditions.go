@@ -19,6 +19,10 @@ const (
 
 	// ConditionFinalizing indicates that the Component is being finalized (deleted).
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionImagePinned indicates whether the workload image of the latest auto-deployed
+	// ComponentRelease is pinned to a digest. Only set when image pinning is enabled.
+	ConditionImagePinned controller.ConditionType = "ImagePinned"
 )
 
 // Constants for condition reasons
@@ -58,6 +62,14 @@ const (
 	// ReasonAutoDeployFailed indicates failure to handle autoDeploy (ComponentRelease/ReleaseBinding creation)
 	ReasonAutoDeployFailed controller.ConditionReason = "AutoDeployFailed"
 
+	// Image pinning
+
+	// ReasonImagePinned indicates the workload image was pinned to a digest (Status=True)
+	ReasonImagePinned controller.ConditionReason = "ImagePinned"
+	// ReasonImagePinningFailed indicates the workload image could not be resolved to a digest and
+	// either the pinned latest release is kept or, without one, the release uses the image as authored (Status=False)
+	ReasonImagePinningFailed controller.ConditionReason = "ImagePinningFailed"
+
 	// ReasonFinalizing indicates the Component is being finalized
 	ReasonFinalizing controller.ConditionReason = "Finalizing"
 )
@@ -4,6 +4,7 @@
 package component
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -316,7 +317,7 @@ func TestHashOutputExamples(t *testing.T) {
 // because the hash helpers below are in this package and importing them there would cycle.
 func buildSpecForTest(t *testing.T, traits map[string]openchoreov1alpha1.TraitSpec) *openchoreov1alpha1.ComponentReleaseSpec {
 	t.Helper()
-	out, err := componentrelease.BuildSpec(componentrelease.BuildInput{
+	out, err := componentrelease.BuildSpec(context.Background(), componentrelease.BuildInput{
 		Component: &openchoreov1alpha1.Component{
 			ObjectMeta: metav1.ObjectMeta{Name: "comp"},
 			Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "proj"}},
@@ -357,7 +358,7 @@ func TestReleaseHash_ChangesWithPostRenderValidations(t *testing.T) {
 // vary the ComponentType's own fields (not just traits).
 func buildSpecWithComponentType(t *testing.T, ct openchoreov1alpha1.ComponentReleaseComponentType) *openchoreov1alpha1.ComponentReleaseSpec {
 	t.Helper()
-	out, err := componentrelease.BuildSpec(componentrelease.BuildInput{
+	out, err := componentrelease.BuildSpec(context.Background(), componentrelease.BuildInput{
 		Component: &openchoreov1alpha1.Component{
 			ObjectMeta: metav1.ObjectMeta{Name: "comp"},
 			Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "proj"}},
@@ -4,11 +4,20 @@
 package component
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/componentrelease"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 func TestParseComponentType(t *testing.T) {
@@ -161,3 +170,110 @@ func TestFindRootEnvironment(t *testing.T) {
 		})
 	}
 }
+
+type countingResolver struct {
+	digest string
+	err    error
+	calls  int
+}
+
+func (c *countingResolver) ResolveDigest(context.Context, string) (string, error) {
+	c.calls++
+	return c.digest, c.err
+}
+
+func TestHandleAutoDeploy_ImagePinning(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	comp := &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns", UID: "web-uid"},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: "proj"},
+			ComponentType: openchoreov1alpha1.ComponentTypeRef{Kind: openchoreov1alpha1.ComponentTypeRefKindComponentType, Name: "deployment/service"},
+			AutoDeploy:    true,
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(comp).
+		WithIndex(&openchoreov1alpha1.ReleaseBinding{},
+			controller.IndexKeyReleaseBindingOwnerEnv, func(obj client.Object) []string {
+				rb := obj.(*openchoreov1alpha1.ReleaseBinding)
+				return []string{controller.MakeReleaseBindingOwnerEnvKey(
+					rb.Spec.Owner.ProjectName, rb.Spec.Owner.ComponentName, rb.Spec.Environment)}
+			}).
+		Build()
+	resolver := &countingResolver{digest: "sha256:0123"}
+	r := &Reconciler{
+		Client: c,
+		Scheme: scheme,
+		ImageResolver: func(context.Context, *openchoreov1alpha1.Component) (componentrelease.DigestResolver, error) {
+			return resolver, nil
+		},
+	}
+	ct := &openchoreov1alpha1.ComponentType{}
+	workload := &openchoreov1alpha1.Workload{}
+	workload.Spec.Container.Image = "acme/web:v1"
+
+	deploy := func() ctrl.Result {
+		t.Helper()
+		result, err := r.handleAutoDeploy(ctx, comp, ct, workload, nil, nil, "dev")
+		if err != nil {
+			t.Fatalf("handleAutoDeploy() error = %v", err)
+		}
+		return result
+	}
+	boundRelease := func() string {
+		t.Helper()
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "web-dev"}, binding); err != nil {
+			t.Fatalf("get ReleaseBinding: %v", err)
+		}
+		return binding.Spec.ReleaseName
+	}
+
+	deploy()
+	pinned := comp.Status.LatestRelease.Name
+	if resolver.calls != 1 || boundRelease() != pinned {
+		t.Fatalf("first deploy: resolver calls = %d, bound release = %q, want 1 and %q", resolver.calls, boundRelease(), pinned)
+	}
+
+	// The digest of the latest release is reused while the image is unchanged, so a
+	// registry outage neither blocks reconciles nor produces an unpinned release.
+	resolver.err = errors.New("registry unavailable")
+	if result := deploy(); result.RequeueAfter != 0 {
+		t.Errorf("unchanged image: RequeueAfter = %v, want 0", result.RequeueAfter)
+	}
+	if resolver.calls != 1 {
+		t.Errorf("unchanged image: resolver calls = %d, want 1", resolver.calls)
+	}
+	if comp.Status.LatestRelease.Name != pinned || boundRelease() != pinned {
+		t.Errorf("unchanged image: latest = %q, bound = %q, want %q", comp.Status.LatestRelease.Name, boundRelease(), pinned)
+	}
+
+	// A changed image that cannot be pinned keeps the pinned release bound and is retried.
+	workload.Spec.Container.Image = "acme/web:v2"
+	if result := deploy(); result.RequeueAfter != imagePinRetryInterval {
+		t.Errorf("changed image: RequeueAfter = %v, want %v", result.RequeueAfter, imagePinRetryInterval)
+	}
+	if comp.Status.LatestRelease.Name != pinned || boundRelease() != pinned {
+		t.Errorf("changed image: latest = %q, bound = %q, want %q", comp.Status.LatestRelease.Name, boundRelease(), pinned)
+	}
+	releases := &openchoreov1alpha1.ComponentReleaseList{}
+	if err := c.List(ctx, releases); err != nil {
+		t.Fatal(err)
+	}
+	if len(releases.Items) != 1 {
+		t.Errorf("ComponentReleases = %d, want 1", len(releases.Items))
+	}
+
+	// Once the new image resolves, a pinned release replaces the latest one.
+	resolver.err, resolver.digest = nil, "sha256:4567"
+	deploy()
+	if comp.Status.LatestRelease.Name == pinned || boundRelease() != comp.Status.LatestRelease.Name {
+		t.Errorf("resolved image: latest = %q, bound = %q", comp.Status.LatestRelease.Name, boundRelease())
+	}
+}
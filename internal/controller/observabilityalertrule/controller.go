@@ -9,7 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
+	"net/url"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,12 +23,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 const (
 	// defaultObserverInternalBaseURL is the internal observer service URL for v1alpha1 alert CRUD.
-	// This service is only reachable within the cluster (not exposed via Gateway).
-	defaultObserverInternalBaseURL = "http://observer-internal.openchoreo-observability-plane:8081"
+	defaultObserverInternalBaseURL = controller.DefaultObserverInternalBaseURL
 	alertsV1alpha1BasePath         = "/api/v1alpha1/alerts/sources"
 	conditionTypeSynced            = "Synced"
 	// observerAPITimeout is the default timeout for HTTP calls to the observer internal API.
@@ -53,9 +53,10 @@ type alertRuleMetadata struct {
 }
 
 type alertRuleSource struct {
-	Type   string `json:"type"`
-	Query  string `json:"query,omitempty"`
-	Metric string `json:"metric,omitempty"`
+	Type      string `json:"type"`
+	Query     string `json:"query,omitempty"`
+	Metric    string `json:"metric,omitempty"`
+	LogMetric string `json:"logMetric,omitempty"`
 }
 
 type alertRuleCondition struct {
@@ -183,7 +184,7 @@ func (r *Reconciler) upsertAlertRule(ctx context.Context, alertRule *openchoreov
 	ruleName := alertRule.Name
 	sourceType := string(alertRule.Spec.Source.Type)
 
-	_, statusCode, err := r.getAlertRule(ctx, baseURL, ruleName, sourceType)
+	_, statusCode, err := r.getAlertRule(ctx, baseURL, alertRule.Namespace, ruleName, sourceType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to GET alert rule: %w", err)
 	}
@@ -198,9 +199,17 @@ func (r *Reconciler) upsertAlertRule(ctx context.Context, alertRule *openchoreov
 	}
 }
 
+// alertRuleURL returns the observer URL of a single alert rule. The namespace is
+// passed along because observer-evaluated (logMetric) rules are keyed by namespace
+// and name.
+func alertRuleURL(baseURL, sourceType, namespace, ruleName string) string {
+	return fmt.Sprintf("%s%s/%s/rules/%s?namespace=%s",
+		baseURL, alertsV1alpha1BasePath, sourceType, ruleName, url.QueryEscape(namespace))
+}
+
 // getAlertRule calls GET /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}
-func (r *Reconciler) getAlertRule(ctx context.Context, baseURL, ruleName, sourceType string) (*alertRuleGetResponse, int, error) {
-	url := fmt.Sprintf("%s%s/%s/rules/%s", baseURL, alertsV1alpha1BasePath, sourceType, ruleName)
+func (r *Reconciler) getAlertRule(ctx context.Context, baseURL, namespace, ruleName, sourceType string) (*alertRuleGetResponse, int, error) {
+	url := alertRuleURL(baseURL, sourceType, namespace, ruleName)
 	reqCtx, cancel := context.WithTimeout(ctx, observerAPITimeout)
 	defer cancel()
 
@@ -332,9 +341,10 @@ func buildAlertRuleRequest(rule *openchoreov1alpha1.ObservabilityAlertRule) (*al
 			EnvironmentUID: environmentUID,
 		},
 		Source: alertRuleSource{
-			Type:   string(spec.Source.Type),
-			Query:  spec.Source.Query,
-			Metric: spec.Source.Metric,
+			Type:      string(spec.Source.Type),
+			Query:     spec.Source.Query,
+			Metric:    spec.Source.Metric,
+			LogMetric: spec.Source.LogMetric,
 		},
 		Condition: alertRuleCondition{
 			Enabled:   enabled,
@@ -350,14 +360,7 @@ func buildAlertRuleRequest(rule *openchoreov1alpha1.ObservabilityAlertRule) (*al
 
 // getObserverInternalBaseURL returns the observer internal base URL, allowing override via environment variable.
 func getObserverInternalBaseURL() string {
-	if v := os.Getenv("OBSERVER_INTERNAL_ENDPOINT"); v != "" {
-		return v
-	}
-	// Fall back to legacy OBSERVER_ENDPOINT for backwards compatibility in tests.
-	if v := os.Getenv("OBSERVER_ENDPOINT"); v != "" {
-		return v
-	}
-	return defaultObserverInternalBaseURL
+	return controller.GetObserverInternalBaseURL()
 }
 
 // formatMinutesHours converts a duration to a minutes/hours-only string (e.g. "5m", "1h").
@@ -429,7 +432,7 @@ func (r *Reconciler) finalize(ctx context.Context, alertRule *openchoreov1alpha1
 	logger.Info("Deleting alert rule from observer backend", "name", alertRule.Name, "sourceType", alertRule.Spec.Source.Type)
 
 	baseURL := getObserverInternalBaseURL()
-	url := fmt.Sprintf("%s%s/%s/rules/%s", baseURL, alertsV1alpha1BasePath, alertRule.Spec.Source.Type, alertRule.Name)
+	url := alertRuleURL(baseURL, string(alertRule.Spec.Source.Type), alertRule.Namespace, alertRule.Name)
 
 	reqCtx, cancel := context.WithTimeout(ctx, observerAPITimeout)
 	defer cancel()
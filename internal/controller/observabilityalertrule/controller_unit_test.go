@@ -190,6 +190,24 @@ func TestBuildAlertRuleRequest_SourceTypes(t *testing.T) {
 			t.Errorf("expected metric %q, got %q", "cpu_usage", req.Source.Metric)
 		}
 	})
+
+	t.Run("log metric source", func(t *testing.T) {
+		rule := validAlertRule("r-log-metric")
+		rule.Spec.Source = openchoreov1alpha1.ObservabilityAlertSource{
+			Type:      openchoreov1alpha1.ObservabilityAlertSourceTypeLogMetric,
+			LogMetric: "errors-by-level",
+		}
+		req, err := buildAlertRuleRequest(rule)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if req.Source.Type != "logMetric" {
+			t.Errorf("expected source type %q, got %q", "logMetric", req.Source.Type)
+		}
+		if req.Source.LogMetric != "errors-by-level" {
+			t.Errorf("expected log metric %q, got %q", "errors-by-level", req.Source.LogMetric)
+		}
+	})
 }
 
 func TestBuildAlertRuleRequest_ConditionFormatting(t *testing.T) {
@@ -0,0 +1,22 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import "os"
+
+// DefaultObserverInternalBaseURL is the internal observer service URL.
+// This service is only reachable within the cluster (not exposed via Gateway).
+const DefaultObserverInternalBaseURL = "http://observer-internal.openchoreo-observability-plane:8081"
+
+// GetObserverInternalBaseURL returns the observer internal base URL, allowing override via environment variable.
+func GetObserverInternalBaseURL() string {
+	if v := os.Getenv("OBSERVER_INTERNAL_ENDPOINT"); v != "" {
+		return v
+	}
+	// Fall back to legacy OBSERVER_ENDPOINT for backwards compatibility in tests.
+	if v := os.Getenv("OBSERVER_ENDPOINT"); v != "" {
+		return v
+	}
+	return DefaultObserverInternalBaseURL
+}
@@ -100,6 +100,18 @@ func (r *DataPlaneResult) GetK8sClient(provider kubernetesClient.DataPlaneClient
 	return nil, fmt.Errorf("no data plane set in result")
 }
 
+// GetSecretStoreName returns the secret store name from the data plane (either DataPlane or ClusterDataPlane).
+// Returns empty string if no secret store ref is configured.
+func (r *DataPlaneResult) GetSecretStoreName() string {
+	if r.DataPlane != nil && r.DataPlane.Spec.SecretStoreRef != nil {
+		return r.DataPlane.Spec.SecretStoreRef.Name
+	}
+	if r.ClusterDataPlane != nil && r.ClusterDataPlane.Spec.SecretStoreRef != nil {
+		return r.ClusterDataPlane.Spec.SecretStoreRef.Name
+	}
+	return ""
+}
+
 // GetObservabilityPlane resolves the observability plane for this data plane result.
 func (r *DataPlaneResult) GetObservabilityPlane(ctx context.Context, c client.Client) (*ObservabilityPlaneResult, error) {
 	if r.DataPlane != nil {
@@ -522,6 +534,7 @@ func (r *WorkflowResult) GetWorkflowSpec() openchoreov1alpha1.WorkflowSpec {
 			Resources:          r.ClusterWorkflow.Spec.Resources,
 			ExternalRefs:       r.ClusterWorkflow.Spec.ExternalRefs,
 			TTLAfterCompletion: r.ClusterWorkflow.Spec.TTLAfterCompletion,
+			Artifacts:          r.ClusterWorkflow.Spec.Artifacts,
 		}
 		// Map ClusterWorkflowPlaneRef to WorkflowPlaneRef, defaulting to ClusterWorkflowPlane "default"
 		// when the field is omitted (CRD defaulting webhook may not have run).
@@ -1038,6 +1038,45 @@ func TestWorkflowPlaneResult_GetObservabilityPlane(t *testing.T) {
 	}
 }
 
+func TestDataPlaneResult_GetSecretStoreName(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *DataPlaneResult
+		want   string
+	}{
+		{
+			name: "DataPlane with secret store",
+			result: &DataPlaneResult{DataPlane: &openchoreov1alpha1.DataPlane{
+				Spec: openchoreov1alpha1.DataPlaneSpec{SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "my-store"}},
+			}},
+			want: "my-store",
+		},
+		{
+			name: "ClusterDataPlane with secret store",
+			result: &DataPlaneResult{ClusterDataPlane: &openchoreov1alpha1.ClusterDataPlane{
+				Spec: openchoreov1alpha1.ClusterDataPlaneSpec{SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "cluster-store"}},
+			}},
+			want: "cluster-store",
+		},
+		{
+			name:   "DataPlane nil secret store ref",
+			result: &DataPlaneResult{DataPlane: &openchoreov1alpha1.DataPlane{}},
+			want:   "",
+		},
+		{
+			name:   "empty result",
+			result: &DataPlaneResult{},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.GetSecretStoreName())
+		})
+	}
+}
+
 func TestWorkflowPlaneResult_GetSecretStoreName(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -107,6 +107,7 @@ func networkPolicyProviderFromDataPlane(dp *controller.DataPlaneResult) networkp
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterobservabilityplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=renderedreleases,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterimageverificationpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
@@ -459,6 +460,17 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return r.handleUndeploy(ctx, releaseBinding, componentRelease)
 	}
 
+	// Verify the release image before deploying it. A binding that fails verification keeps
+	// its previously deployed release.
+	verified, err := r.verifyImage(ctx, releaseBinding, componentRelease, environment)
+	if err != nil {
+		logger.Error(err, "Failed to resolve image verification policies")
+		return ctrl.Result{}, err
+	}
+	if !verified {
+		return ctrl.Result{RequeueAfter: imageVerificationRetryInterval}, nil
+	}
+
 	// Build a facade DataPlane from the result for use by the pipeline and helper functions.
 	// This works because ClusterDataPlane has the same spec fields (Gateway, SecretStoreRef, etc.).
 	dataPlane := dataPlaneResult.ToDataPlane()
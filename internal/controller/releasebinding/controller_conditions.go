@@ -31,6 +31,10 @@ const (
 	// matching ResourceReleaseBinding whose outputs are populated.
 	ConditionResourceDependenciesReady controller.ConditionType = "ResourceDependenciesReady"
 
+	// ConditionImageVerified indicates that the release image passed the image verification
+	// policies of the environment. Absent when no policy applies.
+	ConditionImageVerified controller.ConditionType = "ImageVerified"
+
 	// ConditionFinalizing indicates that the ReleaseBinding is being finalized (deleted).
 	ConditionFinalizing controller.ConditionType = "Finalizing"
 )
@@ -114,6 +118,13 @@ const (
 	// ReasonNoResourceDependencies indicates there are no resource dependencies to resolve
 	ReasonNoResourceDependencies controller.ConditionReason = "NoResourceDependencies"
 
+	// Image verification condition reasons
+
+	// ReasonImageVerified indicates the release image signature was verified
+	ReasonImageVerified controller.ConditionReason = "ImageVerified"
+	// ReasonImageVerificationFailed indicates the release image signature could not be verified
+	ReasonImageVerificationFailed controller.ConditionReason = "ImageVerificationFailed"
+
 	// Ready condition reasons
 
 	// ReasonReady indicates the ReleaseBinding is fully ready
@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/imageverify"
+	"github.com/openchoreo/openchoreo/internal/provenance"
+)
+
+// imageVerificationRetryInterval is how often a binding whose image failed verification is
+// re-verified, so that policy changes and newly trusted keys are picked up.
+const imageVerificationRetryInterval = 5 * time.Minute
+
+// verifyImage verifies the signature of the release image against the image verification
+// policies of the environment and records the outcome in the ImageVerified condition.
+// It returns false when the release must not be deployed.
+func (r *Reconciler) verifyImage(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment) (bool, error) {
+	policies, err := imageverify.PoliciesForEnvironment(ctx, r.Client, environment)
+	if err != nil {
+		return false, err
+	}
+	if len(policies) == 0 {
+		meta.RemoveStatusCondition(&releaseBinding.Status.Conditions, string(ConditionImageVerified))
+		return true, nil
+	}
+
+	// The signature is only taken from provenance confirmed by the workflow run that built the image
+	workload := componentRelease.Spec.Workload
+	buildProvenance, err := provenance.Resolve(ctx, r.Client, componentRelease.Namespace, componentRelease.Spec.Owner,
+		workload.Container.Image, workload.Provenance)
+	unverified := ""
+	if errors.Is(err, provenance.ErrUnverified) {
+		unverified = "; " + err.Error()
+	} else if err != nil {
+		return false, err
+	}
+
+	if err := imageverify.Verify(policies, workload.Container.Image, buildProvenance); err != nil {
+		msg := fmt.Sprintf("Image verification failed: %v%s", err, unverified)
+		controller.MarkFalseCondition(releaseBinding, ConditionImageVerified, ReasonImageVerificationFailed, msg)
+		log.FromContext(ctx).Info("Image verification failed", "image", workload.Container.Image, "error", err.Error())
+		return false, nil
+	}
+	controller.MarkTrueCondition(releaseBinding, ConditionImageVerified, ReasonImageVerified,
+		fmt.Sprintf("Image %q passed verification", workload.Container.Image))
+	return true, nil
+}
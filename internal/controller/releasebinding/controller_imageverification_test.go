@@ -0,0 +1,99 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+func newImageVerificationReconciler(t *testing.T) *Reconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(scheme))
+	return &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Scheme: scheme}
+}
+
+func TestVerifyImage_NoPolicy(t *testing.T) {
+	r := newImageVerificationReconciler(t)
+	rb := makeValidReleaseBinding(testProjectName, testComponentName)
+	controller.MarkFalseCondition(rb, ConditionImageVerified, ReasonImageVerificationFailed, "stale")
+	env := &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: testEnvStaging, Namespace: testNamespace}}
+
+	verified, err := r.verifyImage(context.Background(), rb, makeValidComponentRelease(testProjectName, testComponentName), env)
+	require.NoError(t, err)
+	assert.True(t, verified)
+	assert.Nil(t, meta.FindStatusCondition(rb.Status.Conditions, string(ConditionImageVerified)),
+		"condition should be removed when no policy applies")
+}
+
+func TestVerifyImage_UnsignedImageBlocksRelease(t *testing.T) {
+	r := newImageVerificationReconciler(t)
+	rb := makeValidReleaseBinding(testProjectName, testComponentName)
+	cr := makeValidComponentRelease(testProjectName, testComponentName)
+	cr.Spec.Workload.Container.Image = "registry.example.com/app@sha256:abc"
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: testEnvStaging, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.EnvironmentSpec{ProvenancePolicy: &openchoreov1alpha1.ProvenancePolicy{
+			ImageVerification: &openchoreov1alpha1.ImageVerification{PublicKeys: []string{"unused"}},
+		}},
+	}
+
+	verified, err := r.verifyImage(context.Background(), rb, cr, env)
+	require.NoError(t, err)
+	assert.False(t, verified)
+
+	cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionImageVerified))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonImageVerificationFailed), cond.Reason)
+	assert.Contains(t, cond.Message, "no image signature is recorded")
+
+	// A failed verification takes precedence over the other conditions in Ready.
+	controller.MarkTrueCondition(rb, ConditionReleaseSynced, ReasonReleaseSynced, "synced")
+	controller.MarkTrueCondition(rb, ConditionResourcesReady, ReasonResourcesReady, "ready")
+	r.setReadyCondition(rb)
+	ready := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, string(ReasonImageVerificationFailed), ready.Reason)
+}
+
+func TestVerifyImage_IgnoresSignatureNotRecordedByWorkflowRun(t *testing.T) {
+	r := newImageVerificationReconciler(t)
+	rb := makeValidReleaseBinding(testProjectName, testComponentName)
+	cr := makeValidComponentRelease(testProjectName, testComponentName)
+	cr.Spec.Workload.Container.Image = "registry.example.com/app@sha256:abc"
+	cr.Spec.Workload.Provenance = &openchoreov1alpha1.BuildProvenance{
+		WorkflowRun: "forged-run",
+		Signature: &openchoreov1alpha1.ArtifactReference{
+			Reference: "e30=", Format: openchoreov1alpha1.SignatureFormatCosignBundle,
+		},
+	}
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: testEnvStaging, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.EnvironmentSpec{ProvenancePolicy: &openchoreov1alpha1.ProvenancePolicy{
+			ImageVerification: &openchoreov1alpha1.ImageVerification{PublicKeys: []string{"unused"}},
+		}},
+	}
+
+	verified, err := r.verifyImage(context.Background(), rb, cr, env)
+	require.NoError(t, err)
+	assert.False(t, verified)
+
+	cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionImageVerified))
+	require.NotNil(t, cond)
+	assert.Contains(t, cond.Message, "no image signature is recorded")
+	assert.Contains(t, cond.Message, `workflow run "forged-run" not found`)
+}
@@ -144,7 +144,7 @@ func (r *Reconciler) setResourcesReadyStatus(
 // the corresponding dependency type don't carry the condition and shouldn't be blocked.
 func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.ReleaseBinding) {
 	// Find all relevant conditions
-	var releaseSynced, resourcesReady, connectionsResolved, resourceDependenciesReady *metav1.Condition
+	var releaseSynced, resourcesReady, connectionsResolved, resourceDependenciesReady, imageVerified *metav1.Condition
 	for i := range releaseBinding.Status.Conditions {
 		switch releaseBinding.Status.Conditions[i].Type {
 		case string(ConditionReleaseSynced):
@@ -155,12 +155,15 @@ func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.Releas
 			connectionsResolved = &releaseBinding.Status.Conditions[i]
 		case string(ConditionResourceDependenciesReady):
 			resourceDependenciesReady = &releaseBinding.Status.Conditions[i]
+		case string(ConditionImageVerified):
+			imageVerified = &releaseBinding.Status.Conditions[i]
 		}
 	}
 
 	// All present conditions must be True for Ready to be True.
-	// ConnectionsResolved and ResourceDependenciesReady are optional — absent = pass.
-	allTrue := releaseSynced != nil && releaseSynced.Status == metav1.ConditionTrue &&
+	// ConnectionsResolved, ResourceDependenciesReady and ImageVerified are optional — absent = pass.
+	allTrue := (imageVerified == nil || imageVerified.Status == metav1.ConditionTrue) &&
+		releaseSynced != nil && releaseSynced.Status == metav1.ConditionTrue &&
 		resourcesReady != nil && resourcesReady.Status == metav1.ConditionTrue &&
 		(connectionsResolved == nil || connectionsResolved.Status == metav1.ConditionTrue) &&
 		(resourceDependenciesReady == nil || resourceDependenciesReady.Status == metav1.ConditionTrue)
@@ -171,6 +174,13 @@ func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.Releas
 		return
 	}
 
+	// A failed image verification blocks the release, so it is reported first
+	if imageVerified != nil && imageVerified.Status != metav1.ConditionTrue {
+		controller.MarkFalseCondition(releaseBinding, ConditionReady,
+			controller.ConditionReason(imageVerified.Reason), imageVerified.Message)
+		return
+	}
+
 	// If ReleaseSynced is not True, use its reason
 	if releaseSynced == nil || releaseSynced.Status != metav1.ConditionTrue {
 		reason := ReasonReleaseSynced
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -23,6 +25,7 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/healthcheck"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
@@ -45,6 +48,17 @@ const (
 	ReasonApplySucceeded = "ApplySucceeded"
 	// ReasonApplyFailed indicates one or more resources failed to apply
 	ReasonApplyFailed = "ApplyFailed"
+	// ReasonWaitingForWave indicates the resources applied so far succeeded, and the remaining
+	// apply waves are held back until the current wave is healthy
+	ReasonWaitingForWave = "WaitingForWave"
+
+	// ConditionDrifted indicates that live resources in the target plane differ from the desired
+	// manifests. It is only set while drift is present, which requires a drift policy that does
+	// not auto-correct drift.
+	ConditionDrifted = "Drifted"
+
+	// ReasonDriftDetected indicates one or more resources have drifted from their desired manifests
+	ReasonDriftDetected = "DriftDetected"
 )
 
 // Reconciler reconciles a RenderedRelease object
@@ -52,6 +66,12 @@ type Reconciler struct {
 	client.Client
 	PlaneClientProvider kubernetesClient.PlaneClientProvider
 	Scheme              *runtime.Scheme
+	// HealthChecks holds the ClusterHealthCheck definitions. They take precedence
+	// over the built-in health checks. May be nil.
+	HealthChecks *healthcheck.Registry
+	// DriftNotifier delivers drift alerts for environments with the Alert drift policy.
+	// May be nil, in which case drift is only reported in the status.
+	DriftNotifier DriftNotifier
 }
 
 // TODO: Optimize to apply resource only if spec has changed
@@ -142,9 +162,30 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	// Unless the environment's drift policy auto-corrects drift, detect resources that were
+	// changed in the target plane since they were last applied, and leave them as they are.
+	driftPolicy, err := r.getDriftPolicy(ctx, release)
+	if err != nil {
+		logger.Error(err, "Failed to get drift policy")
+		return ctrl.Result{}, err
+	}
+	var drifted map[string][]string
+	resourcesToApply := desiredResources
+	if driftPolicy.GetMode() != openchoreov1alpha1.DriftPolicyModeAutoCorrect {
+		drifted, err = r.detectDrift(ctx, planeClient, desiredResources)
+		if err != nil {
+			logger.Error(err, "Failed to detect drift", "targetPlane", targetPlane)
+			return ctrl.Result{}, err
+		}
+		resourcesToApply = withoutDrifted(desiredResources, drifted)
+	}
+
 	// PHASE 1: Apply desired resources to the target plane
-	// This ensures all resources in the spec are created/updated with proper tracking labels
-	if err := r.applyResources(ctx, planeClient, desiredResources); err != nil {
+	// This ensures all resources in the spec are created/updated with proper tracking labels.
+	// Resources that declare apply waves or sync hooks are applied one step at a time, and
+	// currentWave is the step still waiting for its resources to become healthy.
+	currentWave, err := r.applyResources(ctx, planeClient, resourcesToApply)
+	if err != nil {
 		logger.Error(err, "Failed to apply resources to target plane", "targetPlane", targetPlane)
 		// Persist the apply error in Release status so upstream controllers (e.g., ReleaseBinding) can surface it
 		changed := controller.MarkFalseCondition(release, controller.ConditionType(ConditionResourcesApplied),
@@ -159,8 +200,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Mark resources as successfully applied and persist to API
-	if changed := controller.MarkTrueCondition(release, controller.ConditionType(ConditionResourcesApplied),
-		controller.ConditionReason(ReasonApplySucceeded), "All resources applied successfully"); changed {
+	release.Status.CurrentWave = currentWave
+	reason, message := ReasonApplySucceeded, "All resources applied successfully"
+	if currentWave != nil {
+		reason = ReasonWaitingForWave
+		message = fmt.Sprintf("Waiting for %s wave %d to become healthy before applying the next wave: %s",
+			currentWave.Phase, currentWave.Wave, strings.Join(currentWave.PendingResources, ", "))
+	}
+	changed := controller.MarkTrueCondition(release, controller.ConditionType(ConditionResourcesApplied),
+		controller.ConditionReason(reason), message)
+	if len(drifted) > 0 {
+		ids := make([]string, 0, len(drifted))
+		for id := range drifted {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		changed = controller.MarkTrueCondition(release, controller.ConditionType(ConditionDrifted),
+			controller.ConditionReason(ReasonDriftDetected),
+			fmt.Sprintf("Resources differ from their desired manifests and are not auto-corrected: %s", strings.Join(ids, ", "))) || changed
+	} else {
+		changed = apimeta.RemoveStatusCondition(&release.Status.Conditions, ConditionDrifted) || changed
+	}
+	if changed {
 		if statusErr := r.Status().Update(ctx, release); statusErr != nil {
 			logger.Error(statusErr, "Failed to update Release status with apply success")
 			return ctrl.Result{}, statusErr
@@ -180,15 +241,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// PHASE 3: Find and delete stale resources (cleanup orphaned resources)
 	// Stale = live resources that are no longer in the desired spec (e.g., user removed a ConfigMap)
 	// This implements Flux-style inventory cleanup to prevent resource accumulation over time
-	staleResources := r.findStaleResources(liveResources, desiredResources)
-	if err := r.deleteResources(ctx, planeClient, staleResources); err != nil {
-		logger.Error(err, "Failed to delete stale resources")
-		return ctrl.Result{}, err
+	// Pruning waits until every wave has been applied, so resources being replaced by a later
+	// wave are not removed before their replacements are in place.
+	if currentWave == nil {
+		staleResources := r.findStaleResources(liveResources, desiredResources)
+		if err := r.deleteResources(ctx, planeClient, staleResources); err != nil {
+			logger.Error(err, "Failed to delete stale resources")
+			return ctrl.Result{}, err
+		}
 	}
 
 	// PHASE 4: Update status with applied resources inventory (done last after all operations)
 	// This maintains an inventory of what we applied for future cleanup operations
-	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources); err != nil || statusUpdated {
+	if driftPolicy.GetMode() == openchoreov1alpha1.DriftPolicyModeAlert {
+		if ids := newlyDrifted(old.Status.Resources, drifted); len(ids) > 0 {
+			// A failed alert is not retried: the drift remains reported in the status.
+			if err := r.notifyDrift(ctx, release, driftPolicy, desiredResources, drifted, ids); err != nil {
+				logger.Error(err, "Failed to send drift notification", "resources", ids)
+			}
+		}
+	}
+	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources, drifted); err != nil || statusUpdated {
 		// Return after updating the status to ensure it is persisted before continuing
 		return ctrl.Result{}, err
 	}
@@ -196,7 +269,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Check if resources are transitioning to determine the appropriate requeue interval:
 	// - Transitioning resources: more frequent requeue to reflect changes quickly
 	// - Stable resources: longer requeue interval to avoid excessive load
-	if r.hasTransitioningResources(release.Status.Resources) {
+	if currentWave != nil || r.hasTransitioningResources(release.Status.Resources) {
 		requeueAfter := getProgressingRequeueInterval(release)
 		logger.Info("Resources are transitioning, requeuing with configured interval",
 			"requeueAfter", requeueAfter)
@@ -263,20 +336,6 @@ func (r *Reconciler) getOPClient(ctx context.Context, namespaceName string, envi
 	return opClient, nil
 }
 
-// applyResources applies the given resources to the target plane
-func (r *Reconciler) applyResources(ctx context.Context, planeClient client.Client, resources []*unstructured.Unstructured) error {
-	for _, obj := range resources {
-		resourceID := obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]
-
-		// Apply the resource using server-side apply
-		if err := planeClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName)); err != nil {
-			return fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
-		}
-	}
-
-	return nil
-}
-
 // makeDesiredResources creates the desired resources from the Release spec
 func (r *Reconciler) makeDesiredResources(release *openchoreov1alpha1.RenderedRelease) ([]*unstructured.Unstructured, error) {
 	desiredObjects := make([]*unstructured.Unstructured, 0, len(release.Spec.Resources))
@@ -0,0 +1,212 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/pkg/hash"
+)
+
+// maxDriftedFields caps the number of drifted field paths reported per resource to keep
+// the RenderedRelease status small.
+const maxDriftedFields = 20
+
+// driftIgnoredMetadataFields are server-managed metadata fields that never count as drift.
+var driftIgnoredMetadataFields = []string{
+	"managedFields", "resourceVersion", "generation", "creationTimestamp", "uid", "selfLink",
+}
+
+// getDriftPolicy returns the drift policy of the release's environment.
+func (r *Reconciler) getDriftPolicy(ctx context.Context, release *openchoreov1alpha1.RenderedRelease) (*openchoreov1alpha1.DriftPolicy, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Name: release.Spec.EnvironmentName, Namespace: release.Namespace}, env); err != nil {
+		return nil, fmt.Errorf("failed to get environment %s: %w", release.Spec.EnvironmentName, err)
+	}
+	return env.Spec.DriftPolicy, nil
+}
+
+// detectDrift compares the live resources in the target plane with the desired resources and
+// returns the drifted field paths keyed by resource ID.
+//
+// Each desired resource is stamped with a hash of its manifest. A live object carrying a
+// different hash has not received the current release yet, so its differences are pending
+// changes rather than drift. Otherwise the desired manifest is server-side applied as a dry
+// run and the result is compared with the live object: the dry run goes through the same
+// defaulting as the live object, so server-defaulted fields do not show up as drift, and
+// only fields owned by this controller can differ. Sync hooks are re-created rather than
+// re-applied and are not checked.
+func (r *Reconciler) detectDrift(ctx context.Context, planeClient client.Client, resources []*unstructured.Unstructured) (map[string][]string, error) {
+	drifted := make(map[string][]string)
+	for _, obj := range resources {
+		if obj.GetAnnotations()[labels.AnnotationKeySyncHook] != "" {
+			continue
+		}
+		resourceID := obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]
+
+		desiredHash := hash.ComputeHash(obj.Object, nil)
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[labels.AnnotationKeyDesiredHash] = desiredHash
+		obj.SetAnnotations(annotations)
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := planeClient.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}, live); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get live resource %s: %w", resourceID, err)
+		}
+		if live.GetAnnotations()[labels.AnnotationKeyDesiredHash] != desiredHash {
+			continue
+		}
+
+		dryRun := obj.DeepCopy()
+		if err := planeClient.Patch(ctx, dryRun, client.Apply, client.ForceOwnership,
+			client.FieldOwner(ControllerName), client.DryRunAll); err != nil {
+			return nil, fmt.Errorf("failed to dry-run apply resource %s: %w", resourceID, err)
+		}
+		if fields := driftedFields(dryRun.Object, live.Object); len(fields) > 0 {
+			drifted[resourceID] = fields
+		}
+	}
+	return drifted, nil
+}
+
+// driftedFields returns the sorted paths of the fields that differ between the object the
+// desired manifest would produce and the live object, ignoring status and server-managed
+// metadata.
+func driftedFields(desired, live map[string]any) []string {
+	var fields []string
+	diffFields("", normalizeForDrift(desired), normalizeForDrift(live), &fields)
+	sort.Strings(fields)
+	if len(fields) > maxDriftedFields {
+		fields = fields[:maxDriftedFields]
+	}
+	return fields
+}
+
+// normalizeForDrift returns a copy of obj without the fields that never count as drift.
+func normalizeForDrift(obj map[string]any) map[string]any {
+	normalized := runtime.DeepCopyJSON(obj)
+	delete(normalized, "status")
+	if metadata, ok := normalized["metadata"].(map[string]any); ok {
+		for _, field := range driftIgnoredMetadataFields {
+			delete(metadata, field)
+		}
+	}
+	return normalized
+}
+
+// diffFields appends the paths at which desired and live differ. Lists of differing
+// length are reported as a whole.
+func diffFields(path string, desired, live any, fields *[]string) {
+	switch d := desired.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			*fields = append(*fields, path)
+			return
+		}
+		keys := make(map[string]struct{}, len(d)+len(l))
+		for k := range d {
+			keys[k] = struct{}{}
+		}
+		for k := range l {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			diffFields(joinFieldPath(path, k), d[k], l[k], fields)
+		}
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(d) {
+			*fields = append(*fields, path)
+			return
+		}
+		for i := range d {
+			diffFields(fmt.Sprintf("%s[%d]", path, i), d[i], l[i], fields)
+		}
+	default:
+		if !reflect.DeepEqual(desired, live) {
+			*fields = append(*fields, path)
+		}
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// withoutDrifted returns the resources that are not drifted, so drift is left in place
+// when it is not auto-corrected.
+func withoutDrifted(resources []*unstructured.Unstructured, drifted map[string][]string) []*unstructured.Unstructured {
+	if len(drifted) == 0 {
+		return resources
+	}
+	kept := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, obj := range resources {
+		if _, ok := drifted[obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]]; !ok {
+			kept = append(kept, obj)
+		}
+	}
+	return kept
+}
+
+// setResourceDrift records the drifted fields on the resource statuses. The detection time
+// is carried over from the previous status while a resource stays drifted.
+func setResourceDrift(old []openchoreov1alpha1.RenderedManifestStatus, statuses []openchoreov1alpha1.RenderedManifestStatus, drifted map[string][]string) {
+	previous := make(map[string]*openchoreov1alpha1.ResourceDrift, len(old))
+	for _, status := range old {
+		previous[status.ID] = status.Drift
+	}
+	for i := range statuses {
+		fields, ok := drifted[statuses[i].ID]
+		if !ok {
+			continue
+		}
+		detected := metav1.Now()
+		if prev := previous[statuses[i].ID]; prev != nil {
+			detected = prev.DetectedTime
+		}
+		statuses[i].Drift = &openchoreov1alpha1.ResourceDrift{Fields: fields, DetectedTime: detected}
+	}
+}
+
+// newlyDrifted returns the IDs of the drifted resources whose drift was not reported in the
+// previous status, or has changed since, in sorted order.
+func newlyDrifted(old []openchoreov1alpha1.RenderedManifestStatus, drifted map[string][]string) []string {
+	previous := make(map[string][]string, len(old))
+	for _, status := range old {
+		if status.Drift != nil {
+			previous[status.ID] = status.Drift.Fields
+		}
+	}
+	var ids []string
+	for id, fields := range drifted {
+		if prev, ok := previous[id]; !ok || !slices.Equal(prev, fields) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
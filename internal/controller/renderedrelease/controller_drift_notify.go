@@ -0,0 +1,161 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// driftNotificationPath is the observer internal endpoint that delivers drift notifications.
+	driftNotificationPath = "/api/v1alpha1/notifications/drift"
+	// driftNotificationTimeout is the timeout for sending a drift notification to the observer.
+	driftNotificationTimeout = 10 * time.Second
+)
+
+// DriftNotification describes drift newly detected in the resources of a RenderedRelease.
+type DriftNotification struct {
+	Namespace   string          `json:"namespace"`
+	Project     string          `json:"project"`
+	Component   string          `json:"component,omitempty"`
+	Resource    string          `json:"resource,omitempty"`
+	Environment string          `json:"environment"`
+	Release     string          `json:"release"`
+	Channels    []string        `json:"channels"`
+	Resources   []DriftedObject `json:"resources"`
+}
+
+// DriftedObject identifies a drifted resource and its drifted fields.
+type DriftedObject struct {
+	ID        string   `json:"id"`
+	Kind      string   `json:"kind"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace,omitempty"`
+	Fields    []string `json:"fields"`
+}
+
+// DriftNotifier delivers drift notifications to notification channels.
+type DriftNotifier interface {
+	NotifyDrift(ctx context.Context, notification *DriftNotification) error
+}
+
+// ObserverDriftNotifier sends drift notifications to the observer's internal API, which
+// delivers them through the configured notification channels.
+type ObserverDriftNotifier struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewObserverDriftNotifier creates a drift notifier for the observer at baseURL.
+func NewObserverDriftNotifier(baseURL string) *ObserverDriftNotifier {
+	return &ObserverDriftNotifier{
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: driftNotificationTimeout},
+	}
+}
+
+// NotifyDrift posts the notification to the observer.
+func (n *ObserverDriftNotifier) NotifyDrift(ctx context.Context, notification *DriftNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal drift notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.baseURL+driftNotificationPath, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create drift notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("drift notification request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Message string `json:"message,omitempty"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		return fmt.Errorf("observer returned status %d for drift notification: %s", resp.StatusCode, errBody.Message)
+	}
+	return nil
+}
+
+// notifyDrift alerts the drift policy's notification channels about the given drifted
+// resources. Without explicit channels, the environment's default channel is notified.
+func (r *Reconciler) notifyDrift(ctx context.Context, release *openchoreov1alpha1.RenderedRelease, policy *openchoreov1alpha1.DriftPolicy,
+	resources []*unstructured.Unstructured, drifted map[string][]string, resourceIDs []string) error {
+	if r.DriftNotifier == nil {
+		return fmt.Errorf("no drift notifier configured")
+	}
+
+	channels := policy.NotificationChannels
+	if len(channels) == 0 {
+		channel, err := r.getDefaultNotificationChannelName(ctx, release.Namespace, release.Spec.EnvironmentName)
+		if err != nil {
+			return err
+		}
+		channels = []string{channel}
+	}
+
+	byID := make(map[string]*unstructured.Unstructured, len(resources))
+	for _, obj := range resources {
+		byID[obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]] = obj
+	}
+	notification := &DriftNotification{
+		Namespace:   release.Namespace,
+		Project:     release.Spec.Owner.ProjectName,
+		Component:   release.Spec.Owner.ComponentName,
+		Resource:    release.Spec.Owner.ResourceName,
+		Environment: release.Spec.EnvironmentName,
+		Release:     release.Name,
+		Channels:    channels,
+	}
+	for _, id := range resourceIDs {
+		obj, ok := byID[id]
+		if !ok {
+			continue
+		}
+		notification.Resources = append(notification.Resources, DriftedObject{
+			ID:        id,
+			Kind:      obj.GetKind(),
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Fields:    drifted[id],
+		})
+	}
+	if len(notification.Resources) == 0 {
+		return nil
+	}
+	return r.DriftNotifier.NotifyDrift(ctx, notification)
+}
+
+// getDefaultNotificationChannelName returns the default ObservabilityAlertsNotificationChannel name for an environment.
+func (r *Reconciler) getDefaultNotificationChannelName(ctx context.Context, namespace, environment string) (string, error) {
+	var channels openchoreov1alpha1.ObservabilityAlertsNotificationChannelList
+	if err := r.List(ctx, &channels, client.InNamespace(namespace)); err != nil {
+		return "", fmt.Errorf("failed to list ObservabilityAlertsNotificationChannels: %w", err)
+	}
+
+	for _, ch := range channels.Items {
+		if ch.Spec.Environment == environment && ch.Spec.IsEnvDefault && ch.DeletionTimestamp.IsZero() {
+			return ch.Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("no default ObservabilityAlertsNotificationChannel found for environment %q", environment)
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/pkg/hash"
+)
+
+func newDriftDeployment(name string, replicas int64, image string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{"spec": map[string]any{"containers": []any{
+				map[string]any{"name": "app", "image": image},
+			}}},
+		},
+	}}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: appsAPIGroup, Version: "v1", Kind: deploymentKind})
+	obj.SetName(name)
+	obj.SetNamespace("dp-ns")
+	obj.SetLabels(map[string]string{labels.LabelKeyRenderedReleaseResourceID: name})
+	return obj
+}
+
+// liveCopy returns the object as the target plane would serve it after the desired manifest
+// was applied with its desired hash.
+func liveCopy(desired *unstructured.Unstructured) *unstructured.Unstructured {
+	live := desired.DeepCopy()
+	live.SetAnnotations(map[string]string{labels.AnnotationKeyDesiredHash: hash.ComputeHash(desired.Object, nil)})
+	live.SetResourceVersion("42")
+	live.SetGeneration(3)
+	live.Object["status"] = map[string]any{"readyReplicas": int64(2)}
+	return live
+}
+
+func TestDriftedFields(t *testing.T) {
+	desired := newDriftDeployment("app", 2, "app:v1").Object
+	desired["status"] = map[string]any{"readyReplicas": int64(0)}
+
+	live := newDriftDeployment("app", 2, "app:v1")
+	live.SetResourceVersion("42")
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl-edit"}})
+	if fields := driftedFields(desired, live.Object); len(fields) != 0 {
+		t.Errorf("expected status and server-managed metadata to be ignored, got %v", fields)
+	}
+
+	drifted := newDriftDeployment("app", 5, "app:hotfix")
+	drifted.SetAnnotations(map[string]string{"note": "edited"})
+	want := []string{
+		"metadata.annotations",
+		"spec.replicas",
+		"spec.template.spec.containers[0].image",
+	}
+	if fields := driftedFields(desired, drifted.Object); !reflect.DeepEqual(fields, want) {
+		t.Errorf("expected drifted fields %v, got %v", want, fields)
+	}
+
+	sidecar := newDriftDeployment("app", 2, "app:v1")
+	containers := sidecar.Object["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)
+	containers["containers"] = append(containers["containers"].([]any), map[string]any{"name": "debug"})
+	if fields := driftedFields(desired, sidecar.Object); !reflect.DeepEqual(fields, []string{"spec.template.spec.containers"}) {
+		t.Errorf("expected the container list to be reported, got %v", fields)
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	ctx := context.Background()
+	live := map[string]*unstructured.Unstructured{}
+	var dryRuns []string
+	plane := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(_ context.Context, _ client.WithWatch, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			stored, ok := live[key.Name]
+			if !ok {
+				return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			obj.(*unstructured.Unstructured).Object = stored.DeepCopy().Object
+			return nil
+		},
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, opts ...client.PatchOption) error {
+			patchOpts := &client.PatchOptions{}
+			patchOpts.ApplyOptions(opts)
+			if len(patchOpts.DryRun) == 0 {
+				t.Errorf("expected only dry-run applies, got an apply for %s", obj.GetName())
+			}
+			dryRuns = append(dryRuns, obj.GetName())
+			return nil
+		},
+	}).Build()
+
+	unchanged := newDriftDeployment("unchanged", 2, "app:v1")
+	live["unchanged"] = liveCopy(unchanged)
+
+	edited := newDriftDeployment("edited", 2, "app:v1")
+	live["edited"] = liveCopy(edited)
+	if err := unstructured.SetNestedField(live["edited"].Object, int64(4), "spec", "replicas"); err != nil {
+		t.Fatalf("failed to edit live object: %v", err)
+	}
+
+	// The live object carries the hash of a previous release: its differences are the
+	// changes this release is about to apply.
+	updated := newDriftDeployment("updated", 3, "app:v2")
+	live["updated"] = liveCopy(newDriftDeployment("updated", 2, "app:v1"))
+
+	missing := newDriftDeployment("missing", 1, "app:v1")
+
+	hook := newWaveResource("Job", "migrate", "PreSync", "")
+	live["migrate"] = hook.DeepCopy()
+
+	r := &Reconciler{}
+	drifted, err := r.detectDrift(ctx, plane, []*unstructured.Unstructured{unchanged, edited, updated, missing, hook})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := map[string][]string{"edited": {"spec.replicas"}}; !reflect.DeepEqual(drifted, want) {
+		t.Errorf("expected drift %v, got %v", want, drifted)
+	}
+	if want := []string{"unchanged", "edited"}; !reflect.DeepEqual(dryRuns, want) {
+		t.Errorf("expected dry runs for %v, got %v", want, dryRuns)
+	}
+	if updated.GetAnnotations()[labels.AnnotationKeyDesiredHash] == "" {
+		t.Errorf("expected the desired hash to be stamped on the resource to apply")
+	}
+	if hook.GetAnnotations()[labels.AnnotationKeyDesiredHash] != "" {
+		t.Errorf("expected sync hooks to be skipped")
+	}
+
+	kept := withoutDrifted([]*unstructured.Unstructured{unchanged, edited, updated}, drifted)
+	if len(kept) != 2 || kept[0] != unchanged || kept[1] != updated {
+		t.Errorf("expected the drifted resource to be left out of the apply, got %d resources", len(kept))
+	}
+}
+
+func TestResourceDriftStatus(t *testing.T) {
+	detected := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	old := []openchoreov1alpha1.RenderedManifestStatus{
+		{ID: "app", Drift: &openchoreov1alpha1.ResourceDrift{Fields: []string{"spec.replicas"}, DetectedTime: detected}},
+		{ID: "svc", Drift: &openchoreov1alpha1.ResourceDrift{Fields: []string{"spec.type"}, DetectedTime: detected}},
+		{ID: "config"},
+	}
+	drifted := map[string][]string{
+		"app":    {"spec.replicas"},
+		"svc":    {"spec.ports", "spec.type"},
+		"config": {"data.level"},
+	}
+
+	if ids := newlyDrifted(old, drifted); !reflect.DeepEqual(ids, []string{"config", "svc"}) {
+		t.Errorf("expected new or changed drift for config and svc, got %v", ids)
+	}
+
+	statuses := []openchoreov1alpha1.RenderedManifestStatus{{ID: "app"}, {ID: "config"}, {ID: "secret"}}
+	setResourceDrift(old, statuses, drifted)
+	if statuses[0].Drift == nil || !statuses[0].Drift.DetectedTime.Equal(&detected) {
+		t.Errorf("expected the detection time to be kept for ongoing drift, got %+v", statuses[0].Drift)
+	}
+	if statuses[1].Drift == nil || statuses[1].Drift.DetectedTime.Equal(&detected) {
+		t.Errorf("expected new drift to be stamped with the current time, got %+v", statuses[1].Drift)
+	}
+	if statuses[2].Drift != nil {
+		t.Errorf("expected no drift for secret, got %+v", statuses[2].Drift)
+	}
+}
+
+func TestNotifyDrift(t *testing.T) {
+	var received DriftNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != driftNotificationPath {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&received); err != nil {
+			t.Errorf("failed to decode notification: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	channel := &openchoreov1alpha1.ObservabilityAlertsNotificationChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-slack", Namespace: "default"},
+		Spec:       openchoreov1alpha1.ObservabilityAlertsNotificationChannelSpec{Environment: "production", IsEnvDefault: true},
+	}
+	r := &Reconciler{
+		Client:        fake.NewClientBuilder().WithScheme(s).WithObjects(channel).Build(),
+		DriftNotifier: NewObserverDriftNotifier(server.URL),
+	}
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-production", Namespace: "default"},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: "shop", ComponentName: "checkout"},
+			EnvironmentName: "production",
+		},
+	}
+	app := newDriftDeployment("app", 2, "app:v1")
+	drifted := map[string][]string{"app": {"spec.replicas"}}
+
+	err := r.notifyDrift(context.Background(), release, &openchoreov1alpha1.DriftPolicy{Mode: openchoreov1alpha1.DriftPolicyModeAlert},
+		[]*unstructured.Unstructured{app}, drifted, []string{"app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := DriftNotification{
+		Namespace:   "default",
+		Project:     "shop",
+		Component:   "checkout",
+		Environment: "production",
+		Release:     "checkout-production",
+		Channels:    []string{"prod-slack"},
+		Resources: []DriftedObject{
+			{ID: "app", Kind: deploymentKind, Name: "app", Namespace: "dp-ns", Fields: []string{"spec.replicas"}},
+		},
+	}
+	if !reflect.DeepEqual(received, want) {
+		t.Errorf("expected notification %+v, got %+v", want, received)
+	}
+
+	t.Run("missing default channel", func(t *testing.T) {
+		r := &Reconciler{
+			Client:        fake.NewClientBuilder().WithScheme(s).Build(),
+			DriftNotifier: NewObserverDriftNotifier(server.URL),
+		}
+		if err := r.notifyDrift(context.Background(), release, &openchoreov1alpha1.DriftPolicy{Mode: openchoreov1alpha1.DriftPolicyModeAlert},
+			[]*unstructured.Unstructured{app}, drifted, []string{"app"}); err == nil {
+			t.Errorf("expected an error without a default notification channel")
+		}
+	})
+}
@@ -20,16 +20,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/healthcheck"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
-// updateStatus updates the Release status with applied resources
+// updateStatus updates the Release status with applied resources and their drift
 // Returns true if the status was updated, false if unchanged
-func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.RenderedRelease, appliedResources, liveResources []*unstructured.Unstructured) (bool, error) {
+func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.RenderedRelease, appliedResources, liveResources []*unstructured.Unstructured, drifted map[string][]string) (bool, error) {
 	logger := log.FromContext(ctx)
 
 	// Build resource status from applied and live resources
 	resourceStatuses := r.buildResourceStatus(ctx, old, appliedResources, liveResources)
+	setResourceDrift(old.Status.Resources, resourceStatuses, drifted)
 
 	// Update the status
 	release.Status.Resources = resourceStatuses
@@ -99,7 +101,7 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 			}
 
 			// Get health check function for this resource type
-			healthCheckFunc := GetHealthCheckFunc(gvk)
+			healthCheckFunc := r.getHealthCheckFunc(gvk)
 			if healthCheckFunc != nil {
 				health, err := healthCheckFunc(liveResource)
 				if err != nil {
@@ -201,6 +203,18 @@ func hasResurrectableWorkload(desiredResources, liveResources []*unstructured.Un
 	return false
 }
 
+// getHealthCheckFunc returns the health check for the given resource type, preferring a
+// ClusterHealthCheck targeting the kind over the built-in checks.
+func (r *Reconciler) getHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	if check := r.HealthChecks.Lookup(gvk.GroupKind()); check != nil {
+		return check.Evaluate
+	}
+	return GetHealthCheckFunc(gvk)
+}
+
+// GetHealthCheckFunc returns the built-in health check for the given resource type.
+// Kinds without a hand-written check fall back to the CEL-defined built-ins in the
+// healthcheck package, and then to treating the resource as healthy once it exists.
 func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
 	switch {
 	case gvk.Group == appsAPIGroup && gvk.Kind == deploymentKind:
@@ -211,7 +225,9 @@ func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unst
 		return getPodHealth
 	case gvk.Group == "batch" && gvk.Kind == "CronJob":
 		return getCronJobHealth
-		// TODO: Add gateway http route health check, and other resources as needed
+	}
+	if check := healthcheck.Builtin(gvk.GroupKind()); check != nil {
+		return check.Evaluate
 	}
 	return getUnknownResourceHealth
 }
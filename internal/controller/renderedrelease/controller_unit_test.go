@@ -22,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/healthcheck"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
@@ -516,6 +517,11 @@ func TestGetHealthCheckFunc(t *testing.T) {
 			gvk:        schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"},
 			wantNonNil: true,
 		},
+		{
+			name:       "batch/Job uses the CEL built-in",
+			gvk:        schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+			wantNonNil: true,
+		},
 		{
 			name:        "unknown resource returns non-nil health function",
 			gvk:         schema.GroupVersionKind{Group: "custom.io", Version: "v1", Kind: "Widget"},
@@ -1432,6 +1438,52 @@ func TestBuildResourceStatus(t *testing.T) {
 	})
 }
 
+func TestBuildResourceStatusHealthChecks(t *testing.T) {
+	ctx := context.Background()
+	emptyRelease := &openchoreov1alpha1.RenderedRelease{}
+	desired := buildResourcesDesired("res-1", "my-cm")
+	live := buildResourcesLive("res-1", "my-cm", map[string]interface{}{"phase": "Pending"})
+
+	registry := healthcheck.NewRegistry()
+	if err := registry.Set("configmaps", openchoreov1alpha1.ClusterHealthCheckSpec{
+		Target: openchoreov1alpha1.HealthCheckTarget{Kind: "ConfigMap"},
+		Rules: []openchoreov1alpha1.HealthCheckRule{
+			{Status: openchoreov1alpha1.HealthStatusDegraded, Expression: "object.status.phase == 'Failed'"},
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("ClusterHealthCheck overrides the built-in check", func(t *testing.T) {
+		r := &Reconciler{HealthChecks: registry}
+		result := r.buildResourceStatus(ctx, emptyRelease, []*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})
+		if len(result) != 1 {
+			t.Fatalf("expected 1 result, got %d", len(result))
+		}
+		// No rule matches, so the default status applies.
+		if result[0].HealthStatus != openchoreov1alpha1.HealthStatusProgressing {
+			t.Errorf("expected HealthStatusProgressing, got %s", result[0].HealthStatus)
+		}
+	})
+
+	t.Run("failing expression reports Unknown", func(t *testing.T) {
+		broken := buildResourcesLive("res-1", "my-cm", nil)
+		r := &Reconciler{HealthChecks: registry}
+		result := r.buildResourceStatus(ctx, emptyRelease, []*unstructured.Unstructured{desired}, []*unstructured.Unstructured{broken})
+		if result[0].HealthStatus != openchoreov1alpha1.HealthStatusUnknown {
+			t.Errorf("expected HealthStatusUnknown, got %s", result[0].HealthStatus)
+		}
+	})
+
+	t.Run("kinds without a ClusterHealthCheck use the built-in check", func(t *testing.T) {
+		r := &Reconciler{HealthChecks: healthcheck.NewRegistry()}
+		result := r.buildResourceStatus(ctx, emptyRelease, []*unstructured.Unstructured{desired}, []*unstructured.Unstructured{live})
+		if result[0].HealthStatus != openchoreov1alpha1.HealthStatusHealthy {
+			t.Errorf("expected HealthStatusHealthy, got %s", result[0].HealthStatus)
+		}
+	})
+}
+
 func TestBuildResourceStatusStatusMarshaling(t *testing.T) {
 	ctx := context.Background()
 	r := &Reconciler{}
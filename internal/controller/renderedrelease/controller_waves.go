@@ -0,0 +1,195 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/pkg/hash"
+)
+
+// applyStep is a group of resources that are applied together: every resource of one
+// sync phase that shares an apply wave.
+type applyStep struct {
+	phase     openchoreov1alpha1.SyncPhase
+	wave      int32
+	resources []*unstructured.Unstructured
+}
+
+// phaseOrder ranks the sync phases in the order they are applied.
+var phaseOrder = map[openchoreov1alpha1.SyncPhase]int{
+	openchoreov1alpha1.SyncPhasePreSync:  0,
+	openchoreov1alpha1.SyncPhaseSync:     1,
+	openchoreov1alpha1.SyncPhasePostSync: 2,
+}
+
+// syncOrder reads the sync phase and apply wave of a desired resource from the annotations
+// the rendering pipeline sets from the template's hook and applyWave fields.
+func syncOrder(obj *unstructured.Unstructured) (openchoreov1alpha1.SyncPhase, int32, error) {
+	annotations := obj.GetAnnotations()
+
+	phase := openchoreov1alpha1.SyncPhaseSync
+	if hook := annotations[labels.AnnotationKeySyncHook]; hook != "" {
+		phase = openchoreov1alpha1.SyncPhase(hook)
+		if phase != openchoreov1alpha1.SyncPhasePreSync && phase != openchoreov1alpha1.SyncPhasePostSync {
+			return "", 0, fmt.Errorf("invalid %s annotation %q: must be PreSync or PostSync", labels.AnnotationKeySyncHook, hook)
+		}
+	}
+
+	var wave int32
+	if value := annotations[labels.AnnotationKeyApplyWave]; value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid %s annotation %q: %w", labels.AnnotationKeyApplyWave, value, err)
+		}
+		wave = int32(parsed)
+	}
+	return phase, wave, nil
+}
+
+// planApplySteps groups the desired resources into the steps they are applied in:
+// PreSync hooks, then regular resources, then PostSync hooks, each by ascending wave.
+// Resources keep their spec order within a step. Without any wave or hook annotations
+// this yields a single step, which preserves the original apply-everything behavior.
+func planApplySteps(resources []*unstructured.Unstructured) ([]applyStep, error) {
+	type stepKey struct {
+		phase openchoreov1alpha1.SyncPhase
+		wave  int32
+	}
+	index := make(map[stepKey]int)
+	var steps []applyStep
+
+	for _, obj := range resources {
+		phase, wave, err := syncOrder(obj)
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID], err)
+		}
+		key := stepKey{phase: phase, wave: wave}
+		i, ok := index[key]
+		if !ok {
+			i = len(steps)
+			index[key] = i
+			steps = append(steps, applyStep{phase: phase, wave: wave})
+		}
+		steps[i].resources = append(steps[i].resources, obj)
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].phase != steps[j].phase {
+			return phaseOrder[steps[i].phase] < phaseOrder[steps[j].phase]
+		}
+		return steps[i].wave < steps[j].wave
+	})
+	return steps, nil
+}
+
+// applyResources applies the desired resources to the target plane step by step. A step
+// is only applied once every resource in the previous steps is healthy (or suspended).
+// It returns the step being waited on, or nil when every step has been applied.
+//
+// Resources of steps that were already applied are re-applied on every reconcile so drift
+// is corrected; resources of later steps are left untouched until their turn.
+func (r *Reconciler) applyResources(ctx context.Context, planeClient client.Client, resources []*unstructured.Unstructured) (*openchoreov1alpha1.RenderedReleaseWave, error) {
+	steps, err := planApplySteps(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, step := range steps {
+		var pending []string
+		for _, obj := range step.resources {
+			resourceID := obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]
+
+			if step.phase != openchoreov1alpha1.SyncPhaseSync {
+				recreating, err := r.applyHook(ctx, planeClient, obj)
+				if err != nil {
+					return nil, fmt.Errorf("failed to apply hook %s: %w", resourceID, err)
+				}
+				if recreating {
+					pending = append(pending, resourceID)
+					continue
+				}
+			} else if err := planeClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName)); err != nil {
+				return nil, fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+			}
+
+			// The last step has nothing waiting on it.
+			if i == len(steps)-1 {
+				continue
+			}
+			// The applied object carries the live status returned by the server.
+			if !r.isSettled(obj) {
+				pending = append(pending, resourceID)
+			}
+		}
+
+		if len(pending) > 0 {
+			return &openchoreov1alpha1.RenderedReleaseWave{
+				Phase:            step.phase,
+				Wave:             step.wave,
+				PendingResources: pending,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isSettled reports whether a resource is healthy or deliberately suspended, so the
+// next step can be applied.
+func (r *Reconciler) isSettled(obj *unstructured.Unstructured) bool {
+	health, err := r.getHealthCheckFunc(obj.GroupVersionKind())(obj)
+	if err != nil {
+		return false
+	}
+	return health == openchoreov1alpha1.HealthStatusHealthy || health == openchoreov1alpha1.HealthStatusSuspended
+}
+
+// applyHook applies a sync hook. Hooks typically run to completion (e.g. a migration Job)
+// and have immutable specs, so instead of being re-applied they are created once and
+// re-created when their rendered content changes, detected via a hash annotation.
+// When the hook is unchanged, obj is replaced with the live object so its health can be
+// evaluated. It returns true while an outdated hook is being deleted.
+func (r *Reconciler) applyHook(ctx context.Context, planeClient client.Client, obj *unstructured.Unstructured) (bool, error) {
+	desiredHash := hash.ComputeHash(obj.Object, nil)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[labels.AnnotationKeySyncHookHash] = desiredHash
+	obj.SetAnnotations(annotations)
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(obj.GroupVersionKind())
+	err := planeClient.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}, live)
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, planeClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName))
+	case err != nil:
+		return false, err
+	}
+
+	if live.GetDeletionTimestamp() != nil {
+		return true, nil
+	}
+	if live.GetAnnotations()[labels.AnnotationKeySyncHookHash] == desiredHash {
+		obj.Object = live.Object
+		return false, nil
+	}
+
+	if err := planeClient.Delete(ctx, live, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// wavePlane is a minimal target plane that records server-side applies and serves the
+// applied objects back with a status set by the test.
+type wavePlane struct {
+	client.Client
+	objects  map[string]*unstructured.Unstructured
+	statuses map[string]map[string]any
+	applied  []string
+	deleted  []string
+}
+
+func newWavePlane() *wavePlane {
+	p := &wavePlane{
+		objects:  map[string]*unstructured.Unstructured{},
+		statuses: map[string]map[string]any{},
+	}
+	p.Client = fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			u := obj.(*unstructured.Unstructured)
+			if status, ok := p.statuses[u.GetName()]; ok {
+				u.Object["status"] = status
+			}
+			p.objects[u.GetName()] = u.DeepCopy()
+			p.applied = append(p.applied, u.GetName())
+			return nil
+		},
+		Get: func(_ context.Context, _ client.WithWatch, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			stored, ok := p.objects[key.Name]
+			if !ok {
+				return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			stored = stored.DeepCopy()
+			if status, ok := p.statuses[key.Name]; ok {
+				stored.Object["status"] = status
+			}
+			obj.(*unstructured.Unstructured).Object = stored.Object
+			return nil
+		},
+		Delete: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.DeleteOption) error {
+			delete(p.objects, obj.GetName())
+			p.deleted = append(p.deleted, obj.GetName())
+			return nil
+		},
+	}).Build()
+	return p
+}
+
+func newWaveResource(kind, name, hook, wave string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	switch kind {
+	case "Job":
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: kind})
+	case deploymentKind:
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Group: appsAPIGroup, Version: "v1", Kind: kind})
+	default:
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: kind})
+	}
+	obj.SetName(name)
+	obj.SetNamespace("dp-ns")
+	obj.SetLabels(map[string]string{labels.LabelKeyRenderedReleaseResourceID: name})
+	annotations := map[string]string{}
+	if hook != "" {
+		annotations[labels.AnnotationKeySyncHook] = hook
+	}
+	if wave != "" {
+		annotations[labels.AnnotationKeyApplyWave] = wave
+	}
+	if len(annotations) > 0 {
+		obj.SetAnnotations(annotations)
+	}
+	return obj
+}
+
+func jobComplete() map[string]any {
+	return map[string]any{"conditions": []any{map[string]any{"type": "Complete", "status": "True"}}}
+}
+
+func TestPlanApplySteps(t *testing.T) {
+	resources := []*unstructured.Unstructured{
+		newWaveResource("ConfigMap", "config", "", ""),
+		newWaveResource("Job", "smoke-test", "PostSync", ""),
+		newWaveResource(deploymentKind, "app", "", "1"),
+		newWaveResource("Job", "migrate", "PreSync", ""),
+		newWaveResource("Secret", "creds", "", "-1"),
+		newWaveResource("Service", "svc", "", ""),
+	}
+
+	steps, err := planApplySteps(resources)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, step := range steps {
+		names := make([]string, 0, len(step.resources))
+		for _, obj := range step.resources {
+			names = append(names, obj.GetName())
+		}
+		got = append(got, string(step.phase)+"/"+strings.Join(names, ","))
+	}
+	want := []string{
+		"PreSync/migrate",
+		"Sync/creds",
+		"Sync/config,svc",
+		"Sync/app",
+		"PostSync/smoke-test",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected steps %v, got %v", want, got)
+	}
+
+	t.Run("no annotations yields a single step", func(t *testing.T) {
+		steps, err := planApplySteps([]*unstructured.Unstructured{
+			newWaveResource("ConfigMap", "a", "", ""),
+			newWaveResource("Service", "b", "", ""),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(steps) != 1 || len(steps[0].resources) != 2 {
+			t.Errorf("expected one step with both resources, got %+v", steps)
+		}
+	})
+
+	t.Run("invalid annotations are rejected", func(t *testing.T) {
+		for _, obj := range []*unstructured.Unstructured{
+			newWaveResource("Job", "bad-hook", "PreApply", ""),
+			newWaveResource("ConfigMap", "bad-wave", "", "first"),
+		} {
+			if _, err := planApplySteps([]*unstructured.Unstructured{obj}); err == nil {
+				t.Errorf("expected error for %s", obj.GetName())
+			}
+		}
+	})
+}
+
+func TestApplyResourcesWaves(t *testing.T) {
+	ctx := context.Background()
+	r := &Reconciler{}
+	desired := func() []*unstructured.Unstructured {
+		return []*unstructured.Unstructured{
+			newWaveResource("Job", "migrate", "PreSync", ""),
+			newWaveResource("ConfigMap", "config", "", ""),
+			newWaveResource("Job", "smoke-test", "PostSync", ""),
+		}
+	}
+	plane := newWavePlane()
+
+	// The PreSync hook has not completed, so nothing else is applied.
+	wave, err := r.applyResources(ctx, plane, desired())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wave == nil || wave.Phase != openchoreov1alpha1.SyncPhasePreSync || !reflect.DeepEqual(wave.PendingResources, []string{"migrate"}) {
+		t.Fatalf("expected to wait on PreSync hook migrate, got %+v", wave)
+	}
+	if !reflect.DeepEqual(plane.applied, []string{"migrate"}) {
+		t.Errorf("expected only the hook to be applied, got %v", plane.applied)
+	}
+
+	// Once the hook completes the regular resources and the PostSync hook are applied,
+	// and the unchanged hook is not applied again.
+	plane.statuses["migrate"] = jobComplete()
+	plane.applied = nil
+	wave, err = r.applyResources(ctx, plane, desired())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wave != nil {
+		t.Errorf("expected all steps to be applied, got %+v", wave)
+	}
+	if !reflect.DeepEqual(plane.applied, []string{"config", "smoke-test"}) {
+		t.Errorf("expected config and smoke-test to be applied, got %v", plane.applied)
+	}
+
+	// A changed hook is deleted and re-created, holding back the later steps meanwhile.
+	changed := desired()
+	changed[0].SetLabels(map[string]string{labels.LabelKeyRenderedReleaseResourceID: "migrate", "schema": "v2"})
+	plane.applied = nil
+	wave, err = r.applyResources(ctx, plane, changed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wave == nil || !reflect.DeepEqual(wave.PendingResources, []string{"migrate"}) {
+		t.Errorf("expected to wait on the re-created hook, got %+v", wave)
+	}
+	if !reflect.DeepEqual(plane.deleted, []string{"migrate"}) || len(plane.applied) != 0 {
+		t.Errorf("expected the outdated hook to be deleted first, deleted=%v applied=%v", plane.deleted, plane.applied)
+	}
+
+	delete(plane.statuses, "migrate")
+	if _, err := r.applyResources(ctx, plane, changed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(plane.applied, []string{"migrate"}) {
+		t.Errorf("expected the hook to be re-created, got %v", plane.applied)
+	}
+}
@@ -5,46 +5,273 @@ package secretreference
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// ControllerName is the name of the controller managing SecretReference resources
+	ControllerName = "secretreference-controller"
+
+	// defaultRefreshInterval is used when spec.refreshInterval is unset. Matches the CRD default.
+	defaultRefreshInterval = time.Hour
+
+	// syncPollInterval is how often an ExternalSecret that has not synced yet is re-checked.
+	syncPollInterval = 10 * time.Second
+
+	// planeRetryInterval is how long to wait before retrying when the target plane is
+	// missing or has no secret store configured. Plane changes are not watched.
+	planeRetryInterval = time.Minute
 )
 
 // Reconciler reconciles a SecretReference object
 type Reconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	PlaneClientProvider kubernetesClient.PlaneClientProvider
+	Scheme              *runtime.Scheme
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterdataplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterworkflowplanes,verbs=get;list;watch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SecretReference object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile materializes the secret described by a SecretReference on its target plane.
+// It applies an External Secrets Operator ExternalSecret that reads spec.data from the
+// ClusterSecretStore configured in the plane's secretStoreRef into each namespace whose
+// workloads consume the secret (see targetNamespaces), and reports the sync state of those
+// ExternalSecrets in status.secretStores and the Ready condition.
+// Owner references are not set on the ExternalSecret as it lives in a separate cluster;
+// a finalizer removes it when the SecretReference is deleted.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
 
-	// TODO(user): your logic here
+	secretRef := &openchoreodevv1alpha1.SecretReference{}
+	if err := r.Get(ctx, req.NamespacedName, secretRef); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("SecretReference resource not found. Ignoring since it must be deleted.")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get SecretReference")
+		return ctrl.Result{}, err
+	}
 
-	return ctrl.Result{}, nil
+	old := secretRef.DeepCopy()
+
+	if !secretRef.DeletionTimestamp.IsZero() {
+		logger.Info("Finalizing SecretReference")
+		return r.finalize(ctx, old, secretRef)
+	}
+
+	if added, err := r.ensureFinalizer(ctx, secretRef); err != nil || added {
+		return ctrl.Result{}, err
+	}
+
+	return r.reconcile(ctx, old, secretRef)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, old, secretRef *openchoreodevv1alpha1.SecretReference) (result ctrl.Result, rErr error) {
+	logger := log.FromContext(ctx)
+
+	// Deferred status write: skip when nothing changed, aggregate errors with
+	// any returned by the body.
+	defer func() {
+		if apiequality.Semantic.DeepEqual(old.Status, secretRef.Status) {
+			return
+		}
+		if err := r.Status().Update(ctx, secretRef); err != nil {
+			logger.Error(err, "Failed to update SecretReference status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
+	// Remove ExternalSecrets left on a plane that is no longer the target, e.g. after
+	// spec.targetPlane was changed or cleared.
+	targetRef := secretRef.Spec.TargetPlane
+	r.deleteStaleExternalSecrets(ctx, secretRef, func(store openchoreodevv1alpha1.SecretStoreReference) bool {
+		return samePlane(store.Plane, targetRef)
+	})
+
+	if targetRef == nil {
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonNoTargetPlane,
+			"spec.targetPlane is not set; the secret is materialized by the resources that consume it")
+		return ctrl.Result{}, nil
+	}
+
+	plane, err := r.resolveTargetPlane(ctx, secretRef.Namespace, targetRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			controller.MarkFalseCondition(secretRef, ConditionReady, ReasonTargetPlaneNotFound, err.Error())
+			return ctrl.Result{RequeueAfter: planeRetryInterval}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if plane.secretStoreName == "" {
+		controller.MarkFalseCondition(secretRef, ConditionReady, ReasonSecretStoreNotConfigured,
+			fmt.Sprintf("%s %q has no secretStoreRef configured", targetRef.Kind, targetRef.Name))
+		return ctrl.Result{RequeueAfter: planeRetryInterval}, nil
+	}
+
+	namespaces, err := r.targetNamespaces(ctx, secretRef, plane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Remove ExternalSecrets from namespaces whose workloads no longer consume the secret.
+	r.deleteStaleExternalSecrets(ctx, secretRef, func(store openchoreodevv1alpha1.SecretStoreReference) bool {
+		return !samePlane(store.Plane, targetRef) || slices.Contains(namespaces, store.Namespace)
+	})
+
+	if len(namespaces) == 0 {
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonNoConsumers,
+			fmt.Sprintf("No workload deployed to %s %q references the secret", targetRef.Kind, targetRef.Name))
+		return ctrl.Result{}, nil
+	}
+
+	planeClient, err := plane.k8sClient(r.PlaneClientProvider)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get client for %s %q: %w", targetRef.Kind, targetRef.Name, err)
+	}
+
+	var failed, pending *openchoreodevv1alpha1.SecretStoreReference
+	for _, namespace := range namespaces {
+		store, err := r.applyExternalSecret(ctx, planeClient, secretRef, namespace, plane.secretStoreName)
+		if err != nil {
+			logger.Error(err, "Failed to apply ExternalSecret to target plane",
+				"planeKind", targetRef.Kind, "planeName", targetRef.Name, "namespace", namespace)
+			controller.MarkFalseCondition(secretRef, ConditionReady, ReasonApplyFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+		store.Plane = targetRef.DeepCopy()
+		setSecretStore(secretRef, *store)
+
+		switch {
+		case store.SyncStatus == openchoreodevv1alpha1.SecretSyncStatusFailed && failed == nil:
+			failed = store
+		case store.SyncStatus == openchoreodevv1alpha1.SecretSyncStatusPending && pending == nil:
+			pending = store
+		}
+	}
+
+	interval := refreshInterval(secretRef)
+	now := time.Now()
+	if refreshDue(secretRef, now, interval) {
+		secretRef.Status.LastRefreshTime = &metav1.Time{Time: now}
+	}
+
+	switch {
+	case failed != nil:
+		controller.MarkFalseCondition(secretRef, ConditionReady, ReasonSyncFailed, failed.Message)
+		return ctrl.Result{RequeueAfter: syncPollInterval}, nil
+	case pending != nil:
+		controller.MarkFalseCondition(secretRef, ConditionReady, ReasonSyncPending,
+			fmt.Sprintf("Waiting for ExternalSecret %s/%s to sync", pending.Namespace, secretRef.Name))
+		return ctrl.Result{RequeueAfter: syncPollInterval}, nil
+	}
+
+	controller.MarkTrueCondition(secretRef, ConditionReady, ReasonSecretSynced,
+		fmt.Sprintf("Secret synced from ClusterSecretStore %q on %s %q", plane.secretStoreName, targetRef.Kind, targetRef.Name))
+	return ctrl.Result{RequeueAfter: nextRefresh(secretRef, now, interval)}, nil
+}
+
+// refreshInterval returns spec.refreshInterval, or the default when it is unset or not positive.
+func refreshInterval(secretRef *openchoreodevv1alpha1.SecretReference) time.Duration {
+	if secretRef.Spec.RefreshInterval != nil && secretRef.Spec.RefreshInterval.Duration > 0 {
+		return secretRef.Spec.RefreshInterval.Duration
+	}
+	return defaultRefreshInterval
+}
+
+// refreshDue reports whether status.lastRefreshTime should be bumped: on first sync, after
+// a spec change, and once the refresh interval has elapsed. Bumping it on every reconcile
+// would turn each status write into another reconcile.
+func refreshDue(secretRef *openchoreodevv1alpha1.SecretReference, now time.Time, interval time.Duration) bool {
+	last := secretRef.Status.LastRefreshTime
+	if last == nil || now.Sub(last.Time) >= interval {
+		return true
+	}
+	ready := meta.FindStatusCondition(secretRef.Status.Conditions, string(ConditionReady))
+	return ready == nil || ready.ObservedGeneration != secretRef.Generation
+}
+
+// nextRefresh returns how long to wait until the next refresh of a synced secret.
+func nextRefresh(secretRef *openchoreodevv1alpha1.SecretReference, now time.Time, interval time.Duration) time.Duration {
+	wait := interval - now.Sub(secretRef.Status.LastRefreshTime.Time)
+	if wait < time.Second {
+		return time.Second
+	}
+	return wait
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreodevv1alpha1.SecretReference{}).
+		Watches(&openchoreodevv1alpha1.Workload{},
+			handler.EnqueueRequestsFromMapFunc(r.findSecretReferencesForWorkload)).
+		Watches(&openchoreodevv1alpha1.Environment{},
+			handler.EnqueueRequestsFromMapFunc(r.findSecretReferencesForEnvironment)).
 		Named("secretreference").
 		Complete(r)
 }
+
+// findSecretReferencesForWorkload enqueues the SecretReferences a Workload reads from, so
+// the secret follows the workload into the namespaces it is deployed to.
+func (r *Reconciler) findSecretReferencesForWorkload(_ context.Context, obj client.Object) []reconcile.Request {
+	workload, ok := obj.(*openchoreodevv1alpha1.Workload)
+	if !ok {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, name := range referencedSecretReferences(workload) {
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: workload.Namespace, Name: name}}
+		if !slices.Contains(requests, req) {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
+// findSecretReferencesForEnvironment enqueues the SecretReferences of the Environment's
+// namespace that target a plane, as adding or moving an environment changes the runtime
+// namespaces they are materialized in.
+func (r *Reconciler) findSecretReferencesForEnvironment(ctx context.Context, obj client.Object) []reconcile.Request {
+	secretRefs := &openchoreodevv1alpha1.SecretReferenceList{}
+	if err := r.List(ctx, secretRefs, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list SecretReferences for Environment", "environment", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, secretRef := range secretRefs.Items {
+		if secretRef.Spec.TargetPlane != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&secretRef)})
+		}
+	}
+	return requests
+}
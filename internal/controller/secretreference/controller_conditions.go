@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates the referenced secret has been materialized on the target plane.
+	// Failure modes are encoded in the condition's Reason.
+	ConditionReady controller.ConditionType = "Ready"
+
+	// ConditionFinalizing indicates the SecretReference is being finalized (deleted).
+	ConditionFinalizing controller.ConditionType = "Finalizing"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonSecretSynced indicates every secret store has synced the secret.
+	ReasonSecretSynced controller.ConditionReason = "SecretSynced"
+
+	// ReasonSyncPending indicates the ExternalSecret was applied but has not synced yet.
+	ReasonSyncPending controller.ConditionReason = "SyncPending"
+
+	// ReasonSyncFailed indicates the external secret store reported an error while syncing.
+	ReasonSyncFailed controller.ConditionReason = "SyncFailed"
+
+	// ReasonNoTargetPlane indicates spec.targetPlane is unset, so there is nothing to materialize.
+	// The reference is resolved by its consumers (workloads and workflows) instead.
+	ReasonNoTargetPlane controller.ConditionReason = "NoTargetPlane"
+
+	// ReasonNoConsumers indicates no workload deployed to the target data plane references the
+	// secret, so there is no namespace to materialize it in yet.
+	ReasonNoConsumers controller.ConditionReason = "NoConsumers"
+
+	// ReasonTargetPlaneNotFound indicates the plane referenced by spec.targetPlane does not exist.
+	ReasonTargetPlaneNotFound controller.ConditionReason = "TargetPlaneNotFound"
+
+	// ReasonSecretStoreNotConfigured indicates the target plane has no secretStoreRef.
+	ReasonSecretStoreNotConfigured controller.ConditionReason = "SecretStoreNotConfigured"
+
+	// ReasonApplyFailed indicates the ExternalSecret could not be applied to the target plane.
+	ReasonApplyFailed controller.ConditionReason = "ApplyFailed"
+
+	// ReasonFinalizing indicates the SecretReference is being finalized.
+	ReasonFinalizing controller.ConditionReason = "Finalizing"
+)
+
+// NewFinalizingCondition returns a Finalizing=True condition observed at the
+// given generation, used while the materialized secrets are being removed.
+func NewFinalizingCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionFinalizing,
+		metav1.ConditionTrue,
+		ReasonFinalizing,
+		"SecretReference is finalizing",
+		generation,
+	)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// SecretReferenceCleanupFinalizer ensures the ExternalSecrets applied to target planes
+	// are removed before the SecretReference is deleted.
+	SecretReferenceCleanupFinalizer = "openchoreo.dev/secretreference-cleanup"
+)
+
+// ensureFinalizer adds the cleanup finalizer when missing. The first return value
+// indicates whether the finalizer was just added (caller should return and let the
+// update trigger the next reconcile).
+func (r *Reconciler) ensureFinalizer(ctx context.Context, secretRef *openchoreodevv1alpha1.SecretReference) (bool, error) {
+	if !secretRef.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	if controllerutil.AddFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		return true, r.Update(ctx, secretRef)
+	}
+	return false, nil
+}
+
+// finalize deletes the ExternalSecrets recorded in status.secretStores from their planes
+// and then removes the finalizer. Deletion blocks while a plane that still exists cannot
+// be reached, so secrets are not left behind on it.
+func (r *Reconciler) finalize(ctx context.Context, old, secretRef *openchoreodevv1alpha1.SecretReference) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	cond := meta.FindStatusCondition(secretRef.Status.Conditions, string(ConditionFinalizing))
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		if meta.SetStatusCondition(&secretRef.Status.Conditions, NewFinalizingCondition(secretRef.Generation)) {
+			return controller.UpdateStatusConditionsAndReturn(ctx, r.Client, old, secretRef)
+		}
+	}
+
+	for _, store := range secretRef.Status.SecretStores {
+		if err := r.deleteExternalSecret(ctx, secretRef, store); err != nil {
+			logger.Error(err, "Failed to delete ExternalSecret from target plane")
+			controller.MarkTrueCondition(secretRef, ConditionFinalizing, ReasonFinalizing, err.Error())
+			return controller.UpdateStatusConditionsAndReturnError(ctx, r.Client, old, secretRef, err)
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(secretRef, SecretReferenceCleanupFinalizer) {
+		if err := r.Update(ctx, secretRef); err != nil {
+			return ctrl.Result{}, fmt.Errorf("remove finalizer: %w", err)
+		}
+	}
+
+	logger.Info("Successfully finalized SecretReference")
+	return ctrl.Result{}, nil
+}
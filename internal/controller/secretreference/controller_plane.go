@@ -0,0 +1,386 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	esv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// workflowNamespacePrefix is the prefix of the namespace on a workflow plane that the
+	// workflow runs of a control plane namespace execute in.
+	workflowNamespacePrefix = "workflows-"
+
+	clusterSecretStoreKind = "ClusterSecretStore"
+)
+
+// targetPlane is a resolved spec.targetPlane: exactly one of dataPlane and workflowPlane is set.
+type targetPlane struct {
+	dataPlane       *controller.DataPlaneResult
+	workflowPlane   *controller.WorkflowPlaneResult
+	secretStoreName string
+}
+
+func (p *targetPlane) k8sClient(provider kubernetesClient.PlaneClientProvider) (client.Client, error) {
+	if p.dataPlane != nil {
+		return p.dataPlane.GetK8sClient(provider)
+	}
+	return p.workflowPlane.GetK8sClient(provider)
+}
+
+// resolveTargetPlane fetches the plane referenced by ref. A missing plane is reported as a
+// NotFound error.
+func (r *Reconciler) resolveTargetPlane(
+	ctx context.Context,
+	namespace string,
+	ref *openchoreodevv1alpha1.TargetPlaneRef,
+) (*targetPlane, error) {
+	switch ref.Kind {
+	case string(openchoreodevv1alpha1.DataPlaneRefKindDataPlane), string(openchoreodevv1alpha1.DataPlaneRefKindClusterDataPlane):
+		dp, err := controller.GetDataPlaneFromRef(ctx, r.Client, namespace, &openchoreodevv1alpha1.DataPlaneRef{
+			Kind: openchoreodevv1alpha1.DataPlaneRefKind(ref.Kind),
+			Name: ref.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &targetPlane{dataPlane: dp, secretStoreName: dp.GetSecretStoreName()}, nil
+
+	case string(openchoreodevv1alpha1.WorkflowPlaneRefKindWorkflowPlane), string(openchoreodevv1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane):
+		wp, err := controller.GetWorkflowPlaneFromRef(ctx, r.Client, namespace, &openchoreodevv1alpha1.WorkflowPlaneRef{
+			Kind: openchoreodevv1alpha1.WorkflowPlaneRefKind(ref.Kind),
+			Name: ref.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &targetPlane{workflowPlane: wp, secretStoreName: wp.GetSecretStoreName()}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported targetPlane kind %q", ref.Kind)
+	}
+}
+
+// targetNamespaces returns the namespaces on the target plane that hold the workloads
+// consuming secretRef, sorted. On a workflow plane that is the namespace the workflow runs
+// of the owning namespace execute in. On a data plane it is the runtime namespace
+// dp-{ns}-{project}-{env}-{hash} of every environment deployed to that plane, for each
+// project with a Workload that references secretRef.
+func (r *Reconciler) targetNamespaces(
+	ctx context.Context,
+	secretRef *openchoreodevv1alpha1.SecretReference,
+	plane *targetPlane,
+) ([]string, error) {
+	if plane.workflowPlane != nil {
+		return []string{workflowNamespacePrefix + secretRef.Namespace}, nil
+	}
+
+	workloads := &openchoreodevv1alpha1.WorkloadList{}
+	if err := r.List(ctx, workloads, client.InNamespace(secretRef.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	projects := make(map[string]struct{})
+	for i := range workloads.Items {
+		if slices.Contains(referencedSecretReferences(&workloads.Items[i]), secretRef.Name) {
+			projects[workloads.Items[i].Spec.Owner.ProjectName] = struct{}{}
+		}
+	}
+	if len(projects) == 0 {
+		return nil, nil
+	}
+
+	environments := &openchoreodevv1alpha1.EnvironmentList{}
+	if err := r.List(ctx, environments, client.InNamespace(secretRef.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	var namespaces []string
+	for i := range environments.Items {
+		env := &environments.Items[i]
+		dp, err := controller.GetDataPlaneFromRef(ctx, r.Client, env.Namespace, env.Spec.DataPlaneRef)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// Nothing can be deployed to an environment without a data plane.
+				continue
+			}
+			return nil, err
+		}
+		if !sameDataPlane(dp, plane.dataPlane) {
+			continue
+		}
+		// Same naming as the release binding controllers use for the runtime namespace.
+		for project := range projects {
+			namespaces = append(namespaces, dpkubernetes.GenerateK8sNameWithLengthLimit(
+				dpkubernetes.MaxNamespaceNameLength,
+				"dp", secretRef.Namespace, project, env.Name,
+			))
+		}
+	}
+	slices.Sort(namespaces)
+	return namespaces, nil
+}
+
+// referencedSecretReferences returns the names of the SecretReferences the container of
+// workload reads env vars or files from.
+func referencedSecretReferences(workload *openchoreodevv1alpha1.Workload) []string {
+	var names []string
+	for _, env := range workload.Spec.Container.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+			names = append(names, env.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	for _, file := range workload.Spec.Container.Files {
+		if file.ValueFrom != nil && file.ValueFrom.SecretKeyRef != nil {
+			names = append(names, file.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	return names
+}
+
+func sameDataPlane(a, b *controller.DataPlaneResult) bool {
+	switch {
+	case a.DataPlane != nil && b.DataPlane != nil:
+		return a.DataPlane.Namespace == b.DataPlane.Namespace && a.DataPlane.Name == b.DataPlane.Name
+	case a.ClusterDataPlane != nil && b.ClusterDataPlane != nil:
+		return a.ClusterDataPlane.Name == b.ClusterDataPlane.Name
+	default:
+		return false
+	}
+}
+
+// applyExternalSecret server-side applies the ExternalSecret for secretRef on the target plane
+// in namespace and returns the secret store entry describing its current sync state.
+func (r *Reconciler) applyExternalSecret(
+	ctx context.Context,
+	planeClient client.Client,
+	secretRef *openchoreodevv1alpha1.SecretReference,
+	namespace, secretStoreName string,
+) (*openchoreodevv1alpha1.SecretStoreReference, error) {
+	if err := ensureNamespace(ctx, planeClient, namespace); err != nil {
+		return nil, err
+	}
+
+	es, err := makeExternalSecret(secretRef, namespace, secretStoreName)
+	if err != nil {
+		return nil, err
+	}
+	if err := planeClient.Patch(ctx, es, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName)); err != nil {
+		return nil, fmt.Errorf("failed to apply ExternalSecret %s/%s: %w", namespace, es.GetName(), err)
+	}
+
+	// The apply response carries the live object, including the status written by ESO.
+	applied := &esv1.ExternalSecret{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(es.Object, applied); err != nil {
+		return nil, fmt.Errorf("failed to decode ExternalSecret %s/%s: %w", namespace, es.GetName(), err)
+	}
+
+	store := &openchoreodevv1alpha1.SecretStoreReference{
+		Name:      secretStoreName,
+		Namespace: namespace,
+		Kind:      esv1.ExtSecretKind,
+	}
+	store.SyncStatus, store.Message = externalSecretSyncStatus(applied)
+	if !applied.Status.RefreshTime.IsZero() {
+		refreshed := applied.Status.RefreshTime
+		store.LastSyncTime = &refreshed
+	}
+	return store, nil
+}
+
+// makeExternalSecret builds the ExternalSecret that materializes secretRef as a Secret of
+// the same name. It is built as unstructured so applying it does not depend on the ESO
+// types being registered in the plane client's scheme.
+func makeExternalSecret(
+	secretRef *openchoreodevv1alpha1.SecretReference,
+	namespace, secretStoreName string,
+) (*unstructured.Unstructured, error) {
+	data := make([]esv1.ExternalSecretData, 0, len(secretRef.Spec.Data))
+	for _, d := range secretRef.Spec.Data {
+		data = append(data, esv1.ExternalSecretData{
+			SecretKey: d.SecretKey,
+			RemoteRef: esv1.ExternalSecretDataRemoteRef{
+				Key:      d.RemoteRef.Key,
+				Property: d.RemoteRef.Property,
+				Version:  d.RemoteRef.Version,
+			},
+		})
+	}
+
+	template := &esv1.ExternalSecretTemplate{Type: secretRef.Spec.Template.Type}
+	if md := secretRef.Spec.Template.Metadata; md != nil {
+		template.Metadata = esv1.ExternalSecretTemplateMetadata{
+			Annotations: md.Annotations,
+			Labels:      md.Labels,
+		}
+	}
+
+	es := &esv1.ExternalSecret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: esv1.SchemeGroupVersion.String(),
+			Kind:       esv1.ExtSecretKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretRef.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				labels.LabelKeyManagedBy:     ControllerName,
+				labels.LabelKeyNamespaceName: secretRef.Namespace,
+				labels.LabelKeyName:          secretRef.Name,
+			},
+		},
+		Spec: esv1.ExternalSecretSpec{
+			SecretStoreRef: esv1.SecretStoreRef{
+				Name: secretStoreName,
+				Kind: clusterSecretStoreKind,
+			},
+			RefreshInterval: &metav1.Duration{Duration: refreshInterval(secretRef)},
+			Target: esv1.ExternalSecretTarget{
+				Name:           secretRef.Name,
+				CreationPolicy: esv1.CreatePolicyOwner,
+				Template:       template,
+			},
+			Data: data,
+		},
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(es)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ExternalSecret %s/%s: %w", namespace, secretRef.Name, err)
+	}
+	// Status is owned by ESO; leaving the zero value in the apply configuration would
+	// make this controller a field manager of it.
+	delete(obj, "status")
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// externalSecretSyncStatus maps the Ready condition of an ExternalSecret to a sync status.
+func externalSecretSyncStatus(es *esv1.ExternalSecret) (openchoreodevv1alpha1.SecretSyncStatus, string) {
+	for _, cond := range es.Status.Conditions {
+		if cond.Type != esv1.ExternalSecretReady {
+			continue
+		}
+		switch {
+		case cond.Status == corev1.ConditionTrue:
+			return openchoreodevv1alpha1.SecretSyncStatusSynced, cond.Message
+		case cond.Reason == esv1.ConditionReasonSecretSyncedError:
+			return openchoreodevv1alpha1.SecretSyncStatusFailed, cond.Message
+		default:
+			return openchoreodevv1alpha1.SecretSyncStatusPending, cond.Message
+		}
+	}
+	return openchoreodevv1alpha1.SecretSyncStatusPending, ""
+}
+
+// ensureNamespace creates the namespace on the target plane if it does not exist yet.
+func ensureNamespace(ctx context.Context, planeClient client.Client, name string) error {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				labels.LabelKeyCreatedBy: ControllerName,
+			},
+		},
+	}
+	if err := planeClient.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", name, err)
+	}
+	return nil
+}
+
+// deleteExternalSecret deletes the ExternalSecret recorded in store from its plane. ESO
+// garbage collects the Secret it created through the owner reference.
+func (r *Reconciler) deleteExternalSecret(
+	ctx context.Context,
+	secretRef *openchoreodevv1alpha1.SecretReference,
+	store openchoreodevv1alpha1.SecretStoreReference,
+) error {
+	if store.Plane == nil {
+		return nil
+	}
+	plane, err := r.resolveTargetPlane(ctx, secretRef.Namespace, store.Plane)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The plane is gone, so is anything this controller applied through it.
+			return nil
+		}
+		return err
+	}
+	planeClient, err := plane.k8sClient(r.PlaneClientProvider)
+	if err != nil {
+		return fmt.Errorf("failed to get client for %s %q: %w", store.Plane.Kind, store.Plane.Name, err)
+	}
+
+	es := &unstructured.Unstructured{}
+	es.SetGroupVersionKind(esv1.ExtSecretGroupVersionKind)
+	es.SetNamespace(store.Namespace)
+	es.SetName(secretRef.Name)
+	if err := planeClient.Delete(ctx, es); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ExternalSecret %s/%s on %s %q: %w",
+			store.Namespace, secretRef.Name, store.Plane.Kind, store.Plane.Name, err)
+	}
+	return nil
+}
+
+// deleteStaleExternalSecrets deletes the ExternalSecrets recorded in status.secretStores
+// that keep does not accept. Entries that could not be cleaned up are kept in the status
+// so the next reconcile retries them.
+func (r *Reconciler) deleteStaleExternalSecrets(
+	ctx context.Context,
+	secretRef *openchoreodevv1alpha1.SecretReference,
+	keep func(store openchoreodevv1alpha1.SecretStoreReference) bool,
+) {
+	logger := log.FromContext(ctx)
+
+	kept := secretRef.Status.SecretStores[:0:0]
+	for _, store := range secretRef.Status.SecretStores {
+		if keep(store) {
+			kept = append(kept, store)
+			continue
+		}
+		if err := r.deleteExternalSecret(ctx, secretRef, store); err != nil {
+			logger.Error(err, "Failed to delete stale ExternalSecret", "namespace", store.Namespace)
+			kept = append(kept, store)
+		}
+	}
+	if len(kept) == 0 {
+		kept = nil
+	}
+	secretRef.Status.SecretStores = kept
+}
+
+// setSecretStore records store in status.secretStores, replacing the entry of the same
+// plane and namespace.
+func setSecretStore(secretRef *openchoreodevv1alpha1.SecretReference, store openchoreodevv1alpha1.SecretStoreReference) {
+	for i := range secretRef.Status.SecretStores {
+		existing := secretRef.Status.SecretStores[i]
+		if samePlane(existing.Plane, store.Plane) && existing.Namespace == store.Namespace {
+			secretRef.Status.SecretStores[i] = store
+			return
+		}
+	}
+	secretRef.Status.SecretStores = append(secretRef.Status.SecretStores, store)
+}
+
+func samePlane(a, b *openchoreodevv1alpha1.TargetPlaneRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Kind == b.Kind && a.Name == b.Name
+}
@@ -0,0 +1,529 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	k8sMocks "github.com/openchoreo/openchoreo/internal/clients/kubernetes/mocks"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	esv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+)
+
+const testNamespace = "acme"
+
+// runtimeNamespace is the namespace the release binding of the payments project renders
+// its workloads into in the dev environment.
+var runtimeNamespace = dpkubernetes.GenerateK8sNameWithLengthLimit(
+	dpkubernetes.MaxNamespaceNameLength, "dp", testNamespace, "payments", "dev")
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreodevv1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	return s
+}
+
+func newSecretReference(targetPlane *openchoreodevv1alpha1.TargetPlaneRef) *openchoreodevv1alpha1.SecretReference {
+	return &openchoreodevv1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "registry-creds",
+			Namespace:  testNamespace,
+			Generation: 1,
+			Finalizers: []string{SecretReferenceCleanupFinalizer},
+		},
+		Spec: openchoreodevv1alpha1.SecretReferenceSpec{
+			TargetPlane: targetPlane,
+			Template: openchoreodevv1alpha1.SecretTemplate{
+				Type: corev1.SecretTypeDockerConfigJson,
+				Metadata: &openchoreodevv1alpha1.SecretMetadata{
+					Labels: map[string]string{"team": "payments"},
+				},
+			},
+			Data: []openchoreodevv1alpha1.SecretDataSource{{
+				SecretKey: ".dockerconfigjson",
+				RemoteRef: openchoreodevv1alpha1.RemoteReference{Key: "docker/hub", Property: "config"},
+			}},
+			RefreshInterval: &metav1.Duration{Duration: 30 * time.Minute},
+		},
+	}
+}
+
+func newDataPlane(secretStore string) *openchoreodevv1alpha1.DataPlane {
+	dp := &openchoreodevv1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "dp1", Namespace: testNamespace},
+	}
+	if secretStore != "" {
+		dp.Spec.SecretStoreRef = &openchoreodevv1alpha1.SecretStoreRef{Name: secretStore}
+	}
+	return dp
+}
+
+func newEnvironment(name, dataPlane string) *openchoreodevv1alpha1.Environment {
+	return &openchoreodevv1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: openchoreodevv1alpha1.EnvironmentSpec{
+			DataPlaneRef: &openchoreodevv1alpha1.DataPlaneRef{Kind: openchoreodevv1alpha1.DataPlaneRefKindDataPlane, Name: dataPlane},
+		},
+	}
+}
+
+// newConsumingWorkload returns a Workload of the payments project that reads an env var
+// from the registry-creds SecretReference.
+func newConsumingWorkload() *openchoreodevv1alpha1.Workload {
+	return &openchoreodevv1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: testNamespace},
+		Spec: openchoreodevv1alpha1.WorkloadSpec{
+			Owner: openchoreodevv1alpha1.WorkloadOwner{ProjectName: "payments", ComponentName: "checkout"},
+			WorkloadTemplateSpec: openchoreodevv1alpha1.WorkloadTemplateSpec{
+				Container: openchoreodevv1alpha1.Container{
+					Image: "checkout:v1",
+					Env: []openchoreodevv1alpha1.EnvVar{{
+						Key: "REGISTRY_AUTH",
+						ValueFrom: &openchoreodevv1alpha1.EnvVarValueFrom{
+							SecretKeyRef: &openchoreodevv1alpha1.SecretKeyRef{Name: "registry-creds", Key: ".dockerconfigjson"},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// fakePlane records the ExternalSecrets applied to and deleted from a target plane and
+// answers applies with the ESO Ready condition in readyCondition, like the live object would.
+type fakePlane struct {
+	client.Client
+	applied        []*unstructured.Unstructured
+	deleted        []types.NamespacedName
+	readyCondition *esv1.ExternalSecretStatusCondition
+	deleteErr      error
+}
+
+func newFakePlane(t *testing.T) *fakePlane {
+	t.Helper()
+	p := &fakePlane{}
+	p.Client = fake.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+				u := obj.(*unstructured.Unstructured)
+				p.applied = append(p.applied, u.DeepCopy())
+				if p.readyCondition != nil {
+					cond, err := runtime.DefaultUnstructuredConverter.ToUnstructured(p.readyCondition)
+					if err != nil {
+						return err
+					}
+					u.Object["status"] = map[string]any{
+						"refreshTime": "2026-01-01T00:00:00Z",
+						"conditions":  []any{cond},
+					}
+				}
+				return nil
+			},
+			Delete: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.DeleteOption) error {
+				if p.deleteErr != nil {
+					return p.deleteErr
+				}
+				p.deleted = append(p.deleted, client.ObjectKeyFromObject(obj))
+				return nil
+			},
+		}).Build()
+	return p
+}
+
+func newReconciler(t *testing.T, plane client.Client, objs ...client.Object) *Reconciler {
+	t.Helper()
+	s := newTestScheme(t)
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).
+		WithStatusSubresource(&openchoreodevv1alpha1.SecretReference{}).Build()
+	provider := k8sMocks.NewMockPlaneClientProvider(t)
+	if plane != nil {
+		provider.EXPECT().DataPlaneClient(mock.Anything).Return(plane, nil).Maybe()
+	}
+	return &Reconciler{Client: c, PlaneClientProvider: provider, Scheme: s}
+}
+
+func reconcileSecretReference(t *testing.T, r *Reconciler) (ctrl.Result, *openchoreodevv1alpha1.SecretReference, error) {
+	t.Helper()
+	key := types.NamespacedName{Name: "registry-creds", Namespace: testNamespace}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	got := &openchoreodevv1alpha1.SecretReference{}
+	if getErr := r.Get(context.Background(), key, got); getErr != nil && !apierrors.IsNotFound(getErr) {
+		t.Fatalf("get SecretReference: %v", getErr)
+	}
+	return result, got, err
+}
+
+func readyCondition(sr *openchoreodevv1alpha1.SecretReference) *metav1.Condition {
+	return meta.FindStatusCondition(sr.Status.Conditions, string(ConditionReady))
+}
+
+func TestReconcile_AddsFinalizer(t *testing.T) {
+	sr := newSecretReference(nil)
+	sr.Finalizers = nil
+	r := newReconciler(t, nil, sr)
+
+	_, got, err := reconcileSecretReference(t, r)
+	require.NoError(t, err)
+	assert.True(t, controllerutil.ContainsFinalizer(got, SecretReferenceCleanupFinalizer))
+}
+
+func TestReconcile_NoTargetPlane(t *testing.T) {
+	r := newReconciler(t, nil, newSecretReference(nil))
+
+	result, got, err := reconcileSecretReference(t, r)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	cond := readyCondition(got)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonNoTargetPlane), cond.Reason)
+	assert.Empty(t, got.Status.SecretStores)
+}
+
+func TestReconcile_TargetPlaneProblems(t *testing.T) {
+	target := &openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"}
+
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		wantReason controller.ConditionReason
+	}{
+		{
+			name:       "plane not found",
+			objs:       []client.Object{newSecretReference(target)},
+			wantReason: ReasonTargetPlaneNotFound,
+		},
+		{
+			name:       "secret store not configured",
+			objs:       []client.Object{newSecretReference(target), newDataPlane("")},
+			wantReason: ReasonSecretStoreNotConfigured,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReconciler(t, nil, tt.objs...)
+
+			result, got, err := reconcileSecretReference(t, r)
+			require.NoError(t, err)
+			assert.Equal(t, planeRetryInterval, result.RequeueAfter)
+
+			cond := readyCondition(got)
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionFalse, cond.Status)
+			assert.Equal(t, string(tt.wantReason), cond.Reason)
+		})
+	}
+}
+
+func TestReconcile_AppliesExternalSecret(t *testing.T) {
+	target := &openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"}
+	plane := newFakePlane(t)
+	r := newReconciler(t, plane, newSecretReference(target), newDataPlane("vault"),
+		newEnvironment("dev", "dp1"), newConsumingWorkload())
+
+	result, got, err := reconcileSecretReference(t, r)
+	require.NoError(t, err)
+	assert.Equal(t, syncPollInterval, result.RequeueAfter)
+
+	// The namespace on the target plane is created before the ExternalSecret is applied.
+	ns := &corev1.Namespace{}
+	require.NoError(t, plane.Get(context.Background(), client.ObjectKey{Name: runtimeNamespace}, ns))
+
+	require.Len(t, plane.applied, 1)
+	es := plane.applied[0]
+	assert.Equal(t, "external-secrets.io/v1", es.GetAPIVersion())
+	assert.Equal(t, "ExternalSecret", es.GetKind())
+	assert.Equal(t, runtimeNamespace, es.GetNamespace())
+	assert.Equal(t, "registry-creds", es.GetName())
+	assert.NotContains(t, es.Object, "status")
+
+	typed := &esv1.ExternalSecret{}
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(es.Object, typed))
+	assert.Equal(t, esv1.SecretStoreRef{Name: "vault", Kind: "ClusterSecretStore"}, typed.Spec.SecretStoreRef)
+	assert.Equal(t, 30*time.Minute, typed.Spec.RefreshInterval.Duration)
+	assert.Equal(t, "registry-creds", typed.Spec.Target.Name)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, typed.Spec.Target.Template.Type)
+	assert.Equal(t, map[string]string{"team": "payments"}, typed.Spec.Target.Template.Metadata.Labels)
+	assert.Equal(t, []esv1.ExternalSecretData{{
+		SecretKey: ".dockerconfigjson",
+		RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: "docker/hub", Property: "config"},
+	}}, typed.Spec.Data)
+
+	require.Len(t, got.Status.SecretStores, 1)
+	store := got.Status.SecretStores[0]
+	assert.Equal(t, "vault", store.Name)
+	assert.Equal(t, runtimeNamespace, store.Namespace)
+	assert.Equal(t, "ExternalSecret", store.Kind)
+	assert.Equal(t, target, store.Plane)
+	assert.Equal(t, openchoreodevv1alpha1.SecretSyncStatusPending, store.SyncStatus)
+	assert.NotNil(t, got.Status.LastRefreshTime)
+
+	cond := readyCondition(got)
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonSyncPending), cond.Reason)
+}
+
+func TestReconcile_MaterializesSecretWhereConsumingWorkloadRuns(t *testing.T) {
+	target := &openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"}
+
+	// Reads the secret, but from an environment on another data plane.
+	prod := newEnvironment("prod", "dp2")
+	// Runs in dev, but does not reference the secret.
+	other := newConsumingWorkload()
+	other.Name = "ledger"
+	other.Spec.Owner = openchoreodevv1alpha1.WorkloadOwner{ProjectName: "finance", ComponentName: "ledger"}
+	other.Spec.Container.Env = nil
+
+	plane := newFakePlane(t)
+	r := newReconciler(t, plane, newSecretReference(target), newDataPlane("vault"),
+		newEnvironment("dev", "dp1"), prod, newConsumingWorkload(), other)
+
+	_, got, err := reconcileSecretReference(t, r)
+	require.NoError(t, err)
+
+	// The workload references the secret by the SecretReference name; the Secret ESO
+	// creates carries that name in the namespace the workload is deployed to.
+	workload := newConsumingWorkload()
+	require.Len(t, plane.applied, 1)
+	typed := &esv1.ExternalSecret{}
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(plane.applied[0].Object, typed))
+	assert.Equal(t, runtimeNamespace, typed.Namespace)
+	assert.Equal(t, workload.Spec.Container.Env[0].ValueFrom.SecretKeyRef.Name, typed.Spec.Target.Name)
+
+	require.Len(t, got.Status.SecretStores, 1)
+	assert.Equal(t, runtimeNamespace, got.Status.SecretStores[0].Namespace)
+}
+
+func TestReconcile_NoConsumers(t *testing.T) {
+	target := &openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"}
+	sr := newSecretReference(target)
+	// Left from a workload that no longer references the secret.
+	sr.Status.SecretStores = []openchoreodevv1alpha1.SecretStoreReference{{
+		Name: "vault", Namespace: runtimeNamespace, Kind: "ExternalSecret", Plane: target.DeepCopy(),
+	}}
+	plane := newFakePlane(t)
+	r := newReconciler(t, plane, sr, newDataPlane("vault"), newEnvironment("dev", "dp1"))
+
+	result, got, err := reconcileSecretReference(t, r)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	assert.Empty(t, plane.applied)
+	assert.Equal(t, []types.NamespacedName{{Namespace: runtimeNamespace, Name: "registry-creds"}}, plane.deleted)
+	assert.Empty(t, got.Status.SecretStores)
+
+	cond := readyCondition(got)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonNoConsumers), cond.Reason)
+}
+
+func TestTargetNamespaces_WorkflowPlane(t *testing.T) {
+	r := newReconciler(t, nil)
+	plane := &targetPlane{workflowPlane: &controller.WorkflowPlaneResult{}}
+
+	got, err := r.targetNamespaces(context.Background(), newSecretReference(nil), plane)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workflows-acme"}, got)
+}
+
+func TestFindSecretReferencesForWorkload(t *testing.T) {
+	workload := newConsumingWorkload()
+	workload.Spec.Container.Files = []openchoreodevv1alpha1.FileVar{
+		{
+			Key: "config.json", MountPath: "/etc/docker",
+			ValueFrom: &openchoreodevv1alpha1.EnvVarValueFrom{
+				SecretKeyRef: &openchoreodevv1alpha1.SecretKeyRef{Name: "registry-creds", Key: ".dockerconfigjson"},
+			},
+		},
+		{
+			Key: "tls.crt", MountPath: "/etc/tls",
+			ValueFrom: &openchoreodevv1alpha1.EnvVarValueFrom{
+				SecretKeyRef: &openchoreodevv1alpha1.SecretKeyRef{Name: "tls", Key: "tls.crt"},
+			},
+		},
+		{Key: "app.yaml", MountPath: "/etc/app", Value: "debug: false"},
+	}
+	r := newReconciler(t, nil)
+
+	got := r.findSecretReferencesForWorkload(context.Background(), workload)
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "registry-creds"}},
+		{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "tls"}},
+	}, got)
+}
+
+func TestReconcile_ReportsSyncStatus(t *testing.T) {
+	target := &openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"}
+
+	tests := []struct {
+		name        string
+		condition   esv1.ExternalSecretStatusCondition
+		wantStatus  openchoreodevv1alpha1.SecretSyncStatus
+		wantReady   metav1.ConditionStatus
+		wantReason  controller.ConditionReason
+		wantRequeue time.Duration
+	}{
+		{
+			name:        "synced",
+			condition:   esv1.ExternalSecretStatusCondition{Type: esv1.ExternalSecretReady, Status: corev1.ConditionTrue, Reason: esv1.ConditionReasonSecretSynced},
+			wantStatus:  openchoreodevv1alpha1.SecretSyncStatusSynced,
+			wantReady:   metav1.ConditionTrue,
+			wantReason:  ReasonSecretSynced,
+			wantRequeue: 30 * time.Minute,
+		},
+		{
+			name: "failed",
+			condition: esv1.ExternalSecretStatusCondition{
+				Type: esv1.ExternalSecretReady, Status: corev1.ConditionFalse,
+				Reason: esv1.ConditionReasonSecretSyncedError, Message: "could not get secret data from provider",
+			},
+			wantStatus:  openchoreodevv1alpha1.SecretSyncStatusFailed,
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  ReasonSyncFailed,
+			wantRequeue: syncPollInterval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plane := newFakePlane(t)
+			plane.readyCondition = &tt.condition
+			r := newReconciler(t, plane, newSecretReference(target), newDataPlane("vault"),
+				newEnvironment("dev", "dp1"), newConsumingWorkload())
+
+			result, got, err := reconcileSecretReference(t, r)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantRequeue.Seconds(), result.RequeueAfter.Seconds(), 5)
+
+			require.Len(t, got.Status.SecretStores, 1)
+			store := got.Status.SecretStores[0]
+			assert.Equal(t, tt.wantStatus, store.SyncStatus)
+			assert.Equal(t, tt.condition.Message, store.Message)
+			require.NotNil(t, store.LastSyncTime)
+
+			cond := readyCondition(got)
+			require.NotNil(t, cond)
+			assert.Equal(t, tt.wantReady, cond.Status)
+			assert.Equal(t, string(tt.wantReason), cond.Reason)
+		})
+	}
+}
+
+func TestReconcile_DeletesExternalSecretFromPreviousPlane(t *testing.T) {
+	sr := newSecretReference(nil)
+	sr.Status.SecretStores = []openchoreodevv1alpha1.SecretStoreReference{{
+		Name: "vault", Namespace: runtimeNamespace, Kind: "ExternalSecret",
+		Plane: &openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"},
+	}}
+	plane := newFakePlane(t)
+	r := newReconciler(t, plane, sr, newDataPlane("vault"))
+
+	_, got, err := reconcileSecretReference(t, r)
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.NamespacedName{{Namespace: runtimeNamespace, Name: "registry-creds"}}, plane.deleted)
+	assert.Empty(t, got.Status.SecretStores)
+}
+
+func TestFinalize(t *testing.T) {
+	newDeleting := func() *openchoreodevv1alpha1.SecretReference {
+		sr := newSecretReference(&openchoreodevv1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp1"})
+		now := metav1.Now()
+		sr.DeletionTimestamp = &now
+		sr.Status.SecretStores = []openchoreodevv1alpha1.SecretStoreReference{{
+			Name: "vault", Namespace: runtimeNamespace, Kind: "ExternalSecret",
+			Plane: sr.Spec.TargetPlane.DeepCopy(),
+		}}
+		sr.Status.Conditions = []metav1.Condition{NewFinalizingCondition(1)}
+		return sr
+	}
+
+	t.Run("deletes the ExternalSecret and removes the finalizer", func(t *testing.T) {
+		plane := newFakePlane(t)
+		r := newReconciler(t, plane, newDeleting(), newDataPlane("vault"))
+
+		_, got, err := reconcileSecretReference(t, r)
+		require.NoError(t, err)
+		assert.Equal(t, []types.NamespacedName{{Namespace: runtimeNamespace, Name: "registry-creds"}}, plane.deleted)
+		// The fake client removes the object once its last finalizer is gone.
+		assert.Empty(t, got.Name)
+	})
+
+	t.Run("keeps the finalizer when the plane cannot be reached", func(t *testing.T) {
+		plane := newFakePlane(t)
+		plane.deleteErr = errors.New("connection refused")
+		r := newReconciler(t, plane, newDeleting(), newDataPlane("vault"))
+
+		_, got, err := reconcileSecretReference(t, r)
+		require.Error(t, err)
+		assert.True(t, controllerutil.ContainsFinalizer(got, SecretReferenceCleanupFinalizer))
+	})
+
+	t.Run("skips planes that no longer exist", func(t *testing.T) {
+		r := newReconciler(t, nil, newDeleting())
+
+		_, got, err := reconcileSecretReference(t, r)
+		require.NoError(t, err)
+		assert.Empty(t, got.Name)
+	})
+}
+
+func TestExternalSecretSyncStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []esv1.ExternalSecretStatusCondition
+		want       openchoreodevv1alpha1.SecretSyncStatus
+	}{
+		{name: "no conditions", want: openchoreodevv1alpha1.SecretSyncStatusPending},
+		{
+			name:       "ready",
+			conditions: []esv1.ExternalSecretStatusCondition{{Type: esv1.ExternalSecretReady, Status: corev1.ConditionTrue}},
+			want:       openchoreodevv1alpha1.SecretSyncStatusSynced,
+		},
+		{
+			name: "sync error",
+			conditions: []esv1.ExternalSecretStatusCondition{{
+				Type: esv1.ExternalSecretReady, Status: corev1.ConditionFalse, Reason: esv1.ConditionReasonSecretSyncedError,
+			}},
+			want: openchoreodevv1alpha1.SecretSyncStatusFailed,
+		},
+		{
+			name:       "not ready for another reason",
+			conditions: []esv1.ExternalSecretStatusCondition{{Type: esv1.ExternalSecretReady, Status: corev1.ConditionFalse, Reason: "Pending"}},
+			want:       openchoreodevv1alpha1.SecretSyncStatusPending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &esv1.ExternalSecret{Status: esv1.ExternalSecretStatus{Conditions: tt.conditions}}
+			got, _ := externalSecretSyncStatus(es)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	buildprovenance "github.com/openchoreo/openchoreo/internal/provenance"
+)
+
+// recordArtifacts collects the artifacts declared by the workflow from the outputs of a succeeded
+// run resource and, for component workflow runs, links the build provenance to the component's
+// Workload so that ComponentReleases created from it carry the provenance.
+func (r *Reconciler) recordArtifacts(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	declared []openchoreodevv1alpha1.WorkflowArtifact,
+	runResource *argoproj.Workflow,
+) error {
+	if len(declared) == 0 {
+		return nil
+	}
+
+	artifacts := collectArgoArtifacts(declared, runResource.Status.Outputs)
+	if len(artifacts) < len(declared) {
+		log.FromContext(ctx).Info("Some declared artifacts were not produced by the run",
+			"declared", len(declared), "produced", len(artifacts))
+	}
+	workflowRun.Status.Artifacts = artifacts
+
+	return r.linkWorkloadProvenance(ctx, workflowRun)
+}
+
+// collectArgoArtifacts resolves the declared artifacts against the global output parameters of an
+// Argo Workflow. Artifacts whose output parameter is missing or empty are skipped.
+func collectArgoArtifacts(
+	declared []openchoreodevv1alpha1.WorkflowArtifact,
+	outputs *argoproj.Outputs,
+) []openchoreodevv1alpha1.WorkflowRunArtifact {
+	if outputs == nil {
+		return nil
+	}
+
+	values := make(map[string]string, len(outputs.Parameters))
+	for _, param := range outputs.Parameters {
+		if param.Value != nil {
+			values[param.Name] = strings.TrimSpace(string(*param.Value))
+		}
+	}
+
+	var artifacts []openchoreodevv1alpha1.WorkflowRunArtifact
+	for _, artifact := range declared {
+		ref := values[artifact.OutputParameter]
+		if ref == "" {
+			continue
+		}
+		artifacts = append(artifacts, openchoreodevv1alpha1.WorkflowRunArtifact{
+			Name:      artifact.Name,
+			Type:      artifact.Type,
+			Reference: ref,
+			Format:    artifact.Format,
+		})
+	}
+	return artifacts
+}
+
+// linkWorkloadProvenance records the build provenance on the Workload of the component the
+// workflow run belongs to. The Workload is only updated when its container image is the image
+// produced by the run, so a Workload that has since moved on to another image is left untouched.
+func (r *Reconciler) linkWorkloadProvenance(ctx context.Context, workflowRun *openchoreodevv1alpha1.WorkflowRun) error {
+	projectName := workflowRun.Labels[labels.LabelKeyProjectName]
+	componentName := workflowRun.Labels[labels.LabelKeyComponentName]
+	if projectName == "" || componentName == "" {
+		return nil
+	}
+
+	provenance := buildprovenance.FromWorkflowRun(workflowRun)
+	if provenance == nil {
+		return nil
+	}
+	image := buildprovenance.ImageArtifactReference(workflowRun.Status.Artifacts)
+
+	var workloads openchoreodevv1alpha1.WorkloadList
+	if err := r.List(ctx, &workloads, client.InNamespace(workflowRun.Namespace)); err != nil {
+		return fmt.Errorf("failed to list workloads: %w", err)
+	}
+	for i := range workloads.Items {
+		workload := &workloads.Items[i]
+		if workload.Spec.Owner.ProjectName != projectName || workload.Spec.Owner.ComponentName != componentName {
+			continue
+		}
+		if !buildprovenance.SameImage(workload.Spec.Container.Image, image) {
+			continue
+		}
+		if equality.Semantic.DeepEqual(workload.Spec.Provenance, provenance) {
+			return nil
+		}
+		workload.Spec.Provenance = provenance
+		if err := r.Update(ctx, workload); err != nil {
+			return fmt.Errorf("failed to record provenance on workload %q: %w", workload.Name, err)
+		}
+		log.FromContext(ctx).Info("Recorded build provenance on workload", "workload", workload.Name)
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const testBuiltImage = "registry.example.com/acme/app@sha256:0123abcd"
+
+func newDeclaredArtifacts() []openchoreodevv1alpha1.WorkflowArtifact {
+	return []openchoreodevv1alpha1.WorkflowArtifact{
+		{Name: "image", Type: openchoreodevv1alpha1.WorkflowArtifactTypeImage, OutputParameter: "image-ref"},
+		{Name: "sbom", Type: openchoreodevv1alpha1.WorkflowArtifactTypeSBOM, OutputParameter: "sbom-ref", Format: "spdx-json"},
+		{Name: "provenance", Type: openchoreodevv1alpha1.WorkflowArtifactTypeProvenance, OutputParameter: "provenance-ref",
+			Format: "https://slsa.dev/provenance/v1"},
+		{Name: "signature", Type: openchoreodevv1alpha1.WorkflowArtifactTypeSignature, OutputParameter: "signature-ref"},
+	}
+}
+
+func argoOutputs(params map[string]string) *argoproj.Outputs {
+	outputs := &argoproj.Outputs{}
+	for name, value := range params {
+		v := argoproj.AnyString(value)
+		outputs.Parameters = append(outputs.Parameters, argoproj.Parameter{Name: name, Value: &v})
+	}
+	return outputs
+}
+
+func TestCollectArgoArtifacts(t *testing.T) {
+	outputs := argoOutputs(map[string]string{
+		"image-ref":  testBuiltImage + "\n",
+		"sbom-ref":   "registry.example.com/acme/app:sha256-0123abcd.sbom",
+		"unrelated":  "value",
+		"signature-": "",
+	})
+
+	artifacts := collectArgoArtifacts(newDeclaredArtifacts(), outputs)
+	if len(artifacts) != 2 {
+		t.Fatalf("expected 2 artifacts, got %d: %+v", len(artifacts), artifacts)
+	}
+	if artifacts[0].Name != "image" || artifacts[0].Reference != testBuiltImage {
+		t.Errorf("unexpected image artifact: %+v", artifacts[0])
+	}
+	if artifacts[1].Type != openchoreodevv1alpha1.WorkflowArtifactTypeSBOM || artifacts[1].Format != "spdx-json" {
+		t.Errorf("unexpected SBOM artifact: %+v", artifacts[1])
+	}
+
+	if got := collectArgoArtifacts(newDeclaredArtifacts(), nil); got != nil {
+		t.Errorf("expected no artifacts without outputs, got %+v", got)
+	}
+}
+
+func TestRecordArtifactsLinksWorkloadProvenance(t *testing.T) {
+	ctx := context.Background()
+
+	workload := &openchoreodevv1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-workload", Namespace: "default"},
+		Spec: openchoreodevv1alpha1.WorkloadSpec{
+			Owner: openchoreodevv1alpha1.WorkloadOwner{ProjectName: "acme", ComponentName: "app"},
+			WorkloadTemplateSpec: openchoreodevv1alpha1.WorkloadTemplateSpec{
+				Container: openchoreodevv1alpha1.Container{Image: "registry.example.com/acme/app:app-run-0a1b2c3d"},
+			},
+		},
+	}
+	otherWorkload := workload.DeepCopy()
+	otherWorkload.Name = "other-workload"
+	otherWorkload.Spec.Owner.ComponentName = "other"
+
+	r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(workload, otherWorkload).Build()}
+
+	run := newActionsTestRun()
+	run.Labels = map[string]string{labels.LabelKeyProjectName: "acme", labels.LabelKeyComponentName: "app"}
+	runResource := &argoproj.Workflow{}
+	runResource.Status.Phase = argoproj.WorkflowSucceeded
+	runResource.Status.Outputs = argoOutputs(map[string]string{
+		"image-ref":      testBuiltImage,
+		"sbom-ref":       "registry.example.com/acme/app:sha256-0123abcd.sbom",
+		"provenance-ref": "registry.example.com/acme/app:sha256-0123abcd.att",
+		"signature-ref":  "registry.example.com/acme/app:sha256-0123abcd.sig",
+	})
+
+	if err := r.recordArtifacts(ctx, run, newDeclaredArtifacts(), runResource); err != nil {
+		t.Fatalf("recordArtifacts() error = %v", err)
+	}
+	if len(run.Status.Artifacts) != 4 {
+		t.Fatalf("expected 4 recorded artifacts, got %d", len(run.Status.Artifacts))
+	}
+
+	updated := &openchoreodevv1alpha1.Workload{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "app-workload", Namespace: "default"}, updated); err != nil {
+		t.Fatalf("failed to get workload: %v", err)
+	}
+	provenance := updated.Spec.Provenance
+	if provenance == nil {
+		t.Fatal("expected provenance to be recorded on the workload")
+	}
+	if provenance.WorkflowRun != run.Name || provenance.ImageDigest != "sha256:0123abcd" {
+		t.Errorf("unexpected provenance: %+v", provenance)
+	}
+	if provenance.SBOM == nil || provenance.SBOM.Format != "spdx-json" {
+		t.Errorf("expected SBOM reference with format, got %+v", provenance.SBOM)
+	}
+	if provenance.Attestation == nil || provenance.Signature == nil {
+		t.Errorf("expected attestation and signature references, got %+v", provenance)
+	}
+
+	other := &openchoreodevv1alpha1.Workload{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "other-workload", Namespace: "default"}, other); err != nil {
+		t.Fatalf("failed to get workload: %v", err)
+	}
+	if other.Spec.Provenance != nil {
+		t.Errorf("expected workload of another component to be untouched, got %+v", other.Spec.Provenance)
+	}
+}
+
+func TestRecordArtifactsSkipsWorkloadWithDifferentImage(t *testing.T) {
+	ctx := context.Background()
+
+	workload := &openchoreodevv1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-workload", Namespace: "default"},
+		Spec: openchoreodevv1alpha1.WorkloadSpec{
+			Owner: openchoreodevv1alpha1.WorkloadOwner{ProjectName: "acme", ComponentName: "app"},
+			WorkloadTemplateSpec: openchoreodevv1alpha1.WorkloadTemplateSpec{
+				Container: openchoreodevv1alpha1.Container{Image: "registry.example.com/acme/app@sha256:ffff"},
+			},
+		},
+	}
+	r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(workload).Build()}
+
+	run := newActionsTestRun()
+	run.Labels = map[string]string{labels.LabelKeyProjectName: "acme", labels.LabelKeyComponentName: "app"}
+	runResource := &argoproj.Workflow{}
+	runResource.Status.Outputs = argoOutputs(map[string]string{"image-ref": testBuiltImage})
+
+	if err := r.recordArtifacts(ctx, run, newDeclaredArtifacts(), runResource); err != nil {
+		t.Fatalf("recordArtifacts() error = %v", err)
+	}
+
+	updated := &openchoreodevv1alpha1.Workload{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "app-workload", Namespace: "default"}, updated); err != nil {
+		t.Fatalf("failed to get workload: %v", err)
+	}
+	if updated.Spec.Provenance != nil {
+		t.Errorf("expected workload with a different image to be untouched, got %+v", updated.Spec.Provenance)
+	}
+}
@@ -52,6 +52,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
 // +kubebuilder:rbac:groups=argoproj.io,resources=workflows,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -81,9 +82,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
-	// Check if TTL has expired
-	if shouldReturn, result, err := r.checkTTLExpiration(ctx, workflowRun); shouldReturn {
-		return result, err
+	// Check if TTL has expired. A pending resume request reopens the run, so it skips the check.
+	if !workflowRun.Spec.Resume {
+		if shouldReturn, result, err := r.checkTTLExpiration(ctx, workflowRun); shouldReturn {
+			return result, err
+		}
 	}
 
 	// Deferred status update
@@ -100,6 +103,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}
 	}()
 
+	// Resume a failed workflow run from its failed tasks.
+	if workflowRun.Spec.Resume {
+		return r.resumeWorkflowRun(ctx, workflowRun)
+	}
+
 	// Set CompletedAt timestamp immediately upon workflow completion.
 	// The deferred status update in Reconcile will persist this change.
 	if isWorkflowCompleted(workflowRun) {
@@ -107,6 +115,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// A cancelled run that has not been submitted to the workflow plane completes right away.
+	if workflowRun.Spec.Cancel && workflowRun.Status.RunReference == nil {
+		setStartedAtIfNeeded(workflowRun)
+		setWorkflowCancelledCondition(workflowRun)
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	if !isWorkflowInitiated(workflowRun) {
 		setStartedAtIfNeeded(workflowRun)
 		setWorkflowPendingCondition(workflowRun)
@@ -181,6 +196,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}, runResource)
 
 		if err == nil {
+			if workflowRun.Spec.Cancel {
+				if err := r.terminateRunResource(ctx, wpClient, runResource); err != nil {
+					logger.Error(err, "failed to cancel run resource",
+						"runName", workflowRun.Status.RunReference.Name,
+						"runNamespace", workflowRun.Status.RunReference.Namespace)
+					return ctrl.Result{Requeue: true}, nil
+				}
+			}
+			if runResource.Status.Phase == argoproj.WorkflowSucceeded {
+				if err := r.recordArtifacts(ctx, workflowRun, workflow.Spec.Artifacts, runResource); err != nil {
+					logger.Error(err, "failed to record workflow run artifacts")
+					return ctrl.Result{Requeue: true}, nil
+				}
+			}
 			return r.syncWorkflowRunStatus(workflowRun, runResource), nil
 		} else if !errors.IsNotFound(err) {
 			logger.Error(err, "failed to get run resource",
@@ -188,6 +217,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 				"runNamespace", workflowRun.Status.RunReference.Namespace)
 			return ctrl.Result{Requeue: true}, nil
 		}
+		if workflowRun.Spec.Cancel {
+			setWorkflowCancelledCondition(workflowRun)
+			return ctrl.Result{}, nil
+		}
 		setWorkflowNotFoundCondition(workflowRun)
 		return ctrl.Result{}, nil
 	}
@@ -310,6 +343,10 @@ func (r *Reconciler) syncWorkflowRunStatus(
 		setWorkflowSucceededCondition(workflowRun)
 		return ctrl.Result{Requeue: true}
 	case argoproj.WorkflowFailed, argoproj.WorkflowError:
+		if workflowRun.Spec.Cancel {
+			setWorkflowCancelledCondition(workflowRun)
+			return ctrl.Result{}
+		}
 		setWorkflowFailedCondition(workflowRun)
 		return ctrl.Result{}
 	default:
@@ -0,0 +1,220 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const (
+	// argoWorkflowLabel is set by Argo on every pod of a workflow.
+	argoWorkflowLabel = "workflows.argoproj.io/workflow"
+	// argoCompletedLabel is set by Argo on completed workflows; the Argo controller ignores
+	// workflows carrying it with value "true".
+	argoCompletedLabel = "workflows.argoproj.io/completed"
+	// argoNodeIDAnnotation records the workflow node a pod was created for.
+	argoNodeIDAnnotation = "workflows.argoproj.io/node-id"
+)
+
+// terminateRunResource requests the termination of a running Argo Workflow. Argo stops all
+// running steps and moves the workflow to the Failed phase, which the regular status sync
+// then reports as cancelled.
+func (r *Reconciler) terminateRunResource(ctx context.Context, wpClient client.Client, runResource *argoproj.Workflow) error {
+	switch runResource.Status.Phase {
+	case argoproj.WorkflowSucceeded, argoproj.WorkflowFailed, argoproj.WorkflowError:
+		return nil
+	}
+	if runResource.Spec.Shutdown == argoproj.ShutdownStrategyTerminate {
+		return nil
+	}
+
+	patch := client.MergeFrom(runResource.DeepCopy())
+	runResource.Spec.Shutdown = argoproj.ShutdownStrategyTerminate
+	if err := wpClient.Patch(ctx, runResource, patch); err != nil {
+		return fmt.Errorf("failed to terminate workflow %q in namespace %q: %w", runResource.Name, runResource.Namespace, err)
+	}
+	log.FromContext(ctx).Info("terminated run resource", "name", runResource.Name, "namespace", runResource.Namespace)
+	return nil
+}
+
+// resumeWorkflowRun resumes a failed workflow run from its failed tasks by resetting the
+// failed nodes of the Argo Workflow in place, the same way `argo retry` does. Succeeded tasks
+// are kept and Argo only re-executes the reset nodes. The resume request is cleared whether
+// or not the run could be resumed.
+func (r *Reconciler) resumeWorkflowRun(ctx context.Context, workflowRun *openchoreodevv1alpha1.WorkflowRun) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if reason := resumeBlockedReason(workflowRun); reason != "" {
+		logger.Info("Ignoring resume request", "reason", reason)
+		return ctrl.Result{}, r.clearResumeRequest(ctx, workflowRun)
+	}
+
+	wpClient, err := r.resolveWorkflowPlaneClient(ctx, workflowRun)
+	if err != nil {
+		logger.Error(err, "failed to resolve workflow plane client for resume")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	runResource := &argoproj.Workflow{}
+	if err := wpClient.Get(ctx, types.NamespacedName{
+		Name:      workflowRun.Status.RunReference.Name,
+		Namespace: workflowRun.Status.RunReference.Namespace,
+	}, runResource); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Ignoring resume request", "reason", "run resource no longer exists")
+			return ctrl.Result{}, r.clearResumeRequest(ctx, workflowRun)
+		}
+		logger.Error(err, "failed to get run resource for resume")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	resetPods := resetFailedArgoNodes(runResource)
+	if len(resetPods) == 0 {
+		logger.Info("Ignoring resume request", "reason", "run resource has no failed tasks")
+		return ctrl.Result{}, r.clearResumeRequest(ctx, workflowRun)
+	}
+
+	// Failed pods must be removed before Argo re-creates them under the same names.
+	if err := deleteArgoNodePods(ctx, wpClient, runResource, resetPods); err != nil {
+		logger.Error(err, "failed to delete pods of failed tasks")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if err := wpClient.Update(ctx, runResource); err != nil {
+		logger.Error(err, "failed to reset run resource for resume")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	logger.Info("resumed run resource from failed tasks", "name", runResource.Name, "resetTasks", len(resetPods))
+
+	if err := r.clearResumeRequest(ctx, workflowRun); err != nil {
+		return ctrl.Result{}, err
+	}
+	workflowRun.Status.CompletedAt = nil
+	workflowRun.Status.Tasks = extractArgoTasksFromWorkflowNodes(runResource.Status.Nodes)
+	setWorkflowResumedCondition(workflowRun)
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// resumeBlockedReason returns why the workflow run cannot be resumed, or an empty string if it can.
+func resumeBlockedReason(workflowRun *openchoreodevv1alpha1.WorkflowRun) string {
+	switch {
+	case !isWorkflowCompleted(workflowRun) || isWorkflowSucceeded(workflowRun):
+		return "workflow run has not failed"
+	case isWorkflowCancelled(workflowRun):
+		return "workflow run was cancelled"
+	case workflowRun.Status.RunReference == nil || workflowRun.Status.RunReference.Name == "":
+		return "workflow run has no run resource"
+	case workflowRun.Status.RunReference.Kind != "Workflow" ||
+		workflowRun.Status.RunReference.APIVersion != argoproj.SchemeGroupVersion.String():
+		return fmt.Sprintf("resume is not supported for %s", workflowRun.Status.RunReference.Kind)
+	default:
+		return ""
+	}
+}
+
+// clearResumeRequest resets spec.resume. The spec update refreshes the in-memory object, so
+// it must happen before any status changes that the deferred status update should persist.
+func (r *Reconciler) clearResumeRequest(ctx context.Context, workflowRun *openchoreodevv1alpha1.WorkflowRun) error {
+	workflowRun.Spec.Resume = false
+	return r.Update(ctx, workflowRun)
+}
+
+// resetFailedArgoNodes prepares a failed Argo Workflow for re-execution. Failed and errored
+// pod nodes are removed so Argo schedules them again, while the non-pod nodes (steps, DAGs and
+// the workflow root) that did not succeed are moved back to Running. Returns the IDs of the
+// removed pod nodes.
+func resetFailedArgoNodes(wf *argoproj.Workflow) []string {
+	if wf.Status.Phase != argoproj.WorkflowFailed && wf.Status.Phase != argoproj.WorkflowError {
+		return nil
+	}
+
+	var removed []string
+	for id, node := range wf.Status.Nodes {
+		if node.Type == argoproj.NodeTypePod && (node.Phase == argoproj.NodeFailed || node.Phase == argoproj.NodeError) {
+			removed = append(removed, id)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	for _, id := range removed {
+		delete(wf.Status.Nodes, id)
+	}
+	for id, node := range wf.Status.Nodes {
+		if node.Type == argoproj.NodeTypePod || node.Phase == argoproj.NodeSucceeded || node.Phase == argoproj.NodeSkipped {
+			continue
+		}
+		node.Phase = argoproj.NodeRunning
+		node.FinishedAt = metav1.Time{}
+		node.Message = ""
+		node.Children = withoutNodes(node.Children, removed)
+		wf.Status.Nodes[id] = node
+	}
+
+	wf.Spec.Shutdown = argoproj.ShutdownStrategyNone
+	wf.Status.Phase = argoproj.WorkflowRunning
+	wf.Status.FinishedAt = metav1.Time{}
+	wf.Status.Message = ""
+	labels := wf.GetLabels()
+	if labels[argoCompletedLabel] != "" {
+		labels[argoCompletedLabel] = "false"
+		wf.SetLabels(labels)
+	}
+	return removed
+}
+
+func withoutNodes(children, removed []string) []string {
+	return slices.DeleteFunc(children, func(child string) bool {
+		return slices.Contains(removed, child)
+	})
+}
+
+// deleteArgoNodePods deletes the pods created for the given Argo Workflow nodes.
+func deleteArgoNodePods(ctx context.Context, wpClient client.Client, wf *argoproj.Workflow, nodeIDs []string) error {
+	var pods corev1.PodList
+	if err := wpClient.List(ctx, &pods, client.InNamespace(wf.Namespace), client.MatchingLabels{argoWorkflowLabel: wf.Name}); err != nil {
+		return fmt.Errorf("failed to list pods of workflow %q: %w", wf.Name, err)
+	}
+
+	reset := make(map[string]struct{}, len(nodeIDs))
+	for _, id := range nodeIDs {
+		reset[id] = struct{}{}
+	}
+	for i := range pods.Items {
+		if _, ok := reset[pods.Items[i].Annotations[argoNodeIDAnnotation]]; !ok {
+			continue
+		}
+		if err := wpClient.Delete(ctx, &pods.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete pod %q: %w", pods.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// resolveWorkflowPlaneClient returns the client of the workflow plane the workflow run executes in.
+func (r *Reconciler) resolveWorkflowPlaneClient(ctx context.Context, workflowRun *openchoreodevv1alpha1.WorkflowRun) (client.Client, error) {
+	workflowResult, err := controller.ResolveWorkflow(ctx, r.Client, workflowRun.Namespace, workflowRun.Spec.Workflow.Kind, workflowRun.Spec.Workflow.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve workflow %q: %w", workflowRun.Spec.Workflow.Name, err)
+	}
+	workflowPlaneResult, err := controller.GetWorkflowPlaneFromRef(ctx, r.Client, workflowRun.Namespace, workflowResult.GetWorkflowSpec().WorkflowPlaneRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow plane: %w", err)
+	}
+	return r.getWorkflowPlaneClient(workflowPlaneResult)
+}
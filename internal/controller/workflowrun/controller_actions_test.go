@@ -0,0 +1,386 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	k8sMocks "github.com/openchoreo/openchoreo/internal/clients/kubernetes/mocks"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
+)
+
+const (
+	testActionsRunName = "actions-wfr"
+	testActionsRunNS   = "workflows-default"
+)
+
+// newActionsTestRun returns a submitted workflow run referencing the Argo Workflow
+// testActionsRunName in the workflow plane.
+func newActionsTestRun() *openchoreodevv1alpha1.WorkflowRun {
+	return &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testActionsRunName,
+			Namespace:  "default",
+			Finalizers: []string{WorkflowRunCleanupFinalizer},
+			Generation: 1,
+		},
+		Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+			Workflow: openchoreodevv1alpha1.WorkflowRunConfig{Name: "build-wf"},
+		},
+		Status: openchoreodevv1alpha1.WorkflowRunStatus{
+			RunReference: &openchoreodevv1alpha1.ResourceReference{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "Workflow",
+				Name:       testActionsRunName,
+				Namespace:  testActionsRunNS,
+			},
+		},
+	}
+}
+
+// newFailedArgoWorkflow returns an Argo Workflow whose first step succeeded and whose second
+// step failed.
+func newFailedArgoWorkflow() *argoproj.Workflow {
+	wf := &argoproj.Workflow{}
+	wf.SetName(testActionsRunName)
+	wf.SetNamespace(testActionsRunNS)
+	wf.SetLabels(map[string]string{argoCompletedLabel: "true"})
+	wf.Spec.Shutdown = argoproj.ShutdownStrategyTerminate
+	wf.Status.Phase = argoproj.WorkflowFailed
+	wf.Status.FinishedAt = metav1.Now()
+	wf.Status.Message = "child 'build' failed"
+	wf.Status.Nodes = argoproj.Nodes{
+		"root": {
+			ID: "root", Name: testActionsRunName, Type: argoproj.NodeTypeSteps,
+			Phase: argoproj.NodeFailed, FinishedAt: metav1.Now(), Children: []string{"group-0"},
+		},
+		"group-0": {
+			ID: "group-0", Name: testActionsRunName + "[0]", Type: argoproj.NodeTypeStepGroup,
+			Phase: argoproj.NodeSucceeded, Children: []string{"checkout"},
+		},
+		"checkout": {
+			ID: "checkout", Name: testActionsRunName + "[0].checkout", DisplayName: "checkout",
+			Type: argoproj.NodeTypePod, Phase: argoproj.NodeSucceeded,
+		},
+		"group-1": {
+			ID: "group-1", Name: testActionsRunName + "[1]", Type: argoproj.NodeTypeStepGroup,
+			Phase: argoproj.NodeFailed, FinishedAt: metav1.Now(), Message: "child failed", Children: []string{"build"},
+		},
+		"build": {
+			ID: "build", Name: testActionsRunName + "[1].build", DisplayName: "build",
+			Type: argoproj.NodeTypePod, Phase: argoproj.NodeFailed, Message: "exit code 1",
+		},
+	}
+	return wf
+}
+
+func newWorkflowPod(name, nodeID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   testActionsRunNS,
+			Labels:      map[string]string{argoWorkflowLabel: testActionsRunName},
+			Annotations: map[string]string{argoNodeIDAnnotation: nodeID},
+		},
+	}
+}
+
+func newActionsReconciler(t *testing.T, wfr *openchoreodevv1alpha1.WorkflowRun, wpObjects ...client.Object) (*Reconciler, client.Client, client.Client) {
+	t.Helper()
+	s := newTestScheme()
+
+	cwf := &openchoreodevv1alpha1.ClusterWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-wf"},
+		Spec: openchoreodevv1alpha1.ClusterWorkflowSpec{
+			RunTemplate: &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion":"argoproj.io/v1alpha1","kind":"Workflow",
+				"metadata":{"name":"${metadata.workflowRunName}","namespace":"${metadata.namespace}"},
+				"spec":{"entrypoint":"main","serviceAccountName":"wf-sa"}
+			}`)},
+		},
+	}
+	cwp := &openchoreodevv1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+
+	cpClient := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(cwf, wfr, cwp).
+		WithStatusSubresource(wfr).
+		Build()
+
+	// Argo Workflows have no status subresource, so status is written with the object.
+	wpScheme := runtime.NewScheme()
+	_ = argoproj.AddToScheme(wpScheme)
+	_ = corev1.AddToScheme(wpScheme)
+	wpClient := fake.NewClientBuilder().WithScheme(wpScheme).WithObjects(wpObjects...).Build()
+
+	mockProvider := &k8sMocks.MockWorkflowPlaneClientProvider{}
+	mockProvider.EXPECT().ClusterWorkflowPlaneClient(mock.Anything).Return(wpClient, nil).Maybe()
+
+	return &Reconciler{
+		Client:              cpClient,
+		Scheme:              s,
+		PlaneClientProvider: mockProvider,
+		Pipeline:            workflowpipeline.NewPipeline(),
+	}, cpClient, wpClient
+}
+
+func reconcileActionsRun(t *testing.T, r *Reconciler) ctrl.Result {
+	t.Helper()
+	result, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: testActionsRunName, Namespace: "default"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return result
+}
+
+func getActionsRun(t *testing.T, cpClient client.Client) *openchoreodevv1alpha1.WorkflowRun {
+	t.Helper()
+	got := &openchoreodevv1alpha1.WorkflowRun{}
+	if err := cpClient.Get(context.Background(), types.NamespacedName{Name: testActionsRunName, Namespace: "default"}, got); err != nil {
+		t.Fatalf("failed to get WorkflowRun: %v", err)
+	}
+	return got
+}
+
+// ---------------------------------------------------------------------------
+// cancel
+// ---------------------------------------------------------------------------
+
+func TestReconcileCancelRunningWorkflow(t *testing.T) {
+	wfr := newActionsTestRun()
+	wfr.Spec.Cancel = true
+	setWorkflowRunningCondition(wfr)
+	setWorkflowPendingCondition(wfr)
+
+	argoWf := &argoproj.Workflow{}
+	argoWf.SetName(testActionsRunName)
+	argoWf.SetNamespace(testActionsRunNS)
+	argoWf.Status.Phase = argoproj.WorkflowRunning
+
+	r, cpClient, wpClient := newActionsReconciler(t, wfr, argoWf)
+	reconcileActionsRun(t, r)
+
+	gotArgo := &argoproj.Workflow{}
+	if err := wpClient.Get(context.Background(), types.NamespacedName{Name: testActionsRunName, Namespace: testActionsRunNS}, gotArgo); err != nil {
+		t.Fatalf("failed to get argo workflow: %v", err)
+	}
+	if gotArgo.Spec.Shutdown != argoproj.ShutdownStrategyTerminate {
+		t.Errorf("expected argo workflow to be terminated, got shutdown=%q", gotArgo.Spec.Shutdown)
+	}
+	got := getActionsRun(t, cpClient)
+	if isWorkflowCompleted(got) {
+		t.Error("expected the run to stay running until the argo workflow has stopped")
+	}
+
+	// Argo reports the terminated workflow as failed.
+	gotArgo.Status.Phase = argoproj.WorkflowFailed
+	if err := wpClient.Update(context.Background(), gotArgo); err != nil {
+		t.Fatalf("failed to update argo workflow: %v", err)
+	}
+	reconcileActionsRun(t, r)
+
+	got = getActionsRun(t, cpClient)
+	assertCondition(t, got, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonWorkflowCancelled))
+	assertCondition(t, got, string(ConditionWorkflowFailed), metav1.ConditionTrue, string(ReasonWorkflowCancelled))
+	if !isWorkflowCancelled(got) {
+		t.Error("expected the run to be reported as cancelled")
+	}
+}
+
+func TestReconcileCancelBeforeSubmission(t *testing.T) {
+	wfr := newActionsTestRun()
+	wfr.Spec.Cancel = true
+	wfr.Status.RunReference = nil
+
+	r, cpClient, _ := newActionsReconciler(t, wfr)
+	result := reconcileActionsRun(t, r)
+	if !result.Requeue {
+		t.Error("expected a requeue to record the completion time")
+	}
+
+	got := getActionsRun(t, cpClient)
+	if !isWorkflowCancelled(got) {
+		t.Errorf("expected the run to be cancelled, got conditions %+v", got.Status.Conditions)
+	}
+	if got.Status.RunReference != nil {
+		t.Error("expected no run resource to be submitted for a cancelled run")
+	}
+}
+
+func TestSyncWorkflowRunStatusCancelled(t *testing.T) {
+	wfr := newActionsTestRun()
+	wfr.Spec.Cancel = true
+	runResource := &argoproj.Workflow{}
+	runResource.Status.Phase = argoproj.WorkflowError
+
+	(&Reconciler{}).syncWorkflowRunStatus(wfr, runResource)
+	assertCondition(t, wfr, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonWorkflowCancelled))
+}
+
+// ---------------------------------------------------------------------------
+// resume
+// ---------------------------------------------------------------------------
+
+func TestResetFailedArgoNodes(t *testing.T) {
+	wf := newFailedArgoWorkflow()
+
+	removed := resetFailedArgoNodes(wf)
+	if !slices.Equal(removed, []string{"build"}) {
+		t.Fatalf("expected the failed pod node to be removed, got %v", removed)
+	}
+	if _, ok := wf.Status.Nodes["build"]; ok {
+		t.Error("expected the failed pod node to be deleted from the workflow status")
+	}
+	if wf.Status.Nodes["checkout"].Phase != argoproj.NodeSucceeded {
+		t.Error("expected the succeeded step to be kept")
+	}
+	if wf.Status.Nodes["group-0"].Phase != argoproj.NodeSucceeded {
+		t.Error("expected the succeeded step group to be kept")
+	}
+	group := wf.Status.Nodes["group-1"]
+	if group.Phase != argoproj.NodeRunning || !group.FinishedAt.IsZero() || group.Message != "" || len(group.Children) != 0 {
+		t.Errorf("expected the failed step group to be reset, got %+v", group)
+	}
+	if wf.Status.Nodes["root"].Phase != argoproj.NodeRunning {
+		t.Error("expected the workflow root node to be reset")
+	}
+	if wf.Status.Phase != argoproj.WorkflowRunning || !wf.Status.FinishedAt.IsZero() || wf.Status.Message != "" {
+		t.Errorf("expected the workflow to be running again, got phase=%q", wf.Status.Phase)
+	}
+	if wf.Spec.Shutdown != argoproj.ShutdownStrategyNone {
+		t.Errorf("expected the shutdown strategy to be cleared, got %q", wf.Spec.Shutdown)
+	}
+	if wf.GetLabels()[argoCompletedLabel] != "false" {
+		t.Error("expected the completed label to be reset")
+	}
+
+	t.Run("succeeded workflow is not reset", func(t *testing.T) {
+		wf := newFailedArgoWorkflow()
+		wf.Status.Phase = argoproj.WorkflowSucceeded
+		if removed := resetFailedArgoNodes(wf); removed != nil {
+			t.Errorf("expected no nodes to be reset, got %v", removed)
+		}
+	})
+}
+
+func TestResumeBlockedReason(t *testing.T) {
+	failed := newActionsTestRun()
+	setWorkflowFailedCondition(failed)
+
+	succeeded := newActionsTestRun()
+	setWorkflowSucceededCondition(succeeded)
+
+	cancelled := newActionsTestRun()
+	setWorkflowCancelledCondition(cancelled)
+
+	running := newActionsTestRun()
+	setWorkflowPendingCondition(running)
+	setWorkflowRunningCondition(running)
+
+	tekton := newActionsTestRun()
+	setWorkflowFailedCondition(tekton)
+	tekton.Status.RunReference.APIVersion = "tekton.dev/v1"
+	tekton.Status.RunReference.Kind = "PipelineRun"
+
+	tests := []struct {
+		name    string
+		run     *openchoreodevv1alpha1.WorkflowRun
+		blocked bool
+	}{
+		{"failed run", failed, false},
+		{"succeeded run", succeeded, true},
+		{"cancelled run", cancelled, true},
+		{"running run", running, true},
+		{"unsupported engine", tekton, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if reason := resumeBlockedReason(tt.run); (reason != "") != tt.blocked {
+				t.Errorf("expected blocked=%v, got reason %q", tt.blocked, reason)
+			}
+		})
+	}
+}
+
+func TestReconcileResumeFailedWorkflow(t *testing.T) {
+	wfr := newActionsTestRun()
+	wfr.Spec.Resume = true
+	wfr.Spec.TTLAfterCompletion = "1h"
+	setWorkflowFailedCondition(wfr)
+	completedAt := metav1.Now()
+	wfr.Status.CompletedAt = &completedAt
+
+	failedPod := newWorkflowPod("build-pod", "build")
+	succeededPod := newWorkflowPod("checkout-pod", "checkout")
+	r, cpClient, wpClient := newActionsReconciler(t, wfr, newFailedArgoWorkflow(), failedPod, succeededPod)
+
+	result := reconcileActionsRun(t, r)
+	if !result.Requeue {
+		t.Error("expected a requeue to sync the resumed workflow")
+	}
+
+	got := getActionsRun(t, cpClient)
+	if got.Spec.Resume {
+		t.Error("expected the resume request to be cleared")
+	}
+	if got.Status.CompletedAt != nil {
+		t.Error("expected the completion time to be cleared")
+	}
+	if isWorkflowCompleted(got) {
+		t.Error("expected the run to be running again")
+	}
+	if findConditionByType(got.Status.Conditions, string(ConditionWorkflowFailed)) != nil {
+		t.Error("expected the failed condition to be removed")
+	}
+	assertCondition(t, got, string(ConditionWorkflowRunning), metav1.ConditionTrue, "")
+	if len(got.Status.Tasks) != 1 || got.Status.Tasks[0].Name != "checkout" {
+		t.Errorf("expected only the succeeded task to be kept, got %+v", got.Status.Tasks)
+	}
+
+	gotArgo := &argoproj.Workflow{}
+	if err := wpClient.Get(context.Background(), types.NamespacedName{Name: testActionsRunName, Namespace: testActionsRunNS}, gotArgo); err != nil {
+		t.Fatalf("failed to get argo workflow: %v", err)
+	}
+	if gotArgo.Status.Phase != argoproj.WorkflowRunning {
+		t.Errorf("expected the argo workflow to be running, got %q", gotArgo.Status.Phase)
+	}
+
+	err := wpClient.Get(context.Background(), client.ObjectKeyFromObject(failedPod), &corev1.Pod{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the pod of the failed task to be deleted, got err=%v", err)
+	}
+	if err := wpClient.Get(context.Background(), client.ObjectKeyFromObject(succeededPod), &corev1.Pod{}); err != nil {
+		t.Errorf("expected the pod of the succeeded task to be kept, got err=%v", err)
+	}
+}
+
+func TestReconcileResumeIgnoredForSucceededRun(t *testing.T) {
+	wfr := newActionsTestRun()
+	wfr.Spec.Resume = true
+	setWorkflowSucceededCondition(wfr)
+
+	r, cpClient, _ := newActionsReconciler(t, wfr)
+	reconcileActionsRun(t, r)
+
+	got := getActionsRun(t, cpClient)
+	if got.Spec.Resume {
+		t.Error("expected the resume request to be cleared")
+	}
+	assertCondition(t, got, string(ConditionWorkflowSucceeded), metav1.ConditionTrue, "")
+}
@@ -27,6 +27,7 @@ const (
 	ReasonWorkflowPlaneResolutionFailed controller.ConditionReason = "WorkflowPlaneResolutionFailed"
 	ReasonWorkflowResolutionFailed      controller.ConditionReason = "WorkflowResolutionFailed"
 	ReasonComponentValidationFailed     controller.ConditionReason = "ComponentValidationFailed"
+	ReasonWorkflowCancelled             controller.ConditionReason = "WorkflowCancelled"
 )
 
 func setWorkflowPendingCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
@@ -97,6 +98,44 @@ func setWorkflowFailedCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
 	})
 }
 
+func setWorkflowCancelledCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRunning),
+		Message:            "Workflow run was cancelled",
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowFailed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowCancelled),
+		Message:            "Workflow run was cancelled",
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowCancelled),
+		Message:            "Workflow run was cancelled",
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+// setWorkflowResumedCondition moves a failed workflow run back to running once its failed
+// tasks have been reset for re-execution.
+func setWorkflowResumedCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
+	meta.RemoveStatusCondition(&workflowRun.Status.Conditions, string(ConditionWorkflowFailed))
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRunning),
+		Message:            "Workflow was resumed from its failed tasks",
+		ObservedGeneration: workflowRun.Generation,
+	})
+	setWorkflowRunningCondition(workflowRun)
+}
+
 func setWorkflowPlaneNotFoundCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
 	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
 		Type:               string(ConditionWorkflowCompleted),
@@ -163,6 +202,11 @@ func isWorkflowSucceeded(workflowRun *openchoreov1alpha1.WorkflowRun) bool {
 	return meta.IsStatusConditionTrue(workflowRun.Status.Conditions, string(ConditionWorkflowSucceeded))
 }
 
+func isWorkflowCancelled(workflowRun *openchoreov1alpha1.WorkflowRun) bool {
+	cond := meta.FindStatusCondition(workflowRun.Status.Conditions, string(ConditionWorkflowCompleted))
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.Reason == string(ReasonWorkflowCancelled)
+}
+
 func isWorkflowRunning(workflowRun *openchoreov1alpha1.WorkflowRun) bool {
 	return meta.IsStatusConditionTrue(workflowRun.Status.Conditions, string(ConditionWorkflowRunning))
 }
@@ -0,0 +1,292 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// Reconciler reconciles a WorkflowRunGroup object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowrungroups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowrungroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowrungroups/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile starts the WorkflowRuns of a WorkflowRunGroup within its concurrency cap, applies
+// the failure policy, and aggregates the state of the runs into the group status.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	group := &openchoreov1alpha1.WorkflowRunGroup{}
+	if err := r.Get(ctx, req.NamespacedName, group); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	// Runs are owned by the group and garbage collected with it.
+	if !group.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := group.DeepCopy()
+	group.Status.ObservedGeneration = group.Generation
+
+	runs, err := r.listRuns(ctx, group)
+	if err != nil {
+		logger.Error(err, "Failed to list WorkflowRuns of the group")
+		return ctrl.Result{}, err
+	}
+	statuses := observeRuns(group, runs)
+
+	if hasPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunFailed) &&
+		group.Spec.FailurePolicy == openchoreov1alpha1.WorkflowRunGroupFailurePolicyFailFast {
+		if err := r.failFast(ctx, statuses, runs); err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if err := r.startRuns(ctx, group, statuses); err != nil {
+		logger.Error(err, "Failed to start WorkflowRuns of the group")
+		return ctrl.Result{}, err
+	}
+
+	updateStatus(group, statuses)
+
+	if !apiequality.Semantic.DeepEqual(old.Status, group.Status) {
+		if err := r.Status().Update(ctx, group); err != nil {
+			logger.Error(err, "Failed to update WorkflowRunGroup status")
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// observeRuns returns the status of each run of the group, in the order of spec.runs.
+// Skipped runs stay skipped; the other phases are derived from the WorkflowRuns.
+func observeRuns(group *openchoreov1alpha1.WorkflowRunGroup, runs map[string]*openchoreov1alpha1.WorkflowRun) []openchoreov1alpha1.WorkflowRunGroupRunStatus {
+	skipped := make(map[string]bool)
+	for _, s := range group.Status.Runs {
+		if s.Phase == openchoreov1alpha1.WorkflowRunGroupRunSkipped {
+			skipped[s.Name] = true
+		}
+	}
+
+	statuses := make([]openchoreov1alpha1.WorkflowRunGroupRunStatus, 0, len(group.Spec.Runs))
+	for _, member := range group.Spec.Runs {
+		status := openchoreov1alpha1.WorkflowRunGroupRunStatus{Name: member.Name}
+		run, ok := runs[RunName(group.Name, member.Name)]
+		switch {
+		case !ok && skipped[member.Name]:
+			status.Phase = openchoreov1alpha1.WorkflowRunGroupRunSkipped
+		case !ok:
+			status.Phase = openchoreov1alpha1.WorkflowRunGroupRunPending
+		default:
+			status.WorkflowRunName = run.Name
+			status.Phase = runPhase(run)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func runPhase(run *openchoreov1alpha1.WorkflowRun) openchoreov1alpha1.WorkflowRunGroupRunPhase {
+	switch {
+	case !meta.IsStatusConditionTrue(run.Status.Conditions, string(workflowrun.ConditionWorkflowCompleted)):
+		return openchoreov1alpha1.WorkflowRunGroupRunRunning
+	case meta.IsStatusConditionTrue(run.Status.Conditions, string(workflowrun.ConditionWorkflowSucceeded)):
+		return openchoreov1alpha1.WorkflowRunGroupRunSucceeded
+	default:
+		// Cancelled runs count as failed.
+		return openchoreov1alpha1.WorkflowRunGroupRunFailed
+	}
+}
+
+// startRuns creates the WorkflowRuns of pending runs, in order, until the concurrency cap is reached.
+func (r *Reconciler) startRuns(ctx context.Context, group *openchoreov1alpha1.WorkflowRunGroup,
+	statuses []openchoreov1alpha1.WorkflowRunGroupRunStatus) error {
+	running := countPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunRunning)
+	for i, member := range group.Spec.Runs {
+		if statuses[i].Phase != openchoreov1alpha1.WorkflowRunGroupRunPending {
+			continue
+		}
+		if group.Spec.MaxConcurrency != nil && running >= *group.Spec.MaxConcurrency {
+			// The remaining runs are started when running runs complete and trigger a reconcile.
+			return nil
+		}
+		name, err := r.createRun(ctx, group, &member)
+		if err != nil {
+			return fmt.Errorf("failed to create WorkflowRun for %q: %w", member.Name, err)
+		}
+		statuses[i].WorkflowRunName = name
+		statuses[i].Phase = openchoreov1alpha1.WorkflowRunGroupRunRunning
+		running++
+	}
+	return nil
+}
+
+// createRun creates the WorkflowRun of a run of the group and returns its name. The name is
+// derived from the group and the run, so a run is created at most once even when the
+// status update recording it fails.
+func (r *Reconciler) createRun(ctx context.Context, group *openchoreov1alpha1.WorkflowRunGroup,
+	member *openchoreov1alpha1.WorkflowRunGroupRun) (string, error) {
+	runLabels := make(map[string]string, len(member.Labels)+1)
+	for k, v := range member.Labels {
+		runLabels[k] = v
+	}
+	runLabels[labels.LabelKeyWorkflowRunGroup] = group.Name
+
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RunName(group.Name, member.Name),
+			Namespace: group.Namespace,
+			Labels:    runLabels,
+		},
+		Spec: openchoreov1alpha1.WorkflowRunSpec{
+			Workflow: openchoreov1alpha1.WorkflowRunConfig{
+				Kind:       member.Workflow.Kind,
+				Name:       member.Workflow.Name,
+				Parameters: member.Workflow.Parameters.DeepCopy(),
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(group, run, r.Scheme); err != nil {
+		return "", err
+	}
+	if err := r.Create(ctx, run); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	log.FromContext(ctx).Info("Created WorkflowRun of the group", "workflowRun", run.Name, "run", member.Name)
+	return run.Name, nil
+}
+
+// failFast skips the pending runs and requests the cancellation of the running runs.
+func (r *Reconciler) failFast(ctx context.Context, statuses []openchoreov1alpha1.WorkflowRunGroupRunStatus,
+	runs map[string]*openchoreov1alpha1.WorkflowRun) error {
+	for i := range statuses {
+		switch statuses[i].Phase {
+		case openchoreov1alpha1.WorkflowRunGroupRunPending:
+			statuses[i].Phase = openchoreov1alpha1.WorkflowRunGroupRunSkipped
+		case openchoreov1alpha1.WorkflowRunGroupRunRunning:
+			run := runs[statuses[i].WorkflowRunName]
+			if run.Spec.Cancel {
+				continue
+			}
+			patch := client.MergeFrom(run.DeepCopy())
+			run.Spec.Cancel = true
+			if err := r.Patch(ctx, run, patch); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to cancel WorkflowRun %q: %w", run.Name, err)
+			}
+			log.FromContext(ctx).Info("Cancelled WorkflowRun after a run of the group failed", "workflowRun", run.Name)
+		}
+	}
+	return nil
+}
+
+// updateStatus records the run statuses, the counts and the aggregate conditions of the group.
+func updateStatus(group *openchoreov1alpha1.WorkflowRunGroup, statuses []openchoreov1alpha1.WorkflowRunGroupRunStatus) {
+	group.Status.Runs = statuses
+	group.Status.Total = int32(len(statuses)) //nolint:gosec // bounded by the MaxItems validation of spec.runs
+	group.Status.Pending = countPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunPending)
+	group.Status.Running = countPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunRunning)
+	group.Status.Succeeded = countPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunSucceeded)
+	group.Status.Failed = countPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunFailed)
+	group.Status.Skipped = countPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunSkipped)
+
+	now := metav1.Now()
+	if group.Status.StartedAt == nil && group.Status.Pending < group.Status.Total {
+		group.Status.StartedAt = &now
+	}
+
+	if group.Status.Failed > 0 {
+		controller.MarkFalseCondition(group, ConditionSucceeded, ReasonRunFailed,
+			fmt.Sprintf("Failed runs: %s", strings.Join(namesWithPhase(statuses, openchoreov1alpha1.WorkflowRunGroupRunFailed), ", ")))
+	}
+
+	if group.Status.Pending > 0 || group.Status.Running > 0 {
+		controller.MarkFalseCondition(group, ConditionCompleted, ReasonRunsInProgress,
+			fmt.Sprintf("%d of %d runs completed", group.Status.Total-group.Status.Pending-group.Status.Running, group.Status.Total))
+		return
+	}
+	controller.MarkTrueCondition(group, ConditionCompleted, ReasonRunsCompleted,
+		fmt.Sprintf("%d succeeded, %d failed, %d skipped", group.Status.Succeeded, group.Status.Failed, group.Status.Skipped))
+	if group.Status.Failed == 0 {
+		controller.MarkTrueCondition(group, ConditionSucceeded, ReasonAllRunsSucceeded, "All runs succeeded")
+	}
+	if group.Status.CompletedAt == nil {
+		group.Status.CompletedAt = &now
+	}
+}
+
+// listRuns returns the WorkflowRuns controlled by the group, by name.
+func (r *Reconciler) listRuns(ctx context.Context, group *openchoreov1alpha1.WorkflowRunGroup) (map[string]*openchoreov1alpha1.WorkflowRun, error) {
+	list := &openchoreov1alpha1.WorkflowRunList{}
+	if err := r.List(ctx, list, client.InNamespace(group.Namespace),
+		client.MatchingLabels{labels.LabelKeyWorkflowRunGroup: group.Name}); err != nil {
+		return nil, err
+	}
+	runs := make(map[string]*openchoreov1alpha1.WorkflowRun, len(list.Items))
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], group) {
+			runs[list.Items[i].Name] = &list.Items[i]
+		}
+	}
+	return runs, nil
+}
+
+// RunName returns the name of the WorkflowRun of a run of a group.
+func RunName(groupName, runName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength, groupName, runName)
+}
+
+func hasPhase(statuses []openchoreov1alpha1.WorkflowRunGroupRunStatus, phase openchoreov1alpha1.WorkflowRunGroupRunPhase) bool {
+	return countPhase(statuses, phase) > 0
+}
+
+func countPhase(statuses []openchoreov1alpha1.WorkflowRunGroupRunStatus, phase openchoreov1alpha1.WorkflowRunGroupRunPhase) int32 {
+	var n int32
+	for _, s := range statuses {
+		if s.Phase == phase {
+			n++
+		}
+	}
+	return n
+}
+
+func namesWithPhase(statuses []openchoreov1alpha1.WorkflowRunGroupRunStatus, phase openchoreov1alpha1.WorkflowRunGroupRunPhase) []string {
+	var names []string
+	for _, s := range statuses {
+		if s.Phase == phase {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.WorkflowRunGroup{}).
+		Owns(&openchoreov1alpha1.WorkflowRun{}).
+		Named("workflowrungroup").
+		Complete(r)
+}
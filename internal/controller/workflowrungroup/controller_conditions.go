@@ -0,0 +1,35 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionCompleted indicates every run of the group has completed or was skipped.
+	ConditionCompleted controller.ConditionType = "WorkflowRunGroupCompleted"
+
+	// ConditionSucceeded indicates whether all runs of the group succeeded. It is set to false
+	// as soon as a run fails, and to true once all runs have succeeded.
+	ConditionSucceeded controller.ConditionType = "WorkflowRunGroupSucceeded"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonRunsInProgress indicates some runs of the group are pending or running.
+	ReasonRunsInProgress controller.ConditionReason = "RunsInProgress"
+
+	// ReasonRunsCompleted indicates every run of the group has completed or was skipped.
+	ReasonRunsCompleted controller.ConditionReason = "RunsCompleted"
+
+	// ReasonAllRunsSucceeded indicates all runs of the group succeeded.
+	ReasonAllRunsSucceeded controller.ConditionReason = "AllRunsSucceeded"
+
+	// ReasonRunFailed indicates at least one run of the group failed.
+	ReasonRunFailed controller.ConditionReason = "RunFailed"
+)
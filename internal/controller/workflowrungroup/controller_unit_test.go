@@ -0,0 +1,278 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	testNamespace = "default"
+	testGroup     = "build-abc1234"
+)
+
+func newGroup(mutate ...func(*openchoreov1alpha1.WorkflowRunGroup)) *openchoreov1alpha1.WorkflowRunGroup {
+	group := &openchoreov1alpha1.WorkflowRunGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testGroup,
+			Namespace:  testNamespace,
+			UID:        "group-uid",
+			Generation: 1,
+		},
+		Spec: openchoreov1alpha1.WorkflowRunGroupSpec{
+			Commit:        "abc1234",
+			FailurePolicy: openchoreov1alpha1.WorkflowRunGroupFailurePolicyContinue,
+		},
+	}
+	for _, name := range []string{"api", "web", "worker"} {
+		group.Spec.Runs = append(group.Spec.Runs, openchoreov1alpha1.WorkflowRunGroupRun{
+			Name: name,
+			WorkflowRunTemplate: openchoreov1alpha1.WorkflowRunTemplate{
+				Labels: map[string]string{labels.LabelKeyComponentName: name},
+				Workflow: openchoreov1alpha1.ScheduledWorkflowConfig{
+					Kind:       openchoreov1alpha1.WorkflowRefKindClusterWorkflow,
+					Name:       "docker-build",
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"commit":"abc1234"}`)},
+				},
+			},
+		})
+	}
+	for _, m := range mutate {
+		m(group)
+	}
+	return group
+}
+
+// newRun returns the run of a group member. completed is false for an active run.
+func newRun(t *testing.T, group *openchoreov1alpha1.WorkflowRunGroup, member string, completed, succeeded bool) *openchoreov1alpha1.WorkflowRun {
+	t.Helper()
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RunName(group.Name, member),
+			Namespace: testNamespace,
+			Labels:    map[string]string{labels.LabelKeyWorkflowRunGroup: group.Name},
+		},
+		Spec: openchoreov1alpha1.WorkflowRunSpec{
+			Workflow: openchoreov1alpha1.WorkflowRunConfig{Name: "docker-build"},
+		},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(group, run, newScheme(t)))
+	if completed {
+		run.Status.Conditions = []metav1.Condition{
+			{Type: string(workflowrun.ConditionWorkflowCompleted), Status: metav1.ConditionTrue, Reason: "Done"},
+			{Type: string(workflowrun.ConditionWorkflowSucceeded), Status: conditionStatus(succeeded), Reason: "Done"},
+		}
+	}
+	return run
+}
+
+func conditionStatus(b bool) metav1.ConditionStatus {
+	if b {
+		return metav1.ConditionTrue
+	}
+	return metav1.ConditionFalse
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	return s
+}
+
+func newReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	s := newScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.WorkflowRunGroup{}).
+		Build()
+	return &Reconciler{Client: c, Scheme: s}, c
+}
+
+func reconcileGroup(t *testing.T, r *Reconciler, c client.Client) *openchoreov1alpha1.WorkflowRunGroup {
+	t.Helper()
+	key := types.NamespacedName{Name: testGroup, Namespace: testNamespace}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	got := &openchoreov1alpha1.WorkflowRunGroup{}
+	require.NoError(t, c.Get(context.Background(), key, got))
+	return got
+}
+
+func listRuns(t *testing.T, c client.Client) map[string]openchoreov1alpha1.WorkflowRun {
+	t.Helper()
+	list := &openchoreov1alpha1.WorkflowRunList{}
+	require.NoError(t, c.List(context.Background(), list, client.InNamespace(testNamespace)))
+	runs := make(map[string]openchoreov1alpha1.WorkflowRun, len(list.Items))
+	for _, run := range list.Items {
+		runs[run.Name] = run
+	}
+	return runs
+}
+
+func phases(group *openchoreov1alpha1.WorkflowRunGroup) map[string]openchoreov1alpha1.WorkflowRunGroupRunPhase {
+	got := make(map[string]openchoreov1alpha1.WorkflowRunGroupRunPhase, len(group.Status.Runs))
+	for _, s := range group.Status.Runs {
+		got[s.Name] = s.Phase
+	}
+	return got
+}
+
+func assertCondition(t *testing.T, group *openchoreov1alpha1.WorkflowRunGroup, condType string, status metav1.ConditionStatus, reason string) {
+	t.Helper()
+	cond := meta.FindStatusCondition(group.Status.Conditions, condType)
+	require.NotNil(t, cond, condType)
+	assert.Equal(t, status, cond.Status, condType)
+	assert.Equal(t, reason, cond.Reason, condType)
+}
+
+func TestReconcileStartsAllRuns(t *testing.T) {
+	r, c := newReconciler(t, newGroup())
+
+	group := reconcileGroup(t, r, c)
+
+	runs := listRuns(t, c)
+	require.Len(t, runs, 3)
+	run, ok := runs[RunName(testGroup, "api")]
+	require.True(t, ok)
+	assert.Equal(t, testGroup, run.Labels[labels.LabelKeyWorkflowRunGroup])
+	assert.Equal(t, "api", run.Labels[labels.LabelKeyComponentName])
+	assert.Equal(t, "docker-build", run.Spec.Workflow.Name)
+	assert.JSONEq(t, `{"commit":"abc1234"}`, string(run.Spec.Workflow.Parameters.Raw))
+	assert.True(t, metav1.IsControlledBy(&run, group))
+
+	assert.Equal(t, int32(3), group.Status.Total)
+	assert.Equal(t, int32(3), group.Status.Running)
+	assert.NotNil(t, group.Status.StartedAt)
+	assert.Equal(t, RunName(testGroup, "api"), group.Status.Runs[0].WorkflowRunName)
+	assertCondition(t, group, string(ConditionCompleted), metav1.ConditionFalse, string(ReasonRunsInProgress))
+	assert.Nil(t, meta.FindStatusCondition(group.Status.Conditions, string(ConditionSucceeded)))
+}
+
+func TestReconcileMaxConcurrency(t *testing.T) {
+	base := newGroup(func(g *openchoreov1alpha1.WorkflowRunGroup) { g.Spec.MaxConcurrency = ptr.To(int32(2)) })
+
+	t.Run("starts runs up to the cap", func(t *testing.T) {
+		r, c := newReconciler(t, base.DeepCopy())
+
+		group := reconcileGroup(t, r, c)
+
+		assert.Len(t, listRuns(t, c), 2)
+		assert.Equal(t, map[string]openchoreov1alpha1.WorkflowRunGroupRunPhase{
+			"api":    openchoreov1alpha1.WorkflowRunGroupRunRunning,
+			"web":    openchoreov1alpha1.WorkflowRunGroupRunRunning,
+			"worker": openchoreov1alpha1.WorkflowRunGroupRunPending,
+		}, phases(group))
+		assert.Equal(t, int32(1), group.Status.Pending)
+	})
+
+	t.Run("starts the next run when a run completes", func(t *testing.T) {
+		r, c := newReconciler(t, base.DeepCopy(),
+			newRun(t, base, "api", true, true),
+			newRun(t, base, "web", false, false),
+		)
+
+		group := reconcileGroup(t, r, c)
+
+		assert.Len(t, listRuns(t, c), 3)
+		assert.Equal(t, int32(1), group.Status.Succeeded)
+		assert.Equal(t, int32(2), group.Status.Running)
+	})
+}
+
+func TestReconcileAllSucceeded(t *testing.T) {
+	group := newGroup()
+	r, c := newReconciler(t, group,
+		newRun(t, group, "api", true, true),
+		newRun(t, group, "web", true, true),
+		newRun(t, group, "worker", true, true),
+	)
+
+	got := reconcileGroup(t, r, c)
+
+	assert.Equal(t, int32(3), got.Status.Succeeded)
+	assert.NotNil(t, got.Status.CompletedAt)
+	assertCondition(t, got, string(ConditionCompleted), metav1.ConditionTrue, string(ReasonRunsCompleted))
+	assertCondition(t, got, string(ConditionSucceeded), metav1.ConditionTrue, string(ReasonAllRunsSucceeded))
+}
+
+func TestReconcileFailurePolicy(t *testing.T) {
+	t.Run("Continue runs the remaining runs", func(t *testing.T) {
+		group := newGroup(func(g *openchoreov1alpha1.WorkflowRunGroup) { g.Spec.MaxConcurrency = ptr.To(int32(2)) })
+		r, c := newReconciler(t, group,
+			newRun(t, group, "api", true, false),
+			newRun(t, group, "web", false, false),
+		)
+
+		got := reconcileGroup(t, r, c)
+
+		runs := listRuns(t, c)
+		assert.Len(t, runs, 3)
+		assert.False(t, runs[RunName(testGroup, "web")].Spec.Cancel)
+		assert.Equal(t, int32(1), got.Status.Failed)
+		assertCondition(t, got, string(ConditionSucceeded), metav1.ConditionFalse, string(ReasonRunFailed))
+		assertCondition(t, got, string(ConditionCompleted), metav1.ConditionFalse, string(ReasonRunsInProgress))
+	})
+
+	t.Run("FailFast cancels running runs and skips pending runs", func(t *testing.T) {
+		group := newGroup(func(g *openchoreov1alpha1.WorkflowRunGroup) {
+			g.Spec.MaxConcurrency = ptr.To(int32(2))
+			g.Spec.FailurePolicy = openchoreov1alpha1.WorkflowRunGroupFailurePolicyFailFast
+		})
+		r, c := newReconciler(t, group,
+			newRun(t, group, "api", true, false),
+			newRun(t, group, "web", false, false),
+		)
+
+		got := reconcileGroup(t, r, c)
+
+		runs := listRuns(t, c)
+		assert.Len(t, runs, 2)
+		assert.True(t, runs[RunName(testGroup, "web")].Spec.Cancel)
+		assert.Equal(t, map[string]openchoreov1alpha1.WorkflowRunGroupRunPhase{
+			"api":    openchoreov1alpha1.WorkflowRunGroupRunFailed,
+			"web":    openchoreov1alpha1.WorkflowRunGroupRunRunning,
+			"worker": openchoreov1alpha1.WorkflowRunGroupRunSkipped,
+		}, phases(got))
+		assertCondition(t, got, string(ConditionSucceeded), metav1.ConditionFalse, string(ReasonRunFailed))
+
+		// Once the cancelled run completes, the skipped run stays skipped and the group completes.
+		web := runs[RunName(testGroup, "web")]
+		web.Status.Conditions = newRun(t, group, "web", true, false).Status.Conditions
+		require.NoError(t, c.Update(context.Background(), &web))
+
+		got = reconcileGroup(t, r, c)
+
+		assert.Len(t, listRuns(t, c), 2)
+		assert.Equal(t, int32(2), got.Status.Failed)
+		assert.Equal(t, int32(1), got.Status.Skipped)
+		assertCondition(t, got, string(ConditionCompleted), metav1.ConditionTrue, string(ReasonRunsCompleted))
+		assertCondition(t, got, string(ConditionSucceeded), metav1.ConditionFalse, string(ReasonRunFailed))
+	})
+}
+
+func TestRunName(t *testing.T) {
+	name := RunName("build-0123456789012345678901234567890123456789", "payments-service-api")
+	assert.LessOrEqual(t, len(name), 63)
+	assert.NotEqual(t, RunName(testGroup, "api"), RunName(testGroup, "web"))
+}
@@ -0,0 +1,295 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowschedule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// Reconciler reconciles a WorkflowSchedule object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Now returns the current time. Defaults to time.Now; tests replace it to control the clock.
+	Now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowschedules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowschedules/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile starts the WorkflowRuns of a WorkflowSchedule when they are due, applies the
+// concurrency policy, prunes completed runs beyond the history limits, and requeues the
+// schedule for its next run.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	ws := &openchoreov1alpha1.WorkflowSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, ws); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	// Runs are owned by the schedule and garbage collected with it.
+	if !ws.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := ws.DeepCopy()
+	ws.Status.ObservedGeneration = ws.Generation
+	now := r.now()
+
+	runs, err := r.listRuns(ctx, ws)
+	if err != nil {
+		logger.Error(err, "Failed to list WorkflowRuns of the schedule")
+		return ctrl.Result{}, err
+	}
+	active, succeeded, failed := classifyRuns(runs)
+	recordLastSuccessfulTime(ws, succeeded)
+	if err := r.pruneHistory(ctx, succeeded, ws.Spec.SuccessfulRunsHistoryLimit); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.pruneHistory(ctx, failed, ws.Spec.FailedRunsHistoryLimit); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	result, err := r.schedule(ctx, ws, &active, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	ws.Status.Active = runNames(active)
+
+	if !apiequality.Semantic.DeepEqual(old.Status, ws.Status) {
+		if err := r.Status().Update(ctx, ws); err != nil {
+			logger.Error(err, "Failed to update WorkflowSchedule status")
+			return ctrl.Result{}, err
+		}
+	}
+	return result, nil
+}
+
+// schedule starts the run that is due, if any, and returns when the schedule is due next.
+func (r *Reconciler) schedule(ctx context.Context, ws *openchoreov1alpha1.WorkflowSchedule,
+	active *[]*openchoreov1alpha1.WorkflowRun, now time.Time) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	sched, err := parseSchedule(&ws.Spec)
+	if err != nil {
+		ws.Status.NextScheduleTime = nil
+		controller.MarkFalseCondition(ws, ConditionReady, ReasonInvalidSchedule, err.Error())
+		// The schedule is reconciled again when it is changed.
+		return ctrl.Result{}, nil
+	}
+	if ws.Spec.Suspend {
+		ws.Status.NextScheduleTime = nil
+		controller.MarkFalseCondition(ws, ConditionReady, ReasonSuspended, "Schedule is suspended")
+		return ctrl.Result{}, nil
+	}
+
+	next := sched.Next(now)
+	ws.Status.NextScheduleTime = &metav1.Time{Time: next}
+	controller.MarkTrueCondition(ws, ConditionReady, ReasonScheduled, "Schedule is active")
+	result := ctrl.Result{RequeueAfter: next.Sub(now)}
+
+	scheduledTime, err := mostRecentScheduleTime(sched, earliestScheduleTime(ws, now), now)
+	if err != nil {
+		controller.MarkFalseCondition(ws, ConditionReady, ReasonInvalidSchedule, err.Error())
+		return ctrl.Result{}, nil
+	}
+	if scheduledTime == nil {
+		return result, nil
+	}
+
+	switch ws.Spec.ConcurrencyPolicy {
+	case openchoreov1alpha1.WorkflowScheduleConcurrencyForbid:
+		if len(*active) > 0 {
+			// The due run is started once the active runs complete, unless it misses its starting deadline.
+			logger.Info("Skipping due run while earlier runs are active", "scheduledTime", scheduledTime, "active", len(*active))
+			return result, nil
+		}
+	case openchoreov1alpha1.WorkflowScheduleConcurrencyReplace:
+		if err := r.cancelRuns(ctx, *active); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	run, err := r.createRun(ctx, ws, *scheduledTime)
+	if err != nil {
+		logger.Error(err, "Failed to create scheduled WorkflowRun", "scheduledTime", scheduledTime)
+		return ctrl.Result{}, err
+	}
+	if run != nil {
+		*active = append(*active, run)
+	}
+	ws.Status.LastScheduleTime = &metav1.Time{Time: *scheduledTime}
+	return result, nil
+}
+
+// createRun creates the WorkflowRun for a scheduled time. The run name is derived from the
+// scheduled time, so a run is created at most once per scheduled time even when the status
+// update recording it fails. It returns nil when the run already exists.
+func (r *Reconciler) createRun(ctx context.Context, ws *openchoreov1alpha1.WorkflowSchedule, scheduledTime time.Time) (*openchoreov1alpha1.WorkflowRun, error) {
+	template := ws.Spec.RunTemplate
+	runLabels := make(map[string]string, len(template.Labels)+1)
+	for k, v := range template.Labels {
+		runLabels[k] = v
+	}
+	runLabels[labels.LabelKeyWorkflowSchedule] = ws.Name
+
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      runName(ws.Name, scheduledTime),
+			Namespace: ws.Namespace,
+			Labels:    runLabels,
+			Annotations: map[string]string{
+				labels.AnnotationKeyScheduledTime: scheduledTime.UTC().Format(time.RFC3339),
+			},
+		},
+		Spec: openchoreov1alpha1.WorkflowRunSpec{
+			Workflow: openchoreov1alpha1.WorkflowRunConfig{
+				Kind:       template.Workflow.Kind,
+				Name:       template.Workflow.Name,
+				Parameters: template.Workflow.Parameters.DeepCopy(),
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(ws, run, r.Scheme); err != nil {
+		return nil, err
+	}
+	if err := r.Create(ctx, run); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	log.FromContext(ctx).Info("Created scheduled WorkflowRun", "workflowRun", run.Name, "scheduledTime", scheduledTime)
+	return run, nil
+}
+
+// runName returns the name of the run for a scheduled time, in minutes since the epoch
+// like the Jobs of a Kubernetes CronJob.
+func runName(scheduleName string, scheduledTime time.Time) string {
+	return fmt.Sprintf("%s-%d", scheduleName, scheduledTime.Unix()/60)
+}
+
+// cancelRuns requests the cancellation of the given runs.
+func (r *Reconciler) cancelRuns(ctx context.Context, runs []*openchoreov1alpha1.WorkflowRun) error {
+	for _, run := range runs {
+		if run.Spec.Cancel {
+			continue
+		}
+		patch := client.MergeFrom(run.DeepCopy())
+		run.Spec.Cancel = true
+		if err := r.Patch(ctx, run, patch); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to cancel WorkflowRun %q: %w", run.Name, err)
+		}
+		log.FromContext(ctx).Info("Cancelled WorkflowRun replaced by the schedule", "workflowRun", run.Name)
+	}
+	return nil
+}
+
+// pruneHistory deletes the oldest completed runs beyond the history limit.
+func (r *Reconciler) pruneHistory(ctx context.Context, runs []*openchoreov1alpha1.WorkflowRun, limit *int32) error {
+	if limit == nil || len(runs) <= int(*limit) {
+		return nil
+	}
+	sort.Slice(runs, func(i, j int) bool { return completionTime(runs[i]).Before(completionTime(runs[j])) })
+	for _, run := range runs[:len(runs)-int(*limit)] {
+		if err := r.Delete(ctx, run, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete WorkflowRun %q: %w", run.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) listRuns(ctx context.Context, ws *openchoreov1alpha1.WorkflowSchedule) ([]*openchoreov1alpha1.WorkflowRun, error) {
+	list := &openchoreov1alpha1.WorkflowRunList{}
+	if err := r.List(ctx, list, client.InNamespace(ws.Namespace),
+		client.MatchingLabels{labels.LabelKeyWorkflowSchedule: ws.Name}); err != nil {
+		return nil, err
+	}
+	runs := make([]*openchoreov1alpha1.WorkflowRun, 0, len(list.Items))
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], ws) {
+			runs = append(runs, &list.Items[i])
+		}
+	}
+	return runs, nil
+}
+
+// classifyRuns splits runs into active, succeeded and failed runs. Cancelled runs count as failed.
+func classifyRuns(runs []*openchoreov1alpha1.WorkflowRun) (active, succeeded, failed []*openchoreov1alpha1.WorkflowRun) {
+	for _, run := range runs {
+		switch {
+		case !meta.IsStatusConditionTrue(run.Status.Conditions, string(workflowrun.ConditionWorkflowCompleted)):
+			active = append(active, run)
+		case meta.IsStatusConditionTrue(run.Status.Conditions, string(workflowrun.ConditionWorkflowSucceeded)):
+			succeeded = append(succeeded, run)
+		default:
+			failed = append(failed, run)
+		}
+	}
+	return active, succeeded, failed
+}
+
+func recordLastSuccessfulTime(ws *openchoreov1alpha1.WorkflowSchedule, succeeded []*openchoreov1alpha1.WorkflowRun) {
+	for _, run := range succeeded {
+		completed := completionTime(run)
+		if ws.Status.LastSuccessfulTime == nil || completed.After(ws.Status.LastSuccessfulTime.Time) {
+			ws.Status.LastSuccessfulTime = &metav1.Time{Time: completed}
+		}
+	}
+}
+
+func completionTime(run *openchoreov1alpha1.WorkflowRun) time.Time {
+	if run.Status.CompletedAt != nil {
+		return run.Status.CompletedAt.Time
+	}
+	return run.CreationTimestamp.Time
+}
+
+func runNames(runs []*openchoreov1alpha1.WorkflowRun) []string {
+	if len(runs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(runs))
+	for _, run := range runs {
+		names = append(names, run.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Reconciler) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.WorkflowSchedule{}).
+		Owns(&openchoreov1alpha1.WorkflowRun{}).
+		Named("workflowschedule").
+		Complete(r)
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowschedule
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates the schedule is valid and starts runs when they are due.
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonScheduled indicates the schedule is active.
+	ReasonScheduled controller.ConditionReason = "Scheduled"
+
+	// ReasonSuspended indicates the schedule is suspended and does not start runs.
+	ReasonSuspended controller.ConditionReason = "Suspended"
+
+	// ReasonInvalidSchedule indicates the cron schedule or time zone cannot be parsed.
+	ReasonInvalidSchedule controller.ConditionReason = "InvalidSchedule"
+)
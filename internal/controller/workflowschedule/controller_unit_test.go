@@ -0,0 +1,320 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowschedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const testNamespace = "default"
+
+// created is the creation time of the test schedules, a Monday.
+var created = time.Date(2026, time.March, 2, 0, 30, 0, 0, time.UTC)
+
+func newSchedule(mutate ...func(*openchoreov1alpha1.WorkflowSchedule)) *openchoreov1alpha1.WorkflowSchedule {
+	ws := &openchoreov1alpha1.WorkflowSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly",
+			Namespace:         testNamespace,
+			UID:               "schedule-uid",
+			Generation:        1,
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+		Spec: openchoreov1alpha1.WorkflowScheduleSpec{
+			Schedule:          "0 2 * * *",
+			ConcurrencyPolicy: openchoreov1alpha1.WorkflowScheduleConcurrencyAllow,
+			RunTemplate: openchoreov1alpha1.WorkflowRunTemplate{
+				Labels: map[string]string{labels.LabelKeyComponentName: "api"},
+				Workflow: openchoreov1alpha1.ScheduledWorkflowConfig{
+					Kind:       openchoreov1alpha1.WorkflowRefKindClusterWorkflow,
+					Name:       "docker-build",
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"branch":"main"}`)},
+				},
+			},
+		},
+	}
+	for _, m := range mutate {
+		m(ws)
+	}
+	return ws
+}
+
+// newRun returns a run owned by the schedule. completed is nil for an active run.
+func newRun(t *testing.T, ws *openchoreov1alpha1.WorkflowSchedule, name string, completed *time.Time, succeeded bool) *openchoreov1alpha1.WorkflowRun {
+	t.Helper()
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			Labels:    map[string]string{labels.LabelKeyWorkflowSchedule: ws.Name},
+		},
+		Spec: openchoreov1alpha1.WorkflowRunSpec{
+			Workflow: openchoreov1alpha1.WorkflowRunConfig{Name: "docker-build"},
+		},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(ws, run, newScheme(t)))
+	if completed != nil {
+		run.Status.CompletedAt = &metav1.Time{Time: *completed}
+		run.Status.Conditions = []metav1.Condition{
+			{Type: string(workflowrun.ConditionWorkflowCompleted), Status: metav1.ConditionTrue, Reason: "Done"},
+			{Type: string(workflowrun.ConditionWorkflowSucceeded), Status: conditionStatus(succeeded), Reason: "Done"},
+		}
+	}
+	return run
+}
+
+func conditionStatus(b bool) metav1.ConditionStatus {
+	if b {
+		return metav1.ConditionTrue
+	}
+	return metav1.ConditionFalse
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	return s
+}
+
+func newReconciler(t *testing.T, now time.Time, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	s := newScheme(t)
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.WorkflowSchedule{}).
+		Build()
+	return &Reconciler{Client: c, Scheme: s, Now: func() time.Time { return now }}, c
+}
+
+func reconcileSchedule(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *openchoreov1alpha1.WorkflowSchedule) {
+	t.Helper()
+	key := types.NamespacedName{Name: "nightly", Namespace: testNamespace}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	got := &openchoreov1alpha1.WorkflowSchedule{}
+	require.NoError(t, c.Get(context.Background(), key, got))
+	return result, got
+}
+
+func listRuns(t *testing.T, c client.Client) []openchoreov1alpha1.WorkflowRun {
+	t.Helper()
+	list := &openchoreov1alpha1.WorkflowRunList{}
+	require.NoError(t, c.List(context.Background(), list, client.InNamespace(testNamespace)))
+	return list.Items
+}
+
+func at(hour, minute int) time.Time {
+	return time.Date(2026, time.March, 2, hour, minute, 0, 0, time.UTC)
+}
+
+func TestReconcileNotDue(t *testing.T) {
+	r, c := newReconciler(t, at(1, 0), newSchedule())
+
+	result, ws := reconcileSchedule(t, r, c)
+
+	assert.Empty(t, listRuns(t, c))
+	assert.Equal(t, time.Hour, result.RequeueAfter)
+	require.NotNil(t, ws.Status.NextScheduleTime)
+	assert.True(t, ws.Status.NextScheduleTime.Equal(&metav1.Time{Time: at(2, 0)}))
+	assert.Nil(t, ws.Status.LastScheduleTime)
+	cond := meta.FindStatusCondition(ws.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonScheduled), cond.Reason)
+}
+
+func TestReconcileCreatesDueRun(t *testing.T) {
+	r, c := newReconciler(t, at(2, 0).Add(30*time.Second), newSchedule())
+
+	result, ws := reconcileSchedule(t, r, c)
+
+	runs := listRuns(t, c)
+	require.Len(t, runs, 1)
+	run := runs[0]
+	assert.Equal(t, runName("nightly", at(2, 0)), run.Name)
+	assert.Equal(t, "nightly", run.Labels[labels.LabelKeyWorkflowSchedule])
+	assert.Equal(t, "api", run.Labels[labels.LabelKeyComponentName])
+	assert.Equal(t, "2026-03-02T02:00:00Z", run.Annotations[labels.AnnotationKeyScheduledTime])
+	assert.Equal(t, "docker-build", run.Spec.Workflow.Name)
+	assert.Equal(t, openchoreov1alpha1.WorkflowRefKindClusterWorkflow, run.Spec.Workflow.Kind)
+	assert.JSONEq(t, `{"branch":"main"}`, string(run.Spec.Workflow.Parameters.Raw))
+	assert.True(t, metav1.IsControlledBy(&run, ws))
+
+	assert.Equal(t, []string{run.Name}, ws.Status.Active)
+	require.NotNil(t, ws.Status.LastScheduleTime)
+	assert.True(t, ws.Status.LastScheduleTime.Equal(&metav1.Time{Time: at(2, 0)}))
+	assert.Equal(t, 24*time.Hour-30*time.Second, result.RequeueAfter)
+
+	// A second reconcile in the same minute does not start the run again.
+	_, _ = reconcileSchedule(t, r, c)
+	assert.Len(t, listRuns(t, c), 1)
+}
+
+func TestReconcileMissedRuns(t *testing.T) {
+	// The controller was unavailable for three days; only the most recent missed run is started.
+	now := time.Date(2026, time.March, 5, 3, 0, 0, 0, time.UTC)
+
+	t.Run("without starting deadline", func(t *testing.T) {
+		r, c := newReconciler(t, now, newSchedule())
+		_, ws := reconcileSchedule(t, r, c)
+
+		runs := listRuns(t, c)
+		require.Len(t, runs, 1)
+		assert.Equal(t, runName("nightly", now.Add(-time.Hour)), runs[0].Name)
+		assert.True(t, ws.Status.LastScheduleTime.Equal(&metav1.Time{Time: now.Add(-time.Hour)}))
+	})
+
+	t.Run("past the starting deadline", func(t *testing.T) {
+		r, c := newReconciler(t, now, newSchedule(func(ws *openchoreov1alpha1.WorkflowSchedule) {
+			ws.Spec.StartingDeadlineSeconds = ptr.To(int64(600))
+		}))
+		_, ws := reconcileSchedule(t, r, c)
+
+		assert.Empty(t, listRuns(t, c))
+		assert.Nil(t, ws.Status.LastScheduleTime)
+	})
+}
+
+func TestReconcileConcurrencyPolicy(t *testing.T) {
+	now := at(2, 0)
+
+	t.Run("Forbid skips the run while a run is active", func(t *testing.T) {
+		ws := newSchedule(func(ws *openchoreov1alpha1.WorkflowSchedule) {
+			ws.Spec.ConcurrencyPolicy = openchoreov1alpha1.WorkflowScheduleConcurrencyForbid
+		})
+		r, c := newReconciler(t, now, ws, newRun(t, ws, "nightly-previous", nil, false))
+
+		_, got := reconcileSchedule(t, r, c)
+
+		assert.Len(t, listRuns(t, c), 1)
+		assert.Equal(t, []string{"nightly-previous"}, got.Status.Active)
+		assert.Nil(t, got.Status.LastScheduleTime)
+	})
+
+	t.Run("Replace cancels the active run", func(t *testing.T) {
+		ws := newSchedule(func(ws *openchoreov1alpha1.WorkflowSchedule) {
+			ws.Spec.ConcurrencyPolicy = openchoreov1alpha1.WorkflowScheduleConcurrencyReplace
+		})
+		r, c := newReconciler(t, now, ws, newRun(t, ws, "nightly-previous", nil, false))
+
+		_, got := reconcileSchedule(t, r, c)
+
+		runs := listRuns(t, c)
+		require.Len(t, runs, 2)
+		for _, run := range runs {
+			assert.Equal(t, run.Name == "nightly-previous", run.Spec.Cancel, run.Name)
+		}
+		assert.Equal(t, []string{runName("nightly", now), "nightly-previous"}, got.Status.Active)
+	})
+
+	t.Run("Allow starts the run alongside the active run", func(t *testing.T) {
+		ws := newSchedule()
+		r, c := newReconciler(t, now, ws, newRun(t, ws, "nightly-previous", nil, false))
+
+		_, got := reconcileSchedule(t, r, c)
+
+		assert.Len(t, listRuns(t, c), 2)
+		assert.Len(t, got.Status.Active, 2)
+	})
+}
+
+func TestReconcileHistoryLimits(t *testing.T) {
+	ws := newSchedule(func(ws *openchoreov1alpha1.WorkflowSchedule) {
+		ws.Spec.SuccessfulRunsHistoryLimit = ptr.To(int32(2))
+		ws.Spec.FailedRunsHistoryLimit = ptr.To(int32(0))
+	})
+	day := func(d int) *time.Time {
+		t := time.Date(2026, time.February, d, 2, 10, 0, 0, time.UTC)
+		return &t
+	}
+	r, c := newReconciler(t, at(1, 0), ws,
+		newRun(t, ws, "succeeded-1", day(25), true),
+		newRun(t, ws, "succeeded-2", day(26), true),
+		newRun(t, ws, "succeeded-3", day(27), true),
+		newRun(t, ws, "failed-1", day(28), false),
+	)
+
+	_, got := reconcileSchedule(t, r, c)
+
+	var names []string
+	for _, run := range listRuns(t, c) {
+		names = append(names, run.Name)
+	}
+	assert.ElementsMatch(t, []string{"succeeded-2", "succeeded-3"}, names)
+	require.NotNil(t, got.Status.LastSuccessfulTime)
+	assert.True(t, got.Status.LastSuccessfulTime.Equal(&metav1.Time{Time: *day(27)}))
+	assert.Empty(t, got.Status.Active)
+}
+
+func TestReconcileSuspended(t *testing.T) {
+	r, c := newReconciler(t, at(2, 0), newSchedule(func(ws *openchoreov1alpha1.WorkflowSchedule) {
+		ws.Spec.Suspend = true
+	}))
+
+	result, ws := reconcileSchedule(t, r, c)
+
+	assert.Empty(t, listRuns(t, c))
+	assert.Zero(t, result.RequeueAfter)
+	assert.Nil(t, ws.Status.NextScheduleTime)
+	cond := meta.FindStatusCondition(ws.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonSuspended), cond.Reason)
+}
+
+func TestReconcileInvalidSchedule(t *testing.T) {
+	tests := map[string]func(*openchoreov1alpha1.WorkflowSchedule){
+		"bad cron expression": func(ws *openchoreov1alpha1.WorkflowSchedule) { ws.Spec.Schedule = "0 25 * * *" },
+		"unknown time zone":   func(ws *openchoreov1alpha1.WorkflowSchedule) { ws.Spec.TimeZone = ptr.To("Mars/Olympus") },
+		"time zone in schedule": func(ws *openchoreov1alpha1.WorkflowSchedule) {
+			ws.Spec.Schedule = "CRON_TZ=Asia/Tokyo 0 2 * * *"
+		},
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, c := newReconciler(t, at(2, 0), newSchedule(mutate))
+
+			result, ws := reconcileSchedule(t, r, c)
+
+			assert.Empty(t, listRuns(t, c))
+			assert.Zero(t, result.RequeueAfter)
+			cond := meta.FindStatusCondition(ws.Status.Conditions, string(ConditionReady))
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionFalse, cond.Status)
+			assert.Equal(t, string(ReasonInvalidSchedule), cond.Reason)
+		})
+	}
+}
+
+func TestReconcileTimeZone(t *testing.T) {
+	// 02:00 in Colombo (UTC+05:30) is 20:30 UTC on the previous day.
+	r, c := newReconciler(t, at(1, 0), newSchedule(func(ws *openchoreov1alpha1.WorkflowSchedule) {
+		ws.Spec.TimeZone = ptr.To("Asia/Colombo")
+	}))
+
+	_, ws := reconcileSchedule(t, r, c)
+
+	require.NotNil(t, ws.Status.NextScheduleTime)
+	assert.Equal(t, time.Date(2026, time.March, 2, 20, 30, 0, 0, time.UTC), ws.Status.NextScheduleTime.UTC())
+}
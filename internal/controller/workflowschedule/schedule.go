@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowschedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// parseSchedule parses the cron schedule of a WorkflowSchedule in its time zone.
+func parseSchedule(spec *openchoreov1alpha1.WorkflowScheduleSpec) (cron.Schedule, error) {
+	// The time zone is set with spec.timeZone; a prefix in the schedule would silently override it.
+	if strings.Contains(spec.Schedule, "TZ=") {
+		return nil, errors.New("the schedule must not set a time zone, use spec.timeZone instead")
+	}
+	expr := spec.Schedule
+	if spec.TimeZone != nil && *spec.TimeZone != "" {
+		if _, err := time.LoadLocation(*spec.TimeZone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", *spec.TimeZone)
+		}
+		expr = "CRON_TZ=" + *spec.TimeZone + " " + expr
+	}
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec.Schedule, err)
+	}
+	return schedule, nil
+}
+
+// mostRecentScheduleTime returns the latest time the schedule was due after earliest and
+// not after now, or nil when no run is due. Instead of iterating over every due time since
+// earliest, which is slow for a schedule that missed many runs, e.g. after a long outage,
+// the search starts a few intervals before now and looks further back only when needed.
+func mostRecentScheduleTime(schedule cron.Schedule, earliest, now time.Time) (*time.Time, error) {
+	t1 := schedule.Next(earliest)
+	if t1.IsZero() || t1.After(now) {
+		return nil, nil
+	}
+	t2 := schedule.Next(t1)
+	if t2.IsZero() || t2.After(now) {
+		return &t1, nil
+	}
+	if t2.Sub(t1) < time.Second {
+		return nil, errors.New("the schedule runs more often than once per second")
+	}
+	// "@every" schedules are due at fixed intervals from earliest rather than at fixed clock times.
+	if every, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		mostRecent := t1.Add(now.Sub(t1) / every.Delay * every.Delay)
+		return &mostRecent, nil
+	}
+
+	for window := 2 * t2.Sub(t1); ; window *= 2 {
+		start := now.Add(-window)
+		if !start.After(earliest) {
+			start = earliest
+		}
+		var mostRecent *time.Time
+		for t := schedule.Next(start); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+			mostRecent = &t
+		}
+		// The search from earliest always finds t1, so the loop ends.
+		if mostRecent != nil {
+			return mostRecent, nil
+		}
+	}
+}
+
+// earliestScheduleTime returns the time after which due runs are considered: the last
+// scheduled run, or the creation of the schedule, bounded by the starting deadline.
+func earliestScheduleTime(ws *openchoreov1alpha1.WorkflowSchedule, now time.Time) time.Time {
+	earliest := ws.CreationTimestamp.Time
+	if ws.Status.LastScheduleTime != nil {
+		earliest = ws.Status.LastScheduleTime.Time
+	}
+	if ws.Spec.StartingDeadlineSeconds != nil {
+		deadline := now.Add(-time.Duration(*ws.Spec.StartingDeadlineSeconds) * time.Second)
+		if deadline.After(earliest) {
+			earliest = deadline
+		}
+	}
+	return earliest
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMostRecentScheduleTime(t *testing.T) {
+	date := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.March, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		schedule string
+		earliest time.Time
+		now      time.Time
+		want     *time.Time
+	}{
+		{"not due", "0 2 * * *", date(2, 2, 0), date(2, 12, 0), nil},
+		{"one due", "0 2 * * *", date(2, 0, 0), date(2, 3, 0), ptrTime(date(2, 2, 0))},
+		{"due exactly now", "*/15 * * * *", date(2, 0, 0), date(2, 0, 15), ptrTime(date(2, 0, 15))},
+		{"many missed", "*/5 * * * *", date(1, 0, 0), date(30, 7, 42), ptrTime(date(30, 7, 40))},
+		// Mon 2 March to Sun 8 March: the most recent weekday run is Friday the 6th.
+		{"irregular interval", "0 9 * * 1-5", date(1, 0, 0), date(8, 18, 0), ptrTime(date(6, 9, 0))},
+		{"every descriptor", "@every 2h", date(2, 0, 0), date(2, 5, 0), ptrTime(date(2, 4, 0))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := cron.ParseStandard(tt.schedule)
+			require.NoError(t, err)
+			got, err := mostRecentScheduleTime(sched, tt.earliest, tt.now)
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, *tt.want, got.UTC())
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
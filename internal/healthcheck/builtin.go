@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+// routeParentsDegraded matches a Gateway API route that a parent rejected or
+// whose backend references could not be resolved.
+const routeParentsDegraded = `has(object.status) && has(object.status.parents) &&
+	object.status.parents.exists(p, has(p.conditions) &&
+		p.conditions.exists(c, c.type in ['Accepted', 'ResolvedRefs'] && c.status == 'False'))`
+
+// routeParentsAccepted matches a Gateway API route that every parent accepted.
+const routeParentsAccepted = `has(object.status) && has(object.status.parents) && size(object.status.parents) > 0 &&
+	object.status.parents.all(p, has(p.conditions) &&
+		p.conditions.exists(c, c.type == 'Accepted' && c.status == 'True'))`
+
+var routeHealth = openchoreov1alpha1.ClusterHealthCheckSpec{
+	Rules: []openchoreov1alpha1.HealthCheckRule{
+		{Status: openchoreov1alpha1.HealthStatusDegraded, Expression: routeParentsDegraded},
+		{Status: openchoreov1alpha1.HealthStatusHealthy, Expression: routeParentsAccepted},
+	},
+}
+
+// builtinSpecs are the health checks for kinds without a hand-written health
+// function. ClusterHealthCheck resources take precedence over these.
+var builtinSpecs = map[schema.GroupKind]openchoreov1alpha1.ClusterHealthCheckSpec{
+	{Group: "batch", Kind: "Job"}: {
+		Rules: []openchoreov1alpha1.HealthCheckRule{
+			{
+				Status:     openchoreov1alpha1.HealthStatusDegraded,
+				Expression: `conditions.exists(c, c.type == 'Failed' && c.status == 'True')`,
+			},
+			{
+				Status:     openchoreov1alpha1.HealthStatusHealthy,
+				Expression: `conditions.exists(c, c.type == 'Complete' && c.status == 'True')`,
+			},
+			{
+				Status:     openchoreov1alpha1.HealthStatusSuspended,
+				Expression: `has(object.spec.suspend) && object.spec.suspend == true`,
+			},
+		},
+	},
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}: {
+		Rules: []openchoreov1alpha1.HealthCheckRule{
+			{
+				// The scale target was scaled to zero, which disables autoscaling.
+				Status:     openchoreov1alpha1.HealthStatusSuspended,
+				Expression: `conditions.exists(c, c.type == 'ScalingActive' && c.status == 'False' && c.reason == 'ScalingDisabled')`,
+			},
+			{
+				Status:     openchoreov1alpha1.HealthStatusDegraded,
+				Expression: `conditions.exists(c, c.type in ['AbleToScale', 'ScalingActive'] && c.status == 'False')`,
+			},
+			{
+				Status:     openchoreov1alpha1.HealthStatusHealthy,
+				Expression: `conditions.exists(c, c.type == 'AbleToScale' && c.status == 'True')`,
+			},
+		},
+	},
+	{Group: gatewayAPIGroup, Kind: "Gateway"}: {
+		Rules: []openchoreov1alpha1.HealthCheckRule{
+			{
+				Status:     openchoreov1alpha1.HealthStatusHealthy,
+				Expression: `conditions.exists(c, c.type == 'Programmed' && c.status == 'True')`,
+			},
+			{
+				// Pending means the implementation has not processed the Gateway yet.
+				Status:     openchoreov1alpha1.HealthStatusDegraded,
+				Expression: `conditions.exists(c, c.type in ['Accepted', 'Programmed'] && c.status == 'False' && c.reason != 'Pending')`,
+			},
+		},
+	},
+	{Group: gatewayAPIGroup, Kind: "HTTPRoute"}: routeHealth,
+	{Group: gatewayAPIGroup, Kind: "GRPCRoute"}: routeHealth,
+	{Group: gatewayAPIGroup, Kind: "TLSRoute"}:  routeHealth,
+	{Group: gatewayAPIGroup, Kind: "TCPRoute"}:  routeHealth,
+}
+
+var builtins = mustCompileBuiltins()
+
+func mustCompileBuiltins() map[schema.GroupKind]*Check {
+	checks := make(map[schema.GroupKind]*Check, len(builtinSpecs))
+	for gk, spec := range builtinSpecs {
+		check, err := Compile(spec)
+		if err != nil {
+			panic(fmt.Sprintf("healthcheck: built-in check for %s does not compile: %v", gk, err))
+		}
+		checks[gk] = check
+	}
+	return checks
+}
+
+// Builtin returns the built-in check for the given kind, or nil when there is none.
+func Builtin(gk schema.GroupKind) *Check {
+	return builtins[gk]
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func withReason(cond map[string]any, reason string) map[string]any {
+	cond["reason"] = reason
+	return cond
+}
+
+func TestBuiltins(t *testing.T) {
+	job := schema.GroupKind{Group: "batch", Kind: "Job"}
+	hpa := schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}
+	gateway := schema.GroupKind{Group: gatewayAPIGroup, Kind: "Gateway"}
+	httpRoute := schema.GroupKind{Group: gatewayAPIGroup, Kind: "HTTPRoute"}
+
+	routeWithParents := func(parents ...[]any) map[string]any {
+		ps := make([]any, 0, len(parents))
+		for _, conds := range parents {
+			ps = append(ps, map[string]any{"conditions": conds})
+		}
+		return map[string]any{"spec": map[string]any{}, "status": map[string]any{"parents": ps}}
+	}
+	withConditions := func(conds ...any) map[string]any {
+		return map[string]any{"spec": map[string]any{}, "status": map[string]any{"conditions": conds}}
+	}
+
+	tests := []struct {
+		name string
+		gk   schema.GroupKind
+		obj  map[string]any
+		want openchoreov1alpha1.HealthStatus
+	}{
+		{name: "job running", gk: job, obj: map[string]any{"spec": map[string]any{}}, want: openchoreov1alpha1.HealthStatusProgressing},
+		{name: "job complete", gk: job, obj: withConditions(condition("Complete", "True")), want: openchoreov1alpha1.HealthStatusHealthy},
+		{name: "job failed", gk: job, obj: withConditions(condition("Failed", "True")), want: openchoreov1alpha1.HealthStatusDegraded},
+		{name: "job suspended", gk: job, obj: map[string]any{"spec": map[string]any{"suspend": true}}, want: openchoreov1alpha1.HealthStatusSuspended},
+		{
+			name: "hpa scaling",
+			gk:   hpa,
+			obj:  withConditions(condition("AbleToScale", "True"), condition("ScalingActive", "True")),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "hpa missing metrics",
+			gk:   hpa,
+			obj:  withConditions(condition("AbleToScale", "True"), withReason(condition("ScalingActive", "False"), "FailedGetResourceMetric")),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "hpa target scaled to zero",
+			gk:   hpa,
+			obj:  withConditions(condition("AbleToScale", "True"), withReason(condition("ScalingActive", "False"), "ScalingDisabled")),
+			want: openchoreov1alpha1.HealthStatusSuspended,
+		},
+		{name: "gateway programmed", gk: gateway, obj: withConditions(condition("Accepted", "True"), condition("Programmed", "True")), want: openchoreov1alpha1.HealthStatusHealthy},
+		{name: "gateway pending", gk: gateway, obj: withConditions(withReason(condition("Programmed", "False"), "Pending")), want: openchoreov1alpha1.HealthStatusProgressing},
+		{name: "gateway invalid", gk: gateway, obj: withConditions(withReason(condition("Programmed", "False"), "Invalid")), want: openchoreov1alpha1.HealthStatusDegraded},
+		{name: "route without status", gk: httpRoute, obj: map[string]any{"spec": map[string]any{}}, want: openchoreov1alpha1.HealthStatusProgressing},
+		{
+			name: "route accepted by all parents",
+			gk:   httpRoute,
+			obj:  routeWithParents([]any{condition("Accepted", "True"), condition("ResolvedRefs", "True")}),
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "route with unresolved backend",
+			gk:   httpRoute,
+			obj: routeWithParents(
+				[]any{condition("Accepted", "True"), condition("ResolvedRefs", "True")},
+				[]any{condition("Accepted", "True"), condition("ResolvedRefs", "False")},
+			),
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := Builtin(tt.gk)
+			require.NotNil(t, check)
+			got, err := check.Evaluate(newObject(tt.obj))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Nil(t, Builtin(schema.GroupKind{Group: "apps", Kind: "Deployment"}))
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package healthcheck evaluates CEL-defined health checks against live resources.
+// Checks come from ClusterHealthCheck resources and from the built-in definitions
+// for common kinds that have no hand-written health function.
+package healthcheck
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+const (
+	// varObject is the live resource as a map.
+	varObject = "object"
+	// varConditions is object.status.conditions, or an empty list when absent.
+	varConditions = "conditions"
+)
+
+var (
+	celEnv     *cel.Env
+	celEnvOnce sync.Once
+	celEnvErr  error
+)
+
+// getCELEnv returns the CEL environment health check expressions are compiled in.
+func getCELEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		opts := append(template.BaseCELExtensions(),
+			cel.Variable(varObject, cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable(varConditions, cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		)
+		celEnv, celEnvErr = cel.NewEnv(opts...)
+	})
+	return celEnv, celEnvErr
+}
+
+// Check is a compiled health check.
+type Check struct {
+	rules         []rule
+	defaultStatus openchoreov1alpha1.HealthStatus
+}
+
+type rule struct {
+	status     openchoreov1alpha1.HealthStatus
+	expression string
+	program    cel.Program
+}
+
+// Compile compiles the rules of a health check spec. The returned error names
+// the first rule that does not compile or does not evaluate to a boolean.
+func Compile(spec openchoreov1alpha1.ClusterHealthCheckSpec) (*Check, error) {
+	env, err := getCELEnv()
+	if err != nil {
+		return nil, fmt.Errorf("CEL environment unavailable: %w", err)
+	}
+
+	check := &Check{
+		rules:         make([]rule, 0, len(spec.Rules)),
+		defaultStatus: spec.DefaultStatus,
+	}
+	if check.defaultStatus == "" {
+		check.defaultStatus = openchoreov1alpha1.HealthStatusProgressing
+	}
+
+	for i, r := range spec.Rules {
+		ast, issues := env.Compile(r.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("rules[%d]: compile error: %w", i, issues.Err())
+		}
+		if out := ast.OutputType(); !out.IsExactType(cel.BoolType) && !out.IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("rules[%d]: expression must return bool, got %s", i, out)
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("rules[%d]: program construction error: %w", i, err)
+		}
+		check.rules = append(check.rules, rule{status: r.Status, expression: r.Expression, program: prg})
+	}
+	return check, nil
+}
+
+// Evaluate returns the status of the first rule that matches obj, or the
+// default status when none does. An expression that fails to evaluate or does
+// not yield a boolean is an error, so broken checks surface as Unknown health
+// rather than silently falling through.
+func (c *Check) Evaluate(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || conditions == nil {
+		conditions = []any{}
+	}
+	vars := map[string]any{
+		varObject:     obj.Object,
+		varConditions: conditions,
+	}
+
+	for _, r := range c.rules {
+		out, _, err := r.program.Eval(vars)
+		if err != nil {
+			return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("evaluate %q: %w", r.expression, err)
+		}
+		matched, ok := out.Value().(bool)
+		if !ok {
+			return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("evaluate %q: expected bool, got %T", r.expression, out.Value())
+		}
+		if matched {
+			return r.status, nil
+		}
+	}
+	return c.defaultStatus, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newObject(obj map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func condition(condType, status string) map[string]any {
+	return map[string]any{"type": condType, "status": status}
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{name: "boolean expression", expression: "conditions.exists(c, c.type == 'Ready')"},
+		{name: "dynamic expression", expression: "object.spec.paused"},
+		{name: "syntax error", expression: "object.spec.(", wantErr: "rules[0]: compile error"},
+		{name: "non-boolean result", expression: "size(conditions)", wantErr: "rules[0]: expression must return bool"},
+		{name: "undeclared variable", expression: "resource.spec.paused", wantErr: "rules[0]: compile error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(openchoreov1alpha1.ClusterHealthCheckSpec{
+				Rules: []openchoreov1alpha1.HealthCheckRule{
+					{Status: openchoreov1alpha1.HealthStatusHealthy, Expression: tt.expression},
+				},
+			})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCheckEvaluate(t *testing.T) {
+	check, err := Compile(openchoreov1alpha1.ClusterHealthCheckSpec{
+		Rules: []openchoreov1alpha1.HealthCheckRule{
+			{Status: openchoreov1alpha1.HealthStatusSuspended, Expression: "has(object.spec.paused) && object.spec.paused"},
+			{Status: openchoreov1alpha1.HealthStatusHealthy, Expression: "conditions.exists(c, c.type == 'Ready' && c.status == 'True')"},
+			{Status: openchoreov1alpha1.HealthStatusDegraded, Expression: "conditions.exists(c, c.type == 'Ready' && c.status == 'False')"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		obj     map[string]any
+		want    openchoreov1alpha1.HealthStatus
+		wantErr bool
+	}{
+		{
+			name: "no status falls back to the default",
+			obj:  map[string]any{"spec": map[string]any{}},
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "first matching rule wins",
+			obj: map[string]any{
+				"spec":   map[string]any{"paused": true},
+				"status": map[string]any{"conditions": []any{condition("Ready", "True")}},
+			},
+			want: openchoreov1alpha1.HealthStatusSuspended,
+		},
+		{
+			name: "ready",
+			obj: map[string]any{
+				"spec":   map[string]any{},
+				"status": map[string]any{"conditions": []any{condition("Ready", "True")}},
+			},
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "not ready",
+			obj: map[string]any{
+				"spec":   map[string]any{},
+				"status": map[string]any{"conditions": []any{condition("Ready", "False")}},
+			},
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name:    "evaluation error reports Unknown",
+			obj:     map[string]any{},
+			want:    openchoreov1alpha1.HealthStatusUnknown,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := check.Evaluate(newObject(tt.obj))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	gk := schema.GroupKind{Group: "postgresql.cnpg.io", Kind: "Cluster"}
+	spec := func(status openchoreov1alpha1.HealthStatus) openchoreov1alpha1.ClusterHealthCheckSpec {
+		return openchoreov1alpha1.ClusterHealthCheckSpec{
+			Target: openchoreov1alpha1.HealthCheckTarget{Group: gk.Group, Kind: gk.Kind},
+			Rules: []openchoreov1alpha1.HealthCheckRule{
+				{Status: status, Expression: "true"},
+			},
+		}
+	}
+	evaluate := func(t *testing.T, check *Check) openchoreov1alpha1.HealthStatus {
+		t.Helper()
+		require.NotNil(t, check)
+		got, err := check.Evaluate(newObject(map[string]any{}))
+		require.NoError(t, err)
+		return got
+	}
+
+	r := NewRegistry()
+	assert.Nil(t, r.Lookup(gk))
+
+	require.NoError(t, r.Set("b-cnpg", spec(openchoreov1alpha1.HealthStatusDegraded)))
+	assert.Equal(t, openchoreov1alpha1.HealthStatusDegraded, evaluate(t, r.Lookup(gk)))
+	assert.Nil(t, r.Lookup(schema.GroupKind{Kind: "Cluster"}), "group must match")
+
+	// The lexically smallest name wins when several checks target the same kind.
+	require.NoError(t, r.Set("a-cnpg", spec(openchoreov1alpha1.HealthStatusHealthy)))
+	assert.Equal(t, openchoreov1alpha1.HealthStatusHealthy, evaluate(t, r.Lookup(gk)))
+
+	// A broken update removes the previous definition.
+	broken := spec(openchoreov1alpha1.HealthStatusHealthy)
+	broken.Rules[0].Expression = "object.("
+	require.Error(t, r.Set("a-cnpg", broken))
+	assert.Equal(t, openchoreov1alpha1.HealthStatusDegraded, evaluate(t, r.Lookup(gk)))
+
+	r.Delete("b-cnpg")
+	assert.Nil(t, r.Lookup(gk))
+
+	var nilRegistry *Registry
+	assert.Nil(t, nilRegistry.Lookup(gk))
+}
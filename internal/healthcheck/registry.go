@@ -0,0 +1,81 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Registry holds the compiled ClusterHealthCheck resources, keyed by name.
+// It is populated by the ClusterHealthCheck controller and read by the
+// RenderedRelease controller. It is safe for concurrent use.
+type Registry struct {
+	mu     sync.RWMutex
+	checks map[string]entry
+}
+
+type entry struct {
+	target schema.GroupKind
+	check  *Check
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{checks: make(map[string]entry)}
+}
+
+// Set compiles spec and stores it under name, replacing any previous check with
+// that name. When compilation fails the previous check is removed, so a broken
+// update does not leave stale rules in effect.
+func (r *Registry) Set(name string, spec openchoreov1alpha1.ClusterHealthCheckSpec) error {
+	check, err := Compile(spec)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		delete(r.checks, name)
+		return err
+	}
+	r.checks[name] = entry{
+		target: schema.GroupKind{Group: spec.Target.Group, Kind: spec.Target.Kind},
+		check:  check,
+	}
+	return nil
+}
+
+// Delete removes the check stored under name.
+func (r *Registry) Delete(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checks, name)
+}
+
+// Lookup returns the check for the given kind, or nil when none is registered.
+// When several checks target the same kind, the one with the lexically smallest
+// name wins so the choice is stable.
+func (r *Registry) Lookup(gk schema.GroupKind) *Check {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		found     *Check
+		foundName string
+	)
+	for name, e := range r.checks {
+		if e.target != gk {
+			continue
+		}
+		if found == nil || name < foundName {
+			found, foundName = e.check, name
+		}
+	}
+	return found
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package imageverify
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var (
+	// oidIssuer is the Fulcio certificate extension holding the raw OIDC issuer.
+	oidIssuer = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidIssuerV2 is the Fulcio certificate extension holding the DER-encoded OIDC issuer.
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// verifyKeyless verifies a signature made with a short-lived certificate. The transparency log
+// entry proves when the signature was made, which is when the certificate must have been valid.
+func verifyKeyless(keyless *openchoreov1alpha1.KeylessVerification, bundle *Bundle, payload, signature []byte) error {
+	cert, err := parseBundleCertificate(bundle.Cert)
+	if err != nil {
+		return err
+	}
+
+	signedAt, err := verifyTransparencyLogEntry(keyless.TransparencyLogPublicKeys, bundle)
+	if err != nil {
+		return err
+	}
+
+	roots, intermediates, err := certificatePools(keyless.Roots)
+	if err != nil {
+		return err
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("signing certificate is not trusted: %w", err)
+	}
+
+	if err := matchIdentity(keyless.Identities, cert); err != nil {
+		return err
+	}
+	if err := verifySignature(cert.PublicKey, payload, signature); err != nil {
+		return fmt.Errorf("signature does not match the signing certificate: %w", err)
+	}
+	return nil
+}
+
+func parseBundleCertificate(encoded string) (*x509.Certificate, error) {
+	data := []byte(encoded)
+	if !bytes.HasPrefix(data, []byte("-----BEGIN")) {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signing certificate: %w", err)
+		}
+		data = decoded
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("signing certificate has no PEM data")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// verifyTransparencyLogEntry verifies the signed entry timestamp of the bundle against the
+// trusted transparency log keys, checks that the entry is for the bundle's signature, and
+// returns the time the entry was integrated into the log.
+func verifyTransparencyLogEntry(logKeys []string, bundle *Bundle) (time.Time, error) {
+	if bundle.RekorBundle == nil {
+		return time.Time{}, errors.New("signature has no transparency log entry")
+	}
+	canonical, err := json.Marshal(bundle.RekorBundle.Payload)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to encode transparency log entry: %w", err)
+	}
+
+	verified := false
+	for _, key := range logKeys {
+		pub, err := parsePublicKey([]byte(key))
+		if err != nil {
+			continue
+		}
+		if verifySignature(pub, canonical, bundle.RekorBundle.SignedEntryTimestamp) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return time.Time{}, errors.New("transparency log entry is not signed by a trusted log")
+	}
+
+	body, err := base64.StdEncoding.DecodeString(bundle.RekorBundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to decode transparency log entry body: %w", err)
+	}
+	var entry struct {
+		Spec struct {
+			Signature struct {
+				Content string `json:"content"`
+			} `json:"signature"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse transparency log entry body: %w", err)
+	}
+	if entry.Spec.Signature.Content != bundle.Base64Signature {
+		return time.Time{}, errors.New("transparency log entry is for a different signature")
+	}
+	return time.Unix(bundle.RekorBundle.Payload.IntegratedTime, 0), nil
+}
+
+// certificatePools splits PEM certificates into self-signed roots and intermediates.
+func certificatePools(data string) (roots, intermediates *x509.CertPool, err error) {
+	roots, intermediates = x509.NewCertPool(), x509.NewCertPool()
+	rest := []byte(data)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse root certificate: %w", err)
+		}
+		found = true
+		if bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil {
+			roots.AddCert(cert)
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+	if !found {
+		return nil, nil, errors.New("no root certificates are configured")
+	}
+	return roots, intermediates, nil
+}
+
+func matchIdentity(identities []openchoreov1alpha1.KeylessIdentity, cert *x509.Certificate) error {
+	issuer := certificateIssuer(cert)
+	subjects := append([]string{}, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjects = append(subjects, uri.String())
+	}
+
+	for _, identity := range identities {
+		if identity.Issuer != issuer {
+			continue
+		}
+		for _, subject := range subjects {
+			if identity.Subject != "" && identity.Subject == subject {
+				return nil
+			}
+			if identity.SubjectRegExp != "" {
+				re, err := regexp.Compile("^(?:" + identity.SubjectRegExp + ")$")
+				if err != nil {
+					return fmt.Errorf("invalid subject regular expression %q: %w", identity.SubjectRegExp, err)
+				}
+				if re.MatchString(subject) {
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("signer %v issued by %q is not a trusted identity", subjects, issuer)
+}
+
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &issuer, "utf8"); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidIssuer):
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
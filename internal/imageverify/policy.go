@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package imageverify
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// PoliciesForEnvironment returns the image verification policies that apply to an environment:
+// the policy of the environment itself and every ClusterImageVerificationPolicy selecting it.
+func PoliciesForEnvironment(ctx context.Context, c client.Reader, env *openchoreov1alpha1.Environment) ([]Policy, error) {
+	var policies []Policy
+	if env.Spec.ProvenancePolicy != nil && env.Spec.ProvenancePolicy.ImageVerification != nil {
+		policies = append(policies, Policy{
+			Source:       fmt.Sprintf("environment %q", env.Name),
+			Verification: *env.Spec.ProvenancePolicy.ImageVerification,
+		})
+	}
+
+	var clusterPolicies openchoreov1alpha1.ClusterImageVerificationPolicyList
+	if err := c.List(ctx, &clusterPolicies); err != nil {
+		return nil, fmt.Errorf("failed to list cluster image verification policies: %w", err)
+	}
+	for _, policy := range clusterPolicies.Items {
+		selected, err := selectsEnvironment(policy.Spec.EnvironmentSelector, env)
+		if err != nil {
+			return nil, fmt.Errorf("invalid environment selector in ClusterImageVerificationPolicy %q: %w", policy.Name, err)
+		}
+		if selected {
+			policies = append(policies, Policy{
+				Source:       fmt.Sprintf("ClusterImageVerificationPolicy %q", policy.Name),
+				Verification: policy.Spec.Verification,
+			})
+		}
+	}
+	return policies, nil
+}
+
+func selectsEnvironment(selector *metav1.LabelSelector, env *openchoreov1alpha1.Environment) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+	return s.Matches(labels.Set(env.Labels)), nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package imageverify verifies cosign image signatures offline. The signature material is read
+// from the cosign signature bundle recorded in the build provenance of a workload, and checked
+// against the public keys or keyless identities configured by an image verification policy.
+package imageverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/registry"
+)
+
+// simpleSigningType is the critical type of the payload cosign signs for container images.
+const simpleSigningType = "cosign container image signature"
+
+// Policy is an image verification policy that applies to an environment.
+type Policy struct {
+	// Source describes where the policy is defined, for error messages.
+	Source       string
+	Verification openchoreov1alpha1.ImageVerification
+}
+
+// Bundle is a cosign signature bundle of a container image.
+type Bundle struct {
+	// Base64Signature is the base64-encoded signature of the payload.
+	Base64Signature string `json:"base64Signature"`
+	// Payload is the base64-encoded simple signing payload that was signed.
+	Payload string `json:"payload"`
+	// Cert is the base64-encoded PEM signing certificate of a keyless signature.
+	Cert string `json:"cert,omitempty"`
+	// RekorBundle is the transparency log entry of the signature.
+	RekorBundle *RekorBundle `json:"rekorBundle,omitempty"`
+}
+
+// RekorBundle holds a transparency log entry and its signed entry timestamp.
+type RekorBundle struct {
+	SignedEntryTimestamp []byte       `json:"SignedEntryTimestamp"`
+	Payload              RekorPayload `json:"Payload"`
+}
+
+// RekorPayload is the transparency log entry covered by the signed entry timestamp.
+// The fields are declared in lexical order so that the JSON encoding is canonical.
+type RekorPayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// Verify checks the signature of an image against every policy that matches the image.
+// It returns nil when no policy matches. The image must be pinned to a digest; the digest
+// is never taken from the provenance, as a tag can be moved after the image was signed.
+func Verify(policies []Policy, image string, provenance *openchoreov1alpha1.BuildProvenance) error {
+	repo, _, digest := registry.SplitReference(image)
+
+	var bundle *Bundle
+	for _, policy := range policies {
+		if !matchesImages(policy.Verification.Images, repo) {
+			continue
+		}
+		if bundle == nil {
+			var err error
+			if bundle, err = signatureBundle(provenance); err != nil {
+				return fmt.Errorf("%s: %w", policy.Source, err)
+			}
+			if digest == "" {
+				return fmt.Errorf("%s: image %q must be pinned to a digest to verify its signature", policy.Source, image)
+			}
+		}
+		if err := verifyBundle(&policy.Verification, bundle, repo, digest); err != nil {
+			return fmt.Errorf("%s: image %q: %w", policy.Source, image, err)
+		}
+	}
+	return nil
+}
+
+func matchesImages(patterns []string, repo string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, repo); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// signatureBundle decodes the cosign signature bundle recorded in the build provenance.
+func signatureBundle(provenance *openchoreov1alpha1.BuildProvenance) (*Bundle, error) {
+	if provenance == nil || provenance.Signature == nil {
+		return nil, errors.New("no image signature is recorded in the build provenance")
+	}
+	if provenance.Signature.Format != openchoreov1alpha1.SignatureFormatCosignBundle {
+		return nil, fmt.Errorf("the recorded image signature has format %q, but offline verification requires a %q",
+			provenance.Signature.Format, openchoreov1alpha1.SignatureFormatCosignBundle)
+	}
+	raw, err := base64.StdEncoding.DecodeString(provenance.Signature.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the signature bundle: %w", err)
+	}
+	bundle := &Bundle{}
+	if err := json.Unmarshal(raw, bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse the signature bundle: %w", err)
+	}
+	return bundle, nil
+}
+
+func verifyBundle(verification *openchoreov1alpha1.ImageVerification, bundle *Bundle, repo, digest string) error {
+	signature, err := base64.StdEncoding.DecodeString(bundle.Base64Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+	payload, err := base64.StdEncoding.DecodeString(bundle.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode signed payload: %w", err)
+	}
+	if err := checkPayload(payload, repo, digest); err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, key := range verification.PublicKeys {
+		pub, err := parsePublicKey([]byte(key))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("public key %d: %w", i, err))
+			continue
+		}
+		if err := verifySignature(pub, payload, signature); err == nil {
+			return nil
+		}
+	}
+	if len(verification.PublicKeys) > 0 {
+		errs = append(errs, errors.New("signature does not match any trusted public key"))
+	}
+
+	if verification.Keyless != nil {
+		if bundle.Cert == "" {
+			errs = append(errs, errors.New("signature has no certificate for keyless verification"))
+		} else if err := verifyKeyless(verification.Keyless, bundle, payload, signature); err != nil {
+			errs = append(errs, err)
+		} else {
+			return nil
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkPayload checks that the signed payload is a signature of the image digest.
+func checkPayload(payload []byte, repo, digest string) error {
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed to parse signed payload: %w", err)
+	}
+	if p.Critical.Type != simpleSigningType {
+		return fmt.Errorf("signed payload has unexpected type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for digest %q, not %q", p.Critical.Image.DockerManifestDigest, digest)
+	}
+	if signedRepo, _, _ := registry.SplitReference(p.Critical.Identity.DockerReference); signedRepo != repo {
+		return fmt.Errorf("signature is for repository %q, not %q", signedRepo, repo)
+	}
+	return nil
+}
+
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// verifySignature verifies a signature over the SHA-256 digest of the data,
+// or over the data itself for Ed25519 keys.
+func verifySignature(pub crypto.PublicKey, data, signature []byte) error {
+	digest := sha256.Sum256(data)
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return errors.New("invalid Ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", pub)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package imageverify
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	testRepo   = "registry.example.com/acme/app"
+	testDigest = "sha256:0123abcd"
+	testImage  = testRepo + "@" + testDigest
+	testIssuer = "https://token.actions.githubusercontent.com"
+	testSigner = "https://github.com/acme/app/.github/workflows/build.yaml@refs/heads/main"
+)
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+func publicKeyPEM(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	t.Helper()
+	digest := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	return sig
+}
+
+func signedPayload(repo, digest string) []byte {
+	return []byte(`{"critical":{"identity":{"docker-reference":"` + repo + `"},"image":{"docker-manifest-digest":"` +
+		digest + `"},"type":"cosign container image signature"},"optional":null}`)
+}
+
+func newBundle(t *testing.T, key *ecdsa.PrivateKey, payload []byte) *Bundle {
+	t.Helper()
+	return &Bundle{
+		Base64Signature: base64.StdEncoding.EncodeToString(sign(t, key, payload)),
+		Payload:         base64.StdEncoding.EncodeToString(payload),
+	}
+}
+
+func provenanceFor(t *testing.T, bundle *Bundle) *openchoreov1alpha1.BuildProvenance {
+	t.Helper()
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatalf("failed to marshal bundle: %v", err)
+	}
+	return &openchoreov1alpha1.BuildProvenance{
+		ImageDigest: testDigest,
+		Signature: &openchoreov1alpha1.ArtifactReference{
+			Reference: base64.StdEncoding.EncodeToString(raw),
+			Format:    openchoreov1alpha1.SignatureFormatCosignBundle,
+		},
+	}
+}
+
+func keyPolicy(keys ...string) []Policy {
+	return []Policy{{Source: "test policy", Verification: openchoreov1alpha1.ImageVerification{PublicKeys: keys}}}
+}
+
+func TestVerifyPublicKey(t *testing.T) {
+	key := newKey(t)
+	provenance := provenanceFor(t, newBundle(t, key, signedPayload(testRepo, testDigest)))
+
+	if err := Verify(keyPolicy(publicKeyPEM(t, newKey(t)), publicKeyPEM(t, key)), testImage, provenance); err != nil {
+		t.Errorf("expected signature to verify, got %v", err)
+	}
+	// A tagged image is not verified against the digest recorded in the provenance.
+	if err := Verify(keyPolicy(publicKeyPEM(t, key)), testRepo+":v1", provenance); err == nil ||
+		!strings.Contains(err.Error(), "must be pinned to a digest") {
+		t.Errorf("expected tagged image to be rejected, got %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		image   string
+		key     string
+		wantErr string
+	}{
+		{"untrusted key", testImage, publicKeyPEM(t, newKey(t)), "does not match any trusted public key"},
+		{"other digest", testRepo + "@sha256:ffff", publicKeyPEM(t, key), "not \"sha256:ffff\""},
+		{"other repository", "registry.example.com/acme/other@" + testDigest, publicKeyPEM(t, key), "not \"registry.example.com/acme/other\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(keyPolicy(tt.key), tt.image, provenance)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Verify() error = %v, want error containing %q", err, tt.wantErr)
+			}
+			if err != nil && !strings.HasPrefix(err.Error(), "test policy: ") {
+				t.Errorf("expected error to name the policy, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyWithoutSignature(t *testing.T) {
+	policies := keyPolicy(publicKeyPEM(t, newKey(t)))
+
+	if err := Verify(policies, testImage, nil); err == nil || !strings.Contains(err.Error(), "no image signature") {
+		t.Errorf("expected missing signature error, got %v", err)
+	}
+
+	provenance := &openchoreov1alpha1.BuildProvenance{
+		Signature: &openchoreov1alpha1.ArtifactReference{Reference: testRepo + ":sha256-0123abcd.sig"},
+	}
+	if err := Verify(policies, testImage, provenance); err == nil || !strings.Contains(err.Error(), "offline verification") {
+		t.Errorf("expected unsupported signature format error, got %v", err)
+	}
+}
+
+func TestVerifySkipsUnmatchedImages(t *testing.T) {
+	policies := []Policy{{Source: "test policy", Verification: openchoreov1alpha1.ImageVerification{
+		Images:     []string{"registry.example.com/other/*"},
+		PublicKeys: []string{publicKeyPEM(t, newKey(t))},
+	}}}
+	if err := Verify(policies, testImage, nil); err != nil {
+		t.Errorf("expected images outside the policy to pass, got %v", err)
+	}
+
+	policies[0].Verification.Images = []string{"registry.example.com/acme/*"}
+	if err := Verify(policies, testImage, nil); err == nil {
+		t.Error("expected images matching the policy to be verified")
+	}
+}
+
+// keylessFixture is a CA, a short-lived signing certificate issued by it and a transparency log key.
+type keylessFixture struct {
+	rootPEM   string
+	logKey    *ecdsa.PrivateKey
+	signKey   *ecdsa.PrivateKey
+	certPEM   []byte
+	notBefore time.Time
+}
+
+func newKeylessFixture(t *testing.T, subject string) *keylessFixture {
+	t.Helper()
+	caKey := newKey(t)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test root"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+	ca, _ := x509.ParseCertificate(caDER)
+
+	issuerValue, _ := asn1.MarshalWithParams(testIssuer, "utf8")
+	subjectURI, _ := url.Parse(subject)
+	notBefore := time.Now().Add(-time.Hour).Truncate(time.Second)
+	signKey := newKey(t)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notBefore.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:            []*url.URL{subjectURI},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuerValue}},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, &signKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create signing certificate: %v", err)
+	}
+
+	return &keylessFixture{
+		rootPEM:   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})),
+		logKey:    newKey(t),
+		signKey:   signKey,
+		certPEM:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}),
+		notBefore: notBefore,
+	}
+}
+
+// bundle signs the image payload and records it in the transparency log at signedAt.
+func (f *keylessFixture) bundle(t *testing.T, signedAt time.Time) *Bundle {
+	t.Helper()
+	bundle := newBundle(t, f.signKey, signedPayload(testRepo, testDigest))
+	bundle.Cert = base64.StdEncoding.EncodeToString(f.certPEM)
+
+	body := `{"kind":"hashedrekord","spec":{"signature":{"content":"` + bundle.Base64Signature + `"}}}`
+	entry := RekorPayload{
+		Body:           base64.StdEncoding.EncodeToString([]byte(body)),
+		IntegratedTime: signedAt.Unix(),
+		LogID:          "test-log",
+		LogIndex:       42,
+	}
+	canonical, _ := json.Marshal(entry)
+	bundle.RekorBundle = &RekorBundle{SignedEntryTimestamp: sign(t, f.logKey, canonical), Payload: entry}
+	return bundle
+}
+
+func (f *keylessFixture) policy(t *testing.T, identity openchoreov1alpha1.KeylessIdentity) []Policy {
+	return []Policy{{Source: "test policy", Verification: openchoreov1alpha1.ImageVerification{
+		Keyless: &openchoreov1alpha1.KeylessVerification{
+			Roots:                     f.rootPEM,
+			TransparencyLogPublicKeys: []string{publicKeyPEM(t, f.logKey)},
+			Identities:                []openchoreov1alpha1.KeylessIdentity{identity},
+		},
+	}}}
+}
+
+func TestVerifyKeyless(t *testing.T) {
+	f := newKeylessFixture(t, testSigner)
+	trusted := openchoreov1alpha1.KeylessIdentity{Issuer: testIssuer, Subject: testSigner}
+	signedAt := f.notBefore.Add(time.Minute)
+
+	if err := Verify(f.policy(t, trusted), testImage, provenanceFor(t, f.bundle(t, signedAt))); err != nil {
+		t.Errorf("expected keyless signature to verify, got %v", err)
+	}
+	byRegExp := openchoreov1alpha1.KeylessIdentity{Issuer: testIssuer, SubjectRegExp: `https://github\.com/acme/.*`}
+	if err := Verify(f.policy(t, byRegExp), testImage, provenanceFor(t, f.bundle(t, signedAt))); err != nil {
+		t.Errorf("expected keyless signature to verify by subject pattern, got %v", err)
+	}
+
+	t.Run("untrusted identity", func(t *testing.T) {
+		other := openchoreov1alpha1.KeylessIdentity{Issuer: testIssuer, Subject: "https://github.com/evil/app"}
+		err := Verify(f.policy(t, other), testImage, provenanceFor(t, f.bundle(t, signedAt)))
+		if err == nil || !strings.Contains(err.Error(), "not a trusted identity") {
+			t.Errorf("expected untrusted identity error, got %v", err)
+		}
+	})
+
+	t.Run("signed after the certificate expired", func(t *testing.T) {
+		err := Verify(f.policy(t, trusted), testImage, provenanceFor(t, f.bundle(t, f.notBefore.Add(time.Hour))))
+		if err == nil || !strings.Contains(err.Error(), "certificate is not trusted") {
+			t.Errorf("expected expired certificate error, got %v", err)
+		}
+	})
+
+	t.Run("untrusted transparency log", func(t *testing.T) {
+		bundle := f.bundle(t, signedAt)
+		bundle.RekorBundle.Payload.IntegratedTime++
+		err := Verify(f.policy(t, trusted), testImage, provenanceFor(t, bundle))
+		if err == nil || !strings.Contains(err.Error(), "not signed by a trusted log") {
+			t.Errorf("expected tampered log entry error, got %v", err)
+		}
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		policies := f.policy(t, trusted)
+		policies[0].Verification.Keyless.Roots = newKeylessFixture(t, testSigner).rootPEM
+		err := Verify(policies, testImage, provenanceFor(t, f.bundle(t, signedAt)))
+		if err == nil || !strings.Contains(err.Error(), "certificate is not trusted") {
+			t.Errorf("expected untrusted root error, got %v", err)
+		}
+	})
+}
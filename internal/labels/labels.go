@@ -86,6 +86,46 @@ const (
 	// the controller falls back to the first route match path (the prefix-routing convention).
 	AnnotationKeyEndpointBasePath = "openchoreo.dev/endpoint-base-path"
 
+	// AnnotationKeyApplyWave carries the apply wave of a rendered resource. The RenderedRelease
+	// controller applies lower waves first and waits for them to become healthy.
+	AnnotationKeyApplyWave = "openchoreo.dev/apply-wave"
+
+	// AnnotationKeySyncHook marks a rendered resource as a PreSync or PostSync hook.
+	AnnotationKeySyncHook = "openchoreo.dev/sync-hook"
+
+	// AnnotationKeySyncHookHash records a hash of a hook's desired manifest on the applied object,
+	// so the RenderedRelease controller re-creates the hook only when its content changes.
+	AnnotationKeySyncHookHash = "openchoreo.dev/sync-hook-hash"
+
+	// AnnotationKeyDesiredHash records a hash of a resource's desired manifest on the applied
+	// object. When drift is not auto-corrected, it tells a changed release apart from drift.
+	AnnotationKeyDesiredHash = "openchoreo.dev/desired-hash"
+
+	// AnnotationKeyRetryOf records the name of the WorkflowRun a retried run was created from.
+	AnnotationKeyRetryOf = "openchoreo.dev/retry-of"
+
+	// LabelKeyWorkflowSchedule identifies the WorkflowSchedule that created a WorkflowRun.
+	LabelKeyWorkflowSchedule = "openchoreo.dev/workflow-schedule"
+
+	// AnnotationKeyScheduledTime records the scheduled time a WorkflowRun was created for, in RFC 3339 format.
+	AnnotationKeyScheduledTime = "openchoreo.dev/scheduled-time"
+
+	// LabelKeyWorkflowRunGroup identifies the WorkflowRunGroup that created a WorkflowRun.
+	LabelKeyWorkflowRunGroup = "openchoreo.dev/workflow-run-group"
+
+	// LabelKeyAuthzAccessRequest identifies the AuthzAccessRequest that created an AuthzRoleBinding.
+	LabelKeyAuthzAccessRequest = "openchoreo.dev/authz-access-request"
+
+	// LabelKeySyncName identifies the occ file-system sync that applied a resource.
+	// Pruning only deletes resources that carry the name of the sync doing the pruning.
+	LabelKeySyncName = "openchoreo.dev/sync"
+
+	// AnnotationKeySyncSource records the repository file a synced resource was applied from.
+	AnnotationKeySyncSource = "openchoreo.dev/sync-source"
+
+	// AnnotationKeySyncRevision records the git commit a synced resource was last applied from.
+	AnnotationKeySyncRevision = "openchoreo.dev/sync-revision"
+
 	LabelValueManagedBy = "openchoreo-control-plane"
 	// LabelValueTrue is the standard "true" value for boolean labels
 	LabelValueTrue = "true"
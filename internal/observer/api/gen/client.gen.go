@@ -99,6 +99,11 @@ type ClientInterface interface {
 
 	QueryLogs(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TailLogsWithBody request with any body
+	TailLogsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TailLogs(ctx context.Context, body TailLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryMetricsWithBody request with any body
 	QueryMetricsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -115,10 +120,10 @@ type ClientInterface interface {
 	CreateAlertRule(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteAlertRule request
-	DeleteAlertRule(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteAlertRule(ctx context.Context, sourceType string, ruleName string, params *DeleteAlertRuleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetAlertRule request
-	GetAlertRule(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetAlertRule(ctx context.Context, sourceType string, ruleName string, params *GetAlertRuleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateAlertRuleWithBody request with any body
 	UpdateAlertRuleWithBody(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -146,16 +151,64 @@ type ClientInterface interface {
 
 	UpdateIncident(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListLogMetrics request
+	ListLogMetrics(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLogMetricWithBody request with any body
+	CreateLogMetricWithBody(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateLogMetric(ctx context.Context, namespace string, project string, body CreateLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLogMetric request
+	DeleteLogMetric(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLogMetric request
+	GetLogMetric(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSavedLogQueries request
+	ListSavedLogQueries(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSavedLogQueryWithBody request with any body
+	CreateSavedLogQueryWithBody(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSavedLogQuery(ctx context.Context, namespace string, project string, body CreateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSavedLogQuery request
+	DeleteSavedLogQuery(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSavedLogQuery request
+	GetSavedLogQuery(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSavedLogQueryWithBody request with any body
+	UpdateSavedLogQueryWithBody(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSavedLogQuery(ctx context.Context, namespace string, project string, name string, body UpdateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunSavedLogQueryWithBody request with any body
+	RunSavedLogQueryWithBody(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunSavedLogQuery(ctx context.Context, namespace string, project string, name string, body RunSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryRuntimeTopologyWithBody request with any body
 	QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	QueryRuntimeTopology(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HandleDriftNotificationWithBody request with any body
+	HandleDriftNotificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HandleDriftNotification(ctx context.Context, body HandleDriftNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryTracesWithBody request with any body
 	QueryTracesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	QueryTraces(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// QueryTraceLogsWithBody request with any body
+	QueryTraceLogsWithBody(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryTraceLogs(ctx context.Context, traceId string, body QueryTraceLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QuerySpansForTraceWithBody request with any body
 	QuerySpansForTraceWithBody(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -216,6 +269,30 @@ func (c *Client) QueryLogs(ctx context.Context, body QueryLogsJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
+func (c *Client) TailLogsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTailLogsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TailLogs(ctx context.Context, body TailLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTailLogsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) QueryMetricsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewQueryMetricsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -288,8 +365,8 @@ func (c *Client) CreateAlertRule(ctx context.Context, sourceType string, body Cr
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAlertRule(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAlertRuleRequest(c.Server, sourceType, ruleName)
+func (c *Client) DeleteAlertRule(ctx context.Context, sourceType string, ruleName string, params *DeleteAlertRuleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertRuleRequest(c.Server, sourceType, ruleName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -300,8 +377,8 @@ func (c *Client) DeleteAlertRule(ctx context.Context, sourceType string, ruleNam
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAlertRule(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAlertRuleRequest(c.Server, sourceType, ruleName)
+func (c *Client) GetAlertRule(ctx context.Context, sourceType string, ruleName string, params *GetAlertRuleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertRuleRequest(c.Server, sourceType, ruleName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +509,174 @@ func (c *Client) UpdateIncident(ctx context.Context, incidentId string, body Upd
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListLogMetrics(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLogMetricsRequest(c.Server, namespace, project)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLogMetricWithBody(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLogMetricRequestWithBody(c.Server, namespace, project, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLogMetric(ctx context.Context, namespace string, project string, body CreateLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLogMetricRequest(c.Server, namespace, project, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteLogMetric(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLogMetricRequest(c.Server, namespace, project, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLogMetric(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogMetricRequest(c.Server, namespace, project, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSavedLogQueries(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSavedLogQueriesRequest(c.Server, namespace, project)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSavedLogQueryWithBody(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSavedLogQueryRequestWithBody(c.Server, namespace, project, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSavedLogQuery(ctx context.Context, namespace string, project string, body CreateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSavedLogQueryRequest(c.Server, namespace, project, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSavedLogQuery(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSavedLogQueryRequest(c.Server, namespace, project, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSavedLogQuery(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSavedLogQueryRequest(c.Server, namespace, project, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSavedLogQueryWithBody(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSavedLogQueryRequestWithBody(c.Server, namespace, project, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSavedLogQuery(ctx context.Context, namespace string, project string, name string, body UpdateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSavedLogQueryRequest(c.Server, namespace, project, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunSavedLogQueryWithBody(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunSavedLogQueryRequestWithBody(c.Server, namespace, project, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunSavedLogQuery(ctx context.Context, namespace string, project string, name string, body RunSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunSavedLogQueryRequest(c.Server, namespace, project, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewQueryRuntimeTopologyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -456,6 +701,30 @@ func (c *Client) QueryRuntimeTopology(ctx context.Context, body QueryRuntimeTopo
 	return c.Client.Do(req)
 }
 
+func (c *Client) HandleDriftNotificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHandleDriftNotificationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HandleDriftNotification(ctx context.Context, body HandleDriftNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHandleDriftNotificationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) QueryTracesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewQueryTracesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -480,6 +749,30 @@ func (c *Client) QueryTraces(ctx context.Context, body QueryTracesJSONRequestBod
 	return c.Client.Do(req)
 }
 
+func (c *Client) QueryTraceLogsWithBody(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryTraceLogsRequestWithBody(c.Server, traceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QueryTraceLogs(ctx context.Context, traceId string, body QueryTraceLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryTraceLogsRequest(c.Server, traceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) QuerySpansForTraceWithBody(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewQuerySpansForTraceRequestWithBody(c.Server, traceId, contentType, body)
 	if err != nil {
@@ -608,6 +901,46 @@ func NewQueryLogsRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
+// NewTailLogsRequest calls the generic TailLogs builder with application/json body
+func NewTailLogsRequest(server string, body TailLogsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTailLogsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTailLogsRequestWithBody generates requests for TailLogs with any type of body
+func NewTailLogsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/logs/tail")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewQueryMetricsRequest calls the generic QueryMetrics builder with application/json body
 func NewQueryMetricsRequest(server string, body QueryMetricsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -736,7 +1069,7 @@ func NewCreateAlertRuleRequestWithBody(server string, sourceType string, content
 }
 
 // NewDeleteAlertRuleRequest generates requests for DeleteAlertRule
-func NewDeleteAlertRuleRequest(server string, sourceType string, ruleName string) (*http.Request, error) {
+func NewDeleteAlertRuleRequest(server string, sourceType string, ruleName string, params *DeleteAlertRuleParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -768,8 +1101,30 @@ func NewDeleteAlertRuleRequest(server string, sourceType string, ruleName string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Namespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -777,7 +1132,7 @@ func NewDeleteAlertRuleRequest(server string, sourceType string, ruleName string
 }
 
 // NewGetAlertRuleRequest generates requests for GetAlertRule
-func NewGetAlertRuleRequest(server string, sourceType string, ruleName string) (*http.Request, error) {
+func NewGetAlertRuleRequest(server string, sourceType string, ruleName string, params *GetAlertRuleParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -809,6 +1164,28 @@ func NewGetAlertRuleRequest(server string, sourceType string, ruleName string) (
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Namespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1220,27 +1597,30 @@ func NewUpdateIncidentRequestWithBody(server string, incidentId string, contentT
 	return req, nil
 }
 
-// NewQueryRuntimeTopologyRequest calls the generic QueryRuntimeTopology builder with application/json body
-func NewQueryRuntimeTopologyRequest(server string, body QueryRuntimeTopologyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewListLogMetricsRequest generates requests for ListLogMetrics
+func NewListLogMetricsRequest(server string, namespace string, project string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryRuntimeTopologyRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewQueryRuntimeTopologyRequestWithBody generates requests for QueryRuntimeTopology with any type of body
-func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/runtime-topology")
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/log-metrics", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1250,37 +1630,49 @@ func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, b
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewQueryTracesRequest calls the generic QueryTraces builder with application/json body
-func NewQueryTracesRequest(server string, body QueryTracesJSONRequestBody) (*http.Request, error) {
+// NewCreateLogMetricRequest calls the generic CreateLogMetric builder with application/json body
+func NewCreateLogMetricRequest(server string, namespace string, project string, body CreateLogMetricJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQueryTracesRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateLogMetricRequestWithBody(server, namespace, project, "application/json", bodyReader)
 }
 
-// NewQueryTracesRequestWithBody generates requests for QueryTraces with any type of body
-func NewQueryTracesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateLogMetricRequestWithBody generates requests for CreateLogMetric with any type of body
+func NewCreateLogMetricRequestWithBody(server string, namespace string, project string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/traces/query")
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/log-metrics", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1300,24 +1692,27 @@ func NewQueryTracesRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewQuerySpansForTraceRequest calls the generic QuerySpansForTrace builder with application/json body
-func NewQuerySpansForTraceRequest(server string, traceId string, body QuerySpansForTraceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteLogMetricRequest generates requests for DeleteLogMetric
+func NewDeleteLogMetricRequest(server string, namespace string, project string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQuerySpansForTraceRequestWithBody(server, traceId, "application/json", bodyReader)
-}
 
-// NewQuerySpansForTraceRequestWithBody generates requests for QuerySpansForTrace with any type of body
-func NewQuerySpansForTraceRequestWithBody(server string, traceId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
 
-	var pathParam0 string
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1327,7 +1722,7 @@ func NewQuerySpansForTraceRequestWithBody(server string, traceId string, content
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/query", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/log-metrics/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1337,30 +1732,35 @@ func NewQuerySpansForTraceRequestWithBody(server string, traceId string, content
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetSpanDetailsForTraceRequest generates requests for GetSpanDetailsForTrace
-func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId string) (*http.Request, error) {
+// NewGetLogMetricRequest generates requests for GetLogMetric
+func NewGetLogMetricRequest(server string, namespace string, project string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "spanId", runtime.ParamLocationPath, spanId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1370,7 +1770,7 @@ func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/log-metrics/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1388,16 +1788,30 @@ func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId stri
 	return req, nil
 }
 
-// NewHealthRequest generates requests for Health
-func NewHealthRequest(server string) (*http.Request, error) {
+// NewListSavedLogQueriesRequest generates requests for ListSavedLogQueries
+func NewListSavedLogQueriesRequest(server string, namespace string, project string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/saved-queries", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1415,869 +1829,2604 @@ func NewHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewCreateSavedLogQueryRequest calls the generic CreateSavedLogQuery builder with application/json body
+func NewCreateSavedLogQueryRequest(server string, namespace string, project string, body CreateSavedLogQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSavedLogQueryRequestWithBody(server, namespace, project, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewCreateSavedLogQueryRequestWithBody generates requests for CreateSavedLogQuery with any type of body
+func NewCreateSavedLogQueryRequestWithBody(server string, namespace string, project string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	var pathParam1 string
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// QueryEventsWithBodyWithResponse request with any body
-	QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
-	QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryLogsWithBodyWithResponse request with any body
-	QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/saved-queries", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryMetricsWithBodyWithResponse request with any body
-	QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// QueryAlertsWithBodyWithResponse request with any body
-	QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+	return req, nil
+}
 
-	QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+// NewDeleteSavedLogQueryRequest generates requests for DeleteSavedLogQuery
+func NewDeleteSavedLogQueryRequest(server string, namespace string, project string, name string) (*http.Request, error) {
+	var err error
 
-	// CreateAlertRuleWithBodyWithResponse request with any body
-	CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+	var pathParam0 string
 
-	CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAlertRuleWithResponse request
-	DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error)
+	var pathParam1 string
 
-	// GetAlertRuleWithResponse request
-	GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAlertRuleWithBodyWithResponse request with any body
-	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+	var pathParam2 string
 
-	UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// HandleAlertWebhookWithBodyWithResponse request with any body
-	HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/saved-queries/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetComponentCostsWithResponse request
-	GetComponentCostsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetComponentCostsParams, reqEditors ...RequestEditorFn) (*GetComponentCostsResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetRecommendationsWithResponse request
-	GetRecommendationsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetRecommendationsParams, reqEditors ...RequestEditorFn) (*GetRecommendationsResp, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryIncidentsWithBodyWithResponse request with any body
-	QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+	return req, nil
+}
 
-	QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+// NewGetSavedLogQueryRequest generates requests for GetSavedLogQuery
+func NewGetSavedLogQueryRequest(server string, namespace string, project string, name string) (*http.Request, error) {
+	var err error
 
-	// UpdateIncidentWithBodyWithResponse request with any body
-	UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+	var pathParam0 string
 
-	UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryRuntimeTopologyWithBodyWithResponse request with any body
-	QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+	var pathParam1 string
 
-	QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryTracesWithBodyWithResponse request with any body
-	QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+	var pathParam2 string
 
-	QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// QuerySpansForTraceWithBodyWithResponse request with any body
-	QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/saved-queries/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetSpanDetailsForTraceWithResponse request
-	GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// HealthWithResponse request
-	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type QueryEventsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EventsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-	JSON501      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r QueryEventsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateSavedLogQueryRequest calls the generic UpdateSavedLogQuery builder with application/json body
+func NewUpdateSavedLogQueryRequest(server string, namespace string, project string, name string, body UpdateSavedLogQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSavedLogQueryRequestWithBody(server, namespace, project, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryEventsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateSavedLogQueryRequestWithBody generates requests for UpdateSavedLogQuery with any type of body
+func NewUpdateSavedLogQueryRequestWithBody(server string, namespace string, project string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type QueryLogsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LogsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r QueryLogsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryLogsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryMetricsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetricsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r QueryMetricsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryMetricsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryAlertsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/saved-queries/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r QueryAlertsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryAlertsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AlertingRuleSyncResponse
-	JSON400      *ErrorResponse
-	JSON409      *ErrorResponse
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewRunSavedLogQueryRequest calls the generic RunSavedLogQuery builder with application/json body
+func NewRunSavedLogQueryRequest(server string, namespace string, project string, name string, body RunSavedLogQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewRunSavedLogQueryRequestWithBody(server, namespace, project, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewRunSavedLogQueryRequestWithBody generates requests for RunSavedLogQuery with any type of body
+func NewRunSavedLogQueryRequestWithBody(server string, namespace string, project string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertingRuleSyncResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertRuleResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1alpha1/logs/namespaces/%s/projects/%s/saved-queries/%s/run", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertingRuleSyncResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r UpdateAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	req.Header.Add("Content-Type", contentType)
 
-type HandleAlertWebhookResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertWebhookResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r HandleAlertWebhookResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewQueryRuntimeTopologyRequest calls the generic QueryRuntimeTopology builder with application/json body
+func NewQueryRuntimeTopologyRequest(server string, body QueryRuntimeTopologyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryRuntimeTopologyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HandleAlertWebhookResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewQueryRuntimeTopologyRequestWithBody generates requests for QueryRuntimeTopology with any type of body
+func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetComponentCostsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CostResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/runtime-topology")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetComponentCostsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetComponentCostsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetRecommendationsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RecommendationResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRecommendationsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewHandleDriftNotificationRequest calls the generic HandleDriftNotification builder with application/json body
+func NewHandleDriftNotificationRequest(server string, body HandleDriftNotificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewHandleDriftNotificationRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRecommendationsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewHandleDriftNotificationRequestWithBody generates requests for HandleDriftNotification with any type of body
+func NewHandleDriftNotificationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryIncidentsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IncidentsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1alpha1/notifications/drift")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r QueryIncidentsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryIncidentsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateIncidentResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IncidentPutResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateIncidentResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewQueryTracesRequest calls the generic QueryTraces builder with application/json body
+func NewQueryTracesRequest(server string, body QueryTracesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryTracesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateIncidentResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewQueryTracesRequestWithBody generates requests for QueryTraces with any type of body
+func NewQueryTracesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryRuntimeTopologyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RuntimeTopologyResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r QueryRuntimeTopologyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryRuntimeTopologyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryTracesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TracesQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r QueryTracesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewQueryTraceLogsRequest calls the generic QueryTraceLogs builder with application/json body
+func NewQueryTraceLogsRequest(server string, traceId string, body QueryTraceLogsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryTraceLogsRequestWithBody(server, traceId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryTracesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewQueryTraceLogsRequestWithBody generates requests for QueryTraceLogs with any type of body
+func NewQueryTraceLogsRequestWithBody(server string, traceId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QuerySpansForTraceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TraceSpansQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r QuerySpansForTraceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r QuerySpansForTraceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetSpanDetailsForTraceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TraceSpanDetailsResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetSpanDetailsForTraceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/logs/query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSpanDetailsForTraceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type HealthResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-	JSON503 *struct {
-		Error  *string `json:"error,omitempty"`
-		Status *string `json:"status,omitempty"`
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r HealthResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-// QueryEventsWithBodyWithResponse request with arbitrary body returning *QueryEventsResp
-func (c *ClientWithResponses) QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
-	rsp, err := c.QueryEventsWithBody(ctx, contentType, body, reqEditors...)
+// NewQuerySpansForTraceRequest calls the generic QuerySpansForTrace builder with application/json body
+func NewQuerySpansForTraceRequest(server string, traceId string, body QuerySpansForTraceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryEventsResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewQuerySpansForTraceRequestWithBody(server, traceId, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
-	rsp, err := c.QueryEvents(ctx, body, reqEditors...)
+// NewQuerySpansForTraceRequestWithBody generates requests for QuerySpansForTrace with any type of body
+func NewQuerySpansForTraceRequestWithBody(server string, traceId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryEventsResp(rsp)
-}
 
-// QueryLogsWithBodyWithResponse request with arbitrary body returning *QueryLogsResp
-func (c *ClientWithResponses) QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
-	rsp, err := c.QueryLogsWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryLogsResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
-	rsp, err := c.QueryLogs(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseQueryLogsResp(rsp)
-}
 
-// QueryMetricsWithBodyWithResponse request with arbitrary body returning *QueryMetricsResp
-func (c *ClientWithResponses) QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
-	rsp, err := c.QueryMetricsWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryMetricsResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
-	rsp, err := c.QueryMetrics(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryMetricsResp(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// QueryAlertsWithBodyWithResponse request with arbitrary body returning *QueryAlertsResp
-func (c *ClientWithResponses) QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
-	rsp, err := c.QueryAlertsWithBody(ctx, contentType, body, reqEditors...)
+// NewGetSpanDetailsForTraceRequest generates requests for GetSpanDetailsForTrace
+func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryAlertsResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
-	rsp, err := c.QueryAlerts(ctx, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "spanId", runtime.ParamLocationPath, spanId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryAlertsResp(rsp)
-}
 
-// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResp
-func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
-	rsp, err := c.CreateAlertRuleWithBody(ctx, sourceType, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAlertRuleResp(rsp)
-}
 
-func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
-	rsp, err := c.CreateAlertRule(ctx, sourceType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateAlertRuleResp(rsp)
-}
 
-// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResp
-func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error) {
-	rsp, err := c.DeleteAlertRule(ctx, sourceType, ruleName, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAlertRuleResp(rsp)
-}
 
-// GetAlertRuleWithResponse request returning *GetAlertRuleResp
-func (c *ClientWithResponses) GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error) {
-	rsp, err := c.GetAlertRule(ctx, sourceType, ruleName, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAlertRuleResp(rsp)
+
+	return req, nil
 }
 
-// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResp
-func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
-	rsp, err := c.UpdateAlertRuleWithBody(ctx, sourceType, ruleName, contentType, body, reqEditors...)
+// NewHealthRequest generates requests for Health
+func NewHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAlertRuleResp(rsp)
-}
 
-func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
-	rsp, err := c.UpdateAlertRule(ctx, sourceType, ruleName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseUpdateAlertRuleResp(rsp)
-}
 
-// HandleAlertWebhookWithBodyWithResponse request with arbitrary body returning *HandleAlertWebhookResp
-func (c *ClientWithResponses) HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
-	rsp, err := c.HandleAlertWebhookWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHandleAlertWebhookResp(rsp)
-}
 
-func (c *ClientWithResponses) HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
-	rsp, err := c.HandleAlertWebhook(ctx, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHandleAlertWebhookResp(rsp)
+
+	return req, nil
 }
 
-// GetComponentCostsWithResponse request returning *GetComponentCostsResp
-func (c *ClientWithResponses) GetComponentCostsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetComponentCostsParams, reqEditors ...RequestEditorFn) (*GetComponentCostsResp, error) {
-	rsp, err := c.GetComponentCosts(ctx, namespace, environment, params, reqEditors...)
-	if err != nil {
-		return nil, err
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return ParseGetComponentCostsResp(rsp)
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// GetRecommendationsWithResponse request returning *GetRecommendationsResp
-func (c *ClientWithResponses) GetRecommendationsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetRecommendationsParams, reqEditors ...RequestEditorFn) (*GetRecommendationsResp, error) {
-	rsp, err := c.GetRecommendations(ctx, namespace, environment, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetRecommendationsResp(rsp)
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// QueryIncidentsWithBodyWithResponse request with arbitrary body returning *QueryIncidentsResp
-func (c *ClientWithResponses) QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
-	rsp, err := c.QueryIncidentsWithBody(ctx, contentType, body, reqEditors...)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryIncidentsResp(rsp)
+	return &ClientWithResponses{client}, nil
 }
 
-func (c *ClientWithResponses) QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
-	rsp, err := c.QueryIncidents(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-	return ParseQueryIncidentsResp(rsp)
 }
 
-// UpdateIncidentWithBodyWithResponse request with arbitrary body returning *UpdateIncidentResp
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// QueryEventsWithBodyWithResponse request with any body
+	QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+
+	QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+
+	// QueryLogsWithBodyWithResponse request with any body
+	QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+
+	QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+
+	// TailLogsWithBodyWithResponse request with any body
+	TailLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TailLogsResp, error)
+
+	TailLogsWithResponse(ctx context.Context, body TailLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*TailLogsResp, error)
+
+	// QueryMetricsWithBodyWithResponse request with any body
+	QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+
+	QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+
+	// QueryAlertsWithBodyWithResponse request with any body
+	QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+
+	QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+
+	// CreateAlertRuleWithBodyWithResponse request with any body
+	CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+
+	CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+
+	// DeleteAlertRuleWithResponse request
+	DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, params *DeleteAlertRuleParams, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error)
+
+	// GetAlertRuleWithResponse request
+	GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, params *GetAlertRuleParams, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error)
+
+	// UpdateAlertRuleWithBodyWithResponse request with any body
+	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+
+	UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+
+	// HandleAlertWebhookWithBodyWithResponse request with any body
+	HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+
+	HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+
+	// GetComponentCostsWithResponse request
+	GetComponentCostsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetComponentCostsParams, reqEditors ...RequestEditorFn) (*GetComponentCostsResp, error)
+
+	// GetRecommendationsWithResponse request
+	GetRecommendationsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetRecommendationsParams, reqEditors ...RequestEditorFn) (*GetRecommendationsResp, error)
+
+	// QueryIncidentsWithBodyWithResponse request with any body
+	QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+
+	QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+
+	// UpdateIncidentWithBodyWithResponse request with any body
+	UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+
+	UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+
+	// ListLogMetricsWithResponse request
+	ListLogMetricsWithResponse(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*ListLogMetricsResp, error)
+
+	// CreateLogMetricWithBodyWithResponse request with any body
+	CreateLogMetricWithBodyWithResponse(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLogMetricResp, error)
+
+	CreateLogMetricWithResponse(ctx context.Context, namespace string, project string, body CreateLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLogMetricResp, error)
+
+	// DeleteLogMetricWithResponse request
+	DeleteLogMetricWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*DeleteLogMetricResp, error)
+
+	// GetLogMetricWithResponse request
+	GetLogMetricWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*GetLogMetricResp, error)
+
+	// ListSavedLogQueriesWithResponse request
+	ListSavedLogQueriesWithResponse(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*ListSavedLogQueriesResp, error)
+
+	// CreateSavedLogQueryWithBodyWithResponse request with any body
+	CreateSavedLogQueryWithBodyWithResponse(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSavedLogQueryResp, error)
+
+	CreateSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, body CreateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSavedLogQueryResp, error)
+
+	// DeleteSavedLogQueryWithResponse request
+	DeleteSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*DeleteSavedLogQueryResp, error)
+
+	// GetSavedLogQueryWithResponse request
+	GetSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*GetSavedLogQueryResp, error)
+
+	// UpdateSavedLogQueryWithBodyWithResponse request with any body
+	UpdateSavedLogQueryWithBodyWithResponse(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSavedLogQueryResp, error)
+
+	UpdateSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, body UpdateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSavedLogQueryResp, error)
+
+	// RunSavedLogQueryWithBodyWithResponse request with any body
+	RunSavedLogQueryWithBodyWithResponse(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunSavedLogQueryResp, error)
+
+	RunSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, body RunSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*RunSavedLogQueryResp, error)
+
+	// QueryRuntimeTopologyWithBodyWithResponse request with any body
+	QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+
+	QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+
+	// HandleDriftNotificationWithBodyWithResponse request with any body
+	HandleDriftNotificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleDriftNotificationResp, error)
+
+	HandleDriftNotificationWithResponse(ctx context.Context, body HandleDriftNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleDriftNotificationResp, error)
+
+	// QueryTracesWithBodyWithResponse request with any body
+	QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+
+	QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+
+	// QueryTraceLogsWithBodyWithResponse request with any body
+	QueryTraceLogsWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTraceLogsResp, error)
+
+	QueryTraceLogsWithResponse(ctx context.Context, traceId string, body QueryTraceLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTraceLogsResp, error)
+
+	// QuerySpansForTraceWithBodyWithResponse request with any body
+	QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+
+	QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+
+	// GetSpanDetailsForTraceWithResponse request
+	GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error)
+
+	// HealthWithResponse request
+	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error)
+}
+
+type QueryEventsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+	JSON501      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryEventsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryEventsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TailLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TailLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TailLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryMetricsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetricsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryMetricsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryMetricsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryAlertsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryAlertsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryAlertsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AlertingRuleSyncResponse
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertingRuleSyncResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRuleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertingRuleSyncResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HandleAlertWebhookResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertWebhookResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r HandleAlertWebhookResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HandleAlertWebhookResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetComponentCostsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CostResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetComponentCostsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetComponentCostsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecommendationsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecommendationResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecommendationsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecommendationsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryIncidentsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IncidentsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryIncidentsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryIncidentsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIncidentResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IncidentPutResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIncidentResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIncidentResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLogMetricsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogMetricList
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLogMetricsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLogMetricsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLogMetricResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *LogMetric
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLogMetricResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLogMetricResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLogMetricResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLogMetricResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLogMetricResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogMetricResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogMetric
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogMetricResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogMetricResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSavedLogQueriesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedLogQueryList
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSavedLogQueriesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSavedLogQueriesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSavedLogQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SavedLogQuery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSavedLogQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSavedLogQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSavedLogQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSavedLogQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSavedLogQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSavedLogQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedLogQuery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSavedLogQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSavedLogQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSavedLogQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedLogQuery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSavedLogQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSavedLogQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunSavedLogQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RunSavedLogQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunSavedLogQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryRuntimeTopologyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RuntimeTopologyResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryRuntimeTopologyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryRuntimeTopologyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HandleDriftNotificationResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertWebhookResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r HandleDriftNotificationResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HandleDriftNotificationResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryTracesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TracesQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryTracesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryTracesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryTraceLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryTraceLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryTraceLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QuerySpansForTraceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TraceSpansQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QuerySpansForTraceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QuerySpansForTraceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpanDetailsForTraceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TraceSpanDetailsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpanDetailsForTraceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpanDetailsForTraceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	JSON503 *struct {
+		Error  *string `json:"error,omitempty"`
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// QueryEventsWithBodyWithResponse request with arbitrary body returning *QueryEventsResp
+func (c *ClientWithResponses) QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
+	rsp, err := c.QueryEventsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryEventsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
+	rsp, err := c.QueryEvents(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryEventsResp(rsp)
+}
+
+// QueryLogsWithBodyWithResponse request with arbitrary body returning *QueryLogsResp
+func (c *ClientWithResponses) QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
+	rsp, err := c.QueryLogsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryLogsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
+	rsp, err := c.QueryLogs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryLogsResp(rsp)
+}
+
+// TailLogsWithBodyWithResponse request with arbitrary body returning *TailLogsResp
+func (c *ClientWithResponses) TailLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TailLogsResp, error) {
+	rsp, err := c.TailLogsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTailLogsResp(rsp)
+}
+
+func (c *ClientWithResponses) TailLogsWithResponse(ctx context.Context, body TailLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*TailLogsResp, error) {
+	rsp, err := c.TailLogs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTailLogsResp(rsp)
+}
+
+// QueryMetricsWithBodyWithResponse request with arbitrary body returning *QueryMetricsResp
+func (c *ClientWithResponses) QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
+	rsp, err := c.QueryMetricsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryMetricsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
+	rsp, err := c.QueryMetrics(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryMetricsResp(rsp)
+}
+
+// QueryAlertsWithBodyWithResponse request with arbitrary body returning *QueryAlertsResp
+func (c *ClientWithResponses) QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
+	rsp, err := c.QueryAlertsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryAlertsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
+	rsp, err := c.QueryAlerts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryAlertsResp(rsp)
+}
+
+// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResp
+func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
+	rsp, err := c.CreateAlertRuleWithBody(ctx, sourceType, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
+	rsp, err := c.CreateAlertRule(ctx, sourceType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResp(rsp)
+}
+
+// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResp
+func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, params *DeleteAlertRuleParams, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error) {
+	rsp, err := c.DeleteAlertRule(ctx, sourceType, ruleName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertRuleResp(rsp)
+}
+
+// GetAlertRuleWithResponse request returning *GetAlertRuleResp
+func (c *ClientWithResponses) GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, params *GetAlertRuleParams, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error) {
+	rsp, err := c.GetAlertRule(ctx, sourceType, ruleName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertRuleResp(rsp)
+}
+
+// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResp
+func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
+	rsp, err := c.UpdateAlertRuleWithBody(ctx, sourceType, ruleName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
+	rsp, err := c.UpdateAlertRule(ctx, sourceType, ruleName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResp(rsp)
+}
+
+// HandleAlertWebhookWithBodyWithResponse request with arbitrary body returning *HandleAlertWebhookResp
+func (c *ClientWithResponses) HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
+	rsp, err := c.HandleAlertWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleAlertWebhookResp(rsp)
+}
+
+func (c *ClientWithResponses) HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
+	rsp, err := c.HandleAlertWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleAlertWebhookResp(rsp)
+}
+
+// GetComponentCostsWithResponse request returning *GetComponentCostsResp
+func (c *ClientWithResponses) GetComponentCostsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetComponentCostsParams, reqEditors ...RequestEditorFn) (*GetComponentCostsResp, error) {
+	rsp, err := c.GetComponentCosts(ctx, namespace, environment, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetComponentCostsResp(rsp)
+}
+
+// GetRecommendationsWithResponse request returning *GetRecommendationsResp
+func (c *ClientWithResponses) GetRecommendationsWithResponse(ctx context.Context, namespace FinOpsNamespace, environment FinOpsEnvironment, params *GetRecommendationsParams, reqEditors ...RequestEditorFn) (*GetRecommendationsResp, error) {
+	rsp, err := c.GetRecommendations(ctx, namespace, environment, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecommendationsResp(rsp)
+}
+
+// QueryIncidentsWithBodyWithResponse request with arbitrary body returning *QueryIncidentsResp
+func (c *ClientWithResponses) QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
+	rsp, err := c.QueryIncidentsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryIncidentsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
+	rsp, err := c.QueryIncidents(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryIncidentsResp(rsp)
+}
+
+// UpdateIncidentWithBodyWithResponse request with arbitrary body returning *UpdateIncidentResp
 func (c *ClientWithResponses) UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
 	rsp, err := c.UpdateIncidentWithBody(ctx, incidentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateIncidentResp(rsp)
+	return ParseUpdateIncidentResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
+	rsp, err := c.UpdateIncident(ctx, incidentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIncidentResp(rsp)
+}
+
+// ListLogMetricsWithResponse request returning *ListLogMetricsResp
+func (c *ClientWithResponses) ListLogMetricsWithResponse(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*ListLogMetricsResp, error) {
+	rsp, err := c.ListLogMetrics(ctx, namespace, project, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLogMetricsResp(rsp)
+}
+
+// CreateLogMetricWithBodyWithResponse request with arbitrary body returning *CreateLogMetricResp
+func (c *ClientWithResponses) CreateLogMetricWithBodyWithResponse(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLogMetricResp, error) {
+	rsp, err := c.CreateLogMetricWithBody(ctx, namespace, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLogMetricResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateLogMetricWithResponse(ctx context.Context, namespace string, project string, body CreateLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLogMetricResp, error) {
+	rsp, err := c.CreateLogMetric(ctx, namespace, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLogMetricResp(rsp)
+}
+
+// DeleteLogMetricWithResponse request returning *DeleteLogMetricResp
+func (c *ClientWithResponses) DeleteLogMetricWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*DeleteLogMetricResp, error) {
+	rsp, err := c.DeleteLogMetric(ctx, namespace, project, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLogMetricResp(rsp)
+}
+
+// GetLogMetricWithResponse request returning *GetLogMetricResp
+func (c *ClientWithResponses) GetLogMetricWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*GetLogMetricResp, error) {
+	rsp, err := c.GetLogMetric(ctx, namespace, project, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogMetricResp(rsp)
+}
+
+// ListSavedLogQueriesWithResponse request returning *ListSavedLogQueriesResp
+func (c *ClientWithResponses) ListSavedLogQueriesWithResponse(ctx context.Context, namespace string, project string, reqEditors ...RequestEditorFn) (*ListSavedLogQueriesResp, error) {
+	rsp, err := c.ListSavedLogQueries(ctx, namespace, project, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSavedLogQueriesResp(rsp)
+}
+
+// CreateSavedLogQueryWithBodyWithResponse request with arbitrary body returning *CreateSavedLogQueryResp
+func (c *ClientWithResponses) CreateSavedLogQueryWithBodyWithResponse(ctx context.Context, namespace string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSavedLogQueryResp, error) {
+	rsp, err := c.CreateSavedLogQueryWithBody(ctx, namespace, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSavedLogQueryResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, body CreateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSavedLogQueryResp, error) {
+	rsp, err := c.CreateSavedLogQuery(ctx, namespace, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSavedLogQueryResp(rsp)
+}
+
+// DeleteSavedLogQueryWithResponse request returning *DeleteSavedLogQueryResp
+func (c *ClientWithResponses) DeleteSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*DeleteSavedLogQueryResp, error) {
+	rsp, err := c.DeleteSavedLogQuery(ctx, namespace, project, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSavedLogQueryResp(rsp)
+}
+
+// GetSavedLogQueryWithResponse request returning *GetSavedLogQueryResp
+func (c *ClientWithResponses) GetSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, reqEditors ...RequestEditorFn) (*GetSavedLogQueryResp, error) {
+	rsp, err := c.GetSavedLogQuery(ctx, namespace, project, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSavedLogQueryResp(rsp)
+}
+
+// UpdateSavedLogQueryWithBodyWithResponse request with arbitrary body returning *UpdateSavedLogQueryResp
+func (c *ClientWithResponses) UpdateSavedLogQueryWithBodyWithResponse(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSavedLogQueryResp, error) {
+	rsp, err := c.UpdateSavedLogQueryWithBody(ctx, namespace, project, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSavedLogQueryResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, body UpdateSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSavedLogQueryResp, error) {
+	rsp, err := c.UpdateSavedLogQuery(ctx, namespace, project, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSavedLogQueryResp(rsp)
+}
+
+// RunSavedLogQueryWithBodyWithResponse request with arbitrary body returning *RunSavedLogQueryResp
+func (c *ClientWithResponses) RunSavedLogQueryWithBodyWithResponse(ctx context.Context, namespace string, project string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunSavedLogQueryResp, error) {
+	rsp, err := c.RunSavedLogQueryWithBody(ctx, namespace, project, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunSavedLogQueryResp(rsp)
+}
+
+func (c *ClientWithResponses) RunSavedLogQueryWithResponse(ctx context.Context, namespace string, project string, name string, body RunSavedLogQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*RunSavedLogQueryResp, error) {
+	rsp, err := c.RunSavedLogQuery(ctx, namespace, project, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunSavedLogQueryResp(rsp)
+}
+
+// QueryRuntimeTopologyWithBodyWithResponse request with arbitrary body returning *QueryRuntimeTopologyResp
+func (c *ClientWithResponses) QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
+	rsp, err := c.QueryRuntimeTopologyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryRuntimeTopologyResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
+	rsp, err := c.QueryRuntimeTopology(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryRuntimeTopologyResp(rsp)
+}
+
+// HandleDriftNotificationWithBodyWithResponse request with arbitrary body returning *HandleDriftNotificationResp
+func (c *ClientWithResponses) HandleDriftNotificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleDriftNotificationResp, error) {
+	rsp, err := c.HandleDriftNotificationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleDriftNotificationResp(rsp)
+}
+
+func (c *ClientWithResponses) HandleDriftNotificationWithResponse(ctx context.Context, body HandleDriftNotificationJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleDriftNotificationResp, error) {
+	rsp, err := c.HandleDriftNotification(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleDriftNotificationResp(rsp)
+}
+
+// QueryTracesWithBodyWithResponse request with arbitrary body returning *QueryTracesResp
+func (c *ClientWithResponses) QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
+	rsp, err := c.QueryTracesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTracesResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
+	rsp, err := c.QueryTraces(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTracesResp(rsp)
+}
+
+// QueryTraceLogsWithBodyWithResponse request with arbitrary body returning *QueryTraceLogsResp
+func (c *ClientWithResponses) QueryTraceLogsWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTraceLogsResp, error) {
+	rsp, err := c.QueryTraceLogsWithBody(ctx, traceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTraceLogsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryTraceLogsWithResponse(ctx context.Context, traceId string, body QueryTraceLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTraceLogsResp, error) {
+	rsp, err := c.QueryTraceLogs(ctx, traceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTraceLogsResp(rsp)
+}
+
+// QuerySpansForTraceWithBodyWithResponse request with arbitrary body returning *QuerySpansForTraceResp
+func (c *ClientWithResponses) QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
+	rsp, err := c.QuerySpansForTraceWithBody(ctx, traceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuerySpansForTraceResp(rsp)
+}
+
+func (c *ClientWithResponses) QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
+	rsp, err := c.QuerySpansForTrace(ctx, traceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuerySpansForTraceResp(rsp)
+}
+
+// GetSpanDetailsForTraceWithResponse request returning *GetSpanDetailsForTraceResp
+func (c *ClientWithResponses) GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error) {
+	rsp, err := c.GetSpanDetailsForTrace(ctx, traceId, spanId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpanDetailsForTraceResp(rsp)
+}
+
+// HealthWithResponse request returning *HealthResp
+func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error) {
+	rsp, err := c.Health(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthResp(rsp)
+}
+
+// ParseQueryEventsResp parses an HTTP response from a QueryEventsWithResponse call
+func ParseQueryEventsResp(rsp *http.Response) (*QueryEventsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryEventsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryLogsResp parses an HTTP response from a QueryLogsWithResponse call
+func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryLogsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTailLogsResp parses an HTTP response from a TailLogsWithResponse call
+func ParseTailLogsResp(rsp *http.Response) (*TailLogsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TailLogsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryMetricsResp parses an HTTP response from a QueryMetricsWithResponse call
+func ParseQueryMetricsResp(rsp *http.Response) (*QueryMetricsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryMetricsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetricsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryAlertsResp parses an HTTP response from a QueryAlertsWithResponse call
+func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryAlertsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAlertRuleResp parses an HTTP response from a CreateAlertRuleWithResponse call
+func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AlertingRuleSyncResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAlertRuleResp parses an HTTP response from a DeleteAlertRuleWithResponse call
+func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertingRuleSyncResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
-	rsp, err := c.UpdateIncident(ctx, incidentId, body, reqEditors...)
+// ParseGetAlertRuleResp parses an HTTP response from a GetAlertRuleWithResponse call
+func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateIncidentResp(rsp)
-}
 
-// QueryRuntimeTopologyWithBodyWithResponse request with arbitrary body returning *QueryRuntimeTopologyResp
-func (c *ClientWithResponses) QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
-	rsp, err := c.QueryRuntimeTopologyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseQueryRuntimeTopologyResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
-	rsp, err := c.QueryRuntimeTopology(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRuleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseQueryRuntimeTopologyResp(rsp)
+
+	return response, nil
 }
 
-// QueryTracesWithBodyWithResponse request with arbitrary body returning *QueryTracesResp
-func (c *ClientWithResponses) QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
-	rsp, err := c.QueryTracesWithBody(ctx, contentType, body, reqEditors...)
+// ParseUpdateAlertRuleResp parses an HTTP response from a UpdateAlertRuleWithResponse call
+func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryTracesResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
-	rsp, err := c.QueryTraces(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseQueryTracesResp(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertingRuleSyncResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// QuerySpansForTraceWithBodyWithResponse request with arbitrary body returning *QuerySpansForTraceResp
-func (c *ClientWithResponses) QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
-	rsp, err := c.QuerySpansForTraceWithBody(ctx, traceId, contentType, body, reqEditors...)
+// ParseHandleAlertWebhookResp parses an HTTP response from a HandleAlertWebhookWithResponse call
+func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseQuerySpansForTraceResp(rsp)
+
+	response := &HandleAlertWebhookResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertWebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
-	rsp, err := c.QuerySpansForTrace(ctx, traceId, body, reqEditors...)
+// ParseGetComponentCostsResp parses an HTTP response from a GetComponentCostsWithResponse call
+func ParseGetComponentCostsResp(rsp *http.Response) (*GetComponentCostsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseQuerySpansForTraceResp(rsp)
+
+	response := &GetComponentCostsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CostResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetSpanDetailsForTraceWithResponse request returning *GetSpanDetailsForTraceResp
-func (c *ClientWithResponses) GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error) {
-	rsp, err := c.GetSpanDetailsForTrace(ctx, traceId, spanId, reqEditors...)
+// ParseGetRecommendationsResp parses an HTTP response from a GetRecommendationsWithResponse call
+func ParseGetRecommendationsResp(rsp *http.Response) (*GetRecommendationsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSpanDetailsForTraceResp(rsp)
-}
 
-// HealthWithResponse request returning *HealthResp
-func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error) {
-	rsp, err := c.Health(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetRecommendationsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecommendationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseHealthResp(rsp)
+
+	return response, nil
 }
 
-// ParseQueryEventsResp parses an HTTP response from a QueryEventsWithResponse call
-func ParseQueryEventsResp(rsp *http.Response) (*QueryEventsResp, error) {
+// ParseQueryIncidentsResp parses an HTTP response from a QueryIncidentsWithResponse call
+func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryEventsResp{
+	response := &QueryIncidentsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventsQueryResponse
+		var dest IncidentsQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2311,34 +4460,27 @@ func ParseQueryEventsResp(rsp *http.Response) (*QueryEventsResp, error) {
 		}
 		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseQueryLogsResp parses an HTTP response from a QueryLogsWithResponse call
-func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
+// ParseUpdateIncidentResp parses an HTTP response from a UpdateIncidentWithResponse call
+func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryLogsResp{
+	response := &UpdateIncidentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogsQueryResponse
+		var dest IncidentPutResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2365,6 +4507,13 @@ func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2377,22 +4526,22 @@ func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
 	return response, nil
 }
 
-// ParseQueryMetricsResp parses an HTTP response from a QueryMetricsWithResponse call
-func ParseQueryMetricsResp(rsp *http.Response) (*QueryMetricsResp, error) {
+// ParseListLogMetricsResp parses an HTTP response from a ListLogMetricsWithResponse call
+func ParseListLogMetricsResp(rsp *http.Response) (*ListLogMetricsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryMetricsResp{
+	response := &ListLogMetricsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetricsQueryResponse
+		var dest LogMetricList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2431,26 +4580,26 @@ func ParseQueryMetricsResp(rsp *http.Response) (*QueryMetricsResp, error) {
 	return response, nil
 }
 
-// ParseQueryAlertsResp parses an HTTP response from a QueryAlertsWithResponse call
-func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
+// ParseCreateLogMetricResp parses an HTTP response from a CreateLogMetricWithResponse call
+func ParseCreateLogMetricResp(rsp *http.Response) (*CreateLogMetricResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryAlertsResp{
+	response := &CreateLogMetricResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertsQueryResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LogMetric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -2473,6 +4622,20 @@ func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2485,40 +4648,47 @@ func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
 	return response, nil
 }
 
-// ParseCreateAlertRuleResp parses an HTTP response from a CreateAlertRuleWithResponse call
-func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error) {
+// ParseDeleteLogMetricResp parses an HTTP response from a DeleteLogMetricWithResponse call
+func ParseDeleteLogMetricResp(rsp *http.Response) (*DeleteLogMetricResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAlertRuleResp{
+	response := &DeleteLogMetricResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AlertingRuleSyncResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -2532,22 +4702,22 @@ func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseDeleteAlertRuleResp parses an HTTP response from a DeleteAlertRuleWithResponse call
-func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error) {
+// ParseGetLogMetricResp parses an HTTP response from a GetLogMetricWithResponse call
+func ParseGetLogMetricResp(rsp *http.Response) (*GetLogMetricResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAlertRuleResp{
+	response := &GetLogMetricResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertingRuleSyncResponse
+		var dest LogMetric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2560,6 +4730,20 @@ func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2579,22 +4763,22 @@ func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseGetAlertRuleResp parses an HTTP response from a GetAlertRuleWithResponse call
-func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
+// ParseListSavedLogQueriesResp parses an HTTP response from a ListSavedLogQueriesWithResponse call
+func ParseListSavedLogQueriesResp(rsp *http.Response) (*ListSavedLogQueriesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertRuleResp{
+	response := &ListSavedLogQueriesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertRuleResponse
+		var dest SavedLogQueryList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2607,12 +4791,19 @@ func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -2626,26 +4817,26 @@ func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
 	return response, nil
 }
 
-// ParseUpdateAlertRuleResp parses an HTTP response from a UpdateAlertRuleWithResponse call
-func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error) {
+// ParseCreateSavedLogQueryResp parses an HTTP response from a CreateSavedLogQueryWithResponse call
+func ParseCreateSavedLogQueryResp(rsp *http.Response) (*CreateSavedLogQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAlertRuleResp{
+	response := &CreateSavedLogQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertingRuleSyncResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SavedLogQuery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -2654,6 +4845,27 @@ func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2666,33 +4878,47 @@ func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseHandleAlertWebhookResp parses an HTTP response from a HandleAlertWebhookWithResponse call
-func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, error) {
+// ParseDeleteSavedLogQueryResp parses an HTTP response from a DeleteSavedLogQueryWithResponse call
+func ParseDeleteSavedLogQueryResp(rsp *http.Response) (*DeleteSavedLogQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HandleAlertWebhookResp{
+	response := &DeleteSavedLogQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertWebhookResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -2706,22 +4932,22 @@ func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, e
 	return response, nil
 }
 
-// ParseGetComponentCostsResp parses an HTTP response from a GetComponentCostsWithResponse call
-func ParseGetComponentCostsResp(rsp *http.Response) (*GetComponentCostsResp, error) {
+// ParseGetSavedLogQueryResp parses an HTTP response from a GetSavedLogQueryWithResponse call
+func ParseGetSavedLogQueryResp(rsp *http.Response) (*GetSavedLogQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentCostsResp{
+	response := &GetSavedLogQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CostResponse
+		var dest SavedLogQuery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2767,22 +4993,22 @@ func ParseGetComponentCostsResp(rsp *http.Response) (*GetComponentCostsResp, err
 	return response, nil
 }
 
-// ParseGetRecommendationsResp parses an HTTP response from a GetRecommendationsWithResponse call
-func ParseGetRecommendationsResp(rsp *http.Response) (*GetRecommendationsResp, error) {
+// ParseUpdateSavedLogQueryResp parses an HTTP response from a UpdateSavedLogQueryWithResponse call
+func ParseUpdateSavedLogQueryResp(rsp *http.Response) (*UpdateSavedLogQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRecommendationsResp{
+	response := &UpdateSavedLogQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RecommendationResponse
+		var dest SavedLogQuery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2828,22 +5054,22 @@ func ParseGetRecommendationsResp(rsp *http.Response) (*GetRecommendationsResp, e
 	return response, nil
 }
 
-// ParseQueryIncidentsResp parses an HTTP response from a QueryIncidentsWithResponse call
-func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
+// ParseRunSavedLogQueryResp parses an HTTP response from a RunSavedLogQueryWithResponse call
+func ParseRunSavedLogQueryResp(rsp *http.Response) (*RunSavedLogQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryIncidentsResp{
+	response := &RunSavedLogQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IncidentsQueryResponse
+		var dest LogsQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2870,6 +5096,13 @@ func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2882,22 +5115,22 @@ func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
 	return response, nil
 }
 
-// ParseUpdateIncidentResp parses an HTTP response from a UpdateIncidentWithResponse call
-func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
+// ParseQueryRuntimeTopologyResp parses an HTTP response from a QueryRuntimeTopologyWithResponse call
+func ParseQueryRuntimeTopologyResp(rsp *http.Response) (*QueryRuntimeTopologyResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateIncidentResp{
+	response := &QueryRuntimeTopologyResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IncidentPutResponse
+		var dest RuntimeTopologyResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2924,12 +5157,45 @@ func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHandleDriftNotificationResp parses an HTTP response from a HandleDriftNotificationWithResponse call
+func ParseHandleDriftNotificationResp(rsp *http.Response) (*HandleDriftNotificationResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HandleDriftNotificationResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertWebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -2943,22 +5209,22 @@ func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
 	return response, nil
 }
 
-// ParseQueryRuntimeTopologyResp parses an HTTP response from a QueryRuntimeTopologyWithResponse call
-func ParseQueryRuntimeTopologyResp(rsp *http.Response) (*QueryRuntimeTopologyResp, error) {
+// ParseQueryTracesResp parses an HTTP response from a QueryTracesWithResponse call
+func ParseQueryTracesResp(rsp *http.Response) (*QueryTracesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryRuntimeTopologyResp{
+	response := &QueryTracesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RuntimeTopologyResponse
+		var dest TracesQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2997,22 +5263,22 @@ func ParseQueryRuntimeTopologyResp(rsp *http.Response) (*QueryRuntimeTopologyRes
 	return response, nil
 }
 
-// ParseQueryTracesResp parses an HTTP response from a QueryTracesWithResponse call
-func ParseQueryTracesResp(rsp *http.Response) (*QueryTracesResp, error) {
+// ParseQueryTraceLogsResp parses an HTTP response from a QueryTraceLogsWithResponse call
+func ParseQueryTraceLogsResp(rsp *http.Response) (*QueryTraceLogsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryTracesResp{
+	response := &QueryTraceLogsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TracesQueryResponse
+		var dest LogsQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34,9 +34,10 @@ const (
 
 // Defines values for AlertRuleRequestSourceType.
 const (
-	AlertRuleRequestSourceTypeBudget AlertRuleRequestSourceType = "budget"
-	AlertRuleRequestSourceTypeLog    AlertRuleRequestSourceType = "log"
-	AlertRuleRequestSourceTypeMetric AlertRuleRequestSourceType = "metric"
+	AlertRuleRequestSourceTypeBudget    AlertRuleRequestSourceType = "budget"
+	AlertRuleRequestSourceTypeLog       AlertRuleRequestSourceType = "log"
+	AlertRuleRequestSourceTypeLogMetric AlertRuleRequestSourceType = "logMetric"
+	AlertRuleRequestSourceTypeMetric    AlertRuleRequestSourceType = "metric"
 )
 
 // Defines values for AlertRuleResponseConditionOperator.
@@ -58,9 +59,10 @@ const (
 
 // Defines values for AlertRuleResponseSourceType.
 const (
-	AlertRuleResponseSourceTypeBudget AlertRuleResponseSourceType = "budget"
-	AlertRuleResponseSourceTypeLog    AlertRuleResponseSourceType = "log"
-	AlertRuleResponseSourceTypeMetric AlertRuleResponseSourceType = "metric"
+	AlertRuleResponseSourceTypeBudget    AlertRuleResponseSourceType = "budget"
+	AlertRuleResponseSourceTypeLog       AlertRuleResponseSourceType = "log"
+	AlertRuleResponseSourceTypeLogMetric AlertRuleResponseSourceType = "logMetric"
+	AlertRuleResponseSourceTypeMetric    AlertRuleResponseSourceType = "metric"
 )
 
 // Defines values for AlertWebhookResponseStatus.
@@ -108,9 +110,9 @@ const (
 
 // Defines values for AlertsQueryResponseAlertsMetadataAlertRuleSourceType.
 const (
-	Budget AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "budget"
-	Log    AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "log"
-	Metric AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "metric"
+	AlertsQueryResponseAlertsMetadataAlertRuleSourceTypeBudget AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "budget"
+	AlertsQueryResponseAlertsMetadataAlertRuleSourceTypeLog    AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "log"
+	AlertsQueryResponseAlertsMetadataAlertRuleSourceTypeMetric AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "metric"
 )
 
 // Defines values for ErrorResponseTitle.
@@ -157,12 +159,29 @@ const (
 	Resolved     IncidentsQueryResponseIncidentsStatus = "resolved"
 )
 
+// Defines values for LogMetricRequestGroupBy.
+const (
+	LogMetricRequestGroupByComponent   LogMetricRequestGroupBy = "component"
+	LogMetricRequestGroupByContainer   LogMetricRequestGroupBy = "container"
+	LogMetricRequestGroupByEnvironment LogMetricRequestGroupBy = "environment"
+	LogMetricRequestGroupByLevel       LogMetricRequestGroupBy = "level"
+	LogMetricRequestGroupByPod         LogMetricRequestGroupBy = "pod"
+)
+
+// Defines values for LogMetricRequestLogLevels.
+const (
+	LogMetricRequestLogLevelsDEBUG LogMetricRequestLogLevels = "DEBUG"
+	LogMetricRequestLogLevelsERROR LogMetricRequestLogLevels = "ERROR"
+	LogMetricRequestLogLevelsINFO  LogMetricRequestLogLevels = "INFO"
+	LogMetricRequestLogLevelsWARN  LogMetricRequestLogLevels = "WARN"
+)
+
 // Defines values for LogsQueryRequestLogLevels.
 const (
-	DEBUG LogsQueryRequestLogLevels = "DEBUG"
-	ERROR LogsQueryRequestLogLevels = "ERROR"
-	INFO  LogsQueryRequestLogLevels = "INFO"
-	WARN  LogsQueryRequestLogLevels = "WARN"
+	LogsQueryRequestLogLevelsDEBUG LogsQueryRequestLogLevels = "DEBUG"
+	LogsQueryRequestLogLevelsERROR LogsQueryRequestLogLevels = "ERROR"
+	LogsQueryRequestLogLevelsINFO  LogsQueryRequestLogLevels = "INFO"
+	LogsQueryRequestLogLevelsWARN  LogsQueryRequestLogLevels = "WARN"
 )
 
 // Defines values for LogsQueryRequestSortOrder.
@@ -171,15 +190,24 @@ const (
 	LogsQueryRequestSortOrderDesc LogsQueryRequestSortOrder = "desc"
 )
 
+// Defines values for LogsTailRequestLogLevels.
+const (
+	LogsTailRequestLogLevelsDEBUG LogsTailRequestLogLevels = "DEBUG"
+	LogsTailRequestLogLevelsERROR LogsTailRequestLogLevels = "ERROR"
+	LogsTailRequestLogLevelsINFO  LogsTailRequestLogLevels = "INFO"
+	LogsTailRequestLogLevelsWARN  LogsTailRequestLogLevels = "WARN"
+)
+
 // Defines values for MetricsQueryRequestMetric.
 const (
 	MetricsQueryRequestMetricHttp     MetricsQueryRequestMetric = "http"
+	MetricsQueryRequestMetricLog      MetricsQueryRequestMetric = "log"
 	MetricsQueryRequestMetricResource MetricsQueryRequestMetric = "resource"
 )
 
 // Defines values for RuntimeTopologyEdgeProtocol.
 const (
-	RuntimeTopologyEdgeProtocolHttp RuntimeTopologyEdgeProtocol = "http"
+	Http RuntimeTopologyEdgeProtocol = "http"
 )
 
 // Defines values for RuntimeTopologyNodeKind.
@@ -191,9 +219,23 @@ const (
 
 // Defines values for RuntimeTopologyNodeRefKind.
 const (
-	RuntimeTopologyNodeRefKindComponent RuntimeTopologyNodeRefKind = "component"
-	RuntimeTopologyNodeRefKindExternal  RuntimeTopologyNodeRefKind = "external"
-	RuntimeTopologyNodeRefKindGateway   RuntimeTopologyNodeRefKind = "gateway"
+	Component RuntimeTopologyNodeRefKind = "component"
+	External  RuntimeTopologyNodeRefKind = "external"
+	Gateway   RuntimeTopologyNodeRefKind = "gateway"
+)
+
+// Defines values for SavedLogQueryRequestLogLevels.
+const (
+	SavedLogQueryRequestLogLevelsDEBUG SavedLogQueryRequestLogLevels = "DEBUG"
+	SavedLogQueryRequestLogLevelsERROR SavedLogQueryRequestLogLevels = "ERROR"
+	SavedLogQueryRequestLogLevelsINFO  SavedLogQueryRequestLogLevels = "INFO"
+	SavedLogQueryRequestLogLevelsWARN  SavedLogQueryRequestLogLevels = "WARN"
+)
+
+// Defines values for SavedLogQueryRunRequestSortOrder.
+const (
+	SavedLogQueryRunRequestSortOrderAsc  SavedLogQueryRunRequestSortOrder = "asc"
+	SavedLogQueryRunRequestSortOrderDesc SavedLogQueryRunRequestSortOrder = "desc"
 )
 
 // Defines values for SpanStatusCode.
@@ -205,8 +247,8 @@ const (
 
 // Defines values for TracesQueryRequestSortOrder.
 const (
-	Asc  TracesQueryRequestSortOrder = "asc"
-	Desc TracesQueryRequestSortOrder = "desc"
+	TracesQueryRequestSortOrderAsc  TracesQueryRequestSortOrder = "asc"
+	TracesQueryRequestSortOrderDesc TracesQueryRequestSortOrder = "desc"
 )
 
 // AlertRuleRequest defines model for AlertRuleRequest.
@@ -244,6 +286,9 @@ type AlertRuleRequest struct {
 		ProjectUid openapi_types.UUID `json:"projectUid"`
 	} `json:"metadata"`
 	Source struct {
+		// LogMetric The name of the log metric in the component's project for log metric based alerts
+		LogMetric *string `json:"logMetric,omitempty"`
+
 		// Metric The metric to query for metric based alerts
 		Metric *AlertRuleRequestSourceMetric `json:"metric,omitempty"`
 
@@ -299,6 +344,9 @@ type AlertRuleResponse struct {
 		ProjectUid *openapi_types.UUID `json:"projectUid,omitempty"`
 	} `json:"metadata,omitempty"`
 	Source *struct {
+		// LogMetric The name of the log metric in the component's project for log metric based alerts
+		LogMetric *string `json:"logMetric,omitempty"`
+
 		// Metric The metric to query for metric based alerts
 		Metric *AlertRuleResponseSourceMetric `json:"metric,omitempty"`
 
@@ -561,6 +609,12 @@ type ComponentLogEntry struct {
 
 		// ProjectUid The OpenChoreo project UID that generated the log
 		ProjectUid *openapi_types.UUID `json:"projectUid,omitempty"`
+
+		// SpanId The ID of the span the log was emitted in, if known
+		SpanId *string `json:"spanId,omitempty"`
+
+		// TraceId The ID of the trace the log was emitted in, if known
+		TraceId *string `json:"traceId,omitempty"`
 	} `json:"metadata,omitempty"`
 
 	// Timestamp The timestamp of the log entry
@@ -609,6 +663,51 @@ type CostResponse struct {
 	Items []ComponentCost `json:"items"`
 }
 
+// DriftNotificationRequest defines model for DriftNotificationRequest.
+type DriftNotificationRequest struct {
+	// Channels The notification channels to notify
+	Channels []string `json:"channels"`
+
+	// Component The component of the drifted release, if owned by a component
+	Component *string `json:"component,omitempty"`
+
+	// Environment The environment of the drifted release
+	Environment string `json:"environment"`
+
+	// Namespace The namespace of the drifted release
+	Namespace string `json:"namespace"`
+
+	// Project The project of the drifted release
+	Project *string `json:"project,omitempty"`
+
+	// Release The name of the drifted RenderedRelease
+	Release *string `json:"release,omitempty"`
+
+	// Resource The resource of the drifted release, if owned by a resource
+	Resource *string `json:"resource,omitempty"`
+
+	// Resources The drifted resources
+	Resources []DriftedResource `json:"resources"`
+}
+
+// DriftedResource defines model for DriftedResource.
+type DriftedResource struct {
+	// Fields The paths of the drifted fields
+	Fields []string `json:"fields"`
+
+	// Id The resource ID within the release
+	Id string `json:"id"`
+
+	// Kind The kind of the resource
+	Kind string `json:"kind"`
+
+	// Name The name of the resource
+	Name string `json:"name"`
+
+	// Namespace The namespace of the resource
+	Namespace *string `json:"namespace,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// ErrorCode The error code from observer service
@@ -881,6 +980,73 @@ type IncidentsQueryResponse struct {
 // IncidentsQueryResponseIncidentsStatus The status of the incident
 type IncidentsQueryResponseIncidentsStatus string
 
+// LogMetric defines model for LogMetric.
+type LogMetric struct {
+	Component   *string    `json:"component,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	GroupBy     *string    `json:"groupBy,omitempty"`
+	Interval    *string    `json:"interval,omitempty"`
+
+	// LastEvaluatedAt The end of the last evaluated interval
+	LastEvaluatedAt *time.Time `json:"lastEvaluatedAt,omitempty"`
+	LogLevels       *[]string  `json:"logLevels,omitempty"`
+	Name            *string    `json:"name,omitempty"`
+	Namespace       *string    `json:"namespace,omitempty"`
+	Project         *string    `json:"project,omitempty"`
+	SearchPhrase    *string    `json:"searchPhrase,omitempty"`
+}
+
+// LogMetricList defines model for LogMetricList.
+type LogMetricList struct {
+	Metrics *[]LogMetric `json:"metrics,omitempty"`
+}
+
+// LogMetricRequest defines model for LogMetricRequest.
+type LogMetricRequest struct {
+	Component   *string `json:"component,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+
+	// GroupBy The log field to count by; omit to count all matching logs as one series
+	GroupBy *LogMetricRequestGroupBy `json:"groupBy,omitempty"`
+
+	// Interval The evaluation interval and sample width in whole minutes or hours, e.g. 1m, 5m, 1h
+	Interval  string                       `json:"interval"`
+	LogLevels *[]LogMetricRequestLogLevels `json:"logLevels,omitempty"`
+
+	// Name The name of the log metric, unique within the project
+	Name string `json:"name"`
+
+	// SavedQuery Name of a saved query in the same project to copy the scope and filters from. When set, component, environment, searchPhrase and logLevels must be omitted.
+	SavedQuery   *string `json:"savedQuery,omitempty"`
+	SearchPhrase *string `json:"searchPhrase,omitempty"`
+}
+
+// LogMetricRequestGroupBy The log field to count by; omit to count all matching logs as one series
+type LogMetricRequestGroupBy string
+
+// LogMetricRequestLogLevels defines model for LogMetricRequest.LogLevels.
+type LogMetricRequestLogLevels string
+
+// LogMetricSeries defines model for LogMetricSeries.
+type LogMetricSeries struct {
+	// Group The value of the group-by field; empty for ungrouped log metrics
+	Group  *string                  `json:"group,omitempty"`
+	Values *[]MetricsTimeSeriesItem `json:"values,omitempty"`
+}
+
+// LogMetricsTimeSeries defines model for LogMetricsTimeSeries.
+type LogMetricsTimeSeries struct {
+	// GroupBy The field the log counts are grouped by
+	GroupBy *string `json:"groupBy,omitempty"`
+
+	// LogMetric The name of the log metric
+	LogMetric *string            `json:"logMetric,omitempty"`
+	Series    *[]LogMetricSeries `json:"series,omitempty"`
+}
+
 // LogsQueryRequest defines model for LogsQueryRequest.
 type LogsQueryRequest struct {
 	// EndTime The end time of the query
@@ -933,11 +1099,33 @@ type LogsQueryResponse_Logs struct {
 	union json.RawMessage
 }
 
+// LogsTailRequest defines model for LogsTailRequest.
+type LogsTailRequest struct {
+	LogLevels    *[]LogsTailRequestLogLevels `json:"logLevels,omitempty"`
+	SearchPhrase *string                     `json:"searchPhrase,omitempty"`
+	SearchScope  LogsTailRequest_SearchScope `json:"searchScope"`
+
+	// StartTime The time to start tailing from, at most one hour in the past. Defaults to
+	// the time of the request.
+	StartTime *time.Time `json:"startTime,omitempty"`
+}
+
+// LogsTailRequestLogLevels defines model for LogsTailRequest.LogLevels.
+type LogsTailRequestLogLevels string
+
+// LogsTailRequest_SearchScope defines model for LogsTailRequest.SearchScope.
+type LogsTailRequest_SearchScope struct {
+	union json.RawMessage
+}
+
 // MetricsQueryRequest defines model for MetricsQueryRequest.
 type MetricsQueryRequest struct {
 	// EndTime The end time of the query
 	EndTime time.Time `json:"endTime"`
 
+	// LogMetric The name of the log metric to query. Required when metric is log.
+	LogMetric *string `json:"logMetric,omitempty"`
+
 	// Metric The type of query to execute
 	Metric      MetricsQueryRequestMetric `json:"metric"`
 	SearchScope ComponentSearchScope      `json:"searchScope"`
@@ -1164,6 +1352,54 @@ type RuntimeTopologySummary struct {
 	StartTime   time.Time `json:"startTime"`
 }
 
+// SavedLogQuery defines model for SavedLogQuery.
+type SavedLogQuery struct {
+	Component    *string    `json:"component,omitempty"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Environment  *string    `json:"environment,omitempty"`
+	LogLevels    *[]string  `json:"logLevels,omitempty"`
+	Name         *string    `json:"name,omitempty"`
+	Namespace    *string    `json:"namespace,omitempty"`
+	Project      *string    `json:"project,omitempty"`
+	SearchPhrase *string    `json:"searchPhrase,omitempty"`
+	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SavedLogQueryList defines model for SavedLogQueryList.
+type SavedLogQueryList struct {
+	Queries *[]SavedLogQuery `json:"queries,omitempty"`
+}
+
+// SavedLogQueryRequest defines model for SavedLogQueryRequest.
+type SavedLogQueryRequest struct {
+	// Component Optional component to narrow the query to
+	Component   *string `json:"component,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Environment Optional environment to narrow the query to
+	Environment *string                          `json:"environment,omitempty"`
+	LogLevels   *[]SavedLogQueryRequestLogLevels `json:"logLevels,omitempty"`
+
+	// Name The name of the saved query, unique within the project
+	Name         string  `json:"name"`
+	SearchPhrase *string `json:"searchPhrase,omitempty"`
+}
+
+// SavedLogQueryRequestLogLevels defines model for SavedLogQueryRequest.LogLevels.
+type SavedLogQueryRequestLogLevels string
+
+// SavedLogQueryRunRequest defines model for SavedLogQueryRunRequest.
+type SavedLogQueryRunRequest struct {
+	EndTime   time.Time                         `json:"endTime"`
+	Limit     *int                              `json:"limit,omitempty"`
+	SortOrder *SavedLogQueryRunRequestSortOrder `json:"sortOrder,omitempty"`
+	StartTime time.Time                         `json:"startTime"`
+}
+
+// SavedLogQueryRunRequestSortOrder defines model for SavedLogQueryRunRequest.SortOrder.
+type SavedLogQueryRunRequestSortOrder string
+
 // SpanStatus Execution status of the span, following the OpenTelemetry span Status model.
 type SpanStatus struct {
 	// Code The status code of the span. One of "ok", "error", or "unset".
@@ -1176,11 +1412,30 @@ type SpanStatus struct {
 // SpanStatusCode The status code of the span. One of "ok", "error", or "unset".
 type SpanStatusCode string
 
+// TraceLogsQueryRequest defines model for TraceLogsQueryRequest.
+type TraceLogsQueryRequest struct {
+	// EndTime The end time of the query
+	EndTime time.Time `json:"endTime"`
+
+	// Limit The maximum number of items to return
+	Limit       *int                 `json:"limit,omitempty"`
+	SearchScope ComponentSearchScope `json:"searchScope"`
+
+	// SpanId Restricts the results to logs emitted within this span of the trace
+	SpanId *string `json:"spanId,omitempty"`
+
+	// StartTime The start time of the query
+	StartTime time.Time `json:"startTime"`
+}
+
 // TraceSpanDetailsResponse defines model for TraceSpanDetailsResponse.
 type TraceSpanDetailsResponse struct {
 	// Attributes The span attributes
 	Attributes *map[string]interface{} `json:"attributes,omitempty"`
 
+	// CorrelatedLogCount The number of log lines emitted within the span. Omitted when the span's component cannot be identified, the caller may not view its logs, or the logs backend is unavailable.
+	CorrelatedLogCount *int `json:"correlatedLogCount,omitempty"`
+
 	// DurationNs The duration of the span in nanoseconds
 	DurationNs *int64 `json:"durationNs,omitempty"`
 
@@ -1325,6 +1580,9 @@ type WorkflowSearchScope struct {
 	WorkflowRunName *string `json:"workflowRunName,omitempty"`
 }
 
+// AlertRuleNamespace defines model for AlertRuleNamespace.
+type AlertRuleNamespace = string
+
 // FinOpsComponent defines model for FinOpsComponent.
 type FinOpsComponent = string
 
@@ -1346,6 +1604,18 @@ type FinOpsProject = string
 // FinOpsStartTime defines model for FinOpsStartTime.
 type FinOpsStartTime = time.Time
 
+// DeleteAlertRuleParams defines parameters for DeleteAlertRule.
+type DeleteAlertRuleParams struct {
+	// Namespace Namespace of the alert rule. Required for logMetric alert rules, which are stored by namespace and name.
+	Namespace *AlertRuleNamespace `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetAlertRuleParams defines parameters for GetAlertRule.
+type GetAlertRuleParams struct {
+	// Namespace Namespace of the alert rule. Required for logMetric alert rules, which are stored by namespace and name.
+	Namespace *AlertRuleNamespace `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
 // GetComponentCostsParams defines parameters for GetComponentCosts.
 type GetComponentCostsParams struct {
 	// Project Project name. When set, narrows the response to components in this project.
@@ -1387,6 +1657,9 @@ type QueryEventsJSONRequestBody = EventsQueryRequest
 // QueryLogsJSONRequestBody defines body for QueryLogs for application/json ContentType.
 type QueryLogsJSONRequestBody = LogsQueryRequest
 
+// TailLogsJSONRequestBody defines body for TailLogs for application/json ContentType.
+type TailLogsJSONRequestBody = LogsTailRequest
+
 // QueryMetricsJSONRequestBody defines body for QueryMetrics for application/json ContentType.
 type QueryMetricsJSONRequestBody = MetricsQueryRequest
 
@@ -1408,12 +1681,30 @@ type QueryIncidentsJSONRequestBody = IncidentsQueryRequest
 // UpdateIncidentJSONRequestBody defines body for UpdateIncident for application/json ContentType.
 type UpdateIncidentJSONRequestBody = IncidentPutRequest
 
+// CreateLogMetricJSONRequestBody defines body for CreateLogMetric for application/json ContentType.
+type CreateLogMetricJSONRequestBody = LogMetricRequest
+
+// CreateSavedLogQueryJSONRequestBody defines body for CreateSavedLogQuery for application/json ContentType.
+type CreateSavedLogQueryJSONRequestBody = SavedLogQueryRequest
+
+// UpdateSavedLogQueryJSONRequestBody defines body for UpdateSavedLogQuery for application/json ContentType.
+type UpdateSavedLogQueryJSONRequestBody = SavedLogQueryRequest
+
+// RunSavedLogQueryJSONRequestBody defines body for RunSavedLogQuery for application/json ContentType.
+type RunSavedLogQueryJSONRequestBody = SavedLogQueryRunRequest
+
 // QueryRuntimeTopologyJSONRequestBody defines body for QueryRuntimeTopology for application/json ContentType.
 type QueryRuntimeTopologyJSONRequestBody = RuntimeTopologyRequest
 
+// HandleDriftNotificationJSONRequestBody defines body for HandleDriftNotification for application/json ContentType.
+type HandleDriftNotificationJSONRequestBody = DriftNotificationRequest
+
 // QueryTracesJSONRequestBody defines body for QueryTraces for application/json ContentType.
 type QueryTracesJSONRequestBody = TracesQueryRequest
 
+// QueryTraceLogsJSONRequestBody defines body for QueryTraceLogs for application/json ContentType.
+type QueryTraceLogsJSONRequestBody = TraceLogsQueryRequest
+
 // QuerySpansForTraceJSONRequestBody defines body for QuerySpansForTrace for application/json ContentType.
 type QuerySpansForTraceJSONRequestBody = TracesQueryRequest
 
@@ -1603,6 +1894,68 @@ func (t *LogsQueryResponse_Logs) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsComponentSearchScope returns the union data inside the LogsTailRequest_SearchScope as a ComponentSearchScope
+func (t LogsTailRequest_SearchScope) AsComponentSearchScope() (ComponentSearchScope, error) {
+	var body ComponentSearchScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromComponentSearchScope overwrites any union data inside the LogsTailRequest_SearchScope as the provided ComponentSearchScope
+func (t *LogsTailRequest_SearchScope) FromComponentSearchScope(v ComponentSearchScope) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeComponentSearchScope performs a merge with any union data inside the LogsTailRequest_SearchScope, using the provided ComponentSearchScope
+func (t *LogsTailRequest_SearchScope) MergeComponentSearchScope(v ComponentSearchScope) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWorkflowSearchScope returns the union data inside the LogsTailRequest_SearchScope as a WorkflowSearchScope
+func (t LogsTailRequest_SearchScope) AsWorkflowSearchScope() (WorkflowSearchScope, error) {
+	var body WorkflowSearchScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWorkflowSearchScope overwrites any union data inside the LogsTailRequest_SearchScope as the provided WorkflowSearchScope
+func (t *LogsTailRequest_SearchScope) FromWorkflowSearchScope(v WorkflowSearchScope) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWorkflowSearchScope performs a merge with any union data inside the LogsTailRequest_SearchScope, using the provided WorkflowSearchScope
+func (t *LogsTailRequest_SearchScope) MergeWorkflowSearchScope(v WorkflowSearchScope) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t LogsTailRequest_SearchScope) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *LogsTailRequest_SearchScope) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsResourceMetricsTimeSeries returns the union data inside the MetricsQueryResponse as a ResourceMetricsTimeSeries
 func (t MetricsQueryResponse) AsResourceMetricsTimeSeries() (ResourceMetricsTimeSeries, error) {
 	var body ResourceMetricsTimeSeries
@@ -1655,6 +2008,32 @@ func (t *MetricsQueryResponse) MergeHttpMetricsTimeSeries(v HttpMetricsTimeSerie
 	return err
 }
 
+// AsLogMetricsTimeSeries returns the union data inside the MetricsQueryResponse as a LogMetricsTimeSeries
+func (t MetricsQueryResponse) AsLogMetricsTimeSeries() (LogMetricsTimeSeries, error) {
+	var body LogMetricsTimeSeries
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromLogMetricsTimeSeries overwrites any union data inside the MetricsQueryResponse as the provided LogMetricsTimeSeries
+func (t *MetricsQueryResponse) FromLogMetricsTimeSeries(v LogMetricsTimeSeries) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeLogMetricsTimeSeries performs a merge with any union data inside the MetricsQueryResponse, using the provided LogMetricsTimeSeries
+func (t *MetricsQueryResponse) MergeLogMetricsTimeSeries(v LogMetricsTimeSeries) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t MetricsQueryResponse) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
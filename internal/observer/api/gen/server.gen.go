@@ -12,6 +12,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -30,6 +31,9 @@ type ServerInterface interface {
 	// Query logs
 	// (POST /api/v1/logs/query)
 	QueryLogs(w http.ResponseWriter, r *http.Request)
+	// Tail logs
+	// (POST /api/v1/logs/tail)
+	TailLogs(w http.ResponseWriter, r *http.Request)
 	// Query metrics
 	// (POST /api/v1/metrics/query)
 	QueryMetrics(w http.ResponseWriter, r *http.Request)
@@ -41,10 +45,10 @@ type ServerInterface interface {
 	CreateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string)
 	// Delete alert rule
 	// (DELETE /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
-	DeleteAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string)
+	DeleteAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string, params DeleteAlertRuleParams)
 	// Get alert rule
 	// (GET /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
-	GetAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string)
+	GetAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string, params GetAlertRuleParams)
 	// Update alert rule
 	// (PUT /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
 	UpdateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string)
@@ -63,12 +67,48 @@ type ServerInterface interface {
 	// Update incident
 	// (PUT /api/v1alpha1/incidents/{incidentId})
 	UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string)
+	// List log metrics
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics)
+	ListLogMetrics(w http.ResponseWriter, r *http.Request, namespace string, project string)
+	// Create log metric
+	// (POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics)
+	CreateLogMetric(w http.ResponseWriter, r *http.Request, namespace string, project string)
+	// Delete log metric
+	// (DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name})
+	DeleteLogMetric(w http.ResponseWriter, r *http.Request, namespace string, project string, name string)
+	// Get log metric
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name})
+	GetLogMetric(w http.ResponseWriter, r *http.Request, namespace string, project string, name string)
+	// List saved log queries
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries)
+	ListSavedLogQueries(w http.ResponseWriter, r *http.Request, namespace string, project string)
+	// Create saved log query
+	// (POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries)
+	CreateSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string)
+	// Delete saved log query
+	// (DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name})
+	DeleteSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string)
+	// Get saved log query
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name})
+	GetSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string)
+	// Replace saved log query
+	// (PUT /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name})
+	UpdateSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string)
+	// Run saved log query
+	// (POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}/run)
+	RunSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string)
 	// Query runtime topology
 	// (POST /api/v1alpha1/metrics/runtime-topology)
 	QueryRuntimeTopology(w http.ResponseWriter, r *http.Request)
+	// Sends drift notifications
+	// (POST /api/v1alpha1/notifications/drift)
+	HandleDriftNotification(w http.ResponseWriter, r *http.Request)
 	// Query traces
 	// (POST /api/v1alpha1/traces/query)
 	QueryTraces(w http.ResponseWriter, r *http.Request)
+	// Query logs correlated with a trace
+	// (POST /api/v1alpha1/traces/{traceId}/logs/query)
+	QueryTraceLogs(w http.ResponseWriter, r *http.Request, traceId string)
 	// Query spans for a trace
 	// (POST /api/v1alpha1/traces/{traceId}/spans/query)
 	QuerySpansForTrace(w http.ResponseWriter, r *http.Request, traceId string)
@@ -129,6 +169,26 @@ func (siw *ServerInterfaceWrapper) QueryLogs(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// TailLogs operation middleware
+func (siw *ServerInterfaceWrapper) TailLogs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TailLogs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // QueryMetrics operation middleware
 func (siw *ServerInterfaceWrapper) QueryMetrics(w http.ResponseWriter, r *http.Request) {
 
@@ -229,8 +289,19 @@ func (siw *ServerInterfaceWrapper) DeleteAlertRule(w http.ResponseWriter, r *htt
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAlertRuleParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAlertRule(w, r, sourceType, ruleName)
+		siw.Handler.DeleteAlertRule(w, r, sourceType, ruleName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -269,8 +340,19 @@ func (siw *ServerInterfaceWrapper) GetAlertRule(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertRuleParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAlertRule(w, r, sourceType, ruleName)
+		siw.Handler.GetAlertRule(w, r, sourceType, ruleName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -571,8 +653,28 @@ func (siw *ServerInterfaceWrapper) UpdateIncident(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// QueryRuntimeTopology operation middleware
-func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
+// ListLogMetrics operation middleware
+func (siw *ServerInterfaceWrapper) ListLogMetrics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project" -------------
+	var project string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -581,7 +683,7 @@ func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryRuntimeTopology(w, r)
+		siw.Handler.ListLogMetrics(w, r, namespace, project)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -591,8 +693,28 @@ func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// QueryTraces operation middleware
-func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Request) {
+// CreateLogMetric operation middleware
+func (siw *ServerInterfaceWrapper) CreateLogMetric(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project" -------------
+	var project string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -601,7 +723,7 @@ func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryTraces(w, r)
+		siw.Handler.CreateLogMetric(w, r, namespace, project)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -611,17 +733,35 @@ func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// QuerySpansForTrace operation middleware
-func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *http.Request) {
+// DeleteLogMetric operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLogMetric(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "traceId" -------------
-	var traceId string
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project" -------------
+	var project string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
@@ -632,7 +772,7 @@ func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QuerySpansForTrace(w, r, traceId)
+		siw.Handler.DeleteLogMetric(w, r, namespace, project, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -642,26 +782,35 @@ func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpanDetailsForTrace operation middleware
-func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter, r *http.Request) {
+// GetLogMetric operation middleware
+func (siw *ServerInterfaceWrapper) GetLogMetric(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "traceId" -------------
-	var traceId string
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "spanId" -------------
-	var spanId string
+	// ------------- Path parameter "project" -------------
+	var project string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "spanId", r.PathValue("spanId"), &spanId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "spanId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
@@ -672,7 +821,7 @@ func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpanDetailsForTrace(w, r, traceId, spanId)
+		siw.Handler.GetLogMetric(w, r, namespace, project, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -682,11 +831,37 @@ func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// Health operation middleware
-func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+// ListSavedLogQueries operation middleware
+func (siw *ServerInterfaceWrapper) ListSavedLogQueries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project" -------------
+	var project string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Health(w, r)
+		siw.Handler.ListSavedLogQueries(w, r, namespace, project)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -696,1072 +871,2277 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// CreateSavedLogQuery operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedLogQuery(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "project" -------------
+	var project string
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx := r.Context()
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSavedLogQuery(w, r, namespace, project)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// DeleteSavedLogQuery operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedLogQuery(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	// ------------- Path parameter "project" -------------
+	var project string
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	ctx := r.Context()
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	r = r.WithContext(ctx)
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSavedLogQuery(w, r, namespace, project, name)
+	}))
 
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/events/query", wrapper.QueryEvents)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/logs/query", wrapper.QueryLogs)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/metrics/query", wrapper.QueryMetrics)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/query", wrapper.QueryAlerts)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules", wrapper.CreateAlertRule)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.DeleteAlertRule)
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.GetAlertRule)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.UpdateAlertRule)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/webhook", wrapper.HandleAlertWebhook)
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/costs/namespaces/{namespace}/environments/{environment}", wrapper.GetComponentCosts)
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/costs/namespaces/{namespace}/environments/{environment}/recommendations", wrapper.GetRecommendations)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/incidents/query", wrapper.QueryIncidents)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/incidents/{incidentId}", wrapper.UpdateIncident)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/runtime-topology", wrapper.QueryRuntimeTopology)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/query", wrapper.QueryTraces)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/query", wrapper.QuerySpansForTrace)
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/{spanId}", wrapper.GetSpanDetailsForTrace)
-	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.Health)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return m
+	handler.ServeHTTP(w, r)
 }
 
-type QueryEventsRequestObject struct {
-	Body *QueryEventsJSONRequestBody
-}
+// GetSavedLogQuery operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedLogQuery(w http.ResponseWriter, r *http.Request) {
 
-type QueryEventsResponseObject interface {
-	VisitQueryEventsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type QueryEvents200JSONResponse EventsQueryResponse
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-func (response QueryEvents200JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "project" -------------
+	var project string
 
-type QueryEvents400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-func (response QueryEvents400JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type QueryEvents401JSONResponse ErrorResponse
+	ctx := r.Context()
 
-func (response QueryEvents401JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type QueryEvents403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedLogQuery(w, r, namespace, project, name)
+	}))
 
-func (response QueryEvents403JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryEvents500JSONResponse ErrorResponse
+// UpdateSavedLogQuery operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSavedLogQuery(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryEvents500JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-type QueryEvents501JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-func (response QueryEvents501JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	// ------------- Path parameter "project" -------------
+	var project string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type QueryLogsRequestObject struct {
-	Body *QueryLogsJSONRequestBody
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-type QueryLogsResponseObject interface {
-	VisitQueryLogsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type QueryLogs200JSONResponse LogsQueryResponse
+	ctx := r.Context()
 
-func (response QueryLogs200JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type QueryLogs400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSavedLogQuery(w, r, namespace, project, name)
+	}))
 
-func (response QueryLogs400JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryLogs401JSONResponse ErrorResponse
+// RunSavedLogQuery operation middleware
+func (siw *ServerInterfaceWrapper) RunSavedLogQuery(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryLogs401JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-type QueryLogs403JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-func (response QueryLogs403JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Path parameter "project" -------------
+	var project string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "project", r.PathValue("project"), &project, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type QueryLogs500JSONResponse ErrorResponse
+	// ------------- Path parameter "name" -------------
+	var name string
 
-func (response QueryLogs500JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type QueryMetricsRequestObject struct {
-	Body *QueryMetricsJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryMetricsResponseObject interface {
-	VisitQueryMetricsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type QueryMetrics200JSONResponse MetricsQueryResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunSavedLogQuery(w, r, namespace, project, name)
+	}))
 
-func (response QueryMetrics200JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryMetrics400JSONResponse ErrorResponse
+// QueryRuntimeTopology operation middleware
+func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryMetrics400JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryMetrics401JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response QueryMetrics401JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryRuntimeTopology(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type QueryMetrics403JSONResponse ErrorResponse
+// HandleDriftNotification operation middleware
+func (siw *ServerInterfaceWrapper) HandleDriftNotification(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryMetrics403JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleDriftNotification(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type QueryMetrics500JSONResponse ErrorResponse
+// QueryTraces operation middleware
+func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryMetrics500JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryAlertsRequestObject struct {
-	Body *QueryAlertsJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type QueryAlertsResponseObject interface {
-	VisitQueryAlertsResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryTraces(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type QueryAlerts200JSONResponse AlertsQueryResponse
+// QueryTraceLogs operation middleware
+func (siw *ServerInterfaceWrapper) QueryTraceLogs(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryAlerts200JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type QueryAlerts400JSONResponse ErrorResponse
+	var err error
 
-func (response QueryAlerts400JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "traceId" -------------
+	var traceId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		return
+	}
 
-type QueryAlerts401JSONResponse ErrorResponse
+	ctx := r.Context()
 
-func (response QueryAlerts401JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type QueryAlerts403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryTraceLogs(w, r, traceId)
+	}))
 
-func (response QueryAlerts403JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryAlerts500JSONResponse ErrorResponse
-
-func (response QueryAlerts500JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// QuerySpansForTrace operation middleware
+func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	Body       *CreateAlertRuleJSONRequestBody
-}
+	// ------------- Path parameter "traceId" -------------
+	var traceId string
 
-type CreateAlertRuleResponseObject interface {
-	VisitCreateAlertRuleResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		return
+	}
 
-type CreateAlertRule201JSONResponse AlertingRuleSyncResponse
+	ctx := r.Context()
 
-func (response CreateAlertRule201JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateAlertRule400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuerySpansForTrace(w, r, traceId)
+	}))
 
-func (response CreateAlertRule400JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateAlertRule409JSONResponse ErrorResponse
-
-func (response CreateAlertRule409JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetSpanDetailsForTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter, r *http.Request) {
 
-type CreateAlertRule500JSONResponse ErrorResponse
+	var err error
 
-func (response CreateAlertRule500JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "traceId" -------------
+	var traceId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		return
+	}
 
-type DeleteAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	RuleName   string `json:"ruleName"`
-}
+	// ------------- Path parameter "spanId" -------------
+	var spanId string
 
-type DeleteAlertRuleResponseObject interface {
-	VisitDeleteAlertRuleResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "spanId", r.PathValue("spanId"), &spanId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "spanId", Err: err})
+		return
+	}
 
-type DeleteAlertRule200JSONResponse AlertingRuleSyncResponse
+	ctx := r.Context()
 
-func (response DeleteAlertRule200JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type DeleteAlertRule400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpanDetailsForTrace(w, r, traceId, spanId)
+	}))
 
-func (response DeleteAlertRule400JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteAlertRule404JSONResponse ErrorResponse
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteAlertRule404JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteAlertRule500JSONResponse ErrorResponse
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
 
-func (response DeleteAlertRule500JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	RuleName   string `json:"ruleName"`
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetAlertRuleResponseObject interface {
-	VisitGetAlertRuleResponse(w http.ResponseWriter) error
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type GetAlertRule200JSONResponse AlertRuleResponse
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
 
-func (response GetAlertRule200JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RequiredParamError struct {
+	ParamName string
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type GetAlertRule400JSONResponse ErrorResponse
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
 
-func (response GetAlertRule400JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type GetAlertRule404JSONResponse ErrorResponse
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
 
-func (response GetAlertRule404JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type GetAlertRule500JSONResponse ErrorResponse
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
 
-func (response GetAlertRule500JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
 }
 
-type UpdateAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	RuleName   string `json:"ruleName"`
-	Body       *UpdateAlertRuleJSONRequestBody
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
-type UpdateAlertRuleResponseObject interface {
-	VisitUpdateAlertRuleResponse(w http.ResponseWriter) error
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type UpdateAlertRule200JSONResponse AlertingRuleSyncResponse
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
 
-func (response UpdateAlertRule200JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/events/query", wrapper.QueryEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/logs/query", wrapper.QueryLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/logs/tail", wrapper.TailLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/metrics/query", wrapper.QueryMetrics)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/query", wrapper.QueryAlerts)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules", wrapper.CreateAlertRule)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.DeleteAlertRule)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.GetAlertRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.UpdateAlertRule)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/webhook", wrapper.HandleAlertWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/costs/namespaces/{namespace}/environments/{environment}", wrapper.GetComponentCosts)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/costs/namespaces/{namespace}/environments/{environment}/recommendations", wrapper.GetRecommendations)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/incidents/query", wrapper.QueryIncidents)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/incidents/{incidentId}", wrapper.UpdateIncident)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics", wrapper.ListLogMetrics)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics", wrapper.CreateLogMetric)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name}", wrapper.DeleteLogMetric)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name}", wrapper.GetLogMetric)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries", wrapper.ListSavedLogQueries)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries", wrapper.CreateSavedLogQuery)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}", wrapper.DeleteSavedLogQuery)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}", wrapper.GetSavedLogQuery)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}", wrapper.UpdateSavedLogQuery)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}/run", wrapper.RunSavedLogQuery)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/runtime-topology", wrapper.QueryRuntimeTopology)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/notifications/drift", wrapper.HandleDriftNotification)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/query", wrapper.QueryTraces)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/logs/query", wrapper.QueryTraceLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/query", wrapper.QuerySpansForTrace)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/{spanId}", wrapper.GetSpanDetailsForTrace)
+	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.Health)
+
+	return m
+}
+
+type QueryEventsRequestObject struct {
+	Body *QueryEventsJSONRequestBody
+}
+
+type QueryEventsResponseObject interface {
+	VisitQueryEventsResponse(w http.ResponseWriter) error
+}
+
+type QueryEvents200JSONResponse EventsQueryResponse
+
+func (response QueryEvents200JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAlertRule400JSONResponse ErrorResponse
+type QueryEvents400JSONResponse ErrorResponse
 
-func (response UpdateAlertRule400JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+func (response QueryEvents400JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAlertRule500JSONResponse ErrorResponse
+type QueryEvents401JSONResponse ErrorResponse
 
-func (response UpdateAlertRule500JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+func (response QueryEvents401JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleAlertWebhookRequestObject struct {
-	Body *HandleAlertWebhookJSONRequestBody
-}
-
-type HandleAlertWebhookResponseObject interface {
-	VisitHandleAlertWebhookResponse(w http.ResponseWriter) error
-}
-
-type HandleAlertWebhook200JSONResponse AlertWebhookResponse
+type QueryEvents403JSONResponse ErrorResponse
 
-func (response HandleAlertWebhook200JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+func (response QueryEvents403JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleAlertWebhook400JSONResponse ErrorResponse
+type QueryEvents500JSONResponse ErrorResponse
 
-func (response HandleAlertWebhook400JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+func (response QueryEvents500JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleAlertWebhook500JSONResponse ErrorResponse
+type QueryEvents501JSONResponse ErrorResponse
 
-func (response HandleAlertWebhook500JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+func (response QueryEvents501JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetComponentCostsRequestObject struct {
-	Namespace   FinOpsNamespace   `json:"namespace"`
-	Environment FinOpsEnvironment `json:"environment"`
-	Params      GetComponentCostsParams
+type QueryLogsRequestObject struct {
+	Body *QueryLogsJSONRequestBody
 }
 
-type GetComponentCostsResponseObject interface {
-	VisitGetComponentCostsResponse(w http.ResponseWriter) error
+type QueryLogsResponseObject interface {
+	VisitQueryLogsResponse(w http.ResponseWriter) error
 }
 
-type GetComponentCosts200JSONResponse CostResponse
+type QueryLogs200JSONResponse LogsQueryResponse
 
-func (response GetComponentCosts200JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+func (response QueryLogs200JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetComponentCosts400JSONResponse ErrorResponse
+type QueryLogs400JSONResponse ErrorResponse
 
-func (response GetComponentCosts400JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+func (response QueryLogs400JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetComponentCosts401JSONResponse ErrorResponse
+type QueryLogs401JSONResponse ErrorResponse
 
-func (response GetComponentCosts401JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+func (response QueryLogs401JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetComponentCosts403JSONResponse ErrorResponse
+type QueryLogs403JSONResponse ErrorResponse
 
-func (response GetComponentCosts403JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+func (response QueryLogs403JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetComponentCosts404JSONResponse ErrorResponse
-
-func (response GetComponentCosts404JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetComponentCosts500JSONResponse ErrorResponse
+type QueryLogs500JSONResponse ErrorResponse
 
-func (response GetComponentCosts500JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+func (response QueryLogs500JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecommendationsRequestObject struct {
-	Namespace   FinOpsNamespace   `json:"namespace"`
-	Environment FinOpsEnvironment `json:"environment"`
-	Params      GetRecommendationsParams
+type TailLogsRequestObject struct {
+	Body *TailLogsJSONRequestBody
 }
 
-type GetRecommendationsResponseObject interface {
-	VisitGetRecommendationsResponse(w http.ResponseWriter) error
+type TailLogsResponseObject interface {
+	VisitTailLogsResponse(w http.ResponseWriter) error
 }
 
-type GetRecommendations200JSONResponse RecommendationResponse
+type TailLogs200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response GetRecommendations200JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response TailLogs200TexteventStreamResponse) VisitTailLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type GetRecommendations400JSONResponse ErrorResponse
+type TailLogs400JSONResponse ErrorResponse
 
-func (response GetRecommendations400JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
+func (response TailLogs400JSONResponse) VisitTailLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecommendations401JSONResponse ErrorResponse
+type TailLogs401JSONResponse ErrorResponse
 
-func (response GetRecommendations401JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
+func (response TailLogs401JSONResponse) VisitTailLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecommendations403JSONResponse ErrorResponse
+type TailLogs403JSONResponse ErrorResponse
 
-func (response GetRecommendations403JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
+func (response TailLogs403JSONResponse) VisitTailLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecommendations404JSONResponse ErrorResponse
-
-func (response GetRecommendations404JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+type TailLogs500JSONResponse ErrorResponse
 
-type GetRecommendations500JSONResponse ErrorResponse
-
-func (response GetRecommendations500JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
+func (response TailLogs500JSONResponse) VisitTailLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidentsRequestObject struct {
-	Body *QueryIncidentsJSONRequestBody
+type QueryMetricsRequestObject struct {
+	Body *QueryMetricsJSONRequestBody
 }
 
-type QueryIncidentsResponseObject interface {
-	VisitQueryIncidentsResponse(w http.ResponseWriter) error
+type QueryMetricsResponseObject interface {
+	VisitQueryMetricsResponse(w http.ResponseWriter) error
 }
 
-type QueryIncidents200JSONResponse IncidentsQueryResponse
+type QueryMetrics200JSONResponse MetricsQueryResponse
 
-func (response QueryIncidents200JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryMetrics200JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents400JSONResponse ErrorResponse
+type QueryMetrics400JSONResponse ErrorResponse
 
-func (response QueryIncidents400JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryMetrics400JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents401JSONResponse ErrorResponse
+type QueryMetrics401JSONResponse ErrorResponse
 
-func (response QueryIncidents401JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryMetrics401JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents403JSONResponse ErrorResponse
+type QueryMetrics403JSONResponse ErrorResponse
 
-func (response QueryIncidents403JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryMetrics403JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents500JSONResponse ErrorResponse
+type QueryMetrics500JSONResponse ErrorResponse
 
-func (response QueryIncidents500JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryMetrics500JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncidentRequestObject struct {
-	IncidentId string `json:"incidentId"`
-	Body       *UpdateIncidentJSONRequestBody
+type QueryAlertsRequestObject struct {
+	Body *QueryAlertsJSONRequestBody
 }
 
-type UpdateIncidentResponseObject interface {
-	VisitUpdateIncidentResponse(w http.ResponseWriter) error
+type QueryAlertsResponseObject interface {
+	VisitQueryAlertsResponse(w http.ResponseWriter) error
 }
 
-type UpdateIncident200JSONResponse IncidentPutResponse
+type QueryAlerts200JSONResponse AlertsQueryResponse
 
-func (response UpdateIncident200JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryAlerts200JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident400JSONResponse ErrorResponse
+type QueryAlerts400JSONResponse ErrorResponse
 
-func (response UpdateIncident400JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryAlerts400JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident401JSONResponse ErrorResponse
+type QueryAlerts401JSONResponse ErrorResponse
 
-func (response UpdateIncident401JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryAlerts401JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident403JSONResponse ErrorResponse
+type QueryAlerts403JSONResponse ErrorResponse
 
-func (response UpdateIncident403JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryAlerts403JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident404JSONResponse ErrorResponse
-
-func (response UpdateIncident404JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateIncident500JSONResponse ErrorResponse
+type QueryAlerts500JSONResponse ErrorResponse
 
-func (response UpdateIncident500JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryAlerts500JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopologyRequestObject struct {
-	Body *QueryRuntimeTopologyJSONRequestBody
+type CreateAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	Body       *CreateAlertRuleJSONRequestBody
 }
 
-type QueryRuntimeTopologyResponseObject interface {
-	VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error
+type CreateAlertRuleResponseObject interface {
+	VisitCreateAlertRuleResponse(w http.ResponseWriter) error
 }
 
-type QueryRuntimeTopology200JSONResponse RuntimeTopologyResponse
+type CreateAlertRule201JSONResponse AlertingRuleSyncResponse
 
-func (response QueryRuntimeTopology200JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule201JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology400JSONResponse ErrorResponse
+type CreateAlertRule400JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology400JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule400JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology401JSONResponse ErrorResponse
+type CreateAlertRule409JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology401JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule409JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology403JSONResponse ErrorResponse
+type CreateAlertRule500JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology403JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule500JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology500JSONResponse ErrorResponse
+type DeleteAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	RuleName   string `json:"ruleName"`
+	Params     DeleteAlertRuleParams
+}
 
-func (response QueryRuntimeTopology500JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+type DeleteAlertRuleResponseObject interface {
+	VisitDeleteAlertRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteAlertRule200JSONResponse AlertingRuleSyncResponse
+
+func (response DeleteAlertRule200JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertRule400JSONResponse ErrorResponse
+
+func (response DeleteAlertRule400JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertRule404JSONResponse ErrorResponse
+
+func (response DeleteAlertRule404JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertRule500JSONResponse ErrorResponse
+
+func (response DeleteAlertRule500JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTracesRequestObject struct {
-	Body *QueryTracesJSONRequestBody
+type GetAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	RuleName   string `json:"ruleName"`
+	Params     GetAlertRuleParams
 }
 
-type QueryTracesResponseObject interface {
-	VisitQueryTracesResponse(w http.ResponseWriter) error
+type GetAlertRuleResponseObject interface {
+	VisitGetAlertRuleResponse(w http.ResponseWriter) error
 }
 
-type QueryTraces200JSONResponse TracesQueryResponse
+type GetAlertRule200JSONResponse AlertRuleResponse
 
-func (response QueryTraces200JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response GetAlertRule200JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces400JSONResponse ErrorResponse
+type GetAlertRule400JSONResponse ErrorResponse
 
-func (response QueryTraces400JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response GetAlertRule400JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces401JSONResponse ErrorResponse
+type GetAlertRule404JSONResponse ErrorResponse
 
-func (response QueryTraces401JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response GetAlertRule404JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces403JSONResponse ErrorResponse
+type GetAlertRule500JSONResponse ErrorResponse
 
-func (response QueryTraces403JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response GetAlertRule500JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces500JSONResponse ErrorResponse
+type UpdateAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	RuleName   string `json:"ruleName"`
+	Body       *UpdateAlertRuleJSONRequestBody
+}
 
-func (response QueryTraces500JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+type UpdateAlertRuleResponseObject interface {
+	VisitUpdateAlertRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateAlertRule200JSONResponse AlertingRuleSyncResponse
+
+func (response UpdateAlertRule200JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertRule400JSONResponse ErrorResponse
+
+func (response UpdateAlertRule400JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertRule500JSONResponse ErrorResponse
+
+func (response UpdateAlertRule500JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTraceRequestObject struct {
-	TraceId string `json:"traceId"`
-	Body    *QuerySpansForTraceJSONRequestBody
+type HandleAlertWebhookRequestObject struct {
+	Body *HandleAlertWebhookJSONRequestBody
 }
 
-type QuerySpansForTraceResponseObject interface {
-	VisitQuerySpansForTraceResponse(w http.ResponseWriter) error
+type HandleAlertWebhookResponseObject interface {
+	VisitHandleAlertWebhookResponse(w http.ResponseWriter) error
 }
 
-type QuerySpansForTrace200JSONResponse TraceSpansQueryResponse
+type HandleAlertWebhook200JSONResponse AlertWebhookResponse
 
-func (response QuerySpansForTrace200JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response HandleAlertWebhook200JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace400JSONResponse ErrorResponse
+type HandleAlertWebhook400JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace400JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response HandleAlertWebhook400JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace401JSONResponse ErrorResponse
+type HandleAlertWebhook500JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace401JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response HandleAlertWebhook500JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComponentCostsRequestObject struct {
+	Namespace   FinOpsNamespace   `json:"namespace"`
+	Environment FinOpsEnvironment `json:"environment"`
+	Params      GetComponentCostsParams
+}
+
+type GetComponentCostsResponseObject interface {
+	VisitGetComponentCostsResponse(w http.ResponseWriter) error
+}
+
+type GetComponentCosts200JSONResponse CostResponse
+
+func (response GetComponentCosts200JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComponentCosts400JSONResponse ErrorResponse
+
+func (response GetComponentCosts400JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComponentCosts401JSONResponse ErrorResponse
+
+func (response GetComponentCosts401JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace403JSONResponse ErrorResponse
+type GetComponentCosts403JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace403JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response GetComponentCosts403JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace500JSONResponse ErrorResponse
+type GetComponentCosts404JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace500JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response GetComponentCosts404JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetComponentCosts500JSONResponse ErrorResponse
+
+func (response GetComponentCosts500JSONResponse) VisitGetComponentCostsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTraceRequestObject struct {
-	TraceId string `json:"traceId"`
-	SpanId  string `json:"spanId"`
+type GetRecommendationsRequestObject struct {
+	Namespace   FinOpsNamespace   `json:"namespace"`
+	Environment FinOpsEnvironment `json:"environment"`
+	Params      GetRecommendationsParams
 }
 
-type GetSpanDetailsForTraceResponseObject interface {
-	VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error
+type GetRecommendationsResponseObject interface {
+	VisitGetRecommendationsResponse(w http.ResponseWriter) error
 }
 
-type GetSpanDetailsForTrace200JSONResponse TraceSpanDetailsResponse
+type GetRecommendations200JSONResponse RecommendationResponse
 
-func (response GetSpanDetailsForTrace200JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetRecommendations200JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace400JSONResponse ErrorResponse
+type GetRecommendations400JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace400JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetRecommendations400JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace401JSONResponse ErrorResponse
+type GetRecommendations401JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace401JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetRecommendations401JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace403JSONResponse ErrorResponse
+type GetRecommendations403JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace403JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetRecommendations403JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace500JSONResponse ErrorResponse
+type GetRecommendations404JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace500JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetRecommendations404JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HealthRequestObject struct {
+type GetRecommendations500JSONResponse ErrorResponse
+
+func (response GetRecommendations500JSONResponse) VisitGetRecommendationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
+type QueryIncidentsRequestObject struct {
+	Body *QueryIncidentsJSONRequestBody
 }
 
-type Health200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+type QueryIncidentsResponseObject interface {
+	VisitQueryIncidentsResponse(w http.ResponseWriter) error
 }
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+type QueryIncidents200JSONResponse IncidentsQueryResponse
+
+func (response QueryIncidents200JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Health503JSONResponse struct {
-	Error  *string `json:"error,omitempty"`
-	Status *string `json:"status,omitempty"`
-}
+type QueryIncidents400JSONResponse ErrorResponse
 
-func (response Health503JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+func (response QueryIncidents400JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Query events
-	// (POST /api/v1/events/query)
-	QueryEvents(ctx context.Context, request QueryEventsRequestObject) (QueryEventsResponseObject, error)
-	// Query logs
+type QueryIncidents401JSONResponse ErrorResponse
+
+func (response QueryIncidents401JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidents403JSONResponse ErrorResponse
+
+func (response QueryIncidents403JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidents500JSONResponse ErrorResponse
+
+func (response QueryIncidents500JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncidentRequestObject struct {
+	IncidentId string `json:"incidentId"`
+	Body       *UpdateIncidentJSONRequestBody
+}
+
+type UpdateIncidentResponseObject interface {
+	VisitUpdateIncidentResponse(w http.ResponseWriter) error
+}
+
+type UpdateIncident200JSONResponse IncidentPutResponse
+
+func (response UpdateIncident200JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident400JSONResponse ErrorResponse
+
+func (response UpdateIncident400JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident401JSONResponse ErrorResponse
+
+func (response UpdateIncident401JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident403JSONResponse ErrorResponse
+
+func (response UpdateIncident403JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident404JSONResponse ErrorResponse
+
+func (response UpdateIncident404JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident500JSONResponse ErrorResponse
+
+func (response UpdateIncident500JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLogMetricsRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+}
+
+type ListLogMetricsResponseObject interface {
+	VisitListLogMetricsResponse(w http.ResponseWriter) error
+}
+
+type ListLogMetrics200JSONResponse LogMetricList
+
+func (response ListLogMetrics200JSONResponse) VisitListLogMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLogMetrics400JSONResponse ErrorResponse
+
+func (response ListLogMetrics400JSONResponse) VisitListLogMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLogMetrics401JSONResponse ErrorResponse
+
+func (response ListLogMetrics401JSONResponse) VisitListLogMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLogMetrics403JSONResponse ErrorResponse
+
+func (response ListLogMetrics403JSONResponse) VisitListLogMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLogMetrics500JSONResponse ErrorResponse
+
+func (response ListLogMetrics500JSONResponse) VisitListLogMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetricRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Body      *CreateLogMetricJSONRequestBody
+}
+
+type CreateLogMetricResponseObject interface {
+	VisitCreateLogMetricResponse(w http.ResponseWriter) error
+}
+
+type CreateLogMetric201JSONResponse LogMetric
+
+func (response CreateLogMetric201JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetric400JSONResponse ErrorResponse
+
+func (response CreateLogMetric400JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetric401JSONResponse ErrorResponse
+
+func (response CreateLogMetric401JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetric403JSONResponse ErrorResponse
+
+func (response CreateLogMetric403JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetric404JSONResponse ErrorResponse
+
+func (response CreateLogMetric404JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetric409JSONResponse ErrorResponse
+
+func (response CreateLogMetric409JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLogMetric500JSONResponse ErrorResponse
+
+func (response CreateLogMetric500JSONResponse) VisitCreateLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLogMetricRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Name      string `json:"name"`
+}
+
+type DeleteLogMetricResponseObject interface {
+	VisitDeleteLogMetricResponse(w http.ResponseWriter) error
+}
+
+type DeleteLogMetric204Response struct {
+}
+
+func (response DeleteLogMetric204Response) VisitDeleteLogMetricResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteLogMetric400JSONResponse ErrorResponse
+
+func (response DeleteLogMetric400JSONResponse) VisitDeleteLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLogMetric401JSONResponse ErrorResponse
+
+func (response DeleteLogMetric401JSONResponse) VisitDeleteLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLogMetric403JSONResponse ErrorResponse
+
+func (response DeleteLogMetric403JSONResponse) VisitDeleteLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLogMetric404JSONResponse ErrorResponse
+
+func (response DeleteLogMetric404JSONResponse) VisitDeleteLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLogMetric500JSONResponse ErrorResponse
+
+func (response DeleteLogMetric500JSONResponse) VisitDeleteLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogMetricRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Name      string `json:"name"`
+}
+
+type GetLogMetricResponseObject interface {
+	VisitGetLogMetricResponse(w http.ResponseWriter) error
+}
+
+type GetLogMetric200JSONResponse LogMetric
+
+func (response GetLogMetric200JSONResponse) VisitGetLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogMetric400JSONResponse ErrorResponse
+
+func (response GetLogMetric400JSONResponse) VisitGetLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogMetric401JSONResponse ErrorResponse
+
+func (response GetLogMetric401JSONResponse) VisitGetLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogMetric403JSONResponse ErrorResponse
+
+func (response GetLogMetric403JSONResponse) VisitGetLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogMetric404JSONResponse ErrorResponse
+
+func (response GetLogMetric404JSONResponse) VisitGetLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogMetric500JSONResponse ErrorResponse
+
+func (response GetLogMetric500JSONResponse) VisitGetLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedLogQueriesRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+}
+
+type ListSavedLogQueriesResponseObject interface {
+	VisitListSavedLogQueriesResponse(w http.ResponseWriter) error
+}
+
+type ListSavedLogQueries200JSONResponse SavedLogQueryList
+
+func (response ListSavedLogQueries200JSONResponse) VisitListSavedLogQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedLogQueries400JSONResponse ErrorResponse
+
+func (response ListSavedLogQueries400JSONResponse) VisitListSavedLogQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedLogQueries401JSONResponse ErrorResponse
+
+func (response ListSavedLogQueries401JSONResponse) VisitListSavedLogQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedLogQueries403JSONResponse ErrorResponse
+
+func (response ListSavedLogQueries403JSONResponse) VisitListSavedLogQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedLogQueries500JSONResponse ErrorResponse
+
+func (response ListSavedLogQueries500JSONResponse) VisitListSavedLogQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedLogQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Body      *CreateSavedLogQueryJSONRequestBody
+}
+
+type CreateSavedLogQueryResponseObject interface {
+	VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error
+}
+
+type CreateSavedLogQuery201JSONResponse SavedLogQuery
+
+func (response CreateSavedLogQuery201JSONResponse) VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedLogQuery400JSONResponse ErrorResponse
+
+func (response CreateSavedLogQuery400JSONResponse) VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedLogQuery401JSONResponse ErrorResponse
+
+func (response CreateSavedLogQuery401JSONResponse) VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedLogQuery403JSONResponse ErrorResponse
+
+func (response CreateSavedLogQuery403JSONResponse) VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedLogQuery409JSONResponse ErrorResponse
+
+func (response CreateSavedLogQuery409JSONResponse) VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedLogQuery500JSONResponse ErrorResponse
+
+func (response CreateSavedLogQuery500JSONResponse) VisitCreateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedLogQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Name      string `json:"name"`
+}
+
+type DeleteSavedLogQueryResponseObject interface {
+	VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error
+}
+
+type DeleteSavedLogQuery204Response struct {
+}
+
+func (response DeleteSavedLogQuery204Response) VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSavedLogQuery400JSONResponse ErrorResponse
+
+func (response DeleteSavedLogQuery400JSONResponse) VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedLogQuery401JSONResponse ErrorResponse
+
+func (response DeleteSavedLogQuery401JSONResponse) VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedLogQuery403JSONResponse ErrorResponse
+
+func (response DeleteSavedLogQuery403JSONResponse) VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedLogQuery404JSONResponse ErrorResponse
+
+func (response DeleteSavedLogQuery404JSONResponse) VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedLogQuery500JSONResponse ErrorResponse
+
+func (response DeleteSavedLogQuery500JSONResponse) VisitDeleteSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedLogQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Name      string `json:"name"`
+}
+
+type GetSavedLogQueryResponseObject interface {
+	VisitGetSavedLogQueryResponse(w http.ResponseWriter) error
+}
+
+type GetSavedLogQuery200JSONResponse SavedLogQuery
+
+func (response GetSavedLogQuery200JSONResponse) VisitGetSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedLogQuery400JSONResponse ErrorResponse
+
+func (response GetSavedLogQuery400JSONResponse) VisitGetSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedLogQuery401JSONResponse ErrorResponse
+
+func (response GetSavedLogQuery401JSONResponse) VisitGetSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedLogQuery403JSONResponse ErrorResponse
+
+func (response GetSavedLogQuery403JSONResponse) VisitGetSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedLogQuery404JSONResponse ErrorResponse
+
+func (response GetSavedLogQuery404JSONResponse) VisitGetSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedLogQuery500JSONResponse ErrorResponse
+
+func (response GetSavedLogQuery500JSONResponse) VisitGetSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedLogQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Name      string `json:"name"`
+	Body      *UpdateSavedLogQueryJSONRequestBody
+}
+
+type UpdateSavedLogQueryResponseObject interface {
+	VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error
+}
+
+type UpdateSavedLogQuery200JSONResponse SavedLogQuery
+
+func (response UpdateSavedLogQuery200JSONResponse) VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedLogQuery400JSONResponse ErrorResponse
+
+func (response UpdateSavedLogQuery400JSONResponse) VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedLogQuery401JSONResponse ErrorResponse
+
+func (response UpdateSavedLogQuery401JSONResponse) VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedLogQuery403JSONResponse ErrorResponse
+
+func (response UpdateSavedLogQuery403JSONResponse) VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedLogQuery404JSONResponse ErrorResponse
+
+func (response UpdateSavedLogQuery404JSONResponse) VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedLogQuery500JSONResponse ErrorResponse
+
+func (response UpdateSavedLogQuery500JSONResponse) VisitUpdateSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedLogQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+	Name      string `json:"name"`
+	Body      *RunSavedLogQueryJSONRequestBody
+}
+
+type RunSavedLogQueryResponseObject interface {
+	VisitRunSavedLogQueryResponse(w http.ResponseWriter) error
+}
+
+type RunSavedLogQuery200JSONResponse LogsQueryResponse
+
+func (response RunSavedLogQuery200JSONResponse) VisitRunSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedLogQuery400JSONResponse ErrorResponse
+
+func (response RunSavedLogQuery400JSONResponse) VisitRunSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedLogQuery401JSONResponse ErrorResponse
+
+func (response RunSavedLogQuery401JSONResponse) VisitRunSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedLogQuery403JSONResponse ErrorResponse
+
+func (response RunSavedLogQuery403JSONResponse) VisitRunSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedLogQuery404JSONResponse ErrorResponse
+
+func (response RunSavedLogQuery404JSONResponse) VisitRunSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedLogQuery500JSONResponse ErrorResponse
+
+func (response RunSavedLogQuery500JSONResponse) VisitRunSavedLogQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopologyRequestObject struct {
+	Body *QueryRuntimeTopologyJSONRequestBody
+}
+
+type QueryRuntimeTopologyResponseObject interface {
+	VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error
+}
+
+type QueryRuntimeTopology200JSONResponse RuntimeTopologyResponse
+
+func (response QueryRuntimeTopology200JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology400JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology400JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology401JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology401JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology403JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology403JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology500JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology500JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleDriftNotificationRequestObject struct {
+	Body *HandleDriftNotificationJSONRequestBody
+}
+
+type HandleDriftNotificationResponseObject interface {
+	VisitHandleDriftNotificationResponse(w http.ResponseWriter) error
+}
+
+type HandleDriftNotification200JSONResponse AlertWebhookResponse
+
+func (response HandleDriftNotification200JSONResponse) VisitHandleDriftNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleDriftNotification400JSONResponse ErrorResponse
+
+func (response HandleDriftNotification400JSONResponse) VisitHandleDriftNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleDriftNotification500JSONResponse ErrorResponse
+
+func (response HandleDriftNotification500JSONResponse) VisitHandleDriftNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTracesRequestObject struct {
+	Body *QueryTracesJSONRequestBody
+}
+
+type QueryTracesResponseObject interface {
+	VisitQueryTracesResponse(w http.ResponseWriter) error
+}
+
+type QueryTraces200JSONResponse TracesQueryResponse
+
+func (response QueryTraces200JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces400JSONResponse ErrorResponse
+
+func (response QueryTraces400JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces401JSONResponse ErrorResponse
+
+func (response QueryTraces401JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces403JSONResponse ErrorResponse
+
+func (response QueryTraces403JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces500JSONResponse ErrorResponse
+
+func (response QueryTraces500JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraceLogsRequestObject struct {
+	TraceId string `json:"traceId"`
+	Body    *QueryTraceLogsJSONRequestBody
+}
+
+type QueryTraceLogsResponseObject interface {
+	VisitQueryTraceLogsResponse(w http.ResponseWriter) error
+}
+
+type QueryTraceLogs200JSONResponse LogsQueryResponse
+
+func (response QueryTraceLogs200JSONResponse) VisitQueryTraceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraceLogs400JSONResponse ErrorResponse
+
+func (response QueryTraceLogs400JSONResponse) VisitQueryTraceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraceLogs401JSONResponse ErrorResponse
+
+func (response QueryTraceLogs401JSONResponse) VisitQueryTraceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraceLogs403JSONResponse ErrorResponse
+
+func (response QueryTraceLogs403JSONResponse) VisitQueryTraceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraceLogs500JSONResponse ErrorResponse
+
+func (response QueryTraceLogs500JSONResponse) VisitQueryTraceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTraceRequestObject struct {
+	TraceId string `json:"traceId"`
+	Body    *QuerySpansForTraceJSONRequestBody
+}
+
+type QuerySpansForTraceResponseObject interface {
+	VisitQuerySpansForTraceResponse(w http.ResponseWriter) error
+}
+
+type QuerySpansForTrace200JSONResponse TraceSpansQueryResponse
+
+func (response QuerySpansForTrace200JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace400JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace400JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace401JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace401JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace403JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace403JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace500JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace500JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTraceRequestObject struct {
+	TraceId string `json:"traceId"`
+	SpanId  string `json:"spanId"`
+}
+
+type GetSpanDetailsForTraceResponseObject interface {
+	VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error
+}
+
+type GetSpanDetailsForTrace200JSONResponse TraceSpanDetailsResponse
+
+func (response GetSpanDetailsForTrace200JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace400JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace400JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace401JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace401JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace403JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace403JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace500JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace500JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health503JSONResponse struct {
+	Error  *string `json:"error,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+func (response Health503JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Query events
+	// (POST /api/v1/events/query)
+	QueryEvents(ctx context.Context, request QueryEventsRequestObject) (QueryEventsResponseObject, error)
+	// Query logs
 	// (POST /api/v1/logs/query)
 	QueryLogs(ctx context.Context, request QueryLogsRequestObject) (QueryLogsResponseObject, error)
+	// Tail logs
+	// (POST /api/v1/logs/tail)
+	TailLogs(ctx context.Context, request TailLogsRequestObject) (TailLogsResponseObject, error)
 	// Query metrics
 	// (POST /api/v1/metrics/query)
 	QueryMetrics(ctx context.Context, request QueryMetricsRequestObject) (QueryMetricsResponseObject, error)
@@ -1795,12 +3175,48 @@ type StrictServerInterface interface {
 	// Update incident
 	// (PUT /api/v1alpha1/incidents/{incidentId})
 	UpdateIncident(ctx context.Context, request UpdateIncidentRequestObject) (UpdateIncidentResponseObject, error)
+	// List log metrics
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics)
+	ListLogMetrics(ctx context.Context, request ListLogMetricsRequestObject) (ListLogMetricsResponseObject, error)
+	// Create log metric
+	// (POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics)
+	CreateLogMetric(ctx context.Context, request CreateLogMetricRequestObject) (CreateLogMetricResponseObject, error)
+	// Delete log metric
+	// (DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name})
+	DeleteLogMetric(ctx context.Context, request DeleteLogMetricRequestObject) (DeleteLogMetricResponseObject, error)
+	// Get log metric
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name})
+	GetLogMetric(ctx context.Context, request GetLogMetricRequestObject) (GetLogMetricResponseObject, error)
+	// List saved log queries
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries)
+	ListSavedLogQueries(ctx context.Context, request ListSavedLogQueriesRequestObject) (ListSavedLogQueriesResponseObject, error)
+	// Create saved log query
+	// (POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries)
+	CreateSavedLogQuery(ctx context.Context, request CreateSavedLogQueryRequestObject) (CreateSavedLogQueryResponseObject, error)
+	// Delete saved log query
+	// (DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name})
+	DeleteSavedLogQuery(ctx context.Context, request DeleteSavedLogQueryRequestObject) (DeleteSavedLogQueryResponseObject, error)
+	// Get saved log query
+	// (GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name})
+	GetSavedLogQuery(ctx context.Context, request GetSavedLogQueryRequestObject) (GetSavedLogQueryResponseObject, error)
+	// Replace saved log query
+	// (PUT /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name})
+	UpdateSavedLogQuery(ctx context.Context, request UpdateSavedLogQueryRequestObject) (UpdateSavedLogQueryResponseObject, error)
+	// Run saved log query
+	// (POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}/run)
+	RunSavedLogQuery(ctx context.Context, request RunSavedLogQueryRequestObject) (RunSavedLogQueryResponseObject, error)
 	// Query runtime topology
 	// (POST /api/v1alpha1/metrics/runtime-topology)
 	QueryRuntimeTopology(ctx context.Context, request QueryRuntimeTopologyRequestObject) (QueryRuntimeTopologyResponseObject, error)
+	// Sends drift notifications
+	// (POST /api/v1alpha1/notifications/drift)
+	HandleDriftNotification(ctx context.Context, request HandleDriftNotificationRequestObject) (HandleDriftNotificationResponseObject, error)
 	// Query traces
 	// (POST /api/v1alpha1/traces/query)
 	QueryTraces(ctx context.Context, request QueryTracesRequestObject) (QueryTracesResponseObject, error)
+	// Query logs correlated with a trace
+	// (POST /api/v1alpha1/traces/{traceId}/logs/query)
+	QueryTraceLogs(ctx context.Context, request QueryTraceLogsRequestObject) (QueryTraceLogsResponseObject, error)
 	// Query spans for a trace
 	// (POST /api/v1alpha1/traces/{traceId}/spans/query)
 	QuerySpansForTrace(ctx context.Context, request QuerySpansForTraceRequestObject) (QuerySpansForTraceResponseObject, error)
@@ -1812,40 +3228,318 @@ type StrictServerInterface interface {
 	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// QueryEvents operation middleware
+func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
+	var request QueryEventsRequestObject
+
+	var body QueryEventsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryEvents(ctx, request.(QueryEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryEventsResponseObject); ok {
+		if err := validResponse.VisitQueryEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryLogs operation middleware
+func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
+	var request QueryLogsRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	var body QueryLogsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryLogs(ctx, request.(QueryLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryLogsResponseObject); ok {
+		if err := validResponse.VisitQueryLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// TailLogs operation middleware
+func (sh *strictHandler) TailLogs(w http.ResponseWriter, r *http.Request) {
+	var request TailLogsRequestObject
+
+	var body TailLogsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TailLogs(ctx, request.(TailLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TailLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TailLogsResponseObject); ok {
+		if err := validResponse.VisitTailLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// QueryMetrics operation middleware
+func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
+	var request QueryMetricsRequestObject
+
+	var body QueryMetricsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryMetrics(ctx, request.(QueryMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryMetrics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryMetricsResponseObject); ok {
+		if err := validResponse.VisitQueryMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// QueryAlerts operation middleware
+func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
+	var request QueryAlertsRequestObject
+
+	var body QueryAlertsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryAlerts(ctx, request.(QueryAlertsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryAlerts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryAlertsResponseObject); ok {
+		if err := validResponse.VisitQueryAlertsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// QueryEvents operation middleware
-func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
-	var request QueryEventsRequestObject
+// CreateAlertRule operation middleware
+func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string) {
+	var request CreateAlertRuleRequestObject
+
+	request.SourceType = sourceType
+
+	var body CreateAlertRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAlertRule(ctx, request.(CreateAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAlertRuleResponseObject); ok {
+		if err := validResponse.VisitCreateAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAlertRule operation middleware
+func (sh *strictHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string, params DeleteAlertRuleParams) {
+	var request DeleteAlertRuleRequestObject
+
+	request.SourceType = sourceType
+	request.RuleName = ruleName
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAlertRule(ctx, request.(DeleteAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAlertRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAlertRule operation middleware
+func (sh *strictHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string, params GetAlertRuleParams) {
+	var request GetAlertRuleRequestObject
+
+	request.SourceType = sourceType
+	request.RuleName = ruleName
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlertRule(ctx, request.(GetAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlertRuleResponseObject); ok {
+		if err := validResponse.VisitGetAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAlertRule operation middleware
+func (sh *strictHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
+	var request UpdateAlertRuleRequestObject
+
+	request.SourceType = sourceType
+	request.RuleName = ruleName
+
+	var body UpdateAlertRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAlertRule(ctx, request.(UpdateAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAlertRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HandleAlertWebhook operation middleware
+func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var request HandleAlertWebhookRequestObject
 
-	var body QueryEventsJSONRequestBody
+	var body HandleAlertWebhookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1853,18 +3547,18 @@ func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryEvents(ctx, request.(QueryEventsRequestObject))
+		return sh.ssi.HandleAlertWebhook(ctx, request.(HandleAlertWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryEvents")
+		handler = middleware(handler, "HandleAlertWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryEventsResponseObject); ok {
-		if err := validResponse.VisitQueryEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(HandleAlertWebhookResponseObject); ok {
+		if err := validResponse.VisitHandleAlertWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1872,30 +3566,55 @@ func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// QueryLogs operation middleware
-func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
-	var request QueryLogsRequestObject
+// GetComponentCosts operation middleware
+func (sh *strictHandler) GetComponentCosts(w http.ResponseWriter, r *http.Request, namespace FinOpsNamespace, environment FinOpsEnvironment, params GetComponentCostsParams) {
+	var request GetComponentCostsRequestObject
 
-	var body QueryLogsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.Namespace = namespace
+	request.Environment = environment
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetComponentCosts(ctx, request.(GetComponentCostsRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetComponentCosts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetComponentCostsResponseObject); ok {
+		if err := validResponse.VisitGetComponentCostsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRecommendations operation middleware
+func (sh *strictHandler) GetRecommendations(w http.ResponseWriter, r *http.Request, namespace FinOpsNamespace, environment FinOpsEnvironment, params GetRecommendationsParams) {
+	var request GetRecommendationsRequestObject
+
+	request.Namespace = namespace
+	request.Environment = environment
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryLogs(ctx, request.(QueryLogsRequestObject))
+		return sh.ssi.GetRecommendations(ctx, request.(GetRecommendationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryLogs")
+		handler = middleware(handler, "GetRecommendations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryLogsResponseObject); ok {
-		if err := validResponse.VisitQueryLogsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRecommendationsResponseObject); ok {
+		if err := validResponse.VisitGetRecommendationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1903,11 +3622,11 @@ func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// QueryMetrics operation middleware
-func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
-	var request QueryMetricsRequestObject
+// QueryIncidents operation middleware
+func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request) {
+	var request QueryIncidentsRequestObject
 
-	var body QueryMetricsJSONRequestBody
+	var body QueryIncidentsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1915,18 +3634,18 @@ func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryMetrics(ctx, request.(QueryMetricsRequestObject))
+		return sh.ssi.QueryIncidents(ctx, request.(QueryIncidentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryMetrics")
+		handler = middleware(handler, "QueryIncidents")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryMetricsResponseObject); ok {
-		if err := validResponse.VisitQueryMetricsResponse(w); err != nil {
+	} else if validResponse, ok := response.(QueryIncidentsResponseObject); ok {
+		if err := validResponse.VisitQueryIncidentsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1934,11 +3653,13 @@ func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// QueryAlerts operation middleware
-func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
-	var request QueryAlertsRequestObject
+// UpdateIncident operation middleware
+func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string) {
+	var request UpdateIncidentRequestObject
 
-	var body QueryAlertsJSONRequestBody
+	request.IncidentId = incidentId
+
+	var body UpdateIncidentJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1946,18 +3667,18 @@ func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryAlerts(ctx, request.(QueryAlertsRequestObject))
+		return sh.ssi.UpdateIncident(ctx, request.(UpdateIncidentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryAlerts")
+		handler = middleware(handler, "UpdateIncident")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryAlertsResponseObject); ok {
-		if err := validResponse.VisitQueryAlertsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateIncidentResponseObject); ok {
+		if err := validResponse.VisitUpdateIncidentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1965,13 +3686,41 @@ func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateAlertRule operation middleware
-func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string) {
-	var request CreateAlertRuleRequestObject
+// ListLogMetrics operation middleware
+func (sh *strictHandler) ListLogMetrics(w http.ResponseWriter, r *http.Request, namespace string, project string) {
+	var request ListLogMetricsRequestObject
 
-	request.SourceType = sourceType
+	request.Namespace = namespace
+	request.Project = project
 
-	var body CreateAlertRuleJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListLogMetrics(ctx, request.(ListLogMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListLogMetrics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListLogMetricsResponseObject); ok {
+		if err := validResponse.VisitListLogMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateLogMetric operation middleware
+func (sh *strictHandler) CreateLogMetric(w http.ResponseWriter, r *http.Request, namespace string, project string) {
+	var request CreateLogMetricRequestObject
+
+	request.Namespace = namespace
+	request.Project = project
+
+	var body CreateLogMetricJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1979,18 +3728,18 @@ func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAlertRule(ctx, request.(CreateAlertRuleRequestObject))
+		return sh.ssi.CreateLogMetric(ctx, request.(CreateLogMetricRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAlertRule")
+		handler = middleware(handler, "CreateLogMetric")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAlertRuleResponseObject); ok {
-		if err := validResponse.VisitCreateAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateLogMetricResponseObject); ok {
+		if err := validResponse.VisitCreateLogMetricResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1998,26 +3747,27 @@ func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteAlertRule operation middleware
-func (sh *strictHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
-	var request DeleteAlertRuleRequestObject
+// DeleteLogMetric operation middleware
+func (sh *strictHandler) DeleteLogMetric(w http.ResponseWriter, r *http.Request, namespace string, project string, name string) {
+	var request DeleteLogMetricRequestObject
 
-	request.SourceType = sourceType
-	request.RuleName = ruleName
+	request.Namespace = namespace
+	request.Project = project
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAlertRule(ctx, request.(DeleteAlertRuleRequestObject))
+		return sh.ssi.DeleteLogMetric(ctx, request.(DeleteLogMetricRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAlertRule")
+		handler = middleware(handler, "DeleteLogMetric")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAlertRuleResponseObject); ok {
-		if err := validResponse.VisitDeleteAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteLogMetricResponseObject); ok {
+		if err := validResponse.VisitDeleteLogMetricResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2025,26 +3775,27 @@ func (sh *strictHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetAlertRule operation middleware
-func (sh *strictHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
-	var request GetAlertRuleRequestObject
+// GetLogMetric operation middleware
+func (sh *strictHandler) GetLogMetric(w http.ResponseWriter, r *http.Request, namespace string, project string, name string) {
+	var request GetLogMetricRequestObject
 
-	request.SourceType = sourceType
-	request.RuleName = ruleName
+	request.Namespace = namespace
+	request.Project = project
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAlertRule(ctx, request.(GetAlertRuleRequestObject))
+		return sh.ssi.GetLogMetric(ctx, request.(GetLogMetricRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAlertRule")
+		handler = middleware(handler, "GetLogMetric")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAlertRuleResponseObject); ok {
-		if err := validResponse.VisitGetAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetLogMetricResponseObject); ok {
+		if err := validResponse.VisitGetLogMetricResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2052,33 +3803,26 @@ func (sh *strictHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, so
 	}
 }
 
-// UpdateAlertRule operation middleware
-func (sh *strictHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
-	var request UpdateAlertRuleRequestObject
-
-	request.SourceType = sourceType
-	request.RuleName = ruleName
+// ListSavedLogQueries operation middleware
+func (sh *strictHandler) ListSavedLogQueries(w http.ResponseWriter, r *http.Request, namespace string, project string) {
+	var request ListSavedLogQueriesRequestObject
 
-	var body UpdateAlertRuleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Namespace = namespace
+	request.Project = project
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAlertRule(ctx, request.(UpdateAlertRuleRequestObject))
+		return sh.ssi.ListSavedLogQueries(ctx, request.(ListSavedLogQueriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAlertRule")
+		handler = middleware(handler, "ListSavedLogQueries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAlertRuleResponseObject); ok {
-		if err := validResponse.VisitUpdateAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSavedLogQueriesResponseObject); ok {
+		if err := validResponse.VisitListSavedLogQueriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2086,11 +3830,14 @@ func (sh *strictHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// HandleAlertWebhook operation middleware
-func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Request) {
-	var request HandleAlertWebhookRequestObject
+// CreateSavedLogQuery operation middleware
+func (sh *strictHandler) CreateSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string) {
+	var request CreateSavedLogQueryRequestObject
 
-	var body HandleAlertWebhookJSONRequestBody
+	request.Namespace = namespace
+	request.Project = project
+
+	var body CreateSavedLogQueryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2098,18 +3845,18 @@ func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.HandleAlertWebhook(ctx, request.(HandleAlertWebhookRequestObject))
+		return sh.ssi.CreateSavedLogQuery(ctx, request.(CreateSavedLogQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HandleAlertWebhook")
+		handler = middleware(handler, "CreateSavedLogQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HandleAlertWebhookResponseObject); ok {
-		if err := validResponse.VisitHandleAlertWebhookResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSavedLogQueryResponseObject); ok {
+		if err := validResponse.VisitCreateSavedLogQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2117,27 +3864,27 @@ func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetComponentCosts operation middleware
-func (sh *strictHandler) GetComponentCosts(w http.ResponseWriter, r *http.Request, namespace FinOpsNamespace, environment FinOpsEnvironment, params GetComponentCostsParams) {
-	var request GetComponentCostsRequestObject
+// DeleteSavedLogQuery operation middleware
+func (sh *strictHandler) DeleteSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string) {
+	var request DeleteSavedLogQueryRequestObject
 
 	request.Namespace = namespace
-	request.Environment = environment
-	request.Params = params
+	request.Project = project
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetComponentCosts(ctx, request.(GetComponentCostsRequestObject))
+		return sh.ssi.DeleteSavedLogQuery(ctx, request.(DeleteSavedLogQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetComponentCosts")
+		handler = middleware(handler, "DeleteSavedLogQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetComponentCostsResponseObject); ok {
-		if err := validResponse.VisitGetComponentCostsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSavedLogQueryResponseObject); ok {
+		if err := validResponse.VisitDeleteSavedLogQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2145,27 +3892,27 @@ func (sh *strictHandler) GetComponentCosts(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetRecommendations operation middleware
-func (sh *strictHandler) GetRecommendations(w http.ResponseWriter, r *http.Request, namespace FinOpsNamespace, environment FinOpsEnvironment, params GetRecommendationsParams) {
-	var request GetRecommendationsRequestObject
+// GetSavedLogQuery operation middleware
+func (sh *strictHandler) GetSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string) {
+	var request GetSavedLogQueryRequestObject
 
 	request.Namespace = namespace
-	request.Environment = environment
-	request.Params = params
+	request.Project = project
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRecommendations(ctx, request.(GetRecommendationsRequestObject))
+		return sh.ssi.GetSavedLogQuery(ctx, request.(GetSavedLogQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRecommendations")
+		handler = middleware(handler, "GetSavedLogQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRecommendationsResponseObject); ok {
-		if err := validResponse.VisitGetRecommendationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSavedLogQueryResponseObject); ok {
+		if err := validResponse.VisitGetSavedLogQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2173,11 +3920,15 @@ func (sh *strictHandler) GetRecommendations(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// QueryIncidents operation middleware
-func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request) {
-	var request QueryIncidentsRequestObject
+// UpdateSavedLogQuery operation middleware
+func (sh *strictHandler) UpdateSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string) {
+	var request UpdateSavedLogQueryRequestObject
 
-	var body QueryIncidentsJSONRequestBody
+	request.Namespace = namespace
+	request.Project = project
+	request.Name = name
+
+	var body UpdateSavedLogQueryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2185,18 +3936,18 @@ func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryIncidents(ctx, request.(QueryIncidentsRequestObject))
+		return sh.ssi.UpdateSavedLogQuery(ctx, request.(UpdateSavedLogQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryIncidents")
+		handler = middleware(handler, "UpdateSavedLogQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryIncidentsResponseObject); ok {
-		if err := validResponse.VisitQueryIncidentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSavedLogQueryResponseObject); ok {
+		if err := validResponse.VisitUpdateSavedLogQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2204,13 +3955,15 @@ func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UpdateIncident operation middleware
-func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string) {
-	var request UpdateIncidentRequestObject
+// RunSavedLogQuery operation middleware
+func (sh *strictHandler) RunSavedLogQuery(w http.ResponseWriter, r *http.Request, namespace string, project string, name string) {
+	var request RunSavedLogQueryRequestObject
 
-	request.IncidentId = incidentId
+	request.Namespace = namespace
+	request.Project = project
+	request.Name = name
 
-	var body UpdateIncidentJSONRequestBody
+	var body RunSavedLogQueryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2218,18 +3971,18 @@ func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateIncident(ctx, request.(UpdateIncidentRequestObject))
+		return sh.ssi.RunSavedLogQuery(ctx, request.(RunSavedLogQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateIncident")
+		handler = middleware(handler, "RunSavedLogQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateIncidentResponseObject); ok {
-		if err := validResponse.VisitUpdateIncidentResponse(w); err != nil {
+	} else if validResponse, ok := response.(RunSavedLogQueryResponseObject); ok {
+		if err := validResponse.VisitRunSavedLogQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2268,6 +4021,37 @@ func (sh *strictHandler) QueryRuntimeTopology(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// HandleDriftNotification operation middleware
+func (sh *strictHandler) HandleDriftNotification(w http.ResponseWriter, r *http.Request) {
+	var request HandleDriftNotificationRequestObject
+
+	var body HandleDriftNotificationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HandleDriftNotification(ctx, request.(HandleDriftNotificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HandleDriftNotification")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HandleDriftNotificationResponseObject); ok {
+		if err := validResponse.VisitHandleDriftNotificationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // QueryTraces operation middleware
 func (sh *strictHandler) QueryTraces(w http.ResponseWriter, r *http.Request) {
 	var request QueryTracesRequestObject
@@ -2299,6 +4083,39 @@ func (sh *strictHandler) QueryTraces(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// QueryTraceLogs operation middleware
+func (sh *strictHandler) QueryTraceLogs(w http.ResponseWriter, r *http.Request, traceId string) {
+	var request QueryTraceLogsRequestObject
+
+	request.TraceId = traceId
+
+	var body QueryTraceLogsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryTraceLogs(ctx, request.(QueryTraceLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryTraceLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryTraceLogsResponseObject); ok {
+		if err := validResponse.VisitQueryTraceLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // QuerySpansForTrace operation middleware
 func (sh *strictHandler) QuerySpansForTrace(w http.ResponseWriter, r *http.Request, traceId string) {
 	var request QuerySpansForTraceRequestObject
@@ -2386,141 +4203,179 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x97XLbONfYrWDY7WzcyrKdbN55453+8HqdXT9vvmo7T2YauTVEHkl4TAFcAJSizXim",
-	"F9Er7JV08EWCFCiRspS4u/qT2CZ5cHBwPnEODr5GMZtmjAKVIjr9GmWY4ylI4Pq314S+z8S5e0P9KQER",
-	"c5JJwmh0GhWPEMVT6KNPE6BIgOwhijlnc4HkBBAHkTEqAEmG5IQIJAgdp4CKofvoCv7ICQeB7jLO/gWx",
-	"vOtHvYioMf7IgS+iXqRGiE5LfKNeJOIJTLFCC77gaZbq5xOI71kuDwXwGYkh6kVykaknQnJCx9HDQ89O",
-	"7IImN0QBrU/r4kuc5oLMAOVZBhwNWU4TxEZ6NjETEs0JTdgcPbt6fY5evHjx6qCP3uZCoiEgNUws0wUa",
-	"c8ASOJITTJGQmEs1WtO8wCLTi7ihRRKdSp5DeJbPj5//2+Hxy8PnP92cHJ8eH58en/yPqBeNGJ9iGZ1G",
-	"CZZwKA285unPCGd0GlxZ76FZW4d2huXEx7qE0Q7zjLMkj/Uozaj9xjHNU8yJXCyjppks4yCAyh4CHE9K",
-	"TkKKu7KUSESoZIhRxX0x4wlS66gIgoZ5fA8SsdGAWmb8E/roo1DMN8iPj1/EMcup1D+C+UNOif39DlEm",
-	"sUIEzSfAAalHalA1Ehuhu8ndgD6bsJyLgx66S+7QswQv1M+Mo7v5HXo2B7gXB330mnFkqYLuTiZ3PXT3",
-	"PFH/vpjf9Qe0gUvGHmHC9D1Jop5aJAlcff8/P58cvrr9fHz46va/fJ4k89sfVpD9HZ6CyHAcEIni0Spu",
-	"oMX37XghgRHOU7kCow9GGyzjYx+01julmkOEGi1kNU2TQNrHDWQex9nhlMScWTUjVkzi2sn+8jQuqdM0",
-	"KZu30jQ9JCBmNFESEBNBGD1omkGhc7oqlRfdlcqDA6kNx1kKXF7lKSi9DkKvX8ZZBlwS0G+oKRBDhPoj",
-	"oHiYKkwDgi8nWqECwmoExPMUlPS5Twq8hoylgGn0oCgjgc9wugzvZgLIPdU0V+pBMqTJiEasPtLytHuR",
-	"QhxLxsPQ3VMFNRcQhgk0n0ann6Ox4rWxVH/SMpHqH+EPtaDwR3QbGF1OOIgJS5Pw8MVjNMNpDiuxsLBp",
-	"Ph0CV7AN44UBW6bciGYPPjN+jsqlswN6K+aR159rSQk21CL60IumIHGCJQ5xmpX8j6SBTO8zoOcTxsFT",
-	"E+jj5a/FvHwhyHOShBjBs4RtBvJe7zyUEe/QAOqJ0x6r+ZY2q3oHyKj7JWjo/CoE0KrLNnO3r3acd41v",
-	"NBF6FZPjobC0Hr0qH4RYSLCcG3pUGWgKyqMLz8o8qwqA/dsQC0gM3YQn5XGW/69c4LFCeApTxhfFr8M8",
-	"GYMMCrqhURAFM7BkCL5AnEsj3ikb1xFYVh76D0G9sciKhbdUKSeQsrFGXRNlBdK19dJPl8lee6sQ42I5",
-	"ep6pCK2aZ2qMtd/bmr2t8Xhwbyn+jpZir9x3rtyXFXlYN3+C4YSx+8ZIQM9BRSlC4mnWgLN7XGGylrFJ",
-	"zwzxT6WWwuCNxqqBXlJSiqXfbUGgHJzNhKodu1cp32QYpyA0dzZwv35YxWBuQIamJSSWuQjDMs+aQDnm",
-	"E3kcg9DyxDnjrdjOTpXQsfIBrhc0bp4ujp0TsIyheYYkvgeK1A9NljPWu3pK6+RZ4n6i8QTTsf45gRTU",
-	"X0OCnmIhFYqQnMmWjK4+QWJB4yZO+gXH90CTywZlOjSP0eWv6JnSoiPOpogNhXJEhiQlcuFeOWjPvW/Y",
-	"mMQ4bRozNY/1mIqTW0LuykC1hRGasEonYJIGF6CRe8R/V2q2UUNB0z6xwkwRVzsmFrclG7VSM6VkSiwr",
-	"mK2w05Pj415IGvEXMs2nyKgjNRiRMBXKNHCQOadKbZt3NIzjXjQl1P5aDKw80LHRZgIwjyfXMTN24gcO",
-	"o+g0+k9H5UbZkd3QOSp2+a+9b7RN5fI9T4BXJqCRj0JzUO8jpj6oE8utIS6+DMqPaN5Is0zC5caLUYtE",
-	"/M2zcm/ep9rtOnZq1EPGaodlhwipsC8su17mBhhNAmjk4/LXbdvCEgqhMUmAyovu8VPM6IiMcw6Jzgdx",
-	"Mh4DRw6gQPMJUDTSyxAKsZrdd+wiwTUR4PKci8cFcmaLP6RuqoBXB3ygiGlAFbHfM+iP+2gQnUwHUQ8N",
-	"opfTQXTQPdpTYoo5EQpLF/jlyiVUDmI5bj3kS/24b+sh3wRLt6BitS+1KuDTAmyjPjUbPB5zGBsyOuq9",
-	"tNQ7mQSpF9L0lYFC43p/aR8ZPdYZFDCDcJpLazT7dKXhI3TEol40x5wqoL0o5kQqAxzWoUUgFFLQ6lln",
-	"IWgRQxWsaX4/XBe+rA2JCoApGx9uJxgyM9xxSJTiIaRixd5DuwjDz4IvTXf9PobyBAOQumxdtMOzmh/e",
-	"aCvEw7UKrdXuh46i2uHqbyU3bVq0g1SmDjtvfnizLaF03u8IcR5lkoxIrIX6fIIptXwYmIr3JortqxUp",
-	"6aOLaSYXiIyQ8baVKdefLfq+z7KG4IFxmsU3wpzjhf59h5sFIcotjc/Y/VuxwniZKLLYNypw0LnnKUlT",
-	"YnK4nrLyPHPJZJNDoR95MUBd5RVQQtMo3PhzFs7INpb5/J5PMT3kgBPl7Xlbn64WoKMKCqkfk5O3xRpD",
-	"SBkdq+im30bQ4yx3c6rVJ334aLLoZrO4GOBHYR2MCvhRynDQW4HRiMQEaLwIlUAJeTgHMp5ISNAwBZO/",
-	"VyNjmiCzC4hySVLyp+H0JVwG1CKDzkr3+7j/7y/RFDAV6N9f/mf1l7lyr+ZYoAwTY/vUL8oQmnqRpXmU",
-	"8aAXDB6H5re+EArKsgTrmvnrFbMZcNFvHfbCqtKjGrdBoBKphSFZyzRmZcJ889as2hZYh3YpqWmyFmtp",
-	"lPnVMOvNzlraiDYVKybc3hZT1MLvigLpVav+gpH5Ur636ixUcsOlZfVtvtMiFdaoCP/tKq36ho0vqDRe",
-	"a1WzpjCDtHGrDJnHoc0hNm7+yu3dBjm7DJGDHrl+WuwwsjECjXivu08aTIhpG6+DwTFQFZsq/8CMtJm7",
-	"2px2axpkvclgVGJCgTfPrXil64RaeckNGb7Nh9ool7gx/Vr41t64tKLqOswvY0nzAP+RD4FTkCBQxpIN",
-	"QXfJwtQG7DDWuughkPPsOp0Ns6obckDQT+7ol/uaZ1PfvFC/VxCz6RRogsOVHjt1b9erm5xzO/aqnfYr",
-	"MNsPHzgbkRSeiqtUcWKejJPCl1a8E2nbOxxbcC3s8i8hvdKjuK6maFbw8zoHe/MVXV+EZ8CEJyJkcxak",
-	"YZPgdYplkQXJgB+W4qjdceNcij76ROSE5RLdeTXxdz3EKAyoV/PvHTJRXwReR6G3B7R2YMDW5DukW+XL",
-	"tAe5tIFQo6EBGaLfBeeMNxNQJ8nPWdKUmFSPUcwS8JO+wFF5MKYs/X7/y/XhP08O3xw+fx72KBsKBeoK",
-	"SI9ZuqblAG+JEISOkZs5GhFIE4F+LLz5H3W8/KP16H8MbgQRma6crTey3b0d4sTldXtRTnEuJ4yTP02i",
-	"mPEhSRJQC0uZfM1yakSfjlKiZVhnbShOrzXl9HqYdy/VtJR0tU40X8x0uiwYIKyswwD14fbcfQ1u266+",
-	"w5IIhIVgMdHOxJzowyHbdfhXDrWdDezVrnm3uT7aQX/cfB/ppnebq2H2/yC0YZ73pNxE8lxp85k/Gp2x",
-	"dAbCZvvOOaP/YMOD5iHb7cq3GXL1GBvGCp1Ge0So0G21Ng4YHsORIc3IAYumnKyYMC57aIrjCaFQGhrz",
-	"TVEDbBAy7HKN58oA6yqsJrbpGqk4pdlud7M53Wjw1ElHg+w7BTDtoU8medsyla1tyb5oKWIU3o+i088b",
-	"lS+t/ugT4/ejlM0r39zui55u17Fjo7c6c2fMG8RCaMQJJMjWgY7yNF209bk992pLGTuL1JYzdlMslSob",
-	"W/A9FOMsgwRhiZQAtEzl/S5l9lZXJAi1RteKboGighRLoPHiw8vjSsC1io5LUC8lTEMkdbBf7RL2q+3D",
-	"ngKmbwz87QPnRhufs9zE3duFXsrF1U7Hyem3GSnE2Ze2DvBDLhtN2yaVXK6+MOgkMxkAHL1Tf667OGuB",
-	"ta9i9qAUpiCWZKZULo7vKZunkJiicg5COYzJ+mN2dvjbdaRtrpEvB15fpK5rNf256JR0DfkOZzSaClrL",
-	"egFTpOVXGtpd61Vrsm2Gcc/Wo9sGyo2Zxxk5Z0KeUZwuBBHNFbVnl2YjDNs3NclLWjiXeHnkyjnF2tBX",
-	"MV454tX52Sbj7Kvd9tVuu69227IGd8p2U/VXKOu2qu9bm4xeVIjxpnMsADwidefs0T6Q3Z++2U4gWueo",
-	"xtSTe2/1GZzyteZjOHt3ae8u7d2lvbu0d5f+yu5Sx3SBN27LjMFT8Me2sWlaHuPc8r6pb4vb7JC+YeO/",
-	"o1+ZsvEbmFkjUvgsjvl/vfjl429RL7p89/p91Is+nV29i3rRxdXV+6sw3/sc0YtySv7I4dJAlTyHwpH9",
-	"MOFYhGuJ9imbp+gpe+LR5CSnbCway88bkzXl+nYrlSqq55cVUUtQbvmbId1uqNT0fHeVB3JFsAQ2TQbZ",
-	"3fbvpu1WHf9152vrfYw8WeJQHLmdSJmFBWoL4fIuZVKBh6wJMmQVmIoMCUjgU0JN2UbJFhkjyngW+r+P",
-	"LvrjPjqZ9tDLaQ+dqH9eHKufJms1Q3FkeTMVUWWrUku00+Cu3HY5S7lOjYdzm1p4w3mlJV7v6q7pVRca",
-	"oLa/rRd91rKNxsoBWD4MNcULSXq1xr1zae17W+tagqjWvCodJxQ/9NGZ69EOpsxRu50Dele8e2c6bUNM",
-	"RgSSn3Ud7RIwL1BBTMXGcyJgk0LaWnH/5iW1zWy5HHRn+Rvlx4ntJ1jjLLeKejfAP7pq0u1CToi4vwKc",
-	"/LKQIHYD/hMnEnYE35zq29WaGui7W1YDf0crS0HOGb+/ghjIbFf0t4PccEzFlMidjPKwQubdyY8lvehe",
-	"QLZ8Q/SQjuBET5ekcxB5KpWvFjOh76qwr9sS9lzAgHrlnn/kmEoiF+VVAVggYzRcSevdIHr+4ng6iO70",
-	"xuD5h48Dqr3LmHHlB6rnL4/fEveCWfwDozqX1FSrs+/KAKUQ60NeXoMdrOtShW6Wb2YlQKrJinbnm52W",
-	"rHaSf/nieNpwUN/zUL3O8w3vdzujvaMpenqjNsvj47ekGe3wTF8Gv6kfRirp5JG4DriKWsMB5qAVzKki",
-	"0A3LWMrGi4skdPbgjLojIwmSHI9GJEYqvjKnSbBrFkRZopvyYiQxH4PUf+gvN8oKbGleS13Lq/d1lBvB",
-	"y3reZAx9dM7oTD1i9HRAD0vX4tDcxVH8forufvgqeFy4Cw+n+vdrc9Dlwb7/w9dEyMo7iZDunTs1whhL",
-	"mOPFMnyE7uyz0x++2p/e4WkH0HXk4Ys5WXKK2iFfvP/D1wkTUgFtjsXWatMaA1jlaregJYtZIIr9RLj2",
-	"5vRj59nWOaTvBXbN0VzRgqoDju9YAlcw0ipfM9qm39d9xcTvNW5BtxCatyWla3Jj25UB+v3m5oNteCUQ",
-	"m9lueNbIVJVUf+CKEk3kIBDmoP1xs92AnsWMCiKk9sWJnKAjnJGj2cmRhX+kQ8ughahWgVaRfXksJ8pv",
-	"j5WcpQVyyH7T81Dot4la6nWh1dFe7W60V4HRXm17tFrtaN0eYbqFMeolpLXQsraNpFnMuS2lNNrYK9Co",
-	"pHngVVWftTxRMbz/DXpGGT18/uXLQQ2r7sg8rBe/d8Fzj2fGHNXpwM23SNqPe0aEiBRFc0FInKT2mw/H",
-	"BTe4O58Fn1h3ZunBvT05VbQa9rKv1ujoM5XGEgRV66P1v+tpuLUTw917z9jjqWsPH2ty3bZjFaX5A37/",
-	"CDjQ2PovmnUaOKaPzGkknAFKIAOlkhlFdwqHO+2dqJ/+m++S+Hyhd0twOscLgTKW5alJr7pUXoIlHlCk",
-	"nAQQ1r+iWooOnfmwTZp/Rv4WjO2uTAQakTSFRMEogBbnfWOtl3R6ExHZL5B1Ho3ybhQgjWRxPtd12jRH",
-	"YEGafpuESo71bwclIM+XwRKlgIUs70NjQt7py89KvI+qtFFYiwnL00Rfnwfy5/IWwKO7kns0foTGaZ74",
-	"xDO2WwHRjxFGCRnphZXFFV+huKntrYbomR6qur76PjfdatXOHcWcCXHodrsMUuJgg+ZoTWdf++hDwTma",
-	"RWTRXtNjj1zAKE8HVOEmjH9dpBoKkk2qp7b1LIlAOcUzTFL1t1pHsXWqrHYoXIVkHtUcjcLU2ILWCzdi",
-	"/c18vLSIFmgYm1b9ukzzwgRQSmagmK5b8y7/7roQnfSuQ8nazXx90O9aRRI+1NmqyZ6nl2uBAeP3KcMJ",
-	"Apro/EWz2IQQ3lCre9F1XasbF2zIEnNlx4f31zfOXcZpNsGl02zV/GGh5gfUS4ugaS6k0zhlBVDPkc5s",
-	"D/lHtv/v//4/znQMqAOKyrsGD+tfHOr99sSYF6anoHRJ2YVC7zH1EBmZ6w05mOtFhd2V0qkiFS0LW+TH",
-	"8nhifiwbXwS0X/d0ICquZ2uXG7Fku3By66fezRWEDQ3DWUFxOy92pNWdd4Mjy6UgCVTDqQF1HP2sqouZ",
-	"clVHh1mKpUL9oI9+NYgI04A8t9ouWPenELGKRGwyB6tskA3jPZWuZxfAJYhJhwRoTU62kgbttvj1CgUP",
-	"g3AyspW4l8muZbTr7lpx9WcfKe9PGLHT7GS7uiFG0wVSkaESCaUnBnQ+IUp47F7XHHsBVZKrqa2I39G1",
-	"xFJfFmQwGNBnc6cXjcOog/sxx9lEe2zv3t+Uzoy7ktSh/TMi0mifIQzoCGQ8gQQJyDDHEtJF6QB4Cv3s",
-	"w2VQ1NW0W++zh7YGQ3v5iqqbAtWxW/B843SKTX+ULgxuv1piO/v3FsxVa7SE03TjGqXbpgZJfmsov1T1",
-	"NoBOSYf6FoPt5WL+PHQs6cto9eZbxcQK91yaGuT+KkvQTrEXzeJMrWLrms1S7WytyslHJbTM1xmm1w3V",
-	"ohe6EIUwWqsZFRmmPTRiacrmjr5KyG50JlzyhX4DGbBoyhJIQzsGCawsUNVtmbwR++i9CZgGEbs3oZbu",
-	"aKR+ZBwNopwKFXX5+6vm7iTbk0g/b9gSaGgu9CvMIFVYH45wrKZaCwssqt5HfXSzyEiM03Sh3BGjQ7Wb",
-	"p+dDRIl2v13N6Q3HMahl+hUkJqlYcTBVSk6Guc0Z4sRc2IHTD95bIZt8YymMPACh2yHsdQfvGmrDktp1",
-	"CBokoYhiysqSsIKzCZX/9lOwRqyT56VGae1xZVjFBoqYTQctzBsG9/DtMC5FdvYIahdpttUUFysQXYGh",
-	"etSuxZAlXhBCu1L9RghdnahO61jWt68yP55uWy1Z6+o8FW5rDkKZV5oPQe1Fcy+aa0WzlWD9LURzGwct",
-	"tEjurChZQ9+wHFkrnu9XjWxjqqqUFFH7CKeiTdheU0tFWqAIK/2wXQMNx+37E8Z/rXMTFeZusqibyLPU",
-	"gHcm0AZ8C4nuRebV1Q6BfafRI+hqsjW83dtsPUxrTTLBQnd8XXG4GNNF4W6U85hggTC1LWmNyQhrB86Y",
-	"5xQsW3z72NnUxhfeNSVtFW5NlQSVcweFOfHJFFAqXQW0G8X1202Oh6Ft2PPQz9o5DrXJtT8QGXpj6cxR",
-	"6BTVRnd4fI9e+qEDdEsTWl0HILG4b+TGuYV/lTdxbIf24trExTkncnGt7JjB7hfAHPhZLifqt6H+7bUj",
-	"xz8+3SzZrX98ukGSKXWsr5rM5QSotHeS9dGldQc04+i3rIic2T7SRotNACujhwX60SCA9G5/rD8xG/8/",
-	"Kg2gDa7WAfqtclV0qdzDg3ZfRszeWCqxSR6a7KafursBPF1q1FBv2vre5f/PPlyijLMZSUAUOTq95W3s",
-	"jz2FJnoD6syEubnKpJb1VnOxEua70okokmFiKRumAGKB5pCmijT6FJ0G5vhA9Af0UuqrUcccKzdLl+W4",
-	"be7aNdVTluQpGIcLZGwOpuNY5jjVBRRoRvCAqsnGOE1NPkW9keBMMi4cCRI0NBbXwjNb5imJwdpyS+6z",
-	"DMcTQM/7ykrmPLWrJE6PjubzeR/rx33Gx0f2W3H05vL84t31xeHz/nF/Iqep17I8aliYqBfNgAuzgCf9",
-	"4/6xvfqV4oxEp9GL/nH/RaQCSDnRDO7K/kz7yKPinswsmInXjorfitg2tSzSB4Gm8OY6WcK0VTIQTIPP",
-	"qChO+4UlC8ektoICZ1lqxeboX7adr/EvW3XurMYLD1VFYI8aO+db0+H58fFuMLBOnUahtmO8okvpQy/6",
-	"qRVGRSV4pX9/FHn7tJv1yrd85vW7f+i1nX/lnoHAzC/pDKckcakvM9uTLc3WAWccTe3Etd70JlXp27+9",
-	"aX2sgf3p+MWW5nSdm5bfxgx8Wfxps7/KM6QMZcD1VJkOAmYE5k4w2QiVZSYjxnrIFYsMMe+hsjJpiP9U",
-	"tujCKz5IzH6+a6diaVdecrA9wr32Yb58DN/beycuDo9PKgT0JhC6g2GbrG1rbgx4VMB/uTUG9/SGrgWh",
-	"TCJS3h/h7JF3L7o2ldpwGSfBUqJ278T2iPCOSVSB7CdjrREBZwMkHgvlnFmjcKtedlZJId7OJpXeQHsz",
-	"9IaNd2WEltqFfGMTtNyPIbBMbxr7LuzNz978PMr8aHH8mxqfN4fPXz0p4xPQvqlRfU73ak1Y0byVY0Dr",
-	"lG8ltGuvf90xgd2o4FAbk2+shYMtLwLrZt97pC7+3trxu6qx76cMvrnsTguxceLrBMmXYFuZbG50byfG",
-	"9k75jlJ85u6M34UQG+DfU4YrGDQvn3ltL8F7CW4hwdiJjBNgK0PN8mvP/xx9NT/cLDJ4OOJ5ahvNYI6n",
-	"IIELXWUayqTqKg3XPavs5KtAoGcpG/esWtHlgcM8GYM80BcWRqd6szByp2KiEoOoLoe+I+NStOb236J3",
-	"lAEdunfittegnM45YAkIUx9nQtupKPOxpu9VnsIu1ZSC30lJnWx3fELHCoXrBY3XaipDxFgT5ylqq1ff",
-	"bnyPHjjlgJMFgi9ESPEkFYgThgLp7WiRo6/qP92CwghgCjJY4qv+vqEomo+rorhLo91dHsy0n6I8/PRd",
-	"5IEyiUb6FtunKAqOGVeKQi+yrT1qZzlBbsjFv4H8dixsTEqrteLKwsJsz73/n3Cv5sA1rPuX8Ot66ypo",
-	"KlQIIOYs00q0Qt5kHhD8j1myuTNpPn6azuR3N565Js7TUz9PTvIdC27kws1hOGHsvnkr53dME13WUlwV",
-	"Ut/WwXZ9XZOHJTY3IDQqn+xwO+R0O8T3ZPYChXWMbqmPJppCe15v4nVbSBedfr71OX8j3lwvGjETUhyV",
-	"JWVHX4ufH478ArKjr95vOtAJOodXukBeIIxGKZZFkXIG/LA8bq/7RHKIGU9E0WewGHdA50ROrF0ZkxlQ",
-	"v5Ktjz4KKJvS6Oyn34dHMkQx52w+oPpsRswyMOVwd2OOaZ5iRV39nshSIhHgeFJplSyZKZsd5vE9SFuU",
-	"tuTIFhm4c0XBaMnhCLFH+crRa0LfZ6K8yL6pE/fSJ16ir/1HNnfY/oNidu0/uS7qkTvMJen2wW/lCtrj",
-	"3TtSbWpRV6q0grPDXN3fb1x/t43rbxozvWMSvX6ygdJ5s8rFpcL9r7XLv6zRMCK3RaNxVG14L9YaEU7G",
-	"E3koyJ+mBKbyca322cUhRRv8Aa0YjQul5WsN99XXmINAcc71iU9ebz6t7MaAmgbUCI8xoZaKGgokrkFo",
-	"ApzMXC+wolFJLvAYdDuhAW02WKYWTas63TtOFr1JRZ5KMykV8c3xQpu0BUoYYtTWqSkkXR15tSHdFC9M",
-	"UwDTyM3dGlDgobvgCaaeNZi4q9py7W3cY2zcLs1Vw2UUKw3XKuHam6+9+XoC5utqnf7HyGssZm9Jqdy4",
-	"udaUFXfZtSvzKO/U61jpcendmbeL7YDwBc7feEOg4c7f4Oo7Ou6rPvZVH+urPipXTlqhLkVqpVx/La88",
-	"fmhV8LF8BTKSzG7RhnfZvUuVt7vPXiDQbZf9srzzdJe65kMuv7Oi0Ris1zJPdn997+J8C7JbJnjaOU0r",
-	"9P7tzm30XFP33GZHxgXX+qQ6mdmrMVynzaJrZ9W90j2Pl/ZiTwcUF/GEbkOJnvnHkW3DVdErW3MXZ54P",
-	"dCBcfq5bYw7osyJ6tqG4a9Zqb7Txb78RBz2ze6uPKi/fHjCgz9wdEDHLqezZNhD2F3svhHcthTgoGzQu",
-	"3xEyoNVLQsKOXq1/5I5UcEMD5m+shpv6wgZE4KreFXbv9+39vvV+X72ZsKcW64IWUI6mhUK7yM42/ekY",
-	"1t245ju7EPJA965vLOChFkuB9TSv7UV6L9ItRLroV+UE2cpQs/x+tb2IHo50a6R28my6KBkvxrQr6ija",
-	"ul/na8ZvbJOiDnGj62sUCBVdW6WOceJfWL0E+qIG+Eu/tdcwew3TQsMsif5jlM1X04C1ueLlN5AoMR2z",
-	"lfxj04RuM8XzG0ivAfeTUD691aPZlq2hmljTTK+zotu1rql3N29QNsWa7nXOXuesK0ZfKf9N2mcCODW9",
-	"8YJ65XwC8b25Iky/WLsboa5L+sulqAb+I2Wq1qC8aLpcXqNs0Fu06WsYEDWDPSICOTh6kV88AklzD0MF",
-	"R5aBvU3qFMWMUoh1BcgIkxSS1d2lSyA53dZUS0grSz7NuseKETwmsut6+1D7ttpx8fOtUqf2m6/L3Wvc",
-	"oRd/T65U3rqpxbLur3evWw3EdiVaBnPO9F1egownpsZmVTFCCLLN5y5D9kkW+tDS7uH24f8FAAD//1ih",
-	"n4pu4QAA",
+	"H4sIAAAAAAAC/+x9e3PbtrfgV8Fwu9Nkl5KdpLl7687+kebR+vdLk1zbvZm5lXcNk0cSbiiABUArasYz",
+	"+yH2E+4n2cGLBCmQImUpdlvN/ObXWAQOXueFg/P4EiVskTMKVIro5EuUY44XIIHrv15kwOVZkcE7vACR",
+	"4wTUrymIhJNcEkajk6j8hNgUyTkgrDohXmQwRmfwe0E4pGjKOMrY7BeQnCReExGj5Zwkc4Q5ICGZanu9",
+	"QrQEimmq/xpHcUTUeL8XwFdRHKkfo5OobBnFkUjmsMBqivAZL/JMfU9hiotMRnEkV7n6QUhO6Cy6vY2j",
+	"N4S+z8VLtwHrays/mSmgj3OgSICMEcWcs6XQC+YgckYFIMmQnBOBBKGzDFC5s+U+CHSVc/afkMirtvWU",
+	"nVrWk8wh+cQKORLAb0gCHQt7TdMLsggc2evPSVYIcgOoyHPg6JoVNHXHlzAh0ZLQlC3Ro7M3L9GzZ8++",
+	"fzxGvxRComt1SJwkMluhGQcsgSM5xxQJiblUo7WtC+xk4ohbnIhOJC8gvMqnx0//ZXT8fPT0u4snxyfH",
+	"xyfHT/4jiqMp4wss1aliCSNJFt3LvyGc0UXwZL2PdfTKsZz7s65g9Jt5zllaJHqU9qn9xDEtMsyJXK1P",
+	"TSNZzkEAlTECnMwrTEIKu/KMSESoZIhRQBwSxlOkzlFtCLoukk8gEZtOqEXGP2CMfhUK+SbF8fGzJGEF",
+	"lfqfYH4oKLF/XyHKJFYTQcs5cEDqkxpUjcSm6Gp+NaGP5qzg4nGMrtIr9CjFK/VvxtHV8go9WgJ8Eo/H",
+	"6A3jyO4Kunoyv4rR1dNU/f+z5dV4QluwZOZtTHh/n6RRrA5JAlf9/9dvT0bfX/52PPr+8r/9Nk+Xl990",
+	"bHsvLtaBDT6v6YMLm3nPB8MN1udjP/TmOxUXR4QaLmQ5TRtB2s8t2zxL8tGCJJxZNiM6FnHuaH99GafU",
+	"cZqMLXtxmhgJSBhNFQUkRBBGH7etoOQ5Q5nKs+FM5daBrMtFxddB6PPLOcuBSwK6hVoCMZvQ/AQUX2eQ",
+	"rm/WxznIOfCGEFXU57qU87pmLANMo1u1MxL4Dc7W4V3MAbmves8Ve5AM6W3UErk+0vqy40hNHEvGw9Dd",
+	"VwW1EBCGCbRYRCe/RTMZxdFMqp80TWT6n/C7OlD4PboMjC7nHMScZWl4+PIzusFZAZ2zsLBpsbgGrmAb",
+	"xAsDNt+227NbHxl/i6qjswN6J+Ztr7/WaifYtSbR2zhagMQpljiEaZbyfyUt2/Q+B/pyzjh4bAL9evqq",
+	"XJdPBEVB0hAieJKwz0Be88FDGfIODaC+rKuZbTBaWL0D1KK0opdnIYCWXfZZu206cN0NvNGbENdEjjeF",
+	"tfOI63gQQiHBCp7AOgKVavnmPc/YDC10WyNlPB3321LgOF3ftbzGAlKzwSK0s4uOwS2IGgGGwTouk+TF",
+	"/y4EnkGkIC8YX5V/XhfpDGSQ0ZgzCk7BDCwZgs+QFBLK5W1al/khBFJ9cVtqT6VaQMZmUbkp5aRj75gu",
+	"N+GO/rqOAo1WJUspUSP2xFYIgzyxZzSPg9w7yD0PBw9S6+8otQ6C5m8laNaFSlhOfITrOWOfWm9Iej3q",
+	"9iYkXuQt83efawjf884WmyH+XbHIMHjDPRug1xgmt/bPuxI377ajbiDwfqRX3/k2Ib0AoTG1hRL0x/oM",
+	"lgZkaFlCYlmIMCzzrQ2UQ0RRJAkITVucMx5d9l8qoTOlj5yvaNK+XJw4hWR9huYbkvgTUMRouxRPtLVT",
+	"ccAiT92/aDLHdKb/nUIG6tcQ0WdYSDVFSF/InoiuuiCxokkbJv2Ik09A09MWxn5tPqPTV+iR4uhTzhaI",
+	"XStrDr4mGZEr1+Rxf+x9y2YkwVnbmJn5rMdUmNwT8lAEahyM0BureAImGaRDsEf8m2K5rRwK2uznamZq",
+	"c7WSZOe2Ji87OVNGFsSigjERnjw5Po5D1Ig/k0WxQIYdqcGIhIVQYoKDLDiN4si20TCO42hBqP2zHJhQ",
+	"CTPDzQRgnszPE2ZkxjccptFJ9F+OKgPikTV0HZWvH+deHy3fuXzPU+C1BejJR6E1qPaI8dTM398sd4a4",
+	"7BmkH9FuYLRIwuXWh9G4FflGxerNwt+1y03o1MqHdKMW2iFC2ewrKa+PuQVGGwHqj+j01a5lYQWF0ISk",
+	"QOXr4Xe5hNEpmRUcUv1OxslsBhw5gEK9N1A01ccQuu61XyWwu5VuuI2ur7n8XE7OPH2E2E0dcPflE9Rm",
+	"GlCuIXoE49kYTaIni0kUo0n0fDGJHg+/eSoyxZwINUvbUN39zANrNW7z+pn5d9CdXz/nWLoDFd26VNfl",
+	"UxOwaaBXg2czDjOzjW73ntvdezIP7l6I09cGCo3r/dL/lnZXZVDADYSf/zRHs187BR+hU6bMyphTBTSO",
+	"Ek6kEsBhHlpeykIMWn0bTAQ97lMlapq/R5uuMhuvRyXAjM1Gu7kYmRX2vB5teyXK8DVkosMO0u+G4XsH",
+	"rC13s01FaYIBSEPMKP3mWX8338os4821Dq2XJUbfovrN1TextxlQ+kGqnlQHG2K81VZQBtteQphHmSRT",
+	"kmiifjnHlFo8DCzFa4kS27RGJWP0epHLFSJTZLRtJcp1t9XY11k2bHhgnHbyjTDneKX/3qOxILRza+Mz",
+	"9ukX0SG8zC2ytCGVc9Bv8guSZcS8bXvMytPMJZNtCoX+5N0BmiyvhBJaRqnGv2Thl+pW96efiwWmIw44",
+	"VdqeZ4Z1PhIDWVCI/RhfBevEcg0ZozN1uxn3IfQkL9yaGn5bH3413gXGcF0O8K2wCkYN/DRjOKitwHRK",
+	"EgI0CYgkNfBoCWQ2l8plLQPj16BGVi5rxiKICkky8ofB9LW5TKidDHpRqd/H4399jhaAqUD/+vy/ql+W",
+	"Sr1aYoFyTIzsU38oQWj8aNbWUd0HvcvgcWh9mx3EoHLXsKqZf14JuwEuxr2vvdDlktXANgh4aPUQJBuR",
+	"xpxMGG9+Mae2A9ShQ1yN2qTFxj3KfS+hzWJn496IPp48utHOkKJx/a4xkLjuDRm8ma+9g9eVhdqbeSVZ",
+	"fZnvuEgNNWrEf9nFVd+y2Wsq+Wqds2ZwA1mrqQyZzyHjEJu193K22/D7RnlFDmrk+qv/xAJ64vFwnTT4",
+	"OKdlvL4MzoACx4ox2pG2U1fbnwDbBtksMhiVmFDg7WsrmwxdUC8tueW1cfuhtnrX3Hr/eujW3ri0xuoG",
+	"rC9nafsA/yyugVOQIFDO0i1BD3mFaQw4YKxNt4fA++vQ5Wz5wrs1Bogc0zYDZKXfqVYlm1H6CiyIVCMR",
+	"GqvrwyfKljQEXnKcwGb4utkWAwT1/IH3Cp9zbnu3KMXHGSRssQCa4rDXzF7V883ssuDcjt31UnAGxnzy",
+	"gbMpyeChqHo1JezBKFl87cQHbW1/hWkHqpE9/rVJd2pE5/Unpg583nRB2P5ENztXGjDhhQjZ/orTYuR4",
+	"k2FZvuLkwEcVOerrhFGOxRh9JHLOComuvFiHqxgxChPqxXJ4wUOqR6A5CrWe0EYgiI21cJPu9d6nNmDd",
+	"ANLYQwMytH+vOJnKd56Rp91Lfgt7VGlx8pe1rgsTemo+Plk35HTw1IuabcIy/FQtCFLEIQMsQMsXtqQm",
+	"VA13G2U7+eBF3bjZMt4uPMV6gGzlhxeVUXIAOPdpoznQwToDmgKH9KwLZtczhvva89hKYB0DtWBmBdk1",
+	"60lir0xHx9Q3YGor12qy8pKO/Jm3kqY3/hpFTglkacuqVTCUaG6u7dBFi03ya1NVy/M7fYWWRM6t518H",
+	"jn0itAWW+uKm2nXQ/d7yNkEYQontsJoMVsllvcLYRQPYzQ6d7GvOGW+XWtqz6iVLW2apP6OEpeB7CgFH",
+	"VZRpFUf1/sfz0b8/Gb0dPX0aNkO0eJc1tT49ZmXPqAb4hQhB6AzxMnpYrxt9W5qAvtVG1m+tGejb0DQk",
+	"kVnnar2R7ZPfNU6dqIqjguJCzhknfxjvIsavSZoCjfSTyhsVyGYc5qcZ0YqTfuqnODvXO6fPw7Q9VctS",
+	"lNrbO+n1jfaxCFqVOp33QHXcnY1Ig9u1fcjNkgiEhWAJ0TdQRfA7txJ1DrWbV89ue86wtd7ZqnO39d7R",
+	"tjNsrQbZ/9mLgXv2F9PNH43esOwGhHUReckZ/Qe7ftw+ZL+n3D5Ddo+xpYFp0Gh3sC8NO62trUx3wcgQ",
+	"Z+SARZsjj5gzLmO0wMmcUKgEjelTBrGYCRl0OcdLdevRrrttaDPUPOSYZr8nsXYfFTNP9d1O9p0CmMXo",
+	"o/H4eRz1lyUHT9eIUXg/jU5+28rntbvTR8Y/TTO2rPW5PHjKXm5Cx1Zt9cblo2khC6EnTiBFNnhgWmTZ",
+	"qu8tzFOvduTmYSe1YzePBZaKlc0s+BglOM8hRVgiRQA9/T9+ljI3AT1CndE5cLvLjddKLIEmqw/Pj2tW",
+	"rq59XIOqLrKhLXWwv98n7O93D3sBmL418HcPnBtu/JIVVO4eekUXZ3sdp6BfZ6QQZp9a5/EPhWwVbdu4",
+	"/zqn9KCSzGQAcPRO/dxUcTYC6x/64kEpRUEiyQ1EcYQT9diVQWoikTgIpTCmm+PE7fCXm7a2PbCqGnhz",
+	"ZJN28PfXop/tGpMfENi3+ZFQN6u5p0Nam0EI9q4Rxn3bPN0+UC7MOl4QZaN/QXG2EkS0h2G8ODWvD9i2",
+	"1Fte7YVTiddHrgXaN4Y+S3DniGcvX2wzzsFF+uAivX8X6R1zcMdst2V/rn9v1ve1RUYclWS87RpLAHfw",
+	"l3Dy6HCRPYRs7uYi2sSo1vd+1647cLNq1h67eVCXDurSQV06qEsHdemvrC4NfC7wxu23pAehj+3CaFrF",
+	"/u/YburL4j4W0rd+Yqoh7oo2KYw5iH6n15CXgz0gZ5wV+Y+r4Dc/K0EwCc1rkyCgHXW8cC/V3mUU0G7V",
+	"FnZvfZnN3qoIl7rT5EbnIOeQszPXT6cJfphz647WA71LjHhLQtcMExUuets4S3D97Jpl8470wl1ouUMc",
+	"CwchaX8ck3a6oBJdr35AbEFk9QvOsur1ImMzJSm0z6owrw9emL2NgmpxXI50wEbkBe4Eme7wfBzKf0iY",
+	"9ORLksq54kHLOcsALQgt9Es8RzrReYz06+uTRYyeL2L0ZN4f290iX73+8defojg6fffmfRRHH1+cvYvi",
+	"6PXZ2fuzsAzxUSSOCkp+L8B6CUpeQG/HtSo7XowMFN+zrkO7EPgG0n8LZ2N4ZwfASLeyHN3CFHhRAjb4",
+	"kBtuL9RNTG/7lGQSuNCeZn5u8xIFYl8/i5FPvxpAudloYYsSMBP8EXTP30z+gby7JUJddtFn21uaJqAe",
+	"eXZ0u9H1yhDUDwh0dL1S/wuqv0HqHWHQBUTDE1/jtaVcdeczYifvsHzDIqZmFUJX/nCLvV61ENfwxJFh",
+	"VOAEtmDbdrF9t+nvaKT66gxwA1kf/D8eptnNI482i5vSF1p1j1bPj+p8hwW7lPHb68poT1Du+NshXW55",
+	"Q9Lr3ZdTiQtjJLCtZ4k6ywtMslZOd2AKIabQTaMu87alVUwydVhKV4rV8SyUfZJR0LqpU7pyLOQYvTIM",
+	"RjFxVfsHaoRuvU0aKUMGUPsGuraqwf1Jvq2SSztq8yqVaZuJ/UyEajwemkzaZRdrZnT2+LgXBTKXMjdp",
+	"l8MsfQevP/uUCgo85G2QIa/BVJuRggS+INR4IVeMKWeESk8DGaPXtXuX+r9nxy03sPXaBkYF3E5I1ZG5",
+	"klP92IWLrlrXljfxjLCr3m3cU02tdbqs1tHQ9tfIcqjJUv1gb/JabeyNKTc98492DsCK61Blg5CAqgfX",
+	"D47pfW+DbCsQ9WBbxX71tVZlcLJF/8CE+mg2MqFXZdsrU7oNEjIlkP6gWfgaMOlHWso58CURsE0Eb33h",
+	"d4jlbcfldZtUXrwlCyLF7p0Mk7ywMmU/wH91EVW7hZwS8ekMcPrjSoLYD/iPnEjYE3yTDmlfZ2qg7+9Y",
+	"Dfw9nSwFuWT80xkkQG72tf92kAuOqVgQuZdRbjto3qWcWOOLroFTKkWMtOFBxNo8x0EUmVRaq3pMH6Oy",
+	"uQ3jLARMqBfy9HuBqSRyVdWexAIZoeHCuq4m0dNnx4tJdKWtYy8//Dqh+lKUMK6uL+r78+NfiGtgDv+x",
+	"YZ1rbKpX0kBAAjJIdHYcLzMx1rFZwotWFiDVYkW/xHCOS9ZLEz5/drxoyXDoKdNeKcOW9sOS2+1piR7f",
+	"aKzy+PgX0j7t8EqfB/s0s6BU++RtcRNwfWotmd+CUrCgaoMuWM4yNlu9TkPxty+oC5tOkeRYpY9D6gJo",
+	"Iqqxy7JMWarvdxhJzGcg9Q/jNTQNPeufSx3Ppt82yZQAL88R0hmM0UtGb9QnRk8mdFSpFiNT3LX8+wRd",
+	"ffNF8KRUF25P9N/nJtj71rb/5ksqZK1NKqRrc6VGmGEJS7xah4/Qlf128s0X+y/1bNAfdHPy8NlEV5+g",
+	"fpMv23/zZc6EVEDbr3EbuWkDASxztQ+PkiUsYHz5SDgg99lptk0MGXt3Qn0R7M7dPWCO71gKZzBV/Q2i",
+	"bds/lJagSmFgQPcgml+qnW7Qjc3zDujni4sP7q0DsRtbRsAKmTqTGk9cYI65OZhnBKWPGysZepQwKoiQ",
+	"Whcnco6OcE6Obp4cWfhH+j4alBD1SKj6ZJ8fyznKgSdAJcnKySHbJ/amMO5za2nGRtVH+35/o30fGO37",
+	"XY/WiJ9qyiNMdzBGM4yqcbVsWD81itkuoqJGe/cKZHhtH7gr8qnxYFoO7/dBjyijo6efPz9uzGr4ZG43",
+	"k9+7YO6PF0YcNfeBm75I2s6xISGiXusctaaOUsftCSLCTjNDk9DNrTrTmv6lrNHEKh8CK3R0XhEjCYKs",
+	"9c78fw/+KkOT9hrBt/lpW2/XZT9UUZw/oPdPgQNNrP6iUacFY8bIROTjHFAKOSiWzCi6UnO40tqJ+tf/",
+	"9FUSHy+0tQRnS7wSKGd5kWHpTLNqtBRLPKFIKQkgrH5FNRWNnPiw1a1+QL4JxpalIkL5IGSQKhgl0DLn",
+	"TaL5knbxQ0SOy8k6jUZpNwqQnqTb4LJEiUkDA9IUKiFUcqz/elwB8nQZLFEGWMiqwD4T8kpX06/mfVTf",
+	"GzVrMWdFphKmIwHyB3Rlcebq6KrCHj0/ovI2p/7mGdmtgOjPCKOUTPXByrJmfOje1J407WU9xcsjPVT9",
+	"fGPEjLbq1o4SzoQY2QHtpMTjLbLKt+V/GaMPJeZoFJFlXRIPPQoB0yKbUDU3YfTr8oWs3LJ5PXORXiUR",
+	"qKD4BpNM/dZ4V9nEyhqJkdSVzNs1t0fh3dgB1ws7D/1kOq8dogUank2vROem6kMKKCMqVQuhw7Kef/BT",
+	"lwT2SVsdKtRux+vH46Ge1OHEJr2qE3h8uXExYPxTxnCKgKb60aOdbEIT3pKre7frJlfXH9A1S43v0Yf3",
+	"5xdOXcZZPseV0mzZ/Khk8xPqvaUYdyzLcSov+NhtnTEP+WmL/t//+b9OdEyoA6rOz/YYNXuMtL09NeKF",
+	"6SUoXlKlv9Q2Jp3qT/uUcVDblkhhrVL6fUndloUNdGFFMjf/rDJuBrjf8JdLVNb77/c2YrfttaNb32NE",
+	"8gLitkprrNxxuy52pNldpcYgVkhBUqhfpybUYfSjOi9mSlWdjvIMSzX1x7WXZaTmMp7QYEyKnYhlJGKb",
+	"NVhmg+w13mPpenWBuQRnMuDVtEEnO3k7HXb4HU/t4RfMXuRePXatT7upriFum6ssQykIQ3YanazjLWI0",
+	"WyGgkiiSUHxiQpdzksydJUOHFpQXibRQS+u4v6NziSVJyhlM6KOl44tGYdSX+xnH+VxrbO/eX1TKjNY6",
+	"iSin/QMi0jmDTugUZDKHFAnIMccSslWlAHgM/cWH0yCppzPzj1529pBpMGTLV7u6LVB1JOEcH4sF5quB",
+	"0M5trzW0s7/3QK5GhmecZVt70Vy25zit3JM9ARBdBqZT7UPTxGDzGZqfrx1K+jRqcdTgkUZiNfdCmji8",
+	"cZck6MfYyyz7Q8JEamxnZ855/lRCx3yu/Lnfslnp+P3Ag2H+NDEmZdXo/rtzu+l8wqEpxjuyP6upH3mv",
+	"98Fal34hKk2luqm3KWFOMeeWHJ2v0A5wpmVkr9WAsR9olIcXhjE0zGN4bMRGvnFW0D4OgHdyaB+WP6HT",
+	"C3sbz+odMeTgVuaYnrcErb7WboSE0UboqsgxjdGUZRlbOhGn9JwL7Ywk+Uq3QAYsWrAUspDRNoXOONlE",
+	"W4qrEcfovbFZTSL2yVi7gHPG1T8ZR5OooEIZvvwnLlP336ZG1t9brLItOY5fKepTsx5NcaKW2rDM2Kl6",
+	"ncboYpWTBGfZCgmQRo3VN229HiKqaY/7MeELjhP4m0Z57MIZtaV+0Fl5U7cKmbvkaSd4V92nZGvamw7T",
+	"Wj2g6E8ZEaHxSZH9K5CYZKIj35qUnFwX1g0Ip6Z4Oc4+eK1C1+wLS7HIAxCYSMI4B20vfctmbe9pNZdd",
+	"XcaOUAicT8kj3IfS/Jxj+q3wRH+CKWU6iq90bEhj3VRRLXC0wNo1CN0QWOo3KIURmseUMRLWnNs0ygZj",
+	"JVJb4fpdW3GHRgVsvXOEIoopq2IwSvQgVP7Ld8GBBnEANUpvBpBjrgiroxKXaWHmfvqqq8TFizsglYOx",
+	"AbG6SoZ1zFB96pcg3G5eEEK/RButEIayj0HnWGWn6FTRK5WgXSCpRpsCq9TcNqQxMk3aUxjtlwMdSPMv",
+	"QZq9COtvQZq7SJOiSXJvUYAa+pbxf5rx3J8abK3BdSopVeIpzkSfB4cGWyodGkqDuP/goIGGXxwO+QH/",
+	"WoHKNeRuk6jb0LO+quyPoA34HhRta61uUAhsm1aNYKjI1vD2L7PdfbAfJ5ljoes1daQGxHRVqhvVOuZY",
+	"PZXZglJGZIS5A2fMUwrWJb797GRqa4N3baZrNbded7ZSnASuzT5TGUqgw3a8s8yv2duw5qG/9VMcWmwC",
+	"m+V0qMVakH8o2H2rsu33UX44FJy+tqDu1xCJxadWbFxa+GdFG8YOqMiqRVxScCJX50qOmdn9CJgDf1HI",
+	"ufrrWv/1xm3HPz5erMmtf3y8QJIpdqycXFRxN6DSlhcdo1OrDmjE0a0sibywVeB0OzQHrIQeFuhbMwGk",
+	"/RQS3UX/E75VHEALXM0DdKvqVLST/+2tVl+mzBheqcTmWcmY+32nowvAi7VXj2bJpffOc/HFh1Nl7b8h",
+	"6gnfeRfpx3ojf1w2rnhCnZhQD/3OKU4/kpcnYfpVSkTpxiPW/HgUQCzQErJMbY0awgBzeCDGE3oqkeYv",
+	"HEsQxqHYPdBbvwF8TTIiV8pCXWRgFC6QiUkriRNZ4Ey7fqIbgidULVZZiHQ73SLFuWRcuC3QdT7VBwvP",
+	"PPZnJAEry+12v8hxMgf0dKykZMEze0ri5OhouVyOsf48Znx2ZPuKo7enL1+/O389ejo+Hs/lIvMKDkYt",
+	"BxPF0Q1wYQ7wyfh4fKw6sRwozkl0Ej0bH4+fReoCKecawV3Agin+YuIV1O950IdQKyp+ITHTrXJ8CJR0",
+	"VMSu0fo0dRBMeZ6odKv/kaUrh6T2RQ3neWbJ5ug/bTEuo1/2qrtTvy/c1hmBffZyyrfeh6fHx/uZgRnD",
+	"TKHx0NJRY+g2jr7rNaMyhq1WfTOKvOeN7SpdWjzzqlXexn3XX6sSGlj5Kb3BGUkRryB/d/xkR6t1wBlH",
+	"C7twzTe9RdWqbu5uWb82wH53/GxHazovTME+IwY+r/7Q/zCaIWUoB66XyvQlQFuwLWGyKaocZKeMxci5",
+	"uV5jHqPKp/oa/6Fk0WvvxTo1z2AuGbLdu6pE6e427o0P8/ld8N5WjX09On5S20BvAaEKqrtEbQMdGfCo",
+	"hP98Zwju8Q3txUqZRKSq/urkUcLolMwKbrL7CSO4gHs70agau7tNeMckqkH23cisEAEnAySeCaWcmWVF",
+	"l6qxk0pq4v1kUqUN9BdD6nl1T0Jo7eX2K4ug9QRogWN625ro7CB+DuLnTuJHk+PfVPi8HT39/kEJnwD3",
+	"zdjM572aE65zXolJ1s54zyUHvEAUlqW1gIAwOXvDWXYVUbjLGuKFTsEh57BCmHNyoxz+L3w3WSIQRsKM",
+	"omxKelEjoQAZ6TFGr3EyR1dqslfmN5QoWKB6/uP8/bsJ1dYW50bg5kgoSuacUeXYq9x2jJV5jE7TDOyI",
+	"AnGTdwVh9AkgH2EVVjShJuWRHKNT+95j5jdVThUITyVwRKSxXEGq7rDoSuPIVVWjWK/gGqaMw4R6MIhA",
+	"ScYEpOYOWZdYKgXingWWn2VxK3kl4bM0N8mRWVKNWEzB1RONexOqrtgn6Lcvk8o0NYlOJtHT46f/Mjp+",
+	"Njr+HxdPjk+O1f/+YxLFE2X50g1yvNK4pHYcUvNJMQv9UftBTqLbS72D1dKbdqGQLHTHYM9ut0KwlHUm",
+	"gMD5ohjrz7SQBYeD2HtgYu+F1ohQCpRA+ueTQsfPa6t5o+nFPgByAjdQSoEHI5wUB9osm2rJNTZdDGpm",
+	"x/53g1/KZOj74LahPKZf+YYQzD4ZODbb7o73hPtmYffKa+6PRXx1vdKrIWDJ1xGST8E23leXgutJxqbt",
+	"UCp+oXvtiYgN8Puk4doM2o/PNDtQ8IGCe1AwdiTjCNjSUDv92qwaR1/MPy5WOdwecfUWpokac7wACVzo",
+	"2M2Ql4/qVaazrmpEKhDoUcZmcVld5rpIZ2DSfJSpuB9HcUQUMPWmFblopqiaTNQkSV+lKSsEsVkUVxmd",
+	"zUCRn/E7UOj8Mm7hWS85YAnq8ucthdB+nMt01tt+VmSwT+6l4A/iXU92Oz6hMzWF8xVNNjIws4k2/vMB",
+	"MrHvv9743n7gjANOVwg+EyHFg+QrjhjKSe+GuRx9Uf/R+R4NAWYgg8FcGWxNiqazT4oNdhbavKpJRWSl",
+	"HdTGou9TIRhOVGbvHiJRfXcvREWZRFNW0PRB0pPD6E56iiObjLORfQnklqTwE8g/GR0Y4dbrwJ1F5EAC",
+	"fw4S0Gi8Af//yopnvMkrtbY5gUk60dk5xZC6WwSYyq8668SWfMV0fpja7r0LZpvP48FxpQfHEBwKbqVj",
+	"LuF6ztindhPUz5imGXg1rdfMUdier4sRXkNzA0JP5aMdbo+Yboe4T2Qvp7AJ0e3uo7neoQOut+G6dU6P",
+	"Tn679DF/K9zcTBoJE1IcVW7aR1/Kf98e+U7ZR1+8v/RNLKh4numgM/UsP82wLAN/VJINL0ifCalrIfFU",
+	"lFUHynEn1Iv7n5EboL5vwRj9KqBKUas9ivysvGX+HfvknrAcjIv51YxjWmRY7a5uJ/KMSATKtaCaG6GS",
+	"mVCU6yL5BFKEHul/gqregKrzIAZrym8IfZ8LT02Oe3bxnGf6d7L+OP07lKvr3+W8jPEZsJZ0WIefqhPc",
+	"78VCHWonSysxO4zV44PB/d4M7l/1KqVcYN882PvTy3aWiyuG+9/rJfKd0DAkt0OhcVQvfyc2ChFOZnM5",
+	"EuQP41Za69yIJyorxruieBNaExragawOQvfGHARKCs7VJvFmKSolNybUlKNCeIYJFbKCAqkrF5ICJzcu",
+	"M3iZtrQQWBXSuZjDhLYLLOPfrVmdsNXsvaRFVRn8JV5pkbZCKUOMWt9vIavYrHp6epXoRuenMmndXQ3B",
+	"ch46J75g6luLiDtrHNdBxt1Fxu1TXLWUpuwUXF3EdRBfB/H1AMTX2Sb+j5GXZtzWTPUrGGwWZYQmOlFY",
+	"T/eUsvlQD5VT13FP5oAS/n36qTQn0XX6bh8P3ioHb5XN3irEIx9H1BVJddL1F/fP0/S2l6PK6StnXXc9",
+	"lbHAmGjDVvZqhB3b2csJDLOyu53ZM6/5UMh7ZjR6Bpu5zIO1rx9UnK+x7RYJHvZTpyV6UpFuLz6n47da",
+	"buIuw8bRF/uvW9V85FWpC96+VSp4lxtm5G62ZSHNaaV0jdfYj+r61j1oGlVnf4GvZhQ1ZFugj5u0uvIc",
+	"qP+gYrSQnkb4rMKX9aCUHl4GJd055aFKlB/QGPzKJP0VhqA7gB3HlJ1iSyoaWfyJLcBTX2BgTtWEh6kw",
+	"nZ6xASZiy22VBRJZQW2ebh1NXOWeK+snwA1oHVACv8FZjGacFXmVAaGs3KV//3GFbNkutWoV0imsrUyP",
+	"g9XblH7fEWZv9L4lmCo7GQesar9xVszmtQp/Kj2QAlcI9fAmGj4Qxmo4bvHwLVnV/kI6Dfx78vCt1tfJ",
+	"hh+wS+9BCfsadqZS9/rKPtR/FsfpikF3RUXeWe8zTXv5UntTQpLNTO7KsngzqMcPQ9E6QFWMW/yq6wyw",
+	"xoW+C6if1ZgP11/5wDG+Ksd4qL7ZXSRbemavvat10MPxV5fKD9kl+kBlf28qU+7f3SR2uJb1n1FLkTfr",
+	"615tcsu+DLsX3lVh0RMcefUHu01VZj1Zleisj6XKLzSnRtkjN16vtRggifMachyMVgej1SajVYn2DmsO",
+	"PPLupitFhghXzGRVdzWIS3tTtrKuxibdjpcNrZnSus00VGMLezIPBQusfmUTUX2dnaxvdbATPTR99GCt",
+	"CVhr6qx3tXuTTU0DChptQraWdY6yyd7ik97B4HK4Cj5og8tGqmu3umygjOP7EXYH88uB5h6y+aUHwR3u",
+	"Fw/UBtPiXngGeaYOQ83BxJsYYwk1Wpeu41hNzbzW63lbJ8Rrlq5Mdlv9VG9OFMv5uMUb8WFfcu6J7x88",
+	"Eg9c/4EGXVj2cC8XnCNe0F5O2geZcm8yJWg0OysowvXhb4B7Ifza5YpjOgv4SZ0V9OsLiYL+xWu2HITE",
+	"QUjsTUgUdAsB4TyQeEEVOxhJlqvKHB1Rdy4SXHuHqiIdP19cfECS4+lU+ySZ/o1YQKJY0VrikJMJxWXw",
+	"K2UpCPTIr0c5wxKWeCViBJ/tBpRFLx9rll51h3QGYkIflaHeNm58ZAvmSMxnIMt56qKVj2OTakT7T+HZ",
+	"jMNMa4B2Uyb0kaUw46ka2zrA9o8MS6DJCuXAE6CSZCAe6/eGQsEoea0FocLfNcNdEpqyZSi+2zFB1ezC",
+	"ncN++G5jlHtiu2uzaKcA27TCrwMjPjzGbg5S5A208Thik9ACzJEySaZ2DeIo5WQq2/niO90YhKdg+f1R",
+	"MseUQma921PIM7aCtExwIdBcPXLqQVzaCjkHwlEKQpEjWmBKpmAyG4UynL1SXd95Q+6Jd6yN88Bznen5",
+	"1s9Cl4E6ZDsblO3sHGgqULq2mf0S1pty1P0i+k3boeH8F7rXnnDeAL9PU1ZtBu3napodpONBOvaQjtKR",
+	"jKNfS0Pt9PtF//c0vR1QH9ZEetl1GB8eWBCppNxyTjIwZE1nCJsJ6VS/2OUOETmmSr8nJl2S/vs0NbX8",
+	"5BjpSznmYAzfSu82FnENCekN/+wqE+roNK3wu4KEK3OJ4DoNdcGp6p+lICSaEi4CPkIVp7EFAQekLtBT",
+	"Cht97K4OtfvsjdP9KWrovmScQ6YvbNnBNHPgef3Lr6KkQh1z9y+JcwtOqDhST1aom1rTiOFQA5WccwXg",
+	"DeMXdrp/TfZz/4qW3ueNHEi3OvCdA9/pwXfWSP8uzOaL0YLacz4rP5EUpK6MrKMuVIctGY9y1MoxfWXA",
+	"PQjmE3ePphYbHszs23BGt29eYzd3E7Mpz/TAcw48Z5OfWCf9t3GfOeBMzlv5yss5JJ80jZmGSEgsC+EI",
+	"r8lL1i9QPxv4d6SpnCuo0sahmTnUC56b6Wl7b5N3uF/YtUlOGyA1M3t1yXRw9CE/u8Mk9dNRfY4sByoA",
+	"82R+om6qFBIFyVZXX595HFxoQXe11ApSZ9EDc+6JQgQPiczPConqfb9EPwLmwF8UCqt+u7y9LPuEsgxY",
+	"31v/oa9i3vrKvc77/1lcA6cgQdg6/51AXqsmITAqvzwiVKgEp8ZfpCMdbwiyzWi6DtnfslBH8z26vbz9",
+	"/wMAtKXWh647AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
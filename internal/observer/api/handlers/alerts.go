@@ -68,7 +68,13 @@ func (h *InternalHandler) GetAlertRule(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := h.alertService.GetAlertRule(r.Context(), ruleName, sourceType)
+	namespace := r.URL.Query().Get("namespace")
+	if err := validateAlertRuleNamespace(sourceType, namespace); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "INVALID_NAMESPACE", err.Error())
+		return
+	}
+
+	resp, err := h.alertService.GetAlertRule(r.Context(), namespace, ruleName, sourceType)
 	if err != nil {
 		if errors.Is(err, service.ErrAlertRuleNotFound) {
 			h.writeErrorResponse(w, http.StatusNotFound, gen.NotFound, "NOT_FOUND", err.Error())
@@ -143,7 +149,13 @@ func (h *InternalHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	resp, err := h.alertService.DeleteAlertRule(r.Context(), ruleName, sourceType)
+	namespace := r.URL.Query().Get("namespace")
+	if err := validateAlertRuleNamespace(sourceType, namespace); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "INVALID_NAMESPACE", err.Error())
+		return
+	}
+
+	resp, err := h.alertService.DeleteAlertRule(r.Context(), namespace, ruleName, sourceType)
 	if err != nil {
 		if errors.Is(err, service.ErrAlertRuleNotFound) {
 			h.writeErrorResponse(w, http.StatusNotFound, gen.NotFound, "NOT_FOUND", err.Error())
@@ -183,3 +195,30 @@ func (h *InternalHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Requ
 
 	h.writeJSON(w, http.StatusOK, resp)
 }
+
+// HandleDriftNotification handles POST /api/v1alpha1/notifications/drift
+func (h *InternalHandler) HandleDriftNotification(w http.ResponseWriter, r *http.Request) {
+	var req gen.DriftNotificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "INVALID_REQUEST_BODY", "invalid request body: "+err.Error())
+		return
+	}
+
+	if len(req.Channels) == 0 {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "MISSING_CHANNELS", "channels is required")
+		return
+	}
+	if len(req.Resources) == 0 {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "MISSING_RESOURCES", "resources is required")
+		return
+	}
+
+	resp, err := h.alertService.HandleDriftNotification(r.Context(), req)
+	if err != nil {
+		h.logger.Error("Failed to handle drift notification", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, gen.InternalServerError, "NOTIFICATION_FAILED", "failed to send drift notification: "+err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
@@ -229,7 +229,7 @@ func TestGetAlertRule_NotFound(t *testing.T) {
 	t.Parallel()
 
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(nil, service.ErrAlertRuleNotFound)
+	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, service.ErrAlertRuleNotFound)
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/sources/log/rules/r1", nil)
@@ -247,7 +247,7 @@ func TestGetAlertRule_ServiceError(t *testing.T) {
 	t.Parallel()
 
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/sources/log/rules/r1", nil)
@@ -265,7 +265,7 @@ func TestGetAlertRule_Success(t *testing.T) {
 	t.Parallel()
 
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(&gen.AlertRuleResponse{}, nil)
+	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&gen.AlertRuleResponse{}, nil)
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/sources/log/rules/r1", nil)
@@ -278,6 +278,38 @@ func TestGetAlertRule_Success(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
 
+func TestGetAlertRule_LogMetricRequiresNamespace(t *testing.T) {
+	t.Parallel()
+
+	h := newInternalHandler(servicemocks.NewMockAlertRuleService(t))
+	req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/sources/logMetric/rules/r1", nil)
+	req.SetPathValue("sourceType", "logMetric")
+	req.SetPathValue("ruleName", "r1")
+	rr := httptest.NewRecorder()
+
+	h.GetAlertRule(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "INVALID_NAMESPACE")
+}
+
+func TestGetAlertRule_LogMetricByNamespace(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockAlertRuleService(t)
+	svc.On("GetAlertRule", mock.Anything, "team-a", "r1", "logMetric").Return(&gen.AlertRuleResponse{}, nil)
+
+	h := newInternalHandler(svc)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/sources/logMetric/rules/r1?namespace=team-a", nil)
+	req.SetPathValue("sourceType", "logMetric")
+	req.SetPathValue("ruleName", "r1")
+	rr := httptest.NewRecorder()
+
+	h.GetAlertRule(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
 // UpdateAlertRule tests ---------------------------------------------------------
 
 func TestUpdateAlertRule_InvalidSourceType(t *testing.T) {
@@ -423,7 +455,7 @@ func TestDeleteAlertRule_NotFound(t *testing.T) {
 	t.Parallel()
 
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("DeleteAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(nil, service.ErrAlertRuleNotFound)
+	svc.On("DeleteAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, service.ErrAlertRuleNotFound)
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodDelete, "/api/v1alpha1/alerts/sources/log/rules/r1", nil)
@@ -441,7 +473,7 @@ func TestDeleteAlertRule_ServiceError(t *testing.T) {
 	t.Parallel()
 
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("DeleteAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("backend error"))
+	svc.On("DeleteAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("backend error"))
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodDelete, "/api/v1alpha1/alerts/sources/log/rules/r1", nil)
@@ -460,7 +492,7 @@ func TestDeleteAlertRule_Success(t *testing.T) {
 
 	action := gen.AlertingRuleSyncResponseAction("deleted")
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("DeleteAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(&gen.AlertingRuleSyncResponse{Action: &action}, nil)
+	svc.On("DeleteAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&gen.AlertingRuleSyncResponse{Action: &action}, nil)
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodDelete, "/api/v1alpha1/alerts/sources/log/rules/r1", nil)
@@ -562,6 +594,52 @@ func TestHandleAlertWebhook_Success(t *testing.T) {
 	assert.Contains(t, rr.Body.String(), "processed")
 }
 
+// HandleDriftNotification tests -------------------------------------------------
+
+func TestHandleDriftNotification_MissingChannels(t *testing.T) {
+	t.Parallel()
+
+	h := newInternalHandler(servicemocks.NewMockAlertRuleService(t))
+	raw := gen.DriftNotificationRequest{
+		Namespace:   testNS,
+		Environment: "production",
+		Resources:   []gen.DriftedResource{{Id: "deployment", Kind: "Deployment", Name: "app", Fields: []string{"spec.replicas"}}},
+	}
+	b, _ := json.Marshal(raw)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/notifications/drift", bytes.NewReader(b))
+	rr := httptest.NewRecorder()
+
+	h.HandleDriftNotification(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "MISSING_CHANNELS")
+}
+
+func TestHandleDriftNotification_Success(t *testing.T) {
+	t.Parallel()
+
+	msg := "drift notification sent to 1 channel(s)"
+	status := gen.AlertWebhookResponseStatusSuccess
+	svc := servicemocks.NewMockAlertRuleService(t)
+	svc.On("HandleDriftNotification", mock.Anything, mock.Anything).Return(&gen.AlertWebhookResponse{Message: &msg, Status: &status}, nil)
+
+	h := newInternalHandler(svc)
+	raw := gen.DriftNotificationRequest{
+		Namespace:   testNS,
+		Environment: "production",
+		Channels:    []string{"prod-slack"},
+		Resources:   []gen.DriftedResource{{Id: "deployment", Kind: "Deployment", Name: "app", Fields: []string{"spec.replicas"}}},
+	}
+	b, _ := json.Marshal(raw)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/notifications/drift", bytes.NewReader(b))
+	rr := httptest.NewRecorder()
+
+	h.HandleDriftNotification(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "drift notification sent")
+}
+
 // Budget source type tests --------------------------------------------------
 
 func TestCreateAlertRule_Budget_Success(t *testing.T) {
@@ -612,7 +690,7 @@ func TestGetAlertRule_Budget_Success(t *testing.T) {
 	t.Parallel()
 
 	svc := servicemocks.NewMockAlertRuleService(t)
-	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything).Return(&gen.AlertRuleResponse{}, nil)
+	svc.On("GetAlertRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&gen.AlertRuleResponse{}, nil)
 
 	h := newInternalHandler(svc)
 	req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/sources/budget/rules/r1", nil)
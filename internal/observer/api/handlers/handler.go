@@ -47,6 +47,8 @@ type Handler struct {
 	baseHandler
 	healthService        service.HealthChecker
 	logsService          service.LogsQuerier
+	logsTailer           service.LogsTailer
+	logQueriesService    service.LogQueryManager
 	eventsService        service.EventsQuerier
 	metricsService       service.MetricsQuerier
 	alertIncidentService service.AlertIncidentService
@@ -58,22 +60,26 @@ type Handler struct {
 func NewHandler(
 	healthService service.HealthChecker,
 	logsService service.LogsQuerier,
+	logsTailer service.LogsTailer,
 	eventsService service.EventsQuerier,
 	metricsService service.MetricsQuerier,
 	alertIncidentService service.AlertIncidentService,
 	tracesService service.TracesQuerier,
 	finOpsService service.FinOpsQuerier,
+	logQueriesService service.LogQueryManager,
 	logger *slog.Logger,
 ) *Handler {
 	return &Handler{
 		baseHandler:          baseHandler{logger: logger},
 		healthService:        healthService,
 		logsService:          logsService,
+		logsTailer:           logsTailer,
 		eventsService:        eventsService,
 		metricsService:       metricsService,
 		alertIncidentService: alertIncidentService,
 		tracesService:        tracesService,
 		finOpsService:        finOpsService,
+		logQueriesService:    logQueriesService,
 	}
 }
 
@@ -0,0 +1,256 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// ListSavedLogQueries handles GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries
+func (h *Handler) ListSavedLogQueries(w http.ResponseWriter, r *http.Request) {
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.ListSavedQueries(r.Context(), r.PathValue("namespace"), r.PathValue("project"))
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to list saved queries")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// CreateSavedLogQuery handles POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries
+func (h *Handler) CreateSavedLogQuery(w http.ResponseWriter, r *http.Request) {
+	var req types.SavedLogQueryRequest
+	if !h.bindSavedLogQueryRequest(w, r, &req) {
+		return
+	}
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.CreateSavedQuery(r.Context(), &req)
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to create saved query")
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, result)
+}
+
+// GetSavedLogQuery handles GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}
+func (h *Handler) GetSavedLogQuery(w http.ResponseWriter, r *http.Request) {
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.GetSavedQuery(r.Context(),
+		r.PathValue("namespace"), r.PathValue("project"), r.PathValue("name"))
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to get saved query")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// UpdateSavedLogQuery handles PUT /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}
+func (h *Handler) UpdateSavedLogQuery(w http.ResponseWriter, r *http.Request) {
+	var req types.SavedLogQueryRequest
+	if !h.bindSavedLogQueryRequest(w, r, &req) {
+		return
+	}
+	if req.Name != r.PathValue("name") {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "name in the request body must match the path")
+		return
+	}
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.UpdateSavedQuery(r.Context(), &req)
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to update saved query")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// DeleteSavedLogQuery handles DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}
+func (h *Handler) DeleteSavedLogQuery(w http.ResponseWriter, r *http.Request) {
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	if err := h.logQueriesService.DeleteSavedQuery(r.Context(),
+		r.PathValue("namespace"), r.PathValue("project"), r.PathValue("name")); err != nil {
+		h.writeLogQueriesError(w, err, "Failed to delete saved query")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunSavedLogQuery handles POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/saved-queries/{name}/run
+func (h *Handler) RunSavedLogQuery(w http.ResponseWriter, r *http.Request) {
+	var req types.SavedLogQueryRunRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+	if err := ValidateSavedLogQueryRunRequest(&req); err != nil {
+		h.logger.Debug("Validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+	req.Namespace = r.PathValue("namespace")
+	req.Project = r.PathValue("project")
+	req.Name = r.PathValue("name")
+
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.RunSavedQuery(r.Context(), &req)
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to retrieve logs")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// ListLogMetrics handles GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics
+func (h *Handler) ListLogMetrics(w http.ResponseWriter, r *http.Request) {
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.ListLogMetrics(r.Context(), r.PathValue("namespace"), r.PathValue("project"))
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to list log metrics")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// CreateLogMetric handles POST /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics
+func (h *Handler) CreateLogMetric(w http.ResponseWriter, r *http.Request) {
+	var req types.LogMetricRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+	if err := ValidateLogMetricRequest(&req); err != nil {
+		h.logger.Debug("Validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+	req.Namespace = r.PathValue("namespace")
+	req.Project = r.PathValue("project")
+
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.CreateLogMetric(r.Context(), &req)
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to create log metric")
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, result)
+}
+
+// GetLogMetric handles GET /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name}
+func (h *Handler) GetLogMetric(w http.ResponseWriter, r *http.Request) {
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	result, err := h.logQueriesService.GetLogMetric(r.Context(),
+		r.PathValue("namespace"), r.PathValue("project"), r.PathValue("name"))
+	if err != nil {
+		h.writeLogQueriesError(w, err, "Failed to get log metric")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// DeleteLogMetric handles DELETE /api/v1alpha1/logs/namespaces/{namespace}/projects/{project}/log-metrics/{name}
+func (h *Handler) DeleteLogMetric(w http.ResponseWriter, r *http.Request) {
+	if !h.requireLogQueriesService(w) {
+		return
+	}
+	if err := h.logQueriesService.DeleteLogMetric(r.Context(),
+		r.PathValue("namespace"), r.PathValue("project"), r.PathValue("name")); err != nil {
+		h.writeLogQueriesError(w, err, "Failed to delete log metric")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// bindSavedLogQueryRequest binds and validates a saved query body and fills in the path scope.
+func (h *Handler) bindSavedLogQueryRequest(w http.ResponseWriter, r *http.Request, req *types.SavedLogQueryRequest) bool {
+	if err := httputil.BindJSON(r, req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return false
+	}
+	if err := ValidateSavedLogQueryRequest(req); err != nil {
+		h.logger.Debug("Validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return false
+	}
+	req.Namespace = r.PathValue("namespace")
+	req.Project = r.PathValue("project")
+	return true
+}
+
+// requireLogQueriesService guards against misconfigured deployments.
+func (h *Handler) requireLogQueriesService(w http.ResponseWriter) bool {
+	if h.logQueriesService != nil {
+		return true
+	}
+	h.logger.Error("Log queries service is not initialized")
+	h.writeErrorResponse(
+		w,
+		http.StatusInternalServerError,
+		gen.InternalServerError,
+		types.ErrorCodeV1LogQueriesServiceNotReady,
+		"Log queries service is not initialized",
+	)
+	return false
+}
+
+// writeLogQueriesError maps saved query and log metric errors to HTTP responses.
+func (h *Handler) writeLogQueriesError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, observerAuthz.ErrAuthzForbidden):
+		h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+		return
+	case errors.Is(err, observerAuthz.ErrAuthzUnauthorized):
+		h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+		return
+	case errors.Is(err, service.ErrLogQueryInvalidRequest), errors.Is(err, service.ErrLogsInvalidRequest):
+		h.logger.Debug("Invalid log query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	case errors.Is(err, service.ErrLogQueryNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, gen.NotFound, types.ErrorCodeV1LogQueriesNotFound, err.Error())
+		return
+	case errors.Is(err, service.ErrLogQueryAlreadyExists):
+		h.writeErrorResponse(w, http.StatusConflict, gen.Conflict, types.ErrorCodeV1LogQueriesAlreadyExists, err.Error())
+		return
+	case errors.Is(err, service.ErrScopeAuthFailed):
+		h.writeErrorResponse(w, http.StatusInternalServerError, gen.InternalServerError, types.ErrorCodeV1ScopeAuthFailed, "")
+		return
+	}
+
+	h.logger.Error(message, "error", err)
+	errorCode := types.ErrorCodeV1LogQueriesInternalGeneric
+	switch {
+	case errors.Is(err, service.ErrLogQueryResolveScope), errors.Is(err, service.ErrLogsResolveSearchScope):
+		errorCode = types.ErrorCodeV1LogQueriesResolverFailed
+	case errors.Is(err, service.ErrLogsRetrieval):
+		errorCode = types.ErrorCodeV1LogsRetrievalFailed
+	}
+	h.writeErrorResponse(w, http.StatusInternalServerError, gen.InternalServerError, errorCode, message)
+}
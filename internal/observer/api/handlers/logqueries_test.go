@@ -0,0 +1,148 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const savedQueriesPath = "/api/v1alpha1/logs/namespaces/default/projects/commerce/saved-queries"
+
+func newLogQueriesTestRequest(method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("namespace", "default")
+	req.SetPathValue("project", "commerce")
+	return req
+}
+
+func TestCreateSavedLogQuery_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogQueryManager(t)
+	svc.EXPECT().CreateSavedQuery(mock.Anything, mock.MatchedBy(func(req *types.SavedLogQueryRequest) bool {
+		return req.Namespace == "default" && req.Project == "commerce" && req.Name == "payment-errors"
+	})).Return(&types.SavedLogQuery{Name: "payment-errors", Namespace: "default", Project: "commerce"}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logQueriesService: svc}
+	rr := httptest.NewRecorder()
+	h.CreateSavedLogQuery(rr, newLogQueriesTestRequest(http.MethodPost, savedQueriesPath,
+		`{"name":"payment-errors","searchPhrase":"failed","logLevels":["ERROR"]}`))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"name":"payment-errors"`)
+}
+
+func TestCreateSavedLogQuery_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logQueriesService: servicemocks.NewMockLogQueryManager(t)}
+	rr := httptest.NewRecorder()
+	h.CreateSavedLogQuery(rr, newLogQueriesTestRequest(http.MethodPost, savedQueriesPath,
+		`{"name":"payment-errors","logLevels":["FATAL"]}`))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestUpdateSavedLogQuery_NameMismatch(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logQueriesService: servicemocks.NewMockLogQueryManager(t)}
+	req := newLogQueriesTestRequest(http.MethodPut, savedQueriesPath+"/payment-errors", `{"name":"other"}`)
+	req.SetPathValue("name", "payment-errors")
+	rr := httptest.NewRecorder()
+	h.UpdateSavedLogQuery(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestRunSavedLogQuery_SetsDefaults(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogQueryManager(t)
+	svc.EXPECT().RunSavedQuery(mock.Anything, mock.MatchedBy(func(req *types.SavedLogQueryRunRequest) bool {
+		return req.Name == "payment-errors" && req.Limit == defaultLimit && req.SortOrder == defaultSortOrder
+	})).Return(&types.LogsQueryResponse{Total: 2}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logQueriesService: svc}
+	req := newLogQueriesTestRequest(http.MethodPost, savedQueriesPath+"/payment-errors/run",
+		`{"startTime":"2026-03-01T10:00:00Z","endTime":"2026-03-01T11:00:00Z"}`)
+	req.SetPathValue("name", "payment-errors")
+	rr := httptest.NewRecorder()
+	h.RunSavedLogQuery(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"total":2`)
+}
+
+func TestLogQueriesHandlers_ErrorMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "not found", err: fmt.Errorf("%w: saved query", service.ErrLogQueryNotFound), wantStatus: http.StatusNotFound},
+		{name: "forbidden", err: observerAuthz.ErrAuthzForbidden, wantStatus: http.StatusForbidden},
+		{name: "invalid", err: service.ErrLogQueryInvalidRequest, wantStatus: http.StatusBadRequest},
+		{name: "resolver", err: service.ErrLogQueryResolveScope, wantStatus: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockLogQueryManager(t)
+			svc.EXPECT().GetLogMetric(mock.Anything, "default", "commerce", "errors").Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logQueriesService: svc}
+			req := newLogQueriesTestRequest(http.MethodGet, "/api/v1alpha1/logs/namespaces/default/projects/commerce/log-metrics/errors", "")
+			req.SetPathValue("name", "errors")
+			rr := httptest.NewRecorder()
+			h.GetLogMetric(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestCreateLogMetric_Conflict(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogQueryManager(t)
+	svc.EXPECT().CreateLogMetric(mock.Anything, mock.Anything).Return(nil, service.ErrLogQueryAlreadyExists)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logQueriesService: svc}
+	rr := httptest.NewRecorder()
+	h.CreateLogMetric(rr, newLogQueriesTestRequest(http.MethodPost,
+		"/api/v1alpha1/logs/namespaces/default/projects/commerce/log-metrics",
+		`{"name":"errors","interval":"1m","groupBy":"level"}`))
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestDeleteSavedLogQuery_ServiceNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+	req := newLogQueriesTestRequest(http.MethodDelete, savedQueriesPath+"/payment-errors", "")
+	req.SetPathValue("name", "payment-errors")
+	rr := httptest.NewRecorder()
+	h.DeleteSavedLogQuery(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -4,8 +4,12 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
 	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
@@ -14,6 +18,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
+// logsTailKeepAliveInterval is how often an idle log tail writes a keep-alive comment.
+const logsTailKeepAliveInterval = 15 * time.Second
+
 // QueryLogs handles POST /api/v1/logs/query
 func (h *Handler) QueryLogs(w http.ResponseWriter, r *http.Request) {
 	var req types.LogsQueryRequest
@@ -44,40 +51,135 @@ func (h *Handler) QueryLogs(w http.ResponseWriter, r *http.Request) {
 	}
 	result, err := h.logsService.QueryLogs(ctx, &req)
 	if err != nil {
-		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
-			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
-			return
+		h.writeLogsError(w, err, "Failed to query logs")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// TailLogs handles POST /api/v1/logs/tail. New log entries of the search scope are
+// streamed as server-sent events: each "logs" event carries a JSON array of entries in
+// chronological order. A comment is written on idle connections every
+// logsTailKeepAliveInterval so proxies do not close them.
+func (h *Handler) TailLogs(w http.ResponseWriter, r *http.Request) {
+	var req types.LogsTailRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateLogsTailRequest(&req); err != nil {
+		h.logger.Debug("Validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if h.logsTailer == nil {
+		h.logger.Error("Logs tailer is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1LogsServiceNotReady,
+			"Logs service is not initialized",
+		)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	started := false
+	var lastWrite time.Time
+	send := func(batch []types.LogEntry) error {
+		if !started {
+			// A tail outlives the server write timeout.
+			if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+				return err
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("X-Accel-Buffering", "no")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		} else if len(batch) == 0 && time.Since(lastWrite) < logsTailKeepAliveInterval {
+			return nil
 		}
-		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
-			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
-			return
+
+		if err := writeLogsTailEvent(w, batch); err != nil {
+			return err
 		}
-		h.logger.Error("Failed to query logs", "error", err)
-		errorCode := types.ErrorCodeV1LogsInternalGeneric
-		switch {
-		case errors.Is(err, service.ErrScopeAuthFailed):
-			h.writeErrorResponse(
-				w,
-				http.StatusInternalServerError,
-				gen.InternalServerError,
-				types.ErrorCodeV1ScopeAuthFailed,
-				"",
-			)
-			return
-		case errors.Is(err, service.ErrLogsResolveSearchScope):
-			errorCode = types.ErrorCodeV1LogsResolverFailed
-		case errors.Is(err, service.ErrLogsRetrieval):
-			errorCode = types.ErrorCodeV1LogsRetrievalFailed
+		lastWrite = time.Now()
+		return rc.Flush()
+	}
+
+	err := h.logsTailer.TailLogs(r.Context(), &req, send)
+	switch {
+	case err == nil:
+		return
+	case !started:
+		h.writeLogsError(w, err, "Failed to tail logs")
+	case r.Context().Err() == nil:
+		h.logger.Error("Log tail stopped", "error", err)
+		if _, werr := fmt.Fprintf(w, "event: error\ndata: {\"errorCode\":%q,\"message\":\"log tail stopped\"}\n\n",
+			types.ErrorCodeV1LogsStreamingFailed); werr == nil {
+			_ = rc.Flush()
 		}
+	}
+}
+
+// writeLogsTailEvent writes a batch of entries as a "logs" event, or a keep-alive
+// comment when the batch is empty.
+func writeLogsTailEvent(w io.Writer, batch []types.LogEntry) error {
+	if len(batch) == 0 {
+		_, err := io.WriteString(w, ": keep-alive\n\n")
+		return err
+	}
+	data, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal log entries: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "event: logs\ndata: %s\n\n", data)
+	return err
+}
+
+// writeLogsError maps logs service errors to HTTP responses.
+func (h *Handler) writeLogsError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+		h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+		return
+	}
+	if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+		h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+		return
+	}
+	if errors.Is(err, service.ErrLogsInvalidRequest) {
+		h.logger.Debug("Invalid logs request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+	h.logger.Error(message, "error", err)
+	errorCode := types.ErrorCodeV1LogsInternalGeneric
+	switch {
+	case errors.Is(err, service.ErrScopeAuthFailed):
 		h.writeErrorResponse(
 			w,
 			http.StatusInternalServerError,
 			gen.InternalServerError,
-			errorCode,
-			"Failed to retrieve logs",
+			types.ErrorCodeV1ScopeAuthFailed,
+			"",
 		)
 		return
+	case errors.Is(err, service.ErrLogsResolveSearchScope):
+		errorCode = types.ErrorCodeV1LogsResolverFailed
+	case errors.Is(err, service.ErrLogsRetrieval):
+		errorCode = types.ErrorCodeV1LogsRetrievalFailed
 	}
-
-	h.writeJSON(w, http.StatusOK, result)
+	h.writeErrorResponse(
+		w,
+		http.StatusInternalServerError,
+		gen.InternalServerError,
+		errorCode,
+		"Failed to retrieve logs",
+	)
 }
@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validLogsTailBody = `{"searchScope":{"namespace":"ns","project":"proj","component":"comp"},"logLevels":["ERROR"]}`
+
+func newLogsTailRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/tail", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestTailLogs_StreamsEvents(t *testing.T) {
+	t.Parallel()
+
+	tailer := servicemocks.NewMockLogsTailer(t)
+	tailer.EXPECT().TailLogs(mock.Anything, mock.MatchedBy(func(req *types.LogsTailRequest) bool {
+		return req.SearchScope.Component.Component == "comp" && req.LogLevels[0] == "ERROR"
+	}), mock.Anything).RunAndReturn(func(_ context.Context, _ *types.LogsTailRequest, send func([]types.LogEntry) error) error {
+		if err := send(nil); err != nil {
+			return err
+		}
+		return send([]types.LogEntry{{Timestamp: "2026-03-07T10:00:00Z", Log: "boom", Level: "ERROR"}})
+	})
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsTailer: tailer}
+	rr := httptest.NewRecorder()
+	h.TailLogs(rr, newLogsTailRequest(validLogsTailBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+	assert.True(t, rr.Flushed)
+	body := rr.Body.String()
+	assert.True(t, strings.HasPrefix(body, ": keep-alive\n\n"), body)
+	assert.Contains(t, body, "event: logs\ndata: [{\"timestamp\":\"2026-03-07T10:00:00Z\",\"log\":\"boom\",\"level\":\"ERROR\"}]\n\n")
+}
+
+func TestTailLogs_ErrorBeforeStreaming(t *testing.T) {
+	t.Parallel()
+
+	tailer := servicemocks.NewMockLogsTailer(t)
+	tailer.EXPECT().TailLogs(mock.Anything, mock.Anything, mock.Anything).Return(observerAuthz.ErrAuthzForbidden)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsTailer: tailer}
+	rr := httptest.NewRecorder()
+	h.TailLogs(rr, newLogsTailRequest(validLogsTailBody))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Contains(t, rr.Header().Get("Content-Type"), "application/json")
+}
+
+func TestTailLogs_ErrorAfterStreaming(t *testing.T) {
+	t.Parallel()
+
+	tailer := servicemocks.NewMockLogsTailer(t)
+	tailer.EXPECT().TailLogs(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ *types.LogsTailRequest, send func([]types.LogEntry) error) error {
+			if err := send(nil); err != nil {
+				return err
+			}
+			return errors.New("adapter gone")
+		})
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsTailer: tailer}
+	rr := httptest.NewRecorder()
+	h.TailLogs(rr, newLogsTailRequest(validLogsTailBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "event: error\n")
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1LogsStreamingFailed)
+}
+
+func TestTailLogs_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsTailer: servicemocks.NewMockLogsTailer(t)}
+	rr := httptest.NewRecorder()
+	h.TailLogs(rr, newLogsTailRequest(`{"searchScope":{"namespace":"ns","project":"proj","component":"comp"},"logLevels":["LOUD"]}`))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestTailLogs_ServiceNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.TailLogs(rr, newLogsTailRequest(validLogsTailBody))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1LogsServiceNotReady)
+}
@@ -210,6 +210,102 @@ func (h *Handler) QuerySpansForTrace(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, genResp)
 }
 
+// QueryTraceLogs handles POST /api/v1alpha1/traces/{traceId}/logs/query
+func (h *Handler) QueryTraceLogs(w http.ResponseWriter, r *http.Request) {
+	traceID := r.PathValue("traceId")
+
+	// 1. BIND REQUEST (from generated type)
+	var genReq gen.TraceLogsQueryRequest
+	if err := httputil.BindJSON(r, &genReq); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	// 2. VALIDATE REQUEST
+	if traceID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "traceId is required")
+		return
+	}
+	if err := ValidateTraceLogsQueryRequest(&genReq); err != nil {
+		h.logger.Debug("Validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	req := &types.TraceLogsQueryRequest{
+		TraceID:   traceID,
+		SpanID:    derefString(genReq.SpanId),
+		StartTime: genReq.StartTime,
+		EndTime:   genReq.EndTime,
+		Limit:     derefInt(genReq.Limit, 100),
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   genReq.SearchScope.Namespace,
+			Project:     derefString(genReq.SearchScope.Project),
+			Component:   derefString(genReq.SearchScope.Component),
+			Environment: derefString(genReq.SearchScope.Environment),
+		},
+	}
+
+	// 3. CHECK SERVICE INITIALIZATION
+	ctx := r.Context()
+	if h.logsService == nil {
+		h.logger.Error("Logs service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1LogsServiceNotReady,
+			"Logs service is not initialized",
+		)
+		return
+	}
+
+	// 4. CALL SERVICE (authorization is enforced by the service layer)
+	result, err := h.logsService.QueryTraceLogs(ctx, req)
+	if err != nil {
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+			return
+		}
+		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+			return
+		}
+		h.logger.Error("Failed to query trace logs", "error", err)
+		errorCode := types.ErrorCodeV1LogsInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrScopeAuthFailed):
+			h.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1ScopeAuthFailed,
+				"",
+			)
+			return
+		case errors.Is(err, service.ErrLogsInvalidRequest):
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request")
+			return
+		case errors.Is(err, service.ErrLogsResolveSearchScope):
+			errorCode = types.ErrorCodeV1LogsResolverFailed
+		case errors.Is(err, service.ErrLogsRetrieval):
+			errorCode = types.ErrorCodeV1LogsRetrievalFailed
+		}
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to retrieve trace logs",
+		)
+		return
+	}
+
+	// 5. RETURN RESPONSE
+	h.writeJSON(w, http.StatusOK, result)
+}
+
 // GetSpanDetailsForTrace handles GET /api/v1alpha1/traces/{traceId}/spans/{spanId}
 func (h *Handler) GetSpanDetailsForTrace(w http.ResponseWriter, r *http.Request) {
 	traceID := r.PathValue("traceId")
@@ -420,6 +516,9 @@ func convertSpanDetailsToGen(span *types.SpanInfo) map[string]interface{} {
 	if span.ResourceAttributes != nil {
 		spanData["resourceAttributes"] = span.ResourceAttributes
 	}
+	if span.CorrelatedLogCount != nil {
+		spanData["correlatedLogCount"] = *span.CorrelatedLogCount
+	}
 
 	return spanData
 }
@@ -4,7 +4,7 @@
 package handlers
 
 // traces_handler_test.go covers the HTTP handler paths for QueryTraces,
-// QuerySpansForTrace, and GetSpanDetailsForTrace that are NOT already covered
+// QuerySpansForTrace, QueryTraceLogs and GetSpanDetailsForTrace that are NOT already covered
 // by scope_auth_test.go (scope-auth error) or traces_test.go (conversion functions).
 
 import (
@@ -338,6 +338,113 @@ func TestQuerySpansForTrace_GenericError(t *testing.T) {
 	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1TracesInternalGeneric)
 }
 
+// QueryTraceLogs tests ----------------------------------------------------------
+
+func TestQueryTraceLogs_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogsQuerier(t)
+	svc.On("QueryTraceLogs", mock.Anything, mock.MatchedBy(func(req *types.TraceLogsQueryRequest) bool {
+		return req.TraceID == "trace-1" && req.SearchScope.Namespace == "test-ns" && req.Limit == 100
+	})).Return(&types.LogsQueryResponse{
+		Logs:  []types.LogEntry{{Timestamp: "2024-01-01T00:00:00Z", Log: "hello"}},
+		Total: 1,
+	}, nil)
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: svc,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/traces/trace-1/logs/query", validTracesRequestBody(t))
+	req.SetPathValue("traceId", "trace-1")
+	rr := httptest.NewRecorder()
+
+	h.QueryTraceLogs(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"total":1`)
+}
+
+func TestQueryTraceLogs_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: servicemocks.NewMockLogsQuerier(t),
+	}
+
+	// Missing searchScope.namespace → validation failure.
+	body := `{"startTime":"2024-01-01T00:00:00Z","endTime":"2024-01-02T00:00:00Z","searchScope":{}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/traces/trace-1/logs/query", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("traceId", "trace-1")
+	rr := httptest.NewRecorder()
+
+	h.QueryTraceLogs(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestQueryTraceLogs_ServiceNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: nil,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/traces/trace-1/logs/query", validTracesRequestBody(t))
+	req.SetPathValue("traceId", "trace-1")
+	rr := httptest.NewRecorder()
+
+	h.QueryTraceLogs(rr, req)
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1LogsServiceNotReady)
+}
+
+func TestQueryTraceLogs_AuthzForbidden(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogsQuerier(t)
+	svc.On("QueryTraceLogs", mock.Anything, mock.Anything).Return(nil, observerAuthz.ErrAuthzForbidden)
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: svc,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/traces/trace-1/logs/query", validTracesRequestBody(t))
+	req.SetPathValue("traceId", "trace-1")
+	rr := httptest.NewRecorder()
+
+	h.QueryTraceLogs(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestQueryTraceLogs_RetrievalError(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogsQuerier(t)
+	svc.On("QueryTraceLogs", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: backend error", service.ErrLogsRetrieval))
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: svc,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/traces/trace-1/logs/query", validTracesRequestBody(t))
+	req.SetPathValue("traceId", "trace-1")
+	rr := httptest.NewRecorder()
+
+	h.QueryTraceLogs(rr, req)
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1LogsRetrievalFailed)
+}
+
 // GetSpanDetailsForTrace tests ---------------------------------------------------
 
 func TestGetSpanDetailsForTrace_Success(t *testing.T) {
@@ -22,10 +22,12 @@ const (
 	defaultSortOrder  = "desc"
 	sortOrderAsc      = "asc"
 	maxQueryTimeRange = 30 * 24 * time.Hour // 30 days
+	maxLogsTailReplay = time.Hour
 
-	sourceTypeLog    = "log"
-	sourceTypeMetric = "metric"
-	sourceTypeBudget = "budget"
+	sourceTypeLog       = "log"
+	sourceTypeMetric    = "metric"
+	sourceTypeBudget    = "budget"
+	sourceTypeLogMetric = "logMetric"
 )
 
 // ValidateLogsQueryRequest validates the LogsQueryRequest
@@ -35,30 +37,8 @@ func ValidateLogsQueryRequest(req *types.LogsQueryRequest) error {
 	}
 
 	// Validate search scope
-	if req.SearchScope == nil {
-		return fmt.Errorf("searchScope is required")
-	}
-
-	// Exactly one of component or workflow must be set
-	if req.SearchScope.Component == nil && req.SearchScope.Workflow == nil {
-		return fmt.Errorf("searchScope must be either a ComponentSearchScope (with namespace, and optionally project/component/environment) or WorkflowSearchScope (with namespace, and optionally workflowRunName)")
-	}
-	if req.SearchScope.Component != nil && req.SearchScope.Workflow != nil {
-		return fmt.Errorf("searchScope cannot be both ComponentSearchScope and WorkflowSearchScope")
-	}
-
-	// Validate component scope if present
-	if req.SearchScope.Component != nil {
-		if err := validateComponentScope(req.SearchScope.Component); err != nil {
-			return err
-		}
-	}
-
-	// Validate workflow scope if present
-	if req.SearchScope.Workflow != nil {
-		if err := validateWorkflowScope(req.SearchScope.Workflow); err != nil {
-			return err
-		}
+	if err := validateLogsSearchScope(req.SearchScope); err != nil {
+		return err
 	}
 
 	// Validate time range
@@ -84,6 +64,65 @@ func ValidateLogsQueryRequest(req *types.LogsQueryRequest) error {
 	return nil
 }
 
+// ValidateLogsTailRequest validates the LogsTailRequest. A startTime, when given, must
+// not be in the future nor more than maxLogsTailReplay in the past.
+func ValidateLogsTailRequest(req *types.LogsTailRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+
+	if err := validateLogsSearchScope(req.SearchScope); err != nil {
+		return err
+	}
+
+	if req.StartTime != "" {
+		startTime, err := time.Parse(time.RFC3339, req.StartTime)
+		if err != nil {
+			return fmt.Errorf("startTime must be in RFC3339 format (e.g., 2024-01-01T00:00:00Z): %w", err)
+		}
+		now := time.Now()
+		if startTime.After(now) {
+			return fmt.Errorf("startTime must not be in the future")
+		}
+		if now.Sub(startTime) > maxLogsTailReplay {
+			return fmt.Errorf("startTime cannot be more than %s in the past", maxLogsTailReplay)
+		}
+	}
+
+	return ValidateLogLevels(req.LogLevels)
+}
+
+// validateLogsSearchScope validates the component/workflow search scope union of a logs request.
+func validateLogsSearchScope(scope *types.SearchScope) error {
+	if scope == nil {
+		return fmt.Errorf("searchScope is required")
+	}
+
+	// Exactly one of component or workflow must be set
+	if scope.Component == nil && scope.Workflow == nil {
+		return fmt.Errorf("searchScope must be either a ComponentSearchScope (with namespace, and optionally project/component/environment) or WorkflowSearchScope (with namespace, and optionally workflowRunName)")
+	}
+	if scope.Component != nil && scope.Workflow != nil {
+		return fmt.Errorf("searchScope cannot be both ComponentSearchScope and WorkflowSearchScope")
+	}
+
+	// Validate component scope if present
+	if scope.Component != nil {
+		if err := validateComponentScope(scope.Component); err != nil {
+			return err
+		}
+	}
+
+	// Validate workflow scope if present
+	if scope.Workflow != nil {
+		if err := validateWorkflowScope(scope.Workflow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ValidateEventsQueryRequest validates the EventsQueryRequest.
 func ValidateEventsQueryRequest(req *types.EventsQueryRequest) error {
 	if req == nil {
@@ -234,8 +273,16 @@ func ValidateMetricsQueryRequest(req *types.MetricsQueryRequest) error {
 	if req.Metric == "" {
 		return fmt.Errorf("metric is required")
 	}
-	if req.Metric != types.MetricTypeResource && req.Metric != types.MetricTypeHTTP {
-		return fmt.Errorf("metric must be either %s or %s", types.MetricTypeResource, types.MetricTypeHTTP)
+	if req.Metric != types.MetricTypeResource && req.Metric != types.MetricTypeHTTP && req.Metric != types.MetricTypeLog {
+		return fmt.Errorf("metric must be either %s, %s or %s", types.MetricTypeResource, types.MetricTypeHTTP, types.MetricTypeLog)
+	}
+	if req.Metric == types.MetricTypeLog {
+		if req.LogMetric == "" {
+			return fmt.Errorf("logMetric is required when metric is %s", types.MetricTypeLog)
+		}
+		if req.SearchScope.Project == "" {
+			return fmt.Errorf("searchScope.project is required when metric is %s", types.MetricTypeLog)
+		}
 	}
 
 	// Validate time range
@@ -314,6 +361,46 @@ func ValidateLogLevels(logLevels []string) error {
 	return nil
 }
 
+// ValidateSavedLogQueryRequest validates the SavedLogQueryRequest
+func ValidateSavedLogQueryRequest(req *types.SavedLogQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	return ValidateLogLevels(req.LogLevels)
+}
+
+// ValidateSavedLogQueryRunRequest validates the SavedLogQueryRunRequest and sets the
+// limit and sort order defaults.
+func ValidateSavedLogQueryRunRequest(req *types.SavedLogQueryRunRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if err := ValidateTimeRange(req.StartTime, req.EndTime); err != nil {
+		return err
+	}
+	if err := ValidateAndSetLimit(&req.Limit); err != nil {
+		return err
+	}
+	return ValidateAndSetSortOrder(&req.SortOrder)
+}
+
+// ValidateLogMetricRequest validates the LogMetricRequest
+func ValidateLogMetricRequest(req *types.LogMetricRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if strings.TrimSpace(req.Interval) == "" {
+		return fmt.Errorf("interval is required")
+	}
+	return ValidateLogLevels(req.LogLevels)
+}
+
 // validateAlertRuleRequest validates the new API AlertRuleRequest type.
 func validateAlertRuleRequest(req gen.AlertRuleRequest) error {
 	// Metadata validations
@@ -332,8 +419,9 @@ func validateAlertRuleRequest(req gen.AlertRuleRequest) error {
 
 	// Source validations
 	sourceType := string(req.Source.Type)
-	if sourceType != sourceTypeLog && sourceType != sourceTypeMetric && sourceType != sourceTypeBudget {
-		return fmt.Errorf("source.type must be 'log', 'metric', or 'budget'")
+	if sourceType != sourceTypeLog && sourceType != sourceTypeMetric && sourceType != sourceTypeBudget &&
+		sourceType != sourceTypeLogMetric {
+		return fmt.Errorf("source.type must be 'log', 'metric', 'budget', or 'logMetric'")
 	}
 	if sourceType == sourceTypeLog {
 		if req.Source.Query == nil || strings.TrimSpace(*req.Source.Query) == "" {
@@ -349,6 +437,14 @@ func validateAlertRuleRequest(req gen.AlertRuleRequest) error {
 			return fmt.Errorf("source.metric must be 'cpu_usage', 'memory_usage' or 'budget' for metric-based alert rules")
 		}
 	}
+	if sourceType == sourceTypeLogMetric {
+		if req.Source.LogMetric == nil || strings.TrimSpace(*req.Source.LogMetric) == "" {
+			return fmt.Errorf("source.logMetric is required for log metric based alert rules")
+		}
+		if strings.TrimSpace(req.Metadata.Namespace) == "" {
+			return fmt.Errorf("metadata.namespace is required for log metric based alert rules")
+		}
+	}
 
 	// Condition validations
 	windowDuration, err := time.ParseDuration(req.Condition.Window)
@@ -384,12 +480,22 @@ func validateAlertRuleRequest(req gen.AlertRuleRequest) error {
 
 // validateSourceType checks that the sourceType path parameter is a known value.
 // Returns an error with a descriptive message for use in a 400 Bad Request response.
+// validateAlertRuleNamespace checks the namespace query parameter of an alert rule
+// lookup. Log metric alert rules are stored by namespace and name, so it is required
+// for them; the adapters key rules by name alone.
+func validateAlertRuleNamespace(sourceType, namespace string) error {
+	if sourceType == sourceTypeLogMetric && namespace == "" {
+		return fmt.Errorf("namespace query parameter is required for logMetric alert rules")
+	}
+	return nil
+}
+
 func validateSourceType(sourceType string) error {
 	switch sourceType {
-	case sourceTypeLog, sourceTypeMetric, sourceTypeBudget:
+	case sourceTypeLog, sourceTypeMetric, sourceTypeBudget, sourceTypeLogMetric:
 		return nil
 	default:
-		return fmt.Errorf("sourceType %q is invalid: must be 'log', 'metric', or 'budget'", sourceType)
+		return fmt.Errorf("sourceType %q is invalid: must be 'log', 'metric', 'budget', or 'logMetric'", sourceType)
 	}
 }
 
@@ -450,6 +556,41 @@ func ValidateTracesQueryRequest(req *gen.TracesQueryRequest) error {
 	return nil
 }
 
+// ValidateTraceLogsQueryRequest validates a trace logs query request.
+func ValidateTraceLogsQueryRequest(req *gen.TraceLogsQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+
+	if req.StartTime.IsZero() {
+		return fmt.Errorf("startTime is required")
+	}
+	if req.EndTime.IsZero() {
+		return fmt.Errorf("endTime is required")
+	}
+	if err := ValidateTimeRange(req.StartTime.Format(time.RFC3339), req.EndTime.Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	if req.SearchScope.Namespace == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if derefString(req.SearchScope.Component) != "" && derefString(req.SearchScope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required when searchScope.component is provided")
+	}
+
+	if req.Limit != nil {
+		if *req.Limit <= 0 {
+			return fmt.Errorf("limit must be a positive integer greater than zero")
+		}
+		if *req.Limit > config.MaxLimit {
+			return fmt.Errorf("limit cannot exceed %d", config.MaxLimit)
+		}
+	}
+
+	return nil
+}
+
 // ValidateAlertsQueryRequest validates an alerts query request.
 func ValidateAlertsQueryRequest(req *gen.AlertsQueryRequest) error {
 	if req == nil {
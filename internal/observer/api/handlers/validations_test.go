@@ -298,6 +298,60 @@ func TestValidateLogsQueryRequest(t *testing.T) {
 	}
 }
 
+func TestValidateLogsTailRequest(t *testing.T) {
+	t.Parallel()
+
+	scope := &types.SearchScope{Component: &types.ComponentSearchScope{Namespace: "test-ns", Project: "proj", Component: "comp"}}
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name        string
+		req         *types.LogsTailRequest
+		errContains string
+	}{
+		{name: "nil request", req: nil, errContains: "required"},
+		{name: "nil searchScope", req: &types.LogsTailRequest{}, errContains: "searchScope is required"},
+		{name: "valid without start time", req: &types.LogsTailRequest{SearchScope: scope}},
+		{
+			name: "valid start time",
+			req:  &types.LogsTailRequest{SearchScope: scope, StartTime: now.Add(-10 * time.Minute).Format(time.RFC3339)},
+		},
+		{
+			name:        "invalid start time",
+			req:         &types.LogsTailRequest{SearchScope: scope, StartTime: "10 minutes ago"},
+			errContains: "RFC3339",
+		},
+		{
+			name:        "start time in the future",
+			req:         &types.LogsTailRequest{SearchScope: scope, StartTime: now.Add(time.Hour).Format(time.RFC3339)},
+			errContains: "future",
+		},
+		{
+			name:        "start time too far back",
+			req:         &types.LogsTailRequest{SearchScope: scope, StartTime: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+			errContains: "in the past",
+		},
+		{
+			name:        "invalid log level",
+			req:         &types.LogsTailRequest{SearchScope: scope, LogLevels: []string{"LOUD"}},
+			errContains: "LOUD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateLogsTailRequest(tt.req)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
 func TestValidateMetricsQueryRequest(t *testing.T) {
 	t.Parallel()
 
@@ -330,6 +384,34 @@ func TestValidateMetricsQueryRequest(t *testing.T) {
 			wantErr:     true,
 			errContains: "namespace is required",
 		},
+		{
+			name: "log metric without name",
+			req: &types.MetricsQueryRequest{
+				Metric:    "log",
+				StartTime: validStart, EndTime: validEnd,
+				SearchScope: types.ComponentSearchScope{Namespace: "ns", Project: "proj"},
+			},
+			wantErr:     true,
+			errContains: "logMetric is required",
+		},
+		{
+			name: "log metric without project",
+			req: &types.MetricsQueryRequest{
+				Metric: "log", LogMetric: "errors",
+				StartTime: validStart, EndTime: validEnd,
+				SearchScope: types.ComponentSearchScope{Namespace: "ns"},
+			},
+			wantErr:     true,
+			errContains: "searchScope.project is required",
+		},
+		{
+			name: "valid log metric",
+			req: &types.MetricsQueryRequest{
+				Metric: "log", LogMetric: "errors",
+				StartTime: validStart, EndTime: validEnd,
+				SearchScope: types.ComponentSearchScope{Namespace: "ns", Project: "proj"},
+			},
+		},
 		{
 			name: "component without project",
 			req: &types.MetricsQueryRequest{
@@ -807,6 +889,43 @@ func TestValidateAlertRuleRequest(t *testing.T) {
 			wantErr:     true,
 			errContains: "source.query is required",
 		},
+		{
+			name: "log metric rule missing log metric",
+			req: func() gen.AlertRuleRequest {
+				r := baseLogRule()
+				r.Source.Type = "logMetric"
+				r.Source.Query = nil
+				return r
+			},
+			wantErr:     true,
+			errContains: "source.logMetric is required",
+		},
+		{
+			name: "log metric rule missing namespace",
+			req: func() gen.AlertRuleRequest {
+				r := baseLogRule()
+				logMetric := "errors-by-level"
+				r.Source.Type = "logMetric"
+				r.Source.Query = nil
+				r.Source.LogMetric = &logMetric
+				r.Metadata.Namespace = ""
+				return r
+			},
+			wantErr:     true,
+			errContains: "metadata.namespace is required",
+		},
+		{
+			name: "valid log metric rule",
+			req: func() gen.AlertRuleRequest {
+				r := baseLogRule()
+				logMetric := "errors-by-level"
+				r.Source.Type = "logMetric"
+				r.Source.Query = nil
+				r.Source.LogMetric = &logMetric
+				r.Metadata.Namespace = "default"
+				return r
+			},
+		},
 		{
 			name: "metric rule missing metric field",
 			req: func() gen.AlertRuleRequest {
@@ -262,6 +262,12 @@ type ComponentLogEntry struct {
 
 		// ProjectUid The OpenChoreo project UID that generated the log
 		ProjectUid *openapi_types.UUID `json:"projectUid,omitempty"`
+
+		// SpanId The ID of the span the log was emitted in, if known
+		SpanId *string `json:"spanId,omitempty"`
+
+		// TraceId The ID of the trace the log was emitted in, if known
+		TraceId *string `json:"traceId,omitempty"`
 	} `json:"metadata,omitempty"`
 
 	// Timestamp The timestamp of the log entry
@@ -389,8 +395,14 @@ type LogsQueryRequest struct {
 	// SortOrder The sort order of the query
 	SortOrder *LogsQueryRequestSortOrder `json:"sortOrder,omitempty"`
 
+	// SpanId Only return component logs emitted within this span of the trace. Requires traceId.
+	SpanId *string `json:"spanId,omitempty"`
+
 	// StartTime The start time of the query
 	StartTime time.Time `json:"startTime"`
+
+	// TraceId Only return component logs emitted within this trace: logs whose trace context matches, or, for logs without trace context, whose message contains the trace ID. Applied before the limit.
+	TraceId *string `json:"traceId,omitempty"`
 }
 
 // LogsQueryRequestLogLevels defines model for LogsQueryRequest.LogLevels.
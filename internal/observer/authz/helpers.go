@@ -83,6 +83,15 @@ func LogsScopeAuthz(req *types.LogsQueryRequest) (ResourceType, string, authzcor
 	return searchScopeAuthz(req.SearchScope)
 }
 
+// LogsTailScopeAuthz determines the authorization resource type, name, and hierarchy
+// from a logs tail request's search scope.
+func LogsTailScopeAuthz(req *types.LogsTailRequest) (ResourceType, string, authzcore.ResourceHierarchy, error) {
+	if req == nil {
+		return "", "", authzcore.ResourceHierarchy{}, fmt.Errorf("request is required")
+	}
+	return searchScopeAuthz(req.SearchScope)
+}
+
 // EventsScopeAuthz determines the authorization resource type, name, and hierarchy
 // from an events query request's search scope.
 func EventsScopeAuthz(req *types.EventsQueryRequest) (ResourceType, string, authzcore.ResourceHierarchy, error) {
@@ -28,6 +28,7 @@ type Config struct {
 	Authz       AuthzConfig       `koanf:"authz"`
 	Logging     LoggingConfig     `koanf:"logging"`
 	Alerting    AlertingConfig    `koanf:"alerting"`
+	LogMetrics  LogMetricsConfig  `koanf:"log_metrics"`
 	Adapters    AdaptersConfig    `koanf:"adapters"`
 	UIDResolver UIDResolverConfig `koanf:"uid_resolver"`
 	CORS        CORSConfig        `koanf:"cors"`
@@ -84,6 +85,8 @@ type LoggingConfig struct {
 	DefaultLogLimit      int `koanf:"default.log.limit"`
 	DefaultBuildLogLimit int `koanf:"default.build.log.limit"`
 	MaxLogLinesPerFile   int `koanf:"max.log.lines.per.file"`
+	// TailPollInterval is how often a live tail looks for new log entries.
+	TailPollInterval time.Duration `koanf:"tail.poll.interval"`
 }
 
 // AlertingConfig holds configuration related to alerting features
@@ -111,6 +114,21 @@ type AlertingConfig struct {
 	FinOpsAgentEnabled bool `koanf:"finops.agent.enabled"`
 }
 
+// LogMetricsConfig holds configuration for saved log queries and log-derived metrics.
+// Both are persisted in the alert store configured under AlertingConfig.
+type LogMetricsConfig struct {
+	// EvaluationEnabled controls whether log-derived metrics are evaluated in the background.
+	EvaluationEnabled bool `koanf:"evaluation.enabled"`
+	// EvaluationTick is how often the evaluator looks for log metrics that are due.
+	EvaluationTick time.Duration `koanf:"evaluation.tick"`
+	// EvaluationLeaseName is the Lease the observer replicas elect the single evaluator with.
+	EvaluationLeaseName string `koanf:"evaluation.lease.name"`
+	// EvaluationLeaseNamespace is the namespace of the Lease. Defaults to the observability namespace.
+	EvaluationLeaseNamespace string `koanf:"evaluation.lease.namespace"`
+	// SampleRetention is how long evaluated samples are kept before being pruned.
+	SampleRetention time.Duration `koanf:"sample.retention"`
+}
+
 // UIDResolverConfig holds configuration for the resource UID resolver
 // which resolves resource names to UIDs via the openchoreo-api
 type UIDResolverConfig struct {
@@ -183,6 +201,7 @@ func Load() (*Config, error) {
 		"LOGGING_DEFAULT_LOG_LIMIT":             "logging.default.log.limit",
 		"LOGGING_DEFAULT_BUILD_LOG_LIMIT":       "logging.default.build.log.limit",
 		"LOGGING_MAX_LOG_LINES_PER_FILE":        "logging.max.log.lines.per.file",
+		"LOGGING_TAIL_POLL_INTERVAL":            "logging.tail.poll.interval",
 		"RCA_SERVICE_URL":                       "alerting.rca.service.url",
 		"AI_RCA_ENABLED":                        "alerting.ai.rca.enabled",
 		"OBSERVABILITY_NAMESPACE":               "alerting.observability.namespace",
@@ -191,6 +210,11 @@ func Load() (*Config, error) {
 		"ALERT_SUPPRESSION_WINDOW":              "alerting.alert.suppression.window",
 		"FINOPS_AGENT_URL":                      "alerting.finops.agent.url",
 		"FINOPS_AGENT_ENABLED":                  "alerting.finops.agent.enabled",
+		"LOG_METRICS_EVALUATION_ENABLED":        "log_metrics.evaluation.enabled",
+		"LOG_METRICS_EVALUATION_TICK":           "log_metrics.evaluation.tick",
+		"LOG_METRICS_LEASE_NAME":                "log_metrics.evaluation.lease.name",
+		"LOG_METRICS_LEASE_NAMESPACE":           "log_metrics.evaluation.lease.namespace",
+		"LOG_METRICS_SAMPLE_RETENTION":          "log_metrics.sample.retention",
 		"LOG_LEVEL":                             "loglevel",
 		"PORT":                                  "server.port",           // Common alias
 		"INTERNAL_PORT":                         "server.internal.port",  // Common alias
@@ -311,6 +335,7 @@ func getDefaults() map[string]interface{} {
 			"default.log.limit":       100,
 			"default.build.log.limit": 3000,
 			"max.log.lines.per.file":  600000,
+			"tail.poll.interval":      "2s",
 		},
 		"alerting": map[string]interface{}{
 			"rca.service.url":          "http://sre-agent:8080",
@@ -322,6 +347,12 @@ func getDefaults() map[string]interface{} {
 			"finops.agent.url":         "http://finops-agent:8080",
 			"finops.agent.enabled":     false,
 		},
+		"log_metrics": map[string]interface{}{
+			"evaluation.enabled":    true,
+			"evaluation.tick":       "30s",
+			"evaluation.lease.name": "observer-log-metrics-evaluator",
+			"sample.retention":      "168h",
+		},
 		"adapters": map[string]interface{}{
 			"logs.adapter.url":        "http://logs-adapter:9098",
 			"logs.adapter.timeout":    "30s",
@@ -361,6 +392,9 @@ func (c *Config) validate() error {
 	if c.Logging.MaxLogLimit <= 0 {
 		return fmt.Errorf("max log limit must be positive")
 	}
+	if c.Logging.TailPollInterval <= 0 {
+		return fmt.Errorf("logging tail poll interval must be positive")
+	}
 
 	if c.Authz.ServiceURL == "" {
 		return fmt.Errorf("authz service URL is required")
@@ -403,6 +437,19 @@ func (c *Config) validate() error {
 		return fmt.Errorf("alert.store.backend must be 'sqlite' or 'postgresql'")
 	}
 
+	if c.LogMetrics.EvaluationTick <= 0 {
+		return fmt.Errorf("log metrics evaluation tick must be positive")
+	}
+	if c.LogMetrics.SampleRetention <= 0 {
+		return fmt.Errorf("log metrics sample retention must be positive")
+	}
+	if c.LogMetrics.EvaluationEnabled && c.LogMetrics.EvaluationLeaseName == "" {
+		return fmt.Errorf("log metrics evaluation lease name is required")
+	}
+	if c.LogMetrics.EvaluationLeaseNamespace == "" {
+		c.LogMetrics.EvaluationLeaseNamespace = c.Alerting.ObservabilityNamespace
+	}
+
 	// Validate and normalize MetricsAdapter configuration
 	if c.Adapters.MetricsAdapterURL == "" {
 		return fmt.Errorf("metrics adapter URL is required")
@@ -21,11 +21,17 @@ func TestLoad_WithDefaults(t *testing.T) {
 	assert.Equal(t, "info", cfg.LogLevel)
 	assert.False(t, cfg.Auth.EnableAuth)
 	assert.Equal(t, 10000, cfg.Logging.MaxLogLimit)
+	assert.Equal(t, 2*time.Second, cfg.Logging.TailPollInterval)
 	assert.Equal(t, "http://logs-adapter:9098", cfg.Adapters.LogsAdapterURL)
 	assert.Equal(t, "http://tracing-adapter:9100", cfg.Adapters.TracingAdapterURL)
 	assert.Equal(t, "http://metrics-adapter:9099", cfg.Adapters.MetricsAdapterURL)
 	assert.Equal(t, "http://finops-adapter:9101", cfg.Adapters.FinOpsAdapterURL)
 	assert.Equal(t, 30*time.Second, cfg.Adapters.FinOpsAdapterTimeout)
+	assert.True(t, cfg.LogMetrics.EvaluationEnabled)
+	assert.Equal(t, 30*time.Second, cfg.LogMetrics.EvaluationTick)
+	assert.Equal(t, "observer-log-metrics-evaluator", cfg.LogMetrics.EvaluationLeaseName)
+	assert.Equal(t, cfg.Alerting.ObservabilityNamespace, cfg.LogMetrics.EvaluationLeaseNamespace)
+	assert.Equal(t, 7*24*time.Hour, cfg.LogMetrics.SampleRetention)
 }
 
 func TestLoad_FinOpsAdapterEnvOverride(t *testing.T) {
@@ -124,7 +130,8 @@ func TestValidate(t *testing.T) {
 				InternalPort: 8081,
 			},
 			Logging: LoggingConfig{
-				MaxLogLimit: 1000,
+				MaxLogLimit:      1000,
+				TailPollInterval: 2 * time.Second,
 			},
 			Authz: AuthzConfig{
 				ServiceURL: "http://localhost:8081",
@@ -143,6 +150,10 @@ func TestValidate(t *testing.T) {
 				FinOpsAdapterURL:      "http://localhost:9101",
 				FinOpsAdapterTimeout:  30 * time.Second,
 			},
+			LogMetrics: LogMetricsConfig{
+				EvaluationTick:  30 * time.Second,
+				SampleRetention: 24 * time.Hour,
+			},
 		}
 	}
 
@@ -171,6 +182,11 @@ func TestValidate(t *testing.T) {
 			mutate:    func(c *Config) { c.Logging.MaxLogLimit = 0 },
 			expectErr: true,
 		},
+		{
+			name:      "invalid logging tail poll interval",
+			mutate:    func(c *Config) { c.Logging.TailPollInterval = 0 },
+			expectErr: true,
+		},
 		{
 			name:      "missing authz service URL",
 			mutate:    func(c *Config) { c.Authz.ServiceURL = "" },
@@ -181,6 +197,16 @@ func TestValidate(t *testing.T) {
 			mutate:    func(c *Config) { c.Authz.Timeout = 0 },
 			expectErr: true,
 		},
+		{
+			name:      "invalid log metrics evaluation tick",
+			mutate:    func(c *Config) { c.LogMetrics.EvaluationTick = 0 },
+			expectErr: true,
+		},
+		{
+			name:      "invalid log metrics sample retention",
+			mutate:    func(c *Config) { c.LogMetrics.SampleRetention = -time.Hour },
+			expectErr: true,
+		},
 		{
 			name:      "missing metrics adapter URL",
 			mutate:    func(c *Config) { c.Adapters.MetricsAdapterURL = "" },
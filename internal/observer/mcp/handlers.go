@@ -241,6 +241,33 @@ func (h *MCPHandler) GetSpanDetails(ctx context.Context, traceID, spanID string)
 	return h.tracesService.GetSpanDetails(ctx, traceID, spanID)
 }
 
+func (h *MCPHandler) QueryTraceLogs(ctx context.Context, traceID, spanID, namespace, project, component, environment,
+	startTime, endTime string, limit int) (any, error) {
+	limit, _, _ = setDefaults(limit, "", nil)
+	start, err := parseRFC3339Time(startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_time: %w", err)
+	}
+	end, err := parseRFC3339Time(endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_time: %w", err)
+	}
+	req := &types.TraceLogsQueryRequest{
+		TraceID:   traceID,
+		SpanID:    spanID,
+		StartTime: start,
+		EndTime:   end,
+		Limit:     limit,
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   namespace,
+			Project:     project,
+			Component:   component,
+			Environment: environment,
+		},
+	}
+	return h.logsService.QueryTraceLogs(ctx, req)
+}
+
 func (h *MCPHandler) QueryAlerts(ctx context.Context, namespace, project, component, environment,
 	startTime, endTime string, limit int, sortOrder string) (any, error) {
 	limit, sortOrder, _ = setDefaults(limit, sortOrder, nil)
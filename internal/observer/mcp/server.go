@@ -335,6 +335,43 @@ func registerTools(s *mcpsdk.Server, handler *MCPHandler) {
 		return handleToolResult(result, err)
 	})
 
+	// Tool: query_trace_logs
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "query_trace_logs",
+		Description: "Query application logs correlated with a distributed trace in OpenChoreo. Returns the log entries that carry the given trace ID, oldest first, optionally narrowed to a single span. Use trace_id and span_id from query_traces, query_trace_spans or get_span_details results.",
+		InputSchema: createSchema(map[string]any{
+			"trace_id":    stringProperty("Trace ID to retrieve logs for (required)"),
+			"span_id":     stringProperty("Span ID to restrict logs to a single span"),
+			"namespace":   stringProperty("Organization namespace (required)"),
+			"project":     stringProperty("Project name"),
+			"component":   stringProperty("Component name"),
+			"environment": stringProperty("Environment name"),
+			"start_time":  stringProperty("Start of time range in RFC3339 format (e.g., 2025-11-04T08:29:02.452Z)"),
+			"end_time":    stringProperty("End of time range in RFC3339 format (e.g., 2025-11-04T09:29:02.452Z)"),
+			"limit":       limitLogsProperty(),
+		}, []string{"trace_id", "namespace", "start_time", "end_time"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		TraceID     string `json:"trace_id"`
+		SpanID      string `json:"span_id"`
+		Namespace   string `json:"namespace"`
+		Project     string `json:"project"`
+		Component   string `json:"component"`
+		Environment string `json:"environment"`
+		StartTime   string `json:"start_time"`
+		EndTime     string `json:"end_time"`
+		Limit       int    `json:"limit"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		if err := validateComponentScope(args.Namespace, args.Project, args.Component); err != nil {
+			return nil, nil, err
+		}
+		result, err := handler.QueryTraceLogs(ctx,
+			args.TraceID, args.SpanID,
+			args.Namespace, args.Project, args.Component, args.Environment,
+			args.StartTime, args.EndTime, args.Limit,
+		)
+		return handleToolResult(result, err)
+	})
+
 	// Tool 8: query_alerts
 	mcpsdk.AddTool(s, &mcpsdk.Tool{
 		Name:        "query_alerts",
@@ -39,9 +39,10 @@ const (
 // ---- Mock service implementations ----
 
 type MockLogsQuerier struct {
-	requests []*types.LogsQueryRequest
-	response *types.LogsQueryResponse
-	err      error
+	requests          []*types.LogsQueryRequest
+	traceLogsRequests []*types.TraceLogsQueryRequest
+	response          *types.LogsQueryResponse
+	err               error
 }
 
 func NewMockLogsQuerier() *MockLogsQuerier {
@@ -62,6 +63,21 @@ func (m *MockLogsQuerier) QueryLogs(_ context.Context, req *types.LogsQueryReque
 	return m.response, nil
 }
 
+func (m *MockLogsQuerier) QueryTraceLogs(_ context.Context, req *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error) {
+	m.traceLogsRequests = append(m.traceLogsRequests, req)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.response, nil
+}
+
+func (m *MockLogsQuerier) lastTraceLogsRequest() *types.TraceLogsQueryRequest {
+	if len(m.traceLogsRequests) == 0 {
+		return nil
+	}
+	return m.traceLogsRequests[len(m.traceLogsRequests)-1]
+}
+
 func (m *MockLogsQuerier) lastRequest() *types.LogsQueryRequest {
 	if len(m.requests) == 0 {
 		return nil
@@ -69,7 +85,10 @@ func (m *MockLogsQuerier) lastRequest() *types.LogsQueryRequest {
 	return m.requests[len(m.requests)-1]
 }
 
-func (m *MockLogsQuerier) reset() { m.requests = nil }
+func (m *MockLogsQuerier) reset() {
+	m.requests = nil
+	m.traceLogsRequests = nil
+}
 
 type MockEventsQuerier struct {
 	requests []*types.EventsQueryRequest
@@ -690,6 +709,36 @@ var allToolSpecs = []toolTestSpec{
 			assert.Equal(t, testSpanID, svcs.traces.spanDetailsSpanIDs[lastIdx])
 		},
 	},
+	{
+		name:                "query_trace_logs",
+		descriptionKeywords: []string{"log", "trace"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"trace_id", "namespace", "start_time", "end_time"},
+		optionalParams:      []string{"span_id", "project", "component", "environment", "limit"},
+		testArgs: map[string]any{
+			"trace_id":    testTraceID,
+			"span_id":     testSpanID,
+			"namespace":   testNamespace,
+			"project":     testProject,
+			"component":   testComponent,
+			"environment": testEnvironment,
+			"start_time":  testStartTime,
+			"end_time":    testEndTime,
+			"limit":       20,
+		},
+		validateCall: func(t *testing.T, svcs *testServices) {
+			t.Helper()
+			req := svcs.logs.lastTraceLogsRequest()
+			require.NotNil(t, req, "Expected QueryTraceLogs to be called")
+			assert.Equal(t, testTraceID, req.TraceID)
+			assert.Equal(t, testSpanID, req.SpanID)
+			assert.Equal(t, testNamespace, req.SearchScope.Namespace)
+			assert.Equal(t, testProject, req.SearchScope.Project)
+			assert.Equal(t, testComponent, req.SearchScope.Component)
+			assert.Equal(t, testEnvironment, req.SearchScope.Environment)
+			assert.Equal(t, 20, req.Limit)
+		},
+	},
 	{
 		name:                "query_alerts",
 		descriptionKeywords: []string{"alert"},
@@ -1138,6 +1187,16 @@ func TestMinimalParameterSets(t *testing.T) {
 				"span_id":  testSpanID,
 			},
 		},
+		{
+			name:     "query_trace_logs_minimal",
+			toolName: "query_trace_logs",
+			args: map[string]any{
+				"trace_id":   testTraceID,
+				"namespace":  testNamespace,
+				"start_time": testStartTime,
+				"end_time":   testEndTime,
+			},
+		},
 		{
 			name:     "query_component_events_minimal",
 			toolName: "query_component_events",
@@ -1635,6 +1694,17 @@ func TestSchemaPropertyTypes(t *testing.T) {
 			"trace_id": "string",
 			"span_id":  "string",
 		},
+		"query_trace_logs": {
+			"trace_id":    "string",
+			"span_id":     "string",
+			"namespace":   "string",
+			"project":     "string",
+			"component":   "string",
+			"environment": "string",
+			"start_time":  "string",
+			"end_time":    "string",
+			"limit":       "number",
+		},
 	}
 
 	for _, tool := range toolsResult.Tools {
@@ -25,6 +25,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/observer/notifications"
 	"github.com/openchoreo/openchoreo/internal/observer/store/alertentry"
 	"github.com/openchoreo/openchoreo/internal/observer/store/incidententry"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
 	legacytypes "github.com/openchoreo/openchoreo/internal/observer/types"
 )
 
@@ -38,6 +39,9 @@ const (
 	sourceTypeLog    = "log"
 	sourceTypeMetric = "metric"
 	sourceTypeBudget = "budget"
+	// sourceTypeLogMetric rules are evaluated by the observer itself against the
+	// samples of a log-derived metric, rather than by an adapter.
+	sourceTypeLogMetric = "logMetric"
 )
 
 // AlertService provides CRUD operations for alert rules, backing the v1alpha1 API.
@@ -57,6 +61,8 @@ type AlertService struct {
 
 	finOpsAgentURL     string
 	finOpsAgentEnabled bool
+
+	logQueryStore logquery.Store
 }
 
 // NewAlertService creates a new AlertService.
@@ -74,6 +80,7 @@ func NewAlertService(
 	metricsAdapterClient *http.Client,
 	finOpsAgentURL string,
 	finOpsAgentEnabled bool,
+	logQueryStore logquery.Store,
 ) *AlertService {
 	return &AlertService{
 		alertEntryStore:      alertEntryStore,
@@ -89,6 +96,7 @@ func NewAlertService(
 		metricsAdapterClient: metricsAdapterClient,
 		finOpsAgentURL:       finOpsAgentURL,
 		finOpsAgentEnabled:   finOpsAgentEnabled,
+		logQueryStore:        logQueryStore,
 	}
 }
 
@@ -122,15 +130,21 @@ func (s *AlertService) CreateAlertRule(ctx context.Context, req gen.AlertRuleReq
 		budgetMetric := gen.AlertRuleRequestSourceMetricBudget
 		req.Source.Metric = &budgetMetric
 		return s.createMetricAlertRuleViaAdapter(ctx, req)
+	case sourceTypeLogMetric:
+		if s.logQueryStore == nil {
+			return nil, fmt.Errorf("log query store is required for log metric alert rules")
+		}
+		return s.createLogMetricAlertRule(ctx, req)
 	default:
 		return nil, fmt.Errorf("unsupported source type: %s", sourceType)
 	}
 }
 
 // GetAlertRule fetches an alert rule via the configured adapter.
-// sourceType must be "log", "metric", or "budget".
+// sourceType must be "log", "metric", "budget", or "logMetric". namespace is
+// required for logMetric rules and ignored by the adapters.
 // Returns an error wrapping ErrAlertRuleNotFound if the rule does not exist.
-func (s *AlertService) GetAlertRule(ctx context.Context, ruleName, sourceType string) (*gen.AlertRuleResponse, error) {
+func (s *AlertService) GetAlertRule(ctx context.Context, namespace, ruleName, sourceType string) (*gen.AlertRuleResponse, error) {
 	switch sourceType {
 	case sourceTypeLog:
 		if s.logsAdapter == nil {
@@ -147,6 +161,11 @@ func (s *AlertService) GetAlertRule(ctx context.Context, ruleName, sourceType st
 			return nil, fmt.Errorf("metrics adapter is required for budget alert rules")
 		}
 		return s.getMetricAlertRuleViaAdapter(ctx, ruleName)
+	case sourceTypeLogMetric:
+		if s.logQueryStore == nil {
+			return nil, fmt.Errorf("log query store is required for log metric alert rules")
+		}
+		return s.getLogMetricAlertRule(ctx, namespace, ruleName)
 	default:
 		return nil, fmt.Errorf("unsupported source type: %s", sourceType)
 	}
@@ -182,15 +201,21 @@ func (s *AlertService) UpdateAlertRule(ctx context.Context, ruleName string, req
 		budgetMetric := gen.AlertRuleRequestSourceMetricBudget
 		req.Source.Metric = &budgetMetric
 		return s.updateMetricAlertRuleViaAdapter(ctx, ruleName, req)
+	case sourceTypeLogMetric:
+		if s.logQueryStore == nil {
+			return nil, fmt.Errorf("log query store is required for log metric alert rules")
+		}
+		return s.updateLogMetricAlertRule(ctx, ruleName, req)
 	default:
 		return nil, fmt.Errorf("unsupported source type: %s", sourceType)
 	}
 }
 
 // DeleteAlertRule deletes an alert rule via the configured adapter.
-// sourceType must be "log", "metric", or "budget".
+// sourceType must be "log", "metric", "budget", or "logMetric". namespace is
+// required for logMetric rules and ignored by the adapters.
 // Returns ErrAlertRuleNotFound if the rule does not exist.
-func (s *AlertService) DeleteAlertRule(ctx context.Context, ruleName, sourceType string) (*gen.AlertingRuleSyncResponse, error) {
+func (s *AlertService) DeleteAlertRule(ctx context.Context, namespace, ruleName, sourceType string) (*gen.AlertingRuleSyncResponse, error) {
 	switch sourceType {
 	case sourceTypeLog:
 		if s.logsAdapter == nil {
@@ -207,6 +232,11 @@ func (s *AlertService) DeleteAlertRule(ctx context.Context, ruleName, sourceType
 			return nil, fmt.Errorf("metrics adapter is required for budget alert rules")
 		}
 		return s.deleteMetricAlertRuleViaAdapter(ctx, ruleName)
+	case sourceTypeLogMetric:
+		if s.logQueryStore == nil {
+			return nil, fmt.Errorf("log query store is required for log metric alert rules")
+		}
+		return s.deleteLogMetricAlertRule(ctx, namespace, ruleName)
 	default:
 		return nil, fmt.Errorf("unsupported source type: %s", sourceType)
 	}
@@ -38,9 +38,10 @@ func TestCreateAlertRule_UnsupportedSourceType(t *testing.T) {
 
 	req := gen.AlertRuleRequest{
 		Source: struct {
-			Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-			Query  *string                           `json:"query,omitempty"`
-			Type   gen.AlertRuleRequestSourceType    `json:"type"`
+			LogMetric *string                           `json:"logMetric,omitempty"`
+			Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+			Query     *string                           `json:"query,omitempty"`
+			Type      gen.AlertRuleRequestSourceType    `json:"type"`
 		}{Type: gen.AlertRuleRequestSourceType("unsupported")},
 		Condition: struct {
 			Enabled   bool                                  `json:"enabled"`
@@ -59,7 +60,7 @@ func TestGetAlertRule_UnsupportedSourceType(t *testing.T) {
 	t.Parallel()
 
 	svc := newTestAlertService()
-	_, err := svc.GetAlertRule(context.Background(), testRuleName, "unsupported")
+	_, err := svc.GetAlertRule(context.Background(), "", testRuleName, "unsupported")
 	require.Error(t, err)
 }
 
@@ -67,7 +68,7 @@ func TestDeleteAlertRule_UnsupportedSourceType(t *testing.T) {
 	t.Parallel()
 
 	svc := newTestAlertService()
-	_, err := svc.DeleteAlertRule(context.Background(), testRuleName, "unsupported")
+	_, err := svc.DeleteAlertRule(context.Background(), "", testRuleName, "unsupported")
 	require.Error(t, err)
 }
 
@@ -37,9 +37,10 @@ func TestSourceTypeFromRequest(t *testing.T) {
 			name: "log type",
 			req: gen.AlertRuleRequest{
 				Source: struct {
-					Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-					Query  *string                           `json:"query,omitempty"`
-					Type   gen.AlertRuleRequestSourceType    `json:"type"`
+					LogMetric *string                           `json:"logMetric,omitempty"`
+					Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+					Query     *string                           `json:"query,omitempty"`
+					Type      gen.AlertRuleRequestSourceType    `json:"type"`
 				}{Type: gen.AlertRuleRequestSourceTypeLog},
 			},
 			expected: "log",
@@ -48,9 +49,10 @@ func TestSourceTypeFromRequest(t *testing.T) {
 			name: "metric type",
 			req: gen.AlertRuleRequest{
 				Source: struct {
-					Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-					Query  *string                           `json:"query,omitempty"`
-					Type   gen.AlertRuleRequestSourceType    `json:"type"`
+					LogMetric *string                           `json:"logMetric,omitempty"`
+					Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+					Query     *string                           `json:"query,omitempty"`
+					Type      gen.AlertRuleRequestSourceType    `json:"type"`
 				}{Type: gen.AlertRuleRequestSourceTypeMetric},
 			},
 			expected: "metric",
@@ -124,9 +126,10 @@ func TestGenRequestToLegacyRequest(t *testing.T) {
 				ComponentUid: compUID, ProjectUid: projUID, EnvironmentUid: envUID,
 			},
 			Source: struct {
-				Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-				Query  *string                           `json:"query,omitempty"`
-				Type   gen.AlertRuleRequestSourceType    `json:"type"`
+				LogMetric *string                           `json:"logMetric,omitempty"`
+				Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+				Query     *string                           `json:"query,omitempty"`
+				Type      gen.AlertRuleRequestSourceType    `json:"type"`
 			}{Type: gen.AlertRuleRequestSourceTypeLog, Query: &query},
 			Condition: struct {
 				Enabled   bool                                  `json:"enabled"`
@@ -0,0 +1,179 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
+)
+
+// errLogMetricAlertRuleNamespaceRequired is returned when a log metric alert rule is
+// addressed without a namespace; rules are stored by namespace and name.
+var errLogMetricAlertRuleNamespaceRequired = errors.New("namespace is required for logMetric alert rules")
+
+// createLogMetricAlertRule stores an alert rule on a log metric of the rule's project.
+// The rule is evaluated by LogQueriesService.Run.
+func (s *AlertService) createLogMetricAlertRule(ctx context.Context, req gen.AlertRuleRequest) (*gen.AlertingRuleSyncResponse, error) {
+	rule, err := s.logMetricAlertRuleFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.logQueryStore.GetAlertRule(ctx, rule.Namespace, rule.Name); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAlertRuleAlreadyExists, rule.Name)
+	} else if !errors.Is(err, logquery.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get log metric alert rule: %w", err)
+	}
+
+	if err := s.logQueryStore.UpsertAlertRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to store log metric alert rule: %w", err)
+	}
+	return buildSyncResponse(alertActionCreated, rule.Name, rule.Name, time.Now().UTC()), nil
+}
+
+// getLogMetricAlertRule returns a stored log metric alert rule.
+func (s *AlertService) getLogMetricAlertRule(ctx context.Context, namespace, ruleName string) (*gen.AlertRuleResponse, error) {
+	if namespace == "" {
+		return nil, errLogMetricAlertRuleNamespaceRequired
+	}
+	rule, err := s.logQueryStore.GetAlertRule(ctx, namespace, ruleName)
+	if err != nil {
+		if errors.Is(err, logquery.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrAlertRuleNotFound, ruleName)
+		}
+		return nil, fmt.Errorf("failed to get log metric alert rule: %w", err)
+	}
+
+	// gen.AlertRuleResponse uses anonymous nested structs, so it is built the same way
+	// adapter responses are decoded.
+	data, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"name":           rule.Name,
+			"namespace":      rule.Namespace,
+			"projectUid":     rule.ProjectID,
+			"componentUid":   rule.ComponentID,
+			"environmentUid": rule.EnvironmentID,
+		},
+		"source": map[string]any{
+			"type":      sourceTypeLogMetric,
+			"logMetric": rule.LogMetricName,
+		},
+		"condition": map[string]any{
+			"enabled":   rule.Enabled,
+			"window":    formatMinutesHoursDuration(rule.Window),
+			"interval":  formatMinutesHoursDuration(rule.Interval),
+			"operator":  rule.Operator,
+			"threshold": rule.Threshold,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal log metric alert rule: %w", err)
+	}
+	var result gen.AlertRuleResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode log metric alert rule: %w", err)
+	}
+	return &result, nil
+}
+
+// updateLogMetricAlertRule replaces a stored log metric alert rule. The evaluation
+// schedule of the rule is kept.
+func (s *AlertService) updateLogMetricAlertRule(ctx context.Context, ruleName string, req gen.AlertRuleRequest) (*gen.AlertingRuleSyncResponse, error) {
+	if req.Metadata.Namespace == "" {
+		return nil, errLogMetricAlertRuleNamespaceRequired
+	}
+	existing, err := s.logQueryStore.GetAlertRule(ctx, req.Metadata.Namespace, ruleName)
+	if err != nil {
+		if errors.Is(err, logquery.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrAlertRuleNotFound, ruleName)
+		}
+		return nil, fmt.Errorf("failed to get log metric alert rule: %w", err)
+	}
+
+	req.Metadata.Name = ruleName
+	rule, err := s.logMetricAlertRuleFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	rule.LastEvaluatedAt = existing.LastEvaluatedAt
+
+	now := time.Now().UTC()
+	if logMetricAlertRuleUnchanged(existing, rule) {
+		return buildSyncResponse(alertActionUnchanged, rule.Name, rule.Name, now), nil
+	}
+	if err := s.logQueryStore.UpsertAlertRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to store log metric alert rule: %w", err)
+	}
+	return buildSyncResponse(alertActionUpdated, rule.Name, rule.Name, now), nil
+}
+
+// deleteLogMetricAlertRule removes a stored log metric alert rule.
+func (s *AlertService) deleteLogMetricAlertRule(ctx context.Context, namespace, ruleName string) (*gen.AlertingRuleSyncResponse, error) {
+	if namespace == "" {
+		return nil, errLogMetricAlertRuleNamespaceRequired
+	}
+	if err := s.logQueryStore.DeleteAlertRule(ctx, namespace, ruleName); err != nil {
+		if errors.Is(err, logquery.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrAlertRuleNotFound, ruleName)
+		}
+		return nil, fmt.Errorf("failed to delete log metric alert rule: %w", err)
+	}
+	return buildSyncResponse(alertActionDeleted, ruleName, ruleName, time.Now().UTC()), nil
+}
+
+// logMetricAlertRuleFromRequest converts the request and checks that the referenced log
+// metric exists in the rule's project.
+func (s *AlertService) logMetricAlertRuleFromRequest(ctx context.Context, req gen.AlertRuleRequest) (*logquery.AlertRule, error) {
+	if req.Metadata.Namespace == "" {
+		return nil, errLogMetricAlertRuleNamespaceRequired
+	}
+	logMetricName := stringPtrVal(req.Source.LogMetric)
+	if logMetricName == "" {
+		return nil, fmt.Errorf("source.logMetric is required for logMetric alert rules")
+	}
+
+	projectID := req.Metadata.ProjectUid.String()
+	if _, err := s.logQueryStore.FindLogMetric(ctx, projectID, logMetricName); err != nil {
+		if errors.Is(err, logquery.ErrNotFound) {
+			return nil, fmt.Errorf("log metric %q not found in project %s", logMetricName, projectID)
+		}
+		return nil, fmt.Errorf("failed to look up log metric: %w", err)
+	}
+
+	// Durations were validated by validateAlertDurations.
+	window, _ := time.ParseDuration(req.Condition.Window)
+	interval, _ := time.ParseDuration(req.Condition.Interval)
+
+	return &logquery.AlertRule{
+		Name:          req.Metadata.Name,
+		Namespace:     req.Metadata.Namespace,
+		LogMetricName: logMetricName,
+		ProjectID:     projectID,
+		ComponentID:   req.Metadata.ComponentUid.String(),
+		EnvironmentID: req.Metadata.EnvironmentUid.String(),
+		Enabled:       req.Condition.Enabled,
+		Operator:      string(req.Condition.Operator),
+		Threshold:     float64(req.Condition.Threshold),
+		Window:        window,
+		Interval:      interval,
+	}, nil
+}
+
+func logMetricAlertRuleUnchanged(existing, updated *logquery.AlertRule) bool {
+	return existing.LogMetricName == updated.LogMetricName &&
+		existing.ProjectID == updated.ProjectID &&
+		existing.ComponentID == updated.ComponentID &&
+		existing.EnvironmentID == updated.EnvironmentID &&
+		existing.Enabled == updated.Enabled &&
+		existing.Operator == updated.Operator &&
+		existing.Threshold == updated.Threshold &&
+		existing.Window == updated.Window &&
+		existing.Interval == updated.Interval
+}
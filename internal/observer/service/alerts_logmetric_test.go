@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
+)
+
+func logMetricAlertRuleRequest(t *testing.T, logMetric string, threshold float32) gen.AlertRuleRequest {
+	t.Helper()
+	body := map[string]any{
+		"metadata": map[string]any{
+			"name":           "payments-error-spike",
+			"namespace":      "default",
+			"projectUid":     sampleProjectUID,
+			"componentUid":   sampleComponentUID,
+			"environmentUid": sampleEnvironmentUID,
+		},
+		"source": map[string]any{"type": "logMetric", "logMetric": logMetric},
+		"condition": map[string]any{
+			"enabled": true, "window": "5m", "interval": "1m", "operator": "gt", "threshold": threshold,
+		},
+	}
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	var req gen.AlertRuleRequest
+	require.NoError(t, json.Unmarshal(data, &req))
+	return req
+}
+
+func TestAlertService_LogMetricAlertRuleLifecycle(t *testing.T) {
+	t.Parallel()
+
+	store := newTestLogQueryStore(t)
+	ctx := context.Background()
+	require.NoError(t, store.CreateLogMetric(ctx, &logquery.LogMetric{
+		NamespaceName: "default", ProjectName: "commerce", ProjectID: sampleProjectUID,
+		Name: "errors-by-level", Interval: time.Minute,
+	}))
+
+	svc := &AlertService{
+		config:        &config.Config{},
+		logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		logQueryStore: store,
+	}
+
+	_, err := svc.CreateAlertRule(ctx, logMetricAlertRuleRequest(t, "missing", 10))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	created, err := svc.CreateAlertRule(ctx, logMetricAlertRuleRequest(t, "errors-by-level", 10))
+	require.NoError(t, err)
+	assert.Equal(t, gen.AlertingRuleSyncResponseAction(alertActionCreated), *created.Action)
+
+	_, err = svc.CreateAlertRule(ctx, logMetricAlertRuleRequest(t, "errors-by-level", 10))
+	require.ErrorIs(t, err, ErrAlertRuleAlreadyExists)
+
+	got, err := svc.GetAlertRule(ctx, "default", "payments-error-spike", sourceTypeLogMetric)
+	require.NoError(t, err)
+	assert.Equal(t, "errors-by-level", *got.Source.LogMetric)
+	assert.Equal(t, "5m", *got.Condition.Window)
+	assert.Equal(t, sampleComponentUID, got.Metadata.ComponentUid.String())
+
+	unchanged, err := svc.UpdateAlertRule(ctx, "payments-error-spike", logMetricAlertRuleRequest(t, "errors-by-level", 10))
+	require.NoError(t, err)
+	assert.Equal(t, gen.AlertingRuleSyncResponseAction(alertActionUnchanged), *unchanged.Action)
+
+	updated, err := svc.UpdateAlertRule(ctx, "payments-error-spike", logMetricAlertRuleRequest(t, "errors-by-level", 20))
+	require.NoError(t, err)
+	assert.Equal(t, gen.AlertingRuleSyncResponseAction(alertActionUpdated), *updated.Action)
+
+	_, err = svc.DeleteAlertRule(ctx, "default", "payments-error-spike", sourceTypeLogMetric)
+	require.NoError(t, err)
+	_, err = svc.DeleteAlertRule(ctx, "default", "payments-error-spike", sourceTypeLogMetric)
+	require.ErrorIs(t, err, ErrAlertRuleNotFound)
+	_, err = svc.UpdateAlertRule(ctx, "payments-error-spike", logMetricAlertRuleRequest(t, "errors-by-level", 20))
+	require.ErrorIs(t, err, ErrAlertRuleNotFound)
+}
+
+func TestAlertService_LogMetricRequiresStore(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestAlertService()
+	_, err := svc.GetAlertRule(context.Background(), "default", "rule", sourceTypeLogMetric)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "log query store is required")
+}
@@ -32,9 +32,10 @@ func TestAlertService_MetricsAdapter_CreateAlertRule(t *testing.T) {
 
 	metricAlertReq := gen.AlertRuleRequest{
 		Source: struct {
-			Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-			Query  *string                           `json:"query,omitempty"`
-			Type   gen.AlertRuleRequestSourceType    `json:"type"`
+			LogMetric *string                           `json:"logMetric,omitempty"`
+			Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+			Query     *string                           `json:"query,omitempty"`
+			Type      gen.AlertRuleRequestSourceType    `json:"type"`
 		}{
 			Type:   gen.AlertRuleRequestSourceTypeMetric,
 			Metric: &metricName,
@@ -177,7 +178,7 @@ func TestAlertService_MetricsAdapter_GetAlertRule(t *testing.T) {
 			logger:               slog.New(slog.NewTextHandler(io.Discard, nil)),
 		}
 
-		resp, err := svc.GetAlertRule(context.Background(), ruleName, sourceTypeMetric)
+		resp, err := svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeMetric)
 
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -196,7 +197,7 @@ func TestAlertService_MetricsAdapter_GetAlertRule(t *testing.T) {
 			logger:               slog.New(slog.NewTextHandler(io.Discard, nil)),
 		}
 
-		_, err := svc.GetAlertRule(context.Background(), ruleName, sourceTypeMetric)
+		_, err := svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeMetric)
 
 		// Should attempt Prometheus path and fail (since not mocked)
 		require.Error(t, err)
@@ -211,9 +212,10 @@ func TestAlertService_MetricsAdapter_UpdateAlertRule(t *testing.T) {
 
 	metricAlertReq := gen.AlertRuleRequest{
 		Source: struct {
-			Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-			Query  *string                           `json:"query,omitempty"`
-			Type   gen.AlertRuleRequestSourceType    `json:"type"`
+			LogMetric *string                           `json:"logMetric,omitempty"`
+			Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+			Query     *string                           `json:"query,omitempty"`
+			Type      gen.AlertRuleRequestSourceType    `json:"type"`
 		}{
 			Type:   gen.AlertRuleRequestSourceTypeMetric,
 			Metric: &metricName,
@@ -347,7 +349,7 @@ func TestAlertService_MetricsAdapter_DeleteAlertRule(t *testing.T) {
 			logger:               slog.New(slog.NewTextHandler(io.Discard, nil)),
 		}
 
-		resp, err := svc.DeleteAlertRule(context.Background(), ruleName, sourceTypeMetric)
+		resp, err := svc.DeleteAlertRule(context.Background(), "", ruleName, sourceTypeMetric)
 
 		require.NoError(t, err)
 		require.NotNil(t, resp)
@@ -363,7 +365,7 @@ func TestAlertService_MetricsAdapter_DeleteAlertRule(t *testing.T) {
 			logger:               slog.New(slog.NewTextHandler(io.Discard, nil)),
 		}
 
-		_, err := svc.DeleteAlertRule(context.Background(), ruleName, sourceTypeMetric)
+		_, err := svc.DeleteAlertRule(context.Background(), "", ruleName, sourceTypeMetric)
 
 		// Should attempt Prometheus path and fail (since not mocked)
 		require.Error(t, err)
@@ -431,7 +433,7 @@ func TestAlertService_MetricsAdapter_HTTPErrors(t *testing.T) {
 			}
 
 			// Test with Get operation
-			resp, err := svc.GetAlertRule(context.Background(), ruleName, sourceTypeMetric)
+			resp, err := svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeMetric)
 
 			assert.Nil(t, resp)
 			require.Error(t, err)
@@ -25,9 +25,10 @@ func newLogAlertRoutingRequest(ruleName string) gen.AlertRuleRequest {
 	query := "level=error"
 	return gen.AlertRuleRequest{
 		Source: struct {
-			Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-			Query  *string                           `json:"query,omitempty"`
-			Type   gen.AlertRuleRequestSourceType    `json:"type"`
+			LogMetric *string                           `json:"logMetric,omitempty"`
+			Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+			Query     *string                           `json:"query,omitempty"`
+			Type      gen.AlertRuleRequestSourceType    `json:"type"`
 		}{
 			Type:  gen.AlertRuleRequestSourceTypeLog,
 			Query: &query,
@@ -76,7 +77,7 @@ func TestLogAlertRoutesViaAdapter(t *testing.T) {
 			return err
 		}},
 		{name: "Get", call: func(svc *AlertService) error {
-			_, err := svc.GetAlertRule(context.Background(), ruleName, sourceTypeLog)
+			_, err := svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeLog)
 			return err
 		}},
 		{name: "Update", call: func(svc *AlertService) error {
@@ -84,7 +85,7 @@ func TestLogAlertRoutesViaAdapter(t *testing.T) {
 			return err
 		}},
 		{name: "Delete", call: func(svc *AlertService) error {
-			_, err := svc.DeleteAlertRule(context.Background(), ruleName, sourceTypeLog)
+			_, err := svc.DeleteAlertRule(context.Background(), "", ruleName, sourceTypeLog)
 			return err
 		}},
 	}
@@ -151,7 +152,7 @@ func TestLogAlertWithoutAdapter(t *testing.T) {
 			return err
 		}},
 		{"Get", func() error {
-			_, err := svc.GetAlertRule(context.Background(), ruleName, sourceTypeLog)
+			_, err := svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeLog)
 			return err
 		}},
 		{"Update", func() error {
@@ -159,7 +160,7 @@ func TestLogAlertWithoutAdapter(t *testing.T) {
 			return err
 		}},
 		{"Delete", func() error {
-			_, err := svc.DeleteAlertRule(context.Background(), ruleName, sourceTypeLog)
+			_, err := svc.DeleteAlertRule(context.Background(), "", ruleName, sourceTypeLog)
 			return err
 		}},
 	}
@@ -185,9 +186,10 @@ func TestBudgetAlertServiceRouting(t *testing.T) {
 
 	budgetAlertReq := gen.AlertRuleRequest{
 		Source: struct {
-			Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-			Query  *string                           `json:"query,omitempty"`
-			Type   gen.AlertRuleRequestSourceType    `json:"type"`
+			LogMetric *string                           `json:"logMetric,omitempty"`
+			Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+			Query     *string                           `json:"query,omitempty"`
+			Type      gen.AlertRuleRequestSourceType    `json:"type"`
 		}{
 			Type: gen.AlertRuleRequestSourceTypeBudget,
 		},
@@ -270,11 +272,11 @@ func TestBudgetAlertServiceRouting(t *testing.T) {
 		t.Fatalf("UpdateAlertRule response invalid: %+v", resp)
 	}
 
-	if _, err = svc.GetAlertRule(context.Background(), ruleName, sourceTypeBudget); err != nil {
+	if _, err = svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeBudget); err != nil {
 		t.Fatalf("GetAlertRule failed: %v", err)
 	}
 
-	resp, err = svc.DeleteAlertRule(context.Background(), ruleName, sourceTypeBudget)
+	resp, err = svc.DeleteAlertRule(context.Background(), "", ruleName, sourceTypeBudget)
 	if err != nil {
 		t.Fatalf("DeleteAlertRule failed: %v", err)
 	}
@@ -294,9 +296,10 @@ func TestBudgetAlertWithoutAdapter(t *testing.T) {
 	ruleName := "budget-rule"
 	budgetAlertReq := gen.AlertRuleRequest{
 		Source: struct {
-			Metric *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
-			Query  *string                           `json:"query,omitempty"`
-			Type   gen.AlertRuleRequestSourceType    `json:"type"`
+			LogMetric *string                           `json:"logMetric,omitempty"`
+			Metric    *gen.AlertRuleRequestSourceMetric `json:"metric,omitempty"`
+			Query     *string                           `json:"query,omitempty"`
+			Type      gen.AlertRuleRequestSourceType    `json:"type"`
 		}{
 			Type: gen.AlertRuleRequestSourceTypeBudget,
 		},
@@ -346,11 +349,11 @@ func TestBudgetAlertWithoutAdapter(t *testing.T) {
 			return err
 		}},
 		{"Get", func() error {
-			_, err := svc.GetAlertRule(context.Background(), ruleName, sourceTypeBudget)
+			_, err := svc.GetAlertRule(context.Background(), "", ruleName, sourceTypeBudget)
 			return err
 		}},
 		{"Delete", func() error {
-			_, err := svc.DeleteAlertRule(context.Background(), ruleName, sourceTypeBudget)
+			_, err := svc.DeleteAlertRule(context.Background(), "", ruleName, sourceTypeBudget)
 			return err
 		}},
 	}
@@ -176,6 +176,34 @@ func TestLogsAuthz_QueryLogs_NilRequest(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestLogsAuthz_TailLogs_Denied(t *testing.T) {
+	inner := mocks.NewMockLogsTailer(t)
+
+	svc := NewLogsTailerWithAuthz(inner, mockPDPDeny(t), testLogger())
+	req := &types.LogsTailRequest{
+		SearchScope: &types.SearchScope{
+			Workflow: &types.WorkflowSearchScope{Namespace: "ns", WorkflowRunName: "run-1"},
+		},
+	}
+
+	err := svc.TailLogs(authedCtx(), req, func([]types.LogEntry) error { return nil })
+	assert.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
+}
+
+func TestLogsAuthz_TailLogs_NilPDP(t *testing.T) {
+	inner := mocks.NewMockLogsTailer(t)
+	inner.EXPECT().TailLogs(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := NewLogsTailerWithAuthz(inner, nil, testLogger())
+	req := &types.LogsTailRequest{
+		SearchScope: &types.SearchScope{
+			Component: &types.ComponentSearchScope{Namespace: "ns", Project: "proj", Component: "comp"},
+		},
+	}
+
+	require.NoError(t, svc.TailLogs(context.Background(), req, func([]types.LogEntry) error { return nil }))
+}
+
 // --- MetricsQuerier Authz Tests ---
 
 func TestMetricsAuthz_QueryMetrics_NilPDP(t *testing.T) {
@@ -0,0 +1,76 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	legacytypes "github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	driftAlertName     = "Resource drift detected"
+	driftAlertType     = "drift"
+	driftAlertSeverity = "warning"
+)
+
+// HandleDriftNotification notifies the requested channels that resources deployed by a
+// RenderedRelease have drifted from their desired manifests. Drift alerts are not tied to
+// an alert rule, so they are delivered directly rather than stored as alert entries.
+func (s *AlertService) HandleDriftNotification(ctx context.Context, req gen.DriftNotificationRequest) (*gen.AlertWebhookResponse, error) {
+	if len(req.Channels) == 0 {
+		return nil, fmt.Errorf("at least one notification channel is required")
+	}
+	if len(req.Resources) == 0 {
+		return nil, fmt.Errorf("at least one drifted resource is required")
+	}
+
+	alertDetails := buildDriftAlertDetails(req)
+	if err := DispatchAlertNotifications(ctx, alertDetails, req.Channels, s.getNotificationChannelConfig, s.logger); err != nil {
+		return nil, err
+	}
+
+	successStatus := gen.AlertWebhookResponseStatusSuccess
+	msg := fmt.Sprintf("drift notification sent to %d channel(s)", len(req.Channels))
+	return &gen.AlertWebhookResponse{
+		Status:  &successStatus,
+		Message: &msg,
+	}, nil
+}
+
+// buildDriftAlertDetails renders a drift notification as alert details, so the channels'
+// existing templates apply. The description lists every drifted resource and its fields.
+func buildDriftAlertDetails(req gen.DriftNotificationRequest) *legacytypes.AlertDetails {
+	lines := make([]string, 0, len(req.Resources)+1)
+	lines = append(lines, fmt.Sprintf("%d resource(s) of %s differ from their desired manifests:",
+		len(req.Resources), stringPtrVal(req.Release)))
+	for _, res := range req.Resources {
+		name := res.Name
+		if ns := stringPtrVal(res.Namespace); ns != "" {
+			name = ns + "/" + name
+		}
+		lines = append(lines, fmt.Sprintf("- %s %s (%s): %s", res.Kind, name, res.Id, strings.Join(res.Fields, ", ")))
+	}
+
+	component := stringPtrVal(req.Component)
+	if component == "" {
+		component = stringPtrVal(req.Resource)
+	}
+	return &legacytypes.AlertDetails{
+		AlertName:        driftAlertName,
+		AlertTimestamp:   time.Now().UTC().Format(time.RFC3339),
+		AlertSeverity:    driftAlertSeverity,
+		AlertDescription: strings.Join(lines, "\n"),
+		AlertValue:       fmt.Sprintf("%d", len(req.Resources)),
+		AlertType:        driftAlertType,
+		Namespace:        req.Namespace,
+		Project:          stringPtrVal(req.Project),
+		Component:        component,
+		Environment:      req.Environment,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	legacytypes "github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func driftRequest() gen.DriftNotificationRequest {
+	project, component, release, ns := "shop", "checkout", "checkout-production", "dp-shop"
+	return gen.DriftNotificationRequest{
+		Namespace:   "default",
+		Project:     &project,
+		Component:   &component,
+		Environment: "production",
+		Release:     &release,
+		Channels:    []string{"prod-webhook"},
+		Resources: []gen.DriftedResource{
+			{Id: "deployment", Kind: "Deployment", Name: "checkout", Namespace: &ns, Fields: []string{"spec.replicas", "spec.template.spec.containers[0].image"}},
+		},
+	}
+}
+
+func TestBuildDriftAlertDetails(t *testing.T) {
+	details := buildDriftAlertDetails(driftRequest())
+
+	assert.Equal(t, driftAlertName, details.AlertName)
+	assert.Equal(t, driftAlertType, details.AlertType)
+	assert.Equal(t, "1", details.AlertValue)
+	assert.Equal(t, "checkout", details.Component)
+	assert.Equal(t, "production", details.Environment)
+	assert.Equal(t, "1 resource(s) of checkout-production differ from their desired manifests:\n"+
+		"- Deployment dp-shop/checkout (deployment): spec.replicas, spec.template.spec.containers[0].image",
+		details.AlertDescription)
+}
+
+func TestHandleDriftNotification(t *testing.T) {
+	var received legacytypes.AlertDetails
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer webhook.Close()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	channelLabels := map[string]string{labels.LabelKeyNotificationChannelName: "prod-webhook"}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-webhook", Namespace: "default", Labels: channelLabels},
+		Data:       map[string]string{"type": "webhook", "webhook.url": webhook.URL},
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "prod-webhook", Namespace: "default", Labels: channelLabels}}
+
+	svc := &AlertService{
+		k8sClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm, secret).Build(),
+		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	resp, err := svc.HandleDriftNotification(context.Background(), driftRequest())
+	require.NoError(t, err)
+	require.NotNil(t, resp.Status)
+	assert.Equal(t, gen.AlertWebhookResponseStatusSuccess, *resp.Status)
+	assert.Equal(t, driftAlertName, received.AlertName)
+	assert.Equal(t, "shop", received.Project)
+
+	t.Run("unknown channel", func(t *testing.T) {
+		req := driftRequest()
+		req.Channels = []string{"missing-channel"}
+		_, err := svc.HandleDriftNotification(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing-channel")
+	})
+
+	t.Run("no resources", func(t *testing.T) {
+		req := driftRequest()
+		req.Resources = nil
+		_, err := svc.HandleDriftNotification(context.Background(), req)
+		require.Error(t, err)
+	})
+}
@@ -18,6 +18,27 @@ type HealthChecker interface {
 // LogsQuerier is the interface for querying logs.
 type LogsQuerier interface {
 	QueryLogs(ctx context.Context, req *types.LogsQueryRequest) (*types.LogsQueryResponse, error)
+	QueryTraceLogs(ctx context.Context, req *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error)
+}
+
+// LogsTailer is the interface for streaming log entries as they arrive.
+type LogsTailer interface {
+	TailLogs(ctx context.Context, req *types.LogsTailRequest, send func([]types.LogEntry) error) error
+}
+
+// LogQueryManager is the interface for managing saved logs queries and the log metrics
+// derived from them.
+type LogQueryManager interface {
+	CreateSavedQuery(ctx context.Context, req *types.SavedLogQueryRequest) (*types.SavedLogQuery, error)
+	UpdateSavedQuery(ctx context.Context, req *types.SavedLogQueryRequest) (*types.SavedLogQuery, error)
+	GetSavedQuery(ctx context.Context, namespace, project, name string) (*types.SavedLogQuery, error)
+	ListSavedQueries(ctx context.Context, namespace, project string) (*types.SavedLogQueryList, error)
+	DeleteSavedQuery(ctx context.Context, namespace, project, name string) error
+	RunSavedQuery(ctx context.Context, req *types.SavedLogQueryRunRequest) (*types.LogsQueryResponse, error)
+	CreateLogMetric(ctx context.Context, req *types.LogMetricRequest) (*types.LogMetric, error)
+	GetLogMetric(ctx context.Context, namespace, project, name string) (*types.LogMetric, error)
+	ListLogMetrics(ctx context.Context, namespace, project string) (*types.LogMetricList, error)
+	DeleteLogMetric(ctx context.Context, namespace, project, name string) error
 }
 
 // EventsQuerier is the interface for querying Kubernetes events.
@@ -69,12 +90,13 @@ type AlertIncidentService interface {
 	IncidentsUpdater
 }
 
-// AlertRuleService is the interface for managing alert rules
-// and processing incoming alert webhooks.
+// AlertRuleService is the interface for managing alert rules, processing incoming
+// alert webhooks, and delivering drift notifications.
 type AlertRuleService interface {
 	CreateAlertRule(ctx context.Context, req gen.AlertRuleRequest) (*gen.AlertingRuleSyncResponse, error)
-	GetAlertRule(ctx context.Context, ruleName, sourceType string) (*gen.AlertRuleResponse, error)
+	GetAlertRule(ctx context.Context, namespace, ruleName, sourceType string) (*gen.AlertRuleResponse, error)
 	UpdateAlertRule(ctx context.Context, ruleName string, req gen.AlertRuleRequest) (*gen.AlertingRuleSyncResponse, error)
-	DeleteAlertRule(ctx context.Context, ruleName, sourceType string) (*gen.AlertingRuleSyncResponse, error)
+	DeleteAlertRule(ctx context.Context, namespace, ruleName, sourceType string) (*gen.AlertingRuleSyncResponse, error)
 	HandleAlertWebhook(ctx context.Context, req gen.AlertWebhookRequest) (*gen.AlertWebhookResponse, error)
+	HandleDriftNotification(ctx context.Context, req gen.DriftNotificationRequest) (*gen.AlertWebhookResponse, error)
 }
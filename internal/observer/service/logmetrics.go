@@ -0,0 +1,367 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+const (
+	// logMetricEvaluationDelay holds back evaluation of a bucket until logs shipped at its
+	// end have had time to be indexed.
+	logMetricEvaluationDelay = 30 * time.Second
+	// maxLogMetricCatchUpBuckets bounds how many missed buckets are back-filled per metric
+	// after the observer was down, so a long outage does not stall the evaluator.
+	maxLogMetricCatchUpBuckets = 60
+	// unknownLogMetricGroup is the group of logs that lack the group-by field.
+	unknownLogMetricGroup = "unknown"
+)
+
+// Run evaluates due log metrics, and the alert rules whose source is a log metric, every
+// LogMetrics.EvaluationTick until ctx is cancelled. Breaching alert rules are raised
+// through alerts.HandleAlertWebhook, the same path adapter-evaluated rules take.
+func (s *LogQueriesService) Run(ctx context.Context, alerts AlertRuleService) {
+	s.logger.Info("Log metrics evaluator started", "tick", s.config.LogMetrics.EvaluationTick)
+	ticker := time.NewTicker(s.config.LogMetrics.EvaluationTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Log metrics evaluator stopped")
+			return
+		case <-ticker.C:
+			s.evaluateDue(ctx, alerts, time.Now().UTC())
+		}
+	}
+}
+
+// evaluateDue evaluates every complete bucket not yet evaluated, then the alert rules of
+// each metric, and finally prunes samples past the retention period.
+func (s *LogQueriesService) evaluateDue(ctx context.Context, alerts AlertRuleService, now time.Time) {
+	metrics, err := s.store.ListLogMetrics(ctx, "", "")
+	if err != nil {
+		s.logger.Error("Failed to list log metrics for evaluation", "error", err)
+		return
+	}
+
+	for i := range metrics {
+		metric := &metrics[i]
+		if err := s.evaluateLogMetric(ctx, metric, now); err != nil {
+			s.logger.Warn("Failed to evaluate log metric",
+				"namespace", metric.NamespaceName, "project", metric.ProjectName, "name", metric.Name, "error", err)
+			continue
+		}
+		if alerts != nil {
+			s.evaluateLogMetricAlertRules(ctx, alerts, metric, now)
+		}
+	}
+
+	pruned, err := s.store.DeleteSamplesBefore(ctx, now.Add(-s.config.LogMetrics.SampleRetention))
+	if err != nil {
+		s.logger.Warn("Failed to prune log metric samples", "error", err)
+	} else if pruned > 0 {
+		s.logger.Debug("Pruned log metric samples", "count", pruned)
+	}
+}
+
+// evaluateLogMetric evaluates the complete buckets since the metric was last evaluated.
+// A metric that was never evaluated starts with the most recent complete bucket.
+func (s *LogQueriesService) evaluateLogMetric(ctx context.Context, metric *logquery.LogMetric, now time.Time) error {
+	end := now.Add(-logMetricEvaluationDelay).Truncate(metric.Interval)
+	start := metric.LastEvaluatedAt
+	if start.IsZero() {
+		start = end.Add(-metric.Interval)
+	}
+	if earliest := end.Add(-maxLogMetricCatchUpBuckets * metric.Interval); start.Before(earliest) {
+		start = earliest
+	}
+
+	for bucket := start; !bucket.Add(metric.Interval).After(end); bucket = bucket.Add(metric.Interval) {
+		bucketEnd := bucket.Add(metric.Interval)
+		samples, err := s.countLogMetricBucket(ctx, metric, bucket, bucketEnd)
+		if err != nil {
+			return err
+		}
+		if err := s.store.WriteSamples(ctx, metric.ID, samples); err != nil {
+			return fmt.Errorf("failed to write samples: %w", err)
+		}
+		if err := s.store.MarkLogMetricEvaluated(ctx, metric.ID, bucketEnd); err != nil {
+			return fmt.Errorf("failed to mark log metric evaluated: %w", err)
+		}
+		metric.LastEvaluatedAt = bucketEnd
+	}
+	return nil
+}
+
+// countLogMetricBucket counts the logs matching the metric in [start, end). Ungrouped
+// metrics use the adapter's total count; grouped metrics count the returned entries, so
+// their counts are capped at config.MaxLimit logs per bucket.
+func (s *LogQueriesService) countLogMetricBucket(
+	ctx context.Context,
+	metric *logquery.LogMetric,
+	start, end time.Time,
+) ([]logquery.Sample, error) {
+	result, err := s.logsAdapter.GetComponentApplicationLogs(ctx, observability.ComponentApplicationLogsParams{
+		ComponentID:   metric.ComponentID,
+		EnvironmentID: metric.EnvironmentID,
+		ProjectID:     metric.ProjectID,
+		Namespace:     metric.NamespaceName,
+		StartTime:     start,
+		EndTime:       end,
+		SearchPhrase:  metric.SearchPhrase,
+		LogLevels:     metric.LogLevels,
+		Limit:         config.MaxLimit,
+		SortOrder:     "desc",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLogsRetrieval, err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("%w: component logs adapter returned nil result", ErrLogsRetrieval)
+	}
+
+	if metric.GroupBy == "" {
+		return []logquery.Sample{{Timestamp: start, Value: float64(result.TotalCount)}}, nil
+	}
+
+	if result.TotalCount > len(result.Logs) {
+		s.logger.Warn("Log metric bucket exceeds the query limit; grouped counts are truncated",
+			"namespace", metric.NamespaceName, "project", metric.ProjectName, "name", metric.Name,
+			"bucket", start, "totalCount", result.TotalCount, "counted", len(result.Logs))
+	}
+
+	counts := make(map[string]float64)
+	for i := range result.Logs {
+		counts[logMetricGroup(&result.Logs[i], metric.GroupBy)]++
+	}
+	samples := make([]logquery.Sample, 0, len(counts))
+	for group, count := range counts {
+		samples = append(samples, logquery.Sample{Timestamp: start, Group: group, Value: count})
+	}
+	return samples, nil
+}
+
+func logMetricGroup(entry *observability.LogEntry, groupBy string) string {
+	var group string
+	switch groupBy {
+	case types.LogMetricGroupByLevel:
+		group = entry.LogLevel
+	case types.LogMetricGroupByComponent:
+		group = entry.ComponentName
+	case types.LogMetricGroupByEnvironment:
+		group = entry.EnvironmentName
+	case types.LogMetricGroupByPod:
+		group = entry.PodName
+	case types.LogMetricGroupByContainer:
+		group = entry.ContainerName
+	}
+	if group == "" {
+		return unknownLogMetricGroup
+	}
+	return group
+}
+
+// evaluateLogMetricAlertRules evaluates the enabled alert rules built on the metric whose
+// interval has elapsed. The samples in the rule window are summed per group and the rule
+// fires with the largest breaching value, so a grouped metric alerts if any group breaches.
+func (s *LogQueriesService) evaluateLogMetricAlertRules(
+	ctx context.Context,
+	alerts AlertRuleService,
+	metric *logquery.LogMetric,
+	now time.Time,
+) {
+	rules, err := s.store.ListAlertRules(ctx, metric.ProjectID, metric.Name)
+	if err != nil {
+		s.logger.Warn("Failed to list log metric alert rules", "logMetric", metric.Name, "error", err)
+		return
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Enabled {
+			continue
+		}
+		if !rule.LastEvaluatedAt.IsZero() && now.Sub(rule.LastEvaluatedAt) < rule.Interval {
+			continue
+		}
+
+		samples, err := s.store.QuerySamples(ctx, metric.ID, now.Add(-rule.Window), now)
+		if err != nil {
+			s.logger.Warn("Failed to query log metric samples", "ruleName", rule.Name, "error", err)
+			continue
+		}
+
+		totals := map[string]float64{"": 0}
+		if len(samples) > 0 {
+			totals = make(map[string]float64)
+		}
+		for _, sample := range samples {
+			totals[sample.Group] += sample.Value
+		}
+
+		breached := false
+		var alertValue float64
+		for _, total := range totals {
+			if compareAlertThreshold(total, rule.Operator, rule.Threshold) && (!breached || total > alertValue) {
+				breached = true
+				alertValue = total
+			}
+		}
+
+		if breached {
+			value := float32(alertValue)
+			timestamp := now
+			if _, err := alerts.HandleAlertWebhook(ctx, gen.AlertWebhookRequest{
+				RuleName:       &rule.Name,
+				RuleNamespace:  &rule.Namespace,
+				AlertValue:     &value,
+				AlertTimestamp: &timestamp,
+			}); err != nil {
+				// Leave the rule due so the alert is retried on the next tick.
+				s.logger.Warn("Failed to raise log metric alert", "ruleName", rule.Name, "error", err)
+				continue
+			}
+		}
+
+		if err := s.store.MarkAlertRuleEvaluated(ctx, rule.Namespace, rule.Name, now); err != nil {
+			s.logger.Warn("Failed to mark log metric alert rule evaluated", "ruleName", rule.Name, "error", err)
+		}
+	}
+}
+
+func compareAlertThreshold(value float64, operator string, threshold float64) bool {
+	switch gen.AlertRuleRequestConditionOperator(operator) {
+	case gen.AlertRuleRequestConditionOperatorGt:
+		return value > threshold
+	case gen.AlertRuleRequestConditionOperatorGte:
+		return value >= threshold
+	case gen.AlertRuleRequestConditionOperatorLt:
+		return value < threshold
+	case gen.AlertRuleRequestConditionOperatorLte:
+		return value <= threshold
+	case gen.AlertRuleRequestConditionOperatorEq:
+		return value == threshold
+	case gen.AlertRuleRequestConditionOperatorNeq:
+		return value != threshold
+	default:
+		return false
+	}
+}
+
+// QueryLogMetric returns the evaluated samples of a log metric, summed into step-wide
+// buckets. The step defaults to, and is never finer than, the metric interval. The
+// search scope must name the project of the metric; a component or environment in the
+// scope must match the metric's own, since authorization is checked against the scope.
+func (s *LogQueriesService) QueryLogMetric(ctx context.Context, req *types.MetricsQueryRequest) (*types.LogMetricsQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrMetricsInvalidRequest)
+	}
+	scope := req.SearchScope
+	if req.LogMetric == "" {
+		return nil, fmt.Errorf("%w: logMetric is required for log metrics", ErrMetricsInvalidRequest)
+	}
+	if scope.Project == "" {
+		return nil, fmt.Errorf("%w: searchScope.project is required for log metrics", ErrMetricsInvalidRequest)
+	}
+
+	metric, err := s.store.GetLogMetric(ctx, scope.Namespace, scope.Project, req.LogMetric)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMetricsInvalidRequest, mapLogQueryStoreError(err, "log metric", req.LogMetric))
+	}
+	if scope.Component != "" && scope.Component != metric.ComponentName {
+		return nil, fmt.Errorf("%w: log metric %q is not scoped to component %q",
+			ErrMetricsInvalidRequest, metric.Name, scope.Component)
+	}
+	if scope.Environment != "" && scope.Environment != metric.EnvironmentName {
+		return nil, fmt.Errorf("%w: log metric %q is not scoped to environment %q",
+			ErrMetricsInvalidRequest, metric.Name, scope.Environment)
+	}
+
+	startTime, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid startTime: %w", ErrMetricsInvalidRequest, err)
+	}
+	endTime, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid endTime: %w", ErrMetricsInvalidRequest, err)
+	}
+	step := metric.Interval
+	if req.Step != nil && *req.Step != "" {
+		requested, err := time.ParseDuration(*req.Step)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid step: %w", ErrMetricsInvalidRequest, err)
+		}
+		if requested > step {
+			step = requested
+		}
+	}
+
+	samples, err := s.store.QuerySamples(ctx, metric.ID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMetricsRetrieval, err)
+	}
+
+	return &types.LogMetricsQueryResponse{
+		LogMetric: metric.Name,
+		GroupBy:   metric.GroupBy,
+		Series:    bucketLogMetricSamples(samples, startTime, step),
+	}, nil
+}
+
+// bucketLogMetricSamples sums samples into step-wide buckets aligned to start and returns
+// one series per group, ordered by group and then by timestamp.
+func bucketLogMetricSamples(samples []logquery.Sample, start time.Time, step time.Duration) []types.LogMetricSeries {
+	buckets := make(map[string]map[time.Time]float64)
+	for _, sample := range samples {
+		bucket := start.Add(sample.Timestamp.Sub(start) / step * step)
+		if buckets[sample.Group] == nil {
+			buckets[sample.Group] = make(map[time.Time]float64)
+		}
+		buckets[sample.Group][bucket] += sample.Value
+	}
+
+	series := make([]types.LogMetricSeries, 0, len(buckets))
+	for group, values := range buckets {
+		items := make([]types.MetricsTimeSeriesItem, 0, len(values))
+		for ts, value := range values {
+			items = append(items, types.MetricsTimeSeriesItem{Timestamp: ts, Value: value})
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Timestamp.Before(items[j].Timestamp) })
+		series = append(series, types.LogMetricSeries{Group: group, Values: items})
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Group < series[j].Group })
+	return series
+}
+
+// metricsQuerierWithLogMetrics routes metric="log" queries to the log metrics store and
+// every other metric type to the wrapped MetricsQuerier.
+type metricsQuerierWithLogMetrics struct {
+	MetricsQuerier
+	logMetrics *LogQueriesService
+}
+
+var _ MetricsQuerier = (*metricsQuerierWithLogMetrics)(nil)
+
+// NewMetricsQuerierWithLogMetrics makes log-derived metrics queryable through the
+// metrics API. Wrap the result with NewMetricsServiceWithAuthz as usual.
+func NewMetricsQuerierWithLogMetrics(base MetricsQuerier, logMetrics *LogQueriesService) MetricsQuerier {
+	return &metricsQuerierWithLogMetrics{MetricsQuerier: base, logMetrics: logMetrics}
+}
+
+func (m *metricsQuerierWithLogMetrics) QueryMetrics(ctx context.Context, req *types.MetricsQueryRequest) (any, error) {
+	if req != nil && req.Metric == types.MetricTypeLog {
+		return m.logMetrics.QueryLogMetric(ctx, req)
+	}
+	return m.MetricsQuerier.QueryMetrics(ctx, req)
+}
@@ -0,0 +1,387 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+var (
+	// ErrLogQueryInvalidRequest indicates a malformed saved query or log metric request.
+	ErrLogQueryInvalidRequest = errors.New("invalid log query request")
+	// ErrLogQueryNotFound indicates that the saved query or log metric does not exist.
+	ErrLogQueryNotFound = errors.New("log query not found")
+	// ErrLogQueryAlreadyExists indicates that the saved query or log metric name is taken.
+	ErrLogQueryAlreadyExists = errors.New("log query already exists")
+	// ErrLogQueryResolveScope indicates a failure while resolving the query scope to UIDs.
+	ErrLogQueryResolveScope = errors.New("log query scope resolution failed")
+)
+
+// logQueryNamePattern restricts saved query and log metric names to DNS labels so
+// they can be used in URLs and referenced from ObservabilityAlertRule specs as-is.
+var logQueryNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+const maxLogQueryNameLength = 63
+
+// LogQueriesService manages saved logs queries and log-derived metrics, and evaluates
+// those metrics (and the alert rules built on them) in the background.
+type LogQueriesService struct {
+	store       logquery.Store
+	logsService LogsQuerier
+	logsAdapter observability.LogsAdapter
+	resolver    *ResourceUIDResolver
+	config      *config.Config
+	logger      *slog.Logger
+}
+
+var _ LogQueryManager = (*LogQueriesService)(nil)
+
+// NewLogQueriesService creates a new LogQueriesService. logsService must be the
+// unwrapped logs service: authorization is enforced by the LogQueryManager wrapper.
+func NewLogQueriesService(
+	store logquery.Store,
+	logsService LogsQuerier,
+	logsAdapter observability.LogsAdapter,
+	resolver *ResourceUIDResolver,
+	cfg *config.Config,
+	logger *slog.Logger,
+) (*LogQueriesService, error) {
+	if store == nil {
+		return nil, fmt.Errorf("log query store is required")
+	}
+	if logsService == nil {
+		return nil, fmt.Errorf("logs service is required")
+	}
+	if logsAdapter == nil {
+		return nil, fmt.Errorf("logs adapter is required")
+	}
+	return &LogQueriesService{
+		store:       store,
+		logsService: logsService,
+		logsAdapter: logsAdapter,
+		resolver:    resolver,
+		config:      cfg,
+		logger:      logger,
+	}, nil
+}
+
+// CreateSavedQuery saves a new logs query for a project.
+func (s *LogQueriesService) CreateSavedQuery(ctx context.Context, req *types.SavedLogQueryRequest) (*types.SavedLogQuery, error) {
+	if err := s.validateSavedQueryRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := savedQueryFromRequest(req)
+	if err := s.store.CreateSavedQuery(ctx, query); err != nil {
+		return nil, mapLogQueryStoreError(err, "saved query", req.Name)
+	}
+	s.logger.Info("Saved query created", "namespace", req.Namespace, "project", req.Project, "name", req.Name)
+	return toSavedLogQuery(query), nil
+}
+
+// UpdateSavedQuery replaces an existing saved query. Log metrics created from it keep
+// the filters they were created with.
+func (s *LogQueriesService) UpdateSavedQuery(ctx context.Context, req *types.SavedLogQueryRequest) (*types.SavedLogQuery, error) {
+	if err := s.validateSavedQueryRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := savedQueryFromRequest(req)
+	if err := s.store.UpdateSavedQuery(ctx, query); err != nil {
+		return nil, mapLogQueryStoreError(err, "saved query", req.Name)
+	}
+
+	updated, err := s.store.GetSavedQuery(ctx, req.Namespace, req.Project, req.Name)
+	if err != nil {
+		return nil, mapLogQueryStoreError(err, "saved query", req.Name)
+	}
+	s.logger.Info("Saved query updated", "namespace", req.Namespace, "project", req.Project, "name", req.Name)
+	return toSavedLogQuery(updated), nil
+}
+
+// GetSavedQuery returns a saved query by name.
+func (s *LogQueriesService) GetSavedQuery(ctx context.Context, namespace, project, name string) (*types.SavedLogQuery, error) {
+	query, err := s.store.GetSavedQuery(ctx, namespace, project, name)
+	if err != nil {
+		return nil, mapLogQueryStoreError(err, "saved query", name)
+	}
+	return toSavedLogQuery(query), nil
+}
+
+// ListSavedQueries returns the saved queries of a project.
+func (s *LogQueriesService) ListSavedQueries(ctx context.Context, namespace, project string) (*types.SavedLogQueryList, error) {
+	queries, err := s.store.ListSavedQueries(ctx, namespace, project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved queries: %w", err)
+	}
+
+	result := &types.SavedLogQueryList{Queries: make([]types.SavedLogQuery, 0, len(queries))}
+	for i := range queries {
+		result.Queries = append(result.Queries, *toSavedLogQuery(&queries[i]))
+	}
+	return result, nil
+}
+
+// DeleteSavedQuery deletes a saved query.
+func (s *LogQueriesService) DeleteSavedQuery(ctx context.Context, namespace, project, name string) error {
+	if err := s.store.DeleteSavedQuery(ctx, namespace, project, name); err != nil {
+		return mapLogQueryStoreError(err, "saved query", name)
+	}
+	s.logger.Info("Saved query deleted", "namespace", namespace, "project", project, "name", name)
+	return nil
+}
+
+// RunSavedQuery runs a saved query over the requested time range through the logs service.
+func (s *LogQueriesService) RunSavedQuery(ctx context.Context, req *types.SavedLogQueryRunRequest) (*types.LogsQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+
+	query, err := s.store.GetSavedQuery(ctx, req.Namespace, req.Project, req.Name)
+	if err != nil {
+		return nil, mapLogQueryStoreError(err, "saved query", req.Name)
+	}
+
+	return s.logsService.QueryLogs(ctx, &types.LogsQueryRequest{
+		SearchScope: &types.SearchScope{Component: &types.ComponentSearchScope{
+			Namespace:   query.NamespaceName,
+			Project:     query.ProjectName,
+			Component:   query.ComponentName,
+			Environment: query.EnvironmentName,
+		}},
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		SearchPhrase: query.SearchPhrase,
+		LogLevels:    query.LogLevels,
+		Limit:        req.Limit,
+		SortOrder:    req.SortOrder,
+	})
+}
+
+// CreateLogMetric creates a log metric, either from inline filters or by copying the
+// filters of a saved query. Evaluation starts with the next complete interval.
+func (s *LogQueriesService) CreateLogMetric(ctx context.Context, req *types.LogMetricRequest) (*types.LogMetric, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+	if err := validateLogQueryName(req.Name); err != nil {
+		return nil, err
+	}
+	if err := validateMinutesHoursDuration(req.Interval, "interval"); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLogQueryInvalidRequest, err)
+	}
+	if !isValidLogMetricGroupBy(req.GroupBy) {
+		return nil, fmt.Errorf("%w: unsupported groupBy %q", ErrLogQueryInvalidRequest, req.GroupBy)
+	}
+	interval, _ := time.ParseDuration(req.Interval)
+
+	metric := &logquery.LogMetric{
+		NamespaceName:   req.Namespace,
+		ProjectName:     req.Project,
+		ComponentName:   req.Component,
+		EnvironmentName: req.Environment,
+		Name:            req.Name,
+		Description:     req.Description,
+		SearchPhrase:    req.SearchPhrase,
+		LogLevels:       req.LogLevels,
+		GroupBy:         req.GroupBy,
+		Interval:        interval,
+	}
+
+	if req.SavedQuery != "" {
+		if req.Component != "" || req.Environment != "" || req.SearchPhrase != "" || len(req.LogLevels) > 0 {
+			return nil, fmt.Errorf("%w: component, environment, searchPhrase and logLevels must not be set together with savedQuery",
+				ErrLogQueryInvalidRequest)
+		}
+		query, err := s.store.GetSavedQuery(ctx, req.Namespace, req.Project, req.SavedQuery)
+		if err != nil {
+			return nil, mapLogQueryStoreError(err, "saved query", req.SavedQuery)
+		}
+		metric.ComponentName = query.ComponentName
+		metric.EnvironmentName = query.EnvironmentName
+		metric.SearchPhrase = query.SearchPhrase
+		metric.LogLevels = query.LogLevels
+	}
+
+	projectUID, componentUID, environmentUID, err := resolveComponentScopeUIDs(ctx, s.resolver, &types.ComponentSearchScope{
+		Namespace:   metric.NamespaceName,
+		Project:     metric.ProjectName,
+		Component:   metric.ComponentName,
+		Environment: metric.EnvironmentName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLogQueryResolveScope, err)
+	}
+	metric.ProjectID = projectUID
+	metric.ComponentID = componentUID
+	metric.EnvironmentID = environmentUID
+
+	if err := s.store.CreateLogMetric(ctx, metric); err != nil {
+		return nil, mapLogQueryStoreError(err, "log metric", req.Name)
+	}
+	s.logger.Info("Log metric created",
+		"namespace", req.Namespace, "project", req.Project, "name", req.Name,
+		"groupBy", metric.GroupBy, "interval", metric.Interval)
+	return toLogMetric(metric), nil
+}
+
+// GetLogMetric returns a log metric by name.
+func (s *LogQueriesService) GetLogMetric(ctx context.Context, namespace, project, name string) (*types.LogMetric, error) {
+	metric, err := s.store.GetLogMetric(ctx, namespace, project, name)
+	if err != nil {
+		return nil, mapLogQueryStoreError(err, "log metric", name)
+	}
+	return toLogMetric(metric), nil
+}
+
+// ListLogMetrics returns the log metrics of a project.
+func (s *LogQueriesService) ListLogMetrics(ctx context.Context, namespace, project string) (*types.LogMetricList, error) {
+	metrics, err := s.store.ListLogMetrics(ctx, namespace, project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list log metrics: %w", err)
+	}
+
+	result := &types.LogMetricList{Metrics: make([]types.LogMetric, 0, len(metrics))}
+	for i := range metrics {
+		result.Metrics = append(result.Metrics, *toLogMetric(&metrics[i]))
+	}
+	return result, nil
+}
+
+// DeleteLogMetric deletes a log metric and its samples. Alert rules that reference it
+// stop firing until the metric is recreated.
+func (s *LogQueriesService) DeleteLogMetric(ctx context.Context, namespace, project, name string) error {
+	if err := s.store.DeleteLogMetric(ctx, namespace, project, name); err != nil {
+		return mapLogQueryStoreError(err, "log metric", name)
+	}
+	s.logger.Info("Log metric deleted", "namespace", namespace, "project", project, "name", name)
+	return nil
+}
+
+// validateSavedQueryRequest validates the request and checks that the component and
+// environment it narrows to exist, so a typo fails on save rather than on every run.
+func (s *LogQueriesService) validateSavedQueryRequest(ctx context.Context, req *types.SavedLogQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+	if err := validateLogQueryName(req.Name); err != nil {
+		return err
+	}
+	if _, _, _, err := resolveComponentScopeUIDs(ctx, s.resolver, &types.ComponentSearchScope{
+		Namespace:   req.Namespace,
+		Project:     req.Project,
+		Component:   req.Component,
+		Environment: req.Environment,
+	}); err != nil {
+		return fmt.Errorf("%w: %w", ErrLogQueryResolveScope, err)
+	}
+	return nil
+}
+
+func validateLogQueryName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrLogQueryInvalidRequest)
+	}
+	if len(name) > maxLogQueryNameLength || !logQueryNamePattern.MatchString(name) {
+		return fmt.Errorf("%w: name must be a lowercase DNS label of at most %d characters",
+			ErrLogQueryInvalidRequest, maxLogQueryNameLength)
+	}
+	return nil
+}
+
+func isValidLogMetricGroupBy(groupBy string) bool {
+	switch groupBy {
+	case "", types.LogMetricGroupByLevel, types.LogMetricGroupByComponent, types.LogMetricGroupByEnvironment,
+		types.LogMetricGroupByPod, types.LogMetricGroupByContainer:
+		return true
+	default:
+		return false
+	}
+}
+
+// mapLogQueryStoreError maps store sentinel errors to the service sentinel errors.
+func mapLogQueryStoreError(err error, kind, name string) error {
+	switch {
+	case errors.Is(err, logquery.ErrNotFound):
+		return fmt.Errorf("%w: %s %q", ErrLogQueryNotFound, kind, name)
+	case errors.Is(err, logquery.ErrAlreadyExists):
+		return fmt.Errorf("%w: %s %q", ErrLogQueryAlreadyExists, kind, name)
+	default:
+		return fmt.Errorf("%s %q: %w", kind, name, err)
+	}
+}
+
+func savedQueryFromRequest(req *types.SavedLogQueryRequest) *logquery.SavedQuery {
+	return &logquery.SavedQuery{
+		NamespaceName:   req.Namespace,
+		ProjectName:     req.Project,
+		ComponentName:   req.Component,
+		EnvironmentName: req.Environment,
+		Name:            req.Name,
+		Description:     req.Description,
+		SearchPhrase:    req.SearchPhrase,
+		LogLevels:       req.LogLevels,
+	}
+}
+
+func toSavedLogQuery(q *logquery.SavedQuery) *types.SavedLogQuery {
+	return &types.SavedLogQuery{
+		Name:         q.Name,
+		Description:  q.Description,
+		Namespace:    q.NamespaceName,
+		Project:      q.ProjectName,
+		Component:    q.ComponentName,
+		Environment:  q.EnvironmentName,
+		SearchPhrase: q.SearchPhrase,
+		LogLevels:    q.LogLevels,
+		CreatedAt:    q.CreatedAt,
+		UpdatedAt:    q.UpdatedAt,
+	}
+}
+
+func toLogMetric(m *logquery.LogMetric) *types.LogMetric {
+	result := &types.LogMetric{
+		Name:         m.Name,
+		Description:  m.Description,
+		Namespace:    m.NamespaceName,
+		Project:      m.ProjectName,
+		Component:    m.ComponentName,
+		Environment:  m.EnvironmentName,
+		SearchPhrase: m.SearchPhrase,
+		LogLevels:    m.LogLevels,
+		GroupBy:      m.GroupBy,
+		Interval:     formatMinutesHoursDuration(m.Interval),
+		CreatedAt:    m.CreatedAt,
+	}
+	if !m.LastEvaluatedAt.IsZero() {
+		evaluatedAt := m.LastEvaluatedAt
+		result.LastEvaluatedAt = &evaluatedAt
+	}
+	return result
+}
+
+// formatMinutesHoursDuration formats a whole-minute duration the way it is accepted on
+// input ("5m", "1h", "1h30m") rather than time.Duration's "5m0s".
+func formatMinutesHoursDuration(d time.Duration) string {
+	hours := d / time.Hour
+	minutes := (d % time.Hour) / time.Minute
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
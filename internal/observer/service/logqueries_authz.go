@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// logQueriesServiceWithAuthz wraps a LogQueryManager and adds authorization checks.
+// Listing and managing saved queries and log metrics is authorized at project scope.
+// Reading or running a single one is authorized against the component and environment
+// it is scoped to, like a regular logs query.
+type logQueriesServiceWithAuthz struct {
+	internal LogQueryManager
+	pdp      authzcore.PDP
+	logger   *slog.Logger
+}
+
+var _ LogQueryManager = (*logQueriesServiceWithAuthz)(nil)
+
+// NewLogQueriesServiceWithAuthz wraps the provided LogQueryManager with authorization checks.
+func NewLogQueriesServiceWithAuthz(s LogQueryManager, pdp authzcore.PDP, logger *slog.Logger) LogQueryManager {
+	return &logQueriesServiceWithAuthz{internal: s, pdp: pdp, logger: logger}
+}
+
+func (s *logQueriesServiceWithAuthz) CreateSavedQuery(ctx context.Context, req *types.SavedLogQueryRequest) (*types.SavedLogQuery, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+	if err := s.checkProject(ctx, observerAuthz.ActionUpdateLogQueries, req.Namespace, req.Project); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateSavedQuery(ctx, req)
+}
+
+func (s *logQueriesServiceWithAuthz) UpdateSavedQuery(ctx context.Context, req *types.SavedLogQueryRequest) (*types.SavedLogQuery, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+	if err := s.checkProject(ctx, observerAuthz.ActionUpdateLogQueries, req.Namespace, req.Project); err != nil {
+		return nil, err
+	}
+	return s.internal.UpdateSavedQuery(ctx, req)
+}
+
+func (s *logQueriesServiceWithAuthz) GetSavedQuery(ctx context.Context, namespace, project, name string) (*types.SavedLogQuery, error) {
+	query, err := s.internal.GetSavedQuery(ctx, namespace, project, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkScope(ctx, namespace, project, query.Component, query.Environment); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+func (s *logQueriesServiceWithAuthz) ListSavedQueries(ctx context.Context, namespace, project string) (*types.SavedLogQueryList, error) {
+	if err := s.checkProject(ctx, observerAuthz.ActionViewLogs, namespace, project); err != nil {
+		return nil, err
+	}
+	return s.internal.ListSavedQueries(ctx, namespace, project)
+}
+
+func (s *logQueriesServiceWithAuthz) DeleteSavedQuery(ctx context.Context, namespace, project, name string) error {
+	if err := s.checkProject(ctx, observerAuthz.ActionUpdateLogQueries, namespace, project); err != nil {
+		return err
+	}
+	return s.internal.DeleteSavedQuery(ctx, namespace, project, name)
+}
+
+func (s *logQueriesServiceWithAuthz) RunSavedQuery(ctx context.Context, req *types.SavedLogQueryRunRequest) (*types.LogsQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+	query, err := s.internal.GetSavedQuery(ctx, req.Namespace, req.Project, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkScope(ctx, req.Namespace, req.Project, query.Component, query.Environment); err != nil {
+		return nil, err
+	}
+	return s.internal.RunSavedQuery(ctx, req)
+}
+
+func (s *logQueriesServiceWithAuthz) CreateLogMetric(ctx context.Context, req *types.LogMetricRequest) (*types.LogMetric, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogQueryInvalidRequest)
+	}
+	if err := s.checkProject(ctx, observerAuthz.ActionUpdateLogQueries, req.Namespace, req.Project); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateLogMetric(ctx, req)
+}
+
+func (s *logQueriesServiceWithAuthz) GetLogMetric(ctx context.Context, namespace, project, name string) (*types.LogMetric, error) {
+	metric, err := s.internal.GetLogMetric(ctx, namespace, project, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkScope(ctx, namespace, project, metric.Component, metric.Environment); err != nil {
+		return nil, err
+	}
+	return metric, nil
+}
+
+func (s *logQueriesServiceWithAuthz) ListLogMetrics(ctx context.Context, namespace, project string) (*types.LogMetricList, error) {
+	if err := s.checkProject(ctx, observerAuthz.ActionViewLogs, namespace, project); err != nil {
+		return nil, err
+	}
+	return s.internal.ListLogMetrics(ctx, namespace, project)
+}
+
+func (s *logQueriesServiceWithAuthz) DeleteLogMetric(ctx context.Context, namespace, project, name string) error {
+	if err := s.checkProject(ctx, observerAuthz.ActionUpdateLogQueries, namespace, project); err != nil {
+		return err
+	}
+	return s.internal.DeleteLogMetric(ctx, namespace, project, name)
+}
+
+func (s *logQueriesServiceWithAuthz) checkProject(ctx context.Context, action observerAuthz.Action, namespace, project string) error {
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(namespace, project, "")
+	return observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		action,
+		resourceType, resourceName, hierarchy,
+		authzcore.Context{},
+	)
+}
+
+func (s *logQueriesServiceWithAuthz) checkScope(ctx context.Context, namespace, project, component, environment string) error {
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(namespace, project, component)
+	authzCtx := authzcore.Context{}
+	// TODO: currently the obs API is not equipped to provide cluster level environments,
+	// once that is done update false to proper isClusterScoped value.
+	if environment != "" {
+		authzCtx.Resource = authzcore.ResourceAttribute{
+			Environment: observerAuthz.FormatDualScopedResourceName(namespace, environment, false),
+		}
+	}
+	return observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		observerAuthz.ActionViewLogs,
+		resourceType, resourceName, hierarchy,
+		authzCtx,
+	)
+}
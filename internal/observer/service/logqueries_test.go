@@ -0,0 +1,353 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/store/logquery"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+// recordingAlertRuleService captures the alerts raised by the log metrics evaluator.
+type recordingAlertRuleService struct {
+	AlertRuleService
+	webhooks []gen.AlertWebhookRequest
+}
+
+func (r *recordingAlertRuleService) HandleAlertWebhook(_ context.Context, req gen.AlertWebhookRequest) (*gen.AlertWebhookResponse, error) {
+	r.webhooks = append(r.webhooks, req)
+	return &gen.AlertWebhookResponse{}, nil
+}
+
+func newTestLogQueryStore(t *testing.T) logquery.Store {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.ReplaceAll(t.Name(), "/", "-"))
+	store, err := logquery.New(logquery.BackendSQLite, dsn, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	require.NoError(t, store.Initialize(context.Background()))
+	return store
+}
+
+// newTestLogQueriesService wires a LogQueriesService to an in-memory store, the given
+// logs adapter and a resolver that returns the sample UIDs.
+func newTestLogQueriesService(t *testing.T, adapter observability.LogsAdapter) (*LogQueriesService, logquery.Store) {
+	t.Helper()
+
+	tokenSrv := newAlwaysOKTokenServer(t)
+	t.Cleanup(tokenSrv.Close)
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/components/"):
+			_, _ = w.Write([]byte(uidResponse(sampleComponentUID)))
+		case strings.Contains(r.URL.Path, "/environments/"):
+			_, _ = w.Write([]byte(uidResponse(sampleEnvironmentUID)))
+		case strings.Contains(r.URL.Path, "/projects/"):
+			_, _ = w.Write([]byte(uidResponse(sampleProjectUID)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(apiSrv.Close)
+	resolver := newTestResolver(t, apiSrv, tokenSrv, &config.UIDResolverConfig{MaxAuthRetry: 1})
+
+	cfg := &config.Config{LogMetrics: config.LogMetricsConfig{
+		EvaluationTick:  time.Minute,
+		SampleRetention: 24 * time.Hour,
+	}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	logsService, err := NewLogsService(adapter, resolver, cfg, logger)
+	require.NoError(t, err)
+
+	store := newTestLogQueryStore(t)
+	svc, err := NewLogQueriesService(store, logsService, adapter, resolver, cfg, logger)
+	require.NoError(t, err)
+	return svc, store
+}
+
+func TestNewLogQueriesService_RequiresStore(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLogQueriesService(nil, &LogsService{}, &fakeLogsAdapter{}, nil, &config.Config{},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.Error(t, err)
+}
+
+func TestLogQueriesService_SavedQueryLifecycle(t *testing.T) {
+	t.Parallel()
+
+	adapter := &fakeLogsAdapter{componentResult: &observability.ComponentApplicationLogsResult{TotalCount: 0}}
+	svc, _ := newTestLogQueriesService(t, adapter)
+	ctx := context.Background()
+
+	req := &types.SavedLogQueryRequest{
+		Namespace:    "default",
+		Project:      "commerce",
+		Name:         "payment-errors",
+		Component:    "payments",
+		SearchPhrase: "payment failed",
+		LogLevels:    []string{"ERROR"},
+	}
+	created, err := svc.CreateSavedQuery(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "payments", created.Component)
+
+	_, err = svc.CreateSavedQuery(ctx, req)
+	require.ErrorIs(t, err, ErrLogQueryAlreadyExists)
+
+	_, err = svc.CreateSavedQuery(ctx, &types.SavedLogQueryRequest{Namespace: "default", Project: "commerce", Name: "Bad_Name"})
+	require.ErrorIs(t, err, ErrLogQueryInvalidRequest)
+
+	req.SearchPhrase = "card declined"
+	updated, err := svc.UpdateSavedQuery(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "card declined", updated.SearchPhrase)
+
+	list, err := svc.ListSavedQueries(ctx, "default", "commerce")
+	require.NoError(t, err)
+	require.Len(t, list.Queries, 1)
+
+	_, err = svc.RunSavedQuery(ctx, &types.SavedLogQueryRunRequest{
+		Namespace: "default",
+		Project:   "commerce",
+		Name:      "payment-errors",
+		StartTime: "2026-03-01T10:00:00Z",
+		EndTime:   "2026-03-01T11:00:00Z",
+		Limit:     50,
+		SortOrder: "asc",
+	})
+	require.NoError(t, err)
+	require.True(t, adapter.componentCalled)
+	assert.Equal(t, sampleComponentUID, adapter.lastComponent.ComponentID)
+	assert.Equal(t, "card declined", adapter.lastComponent.SearchPhrase)
+	assert.Equal(t, []string{"ERROR"}, adapter.lastComponent.LogLevels)
+	assert.Equal(t, 50, adapter.lastComponent.Limit)
+
+	require.NoError(t, svc.DeleteSavedQuery(ctx, "default", "commerce", "payment-errors"))
+	_, err = svc.GetSavedQuery(ctx, "default", "commerce", "payment-errors")
+	require.ErrorIs(t, err, ErrLogQueryNotFound)
+	_, err = svc.RunSavedQuery(ctx, &types.SavedLogQueryRunRequest{Namespace: "default", Project: "commerce", Name: "payment-errors"})
+	require.ErrorIs(t, err, ErrLogQueryNotFound)
+}
+
+func TestLogQueriesService_CreateLogMetric(t *testing.T) {
+	t.Parallel()
+
+	svc, store := newTestLogQueriesService(t, &fakeLogsAdapter{})
+	ctx := context.Background()
+
+	_, err := svc.CreateSavedQuery(ctx, &types.SavedLogQueryRequest{
+		Namespace: "default", Project: "commerce", Name: "payment-errors",
+		Component: "payments", Environment: "production", SearchPhrase: "failed", LogLevels: []string{"ERROR"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     types.LogMetricRequest
+		wantErr error
+	}{
+		{
+			name:    "seconds interval",
+			req:     types.LogMetricRequest{Name: "m1", Interval: "30s"},
+			wantErr: ErrLogQueryInvalidRequest,
+		},
+		{
+			name:    "unknown group by",
+			req:     types.LogMetricRequest{Name: "m1", Interval: "1m", GroupBy: "host"},
+			wantErr: ErrLogQueryInvalidRequest,
+		},
+		{
+			name:    "saved query with inline filters",
+			req:     types.LogMetricRequest{Name: "m1", Interval: "1m", SavedQuery: "payment-errors", SearchPhrase: "x"},
+			wantErr: ErrLogQueryInvalidRequest,
+		},
+		{
+			name:    "missing saved query",
+			req:     types.LogMetricRequest{Name: "m1", Interval: "1m", SavedQuery: "missing"},
+			wantErr: ErrLogQueryNotFound,
+		},
+	}
+	for _, tt := range tests {
+		req := tt.req
+		req.Namespace, req.Project = "default", "commerce"
+		_, err := svc.CreateLogMetric(ctx, &req)
+		require.ErrorIs(t, err, tt.wantErr, tt.name)
+	}
+
+	metric, err := svc.CreateLogMetric(ctx, &types.LogMetricRequest{
+		Namespace: "default", Project: "commerce", Name: "payment-errors-by-pod",
+		SavedQuery: "payment-errors", GroupBy: types.LogMetricGroupByPod, Interval: "5m",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "payments", metric.Component)
+	assert.Equal(t, "production", metric.Environment)
+	assert.Equal(t, "failed", metric.SearchPhrase)
+	assert.Equal(t, "5m", metric.Interval)
+	assert.Nil(t, metric.LastEvaluatedAt)
+
+	stored, err := store.FindLogMetric(ctx, sampleProjectUID, "payment-errors-by-pod")
+	require.NoError(t, err)
+	assert.Equal(t, sampleComponentUID, stored.ComponentID)
+	assert.Equal(t, sampleEnvironmentUID, stored.EnvironmentID)
+}
+
+func TestLogQueriesService_EvaluateDue(t *testing.T) {
+	t.Parallel()
+
+	bucket := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	adapter := &fakeLogsAdapter{componentResult: &observability.ComponentApplicationLogsResult{
+		TotalCount: 3,
+		Logs: []observability.LogEntry{
+			{LogLevel: "ERROR"},
+			{LogLevel: "ERROR"},
+			{LogLevel: "WARN"},
+		},
+	}}
+	svc, store := newTestLogQueriesService(t, adapter)
+	ctx := context.Background()
+
+	_, err := svc.CreateLogMetric(ctx, &types.LogMetricRequest{
+		Namespace: "default", Project: "commerce", Name: "errors-by-level",
+		GroupBy: types.LogMetricGroupByLevel, Interval: "1m",
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.UpsertAlertRule(ctx, &logquery.AlertRule{
+		Name: "error-spike", Namespace: "default", LogMetricName: "errors-by-level", ProjectID: sampleProjectUID,
+		Enabled: true, Operator: "gte", Threshold: 2, Window: 5 * time.Minute, Interval: time.Minute,
+	}))
+	require.NoError(t, store.UpsertAlertRule(ctx, &logquery.AlertRule{
+		Name: "disabled", Namespace: "default", LogMetricName: "errors-by-level", ProjectID: sampleProjectUID,
+		Enabled: false, Operator: "gte", Threshold: 1, Window: 5 * time.Minute, Interval: time.Minute,
+	}))
+
+	alerts := &recordingAlertRuleService{}
+	now := bucket.Add(time.Minute + logMetricEvaluationDelay)
+	svc.evaluateDue(ctx, alerts, now)
+
+	assert.True(t, bucket.Equal(adapter.lastComponent.StartTime))
+	assert.True(t, bucket.Add(time.Minute).Equal(adapter.lastComponent.EndTime))
+	assert.Equal(t, config.MaxLimit, adapter.lastComponent.Limit)
+
+	metric, err := store.GetLogMetric(ctx, "default", "commerce", "errors-by-level")
+	require.NoError(t, err)
+	assert.True(t, bucket.Add(time.Minute).Equal(metric.LastEvaluatedAt))
+
+	samples, err := store.QuerySamples(ctx, metric.ID, bucket, bucket)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, logquery.Sample{Timestamp: bucket, Group: "ERROR", Value: 2}, samples[0])
+	assert.Equal(t, logquery.Sample{Timestamp: bucket, Group: "WARN", Value: 1}, samples[1])
+
+	require.Len(t, alerts.webhooks, 1, "only the enabled rule fires")
+	assert.Equal(t, "error-spike", *alerts.webhooks[0].RuleName)
+	assert.InDelta(t, 2, *alerts.webhooks[0].AlertValue, 0)
+
+	// The rule interval has not elapsed, so a second pass neither re-queries nor re-fires.
+	adapter.componentCalled = false
+	svc.evaluateDue(ctx, alerts, now.Add(10*time.Second))
+	assert.False(t, adapter.componentCalled)
+	assert.Len(t, alerts.webhooks, 1)
+}
+
+func TestLogQueriesService_QueryLogMetric(t *testing.T) {
+	t.Parallel()
+
+	svc, store := newTestLogQueriesService(t, &fakeLogsAdapter{})
+	ctx := context.Background()
+
+	_, err := svc.CreateLogMetric(ctx, &types.LogMetricRequest{
+		Namespace: "default", Project: "commerce", Name: "payments-errors",
+		Component: "payments", GroupBy: types.LogMetricGroupByLevel, Interval: "1m",
+	})
+	require.NoError(t, err)
+	metric, err := store.GetLogMetric(ctx, "default", "commerce", "payments-errors")
+	require.NoError(t, err)
+
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, store.WriteSamples(ctx, metric.ID, []logquery.Sample{
+		{Timestamp: base, Group: "ERROR", Value: 1},
+		{Timestamp: base.Add(time.Minute), Group: "ERROR", Value: 2},
+		{Timestamp: base.Add(2 * time.Minute), Group: "ERROR", Value: 4},
+		{Timestamp: base.Add(time.Minute), Group: "WARN", Value: 5},
+	}))
+
+	step := "2m"
+	querier := NewMetricsQuerierWithLogMetrics(nil, svc)
+	result, err := querier.QueryMetrics(ctx, &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeLog,
+		LogMetric:   "payments-errors",
+		StartTime:   base.Format(time.RFC3339),
+		EndTime:     base.Add(10 * time.Minute).Format(time.RFC3339),
+		Step:        &step,
+		SearchScope: types.ComponentSearchScope{Namespace: "default", Project: "commerce", Component: "payments"},
+	})
+	require.NoError(t, err)
+	resp, ok := result.(*types.LogMetricsQueryResponse)
+	require.True(t, ok)
+	assert.Equal(t, types.LogMetricGroupByLevel, resp.GroupBy)
+	require.Len(t, resp.Series, 2)
+	assert.Equal(t, "ERROR", resp.Series[0].Group)
+	assert.Equal(t, []types.MetricsTimeSeriesItem{
+		{Timestamp: base, Value: 3},
+		{Timestamp: base.Add(2 * time.Minute), Value: 4},
+	}, resp.Series[0].Values)
+	assert.Equal(t, "WARN", resp.Series[1].Group)
+
+	// A component outside the metric's scope must not be used to read it.
+	_, err = svc.QueryLogMetric(ctx, &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeLog,
+		LogMetric:   "payments-errors",
+		StartTime:   base.Format(time.RFC3339),
+		EndTime:     base.Add(10 * time.Minute).Format(time.RFC3339),
+		SearchScope: types.ComponentSearchScope{Namespace: "default", Project: "commerce", Component: "checkout"},
+	})
+	require.ErrorIs(t, err, ErrMetricsInvalidRequest)
+
+	_, err = svc.QueryLogMetric(ctx, &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeLog,
+		LogMetric:   "missing",
+		StartTime:   base.Format(time.RFC3339),
+		EndTime:     base.Add(10 * time.Minute).Format(time.RFC3339),
+		SearchScope: types.ComponentSearchScope{Namespace: "default", Project: "commerce"},
+	})
+	require.ErrorIs(t, err, ErrMetricsInvalidRequest)
+}
+
+func TestCompareAlertThreshold(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, compareAlertThreshold(5, "gt", 4))
+	assert.False(t, compareAlertThreshold(4, "gt", 4))
+	assert.True(t, compareAlertThreshold(4, "gte", 4))
+	assert.True(t, compareAlertThreshold(0, "lt", 1))
+	assert.True(t, compareAlertThreshold(1, "lte", 1))
+	assert.True(t, compareAlertThreshold(1, "eq", 1))
+	assert.True(t, compareAlertThreshold(2, "neq", 1))
+	assert.False(t, compareAlertThreshold(2, "unknown", 1))
+}
+
+func TestFormatMinutesHoursDuration(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "5m", formatMinutesHoursDuration(5*time.Minute))
+	assert.Equal(t, "1h", formatMinutesHoursDuration(time.Hour))
+	assert.Equal(t, "1h30m", formatMinutesHoursDuration(90*time.Minute))
+}
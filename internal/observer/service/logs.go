@@ -28,6 +28,8 @@ var (
 	ErrLogsResolveSearchScope = errors.New("logs search scope resolution failed")
 	// ErrLogsRetrieval indicates a failure while retrieving logs from the adapter.
 	ErrLogsRetrieval = errors.New("logs retrieval failed")
+	// ErrLogsInvalidRequest indicates a malformed logs request.
+	ErrLogsInvalidRequest = errors.New("invalid logs request")
 )
 
 // NewLogsService creates a new LogsService instance backed by the HTTP logs adapter.
@@ -102,6 +104,69 @@ func (s *LogsService) QueryLogs(ctx context.Context, req *types.LogsQueryRequest
 	return s.queryComponentLogs(ctx, scope, startTime, endTime, req)
 }
 
+// QueryTraceLogs returns the component logs emitted while serving a trace, or a single
+// span of it when req.SpanID is set. Logs are matched on the trace context extracted by
+// the logs adapter, and are returned oldest first.
+func (s *LogsService) QueryTraceLogs(ctx context.Context, req *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrLogsInvalidRequest)
+	}
+	if req.TraceID == "" {
+		return nil, fmt.Errorf("%w: traceId is required", ErrLogsInvalidRequest)
+	}
+	s.logger.Info("QueryTraceLogs called",
+		"traceId", req.TraceID,
+		"spanId", req.SpanID,
+		"startTime", req.StartTime,
+		"endTime", req.EndTime)
+
+	projectUID, componentUID, environmentUID, err := resolveComponentScopeUIDs(ctx, s.resolver, &req.SearchScope)
+	if err != nil {
+		s.logger.Error("Failed to resolve search scope", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrLogsResolveSearchScope, err)
+	}
+
+	// The adapter applies the trace filter before the limit; the exact trace/span
+	// match is applied again below for adapters that do not support it.
+	params := observability.ComponentApplicationLogsParams{
+		ComponentID:   componentUID,
+		EnvironmentID: environmentUID,
+		ProjectID:     projectUID,
+		Namespace:     req.SearchScope.Namespace,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		TraceID:       req.TraceID,
+		SpanID:        req.SpanID,
+		Limit:         req.Limit,
+		SortOrder:     correlatedLogsSortOrder,
+	}
+
+	result, err := s.logsAdapter.GetComponentApplicationLogs(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to get trace logs from adapter", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrLogsRetrieval, err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("%w: component logs adapter returned nil result", ErrLogsRetrieval)
+	}
+
+	matched := correlatedLogs(result.Logs, req.TraceID, req.SpanID)
+	total := result.TotalCount
+	if len(matched) != len(result.Logs) {
+		total = len(matched)
+	}
+
+	s.logger.Debug("Trace logs retrieved from adapter",
+		"count", len(matched),
+		"adapterCount", len(result.Logs))
+
+	return s.convertComponentLogsToResponse(&observability.ComponentApplicationLogsResult{
+		Logs:       matched,
+		TotalCount: total,
+		Took:       result.Took,
+	}), nil
+}
+
 // queryComponentLogs handles component log queries
 func (s *LogsService) queryComponentLogs(
 	ctx context.Context,
@@ -204,6 +269,8 @@ func (s *LogsService) convertComponentLogsToResponse(
 				ContainerName:   log.ContainerName,
 				PodName:         log.PodName,
 				PodNamespace:    log.PodNamespace,
+				TraceID:         log.TraceID,
+				SpanID:          log.SpanID,
 			},
 		})
 	}
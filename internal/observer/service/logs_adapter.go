@@ -52,6 +52,8 @@ type backendLogsRequest struct {
 	SortOrder    string    `json:"sortOrder,omitempty"`
 	SearchPhrase string    `json:"searchPhrase,omitempty"`
 	LogLevels    []string  `json:"logLevels,omitempty"`
+	TraceID      string    `json:"traceId,omitempty"`
+	SpanID       string    `json:"spanId,omitempty"`
 }
 
 // backendComponentLogsResponse matches the adapter's JSON response format for component logs.
@@ -79,6 +81,8 @@ type backendComponentLogMetadata struct {
 	PodName         string `json:"podName"`
 	PodNamespace    string `json:"podNamespace"`
 	ContainerName   string `json:"containerName"`
+	TraceID         string `json:"traceId"`
+	SpanID          string `json:"spanId"`
 }
 
 // backendWorkflowLogsResponse matches the adapter's JSON response format for workflow logs.
@@ -133,6 +137,8 @@ func (p *LogsAdapter) GetComponentApplicationLogs(ctx context.Context, params ob
 		SortOrder:    params.SortOrder,
 		SearchPhrase: params.SearchPhrase,
 		LogLevels:    params.LogLevels,
+		TraceID:      params.TraceID,
+		SpanID:       params.SpanID,
 	}
 
 	requestBody, err := json.Marshal(adapterReq)
@@ -165,6 +171,11 @@ func (p *LogsAdapter) GetComponentApplicationLogs(ctx context.Context, params ob
 
 	logs := make([]observability.LogEntry, 0, len(adapterResp.Logs))
 	for _, l := range adapterResp.Logs {
+		// Prefer the trace context reported by the adapter, falling back to parsing the log line
+		traceID, spanID := normalizeTraceContextID(l.Metadata.TraceID), normalizeTraceContextID(l.Metadata.SpanID)
+		if traceID == "" {
+			traceID, spanID = extractTraceContext(l.Log)
+		}
 		logs = append(logs, observability.LogEntry{
 			Timestamp:       l.Timestamp,
 			Log:             l.Log,
@@ -180,6 +191,8 @@ func (p *LogsAdapter) GetComponentApplicationLogs(ctx context.Context, params ob
 			PodName:         l.Metadata.PodName,
 			PodNamespace:    l.Metadata.PodNamespace,
 			ContainerName:   l.Metadata.ContainerName,
+			TraceID:         traceID,
+			SpanID:          spanID,
 		})
 	}
 
@@ -52,3 +52,66 @@ func (s *logsServiceWithAuthz) QueryLogs(ctx context.Context, req *types.LogsQue
 	}
 	return s.internal.QueryLogs(ctx, req)
 }
+
+func (s *logsServiceWithAuthz) QueryTraceLogs(ctx context.Context, req *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error) {
+	if err := checkComponentLogsAccess(ctx, s.logger, s.pdp, req.SearchScope); err != nil {
+		return nil, err
+	}
+	return s.internal.QueryTraceLogs(ctx, req)
+}
+
+// checkComponentLogsAccess runs the authorization check of the component logs
+// endpoints for scope.
+func checkComponentLogsAccess(ctx context.Context, logger *slog.Logger, pdp authzcore.PDP, scope types.ComponentSearchScope) error {
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(scope.Namespace, scope.Project, scope.Component)
+	// TODO: currently the obs API is not equipped to provide cluster level environments,
+	// once that is done update false to proper isClusterScoped value.
+	return observerAuthz.CheckAuthorization(
+		ctx, logger, pdp,
+		observerAuthz.ActionViewLogs,
+		resourceType, resourceName, hierarchy,
+		authzcore.Context{Resource: authzcore.ResourceAttribute{
+			Environment: observerAuthz.FormatDualScopedResourceName(scope.Namespace, scope.Environment, false),
+		}},
+	)
+}
+
+// logsTailerWithAuthz wraps a LogsTailer and adds the same authorization checks as
+// logsServiceWithAuthz.QueryLogs.
+type logsTailerWithAuthz struct {
+	internal LogsTailer
+	pdp      authzcore.PDP
+	logger   *slog.Logger
+}
+
+var _ LogsTailer = (*logsTailerWithAuthz)(nil)
+
+// NewLogsTailerWithAuthz wraps the provided LogsTailer with authorization checks.
+func NewLogsTailerWithAuthz(s LogsTailer, pdp authzcore.PDP, logger *slog.Logger) LogsTailer {
+	return &logsTailerWithAuthz{internal: s, pdp: pdp, logger: logger}
+}
+
+func (s *logsTailerWithAuthz) TailLogs(ctx context.Context, req *types.LogsTailRequest, send func([]types.LogEntry) error) error {
+	resourceType, resourceName, hierarchy, err := observerAuthz.LogsTailScopeAuthz(req)
+	if err != nil {
+		return err
+	}
+	// TODO: currently the obs API is not equipped to provide cluster level environments,
+	// once that is done update false to proper isClusterScoped value.
+	authzCtx := authzcore.Context{}
+	if req.SearchScope != nil && req.SearchScope.Component != nil {
+		scope := req.SearchScope.Component
+		authzCtx.Resource = authzcore.ResourceAttribute{
+			Environment: observerAuthz.FormatDualScopedResourceName(scope.Namespace, scope.Environment, false),
+		}
+	}
+	if err := observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		observerAuthz.ActionViewLogs,
+		resourceType, resourceName, hierarchy,
+		authzCtx,
+	); err != nil {
+		return err
+	}
+	return s.internal.TailLogs(ctx, req, send)
+}
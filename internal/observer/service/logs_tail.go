@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// defaultLogsTailPollInterval is used when the logging config does not set one.
+	defaultLogsTailPollInterval = 2 * time.Second
+	// defaultLogsTailBatchLimit bounds a single adapter query when the config does not set one.
+	defaultLogsTailBatchLimit = 1000
+	// maxLogsTailPagesPerPoll bounds how many full batches are drained in one poll so a
+	// noisy component cannot starve the keep-alives of a stream.
+	maxLogsTailPagesPerPoll = 10
+)
+
+var _ LogsTailer = (*LogsService)(nil)
+
+// TailLogs streams log entries of the search scope as they arrive, starting at
+// req.StartTime or at the time of the call. The logs adapter is polled every
+// config.Logging.TailPollInterval with the request filters and new entries are passed
+// to send in chronological order. send is also called with an empty batch when a poll
+// found nothing new, so callers can keep idle connections alive.
+//
+// Errors resolving the scope or fetching the first batch are returned before send is
+// called. Later adapter errors are logged and retried on the next poll. TailLogs returns
+// nil when ctx is done and the error of send when it fails.
+func (s *LogsService) TailLogs(ctx context.Context, req *types.LogsTailRequest, send func([]types.LogEntry) error) error {
+	if req == nil {
+		return fmt.Errorf("%w: request is required", ErrLogsInvalidRequest)
+	}
+
+	scope, err := resolveSearchScope(ctx, s.resolver, req.SearchScope)
+	if err != nil {
+		s.logger.Error("Failed to resolve search scope", "error", err)
+		return fmt.Errorf("%w: %w", ErrLogsResolveSearchScope, err)
+	}
+
+	start := time.Now().UTC()
+	if req.StartTime != "" {
+		start, err = time.Parse(time.RFC3339, req.StartTime)
+		if err != nil {
+			return fmt.Errorf("%w: invalid startTime: %w", ErrLogsInvalidRequest, err)
+		}
+	}
+
+	interval := defaultLogsTailPollInterval
+	batchLimit := defaultLogsTailBatchLimit
+	if s.config != nil {
+		if s.config.Logging.TailPollInterval > 0 {
+			interval = s.config.Logging.TailPollInterval
+		}
+		if s.config.Logging.MaxLogLimit > 0 {
+			batchLimit = s.config.Logging.MaxLogLimit
+		}
+	}
+
+	s.logger.Info("TailLogs started",
+		"startTime", start,
+		"workflowScope", scope.IsWorkflowScope,
+		"hasSearchPhrase", req.SearchPhrase != "")
+
+	cursor := newLogsTailCursor(start)
+	batch, err := s.pollTail(ctx, scope, req, cursor, batchLimit)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := send(batch); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		batch, err = s.pollTail(ctx, scope, req, cursor, batchLimit)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.logger.Warn("Failed to poll logs for tail, retrying", "error", err)
+			batch = nil
+		}
+	}
+}
+
+// pollTail fetches the entries logged since the cursor, draining up to
+// maxLogsTailPagesPerPoll full batches.
+func (s *LogsService) pollTail(
+	ctx context.Context,
+	scope *internalSearchScope,
+	req *types.LogsTailRequest,
+	cursor *logsTailCursor,
+	batchLimit int,
+) ([]types.LogEntry, error) {
+	var entries []types.LogEntry
+	for range maxLogsTailPagesPerPoll {
+		query := &types.LogsQueryRequest{
+			SearchPhrase: req.SearchPhrase,
+			LogLevels:    req.LogLevels,
+			Limit:        batchLimit,
+			SortOrder:    "asc",
+		}
+		var (
+			result *types.LogsQueryResponse
+			err    error
+		)
+		start, end := cursor.since, time.Now().UTC()
+		if scope.IsWorkflowScope {
+			result, err = s.queryWorkflowLogs(ctx, scope, start, end, query)
+		} else {
+			result, err = s.queryComponentLogs(ctx, scope, start, end, query)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		fresh := cursor.advance(result.Logs)
+		entries = append(entries, fresh...)
+		// A short batch means the window is drained. A full batch without anything new
+		// only repeats entries at the cursor, so moving on avoids spinning on it.
+		if len(result.Logs) < batchLimit || len(fresh) == 0 {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// logsTailCursor tracks the position of a tail. Log timestamps are rendered with second
+// precision, so each poll re-reads the cursor's second and skips the entries of that
+// second which were already sent.
+type logsTailCursor struct {
+	since time.Time
+	sent  map[string]int
+}
+
+func newLogsTailCursor(start time.Time) *logsTailCursor {
+	return &logsTailCursor{since: start.Truncate(time.Second), sent: map[string]int{}}
+}
+
+// advance returns the entries not sent yet and moves the cursor past them. entries must
+// be in ascending timestamp order.
+func (c *logsTailCursor) advance(entries []types.LogEntry) []types.LogEntry {
+	skip := make(map[string]int, len(c.sent))
+	for key, n := range c.sent {
+		skip[key] = n
+	}
+
+	fresh := make([]types.LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		ts, err := time.Parse(time.RFC3339, entry.Timestamp)
+		if err != nil || ts.Before(c.since) {
+			continue
+		}
+		if ts.Equal(c.since) {
+			key := logsTailEntryKey(entry)
+			if skip[key] > 0 {
+				skip[key]--
+				continue
+			}
+		}
+		fresh = append(fresh, entry)
+	}
+	if len(fresh) == 0 {
+		return fresh
+	}
+
+	last, _ := time.Parse(time.RFC3339, fresh[len(fresh)-1].Timestamp)
+	if !last.Equal(c.since) {
+		c.since = last
+		c.sent = map[string]int{}
+	}
+	for _, entry := range fresh {
+		if entry.Timestamp == fresh[len(fresh)-1].Timestamp {
+			c.sent[logsTailEntryKey(entry)]++
+		}
+	}
+	return fresh
+}
+
+func logsTailEntryKey(entry types.LogEntry) string {
+	if entry.Metadata == nil {
+		return entry.Timestamp + "\x00" + entry.Log
+	}
+	return entry.Timestamp + "\x00" + entry.Metadata.PodName + "\x00" + entry.Metadata.ContainerName + "\x00" + entry.Log
+}
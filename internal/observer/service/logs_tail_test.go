@@ -0,0 +1,158 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+func workflowTailRequest() *types.LogsTailRequest {
+	return &types.LogsTailRequest{
+		SearchScope: &types.SearchScope{
+			Workflow: &types.WorkflowSearchScope{Namespace: "ns-1", WorkflowRunName: "wf-run-1"},
+		},
+		StartTime:    "2026-03-07T10:00:00Z",
+		SearchPhrase: "error",
+		LogLevels:    []string{"ERROR"},
+	}
+}
+
+func TestLogsService_TailLogs_StreamsFirstBatch(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 7, 10, 0, 5, 0, time.UTC)
+	adapter := &fakeLogsAdapter{
+		workflowResult: &observability.WorkflowLogsResult{
+			Logs: []observability.WorkflowLogEntry{
+				{Timestamp: now, Log: "step failed", LogLevel: "ERROR"},
+			},
+			TotalCount: 1,
+		},
+	}
+	svc := newLogsServiceForTest(t, adapter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []types.LogEntry
+	err := svc.TailLogs(ctx, workflowTailRequest(), func(batch []types.LogEntry) error {
+		got = append(got, batch...)
+		cancel()
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "step failed", got[0].Log)
+	assert.Equal(t, "wf-run-1", adapter.lastWorkflow.WorkflowRunName)
+	assert.Equal(t, "error", adapter.lastWorkflow.SearchPhrase)
+	assert.Equal(t, []string{"ERROR"}, adapter.lastWorkflow.LogLevels)
+	assert.Equal(t, "asc", adapter.lastWorkflow.SortOrder)
+	assert.Equal(t, time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC), adapter.lastWorkflow.StartTime)
+}
+
+func TestLogsService_TailLogs_ErrorsBeforeStreaming(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		adapter *fakeLogsAdapter
+		mutate  func(req *types.LogsTailRequest)
+		wantErr error
+	}{
+		{
+			name:    "missing search scope",
+			adapter: &fakeLogsAdapter{},
+			mutate:  func(req *types.LogsTailRequest) { req.SearchScope = nil },
+			wantErr: ErrLogsResolveSearchScope,
+		},
+		{
+			name:    "invalid start time",
+			adapter: &fakeLogsAdapter{},
+			mutate:  func(req *types.LogsTailRequest) { req.StartTime = "yesterday" },
+			wantErr: ErrLogsInvalidRequest,
+		},
+		{
+			name:    "adapter error",
+			adapter: &fakeLogsAdapter{workflowErr: errors.New("upstream boom")},
+			mutate:  func(*types.LogsTailRequest) {},
+			wantErr: ErrLogsRetrieval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc := newLogsServiceForTest(t, tt.adapter)
+			req := workflowTailRequest()
+			tt.mutate(req)
+
+			sent := false
+			err := svc.TailLogs(context.Background(), req, func([]types.LogEntry) error {
+				sent = true
+				return nil
+			})
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.False(t, sent)
+		})
+	}
+}
+
+func TestLogsService_TailLogs_ReturnsSendError(t *testing.T) {
+	t.Parallel()
+	svc := newLogsServiceForTest(t, &fakeLogsAdapter{workflowResult: &observability.WorkflowLogsResult{}})
+
+	errClosed := errors.New("client went away")
+	err := svc.TailLogs(context.Background(), workflowTailRequest(), func([]types.LogEntry) error {
+		return errClosed
+	})
+	require.ErrorIs(t, err, errClosed)
+}
+
+func TestLogsTailCursor_Advance(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	entry := func(ts, log string) types.LogEntry {
+		return types.LogEntry{Timestamp: ts, Log: log, Metadata: &types.LogMetadata{PodName: "pod-1"}}
+	}
+
+	cursor := newLogsTailCursor(start.Add(300 * time.Millisecond))
+	assert.Equal(t, start, cursor.since)
+
+	first := cursor.advance([]types.LogEntry{
+		entry("2026-03-07T09:59:59Z", "before start"),
+		entry("2026-03-07T10:00:00Z", "a"),
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "b"),
+	})
+	require.Len(t, first, 3)
+	assert.Equal(t, start.Add(time.Second), cursor.since)
+
+	// The next poll re-reads 10:00:01 and also finds a third "b" logged in that second.
+	second := cursor.advance([]types.LogEntry{
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "c"),
+	})
+	require.Len(t, second, 2)
+	assert.Equal(t, "b", second[0].Log)
+	assert.Equal(t, "c", second[1].Log)
+
+	third := cursor.advance([]types.LogEntry{
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "b"),
+		entry("2026-03-07T10:00:01Z", "c"),
+	})
+	assert.Empty(t, third)
+	assert.Equal(t, start.Add(time.Second), cursor.since)
+}
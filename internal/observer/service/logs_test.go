@@ -333,6 +333,69 @@ func TestLogsService_QueryLogs_ComponentScope_Success(t *testing.T) {
 	assert.Equal(t, "hello", resp.Logs[0].Log)
 }
 
+func TestLogsService_QueryTraceLogs_InvalidRequest(t *testing.T) {
+	t.Parallel()
+	svc := newLogsServiceForTest(t, &fakeLogsAdapter{})
+
+	_, err := svc.QueryTraceLogs(context.Background(), nil)
+	require.ErrorIs(t, err, ErrLogsInvalidRequest)
+
+	_, err = svc.QueryTraceLogs(context.Background(), &types.TraceLogsQueryRequest{
+		SearchScope: types.ComponentSearchScope{Namespace: "ns"},
+	})
+	require.ErrorIs(t, err, ErrLogsInvalidRequest)
+}
+
+func TestLogsService_QueryTraceLogs_Success(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	adapter := &fakeLogsAdapter{
+		componentResult: &observability.ComponentApplicationLogsResult{
+			Logs: []observability.LogEntry{
+				{Timestamp: now, Log: "in span", TraceID: sampleTraceID, SpanID: sampleSpanID},
+				{Timestamp: now, Log: "sibling span", TraceID: sampleTraceID, SpanID: "b7ad6b7169203331"},
+			},
+			TotalCount: 2,
+			Took:       4,
+		},
+	}
+	svc := newLogsServiceForTest(t, adapter)
+
+	resp, err := svc.QueryTraceLogs(context.Background(), &types.TraceLogsQueryRequest{
+		TraceID:     sampleTraceID,
+		SpanID:      sampleSpanID,
+		StartTime:   now.Add(-time.Hour),
+		EndTime:     now,
+		Limit:       50,
+		SearchScope: types.ComponentSearchScope{Namespace: "ns"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ns", adapter.lastComponent.Namespace)
+	// The trace filter is part of the query, so the adapter applies it before the limit
+	assert.Equal(t, sampleTraceID, adapter.lastComponent.TraceID)
+	assert.Equal(t, sampleSpanID, adapter.lastComponent.SpanID)
+	assert.Equal(t, "asc", adapter.lastComponent.SortOrder)
+	assert.Equal(t, 50, adapter.lastComponent.Limit)
+	require.Len(t, resp.Logs, 1)
+	assert.Equal(t, "in span", resp.Logs[0].Log)
+	assert.Equal(t, 1, resp.Total)
+	require.NotNil(t, resp.Logs[0].Metadata)
+	assert.Equal(t, sampleTraceID, resp.Logs[0].Metadata.TraceID)
+	assert.Equal(t, sampleSpanID, resp.Logs[0].Metadata.SpanID)
+}
+
+func TestLogsService_QueryTraceLogs_AdapterError(t *testing.T) {
+	t.Parallel()
+	adapter := &fakeLogsAdapter{componentErr: errors.New("upstream boom")}
+	svc := newLogsServiceForTest(t, adapter)
+
+	_, err := svc.QueryTraceLogs(context.Background(), &types.TraceLogsQueryRequest{
+		TraceID:     sampleTraceID,
+		SearchScope: types.ComponentSearchScope{Namespace: "ns"},
+	})
+	require.ErrorIs(t, err, ErrLogsRetrieval)
+}
+
 func TestLogsService_ConvertComponentLogsToResponse(t *testing.T) {
 	t.Parallel()
 	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
@@ -82,6 +82,65 @@ func (_c *MockLogsQuerier_QueryLogs_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// QueryTraceLogs provides a mock function with given fields: ctx, req
+func (_m *MockLogsQuerier) QueryTraceLogs(ctx context.Context, req *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryTraceLogs")
+	}
+
+	var r0 *types.LogsQueryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.TraceLogsQueryRequest) *types.LogsQueryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.LogsQueryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.TraceLogsQueryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLogsQuerier_QueryTraceLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryTraceLogs'
+type MockLogsQuerier_QueryTraceLogs_Call struct {
+	*mock.Call
+}
+
+// QueryTraceLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *types.TraceLogsQueryRequest
+func (_e *MockLogsQuerier_Expecter) QueryTraceLogs(ctx interface{}, req interface{}) *MockLogsQuerier_QueryTraceLogs_Call {
+	return &MockLogsQuerier_QueryTraceLogs_Call{Call: _e.mock.On("QueryTraceLogs", ctx, req)}
+}
+
+func (_c *MockLogsQuerier_QueryTraceLogs_Call) Run(run func(ctx context.Context, req *types.TraceLogsQueryRequest)) *MockLogsQuerier_QueryTraceLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.TraceLogsQueryRequest))
+	})
+	return _c
+}
+
+func (_c *MockLogsQuerier_QueryTraceLogs_Call) Return(_a0 *types.LogsQueryResponse, _a1 error) *MockLogsQuerier_QueryTraceLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLogsQuerier_QueryTraceLogs_Call) RunAndReturn(run func(context.Context, *types.TraceLogsQueryRequest) (*types.LogsQueryResponse, error)) *MockLogsQuerier_QueryTraceLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockLogsQuerier creates a new instance of MockLogsQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockLogsQuerier(t interface {
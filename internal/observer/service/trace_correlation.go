@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+// correlatedLogsSortOrder returns correlated logs oldest first, so they read in
+// the order the traced request emitted them.
+const correlatedLogsSortOrder = "asc"
+
+var (
+	// traceparentPattern matches a W3C traceparent value: version-traceid-spanid-flags
+	traceparentPattern = regexp.MustCompile(`\b[0-9a-fA-F]{2}-([0-9a-fA-F]{32})-([0-9a-fA-F]{16})-[0-9a-fA-F]{2}\b`)
+
+	// traceIDPattern and spanIDPattern match the trace context fields written by OpenTelemetry
+	// log bridges and common logging libraries, in JSON ("trace_id":"...") or logfmt (trace_id=...) form.
+	// Accepted key spellings include trace_id, traceId, traceID, trace.id and trace-id.
+	traceIDPattern = regexp.MustCompile(`(?i)\btrace[_.-]?id"?\s*[:=]\s*"?([0-9a-f]{32})\b`)
+	spanIDPattern  = regexp.MustCompile(`(?i)\bspan[_.-]?id"?\s*[:=]\s*"?([0-9a-f]{16})\b`)
+)
+
+// extractTraceContext returns the trace and span IDs found in a log line, lowercased.
+// Either value is empty when the line does not carry it.
+func extractTraceContext(line string) (traceID, spanID string) {
+	if m := traceIDPattern.FindStringSubmatch(line); m != nil {
+		traceID = normalizeTraceContextID(m[1])
+	}
+	if m := spanIDPattern.FindStringSubmatch(line); m != nil {
+		spanID = normalizeTraceContextID(m[1])
+	}
+	if traceID == "" {
+		if m := traceparentPattern.FindStringSubmatch(line); m != nil {
+			traceID = normalizeTraceContextID(m[1])
+			if spanID == "" {
+				spanID = normalizeTraceContextID(m[2])
+			}
+		}
+	}
+	return traceID, spanID
+}
+
+// normalizeTraceContextID lowercases a trace or span ID. All-zero IDs are invalid
+// in W3C trace context and are mapped to the empty string.
+func normalizeTraceContextID(id string) string {
+	if strings.Trim(id, "0") == "" {
+		return ""
+	}
+	return strings.ToLower(id)
+}
+
+// correlatedLogs returns the entries that belong to traceID and, when spanID is
+// set, to that span. Entries without an extracted trace ID are kept if their
+// message mentions the trace ID, since the adapter matched them on it.
+func correlatedLogs(logs []observability.LogEntry, traceID, spanID string) []observability.LogEntry {
+	traceID = strings.ToLower(traceID)
+	spanID = strings.ToLower(spanID)
+
+	matched := make([]observability.LogEntry, 0, len(logs))
+	for _, entry := range logs {
+		if entry.TraceID == "" {
+			if !strings.Contains(strings.ToLower(entry.Log), traceID) {
+				continue
+			}
+		} else if entry.TraceID != traceID {
+			continue
+		}
+		if spanID != "" && entry.SpanID != spanID {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+	return matched
+}
+
+// resourceAttributeString returns the value of an OpenChoreo pod label recorded as a
+// span resource attribute, either under the label key itself or under the
+// k8s.pod.labels.<key> name used by the OpenTelemetry k8sattributes processor.
+func resourceAttributeString(attrs map[string]interface{}, labelKey string) string {
+	for _, key := range []string{labelKey, "k8s.pod.labels." + labelKey} {
+		if v, ok := attrs[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// spanLogScope derives the component logs scope of a span from its resource attributes.
+// ok is false when the span does not identify its OpenChoreo namespace.
+func spanLogScope(attrs map[string]interface{}) (params observability.ComponentApplicationLogsParams, ok bool) {
+	params = observability.ComponentApplicationLogsParams{
+		Namespace:     resourceAttributeString(attrs, labels.NamespaceName),
+		ProjectID:     resourceAttributeString(attrs, labels.ProjectID),
+		ComponentID:   resourceAttributeString(attrs, labels.ComponentID),
+		EnvironmentID: resourceAttributeString(attrs, labels.EnvironmentID),
+	}
+	return params, params.Namespace != ""
+}
+
+// spanLogAuthzScope derives the scope used to authorize viewing the logs of a span
+// from its resource attributes. Names the span does not carry widen the check to
+// the enclosing resource.
+func spanLogAuthzScope(attrs map[string]interface{}) types.ComponentSearchScope {
+	return types.ComponentSearchScope{
+		Namespace:   resourceAttributeString(attrs, labels.NamespaceName),
+		Project:     resourceAttributeString(attrs, labels.ProjectName),
+		Component:   resourceAttributeString(attrs, labels.ComponentName),
+		Environment: resourceAttributeString(attrs, labels.EnvironmentName),
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+const (
+	sampleTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	sampleSpanID  = "00f067aa0ba902b7"
+)
+
+func TestExtractTraceContext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		line        string
+		wantTraceID string
+		wantSpanID  string
+	}{
+		{
+			name:        "json snake case",
+			line:        `{"level":"info","msg":"handled","trace_id":"` + sampleTraceID + `","span_id":"` + sampleSpanID + `"}`,
+			wantTraceID: sampleTraceID,
+			wantSpanID:  sampleSpanID,
+		},
+		{
+			name:        "json camel case",
+			line:        `{"traceId":"` + sampleTraceID + `","spanId":"` + sampleSpanID + `"}`,
+			wantTraceID: sampleTraceID,
+			wantSpanID:  sampleSpanID,
+		},
+		{
+			name:        "logfmt with uppercase hex",
+			line:        `level=info trace.id=4BF92F3577B34DA6A3CE929D0E0E4736 span.id=00F067AA0BA902B7 msg=ok`,
+			wantTraceID: sampleTraceID,
+			wantSpanID:  sampleSpanID,
+		},
+		{
+			name:        "traceparent header",
+			line:        `forwarding request traceparent=00-` + sampleTraceID + `-` + sampleSpanID + `-01`,
+			wantTraceID: sampleTraceID,
+			wantSpanID:  sampleSpanID,
+		},
+		{
+			name:        "trace id only",
+			line:        `trace_id=` + sampleTraceID + ` request done`,
+			wantTraceID: sampleTraceID,
+		},
+		{
+			name: "all-zero trace id is invalid",
+			line: `trace_id=00000000000000000000000000000000 span_id=0000000000000000`,
+		},
+		{
+			name: "no trace context",
+			line: "GET /healthz 200",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			traceID, spanID := extractTraceContext(tt.line)
+			assert.Equal(t, tt.wantTraceID, traceID)
+			assert.Equal(t, tt.wantSpanID, spanID)
+		})
+	}
+}
+
+func TestCorrelatedLogs(t *testing.T) {
+	t.Parallel()
+
+	otherTraceID := "0af7651916cd43dd8448eb211c80319c"
+	logs := []observability.LogEntry{
+		{Log: "span match", TraceID: sampleTraceID, SpanID: sampleSpanID},
+		{Log: "other span", TraceID: sampleTraceID, SpanID: "b7ad6b7169203331"},
+		{Log: "other trace mentions " + sampleTraceID, TraceID: otherTraceID},
+		{Log: "unstructured " + sampleTraceID},
+		{Log: "unrelated"},
+	}
+
+	t.Run("trace only", func(t *testing.T) {
+		t.Parallel()
+		got := correlatedLogs(logs, strings.ToUpper(sampleTraceID), "")
+		assert.Equal(t, []string{"span match", "other span", "unstructured " + sampleTraceID}, logLines(got))
+	})
+
+	t.Run("trace and span", func(t *testing.T) {
+		t.Parallel()
+		got := correlatedLogs(logs, sampleTraceID, sampleSpanID)
+		assert.Equal(t, []string{"span match"}, logLines(got))
+	})
+}
+
+func TestSpanLogScope(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pod label attributes", func(t *testing.T) {
+		t.Parallel()
+		params, ok := spanLogScope(map[string]interface{}{
+			"k8s.pod.labels." + labels.NamespaceName: "ns",
+			"k8s.pod.labels." + labels.ProjectID:     sampleProjectUID,
+			labels.ComponentID:                       sampleComponentUID,
+			labels.EnvironmentID:                     sampleEnvironmentUID,
+		})
+		assert.True(t, ok)
+		assert.Equal(t, "ns", params.Namespace)
+		assert.Equal(t, sampleProjectUID, params.ProjectID)
+		assert.Equal(t, sampleComponentUID, params.ComponentID)
+		assert.Equal(t, sampleEnvironmentUID, params.EnvironmentID)
+	})
+
+	t.Run("missing namespace", func(t *testing.T) {
+		t.Parallel()
+		_, ok := spanLogScope(map[string]interface{}{labels.ComponentID: sampleComponentUID})
+		assert.False(t, ok)
+	})
+}
+
+func logLines(logs []observability.LogEntry) []string {
+	lines := make([]string, 0, len(logs))
+	for _, l := range logs {
+		lines = append(lines, l.Log)
+	}
+	return lines
+}
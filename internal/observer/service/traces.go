@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 	"github.com/openchoreo/openchoreo/internal/observer/types"
 	"github.com/openchoreo/openchoreo/pkg/observability"
@@ -25,13 +27,22 @@ var (
 
 type TracesService struct {
 	tracingAdapter observability.TracingAdapter
-	config         *config.Config
-	resolver       *ResourceUIDResolver
-	logger         *slog.Logger
+	// logsAdapter is used to count the logs correlated with a span; optional
+	logsAdapter observability.LogsAdapter
+	// logsPDP authorizes the correlated log count like the logs endpoints; nil disables the check
+	logsPDP  authzcore.PDP
+	config   *config.Config
+	resolver *ResourceUIDResolver
+	logger   *slog.Logger
 }
 
+// NewTracesService creates a new TracesService. logsAdapter may be nil, in which
+// case span details do not include a correlated log count. The count is only
+// included for callers logsPDP allows to view the logs of the span.
 func NewTracesService(
 	tracingAdapter observability.TracingAdapter,
+	logsAdapter observability.LogsAdapter,
+	logsPDP authzcore.PDP,
 	resolver *ResourceUIDResolver,
 	cfg *config.Config,
 	logger *slog.Logger,
@@ -41,6 +52,8 @@ func NewTracesService(
 	}
 	return &TracesService{
 		tracingAdapter: tracingAdapter,
+		logsAdapter:    logsAdapter,
+		logsPDP:        logsPDP,
 		config:         cfg,
 		resolver:       resolver,
 		logger:         logger,
@@ -84,13 +97,23 @@ func (s *TracesService) QueryTraces(ctx context.Context, req *types.TracesQueryR
 }
 
 func (s *TracesService) resolveSearchScope(ctx context.Context, scope *types.ComponentSearchScope) (projectUID, componentUID, environmentUID string, err error) {
+	return resolveComponentScopeUIDs(ctx, s.resolver, scope)
+}
+
+// resolveComponentScopeUIDs resolves the optional project, component and environment of a
+// component search scope to UIDs. Fields left empty in the scope resolve to empty UIDs.
+func resolveComponentScopeUIDs(
+	ctx context.Context,
+	resolver *ResourceUIDResolver,
+	scope *types.ComponentSearchScope,
+) (projectUID, componentUID, environmentUID string, err error) {
 	// Guard against nil resolver when scope fields are provided
-	if s.resolver == nil && (scope.Project != "" || scope.Component != "" || scope.Environment != "") {
+	if resolver == nil && (scope.Project != "" || scope.Component != "" || scope.Environment != "") {
 		return "", "", "", fmt.Errorf("%w: resolver not initialized", ErrTracesResolveSearchScope)
 	}
 
 	if scope.Project != "" {
-		projectUID, err = s.resolver.GetProjectUID(ctx, scope.Namespace, scope.Project)
+		projectUID, err = resolver.GetProjectUID(ctx, scope.Namespace, scope.Project)
 		if err != nil {
 			return "", "", "", fmt.Errorf("failed to resolve project UID: %w", err)
 		}
@@ -100,14 +123,14 @@ func (s *TracesService) resolveSearchScope(ctx context.Context, scope *types.Com
 		if scope.Project == "" {
 			return "", "", "", fmt.Errorf("%w: component specified without project", ErrTracesResolveSearchScope)
 		}
-		componentUID, err = s.resolver.GetComponentUID(ctx, scope.Namespace, scope.Project, scope.Component)
+		componentUID, err = resolver.GetComponentUID(ctx, scope.Namespace, scope.Project, scope.Component)
 		if err != nil {
 			return "", "", "", fmt.Errorf("failed to resolve component UID: %w", err)
 		}
 	}
 
 	if scope.Environment != "" {
-		environmentUID, err = s.resolver.GetEnvironmentUID(ctx, scope.Namespace, scope.Environment)
+		environmentUID, err = resolver.GetEnvironmentUID(ctx, scope.Namespace, scope.Environment)
 		if err != nil {
 			return "", "", "", fmt.Errorf("failed to resolve environment UID: %w", err)
 		}
@@ -190,9 +213,54 @@ func (s *TracesService) GetSpanDetails(ctx context.Context, traceID string, span
 		Status:             detail.Status,
 		Attributes:         detail.Attributes,
 		ResourceAttributes: detail.ResourceAttributes,
+		CorrelatedLogCount: s.countCorrelatedLogs(ctx, traceID, detail),
 	}, nil
 }
 
+// countCorrelatedLogs returns the number of log lines emitted within a span, or nil when
+// the count is unavailable. Failures are logged rather than returned so that span details
+// remain available when the logs backend is not.
+func (s *TracesService) countCorrelatedLogs(ctx context.Context, traceID string, detail *observability.SpanDetail) *int {
+	if s.logsAdapter == nil {
+		return nil
+	}
+	params, ok := spanLogScope(detail.ResourceAttributes)
+	if !ok {
+		s.logger.Debug("Span has no OpenChoreo namespace, skipping log correlation",
+			"traceId", traceID,
+			"spanId", detail.SpanID)
+		return nil
+	}
+
+	// Callers who may view the trace but not its logs must not learn how many there are
+	if err := checkComponentLogsAccess(ctx, s.logger, s.logsPDP, spanLogAuthzScope(detail.ResourceAttributes)); err != nil {
+		s.logger.Debug("Not authorized to view the logs of the span, skipping log correlation",
+			"traceId", traceID,
+			"spanId", detail.SpanID,
+			"error", err)
+		return nil
+	}
+
+	// Log timestamps are commonly truncated to the second, so widen the window accordingly
+	params.StartTime = detail.StartTime.Add(-time.Second)
+	params.EndTime = detail.EndTime.Add(time.Second)
+	params.TraceID = traceID
+	params.SpanID = detail.SpanID
+	params.Limit = config.MaxLimit
+	params.SortOrder = correlatedLogsSortOrder
+
+	result, err := s.logsAdapter.GetComponentApplicationLogs(ctx, params)
+	if err != nil {
+		s.logger.Warn("Failed to count correlated logs", "traceId", traceID, "spanId", detail.SpanID, "error", err)
+		return nil
+	}
+	if result == nil {
+		return nil
+	}
+	count := len(correlatedLogs(result.Logs, traceID, detail.SpanID))
+	return &count
+}
+
 func (s *TracesService) convertToResponse(result *observability.TracesQueryResult) *types.TracesQueryResponse {
 	traces := make([]types.TraceInfo, len(result.Traces))
 	for i, trace := range result.Traces {
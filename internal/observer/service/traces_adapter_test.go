@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
 	"github.com/openchoreo/openchoreo/internal/observer/types"
 	"github.com/openchoreo/openchoreo/pkg/observability"
 )
@@ -68,6 +69,8 @@ func newTracesServiceForTest(t *testing.T, adapter observability.TracingAdapter)
 	t.Helper()
 	svc, err := NewTracesService(
 		adapter,
+		nil, // logs adapter — span log correlation disabled
+		nil, // logs PDP
 		nil, // resolver — not used when scope filters are empty
 		&config.Config{},
 		slog.New(slog.NewTextHandler(io.Discard, nil)),
@@ -81,14 +84,14 @@ func TestNewTracesService(t *testing.T) {
 
 	t.Run("rejects nil adapter", func(t *testing.T) {
 		t.Parallel()
-		_, err := NewTracesService(nil, nil, &config.Config{},
+		_, err := NewTracesService(nil, nil, nil, nil, &config.Config{},
 			slog.New(slog.NewTextHandler(io.Discard, nil)))
 		require.Error(t, err)
 	})
 
 	t.Run("accepts non-nil adapter", func(t *testing.T) {
 		t.Parallel()
-		svc, err := NewTracesService(&fakeTracingAdapter{}, nil, &config.Config{},
+		svc, err := NewTracesService(&fakeTracingAdapter{}, nil, nil, nil, &config.Config{},
 			slog.New(slog.NewTextHandler(io.Discard, nil)))
 		require.NoError(t, err)
 		require.NotNil(t, svc)
@@ -207,7 +210,7 @@ func TestTracesService_QueryTraces_WithResolver_Success(t *testing.T) {
 			Took:       3,
 		},
 	}
-	svc, err := NewTracesService(adapter, resolver, &config.Config{},
+	svc, err := NewTracesService(adapter, nil, nil, resolver, &config.Config{},
 		slog.New(slog.NewTextHandler(io.Discard, nil)))
 	require.NoError(t, err)
 
@@ -239,7 +242,7 @@ func TestTracesService_QueryTraces_ComponentWithoutProject(t *testing.T) {
 	defer apiSrv.Close()
 	resolver := newTestResolver(t, apiSrv, tokenSrv, &config.UIDResolverConfig{MaxAuthRetry: 1})
 
-	svc, err := NewTracesService(&fakeTracingAdapter{}, resolver, &config.Config{},
+	svc, err := NewTracesService(&fakeTracingAdapter{}, nil, nil, resolver, &config.Config{},
 		slog.New(slog.NewTextHandler(io.Discard, nil)))
 	require.NoError(t, err)
 
@@ -386,6 +389,73 @@ func TestTracesService_GetSpanDetails_Success(t *testing.T) {
 	assert.Equal(t, "failed to initialize connection to database", resp.Status.Message)
 }
 
+func TestTracesService_GetSpanDetails_CorrelatedLogCount(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	tracing := &fakeTracingAdapter{
+		spanDetailResult: &observability.SpanDetail{
+			SpanID:    sampleSpanID,
+			StartTime: now,
+			EndTime:   now.Add(time.Second),
+			ResourceAttributes: map[string]interface{}{
+				"k8s.pod.labels." + labels.NamespaceName: "ns",
+				"k8s.pod.labels." + labels.ComponentID:   sampleComponentUID,
+			},
+		},
+	}
+	logs := &fakeLogsAdapter{
+		componentResult: &observability.ComponentApplicationLogsResult{
+			Logs: []observability.LogEntry{
+				{Log: "a", TraceID: sampleTraceID, SpanID: sampleSpanID},
+				{Log: "b", TraceID: sampleTraceID, SpanID: sampleSpanID},
+				{Log: "c", TraceID: sampleTraceID, SpanID: "b7ad6b7169203331"},
+			},
+		},
+	}
+	svc, err := NewTracesService(tracing, logs, nil, nil, &config.Config{},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+
+	resp, err := svc.GetSpanDetails(context.Background(), sampleTraceID, sampleSpanID)
+	require.NoError(t, err)
+	require.NotNil(t, resp.CorrelatedLogCount)
+	assert.Equal(t, 2, *resp.CorrelatedLogCount)
+	assert.Equal(t, "ns", logs.lastComponent.Namespace)
+	assert.Equal(t, sampleComponentUID, logs.lastComponent.ComponentID)
+	assert.Equal(t, sampleTraceID, logs.lastComponent.TraceID)
+	assert.Equal(t, sampleSpanID, logs.lastComponent.SpanID)
+	assert.Empty(t, logs.lastComponent.SearchPhrase)
+	assert.True(t, logs.lastComponent.StartTime.Before(now))
+
+	// Log correlation is best-effort and never fails the span lookup
+	logs.componentErr = errors.New("logs backend down")
+	resp, err = svc.GetSpanDetails(context.Background(), sampleTraceID, sampleSpanID)
+	require.NoError(t, err)
+	assert.Nil(t, resp.CorrelatedLogCount)
+}
+
+func TestTracesService_GetSpanDetails_CorrelatedLogCountRequiresLogsAccess(t *testing.T) {
+	t.Parallel()
+	tracing := &fakeTracingAdapter{
+		spanDetailResult: &observability.SpanDetail{
+			SpanID: sampleSpanID,
+			ResourceAttributes: map[string]interface{}{
+				"k8s.pod.labels." + labels.NamespaceName: "ns",
+				"k8s.pod.labels." + labels.ComponentName: "api",
+			},
+		},
+	}
+	logs := &fakeLogsAdapter{componentResult: &observability.ComponentApplicationLogsResult{}}
+	svc, err := NewTracesService(tracing, logs, mockPDPDeny(t), nil, &config.Config{},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+
+	resp, err := svc.GetSpanDetails(authedCtx(), sampleTraceID, sampleSpanID)
+	require.NoError(t, err)
+	assert.Nil(t, resp.CorrelatedLogCount)
+	assert.False(t, logs.componentCalled, "the logs backend must not be queried without logs access")
+}
+
 func TestTracesService_GetSpanDetails_NotFoundPassthrough(t *testing.T) {
 	t.Parallel()
 	adapter := &fakeTracingAdapter{spanDetailErr: ErrSpanNotFound}
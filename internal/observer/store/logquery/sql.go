@@ -0,0 +1,723 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logquery
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+const initializeTimeout = 30 * time.Second
+
+type sqlStore struct {
+	db      *sql.DB
+	backend string
+	dsn     string
+	logger  *slog.Logger
+}
+
+func newSQLStore(backend, dsn string, logger *slog.Logger) (Store, error) {
+	driver := "sqlite"
+	if backend == BackendPostgreSQL {
+		driver = "pgx"
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log query store: %w", err)
+	}
+	return &sqlStore{
+		db:      db,
+		backend: backend,
+		dsn:     dsn,
+		logger:  logger,
+	}, nil
+}
+
+func (s *sqlStore) Initialize(ctx context.Context) error {
+	initCtx, cancel := context.WithTimeout(ctx, initializeTimeout)
+	defer cancel()
+
+	if s.backend == BackendSQLite {
+		s.db.SetMaxOpenConns(1)
+		if err := s.enableSQLiteWAL(initCtx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.db.PingContext(initCtx); err != nil {
+		return fmt.Errorf("failed to ping log query store: %w", err)
+	}
+	for _, stmt := range []string{
+		createSavedQueriesTableQuery,
+		createLogMetricsTableQuery,
+		createLogMetricSamplesTableQuery,
+		createLogMetricSamplesTimestampIndexQuery,
+		createAlertRulesTableQuery,
+		createAlertRulesMetricIndexQuery,
+	} {
+		if _, err := s.db.ExecContext(initCtx, stmt); err != nil {
+			return fmt.Errorf("failed to initialize log query store schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// ---- Saved queries ----
+
+func (s *sqlStore) CreateSavedQuery(ctx context.Context, query *SavedQuery) error {
+	if query == nil {
+		return fmt.Errorf("saved query is required")
+	}
+	levels, err := encodeLogLevels(query.LogLevels)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	id := uuid.NewString()
+	result, err := s.db.ExecContext(ctx, s.rebind(insertSavedQueryQuery),
+		id, query.NamespaceName, query.ProjectName, query.Name, query.Description,
+		query.ComponentName, query.EnvironmentName, query.SearchPhrase, levels,
+		now.UnixNano(), now.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert saved query: %w", err)
+	}
+	if err := requireAffected(result, ErrAlreadyExists); err != nil {
+		return err
+	}
+
+	query.ID = id
+	query.CreatedAt = now
+	query.UpdatedAt = now
+	return nil
+}
+
+func (s *sqlStore) UpdateSavedQuery(ctx context.Context, query *SavedQuery) error {
+	if query == nil {
+		return fmt.Errorf("saved query is required")
+	}
+	levels, err := encodeLogLevels(query.LogLevels)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	result, err := s.db.ExecContext(ctx, s.rebind(updateSavedQueryQuery),
+		query.Description, query.ComponentName, query.EnvironmentName, query.SearchPhrase, levels,
+		now.UnixNano(), query.NamespaceName, query.ProjectName, query.Name,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update saved query: %w", err)
+	}
+	if err := requireAffected(result, ErrNotFound); err != nil {
+		return err
+	}
+	query.UpdatedAt = now
+	return nil
+}
+
+func (s *sqlStore) GetSavedQuery(ctx context.Context, namespace, project, name string) (*SavedQuery, error) {
+	row := s.db.QueryRowContext(ctx, s.rebind(selectSavedQueryColumns+" WHERE namespace_name = ? AND project_name = ? AND name = ?"),
+		namespace, project, name)
+	query, err := scanSavedQuery(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved query: %w", err)
+	}
+	return query, nil
+}
+
+func (s *sqlStore) ListSavedQueries(ctx context.Context, namespace, project string) ([]SavedQuery, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(selectSavedQueryColumns+" WHERE namespace_name = ? AND project_name = ? ORDER BY name"),
+		namespace, project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved queries: %w", err)
+	}
+	defer rows.Close()
+
+	queries := []SavedQuery{}
+	for rows.Next() {
+		query, err := scanSavedQuery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved query: %w", err)
+		}
+		queries = append(queries, *query)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate saved queries: %w", err)
+	}
+	return queries, nil
+}
+
+func (s *sqlStore) DeleteSavedQuery(ctx context.Context, namespace, project, name string) error {
+	result, err := s.db.ExecContext(ctx, s.rebind("DELETE FROM saved_log_queries WHERE namespace_name = ? AND project_name = ? AND name = ?"),
+		namespace, project, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved query: %w", err)
+	}
+	return requireAffected(result, ErrNotFound)
+}
+
+// ---- Log metrics ----
+
+func (s *sqlStore) CreateLogMetric(ctx context.Context, metric *LogMetric) error {
+	if metric == nil {
+		return fmt.Errorf("log metric is required")
+	}
+	levels, err := encodeLogLevels(metric.LogLevels)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	id := uuid.NewString()
+	result, err := s.db.ExecContext(ctx, s.rebind(insertLogMetricQuery),
+		id, metric.NamespaceName, metric.ProjectName, metric.Name, metric.Description,
+		metric.ComponentName, metric.EnvironmentName,
+		metric.ProjectID, metric.ComponentID, metric.EnvironmentID,
+		metric.SearchPhrase, levels, metric.GroupBy, int64(metric.Interval),
+		now.UnixNano(), now.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert log metric: %w", err)
+	}
+	if err := requireAffected(result, ErrAlreadyExists); err != nil {
+		return err
+	}
+
+	metric.ID = id
+	metric.CreatedAt = now
+	metric.UpdatedAt = now
+	return nil
+}
+
+func (s *sqlStore) GetLogMetric(ctx context.Context, namespace, project, name string) (*LogMetric, error) {
+	row := s.db.QueryRowContext(ctx, s.rebind(selectLogMetricColumns+" WHERE namespace_name = ? AND project_name = ? AND name = ?"),
+		namespace, project, name)
+	return s.getLogMetric(row)
+}
+
+func (s *sqlStore) FindLogMetric(ctx context.Context, projectID, name string) (*LogMetric, error) {
+	row := s.db.QueryRowContext(ctx, s.rebind(selectLogMetricColumns+" WHERE project_id = ? AND name = ?"),
+		projectID, name)
+	return s.getLogMetric(row)
+}
+
+func (s *sqlStore) getLogMetric(row *sql.Row) (*LogMetric, error) {
+	metric, err := scanLogMetric(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log metric: %w", err)
+	}
+	return metric, nil
+}
+
+func (s *sqlStore) ListLogMetrics(ctx context.Context, namespace, project string) ([]LogMetric, error) {
+	query := selectLogMetricColumns
+	var args []any
+	if namespace != "" || project != "" {
+		query += " WHERE namespace_name = ? AND project_name = ?"
+		args = append(args, namespace, project)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(query+" ORDER BY namespace_name, project_name, name"), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list log metrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := []LogMetric{}
+	for rows.Next() {
+		metric, err := scanLogMetric(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan log metric: %w", err)
+		}
+		metrics = append(metrics, *metric)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate log metrics: %w", err)
+	}
+	return metrics, nil
+}
+
+func (s *sqlStore) MarkLogMetricEvaluated(ctx context.Context, id string, at time.Time) error {
+	result, err := s.db.ExecContext(ctx, s.rebind("UPDATE log_metrics SET last_evaluated_ns = ? WHERE id = ?"),
+		at.UTC().UnixNano(), id)
+	if err != nil {
+		return fmt.Errorf("failed to mark log metric evaluated: %w", err)
+	}
+	return requireAffected(result, ErrNotFound)
+}
+
+func (s *sqlStore) DeleteLogMetric(ctx context.Context, namespace, project, name string) error {
+	metric, err := s.GetLogMetric(ctx, namespace, project, name)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin log metric deletion: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, s.rebind("DELETE FROM log_metric_samples WHERE metric_id = ?"), metric.ID); err != nil {
+		return fmt.Errorf("failed to delete log metric samples: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, s.rebind("DELETE FROM log_metrics WHERE id = ?"), metric.ID); err != nil {
+		return fmt.Errorf("failed to delete log metric: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit log metric deletion: %w", err)
+	}
+	return nil
+}
+
+// ---- Samples ----
+
+func (s *sqlStore) WriteSamples(ctx context.Context, metricID string, samples []Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin sample write: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.PrepareContext(ctx, s.rebind(upsertSampleQuery))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sample write: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, sample := range samples {
+		if _, err := stmt.ExecContext(ctx, metricID, sample.Timestamp.UTC().UnixNano(), sample.Group, sample.Value); err != nil {
+			return fmt.Errorf("failed to write sample: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit samples: %w", err)
+	}
+	return nil
+}
+
+func (s *sqlStore) QuerySamples(ctx context.Context, metricID string, start, end time.Time) ([]Sample, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(querySamplesQuery),
+		metricID, start.UTC().UnixNano(), end.UTC().UnixNano())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query samples: %w", err)
+	}
+	defer rows.Close()
+
+	samples := []Sample{}
+	for rows.Next() {
+		var sample Sample
+		var tsNS int64
+		if err := rows.Scan(&tsNS, &sample.Group, &sample.Value); err != nil {
+			return nil, fmt.Errorf("failed to scan sample: %w", err)
+		}
+		sample.Timestamp = time.Unix(0, tsNS).UTC()
+		samples = append(samples, sample)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate samples: %w", err)
+	}
+	return samples, nil
+}
+
+func (s *sqlStore) DeleteSamplesBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, s.rebind("DELETE FROM log_metric_samples WHERE timestamp_ns < ?"),
+		before.UTC().UnixNano())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune samples: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pruned samples: %w", err)
+	}
+	return deleted, nil
+}
+
+// ---- Alert rules ----
+
+func (s *sqlStore) UpsertAlertRule(ctx context.Context, rule *AlertRule) error {
+	if rule == nil {
+		return fmt.Errorf("alert rule is required")
+	}
+	now := time.Now().UTC()
+	if _, err := s.db.ExecContext(ctx, s.rebind(upsertAlertRuleQuery),
+		rule.Name, rule.Namespace, rule.LogMetricName,
+		rule.ProjectID, rule.ComponentID, rule.EnvironmentID,
+		rule.Enabled, rule.Operator, rule.Threshold,
+		int64(rule.Window), int64(rule.Interval), now.UnixNano(),
+	); err != nil {
+		return fmt.Errorf("failed to upsert log metric alert rule: %w", err)
+	}
+	rule.UpdatedAt = now
+	return nil
+}
+
+func (s *sqlStore) GetAlertRule(ctx context.Context, namespace, name string) (*AlertRule, error) {
+	row := s.db.QueryRowContext(ctx, s.rebind(selectAlertRuleColumns+" WHERE namespace = ? AND name = ?"), namespace, name)
+	rule, err := scanAlertRule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log metric alert rule: %w", err)
+	}
+	return rule, nil
+}
+
+func (s *sqlStore) ListAlertRules(ctx context.Context, projectID, logMetricName string) ([]AlertRule, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(selectAlertRuleColumns+" WHERE project_id = ? AND log_metric_name = ? ORDER BY namespace, name"),
+		projectID, logMetricName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list log metric alert rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []AlertRule{}
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan log metric alert rule: %w", err)
+		}
+		rules = append(rules, *rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate log metric alert rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *sqlStore) MarkAlertRuleEvaluated(ctx context.Context, namespace, name string, at time.Time) error {
+	result, err := s.db.ExecContext(ctx,
+		s.rebind("UPDATE log_metric_alert_rules SET last_evaluated_ns = ? WHERE namespace = ? AND name = ?"),
+		at.UTC().UnixNano(), namespace, name)
+	if err != nil {
+		return fmt.Errorf("failed to mark log metric alert rule evaluated: %w", err)
+	}
+	return requireAffected(result, ErrNotFound)
+}
+
+func (s *sqlStore) DeleteAlertRule(ctx context.Context, namespace, name string) error {
+	result, err := s.db.ExecContext(ctx, s.rebind("DELETE FROM log_metric_alert_rules WHERE namespace = ? AND name = ?"),
+		namespace, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete log metric alert rule: %w", err)
+	}
+	return requireAffected(result, ErrNotFound)
+}
+
+func (s *sqlStore) Close() error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *sqlStore) enableSQLiteWAL(ctx context.Context) error {
+	if strings.Contains(strings.ToLower(s.dsn), "memory") {
+		// In-memory SQLite does not support WAL; this path is expected in tests.
+		return nil
+	}
+
+	if _, err := s.db.ExecContext(ctx, "PRAGMA journal_mode=WAL;"); err != nil {
+		return fmt.Errorf("failed to enable sqlite WAL mode: %w", err)
+	}
+	return nil
+}
+
+// rebind rewrites the '?' placeholders used by the queries in this file into the
+// positional '$n' form expected by PostgreSQL. None of the queries contain a literal '?'.
+func (s *sqlStore) rebind(query string) string {
+	if s.backend != BackendPostgreSQL {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// requireAffected returns errNone when the statement did not touch any row.
+func requireAffected(result sql.Result, errNone error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return errNone
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSavedQuery(row rowScanner) (*SavedQuery, error) {
+	var query SavedQuery
+	var levels string
+	var createdNS, updatedNS int64
+	if err := row.Scan(
+		&query.ID, &query.NamespaceName, &query.ProjectName, &query.Name, &query.Description,
+		&query.ComponentName, &query.EnvironmentName, &query.SearchPhrase, &levels,
+		&createdNS, &updatedNS,
+	); err != nil {
+		return nil, err
+	}
+	var err error
+	if query.LogLevels, err = decodeLogLevels(levels); err != nil {
+		return nil, err
+	}
+	query.CreatedAt = time.Unix(0, createdNS).UTC()
+	query.UpdatedAt = time.Unix(0, updatedNS).UTC()
+	return &query, nil
+}
+
+func scanLogMetric(row rowScanner) (*LogMetric, error) {
+	var metric LogMetric
+	var levels string
+	var intervalNS, lastEvaluatedNS, createdNS, updatedNS int64
+	if err := row.Scan(
+		&metric.ID, &metric.NamespaceName, &metric.ProjectName, &metric.Name, &metric.Description,
+		&metric.ComponentName, &metric.EnvironmentName,
+		&metric.ProjectID, &metric.ComponentID, &metric.EnvironmentID,
+		&metric.SearchPhrase, &levels, &metric.GroupBy, &intervalNS, &lastEvaluatedNS,
+		&createdNS, &updatedNS,
+	); err != nil {
+		return nil, err
+	}
+	var err error
+	if metric.LogLevels, err = decodeLogLevels(levels); err != nil {
+		return nil, err
+	}
+	metric.Interval = time.Duration(intervalNS)
+	metric.LastEvaluatedAt = unixNanoOrZero(lastEvaluatedNS)
+	metric.CreatedAt = time.Unix(0, createdNS).UTC()
+	metric.UpdatedAt = time.Unix(0, updatedNS).UTC()
+	return &metric, nil
+}
+
+func scanAlertRule(row rowScanner) (*AlertRule, error) {
+	var rule AlertRule
+	var windowNS, intervalNS, lastEvaluatedNS, updatedNS int64
+	if err := row.Scan(
+		&rule.Name, &rule.Namespace, &rule.LogMetricName,
+		&rule.ProjectID, &rule.ComponentID, &rule.EnvironmentID,
+		&rule.Enabled, &rule.Operator, &rule.Threshold,
+		&windowNS, &intervalNS, &lastEvaluatedNS, &updatedNS,
+	); err != nil {
+		return nil, err
+	}
+	rule.Window = time.Duration(windowNS)
+	rule.Interval = time.Duration(intervalNS)
+	rule.LastEvaluatedAt = unixNanoOrZero(lastEvaluatedNS)
+	rule.UpdatedAt = time.Unix(0, updatedNS).UTC()
+	return &rule, nil
+}
+
+func unixNanoOrZero(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns).UTC()
+}
+
+func encodeLogLevels(levels []string) (string, error) {
+	if len(levels) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(levels)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode log levels: %w", err)
+	}
+	return string(b), nil
+}
+
+func decodeLogLevels(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var levels []string
+	if err := json.Unmarshal([]byte(value), &levels); err != nil {
+		return nil, fmt.Errorf("failed to decode log levels: %w", err)
+	}
+	return levels, nil
+}
+
+const createSavedQueriesTableQuery = `
+CREATE TABLE IF NOT EXISTS saved_log_queries (
+	id TEXT PRIMARY KEY,
+	namespace_name TEXT NOT NULL,
+	project_name TEXT NOT NULL,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	component_name TEXT NOT NULL DEFAULT '',
+	environment_name TEXT NOT NULL DEFAULT '',
+	search_phrase TEXT NOT NULL DEFAULT '',
+	log_levels TEXT NOT NULL DEFAULT '',
+	created_at_ns BIGINT NOT NULL,
+	updated_at_ns BIGINT NOT NULL,
+	UNIQUE (namespace_name, project_name, name)
+);`
+
+const createLogMetricsTableQuery = `
+CREATE TABLE IF NOT EXISTS log_metrics (
+	id TEXT PRIMARY KEY,
+	namespace_name TEXT NOT NULL,
+	project_name TEXT NOT NULL,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	component_name TEXT NOT NULL DEFAULT '',
+	environment_name TEXT NOT NULL DEFAULT '',
+	project_id TEXT NOT NULL,
+	component_id TEXT NOT NULL DEFAULT '',
+	environment_id TEXT NOT NULL DEFAULT '',
+	search_phrase TEXT NOT NULL DEFAULT '',
+	log_levels TEXT NOT NULL DEFAULT '',
+	group_by TEXT NOT NULL DEFAULT '',
+	interval_ns BIGINT NOT NULL,
+	last_evaluated_ns BIGINT NOT NULL DEFAULT 0,
+	created_at_ns BIGINT NOT NULL,
+	updated_at_ns BIGINT NOT NULL,
+	UNIQUE (namespace_name, project_name, name)
+);`
+
+const createLogMetricSamplesTableQuery = `
+CREATE TABLE IF NOT EXISTS log_metric_samples (
+	metric_id TEXT NOT NULL,
+	timestamp_ns BIGINT NOT NULL,
+	group_value TEXT NOT NULL,
+	value DOUBLE PRECISION NOT NULL,
+	PRIMARY KEY (metric_id, timestamp_ns, group_value)
+);`
+
+const createLogMetricSamplesTimestampIndexQuery = `
+CREATE INDEX IF NOT EXISTS idx_log_metric_samples_ts
+ON log_metric_samples(timestamp_ns);`
+
+const createAlertRulesTableQuery = `
+CREATE TABLE IF NOT EXISTS log_metric_alert_rules (
+	name TEXT NOT NULL,
+	namespace TEXT NOT NULL,
+	log_metric_name TEXT NOT NULL,
+	project_id TEXT NOT NULL,
+	component_id TEXT NOT NULL DEFAULT '',
+	environment_id TEXT NOT NULL DEFAULT '',
+	enabled BOOLEAN NOT NULL,
+	operator TEXT NOT NULL,
+	threshold DOUBLE PRECISION NOT NULL,
+	window_ns BIGINT NOT NULL,
+	interval_ns BIGINT NOT NULL,
+	last_evaluated_ns BIGINT NOT NULL DEFAULT 0,
+	updated_at_ns BIGINT NOT NULL,
+	PRIMARY KEY (namespace, name)
+);`
+
+const createAlertRulesMetricIndexQuery = `
+CREATE INDEX IF NOT EXISTS idx_log_metric_alert_rules_metric
+ON log_metric_alert_rules(project_id, log_metric_name);`
+
+const insertSavedQueryQuery = `
+INSERT INTO saved_log_queries (
+	id, namespace_name, project_name, name, description,
+	component_name, environment_name, search_phrase, log_levels,
+	created_at_ns, updated_at_ns
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (namespace_name, project_name, name) DO NOTHING;`
+
+const updateSavedQueryQuery = `
+UPDATE saved_log_queries SET
+	description = ?, component_name = ?, environment_name = ?, search_phrase = ?, log_levels = ?,
+	updated_at_ns = ?
+WHERE namespace_name = ? AND project_name = ? AND name = ?;`
+
+const selectSavedQueryColumns = `
+SELECT id, namespace_name, project_name, name, description,
+	component_name, environment_name, search_phrase, log_levels,
+	created_at_ns, updated_at_ns
+FROM saved_log_queries`
+
+const insertLogMetricQuery = `
+INSERT INTO log_metrics (
+	id, namespace_name, project_name, name, description,
+	component_name, environment_name,
+	project_id, component_id, environment_id,
+	search_phrase, log_levels, group_by, interval_ns,
+	created_at_ns, updated_at_ns
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (namespace_name, project_name, name) DO NOTHING;`
+
+const selectLogMetricColumns = `
+SELECT id, namespace_name, project_name, name, description,
+	component_name, environment_name,
+	project_id, component_id, environment_id,
+	search_phrase, log_levels, group_by, interval_ns, last_evaluated_ns,
+	created_at_ns, updated_at_ns
+FROM log_metrics`
+
+const upsertSampleQuery = `
+INSERT INTO log_metric_samples (metric_id, timestamp_ns, group_value, value)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (metric_id, timestamp_ns, group_value) DO UPDATE SET value = excluded.value;`
+
+const querySamplesQuery = `
+SELECT timestamp_ns, group_value, value
+FROM log_metric_samples
+WHERE metric_id = ? AND timestamp_ns >= ? AND timestamp_ns <= ?
+ORDER BY timestamp_ns ASC, group_value ASC;`
+
+const upsertAlertRuleQuery = `
+INSERT INTO log_metric_alert_rules (
+	name, namespace, log_metric_name,
+	project_id, component_id, environment_id,
+	enabled, operator, threshold,
+	window_ns, interval_ns, updated_at_ns
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (namespace, name) DO UPDATE SET
+	log_metric_name = excluded.log_metric_name,
+	project_id = excluded.project_id,
+	component_id = excluded.component_id,
+	environment_id = excluded.environment_id,
+	enabled = excluded.enabled,
+	operator = excluded.operator,
+	threshold = excluded.threshold,
+	window_ns = excluded.window_ns,
+	interval_ns = excluded.interval_ns,
+	updated_at_ns = excluded.updated_at_ns;`
+
+const selectAlertRuleColumns = `
+SELECT name, namespace, log_metric_name,
+	project_id, component_id, environment_id,
+	enabled, operator, threshold,
+	window_ns, interval_ns, last_evaluated_ns, updated_at_ns
+FROM log_metric_alert_rules`
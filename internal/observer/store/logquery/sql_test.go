@@ -0,0 +1,227 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logquery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) Store {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.ReplaceAll(t.Name(), "/", "-"))
+	store, err := New(BackendSQLite, dsn, slog.Default())
+	require.NoError(t, err, "failed to create store")
+	t.Cleanup(func() {
+		require.NoError(t, store.Close(), "failed to close store")
+	})
+	require.NoError(t, store.Initialize(context.Background()), "failed to initialize store")
+	return store
+}
+
+func TestNewRejectsUnknownBackend(t *testing.T) {
+	t.Parallel()
+
+	_, err := New("mysql", "", slog.Default())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported log query store backend")
+}
+
+func TestSQLiteFileInitializeIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "logquery.db")
+	store, err := New(BackendSQLite, dsn, slog.Default())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+
+	ctx := context.Background()
+	require.NoError(t, store.Initialize(ctx))
+	require.NoError(t, store.Initialize(ctx), "schema creation must be repeatable")
+}
+
+func TestSavedQueryLifecycle(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	query := &SavedQuery{
+		NamespaceName: "default",
+		ProjectName:   "commerce",
+		ComponentName: "payments",
+		Name:          "payment-errors",
+		Description:   "Errors from the payment flow",
+		SearchPhrase:  "payment failed",
+		LogLevels:     []string{"ERROR", "WARN"},
+	}
+	require.NoError(t, store.CreateSavedQuery(ctx, query))
+	require.NotEmpty(t, query.ID)
+
+	err := store.CreateSavedQuery(ctx, &SavedQuery{NamespaceName: "default", ProjectName: "commerce", Name: "payment-errors"})
+	require.ErrorIs(t, err, ErrAlreadyExists)
+
+	// Same name in a different project is allowed.
+	require.NoError(t, store.CreateSavedQuery(ctx, &SavedQuery{NamespaceName: "default", ProjectName: "billing", Name: "payment-errors"}))
+
+	got, err := store.GetSavedQuery(ctx, "default", "commerce", "payment-errors")
+	require.NoError(t, err)
+	assert.Equal(t, query.ID, got.ID)
+	assert.Equal(t, "payments", got.ComponentName)
+	assert.Equal(t, []string{"ERROR", "WARN"}, got.LogLevels)
+
+	got.SearchPhrase = "card declined"
+	got.LogLevels = nil
+	require.NoError(t, store.UpdateSavedQuery(ctx, got))
+
+	updated, err := store.GetSavedQuery(ctx, "default", "commerce", "payment-errors")
+	require.NoError(t, err)
+	assert.Equal(t, "card declined", updated.SearchPhrase)
+	assert.Empty(t, updated.LogLevels)
+
+	list, err := store.ListSavedQueries(ctx, "default", "commerce")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	require.NoError(t, store.DeleteSavedQuery(ctx, "default", "commerce", "payment-errors"))
+	_, err = store.GetSavedQuery(ctx, "default", "commerce", "payment-errors")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorIs(t, store.DeleteSavedQuery(ctx, "default", "commerce", "payment-errors"), ErrNotFound)
+	require.ErrorIs(t, store.UpdateSavedQuery(ctx, &SavedQuery{NamespaceName: "default", ProjectName: "commerce", Name: "missing"}), ErrNotFound)
+}
+
+func TestLogMetricSamplesAndDeletion(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	metric := &LogMetric{
+		NamespaceName: "default",
+		ProjectName:   "commerce",
+		ProjectID:     "project-uid",
+		Name:          "errors-by-level",
+		SearchPhrase:  "failed",
+		GroupBy:       "level",
+		Interval:      time.Minute,
+	}
+	require.NoError(t, store.CreateLogMetric(ctx, metric))
+	require.ErrorIs(t, store.CreateLogMetric(ctx, &LogMetric{NamespaceName: "default", ProjectName: "commerce", ProjectID: "project-uid", Name: "errors-by-level", Interval: time.Minute}), ErrAlreadyExists)
+
+	found, err := store.FindLogMetric(ctx, "project-uid", "errors-by-level")
+	require.NoError(t, err)
+	assert.Equal(t, metric.ID, found.ID)
+	assert.Equal(t, time.Minute, found.Interval)
+	assert.True(t, found.LastEvaluatedAt.IsZero())
+
+	evaluatedAt := time.Date(2026, 3, 1, 10, 2, 0, 0, time.UTC)
+	require.NoError(t, store.MarkLogMetricEvaluated(ctx, metric.ID, evaluatedAt))
+
+	all, err := store.ListLogMetrics(ctx, "", "")
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.True(t, evaluatedAt.Equal(all[0].LastEvaluatedAt))
+
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, store.WriteSamples(ctx, metric.ID, []Sample{
+		{Timestamp: base, Group: "ERROR", Value: 3},
+		{Timestamp: base, Group: "WARN", Value: 1},
+		{Timestamp: base.Add(time.Minute), Group: "ERROR", Value: 5},
+	}))
+	// Re-evaluating a bucket overwrites it instead of duplicating it.
+	require.NoError(t, store.WriteSamples(ctx, metric.ID, []Sample{{Timestamp: base, Group: "ERROR", Value: 4}}))
+
+	samples, err := store.QuerySamples(ctx, metric.ID, base, base.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, samples, 3)
+	assert.Equal(t, Sample{Timestamp: base, Group: "ERROR", Value: 4}, samples[0])
+	assert.Equal(t, "WARN", samples[1].Group)
+
+	pruned, err := store.DeleteSamplesBefore(ctx, base.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pruned)
+
+	require.NoError(t, store.DeleteLogMetric(ctx, "default", "commerce", "errors-by-level"))
+	samples, err = store.QuerySamples(ctx, metric.ID, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, samples, "samples must be removed with their metric")
+	require.ErrorIs(t, store.DeleteLogMetric(ctx, "default", "commerce", "errors-by-level"), ErrNotFound)
+}
+
+func TestAlertRuleUpsert(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	rule := &AlertRule{
+		Name:          "payments-error-spike",
+		Namespace:     "default",
+		LogMetricName: "errors-by-level",
+		ProjectID:     "project-uid",
+		ComponentID:   "component-uid",
+		Enabled:       true,
+		Operator:      "gt",
+		Threshold:     10,
+		Window:        5 * time.Minute,
+		Interval:      time.Minute,
+	}
+	require.NoError(t, store.UpsertAlertRule(ctx, rule))
+
+	rule.Threshold = 20
+	require.NoError(t, store.UpsertAlertRule(ctx, rule))
+
+	// A rule with the same name in another namespace is a different rule
+	other := *rule
+	other.Namespace = "team-b"
+	other.Threshold = 99
+	require.NoError(t, store.UpsertAlertRule(ctx, &other))
+
+	got, err := store.GetAlertRule(ctx, "default", "payments-error-spike")
+	require.NoError(t, err)
+	assert.InDelta(t, 20, got.Threshold, 0)
+	assert.Equal(t, 5*time.Minute, got.Window)
+	assert.True(t, got.Enabled)
+	_, err = store.GetAlertRule(ctx, "team-c", "payments-error-spike")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, store.MarkAlertRuleEvaluated(ctx, rule.Namespace, rule.Name, at))
+
+	rules, err := store.ListAlertRules(ctx, "project-uid", "errors-by-level")
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "default", rules[0].Namespace)
+	assert.True(t, at.Equal(rules[0].LastEvaluatedAt))
+	assert.Equal(t, "team-b", rules[1].Namespace)
+	assert.True(t, rules[1].LastEvaluatedAt.IsZero())
+
+	require.NoError(t, store.DeleteAlertRule(ctx, rule.Namespace, rule.Name))
+	_, err = store.GetAlertRule(ctx, rule.Namespace, rule.Name)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorIs(t, store.DeleteAlertRule(ctx, rule.Namespace, rule.Name), ErrNotFound)
+
+	got, err = store.GetAlertRule(ctx, "team-b", "payments-error-spike")
+	require.NoError(t, err)
+	assert.InDelta(t, 99, got.Threshold, 0)
+}
+
+func TestRebindForPostgres(t *testing.T) {
+	t.Parallel()
+
+	s := &sqlStore{backend: BackendPostgreSQL}
+	assert.Equal(t, "SELECT 1 WHERE a = $1 AND b = $2", s.rebind("SELECT 1 WHERE a = ? AND b = ?"))
+
+	s.backend = BackendSQLite
+	assert.Equal(t, "a = ?", s.rebind("a = ?"))
+}
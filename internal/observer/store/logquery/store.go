@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const (
+	BackendSQLite     = "sqlite"
+	BackendPostgreSQL = "postgresql"
+)
+
+// ErrNotFound is returned when the requested saved query, log metric or alert rule does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrAlreadyExists is returned when creating a saved query or log metric whose name is already taken
+// within the project.
+var ErrAlreadyExists = errors.New("already exists")
+
+// SavedQuery is a named logs query persisted for a project. ComponentName and
+// EnvironmentName optionally narrow the query further.
+type SavedQuery struct {
+	ID              string
+	NamespaceName   string
+	ProjectName     string
+	ComponentName   string
+	EnvironmentName string
+	Name            string
+	Description     string
+	SearchPhrase    string
+	LogLevels       []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// LogMetric is a logs query plus a group-by that is evaluated every Interval into a
+// time series of matching log counts. The resolved UIDs are kept alongside the names so
+// evaluation and alert rule matching do not depend on the resolver.
+type LogMetric struct {
+	ID              string
+	NamespaceName   string
+	ProjectName     string
+	ComponentName   string
+	EnvironmentName string
+	ProjectID       string
+	ComponentID     string
+	EnvironmentID   string
+	Name            string
+	Description     string
+	SearchPhrase    string
+	LogLevels       []string
+	GroupBy         string
+	Interval        time.Duration
+	// LastEvaluatedAt is the end of the last evaluated bucket; zero if never evaluated.
+	LastEvaluatedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Sample is one evaluated bucket of a log metric. Group is empty for ungrouped metrics.
+type Sample struct {
+	Timestamp time.Time
+	Group     string
+	Value     float64
+}
+
+// AlertRule is an alert rule whose source is a log metric. The observer evaluates these
+// itself instead of delegating to an adapter. Rules are identified by namespace and name,
+// like the ObservabilityAlertRule resources they are synced from.
+type AlertRule struct {
+	Name            string
+	Namespace       string
+	LogMetricName   string
+	ProjectID       string
+	ComponentID     string
+	EnvironmentID   string
+	Enabled         bool
+	Operator        string
+	Threshold       float64
+	Window          time.Duration
+	Interval        time.Duration
+	LastEvaluatedAt time.Time
+	UpdatedAt       time.Time
+}
+
+// Store persists saved queries, log metrics, their samples and the alert rules built on them.
+type Store interface {
+	Initialize(ctx context.Context) error
+
+	CreateSavedQuery(ctx context.Context, query *SavedQuery) error
+	UpdateSavedQuery(ctx context.Context, query *SavedQuery) error
+	GetSavedQuery(ctx context.Context, namespace, project, name string) (*SavedQuery, error)
+	ListSavedQueries(ctx context.Context, namespace, project string) ([]SavedQuery, error)
+	DeleteSavedQuery(ctx context.Context, namespace, project, name string) error
+
+	CreateLogMetric(ctx context.Context, metric *LogMetric) error
+	GetLogMetric(ctx context.Context, namespace, project, name string) (*LogMetric, error)
+	// FindLogMetric looks a log metric up by project UID, which is all alert rules carry.
+	FindLogMetric(ctx context.Context, projectID, name string) (*LogMetric, error)
+	// ListLogMetrics lists the log metrics of a project, or of every project when both
+	// namespace and project are empty.
+	ListLogMetrics(ctx context.Context, namespace, project string) ([]LogMetric, error)
+	MarkLogMetricEvaluated(ctx context.Context, id string, at time.Time) error
+	// DeleteLogMetric removes the log metric together with its samples.
+	DeleteLogMetric(ctx context.Context, namespace, project, name string) error
+
+	// WriteSamples upserts samples, so re-evaluating a bucket overwrites its value.
+	WriteSamples(ctx context.Context, metricID string, samples []Sample) error
+	QuerySamples(ctx context.Context, metricID string, start, end time.Time) ([]Sample, error)
+	DeleteSamplesBefore(ctx context.Context, before time.Time) (int64, error)
+
+	UpsertAlertRule(ctx context.Context, rule *AlertRule) error
+	GetAlertRule(ctx context.Context, namespace, name string) (*AlertRule, error)
+	ListAlertRules(ctx context.Context, projectID, logMetricName string) ([]AlertRule, error)
+	MarkAlertRuleEvaluated(ctx context.Context, namespace, name string, at time.Time) error
+	DeleteAlertRule(ctx context.Context, namespace, name string) error
+
+	Close() error
+}
+
+// New creates a concrete log query store for the configured backend.
+func New(backend, dsn string, logger *slog.Logger) (Store, error) {
+	selected := strings.ToLower(strings.TrimSpace(backend))
+	if selected == "" {
+		selected = BackendSQLite
+	}
+
+	switch selected {
+	case BackendSQLite, BackendPostgreSQL:
+		return newSQLStore(selected, dsn, logger)
+	default:
+		return nil, fmt.Errorf("unsupported log query store backend %q: use %q or %q", selected, BackendSQLite, BackendPostgreSQL)
+	}
+}
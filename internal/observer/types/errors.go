@@ -11,6 +11,7 @@ const (
 	ErrorCodeV1LogsServiceNotReady = "OBS-V1-L-03"
 	ErrorCodeV1LogsResolverFailed  = "OBS-V1-L-04"
 	ErrorCodeV1LogsRetrievalFailed = "OBS-V1-L-05"
+	ErrorCodeV1LogsStreamingFailed = "OBS-V1-L-06"
 
 	// Events API (v1) internal server error codes.
 	ErrorCodeV1EventsInternalGeneric = "OBS-V1-E-01"
@@ -43,6 +44,13 @@ const (
 	ErrorCodeV1RuntimeTopologyResolverFailed  = "OBS-V1-RG-04"
 	ErrorCodeV1RuntimeTopologyRetrievalFailed = "OBS-V1-RG-05"
 
+	// Saved log queries and log metrics API (v1alpha1) error codes.
+	ErrorCodeV1LogQueriesInternalGeneric = "OBS-V1-LQ-01"
+	ErrorCodeV1LogQueriesServiceNotReady = "OBS-V1-LQ-03"
+	ErrorCodeV1LogQueriesResolverFailed  = "OBS-V1-LQ-04"
+	ErrorCodeV1LogQueriesNotFound        = "OBS-V1-LQ-06"
+	ErrorCodeV1LogQueriesAlreadyExists   = "OBS-V1-LQ-07"
+
 	// Scope resolution auth failure — shared across all APIs.
 	ErrorCodeV1ScopeAuthFailed = "OBS-V1-SCOPE-AUTH-FAILED"
 )
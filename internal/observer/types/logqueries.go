@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// Log metric group-by values. An empty group-by counts all matching logs as one series.
+const (
+	LogMetricGroupByLevel       = "level"
+	LogMetricGroupByComponent   = "component"
+	LogMetricGroupByEnvironment = "environment"
+	LogMetricGroupByPod         = "pod"
+	LogMetricGroupByContainer   = "container"
+)
+
+// SavedLogQueryRequest is the request body for creating or replacing a saved logs query.
+// Namespace and Project come from the request path.
+// Matches OpenAPI SavedLogQueryRequest schema
+type SavedLogQueryRequest struct {
+	Namespace string `json:"-"`
+	Project   string `json:"-"`
+
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Component    string   `json:"component,omitempty"`
+	Environment  string   `json:"environment,omitempty"`
+	SearchPhrase string   `json:"searchPhrase,omitempty"`
+	LogLevels    []string `json:"logLevels,omitempty"`
+}
+
+// SavedLogQuery is a saved logs query.
+// Matches OpenAPI SavedLogQuery schema
+type SavedLogQuery struct {
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	Namespace    string    `json:"namespace"`
+	Project      string    `json:"project"`
+	Component    string    `json:"component,omitempty"`
+	Environment  string    `json:"environment,omitempty"`
+	SearchPhrase string    `json:"searchPhrase,omitempty"`
+	LogLevels    []string  `json:"logLevels,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// SavedLogQueryList is the response for listing the saved queries of a project.
+type SavedLogQueryList struct {
+	Queries []SavedLogQuery `json:"queries"`
+}
+
+// SavedLogQueryRunRequest is the request body for running a saved query over a time range.
+// Namespace, Project and Name come from the request path.
+// Matches OpenAPI SavedLogQueryRunRequest schema
+type SavedLogQueryRunRequest struct {
+	Namespace string `json:"-"`
+	Project   string `json:"-"`
+	Name      string `json:"-"`
+
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+	Limit     int    `json:"limit,omitempty"`
+	SortOrder string `json:"sortOrder,omitempty"`
+}
+
+// LogMetricRequest is the request body for creating a log metric. When SavedQuery is set,
+// the scope and filters are copied from that saved query and must not be repeated.
+// Namespace and Project come from the request path.
+// Matches OpenAPI LogMetricRequest schema
+type LogMetricRequest struct {
+	Namespace string `json:"-"`
+	Project   string `json:"-"`
+
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	SavedQuery   string   `json:"savedQuery,omitempty"`
+	Component    string   `json:"component,omitempty"`
+	Environment  string   `json:"environment,omitempty"`
+	SearchPhrase string   `json:"searchPhrase,omitempty"`
+	LogLevels    []string `json:"logLevels,omitempty"`
+	GroupBy      string   `json:"groupBy,omitempty"`
+	// Interval is the evaluation interval and the width of each sample bucket, e.g. "1m".
+	Interval string `json:"interval"`
+}
+
+// LogMetric is a log-derived metric.
+// Matches OpenAPI LogMetric schema
+type LogMetric struct {
+	Name            string     `json:"name"`
+	Description     string     `json:"description,omitempty"`
+	Namespace       string     `json:"namespace"`
+	Project         string     `json:"project"`
+	Component       string     `json:"component,omitempty"`
+	Environment     string     `json:"environment,omitempty"`
+	SearchPhrase    string     `json:"searchPhrase,omitempty"`
+	LogLevels       []string   `json:"logLevels,omitempty"`
+	GroupBy         string     `json:"groupBy,omitempty"`
+	Interval        string     `json:"interval"`
+	LastEvaluatedAt *time.Time `json:"lastEvaluatedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+// LogMetricList is the response for listing the log metrics of a project.
+type LogMetricList struct {
+	Metrics []LogMetric `json:"metrics"`
+}
+
+// LogMetricsQueryResponse is the response for metric="log" queries. It holds one series
+// per group, or a single series with an empty group for ungrouped log metrics.
+type LogMetricsQueryResponse struct {
+	LogMetric string            `json:"logMetric"`
+	GroupBy   string            `json:"groupBy,omitempty"`
+	Series    []LogMetricSeries `json:"series"`
+}
+
+// LogMetricSeries is the time series of one group of a log metric.
+type LogMetricSeries struct {
+	Group  string                  `json:"group,omitempty"`
+	Values []MetricsTimeSeriesItem `json:"values"`
+}
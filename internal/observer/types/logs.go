@@ -131,6 +131,8 @@ type LogMetadata struct {
 	ContainerName   string `json:"containerName,omitempty"`
 	PodName         string `json:"podName,omitempty"`
 	PodNamespace    string `json:"podNamespace,omitempty"`
+	TraceID         string `json:"traceId,omitempty"`
+	SpanID          string `json:"spanId,omitempty"`
 }
 
 // LogEntry represents a single log entry in the response
@@ -150,3 +152,17 @@ type LogsQueryResponse struct {
 	Total  int        `json:"total"`
 	TookMs int        `json:"tookMs"`
 }
+
+// LogsTailRequest represents the request body for POST /api/v1/logs/tail
+// Matches OpenAPI LogsTailRequest schema
+type LogsTailRequest struct {
+	// SearchScope defines where to tail logs from (component or workflow)
+	SearchScope *SearchScope `json:"searchScope" validate:"required"`
+
+	// StartTime is the point to start tailing from; defaults to the time of the request
+	StartTime string `json:"startTime,omitempty"`
+
+	// Optional filters, applied by the observer before entries are streamed
+	SearchPhrase string   `json:"searchPhrase,omitempty"`
+	LogLevels    []string `json:"logLevels,omitempty"`
+}
@@ -9,7 +9,10 @@ import "time"
 // Matches OpenAPI MetricsQueryRequest schema
 type MetricsQueryRequest struct {
 	// Metric defines the type of metrics to query
-	Metric string `json:"metric" validate:"required"` // "resource" | "http"
+	Metric string `json:"metric" validate:"required"` // "resource" | "http" | "log"
+
+	// LogMetric names the log metric to query; required when Metric is "log".
+	LogMetric string `json:"logMetric,omitempty"`
 
 	// Time range for the query (required)
 	StartTime string `json:"startTime" validate:"required"`
@@ -28,6 +31,7 @@ type MetricsQueryRequest struct {
 const (
 	MetricTypeResource = "resource"
 	MetricTypeHTTP     = "http"
+	MetricTypeLog      = "log"
 )
 
 // MetricsTimeSeriesItem represents a single point in a metrics time series
@@ -19,6 +19,17 @@ type TracesQueryRequest struct {
 	SearchScope       ComponentSearchScope
 }
 
+// TraceLogsQueryRequest represents the internal request for querying the logs
+// correlated with a trace, or with a single span when SpanID is set
+type TraceLogsQueryRequest struct {
+	TraceID     string
+	SpanID      string
+	StartTime   time.Time
+	EndTime     time.Time
+	Limit       int
+	SearchScope ComponentSearchScope
+}
+
 // TracesQueryResponse represents the internal response for trace queries
 type TracesQueryResponse struct {
 	Traces []TraceInfo `json:"traces"`
@@ -59,4 +70,7 @@ type SpanInfo struct {
 	Status             *observability.SpanStatus `json:"status,omitempty"`
 	Attributes         map[string]interface{}    `json:"attributes,omitempty"`
 	ResourceAttributes map[string]interface{}    `json:"resourceAttributes,omitempty"`
+	// CorrelatedLogCount is the number of log lines emitted within the span.
+	// It is only set on span details, and only when the span's component can be identified.
+	CorrelatedLogCount *int `json:"correlatedLogCount,omitempty"`
 }
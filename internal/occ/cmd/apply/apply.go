@@ -173,7 +173,7 @@ func applyResource(
 	}
 
 	// Check if resource exists
-	statusCode, err := entry.get(ctx, c, ns, info.name)
+	statusCode, _, err := entry.get(ctx, c, ns, info.name)
 	if err != nil {
 		return fmt.Errorf("%s/%s: failed to check existence: %w", strings.ToLower(info.kind), info.name, err)
 	}
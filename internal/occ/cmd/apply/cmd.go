@@ -4,9 +4,12 @@
 package apply
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 )
 
@@ -16,19 +19,74 @@ func NewApplyCmd(f client.NewClientFunc) *cobra.Command {
 		Short: "Apply OpenChoreo resources by file name",
 		Long: `Apply a configuration file to create or update OpenChoreo resources.
 
+In file-system mode, apply syncs all OpenChoreo resources of a repository to the
+control plane: each resource is diffed against its live state and applied when
+it differs, and the sync status and health of every file is reported. Synced
+resources are labeled with the sync name, so that --prune can delete the ones
+that were removed from the repository; nothing is pruned while a file of the
+repository is not valid YAML. With --watch, apply keeps syncing until
+interrupted, optionally pulling the repository first with --git-pull, which
+lets it run as a GitOps controller.
+
 Examples:
   # Apply a namespace configuration
-  occ apply -f namespace.yaml`,
+  occ apply -f namespace.yaml
+
+  # Preview syncing a repository, including deletions
+  occ apply --mode file-system --root-dir ./gitops --prune --dry-run
+
+  # Keep a checkout in sync with the control plane
+  occ apply --mode file-system --root-dir ./gitops --prune --watch --git-pull --interval 2m`,
 		PreRunE: auth.RequireLogin(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			filePath, _ := cmd.Flags().GetString("file")
+			params := Params{
+				FilePath:  filePath,
+				Mode:      flags.GetMode(cmd),
+				RootDir:   flags.GetRootDir(cmd),
+				Namespace: flags.GetNamespace(cmd),
+			}
+			params.SyncName, _ = cmd.Flags().GetString("sync-name")
+			params.Prune, _ = cmd.Flags().GetBool("prune")
+			params.DryRun, _ = cmd.Flags().GetBool("dry-run")
+			params.Watch, _ = cmd.Flags().GetBool("watch")
+			params.Interval, _ = cmd.Flags().GetDuration("interval")
+			params.GitPull, _ = cmd.Flags().GetBool("git-pull")
+
+			switch params.Mode {
+			case flags.ModeFileSystem:
+				if params.FilePath != "" {
+					return fmt.Errorf("--file cannot be used with --mode %s; use --root-dir", flags.ModeFileSystem)
+				}
+			case "", flags.ModeAPIServer:
+				for _, name := range []string{"root-dir", "sync-name", "prune", "dry-run", "watch", "interval", "git-pull"} {
+					if cmd.Flags().Changed(name) {
+						return fmt.Errorf("--%s requires --mode %s", name, flags.ModeFileSystem)
+					}
+				}
+			default:
+				return fmt.Errorf("unsupported mode %q: must be %q or %q", params.Mode, flags.ModeAPIServer, flags.ModeFileSystem)
+			}
+
 			cl, err := f()
 			if err != nil {
 				return err
 			}
-			return Apply(cl.(*client.Client), Params{FilePath: filePath})
+			if params.Mode == flags.ModeFileSystem {
+				return ApplyFileSystem(cl.(*client.Client), params)
+			}
+			return Apply(cl.(*client.Client), params)
 		},
 	}
 	cmd.Flags().StringP("file", "f", "", "Path to the configuration file to apply (e.g., manifests/deployment.yaml)")
+	flags.AddMode(cmd)
+	flags.AddRootDir(cmd)
+	flags.AddNamespace(cmd)
+	cmd.Flags().String("sync-name", "", "Name recorded on synced resources to scope pruning (defaults to the root directory name)")
+	cmd.Flags().Bool("prune", false, "Delete resources of this sync that were removed from the repository")
+	cmd.Flags().Bool("dry-run", false, "Report the changes without applying them")
+	cmd.Flags().Bool("watch", false, "Keep syncing the repository until interrupted")
+	cmd.Flags().Duration("interval", DefaultSyncInterval, "How often to sync with --watch")
+	cmd.Flags().Bool("git-pull", false, "Pull the repository (fast-forward only) before every sync")
 	return cmd
 }
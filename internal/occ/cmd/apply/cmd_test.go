@@ -180,3 +180,32 @@ metadata:
 	})
 	assert.Contains(t, out, "namespace/upd-ns configured")
 }
+
+// --- RunE: mode validation ---
+
+func TestNewApplyCmd_RunE_ModeValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "file with file-system mode", args: []string{"--mode", "file-system", "-f", "x.yaml"}, wantErr: "--file cannot be used"},
+		{name: "prune without file-system mode", args: []string{"-f", "x.yaml", "--prune"}, wantErr: "--prune requires --mode file-system"},
+		{name: "watch with api-server mode", args: []string{"--mode", "api-server", "--watch"}, wantErr: "--watch requires --mode file-system"},
+		{name: "unknown mode", args: []string{"--mode", "other"}, wantErr: `unsupported mode "other"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() (client.Interface, error) {
+				t.Fatal("no client expected")
+				return nil, nil
+			}
+			cmd := NewApplyCmd(f)
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			err := cmd.RunE(cmd, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
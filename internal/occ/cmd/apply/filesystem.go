@@ -0,0 +1,242 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/reconcile"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
+)
+
+// DefaultSyncInterval is how often --watch re-syncs the repository.
+const DefaultSyncInterval = time.Minute
+
+// ApplyFileSystem reconciles the OpenChoreo resources of a file-system mode
+// repository against the control plane. Every resource is diffed against its
+// live state and applied when it differs; with Prune, resources of the same
+// sync that were removed from the repository are deleted. The result is
+// reported per file. With Watch, it keeps syncing every Interval until
+// interrupted, which also corrects drift of the live resources.
+func ApplyFileSystem(c *client.Client, params Params) error {
+	root := params.RootDir
+	if root == "" {
+		var err error
+		if root, err = os.Getwd(); err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return fmt.Errorf("failed to resolve root directory: %w", err)
+	}
+
+	syncName := params.SyncName
+	if syncName == "" {
+		syncName = filepath.Base(root)
+	}
+	if errs := validation.IsValidLabelValue(syncName); len(errs) > 0 {
+		return fmt.Errorf("invalid sync name %q: %s (set one with --sync-name)", syncName, strings.Join(errs, "; "))
+	}
+
+	namespace := params.Namespace
+	if namespace == "" {
+		namespace = resolveDefaultNamespace()
+	}
+
+	s := &fileSystemSync{
+		target: newAPITarget(c.GetClient()),
+		root:   root,
+		params: params,
+		opts: reconcile.Options{
+			SyncName:         syncName,
+			DefaultNamespace: namespace,
+			Prune:            params.Prune,
+			DryRun:           params.DryRun,
+		},
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if !params.Watch {
+		result, err := s.sync(ctx)
+		if result != nil {
+			printSyncResult(os.Stdout, result)
+		}
+		if err != nil {
+			return err
+		}
+		if failed := result.Count(reconcile.ActionFailed) + result.InvalidFiles(); failed > 0 {
+			return fmt.Errorf("sync completed with %d error(s)", failed)
+		}
+		return nil
+	}
+
+	interval := params.Interval
+	if interval <= 0 {
+		interval = DefaultSyncInterval
+	}
+	fmt.Printf("Syncing %s as %q every %s\n", root, syncName, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		result, err := s.sync(ctx)
+		switch {
+		case result != nil && (changed(result) || err != nil):
+			printSyncResult(os.Stdout, result)
+		case result != nil:
+			fmt.Printf("%s: %d file(s) in sync%s\n", time.Now().Format(time.RFC3339), len(result.Files), atRevision(result))
+		}
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// fileSystemSync syncs one repository; it is reused across watch passes.
+type fileSystemSync struct {
+	target reconcile.Target
+	root   string
+	params Params
+	opts   reconcile.Options
+}
+
+// sync runs one pass: it optionally pulls the repository, refreshes the index
+// and reconciles the desired resources.
+func (s *fileSystemSync) sync(ctx context.Context) (*reconcile.Result, error) {
+	if s.params.GitPull {
+		if out, err := git(ctx, s.root, "pull", "--ff-only"); err != nil {
+			return nil, fmt.Errorf("failed to pull %s: %w: %s", s.root, err, out)
+		}
+	}
+	// The revision is best effort: the root does not have to be a git repository
+	revision, err := git(ctx, s.root, "rev-parse", "HEAD")
+	if err != nil {
+		revision = ""
+	}
+
+	persistentIndex, err := cache.LoadOrBuild(s.root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build index: %w", err)
+	}
+	persistentIndex.SetCommitSHA(revision)
+
+	opts := s.opts
+	opts.Revision = revision
+	opts.InvalidFiles = map[string]string{}
+	for relPath, parseErr := range persistentIndex.ParseErrors() {
+		opts.InvalidFiles[filepath.ToSlash(relPath)] = parseErr
+	}
+	desired := reconcile.Desired(persistentIndex.Index, s.target, opts)
+	return reconcile.New(s.target, opts).Reconcile(ctx, desired)
+}
+
+func git(ctx context.Context, dir string, args ...string) (string, error) {
+	// #nosec G204 -- fixed git subcommands run in the directory being synced
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	out, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(out)), err
+}
+
+// changed reports whether a pass changed anything, or would have in a dry
+// run, or left something unhealthy, so that watch mode only prints those passes.
+func changed(result *reconcile.Result) bool {
+	for _, f := range result.Files {
+		if f.Health() != reconcile.HealthHealthy {
+			return true
+		}
+		for _, r := range f.Resources {
+			if r.Action != reconcile.ActionUnchanged && r.Action != reconcile.ActionSkipped {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func atRevision(result *reconcile.Result) string {
+	if result.Revision == "" {
+		return ""
+	}
+	revision := result.Revision
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+	return " at " + revision
+}
+
+// fileStatus returns the sync status of a file for the report.
+func fileStatus(f reconcile.FileResult, dryRun bool) string {
+	if !f.Synced() {
+		return "Failed"
+	}
+	if dryRun {
+		for _, r := range f.Resources {
+			switch r.Action {
+			case reconcile.ActionCreated, reconcile.ActionConfigured, reconcile.ActionPruned:
+				return "OutOfSync"
+			}
+		}
+	}
+	return "Synced"
+}
+
+// printSyncResult prints a row per file with its sync status and health,
+// followed by a row per resource, the changed fields and a summary.
+func printSyncResult(out io.Writer, result *reconcile.Result) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "FILE / RESOURCE\tSTATUS\tHEALTH\tMESSAGE")
+	for _, f := range result.Files {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", f.Path, fileStatus(f, result.DryRun), f.Health(), strings.ReplaceAll(f.Error, "\n", "; "))
+		for _, r := range f.Resources {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", r.Ref, r.Action, r.Health, r.Message)
+		}
+	}
+	_ = w.Flush()
+
+	for _, f := range result.Files {
+		for _, r := range f.Resources {
+			if len(r.Diff) == 0 || r.Action == reconcile.ActionUnchanged {
+				continue
+			}
+			fmt.Fprintf(out, "\n%s (%s):\n", r.Ref, f.Path)
+			for _, field := range r.Diff {
+				fmt.Fprintf(out, "  %s\n", field)
+			}
+		}
+	}
+
+	prefix := "Synced"
+	if result.DryRun {
+		prefix = "Dry run: would sync"
+	}
+	fmt.Fprintf(out, "\n%s %d file(s)%s: %d created, %d configured, %d pruned, %d unchanged, %d out of sync, %d skipped, %d failed\n",
+		prefix, len(result.Files), atRevision(result),
+		result.Count(reconcile.ActionCreated), result.Count(reconcile.ActionConfigured),
+		result.Count(reconcile.ActionPruned), result.Count(reconcile.ActionUnchanged),
+		result.Count(reconcile.ActionOutOfSync), result.Count(reconcile.ActionSkipped),
+		result.Count(reconcile.ActionFailed))
+	if invalid := result.InvalidFiles(); invalid > 0 {
+		fmt.Fprintf(out, "%d file(s) could not be parsed\n", invalid)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/reconcile"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+// fakeAPI is an in-memory openchoreo-api keyed by resource path.
+type fakeAPI struct {
+	mu      sync.Mutex
+	objects map[string]map[string]any
+	calls   []string
+}
+
+func (a *fakeAPI) roundTrip(r *http.Request) (*http.Response, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	path := r.URL.Path
+	if r.Method != http.MethodGet {
+		a.calls = append(a.calls, r.Method+" "+path)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if obj, ok := a.objects[path]; ok {
+			return testutil.JSONResp(http.StatusOK, obj), nil
+		}
+		if selector := r.URL.Query().Get("labelSelector"); selector != "" {
+			return testutil.JSONResp(http.StatusOK, map[string]any{"items": a.list(path, selector), "pagination": map[string]any{}}), nil
+		}
+		return testutil.JSONResp(http.StatusNotFound, map[string]any{"error": "not found"}), nil
+	case http.MethodPost, http.MethodPut:
+		var obj map[string]any
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return testutil.JSONResp(http.StatusBadRequest, map[string]any{"error": err.Error()}), nil
+		}
+		if r.Method == http.MethodPost {
+			name, _ := obj["metadata"].(map[string]any)["name"].(string)
+			path += "/" + name
+			a.objects[path] = obj
+			return testutil.JSONResp(http.StatusCreated, obj), nil
+		}
+		a.objects[path] = obj
+		return testutil.JSONResp(http.StatusOK, obj), nil
+	case http.MethodDelete:
+		delete(a.objects, path)
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: http.Header{}}, nil
+	}
+	return testutil.JSONResp(http.StatusMethodNotAllowed, map[string]any{}), nil
+}
+
+func (a *fakeAPI) list(collection, selector string) []map[string]any {
+	key, value, _ := strings.Cut(selector, "=")
+	var items []map[string]any
+	for path, obj := range a.objects {
+		rest, ok := strings.CutPrefix(path, collection+"/")
+		if !ok || strings.Contains(rest, "/") {
+			continue
+		}
+		objLabels, _ := obj["metadata"].(map[string]any)["labels"].(map[string]any)
+		if objLabels[key] == value {
+			items = append(items, obj)
+		}
+	}
+	return items
+}
+
+func (a *fakeAPI) paths() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var paths []string
+	for path := range a.objects {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestApplyFileSystem_SyncAndPrune(t *testing.T) {
+	api := &fakeAPI{objects: map[string]map[string]any{}}
+	cl := setupApplyTest(t, api.roundTrip)
+
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "shop.yaml"), []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: shop
+spec:
+  deploymentPipelineRef:
+    name: default
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: legacy
+`), 0600))
+	params := Params{Mode: "file-system", RootDir: root, Namespace: "team", SyncName: "gitops", Prune: true}
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, ApplyFileSystem(cl, params))
+	})
+	assert.Contains(t, out, "shop.yaml")
+	assert.Contains(t, out, "team/project/shop")
+	assert.Contains(t, out, "Synced 1 file(s): 2 created")
+	assert.Equal(t, []string{
+		"/api/v1/namespaces/team/projects/legacy",
+		"/api/v1/namespaces/team/projects/shop",
+	}, api.paths())
+	shop := api.objects["/api/v1/namespaces/team/projects/shop"]
+	assert.Equal(t, "gitops", shop["metadata"].(map[string]any)["labels"].(map[string]any)[labels.LabelKeySyncName])
+	assert.NotContains(t, shop, "kind")
+
+	// Nothing changed: a second sync only reads
+	api.calls = nil
+	out = testutil.CaptureStdout(t, func() {
+		require.NoError(t, ApplyFileSystem(cl, params))
+	})
+	assert.Empty(t, api.calls)
+	assert.Contains(t, out, "2 unchanged")
+
+	// A resource removed from the repository is pruned; a dry run only reports it
+	require.NoError(t, os.WriteFile(filepath.Join(root, "shop.yaml"), []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: shop
+spec:
+  deploymentPipelineRef:
+    name: default
+`), 0600))
+	dryRun := params
+	dryRun.DryRun = true
+	out = testutil.CaptureStdout(t, func() {
+		require.NoError(t, ApplyFileSystem(cl, dryRun))
+	})
+	assert.Empty(t, api.calls)
+	assert.Contains(t, out, "OutOfSync")
+	assert.Contains(t, out, "Dry run: would sync")
+
+	out = testutil.CaptureStdout(t, func() {
+		require.NoError(t, ApplyFileSystem(cl, params))
+	})
+	assert.Equal(t, []string{"DELETE /api/v1/namespaces/team/projects/legacy"}, api.calls)
+	assert.Contains(t, out, "1 pruned")
+}
+
+func TestApplyFileSystem_InvalidFileIsNotPruned(t *testing.T) {
+	api := &fakeAPI{objects: map[string]map[string]any{}}
+	cl := setupApplyTest(t, api.roundTrip)
+
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "shop.yaml"), []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: shop
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "api.yaml"), []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: api
+spec:
+  owner:
+    projectName: shop
+`), 0600))
+	params := Params{Mode: "file-system", RootDir: root, Namespace: "team", SyncName: "gitops", Prune: true}
+	testutil.CaptureStdout(t, func() {
+		require.NoError(t, ApplyFileSystem(cl, params))
+	})
+	require.Contains(t, api.paths(), "/api/v1/namespaces/team/components/api")
+
+	// A syntax error drops the component from the index; it must not be pruned
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "api.yaml"), []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: api
+spec:
+  owner:
+    projectName: shop
+   broken: indentation
+`), 0600))
+	api.calls = nil
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = ApplyFileSystem(cl, params)
+	})
+	require.ErrorIs(t, err, reconcile.ErrInvalidFiles)
+	assert.Empty(t, api.calls)
+	assert.Contains(t, api.paths(), "/api/v1/namespaces/team/components/api")
+	assert.Regexp(t, `api\.yaml\s+Failed\s+Unknown\s+invalid YAML`, out)
+	assert.Contains(t, out, "1 file(s) could not be parsed")
+}
+
+func TestApplyFileSystem_InvalidSyncName(t *testing.T) {
+	cl := setupApplyTest(t, func(_ *http.Request) (*http.Response, error) {
+		t.Fatal("no HTTP call expected")
+		return nil, nil
+	})
+
+	err := ApplyFileSystem(cl, Params{RootDir: t.TempDir(), SyncName: "not a label value"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sync name")
+}
+
+func TestFileStatus(t *testing.T) {
+	ref := reconcile.ResourceRef{Kind: "Project", Namespace: "team", Name: "shop"}
+	file := func(action reconcile.Action) reconcile.FileResult {
+		return reconcile.FileResult{Path: "shop.yaml", Resources: []reconcile.ResourceResult{{Ref: ref, Action: action}}}
+	}
+
+	assert.Equal(t, "Synced", fileStatus(file(reconcile.ActionCreated), false))
+	assert.Equal(t, "OutOfSync", fileStatus(file(reconcile.ActionCreated), true))
+	assert.Equal(t, "Synced", fileStatus(file(reconcile.ActionUnchanged), true))
+	assert.Equal(t, "Failed", fileStatus(file(reconcile.ActionFailed), false))
+}
@@ -3,9 +3,22 @@
 
 package apply
 
+import "time"
+
 // Params defines parameters for applying configuration files.
 type Params struct {
 	FilePath string
+
+	// File-system mode
+	Mode      string        // Operational mode: "api-server" or "file-system"
+	RootDir   string        // Root directory of the repository to sync
+	Namespace string        // Namespace for resources without one (defaults to the CLI context)
+	SyncName  string        // Name recorded on synced resources (defaults to the root directory name)
+	Prune     bool          // Delete synced resources that were removed from the repository
+	DryRun    bool          // Report the changes without applying them
+	Watch     bool          // Keep syncing every Interval
+	Interval  time.Duration // How often to sync with Watch
+	GitPull   bool          // Pull the repository before every sync
 }
 
 // GetFilePath returns the file path.
@@ -35,8 +35,8 @@ var readOnlyKinds = map[string]bool{
 	"RenderedRelease": true,
 }
 
-// getFn checks if a resource exists. Returns the HTTP status code.
-type getFn func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error)
+// getFn gets a resource. Returns status code and response body.
+type getFn func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error)
 
 // createFn creates a resource. Returns status code and response body.
 type createFn func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error)
@@ -44,12 +44,20 @@ type createFn func(ctx context.Context, c *gen.ClientWithResponses, ns string, b
 // updateFn updates a resource. Returns status code and response body.
 type updateFn func(ctx context.Context, c *gen.ClientWithResponses, ns, name string, body io.Reader) (int, []byte, error)
 
+// deleteFn deletes a resource. Returns status code and response body.
+type deleteFn func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error)
+
+// listFn lists a page of the resources matching a label selector. Returns status code and response body.
+type listFn func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error)
+
 type resourceEntry struct {
 	scope      resourceScope
 	capability applyCapability
 	get        getFn
 	create     createFn
 	update     updateFn // nil for capCreateOnly
+	delete     deleteFn
+	list       listFn
 }
 
 func getResourceRegistry() map[string]resourceEntry {
@@ -63,12 +71,12 @@ func getResourceRegistry() map[string]resourceEntry {
 func addClusterScopedResources(reg map[string]resourceEntry) {
 	reg["Namespace"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetNamespaceWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateNamespaceWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -84,16 +92,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteNamespaceWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListNamespacesWithResponse(ctx, &gen.ListNamespacesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterComponentType"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterComponentTypeWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterComponentTypeWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -109,16 +131,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterComponentTypeWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterComponentTypesWithResponse(ctx, &gen.ListClusterComponentTypesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterTrait"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterTraitWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterTraitWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -134,16 +170,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterTraitWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterTraitsWithResponse(ctx, &gen.ListClusterTraitsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterWorkflowPlane"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterWorkflowPlaneWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterWorkflowPlaneWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -159,16 +209,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterWorkflowPlaneWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterWorkflowPlanesWithResponse(ctx, &gen.ListClusterWorkflowPlanesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterWorkflow"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterWorkflowWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterWorkflowWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -184,16 +248,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterWorkflowWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterWorkflowsWithResponse(ctx, &gen.ListClusterWorkflowsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterDataPlane"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterDataPlaneWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterDataPlaneWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -209,16 +287,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterDataPlaneWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterDataPlanesWithResponse(ctx, &gen.ListClusterDataPlanesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterObservabilityPlane"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterObservabilityPlaneWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterObservabilityPlaneWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -234,16 +326,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterObservabilityPlaneWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterObservabilityPlanesWithResponse(ctx, &gen.ListClusterObservabilityPlanesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterAuthzRole"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterRoleWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterRoleWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -259,16 +365,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterRoleWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterRolesWithResponse(ctx, &gen.ListClusterRolesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterAuthzRoleBinding"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterRoleBindingWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterRoleBindingWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -284,16 +404,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterRoleBindingWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterRoleBindingsWithResponse(ctx, &gen.ListClusterRoleBindingsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterResourceType"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterResourceTypeWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterResourceTypeWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -309,16 +443,30 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterResourceTypeWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterResourceTypesWithResponse(ctx, &gen.ListClusterResourceTypesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ClusterProjectType"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
 			r, err := c.GetClusterProjectTypeWithResponse(ctx, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterProjectTypeWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -334,6 +482,20 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.DeleteClusterProjectTypeWithResponse(ctx, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, _, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListClusterProjectTypesWithResponse(ctx, &gen.ListClusterProjectTypesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 }
 
@@ -341,12 +503,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["Project"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetProjectWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -362,16 +524,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteProjectWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListProjectsWithResponse(ctx, ns, &gen.ListProjectsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["Component"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetComponentWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateComponentWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -387,16 +563,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteComponentWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListComponentsWithResponse(ctx, ns, &gen.ListComponentsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ComponentType"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetComponentTypeWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateComponentTypeWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -412,16 +602,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteComponentTypeWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListComponentTypesWithResponse(ctx, ns, &gen.ListComponentTypesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["Environment"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetEnvironmentWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateEnvironmentWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -437,16 +641,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteEnvironmentWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListEnvironmentsWithResponse(ctx, ns, &gen.ListEnvironmentsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["DataPlane"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetDataPlaneWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateDataPlaneWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -462,16 +680,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteDataPlaneWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListDataPlanesWithResponse(ctx, ns, &gen.ListDataPlanesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["WorkflowPlane"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetWorkflowPlaneWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkflowPlaneWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -487,16 +719,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteWorkflowPlaneWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListWorkflowPlanesWithResponse(ctx, ns, &gen.ListWorkflowPlanesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ObservabilityPlane"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetObservabilityPlaneWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateObservabilityPlaneWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -512,16 +758,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteObservabilityPlaneWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListObservabilityPlanesWithResponse(ctx, ns, &gen.ListObservabilityPlanesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["DeploymentPipeline"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetDeploymentPipelineWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateDeploymentPipelineWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -537,16 +797,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteDeploymentPipelineWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListDeploymentPipelinesWithResponse(ctx, ns, &gen.ListDeploymentPipelinesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["Trait"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetTraitWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateTraitWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -562,16 +836,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteTraitWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListTraitsWithResponse(ctx, ns, &gen.ListTraitsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["SecretReference"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetSecretReferenceWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateSecretReferenceWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -587,16 +875,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteSecretReferenceWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListSecretReferencesWithResponse(ctx, ns, &gen.ListSecretReferencesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["Workflow"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetWorkflowWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkflowWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -612,16 +914,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteWorkflowWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListWorkflowsWithResponse(ctx, ns, &gen.ListWorkflowsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["Workload"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetWorkloadWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkloadWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -637,17 +953,31 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteWorkloadWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListWorkloadsWithResponse(ctx, ns, &gen.ListWorkloadsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ComponentRelease"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetComponentReleaseWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateComponentReleaseWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -656,16 +986,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteComponentReleaseWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListComponentReleasesWithResponse(ctx, ns, &gen.ListComponentReleasesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ReleaseBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetReleaseBindingWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateReleaseBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -681,16 +1025,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteReleaseBindingWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListReleaseBindingsWithResponse(ctx, ns, &gen.ListReleaseBindingsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ObservabilityAlertsNotificationChannel"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetObservabilityAlertsNotificationChannelWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateObservabilityAlertsNotificationChannelWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -706,16 +1064,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteObservabilityAlertsNotificationChannelWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListObservabilityAlertsNotificationChannelsWithResponse(ctx, ns, &gen.ListObservabilityAlertsNotificationChannelsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["AuthzRole"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetNamespaceRoleWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateNamespaceRoleWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -731,16 +1103,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteNamespaceRoleWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListNamespaceRolesWithResponse(ctx, ns, &gen.ListNamespaceRolesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["AuthzRoleBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetNamespaceRoleBindingWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateNamespaceRoleBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -756,16 +1142,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteNamespaceRoleBindingWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListNamespaceRoleBindingsWithResponse(ctx, ns, &gen.ListNamespaceRoleBindingsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ResourceType"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetResourceTypeWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceTypeWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -781,16 +1181,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteResourceTypeWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListResourceTypesWithResponse(ctx, ns, &gen.ListResourceTypesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ProjectType"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetProjectTypeWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectTypeWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -806,16 +1220,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteProjectTypeWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListProjectTypesWithResponse(ctx, ns, &gen.ListProjectTypesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["Resource"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetResourceWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -831,16 +1259,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteResourceWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListResourcesWithResponse(ctx, ns, &gen.ListResourcesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ResourceReleaseBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetResourceReleaseBindingWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceReleaseBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -856,16 +1298,30 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteResourceReleaseBindingWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListResourceReleaseBindingsWithResponse(ctx, ns, &gen.ListResourceReleaseBindingsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ProjectReleaseBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetProjectReleaseBindingWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectReleaseBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -881,6 +1337,20 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteProjectReleaseBindingWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListProjectReleaseBindingsWithResponse(ctx, ns, &gen.ListProjectReleaseBindingsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	// Create-only resources
@@ -888,12 +1358,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["WorkflowRun"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetWorkflowRunWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkflowRunWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -902,17 +1372,31 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteWorkflowRunWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListWorkflowRunsWithResponse(ctx, ns, &gen.ListWorkflowRunsParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ResourceRelease"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetResourceReleaseWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceReleaseWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -921,17 +1405,31 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteResourceReleaseWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListResourceReleasesWithResponse(ctx, ns, &gen.ListResourceReleasesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
 	}
 
 	reg["ProjectRelease"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
 			r, err := c.GetProjectReleaseWithResponse(ctx, ns, name)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectReleaseWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -940,7 +1438,29 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 			}
 			return r.StatusCode(), r.Body, nil
 		},
+		delete: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.DeleteProjectReleaseWithResponse(ctx, ns, name)
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+		list: func(ctx context.Context, c *gen.ClientWithResponses, ns, labelSelector, cursor string) (int, []byte, error) {
+			r, err := c.ListProjectReleasesWithResponse(ctx, ns, &gen.ListProjectReleasesParams{LabelSelector: &labelSelector, Cursor: optionalCursor(cursor)})
+			if err != nil {
+				return 0, nil, err
+			}
+			return r.StatusCode(), r.Body, nil
+		},
+	}
+}
+
+// optionalCursor returns the cursor query parameter for a list page, nil for the first page.
+func optionalCursor(cursor string) *string {
+	if cursor == "" {
+		return nil
 	}
+	return &cursor
 }
 
 // supportedKinds returns a sorted list of supported kind names.
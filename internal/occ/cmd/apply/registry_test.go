@@ -50,3 +50,10 @@ func TestResourceFamilyKindsRegistered(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryEntriesSupportSync(t *testing.T) {
+	for kind, entry := range getResourceRegistry() {
+		assert.NotNil(t, entry.delete, "kind %q has no delete", kind)
+		assert.NotNil(t, entry.list, "kind %q has no list", kind)
+	}
+}
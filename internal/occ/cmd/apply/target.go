@@ -0,0 +1,162 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/reconcile"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// apiTarget reconciles resources against openchoreo-api using the resource registry.
+type apiTarget struct {
+	client   *gen.ClientWithResponses
+	registry map[string]resourceEntry
+}
+
+var _ reconcile.Target = (*apiTarget)(nil)
+
+func newAPITarget(c *gen.ClientWithResponses) *apiTarget {
+	return &apiTarget{client: c, registry: getResourceRegistry()}
+}
+
+func (t *apiTarget) Kind(kind string) (reconcile.KindInfo, bool) {
+	entry, ok := t.registry[kind]
+	if !ok || readOnlyKinds[kind] {
+		return reconcile.KindInfo{}, false
+	}
+	return reconcile.KindInfo{
+		Namespaced: entry.scope == scopeNamespaced,
+		CreateOnly: entry.capability == capCreateOnly,
+	}, true
+}
+
+func (t *apiTarget) entry(kind string) (resourceEntry, error) {
+	entry, ok := t.registry[kind]
+	if !ok {
+		return resourceEntry{}, fmt.Errorf("unsupported kind %q", kind)
+	}
+	return entry, nil
+}
+
+func (t *apiTarget) Get(ctx context.Context, ref reconcile.ResourceRef) (map[string]any, error) {
+	entry, err := t.entry(ref.Kind)
+	if err != nil {
+		return nil, err
+	}
+	code, body, err := entry.get(ctx, t.client, ref.Namespace, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch code {
+	case http.StatusOK:
+		var live map[string]any
+		if err := json.Unmarshal(body, &live); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return live, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected status %d: %s", code, parseErrorBody(body))
+	}
+}
+
+func (t *apiTarget) Apply(ctx context.Context, ref reconcile.ResourceRef, obj map[string]any, exists bool) error {
+	entry, err := t.entry(ref.Kind)
+	if err != nil {
+		return err
+	}
+	jsonBody, err := stripKindAndAPIVersion(maps.Clone(obj))
+	if err != nil {
+		return fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	if exists {
+		if entry.update == nil {
+			return fmt.Errorf("resource already exists and cannot be updated (create-only resource)")
+		}
+		code, body, err := entry.update(ctx, t.client, ref.Namespace, ref.Name, bytes.NewReader(jsonBody))
+		if err != nil {
+			return fmt.Errorf("update failed: %w", err)
+		}
+		if code != http.StatusOK {
+			return fmt.Errorf("update failed: %s", parseErrorBody(body))
+		}
+		return nil
+	}
+	code, body, err := entry.create(ctx, t.client, ref.Namespace, bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("create failed: %w", err)
+	}
+	if code != http.StatusOK && code != http.StatusCreated {
+		return fmt.Errorf("create failed: %s", parseErrorBody(body))
+	}
+	return nil
+}
+
+func (t *apiTarget) Delete(ctx context.Context, ref reconcile.ResourceRef) error {
+	entry, err := t.entry(ref.Kind)
+	if err != nil {
+		return err
+	}
+	code, body, err := entry.delete(ctx, t.client, ref.Namespace, ref.Name)
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+	switch code {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("delete failed: %s", parseErrorBody(body))
+	}
+}
+
+// listPage is the common shape of the paginated list responses.
+type listPage struct {
+	Items      []map[string]any `json:"items"`
+	Pagination gen.Pagination   `json:"pagination"`
+}
+
+func (t *apiTarget) ListSynced(ctx context.Context, kind, namespace, syncName string) ([]map[string]any, error) {
+	entry, err := t.entry(kind)
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.LabelKeySyncName + "=" + syncName
+	var items []map[string]any
+	cursor := ""
+	for {
+		code, body, err := entry.list(ctx, t.client, namespace, selector, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if code != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d: %s", code, parseErrorBody(body))
+		}
+		var page listPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		for _, item := range page.Items {
+			// List items may leave out the namespace they were listed in
+			if metadata, ok := item["metadata"].(map[string]any); ok && entry.scope == scopeNamespaced {
+				if ns, _ := metadata["namespace"].(string); ns == "" {
+					metadata["namespace"] = namespace
+				}
+			}
+			items = append(items, item)
+		}
+		if page.Pagination.NextCursor == nil || *page.Pagination.NextCursor == "" {
+			return items, nil
+		}
+		cursor = *page.Pagination.NextCursor
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Authz implements authorization inspection operations
+type Authz struct {
+	client client.Interface
+}
+
+// New creates a new authz implementation
+func New(c client.Interface) *Authz {
+	return &Authz{client: c}
+}
+
+// Explain evaluates an authorization request and prints the decision with the policies that
+// matched it. When a simulate file is given, the request is evaluated against the proposed
+// roles and role bindings in it instead of only the applied ones.
+func (a *Authz) Explain(params ExplainParams) error {
+	if err := cmdutil.RequireFields("explain", "authz", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	req := buildEvaluateRequest(params)
+	ctx := context.Background()
+
+	var (
+		result *gen.AuthzExplanation
+		err    error
+	)
+	if params.SimulateFile != "" {
+		content, readErr := os.ReadFile(params.SimulateFile)
+		if readErr != nil {
+			return fmt.Errorf("failed to read simulate file: %w", readErr)
+		}
+		simReq, parseErr := parseSimulateRequest(content)
+		if parseErr != nil {
+			return fmt.Errorf("failed to parse %s: %w", params.SimulateFile, parseErr)
+		}
+		simReq.Request = req
+		simReq.IgnoreExisting = &params.IgnoreExisting
+		result, err = a.client.SimulateAuthz(ctx, *simReq)
+	} else {
+		result, err = a.client.ExplainAuthz(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+
+	return printExplanation(result)
+}
+
+// buildEvaluateRequest converts the explain parameters to an API evaluate request
+func buildEvaluateRequest(params ExplainParams) gen.EvaluateRequest {
+	resourceType := params.ResourceType
+	if resourceType == "" {
+		resourceType, _, _ = strings.Cut(params.Action, ":")
+	}
+
+	hierarchy := gen.ResourceHierarchy{Namespace: &params.Namespace}
+	id := params.Namespace
+	if params.Project != "" {
+		hierarchy.Project = &params.Project
+		id = params.Project
+	}
+	if params.Component != "" {
+		hierarchy.Component = &params.Component
+		id = params.Component
+	}
+	if params.Resource != "" {
+		hierarchy.Resource = &params.Resource
+		id = params.Resource
+	}
+
+	req := gen.EvaluateRequest{
+		Action:   params.Action,
+		Resource: gen.Resource{Type: resourceType, Id: &id, Hierarchy: hierarchy},
+	}
+
+	if len(params.EntitlementValues) > 0 {
+		req.SubjectContext = &gen.SubjectContext{
+			Type:              gen.SubjectContextType(params.SubjectType),
+			EntitlementClaim:  params.EntitlementClaim,
+			EntitlementValues: params.EntitlementValues,
+		}
+	}
+
+	if params.Environment != "" {
+		// Condition attributes carry namespace-prefixed environment names
+		env := params.Environment
+		if !strings.Contains(env, "/") {
+			env = params.Namespace + "/" + env
+		}
+		req.Context = &gen.AuthzContext{}
+		req.Context.Resource = &struct {
+			ComponentType *string `json:"componentType,omitempty"`
+			Environment   *string `json:"environment,omitempty"`
+			ResourceType  *string `json:"resourceType,omitempty"`
+			Workflow      *string `json:"workflow,omitempty"`
+		}{Environment: &env}
+	}
+
+	return req
+}
+
+// parseSimulateRequest reads the proposed roles and role bindings from a multi-document YAML file
+func parseSimulateRequest(content []byte) (*gen.AuthzSimulateRequest, error) {
+	var (
+		clusterRoles        []gen.ClusterAuthzRole
+		roles               []gen.AuthzRole
+		clusterRoleBindings []gen.ClusterAuthzRoleBinding
+		roleBindings        []gen.AuthzRoleBinding
+	)
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc map[string]any
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse YAML document: %w", err)
+		}
+		if doc == nil {
+			continue
+		}
+
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+		kind, _ := doc["kind"].(string)
+		switch kind {
+		case "ClusterAuthzRole":
+			err = appendDecoded(data, &clusterRoles)
+		case "AuthzRole":
+			err = appendDecoded(data, &roles)
+		case "ClusterAuthzRoleBinding":
+			err = appendDecoded(data, &clusterRoleBindings)
+		case "AuthzRoleBinding":
+			err = appendDecoded(data, &roleBindings)
+		default:
+			return nil, fmt.Errorf("unsupported kind %q: expected ClusterAuthzRole, AuthzRole, ClusterAuthzRoleBinding or AuthzRoleBinding", kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", kind, err)
+		}
+	}
+
+	req := &gen.AuthzSimulateRequest{}
+	if len(clusterRoles) > 0 {
+		req.ClusterRoles = &clusterRoles
+	}
+	if len(roles) > 0 {
+		req.Roles = &roles
+	}
+	if len(clusterRoleBindings) > 0 {
+		req.ClusterRoleBindings = &clusterRoleBindings
+	}
+	if len(roleBindings) > 0 {
+		req.RoleBindings = &roleBindings
+	}
+	return req, nil
+}
+
+func appendDecoded[T any](data []byte, items *[]T) error {
+	var item T
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*items = append(*items, item)
+	return nil
+}
+
+func printExplanation(result *gen.AuthzExplanation) error {
+	decision := "DENIED"
+	if result.Decision {
+		decision = "ALLOWED"
+	}
+	fmt.Printf("Decision: %s\n", decision)
+	fmt.Printf("Reason:   %s\n", result.Reason)
+
+	if len(result.Policies) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ENTITLEMENT\tBINDING\tROLE\tSCOPE\tEFFECT\tAPPLIES")
+	for _, p := range result.Policies {
+		role := p.Role.Name
+		if p.Role.Namespace != nil && *p.Role.Namespace != "" {
+			role = *p.Role.Namespace + "/" + p.Role.Name
+		}
+		applies := "no"
+		if p.Applies {
+			applies = "yes"
+		}
+		fmt.Fprintf(w, "%s:%s\t%s\t%s\t%s\t%s\t%s\n",
+			p.Entitlement.Claim,
+			p.Entitlement.Value,
+			p.BindingName,
+			role,
+			formatScope(p.Scope),
+			p.Effect,
+			applies)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, p := range result.Policies {
+		if p.Conditions == nil || len(*p.Conditions) == 0 {
+			continue
+		}
+		fmt.Printf("\nConditions of %s:\n", p.BindingName)
+		for _, c := range *p.Conditions {
+			line := fmt.Sprintf("  [%s] %s", c.Result, c.Expression)
+			if c.Error != nil && *c.Error != "" {
+				line += " (" + *c.Error + ")"
+			}
+			fmt.Println(line)
+		}
+	}
+	return nil
+}
+
+func formatScope(h gen.ResourceHierarchy) string {
+	var parts []string
+	for _, s := range []*string{h.Namespace, h.Project, h.Component, h.Resource} {
+		if s != nil && *s != "" {
+			parts = append(parts, *s)
+		}
+	}
+	if len(parts) == 0 {
+		return "*"
+	}
+	return strings.Join(parts, "/")
+}
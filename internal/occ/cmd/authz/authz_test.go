@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestBuildEvaluateRequest(t *testing.T) {
+	t.Run("defaults resource type and uses the caller", func(t *testing.T) {
+		req := buildEvaluateRequest(ExplainParams{
+			Action:    "component:deploy",
+			Namespace: "acme-corp",
+			Project:   "online-store",
+			Component: "product-catalog",
+		})
+		assert.Equal(t, "component", req.Resource.Type)
+		assert.Equal(t, ptr.To("product-catalog"), req.Resource.Id)
+		assert.Equal(t, ptr.To("online-store"), req.Resource.Hierarchy.Project)
+		assert.Nil(t, req.SubjectContext)
+		assert.Nil(t, req.Context)
+	})
+
+	t.Run("subject and namespace-prefixed environment", func(t *testing.T) {
+		req := buildEvaluateRequest(ExplainParams{
+			Action:            "releasebinding:create",
+			Namespace:         "acme-corp",
+			Environment:       "production",
+			SubjectType:       "user",
+			EntitlementClaim:  "groups",
+			EntitlementValues: []string{"dev-team"},
+		})
+		require.NotNil(t, req.SubjectContext)
+		assert.Equal(t, []string{"dev-team"}, req.SubjectContext.EntitlementValues)
+		require.NotNil(t, req.Context)
+		assert.Equal(t, ptr.To("acme-corp/production"), req.Context.Resource.Environment)
+	})
+}
+
+func TestParseSimulateRequest(t *testing.T) {
+	t.Run("groups objects by kind", func(t *testing.T) {
+		req, err := parseSimulateRequest([]byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: AuthzRole
+metadata:
+  name: deployer
+spec:
+  actions: ["releasebinding:create"]
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: AuthzRoleBinding
+metadata:
+  name: dev-deployer
+spec:
+  entitlement:
+    claim: groups
+    value: dev-team
+  roleMappings:
+    - roleRef:
+        kind: AuthzRole
+        name: deployer
+`))
+		require.NoError(t, err)
+		require.NotNil(t, req.Roles)
+		assert.Equal(t, "deployer", (*req.Roles)[0].Metadata.Name)
+		require.NotNil(t, req.RoleBindings)
+		assert.Equal(t, "dev-team", (*req.RoleBindings)[0].Spec.Entitlement.Value)
+		assert.Nil(t, req.ClusterRoles)
+		assert.Nil(t, req.ClusterRoleBindings)
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := parseSimulateRequest([]byte("kind: Component\nmetadata:\n  name: c1\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported kind "Component"`)
+	})
+}
+
+func TestExplain_PrintsPoliciesAndConditions(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ExplainAuthz(mock.Anything, mock.Anything).Return(&gen.AuthzExplanation{
+		Decision: false,
+		Reason:   "no allow policy applies: conditions of binding 'dev-deployer' (role 'deployer') are not satisfied",
+		Policies: []gen.AuthzPolicyMatch{{
+			Entitlement: gen.Entitlement{Claim: "groups", Value: "dev-team"},
+			BindingName: "dev-deployer",
+			Role:        gen.AuthzPolicyRole{Name: "deployer", Namespace: ptr.To("acme-corp")},
+			Scope:       gen.ResourceHierarchy{Namespace: ptr.To("acme-corp"), Project: ptr.To("online-store")},
+			Effect:      "allow",
+			Conditions: &[]gen.AuthzConditionResult{{
+				Expression: `resource.environment != "acme-corp/production"`,
+				Result:     "false",
+			}},
+		}},
+	}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Explain(ExplainParams{Action: "releasebinding:create", Namespace: "acme-corp"}))
+	})
+	assert.Contains(t, out, "Decision: DENIED")
+	assert.Regexp(t, `groups:dev-team\s+dev-deployer\s+acme-corp/deployer\s+acme-corp/online-store\s+allow\s+no`, out)
+	assert.Contains(t, out, `[false] resource.environment != "acme-corp/production"`)
+}
+
+func TestExplain_Simulate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bindings.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`kind: ClusterAuthzRoleBinding
+metadata:
+  name: dev-viewer
+spec:
+  entitlement:
+    claim: groups
+    value: dev-team
+  roleMappings:
+    - roleRef:
+        kind: ClusterAuthzRole
+        name: viewer
+`), 0o600))
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().SimulateAuthz(mock.Anything, mock.MatchedBy(func(req gen.AuthzSimulateRequest) bool {
+		return req.Request.Action == "component:view" &&
+			req.ClusterRoleBindings != nil && (*req.ClusterRoleBindings)[0].Metadata.Name == "dev-viewer" &&
+			req.IgnoreExisting != nil && *req.IgnoreExisting
+	})).Return(&gen.AuthzExplanation{Decision: true, Reason: "allowed"}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Explain(ExplainParams{
+			Action:         "component:view",
+			Namespace:      "acme-corp",
+			SimulateFile:   path,
+			IgnoreExisting: true,
+		}))
+	})
+	assert.Contains(t, out, "Decision: ALLOWED")
+}
+
+func TestExplain_RequiresNamespace(t *testing.T) {
+	err := New(mocks.NewMockInterface(t)).Explain(ExplainParams{Action: "component:view"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "namespace")
+}
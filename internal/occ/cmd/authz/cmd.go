@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewAuthzCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authz",
+		Short: "Inspect authorization decisions",
+		Long:  `Inspect how OpenChoreo authorization policies decide requests.`,
+	}
+	cmd.AddCommand(
+		newExplainCmd(f),
+	)
+	return cmd
+}
+
+func newExplainCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain [ACTION]",
+		Short: "Explain an authorization decision",
+		Long: `Evaluate an action on a resource and show the decision together with the matching
+allow and deny policies, their bindings, roles and condition results.
+
+The logged-in user is evaluated unless entitlement values are given. With --simulate, the
+request is evaluated against the roles and role bindings in the file as if they were applied.`,
+		Example: `  # Explain why the logged-in user may or may not deploy a component
+  occ authz explain component:deploy --namespace acme-corp --project online-store --component product-catalog
+
+  # Explain the decision for another group in the production environment
+  occ authz explain releasebinding:create -n acme-corp -p online-store -c product-catalog \
+    --entitlement-value dev-team --env production
+
+  # Evaluate against proposed bindings before applying them
+  occ authz explain component:view -n acme-corp --entitlement-value dev-team --simulate bindings.yaml`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			resourceType, _ := cmd.Flags().GetString("resource-type")
+			subjectType, _ := cmd.Flags().GetString("subject-type")
+			claim, _ := cmd.Flags().GetString("entitlement-claim")
+			values, _ := cmd.Flags().GetStringArray("entitlement-value")
+			simulateFile, _ := cmd.Flags().GetString("simulate")
+			ignoreExisting, _ := cmd.Flags().GetBool("ignore-existing")
+			return New(cl).Explain(ExplainParams{
+				Action:            args[0],
+				ResourceType:      resourceType,
+				Namespace:         flags.GetNamespace(cmd),
+				Project:           flags.GetProject(cmd),
+				Component:         flags.GetComponent(cmd),
+				Resource:          flags.GetResource(cmd),
+				Environment:       flags.GetEnvironment(cmd),
+				SubjectType:       subjectType,
+				EntitlementClaim:  claim,
+				EntitlementValues: values,
+				SimulateFile:      simulateFile,
+				IgnoreExisting:    ignoreExisting,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddComponent(cmd)
+	flags.AddResource(cmd)
+	flags.AddEnvironment(cmd)
+	cmd.Flags().String("resource-type", "", "Type of the evaluated resource (defaults to the prefix of the action)")
+	cmd.Flags().String("subject-type", "user", "Type of the evaluated subject")
+	cmd.Flags().String("entitlement-claim", "groups", "Claim of the evaluated entitlement values")
+	cmd.Flags().StringArray("entitlement-value", nil, "Entitlement value of the evaluated subject (repeatable)")
+	cmd.Flags().String("simulate", "", "Path to a YAML file with proposed roles and role bindings")
+	cmd.Flags().Bool("ignore-existing", false, "With --simulate, evaluate against the proposed objects only")
+	return cmd
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func mockFactory(mc *mocks.MockInterface) client.NewClientFunc {
+	return func() (client.Interface, error) {
+		return mc, nil
+	}
+}
+
+func errFactory(msg string) client.NewClientFunc {
+	return func() (client.Interface, error) {
+		return nil, fmt.Errorf("%s", msg)
+	}
+}
+
+// --- NewAuthzCmd structure ---
+
+func TestNewAuthzCmd_Subcommands(t *testing.T) {
+	cmd := NewAuthzCmd(errFactory("unused"))
+	assert.Equal(t, "authz", cmd.Use)
+	names := make([]string, 0, len(cmd.Commands()))
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"explain"}, names)
+}
+
+// --- explain ---
+
+func TestExplainCmd_MissingArg(t *testing.T) {
+	cmd := newExplainCmd(errFactory("unused"))
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "required argument")
+}
+
+func TestExplainCmd_FactoryError(t *testing.T) {
+	cmd := newExplainCmd(errFactory("factory failed"))
+	err := cmd.RunE(cmd, []string{"component:view"})
+	assert.EqualError(t, err, "factory failed")
+}
+
+func TestExplainCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ExplainAuthz(mock.Anything, mock.MatchedBy(func(req gen.EvaluateRequest) bool {
+		return req.Action == "component:view" &&
+			req.SubjectContext != nil && req.SubjectContext.EntitlementClaim == "groups" &&
+			len(req.SubjectContext.EntitlementValues) == 2
+	})).Return(&gen.AuthzExplanation{Decision: true, Reason: "allowed by binding 'dev-viewer' (role 'viewer') for entitlement 'groups:dev-team'"}, nil)
+
+	cmd := newExplainCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	require.NoError(t, cmd.Flags().Set("entitlement-value", "dev-team"))
+	require.NoError(t, cmd.Flags().Set("entitlement-value", "qa-team"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"component:view"}))
+	})
+	assert.Contains(t, out, "allowed by binding 'dev-viewer'")
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+// ExplainParams defines parameters for explaining an authorization decision
+type ExplainParams struct {
+	Action       string
+	ResourceType string
+	Namespace    string
+	Project      string
+	Component    string
+	Resource     string
+	Environment  string
+
+	// Subject to evaluate; the logged-in user is evaluated when no entitlement values are set
+	SubjectType       string
+	EntitlementClaim  string
+	EntitlementValues []string
+
+	// SimulateFile holds proposed roles and role bindings to evaluate the request against
+	SimulateFile   string
+	IgnoreExisting bool
+}
+
+func (p ExplainParams) GetNamespace() string { return p.Namespace }
@@ -121,6 +121,12 @@ Use --componenttype/--traits/--workflow for namespace-scoped resources, or
 --clustercomponenttype/--clustertraits/--clusterworkflow for cluster-scoped resources.
 Each pair is mutually exclusive.
 
+With --interactive, the command instead asks for each parameter of the
+ComponentType, offering enum values and defaults and checking answers against
+the schema and the ComponentType validation rules. Traits and a workflow are
+chosen from those the ComponentType allows, and a Workload with the container
+image and endpoint is written next to the Component.
+
 Examples:
   # Scaffold using a cluster-scoped ClusterComponentType
   occ component scaffold my-app --clustercomponenttype deployment/web-app
@@ -132,7 +138,10 @@ Examples:
   occ component scaffold my-app --clustercomponenttype deployment/web-app --clustertraits storage,ingress
 
   # Output to file
-  occ component scaffold my-app --clustercomponenttype deployment/web-app -o my-app.yaml`,
+  occ component scaffold my-app --clustercomponenttype deployment/web-app -o my-app.yaml
+
+  # Answer questions to produce a ready-to-apply Component and Workload
+  occ component scaffold my-app --clustercomponenttype deployment/web-app --interactive -o my-app.yaml`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
 		PreRunE: auth.RequireLogin(),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -142,6 +151,7 @@ Examples:
 			clusterWorkflow, _ := cmd.Flags().GetString("clusterworkflow")
 			skipComments, _ := cmd.Flags().GetBool("skip-comments")
 			skipOptional, _ := cmd.Flags().GetBool("skip-optional")
+			interactive, _ := cmd.Flags().GetBool("interactive")
 			cl, err := f()
 			if err != nil {
 				return err
@@ -159,6 +169,7 @@ Examples:
 				OutputPath:           flags.GetOutputFile(cmd),
 				SkipComments:         skipComments,
 				SkipOptional:         skipOptional,
+				Interactive:          interactive,
 			})
 		},
 	}
@@ -170,6 +181,7 @@ Examples:
 	cmd.Flags().String("clusterworkflow", "", "Cluster-scoped ClusterWorkflow name")
 	cmd.Flags().Bool("skip-comments", false, "Skip section headers and field description comments for minimal output")
 	cmd.Flags().Bool("skip-optional", false, "Skip optional fields without defaults (show only required fields)")
+	cmd.Flags().Bool("interactive", false, "Prompt for parameters, traits, workflow and workload instead of generating commented YAML")
 	flags.AddNamespace(cmd)
 	flags.AddProject(cmd)
 	flags.AddOutputFile(cmd)
@@ -227,7 +239,10 @@ If --env is not specified, uses the lowest environment from the deployment pipel
   occ component logs my-component --container main
 
   # Follow logs in real-time
-  occ component logs my-component --env dev -f`,
+  occ component logs my-component --env dev -f
+
+  # Follow only error logs mentioning a phrase
+  occ component logs my-component --env dev -f --level ERROR --search "payment failed"`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
 		PreRunE: auth.RequireLogin(),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -246,6 +261,8 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 				Follow:      flags.GetFollow(cmd),
 				Since:       flags.GetSince(cmd),
 				Tail:        tail,
+				Levels:      flags.GetLogLevels(cmd),
+				Search:      flags.GetLogSearch(cmd),
 			})
 		},
 	}
@@ -257,6 +274,7 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 	flags.AddFollow(cmd)
 	flags.AddSince(cmd)
 	flags.AddTail(cmd)
+	flags.AddLogFilters(cmd)
 	return cmd
 }
 
@@ -15,6 +15,7 @@ import (
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/yaml"
 
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/setoverride"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
@@ -408,6 +409,10 @@ func (cp *Component) scaffoldComponent(params ScaffoldParams) error {
 		return err
 	}
 
+	if params.Interactive {
+		return cp.scaffoldInteractive(params, res)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -447,15 +452,71 @@ func (cp *Component) scaffoldComponent(params ScaffoldParams) error {
 		return fmt.Errorf("failed to generate Component YAML: %w", err)
 	}
 
-	if params.OutputPath != "" {
-		if err := os.WriteFile(params.OutputPath, []byte(yamlContent), 0600); err != nil {
-			return fmt.Errorf("failed to write output file %s: %w", params.OutputPath, err)
+	return writeScaffoldOutput(params.OutputPath, yamlContent, "Component YAML")
+}
+
+// scaffoldInteractive prompts on stdin for the values of the component and
+// writes the resulting Component and Workload.
+func (cp *Component) scaffoldInteractive(params ScaffoldParams, res *scaffoldResolution) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	spec, err := fetchComponentTypeSpec(ctx, cp.client, params.Namespace, res)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	opts := scaffold.InteractiveOptions{
+		ComponentName:     params.ComponentName,
+		Namespace:         params.Namespace,
+		ProjectName:       params.ProjectName,
+		ComponentTypeName: res.componentTypeName,
+		ComponentTypeKind: res.componentTypeKind,
+		ComponentType:     spec,
+		LoadTraitSchema: func(kind, name string) (*extv1.JSONSchemaProps, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			if kind == "ClusterTrait" {
+				return schemaFromResponse(cp.client.GetClusterTraitSchema(ctx, name))
+			}
+			return schemaFromResponse(cp.client.GetTraitSchema(ctx, params.Namespace, name))
+		},
+		LoadWorkflowSchema: func(kind, name string) (*extv1.JSONSchemaProps, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			if kind == "ClusterWorkflow" {
+				return schemaFromResponse(cp.client.GetClusterWorkflowSchema(ctx, name))
+			}
+			return schemaFromResponse(cp.client.GetWorkflowSchema(ctx, params.Namespace, name))
+		},
+	}
+
+	// Keep stdout clean for the YAML when it is not written to a file
+	promptOut := os.Stdout
+	if params.OutputPath == "" {
+		promptOut = os.Stderr
+	}
+	result, err := scaffold.RunInteractive(scaffold.NewPrompter(os.Stdin, promptOut), opts)
+	if err != nil {
+		return err
+	}
+	yamlContent, err := result.YAML()
+	if err != nil {
+		return err
+	}
+
+	return writeScaffoldOutput(params.OutputPath, yamlContent, "Component and Workload YAML")
+}
+
+// writeScaffoldOutput writes scaffolded YAML to outputPath, or to stdout when it is empty.
+func writeScaffoldOutput(outputPath, yamlContent, what string) error {
+	if outputPath != "" {
+		if err := os.WriteFile(outputPath, []byte(yamlContent), 0600); err != nil {
+			return fmt.Errorf("failed to write output file %s: %w", outputPath, err)
 		}
-		fmt.Printf("Component YAML written to %s\n", params.OutputPath)
+		fmt.Printf("%s written to %s\n", what, outputPath)
 	} else {
 		fmt.Print(yamlContent)
 	}
-
 	return nil
 }
 
@@ -478,6 +539,11 @@ func validateScaffoldParams(params ScaffoldParams) error {
 	if params.WorkflowName != "" && params.ClusterWorkflowName != "" {
 		return fmt.Errorf("--workflow and --clusterworkflow are mutually exclusive")
 	}
+	if params.Interactive && (len(params.Traits) > 0 || len(params.ClusterTraits) > 0 ||
+		params.WorkflowName != "" || params.ClusterWorkflowName != "") {
+		return fmt.Errorf("--interactive cannot be combined with --traits, --clustertraits, --workflow or --clusterworkflow; " +
+			"traits and workflows are chosen from those allowed by the component type")
+	}
 	return nil
 }
 
@@ -588,6 +654,61 @@ func fetchScaffoldSchemas(
 	return componentTypeSchema, traitSchemas, workflowSchema, nil
 }
 
+// fetchComponentTypeSpec fetches the full ComponentType or ClusterComponentType
+// and returns its spec in the namespace-scoped form.
+func fetchComponentTypeSpec(
+	ctx context.Context,
+	apiClient client.Interface,
+	namespace string,
+	res *scaffoldResolution,
+) (*v1alpha1.ComponentTypeSpec, error) {
+	if res.useClusterCT {
+		cct, err := apiClient.GetClusterComponentType(ctx, res.componentTypeName)
+		if err != nil {
+			return nil, err
+		}
+		if cct.Spec == nil {
+			return nil, fmt.Errorf("cluster component type %q has no spec", res.componentTypeName)
+		}
+		var clusterSpec v1alpha1.ClusterComponentTypeSpec
+		if err := convertViaJSON(cct.Spec, &clusterSpec); err != nil {
+			return nil, fmt.Errorf("invalid ClusterComponentType %q: %w", res.componentTypeName, err)
+		}
+		spec := clusterSpec.ToComponentTypeSpec()
+		return &spec, nil
+	}
+
+	ct, err := apiClient.GetComponentType(ctx, namespace, res.componentTypeName)
+	if err != nil {
+		return nil, err
+	}
+	if ct.Spec == nil {
+		return nil, fmt.Errorf("component type %q has no spec", res.componentTypeName)
+	}
+	var spec v1alpha1.ComponentTypeSpec
+	if err := convertViaJSON(ct.Spec, &spec); err != nil {
+		return nil, fmt.Errorf("invalid ComponentType %q: %w", res.componentTypeName, err)
+	}
+	return &spec, nil
+}
+
+// convertViaJSON converts an API model into the equivalent CRD type.
+func convertViaJSON(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// schemaFromResponse unmarshals a schema returned by the API client.
+func schemaFromResponse(raw *json.RawMessage, err error) (*extv1.JSONSchemaProps, error) {
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSchema(raw)
+}
+
 // parseComponentType parses "workloadType/componentTypeName" format
 func parseComponentType(typeStr string) (workloadType, componentTypeName string, err error) {
 	if typeStr == "" {
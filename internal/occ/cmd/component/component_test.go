@@ -249,6 +249,18 @@ func TestValidateScaffoldParams(t *testing.T) {
 			},
 			wantErr: "mutually exclusive",
 		},
+		{
+			name: "interactive with traits",
+			params: ScaffoldParams{
+				ComponentName: "c",
+				Namespace:     "ns",
+				ProjectName:   "proj",
+				ComponentType: "deployment/web-app",
+				Traits:        []string{"ingress"},
+				Interactive:   true,
+			},
+			wantErr: "--interactive cannot be combined with --traits",
+		},
 	}
 
 	for _, tt := range tests {
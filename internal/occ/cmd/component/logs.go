@@ -5,6 +5,7 @@ package component
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -158,7 +159,8 @@ func reverseLogs(logs []client.LogEntry) {
 	}
 }
 
-// followLogs continuously fetches and prints new logs
+// followLogs prints the logs of the --since window and then streams new logs from the
+// observer's log tail. Observers without the tail endpoint are polled instead.
 func (cp *Component) followLogs(
 	ctx context.Context,
 	observerURL string,
@@ -186,8 +188,47 @@ func (cp *Component) followLogs(
 	// Print initial logs
 	printLogs(logs, params.Container)
 
+	// The tail starts at endTime, rendered with second precision, so it replays the
+	// entries of that second which were already printed.
+	tailStart := endTime.UTC().Truncate(time.Second)
+	printed := newPrintedLogs(logs, tailStart.Format(time.RFC3339))
+
+	obsClient := client.NewObserverClient(observerURL, token)
+	err = obsClient.TailComponentLogs(ctx, client.ComponentLogsTailRequest{
+		StartTime:       tailStart.Format(time.RFC3339),
+		ComponentName:   params.Component,
+		ProjectName:     params.Project,
+		NamespaceName:   params.Namespace,
+		EnvironmentName: params.Environment,
+		SearchPhrase:    params.Search,
+		LogLevels:       params.Levels,
+	}, func(batch []client.LogEntry) {
+		printLogs(printed.skip(batch), params.Container)
+	})
+	switch {
+	case errors.Is(err, client.ErrLogTailUnsupported):
+		return cp.pollLogs(ctx, observerURL, token, environmentID, params, logs, endTime)
+	case err != nil:
+		return fmt.Errorf("log stream for component %s failed: %w", params.Component, err)
+	}
+
+	fmt.Println("\nStopping log streaming...")
+	return nil
+}
+
+// pollLogs periodically fetches and prints logs newer than the already printed ones.
+// It is used with observers that do not provide the log tail endpoint.
+func (cp *Component) pollLogs(
+	ctx context.Context,
+	observerURL string,
+	token string,
+	environmentID string,
+	params LogsParams,
+	logs []client.LogEntry,
+	endTime time.Time,
+) error {
 	// Update startTime to the last log timestamp or endTime
-	startTime = endTime // default
+	startTime := endTime // default
 	if len(logs) > 0 {
 		lastTimestamp, err := time.Parse(time.RFC3339, logs[len(logs)-1].Timestamp)
 		if err == nil {
@@ -239,6 +280,49 @@ func (cp *Component) followLogs(
 	}
 }
 
+// printedLogs remembers the entries printed for one timestamp so a log tail starting at
+// that timestamp does not print them again.
+type printedLogs struct {
+	timestamp string
+	counts    map[string]int
+}
+
+func newPrintedLogs(logs []client.LogEntry, timestamp string) *printedLogs {
+	p := &printedLogs{timestamp: timestamp, counts: map[string]int{}}
+	for _, log := range logs {
+		if log.Timestamp == timestamp {
+			p.counts[printedLogKey(log)]++
+		}
+	}
+	return p
+}
+
+// skip drops the entries that were already printed.
+func (p *printedLogs) skip(logs []client.LogEntry) []client.LogEntry {
+	if len(p.counts) == 0 {
+		return logs
+	}
+	fresh := make([]client.LogEntry, 0, len(logs))
+	for _, log := range logs {
+		if log.Timestamp == p.timestamp {
+			if key := printedLogKey(log); p.counts[key] > 0 {
+				p.counts[key]--
+				continue
+			}
+		}
+		fresh = append(fresh, log)
+	}
+	return fresh
+}
+
+func printedLogKey(log client.LogEntry) string {
+	pod := ""
+	if log.Metadata != nil {
+		pod = log.Metadata.PodName
+	}
+	return pod + "\x00" + log.ContainerName() + "\x00" + log.Log
+}
+
 // fetchLogs makes an HTTP request to the observer to fetch logs
 func (cp *Component) fetchLogs(
 	ctx context.Context,
@@ -265,6 +349,8 @@ func (cp *Component) fetchLogs(
 		Limit:           int64(params.Tail),
 		SortOrder:       sortOrder,
 		LogType:         "runtime",
+		SearchPhrase:    params.Search,
+		LogLevels:       params.Levels,
 	}
 
 	// Create observer client and fetch logs
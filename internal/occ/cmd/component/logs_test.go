@@ -499,3 +499,24 @@ func TestLogs_WithContainerFilter(t *testing.T) {
 	assert.Contains(t, out, "[daprd] daprd log")
 	assert.NotContains(t, out, "main log")
 }
+
+func TestPrintedLogs_Skip(t *testing.T) {
+	pod := &client.LogMetadata{PodName: "pod-1"}
+	printed := []client.LogEntry{
+		{Timestamp: "2026-01-01T00:00:00Z", Log: "old", Metadata: pod},
+		{Timestamp: "2026-01-01T00:00:01Z", Log: "dup", Metadata: pod},
+		{Timestamp: "2026-01-01T00:00:01Z", Log: "seen", Metadata: pod},
+	}
+	p := newPrintedLogs(printed, "2026-01-01T00:00:01Z")
+
+	got := p.skip([]client.LogEntry{
+		{Timestamp: "2026-01-01T00:00:01Z", Log: "dup", Metadata: pod},
+		{Timestamp: "2026-01-01T00:00:01Z", Log: "dup", Metadata: pod},
+		{Timestamp: "2026-01-01T00:00:01Z", Log: "seen", Metadata: pod},
+		{Timestamp: "2026-01-01T00:00:02Z", Log: "seen", Metadata: pod},
+	})
+	// Only the entries printed at the boundary second are skipped, once each.
+	require.Len(t, got, 2)
+	assert.Equal(t, "dup", got[0].Log)
+	assert.Equal(t, "2026-01-01T00:00:02Z", got[1].Timestamp)
+}
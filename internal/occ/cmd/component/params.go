@@ -25,6 +25,7 @@ type ScaffoldParams struct {
 	OutputPath           string
 	SkipComments         bool // skip structural comments and field descriptions
 	SkipOptional         bool // skip optional fields without defaults
+	Interactive          bool // prompt for values instead of generating commented YAML
 }
 
 // DeployParams defines parameters for deploying or promoting a component
@@ -98,8 +99,10 @@ type LogsParams struct {
 	Environment string
 	Container   string // optional — empty means logs from all containers
 	Follow      bool
-	Since       string // duration like "1h", "30m", "5m"
-	Tail        int    // number of lines to show from the end of logs (0 means no limit)
+	Since       string   // duration like "1h", "30m", "5m"
+	Tail        int      // number of lines to show from the end of logs (0 means no limit)
+	Levels      []string // optional — only logs of these levels
+	Search      string   // optional — only logs containing this phrase
 }
 
 // ExecParams defines parameters for exec-ing into a component's running pod
@@ -17,6 +17,7 @@ import (
 
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
 	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
 
 // Shared schemas used across scaffold tests.
@@ -351,3 +352,97 @@ func TestFetchScaffoldSchemas_WorkflowSchemaError(t *testing.T) {
 	_, _, _, err := fetchScaffoldSchemas(context.Background(), mc, "ns", res)
 	assert.ErrorContains(t, err, "workflow schema not found")
 }
+
+// --- Scaffold: interactive ---
+
+// setStdin replaces os.Stdin with a file holding input for the duration of the test.
+func setStdin(t *testing.T, input string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stdin")
+	require.NoError(t, os.WriteFile(path, []byte(input), 0600))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	orig := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() {
+		os.Stdin = orig
+		_ = f.Close()
+	})
+}
+
+func TestScaffold_Interactive(t *testing.T) {
+	var cct gen.ClusterComponentType
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"metadata": {"name": "web-app"},
+		"spec": {
+			"workloadType": "deployment",
+			"parameters": {"openAPIV3Schema": {
+				"type": "object",
+				"required": ["port"],
+				"properties": {"port": {"type": "integer", "default": 8080}}
+			}},
+			"preRenderValidations": [{"rule": "${parameters.port != 22}", "message": "port 22 is reserved"}],
+			"allowedTraits": [{"kind": "ClusterTrait", "name": "ingress"}],
+			"resources": []
+		}
+	}`), &cct))
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetClusterComponentType(mock.Anything, "web-app").Return(&cct, nil)
+	mc.EXPECT().GetClusterTraitSchema(mock.Anything, "ingress").Return(&minimalTraitSchema, nil)
+
+	// Port 22 breaks the rule and is edited to 8081; then the ingress trait with
+	// two replicas and a workload without an endpoint
+	setStdin(t, "22\n\n8081\n1\n\n2\nnginx:1.27\nn\n")
+	outFile := filepath.Join(t.TempDir(), "my-comp.yaml")
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Scaffold(ScaffoldParams{
+			ComponentName:        "my-comp",
+			Namespace:            "ns",
+			ProjectName:          "my-project",
+			ClusterComponentType: "deployment/web-app",
+			OutputPath:           outFile,
+			Interactive:          true,
+		}))
+	})
+	assert.Contains(t, out, "port 22 is reserved")
+	assert.Contains(t, out, fmt.Sprintf("Component and Workload YAML written to %s\n", outFile))
+
+	data, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.Equal(t, "# Generated by occ scaffold component --interactive\n"+
+		"# Component: my-comp\n"+
+		"# Type: deployment/web-app\n"+
+		"apiVersion: openchoreo.dev/v1alpha1\n"+
+		"kind: Component\n"+
+		"metadata:\n"+
+		"  name: my-comp\n"+
+		"  namespace: ns\n"+
+		"spec:\n"+
+		"  componentType:\n"+
+		"    kind: ClusterComponentType\n"+
+		"    name: deployment/web-app\n"+
+		"  owner:\n"+
+		"    projectName: my-project\n"+
+		"  parameters:\n"+
+		"    port: 8081\n"+
+		"  traits:\n"+
+		"  - instanceName: ingress\n"+
+		"    kind: ClusterTrait\n"+
+		"    name: ingress\n"+
+		"    parameters:\n"+
+		"      replicas: 2\n"+
+		"---\n"+
+		"apiVersion: openchoreo.dev/v1alpha1\n"+
+		"kind: Workload\n"+
+		"metadata:\n"+
+		"  name: my-comp-workload\n"+
+		"  namespace: ns\n"+
+		"spec:\n"+
+		"  container:\n"+
+		"    image: nginx:1.27\n"+
+		"  owner:\n"+
+		"    componentName: my-comp\n"+
+		"    projectName: my-project\n", string(data))
+}
@@ -73,6 +73,8 @@ func newGenerateCmd() *cobra.Command {
 				RootDir:    flags.GetRootDir(cmd),
 			}
 			name, _ := cmd.Flags().GetString("name")
+			params.PinImages, _ = cmd.Flags().GetBool("pin-images")
+			params.InsecureRegistries, _ = cmd.Flags().GetStringSlice("insecure-registry")
 			if allSet {
 				params.All = true
 			}
@@ -89,6 +91,8 @@ func newGenerateCmd() *cobra.Command {
 		},
 	}
 	cmd.Flags().String("name", "", "Name of the resource (must be lowercase letters, numbers, or hyphens)")
+	cmd.Flags().Bool("pin-images", true, "Pin tagged workload images to the digests they resolve to in their registries")
+	cmd.Flags().StringSlice("insecure-registry", nil, "Registry (host[:port]) reached over plain HTTP when pinning images")
 	flags.AddAll(cmd)
 	flags.AddNamespace(cmd)
 	flags.AddProject(cmd)
@@ -13,6 +13,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentreleasespec "github.com/openchoreo/openchoreo/internal/componentrelease"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
@@ -24,6 +26,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/output"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/registry"
 	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
 )
 
@@ -146,6 +149,13 @@ func (cr *ComponentRelease) Generate(params GenerateParams) error {
 
 	// 4. Create generator
 	gen := generator.NewReleaseGenerator(ocIndex)
+	if params.PinImages {
+		// Registry credentials referenced by components live in the cluster, so file-system
+		// mode resolves digests anonymously.
+		gen.WithImageResolver(func(context.Context, *v1alpha1.Component) (componentreleasespec.DigestResolver, error) {
+			return registry.NewResolver(nil, params.InsecureRegistries), nil
+		})
+	}
 
 	// 5. Determine base directory and custom output path
 	// baseDir is where the writer will use for default path resolution
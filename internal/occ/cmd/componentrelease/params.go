@@ -14,6 +14,10 @@ type GenerateParams struct {
 	DryRun        bool   // Preview without writing files
 	Mode          string // Operational mode: "api-server" or "file-system"
 	RootDir       string // Root directory path for file-system mode
+	// PinImages pins tagged workload images to the digests they resolve to. Registries are
+	// queried without credentials; releases whose image cannot be resolved keep the tag.
+	PinImages          bool
+	InsecureRegistries []string // Registries (host[:port]) reached over plain HTTP when pinning
 }
 
 // ListParams defines parameters for listing component releases
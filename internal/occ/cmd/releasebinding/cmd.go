@@ -98,6 +98,73 @@ func newGenerateCmd() *cobra.Command {
 	return cmd
 }
 
+// NewPromoteCmd returns the top-level promote command.
+func NewPromoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Promote releases along the deployment pipeline",
+		Long: `Promote the component releases bound in one environment to the next environment
+of the deployment pipeline by writing the target ReleaseBinding files.
+
+Existing target bindings keep their per-environment overrides; only their release
+is changed. A summary of the moved releases is printed as a commit message.`,
+		Example: `  # Promote a single component from dev to staging
+  occ promote --mode file-system --from dev --to staging --project online-store --component product-catalog
+
+  # Promote all components of a project
+  occ promote --mode file-system --from dev --to staging --project online-store
+
+  # Preview promoting all components in the repository
+  occ promote --mode file-system --from staging --to production --all --use-pipeline default --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allSet := cmd.Flags().Changed("all")
+			projectSet := cmd.Flags().Changed("project")
+			componentSet := cmd.Flags().Changed("component")
+
+			if allSet {
+				if projectSet || componentSet {
+					return fmt.Errorf("--all cannot be combined with --project or --component")
+				}
+				if flags.GetUsePipeline(cmd) == "" {
+					return fmt.Errorf("--use-pipeline is required when using --all scope")
+				}
+			} else if componentSet {
+				if !projectSet {
+					return fmt.Errorf("--component requires --project to be specified")
+				}
+			} else if !projectSet {
+				return fmt.Errorf("one of --all, --project, or --component must be specified")
+			}
+
+			return New(nil).Promote(PromoteParams{
+				SourceEnv:     flags.GetFrom(cmd),
+				TargetEnv:     flags.GetTo(cmd),
+				All:           flags.GetAll(cmd),
+				ProjectName:   flags.GetProject(cmd),
+				ComponentName: flags.GetComponent(cmd),
+				UsePipeline:   flags.GetUsePipeline(cmd),
+				OutputPath:    flags.GetOutputPath(cmd),
+				DryRun:        flags.GetDryRun(cmd),
+				Mode:          flags.GetMode(cmd),
+				RootDir:       flags.GetRootDir(cmd),
+			})
+		},
+	}
+	flags.AddFrom(cmd)
+	flags.AddTo(cmd)
+	flags.AddAll(cmd)
+	flags.AddProject(cmd)
+	flags.AddComponent(cmd)
+	flags.AddUsePipeline(cmd)
+	flags.AddOutputPath(cmd)
+	flags.AddDryRun(cmd)
+	flags.AddMode(cmd)
+	flags.AddRootDir(cmd)
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
+
 func newListCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -17,6 +17,20 @@ type GenerateParams struct {
 	RootDir          string // Root directory path for file-system mode
 }
 
+// PromoteParams defines parameters for promoting releases between environments
+type PromoteParams struct {
+	SourceEnv     string // Required: environment to promote from
+	TargetEnv     string // Required: environment to promote to
+	All           bool   // Promote all components
+	ProjectName   string // Promote all components in this project
+	ComponentName string // Promote a specific component (requires ProjectName)
+	UsePipeline   string // Optional: deployment pipeline name (derived from the project when empty)
+	OutputPath    string // Optional: output directory for new bindings
+	DryRun        bool   // Preview without writing files
+	Mode          string // Operational mode: "api-server" or "file-system"
+	RootDir       string // Root directory path for file-system mode
+}
+
 // ListParams defines parameters for listing release bindings
 type ListParams struct {
 	Namespace string
@@ -0,0 +1,215 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	occonfig "github.com/openchoreo/openchoreo/internal/occ/fsmode/config"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/generator"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/output"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/pipeline"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
+)
+
+// Promote moves the releases bound in the source environment to the target
+// environment by writing the target ReleaseBinding files, and prints a
+// summary of the moved releases that can be used as a commit message.
+func (r *ReleaseBinding) Promote(params PromoteParams) error {
+	mode := params.Mode
+	if mode == "" {
+		mode = flags.ModeAPIServer
+	}
+	if mode != flags.ModeFileSystem {
+		return fmt.Errorf("promote only supports file-system mode; use --mode file-system (got %q)", mode)
+	}
+	if params.SourceEnv == "" || params.TargetEnv == "" {
+		return fmt.Errorf("both --from and --to are required")
+	}
+
+	ctx, err := currentContext()
+	if err != nil {
+		return err
+	}
+	namespace := ctx.Namespace
+	if namespace == "" {
+		return fmt.Errorf("namespace is required in context")
+	}
+
+	repoPath := params.RootDir
+	if repoPath == "" {
+		repoPath, err = os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+	}
+
+	fmt.Println("Loading index...")
+	persistentIndex, err := cache.LoadOrBuild(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to build index: %w", err)
+	}
+	ocIndex := fsmode.WrapIndex(persistentIndex.Index)
+
+	releaseConfig, err := r.loadReleaseConfig(repoPath, false)
+	if err != nil {
+		return err
+	}
+
+	pipelineName, err := resolvePipelineName(ocIndex, namespace, params.All, params.ProjectName, params.UsePipeline)
+	if err != nil {
+		return err
+	}
+	pipelineEntry, ok := ocIndex.GetDeploymentPipeline(pipelineName)
+	if !ok {
+		return fmt.Errorf("deployment pipeline %q not found", pipelineName)
+	}
+	pipelineInfo, err := pipeline.ParsePipeline(pipelineEntry.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to parse deployment pipeline: %w", err)
+	}
+
+	result, err := generator.NewBindingGenerator(ocIndex).Promote(generator.PromoteOptions{
+		SourceEnv:     params.SourceEnv,
+		TargetEnv:     params.TargetEnv,
+		All:           params.All,
+		ProjectName:   params.ProjectName,
+		ComponentName: params.ComponentName,
+		PipelineInfo:  pipelineInfo,
+		Namespace:     namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range result.Errors {
+		fmt.Fprintf(os.Stderr, "Error promoting %s/%s: %v\n", e.ProjectName, e.ComponentName, e.Error)
+	}
+
+	var changed []generator.Promotion
+	for _, p := range result.Promotions {
+		if p.Changed() {
+			changed = append(changed, p)
+		}
+	}
+
+	var writeErr error
+	if params.DryRun {
+		for _, p := range changed {
+			fmt.Printf("# %s binding: %s (project: %s, component: %s, release: %s)\n",
+				promotionAction(p), p.BindingName, p.ProjectName, p.ComponentName, p.ReleaseName)
+			if err := r.printYAML(p.Binding); err != nil {
+				return err
+			}
+			fmt.Println("---")
+		}
+	} else if len(changed) > 0 {
+		// Report the bindings that were written even when others failed
+		changed, writeErr = writePromotions(changed, repoPath, params.OutputPath, releaseConfig,
+			buildBindingOutputDirResolver(ocIndex, namespace))
+	}
+
+	printPromotionSummary(os.Stdout, params.SourceEnv, params.TargetEnv, changed, result, params.DryRun)
+	if writeErr != nil {
+		return writeErr
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("promotion completed with %d error(s)", len(result.Errors))
+	}
+	return nil
+}
+
+// writePromotions writes the target bindings and returns the promotions whose files were written.
+func writePromotions(promotions []generator.Promotion, baseDir, customOutputPath string,
+	releaseConfig *occonfig.ReleaseConfig, resolver output.OutputDirResolverFunc) ([]generator.Promotion, error) {
+	bindings := make([]*unstructured.Unstructured, 0, len(promotions))
+	existingPaths := make(map[string]string)
+	for _, p := range promotions {
+		bindings = append(bindings, p.Binding)
+		if p.IsUpdate && p.ExistingFilePath != "" {
+			existingPaths[p.BindingName] = p.ExistingFilePath
+		}
+	}
+
+	writeResult, err := output.NewWriter(baseDir).WriteBulkBindings(bindings, output.BulkBindingWriteOptions{
+		Config:        releaseConfig,
+		OutputDir:     customOutputPath,
+		Resolver:      resolver,
+		ExistingPaths: existingPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write bindings: %w", err)
+	}
+	for _, err := range writeResult.Errors {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	}
+
+	var result []generator.Promotion
+	for _, p := range promotions {
+		if path, ok := writeResult.WrittenPaths[p.BindingName]; ok {
+			fmt.Printf("%s: %s\n", promotionAction(p), path)
+			result = append(result, p)
+		}
+	}
+	if len(writeResult.Errors) > 0 {
+		return result, fmt.Errorf("failed to write %d binding(s)", len(writeResult.Errors))
+	}
+	return result, nil
+}
+
+func promotionAction(p generator.Promotion) string {
+	if p.IsUpdate {
+		return actionUpdated
+	}
+	return actionCreated
+}
+
+// printPromotionSummary prints the moved releases as a table, followed by a
+// commit message describing them.
+func printPromotionSummary(out io.Writer, sourceEnv, targetEnv string, changed []generator.Promotion,
+	result *generator.PromotionResult, dryRun bool) {
+	for _, s := range result.Skipped {
+		fmt.Fprintf(out, "Skipped %s/%s: %v\n", s.ProjectName, s.ComponentName, s.Error)
+	}
+
+	unchanged := len(result.Promotions) - len(changed)
+	if len(changed) == 0 {
+		fmt.Fprintf(out, "\nNothing to promote from %s to %s: %d component(s) already up to date, %d skipped, %d error(s)\n",
+			sourceEnv, targetEnv, unchanged, len(result.Skipped), len(result.Errors))
+		return
+	}
+
+	verb := "Promoted"
+	if dryRun {
+		verb = "Would promote"
+	}
+	fmt.Fprintf(out, "\n%s %d component(s) from %s to %s (%d already up to date, %d skipped, %d error(s)):\n",
+		verb, len(changed), sourceEnv, targetEnv, unchanged, len(result.Skipped), len(result.Errors))
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "  COMPONENT\tFROM\tTO")
+	for _, p := range changed {
+		fmt.Fprintf(w, "  %s/%s\t%s\t%s\n", p.ProjectName, p.ComponentName, previousReleaseOrNone(p), p.ReleaseName)
+	}
+	_ = w.Flush()
+
+	fmt.Fprintf(out, "\nCommit message:\n\n")
+	fmt.Fprintf(out, "Promote %s to %s\n\n", sourceEnv, targetEnv)
+	for _, p := range changed {
+		fmt.Fprintf(out, "- %s/%s: %s -> %s\n", p.ProjectName, p.ComponentName, previousReleaseOrNone(p), p.ReleaseName)
+	}
+}
+
+func previousReleaseOrNone(p generator.Promotion) string {
+	if p.PreviousRelease == "" {
+		return "(none)"
+	}
+	return p.PreviousRelease
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+// setupRepoForPromotion extends the two-component repo with dev bindings for
+// both components and a staging binding with overrides for my-svc.
+func setupRepoForPromotion(t *testing.T) string {
+	t.Helper()
+	home := testutil.SetupTestHome(t)
+	testutil.WriteOCConfig(t, home, &config.StoredConfig{
+		CurrentContext: "my-ctx",
+		Contexts:       []config.Context{{Name: "my-ctx", Namespace: "test-ns"}},
+	})
+
+	repoDir := setupRepoForBindingTwoComponents(t)
+	for _, component := range []string{"my-svc", "my-worker"} {
+		testutil.WriteYAML(t, repoDir, "projects/myproj/components/"+component+"/release-bindings/"+component+"-dev.yaml", `
+apiVersion: openchoreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: `+component+`-dev
+  namespace: test-ns
+spec:
+  owner:
+    projectName: myproj
+    componentName: `+component+`
+  environment: dev
+  releaseName: `+component+`-release-2
+`)
+	}
+	testutil.WriteYAML(t, repoDir, "projects/myproj/components/my-svc/release-bindings/my-svc-staging.yaml", `
+apiVersion: openchoreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: my-svc-staging
+  namespace: test-ns
+spec:
+  owner:
+    projectName: myproj
+    componentName: my-svc
+  environment: staging
+  releaseName: my-svc-release-1
+  workloadOverrides:
+    container:
+      env:
+        - key: LOG_LEVEL
+          value: warn
+`)
+	return repoDir
+}
+
+func TestPromote_Project(t *testing.T) {
+	repoDir := setupRepoForPromotion(t)
+
+	out := testutil.CaptureStdout(t, func() {
+		err := New(nil).Promote(PromoteParams{
+			Mode:        flags.ModeFileSystem,
+			RootDir:     repoDir,
+			SourceEnv:   "dev",
+			TargetEnv:   "staging",
+			ProjectName: "myproj",
+		})
+		require.NoError(t, err)
+	})
+
+	assert.Contains(t, out, "Promoted 2 component(s) from dev to staging")
+	assert.Contains(t, out, "Promote dev to staging\n\n"+
+		"- myproj/my-svc: my-svc-release-1 -> my-svc-release-2\n"+
+		"- myproj/my-worker: (none) -> my-worker-release-2\n")
+
+	svcStaging, err := os.ReadFile(filepath.Join(repoDir, "projects/myproj/components/my-svc/release-bindings/my-svc-staging.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(svcStaging), "releaseName: my-svc-release-2")
+	assert.Contains(t, string(svcStaging), "value: warn", "environment overrides must be kept")
+
+	workerStaging, err := os.ReadFile(filepath.Join(repoDir, "projects/myproj/components/my-worker/release-bindings/my-worker-staging.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(workerStaging), "releaseName: my-worker-release-2")
+	assert.Contains(t, string(workerStaging), "environment: staging")
+
+	// Promoting again has nothing left to move
+	out = testutil.CaptureStdout(t, func() {
+		err := New(nil).Promote(PromoteParams{
+			Mode:        flags.ModeFileSystem,
+			RootDir:     repoDir,
+			SourceEnv:   "dev",
+			TargetEnv:   "staging",
+			ProjectName: "myproj",
+		})
+		require.NoError(t, err)
+	})
+	assert.Contains(t, out, "Nothing to promote from dev to staging: 2 component(s) already up to date")
+}
+
+func TestPromote_UpdatesBindingFileWithAnotherName(t *testing.T) {
+	repoDir := setupRepoForPromotion(t)
+	bindingsDir := filepath.Join(repoDir, "projects/myproj/components/my-svc/release-bindings")
+	require.NoError(t, os.Rename(filepath.Join(bindingsDir, "my-svc-staging.yaml"), filepath.Join(bindingsDir, "staging.yaml")))
+
+	out := testutil.CaptureStdout(t, func() {
+		err := New(nil).Promote(PromoteParams{
+			Mode:          flags.ModeFileSystem,
+			RootDir:       repoDir,
+			SourceEnv:     "dev",
+			TargetEnv:     "staging",
+			ProjectName:   "myproj",
+			ComponentName: "my-svc",
+		})
+		require.NoError(t, err)
+	})
+
+	assert.Contains(t, out, "Updated: "+filepath.Join(bindingsDir, "staging.yaml"))
+	assert.Contains(t, out, "Promoted 1 component(s) from dev to staging")
+	assert.Contains(t, out, "- myproj/my-svc: my-svc-release-1 -> my-svc-release-2\n")
+	staging, err := os.ReadFile(filepath.Join(bindingsDir, "staging.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(staging), "releaseName: my-svc-release-2")
+	assert.NoFileExists(t, filepath.Join(bindingsDir, "my-svc-staging.yaml"))
+}
+
+func TestPromote_DryRunWritesNothing(t *testing.T) {
+	repoDir := setupRepoForPromotion(t)
+
+	out := testutil.CaptureStdout(t, func() {
+		err := New(nil).Promote(PromoteParams{
+			Mode:          flags.ModeFileSystem,
+			RootDir:       repoDir,
+			SourceEnv:     "dev",
+			TargetEnv:     "staging",
+			ProjectName:   "myproj",
+			ComponentName: "my-worker",
+			DryRun:        true,
+		})
+		require.NoError(t, err)
+	})
+
+	assert.Contains(t, out, "# Created binding: my-worker-staging")
+	assert.Contains(t, out, "Would promote 1 component(s) from dev to staging")
+	_, err := os.Stat(filepath.Join(repoDir, "projects/myproj/components/my-worker/release-bindings/my-worker-staging.yaml"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPromote_Errors(t *testing.T) {
+	repoDir := setupRepoForPromotion(t)
+
+	tests := []struct {
+		name    string
+		params  PromoteParams
+		wantErr string
+	}{
+		{
+			name:    "api-server mode",
+			params:  PromoteParams{SourceEnv: "dev", TargetEnv: "staging", ProjectName: "myproj"},
+			wantErr: "promote only supports file-system mode",
+		},
+		{
+			name:    "missing target",
+			params:  PromoteParams{Mode: flags.ModeFileSystem, SourceEnv: "dev", ProjectName: "myproj"},
+			wantErr: "both --from and --to are required",
+		},
+		{
+			name:    "reverse direction",
+			params:  PromoteParams{Mode: flags.ModeFileSystem, RootDir: repoDir, SourceEnv: "staging", TargetEnv: "dev", ProjectName: "myproj"},
+			wantErr: `no promotion path from "staging" to "dev"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			testutil.CaptureStdout(t, func() {
+				err = New(nil).Promote(tt.params)
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestPromoteCmd_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "no scope", args: []string{"--from", "dev", "--to", "staging"}, wantErr: "one of --all, --project, or --component must be specified"},
+		{name: "all with project", args: []string{"--from", "dev", "--to", "staging", "--all", "--project", "p"}, wantErr: "--all cannot be combined"},
+		{name: "all without pipeline", args: []string{"--from", "dev", "--to", "staging", "--all"}, wantErr: "--use-pipeline is required when using --all scope"},
+		{name: "component without project", args: []string{"--from", "dev", "--to", "staging", "--component", "c"}, wantErr: "--component requires --project"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewPromoteCmd()
+			require.NoError(t, cmd.ParseFlags(tt.args))
+			err := cmd.RunE(cmd, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
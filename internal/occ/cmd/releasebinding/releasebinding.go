@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 	"text/tabwriter"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -92,27 +91,9 @@ func (r *ReleaseBinding) Generate(params GenerateParams) error {
 	}
 
 	// 2. Load context for other defaults (namespace, etc.)
-	cfg, err := config.LoadStoredConfig()
+	ctx, err := currentContext()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	if cfg.CurrentContext == "" {
-		return fmt.Errorf("no current context set")
-	}
-
-	// Find current context
-	var ctx *config.Context
-	for _, c := range cfg.Contexts {
-		if c.Name == cfg.CurrentContext {
-			ctxCopy := c
-			ctx = &ctxCopy
-			break
-		}
-	}
-
-	if ctx == nil {
-		return fmt.Errorf("current context %q not found in config", cfg.CurrentContext)
+		return err
 	}
 
 	repoPath := params.RootDir
@@ -238,6 +219,27 @@ func (r *ReleaseBinding) Delete(params DeleteParams) error {
 	return nil
 }
 
+// currentContext returns the current context from the stored config.
+func currentContext() (*config.Context, error) {
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if cfg.CurrentContext == "" {
+		return nil, fmt.Errorf("no current context set")
+	}
+
+	for _, c := range cfg.Contexts {
+		if c.Name == cfg.CurrentContext {
+			ctxCopy := c
+			return &ctxCopy, nil
+		}
+	}
+
+	return nil, fmt.Errorf("current context %q not found in config", cfg.CurrentContext)
+}
+
 // loadReleaseConfig loads the release-config.yaml file
 func (r *ReleaseBinding) loadReleaseConfig(repoPath string, requireForBulk bool) (*occonfig.ReleaseConfig, error) {
 	configPath := filepath.Join(repoPath, releaseConfigFileName)
@@ -387,17 +389,14 @@ func (r *ReleaseBinding) writeResults(result *generator.BulkBindingResult, baseD
 			return fmt.Errorf("failed to write bindings: %w", err)
 		}
 
-		// Build lookup map from binding name to IsUpdate
-		updateMap := make(map[string]bool, len(result.Bindings))
-		for _, info := range result.Bindings {
-			updateMap[info.BindingName] = info.IsUpdate
-		}
-
 		// Print results
-		for _, path := range writeResult.OutputPaths {
-			bindingName := strings.TrimSuffix(filepath.Base(path), ".yaml")
+		for _, info := range result.Bindings {
+			path, ok := writeResult.WrittenPaths[info.BindingName]
+			if !ok {
+				continue
+			}
 			action := actionCreated
-			if updateMap[bindingName] {
+			if info.IsUpdate {
 				action = actionUpdated
 			}
 			fmt.Printf("%s: %s\n", action, path)
@@ -422,28 +421,37 @@ func (r *ReleaseBinding) writeResults(result *generator.BulkBindingResult, baseD
 // deriveUsePipeline resolves params.UsePipeline from the project's deploymentPipelineRef
 // when UsePipeline is not explicitly set.
 func deriveUsePipeline(ocIndex *fsmode.Index, namespace string, params *GenerateParams) error {
-	if params.UsePipeline == "" && params.All {
-		return fmt.Errorf("--use-pipeline is required when using --all")
+	pipelineName, err := resolvePipelineName(ocIndex, namespace, params.All, params.ProjectName, params.UsePipeline)
+	if err != nil {
+		return err
 	}
+	params.UsePipeline = pipelineName
+	return nil
+}
 
-	if params.UsePipeline == "" && params.ProjectName != "" {
-		projectEntry, ok := ocIndex.GetProject(namespace, params.ProjectName)
-		if !ok {
-			return fmt.Errorf("project %q not found in namespace %q", params.ProjectName, namespace)
-		}
-		pipelineRef := projectEntry.GetNestedString("spec", "deploymentPipelineRef", "name")
-		if pipelineRef == "" {
-			return fmt.Errorf("project %q has no deploymentPipelineRef set", params.ProjectName)
-		}
-		params.UsePipeline = pipelineRef
-		fmt.Printf("No --use-pipeline specified, using project's deploymentPipelineRef: %s\n", pipelineRef)
+// resolvePipelineName returns usePipeline when set, or else the deploymentPipelineRef
+// of the given project.
+func resolvePipelineName(ocIndex *fsmode.Index, namespace string, all bool, projectName, usePipeline string) (string, error) {
+	if usePipeline != "" {
+		return usePipeline, nil
 	}
-
-	if params.UsePipeline == "" {
-		return fmt.Errorf("--use-pipeline is required (could not derive from project)")
+	if all {
+		return "", fmt.Errorf("--use-pipeline is required when using --all")
+	}
+	if projectName == "" {
+		return "", fmt.Errorf("--use-pipeline is required (could not derive from project)")
 	}
 
-	return nil
+	projectEntry, ok := ocIndex.GetProject(namespace, projectName)
+	if !ok {
+		return "", fmt.Errorf("project %q not found in namespace %q", projectName, namespace)
+	}
+	pipelineRef := projectEntry.GetNestedString("spec", "deploymentPipelineRef", "name")
+	if pipelineRef == "" {
+		return "", fmt.Errorf("project %q has no deploymentPipelineRef set", projectName)
+	}
+	fmt.Printf("No --use-pipeline specified, using project's deploymentPipelineRef: %s\n", pipelineRef)
+	return pipelineRef, nil
 }
 
 func (r *ReleaseBinding) printYAML(resource interface{}) error {
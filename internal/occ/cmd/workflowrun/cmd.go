@@ -23,6 +23,9 @@ func NewWorkflowRunCmd(f client.NewClientFunc) *cobra.Command {
 		newListCmd(f),
 		newGetCmd(f),
 		newLogsCmd(f),
+		newCancelCmd(f),
+		newRetryCmd(f),
+		newResumeCmd(f),
 	)
 	return cmd
 }
@@ -109,3 +112,79 @@ or archived logs from the observer for completed runs.`,
 	flags.AddSince(cmd)
 	return cmd
 }
+
+func newCancelCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [WORKFLOW_RUN_NAME]",
+		Short: "Cancel a workflow run",
+		Long: `Cancel a workflow run.
+Running tasks are terminated and the run completes with the Cancelled status.`,
+		Example: `  # Cancel a workflow run
+  occ workflowrun cancel my-run --namespace acme-corp`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Cancel(ActionParams{
+				Namespace:       flags.GetNamespace(cmd),
+				WorkflowRunName: args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
+
+func newRetryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry [WORKFLOW_RUN_NAME]",
+		Short: "Retry a workflow run",
+		Long: `Retry a completed workflow run.
+Starts a new workflow run with the same workflow and parameters.`,
+		Example: `  # Retry a workflow run
+  occ workflowrun retry my-run --namespace acme-corp`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Retry(ActionParams{
+				Namespace:       flags.GetNamespace(cmd),
+				WorkflowRunName: args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
+
+func newResumeCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume [WORKFLOW_RUN_NAME]",
+		Short: "Resume a failed workflow run",
+		Long: `Resume a failed workflow run from its failed tasks.
+Tasks that already succeeded are kept and only the failed tasks are re-executed.
+Resuming is supported for runs executed by Argo Workflows.`,
+		Example: `  # Resume a failed workflow run
+  occ workflowrun resume my-run --namespace acme-corp`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Resume(ActionParams{
+				Namespace:       flags.GetNamespace(cmd),
+				WorkflowRunName: args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
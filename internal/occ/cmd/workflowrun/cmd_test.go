@@ -44,7 +44,7 @@ func TestNewWorkflowRunCmd_Subcommands(t *testing.T) {
 	for _, sub := range cmd.Commands() {
 		names = append(names, sub.Name())
 	}
-	assert.ElementsMatch(t, []string{"list", "get", "logs"}, names)
+	assert.ElementsMatch(t, []string{"list", "get", "logs", "cancel", "retry", "resume"}, names)
 }
 
 // --- list ---
@@ -119,3 +119,50 @@ func TestLogsCmd_FactoryError(t *testing.T) {
 	err := cmd.RunE(cmd, []string{"run-abc"})
 	assert.EqualError(t, err, "factory failed")
 }
+
+// --- cancel / retry / resume ---
+
+func TestCancelCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().CancelWorkflowRun(mock.Anything, "acme-corp", "run-abc").Return(
+		&gen.WorkflowRun{Metadata: gen.ObjectMeta{Name: "run-abc"}}, nil,
+	)
+
+	cmd := newCancelCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"run-abc"}))
+	})
+	assert.Contains(t, out, "Cancellation requested for workflow run 'run-abc'")
+}
+
+func TestRetryCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().RetryWorkflowRun(mock.Anything, "acme-corp", "run-abc").Return(
+		&gen.WorkflowRun{Metadata: gen.ObjectMeta{Name: "run-def"}}, nil,
+	)
+
+	cmd := newRetryCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"run-abc"}))
+	})
+	assert.Contains(t, out, "Successfully started workflow run: run-def")
+	assert.Contains(t, out, "Retry of: run-abc")
+}
+
+func TestResumeCmd_APIError(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ResumeWorkflowRun(mock.Anything, "acme-corp", "run-abc").Return(nil, fmt.Errorf("workflow run cannot be resumed"))
+
+	cmd := newResumeCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	assert.EqualError(t, cmd.RunE(cmd, []string{"run-abc"}), "workflow run cannot be resumed")
+}
+
+func TestResumeCmd_MissingArg(t *testing.T) {
+	cmd := newResumeCmd(errFactory("unused"))
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "required argument")
+}
@@ -5,6 +5,7 @@ package workflowrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -19,6 +20,13 @@ import (
 
 const defaultPlaneName = "default"
 
+// runStatusPollInterval is how often a followed run is checked for completion, and
+// runCompletionDrainPeriod how long its log tail stays open after it completed.
+var (
+	runStatusPollInterval    = 5 * time.Second
+	runCompletionDrainPeriod = 5 * time.Second
+)
+
 // Logs fetches and displays logs for a workflow run
 func (w *WorkflowRun) Logs(params LogsParams) error {
 	if err := cmdutil.RequireFields("logs", "workflowrun", map[string]string{"namespace": params.Namespace}); err != nil {
@@ -58,7 +66,7 @@ func (w *WorkflowRun) fetchLiveLogs(ctx context.Context, apiClient client.Interf
 	sinceSeconds := parseSinceToSeconds(params.Since)
 
 	if params.Follow {
-		return w.followLiveLogs(ctx, apiClient, params, sinceSeconds)
+		return w.tailLiveLogs(ctx, apiClient, params, sinceSeconds)
 	}
 
 	logParams := &gen.GetWorkflowRunLogsParams{}
@@ -75,6 +83,107 @@ func (w *WorkflowRun) fetchLiveLogs(ctx context.Context, apiClient client.Interf
 	return nil
 }
 
+// tailLiveLogs prints the live logs of the run and then streams new ones from the
+// observer's log tail until the run completes. Runs whose observer cannot be resolved
+// or does not provide the tail endpoint are followed by polling instead.
+func (w *WorkflowRun) tailLiveLogs(ctx context.Context, apiClient client.Interface, params LogsParams, sinceSeconds int64) error {
+	obsClient, _, err := newRunObserverClient(ctx, apiClient, params)
+	if err != nil {
+		return w.followLiveLogs(ctx, apiClient, params, sinceSeconds)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Initial fetch
+	logParams := &gen.GetWorkflowRunLogsParams{}
+	if sinceSeconds > 0 {
+		logParams.SinceSeconds = &sinceSeconds
+	}
+
+	entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, logParams)
+	if err != nil {
+		return fmt.Errorf("failed to get live logs: %w", err)
+	}
+	printLogEntries(entries)
+
+	// The tail is rendered with second precision and starts at the current second, so
+	// the entries of that second which were already printed are skipped.
+	tailStart := time.Now().UTC().Truncate(time.Second)
+	printed := map[string]int{}
+	for _, entry := range entries {
+		if entry.Timestamp != nil && !entry.Timestamp.Before(tailStart) {
+			printed[entry.Log]++
+		}
+	}
+
+	tailCtx, cancelTail := context.WithCancel(ctx)
+	defer cancelTail()
+	completed := make(chan struct{})
+	go func() {
+		if waitForRunCompletion(tailCtx, apiClient, params) {
+			close(completed)
+			// Give the observer time to ingest the last lines before closing the tail.
+			select {
+			case <-tailCtx.Done():
+			case <-time.After(runCompletionDrainPeriod):
+			}
+			cancelTail()
+		}
+	}()
+
+	err = obsClient.TailWorkflowRunLogs(tailCtx, params.Namespace, params.WorkflowRunName, tailStart.Format(time.RFC3339),
+		func(batch []client.LogEntry) {
+			for _, log := range batch {
+				if ts, err := time.Parse(time.RFC3339, log.Timestamp); err == nil && ts.Equal(tailStart) && printed[log.Log] > 0 {
+					printed[log.Log]--
+					continue
+				}
+				fmt.Printf("%s %s\n", log.Timestamp, log.Log)
+			}
+		})
+	switch {
+	case errors.Is(err, client.ErrLogTailUnsupported):
+		cancelTail()
+		return pollLiveLogs(ctx, apiClient, params, lastEntryTime(entries))
+	case err != nil:
+		return fmt.Errorf("log stream for workflow run %s failed: %w", params.WorkflowRunName, err)
+	}
+
+	select {
+	case <-completed:
+		fmt.Println("\nWorkflow run completed.")
+	default:
+		fmt.Println("\nStopping log streaming...")
+	}
+	return nil
+}
+
+// waitForRunCompletion polls the run status until live observability ends, reporting
+// whether it did before ctx was done.
+func waitForRunCompletion(ctx context.Context, apiClient client.Interface, params LogsParams) bool {
+	ticker := time.NewTicker(runStatusPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			status, err := apiClient.GetWorkflowRunStatus(ctx, params.Namespace, params.WorkflowRunName)
+			if err != nil {
+				if ctx.Err() == nil {
+					fmt.Fprintf(os.Stderr, "Error checking workflow run status: %v\n", err)
+				}
+				continue
+			}
+			if !status.HasLiveObservability {
+				return true
+			}
+		}
+	}
+}
+
 // followLiveLogs continuously polls for new live logs
 func (w *WorkflowRun) followLiveLogs(ctx context.Context, apiClient client.Interface, params LogsParams, sinceSeconds int64) error {
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
@@ -92,14 +201,21 @@ func (w *WorkflowRun) followLiveLogs(ctx context.Context, apiClient client.Inter
 	}
 	printLogEntries(entries)
 
-	// Track the last-seen timestamp to avoid printing duplicates
-	var lastSeen time.Time
+	return pollLiveLogs(ctx, apiClient, params, lastEntryTime(entries))
+}
+
+// lastEntryTime returns the timestamp of the last entry, or the zero time.
+func lastEntryTime(entries []gen.WorkflowRunLogEntry) time.Time {
 	if len(entries) > 0 {
 		if ts := entries[len(entries)-1].Timestamp; ts != nil {
-			lastSeen = *ts
+			return *ts
 		}
 	}
+	return time.Time{}
+}
 
+// pollLiveLogs polls for live logs newer than lastSeen until the run completes.
+func pollLiveLogs(ctx context.Context, apiClient client.Interface, params LogsParams, lastSeen time.Time) error {
 	// Poll with a window large enough to not miss logs, deduplicate client-side
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
@@ -169,33 +285,9 @@ func filterNewEntries(entries []gen.WorkflowRunLogEntry, lastSeen time.Time) []g
 
 // fetchArchivedLogs fetches logs from the observer (OpenSearch)
 func (w *WorkflowRun) fetchArchivedLogs(ctx context.Context, apiClient client.Interface, params LogsParams) error {
-	// Get the workflow run to find its workflow name and UID
-	workflowRun, err := apiClient.GetWorkflowRun(ctx, params.Namespace, params.WorkflowRunName)
-	if err != nil {
-		return fmt.Errorf("failed to get workflow run: %w", err)
-	}
-
-	workflowName := ""
-	if workflowRun.Spec != nil {
-		workflowName = workflowRun.Spec.Workflow.Name
-	}
-	if workflowName == "" {
-		return fmt.Errorf("workflow run %s has no workflow reference", params.WorkflowRunName)
-	}
-
-	// Resolve the observer URL from the workflow plane chain
-	observerURL, err := resolveObserverURL(ctx, apiClient, params.Namespace, workflowName)
+	obsClient, observerURL, err := newRunObserverClient(ctx, apiClient, params)
 	if err != nil {
-		return fmt.Errorf("failed to resolve observer URL: %w", err)
-	}
-
-	// Get credential for observer API auth
-	credential, err := config.GetCurrentCredential()
-	if err != nil {
-		return fmt.Errorf("failed to get credentials: %w", err)
-	}
-	if credential == nil {
-		return fmt.Errorf("no current credential available")
+		return err
 	}
 
 	// Calculate time range
@@ -211,7 +303,6 @@ func (w *WorkflowRun) fetchArchivedLogs(ctx context.Context, apiClient client.In
 	startTime := time.Now().Add(-duration)
 	endTime := time.Now()
 
-	obsClient := client.NewObserverClient(observerURL, credential.Token)
 	logResponse, err := obsClient.FetchWorkflowRunLogs(ctx, params.WorkflowRunName, client.WorkflowRunLogsRequest{
 		NamespaceName: params.Namespace,
 		StartTime:     startTime.Format(time.RFC3339),
@@ -238,6 +329,41 @@ func (w *WorkflowRun) fetchArchivedLogs(ctx context.Context, apiClient client.In
 	return nil
 }
 
+// newRunObserverClient creates a client for the observer that stores the logs of the
+// workflow run, and returns it with the observer URL.
+func newRunObserverClient(ctx context.Context, apiClient client.Interface, params LogsParams) (*client.ObserverClient, string, error) {
+	// Get the workflow run to find its workflow name and UID
+	workflowRun, err := apiClient.GetWorkflowRun(ctx, params.Namespace, params.WorkflowRunName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get workflow run: %w", err)
+	}
+
+	workflowName := ""
+	if workflowRun.Spec != nil {
+		workflowName = workflowRun.Spec.Workflow.Name
+	}
+	if workflowName == "" {
+		return nil, "", fmt.Errorf("workflow run %s has no workflow reference", params.WorkflowRunName)
+	}
+
+	// Resolve the observer URL from the workflow plane chain
+	observerURL, err := resolveObserverURL(ctx, apiClient, params.Namespace, workflowName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve observer URL: %w", err)
+	}
+
+	// Get credential for observer API auth
+	credential, err := config.GetCurrentCredential()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get credentials: %w", err)
+	}
+	if credential == nil {
+		return nil, "", fmt.Errorf("no current credential available")
+	}
+
+	return client.NewObserverClient(observerURL, credential.Token), observerURL, nil
+}
+
 // resolveObserverURL resolves the observer URL by traversing:
 // Workflow.WorkflowPlaneRef -> WorkflowPlane/ClusterWorkflowPlane -> ObservabilityPlane/ClusterObservabilityPlane -> ObserverURL
 // workflowName must be non-empty and the workflow's workflowPlaneRef is always set by CRD defaulting.
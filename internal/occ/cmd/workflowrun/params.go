@@ -28,3 +28,11 @@ type LogsParams struct {
 }
 
 func (p LogsParams) GetNamespace() string { return p.Namespace }
+
+// ActionParams defines parameters for cancelling, retrying or resuming a workflow run
+type ActionParams struct {
+	Namespace       string
+	WorkflowRunName string
+}
+
+func (p ActionParams) GetNamespace() string { return p.Namespace }
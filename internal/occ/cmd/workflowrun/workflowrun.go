@@ -127,6 +127,50 @@ func (w *WorkflowRun) Get(params GetParams) error {
 	return nil
 }
 
+// Cancel requests the cancellation of a running workflow run
+func (w *WorkflowRun) Cancel(params ActionParams) error {
+	if err := cmdutil.RequireFields("cancel", "workflowrun", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	if _, err := w.client.CancelWorkflowRun(context.Background(), params.Namespace, params.WorkflowRunName); err != nil {
+		return err
+	}
+
+	fmt.Printf("Cancellation requested for workflow run '%s'\n", params.WorkflowRunName)
+	return nil
+}
+
+// Retry starts a new workflow run with the same workflow and parameters as a completed run
+func (w *WorkflowRun) Retry(params ActionParams) error {
+	if err := cmdutil.RequireFields("retry", "workflowrun", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	run, err := w.client.RetryWorkflowRun(context.Background(), params.Namespace, params.WorkflowRunName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully started workflow run: %s\n", run.Metadata.Name)
+	fmt.Printf("  Retry of: %s\n", params.WorkflowRunName)
+	return nil
+}
+
+// Resume resumes a failed workflow run from its failed tasks
+func (w *WorkflowRun) Resume(params ActionParams) error {
+	if err := cmdutil.RequireFields("resume", "workflowrun", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	if _, err := w.client.ResumeWorkflowRun(context.Background(), params.Namespace, params.WorkflowRunName); err != nil {
+		return err
+	}
+
+	fmt.Printf("Resume requested for workflow run '%s'\n", params.WorkflowRunName)
+	return nil
+}
+
 func PrintList(items []gen.WorkflowRun) error {
 	if len(items) == 0 {
 		fmt.Println("No workflow runs found")
@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewWorkflowRunGroupCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "workflowrungroup",
+		Aliases: []string{"wfrg", "workflowrungroups"},
+		Short:   "Manage workflow run groups",
+		Long:    `Manage workflow run groups for OpenChoreo. A workflow run group builds several components of the same commit.`,
+	}
+	cmd.AddCommand(
+		newListCmd(f),
+		newGetCmd(f),
+	)
+	return cmd
+}
+
+func newListCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List workflow run groups",
+		Long:  `List all workflow run groups in a namespace with their aggregate status.`,
+		Example: `  # List all workflow run groups in a namespace
+  occ workflowrungroup list --namespace acme-corp`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).List(ListParams{
+				Namespace: flags.GetNamespace(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
+
+func newGetCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [WORKFLOW_RUN_GROUP_NAME]",
+		Short: "Get a workflow run group",
+		Long:  `Get a workflow run group and display its details in YAML format.`,
+		Example: `  # Get a workflow run group
+  occ workflowrungroup get build-1a2b3c4-5d6e7f80 --namespace acme-corp`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Get(GetParams{
+				Namespace:            flags.GetNamespace(cmd),
+				WorkflowRunGroupName: args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func mockFactory(mc *mocks.MockInterface) client.NewClientFunc {
+	return func() (client.Interface, error) {
+		return mc, nil
+	}
+}
+
+func errFactory(msg string) client.NewClientFunc {
+	return func() (client.Interface, error) {
+		return nil, fmt.Errorf("%s", msg)
+	}
+}
+
+// --- NewWorkflowRunGroupCmd structure ---
+
+func TestNewWorkflowRunGroupCmd_Use(t *testing.T) {
+	cmd := NewWorkflowRunGroupCmd(errFactory("unused"))
+	assert.Equal(t, "workflowrungroup", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "wfrg")
+	assert.Contains(t, cmd.Aliases, "workflowrungroups")
+}
+
+func TestNewWorkflowRunGroupCmd_Subcommands(t *testing.T) {
+	cmd := NewWorkflowRunGroupCmd(errFactory("unused"))
+	names := make([]string, 0, len(cmd.Commands()))
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"list", "get"}, names)
+}
+
+// --- list ---
+
+func TestListCmd_FactoryError(t *testing.T) {
+	cmd := newListCmd(errFactory("factory failed"))
+	err := cmd.RunE(cmd, nil)
+	assert.EqualError(t, err, "factory failed")
+}
+
+func TestListCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListWorkflowRunGroups(mock.Anything, "acme-corp", mock.Anything).Return(&gen.WorkflowRunGroupList{
+		Items:      []gen.WorkflowRunGroup{{Metadata: gen.ObjectMeta{Name: "build-abc1234"}}},
+		Pagination: gen.Pagination{},
+	}, nil)
+
+	cmd := newListCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, nil))
+	})
+	assert.Contains(t, out, "build-abc1234")
+}
+
+// --- get ---
+
+func TestGetCmd_MissingArg(t *testing.T) {
+	cmd := newGetCmd(errFactory("unused"))
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "required argument")
+}
+
+func TestGetCmd_FactoryError(t *testing.T) {
+	cmd := newGetCmd(errFactory("factory failed"))
+	err := cmd.RunE(cmd, []string{"build-abc1234"})
+	assert.EqualError(t, err, "factory failed")
+}
+
+func TestGetCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunGroup(mock.Anything, "acme-corp", "build-abc1234").Return(
+		&gen.WorkflowRunGroup{Metadata: gen.ObjectMeta{Name: "build-abc1234"}}, nil,
+	)
+
+	cmd := newGetCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"build-abc1234"}))
+	})
+	assert.Contains(t, out, "name: build-abc1234")
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+// ListParams defines parameters for listing workflow run groups
+type ListParams struct {
+	Namespace string
+}
+
+func (p ListParams) GetNamespace() string { return p.Namespace }
+
+// GetParams defines parameters for getting a single workflow run group
+type GetParams struct {
+	Namespace            string
+	WorkflowRunGroupName string
+}
+
+func (p GetParams) GetNamespace() string { return p.Namespace }
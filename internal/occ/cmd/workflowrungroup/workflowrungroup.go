@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+const conditionStatusTrue = "True"
+
+// WorkflowRunGroup implements workflow run group operations
+type WorkflowRunGroup struct {
+	client client.Interface
+}
+
+// New creates a new workflow run group implementation
+func New(c client.Interface) *WorkflowRunGroup {
+	return &WorkflowRunGroup{client: c}
+}
+
+// List lists workflow run groups in a namespace
+func (w *WorkflowRunGroup) List(params ListParams) error {
+	if err := cmdutil.RequireFields("list", "workflowrungroup", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.WorkflowRunGroup, string, error) {
+		p := &gen.ListWorkflowRunGroupsParams{}
+		p.Limit = &limit
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := w.client.ListWorkflowRunGroups(ctx, params.Namespace, p)
+		if err != nil {
+			return nil, "", err
+		}
+		next := ""
+		if result.Pagination.NextCursor != nil {
+			next = *result.Pagination.NextCursor
+		}
+		return result.Items, next, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return printList(items)
+}
+
+// Get retrieves a single workflow run group and outputs it as YAML
+func (w *WorkflowRunGroup) Get(params GetParams) error {
+	if err := cmdutil.RequireFields("get", "workflowrungroup", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	result, err := w.client.GetWorkflowRunGroup(context.Background(), params.Namespace, params.WorkflowRunGroupName)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal workflow run group to YAML: %w", err)
+	}
+
+	fmt.Print(string(data))
+	return nil
+}
+
+func printList(items []gen.WorkflowRunGroup) error {
+	if len(items) == 0 {
+		fmt.Println("No workflow run groups found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCOMMIT\tRUNS\tSUCCEEDED\tFAILED\tSTATUS\tAGE")
+
+	for _, group := range items {
+		commit := ""
+		if group.Spec != nil && group.Spec.Commit != nil {
+			commit = *group.Spec.Commit
+		}
+		var total, succeeded, failed int32
+		status := "Pending"
+		if group.Status != nil {
+			total = deref(group.Status.Total)
+			succeeded = deref(group.Status.Succeeded)
+			failed = deref(group.Status.Failed)
+			status = deriveStatus(group.Status)
+		}
+		age := "<unknown>"
+		if group.Metadata.CreationTimestamp != nil {
+			age = utils.FormatAge(*group.Metadata.CreationTimestamp)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\n",
+			group.Metadata.Name,
+			commit,
+			total,
+			succeeded,
+			failed,
+			status,
+			age)
+	}
+
+	return w.Flush()
+}
+
+// deriveStatus maps the aggregate conditions of a WorkflowRunGroup to a human-readable status.
+// A group that has not completed is reported as Failing once one of its runs failed.
+func deriveStatus(status *gen.WorkflowRunGroupStatus) string {
+	conds := map[string]gen.Condition{}
+	if status.Conditions != nil {
+		for _, c := range *status.Conditions {
+			conds[c.Type] = c
+		}
+	}
+
+	succeeded, hasSucceeded := conds["WorkflowRunGroupSucceeded"]
+	if c, ok := conds["WorkflowRunGroupCompleted"]; ok && c.Status == conditionStatusTrue {
+		if hasSucceeded && succeeded.Status == conditionStatusTrue {
+			return "Succeeded"
+		}
+		return "Failed"
+	}
+	if hasSucceeded && succeeded.Status != conditionStatusTrue {
+		return "Failing"
+	}
+	if deref(status.Running) > 0 || deref(status.Succeeded) > 0 {
+		return "Running"
+	}
+	return "Pending"
+}
+
+func deref(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrungroup
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func conditions(completed, succeeded string) *[]gen.Condition {
+	var conds []gen.Condition
+	if completed != "" {
+		conds = append(conds, gen.Condition{Type: "WorkflowRunGroupCompleted", Status: gen.ConditionStatus(completed)})
+	}
+	if succeeded != "" {
+		conds = append(conds, gen.Condition{Type: "WorkflowRunGroupSucceeded", Status: gen.ConditionStatus(succeeded)})
+	}
+	return &conds
+}
+
+func TestDeriveStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status gen.WorkflowRunGroupStatus
+		want   string
+	}{
+		{name: "no conditions", status: gen.WorkflowRunGroupStatus{}, want: "Pending"},
+		{name: "in progress", status: gen.WorkflowRunGroupStatus{Conditions: conditions("False", ""), Running: ptr.To[int32](2)}, want: "Running"},
+		{name: "failing", status: gen.WorkflowRunGroupStatus{Conditions: conditions("False", "False"), Running: ptr.To[int32](1)}, want: "Failing"},
+		{name: "succeeded", status: gen.WorkflowRunGroupStatus{Conditions: conditions("True", "True")}, want: "Succeeded"},
+		{name: "failed", status: gen.WorkflowRunGroupStatus{Conditions: conditions("True", "False")}, want: "Failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, deriveStatus(&tt.status))
+		})
+	}
+}
+
+func TestList_PrintsAggregateStatus(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListWorkflowRunGroups(mock.Anything, "acme-corp", mock.Anything).Return(&gen.WorkflowRunGroupList{
+		Items: []gen.WorkflowRunGroup{{
+			Metadata: gen.ObjectMeta{Name: "build-abc1234"},
+			Spec:     &gen.WorkflowRunGroupSpec{Commit: ptr.To("abc1234")},
+			Status: &gen.WorkflowRunGroupStatus{
+				Conditions: conditions("True", "False"),
+				Total:      ptr.To[int32](3),
+				Succeeded:  ptr.To[int32](2),
+				Failed:     ptr.To[int32](1),
+			},
+		}},
+	}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).List(ListParams{Namespace: "acme-corp"}))
+	})
+	assert.Contains(t, out, "NAME")
+	assert.Regexp(t, `build-abc1234\s+abc1234\s+3\s+2\s+1\s+Failed`, out)
+}
+
+func TestList_Empty(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListWorkflowRunGroups(mock.Anything, "acme-corp", mock.Anything).Return(&gen.WorkflowRunGroupList{}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).List(ListParams{Namespace: "acme-corp"}))
+	})
+	assert.Contains(t, out, "No workflow run groups found")
+}
+
+func TestList_RequiresNamespace(t *testing.T) {
+	err := New(mocks.NewMockInterface(t)).List(ListParams{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "namespace")
+}
+
+func TestGet_PropagatesError(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunGroup(mock.Anything, "acme-corp", "nope").Return(nil, errors.New("not found"))
+
+	err := New(mc).Get(GetParams{Namespace: "acme-corp", WorkflowRunGroupName: "nope"})
+	assert.EqualError(t, err, "not found")
+}
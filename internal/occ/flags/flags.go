@@ -101,6 +101,23 @@ func GetTail(cmd *cobra.Command) int {
 	return val
 }
 
+// --- Log filters ---
+
+func AddLogFilters(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("level", nil, "Only show logs of these levels (DEBUG, INFO, WARN, ERROR)")
+	cmd.Flags().String("search", "", "Only show logs containing this phrase")
+}
+
+func GetLogLevels(cmd *cobra.Command) []string {
+	val, _ := cmd.Flags().GetStringSlice("level")
+	return val
+}
+
+func GetLogSearch(cmd *cobra.Command) string {
+	val, _ := cmd.Flags().GetString("search")
+	return val
+}
+
 // --- Mode ---
 
 func AddMode(cmd *cobra.Command) {
@@ -178,6 +195,17 @@ func GetRelease(cmd *cobra.Command) string {
 	return val
 }
 
+// --- From ---
+
+func AddFrom(cmd *cobra.Command) {
+	cmd.Flags().String("from", "", "Source environment to promote from")
+}
+
+func GetFrom(cmd *cobra.Command) string {
+	val, _ := cmd.Flags().GetString("from")
+	return val
+}
+
 // --- To ---
 
 func AddTo(cmd *cobra.Command) {
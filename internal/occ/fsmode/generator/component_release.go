@@ -4,7 +4,10 @@
 package generator
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,7 +27,8 @@ const (
 
 // ReleaseGenerator generates ComponentRelease resources
 type ReleaseGenerator struct {
-	index *fsmode.Index
+	index         *fsmode.Index
+	imageResolver componentrelease.ResolverFactory
 }
 
 // NewReleaseGenerator creates a new release generator
@@ -32,6 +36,13 @@ func NewReleaseGenerator(index *fsmode.Index) *ReleaseGenerator {
 	return &ReleaseGenerator{index: index}
 }
 
+// WithImageResolver makes the generator pin workload images to the digests resolved by
+// resolver, as the controller and API server do. Images are not pinned by default.
+func (g *ReleaseGenerator) WithImageResolver(resolver componentrelease.ResolverFactory) *ReleaseGenerator {
+	g.imageResolver = resolver
+	return g
+}
+
 // ReleaseOptions configures release generation
 type ReleaseOptions struct {
 	ComponentName string
@@ -124,7 +135,7 @@ func (g *ReleaseGenerator) GenerateRelease(opts ReleaseOptions) (*unstructured.U
 		}
 	}
 
-	crSpec, err := componentrelease.BuildSpec(componentrelease.BuildInput{
+	crSpec, err := componentrelease.BuildSpec(context.Background(), componentrelease.BuildInput{
 		Component: comp.Component,
 		ComponentType: v1alpha1.ComponentReleaseComponentType{
 			Kind: v1alpha1.ComponentTypeRefKind(ctKind),
@@ -134,8 +145,13 @@ func (g *ReleaseGenerator) GenerateRelease(opts ReleaseOptions) (*unstructured.U
 		Traits:        traits,
 		ClusterTraits: clusterTraits,
 		Workload:      &wl.Spec.WorkloadTemplateSpec,
+		ImageResolver: g.imageResolver,
 	})
-	if err != nil {
+	var pinErr *componentrelease.ImagePinError
+	if errors.As(err, &pinErr) {
+		// Pinning is best-effort; the release keeps the image as authored
+		fmt.Fprintf(os.Stderr, "Warning: component %q: %v\n", opts.ComponentName, pinErr)
+	} else if err != nil {
 		return nil, fmt.Errorf("failed to build component release spec: %w", err)
 	}
 
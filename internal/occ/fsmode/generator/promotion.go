@@ -0,0 +1,191 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/pipeline"
+)
+
+// PromoteOptions defines options for promoting releases between environments
+type PromoteOptions struct {
+	SourceEnv     string
+	TargetEnv     string
+	All           bool   // Promote all components in the repository
+	ProjectName   string // Promote all components of this project, or the component below
+	ComponentName string // Promote a single component (requires ProjectName)
+	PipelineInfo  *pipeline.PipelineInfo
+	Namespace     string
+}
+
+// PromotionResult contains the results of a promotion
+type PromotionResult struct {
+	Promotions []Promotion
+	Skipped    []BindingError // components that have nothing bound in the source environment
+	Errors     []BindingError
+}
+
+// Promotion describes the release moved for one component
+type Promotion struct {
+	BindingInfo
+	PreviousRelease string // release bound in the target environment before, empty for new bindings
+}
+
+// Changed reports whether the promotion changes the target binding.
+func (p Promotion) Changed() bool {
+	return p.PreviousRelease != p.ReleaseName
+}
+
+// Promote copies the release bound in the source environment to the target
+// environment for every component in scope. Existing target bindings are
+// read from disk and only their releaseName is changed, so per-environment
+// overrides are kept; missing ones are created.
+func (g *BindingGenerator) Promote(opts PromoteOptions) (*PromotionResult, error) {
+	if opts.SourceEnv == "" {
+		return nil, fmt.Errorf("source environment is required")
+	}
+	if opts.TargetEnv == "" {
+		return nil, fmt.Errorf("target environment is required")
+	}
+	if opts.PipelineInfo == nil {
+		return nil, fmt.Errorf("pipeline info is required")
+	}
+	if opts.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if err := opts.PipelineInfo.ValidateEnvironment(opts.SourceEnv); err != nil {
+		return nil, err
+	}
+	if err := opts.PipelineInfo.ValidateEnvironment(opts.TargetEnv); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(opts.PipelineInfo.PromotionPaths[opts.SourceEnv], opts.TargetEnv) {
+		return nil, fmt.Errorf("deployment pipeline %q has no promotion path from %q to %q",
+			opts.PipelineInfo.Name, opts.SourceEnv, opts.TargetEnv)
+	}
+
+	components, err := g.componentsInScope(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PromotionResult{}
+	for _, owner := range components {
+		sourceBinding, ok := g.index.GetReleaseBindingForEnv(owner.ProjectName, owner.ComponentName, opts.SourceEnv)
+		if !ok {
+			skipErr := fmt.Errorf("no ReleaseBinding found in environment %q", opts.SourceEnv)
+			if opts.ComponentName != "" {
+				return nil, fmt.Errorf("%w for component %s/%s", skipErr, owner.ProjectName, owner.ComponentName)
+			}
+			result.Skipped = append(result.Skipped, BindingError{
+				ProjectName:   owner.ProjectName,
+				ComponentName: owner.ComponentName,
+				Error:         skipErr,
+			})
+			continue
+		}
+
+		promotion, err := g.promoteComponent(owner, sourceBinding.GetNestedString("spec", "releaseName"), opts)
+		if err != nil {
+			if opts.ComponentName != "" {
+				return nil, err
+			}
+			result.Errors = append(result.Errors, BindingError{
+				ProjectName:   owner.ProjectName,
+				ComponentName: owner.ComponentName,
+				Error:         err,
+			})
+			continue
+		}
+		result.Promotions = append(result.Promotions, *promotion)
+	}
+
+	return result, nil
+}
+
+// componentsInScope returns the owners of the components to promote, sorted by project and component.
+func (g *BindingGenerator) componentsInScope(opts PromoteOptions) ([]*fsmode.OwnerRef, error) {
+	var components []*fsmode.OwnerRef
+	switch {
+	case opts.ComponentName != "":
+		if opts.ProjectName == "" {
+			return nil, fmt.Errorf("project name is required to promote a component")
+		}
+		entry, ok := g.index.GetComponent(opts.Namespace, opts.ComponentName)
+		if !ok {
+			return nil, fmt.Errorf("component %q not found in namespace %q", opts.ComponentName, opts.Namespace)
+		}
+		owner := fsmode.ExtractOwnerRef(entry)
+		if owner == nil || owner.ProjectName != opts.ProjectName {
+			return nil, fmt.Errorf("component %q does not belong to project %q", opts.ComponentName, opts.ProjectName)
+		}
+		components = append(components, owner)
+	case opts.ProjectName != "":
+		for _, entry := range g.index.ListComponentsForProject(opts.ProjectName) {
+			if owner := fsmode.ExtractOwnerRef(entry); owner != nil {
+				components = append(components, owner)
+			}
+		}
+	case opts.All:
+		for _, entry := range g.index.ListComponents() {
+			if owner := fsmode.ExtractOwnerRef(entry); owner != nil {
+				components = append(components, owner)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("either All, ProjectName or ComponentName must be specified")
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		if components[i].ProjectName != components[j].ProjectName {
+			return components[i].ProjectName < components[j].ProjectName
+		}
+		return components[i].ComponentName < components[j].ComponentName
+	})
+	return components, nil
+}
+
+// promoteComponent builds the target environment binding of one component for the given release.
+func (g *BindingGenerator) promoteComponent(owner *fsmode.OwnerRef, releaseName string, opts PromoteOptions) (*Promotion, error) {
+	if releaseName == "" {
+		return nil, fmt.Errorf("ReleaseBinding in environment %q has no releaseName set", opts.SourceEnv)
+	}
+
+	promotion := &Promotion{
+		BindingInfo: BindingInfo{
+			ProjectName:   owner.ProjectName,
+			ComponentName: owner.ComponentName,
+			ReleaseName:   releaseName,
+			Environment:   opts.TargetEnv,
+		},
+	}
+
+	var binding *unstructured.Unstructured
+	if existing, ok := g.index.GetReleaseBindingForEnv(owner.ProjectName, owner.ComponentName, opts.TargetEnv); ok {
+		// UPDATE: keep everything in the file, including the environment overrides
+		var err error
+		binding, err = readBindingFromFile(existing.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		promotion.PreviousRelease = getNestedString(binding.Object, "spec", "releaseName")
+		if err := unstructured.SetNestedField(binding.Object, releaseName, "spec", "releaseName"); err != nil {
+			return nil, fmt.Errorf("failed to update releaseName in existing binding: %w", err)
+		}
+		promotion.IsUpdate = true
+		promotion.ExistingFilePath = existing.FilePath
+	} else {
+		binding = g.buildMinimalBinding(owner.ProjectName, owner.ComponentName, releaseName, opts.TargetEnv, opts.Namespace)
+	}
+
+	promotion.BindingName = binding.GetName()
+	promotion.Binding = binding
+	return promotion, nil
+}
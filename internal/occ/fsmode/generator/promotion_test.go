@@ -0,0 +1,196 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode"
+	"github.com/openchoreo/openchoreo/internal/occ/fsmode/pipeline"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
+)
+
+func TestPromote(t *testing.T) {
+	const (
+		namespace   = "test-ns"
+		projectName = "my-proj"
+	)
+
+	// Pipeline with dev -> staging -> prod
+	pipelineInfo := &pipeline.PipelineInfo{
+		Name:            "test-pipeline",
+		RootEnvironment: "dev",
+		Environments:    []string{"dev", "staging", "prod"},
+		PromotionPaths:  map[string][]string{"dev": {"staging"}, "staging": {"prod"}},
+		EnvPosition:     map[string]int{"dev": 0, "staging": 1, "prod": 2},
+	}
+
+	// api: dev has a new release, staging has an older one with overrides
+	// web: only bound in dev
+	// worker: not bound in dev
+	// cron: dev and staging are already at the same release
+	newRepo := func(t *testing.T) (*BindingGenerator, string) {
+		t.Helper()
+		dir := t.TempDir()
+		idx := index.New(dir)
+		for _, name := range []string{"api", "web", "worker", "cron"} {
+			addComponentWithKind(t, idx, namespace, name, projectName, "my-type", "ComponentType",
+				filepath.Join(dir, name+".yaml"))
+		}
+		addComponentWithKind(t, idx, namespace, "other", "other-proj", "my-type", "ComponentType",
+			filepath.Join(dir, "other.yaml"))
+
+		addReleaseBinding(t, idx, namespace, "api-dev", projectName, "api", "dev", "api-2", filepath.Join(dir, "api-dev.yaml"))
+		addReleaseBinding(t, idx, namespace, "web-dev", projectName, "web", "dev", "web-1", filepath.Join(dir, "web-dev.yaml"))
+		addReleaseBinding(t, idx, namespace, "cron-dev", projectName, "cron", "dev", "cron-1", filepath.Join(dir, "cron-dev.yaml"))
+		addReleaseBinding(t, idx, namespace, "cron-staging", projectName, "cron", "staging", "cron-1",
+			filepath.Join(dir, "cron-staging.yaml"))
+
+		stagingPath := filepath.Join(dir, "api-staging.yaml")
+		require.NoError(t, os.WriteFile(stagingPath, []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: api-staging
+  namespace: test-ns
+spec:
+  owner:
+    projectName: my-proj
+    componentName: api
+  environment: staging
+  releaseName: api-1
+  componentTypeEnvironmentConfigs:
+    replicas: 3
+`), 0600))
+		addReleaseBinding(t, idx, namespace, "api-staging", projectName, "api", "staging", "api-1", stagingPath)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cron-staging.yaml"), []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: cron-staging
+spec:
+  owner:
+    projectName: my-proj
+    componentName: cron
+  environment: staging
+  releaseName: cron-1
+`), 0600))
+
+		return NewBindingGenerator(fsmode.WrapIndex(idx)), stagingPath
+	}
+
+	t.Run("project scope", func(t *testing.T) {
+		gen, stagingPath := newRepo(t)
+		result, err := gen.Promote(PromoteOptions{
+			SourceEnv:    "dev",
+			TargetEnv:    "staging",
+			ProjectName:  projectName,
+			PipelineInfo: pipelineInfo,
+			Namespace:    namespace,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Promotions, 3)
+		assert.Empty(t, result.Errors)
+		require.Len(t, result.Skipped, 1)
+		assert.Equal(t, "worker", result.Skipped[0].ComponentName)
+
+		api, cron, web := result.Promotions[0], result.Promotions[1], result.Promotions[2]
+
+		assert.Equal(t, "api", api.ComponentName)
+		assert.True(t, api.Changed())
+		assert.True(t, api.IsUpdate)
+		assert.Equal(t, stagingPath, api.ExistingFilePath)
+		assert.Equal(t, "api-1", api.PreviousRelease)
+		assert.Equal(t, "api-2", getNestedString(api.Binding.Object, "spec", "releaseName"))
+		replicas, _, _ := unstructured.NestedFieldNoCopy(api.Binding.Object, "spec", "componentTypeEnvironmentConfigs", "replicas")
+		assert.EqualValues(t, 3, replicas, "environment overrides must be kept")
+
+		assert.Equal(t, "cron", cron.ComponentName)
+		assert.False(t, cron.Changed())
+
+		assert.Equal(t, "web", web.ComponentName)
+		assert.True(t, web.Changed())
+		assert.False(t, web.IsUpdate)
+		assert.Equal(t, "web-staging", web.BindingName)
+		assert.Equal(t, "", web.PreviousRelease)
+		assert.Equal(t, "staging", getNestedString(web.Binding.Object, "spec", "environment"))
+	})
+
+	t.Run("all scope includes other projects", func(t *testing.T) {
+		gen, _ := newRepo(t)
+		result, err := gen.Promote(PromoteOptions{
+			SourceEnv:    "dev",
+			TargetEnv:    "staging",
+			All:          true,
+			PipelineInfo: pipelineInfo,
+			Namespace:    namespace,
+		})
+		require.NoError(t, err)
+		assert.Len(t, result.Promotions, 3)
+		assert.Len(t, result.Skipped, 2)
+	})
+
+	t.Run("single component without source binding fails", func(t *testing.T) {
+		gen, _ := newRepo(t)
+		_, err := gen.Promote(PromoteOptions{
+			SourceEnv:     "dev",
+			TargetEnv:     "staging",
+			ProjectName:   projectName,
+			ComponentName: "worker",
+			PipelineInfo:  pipelineInfo,
+			Namespace:     namespace,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `no ReleaseBinding found in environment "dev" for component my-proj/worker`)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		gen, _ := newRepo(t)
+		tests := []struct {
+			name    string
+			opts    PromoteOptions
+			wantErr string
+		}{
+			{
+				name:    "missing source",
+				opts:    PromoteOptions{TargetEnv: "staging", ProjectName: projectName, PipelineInfo: pipelineInfo, Namespace: namespace},
+				wantErr: "source environment is required",
+			},
+			{
+				name:    "unknown environment",
+				opts:    PromoteOptions{SourceEnv: "dev", TargetEnv: "qa", ProjectName: projectName, PipelineInfo: pipelineInfo, Namespace: namespace},
+				wantErr: `environment "qa" does not exist`,
+			},
+			{
+				name:    "skipping an environment",
+				opts:    PromoteOptions{SourceEnv: "dev", TargetEnv: "prod", ProjectName: projectName, PipelineInfo: pipelineInfo, Namespace: namespace},
+				wantErr: `no promotion path from "dev" to "prod"`,
+			},
+			{
+				name: "component of another project",
+				opts: PromoteOptions{
+					SourceEnv: "dev", TargetEnv: "staging", ProjectName: projectName, ComponentName: "other",
+					PipelineInfo: pipelineInfo, Namespace: namespace,
+				},
+				wantErr: `component "other" does not belong to project "my-proj"`,
+			},
+			{
+				name:    "no scope",
+				opts:    PromoteOptions{SourceEnv: "dev", TargetEnv: "staging", PipelineInfo: pipelineInfo, Namespace: namespace},
+				wantErr: "either All, ProjectName or ComponentName must be specified",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := gen.Promote(tt.opts)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			})
+		}
+	})
+}
@@ -200,9 +200,11 @@ func (g *BindingGenerator) selectComponentRelease(opts BindingOptions) (string,
 				opts.ProjectName, opts.ComponentName)
 		}
 
+		// The expected spec is generated without resolving image digests, so pinned
+		// releases are compared by the image reference they were pinned from.
 		var compareErrs []error
 		for _, release := range releases {
-			match, err := output.CompareReleaseSpecs(expectedRelease, release.Resource)
+			match, err := output.CompareReleaseSpecs(expectedRelease, unpinReleaseImage(release.Resource))
 			if err != nil {
 				compareErrs = append(compareErrs, err)
 				continue
@@ -399,6 +401,19 @@ func readBindingFromFile(filePath string) (*unstructured.Unstructured, error) {
 	return obj, nil
 }
 
+// unpinReleaseImage returns the release with its workload image restored to the reference
+// recorded in spec.image, without spec.image. Releases that are not pinned are returned as is.
+func unpinReleaseImage(release *unstructured.Unstructured) *unstructured.Unstructured {
+	reference, found, _ := unstructured.NestedString(release.Object, "spec", "image", "reference")
+	if !found {
+		return release
+	}
+	unpinned := release.DeepCopy()
+	_ = unstructured.SetNestedField(unpinned.Object, reference, "spec", "workload", "container", "image")
+	unstructured.RemoveNestedField(unpinned.Object, "spec", "image")
+	return unpinned
+}
+
 // projectToLegacyReleaseShape returns a deep copy of the expected release with the spec
 // fields that pre-BuildSpec occ versions never emitted stripped out, so it can be compared
 // against releases written by an older occ binary. The on-disk release is never mutated:
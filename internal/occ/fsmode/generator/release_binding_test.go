@@ -946,6 +946,42 @@ func TestSelectComponentRelease_DifferentReleaseNotLegacy(t *testing.T) {
 		"a genuinely different release must not be reported as a legacy match")
 }
 
+func TestSelectComponentRelease_PinnedRelease(t *testing.T) {
+	const (
+		namespace         = "test-ns"
+		projectName       = "my-proj"
+		componentName     = "my-comp"
+		componentTypeName = "my-type"
+		releaseName       = "my-comp-20250101-0"
+		image             = "reg/my-comp:v1"
+	)
+
+	idx := index.New("/repo")
+	addTraitUsingComponent(t, idx, namespace, projectName, componentName, componentTypeName, image)
+
+	// A release generated with image pinning matches the component's tagged image
+	pinned := generateMatchingRelease(t, idx, releaseName, projectName, componentName)
+	require.NoError(t, unstructured.SetNestedField(pinned.Object, "reg/my-comp@sha256:0123",
+		"spec", "workload", "container", "image"))
+	require.NoError(t, unstructured.SetNestedMap(pinned.Object, map[string]interface{}{
+		"reference": image, "digest": "sha256:0123",
+	}, "spec", "image"))
+	require.NoError(t, idx.Add(&index.ResourceEntry{
+		Resource: pinned,
+		FilePath: "/repo/projects/my-proj/components/my-comp/releases/" + releaseName + ".yaml",
+	}))
+
+	binding, err := NewBindingGenerator(fsmode.WrapIndex(idx)).GenerateBinding(BindingOptions{
+		ProjectName:   projectName,
+		ComponentName: componentName,
+		TargetEnv:     "dev",
+		PipelineInfo:  newTestPipelineInfo(),
+		Namespace:     namespace,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, releaseName, getNestedString(binding.Object, "spec", "releaseName"))
+}
+
 // addReleaseBinding adds a ReleaseBinding resource entry to the index.
 func addReleaseBinding(t *testing.T, idx *index.Index, namespace, name, project, component, env, releaseName, filePath string) {
 	t.Helper()
@@ -85,6 +85,9 @@ func WrapIndex(idx *index.Index) *Index {
 	// Build OpenChoreo-specific indexes from existing resources
 	ocIndex.rebuildSpecializedIndexes()
 
+	// Track references between OpenChoreo resources for reverse lookups
+	idx.SetReferenceExtractor(ExtractReferences)
+
 	return ocIndex
 }
 
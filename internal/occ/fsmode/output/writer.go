@@ -204,9 +204,10 @@ type BulkWriteOptions struct {
 
 // BulkWriteResult contains the result of a bulk write operation
 type BulkWriteResult struct {
-	OutputPaths []string // Paths where files were written (empty for dry-run)
-	Skipped     []string // Release names that were skipped (unchanged)
-	Errors      []error  // Any errors encountered during writing
+	OutputPaths  []string          // Paths where files were written (empty for dry-run)
+	WrittenPaths map[string]string // Resource name → path it was written to (empty for dry-run)
+	Skipped      []string          // Release names that were skipped (unchanged)
+	Errors       []error           // Any errors encountered during writing
 }
 
 // WriteBulkReleases writes multiple releases according to config
@@ -215,9 +216,10 @@ func (w *Writer) WriteBulkReleases(
 	opts BulkWriteOptions,
 ) (*BulkWriteResult, error) {
 	result := &BulkWriteResult{
-		OutputPaths: make([]string, 0, len(releases)),
-		Skipped:     make([]string, 0),
-		Errors:      make([]error, 0),
+		OutputPaths:  make([]string, 0, len(releases)),
+		WrittenPaths: make(map[string]string, len(releases)),
+		Skipped:      make([]string, 0),
+		Errors:       make([]error, 0),
 	}
 
 	// If dry-run, concatenate all releases to stdout
@@ -292,6 +294,7 @@ func (w *Writer) WriteBulkReleases(
 		}
 
 		result.OutputPaths = append(result.OutputPaths, outputPath)
+		result.WrittenPaths[release.GetName()] = outputPath
 	}
 
 	return result, nil
@@ -425,9 +428,10 @@ func (w *Writer) WriteBulkBindings(
 	opts BulkBindingWriteOptions,
 ) (*BulkWriteResult, error) {
 	result := &BulkWriteResult{
-		OutputPaths: make([]string, 0, len(bindings)),
-		Skipped:     make([]string, 0),
-		Errors:      make([]error, 0),
+		OutputPaths:  make([]string, 0, len(bindings)),
+		WrittenPaths: make(map[string]string, len(bindings)),
+		Skipped:      make([]string, 0),
+		Errors:       make([]error, 0),
 	}
 
 	// If dry-run, concatenate all bindings to stdout
@@ -487,6 +491,7 @@ func (w *Writer) WriteBulkBindings(
 		}
 
 		result.OutputPaths = append(result.OutputPaths, outputPath)
+		result.WrittenPaths[binding.GetName()] = outputPath
 	}
 
 	return result, nil
@@ -436,6 +436,7 @@ func TestWriteBulkBindings(t *testing.T) {
 		assert.Len(t, result.OutputPaths, 1)
 		assert.Equal(t, existingPath, result.OutputPaths[0])
 		assert.FileExists(t, existingPath)
+		assert.Equal(t, map[string]string{"comp-a-dev": existingPath}, result.WrittenPaths)
 	})
 
 	t.Run("with existing paths escaping base dir are rejected", func(t *testing.T) {
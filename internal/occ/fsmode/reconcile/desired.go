@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
+	"github.com/openchoreo/openchoreo/pkg/hash"
+)
+
+const apiGroup = "openchoreo.dev"
+
+// kindOrder is the order kinds are applied in, so that a resource is applied
+// after the resources it refers to. Kinds not listed are applied last, and
+// pruning deletes in the reverse order.
+var kindOrder = []string{
+	"Namespace",
+	"ClusterAuthzRole",
+	"ClusterAuthzRoleBinding",
+	"ClusterDataPlane",
+	"ClusterWorkflowPlane",
+	"ClusterObservabilityPlane",
+	"ClusterComponentType",
+	"ClusterResourceType",
+	"ClusterProjectType",
+	"ClusterTrait",
+	"ClusterWorkflow",
+	"AuthzRole",
+	"AuthzRoleBinding",
+	"DataPlane",
+	"WorkflowPlane",
+	"ObservabilityPlane",
+	"Environment",
+	"DeploymentPipeline",
+	"ObservabilityAlertsNotificationChannel",
+	"SecretReference",
+	"ComponentType",
+	"ResourceType",
+	"ProjectType",
+	"Trait",
+	"Workflow",
+	"Project",
+	"Component",
+	"Workload",
+	"Resource",
+	"ComponentRelease",
+	"ResourceRelease",
+	"ProjectRelease",
+	"ReleaseBinding",
+	"ResourceReleaseBinding",
+	"ProjectReleaseBinding",
+	"WorkflowRun",
+}
+
+func kindRank(kind string) int {
+	if i := slices.Index(kindOrder, kind); i >= 0 {
+		return i
+	}
+	return len(kindOrder)
+}
+
+// DesiredResource is a resource of the repository, prepared to be applied.
+type DesiredResource struct {
+	Ref ResourceRef
+	// Path is the file the resource was read from, relative to the repository root.
+	Path string
+	// Object is the resource with the defaulted namespace and the sync label and
+	// annotations set.
+	Object map[string]any
+	// Hash is the hash of Object, recorded in AnnotationKeyDesiredHash.
+	Hash string
+	// Skip is why the resource cannot be reconciled, if it cannot.
+	Skip string
+}
+
+// Desired returns the resources of the index in apply order, prepared to be
+// reconciled against the target. Resources the target cannot reconcile are
+// returned with Skip set, so they are reported with their file.
+func Desired(idx *index.Index, target Target, opts Options) []DesiredResource {
+	entries := idx.ListAll()
+	desired := make([]DesiredResource, 0, len(entries))
+	for _, entry := range entries {
+		desired = append(desired, desiredResource(idx.GetRepoPath(), entry, target, opts))
+	}
+	slices.SortFunc(desired, func(a, b DesiredResource) int {
+		if d := kindRank(a.Ref.Kind) - kindRank(b.Ref.Kind); d != 0 {
+			return d
+		}
+		if c := strings.Compare(a.Ref.Kind, b.Ref.Kind); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Ref.Namespace, b.Ref.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Ref.Name, b.Ref.Name)
+	})
+	return desired
+}
+
+func desiredResource(root string, entry *index.ResourceEntry, target Target, opts Options) DesiredResource {
+	obj := entry.Resource
+	gvk := obj.GroupVersionKind()
+	d := DesiredResource{
+		Ref:  ResourceRef{Kind: gvk.Kind, Namespace: obj.GetNamespace(), Name: obj.GetName()},
+		Path: relativePath(root, entry.FilePath),
+	}
+
+	if gvk.Group != apiGroup {
+		d.Skip = fmt.Sprintf("not an OpenChoreo resource (apiVersion %q)", obj.GetAPIVersion())
+		return d
+	}
+	info, ok := target.Kind(gvk.Kind)
+	if !ok {
+		d.Skip = fmt.Sprintf("kind %q cannot be applied", gvk.Kind)
+		return d
+	}
+	if !info.Namespaced {
+		d.Ref.Namespace = ""
+	} else if d.Ref.Namespace == "" {
+		if opts.DefaultNamespace == "" {
+			d.Skip = "namespace is required (set metadata.namespace or a default namespace)"
+			return d
+		}
+		d.Ref.Namespace = opts.DefaultNamespace
+	}
+
+	object := runtime.DeepCopyJSON(obj.Object)
+	delete(object, "status")
+	metadata, _ := object["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+		object["metadata"] = metadata
+	}
+	if d.Ref.Namespace != "" {
+		metadata["namespace"] = d.Ref.Namespace
+	} else {
+		delete(metadata, "namespace")
+	}
+	setStringMapValue(metadata, "labels", labels.LabelKeySyncName, opts.SyncName)
+	setStringMapValue(metadata, "annotations", labels.AnnotationKeySyncSource, d.Path)
+
+	// The revision is left out of the hash so that a new commit that does not
+	// touch the resource does not count as a change.
+	d.Hash = hash.ComputeHash(object, nil)
+	setStringMapValue(metadata, "annotations", labels.AnnotationKeyDesiredHash, d.Hash)
+	if opts.Revision != "" {
+		setStringMapValue(metadata, "annotations", labels.AnnotationKeySyncRevision, opts.Revision)
+	}
+	d.Object = object
+	return d
+}
+
+// setStringMapValue sets key in the string map field of metadata, creating it if needed.
+func setStringMapValue(metadata map[string]any, field, key, value string) {
+	m, _ := metadata[field].(map[string]any)
+	if m == nil {
+		m = map[string]any{}
+		metadata[field] = m
+	}
+	m[key] = value
+}
+
+func relativePath(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(path)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestDesired(t *testing.T) {
+	foreign := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "web"},
+	}}
+	idx := newIndex(t, map[string][]*unstructured.Unstructured{
+		"a.yaml": {
+			resource("Component", "team", "api", nil),
+			resource("Project", "", "shop", nil),
+			resource("Namespace", "ignored", "team", nil),
+		},
+		"b.yaml": {foreign, resource("Trait", "", "ingress", nil)},
+	})
+
+	t.Run("orders, defaults and labels resources", func(t *testing.T) {
+		opts := Options{SyncName: "gitops", DefaultNamespace: "default", Revision: "abc123"}
+		desired := Desired(idx, newFakeTarget(), opts)
+
+		var refs []string
+		skipped := map[string]string{}
+		for _, d := range desired {
+			if d.Skip != "" {
+				skipped[d.Ref.Kind] = d.Skip
+				continue
+			}
+			refs = append(refs, d.Ref.String())
+		}
+		assert.Equal(t, []string{"namespace/team", "default/project/shop", "team/component/api"}, refs)
+		assert.Contains(t, skipped["Deployment"], "not an OpenChoreo resource")
+		assert.Contains(t, skipped["Trait"], "cannot be applied")
+
+		namespace := desired[0]
+		assert.Equal(t, "a.yaml", namespace.Path)
+		metadata := namespace.Object["metadata"].(map[string]any)
+		assert.NotContains(t, metadata, "namespace")
+		annotations := metadata["annotations"].(map[string]any)
+		assert.Equal(t, namespace.Hash, annotations[labels.AnnotationKeyDesiredHash])
+		assert.Equal(t, "abc123", annotations[labels.AnnotationKeySyncRevision])
+		assert.Equal(t, "a.yaml", annotations[labels.AnnotationKeySyncSource])
+	})
+
+	t.Run("the revision does not change the hash", func(t *testing.T) {
+		first := Desired(idx, newFakeTarget(), Options{SyncName: "gitops", DefaultNamespace: "default", Revision: "1"})
+		second := Desired(idx, newFakeTarget(), Options{SyncName: "gitops", DefaultNamespace: "default", Revision: "2"})
+		for i := range first {
+			assert.Equal(t, first[i].Hash, second[i].Hash)
+		}
+	})
+
+	t.Run("namespaced resources need a namespace", func(t *testing.T) {
+		for _, d := range Desired(idx, newFakeTarget(), Options{SyncName: "gitops"}) {
+			if d.Ref.Kind == "Project" {
+				assert.Contains(t, d.Skip, "namespace is required")
+			}
+		}
+	})
+}
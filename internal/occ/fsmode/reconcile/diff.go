@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// diffIgnoredAnnotations are set on every synced resource and are compared
+// through the desired hash rather than field by field.
+var diffIgnoredAnnotations = []string{
+	labels.AnnotationKeyDesiredHash,
+	labels.AnnotationKeySyncRevision,
+}
+
+// Diff returns the fields set in the desired resource that differ from the
+// live resource, as "+ path" for fields missing from the live resource and
+// "~ path" for changed fields. Only the spec, labels and annotations are
+// compared; fields that are only set on the live resource, such as
+// server-side defaults, are not reported.
+func Diff(desired, live map[string]any) []string {
+	d, l := normalizeForDiff(desired), normalizeForDiff(live)
+	var fields []string
+	diffValues("spec", d["spec"], l["spec"], &fields)
+	dm, _ := d["metadata"].(map[string]any)
+	lm, _ := l["metadata"].(map[string]any)
+	for _, field := range []string{"labels", "annotations"} {
+		diffValues("metadata."+field, dm[field], lm[field], &fields)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// normalizeForDiff returns a JSON round-tripped copy of obj, so that numbers
+// read from YAML and from the API compare equal, without the ignored annotations.
+func normalizeForDiff(obj map[string]any) map[string]any {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return obj
+	}
+	var normalized map[string]any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return obj
+	}
+	if metadata, ok := normalized["metadata"].(map[string]any); ok {
+		if annotations, ok := metadata["annotations"].(map[string]any); ok {
+			for _, key := range diffIgnoredAnnotations {
+				delete(annotations, key)
+			}
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	return normalized
+}
+
+// diffValues appends the paths at which the desired value is not matched by
+// the live value. Lists of differing length are reported as a whole.
+func diffValues(path string, desired, live any, fields *[]string) {
+	if desired == nil {
+		return
+	}
+	if live == nil {
+		*fields = append(*fields, "+ "+path)
+		return
+	}
+	switch d := desired.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			*fields = append(*fields, "~ "+path)
+			return
+		}
+		for k, v := range d {
+			diffValues(path+"."+k, v, l[k], fields)
+		}
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(d) {
+			*fields = append(*fields, "~ "+path)
+			return
+		}
+		for i := range d {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), d[i], l[i], fields)
+		}
+	default:
+		if !reflect.DeepEqual(desired, live) {
+			*fields = append(*fields, "~ "+path)
+		}
+	}
+}
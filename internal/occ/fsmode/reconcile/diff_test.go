@@ -0,0 +1,79 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestDiff(t *testing.T) {
+	desired := map[string]any{
+		"metadata": map[string]any{
+			"name":   "api",
+			"labels": map[string]any{"team": "payments"},
+			"annotations": map[string]any{
+				labels.AnnotationKeyDesiredHash: "new",
+			},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"image":    "api:v2",
+			"ports":    []any{int64(80), int64(443)},
+			"env":      map[string]any{"LOG_LEVEL": "debug"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		live map[string]any
+		want []string
+	}{
+		{
+			name: "equal, ignoring live-only fields and number types",
+			live: map[string]any{
+				"metadata": map[string]any{
+					"name":        "api",
+					"uid":         "1234",
+					"labels":      map[string]any{"team": "payments", "openchoreo.dev/project": "shop"},
+					"annotations": map[string]any{labels.AnnotationKeyDesiredHash: "old"},
+				},
+				"spec": map[string]any{
+					"replicas": float64(2),
+					"image":    "api:v2",
+					"ports":    []any{float64(80), float64(443)},
+					"env":      map[string]any{"LOG_LEVEL": "debug"},
+					"paused":   false,
+				},
+				"status": map[string]any{"ready": true},
+			},
+		},
+		{
+			name: "changed and missing fields",
+			live: map[string]any{
+				"metadata": map[string]any{"name": "api"},
+				"spec": map[string]any{
+					"replicas": float64(1),
+					"image":    "api:v2",
+					"ports":    []any{float64(80)},
+					"env":      map[string]any{"LOG_LEVEL": "info"},
+				},
+			},
+			want: []string{"+ metadata.labels", "~ spec.env.LOG_LEVEL", "~ spec.ports", "~ spec.replicas"},
+		},
+		{
+			name: "no live spec",
+			live: map[string]any{"metadata": map[string]any{"name": "api", "labels": map[string]any{"team": "payments"}}},
+			want: []string{"+ spec"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Diff(desired, tt.live))
+		})
+	}
+}
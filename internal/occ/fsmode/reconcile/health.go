@@ -0,0 +1,51 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import "fmt"
+
+// readyCondition is the condition OpenChoreo controllers report readiness with.
+const readyCondition = "Ready"
+
+// HealthOf returns the health of a live resource from its Ready condition.
+// Resources without conditions report nothing to wait for and are healthy.
+func HealthOf(live map[string]any) (Health, string) {
+	if live == nil {
+		return HealthMissing, ""
+	}
+	status, _ := live["status"].(map[string]any)
+	conditions, _ := status["conditions"].([]any)
+	if len(conditions) == 0 {
+		return HealthHealthy, ""
+	}
+	for _, c := range conditions {
+		condition, _ := c.(map[string]any)
+		if condition["type"] != readyCondition {
+			continue
+		}
+		message := conditionMessage(condition)
+		switch condition["status"] {
+		case "True":
+			return HealthHealthy, ""
+		case "False":
+			return HealthDegraded, message
+		default:
+			return HealthProgressing, message
+		}
+	}
+	return HealthUnknown, "no Ready condition reported"
+}
+
+func conditionMessage(condition map[string]any) string {
+	reason, _ := condition["reason"].(string)
+	message, _ := condition["message"].(string)
+	switch {
+	case reason != "" && message != "":
+		return fmt.Sprintf("%s: %s", reason, message)
+	case message != "":
+		return message
+	default:
+		return reason
+	}
+}
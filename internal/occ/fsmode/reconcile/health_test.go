@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthOf(t *testing.T) {
+	withConditions := func(conditions ...map[string]any) map[string]any {
+		list := make([]any, len(conditions))
+		for i, c := range conditions {
+			list[i] = c
+		}
+		return map[string]any{"status": map[string]any{"conditions": list}}
+	}
+
+	tests := []struct {
+		name        string
+		live        map[string]any
+		wantHealth  Health
+		wantMessage string
+	}{
+		{name: "missing", live: nil, wantHealth: HealthMissing},
+		{name: "no status", live: map[string]any{"spec": map[string]any{}}, wantHealth: HealthHealthy},
+		{
+			name:       "ready",
+			live:       withConditions(map[string]any{"type": "Ready", "status": "True"}),
+			wantHealth: HealthHealthy,
+		},
+		{
+			name: "not ready",
+			live: withConditions(
+				map[string]any{"type": "Synced", "status": "True"},
+				map[string]any{"type": "Ready", "status": "False", "reason": "ImagePullFailed", "message": "no such image"},
+			),
+			wantHealth:  HealthDegraded,
+			wantMessage: "ImagePullFailed: no such image",
+		},
+		{
+			name:        "readiness unknown",
+			live:        withConditions(map[string]any{"type": "Ready", "status": "Unknown", "reason": "Deploying"}),
+			wantHealth:  HealthProgressing,
+			wantMessage: "Deploying",
+		},
+		{
+			name:        "no Ready condition",
+			live:        withConditions(map[string]any{"type": "Synced", "status": "True"}),
+			wantHealth:  HealthUnknown,
+			wantMessage: "no Ready condition reported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, message := HealthOf(tt.live)
+			assert.Equal(t, tt.wantHealth, health)
+			assert.Equal(t, tt.wantMessage, message)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// unknownSource is the file reported for pruned resources without a source annotation.
+const unknownSource = "<unknown>"
+
+// ErrNothingToSync is returned when pruning is requested but the repository
+// has no resources to sync, which would prune every resource of the sync.
+var ErrNothingToSync = errors.New("no resources to sync")
+
+// ErrInvalidFiles is returned when pruning is requested but files of the
+// repository could not be parsed. Their resources are unknown, so pruning
+// could delete resources that are still in the repository.
+var ErrInvalidFiles = errors.New("repository has files that cannot be parsed")
+
+// Options configures a sync.
+type Options struct {
+	// SyncName is recorded on every applied resource with LabelKeySyncName.
+	// Pruning only deletes resources with the same sync name.
+	SyncName string
+	// DefaultNamespace is used for namespaced resources without a namespace.
+	DefaultNamespace string
+	// Revision is the git commit the resources are read from, if known.
+	Revision string
+	// Prune deletes synced resources that are no longer in the repository.
+	Prune bool
+	// DryRun reports what would change without changing anything.
+	DryRun bool
+	// InvalidFiles maps the files that could not be parsed, relative to the
+	// repository root, to the parse error. They are reported as failed and
+	// pruning is refused.
+	InvalidFiles map[string]string
+}
+
+// Reconciler reconciles the resources of a repository against a target.
+type Reconciler struct {
+	target Target
+	opts   Options
+}
+
+// New creates a Reconciler.
+func New(target Target, opts Options) *Reconciler {
+	return &Reconciler{target: target, opts: opts}
+}
+
+// Reconcile applies the desired resources in order and, with Options.Prune,
+// deletes the synced resources that are no longer desired, in reverse order.
+// Failures of individual resources and invalid files are reported in the
+// result; the error is only set when pruning could not be done.
+func (r *Reconciler) Reconcile(ctx context.Context, desired []DesiredResource) (*Result, error) {
+	files := map[string]*FileResult{}
+	for path, parseErr := range r.opts.InvalidFiles {
+		files[path] = &FileResult{Path: path, Error: parseErr}
+	}
+	report := func(path string, res ResourceResult) {
+		f, ok := files[path]
+		if !ok {
+			f = &FileResult{Path: path}
+			files[path] = f
+		}
+		f.Resources = append(f.Resources, res)
+	}
+
+	wanted := map[ResourceRef]bool{}
+	namespaces := map[string]bool{}
+	if r.opts.DefaultNamespace != "" {
+		namespaces[r.opts.DefaultNamespace] = true
+	}
+	var extraKinds []string
+	for _, d := range desired {
+		if d.Skip != "" {
+			report(d.Path, ResourceResult{Ref: d.Ref, Action: ActionSkipped, Message: d.Skip})
+			continue
+		}
+		wanted[d.Ref] = true
+		if d.Ref.Namespace != "" {
+			namespaces[d.Ref.Namespace] = true
+		}
+		if !slices.Contains(kindOrder, d.Ref.Kind) && !slices.Contains(extraKinds, d.Ref.Kind) {
+			extraKinds = append(extraKinds, d.Ref.Kind)
+		}
+		report(d.Path, r.reconcileResource(ctx, d))
+	}
+
+	var err error
+	if r.opts.Prune {
+		switch {
+		case len(r.opts.InvalidFiles) > 0:
+			err = fmt.Errorf("%w: refusing to prune sync %q until %d invalid file(s) are fixed",
+				ErrInvalidFiles, r.opts.SyncName, len(r.opts.InvalidFiles))
+		case len(wanted) == 0:
+			err = fmt.Errorf("%w: refusing to prune every resource of sync %q", ErrNothingToSync, r.opts.SyncName)
+		default:
+			err = r.prune(ctx, wanted, namespaces, extraKinds, report)
+		}
+	}
+
+	result := &Result{Revision: r.opts.Revision, DryRun: r.opts.DryRun}
+	for _, f := range files {
+		result.Files = append(result.Files, *f)
+	}
+	sort.Slice(result.Files, func(i, j int) bool { return result.Files[i].Path < result.Files[j].Path })
+	return result, err
+}
+
+func (r *Reconciler) reconcileResource(ctx context.Context, d DesiredResource) ResourceResult {
+	res := ResourceResult{Ref: d.Ref}
+	failed := func(err error) ResourceResult {
+		res.Action = ActionFailed
+		res.Health = HealthUnknown
+		res.Message = err.Error()
+		return res
+	}
+
+	live, err := r.target.Get(ctx, d.Ref)
+	if err != nil {
+		return failed(fmt.Errorf("failed to get live resource: %w", err))
+	}
+
+	if live == nil {
+		res.Action = ActionCreated
+		res.Health = HealthMissing
+		if r.opts.DryRun {
+			return res
+		}
+		if err := r.target.Apply(ctx, d.Ref, d.Object, false); err != nil {
+			return failed(err)
+		}
+		res.Health = HealthProgressing
+		return res
+	}
+
+	res.Diff = Diff(d.Object, live)
+	res.Health, res.Message = HealthOf(live)
+	if liveHash(live) == d.Hash && len(res.Diff) == 0 {
+		res.Action = ActionUnchanged
+		return res
+	}
+	if len(res.Diff) == 0 {
+		res.Message = "changed since the last sync"
+	}
+
+	if info, _ := r.target.Kind(d.Ref.Kind); info.CreateOnly {
+		res.Action = ActionOutOfSync
+		res.Message = "differs from the live resource, which cannot be updated; delete it to re-create it"
+		return res
+	}
+	res.Action = ActionConfigured
+	if r.opts.DryRun {
+		return res
+	}
+	if err := r.target.Apply(ctx, d.Ref, d.Object, true); err != nil {
+		return failed(err)
+	}
+	res.Health = HealthProgressing
+	return res
+}
+
+// prune deletes the synced resources of the sync that are not wanted, in
+// reverse apply order. Namespaced kinds are listed in the namespaces of the
+// wanted resources, the default namespace and the synced namespaces.
+func (r *Reconciler) prune(
+	ctx context.Context,
+	wanted map[ResourceRef]bool,
+	namespaces map[string]bool,
+	extraKinds []string,
+	report func(string, ResourceResult),
+) error {
+	synced := map[string][]map[string]any{}
+	if _, ok := r.target.Kind("Namespace"); ok {
+		items, err := r.target.ListSynced(ctx, "Namespace", "", r.opts.SyncName)
+		if err != nil {
+			return fmt.Errorf("failed to list synced namespaces: %w", err)
+		}
+		synced["Namespace"] = items
+		for _, item := range items {
+			namespaces[objectRef("Namespace", item).Name] = true
+		}
+	}
+	sortedNamespaces := make([]string, 0, len(namespaces))
+	for ns := range namespaces {
+		sortedNamespaces = append(sortedNamespaces, ns)
+	}
+	sort.Strings(sortedNamespaces)
+
+	kinds := append(slices.Clone(kindOrder), extraKinds...)
+	slices.Reverse(kinds)
+	var errs []error
+	for _, kind := range kinds {
+		info, ok := r.target.Kind(kind)
+		if !ok {
+			continue
+		}
+		items, listed := synced[kind]
+		if !listed {
+			scopes := []string{""}
+			if info.Namespaced {
+				scopes = sortedNamespaces
+			}
+			for _, ns := range scopes {
+				nsItems, err := r.target.ListSynced(ctx, kind, ns, r.opts.SyncName)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to list synced %s resources: %w", kind, err))
+					continue
+				}
+				items = append(items, nsItems...)
+			}
+		}
+		for _, item := range items {
+			ref := objectRef(kind, item)
+			if !info.Namespaced {
+				ref.Namespace = ""
+			}
+			if wanted[ref] {
+				continue
+			}
+			res := ResourceResult{Ref: ref, Action: ActionPruned}
+			if !r.opts.DryRun {
+				if err := r.target.Delete(ctx, ref); err != nil {
+					res.Action = ActionFailed
+					res.Health = HealthUnknown
+					res.Message = fmt.Sprintf("failed to prune: %v", err)
+				}
+			}
+			report(sourceOf(item), res)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func objectRef(kind string, obj map[string]any) ResourceRef {
+	metadata, _ := obj["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	return ResourceRef{Kind: kind, Namespace: namespace, Name: name}
+}
+
+func annotation(obj map[string]any, key string) string {
+	metadata, _ := obj["metadata"].(map[string]any)
+	annotations, _ := metadata["annotations"].(map[string]any)
+	value, _ := annotations[key].(string)
+	return value
+}
+
+func liveHash(live map[string]any) string {
+	return annotation(live, labels.AnnotationKeyDesiredHash)
+}
+
+func sourceOf(live map[string]any) string {
+	if source := annotation(live, labels.AnnotationKeySyncSource); source != "" {
+		return source
+	}
+	return unknownSource
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
+)
+
+// fakeTarget is an in-memory control plane that records the calls it receives.
+type fakeTarget struct {
+	live    map[ResourceRef]map[string]any
+	calls   []string
+	failFor map[ResourceRef]error
+}
+
+func newFakeTarget() *fakeTarget {
+	return &fakeTarget{live: map[ResourceRef]map[string]any{}, failFor: map[ResourceRef]error{}}
+}
+
+var fakeKinds = map[string]KindInfo{
+	"Namespace":        {},
+	"Project":          {Namespaced: true},
+	"Component":        {Namespaced: true},
+	"ComponentRelease": {Namespaced: true, CreateOnly: true},
+}
+
+func (f *fakeTarget) Kind(kind string) (KindInfo, bool) {
+	info, ok := fakeKinds[kind]
+	return info, ok
+}
+
+func (f *fakeTarget) Get(_ context.Context, ref ResourceRef) (map[string]any, error) {
+	if live, ok := f.live[ref]; ok {
+		return runtime.DeepCopyJSON(live), nil
+	}
+	return nil, nil
+}
+
+func (f *fakeTarget) Apply(_ context.Context, ref ResourceRef, obj map[string]any, exists bool) error {
+	verb := "create"
+	if exists {
+		verb = "update"
+	}
+	f.calls = append(f.calls, verb+" "+ref.String())
+	if err := f.failFor[ref]; err != nil {
+		return err
+	}
+	f.live[ref] = runtime.DeepCopyJSON(obj)
+	return nil
+}
+
+func (f *fakeTarget) Delete(_ context.Context, ref ResourceRef) error {
+	f.calls = append(f.calls, "delete "+ref.String())
+	delete(f.live, ref)
+	return nil
+}
+
+func (f *fakeTarget) ListSynced(_ context.Context, kind, namespace, syncName string) ([]map[string]any, error) {
+	var items []map[string]any
+	for ref, obj := range f.live {
+		if ref.Kind != kind || (fakeKinds[kind].Namespaced && ref.Namespace != namespace) {
+			continue
+		}
+		metadata, _ := obj["metadata"].(map[string]any)
+		objLabels, _ := metadata["labels"].(map[string]any)
+		if objLabels[labels.LabelKeySyncName] == syncName {
+			items = append(items, obj)
+		}
+	}
+	return items, nil
+}
+
+func resource(kind, namespace, name string, spec map[string]any) *unstructured.Unstructured {
+	metadata := map[string]any{"name": name}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	obj := map[string]any{
+		"apiVersion": "openchoreo.dev/v1alpha1",
+		"kind":       kind,
+		"metadata":   metadata,
+	}
+	if spec != nil {
+		obj["spec"] = spec
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func newIndex(t *testing.T, entries map[string][]*unstructured.Unstructured) *index.Index {
+	t.Helper()
+	idx := index.New("/repo")
+	for path, objs := range entries {
+		for _, obj := range objs {
+			require.NoError(t, idx.Add(&index.ResourceEntry{Resource: obj, FilePath: "/repo/" + path}))
+		}
+	}
+	return idx
+}
+
+func fileResult(t *testing.T, result *Result, path string) FileResult {
+	t.Helper()
+	for _, f := range result.Files {
+		if f.Path == path {
+			return f
+		}
+	}
+	t.Fatalf("no result for file %q in %+v", path, result.Files)
+	return FileResult{}
+}
+
+func TestReconcileCreatesUpdatesAndLeavesUnchanged(t *testing.T) {
+	ctx := context.Background()
+	target := newFakeTarget()
+	opts := Options{SyncName: "gitops", DefaultNamespace: "default"}
+	idx := newIndex(t, map[string][]*unstructured.Unstructured{
+		"projects/shop.yaml": {
+			resource("Project", "", "shop", map[string]any{"deploymentPipelineRef": "default"}),
+			resource("Component", "", "api", map[string]any{"owner": map[string]any{"projectName": "shop"}}),
+		},
+	})
+
+	result, err := New(target, opts).Reconcile(ctx, Desired(idx, target, opts))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create default/project/shop", "create default/component/api"}, target.calls)
+	file := fileResult(t, result, "projects/shop.yaml")
+	assert.True(t, file.Synced())
+	assert.Equal(t, HealthProgressing, file.Health())
+	assert.Equal(t, 2, result.Count(ActionCreated))
+
+	applied := target.live[ResourceRef{Kind: "Component", Namespace: "default", Name: "api"}]
+	metadata := applied["metadata"].(map[string]any)
+	assert.Equal(t, "default", metadata["namespace"])
+	assert.Equal(t, "gitops", metadata["labels"].(map[string]any)[labels.LabelKeySyncName])
+	assert.Equal(t, "projects/shop.yaml", metadata["annotations"].(map[string]any)[labels.AnnotationKeySyncSource])
+
+	// A second pass finds nothing to do
+	target.calls = nil
+	result, err = New(target, opts).Reconcile(ctx, Desired(idx, target, opts))
+	require.NoError(t, err)
+	assert.Empty(t, target.calls)
+	assert.Equal(t, 2, result.Count(ActionUnchanged))
+	assert.Equal(t, HealthHealthy, fileResult(t, result, "projects/shop.yaml").Health())
+
+	// Drift of the live resource is reported and corrected
+	target.live[ResourceRef{Kind: "Project", Namespace: "default", Name: "shop"}]["spec"] =
+		map[string]any{"deploymentPipelineRef": "other"}
+	result, err = New(target, opts).Reconcile(ctx, Desired(idx, target, opts))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"update default/project/shop"}, target.calls)
+	project := fileResult(t, result, "projects/shop.yaml").Resources[0]
+	assert.Equal(t, ActionConfigured, project.Action)
+	assert.Equal(t, []string{"~ spec.deploymentPipelineRef"}, project.Diff)
+}
+
+func TestReconcileDryRunChangesNothing(t *testing.T) {
+	target := newFakeTarget()
+	opts := Options{SyncName: "gitops", DefaultNamespace: "default", DryRun: true, Prune: true}
+	idx := newIndex(t, map[string][]*unstructured.Unstructured{
+		"shop.yaml": {resource("Project", "", "shop", nil)},
+	})
+	target.live[ResourceRef{Kind: "Project", Namespace: "default", Name: "old"}] = map[string]any{
+		"metadata": map[string]any{
+			"name": "old", "namespace": "default",
+			"labels": map[string]any{labels.LabelKeySyncName: "gitops"},
+		},
+	}
+
+	result, err := New(target, opts).Reconcile(context.Background(), Desired(idx, target, opts))
+	require.NoError(t, err)
+	assert.Empty(t, target.calls)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.Count(ActionCreated))
+	assert.Equal(t, 1, result.Count(ActionPruned))
+	assert.Equal(t, HealthMissing, fileResult(t, result, "shop.yaml").Health())
+}
+
+func TestReconcilePrunesOnlyResourcesOfTheSync(t *testing.T) {
+	target := newFakeTarget()
+	opts := Options{SyncName: "gitops", DefaultNamespace: "default", Prune: true}
+	synced := func(name, syncName, source string) map[string]any {
+		return map[string]any{"metadata": map[string]any{
+			"name": name, "namespace": "default",
+			"labels":      map[string]any{labels.LabelKeySyncName: syncName},
+			"annotations": map[string]any{labels.AnnotationKeySyncSource: source},
+		}}
+	}
+	target.live[ResourceRef{Kind: "Project", Namespace: "default", Name: "removed"}] =
+		synced("removed", "gitops", "projects/removed.yaml")
+	target.live[ResourceRef{Kind: "Component", Namespace: "default", Name: "removed-api"}] =
+		synced("removed-api", "gitops", "projects/removed.yaml")
+	target.live[ResourceRef{Kind: "Project", Namespace: "default", Name: "foreign"}] =
+		synced("foreign", "other-sync", "foreign.yaml")
+	idx := newIndex(t, map[string][]*unstructured.Unstructured{
+		"shop.yaml": {resource("Project", "", "shop", nil)},
+	})
+
+	result, err := New(target, opts).Reconcile(context.Background(), Desired(idx, target, opts))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"create default/project/shop",
+		"delete default/component/removed-api",
+		"delete default/project/removed",
+	}, target.calls)
+	removed := fileResult(t, result, "projects/removed.yaml")
+	assert.Len(t, removed.Resources, 2)
+	assert.Contains(t, target.live, ResourceRef{Kind: "Project", Namespace: "default", Name: "foreign"})
+}
+
+func TestReconcileRefusesToPruneEverything(t *testing.T) {
+	target := newFakeTarget()
+	opts := Options{SyncName: "gitops", DefaultNamespace: "default", Prune: true}
+
+	_, err := New(target, opts).Reconcile(context.Background(), nil)
+	require.ErrorIs(t, err, ErrNothingToSync)
+	assert.Empty(t, target.calls)
+}
+
+func TestReconcileRefusesToPruneWithInvalidFiles(t *testing.T) {
+	target := newFakeTarget()
+	opts := Options{
+		SyncName: "gitops", DefaultNamespace: "default", Prune: true,
+		InvalidFiles: map[string]string{"api.yaml": "invalid YAML: document 1"},
+	}
+	target.live[ResourceRef{Kind: "Component", Namespace: "default", Name: "api"}] = map[string]any{
+		"metadata": map[string]any{
+			"name": "api", "namespace": "default",
+			"labels":      map[string]any{labels.LabelKeySyncName: "gitops"},
+			"annotations": map[string]any{labels.AnnotationKeySyncSource: "api.yaml"},
+		},
+	}
+	idx := newIndex(t, map[string][]*unstructured.Unstructured{
+		"shop.yaml": {resource("Project", "", "shop", nil)},
+	})
+
+	result, err := New(target, opts).Reconcile(context.Background(), Desired(idx, target, opts))
+	require.ErrorIs(t, err, ErrInvalidFiles)
+	assert.Equal(t, []string{"create default/project/shop"}, target.calls)
+	invalid := fileResult(t, result, "api.yaml")
+	assert.False(t, invalid.Synced())
+	assert.Equal(t, HealthUnknown, invalid.Health())
+	assert.Equal(t, "invalid YAML: document 1", invalid.Error)
+	assert.Equal(t, 1, result.InvalidFiles())
+}
+
+func TestReconcileReportsFailuresAndCreateOnlyChanges(t *testing.T) {
+	target := newFakeTarget()
+	opts := Options{SyncName: "gitops", DefaultNamespace: "default"}
+	release := resource("ComponentRelease", "", "api-1", map[string]any{"image": "api:v2"})
+	idx := newIndex(t, map[string][]*unstructured.Unstructured{
+		"releases.yaml": {release},
+		"broken.yaml":   {resource("Project", "", "broken", nil)},
+	})
+	target.live[ResourceRef{Kind: "ComponentRelease", Namespace: "default", Name: "api-1"}] = map[string]any{
+		"metadata": map[string]any{"name": "api-1", "namespace": "default"},
+		"spec":     map[string]any{"image": "api:v1"},
+	}
+	target.failFor[ResourceRef{Kind: "Project", Namespace: "default", Name: "broken"}] = errors.New("denied")
+
+	result, err := New(target, opts).Reconcile(context.Background(), Desired(idx, target, opts))
+	require.NoError(t, err)
+
+	releases := fileResult(t, result, "releases.yaml")
+	assert.False(t, releases.Synced())
+	assert.Equal(t, ActionOutOfSync, releases.Resources[0].Action)
+	assert.Equal(t, []string{"+ metadata.annotations", "+ metadata.labels", "~ spec.image"}, releases.Resources[0].Diff)
+
+	broken := fileResult(t, result, "broken.yaml")
+	assert.False(t, broken.Synced())
+	assert.Equal(t, ActionFailed, broken.Resources[0].Action)
+	assert.Equal(t, "denied", broken.Resources[0].Message)
+	assert.Equal(t, 1, result.Count(ActionFailed))
+}
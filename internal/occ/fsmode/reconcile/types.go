@@ -0,0 +1,172 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reconcile reconciles the OpenChoreo resources of a file-system mode
+// repository against a control plane: it diffs every resource against its live
+// state, applies the changes, prunes resources removed from the repository and
+// reports the sync and health status of every file.
+package reconcile
+
+import (
+	"context"
+	"strings"
+)
+
+// ResourceRef identifies a resource by kind, namespace and name. Namespace is
+// empty for cluster-scoped kinds.
+type ResourceRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String returns the reference in the "kind/name" form used by occ apply,
+// qualified with the namespace for namespaced resources.
+func (r ResourceRef) String() string {
+	s := strings.ToLower(r.Kind) + "/" + r.Name
+	if r.Namespace != "" {
+		s = r.Namespace + "/" + s
+	}
+	return s
+}
+
+// KindInfo describes how a kind can be reconciled.
+type KindInfo struct {
+	Namespaced bool
+	// CreateOnly kinds are immutable once created; a difference from the live
+	// resource is reported but not applied.
+	CreateOnly bool
+}
+
+// Target is the control plane the resources are reconciled against.
+type Target interface {
+	// Kind reports whether the kind can be reconciled and how.
+	Kind(kind string) (KindInfo, bool)
+	// Get returns the live resource, or nil when it does not exist.
+	Get(ctx context.Context, ref ResourceRef) (map[string]any, error)
+	// Apply creates the resource, or updates it when exists is true.
+	Apply(ctx context.Context, ref ResourceRef, obj map[string]any, exists bool) error
+	// Delete deletes the resource.
+	Delete(ctx context.Context, ref ResourceRef) error
+	// ListSynced returns the live resources of the kind that carry the sync
+	// label with the given sync name. Namespace is ignored for cluster-scoped kinds.
+	ListSynced(ctx context.Context, kind, namespace, syncName string) ([]map[string]any, error)
+}
+
+// Action is what a sync did, or would do in a dry run, with a resource.
+type Action string
+
+const (
+	ActionCreated    Action = "created"
+	ActionConfigured Action = "configured"
+	ActionUnchanged  Action = "unchanged"
+	ActionPruned     Action = "pruned"
+	// ActionOutOfSync is reported for create-only resources that differ from
+	// their live state.
+	ActionOutOfSync Action = "out-of-sync"
+	ActionSkipped   Action = "skipped"
+	ActionFailed    Action = "failed"
+)
+
+// Health is the health of a live resource, derived from its Ready condition.
+type Health string
+
+const (
+	HealthHealthy     Health = "Healthy"
+	HealthProgressing Health = "Progressing"
+	HealthDegraded    Health = "Degraded"
+	HealthMissing     Health = "Missing"
+	HealthUnknown     Health = "Unknown"
+)
+
+// healthRank orders health from best to worst, for the health of a file.
+var healthRank = map[Health]int{
+	HealthHealthy:     0,
+	HealthUnknown:     1,
+	HealthProgressing: 2,
+	HealthMissing:     3,
+	HealthDegraded:    4,
+}
+
+// ResourceResult is the outcome of reconciling a single resource.
+type ResourceResult struct {
+	Ref    ResourceRef
+	Action Action
+	// Diff lists the fields that differ from the live resource, see Diff.
+	Diff    []string
+	Health  Health
+	Message string
+}
+
+// FileResult is the outcome of reconciling the resources of a single file.
+type FileResult struct {
+	// Path is the file path relative to the repository root. Pruned resources
+	// are reported under the file they were last applied from.
+	Path      string
+	Resources []ResourceResult
+	// Error is why the file could not be parsed, if it could not. Only the
+	// resources of its valid documents are reconciled.
+	Error string
+}
+
+// Synced reports whether the file was parsed and every resource of it was
+// reconciled, or would be in a dry run.
+func (f FileResult) Synced() bool {
+	if f.Error != "" {
+		return false
+	}
+	for _, r := range f.Resources {
+		switch r.Action {
+		case ActionFailed, ActionOutOfSync:
+			return false
+		}
+	}
+	return true
+}
+
+// Health returns the worst health of the resources of the file, or unknown
+// when the file could not be parsed.
+func (f FileResult) Health() Health {
+	health := HealthHealthy
+	if f.Error != "" {
+		health = HealthUnknown
+	}
+	for _, r := range f.Resources {
+		if r.Health != "" && healthRank[r.Health] > healthRank[health] {
+			health = r.Health
+		}
+	}
+	return health
+}
+
+// Result is the outcome of a sync.
+type Result struct {
+	// Revision is the git commit the desired state was read from, if known.
+	Revision string
+	DryRun   bool
+	Files    []FileResult
+}
+
+// Count returns the number of resources with the given action.
+func (r *Result) Count(action Action) int {
+	n := 0
+	for _, f := range r.Files {
+		for _, res := range f.Resources {
+			if res.Action == action {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// InvalidFiles returns the number of files that could not be parsed.
+func (r *Result) InvalidFiles() int {
+	n := 0
+	for _, f := range r.Files {
+		if f.Error != "" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fsmode
+
+import (
+	"strings"
+
+	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
+)
+
+// ExtractReferences returns the OpenChoreo resources an entry refers to.
+// Namespaced references resolve in the namespace of the referring resource.
+func ExtractReferences(entry *index.ResourceEntry) []index.Reference {
+	if entry == nil || entry.Resource == nil {
+		return nil
+	}
+
+	namespace := entry.Namespace()
+	ref := func(kind, name string) index.Reference {
+		return index.Reference{Kind: kind, Namespace: namespace, Name: name}
+	}
+
+	var refs []index.Reference
+
+	// Owner references: spec.owner.projectName and spec.owner.componentName
+	if owner := ExtractOwnerRef(entry); owner != nil {
+		refs = append(refs, ref(ProjectGVK.Kind, owner.ProjectName))
+		if entry.Resource.GroupVersionKind() != ComponentGVK {
+			refs = append(refs, ref(ComponentGVK.Kind, owner.ComponentName))
+		}
+	}
+
+	switch entry.Resource.GroupVersionKind() {
+	case ComponentGVK:
+		refs = append(refs, typedRef(entry.GetNestedMap("spec", "componentType"),
+			ComponentTypeGVK.Kind, namespace, componentTypeName))
+		for _, trait := range entry.GetNestedSlice("spec", "traits") {
+			if traitMap, ok := trait.(map[string]any); ok {
+				refs = append(refs, typedRef(traitMap, TraitGVK.Kind, namespace, nil))
+			}
+		}
+
+	case ReleaseBindingGVK:
+		refs = append(refs,
+			ref(ComponentReleaseGVK.Kind, entry.GetNestedString("spec", "releaseName")),
+			ref(EnvironmentGVK.Kind, entry.GetNestedString("spec", "environment")))
+
+	case ProjectGVK:
+		pipelineName := entry.GetNestedString("spec", "deploymentPipelineRef", "name")
+		if pipelineName == "" {
+			// Legacy form: deploymentPipelineRef: <name>
+			pipelineName = entry.GetNestedString("spec", "deploymentPipelineRef")
+		}
+		refs = append(refs, ref(DeploymentPipelineGVK.Kind, pipelineName))
+
+	case DeploymentPipelineGVK:
+		for _, path := range entry.GetNestedSlice("spec", "promotionPaths") {
+			pathMap, ok := path.(map[string]any)
+			if !ok {
+				continue
+			}
+			if source, ok := pathMap["sourceEnvironmentRef"].(map[string]any); ok {
+				refs = append(refs, typedRef(source, EnvironmentGVK.Kind, namespace, nil))
+			}
+			targets, _ := pathMap["targetEnvironmentRefs"].([]any)
+			for _, target := range targets {
+				if targetMap, ok := target.(map[string]any); ok {
+					refs = append(refs, typedRef(targetMap, EnvironmentGVK.Kind, namespace, nil))
+				}
+			}
+		}
+
+	case EnvironmentGVK:
+		refs = append(refs, typedRef(entry.GetNestedMap("spec", "dataPlaneRef"), DataPlaneGVK.Kind, namespace, nil))
+	}
+
+	// Drop unset references
+	set := refs[:0]
+	for _, r := range refs {
+		if r.Kind != "" && r.Name != "" {
+			set = append(set, r)
+		}
+	}
+	return set
+}
+
+// ListReleaseBindingsForRelease returns the release bindings that point at a component release
+func (idx *Index) ListReleaseBindingsForRelease(namespace, releaseName string) []*index.ResourceEntry {
+	return idx.listReferrers(ReleaseBindingGVK.Kind, ComponentReleaseGVK.Kind, namespace, releaseName)
+}
+
+// ListReleaseBindingsForEnvironment returns the release bindings that deploy to an environment
+func (idx *Index) ListReleaseBindingsForEnvironment(namespace, envName string) []*index.ResourceEntry {
+	return idx.listReferrers(ReleaseBindingGVK.Kind, EnvironmentGVK.Kind, namespace, envName)
+}
+
+// ListComponentsForComponentType returns the components that use a namespaced component type
+func (idx *Index) ListComponentsForComponentType(namespace, typeName string) []*index.ResourceEntry {
+	return idx.listReferrers(ComponentGVK.Kind, ComponentTypeGVK.Kind, namespace, typeName)
+}
+
+// listReferrers returns the resources of referrerKind that reference the given resource
+func (idx *Index) listReferrers(referrerKind, kind, namespace, name string) []*index.ResourceEntry {
+	var referrers []*index.ResourceEntry
+	for _, entry := range idx.Index.ReferencedBy(index.Reference{Kind: kind, Namespace: namespace, Name: name}) {
+		if entry.Resource.GetKind() == referrerKind {
+			referrers = append(referrers, entry)
+		}
+	}
+	return referrers
+}
+
+// typedRef builds a reference from a {kind, name} ref object. Kinds prefixed
+// with "Cluster" are cluster-scoped; an empty kind defaults to defaultKind.
+func typedRef(refMap map[string]any, defaultKind, namespace string, normalizeName func(string) string) index.Reference {
+	if refMap == nil {
+		return index.Reference{}
+	}
+	kind, _ := refMap["kind"].(string)
+	name, _ := refMap["name"].(string)
+	if kind == "" {
+		kind = defaultKind
+	}
+	if normalizeName != nil {
+		name = normalizeName(name)
+	}
+	if strings.HasPrefix(kind, "Cluster") {
+		namespace = ""
+	}
+	return index.Reference{Kind: kind, Namespace: namespace, Name: name}
+}
+
+// componentTypeName strips the workload type from a "{workloadType}/{name}" component type reference
+func componentTypeName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
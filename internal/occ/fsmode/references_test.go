@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fsmode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
+)
+
+func newEntry(kind, namespace, name string, spec map[string]any) *index.ResourceEntry {
+	return &index.ResourceEntry{
+		Resource: &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "openchoreo.dev/v1alpha1",
+				"kind":       kind,
+				"metadata":   map[string]any{"name": name, "namespace": namespace},
+				"spec":       spec,
+			},
+		},
+		FilePath: "/repo/" + kind + "/" + name + ".yaml",
+	}
+}
+
+func TestExtractReferences(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry *index.ResourceEntry
+		want  []index.Reference
+	}{
+		{
+			name: "release binding",
+			entry: newEntry("ReleaseBinding", "ns1", "web-dev", map[string]any{
+				"owner":       map[string]any{"projectName": "proj-a", "componentName": "web"},
+				"environment": "dev",
+				"releaseName": "web-1",
+			}),
+			want: []index.Reference{
+				{Kind: "Project", Namespace: "ns1", Name: "proj-a"},
+				{Kind: "Component", Namespace: "ns1", Name: "web"},
+				{Kind: "ComponentRelease", Namespace: "ns1", Name: "web-1"},
+				{Kind: "Environment", Namespace: "ns1", Name: "dev"},
+			},
+		},
+		{
+			name: "component with cluster type and traits",
+			entry: newEntry("Component", "ns1", "web", map[string]any{
+				"owner":         map[string]any{"projectName": "proj-a"},
+				"componentType": map[string]any{"kind": "ClusterComponentType", "name": "deployment/service"},
+				"traits": []any{
+					map[string]any{"name": "autoscaler", "instanceName": "hpa"},
+					map[string]any{"kind": "ClusterTrait", "name": "ingress", "instanceName": "ing"},
+				},
+			}),
+			want: []index.Reference{
+				{Kind: "Project", Namespace: "ns1", Name: "proj-a"},
+				{Kind: "ClusterComponentType", Name: "service"},
+				{Kind: "Trait", Namespace: "ns1", Name: "autoscaler"},
+				{Kind: "ClusterTrait", Name: "ingress"},
+			},
+		},
+		{
+			name: "project with legacy pipeline ref",
+			entry: newEntry("Project", "ns1", "proj-a", map[string]any{
+				"deploymentPipelineRef": "default",
+			}),
+			want: []index.Reference{
+				{Kind: "DeploymentPipeline", Namespace: "ns1", Name: "default"},
+			},
+		},
+		{
+			name: "deployment pipeline",
+			entry: newEntry("DeploymentPipeline", "ns1", "default", map[string]any{
+				"promotionPaths": []any{
+					map[string]any{
+						"sourceEnvironmentRef":  map[string]any{"name": "dev"},
+						"targetEnvironmentRefs": []any{map[string]any{"name": "staging"}},
+					},
+				},
+			}),
+			want: []index.Reference{
+				{Kind: "Environment", Namespace: "ns1", Name: "dev"},
+				{Kind: "Environment", Namespace: "ns1", Name: "staging"},
+			},
+		},
+		{
+			name: "environment on a cluster data plane",
+			entry: newEntry("Environment", "ns1", "dev", map[string]any{
+				"dataPlaneRef": map[string]any{"kind": "ClusterDataPlane", "name": "default"},
+			}),
+			want: []index.Reference{
+				{Kind: "ClusterDataPlane", Name: "default"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExtractReferences(tt.entry))
+		})
+	}
+}
+
+func TestReverseReferenceLookups(t *testing.T) {
+	idx := index.New("/repo")
+	binding := func(name, env, release string) *index.ResourceEntry {
+		return newEntry("ReleaseBinding", "ns1", name, map[string]any{
+			"owner":       map[string]any{"projectName": "proj-a", "componentName": "web"},
+			"environment": env,
+			"releaseName": release,
+		})
+	}
+	require.NoError(t, idx.Add(binding("web-dev", "dev", "web-2")))
+	require.NoError(t, idx.Add(binding("web-staging", "staging", "web-1")))
+	require.NoError(t, idx.Add(binding("web-prod", "prod", "web-1")))
+	require.NoError(t, idx.Add(newEntry("ComponentRelease", "ns1", "web-1", map[string]any{
+		"owner": map[string]any{"projectName": "proj-a", "componentName": "web"},
+	})))
+	require.NoError(t, idx.Add(newEntry("Component", "ns1", "web", map[string]any{
+		"owner":         map[string]any{"projectName": "proj-a"},
+		"componentType": map[string]any{"name": "deployment/http-service"},
+	})))
+
+	ocIndex := WrapIndex(idx)
+
+	names := func(entries []*index.ResourceEntry) []string {
+		var result []string
+		for _, entry := range entries {
+			result = append(result, entry.Name())
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"web-prod", "web-staging"}, names(ocIndex.ListReleaseBindingsForRelease("ns1", "web-1")))
+	assert.Equal(t, []string{"web-dev"}, names(ocIndex.ListReleaseBindingsForEnvironment("ns1", "dev")))
+	assert.Equal(t, []string{"web"}, names(ocIndex.ListComponentsForComponentType("ns1", "http-service")))
+	assert.Empty(t, ocIndex.ListReleaseBindingsForRelease("ns2", "web-1"))
+
+	// The component release is referenced only by bindings; the component by
+	// bindings and its release
+	assert.Equal(t, []string{"web-1", "web-dev", "web-prod", "web-staging"},
+		names(ocIndex.ReferencedBy(index.Reference{Kind: "Component", Namespace: "ns1", Name: "web"})))
+
+	// Entries added after wrapping are tracked as well
+	idx.RemoveEntriesForFile("/repo/ReleaseBinding/web-dev.yaml")
+	require.NoError(t, idx.Add(binding("web-dev", "dev", "web-1")))
+	assert.Equal(t, []string{"web-dev", "web-prod", "web-staging"},
+		names(ocIndex.ListReleaseBindingsForRelease("ns1", "web-1")))
+}
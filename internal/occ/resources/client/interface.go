@@ -93,6 +93,12 @@ type Interface interface {
 	CreateWorkflowRun(ctx context.Context, namespace string, body gen.CreateWorkflowRunJSONRequestBody) (*gen.WorkflowRun, error)
 	GetWorkflowRunStatus(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRunStatusResponse, error)
 	GetWorkflowRunLogs(ctx context.Context, namespaceName, runName string, params *gen.GetWorkflowRunLogsParams) ([]gen.WorkflowRunLogEntry, error)
+	CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error)
+	RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error)
+	ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error)
+
+	ListWorkflowRunGroups(ctx context.Context, namespaceName string, params *gen.ListWorkflowRunGroupsParams) (*gen.WorkflowRunGroupList, error)
+	GetWorkflowRunGroup(ctx context.Context, namespaceName, groupName string) (*gen.WorkflowRunGroup, error)
 
 	ListComponentReleases(ctx context.Context, namespaceName string, params *gen.ListComponentReleasesParams) (*gen.ComponentReleaseList, error)
 	GetComponentRelease(ctx context.Context, namespaceName, componentReleaseName string) (*gen.ComponentRelease, error)
@@ -199,6 +205,9 @@ type Interface interface {
 	ListNamespaceRoleBindings(ctx context.Context, namespaceName string, params *gen.ListNamespaceRoleBindingsParams) (*gen.AuthzRoleBindingList, error)
 	GetNamespaceRoleBinding(ctx context.Context, namespaceName, name string) (*gen.AuthzRoleBinding, error)
 	DeleteNamespaceRoleBinding(ctx context.Context, namespaceName, name string) error
+
+	ExplainAuthz(ctx context.Context, req gen.EvaluateRequest) (*gen.AuthzExplanation, error)
+	SimulateAuthz(ctx context.Context, req gen.AuthzSimulateRequest) (*gen.AuthzExplanation, error)
 }
 
 // compile-time check that *Client satisfies Interface.
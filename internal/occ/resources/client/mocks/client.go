@@ -24,6 +24,66 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 	return &MockInterface_Expecter{mock: &_m.Mock}
 }
 
+// CancelWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockInterface) CancelWorkflowRun(ctx context.Context, namespaceName string, runName string) (*gen.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelWorkflowRun")
+	}
+
+	var r0 *gen.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_CancelWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelWorkflowRun'
+type MockInterface_CancelWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// CancelWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockInterface_Expecter) CancelWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}) *MockInterface_CancelWorkflowRun_Call {
+	return &MockInterface_CancelWorkflowRun_Call{Call: _e.mock.On("CancelWorkflowRun", ctx, namespaceName, runName)}
+}
+
+func (_c *MockInterface_CancelWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockInterface_CancelWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_CancelWorkflowRun_Call) Return(_a0 *gen.WorkflowRun, _a1 error) *MockInterface_CancelWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_CancelWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string) (*gen.WorkflowRun, error)) *MockInterface_CancelWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateClusterProjectType provides a mock function with given fields: ctx, cpt
 func (_m *MockInterface) CreateClusterProjectType(ctx context.Context, cpt gen.ClusterProjectType) (*gen.ClusterProjectType, error) {
 	ret := _m.Called(ctx, cpt)
@@ -2639,6 +2699,65 @@ func (_c *MockInterface_DeleteWorkload_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ExplainAuthz provides a mock function with given fields: ctx, req
+func (_m *MockInterface) ExplainAuthz(ctx context.Context, req gen.EvaluateRequest) (*gen.AuthzExplanation, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainAuthz")
+	}
+
+	var r0 *gen.AuthzExplanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gen.EvaluateRequest) (*gen.AuthzExplanation, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gen.EvaluateRequest) *gen.AuthzExplanation); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AuthzExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gen.EvaluateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ExplainAuthz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainAuthz'
+type MockInterface_ExplainAuthz_Call struct {
+	*mock.Call
+}
+
+// ExplainAuthz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req gen.EvaluateRequest
+func (_e *MockInterface_Expecter) ExplainAuthz(ctx interface{}, req interface{}) *MockInterface_ExplainAuthz_Call {
+	return &MockInterface_ExplainAuthz_Call{Call: _e.mock.On("ExplainAuthz", ctx, req)}
+}
+
+func (_c *MockInterface_ExplainAuthz_Call) Run(run func(ctx context.Context, req gen.EvaluateRequest)) *MockInterface_ExplainAuthz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gen.EvaluateRequest))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ExplainAuthz_Call) Return(_a0 *gen.AuthzExplanation, _a1 error) *MockInterface_ExplainAuthz_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ExplainAuthz_Call) RunAndReturn(run func(context.Context, gen.EvaluateRequest) (*gen.AuthzExplanation, error)) *MockInterface_ExplainAuthz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateRelease provides a mock function with given fields: ctx, namespaceName, componentName, req
 func (_m *MockInterface) GenerateRelease(ctx context.Context, namespaceName string, componentName string, req gen.GenerateReleaseRequest) (*gen.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, componentName, req)
@@ -5444,6 +5563,66 @@ func (_c *MockInterface_GetWorkflowRun_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetWorkflowRunGroup provides a mock function with given fields: ctx, namespaceName, groupName
+func (_m *MockInterface) GetWorkflowRunGroup(ctx context.Context, namespaceName string, groupName string) (*gen.WorkflowRunGroup, error) {
+	ret := _m.Called(ctx, namespaceName, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunGroup")
+	}
+
+	var r0 *gen.WorkflowRunGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.WorkflowRunGroup, error)); ok {
+		return rf(ctx, namespaceName, groupName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.WorkflowRunGroup); ok {
+		r0 = rf(ctx, namespaceName, groupName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.WorkflowRunGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, groupName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetWorkflowRunGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunGroup'
+type MockInterface_GetWorkflowRunGroup_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - groupName string
+func (_e *MockInterface_Expecter) GetWorkflowRunGroup(ctx interface{}, namespaceName interface{}, groupName interface{}) *MockInterface_GetWorkflowRunGroup_Call {
+	return &MockInterface_GetWorkflowRunGroup_Call{Call: _e.mock.On("GetWorkflowRunGroup", ctx, namespaceName, groupName)}
+}
+
+func (_c *MockInterface_GetWorkflowRunGroup_Call) Run(run func(ctx context.Context, namespaceName string, groupName string)) *MockInterface_GetWorkflowRunGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetWorkflowRunGroup_Call) Return(_a0 *gen.WorkflowRunGroup, _a1 error) *MockInterface_GetWorkflowRunGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetWorkflowRunGroup_Call) RunAndReturn(run func(context.Context, string, string) (*gen.WorkflowRunGroup, error)) *MockInterface_GetWorkflowRunGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRunLogs provides a mock function with given fields: ctx, namespaceName, runName, params
 func (_m *MockInterface) GetWorkflowRunLogs(ctx context.Context, namespaceName string, runName string, params *gen.GetWorkflowRunLogsParams) ([]gen.WorkflowRunLogEntry, error) {
 	ret := _m.Called(ctx, namespaceName, runName, params)
@@ -7715,6 +7894,66 @@ func (_c *MockInterface_ListWorkflowPlanes_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListWorkflowRunGroups provides a mock function with given fields: ctx, namespaceName, params
+func (_m *MockInterface) ListWorkflowRunGroups(ctx context.Context, namespaceName string, params *gen.ListWorkflowRunGroupsParams) (*gen.WorkflowRunGroupList, error) {
+	ret := _m.Called(ctx, namespaceName, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowRunGroups")
+	}
+
+	var r0 *gen.WorkflowRunGroupList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gen.ListWorkflowRunGroupsParams) (*gen.WorkflowRunGroupList, error)); ok {
+		return rf(ctx, namespaceName, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gen.ListWorkflowRunGroupsParams) *gen.WorkflowRunGroupList); ok {
+		r0 = rf(ctx, namespaceName, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.WorkflowRunGroupList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *gen.ListWorkflowRunGroupsParams) error); ok {
+		r1 = rf(ctx, namespaceName, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ListWorkflowRunGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowRunGroups'
+type MockInterface_ListWorkflowRunGroups_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowRunGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - params *gen.ListWorkflowRunGroupsParams
+func (_e *MockInterface_Expecter) ListWorkflowRunGroups(ctx interface{}, namespaceName interface{}, params interface{}) *MockInterface_ListWorkflowRunGroups_Call {
+	return &MockInterface_ListWorkflowRunGroups_Call{Call: _e.mock.On("ListWorkflowRunGroups", ctx, namespaceName, params)}
+}
+
+func (_c *MockInterface_ListWorkflowRunGroups_Call) Run(run func(ctx context.Context, namespaceName string, params *gen.ListWorkflowRunGroupsParams)) *MockInterface_ListWorkflowRunGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*gen.ListWorkflowRunGroupsParams))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ListWorkflowRunGroups_Call) Return(_a0 *gen.WorkflowRunGroupList, _a1 error) *MockInterface_ListWorkflowRunGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ListWorkflowRunGroups_Call) RunAndReturn(run func(context.Context, string, *gen.ListWorkflowRunGroupsParams) (*gen.WorkflowRunGroupList, error)) *MockInterface_ListWorkflowRunGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowRuns provides a mock function with given fields: ctx, namespaceName, params
 func (_m *MockInterface) ListWorkflowRuns(ctx context.Context, namespaceName string, params *gen.ListWorkflowRunsParams) (*gen.WorkflowRunList, error) {
 	ret := _m.Called(ctx, namespaceName, params)
@@ -7895,6 +8134,185 @@ func (_c *MockInterface_ListWorkloads_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ResumeWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockInterface) ResumeWorkflowRun(ctx context.Context, namespaceName string, runName string) (*gen.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeWorkflowRun")
+	}
+
+	var r0 *gen.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ResumeWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeWorkflowRun'
+type MockInterface_ResumeWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// ResumeWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockInterface_Expecter) ResumeWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}) *MockInterface_ResumeWorkflowRun_Call {
+	return &MockInterface_ResumeWorkflowRun_Call{Call: _e.mock.On("ResumeWorkflowRun", ctx, namespaceName, runName)}
+}
+
+func (_c *MockInterface_ResumeWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockInterface_ResumeWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ResumeWorkflowRun_Call) Return(_a0 *gen.WorkflowRun, _a1 error) *MockInterface_ResumeWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ResumeWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string) (*gen.WorkflowRun, error)) *MockInterface_ResumeWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockInterface) RetryWorkflowRun(ctx context.Context, namespaceName string, runName string) (*gen.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryWorkflowRun")
+	}
+
+	var r0 *gen.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_RetryWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryWorkflowRun'
+type MockInterface_RetryWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// RetryWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockInterface_Expecter) RetryWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}) *MockInterface_RetryWorkflowRun_Call {
+	return &MockInterface_RetryWorkflowRun_Call{Call: _e.mock.On("RetryWorkflowRun", ctx, namespaceName, runName)}
+}
+
+func (_c *MockInterface_RetryWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockInterface_RetryWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_RetryWorkflowRun_Call) Return(_a0 *gen.WorkflowRun, _a1 error) *MockInterface_RetryWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_RetryWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string) (*gen.WorkflowRun, error)) *MockInterface_RetryWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateAuthz provides a mock function with given fields: ctx, req
+func (_m *MockInterface) SimulateAuthz(ctx context.Context, req gen.AuthzSimulateRequest) (*gen.AuthzExplanation, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateAuthz")
+	}
+
+	var r0 *gen.AuthzExplanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gen.AuthzSimulateRequest) (*gen.AuthzExplanation, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gen.AuthzSimulateRequest) *gen.AuthzExplanation); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AuthzExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gen.AuthzSimulateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_SimulateAuthz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateAuthz'
+type MockInterface_SimulateAuthz_Call struct {
+	*mock.Call
+}
+
+// SimulateAuthz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req gen.AuthzSimulateRequest
+func (_e *MockInterface_Expecter) SimulateAuthz(ctx interface{}, req interface{}) *MockInterface_SimulateAuthz_Call {
+	return &MockInterface_SimulateAuthz_Call{Call: _e.mock.On("SimulateAuthz", ctx, req)}
+}
+
+func (_c *MockInterface_SimulateAuthz_Call) Run(run func(ctx context.Context, req gen.AuthzSimulateRequest)) *MockInterface_SimulateAuthz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gen.AuthzSimulateRequest))
+	})
+	return _c
+}
+
+func (_c *MockInterface_SimulateAuthz_Call) Return(_a0 *gen.AuthzExplanation, _a1 error) *MockInterface_SimulateAuthz_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_SimulateAuthz_Call) RunAndReturn(run func(context.Context, gen.AuthzSimulateRequest) (*gen.AuthzExplanation, error)) *MockInterface_SimulateAuthz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateClusterProjectType provides a mock function with given fields: ctx, cptName, cpt
 func (_m *MockInterface) UpdateClusterProjectType(ctx context.Context, cptName string, cpt gen.ClusterProjectType) (*gen.ClusterProjectType, error) {
 	ret := _m.Called(ctx, cptName, cpt)
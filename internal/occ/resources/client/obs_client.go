@@ -4,19 +4,31 @@
 package client
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
+// maxLogStreamEventSize bounds a single server-sent event of a log tail.
+const maxLogStreamEventSize = 16 * 1024 * 1024
+
+// ErrLogTailUnsupported is returned by the tail methods when the observer does not
+// provide the log tail endpoint, so callers can fall back to polling.
+var ErrLogTailUnsupported = errors.New("observer does not support log tailing")
+
 // ObserverClient provides HTTP client for Observer API
 type ObserverClient struct {
 	baseURL    string
 	token      string
 	httpClient *http.Client
+	// streamClient has no overall timeout; streams end with their context.
+	streamClient *http.Client
 }
 
 // LogEntry represents a single log entry from observer
@@ -68,9 +80,10 @@ type ComponentLogsRequest struct {
 // NewObserverClient creates a new Observer API client
 func NewObserverClient(observerURL, token string) *ObserverClient {
 	return &ObserverClient{
-		baseURL:    observerURL,
-		token:      token,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:      observerURL,
+		token:        token,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+		streamClient: &http.Client{},
 	}
 }
 
@@ -89,6 +102,12 @@ func (c *ObserverClient) FetchComponentLogs(ctx context.Context, req ComponentLo
 			"environment": req.EnvironmentName,
 		},
 	}
+	if req.SearchPhrase != "" {
+		payload["searchPhrase"] = req.SearchPhrase
+	}
+	if len(req.LogLevels) > 0 {
+		payload["logLevels"] = req.LogLevels
+	}
 
 	path := "/api/v1/logs/query"
 	resp, err := c.doRequest(ctx, "POST", path, payload)
@@ -161,6 +180,128 @@ func (c *ObserverClient) FetchWorkflowRunLogs(ctx context.Context, runName strin
 	return &logResponse, nil
 }
 
+// ComponentLogsTailRequest represents the request body for tailing component logs
+type ComponentLogsTailRequest struct {
+	StartTime       string   `json:"startTime,omitempty"`
+	ComponentName   string   `json:"componentName"`
+	ProjectName     string   `json:"projectName"`
+	NamespaceName   string   `json:"namespaceName"`
+	EnvironmentName string   `json:"environmentName"`
+	SearchPhrase    string   `json:"searchPhrase,omitempty"`
+	LogLevels       []string `json:"logLevels,omitempty"`
+}
+
+// TailComponentLogs streams new logs of a component from the observer API, calling
+// onLogs for each batch, until ctx is done or the stream fails.
+func (c *ObserverClient) TailComponentLogs(ctx context.Context, req ComponentLogsTailRequest, onLogs func([]LogEntry)) error {
+	payload := map[string]interface{}{
+		"searchScope": map[string]interface{}{
+			"namespace":   req.NamespaceName,
+			"project":     req.ProjectName,
+			"component":   req.ComponentName,
+			"environment": req.EnvironmentName,
+		},
+	}
+	if req.StartTime != "" {
+		payload["startTime"] = req.StartTime
+	}
+	if req.SearchPhrase != "" {
+		payload["searchPhrase"] = req.SearchPhrase
+	}
+	if len(req.LogLevels) > 0 {
+		payload["logLevels"] = req.LogLevels
+	}
+	return c.tailLogs(ctx, payload, onLogs)
+}
+
+// TailWorkflowRunLogs streams new logs of a workflow run from the observer API, calling
+// onLogs for each batch, until ctx is done or the stream fails.
+func (c *ObserverClient) TailWorkflowRunLogs(ctx context.Context, namespace, runName, startTime string, onLogs func([]LogEntry)) error {
+	payload := map[string]interface{}{
+		"searchScope": map[string]interface{}{
+			"namespace":       namespace,
+			"workflowRunName": runName,
+		},
+	}
+	if startTime != "" {
+		payload["startTime"] = startTime
+	}
+	return c.tailLogs(ctx, payload, onLogs)
+}
+
+// tailLogs opens a log tail and reads its server-sent events. It returns nil when ctx
+// is done.
+func (c *ObserverClient) tailLogs(ctx context.Context, payload map[string]interface{}, onLogs func([]LogEntry)) error {
+	streamClient := &APIClient{
+		baseURL:    c.baseURL,
+		token:      c.token,
+		httpClient: c.streamClient,
+	}
+	resp, err := streamClient.doRequest(ctx, "POST", "/api/v1/logs/tail", payload)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return ErrLogTailUnsupported
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("observer API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	err = readLogStream(resp.Body, onLogs)
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// readLogStream dispatches the "logs" events of a log tail to onLogs. Comments are
+// ignored and an "error" event ends the stream with an error.
+func readLogStream(r io.Reader, onLogs func([]LogEntry)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogStreamEventSize)
+
+	var event string
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			switch event {
+			case "logs":
+				var logs []LogEntry
+				if err := json.Unmarshal([]byte(data.String()), &logs); err != nil {
+					return fmt.Errorf("failed to parse log stream event: %w", err)
+				}
+				onLogs(logs)
+			case "error":
+				return fmt.Errorf("observer stopped the log stream: %s", data.String())
+			}
+			event = ""
+			data.Reset()
+		case strings.HasPrefix(line, ":"):
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read log stream: %w", err)
+	}
+	return fmt.Errorf("log stream closed by the observer")
+}
+
 // doRequest performs HTTP request with proper headers
 func (c *ObserverClient) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	// Reuse legacy_client.go's APIClient doRequest logic
@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+func TestReadLogStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		stream   string
+		wantLogs []string
+		wantErr  string
+	}{
+		{
+			name: "logs events and keep-alives",
+			stream: ": keep-alive\n\n" +
+				"event: logs\ndata: [{\"timestamp\":\"2026-01-01T00:00:00Z\",\"log\":\"first\"}]\n\n" +
+				"event: logs\ndata: [{\"timestamp\":\"2026-01-01T00:00:01Z\",\"log\":\"second\"}," +
+				"{\"timestamp\":\"2026-01-01T00:00:01Z\",\"log\":\"third\"}]\n\n",
+			wantLogs: []string{"first", "second", "third"},
+			wantErr:  "closed by the observer",
+		},
+		{
+			name:     "error event",
+			stream:   "event: logs\ndata: [{\"log\":\"first\"}]\n\nevent: error\ndata: {\"message\":\"log tail stopped\"}\n\n",
+			wantLogs: []string{"first"},
+			wantErr:  "log tail stopped",
+		},
+		{
+			name:    "malformed event",
+			stream:  "event: logs\ndata: not-json\n\n",
+			wantErr: "failed to parse log stream event",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := readLogStream(strings.NewReader(tt.stream), func(logs []LogEntry) {
+				for _, log := range logs {
+					got = append(got, log.Log)
+				}
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, tt.wantLogs, got)
+		})
+	}
+}
+
+func TestTailComponentLogs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/logs/tail", r.URL.Path)
+		assert.Equal(t, "Bearer "+testutil.NonExpiredJWT, r.Header.Get("Authorization"))
+
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "2026-01-01T00:00:00Z", body["startTime"])
+		assert.Equal(t, []any{"ERROR"}, body["logLevels"])
+		assert.Equal(t, "my-comp", body["searchScope"].(map[string]any)["component"])
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: logs\ndata: [{\"timestamp\":\"2026-01-01T00:00:00Z\",\"log\":\"boom\"}]\n\n"))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	var got []LogEntry
+	err := NewObserverClient(server.URL, testutil.NonExpiredJWT).TailComponentLogs(ctx, ComponentLogsTailRequest{
+		StartTime:       "2026-01-01T00:00:00Z",
+		ComponentName:   "my-comp",
+		ProjectName:     "my-proj",
+		NamespaceName:   "ns",
+		EnvironmentName: "dev",
+		LogLevels:       []string{"ERROR"},
+	}, func(logs []LogEntry) {
+		got = append(got, logs...)
+		cancel()
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "boom", got[0].Log)
+}
+
+func TestTailWorkflowRunLogs_Unsupported(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	err := NewObserverClient(server.URL, "").TailWorkflowRunLogs(context.Background(), "ns", "run-1", "", func([]LogEntry) {
+		t.Fatal("unexpected logs")
+	})
+	assert.ErrorIs(t, err, ErrLogTailUnsupported)
+}
@@ -905,6 +905,69 @@ func (c *Client) GetWorkflowRun(ctx context.Context, namespaceName, runName stri
 	return resp.JSON200, nil
 }
 
+// CancelWorkflowRun requests the cancellation of a workflow run
+func (c *Client) CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error) {
+	resp, err := c.client.CancelWorkflowRunWithResponse(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel workflow run: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// RetryWorkflowRun starts a new workflow run with the same workflow and parameters as a completed run
+func (c *Client) RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error) {
+	resp, err := c.client.RetryWorkflowRunWithResponse(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retry workflow run: %w", err)
+	}
+	if resp.JSON201 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON201, nil
+}
+
+// ResumeWorkflowRun resumes a failed workflow run from its failed tasks
+func (c *Client) ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error) {
+	resp, err := c.client.ResumeWorkflowRunWithResponse(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume workflow run: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ListWorkflowRunGroups retrieves all workflow run groups for a namespace
+func (c *Client) ListWorkflowRunGroups(ctx context.Context, namespaceName string, params *gen.ListWorkflowRunGroupsParams) (*gen.WorkflowRunGroupList, error) {
+	if params == nil {
+		params = &gen.ListWorkflowRunGroupsParams{}
+	}
+	resp, err := c.client.ListWorkflowRunGroupsWithResponse(ctx, namespaceName, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow run groups: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetWorkflowRunGroup retrieves a specific workflow run group
+func (c *Client) GetWorkflowRunGroup(ctx context.Context, namespaceName, groupName string) (*gen.WorkflowRunGroup, error) {
+	resp, err := c.client.GetWorkflowRunGroupWithResponse(ctx, namespaceName, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow run group: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
 // GetWorkload retrieves a specific workload
 func (c *Client) GetWorkload(ctx context.Context, namespaceName, workloadName string) (*gen.Workload, error) {
 	resp, err := c.client.GetWorkloadWithResponse(ctx, namespaceName, workloadName)
@@ -1241,6 +1304,30 @@ func (c *Client) DeleteNamespaceRoleBinding(ctx context.Context, namespaceName,
 	return nil
 }
 
+// ExplainAuthz evaluates an authorization request and returns the policies that decided it
+func (c *Client) ExplainAuthz(ctx context.Context, req gen.EvaluateRequest) (*gen.AuthzExplanation, error) {
+	resp, err := c.client.ExplainAuthzWithResponse(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain authorization decision: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// SimulateAuthz explains an authorization request against proposed roles and role bindings
+func (c *Client) SimulateAuthz(ctx context.Context, req gen.AuthzSimulateRequest) (*gen.AuthzExplanation, error) {
+	resp, err := c.client.SimulateAuthzWithResponse(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate authorization decision: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
 // GetWorkflowRunLogs retrieves live logs for a workflow run from the workflow plane
 func (c *Client) GetWorkflowRunLogs(ctx context.Context, namespaceName, runName string, params *gen.GetWorkflowRunLogsParams) ([]gen.WorkflowRunLogEntry, error) {
 	if params == nil {
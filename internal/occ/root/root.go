@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/apply"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/authz"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/authzrole"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/authzrolebinding"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/clusterauthzrole"
@@ -47,6 +48,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflow"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowplane"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrungroup"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workload"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 )
@@ -75,6 +77,7 @@ func BuildRootCmd() *cobra.Command {
 		resourcereleasebinding.NewResourceReleaseBindingCmd(f),
 		projectreleasebinding.NewProjectReleaseBindingCmd(f),
 		releasebinding.NewReleaseBindingCmd(f),
+		releasebinding.NewPromoteCmd(),
 		namespace.NewNamespaceCmd(f),
 		project.NewProjectCmd(f),
 		component.NewComponentCmd(f),
@@ -99,8 +102,10 @@ func BuildRootCmd() *cobra.Command {
 		clusterauthzrolebinding.NewClusterAuthzRoleBindingCmd(f),
 		authzrole.NewAuthzRoleCmd(f),
 		authzrolebinding.NewAuthzRoleBindingCmd(f),
+		authz.NewAuthzCmd(f),
 		workflow.NewWorkflowCmd(f),
 		workflowrun.NewWorkflowRunCmd(f),
+		workflowrungroup.NewWorkflowRunGroupCmd(f),
 		secretreference.NewSecretReferenceCmd(f),
 		secret.NewSecretCmd(f),
 		workload.NewWorkloadCmd(f),
@@ -32,6 +32,7 @@ func TestBuildRootCmd_Subcommands(t *testing.T) {
 		"resourcereleasebinding",
 		"projectreleasebinding",
 		"releasebinding",
+		"promote",
 		"namespace",
 		"project",
 		"component",
@@ -56,8 +57,10 @@ func TestBuildRootCmd_Subcommands(t *testing.T) {
 		"clusterauthzrolebinding",
 		"authzrole",
 		"authzrolebinding",
+		"authz",
 		"workflow",
 		"workflowrun",
+		"workflowrungroup",
 		"secretreference",
 		"secret",
 		"workload",
@@ -98,14 +98,27 @@ type ClientInterface interface {
 	// ListActions request
 	ListActions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAuthzDecisions request
+	ListAuthzDecisions(ctx context.Context, params *ListAuthzDecisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EvaluatesWithBody request with any body
 	EvaluatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Evaluates(ctx context.Context, body EvaluatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExplainAuthzWithBody request with any body
+	ExplainAuthzWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExplainAuthz(ctx context.Context, body ExplainAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSubjectProfile request
 	GetSubjectProfile(ctx context.Context, params *GetSubjectProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SimulateAuthzWithBody request with any body
+	SimulateAuthzWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimulateAuthz(ctx context.Context, body SimulateAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListClusterRoleBindings request
 	ListClusterRoleBindings(ctx context.Context, params *ListClusterRoleBindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -330,6 +343,27 @@ type ClientInterface interface {
 
 	UpdateNamespace(ctx context.Context, namespaceName NamespaceNameParam, body UpdateNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAuthzAccessRequests request
+	ListAuthzAccessRequests(ctx context.Context, namespaceName NamespaceNameParam, params *ListAuthzAccessRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAuthzAccessRequestWithBody request with any body
+	CreateAuthzAccessRequestWithBody(ctx context.Context, namespaceName NamespaceNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, body CreateAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthzAccessRequest request
+	GetAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveAuthzAccessRequestWithBody request with any body
+	ApproveAuthzAccessRequestWithBody(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body ApproveAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DenyAuthzAccessRequestWithBody request with any body
+	DenyAuthzAccessRequestWithBody(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DenyAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body DenyAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListNamespaceRoleBindings request
 	ListNamespaceRoleBindings(ctx context.Context, namespaceName NamespaceNameParam, params *ListNamespaceRoleBindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -762,6 +796,12 @@ type ClientInterface interface {
 
 	UpdateWorkflowPlane(ctx context.Context, namespaceName NamespaceNameParam, workflowPlaneName WorkflowPlaneNameParam, body UpdateWorkflowPlaneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListWorkflowRunGroups request
+	ListWorkflowRunGroups(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkflowRunGroup request
+	GetWorkflowRunGroup(ctx context.Context, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListWorkflowRuns request
 	ListWorkflowRuns(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -781,12 +821,21 @@ type ClientInterface interface {
 
 	UpdateWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, body UpdateWorkflowRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CancelWorkflowRun request
+	CancelWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkflowRunEvents request
 	GetWorkflowRunEvents(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetWorkflowRunLogs request
 	GetWorkflowRunLogs(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ResumeWorkflowRun request
+	ResumeWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryWorkflowRun request
+	RetryWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkflowRunStatus request
 	GetWorkflowRunStatus(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -918,6 +967,18 @@ func (c *Client) ListActions(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListAuthzDecisions(ctx context.Context, params *ListAuthzDecisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuthzDecisionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EvaluatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEvaluatesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -942,6 +1003,30 @@ func (c *Client) Evaluates(ctx context.Context, body EvaluatesJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
+func (c *Client) ExplainAuthzWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainAuthzRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExplainAuthz(ctx context.Context, body ExplainAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainAuthzRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSubjectProfile(ctx context.Context, params *GetSubjectProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSubjectProfileRequest(c.Server, params)
 	if err != nil {
@@ -954,6 +1039,30 @@ func (c *Client) GetSubjectProfile(ctx context.Context, params *GetSubjectProfil
 	return c.Client.Do(req)
 }
 
+func (c *Client) SimulateAuthzWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimulateAuthzRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimulateAuthz(ctx context.Context, body SimulateAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimulateAuthzRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListClusterRoleBindings(ctx context.Context, params *ListClusterRoleBindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListClusterRoleBindingsRequest(c.Server, params)
 	if err != nil {
@@ -1938,6 +2047,102 @@ func (c *Client) UpdateNamespace(ctx context.Context, namespaceName NamespaceNam
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListAuthzAccessRequests(ctx context.Context, namespaceName NamespaceNameParam, params *ListAuthzAccessRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuthzAccessRequestsRequest(c.Server, namespaceName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAuthzAccessRequestWithBody(ctx context.Context, namespaceName NamespaceNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAuthzAccessRequestRequestWithBody(c.Server, namespaceName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, body CreateAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAuthzAccessRequestRequest(c.Server, namespaceName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthzAccessRequestRequest(c.Server, namespaceName, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveAuthzAccessRequestWithBody(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveAuthzAccessRequestRequestWithBody(c.Server, namespaceName, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body ApproveAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveAuthzAccessRequestRequest(c.Server, namespaceName, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DenyAuthzAccessRequestWithBody(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDenyAuthzAccessRequestRequestWithBody(c.Server, namespaceName, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DenyAuthzAccessRequest(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body DenyAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDenyAuthzAccessRequestRequest(c.Server, namespaceName, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListNamespaceRoleBindings(ctx context.Context, namespaceName NamespaceNameParam, params *ListNamespaceRoleBindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListNamespaceRoleBindingsRequest(c.Server, namespaceName, params)
 	if err != nil {
@@ -3834,6 +4039,30 @@ func (c *Client) UpdateWorkflowPlane(ctx context.Context, namespaceName Namespac
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListWorkflowRunGroups(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWorkflowRunGroupsRequest(c.Server, namespaceName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkflowRunGroup(ctx context.Context, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkflowRunGroupRequest(c.Server, namespaceName, groupName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListWorkflowRuns(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListWorkflowRunsRequest(c.Server, namespaceName, params)
 	if err != nil {
@@ -3918,6 +4147,18 @@ func (c *Client) UpdateWorkflowRun(ctx context.Context, namespaceName NamespaceN
 	return c.Client.Do(req)
 }
 
+func (c *Client) CancelWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelWorkflowRunRequest(c.Server, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkflowRunEvents(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkflowRunEventsRequest(c.Server, namespaceName, runName, params)
 	if err != nil {
@@ -3942,6 +4183,30 @@ func (c *Client) GetWorkflowRunLogs(ctx context.Context, namespaceName Namespace
 	return c.Client.Do(req)
 }
 
+func (c *Client) ResumeWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeWorkflowRunRequest(c.Server, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryWorkflowRun(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryWorkflowRunRequest(c.Server, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkflowRunStatus(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkflowRunStatusRequest(c.Server, namespaceName, runName)
 	if err != nil {
@@ -4431,19 +4696,8 @@ func NewListActionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewEvaluatesRequest calls the generic Evaluates builder with application/json body
-func NewEvaluatesRequest(server string, body EvaluatesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEvaluatesRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewEvaluatesRequestWithBody generates requests for Evaluates with any type of body
-func NewEvaluatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListAuthzDecisionsRequest generates requests for ListAuthzDecisions
+func NewListAuthzDecisionsRequest(server string, params *ListAuthzDecisionsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4451,7 +4705,7 @@ func NewEvaluatesRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/authz/evaluates")
+	operationPath := fmt.Sprintf("/api/v1/authz/decisions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4461,41 +4715,44 @@ func NewEvaluatesRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Namespace != nil {
 
-// NewGetSubjectProfileRequest generates requests for GetSubjectProfile
-func NewGetSubjectProfileRequest(server string, params *GetSubjectProfileParams) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/api/v1/authz/profile")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Action != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
 
-		if params.Namespace != nil {
+		if params.EntitlementValue != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entitlementValue", runtime.ParamLocationQuery, *params.EntitlementValue); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4509,9 +4766,9 @@ func NewGetSubjectProfileRequest(server string, params *GetSubjectProfileParams)
 
 		}
 
-		if params.Project != nil {
+		if params.Decision != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, *params.Project); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "decision", runtime.ParamLocationQuery, *params.Decision); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4525,9 +4782,9 @@ func NewGetSubjectProfileRequest(server string, params *GetSubjectProfileParams)
 
 		}
 
-		if params.Component != nil {
+		if params.Since != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "component", runtime.ParamLocationQuery, *params.Component); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4541,9 +4798,9 @@ func NewGetSubjectProfileRequest(server string, params *GetSubjectProfileParams)
 
 		}
 
-		if params.Resource != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, *params.Resource); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4568,6 +4825,223 @@ func NewGetSubjectProfileRequest(server string, params *GetSubjectProfileParams)
 	return req, nil
 }
 
+// NewEvaluatesRequest calls the generic Evaluates builder with application/json body
+func NewEvaluatesRequest(server string, body EvaluatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEvaluatesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEvaluatesRequestWithBody generates requests for Evaluates with any type of body
+func NewEvaluatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/authz/evaluates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExplainAuthzRequest calls the generic ExplainAuthz builder with application/json body
+func NewExplainAuthzRequest(server string, body ExplainAuthzJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExplainAuthzRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExplainAuthzRequestWithBody generates requests for ExplainAuthz with any type of body
+func NewExplainAuthzRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/authz/explain")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSubjectProfileRequest generates requests for GetSubjectProfile
+func NewGetSubjectProfileRequest(server string, params *GetSubjectProfileParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/authz/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Namespace != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, *params.Namespace); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Project != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, *params.Project); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Component != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "component", runtime.ParamLocationQuery, *params.Component); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Resource != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, *params.Resource); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimulateAuthzRequest calls the generic SimulateAuthz builder with application/json body
+func NewSimulateAuthzRequest(server string, body SimulateAuthzJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimulateAuthzRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimulateAuthzRequestWithBody generates requests for SimulateAuthz with any type of body
+func NewSimulateAuthzRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/authz/simulate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewListClusterRoleBindingsRequest generates requests for ListClusterRoleBindings
 func NewListClusterRoleBindingsRequest(server string, params *ListClusterRoleBindingsParams) (*http.Request, error) {
 	var err error
@@ -7334,8 +7808,8 @@ func NewUpdateNamespaceRequestWithBody(server string, namespaceName NamespaceNam
 	return req, nil
 }
 
-// NewListNamespaceRoleBindingsRequest generates requests for ListNamespaceRoleBindings
-func NewListNamespaceRoleBindingsRequest(server string, namespaceName NamespaceNameParam, params *ListNamespaceRoleBindingsParams) (*http.Request, error) {
+// NewListAuthzAccessRequestsRequest generates requests for ListAuthzAccessRequests
+func NewListAuthzAccessRequestsRequest(server string, namespaceName NamespaceNameParam, params *ListAuthzAccessRequestsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7350,7 +7824,7 @@ func NewListNamespaceRoleBindingsRequest(server string, namespaceName NamespaceN
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzaccessrequests", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7422,19 +7896,19 @@ func NewListNamespaceRoleBindingsRequest(server string, namespaceName NamespaceN
 	return req, nil
 }
 
-// NewCreateNamespaceRoleBindingRequest calls the generic CreateNamespaceRoleBinding builder with application/json body
-func NewCreateNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, body CreateNamespaceRoleBindingJSONRequestBody) (*http.Request, error) {
+// NewCreateAuthzAccessRequestRequest calls the generic CreateAuthzAccessRequest builder with application/json body
+func NewCreateAuthzAccessRequestRequest(server string, namespaceName NamespaceNameParam, body CreateAuthzAccessRequestJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateNamespaceRoleBindingRequestWithBody(server, namespaceName, "application/json", bodyReader)
+	return NewCreateAuthzAccessRequestRequestWithBody(server, namespaceName, "application/json", bodyReader)
 }
 
-// NewCreateNamespaceRoleBindingRequestWithBody generates requests for CreateNamespaceRoleBinding with any type of body
-func NewCreateNamespaceRoleBindingRequestWithBody(server string, namespaceName NamespaceNameParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAuthzAccessRequestRequestWithBody generates requests for CreateAuthzAccessRequest with any type of body
+func NewCreateAuthzAccessRequestRequestWithBody(server string, namespaceName NamespaceNameParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7449,7 +7923,7 @@ func NewCreateNamespaceRoleBindingRequestWithBody(server string, namespaceName N
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzaccessrequests", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7469,8 +7943,8 @@ func NewCreateNamespaceRoleBindingRequestWithBody(server string, namespaceName N
 	return req, nil
 }
 
-// NewDeleteNamespaceRoleBindingRequest generates requests for DeleteNamespaceRoleBinding
-func NewDeleteNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, name string) (*http.Request, error) {
+// NewGetAuthzAccessRequestRequest generates requests for GetAuthzAccessRequest
+func NewGetAuthzAccessRequestRequest(server string, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7492,48 +7966,7 @@ func NewDeleteNamespaceRoleBindingRequest(server string, namespaceName Namespace
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetNamespaceRoleBindingRequest generates requests for GetNamespaceRoleBinding
-func NewGetNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzaccessrequests/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7551,19 +7984,344 @@ func NewGetNamespaceRoleBindingRequest(server string, namespaceName NamespaceNam
 	return req, nil
 }
 
-// NewUpdateNamespaceRoleBindingRequest calls the generic UpdateNamespaceRoleBinding builder with application/json body
-func NewUpdateNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, name string, body UpdateNamespaceRoleBindingJSONRequestBody) (*http.Request, error) {
+// NewApproveAuthzAccessRequestRequest calls the generic ApproveAuthzAccessRequest builder with application/json body
+func NewApproveAuthzAccessRequestRequest(server string, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body ApproveAuthzAccessRequestJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateNamespaceRoleBindingRequestWithBody(server, namespaceName, name, "application/json", bodyReader)
+	return NewApproveAuthzAccessRequestRequestWithBody(server, namespaceName, name, "application/json", bodyReader)
 }
 
-// NewUpdateNamespaceRoleBindingRequestWithBody generates requests for UpdateNamespaceRoleBinding with any type of body
-func NewUpdateNamespaceRoleBindingRequestWithBody(server string, namespaceName NamespaceNameParam, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApproveAuthzAccessRequestRequestWithBody generates requests for ApproveAuthzAccessRequest with any type of body
+func NewApproveAuthzAccessRequestRequestWithBody(server string, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzaccessrequests/%s/approve", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDenyAuthzAccessRequestRequest calls the generic DenyAuthzAccessRequest builder with application/json body
+func NewDenyAuthzAccessRequestRequest(server string, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body DenyAuthzAccessRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDenyAuthzAccessRequestRequestWithBody(server, namespaceName, name, "application/json", bodyReader)
+}
+
+// NewDenyAuthzAccessRequestRequestWithBody generates requests for DenyAuthzAccessRequest with any type of body
+func NewDenyAuthzAccessRequestRequestWithBody(server string, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzaccessrequests/%s/deny", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListNamespaceRoleBindingsRequest generates requests for ListNamespaceRoleBindings
+func NewListNamespaceRoleBindingsRequest(server string, namespaceName NamespaceNameParam, params *ListNamespaceRoleBindingsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateNamespaceRoleBindingRequest calls the generic CreateNamespaceRoleBinding builder with application/json body
+func NewCreateNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, body CreateNamespaceRoleBindingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateNamespaceRoleBindingRequestWithBody(server, namespaceName, "application/json", bodyReader)
+}
+
+// NewCreateNamespaceRoleBindingRequestWithBody generates requests for CreateNamespaceRoleBinding with any type of body
+func NewCreateNamespaceRoleBindingRequestWithBody(server string, namespaceName NamespaceNameParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNamespaceRoleBindingRequest generates requests for DeleteNamespaceRoleBinding
+func NewDeleteNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNamespaceRoleBindingRequest generates requests for GetNamespaceRoleBinding
+func NewGetNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/authzrolebindings/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNamespaceRoleBindingRequest calls the generic UpdateNamespaceRoleBinding builder with application/json body
+func NewUpdateNamespaceRoleBindingRequest(server string, namespaceName NamespaceNameParam, name string, body UpdateNamespaceRoleBindingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNamespaceRoleBindingRequestWithBody(server, namespaceName, name, "application/json", bodyReader)
+}
+
+// NewUpdateNamespaceRoleBindingRequestWithBody generates requests for UpdateNamespaceRoleBinding with any type of body
+func NewUpdateNamespaceRoleBindingRequestWithBody(server string, namespaceName NamespaceNameParam, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -13752,6 +14510,135 @@ func NewUpdateWorkflowPlaneRequestWithBody(server string, namespaceName Namespac
 	return req, nil
 }
 
+// NewListWorkflowRunGroupsRequest generates requests for ListWorkflowRunGroups
+func NewListWorkflowRunGroupsRequest(server string, namespaceName NamespaceNameParam, params *ListWorkflowRunGroupsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowrungroups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkflowRunGroupRequest generates requests for GetWorkflowRunGroup
+func NewGetWorkflowRunGroupRequest(server string, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "groupName", runtime.ParamLocationPath, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowrungroups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewListWorkflowRunsRequest generates requests for ListWorkflowRuns
 func NewListWorkflowRunsRequest(server string, namespaceName NamespaceNameParam, params *ListWorkflowRunsParams) (*http.Request, error) {
 	var err error
@@ -14039,6 +14926,47 @@ func NewUpdateWorkflowRunRequestWithBody(server string, namespaceName NamespaceN
 	return req, nil
 }
 
+// NewCancelWorkflowRunRequest generates requests for CancelWorkflowRun
+func NewCancelWorkflowRunRequest(server string, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "runName", runtime.ParamLocationPath, runName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowruns/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetWorkflowRunEventsRequest generates requests for GetWorkflowRunEvents
 func NewGetWorkflowRunEventsRequest(server string, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunEventsParams) (*http.Request, error) {
 	var err error
@@ -14181,8 +15109,8 @@ func NewGetWorkflowRunLogsRequest(server string, namespaceName NamespaceNamePara
 	return req, nil
 }
 
-// NewGetWorkflowRunStatusRequest generates requests for GetWorkflowRunStatus
-func NewGetWorkflowRunStatusRequest(server string, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) (*http.Request, error) {
+// NewResumeWorkflowRunRequest generates requests for ResumeWorkflowRun
+func NewResumeWorkflowRunRequest(server string, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -14204,7 +15132,7 @@ func NewGetWorkflowRunStatusRequest(server string, namespaceName NamespaceNamePa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowruns/%s/status", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowruns/%s/resume", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -14214,7 +15142,7 @@ func NewGetWorkflowRunStatusRequest(server string, namespaceName NamespaceNamePa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -14222,8 +15150,8 @@ func NewGetWorkflowRunStatusRequest(server string, namespaceName NamespaceNamePa
 	return req, nil
 }
 
-// NewListWorkflowsRequest generates requests for ListWorkflows
-func NewListWorkflowsRequest(server string, namespaceName NamespaceNameParam, params *ListWorkflowsParams) (*http.Request, error) {
+// NewRetryWorkflowRunRequest generates requests for RetryWorkflowRun
+func NewRetryWorkflowRunRequest(server string, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -14233,12 +15161,19 @@ func NewListWorkflowsRequest(server string, namespaceName NamespaceNameParam, pa
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "runName", runtime.ParamLocationPath, runName)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflows", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowruns/%s/retry", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -14248,81 +15183,156 @@ func NewListWorkflowsRequest(server string, namespaceName NamespaceNameParam, pa
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.LabelSelector != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Limit != nil {
+// NewGetWorkflowRunStatusRequest generates requests for GetWorkflowRunStatus
+func NewGetWorkflowRunStatusRequest(server string, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Cursor != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "runName", runtime.ParamLocationPath, runName)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflowruns/%s/status", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewCreateWorkflowRequest calls the generic CreateWorkflow builder with application/json body
-func NewCreateWorkflowRequest(server string, namespaceName NamespaceNameParam, body CreateWorkflowJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateWorkflowRequestWithBody(server, namespaceName, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewCreateWorkflowRequestWithBody generates requests for CreateWorkflow with any type of body
-func NewCreateWorkflowRequestWithBody(server string, namespaceName NamespaceNameParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewListWorkflowsRequest generates requests for ListWorkflows
+func NewListWorkflowsRequest(server string, namespaceName NamespaceNameParam, params *ListWorkflowsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespaceName", runtime.ParamLocationPath, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/namespaces/%s/workflows", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWorkflowRequest calls the generic CreateWorkflow builder with application/json body
+func NewCreateWorkflowRequest(server string, namespaceName NamespaceNameParam, body CreateWorkflowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWorkflowRequestWithBody(server, namespaceName, "application/json", bodyReader)
+}
+
+// NewCreateWorkflowRequestWithBody generates requests for CreateWorkflow with any type of body
+func NewCreateWorkflowRequestWithBody(server string, namespaceName NamespaceNameParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -15487,14 +16497,27 @@ type ClientWithResponsesInterface interface {
 	// ListActionsWithResponse request
 	ListActionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListActionsResp, error)
 
+	// ListAuthzDecisionsWithResponse request
+	ListAuthzDecisionsWithResponse(ctx context.Context, params *ListAuthzDecisionsParams, reqEditors ...RequestEditorFn) (*ListAuthzDecisionsResp, error)
+
 	// EvaluatesWithBodyWithResponse request with any body
 	EvaluatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluatesResp, error)
 
 	EvaluatesWithResponse(ctx context.Context, body EvaluatesJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluatesResp, error)
 
+	// ExplainAuthzWithBodyWithResponse request with any body
+	ExplainAuthzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExplainAuthzResp, error)
+
+	ExplainAuthzWithResponse(ctx context.Context, body ExplainAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*ExplainAuthzResp, error)
+
 	// GetSubjectProfileWithResponse request
 	GetSubjectProfileWithResponse(ctx context.Context, params *GetSubjectProfileParams, reqEditors ...RequestEditorFn) (*GetSubjectProfileResp, error)
 
+	// SimulateAuthzWithBodyWithResponse request with any body
+	SimulateAuthzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimulateAuthzResp, error)
+
+	SimulateAuthzWithResponse(ctx context.Context, body SimulateAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*SimulateAuthzResp, error)
+
 	// ListClusterRoleBindingsWithResponse request
 	ListClusterRoleBindingsWithResponse(ctx context.Context, params *ListClusterRoleBindingsParams, reqEditors ...RequestEditorFn) (*ListClusterRoleBindingsResp, error)
 
@@ -15719,6 +16742,27 @@ type ClientWithResponsesInterface interface {
 
 	UpdateNamespaceWithResponse(ctx context.Context, namespaceName NamespaceNameParam, body UpdateNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNamespaceResp, error)
 
+	// ListAuthzAccessRequestsWithResponse request
+	ListAuthzAccessRequestsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListAuthzAccessRequestsParams, reqEditors ...RequestEditorFn) (*ListAuthzAccessRequestsResp, error)
+
+	// CreateAuthzAccessRequestWithBodyWithResponse request with any body
+	CreateAuthzAccessRequestWithBodyWithResponse(ctx context.Context, namespaceName NamespaceNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAuthzAccessRequestResp, error)
+
+	CreateAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, body CreateAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAuthzAccessRequestResp, error)
+
+	// GetAuthzAccessRequestWithResponse request
+	GetAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, reqEditors ...RequestEditorFn) (*GetAuthzAccessRequestResp, error)
+
+	// ApproveAuthzAccessRequestWithBodyWithResponse request with any body
+	ApproveAuthzAccessRequestWithBodyWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveAuthzAccessRequestResp, error)
+
+	ApproveAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body ApproveAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveAuthzAccessRequestResp, error)
+
+	// DenyAuthzAccessRequestWithBodyWithResponse request with any body
+	DenyAuthzAccessRequestWithBodyWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DenyAuthzAccessRequestResp, error)
+
+	DenyAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body DenyAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*DenyAuthzAccessRequestResp, error)
+
 	// ListNamespaceRoleBindingsWithResponse request
 	ListNamespaceRoleBindingsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListNamespaceRoleBindingsParams, reqEditors ...RequestEditorFn) (*ListNamespaceRoleBindingsResp, error)
 
@@ -16151,6 +17195,12 @@ type ClientWithResponsesInterface interface {
 
 	UpdateWorkflowPlaneWithResponse(ctx context.Context, namespaceName NamespaceNameParam, workflowPlaneName WorkflowPlaneNameParam, body UpdateWorkflowPlaneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkflowPlaneResp, error)
 
+	// ListWorkflowRunGroupsWithResponse request
+	ListWorkflowRunGroupsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunGroupsParams, reqEditors ...RequestEditorFn) (*ListWorkflowRunGroupsResp, error)
+
+	// GetWorkflowRunGroupWithResponse request
+	GetWorkflowRunGroupWithResponse(ctx context.Context, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam, reqEditors ...RequestEditorFn) (*GetWorkflowRunGroupResp, error)
+
 	// ListWorkflowRunsWithResponse request
 	ListWorkflowRunsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunsParams, reqEditors ...RequestEditorFn) (*ListWorkflowRunsResp, error)
 
@@ -16170,12 +17220,21 @@ type ClientWithResponsesInterface interface {
 
 	UpdateWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, body UpdateWorkflowRunJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkflowRunResp, error)
 
+	// CancelWorkflowRunWithResponse request
+	CancelWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*CancelWorkflowRunResp, error)
+
 	// GetWorkflowRunEventsWithResponse request
 	GetWorkflowRunEventsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunEventsParams, reqEditors ...RequestEditorFn) (*GetWorkflowRunEventsResp, error)
 
 	// GetWorkflowRunLogsWithResponse request
 	GetWorkflowRunLogsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunLogsParams, reqEditors ...RequestEditorFn) (*GetWorkflowRunLogsResp, error)
 
+	// ResumeWorkflowRunWithResponse request
+	ResumeWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*ResumeWorkflowRunResp, error)
+
+	// RetryWorkflowRunWithResponse request
+	RetryWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*RetryWorkflowRunResp, error)
+
 	// GetWorkflowRunStatusWithResponse request
 	GetWorkflowRunStatusWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*GetWorkflowRunStatusResp, error)
 
@@ -16342,6 +17401,32 @@ func (r ListActionsResp) StatusCode() int {
 	return 0
 }
 
+type ListAuthzDecisionsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzDecisionRecordList
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuthzDecisionsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuthzDecisionsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type EvaluatesResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -16368,6 +17453,32 @@ func (r EvaluatesResp) StatusCode() int {
 	return 0
 }
 
+type ExplainAuthzResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzExplanation
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ExplainAuthzResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExplainAuthzResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetSubjectProfileResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -16394,6 +17505,32 @@ func (r GetSubjectProfileResp) StatusCode() int {
 	return 0
 }
 
+type SimulateAuthzResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzExplanation
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r SimulateAuthzResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimulateAuthzResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListClusterRoleBindingsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -17993,6 +19130,139 @@ func (r UpdateNamespaceResp) StatusCode() int {
 	return 0
 }
 
+type ListAuthzAccessRequestsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzAccessRequestList
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuthzAccessRequestsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuthzAccessRequestsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAuthzAccessRequestResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AuthzAccessRequest
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAuthzAccessRequestResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAuthzAccessRequestResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthzAccessRequestResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzAccessRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthzAccessRequestResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthzAccessRequestResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveAuthzAccessRequestResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzAccessRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveAuthzAccessRequestResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveAuthzAccessRequestResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DenyAuthzAccessRequestResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzAccessRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r DenyAuthzAccessRequestResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DenyAuthzAccessRequestResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListNamespaceRoleBindingsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -21083,6 +22353,58 @@ func (r UpdateWorkflowPlaneResp) StatusCode() int {
 	return 0
 }
 
+type ListWorkflowRunGroupsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRunGroupList
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkflowRunGroupsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkflowRunGroupsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkflowRunGroupResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRunGroup
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkflowRunGroupResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkflowRunGroupResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListWorkflowRunsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -21216,6 +22538,33 @@ func (r UpdateWorkflowRunResp) StatusCode() int {
 	return 0
 }
 
+type CancelWorkflowRunResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelWorkflowRunResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelWorkflowRunResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetWorkflowRunEventsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -21268,6 +22617,60 @@ func (r GetWorkflowRunLogsResp) StatusCode() int {
 	return 0
 }
 
+type ResumeWorkflowRunResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeWorkflowRunResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeWorkflowRunResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryWorkflowRunResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WorkflowRun
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryWorkflowRunResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryWorkflowRunResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetWorkflowRunStatusResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -21971,6 +23374,15 @@ func (c *ClientWithResponses) ListActionsWithResponse(ctx context.Context, reqEd
 	return ParseListActionsResp(rsp)
 }
 
+// ListAuthzDecisionsWithResponse request returning *ListAuthzDecisionsResp
+func (c *ClientWithResponses) ListAuthzDecisionsWithResponse(ctx context.Context, params *ListAuthzDecisionsParams, reqEditors ...RequestEditorFn) (*ListAuthzDecisionsResp, error) {
+	rsp, err := c.ListAuthzDecisions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuthzDecisionsResp(rsp)
+}
+
 // EvaluatesWithBodyWithResponse request with arbitrary body returning *EvaluatesResp
 func (c *ClientWithResponses) EvaluatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluatesResp, error) {
 	rsp, err := c.EvaluatesWithBody(ctx, contentType, body, reqEditors...)
@@ -21988,6 +23400,23 @@ func (c *ClientWithResponses) EvaluatesWithResponse(ctx context.Context, body Ev
 	return ParseEvaluatesResp(rsp)
 }
 
+// ExplainAuthzWithBodyWithResponse request with arbitrary body returning *ExplainAuthzResp
+func (c *ClientWithResponses) ExplainAuthzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExplainAuthzResp, error) {
+	rsp, err := c.ExplainAuthzWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainAuthzResp(rsp)
+}
+
+func (c *ClientWithResponses) ExplainAuthzWithResponse(ctx context.Context, body ExplainAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*ExplainAuthzResp, error) {
+	rsp, err := c.ExplainAuthz(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainAuthzResp(rsp)
+}
+
 // GetSubjectProfileWithResponse request returning *GetSubjectProfileResp
 func (c *ClientWithResponses) GetSubjectProfileWithResponse(ctx context.Context, params *GetSubjectProfileParams, reqEditors ...RequestEditorFn) (*GetSubjectProfileResp, error) {
 	rsp, err := c.GetSubjectProfile(ctx, params, reqEditors...)
@@ -21997,6 +23426,23 @@ func (c *ClientWithResponses) GetSubjectProfileWithResponse(ctx context.Context,
 	return ParseGetSubjectProfileResp(rsp)
 }
 
+// SimulateAuthzWithBodyWithResponse request with arbitrary body returning *SimulateAuthzResp
+func (c *ClientWithResponses) SimulateAuthzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimulateAuthzResp, error) {
+	rsp, err := c.SimulateAuthzWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimulateAuthzResp(rsp)
+}
+
+func (c *ClientWithResponses) SimulateAuthzWithResponse(ctx context.Context, body SimulateAuthzJSONRequestBody, reqEditors ...RequestEditorFn) (*SimulateAuthzResp, error) {
+	rsp, err := c.SimulateAuthz(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimulateAuthzResp(rsp)
+}
+
 // ListClusterRoleBindingsWithResponse request returning *ListClusterRoleBindingsResp
 func (c *ClientWithResponses) ListClusterRoleBindingsWithResponse(ctx context.Context, params *ListClusterRoleBindingsParams, reqEditors ...RequestEditorFn) (*ListClusterRoleBindingsResp, error) {
 	rsp, err := c.ListClusterRoleBindings(ctx, params, reqEditors...)
@@ -22713,6 +24159,75 @@ func (c *ClientWithResponses) UpdateNamespaceWithResponse(ctx context.Context, n
 	return ParseUpdateNamespaceResp(rsp)
 }
 
+// ListAuthzAccessRequestsWithResponse request returning *ListAuthzAccessRequestsResp
+func (c *ClientWithResponses) ListAuthzAccessRequestsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListAuthzAccessRequestsParams, reqEditors ...RequestEditorFn) (*ListAuthzAccessRequestsResp, error) {
+	rsp, err := c.ListAuthzAccessRequests(ctx, namespaceName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuthzAccessRequestsResp(rsp)
+}
+
+// CreateAuthzAccessRequestWithBodyWithResponse request with arbitrary body returning *CreateAuthzAccessRequestResp
+func (c *ClientWithResponses) CreateAuthzAccessRequestWithBodyWithResponse(ctx context.Context, namespaceName NamespaceNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAuthzAccessRequestResp, error) {
+	rsp, err := c.CreateAuthzAccessRequestWithBody(ctx, namespaceName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAuthzAccessRequestResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, body CreateAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAuthzAccessRequestResp, error) {
+	rsp, err := c.CreateAuthzAccessRequest(ctx, namespaceName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAuthzAccessRequestResp(rsp)
+}
+
+// GetAuthzAccessRequestWithResponse request returning *GetAuthzAccessRequestResp
+func (c *ClientWithResponses) GetAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, reqEditors ...RequestEditorFn) (*GetAuthzAccessRequestResp, error) {
+	rsp, err := c.GetAuthzAccessRequest(ctx, namespaceName, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthzAccessRequestResp(rsp)
+}
+
+// ApproveAuthzAccessRequestWithBodyWithResponse request with arbitrary body returning *ApproveAuthzAccessRequestResp
+func (c *ClientWithResponses) ApproveAuthzAccessRequestWithBodyWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveAuthzAccessRequestResp, error) {
+	rsp, err := c.ApproveAuthzAccessRequestWithBody(ctx, namespaceName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveAuthzAccessRequestResp(rsp)
+}
+
+func (c *ClientWithResponses) ApproveAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body ApproveAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveAuthzAccessRequestResp, error) {
+	rsp, err := c.ApproveAuthzAccessRequest(ctx, namespaceName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveAuthzAccessRequestResp(rsp)
+}
+
+// DenyAuthzAccessRequestWithBodyWithResponse request with arbitrary body returning *DenyAuthzAccessRequestResp
+func (c *ClientWithResponses) DenyAuthzAccessRequestWithBodyWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DenyAuthzAccessRequestResp, error) {
+	rsp, err := c.DenyAuthzAccessRequestWithBody(ctx, namespaceName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDenyAuthzAccessRequestResp(rsp)
+}
+
+func (c *ClientWithResponses) DenyAuthzAccessRequestWithResponse(ctx context.Context, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam, body DenyAuthzAccessRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*DenyAuthzAccessRequestResp, error) {
+	rsp, err := c.DenyAuthzAccessRequest(ctx, namespaceName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDenyAuthzAccessRequestResp(rsp)
+}
+
 // ListNamespaceRoleBindingsWithResponse request returning *ListNamespaceRoleBindingsResp
 func (c *ClientWithResponses) ListNamespaceRoleBindingsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListNamespaceRoleBindingsParams, reqEditors ...RequestEditorFn) (*ListNamespaceRoleBindingsResp, error) {
 	rsp, err := c.ListNamespaceRoleBindings(ctx, namespaceName, params, reqEditors...)
@@ -24093,6 +25608,24 @@ func (c *ClientWithResponses) UpdateWorkflowPlaneWithResponse(ctx context.Contex
 	return ParseUpdateWorkflowPlaneResp(rsp)
 }
 
+// ListWorkflowRunGroupsWithResponse request returning *ListWorkflowRunGroupsResp
+func (c *ClientWithResponses) ListWorkflowRunGroupsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunGroupsParams, reqEditors ...RequestEditorFn) (*ListWorkflowRunGroupsResp, error) {
+	rsp, err := c.ListWorkflowRunGroups(ctx, namespaceName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkflowRunGroupsResp(rsp)
+}
+
+// GetWorkflowRunGroupWithResponse request returning *GetWorkflowRunGroupResp
+func (c *ClientWithResponses) GetWorkflowRunGroupWithResponse(ctx context.Context, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam, reqEditors ...RequestEditorFn) (*GetWorkflowRunGroupResp, error) {
+	rsp, err := c.GetWorkflowRunGroup(ctx, namespaceName, groupName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkflowRunGroupResp(rsp)
+}
+
 // ListWorkflowRunsWithResponse request returning *ListWorkflowRunsResp
 func (c *ClientWithResponses) ListWorkflowRunsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, params *ListWorkflowRunsParams, reqEditors ...RequestEditorFn) (*ListWorkflowRunsResp, error) {
 	rsp, err := c.ListWorkflowRuns(ctx, namespaceName, params, reqEditors...)
@@ -24154,6 +25687,15 @@ func (c *ClientWithResponses) UpdateWorkflowRunWithResponse(ctx context.Context,
 	return ParseUpdateWorkflowRunResp(rsp)
 }
 
+// CancelWorkflowRunWithResponse request returning *CancelWorkflowRunResp
+func (c *ClientWithResponses) CancelWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*CancelWorkflowRunResp, error) {
+	rsp, err := c.CancelWorkflowRun(ctx, namespaceName, runName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelWorkflowRunResp(rsp)
+}
+
 // GetWorkflowRunEventsWithResponse request returning *GetWorkflowRunEventsResp
 func (c *ClientWithResponses) GetWorkflowRunEventsWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params *GetWorkflowRunEventsParams, reqEditors ...RequestEditorFn) (*GetWorkflowRunEventsResp, error) {
 	rsp, err := c.GetWorkflowRunEvents(ctx, namespaceName, runName, params, reqEditors...)
@@ -24172,6 +25714,24 @@ func (c *ClientWithResponses) GetWorkflowRunLogsWithResponse(ctx context.Context
 	return ParseGetWorkflowRunLogsResp(rsp)
 }
 
+// ResumeWorkflowRunWithResponse request returning *ResumeWorkflowRunResp
+func (c *ClientWithResponses) ResumeWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*ResumeWorkflowRunResp, error) {
+	rsp, err := c.ResumeWorkflowRun(ctx, namespaceName, runName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeWorkflowRunResp(rsp)
+}
+
+// RetryWorkflowRunWithResponse request returning *RetryWorkflowRunResp
+func (c *ClientWithResponses) RetryWorkflowRunWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*RetryWorkflowRunResp, error) {
+	rsp, err := c.RetryWorkflowRun(ctx, namespaceName, runName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryWorkflowRunResp(rsp)
+}
+
 // GetWorkflowRunStatusWithResponse request returning *GetWorkflowRunStatusResp
 func (c *ClientWithResponses) GetWorkflowRunStatusWithResponse(ctx context.Context, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, reqEditors ...RequestEditorFn) (*GetWorkflowRunStatusResp, error) {
 	rsp, err := c.GetWorkflowRunStatus(ctx, namespaceName, runName, reqEditors...)
@@ -24583,6 +26143,60 @@ func ParseListActionsResp(rsp *http.Response) (*ListActionsResp, error) {
 	return response, nil
 }
 
+// ParseListAuthzDecisionsResp parses an HTTP response from a ListAuthzDecisionsWithResponse call
+func ParseListAuthzDecisionsResp(rsp *http.Response) (*ListAuthzDecisionsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuthzDecisionsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzDecisionRecordList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseEvaluatesResp parses an HTTP response from a EvaluatesWithResponse call
 func ParseEvaluatesResp(rsp *http.Response) (*EvaluatesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -24637,22 +26251,130 @@ func ParseEvaluatesResp(rsp *http.Response) (*EvaluatesResp, error) {
 	return response, nil
 }
 
-// ParseGetSubjectProfileResp parses an HTTP response from a GetSubjectProfileWithResponse call
-func ParseGetSubjectProfileResp(rsp *http.Response) (*GetSubjectProfileResp, error) {
+// ParseExplainAuthzResp parses an HTTP response from a ExplainAuthzWithResponse call
+func ParseExplainAuthzResp(rsp *http.Response) (*ExplainAuthzResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSubjectProfileResp{
+	response := &ExplainAuthzResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserCapabilitiesResponse
+		var dest AuthzExplanation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubjectProfileResp parses an HTTP response from a GetSubjectProfileWithResponse call
+func ParseGetSubjectProfileResp(rsp *http.Response) (*GetSubjectProfileResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubjectProfileResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserCapabilitiesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimulateAuthzResp parses an HTTP response from a SimulateAuthzWithResponse call
+func ParseSimulateAuthzResp(rsp *http.Response) (*SimulateAuthzResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimulateAuthzResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzExplanation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25341,22 +27063,374 @@ func ParseListClusterComponentTypesResp(rsp *http.Response) (*ListClusterCompone
 	return response, nil
 }
 
-// ParseCreateClusterComponentTypeResp parses an HTTP response from a CreateClusterComponentTypeWithResponse call
-func ParseCreateClusterComponentTypeResp(rsp *http.Response) (*CreateClusterComponentTypeResp, error) {
+// ParseCreateClusterComponentTypeResp parses an HTTP response from a CreateClusterComponentTypeWithResponse call
+func ParseCreateClusterComponentTypeResp(rsp *http.Response) (*CreateClusterComponentTypeResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClusterComponentTypeResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClusterComponentType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClusterComponentTypeResp parses an HTTP response from a DeleteClusterComponentTypeWithResponse call
+func ParseDeleteClusterComponentTypeResp(rsp *http.Response) (*DeleteClusterComponentTypeResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClusterComponentTypeResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterComponentTypeResp parses an HTTP response from a GetClusterComponentTypeWithResponse call
+func ParseGetClusterComponentTypeResp(rsp *http.Response) (*GetClusterComponentTypeResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterComponentTypeResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterComponentType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClusterComponentTypeResp parses an HTTP response from a UpdateClusterComponentTypeWithResponse call
+func ParseUpdateClusterComponentTypeResp(rsp *http.Response) (*UpdateClusterComponentTypeResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClusterComponentTypeResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterComponentType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterComponentTypeSchemaResp parses an HTTP response from a GetClusterComponentTypeSchemaWithResponse call
+func ParseGetClusterComponentTypeSchemaResp(rsp *http.Response) (*GetClusterComponentTypeSchemaResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterComponentTypeSchemaResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterDataPlanesResp parses an HTTP response from a ListClusterDataPlanesWithResponse call
+func ParseListClusterDataPlanesResp(rsp *http.Response) (*ListClusterDataPlanesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterDataPlanesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterDataPlaneList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClusterDataPlaneResp parses an HTTP response from a CreateClusterDataPlaneWithResponse call
+func ParseCreateClusterDataPlaneResp(rsp *http.Response) (*CreateClusterDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterComponentTypeResp{
+	response := &CreateClusterDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterComponentType
+		var dest ClusterDataPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25409,15 +27483,15 @@ func ParseCreateClusterComponentTypeResp(rsp *http.Response) (*CreateClusterComp
 	return response, nil
 }
 
-// ParseDeleteClusterComponentTypeResp parses an HTTP response from a DeleteClusterComponentTypeWithResponse call
-func ParseDeleteClusterComponentTypeResp(rsp *http.Response) (*DeleteClusterComponentTypeResp, error) {
+// ParseDeleteClusterDataPlaneResp parses an HTTP response from a DeleteClusterDataPlaneWithResponse call
+func ParseDeleteClusterDataPlaneResp(rsp *http.Response) (*DeleteClusterDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterComponentTypeResp{
+	response := &DeleteClusterDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -25456,22 +27530,22 @@ func ParseDeleteClusterComponentTypeResp(rsp *http.Response) (*DeleteClusterComp
 	return response, nil
 }
 
-// ParseGetClusterComponentTypeResp parses an HTTP response from a GetClusterComponentTypeWithResponse call
-func ParseGetClusterComponentTypeResp(rsp *http.Response) (*GetClusterComponentTypeResp, error) {
+// ParseGetClusterDataPlaneResp parses an HTTP response from a GetClusterDataPlaneWithResponse call
+func ParseGetClusterDataPlaneResp(rsp *http.Response) (*GetClusterDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterComponentTypeResp{
+	response := &GetClusterDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterComponentType
+		var dest ClusterDataPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25510,22 +27584,22 @@ func ParseGetClusterComponentTypeResp(rsp *http.Response) (*GetClusterComponentT
 	return response, nil
 }
 
-// ParseUpdateClusterComponentTypeResp parses an HTTP response from a UpdateClusterComponentTypeWithResponse call
-func ParseUpdateClusterComponentTypeResp(rsp *http.Response) (*UpdateClusterComponentTypeResp, error) {
+// ParseUpdateClusterDataPlaneResp parses an HTTP response from a UpdateClusterDataPlaneWithResponse call
+func ParseUpdateClusterDataPlaneResp(rsp *http.Response) (*UpdateClusterDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterComponentTypeResp{
+	response := &UpdateClusterDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterComponentType
+		var dest ClusterDataPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25585,76 +27659,22 @@ func ParseUpdateClusterComponentTypeResp(rsp *http.Response) (*UpdateClusterComp
 	return response, nil
 }
 
-// ParseGetClusterComponentTypeSchemaResp parses an HTTP response from a GetClusterComponentTypeSchemaWithResponse call
-func ParseGetClusterComponentTypeSchemaResp(rsp *http.Response) (*GetClusterComponentTypeSchemaResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetClusterComponentTypeSchemaResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseListClusterDataPlanesResp parses an HTTP response from a ListClusterDataPlanesWithResponse call
-func ParseListClusterDataPlanesResp(rsp *http.Response) (*ListClusterDataPlanesResp, error) {
+// ParseListClusterObservabilityPlanesResp parses an HTTP response from a ListClusterObservabilityPlanesWithResponse call
+func ParseListClusterObservabilityPlanesResp(rsp *http.Response) (*ListClusterObservabilityPlanesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterDataPlanesResp{
+	response := &ListClusterObservabilityPlanesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDataPlaneList
+		var dest ClusterObservabilityPlaneList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25693,22 +27713,22 @@ func ParseListClusterDataPlanesResp(rsp *http.Response) (*ListClusterDataPlanesR
 	return response, nil
 }
 
-// ParseCreateClusterDataPlaneResp parses an HTTP response from a CreateClusterDataPlaneWithResponse call
-func ParseCreateClusterDataPlaneResp(rsp *http.Response) (*CreateClusterDataPlaneResp, error) {
+// ParseCreateClusterObservabilityPlaneResp parses an HTTP response from a CreateClusterObservabilityPlaneWithResponse call
+func ParseCreateClusterObservabilityPlaneResp(rsp *http.Response) (*CreateClusterObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterDataPlaneResp{
+	response := &CreateClusterObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterDataPlane
+		var dest ClusterObservabilityPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25761,15 +27781,15 @@ func ParseCreateClusterDataPlaneResp(rsp *http.Response) (*CreateClusterDataPlan
 	return response, nil
 }
 
-// ParseDeleteClusterDataPlaneResp parses an HTTP response from a DeleteClusterDataPlaneWithResponse call
-func ParseDeleteClusterDataPlaneResp(rsp *http.Response) (*DeleteClusterDataPlaneResp, error) {
+// ParseDeleteClusterObservabilityPlaneResp parses an HTTP response from a DeleteClusterObservabilityPlaneWithResponse call
+func ParseDeleteClusterObservabilityPlaneResp(rsp *http.Response) (*DeleteClusterObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterDataPlaneResp{
+	response := &DeleteClusterObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -25808,22 +27828,22 @@ func ParseDeleteClusterDataPlaneResp(rsp *http.Response) (*DeleteClusterDataPlan
 	return response, nil
 }
 
-// ParseGetClusterDataPlaneResp parses an HTTP response from a GetClusterDataPlaneWithResponse call
-func ParseGetClusterDataPlaneResp(rsp *http.Response) (*GetClusterDataPlaneResp, error) {
+// ParseGetClusterObservabilityPlaneResp parses an HTTP response from a GetClusterObservabilityPlaneWithResponse call
+func ParseGetClusterObservabilityPlaneResp(rsp *http.Response) (*GetClusterObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterDataPlaneResp{
+	response := &GetClusterObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDataPlane
+		var dest ClusterObservabilityPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25862,22 +27882,22 @@ func ParseGetClusterDataPlaneResp(rsp *http.Response) (*GetClusterDataPlaneResp,
 	return response, nil
 }
 
-// ParseUpdateClusterDataPlaneResp parses an HTTP response from a UpdateClusterDataPlaneWithResponse call
-func ParseUpdateClusterDataPlaneResp(rsp *http.Response) (*UpdateClusterDataPlaneResp, error) {
+// ParseUpdateClusterObservabilityPlaneResp parses an HTTP response from a UpdateClusterObservabilityPlaneWithResponse call
+func ParseUpdateClusterObservabilityPlaneResp(rsp *http.Response) (*UpdateClusterObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterDataPlaneResp{
+	response := &UpdateClusterObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterDataPlane
+		var dest ClusterObservabilityPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25937,22 +27957,22 @@ func ParseUpdateClusterDataPlaneResp(rsp *http.Response) (*UpdateClusterDataPlan
 	return response, nil
 }
 
-// ParseListClusterObservabilityPlanesResp parses an HTTP response from a ListClusterObservabilityPlanesWithResponse call
-func ParseListClusterObservabilityPlanesResp(rsp *http.Response) (*ListClusterObservabilityPlanesResp, error) {
+// ParseListClusterProjectTypesResp parses an HTTP response from a ListClusterProjectTypesWithResponse call
+func ParseListClusterProjectTypesResp(rsp *http.Response) (*ListClusterProjectTypesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterObservabilityPlanesResp{
+	response := &ListClusterProjectTypesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterObservabilityPlaneList
+		var dest ClusterProjectTypeList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25991,22 +28011,22 @@ func ParseListClusterObservabilityPlanesResp(rsp *http.Response) (*ListClusterOb
 	return response, nil
 }
 
-// ParseCreateClusterObservabilityPlaneResp parses an HTTP response from a CreateClusterObservabilityPlaneWithResponse call
-func ParseCreateClusterObservabilityPlaneResp(rsp *http.Response) (*CreateClusterObservabilityPlaneResp, error) {
+// ParseCreateClusterProjectTypeResp parses an HTTP response from a CreateClusterProjectTypeWithResponse call
+func ParseCreateClusterProjectTypeResp(rsp *http.Response) (*CreateClusterProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterObservabilityPlaneResp{
+	response := &CreateClusterProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterObservabilityPlane
+		var dest ClusterProjectType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26040,13 +28060,6 @@ func ParseCreateClusterObservabilityPlaneResp(rsp *http.Response) (*CreateCluste
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26059,15 +28072,15 @@ func ParseCreateClusterObservabilityPlaneResp(rsp *http.Response) (*CreateCluste
 	return response, nil
 }
 
-// ParseDeleteClusterObservabilityPlaneResp parses an HTTP response from a DeleteClusterObservabilityPlaneWithResponse call
-func ParseDeleteClusterObservabilityPlaneResp(rsp *http.Response) (*DeleteClusterObservabilityPlaneResp, error) {
+// ParseDeleteClusterProjectTypeResp parses an HTTP response from a DeleteClusterProjectTypeWithResponse call
+func ParseDeleteClusterProjectTypeResp(rsp *http.Response) (*DeleteClusterProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterObservabilityPlaneResp{
+	response := &DeleteClusterProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26106,22 +28119,22 @@ func ParseDeleteClusterObservabilityPlaneResp(rsp *http.Response) (*DeleteCluste
 	return response, nil
 }
 
-// ParseGetClusterObservabilityPlaneResp parses an HTTP response from a GetClusterObservabilityPlaneWithResponse call
-func ParseGetClusterObservabilityPlaneResp(rsp *http.Response) (*GetClusterObservabilityPlaneResp, error) {
+// ParseGetClusterProjectTypeResp parses an HTTP response from a GetClusterProjectTypeWithResponse call
+func ParseGetClusterProjectTypeResp(rsp *http.Response) (*GetClusterProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterObservabilityPlaneResp{
+	response := &GetClusterProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterObservabilityPlane
+		var dest ClusterProjectType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26160,22 +28173,22 @@ func ParseGetClusterObservabilityPlaneResp(rsp *http.Response) (*GetClusterObser
 	return response, nil
 }
 
-// ParseUpdateClusterObservabilityPlaneResp parses an HTTP response from a UpdateClusterObservabilityPlaneWithResponse call
-func ParseUpdateClusterObservabilityPlaneResp(rsp *http.Response) (*UpdateClusterObservabilityPlaneResp, error) {
+// ParseUpdateClusterProjectTypeResp parses an HTTP response from a UpdateClusterProjectTypeWithResponse call
+func ParseUpdateClusterProjectTypeResp(rsp *http.Response) (*UpdateClusterProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterObservabilityPlaneResp{
+	response := &UpdateClusterProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterObservabilityPlane
+		var dest ClusterProjectType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26216,12 +28229,59 @@ func ParseUpdateClusterObservabilityPlaneResp(rsp *http.Response) (*UpdateCluste
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterProjectTypeSchemaResp parses an HTTP response from a GetClusterProjectTypeSchemaWithResponse call
+func ParseGetClusterProjectTypeSchemaResp(rsp *http.Response) (*GetClusterProjectTypeSchemaResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterProjectTypeSchemaResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -26235,22 +28295,22 @@ func ParseUpdateClusterObservabilityPlaneResp(rsp *http.Response) (*UpdateCluste
 	return response, nil
 }
 
-// ParseListClusterProjectTypesResp parses an HTTP response from a ListClusterProjectTypesWithResponse call
-func ParseListClusterProjectTypesResp(rsp *http.Response) (*ListClusterProjectTypesResp, error) {
+// ParseListClusterResourceTypesResp parses an HTTP response from a ListClusterResourceTypesWithResponse call
+func ParseListClusterResourceTypesResp(rsp *http.Response) (*ListClusterResourceTypesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterProjectTypesResp{
+	response := &ListClusterResourceTypesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterProjectTypeList
+		var dest ClusterResourceTypeList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26289,22 +28349,22 @@ func ParseListClusterProjectTypesResp(rsp *http.Response) (*ListClusterProjectTy
 	return response, nil
 }
 
-// ParseCreateClusterProjectTypeResp parses an HTTP response from a CreateClusterProjectTypeWithResponse call
-func ParseCreateClusterProjectTypeResp(rsp *http.Response) (*CreateClusterProjectTypeResp, error) {
+// ParseCreateClusterResourceTypeResp parses an HTTP response from a CreateClusterResourceTypeWithResponse call
+func ParseCreateClusterResourceTypeResp(rsp *http.Response) (*CreateClusterResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterProjectTypeResp{
+	response := &CreateClusterResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterProjectType
+		var dest ClusterResourceType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26350,15 +28410,15 @@ func ParseCreateClusterProjectTypeResp(rsp *http.Response) (*CreateClusterProjec
 	return response, nil
 }
 
-// ParseDeleteClusterProjectTypeResp parses an HTTP response from a DeleteClusterProjectTypeWithResponse call
-func ParseDeleteClusterProjectTypeResp(rsp *http.Response) (*DeleteClusterProjectTypeResp, error) {
+// ParseDeleteClusterResourceTypeResp parses an HTTP response from a DeleteClusterResourceTypeWithResponse call
+func ParseDeleteClusterResourceTypeResp(rsp *http.Response) (*DeleteClusterResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterProjectTypeResp{
+	response := &DeleteClusterResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26397,22 +28457,22 @@ func ParseDeleteClusterProjectTypeResp(rsp *http.Response) (*DeleteClusterProjec
 	return response, nil
 }
 
-// ParseGetClusterProjectTypeResp parses an HTTP response from a GetClusterProjectTypeWithResponse call
-func ParseGetClusterProjectTypeResp(rsp *http.Response) (*GetClusterProjectTypeResp, error) {
+// ParseGetClusterResourceTypeResp parses an HTTP response from a GetClusterResourceTypeWithResponse call
+func ParseGetClusterResourceTypeResp(rsp *http.Response) (*GetClusterResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterProjectTypeResp{
+	response := &GetClusterResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterProjectType
+		var dest ClusterResourceType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26451,22 +28511,22 @@ func ParseGetClusterProjectTypeResp(rsp *http.Response) (*GetClusterProjectTypeR
 	return response, nil
 }
 
-// ParseUpdateClusterProjectTypeResp parses an HTTP response from a UpdateClusterProjectTypeWithResponse call
-func ParseUpdateClusterProjectTypeResp(rsp *http.Response) (*UpdateClusterProjectTypeResp, error) {
+// ParseUpdateClusterResourceTypeResp parses an HTTP response from a UpdateClusterResourceTypeWithResponse call
+func ParseUpdateClusterResourceTypeResp(rsp *http.Response) (*UpdateClusterResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterProjectTypeResp{
+	response := &UpdateClusterResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterProjectType
+		var dest ClusterResourceType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26519,15 +28579,15 @@ func ParseUpdateClusterProjectTypeResp(rsp *http.Response) (*UpdateClusterProjec
 	return response, nil
 }
 
-// ParseGetClusterProjectTypeSchemaResp parses an HTTP response from a GetClusterProjectTypeSchemaWithResponse call
-func ParseGetClusterProjectTypeSchemaResp(rsp *http.Response) (*GetClusterProjectTypeSchemaResp, error) {
+// ParseGetClusterResourceTypeSchemaResp parses an HTTP response from a GetClusterResourceTypeSchemaWithResponse call
+func ParseGetClusterResourceTypeSchemaResp(rsp *http.Response) (*GetClusterResourceTypeSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterProjectTypeSchemaResp{
+	response := &GetClusterResourceTypeSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26573,22 +28633,22 @@ func ParseGetClusterProjectTypeSchemaResp(rsp *http.Response) (*GetClusterProjec
 	return response, nil
 }
 
-// ParseListClusterResourceTypesResp parses an HTTP response from a ListClusterResourceTypesWithResponse call
-func ParseListClusterResourceTypesResp(rsp *http.Response) (*ListClusterResourceTypesResp, error) {
+// ParseListClusterTraitsResp parses an HTTP response from a ListClusterTraitsWithResponse call
+func ParseListClusterTraitsResp(rsp *http.Response) (*ListClusterTraitsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterResourceTypesResp{
+	response := &ListClusterTraitsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterResourceTypeList
+		var dest ClusterTraitList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26627,22 +28687,22 @@ func ParseListClusterResourceTypesResp(rsp *http.Response) (*ListClusterResource
 	return response, nil
 }
 
-// ParseCreateClusterResourceTypeResp parses an HTTP response from a CreateClusterResourceTypeWithResponse call
-func ParseCreateClusterResourceTypeResp(rsp *http.Response) (*CreateClusterResourceTypeResp, error) {
+// ParseCreateClusterTraitResp parses an HTTP response from a CreateClusterTraitWithResponse call
+func ParseCreateClusterTraitResp(rsp *http.Response) (*CreateClusterTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterResourceTypeResp{
+	response := &CreateClusterTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterResourceType
+		var dest ClusterTrait
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26676,6 +28736,13 @@ func ParseCreateClusterResourceTypeResp(rsp *http.Response) (*CreateClusterResou
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26688,15 +28755,15 @@ func ParseCreateClusterResourceTypeResp(rsp *http.Response) (*CreateClusterResou
 	return response, nil
 }
 
-// ParseDeleteClusterResourceTypeResp parses an HTTP response from a DeleteClusterResourceTypeWithResponse call
-func ParseDeleteClusterResourceTypeResp(rsp *http.Response) (*DeleteClusterResourceTypeResp, error) {
+// ParseDeleteClusterTraitResp parses an HTTP response from a DeleteClusterTraitWithResponse call
+func ParseDeleteClusterTraitResp(rsp *http.Response) (*DeleteClusterTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterResourceTypeResp{
+	response := &DeleteClusterTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26735,22 +28802,22 @@ func ParseDeleteClusterResourceTypeResp(rsp *http.Response) (*DeleteClusterResou
 	return response, nil
 }
 
-// ParseGetClusterResourceTypeResp parses an HTTP response from a GetClusterResourceTypeWithResponse call
-func ParseGetClusterResourceTypeResp(rsp *http.Response) (*GetClusterResourceTypeResp, error) {
+// ParseGetClusterTraitResp parses an HTTP response from a GetClusterTraitWithResponse call
+func ParseGetClusterTraitResp(rsp *http.Response) (*GetClusterTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterResourceTypeResp{
+	response := &GetClusterTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterResourceType
+		var dest ClusterTrait
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26789,22 +28856,22 @@ func ParseGetClusterResourceTypeResp(rsp *http.Response) (*GetClusterResourceTyp
 	return response, nil
 }
 
-// ParseUpdateClusterResourceTypeResp parses an HTTP response from a UpdateClusterResourceTypeWithResponse call
-func ParseUpdateClusterResourceTypeResp(rsp *http.Response) (*UpdateClusterResourceTypeResp, error) {
+// ParseUpdateClusterTraitResp parses an HTTP response from a UpdateClusterTraitWithResponse call
+func ParseUpdateClusterTraitResp(rsp *http.Response) (*UpdateClusterTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterResourceTypeResp{
+	response := &UpdateClusterTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterResourceType
+		var dest ClusterTrait
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26845,6 +28912,13 @@ func ParseUpdateClusterResourceTypeResp(rsp *http.Response) (*UpdateClusterResou
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26857,15 +28931,15 @@ func ParseUpdateClusterResourceTypeResp(rsp *http.Response) (*UpdateClusterResou
 	return response, nil
 }
 
-// ParseGetClusterResourceTypeSchemaResp parses an HTTP response from a GetClusterResourceTypeSchemaWithResponse call
-func ParseGetClusterResourceTypeSchemaResp(rsp *http.Response) (*GetClusterResourceTypeSchemaResp, error) {
+// ParseGetClusterTraitSchemaResp parses an HTTP response from a GetClusterTraitSchemaWithResponse call
+func ParseGetClusterTraitSchemaResp(rsp *http.Response) (*GetClusterTraitSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterResourceTypeSchemaResp{
+	response := &GetClusterTraitSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26911,22 +28985,22 @@ func ParseGetClusterResourceTypeSchemaResp(rsp *http.Response) (*GetClusterResou
 	return response, nil
 }
 
-// ParseListClusterTraitsResp parses an HTTP response from a ListClusterTraitsWithResponse call
-func ParseListClusterTraitsResp(rsp *http.Response) (*ListClusterTraitsResp, error) {
+// ParseListClusterWorkflowPlanesResp parses an HTTP response from a ListClusterWorkflowPlanesWithResponse call
+func ParseListClusterWorkflowPlanesResp(rsp *http.Response) (*ListClusterWorkflowPlanesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterTraitsResp{
+	response := &ListClusterWorkflowPlanesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterTraitList
+		var dest ClusterWorkflowPlaneList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26965,22 +29039,22 @@ func ParseListClusterTraitsResp(rsp *http.Response) (*ListClusterTraitsResp, err
 	return response, nil
 }
 
-// ParseCreateClusterTraitResp parses an HTTP response from a CreateClusterTraitWithResponse call
-func ParseCreateClusterTraitResp(rsp *http.Response) (*CreateClusterTraitResp, error) {
+// ParseCreateClusterWorkflowPlaneResp parses an HTTP response from a CreateClusterWorkflowPlaneWithResponse call
+func ParseCreateClusterWorkflowPlaneResp(rsp *http.Response) (*CreateClusterWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterTraitResp{
+	response := &CreateClusterWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterTrait
+		var dest ClusterWorkflowPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27033,15 +29107,15 @@ func ParseCreateClusterTraitResp(rsp *http.Response) (*CreateClusterTraitResp, e
 	return response, nil
 }
 
-// ParseDeleteClusterTraitResp parses an HTTP response from a DeleteClusterTraitWithResponse call
-func ParseDeleteClusterTraitResp(rsp *http.Response) (*DeleteClusterTraitResp, error) {
+// ParseDeleteClusterWorkflowPlaneResp parses an HTTP response from a DeleteClusterWorkflowPlaneWithResponse call
+func ParseDeleteClusterWorkflowPlaneResp(rsp *http.Response) (*DeleteClusterWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterTraitResp{
+	response := &DeleteClusterWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -27080,22 +29154,22 @@ func ParseDeleteClusterTraitResp(rsp *http.Response) (*DeleteClusterTraitResp, e
 	return response, nil
 }
 
-// ParseGetClusterTraitResp parses an HTTP response from a GetClusterTraitWithResponse call
-func ParseGetClusterTraitResp(rsp *http.Response) (*GetClusterTraitResp, error) {
+// ParseGetClusterWorkflowPlaneResp parses an HTTP response from a GetClusterWorkflowPlaneWithResponse call
+func ParseGetClusterWorkflowPlaneResp(rsp *http.Response) (*GetClusterWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterTraitResp{
+	response := &GetClusterWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterTrait
+		var dest ClusterWorkflowPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27134,22 +29208,22 @@ func ParseGetClusterTraitResp(rsp *http.Response) (*GetClusterTraitResp, error)
 	return response, nil
 }
 
-// ParseUpdateClusterTraitResp parses an HTTP response from a UpdateClusterTraitWithResponse call
-func ParseUpdateClusterTraitResp(rsp *http.Response) (*UpdateClusterTraitResp, error) {
+// ParseUpdateClusterWorkflowPlaneResp parses an HTTP response from a UpdateClusterWorkflowPlaneWithResponse call
+func ParseUpdateClusterWorkflowPlaneResp(rsp *http.Response) (*UpdateClusterWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterTraitResp{
+	response := &UpdateClusterWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterTrait
+		var dest ClusterWorkflowPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27209,76 +29283,22 @@ func ParseUpdateClusterTraitResp(rsp *http.Response) (*UpdateClusterTraitResp, e
 	return response, nil
 }
 
-// ParseGetClusterTraitSchemaResp parses an HTTP response from a GetClusterTraitSchemaWithResponse call
-func ParseGetClusterTraitSchemaResp(rsp *http.Response) (*GetClusterTraitSchemaResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetClusterTraitSchemaResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseListClusterWorkflowPlanesResp parses an HTTP response from a ListClusterWorkflowPlanesWithResponse call
-func ParseListClusterWorkflowPlanesResp(rsp *http.Response) (*ListClusterWorkflowPlanesResp, error) {
+// ParseListClusterWorkflowsResp parses an HTTP response from a ListClusterWorkflowsWithResponse call
+func ParseListClusterWorkflowsResp(rsp *http.Response) (*ListClusterWorkflowsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterWorkflowPlanesResp{
+	response := &ListClusterWorkflowsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterWorkflowPlaneList
+		var dest ClusterWorkflowList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27317,22 +29337,22 @@ func ParseListClusterWorkflowPlanesResp(rsp *http.Response) (*ListClusterWorkflo
 	return response, nil
 }
 
-// ParseCreateClusterWorkflowPlaneResp parses an HTTP response from a CreateClusterWorkflowPlaneWithResponse call
-func ParseCreateClusterWorkflowPlaneResp(rsp *http.Response) (*CreateClusterWorkflowPlaneResp, error) {
+// ParseCreateClusterWorkflowResp parses an HTTP response from a CreateClusterWorkflowWithResponse call
+func ParseCreateClusterWorkflowResp(rsp *http.Response) (*CreateClusterWorkflowResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterWorkflowPlaneResp{
+	response := &CreateClusterWorkflowResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterWorkflowPlane
+		var dest ClusterWorkflow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27385,15 +29405,15 @@ func ParseCreateClusterWorkflowPlaneResp(rsp *http.Response) (*CreateClusterWork
 	return response, nil
 }
 
-// ParseDeleteClusterWorkflowPlaneResp parses an HTTP response from a DeleteClusterWorkflowPlaneWithResponse call
-func ParseDeleteClusterWorkflowPlaneResp(rsp *http.Response) (*DeleteClusterWorkflowPlaneResp, error) {
+// ParseDeleteClusterWorkflowResp parses an HTTP response from a DeleteClusterWorkflowWithResponse call
+func ParseDeleteClusterWorkflowResp(rsp *http.Response) (*DeleteClusterWorkflowResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterWorkflowPlaneResp{
+	response := &DeleteClusterWorkflowResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -27432,22 +29452,22 @@ func ParseDeleteClusterWorkflowPlaneResp(rsp *http.Response) (*DeleteClusterWork
 	return response, nil
 }
 
-// ParseGetClusterWorkflowPlaneResp parses an HTTP response from a GetClusterWorkflowPlaneWithResponse call
-func ParseGetClusterWorkflowPlaneResp(rsp *http.Response) (*GetClusterWorkflowPlaneResp, error) {
+// ParseGetClusterWorkflowResp parses an HTTP response from a GetClusterWorkflowWithResponse call
+func ParseGetClusterWorkflowResp(rsp *http.Response) (*GetClusterWorkflowResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterWorkflowPlaneResp{
+	response := &GetClusterWorkflowResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterWorkflowPlane
+		var dest ClusterWorkflow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27486,22 +29506,22 @@ func ParseGetClusterWorkflowPlaneResp(rsp *http.Response) (*GetClusterWorkflowPl
 	return response, nil
 }
 
-// ParseUpdateClusterWorkflowPlaneResp parses an HTTP response from a UpdateClusterWorkflowPlaneWithResponse call
-func ParseUpdateClusterWorkflowPlaneResp(rsp *http.Response) (*UpdateClusterWorkflowPlaneResp, error) {
+// ParseUpdateClusterWorkflowResp parses an HTTP response from a UpdateClusterWorkflowWithResponse call
+func ParseUpdateClusterWorkflowResp(rsp *http.Response) (*UpdateClusterWorkflowResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterWorkflowPlaneResp{
+	response := &UpdateClusterWorkflowResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterWorkflowPlane
+		var dest ClusterWorkflow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27561,22 +29581,76 @@ func ParseUpdateClusterWorkflowPlaneResp(rsp *http.Response) (*UpdateClusterWork
 	return response, nil
 }
 
-// ParseListClusterWorkflowsResp parses an HTTP response from a ListClusterWorkflowsWithResponse call
-func ParseListClusterWorkflowsResp(rsp *http.Response) (*ListClusterWorkflowsResp, error) {
+// ParseGetClusterWorkflowSchemaResp parses an HTTP response from a GetClusterWorkflowSchemaWithResponse call
+func ParseGetClusterWorkflowSchemaResp(rsp *http.Response) (*GetClusterWorkflowSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListClusterWorkflowsResp{
+	response := &GetClusterWorkflowSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterWorkflowList
+		var dest SchemaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNamespacesResp parses an HTTP response from a ListNamespacesWithResponse call
+func ParseListNamespacesResp(rsp *http.Response) (*ListNamespacesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNamespacesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27615,22 +29689,22 @@ func ParseListClusterWorkflowsResp(rsp *http.Response) (*ListClusterWorkflowsRes
 	return response, nil
 }
 
-// ParseCreateClusterWorkflowResp parses an HTTP response from a CreateClusterWorkflowWithResponse call
-func ParseCreateClusterWorkflowResp(rsp *http.Response) (*CreateClusterWorkflowResp, error) {
+// ParseCreateNamespaceResp parses an HTTP response from a CreateNamespaceWithResponse call
+func ParseCreateNamespaceResp(rsp *http.Response) (*CreateNamespaceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClusterWorkflowResp{
+	response := &CreateNamespaceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ClusterWorkflow
+		var dest Namespace
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27683,15 +29757,15 @@ func ParseCreateClusterWorkflowResp(rsp *http.Response) (*CreateClusterWorkflowR
 	return response, nil
 }
 
-// ParseDeleteClusterWorkflowResp parses an HTTP response from a DeleteClusterWorkflowWithResponse call
-func ParseDeleteClusterWorkflowResp(rsp *http.Response) (*DeleteClusterWorkflowResp, error) {
+// ParseDeleteNamespaceResp parses an HTTP response from a DeleteNamespaceWithResponse call
+func ParseDeleteNamespaceResp(rsp *http.Response) (*DeleteNamespaceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClusterWorkflowResp{
+	response := &DeleteNamespaceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -27730,22 +29804,22 @@ func ParseDeleteClusterWorkflowResp(rsp *http.Response) (*DeleteClusterWorkflowR
 	return response, nil
 }
 
-// ParseGetClusterWorkflowResp parses an HTTP response from a GetClusterWorkflowWithResponse call
-func ParseGetClusterWorkflowResp(rsp *http.Response) (*GetClusterWorkflowResp, error) {
+// ParseGetNamespaceResp parses an HTTP response from a GetNamespaceWithResponse call
+func ParseGetNamespaceResp(rsp *http.Response) (*GetNamespaceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterWorkflowResp{
+	response := &GetNamespaceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterWorkflow
+		var dest Namespace
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27784,22 +29858,22 @@ func ParseGetClusterWorkflowResp(rsp *http.Response) (*GetClusterWorkflowResp, e
 	return response, nil
 }
 
-// ParseUpdateClusterWorkflowResp parses an HTTP response from a UpdateClusterWorkflowWithResponse call
-func ParseUpdateClusterWorkflowResp(rsp *http.Response) (*UpdateClusterWorkflowResp, error) {
+// ParseUpdateNamespaceResp parses an HTTP response from a UpdateNamespaceWithResponse call
+func ParseUpdateNamespaceResp(rsp *http.Response) (*UpdateNamespaceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateClusterWorkflowResp{
+	response := &UpdateNamespaceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClusterWorkflow
+		var dest Namespace
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27833,13 +29907,6 @@ func ParseUpdateClusterWorkflowResp(rsp *http.Response) (*UpdateClusterWorkflowR
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27859,76 +29926,22 @@ func ParseUpdateClusterWorkflowResp(rsp *http.Response) (*UpdateClusterWorkflowR
 	return response, nil
 }
 
-// ParseGetClusterWorkflowSchemaResp parses an HTTP response from a GetClusterWorkflowSchemaWithResponse call
-func ParseGetClusterWorkflowSchemaResp(rsp *http.Response) (*GetClusterWorkflowSchemaResp, error) {
+// ParseListAuthzAccessRequestsResp parses an HTTP response from a ListAuthzAccessRequestsWithResponse call
+func ParseListAuthzAccessRequestsResp(rsp *http.Response) (*ListAuthzAccessRequestsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClusterWorkflowSchemaResp{
+	response := &ListAuthzAccessRequestsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseListNamespacesResp parses an HTTP response from a ListNamespacesWithResponse call
-func ParseListNamespacesResp(rsp *http.Response) (*ListNamespacesResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ListNamespacesResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NamespaceList
+		var dest AuthzAccessRequestList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27967,22 +29980,22 @@ func ParseListNamespacesResp(rsp *http.Response) (*ListNamespacesResp, error) {
 	return response, nil
 }
 
-// ParseCreateNamespaceResp parses an HTTP response from a CreateNamespaceWithResponse call
-func ParseCreateNamespaceResp(rsp *http.Response) (*CreateNamespaceResp, error) {
+// ParseCreateAuthzAccessRequestResp parses an HTTP response from a CreateAuthzAccessRequestWithResponse call
+func ParseCreateAuthzAccessRequestResp(rsp *http.Response) (*CreateAuthzAccessRequestResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateNamespaceResp{
+	response := &CreateAuthzAccessRequestResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Namespace
+		var dest AuthzAccessRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28016,13 +30029,6 @@ func ParseCreateNamespaceResp(rsp *http.Response) (*CreateNamespaceResp, error)
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28035,20 +30041,27 @@ func ParseCreateNamespaceResp(rsp *http.Response) (*CreateNamespaceResp, error)
 	return response, nil
 }
 
-// ParseDeleteNamespaceResp parses an HTTP response from a DeleteNamespaceWithResponse call
-func ParseDeleteNamespaceResp(rsp *http.Response) (*DeleteNamespaceResp, error) {
+// ParseGetAuthzAccessRequestResp parses an HTTP response from a GetAuthzAccessRequestWithResponse call
+func ParseGetAuthzAccessRequestResp(rsp *http.Response) (*GetAuthzAccessRequestResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteNamespaceResp{
+	response := &GetAuthzAccessRequestResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzAccessRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28082,22 +30095,22 @@ func ParseDeleteNamespaceResp(rsp *http.Response) (*DeleteNamespaceResp, error)
 	return response, nil
 }
 
-// ParseGetNamespaceResp parses an HTTP response from a GetNamespaceWithResponse call
-func ParseGetNamespaceResp(rsp *http.Response) (*GetNamespaceResp, error) {
+// ParseApproveAuthzAccessRequestResp parses an HTTP response from a ApproveAuthzAccessRequestWithResponse call
+func ParseApproveAuthzAccessRequestResp(rsp *http.Response) (*ApproveAuthzAccessRequestResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNamespaceResp{
+	response := &ApproveAuthzAccessRequestResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Namespace
+		var dest AuthzAccessRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28124,6 +30137,13 @@ func ParseGetNamespaceResp(rsp *http.Response) (*GetNamespaceResp, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28136,34 +30156,27 @@ func ParseGetNamespaceResp(rsp *http.Response) (*GetNamespaceResp, error) {
 	return response, nil
 }
 
-// ParseUpdateNamespaceResp parses an HTTP response from a UpdateNamespaceWithResponse call
-func ParseUpdateNamespaceResp(rsp *http.Response) (*UpdateNamespaceResp, error) {
+// ParseDenyAuthzAccessRequestResp parses an HTTP response from a DenyAuthzAccessRequestWithResponse call
+func ParseDenyAuthzAccessRequestResp(rsp *http.Response) (*DenyAuthzAccessRequestResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateNamespaceResp{
+	response := &DenyAuthzAccessRequestResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Namespace
+		var dest AuthzAccessRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28185,12 +30198,12 @@ func ParseUpdateNamespaceResp(rsp *http.Response) (*UpdateNamespaceResp, error)
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -28483,13 +30496,135 @@ func ParseUpdateNamespaceRoleBindingResp(rsp *http.Response) (*UpdateNamespaceRo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNamespaceRolesResp parses an HTTP response from a ListNamespaceRolesWithResponse call
+func ParseListNamespaceRolesResp(rsp *http.Response) (*ListNamespaceRolesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNamespaceRolesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzRoleList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateNamespaceRoleResp parses an HTTP response from a CreateNamespaceRoleWithResponse call
+func ParseCreateNamespaceRoleResp(rsp *http.Response) (*CreateNamespaceRoleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateNamespaceRoleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AuthzRole
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28516,27 +30651,20 @@ func ParseUpdateNamespaceRoleBindingResp(rsp *http.Response) (*UpdateNamespaceRo
 	return response, nil
 }
 
-// ParseListNamespaceRolesResp parses an HTTP response from a ListNamespaceRolesWithResponse call
-func ParseListNamespaceRolesResp(rsp *http.Response) (*ListNamespaceRolesResp, error) {
+// ParseDeleteNamespaceRoleResp parses an HTTP response from a DeleteNamespaceRoleWithResponse call
+func ParseDeleteNamespaceRoleResp(rsp *http.Response) (*DeleteNamespaceRoleResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListNamespaceRolesResp{
+	response := &DeleteNamespaceRoleResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthzRoleList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28558,6 +30686,20 @@ func ParseListNamespaceRolesResp(rsp *http.Response) (*ListNamespaceRolesResp, e
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28570,26 +30712,26 @@ func ParseListNamespaceRolesResp(rsp *http.Response) (*ListNamespaceRolesResp, e
 	return response, nil
 }
 
-// ParseCreateNamespaceRoleResp parses an HTTP response from a CreateNamespaceRoleWithResponse call
-func ParseCreateNamespaceRoleResp(rsp *http.Response) (*CreateNamespaceRoleResp, error) {
+// ParseGetNamespaceRoleResp parses an HTTP response from a GetNamespaceRoleWithResponse call
+func ParseGetNamespaceRoleResp(rsp *http.Response) (*GetNamespaceRoleResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateNamespaceRoleResp{
+	response := &GetNamespaceRoleResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest AuthzRole
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -28612,19 +30754,12 @@ func ParseCreateNamespaceRoleResp(rsp *http.Response) (*CreateNamespaceRoleResp,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -28638,20 +30773,27 @@ func ParseCreateNamespaceRoleResp(rsp *http.Response) (*CreateNamespaceRoleResp,
 	return response, nil
 }
 
-// ParseDeleteNamespaceRoleResp parses an HTTP response from a DeleteNamespaceRoleWithResponse call
-func ParseDeleteNamespaceRoleResp(rsp *http.Response) (*DeleteNamespaceRoleResp, error) {
+// ParseUpdateNamespaceRoleResp parses an HTTP response from a UpdateNamespaceRoleWithResponse call
+func ParseUpdateNamespaceRoleResp(rsp *http.Response) (*UpdateNamespaceRoleResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteNamespaceRoleResp{
+	response := &UpdateNamespaceRoleResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzRole
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28680,12 +30822,12 @@ func ParseDeleteNamespaceRoleResp(rsp *http.Response) (*DeleteNamespaceRoleResp,
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -28699,22 +30841,22 @@ func ParseDeleteNamespaceRoleResp(rsp *http.Response) (*DeleteNamespaceRoleResp,
 	return response, nil
 }
 
-// ParseGetNamespaceRoleResp parses an HTTP response from a GetNamespaceRoleWithResponse call
-func ParseGetNamespaceRoleResp(rsp *http.Response) (*GetNamespaceRoleResp, error) {
+// ParseListComponentReleasesResp parses an HTTP response from a ListComponentReleasesWithResponse call
+func ParseListComponentReleasesResp(rsp *http.Response) (*ListComponentReleasesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNamespaceRoleResp{
+	response := &ListComponentReleasesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthzRole
+		var dest ComponentReleaseList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28760,26 +30902,26 @@ func ParseGetNamespaceRoleResp(rsp *http.Response) (*GetNamespaceRoleResp, error
 	return response, nil
 }
 
-// ParseUpdateNamespaceRoleResp parses an HTTP response from a UpdateNamespaceRoleWithResponse call
-func ParseUpdateNamespaceRoleResp(rsp *http.Response) (*UpdateNamespaceRoleResp, error) {
+// ParseCreateComponentReleaseResp parses an HTTP response from a CreateComponentReleaseWithResponse call
+func ParseCreateComponentReleaseResp(rsp *http.Response) (*CreateComponentReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateNamespaceRoleResp{
+	response := &CreateComponentReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthzRole
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ComponentRelease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -28802,12 +30944,12 @@ func ParseUpdateNamespaceRoleResp(rsp *http.Response) (*UpdateNamespaceRoleResp,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
@@ -28828,22 +30970,123 @@ func ParseUpdateNamespaceRoleResp(rsp *http.Response) (*UpdateNamespaceRoleResp,
 	return response, nil
 }
 
-// ParseListComponentReleasesResp parses an HTTP response from a ListComponentReleasesWithResponse call
-func ParseListComponentReleasesResp(rsp *http.Response) (*ListComponentReleasesResp, error) {
+// ParseDeleteComponentReleaseResp parses an HTTP response from a DeleteComponentReleaseWithResponse call
+func ParseDeleteComponentReleaseResp(rsp *http.Response) (*DeleteComponentReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListComponentReleasesResp{
+	response := &DeleteComponentReleaseResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetComponentReleaseResp parses an HTTP response from a GetComponentReleaseWithResponse call
+func ParseGetComponentReleaseResp(rsp *http.Response) (*GetComponentReleaseResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetComponentReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentReleaseList
+		var dest ComponentRelease
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListComponentsResp parses an HTTP response from a ListComponentsWithResponse call
+func ParseListComponentsResp(rsp *http.Response) (*ListComponentsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListComponentsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComponentList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28889,22 +31132,22 @@ func ParseListComponentReleasesResp(rsp *http.Response) (*ListComponentReleasesR
 	return response, nil
 }
 
-// ParseCreateComponentReleaseResp parses an HTTP response from a CreateComponentReleaseWithResponse call
-func ParseCreateComponentReleaseResp(rsp *http.Response) (*CreateComponentReleaseResp, error) {
+// ParseCreateComponentResp parses an HTTP response from a CreateComponentWithResponse call
+func ParseCreateComponentResp(rsp *http.Response) (*CreateComponentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateComponentReleaseResp{
+	response := &CreateComponentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ComponentRelease
+		var dest Component
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28957,15 +31200,15 @@ func ParseCreateComponentReleaseResp(rsp *http.Response) (*CreateComponentReleas
 	return response, nil
 }
 
-// ParseDeleteComponentReleaseResp parses an HTTP response from a DeleteComponentReleaseWithResponse call
-func ParseDeleteComponentReleaseResp(rsp *http.Response) (*DeleteComponentReleaseResp, error) {
+// ParseDeleteComponentResp parses an HTTP response from a DeleteComponentWithResponse call
+func ParseDeleteComponentResp(rsp *http.Response) (*DeleteComponentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteComponentReleaseResp{
+	response := &DeleteComponentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -29004,22 +31247,22 @@ func ParseDeleteComponentReleaseResp(rsp *http.Response) (*DeleteComponentReleas
 	return response, nil
 }
 
-// ParseGetComponentReleaseResp parses an HTTP response from a GetComponentReleaseWithResponse call
-func ParseGetComponentReleaseResp(rsp *http.Response) (*GetComponentReleaseResp, error) {
+// ParseGetComponentResp parses an HTTP response from a GetComponentWithResponse call
+func ParseGetComponentResp(rsp *http.Response) (*GetComponentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentReleaseResp{
+	response := &GetComponentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentRelease
+		var dest Component
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29058,22 +31301,22 @@ func ParseGetComponentReleaseResp(rsp *http.Response) (*GetComponentReleaseResp,
 	return response, nil
 }
 
-// ParseListComponentsResp parses an HTTP response from a ListComponentsWithResponse call
-func ParseListComponentsResp(rsp *http.Response) (*ListComponentsResp, error) {
+// ParseUpdateComponentResp parses an HTTP response from a UpdateComponentWithResponse call
+func ParseUpdateComponentResp(rsp *http.Response) (*UpdateComponentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListComponentsResp{
+	response := &UpdateComponentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentList
+		var dest Component
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29107,6 +31350,20 @@ func ParseListComponentsResp(rsp *http.Response) (*ListComponentsResp, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29119,22 +31376,22 @@ func ParseListComponentsResp(rsp *http.Response) (*ListComponentsResp, error) {
 	return response, nil
 }
 
-// ParseCreateComponentResp parses an HTTP response from a CreateComponentWithResponse call
-func ParseCreateComponentResp(rsp *http.Response) (*CreateComponentResp, error) {
+// ParseGenerateReleaseResp parses an HTTP response from a GenerateReleaseWithResponse call
+func ParseGenerateReleaseResp(rsp *http.Response) (*GenerateReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateComponentResp{
+	response := &GenerateReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Component
+		var dest ComponentRelease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29161,12 +31418,12 @@ func ParseCreateComponentResp(rsp *http.Response) (*CreateComponentResp, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
@@ -29187,20 +31444,27 @@ func ParseCreateComponentResp(rsp *http.Response) (*CreateComponentResp, error)
 	return response, nil
 }
 
-// ParseDeleteComponentResp parses an HTTP response from a DeleteComponentWithResponse call
-func ParseDeleteComponentResp(rsp *http.Response) (*DeleteComponentResp, error) {
+// ParseGetComponentSchemaResp parses an HTTP response from a GetComponentSchemaWithResponse call
+func ParseGetComponentSchemaResp(rsp *http.Response) (*GetComponentSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteComponentResp{
+	response := &GetComponentSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29234,27 +31498,34 @@ func ParseDeleteComponentResp(rsp *http.Response) (*DeleteComponentResp, error)
 	return response, nil
 }
 
-// ParseGetComponentResp parses an HTTP response from a GetComponentWithResponse call
-func ParseGetComponentResp(rsp *http.Response) (*GetComponentResp, error) {
+// ParseListComponentTypesResp parses an HTTP response from a ListComponentTypesWithResponse call
+func ParseListComponentTypesResp(rsp *http.Response) (*ListComponentTypesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentResp{
+	response := &ListComponentTypesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Component
+		var dest ComponentTypeList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29269,13 +31540,6 @@ func ParseGetComponentResp(rsp *http.Response) (*GetComponentResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29288,26 +31552,26 @@ func ParseGetComponentResp(rsp *http.Response) (*GetComponentResp, error) {
 	return response, nil
 }
 
-// ParseUpdateComponentResp parses an HTTP response from a UpdateComponentWithResponse call
-func ParseUpdateComponentResp(rsp *http.Response) (*UpdateComponentResp, error) {
+// ParseCreateComponentTypeResp parses an HTTP response from a CreateComponentTypeWithResponse call
+func ParseCreateComponentTypeResp(rsp *http.Response) (*CreateComponentTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateComponentResp{
+	response := &CreateComponentTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Component
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ComponentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -29330,13 +31594,6 @@ func ParseUpdateComponentResp(rsp *http.Response) (*UpdateComponentResp, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29363,34 +31620,20 @@ func ParseUpdateComponentResp(rsp *http.Response) (*UpdateComponentResp, error)
 	return response, nil
 }
 
-// ParseGenerateReleaseResp parses an HTTP response from a GenerateReleaseWithResponse call
-func ParseGenerateReleaseResp(rsp *http.Response) (*GenerateReleaseResp, error) {
+// ParseDeleteComponentTypeResp parses an HTTP response from a DeleteComponentTypeWithResponse call
+func ParseDeleteComponentTypeResp(rsp *http.Response) (*DeleteComponentTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GenerateReleaseResp{
+	response := &DeleteComponentTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ComponentRelease
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29412,13 +31655,6 @@ func ParseGenerateReleaseResp(rsp *http.Response) (*GenerateReleaseResp, error)
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29431,22 +31667,22 @@ func ParseGenerateReleaseResp(rsp *http.Response) (*GenerateReleaseResp, error)
 	return response, nil
 }
 
-// ParseGetComponentSchemaResp parses an HTTP response from a GetComponentSchemaWithResponse call
-func ParseGetComponentSchemaResp(rsp *http.Response) (*GetComponentSchemaResp, error) {
+// ParseGetComponentTypeResp parses an HTTP response from a GetComponentTypeWithResponse call
+func ParseGetComponentTypeResp(rsp *http.Response) (*GetComponentTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentSchemaResp{
+	response := &GetComponentTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
+		var dest ComponentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29485,22 +31721,22 @@ func ParseGetComponentSchemaResp(rsp *http.Response) (*GetComponentSchemaResp, e
 	return response, nil
 }
 
-// ParseListComponentTypesResp parses an HTTP response from a ListComponentTypesWithResponse call
-func ParseListComponentTypesResp(rsp *http.Response) (*ListComponentTypesResp, error) {
+// ParseUpdateComponentTypeResp parses an HTTP response from a UpdateComponentTypeWithResponse call
+func ParseUpdateComponentTypeResp(rsp *http.Response) (*UpdateComponentTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListComponentTypesResp{
+	response := &UpdateComponentTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentTypeList
+		var dest ComponentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29527,6 +31763,27 @@ func ParseListComponentTypesResp(rsp *http.Response) (*ListComponentTypesResp, e
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29539,33 +31796,26 @@ func ParseListComponentTypesResp(rsp *http.Response) (*ListComponentTypesResp, e
 	return response, nil
 }
 
-// ParseCreateComponentTypeResp parses an HTTP response from a CreateComponentTypeWithResponse call
-func ParseCreateComponentTypeResp(rsp *http.Response) (*CreateComponentTypeResp, error) {
+// ParseGetComponentTypeSchemaResp parses an HTTP response from a GetComponentTypeSchemaWithResponse call
+func ParseGetComponentTypeSchemaResp(rsp *http.Response) (*GetComponentTypeSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateComponentTypeResp{
+	response := &GetComponentTypeSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ComponentType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -29581,19 +31831,66 @@ func ParseCreateComponentTypeResp(rsp *http.Response) (*CreateComponentTypeResp,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDataPlanesResp parses an HTTP response from a ListDataPlanesWithResponse call
+func ParseListDataPlanesResp(rsp *http.Response) (*ListDataPlanesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDataPlanesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataPlaneList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -29607,20 +31904,34 @@ func ParseCreateComponentTypeResp(rsp *http.Response) (*CreateComponentTypeResp,
 	return response, nil
 }
 
-// ParseDeleteComponentTypeResp parses an HTTP response from a DeleteComponentTypeWithResponse call
-func ParseDeleteComponentTypeResp(rsp *http.Response) (*DeleteComponentTypeResp, error) {
+// ParseCreateDataPlaneResp parses an HTTP response from a CreateDataPlaneWithResponse call
+func ParseCreateDataPlaneResp(rsp *http.Response) (*CreateDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteComponentTypeResp{
+	response := &CreateDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DataPlane
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29635,12 +31946,19 @@ func ParseDeleteComponentTypeResp(rsp *http.Response) (*DeleteComponentTypeResp,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -29654,27 +31972,20 @@ func ParseDeleteComponentTypeResp(rsp *http.Response) (*DeleteComponentTypeResp,
 	return response, nil
 }
 
-// ParseGetComponentTypeResp parses an HTTP response from a GetComponentTypeWithResponse call
-func ParseGetComponentTypeResp(rsp *http.Response) (*GetComponentTypeResp, error) {
+// ParseDeleteDataPlaneResp parses an HTTP response from a DeleteDataPlaneWithResponse call
+func ParseDeleteDataPlaneResp(rsp *http.Response) (*DeleteDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentTypeResp{
+	response := &DeleteDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29708,34 +32019,27 @@ func ParseGetComponentTypeResp(rsp *http.Response) (*GetComponentTypeResp, error
 	return response, nil
 }
 
-// ParseUpdateComponentTypeResp parses an HTTP response from a UpdateComponentTypeWithResponse call
-func ParseUpdateComponentTypeResp(rsp *http.Response) (*UpdateComponentTypeResp, error) {
+// ParseGetDataPlaneResp parses an HTTP response from a GetDataPlaneWithResponse call
+func ParseGetDataPlaneResp(rsp *http.Response) (*GetDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateComponentTypeResp{
+	response := &GetDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ComponentType
+		var dest DataPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29757,20 +32061,6 @@ func ParseUpdateComponentTypeResp(rsp *http.Response) (*UpdateComponentTypeResp,
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29783,27 +32073,34 @@ func ParseUpdateComponentTypeResp(rsp *http.Response) (*UpdateComponentTypeResp,
 	return response, nil
 }
 
-// ParseGetComponentTypeSchemaResp parses an HTTP response from a GetComponentTypeSchemaWithResponse call
-func ParseGetComponentTypeSchemaResp(rsp *http.Response) (*GetComponentTypeSchemaResp, error) {
+// ParseUpdateDataPlaneResp parses an HTTP response from a UpdateDataPlaneWithResponse call
+func ParseUpdateDataPlaneResp(rsp *http.Response) (*UpdateDataPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetComponentTypeSchemaResp{
+	response := &UpdateDataPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
+		var dest DataPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29825,6 +32122,20 @@ func ParseGetComponentTypeSchemaResp(rsp *http.Response) (*GetComponentTypeSchem
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -29837,22 +32148,22 @@ func ParseGetComponentTypeSchemaResp(rsp *http.Response) (*GetComponentTypeSchem
 	return response, nil
 }
 
-// ParseListDataPlanesResp parses an HTTP response from a ListDataPlanesWithResponse call
-func ParseListDataPlanesResp(rsp *http.Response) (*ListDataPlanesResp, error) {
+// ParseListDeploymentPipelinesResp parses an HTTP response from a ListDeploymentPipelinesWithResponse call
+func ParseListDeploymentPipelinesResp(rsp *http.Response) (*ListDeploymentPipelinesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDataPlanesResp{
+	response := &ListDeploymentPipelinesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DataPlaneList
+		var dest DeploymentPipelineList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29891,22 +32202,22 @@ func ParseListDataPlanesResp(rsp *http.Response) (*ListDataPlanesResp, error) {
 	return response, nil
 }
 
-// ParseCreateDataPlaneResp parses an HTTP response from a CreateDataPlaneWithResponse call
-func ParseCreateDataPlaneResp(rsp *http.Response) (*CreateDataPlaneResp, error) {
+// ParseCreateDeploymentPipelineResp parses an HTTP response from a CreateDeploymentPipelineWithResponse call
+func ParseCreateDeploymentPipelineResp(rsp *http.Response) (*CreateDeploymentPipelineResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDataPlaneResp{
+	response := &CreateDeploymentPipelineResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DataPlane
+		var dest DeploymentPipeline
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -29959,15 +32270,15 @@ func ParseCreateDataPlaneResp(rsp *http.Response) (*CreateDataPlaneResp, error)
 	return response, nil
 }
 
-// ParseDeleteDataPlaneResp parses an HTTP response from a DeleteDataPlaneWithResponse call
-func ParseDeleteDataPlaneResp(rsp *http.Response) (*DeleteDataPlaneResp, error) {
+// ParseDeleteDeploymentPipelineResp parses an HTTP response from a DeleteDeploymentPipelineWithResponse call
+func ParseDeleteDeploymentPipelineResp(rsp *http.Response) (*DeleteDeploymentPipelineResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDataPlaneResp{
+	response := &DeleteDeploymentPipelineResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -30006,22 +32317,22 @@ func ParseDeleteDataPlaneResp(rsp *http.Response) (*DeleteDataPlaneResp, error)
 	return response, nil
 }
 
-// ParseGetDataPlaneResp parses an HTTP response from a GetDataPlaneWithResponse call
-func ParseGetDataPlaneResp(rsp *http.Response) (*GetDataPlaneResp, error) {
+// ParseGetDeploymentPipelineResp parses an HTTP response from a GetDeploymentPipelineWithResponse call
+func ParseGetDeploymentPipelineResp(rsp *http.Response) (*GetDeploymentPipelineResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDataPlaneResp{
+	response := &GetDeploymentPipelineResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DataPlane
+		var dest DeploymentPipeline
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30060,22 +32371,22 @@ func ParseGetDataPlaneResp(rsp *http.Response) (*GetDataPlaneResp, error) {
 	return response, nil
 }
 
-// ParseUpdateDataPlaneResp parses an HTTP response from a UpdateDataPlaneWithResponse call
-func ParseUpdateDataPlaneResp(rsp *http.Response) (*UpdateDataPlaneResp, error) {
+// ParseUpdateDeploymentPipelineResp parses an HTTP response from a UpdateDeploymentPipelineWithResponse call
+func ParseUpdateDeploymentPipelineResp(rsp *http.Response) (*UpdateDeploymentPipelineResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDataPlaneResp{
+	response := &UpdateDeploymentPipelineResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DataPlane
+		var dest DeploymentPipeline
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30109,13 +32420,6 @@ func ParseUpdateDataPlaneResp(rsp *http.Response) (*UpdateDataPlaneResp, error)
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -30135,22 +32439,22 @@ func ParseUpdateDataPlaneResp(rsp *http.Response) (*UpdateDataPlaneResp, error)
 	return response, nil
 }
 
-// ParseListDeploymentPipelinesResp parses an HTTP response from a ListDeploymentPipelinesWithResponse call
-func ParseListDeploymentPipelinesResp(rsp *http.Response) (*ListDeploymentPipelinesResp, error) {
+// ParseListEnvironmentsResp parses an HTTP response from a ListEnvironmentsWithResponse call
+func ParseListEnvironmentsResp(rsp *http.Response) (*ListEnvironmentsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDeploymentPipelinesResp{
+	response := &ListEnvironmentsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentPipelineList
+		var dest EnvironmentList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30189,22 +32493,22 @@ func ParseListDeploymentPipelinesResp(rsp *http.Response) (*ListDeploymentPipeli
 	return response, nil
 }
 
-// ParseCreateDeploymentPipelineResp parses an HTTP response from a CreateDeploymentPipelineWithResponse call
-func ParseCreateDeploymentPipelineResp(rsp *http.Response) (*CreateDeploymentPipelineResp, error) {
+// ParseCreateEnvironmentResp parses an HTTP response from a CreateEnvironmentWithResponse call
+func ParseCreateEnvironmentResp(rsp *http.Response) (*CreateEnvironmentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDeploymentPipelineResp{
+	response := &CreateEnvironmentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DeploymentPipeline
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30257,15 +32561,15 @@ func ParseCreateDeploymentPipelineResp(rsp *http.Response) (*CreateDeploymentPip
 	return response, nil
 }
 
-// ParseDeleteDeploymentPipelineResp parses an HTTP response from a DeleteDeploymentPipelineWithResponse call
-func ParseDeleteDeploymentPipelineResp(rsp *http.Response) (*DeleteDeploymentPipelineResp, error) {
+// ParseDeleteEnvironmentResp parses an HTTP response from a DeleteEnvironmentWithResponse call
+func ParseDeleteEnvironmentResp(rsp *http.Response) (*DeleteEnvironmentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDeploymentPipelineResp{
+	response := &DeleteEnvironmentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -30304,22 +32608,22 @@ func ParseDeleteDeploymentPipelineResp(rsp *http.Response) (*DeleteDeploymentPip
 	return response, nil
 }
 
-// ParseGetDeploymentPipelineResp parses an HTTP response from a GetDeploymentPipelineWithResponse call
-func ParseGetDeploymentPipelineResp(rsp *http.Response) (*GetDeploymentPipelineResp, error) {
+// ParseGetEnvironmentResp parses an HTTP response from a GetEnvironmentWithResponse call
+func ParseGetEnvironmentResp(rsp *http.Response) (*GetEnvironmentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeploymentPipelineResp{
+	response := &GetEnvironmentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentPipeline
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30358,22 +32662,22 @@ func ParseGetDeploymentPipelineResp(rsp *http.Response) (*GetDeploymentPipelineR
 	return response, nil
 }
 
-// ParseUpdateDeploymentPipelineResp parses an HTTP response from a UpdateDeploymentPipelineWithResponse call
-func ParseUpdateDeploymentPipelineResp(rsp *http.Response) (*UpdateDeploymentPipelineResp, error) {
+// ParseUpdateEnvironmentResp parses an HTTP response from a UpdateEnvironmentWithResponse call
+func ParseUpdateEnvironmentResp(rsp *http.Response) (*UpdateEnvironmentResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDeploymentPipelineResp{
+	response := &UpdateEnvironmentResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentPipeline
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30407,6 +32711,13 @@ func ParseUpdateDeploymentPipelineResp(rsp *http.Response) (*UpdateDeploymentPip
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -30426,22 +32737,22 @@ func ParseUpdateDeploymentPipelineResp(rsp *http.Response) (*UpdateDeploymentPip
 	return response, nil
 }
 
-// ParseListEnvironmentsResp parses an HTTP response from a ListEnvironmentsWithResponse call
-func ParseListEnvironmentsResp(rsp *http.Response) (*ListEnvironmentsResp, error) {
+// ParseListObservabilityAlertsNotificationChannelsResp parses an HTTP response from a ListObservabilityAlertsNotificationChannelsWithResponse call
+func ParseListObservabilityAlertsNotificationChannelsResp(rsp *http.Response) (*ListObservabilityAlertsNotificationChannelsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListEnvironmentsResp{
+	response := &ListObservabilityAlertsNotificationChannelsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentList
+		var dest ObservabilityAlertsNotificationChannelList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30480,22 +32791,22 @@ func ParseListEnvironmentsResp(rsp *http.Response) (*ListEnvironmentsResp, error
 	return response, nil
 }
 
-// ParseCreateEnvironmentResp parses an HTTP response from a CreateEnvironmentWithResponse call
-func ParseCreateEnvironmentResp(rsp *http.Response) (*CreateEnvironmentResp, error) {
+// ParseCreateObservabilityAlertsNotificationChannelResp parses an HTTP response from a CreateObservabilityAlertsNotificationChannelWithResponse call
+func ParseCreateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*CreateObservabilityAlertsNotificationChannelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEnvironmentResp{
+	response := &CreateObservabilityAlertsNotificationChannelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Environment
+		var dest ObservabilityAlertsNotificationChannel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30548,15 +32859,15 @@ func ParseCreateEnvironmentResp(rsp *http.Response) (*CreateEnvironmentResp, err
 	return response, nil
 }
 
-// ParseDeleteEnvironmentResp parses an HTTP response from a DeleteEnvironmentWithResponse call
-func ParseDeleteEnvironmentResp(rsp *http.Response) (*DeleteEnvironmentResp, error) {
+// ParseDeleteObservabilityAlertsNotificationChannelResp parses an HTTP response from a DeleteObservabilityAlertsNotificationChannelWithResponse call
+func ParseDeleteObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*DeleteObservabilityAlertsNotificationChannelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEnvironmentResp{
+	response := &DeleteObservabilityAlertsNotificationChannelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -30595,22 +32906,22 @@ func ParseDeleteEnvironmentResp(rsp *http.Response) (*DeleteEnvironmentResp, err
 	return response, nil
 }
 
-// ParseGetEnvironmentResp parses an HTTP response from a GetEnvironmentWithResponse call
-func ParseGetEnvironmentResp(rsp *http.Response) (*GetEnvironmentResp, error) {
+// ParseGetObservabilityAlertsNotificationChannelResp parses an HTTP response from a GetObservabilityAlertsNotificationChannelWithResponse call
+func ParseGetObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*GetObservabilityAlertsNotificationChannelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentResp{
+	response := &GetObservabilityAlertsNotificationChannelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest ObservabilityAlertsNotificationChannel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30649,22 +32960,22 @@ func ParseGetEnvironmentResp(rsp *http.Response) (*GetEnvironmentResp, error) {
 	return response, nil
 }
 
-// ParseUpdateEnvironmentResp parses an HTTP response from a UpdateEnvironmentWithResponse call
-func ParseUpdateEnvironmentResp(rsp *http.Response) (*UpdateEnvironmentResp, error) {
+// ParseUpdateObservabilityAlertsNotificationChannelResp parses an HTTP response from a UpdateObservabilityAlertsNotificationChannelWithResponse call
+func ParseUpdateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*UpdateObservabilityAlertsNotificationChannelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEnvironmentResp{
+	response := &UpdateObservabilityAlertsNotificationChannelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest ObservabilityAlertsNotificationChannel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30698,13 +33009,6 @@ func ParseUpdateEnvironmentResp(rsp *http.Response) (*UpdateEnvironmentResp, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -30724,22 +33028,22 @@ func ParseUpdateEnvironmentResp(rsp *http.Response) (*UpdateEnvironmentResp, err
 	return response, nil
 }
 
-// ParseListObservabilityAlertsNotificationChannelsResp parses an HTTP response from a ListObservabilityAlertsNotificationChannelsWithResponse call
-func ParseListObservabilityAlertsNotificationChannelsResp(rsp *http.Response) (*ListObservabilityAlertsNotificationChannelsResp, error) {
+// ParseListObservabilityPlanesResp parses an HTTP response from a ListObservabilityPlanesWithResponse call
+func ParseListObservabilityPlanesResp(rsp *http.Response) (*ListObservabilityPlanesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListObservabilityAlertsNotificationChannelsResp{
+	response := &ListObservabilityPlanesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ObservabilityAlertsNotificationChannelList
+		var dest ObservabilityPlaneList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30778,22 +33082,22 @@ func ParseListObservabilityAlertsNotificationChannelsResp(rsp *http.Response) (*
 	return response, nil
 }
 
-// ParseCreateObservabilityAlertsNotificationChannelResp parses an HTTP response from a CreateObservabilityAlertsNotificationChannelWithResponse call
-func ParseCreateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*CreateObservabilityAlertsNotificationChannelResp, error) {
+// ParseCreateObservabilityPlaneResp parses an HTTP response from a CreateObservabilityPlaneWithResponse call
+func ParseCreateObservabilityPlaneResp(rsp *http.Response) (*CreateObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateObservabilityAlertsNotificationChannelResp{
+	response := &CreateObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ObservabilityAlertsNotificationChannel
+		var dest ObservabilityPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30846,15 +33150,15 @@ func ParseCreateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (
 	return response, nil
 }
 
-// ParseDeleteObservabilityAlertsNotificationChannelResp parses an HTTP response from a DeleteObservabilityAlertsNotificationChannelWithResponse call
-func ParseDeleteObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*DeleteObservabilityAlertsNotificationChannelResp, error) {
+// ParseDeleteObservabilityPlaneResp parses an HTTP response from a DeleteObservabilityPlaneWithResponse call
+func ParseDeleteObservabilityPlaneResp(rsp *http.Response) (*DeleteObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteObservabilityAlertsNotificationChannelResp{
+	response := &DeleteObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -30893,22 +33197,22 @@ func ParseDeleteObservabilityAlertsNotificationChannelResp(rsp *http.Response) (
 	return response, nil
 }
 
-// ParseGetObservabilityAlertsNotificationChannelResp parses an HTTP response from a GetObservabilityAlertsNotificationChannelWithResponse call
-func ParseGetObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*GetObservabilityAlertsNotificationChannelResp, error) {
+// ParseGetObservabilityPlaneResp parses an HTTP response from a GetObservabilityPlaneWithResponse call
+func ParseGetObservabilityPlaneResp(rsp *http.Response) (*GetObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetObservabilityAlertsNotificationChannelResp{
+	response := &GetObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ObservabilityAlertsNotificationChannel
+		var dest ObservabilityPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30947,22 +33251,22 @@ func ParseGetObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*Ge
 	return response, nil
 }
 
-// ParseUpdateObservabilityAlertsNotificationChannelResp parses an HTTP response from a UpdateObservabilityAlertsNotificationChannelWithResponse call
-func ParseUpdateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (*UpdateObservabilityAlertsNotificationChannelResp, error) {
+// ParseUpdateObservabilityPlaneResp parses an HTTP response from a UpdateObservabilityPlaneWithResponse call
+func ParseUpdateObservabilityPlaneResp(rsp *http.Response) (*UpdateObservabilityPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateObservabilityAlertsNotificationChannelResp{
+	response := &UpdateObservabilityPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ObservabilityAlertsNotificationChannel
+		var dest ObservabilityPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30996,6 +33300,13 @@ func ParseUpdateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31015,22 +33326,22 @@ func ParseUpdateObservabilityAlertsNotificationChannelResp(rsp *http.Response) (
 	return response, nil
 }
 
-// ParseListObservabilityPlanesResp parses an HTTP response from a ListObservabilityPlanesWithResponse call
-func ParseListObservabilityPlanesResp(rsp *http.Response) (*ListObservabilityPlanesResp, error) {
+// ParseListProjectReleaseBindingsResp parses an HTTP response from a ListProjectReleaseBindingsWithResponse call
+func ParseListProjectReleaseBindingsResp(rsp *http.Response) (*ListProjectReleaseBindingsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListObservabilityPlanesResp{
+	response := &ListProjectReleaseBindingsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ObservabilityPlaneList
+		var dest ProjectReleaseBindingList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31069,22 +33380,22 @@ func ParseListObservabilityPlanesResp(rsp *http.Response) (*ListObservabilityPla
 	return response, nil
 }
 
-// ParseCreateObservabilityPlaneResp parses an HTTP response from a CreateObservabilityPlaneWithResponse call
-func ParseCreateObservabilityPlaneResp(rsp *http.Response) (*CreateObservabilityPlaneResp, error) {
+// ParseCreateProjectReleaseBindingResp parses an HTTP response from a CreateProjectReleaseBindingWithResponse call
+func ParseCreateProjectReleaseBindingResp(rsp *http.Response) (*CreateProjectReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateObservabilityPlaneResp{
+	response := &CreateProjectReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ObservabilityPlane
+		var dest ProjectReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31118,13 +33429,6 @@ func ParseCreateObservabilityPlaneResp(rsp *http.Response) (*CreateObservability
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31137,15 +33441,15 @@ func ParseCreateObservabilityPlaneResp(rsp *http.Response) (*CreateObservability
 	return response, nil
 }
 
-// ParseDeleteObservabilityPlaneResp parses an HTTP response from a DeleteObservabilityPlaneWithResponse call
-func ParseDeleteObservabilityPlaneResp(rsp *http.Response) (*DeleteObservabilityPlaneResp, error) {
+// ParseDeleteProjectReleaseBindingResp parses an HTTP response from a DeleteProjectReleaseBindingWithResponse call
+func ParseDeleteProjectReleaseBindingResp(rsp *http.Response) (*DeleteProjectReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteObservabilityPlaneResp{
+	response := &DeleteProjectReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -31184,22 +33488,22 @@ func ParseDeleteObservabilityPlaneResp(rsp *http.Response) (*DeleteObservability
 	return response, nil
 }
 
-// ParseGetObservabilityPlaneResp parses an HTTP response from a GetObservabilityPlaneWithResponse call
-func ParseGetObservabilityPlaneResp(rsp *http.Response) (*GetObservabilityPlaneResp, error) {
+// ParseGetProjectReleaseBindingResp parses an HTTP response from a GetProjectReleaseBindingWithResponse call
+func ParseGetProjectReleaseBindingResp(rsp *http.Response) (*GetProjectReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetObservabilityPlaneResp{
+	response := &GetProjectReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ObservabilityPlane
+		var dest ProjectReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31238,22 +33542,22 @@ func ParseGetObservabilityPlaneResp(rsp *http.Response) (*GetObservabilityPlaneR
 	return response, nil
 }
 
-// ParseUpdateObservabilityPlaneResp parses an HTTP response from a UpdateObservabilityPlaneWithResponse call
-func ParseUpdateObservabilityPlaneResp(rsp *http.Response) (*UpdateObservabilityPlaneResp, error) {
+// ParseUpdateProjectReleaseBindingResp parses an HTTP response from a UpdateProjectReleaseBindingWithResponse call
+func ParseUpdateProjectReleaseBindingResp(rsp *http.Response) (*UpdateProjectReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateObservabilityPlaneResp{
+	response := &UpdateProjectReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ObservabilityPlane
+		var dest ProjectReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31287,20 +33591,6 @@ func ParseUpdateObservabilityPlaneResp(rsp *http.Response) (*UpdateObservability
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31313,22 +33603,22 @@ func ParseUpdateObservabilityPlaneResp(rsp *http.Response) (*UpdateObservability
 	return response, nil
 }
 
-// ParseListProjectReleaseBindingsResp parses an HTTP response from a ListProjectReleaseBindingsWithResponse call
-func ParseListProjectReleaseBindingsResp(rsp *http.Response) (*ListProjectReleaseBindingsResp, error) {
+// ParseListProjectReleasesResp parses an HTTP response from a ListProjectReleasesWithResponse call
+func ParseListProjectReleasesResp(rsp *http.Response) (*ListProjectReleasesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectReleaseBindingsResp{
+	response := &ListProjectReleasesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectReleaseBindingList
+		var dest ProjectReleaseList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31367,22 +33657,22 @@ func ParseListProjectReleaseBindingsResp(rsp *http.Response) (*ListProjectReleas
 	return response, nil
 }
 
-// ParseCreateProjectReleaseBindingResp parses an HTTP response from a CreateProjectReleaseBindingWithResponse call
-func ParseCreateProjectReleaseBindingResp(rsp *http.Response) (*CreateProjectReleaseBindingResp, error) {
+// ParseCreateProjectReleaseResp parses an HTTP response from a CreateProjectReleaseWithResponse call
+func ParseCreateProjectReleaseResp(rsp *http.Response) (*CreateProjectReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectReleaseBindingResp{
+	response := &CreateProjectReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectReleaseBinding
+		var dest ProjectRelease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31428,15 +33718,15 @@ func ParseCreateProjectReleaseBindingResp(rsp *http.Response) (*CreateProjectRel
 	return response, nil
 }
 
-// ParseDeleteProjectReleaseBindingResp parses an HTTP response from a DeleteProjectReleaseBindingWithResponse call
-func ParseDeleteProjectReleaseBindingResp(rsp *http.Response) (*DeleteProjectReleaseBindingResp, error) {
+// ParseDeleteProjectReleaseResp parses an HTTP response from a DeleteProjectReleaseWithResponse call
+func ParseDeleteProjectReleaseResp(rsp *http.Response) (*DeleteProjectReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectReleaseBindingResp{
+	response := &DeleteProjectReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -31475,22 +33765,22 @@ func ParseDeleteProjectReleaseBindingResp(rsp *http.Response) (*DeleteProjectRel
 	return response, nil
 }
 
-// ParseGetProjectReleaseBindingResp parses an HTTP response from a GetProjectReleaseBindingWithResponse call
-func ParseGetProjectReleaseBindingResp(rsp *http.Response) (*GetProjectReleaseBindingResp, error) {
+// ParseGetProjectReleaseResp parses an HTTP response from a GetProjectReleaseWithResponse call
+func ParseGetProjectReleaseResp(rsp *http.Response) (*GetProjectReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectReleaseBindingResp{
+	response := &GetProjectReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectReleaseBinding
+		var dest ProjectRelease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31529,22 +33819,22 @@ func ParseGetProjectReleaseBindingResp(rsp *http.Response) (*GetProjectReleaseBi
 	return response, nil
 }
 
-// ParseUpdateProjectReleaseBindingResp parses an HTTP response from a UpdateProjectReleaseBindingWithResponse call
-func ParseUpdateProjectReleaseBindingResp(rsp *http.Response) (*UpdateProjectReleaseBindingResp, error) {
+// ParseListProjectsResp parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResp(rsp *http.Response) (*ListProjectsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectReleaseBindingResp{
+	response := &ListProjectsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectReleaseBinding
+		var dest ProjectList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31590,26 +33880,26 @@ func ParseUpdateProjectReleaseBindingResp(rsp *http.Response) (*UpdateProjectRel
 	return response, nil
 }
 
-// ParseListProjectReleasesResp parses an HTTP response from a ListProjectReleasesWithResponse call
-func ParseListProjectReleasesResp(rsp *http.Response) (*ListProjectReleasesResp, error) {
+// ParseCreateProjectResp parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResp(rsp *http.Response) (*CreateProjectResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectReleasesResp{
+	response := &CreateProjectResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectReleaseList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -31632,6 +33922,20 @@ func ParseListProjectReleasesResp(rsp *http.Response) (*ListProjectReleasesResp,
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31644,34 +33948,20 @@ func ParseListProjectReleasesResp(rsp *http.Response) (*ListProjectReleasesResp,
 	return response, nil
 }
 
-// ParseCreateProjectReleaseResp parses an HTTP response from a CreateProjectReleaseWithResponse call
-func ParseCreateProjectReleaseResp(rsp *http.Response) (*CreateProjectReleaseResp, error) {
+// ParseDeleteProjectResp parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResp(rsp *http.Response) (*DeleteProjectResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectReleaseResp{
+	response := &DeleteProjectResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectRelease
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31686,12 +33976,12 @@ func ParseCreateProjectReleaseResp(rsp *http.Response) (*CreateProjectReleaseRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -31705,20 +33995,27 @@ func ParseCreateProjectReleaseResp(rsp *http.Response) (*CreateProjectReleaseRes
 	return response, nil
 }
 
-// ParseDeleteProjectReleaseResp parses an HTTP response from a DeleteProjectReleaseWithResponse call
-func ParseDeleteProjectReleaseResp(rsp *http.Response) (*DeleteProjectReleaseResp, error) {
+// ParseGetProjectResp parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResp(rsp *http.Response) (*GetProjectResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectReleaseResp{
+	response := &GetProjectResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31752,27 +34049,34 @@ func ParseDeleteProjectReleaseResp(rsp *http.Response) (*DeleteProjectReleaseRes
 	return response, nil
 }
 
-// ParseGetProjectReleaseResp parses an HTTP response from a GetProjectReleaseWithResponse call
-func ParseGetProjectReleaseResp(rsp *http.Response) (*GetProjectReleaseResp, error) {
+// ParseUpdateProjectResp parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResp(rsp *http.Response) (*UpdateProjectResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectReleaseResp{
+	response := &UpdateProjectResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRelease
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31794,6 +34098,20 @@ func ParseGetProjectReleaseResp(rsp *http.Response) (*GetProjectReleaseResp, err
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31806,22 +34124,22 @@ func ParseGetProjectReleaseResp(rsp *http.Response) (*GetProjectReleaseResp, err
 	return response, nil
 }
 
-// ParseListProjectsResp parses an HTTP response from a ListProjectsWithResponse call
-func ParseListProjectsResp(rsp *http.Response) (*ListProjectsResp, error) {
+// ParseListProjectTypesResp parses an HTTP response from a ListProjectTypesWithResponse call
+func ParseListProjectTypesResp(rsp *http.Response) (*ListProjectTypesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectsResp{
+	response := &ListProjectTypesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectList
+		var dest ProjectTypeList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31848,13 +34166,6 @@ func ParseListProjectsResp(rsp *http.Response) (*ListProjectsResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31867,22 +34178,22 @@ func ParseListProjectsResp(rsp *http.Response) (*ListProjectsResp, error) {
 	return response, nil
 }
 
-// ParseCreateProjectResp parses an HTTP response from a CreateProjectWithResponse call
-func ParseCreateProjectResp(rsp *http.Response) (*CreateProjectResp, error) {
+// ParseCreateProjectTypeResp parses an HTTP response from a CreateProjectTypeWithResponse call
+func ParseCreateProjectTypeResp(rsp *http.Response) (*CreateProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectResp{
+	response := &CreateProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Project
+		var dest ProjectType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -31916,13 +34227,6 @@ func ParseCreateProjectResp(rsp *http.Response) (*CreateProjectResp, error) {
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -31935,15 +34239,15 @@ func ParseCreateProjectResp(rsp *http.Response) (*CreateProjectResp, error) {
 	return response, nil
 }
 
-// ParseDeleteProjectResp parses an HTTP response from a DeleteProjectWithResponse call
-func ParseDeleteProjectResp(rsp *http.Response) (*DeleteProjectResp, error) {
+// ParseDeleteProjectTypeResp parses an HTTP response from a DeleteProjectTypeWithResponse call
+func ParseDeleteProjectTypeResp(rsp *http.Response) (*DeleteProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectResp{
+	response := &DeleteProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -31982,22 +34286,22 @@ func ParseDeleteProjectResp(rsp *http.Response) (*DeleteProjectResp, error) {
 	return response, nil
 }
 
-// ParseGetProjectResp parses an HTTP response from a GetProjectWithResponse call
-func ParseGetProjectResp(rsp *http.Response) (*GetProjectResp, error) {
+// ParseGetProjectTypeResp parses an HTTP response from a GetProjectTypeWithResponse call
+func ParseGetProjectTypeResp(rsp *http.Response) (*GetProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectResp{
+	response := &GetProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+		var dest ProjectType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32036,22 +34340,22 @@ func ParseGetProjectResp(rsp *http.Response) (*GetProjectResp, error) {
 	return response, nil
 }
 
-// ParseUpdateProjectResp parses an HTTP response from a UpdateProjectWithResponse call
-func ParseUpdateProjectResp(rsp *http.Response) (*UpdateProjectResp, error) {
+// ParseUpdateProjectTypeResp parses an HTTP response from a UpdateProjectTypeWithResponse call
+func ParseUpdateProjectTypeResp(rsp *http.Response) (*UpdateProjectTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectResp{
+	response := &UpdateProjectTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+		var dest ProjectType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32092,12 +34396,59 @@ func ParseUpdateProjectResp(rsp *http.Response) (*UpdateProjectResp, error) {
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectTypeSchemaResp parses an HTTP response from a GetProjectTypeSchemaWithResponse call
+func ParseGetProjectTypeSchemaResp(rsp *http.Response) (*GetProjectTypeSchemaResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectTypeSchemaResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -32111,22 +34462,22 @@ func ParseUpdateProjectResp(rsp *http.Response) (*UpdateProjectResp, error) {
 	return response, nil
 }
 
-// ParseListProjectTypesResp parses an HTTP response from a ListProjectTypesWithResponse call
-func ParseListProjectTypesResp(rsp *http.Response) (*ListProjectTypesResp, error) {
+// ParseListReleaseBindingsResp parses an HTTP response from a ListReleaseBindingsWithResponse call
+func ParseListReleaseBindingsResp(rsp *http.Response) (*ListReleaseBindingsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectTypesResp{
+	response := &ListReleaseBindingsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTypeList
+		var dest ReleaseBindingList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32153,6 +34504,13 @@ func ParseListProjectTypesResp(rsp *http.Response) (*ListProjectTypesResp, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32165,22 +34523,22 @@ func ParseListProjectTypesResp(rsp *http.Response) (*ListProjectTypesResp, error
 	return response, nil
 }
 
-// ParseCreateProjectTypeResp parses an HTTP response from a CreateProjectTypeWithResponse call
-func ParseCreateProjectTypeResp(rsp *http.Response) (*CreateProjectTypeResp, error) {
+// ParseCreateReleaseBindingResp parses an HTTP response from a CreateReleaseBindingWithResponse call
+func ParseCreateReleaseBindingResp(rsp *http.Response) (*CreateReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectTypeResp{
+	response := &CreateReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectType
+		var dest ReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32214,6 +34572,13 @@ func ParseCreateProjectTypeResp(rsp *http.Response) (*CreateProjectTypeResp, err
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32226,15 +34591,15 @@ func ParseCreateProjectTypeResp(rsp *http.Response) (*CreateProjectTypeResp, err
 	return response, nil
 }
 
-// ParseDeleteProjectTypeResp parses an HTTP response from a DeleteProjectTypeWithResponse call
-func ParseDeleteProjectTypeResp(rsp *http.Response) (*DeleteProjectTypeResp, error) {
+// ParseDeleteReleaseBindingResp parses an HTTP response from a DeleteReleaseBindingWithResponse call
+func ParseDeleteReleaseBindingResp(rsp *http.Response) (*DeleteReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectTypeResp{
+	response := &DeleteReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -32273,22 +34638,22 @@ func ParseDeleteProjectTypeResp(rsp *http.Response) (*DeleteProjectTypeResp, err
 	return response, nil
 }
 
-// ParseGetProjectTypeResp parses an HTTP response from a GetProjectTypeWithResponse call
-func ParseGetProjectTypeResp(rsp *http.Response) (*GetProjectTypeResp, error) {
+// ParseGetReleaseBindingResp parses an HTTP response from a GetReleaseBindingWithResponse call
+func ParseGetReleaseBindingResp(rsp *http.Response) (*GetReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectTypeResp{
+	response := &GetReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectType
+		var dest ReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32327,22 +34692,22 @@ func ParseGetProjectTypeResp(rsp *http.Response) (*GetProjectTypeResp, error) {
 	return response, nil
 }
 
-// ParseUpdateProjectTypeResp parses an HTTP response from a UpdateProjectTypeWithResponse call
-func ParseUpdateProjectTypeResp(rsp *http.Response) (*UpdateProjectTypeResp, error) {
+// ParseUpdateReleaseBindingResp parses an HTTP response from a UpdateReleaseBindingWithResponse call
+func ParseUpdateReleaseBindingResp(rsp *http.Response) (*UpdateReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectTypeResp{
+	response := &UpdateReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectType
+		var dest ReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32376,12 +34741,12 @@ func ParseUpdateProjectTypeResp(rsp *http.Response) (*UpdateProjectTypeResp, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -32395,27 +34760,34 @@ func ParseUpdateProjectTypeResp(rsp *http.Response) (*UpdateProjectTypeResp, err
 	return response, nil
 }
 
-// ParseGetProjectTypeSchemaResp parses an HTTP response from a GetProjectTypeSchemaWithResponse call
-func ParseGetProjectTypeSchemaResp(rsp *http.Response) (*GetProjectTypeSchemaResp, error) {
+// ParseGetReleaseBindingK8sResourceEventsResp parses an HTTP response from a GetReleaseBindingK8sResourceEventsWithResponse call
+func ParseGetReleaseBindingK8sResourceEventsResp(rsp *http.Response) (*GetReleaseBindingK8sResourceEventsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectTypeSchemaResp{
+	response := &GetReleaseBindingK8sResourceEventsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
+		var dest ResourceEventsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32449,22 +34821,22 @@ func ParseGetProjectTypeSchemaResp(rsp *http.Response) (*GetProjectTypeSchemaRes
 	return response, nil
 }
 
-// ParseListReleaseBindingsResp parses an HTTP response from a ListReleaseBindingsWithResponse call
-func ParseListReleaseBindingsResp(rsp *http.Response) (*ListReleaseBindingsResp, error) {
+// ParseGetReleaseBindingK8sResourceLogsResp parses an HTTP response from a GetReleaseBindingK8sResourceLogsWithResponse call
+func ParseGetReleaseBindingK8sResourceLogsResp(rsp *http.Response) (*GetReleaseBindingK8sResourceLogsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListReleaseBindingsResp{
+	response := &GetReleaseBindingK8sResourceLogsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReleaseBindingList
+		var dest ResourcePodLogsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32510,26 +34882,26 @@ func ParseListReleaseBindingsResp(rsp *http.Response) (*ListReleaseBindingsResp,
 	return response, nil
 }
 
-// ParseCreateReleaseBindingResp parses an HTTP response from a CreateReleaseBindingWithResponse call
-func ParseCreateReleaseBindingResp(rsp *http.Response) (*CreateReleaseBindingResp, error) {
+// ParseGetReleaseBindingK8sResourceTreeResp parses an HTTP response from a GetReleaseBindingK8sResourceTreeWithResponse call
+func ParseGetReleaseBindingK8sResourceTreeResp(rsp *http.Response) (*GetReleaseBindingK8sResourceTreeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateReleaseBindingResp{
+	response := &GetReleaseBindingK8sResourceTreeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ReleaseBinding
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest K8sResourceTreeResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -32552,60 +34924,6 @@ func ParseCreateReleaseBindingResp(rsp *http.Response) (*CreateReleaseBindingRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseDeleteReleaseBindingResp parses an HTTP response from a DeleteReleaseBindingWithResponse call
-func ParseDeleteReleaseBindingResp(rsp *http.Response) (*DeleteReleaseBindingResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteReleaseBindingResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32625,27 +34943,34 @@ func ParseDeleteReleaseBindingResp(rsp *http.Response) (*DeleteReleaseBindingRes
 	return response, nil
 }
 
-// ParseGetReleaseBindingResp parses an HTTP response from a GetReleaseBindingWithResponse call
-func ParseGetReleaseBindingResp(rsp *http.Response) (*GetReleaseBindingResp, error) {
+// ParseListResourceReleaseBindingsResp parses an HTTP response from a ListResourceReleaseBindingsWithResponse call
+func ParseListResourceReleaseBindingsResp(rsp *http.Response) (*ListResourceReleaseBindingsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetReleaseBindingResp{
+	response := &ListResourceReleaseBindingsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReleaseBinding
+		var dest ResourceReleaseBindingList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32660,13 +34985,6 @@ func ParseGetReleaseBindingResp(rsp *http.Response) (*GetReleaseBindingResp, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32679,26 +34997,26 @@ func ParseGetReleaseBindingResp(rsp *http.Response) (*GetReleaseBindingResp, err
 	return response, nil
 }
 
-// ParseUpdateReleaseBindingResp parses an HTTP response from a UpdateReleaseBindingWithResponse call
-func ParseUpdateReleaseBindingResp(rsp *http.Response) (*UpdateReleaseBindingResp, error) {
+// ParseCreateResourceReleaseBindingResp parses an HTTP response from a CreateResourceReleaseBindingWithResponse call
+func ParseCreateResourceReleaseBindingResp(rsp *http.Response) (*CreateResourceReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateReleaseBindingResp{
+	response := &CreateResourceReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReleaseBinding
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResourceReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -32721,19 +35039,12 @@ func ParseUpdateReleaseBindingResp(rsp *http.Response) (*UpdateReleaseBindingRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -32747,34 +35058,20 @@ func ParseUpdateReleaseBindingResp(rsp *http.Response) (*UpdateReleaseBindingRes
 	return response, nil
 }
 
-// ParseGetReleaseBindingK8sResourceEventsResp parses an HTTP response from a GetReleaseBindingK8sResourceEventsWithResponse call
-func ParseGetReleaseBindingK8sResourceEventsResp(rsp *http.Response) (*GetReleaseBindingK8sResourceEventsResp, error) {
+// ParseDeleteResourceReleaseBindingResp parses an HTTP response from a DeleteResourceReleaseBindingWithResponse call
+func ParseDeleteResourceReleaseBindingResp(rsp *http.Response) (*DeleteResourceReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetReleaseBindingK8sResourceEventsResp{
+	response := &DeleteResourceReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceEventsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32808,34 +35105,27 @@ func ParseGetReleaseBindingK8sResourceEventsResp(rsp *http.Response) (*GetReleas
 	return response, nil
 }
 
-// ParseGetReleaseBindingK8sResourceLogsResp parses an HTTP response from a GetReleaseBindingK8sResourceLogsWithResponse call
-func ParseGetReleaseBindingK8sResourceLogsResp(rsp *http.Response) (*GetReleaseBindingK8sResourceLogsResp, error) {
+// ParseGetResourceReleaseBindingResp parses an HTTP response from a GetResourceReleaseBindingWithResponse call
+func ParseGetResourceReleaseBindingResp(rsp *http.Response) (*GetResourceReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetReleaseBindingK8sResourceLogsResp{
+	response := &GetResourceReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourcePodLogsResponse
+		var dest ResourceReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -32869,22 +35159,22 @@ func ParseGetReleaseBindingK8sResourceLogsResp(rsp *http.Response) (*GetReleaseB
 	return response, nil
 }
 
-// ParseGetReleaseBindingK8sResourceTreeResp parses an HTTP response from a GetReleaseBindingK8sResourceTreeWithResponse call
-func ParseGetReleaseBindingK8sResourceTreeResp(rsp *http.Response) (*GetReleaseBindingK8sResourceTreeResp, error) {
+// ParseUpdateResourceReleaseBindingResp parses an HTTP response from a UpdateResourceReleaseBindingWithResponse call
+func ParseUpdateResourceReleaseBindingResp(rsp *http.Response) (*UpdateResourceReleaseBindingResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetReleaseBindingK8sResourceTreeResp{
+	response := &UpdateResourceReleaseBindingResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest K8sResourceTreeResponse
+		var dest ResourceReleaseBinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32930,22 +35220,22 @@ func ParseGetReleaseBindingK8sResourceTreeResp(rsp *http.Response) (*GetReleaseB
 	return response, nil
 }
 
-// ParseListResourceReleaseBindingsResp parses an HTTP response from a ListResourceReleaseBindingsWithResponse call
-func ParseListResourceReleaseBindingsResp(rsp *http.Response) (*ListResourceReleaseBindingsResp, error) {
+// ParseListResourceReleasesResp parses an HTTP response from a ListResourceReleasesWithResponse call
+func ParseListResourceReleasesResp(rsp *http.Response) (*ListResourceReleasesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListResourceReleaseBindingsResp{
+	response := &ListResourceReleasesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceReleaseBindingList
+		var dest ResourceReleaseList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -32984,22 +35274,22 @@ func ParseListResourceReleaseBindingsResp(rsp *http.Response) (*ListResourceRele
 	return response, nil
 }
 
-// ParseCreateResourceReleaseBindingResp parses an HTTP response from a CreateResourceReleaseBindingWithResponse call
-func ParseCreateResourceReleaseBindingResp(rsp *http.Response) (*CreateResourceReleaseBindingResp, error) {
+// ParseCreateResourceReleaseResp parses an HTTP response from a CreateResourceReleaseWithResponse call
+func ParseCreateResourceReleaseResp(rsp *http.Response) (*CreateResourceReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResourceReleaseBindingResp{
+	response := &CreateResourceReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResourceReleaseBinding
+		var dest ResourceRelease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33045,15 +35335,15 @@ func ParseCreateResourceReleaseBindingResp(rsp *http.Response) (*CreateResourceR
 	return response, nil
 }
 
-// ParseDeleteResourceReleaseBindingResp parses an HTTP response from a DeleteResourceReleaseBindingWithResponse call
-func ParseDeleteResourceReleaseBindingResp(rsp *http.Response) (*DeleteResourceReleaseBindingResp, error) {
+// ParseDeleteResourceReleaseResp parses an HTTP response from a DeleteResourceReleaseWithResponse call
+func ParseDeleteResourceReleaseResp(rsp *http.Response) (*DeleteResourceReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteResourceReleaseBindingResp{
+	response := &DeleteResourceReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -33092,22 +35382,22 @@ func ParseDeleteResourceReleaseBindingResp(rsp *http.Response) (*DeleteResourceR
 	return response, nil
 }
 
-// ParseGetResourceReleaseBindingResp parses an HTTP response from a GetResourceReleaseBindingWithResponse call
-func ParseGetResourceReleaseBindingResp(rsp *http.Response) (*GetResourceReleaseBindingResp, error) {
+// ParseGetResourceReleaseResp parses an HTTP response from a GetResourceReleaseWithResponse call
+func ParseGetResourceReleaseResp(rsp *http.Response) (*GetResourceReleaseResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResourceReleaseBindingResp{
+	response := &GetResourceReleaseResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceReleaseBinding
+		var dest ResourceRelease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33146,22 +35436,22 @@ func ParseGetResourceReleaseBindingResp(rsp *http.Response) (*GetResourceRelease
 	return response, nil
 }
 
-// ParseUpdateResourceReleaseBindingResp parses an HTTP response from a UpdateResourceReleaseBindingWithResponse call
-func ParseUpdateResourceReleaseBindingResp(rsp *http.Response) (*UpdateResourceReleaseBindingResp, error) {
+// ParseListResourcesResp parses an HTTP response from a ListResourcesWithResponse call
+func ParseListResourcesResp(rsp *http.Response) (*ListResourcesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateResourceReleaseBindingResp{
+	response := &ListResourcesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceReleaseBinding
+		var dest ResourceInstanceList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33207,26 +35497,26 @@ func ParseUpdateResourceReleaseBindingResp(rsp *http.Response) (*UpdateResourceR
 	return response, nil
 }
 
-// ParseListResourceReleasesResp parses an HTTP response from a ListResourceReleasesWithResponse call
-func ParseListResourceReleasesResp(rsp *http.Response) (*ListResourceReleasesResp, error) {
+// ParseCreateResourceResp parses an HTTP response from a CreateResourceWithResponse call
+func ParseCreateResourceResp(rsp *http.Response) (*CreateResourceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListResourceReleasesResp{
+	response := &CreateResourceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceReleaseList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResourceInstance
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -33249,6 +35539,13 @@ func ParseListResourceReleasesResp(rsp *http.Response) (*ListResourceReleasesRes
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33261,34 +35558,20 @@ func ParseListResourceReleasesResp(rsp *http.Response) (*ListResourceReleasesRes
 	return response, nil
 }
 
-// ParseCreateResourceReleaseResp parses an HTTP response from a CreateResourceReleaseWithResponse call
-func ParseCreateResourceReleaseResp(rsp *http.Response) (*CreateResourceReleaseResp, error) {
+// ParseDeleteResourceResp parses an HTTP response from a DeleteResourceWithResponse call
+func ParseDeleteResourceResp(rsp *http.Response) (*DeleteResourceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResourceReleaseResp{
+	response := &DeleteResourceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResourceRelease
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33303,12 +35586,12 @@ func ParseCreateResourceReleaseResp(rsp *http.Response) (*CreateResourceReleaseR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -33322,20 +35605,27 @@ func ParseCreateResourceReleaseResp(rsp *http.Response) (*CreateResourceReleaseR
 	return response, nil
 }
 
-// ParseDeleteResourceReleaseResp parses an HTTP response from a DeleteResourceReleaseWithResponse call
-func ParseDeleteResourceReleaseResp(rsp *http.Response) (*DeleteResourceReleaseResp, error) {
+// ParseGetResourceResp parses an HTTP response from a GetResourceWithResponse call
+func ParseGetResourceResp(rsp *http.Response) (*GetResourceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteResourceReleaseResp{
+	response := &GetResourceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33369,27 +35659,34 @@ func ParseDeleteResourceReleaseResp(rsp *http.Response) (*DeleteResourceReleaseR
 	return response, nil
 }
 
-// ParseGetResourceReleaseResp parses an HTTP response from a GetResourceReleaseWithResponse call
-func ParseGetResourceReleaseResp(rsp *http.Response) (*GetResourceReleaseResp, error) {
+// ParseUpdateResourceResp parses an HTTP response from a UpdateResourceWithResponse call
+func ParseUpdateResourceResp(rsp *http.Response) (*UpdateResourceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResourceReleaseResp{
+	response := &UpdateResourceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceRelease
+		var dest ResourceInstance
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33423,22 +35720,22 @@ func ParseGetResourceReleaseResp(rsp *http.Response) (*GetResourceReleaseResp, e
 	return response, nil
 }
 
-// ParseListResourcesResp parses an HTTP response from a ListResourcesWithResponse call
-func ParseListResourcesResp(rsp *http.Response) (*ListResourcesResp, error) {
+// ParseListResourceTypesResp parses an HTTP response from a ListResourceTypesWithResponse call
+func ParseListResourceTypesResp(rsp *http.Response) (*ListResourceTypesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListResourcesResp{
+	response := &ListResourceTypesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceInstanceList
+		var dest ResourceTypeList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33465,13 +35762,6 @@ func ParseListResourcesResp(rsp *http.Response) (*ListResourcesResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33484,22 +35774,22 @@ func ParseListResourcesResp(rsp *http.Response) (*ListResourcesResp, error) {
 	return response, nil
 }
 
-// ParseCreateResourceResp parses an HTTP response from a CreateResourceWithResponse call
-func ParseCreateResourceResp(rsp *http.Response) (*CreateResourceResp, error) {
+// ParseCreateResourceTypeResp parses an HTTP response from a CreateResourceTypeWithResponse call
+func ParseCreateResourceTypeResp(rsp *http.Response) (*CreateResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResourceResp{
+	response := &CreateResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResourceInstance
+		var dest ResourceType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33545,15 +35835,15 @@ func ParseCreateResourceResp(rsp *http.Response) (*CreateResourceResp, error) {
 	return response, nil
 }
 
-// ParseDeleteResourceResp parses an HTTP response from a DeleteResourceWithResponse call
-func ParseDeleteResourceResp(rsp *http.Response) (*DeleteResourceResp, error) {
+// ParseDeleteResourceTypeResp parses an HTTP response from a DeleteResourceTypeWithResponse call
+func ParseDeleteResourceTypeResp(rsp *http.Response) (*DeleteResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteResourceResp{
+	response := &DeleteResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -33592,22 +35882,22 @@ func ParseDeleteResourceResp(rsp *http.Response) (*DeleteResourceResp, error) {
 	return response, nil
 }
 
-// ParseGetResourceResp parses an HTTP response from a GetResourceWithResponse call
-func ParseGetResourceResp(rsp *http.Response) (*GetResourceResp, error) {
+// ParseGetResourceTypeResp parses an HTTP response from a GetResourceTypeWithResponse call
+func ParseGetResourceTypeResp(rsp *http.Response) (*GetResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResourceResp{
+	response := &GetResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceInstance
+		var dest ResourceType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33646,22 +35936,22 @@ func ParseGetResourceResp(rsp *http.Response) (*GetResourceResp, error) {
 	return response, nil
 }
 
-// ParseUpdateResourceResp parses an HTTP response from a UpdateResourceWithResponse call
-func ParseUpdateResourceResp(rsp *http.Response) (*UpdateResourceResp, error) {
+// ParseUpdateResourceTypeResp parses an HTTP response from a UpdateResourceTypeWithResponse call
+func ParseUpdateResourceTypeResp(rsp *http.Response) (*UpdateResourceTypeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateResourceResp{
+	response := &UpdateResourceTypeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceInstance
+		var dest ResourceType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -33695,6 +35985,13 @@ func ParseUpdateResourceResp(rsp *http.Response) (*UpdateResourceResp, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33707,34 +36004,27 @@ func ParseUpdateResourceResp(rsp *http.Response) (*UpdateResourceResp, error) {
 	return response, nil
 }
 
-// ParseListResourceTypesResp parses an HTTP response from a ListResourceTypesWithResponse call
-func ParseListResourceTypesResp(rsp *http.Response) (*ListResourceTypesResp, error) {
+// ParseGetResourceTypeSchemaResp parses an HTTP response from a GetResourceTypeSchemaWithResponse call
+func ParseGetResourceTypeSchemaResp(rsp *http.Response) (*GetResourceTypeSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListResourceTypesResp{
+	response := &GetResourceTypeSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceTypeList
+		var dest SchemaResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33749,6 +36039,13 @@ func ParseListResourceTypesResp(rsp *http.Response) (*ListResourceTypesResp, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33761,26 +36058,26 @@ func ParseListResourceTypesResp(rsp *http.Response) (*ListResourceTypesResp, err
 	return response, nil
 }
 
-// ParseCreateResourceTypeResp parses an HTTP response from a CreateResourceTypeWithResponse call
-func ParseCreateResourceTypeResp(rsp *http.Response) (*CreateResourceTypeResp, error) {
+// ParseListSecretReferencesResp parses an HTTP response from a ListSecretReferencesWithResponse call
+func ParseListSecretReferencesResp(rsp *http.Response) (*ListSecretReferencesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResourceTypeResp{
+	response := &ListSecretReferencesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResourceType
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretReferenceList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -33803,13 +36100,6 @@ func ParseCreateResourceTypeResp(rsp *http.Response) (*CreateResourceTypeResp, e
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33822,73 +36112,33 @@ func ParseCreateResourceTypeResp(rsp *http.Response) (*CreateResourceTypeResp, e
 	return response, nil
 }
 
-// ParseDeleteResourceTypeResp parses an HTTP response from a DeleteResourceTypeWithResponse call
-func ParseDeleteResourceTypeResp(rsp *http.Response) (*DeleteResourceTypeResp, error) {
+// ParseCreateSecretReferenceResp parses an HTTP response from a CreateSecretReferenceWithResponse call
+func ParseCreateSecretReferenceResp(rsp *http.Response) (*CreateSecretReferenceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteResourceTypeResp{
+	response := &CreateSecretReferenceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SecretReference
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetResourceTypeResp parses an HTTP response from a GetResourceTypeWithResponse call
-func ParseGetResourceTypeResp(rsp *http.Response) (*GetResourceTypeResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetResourceTypeResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON201 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceType
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -33904,12 +36154,19 @@ func ParseGetResourceTypeResp(rsp *http.Response) (*GetResourceTypeResp, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -33923,34 +36180,20 @@ func ParseGetResourceTypeResp(rsp *http.Response) (*GetResourceTypeResp, error)
 	return response, nil
 }
 
-// ParseUpdateResourceTypeResp parses an HTTP response from a UpdateResourceTypeWithResponse call
-func ParseUpdateResourceTypeResp(rsp *http.Response) (*UpdateResourceTypeResp, error) {
+// ParseDeleteSecretReferenceResp parses an HTTP response from a DeleteSecretReferenceWithResponse call
+func ParseDeleteSecretReferenceResp(rsp *http.Response) (*DeleteSecretReferenceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateResourceTypeResp{
+	response := &DeleteSecretReferenceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResourceType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -33972,12 +36215,59 @@ func ParseUpdateResourceTypeResp(rsp *http.Response) (*UpdateResourceTypeResp, e
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSecretReferenceResp parses an HTTP response from a GetSecretReferenceWithResponse call
+func ParseGetSecretReferenceResp(rsp *http.Response) (*GetSecretReferenceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSecretReferenceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretReference
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -33991,27 +36281,34 @@ func ParseUpdateResourceTypeResp(rsp *http.Response) (*UpdateResourceTypeResp, e
 	return response, nil
 }
 
-// ParseGetResourceTypeSchemaResp parses an HTTP response from a GetResourceTypeSchemaWithResponse call
-func ParseGetResourceTypeSchemaResp(rsp *http.Response) (*GetResourceTypeSchemaResp, error) {
+// ParseUpdateSecretReferenceResp parses an HTTP response from a UpdateSecretReferenceWithResponse call
+func ParseUpdateSecretReferenceResp(rsp *http.Response) (*UpdateSecretReferenceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResourceTypeSchemaResp{
+	response := &UpdateSecretReferenceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
+		var dest SecretReference
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34033,6 +36330,13 @@ func ParseGetResourceTypeSchemaResp(rsp *http.Response) (*GetResourceTypeSchemaR
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34045,22 +36349,22 @@ func ParseGetResourceTypeSchemaResp(rsp *http.Response) (*GetResourceTypeSchemaR
 	return response, nil
 }
 
-// ParseListSecretReferencesResp parses an HTTP response from a ListSecretReferencesWithResponse call
-func ParseListSecretReferencesResp(rsp *http.Response) (*ListSecretReferencesResp, error) {
+// ParseListTraitsResp parses an HTTP response from a ListTraitsWithResponse call
+func ParseListTraitsResp(rsp *http.Response) (*ListTraitsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListSecretReferencesResp{
+	response := &ListTraitsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SecretReferenceList
+		var dest TraitList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34099,22 +36403,22 @@ func ParseListSecretReferencesResp(rsp *http.Response) (*ListSecretReferencesRes
 	return response, nil
 }
 
-// ParseCreateSecretReferenceResp parses an HTTP response from a CreateSecretReferenceWithResponse call
-func ParseCreateSecretReferenceResp(rsp *http.Response) (*CreateSecretReferenceResp, error) {
+// ParseCreateTraitResp parses an HTTP response from a CreateTraitWithResponse call
+func ParseCreateTraitResp(rsp *http.Response) (*CreateTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateSecretReferenceResp{
+	response := &CreateTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SecretReference
+		var dest Trait
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34167,15 +36471,15 @@ func ParseCreateSecretReferenceResp(rsp *http.Response) (*CreateSecretReferenceR
 	return response, nil
 }
 
-// ParseDeleteSecretReferenceResp parses an HTTP response from a DeleteSecretReferenceWithResponse call
-func ParseDeleteSecretReferenceResp(rsp *http.Response) (*DeleteSecretReferenceResp, error) {
+// ParseDeleteTraitResp parses an HTTP response from a DeleteTraitWithResponse call
+func ParseDeleteTraitResp(rsp *http.Response) (*DeleteTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteSecretReferenceResp{
+	response := &DeleteTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -34214,22 +36518,22 @@ func ParseDeleteSecretReferenceResp(rsp *http.Response) (*DeleteSecretReferenceR
 	return response, nil
 }
 
-// ParseGetSecretReferenceResp parses an HTTP response from a GetSecretReferenceWithResponse call
-func ParseGetSecretReferenceResp(rsp *http.Response) (*GetSecretReferenceResp, error) {
+// ParseGetTraitResp parses an HTTP response from a GetTraitWithResponse call
+func ParseGetTraitResp(rsp *http.Response) (*GetTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSecretReferenceResp{
+	response := &GetTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SecretReference
+		var dest Trait
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34268,22 +36572,22 @@ func ParseGetSecretReferenceResp(rsp *http.Response) (*GetSecretReferenceResp, e
 	return response, nil
 }
 
-// ParseUpdateSecretReferenceResp parses an HTTP response from a UpdateSecretReferenceWithResponse call
-func ParseUpdateSecretReferenceResp(rsp *http.Response) (*UpdateSecretReferenceResp, error) {
+// ParseUpdateTraitResp parses an HTTP response from a UpdateTraitWithResponse call
+func ParseUpdateTraitResp(rsp *http.Response) (*UpdateTraitResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateSecretReferenceResp{
+	response := &UpdateTraitResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SecretReference
+		var dest Trait
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34317,6 +36621,13 @@ func ParseUpdateSecretReferenceResp(rsp *http.Response) (*UpdateSecretReferenceR
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest UnprocessableContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34336,34 +36647,27 @@ func ParseUpdateSecretReferenceResp(rsp *http.Response) (*UpdateSecretReferenceR
 	return response, nil
 }
 
-// ParseListTraitsResp parses an HTTP response from a ListTraitsWithResponse call
-func ParseListTraitsResp(rsp *http.Response) (*ListTraitsResp, error) {
+// ParseGetTraitSchemaResp parses an HTTP response from a GetTraitSchemaWithResponse call
+func ParseGetTraitSchemaResp(rsp *http.Response) (*GetTraitSchemaResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTraitsResp{
+	response := &GetTraitSchemaResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TraitList
+		var dest SchemaResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34378,6 +36682,13 @@ func ParseListTraitsResp(rsp *http.Response) (*ListTraitsResp, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34390,26 +36701,26 @@ func ParseListTraitsResp(rsp *http.Response) (*ListTraitsResp, error) {
 	return response, nil
 }
 
-// ParseCreateTraitResp parses an HTTP response from a CreateTraitWithResponse call
-func ParseCreateTraitResp(rsp *http.Response) (*CreateTraitResp, error) {
+// ParseListWorkflowPlanesResp parses an HTTP response from a ListWorkflowPlanesWithResponse call
+func ParseListWorkflowPlanesResp(rsp *http.Response) (*ListWorkflowPlanesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTraitResp{
+	response := &ListWorkflowPlanesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Trait
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowPlaneList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -34432,20 +36743,6 @@ func ParseCreateTraitResp(rsp *http.Response) (*CreateTraitResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34458,20 +36755,34 @@ func ParseCreateTraitResp(rsp *http.Response) (*CreateTraitResp, error) {
 	return response, nil
 }
 
-// ParseDeleteTraitResp parses an HTTP response from a DeleteTraitWithResponse call
-func ParseDeleteTraitResp(rsp *http.Response) (*DeleteTraitResp, error) {
+// ParseCreateWorkflowPlaneResp parses an HTTP response from a CreateWorkflowPlaneWithResponse call
+func ParseCreateWorkflowPlaneResp(rsp *http.Response) (*CreateWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTraitResp{
+	response := &CreateWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WorkflowPlane
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34486,12 +36797,19 @@ func ParseDeleteTraitResp(rsp *http.Response) (*DeleteTraitResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -34505,27 +36823,20 @@ func ParseDeleteTraitResp(rsp *http.Response) (*DeleteTraitResp, error) {
 	return response, nil
 }
 
-// ParseGetTraitResp parses an HTTP response from a GetTraitWithResponse call
-func ParseGetTraitResp(rsp *http.Response) (*GetTraitResp, error) {
+// ParseDeleteWorkflowPlaneResp parses an HTTP response from a DeleteWorkflowPlaneWithResponse call
+func ParseDeleteWorkflowPlaneResp(rsp *http.Response) (*DeleteWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTraitResp{
+	response := &DeleteWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Trait
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34559,34 +36870,27 @@ func ParseGetTraitResp(rsp *http.Response) (*GetTraitResp, error) {
 	return response, nil
 }
 
-// ParseUpdateTraitResp parses an HTTP response from a UpdateTraitWithResponse call
-func ParseUpdateTraitResp(rsp *http.Response) (*UpdateTraitResp, error) {
+// ParseGetWorkflowPlaneResp parses an HTTP response from a GetWorkflowPlaneWithResponse call
+func ParseGetWorkflowPlaneResp(rsp *http.Response) (*GetWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTraitResp{
+	response := &GetWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Trait
+		var dest WorkflowPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34608,20 +36912,6 @@ func ParseUpdateTraitResp(rsp *http.Response) (*UpdateTraitResp, error) {
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34634,27 +36924,34 @@ func ParseUpdateTraitResp(rsp *http.Response) (*UpdateTraitResp, error) {
 	return response, nil
 }
 
-// ParseGetTraitSchemaResp parses an HTTP response from a GetTraitSchemaWithResponse call
-func ParseGetTraitSchemaResp(rsp *http.Response) (*GetTraitSchemaResp, error) {
+// ParseUpdateWorkflowPlaneResp parses an HTTP response from a UpdateWorkflowPlaneWithResponse call
+func ParseUpdateWorkflowPlaneResp(rsp *http.Response) (*UpdateWorkflowPlaneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTraitSchemaResp{
+	response := &UpdateWorkflowPlaneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SchemaResponse
+		var dest WorkflowPlane
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34676,6 +36973,20 @@ func ParseGetTraitSchemaResp(rsp *http.Response) (*GetTraitSchemaResp, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34688,22 +36999,22 @@ func ParseGetTraitSchemaResp(rsp *http.Response) (*GetTraitSchemaResp, error) {
 	return response, nil
 }
 
-// ParseListWorkflowPlanesResp parses an HTTP response from a ListWorkflowPlanesWithResponse call
-func ParseListWorkflowPlanesResp(rsp *http.Response) (*ListWorkflowPlanesResp, error) {
+// ParseListWorkflowRunGroupsResp parses an HTTP response from a ListWorkflowRunGroupsWithResponse call
+func ParseListWorkflowRunGroupsResp(rsp *http.Response) (*ListWorkflowRunGroupsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListWorkflowPlanesResp{
+	response := &ListWorkflowRunGroupsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowPlaneList
+		var dest WorkflowRunGroupList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34742,33 +37053,26 @@ func ParseListWorkflowPlanesResp(rsp *http.Response) (*ListWorkflowPlanesResp, e
 	return response, nil
 }
 
-// ParseCreateWorkflowPlaneResp parses an HTTP response from a CreateWorkflowPlaneWithResponse call
-func ParseCreateWorkflowPlaneResp(rsp *http.Response) (*CreateWorkflowPlaneResp, error) {
+// ParseGetWorkflowRunGroupResp parses an HTTP response from a GetWorkflowRunGroupWithResponse call
+func ParseGetWorkflowRunGroupResp(rsp *http.Response) (*GetWorkflowRunGroupResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateWorkflowPlaneResp{
+	response := &GetWorkflowRunGroupResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest WorkflowPlane
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRunGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -34784,19 +37088,12 @@ func ParseCreateWorkflowPlaneResp(rsp *http.Response) (*CreateWorkflowPlaneResp,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
@@ -34810,20 +37107,34 @@ func ParseCreateWorkflowPlaneResp(rsp *http.Response) (*CreateWorkflowPlaneResp,
 	return response, nil
 }
 
-// ParseDeleteWorkflowPlaneResp parses an HTTP response from a DeleteWorkflowPlaneWithResponse call
-func ParseDeleteWorkflowPlaneResp(rsp *http.Response) (*DeleteWorkflowPlaneResp, error) {
+// ParseListWorkflowRunsResp parses an HTTP response from a ListWorkflowRunsWithResponse call
+func ParseListWorkflowRunsResp(rsp *http.Response) (*ListWorkflowRunsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteWorkflowPlaneResp{
+	response := &ListWorkflowRunsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRunList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34838,13 +37149,6 @@ func ParseDeleteWorkflowPlaneResp(rsp *http.Response) (*DeleteWorkflowPlaneResp,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34857,26 +37161,33 @@ func ParseDeleteWorkflowPlaneResp(rsp *http.Response) (*DeleteWorkflowPlaneResp,
 	return response, nil
 }
 
-// ParseGetWorkflowPlaneResp parses an HTTP response from a GetWorkflowPlaneWithResponse call
-func ParseGetWorkflowPlaneResp(rsp *http.Response) (*GetWorkflowPlaneResp, error) {
+// ParseCreateWorkflowRunResp parses an HTTP response from a CreateWorkflowRunWithResponse call
+func ParseCreateWorkflowRunResp(rsp *http.Response) (*CreateWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkflowPlaneResp{
+	response := &CreateWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowPlane
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -34899,6 +37210,13 @@ func ParseGetWorkflowPlaneResp(rsp *http.Response) (*GetWorkflowPlaneResp, error
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest UnprocessableContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34911,34 +37229,20 @@ func ParseGetWorkflowPlaneResp(rsp *http.Response) (*GetWorkflowPlaneResp, error
 	return response, nil
 }
 
-// ParseUpdateWorkflowPlaneResp parses an HTTP response from a UpdateWorkflowPlaneWithResponse call
-func ParseUpdateWorkflowPlaneResp(rsp *http.Response) (*UpdateWorkflowPlaneResp, error) {
+// ParseDeleteWorkflowRunResp parses an HTTP response from a DeleteWorkflowRunWithResponse call
+func ParseDeleteWorkflowRunResp(rsp *http.Response) (*DeleteWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateWorkflowPlaneResp{
+	response := &DeleteWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowPlane
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34960,20 +37264,6 @@ func ParseUpdateWorkflowPlaneResp(rsp *http.Response) (*UpdateWorkflowPlaneResp,
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -34986,34 +37276,27 @@ func ParseUpdateWorkflowPlaneResp(rsp *http.Response) (*UpdateWorkflowPlaneResp,
 	return response, nil
 }
 
-// ParseListWorkflowRunsResp parses an HTTP response from a ListWorkflowRunsWithResponse call
-func ParseListWorkflowRunsResp(rsp *http.Response) (*ListWorkflowRunsResp, error) {
+// ParseGetWorkflowRunResp parses an HTTP response from a GetWorkflowRunWithResponse call
+func ParseGetWorkflowRunResp(rsp *http.Response) (*GetWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListWorkflowRunsResp{
+	response := &GetWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunList
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35028,6 +37311,13 @@ func ParseListWorkflowRunsResp(rsp *http.Response) (*ListWorkflowRunsResp, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35040,26 +37330,26 @@ func ParseListWorkflowRunsResp(rsp *http.Response) (*ListWorkflowRunsResp, error
 	return response, nil
 }
 
-// ParseCreateWorkflowRunResp parses an HTTP response from a CreateWorkflowRunWithResponse call
-func ParseCreateWorkflowRunResp(rsp *http.Response) (*CreateWorkflowRunResp, error) {
+// ParseUpdateWorkflowRunResp parses an HTTP response from a UpdateWorkflowRunWithResponse call
+func ParseUpdateWorkflowRunResp(rsp *http.Response) (*UpdateWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateWorkflowRunResp{
+	response := &UpdateWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -35108,20 +37398,27 @@ func ParseCreateWorkflowRunResp(rsp *http.Response) (*CreateWorkflowRunResp, err
 	return response, nil
 }
 
-// ParseDeleteWorkflowRunResp parses an HTTP response from a DeleteWorkflowRunWithResponse call
-func ParseDeleteWorkflowRunResp(rsp *http.Response) (*DeleteWorkflowRunResp, error) {
+// ParseCancelWorkflowRunResp parses an HTTP response from a CancelWorkflowRunWithResponse call
+func ParseCancelWorkflowRunResp(rsp *http.Response) (*CancelWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteWorkflowRunResp{
+	response := &CancelWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35143,6 +37440,13 @@ func ParseDeleteWorkflowRunResp(rsp *http.Response) (*DeleteWorkflowRunResp, err
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35155,33 +37459,33 @@ func ParseDeleteWorkflowRunResp(rsp *http.Response) (*DeleteWorkflowRunResp, err
 	return response, nil
 }
 
-// ParseGetWorkflowRunResp parses an HTTP response from a GetWorkflowRunWithResponse call
-func ParseGetWorkflowRunResp(rsp *http.Response) (*GetWorkflowRunResp, error) {
+// ParseGetWorkflowRunEventsResp parses an HTTP response from a GetWorkflowRunEventsWithResponse call
+func ParseGetWorkflowRunEventsResp(rsp *http.Response) (*GetWorkflowRunEventsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkflowRunResp{
+	response := &GetWorkflowRunEventsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRun
+		var dest []WorkflowRunEventEntry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Forbidden
@@ -35209,22 +37513,22 @@ func ParseGetWorkflowRunResp(rsp *http.Response) (*GetWorkflowRunResp, error) {
 	return response, nil
 }
 
-// ParseUpdateWorkflowRunResp parses an HTTP response from a UpdateWorkflowRunWithResponse call
-func ParseUpdateWorkflowRunResp(rsp *http.Response) (*UpdateWorkflowRunResp, error) {
+// ParseGetWorkflowRunLogsResp parses an HTTP response from a GetWorkflowRunLogsWithResponse call
+func ParseGetWorkflowRunLogsResp(rsp *http.Response) (*GetWorkflowRunLogsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateWorkflowRunResp{
+	response := &GetWorkflowRunLogsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRun
+		var dest []WorkflowRunLogEntry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -35237,13 +37541,6 @@ func ParseUpdateWorkflowRunResp(rsp *http.Response) (*UpdateWorkflowRunResp, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35258,13 +37555,6 @@ func ParseUpdateWorkflowRunResp(rsp *http.Response) (*UpdateWorkflowRunResp, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest UnprocessableContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35277,33 +37567,33 @@ func ParseUpdateWorkflowRunResp(rsp *http.Response) (*UpdateWorkflowRunResp, err
 	return response, nil
 }
 
-// ParseGetWorkflowRunEventsResp parses an HTTP response from a GetWorkflowRunEventsWithResponse call
-func ParseGetWorkflowRunEventsResp(rsp *http.Response) (*GetWorkflowRunEventsResp, error) {
+// ParseResumeWorkflowRunResp parses an HTTP response from a ResumeWorkflowRunWithResponse call
+func ParseResumeWorkflowRunResp(rsp *http.Response) (*ResumeWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkflowRunEventsResp{
+	response := &ResumeWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []WorkflowRunEventEntry
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Forbidden
@@ -35319,6 +37609,13 @@ func ParseGetWorkflowRunEventsResp(rsp *http.Response) (*GetWorkflowRunEventsRes
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -35331,33 +37628,33 @@ func ParseGetWorkflowRunEventsResp(rsp *http.Response) (*GetWorkflowRunEventsRes
 	return response, nil
 }
 
-// ParseGetWorkflowRunLogsResp parses an HTTP response from a GetWorkflowRunLogsWithResponse call
-func ParseGetWorkflowRunLogsResp(rsp *http.Response) (*GetWorkflowRunLogsResp, error) {
+// ParseRetryWorkflowRunResp parses an HTTP response from a RetryWorkflowRunWithResponse call
+func ParseRetryWorkflowRunResp(rsp *http.Response) (*RetryWorkflowRunResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkflowRunLogsResp{
+	response := &RetryWorkflowRunResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []WorkflowRunLogEntry
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Forbidden
@@ -35373,6 +37670,13 @@ func ParseGetWorkflowRunLogsResp(rsp *http.Response) (*GetWorkflowRunLogsResp, e
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -57,6 +57,12 @@ const (
 	ClusterComponentTypeSpecAllowedWorkflowsKindClusterWorkflow ClusterComponentTypeSpecAllowedWorkflowsKind = "ClusterWorkflow"
 )
 
+// Defines values for ClusterComponentTypeSpecResourcesHook.
+const (
+	ClusterComponentTypeSpecResourcesHookPostSync ClusterComponentTypeSpecResourcesHook = "PostSync"
+	ClusterComponentTypeSpecResourcesHookPreSync  ClusterComponentTypeSpecResourcesHook = "PreSync"
+)
+
 // Defines values for ClusterComponentTypeSpecResourcesTargetPlane.
 const (
 	ClusterComponentTypeSpecResourcesTargetPlaneDataplane          ClusterComponentTypeSpecResourcesTargetPlane = "dataplane"
@@ -82,6 +88,12 @@ const (
 	ClusterObservabilityPlaneRefKindClusterObservabilityPlane ClusterObservabilityPlaneRefKind = "ClusterObservabilityPlane"
 )
 
+// Defines values for ClusterTraitSpecCreatesHook.
+const (
+	ClusterTraitSpecCreatesHookPostSync ClusterTraitSpecCreatesHook = "PostSync"
+	ClusterTraitSpecCreatesHookPreSync  ClusterTraitSpecCreatesHook = "PreSync"
+)
+
 // Defines values for ClusterTraitSpecCreatesTargetPlane.
 const (
 	ClusterTraitSpecCreatesTargetPlaneDataplane          ClusterTraitSpecCreatesTargetPlane = "dataplane"
@@ -136,6 +148,12 @@ const (
 	ComponentTypeSpecAllowedWorkflowsKindWorkflow        ComponentTypeSpecAllowedWorkflowsKind = "Workflow"
 )
 
+// Defines values for ComponentTypeSpecResourcesHook.
+const (
+	ComponentTypeSpecResourcesHookPostSync ComponentTypeSpecResourcesHook = "PostSync"
+	ComponentTypeSpecResourcesHookPreSync  ComponentTypeSpecResourcesHook = "PreSync"
+)
+
 // Defines values for ComponentTypeSpecResourcesTargetPlane.
 const (
 	ComponentTypeSpecResourcesTargetPlaneDataplane          ComponentTypeSpecResourcesTargetPlane = "dataplane"
@@ -204,6 +222,13 @@ const (
 	EnvironmentSpecDataPlaneRefKindDataPlane        EnvironmentSpecDataPlaneRefKind = "DataPlane"
 )
 
+// Defines values for EnvironmentSpecDriftPolicyMode.
+const (
+	Alert       EnvironmentSpecDriftPolicyMode = "Alert"
+	AutoCorrect EnvironmentSpecDriftPolicyMode = "AutoCorrect"
+	ReportOnly  EnvironmentSpecDriftPolicyMode = "ReportOnly"
+)
+
 // Defines values for ErrorResponseCode.
 const (
 	BADREQUEST           ErrorResponseCode = "BAD_REQUEST"
@@ -263,6 +288,12 @@ const (
 	ProjectTypeRefKindProjectType        ProjectTypeRefKind = "ProjectType"
 )
 
+// Defines values for ProjectTypeSpecResourcesHook.
+const (
+	ProjectTypeSpecResourcesHookPostSync ProjectTypeSpecResourcesHook = "PostSync"
+	ProjectTypeSpecResourcesHookPreSync  ProjectTypeSpecResourcesHook = "PreSync"
+)
+
 // Defines values for ProjectTypeSpecResourcesTargetPlane.
 const (
 	ProjectTypeSpecResourcesTargetPlaneDataplane          ProjectTypeSpecResourcesTargetPlane = "dataplane"
@@ -378,6 +409,12 @@ const (
 	TraitRemoveTargetPlaneObservabilityplane TraitRemoveTargetPlane = "observabilityplane"
 )
 
+// Defines values for TraitSpecCreatesHook.
+const (
+	PostSync TraitSpecCreatesHook = "PostSync"
+	PreSync  TraitSpecCreatesHook = "PreSync"
+)
+
 // Defines values for TraitSpecCreatesTargetPlane.
 const (
 	TraitSpecCreatesTargetPlaneDataplane          TraitSpecCreatesTargetPlane = "dataplane"
@@ -397,20 +434,43 @@ const (
 	Observabilityplane TraitSpecPatchesTargetPlane = "observabilityplane"
 )
 
+// Defines values for WorkflowArtifactType.
+const (
+	WorkflowArtifactTypeImage      WorkflowArtifactType = "Image"
+	WorkflowArtifactTypeProvenance WorkflowArtifactType = "Provenance"
+	WorkflowArtifactTypeSBOM       WorkflowArtifactType = "SBOM"
+	WorkflowArtifactTypeSignature  WorkflowArtifactType = "Signature"
+)
+
 // Defines values for WorkflowPlaneRefKind.
 const (
 	WorkflowPlaneRefKindClusterWorkflowPlane WorkflowPlaneRefKind = "ClusterWorkflowPlane"
 	WorkflowPlaneRefKindWorkflowPlane        WorkflowPlaneRefKind = "WorkflowPlane"
 )
 
+// Defines values for WorkflowRunArtifactType.
+const (
+	WorkflowRunArtifactTypeImage      WorkflowRunArtifactType = "Image"
+	WorkflowRunArtifactTypeProvenance WorkflowRunArtifactType = "Provenance"
+	WorkflowRunArtifactTypeSBOM       WorkflowRunArtifactType = "SBOM"
+	WorkflowRunArtifactTypeSignature  WorkflowRunArtifactType = "Signature"
+)
+
 // Defines values for WorkflowRunConfigKind.
 const (
 	WorkflowRunConfigKindClusterWorkflow WorkflowRunConfigKind = "ClusterWorkflow"
 	WorkflowRunConfigKindWorkflow        WorkflowRunConfigKind = "Workflow"
 )
 
+// Defines values for WorkflowRunGroupSpecFailurePolicy.
+const (
+	Continue WorkflowRunGroupSpecFailurePolicy = "Continue"
+	FailFast WorkflowRunGroupSpecFailurePolicy = "FailFast"
+)
+
 // Defines values for WorkflowRunStatusResponseStatus.
 const (
+	WorkflowRunStatusResponseStatusCancelled WorkflowRunStatusResponseStatus = "Cancelled"
 	WorkflowRunStatusResponseStatusError     WorkflowRunStatusResponseStatus = "Error"
 	WorkflowRunStatusResponseStatusFailed    WorkflowRunStatusResponseStatus = "Failed"
 	WorkflowRunStatusResponseStatusPending   WorkflowRunStatusResponseStatus = "Pending"
@@ -497,6 +557,15 @@ type AgentConnectionStatus struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// ArtifactReference Location of a supply chain artifact
+type ArtifactReference struct {
+	// Format Artifact encoding, e.g. spdx-json or an attestation predicate type
+	Format *string `json:"format,omitempty"`
+
+	// Reference Artifact location, e.g. an OCI reference or a URL
+	Reference string `json:"reference"`
+}
+
 // AuthMechanismConfig Configuration for an authentication mechanism
 type AuthMechanismConfig struct {
 	// Entitlement Configuration for extracting entitlement claims from tokens
@@ -506,6 +575,80 @@ type AuthMechanismConfig struct {
 	Type string `json:"type"`
 }
 
+// AuthzAccessRequest Time-bound request for a role (Kubernetes CRD). Once approved, an AuthzRoleBinding that
+// expires after the requested duration is created for the entitlement.
+type AuthzAccessRequest struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Specification of an access request
+	Spec   *AuthzAccessRequestSpec   `json:"spec,omitempty"`
+	Status *AuthzAccessRequestStatus `json:"status,omitempty"`
+}
+
+// AuthzAccessRequestList List of access requests
+type AuthzAccessRequestList struct {
+	Items []AuthzAccessRequest `json:"items"`
+
+	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
+	// for efficient pagination through large result sets.
+	Pagination Pagination `json:"pagination"`
+}
+
+// AuthzAccessRequestReview Approval or denial of an access request
+type AuthzAccessRequestReview struct {
+	// Comment Comment recorded with the review
+	Comment *string `json:"comment,omitempty"`
+}
+
+// AuthzAccessRequestSpec Specification of an access request
+type AuthzAccessRequestSpec struct {
+	// Duration How long access is granted for once approved, between 1m and 168h
+	Duration string `json:"duration"`
+
+	// Entitlement Entitlement claim-value pair for subject identification
+	Entitlement *AuthzEntitlementClaim `json:"entitlement,omitempty"`
+
+	// Reason Why access is needed, e.g. an incident or ticket reference
+	Reason string `json:"reason"`
+
+	// Requester ID of the subject that requested access, set from the authenticated caller
+	Requester *string `json:"requester,omitempty"`
+
+	// RoleRef Reference to an AuthzRole or ClusterAuthzRole
+	RoleRef AuthzRoleRef `json:"roleRef"`
+
+	// Scope Target resource scope within a namespace (project/component scope)
+	Scope *AuthzScope `json:"scope,omitempty"`
+}
+
+// AuthzAccessRequestStatus Observed state of an access request
+type AuthzAccessRequestStatus struct {
+	// BindingName Name of the AuthzRoleBinding created for the approved request
+	BindingName *string `json:"bindingName,omitempty"`
+
+	// Conditions Kubernetes-style conditions (AccessGranted)
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// ExpiresAt Time the granted access expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Phase Lifecycle phase of the request (Pending, Approved, Denied or Expired)
+	Phase         *string    `json:"phase,omitempty"`
+	ReviewComment *string    `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
+
+	// ReviewedBy ID of the subject that approved or denied the request
+	ReviewedBy *string `json:"reviewedBy,omitempty"`
+}
+
 // AuthzClusterScope Target resource scope for cluster-scoped bindings (namespace/project/component)
 type AuthzClusterScope struct {
 	// Component Component name
@@ -527,6 +670,21 @@ type AuthzCondition struct {
 	Expression string `json:"expression"`
 }
 
+// AuthzConditionResult Result of evaluating a binding condition against a request
+type AuthzConditionResult struct {
+	// Actions Action patterns the condition applies to
+	Actions *[]string `json:"actions,omitempty"`
+
+	// Error Why the expression could not be evaluated
+	Error *string `json:"error,omitempty"`
+
+	// Expression CEL expression of the condition
+	Expression string `json:"expression"`
+
+	// Result Evaluation result, one of "true", "false" or "error" when the expression could not be evaluated
+	Result string `json:"result"`
+}
+
 // AuthzContext Additional context for authorization
 type AuthzContext struct {
 	// Resource Resource-level attributes for condition evaluation
@@ -545,6 +703,40 @@ type AuthzContext struct {
 	} `json:"resource,omitempty"`
 }
 
+// AuthzDecisionRecord Logged authorization decision
+type AuthzDecisionRecord struct {
+	Action string `json:"action"`
+
+	// BindingName Binding that allowed or denied the request; empty when no policy matched
+	BindingName *string `json:"bindingName,omitempty"`
+
+	// Cached Whether the decision was served from the decision cache
+	Cached bool `json:"cached"`
+
+	// Decision Authorization result (true = allowed)
+	Decision bool `json:"decision"`
+
+	// DurationMs Evaluation time in milliseconds
+	DurationMs float64 `json:"durationMs"`
+
+	// Reason Reason for the decision
+	Reason *string `json:"reason,omitempty"`
+
+	// Resource Resource for authorization evaluation
+	Resource Resource `json:"resource"`
+
+	// Subject Authenticated subject context
+	Subject SubjectContext `json:"subject"`
+
+	// Time When the decision was made
+	Time time.Time `json:"time"`
+}
+
+// AuthzDecisionRecordList Logged authorization decisions
+type AuthzDecisionRecordList struct {
+	Items []AuthzDecisionRecord `json:"items"`
+}
+
 // AuthzEntitlementClaim Entitlement claim-value pair for subject identification
 type AuthzEntitlementClaim struct {
 	// Claim JWT claim name
@@ -554,6 +746,58 @@ type AuthzEntitlementClaim struct {
 	Value string `json:"value"`
 }
 
+// AuthzExplanation Authorization decision with the policies that produced it
+type AuthzExplanation struct {
+	// Decision Authorization result (true = allowed)
+	Decision bool `json:"decision"`
+
+	// Policies Matching policies for each of the subject's entitlements
+	Policies []AuthzPolicyMatch `json:"policies"`
+
+	// Reason Names the binding that determined the decision
+	Reason string `json:"reason"`
+}
+
+// AuthzPolicyMatch A policy whose subject, resource scope and role actions match the request
+type AuthzPolicyMatch struct {
+	// Applies Whether the policy took part in the decision once its conditions were evaluated
+	Applies bool `json:"applies"`
+
+	// BindingName AuthzRoleBinding or ClusterAuthzRoleBinding the policy comes from
+	BindingName string `json:"bindingName"`
+
+	// Conditions Binding conditions targeting the requested action, with their results
+	Conditions *[]AuthzConditionResult `json:"conditions,omitempty"`
+
+	// Effect Effect of the binding, allow or deny
+	Effect string `json:"effect"`
+
+	// Entitlement Entitlement with claim and value
+	Entitlement Entitlement `json:"entitlement"`
+
+	// Role Role referenced by a policy
+	Role AuthzPolicyRole `json:"role"`
+
+	// Scope Resource hierarchy scope. Authoritative validation lives on the
+	// AuthzRoleBinding / ClusterAuthzRoleBinding CRD CEL rules; this schema
+	// documents the same invariants for clients:
+	// - `project` is required when `component` or `resource` is set
+	// - `component` and `resource` are mutually exclusive (siblings under `project`)
+	//
+	// Hierarchies that violate these invariants are treated as no-match by
+	// the authz engine rather than rejected on the wire.
+	Scope ResourceHierarchy `json:"scope"`
+}
+
+// AuthzPolicyRole Role referenced by a policy
+type AuthzPolicyRole struct {
+	// Name Role name
+	Name string `json:"name"`
+
+	// Namespace Namespace of an AuthzRole; empty for a ClusterAuthzRole
+	Namespace *string `json:"namespace,omitempty"`
+}
+
 // AuthzRole Namespace-scoped authorization role (Kubernetes CRD).
 // Defines a set of actions that can be assigned to subjects via role bindings within a namespace.
 type AuthzRole struct {
@@ -605,6 +849,9 @@ type AuthzRoleBindingSpec struct {
 	// Entitlement Entitlement claim-value pair for subject identification
 	Entitlement AuthzEntitlementClaim `json:"entitlement"`
 
+	// ExpiresAt Time after which the binding is no longer honoured. Set on bindings created for approved access requests.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
 	// RoleMappings List of role-scope pair mappings this binding grants
 	RoleMappings []AuthzRoleMapping `json:"roleMappings"`
 }
@@ -661,6 +908,48 @@ type AuthzScope struct {
 
 	// Project Project name
 	Project *string `json:"project,omitempty"`
+
+	// Resource Resource name
+	Resource *string `json:"resource,omitempty"`
+}
+
+// AuthzSimulateRequest Authorization request evaluated against proposed roles and role bindings
+type AuthzSimulateRequest struct {
+	// ClusterRoleBindings Proposed cluster role bindings
+	ClusterRoleBindings *[]ClusterAuthzRoleBinding `json:"clusterRoleBindings,omitempty"`
+
+	// ClusterRoles Proposed cluster roles
+	ClusterRoles *[]ClusterAuthzRole `json:"clusterRoles,omitempty"`
+
+	// IgnoreExisting Evaluate against the proposed objects only, ignoring the current policies
+	IgnoreExisting *bool `json:"ignoreExisting,omitempty"`
+
+	// Request Single authorization evaluation request
+	Request EvaluateRequest `json:"request"`
+
+	// RoleBindings Proposed namespace role bindings
+	RoleBindings *[]AuthzRoleBinding `json:"roleBindings,omitempty"`
+
+	// Roles Proposed namespace roles
+	Roles *[]AuthzRole `json:"roles,omitempty"`
+}
+
+// BuildProvenance How the workload image was built, recorded by the workflow run that built it
+type BuildProvenance struct {
+	// Attestation Location of a supply chain artifact
+	Attestation *ArtifactReference `json:"attestation,omitempty"`
+
+	// ImageDigest Digest of the built image
+	ImageDigest *string `json:"imageDigest,omitempty"`
+
+	// Sbom Location of a supply chain artifact
+	Sbom *ArtifactReference `json:"sbom,omitempty"`
+
+	// Signature Location of a supply chain artifact
+	Signature *ArtifactReference `json:"signature,omitempty"`
+
+	// WorkflowRun Name of the workflow run that built the image
+	WorkflowRun *string `json:"workflowRun,omitempty"`
 }
 
 // CapabilityConstraints CEL expressions constraining access for a given action and resource path. Multiple expressions are OR'd.
@@ -735,6 +1024,9 @@ type ClusterAuthzRoleBindingSpec struct {
 	// Entitlement Entitlement claim-value pair for subject identification
 	Entitlement AuthzEntitlementClaim `json:"entitlement"`
 
+	// ExpiresAt Time after which the binding is no longer honoured. Set on bindings created for approved access requests.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
 	// RoleMappings List of cluster role mappings this binding grants
 	RoleMappings []ClusterAuthzRoleMapping `json:"roleMappings"`
 }
@@ -839,9 +1131,15 @@ type ClusterComponentTypeSpec struct {
 
 	// Resources Templates that generate Kubernetes resources dynamically
 	Resources []struct {
+		// ApplyWave Apply wave; lower waves are applied first and must be healthy before the next wave is applied
+		ApplyWave *int32 `json:"applyWave,omitempty"`
+
 		// ForEach CEL expression for generating multiple resources from a list
 		ForEach *string `json:"forEach,omitempty"`
 
+		// Hook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+		Hook *ClusterComponentTypeSpecResourcesHook `json:"hook,omitempty"`
+
 		// Id Unique identifier for this resource within the component type
 		Id string `json:"id"`
 
@@ -889,6 +1187,9 @@ type ClusterComponentTypeSpecAllowedTraitsKind string
 // ClusterComponentTypeSpecAllowedWorkflowsKind Kind of the workflow reference. Must be "ClusterWorkflow".
 type ClusterComponentTypeSpecAllowedWorkflowsKind string
 
+// ClusterComponentTypeSpecResourcesHook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+type ClusterComponentTypeSpecResourcesHook string
+
 // ClusterComponentTypeSpecResourcesTargetPlane Target plane for deployment
 type ClusterComponentTypeSpecResourcesTargetPlane string
 
@@ -1118,9 +1419,15 @@ type ClusterTraitList struct {
 type ClusterTraitSpec struct {
 	// Creates New Kubernetes resources to create when this trait is applied
 	Creates *[]struct {
+		// ApplyWave Apply wave; lower waves are applied first and must be healthy before the next wave is applied
+		ApplyWave *int32 `json:"applyWave,omitempty"`
+
 		// ForEach CEL expression for generating multiple resources from a list
 		ForEach *string `json:"forEach,omitempty"`
 
+		// Hook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+		Hook *ClusterTraitSpecCreatesHook `json:"hook,omitempty"`
+
 		// IncludeWhen CEL expression determining if this resource should be created
 		IncludeWhen *string `json:"includeWhen,omitempty"`
 
@@ -1192,6 +1499,9 @@ type ClusterTraitSpec struct {
 	Validations *[]ValidationRule `json:"validations,omitempty"`
 }
 
+// ClusterTraitSpecCreatesHook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+type ClusterTraitSpecCreatesHook string
+
 // ClusterTraitSpecCreatesTargetPlane Target plane for deployment
 type ClusterTraitSpecCreatesTargetPlane string
 
@@ -1300,6 +1610,9 @@ type ClusterWorkflowPlaneStatus struct {
 
 // ClusterWorkflowSpec Desired state of a ClusterWorkflow
 type ClusterWorkflowSpec struct {
+	// Artifacts Artifacts produced by runs of this workflow, read from the workflow-level outputs of the run.
+	Artifacts *[]WorkflowArtifact `json:"artifacts,omitempty"`
+
 	// ExternalRefs External CR references resolved and injected into the CEL context under their id.
 	ExternalRefs *[]ExternalRef `json:"externalRefs,omitempty"`
 
@@ -1395,6 +1708,15 @@ type ComponentReleaseSpec struct {
 	// ComponentType Frozen ComponentType spec at release time
 	ComponentType map[string]interface{} `json:"componentType"`
 
+	// Image How the workload container image was pinned to a digest at release time
+	Image *struct {
+		// Digest Manifest digest the reference resolved to
+		Digest string `json:"digest"`
+
+		// Reference Image reference as authored in the Workload
+		Reference string `json:"reference"`
+	} `json:"image,omitempty"`
+
 	// Owner Identifies the component and project this release belongs to
 	Owner struct {
 		// ComponentName Parent component name
@@ -1437,6 +1759,12 @@ type ComponentSpec struct {
 	// Parameters ComponentType parameter values (schema defined by the referenced ComponentType)
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 
+	// RegistryAuthentication Credentials used to resolve the component's image tags to digests when a release is created
+	RegistryAuthentication *struct {
+		// SecretRef Name of a kubernetes.io/dockerconfigjson Secret in the component's namespace
+		SecretRef *string `json:"secretRef,omitempty"`
+	} `json:"registryAuthentication,omitempty"`
+
 	// Traits Trait instances attached to the component
 	Traits *[]ComponentTrait `json:"traits,omitempty"`
 
@@ -1562,9 +1890,15 @@ type ComponentTypeSpec struct {
 
 	// Resources Templates that generate Kubernetes resources dynamically
 	Resources []struct {
+		// ApplyWave Apply wave; lower waves are applied first and must be healthy before the next wave is applied
+		ApplyWave *int32 `json:"applyWave,omitempty"`
+
 		// ForEach CEL expression for generating multiple resources from a list
 		ForEach *string `json:"forEach,omitempty"`
 
+		// Hook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+		Hook *ComponentTypeSpecResourcesHook `json:"hook,omitempty"`
+
 		// Id Unique identifier for this resource within the component type
 		Id string `json:"id"`
 
@@ -1612,6 +1946,9 @@ type ComponentTypeSpecAllowedTraitsKind string
 // ComponentTypeSpecAllowedWorkflowsKind Kind of the workflow reference (Workflow or ClusterWorkflow)
 type ComponentTypeSpecAllowedWorkflowsKind string
 
+// ComponentTypeSpecResourcesHook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+type ComponentTypeSpecResourcesHook string
+
 // ComponentTypeSpecResourcesTargetPlane Target plane for deployment
 type ComponentTypeSpecResourcesTargetPlane string
 
@@ -2057,16 +2394,45 @@ type EnvironmentSpec struct {
 		Name string `json:"name"`
 	} `json:"dataPlaneRef,omitempty"`
 
+	// DriftPolicy How changes made directly to deployed resources, outside of OpenChoreo, are handled
+	DriftPolicy *struct {
+		// Mode AutoCorrect re-applies the desired state, ReportOnly reports drifted fields without
+		// correcting them, and Alert additionally notifies the notification channels
+		Mode *EnvironmentSpecDriftPolicyMode `json:"mode,omitempty"`
+
+		// NotificationChannels Notification channels alerted in Alert mode. Defaults to the environment's default channel.
+		NotificationChannels *[]string `json:"notificationChannels,omitempty"`
+	} `json:"driftPolicy,omitempty"`
+
 	// Gateway Gateway configuration with ingress and egress network specs
 	Gateway *GatewaySpec `json:"gateway,omitempty"`
 
 	// IsProduction Whether this is a production environment
 	IsProduction *bool `json:"isProduction,omitempty"`
+
+	// ProvenancePolicy Build provenance a ComponentRelease must carry before it can be bound to this environment
+	ProvenancePolicy *struct {
+		// ImageVerification Verifies release image signatures offline against trusted keys or keyless identities
+		ImageVerification *ImageVerification `json:"imageVerification,omitempty"`
+
+		// RequireAttestation Require a recorded provenance attestation
+		RequireAttestation *bool `json:"requireAttestation,omitempty"`
+
+		// RequireSBOM Require a recorded SBOM
+		RequireSBOM *bool `json:"requireSBOM,omitempty"`
+
+		// RequireSignedImage Require a recorded image signature
+		RequireSignedImage *bool `json:"requireSignedImage,omitempty"`
+	} `json:"provenancePolicy,omitempty"`
 }
 
 // EnvironmentSpecDataPlaneRefKind Kind of data plane (DataPlane or ClusterDataPlane)
 type EnvironmentSpecDataPlaneRefKind string
 
+// EnvironmentSpecDriftPolicyMode AutoCorrect re-applies the desired state, ReportOnly reports drifted fields without
+// correcting them, and Alert additionally notifies the notification channels
+type EnvironmentSpecDriftPolicyMode string
+
 // EnvironmentStatus Observed state of an Environment
 type EnvironmentStatus struct {
 	// Conditions Current state conditions of the Environment
@@ -2238,6 +2604,35 @@ type HealthInfo struct {
 	Status string `json:"status"`
 }
 
+// ImageVerification Verifies release image signatures offline against trusted keys or keyless identities
+type ImageVerification struct {
+	// Images Glob patterns of image repositories to verify. Empty matches all images.
+	Images *[]string `json:"images,omitempty"`
+
+	// Keyless Trusted keyless (certificate based) signers
+	Keyless *struct {
+		Identities []struct {
+			// Issuer OIDC issuer of the signer
+			Issuer string `json:"issuer"`
+
+			// Subject Exact signer identity
+			Subject *string `json:"subject,omitempty"`
+
+			// SubjectRegExp Regular expression the signer identity must match
+			SubjectRegExp *string `json:"subjectRegExp,omitempty"`
+		} `json:"identities"`
+
+		// Roots PEM-encoded CA certificates that issue signing certificates
+		Roots string `json:"roots"`
+
+		// TransparencyLogPublicKeys PEM-encoded public keys of trusted transparency logs
+		TransparencyLogPublicKeys []string `json:"transparencyLogPublicKeys"`
+	} `json:"keyless,omitempty"`
+
+	// PublicKeys PEM-encoded public keys trusted to sign images
+	PublicKeys *[]string `json:"publicKeys,omitempty"`
+}
+
 // K8sResourceTreeResponse Response containing resource trees for all rendered releases owned by a release binding
 type K8sResourceTreeResponse struct {
 	// RenderedReleases Resource trees per rendered release (dataplane and/or observabilityplane)
@@ -2953,9 +3348,15 @@ type ProjectTypeSpec struct {
 
 	// Resources Templates that generate namespace-scoped Kubernetes manifests applied to the namespace owned by every ProjectReleaseBinding of this type.
 	Resources []struct {
+		// ApplyWave Apply wave; lower waves are applied first and must be healthy before the next wave is applied.
+		ApplyWave *int32 `json:"applyWave,omitempty"`
+
 		// ForEach CEL expression for generating multiple resources from a list.
 		ForEach *string `json:"forEach,omitempty"`
 
+		// Hook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources.
+		Hook *ProjectTypeSpecResourcesHook `json:"hook,omitempty"`
+
 		// Id Unique identifier for this resource within the project type.
 		Id string `json:"id"`
 
@@ -2976,6 +3377,9 @@ type ProjectTypeSpec struct {
 	Validations *[]ValidationRule `json:"validations,omitempty"`
 }
 
+// ProjectTypeSpecResourcesHook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources.
+type ProjectTypeSpecResourcesHook string
+
 // ProjectTypeSpecResourcesTargetPlane Target plane for deployment.
 type ProjectTypeSpecResourcesTargetPlane string
 
@@ -3836,6 +4240,9 @@ type SecretType string
 
 // SubjectContext Authenticated subject context
 type SubjectContext struct {
+	// Claims Claims of the subject's token, exposed to authorization conditions as subject.claims
+	Claims *map[string]interface{} `json:"claims,omitempty"`
+
 	// EntitlementClaim Entitlement claim name
 	EntitlementClaim string `json:"entitlement_claim"`
 
@@ -3949,9 +4356,15 @@ type TraitRemoveTargetPlane string
 type TraitSpec struct {
 	// Creates New Kubernetes resources to create when this trait is applied
 	Creates *[]struct {
+		// ApplyWave Apply wave; lower waves are applied first and must be healthy before the next wave is applied
+		ApplyWave *int32 `json:"applyWave,omitempty"`
+
 		// ForEach CEL expression for generating multiple resources from a list
 		ForEach *string `json:"forEach,omitempty"`
 
+		// Hook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+		Hook *TraitSpecCreatesHook `json:"hook,omitempty"`
+
 		// IncludeWhen CEL expression determining if this resource should be created
 		IncludeWhen *string `json:"includeWhen,omitempty"`
 
@@ -4023,6 +4436,9 @@ type TraitSpec struct {
 	Validations *[]ValidationRule `json:"validations,omitempty"`
 }
 
+// TraitSpecCreatesHook Marks the resource as a sync hook applied before (PreSync) or after (PostSync) the regular resources
+type TraitSpecCreatesHook string
+
 // TraitSpecCreatesTargetPlane Target plane for deployment
 type TraitSpecCreatesTargetPlane string
 
@@ -4134,6 +4550,24 @@ type Workflow struct {
 	Status *WorkflowStatus `json:"status,omitempty"`
 }
 
+// WorkflowArtifact Artifact produced by a workflow run
+type WorkflowArtifact struct {
+	// Format Artifact encoding, e.g. spdx-json or an attestation predicate type
+	Format *string `json:"format,omitempty"`
+
+	// Name Unique artifact name within the workflow
+	Name string `json:"name"`
+
+	// OutputParameter Workflow-level output parameter holding the artifact reference
+	OutputParameter string `json:"outputParameter"`
+
+	// Type Kind of artifact
+	Type WorkflowArtifactType `json:"type"`
+}
+
+// WorkflowArtifactType Kind of artifact
+type WorkflowArtifactType string
+
 // WorkflowList Paginated list of workflows
 type WorkflowList struct {
 	Items []Workflow `json:"items"`
@@ -4239,6 +4673,24 @@ type WorkflowRun struct {
 	Status *WorkflowRunStatus `json:"status,omitempty"`
 }
 
+// WorkflowRunArtifact Artifact produced by a workflow run
+type WorkflowRunArtifact struct {
+	// Format Artifact encoding
+	Format *string `json:"format,omitempty"`
+
+	// Name Artifact name declared by the workflow
+	Name string `json:"name"`
+
+	// Reference Artifact location, e.g. an image reference with digest
+	Reference string `json:"reference"`
+
+	// Type Kind of artifact
+	Type WorkflowRunArtifactType `json:"type"`
+}
+
+// WorkflowRunArtifactType Kind of artifact
+type WorkflowRunArtifactType string
+
 // WorkflowRunConfig Workflow configuration referencing the Workflow and providing schema values. Kind and name are immutable after creation.
 type WorkflowRunConfig struct {
 	// Kind Kind of referenced workflow resource (Workflow or ClusterWorkflow)
@@ -4269,6 +4721,89 @@ type WorkflowRunEventEntry struct {
 	Type string `json:"type"`
 }
 
+// WorkflowRunGroup One logical build of several components from the same commit
+type WorkflowRunGroup struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Desired state of a WorkflowRunGroup
+	Spec   *WorkflowRunGroupSpec   `json:"spec,omitempty"`
+	Status *WorkflowRunGroupStatus `json:"status,omitempty"`
+}
+
+// WorkflowRunGroupList Paginated list of workflow run groups
+type WorkflowRunGroupList struct {
+	Items []WorkflowRunGroup `json:"items"`
+
+	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
+	// for efficient pagination through large result sets.
+	Pagination Pagination `json:"pagination"`
+}
+
+// WorkflowRunGroupRun A workflow run of a WorkflowRunGroup
+type WorkflowRunGroupRun struct {
+	// Labels Labels of the created WorkflowRun
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name Name of the run within the group, typically the component name
+	Name string `json:"name"`
+
+	// Workflow Workflow configuration referencing the Workflow and providing schema values. Kind and name are immutable after creation.
+	Workflow WorkflowRunConfig `json:"workflow"`
+}
+
+// WorkflowRunGroupRunStatus Observed state of a run of a WorkflowRunGroup
+type WorkflowRunGroupRunStatus struct {
+	Name string `json:"name"`
+
+	// Phase Phase of the run (Pending, Running, Succeeded, Failed or Skipped)
+	Phase string `json:"phase"`
+
+	// WorkflowRunName Name of the created WorkflowRun, empty until the run is started
+	WorkflowRunName *string `json:"workflowRunName,omitempty"`
+}
+
+// WorkflowRunGroupSpec Desired state of a WorkflowRunGroup
+type WorkflowRunGroupSpec struct {
+	// Commit Commit the runs of the group build
+	Commit *string `json:"commit,omitempty"`
+
+	// FailurePolicy Continue runs the remaining runs after a failure; FailFast cancels the active runs and skips the runs that have not started.
+	FailurePolicy *WorkflowRunGroupSpecFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// MaxConcurrency Maximum number of runs of the group that execute at the same time. Unlimited when unset.
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+
+	// Runs Workflow runs of the group, started in the listed order
+	Runs []WorkflowRunGroupRun `json:"runs"`
+}
+
+// WorkflowRunGroupSpecFailurePolicy Continue runs the remaining runs after a failure; FailFast cancels the active runs and skips the runs that have not started.
+type WorkflowRunGroupSpecFailurePolicy string
+
+// WorkflowRunGroupStatus Observed state of a WorkflowRunGroup
+type WorkflowRunGroupStatus struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Conditions Kubernetes-style conditions (WorkflowRunGroupCompleted, WorkflowRunGroupSucceeded)
+	Conditions *[]Condition                 `json:"conditions,omitempty"`
+	Failed     *int32                       `json:"failed,omitempty"`
+	Pending    *int32                       `json:"pending,omitempty"`
+	Running    *int32                       `json:"running,omitempty"`
+	Runs       *[]WorkflowRunGroupRunStatus `json:"runs,omitempty"`
+	Skipped    *int32                       `json:"skipped,omitempty"`
+	StartedAt  *time.Time                   `json:"startedAt,omitempty"`
+	Succeeded  *int32                       `json:"succeeded,omitempty"`
+	Total      *int32                       `json:"total,omitempty"`
+}
+
 // WorkflowRunList Paginated list of workflow runs
 type WorkflowRunList struct {
 	Items []WorkflowRun `json:"items"`
@@ -4289,6 +4824,12 @@ type WorkflowRunLogEntry struct {
 
 // WorkflowRunSpec Desired state of a WorkflowRun
 type WorkflowRunSpec struct {
+	// Cancel Requests the termination of the workflow run. Cannot be unset once set.
+	Cancel *bool `json:"cancel,omitempty"`
+
+	// Resume Requests that a failed workflow run is resumed from its failed tasks. Reset by the controller once the resume has started.
+	Resume *bool `json:"resume,omitempty"`
+
 	// TtlAfterCompletion Time-to-live for this workflow run after completion (duration string like 10d1h30m).
 	TtlAfterCompletion *string `json:"ttlAfterCompletion,omitempty"`
 
@@ -4298,7 +4839,9 @@ type WorkflowRunSpec struct {
 
 // WorkflowRunStatus Observed state of a WorkflowRun
 type WorkflowRunStatus struct {
-	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// Artifacts Artifacts produced by the run
+	Artifacts   *[]WorkflowRunArtifact `json:"artifacts,omitempty"`
+	CompletedAt *time.Time             `json:"completedAt,omitempty"`
 
 	// Conditions Kubernetes-style conditions
 	Conditions *[]Condition         `json:"conditions,omitempty"`
@@ -4327,6 +4870,9 @@ type WorkflowRunStatusResponseStatus string
 
 // WorkflowSpec Desired state of a Workflow
 type WorkflowSpec struct {
+	// Artifacts Artifacts produced by runs of this workflow, read from the workflow-level outputs of the run.
+	Artifacts *[]WorkflowArtifact `json:"artifacts,omitempty"`
+
 	// ExternalRefs External CR references resolved and injected into the CEL context under their id.
 	ExternalRefs *[]ExternalRef `json:"externalRefs,omitempty"`
 
@@ -4531,11 +5077,17 @@ type WorkloadSpec struct {
 		// ProjectName Name of the owning project
 		ProjectName string `json:"projectName"`
 	} `json:"owner,omitempty"`
+
+	// Provenance How the workload image was built, recorded by the workflow run that built it
+	Provenance *BuildProvenance `json:"provenance,omitempty"`
 }
 
 // WorkloadStatus Observed state of a Workload
 type WorkloadStatus = map[string]interface{}
 
+// AuthzAccessRequestNameParam defines model for AuthzAccessRequestNameParam.
+type AuthzAccessRequestNameParam = string
+
 // ClusterComponentTypeNameParam defines model for ClusterComponentTypeNameParam.
 type ClusterComponentTypeNameParam = string
 
@@ -4659,6 +5211,9 @@ type WorkflowPlaneNameParam = string
 // WorkflowQueryParam defines model for WorkflowQueryParam.
 type WorkflowQueryParam = string
 
+// WorkflowRunGroupNameParam defines model for WorkflowRunGroupNameParam.
+type WorkflowRunGroupNameParam = string
+
 // WorkflowRunNameParam defines model for WorkflowRunNameParam.
 type WorkflowRunNameParam = string
 
@@ -4689,6 +5244,27 @@ type Unauthorized = ErrorResponse
 // UnprocessableContent Standard error response format
 type UnprocessableContent = ErrorResponse
 
+// ListAuthzDecisionsParams defines parameters for ListAuthzDecisions.
+type ListAuthzDecisionsParams struct {
+	// Namespace Only decisions on resources in this namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Action Only decisions for this action
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// EntitlementValue Only decisions for subjects holding this entitlement value
+	EntitlementValue *string `form:"entitlementValue,omitempty" json:"entitlementValue,omitempty"`
+
+	// Decision Only allowed (true) or denied (false) decisions
+	Decision *bool `form:"decision,omitempty" json:"decision,omitempty"`
+
+	// Since Only decisions made at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Maximum number of decisions to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // EvaluatesJSONBody defines parameters for Evaluates.
 type EvaluatesJSONBody = []EvaluateRequest
 
@@ -4894,6 +5470,23 @@ type ListNamespacesParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// ListAuthzAccessRequestsParams defines parameters for ListAuthzAccessRequests.
+type ListAuthzAccessRequestsParams struct {
+	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
+	// Supports equality-based requirements: "key=value" (equality), "key!=value" (inequality).
+	// Supports set-based requirements: "key in (val1,val2)" (value in set), "key notin (val1,val2)" (value not in set).
+	// Supports existence checks: "key" (label exists), "!key" (label does not exist).
+	// Multiple requirements are comma-separated and ANDed together.
+	LabelSelector *LabelSelectorParam `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // ListNamespaceRoleBindingsParams defines parameters for ListNamespaceRoleBindings.
 type ListNamespaceRoleBindingsParams struct {
 	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
@@ -5319,6 +5912,23 @@ type ListWorkflowPlanesParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// ListWorkflowRunGroupsParams defines parameters for ListWorkflowRunGroups.
+type ListWorkflowRunGroupsParams struct {
+	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
+	// Supports equality-based requirements: "key=value" (equality), "key!=value" (inequality).
+	// Supports set-based requirements: "key in (val1,val2)" (value in set), "key notin (val1,val2)" (value not in set).
+	// Supports existence checks: "key" (label exists), "!key" (label does not exist).
+	// Multiple requirements are comma-separated and ANDed together.
+	LabelSelector *LabelSelectorParam `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // ListWorkflowRunsParams defines parameters for ListWorkflowRuns.
 type ListWorkflowRunsParams struct {
 	// Workflow Filter workflow runs by workflow name
@@ -5422,6 +6032,12 @@ type ListSecretsParams struct {
 // EvaluatesJSONRequestBody defines body for Evaluates for application/json ContentType.
 type EvaluatesJSONRequestBody = EvaluatesJSONBody
 
+// ExplainAuthzJSONRequestBody defines body for ExplainAuthz for application/json ContentType.
+type ExplainAuthzJSONRequestBody = EvaluateRequest
+
+// SimulateAuthzJSONRequestBody defines body for SimulateAuthz for application/json ContentType.
+type SimulateAuthzJSONRequestBody = AuthzSimulateRequest
+
 // CreateClusterRoleBindingJSONRequestBody defines body for CreateClusterRoleBinding for application/json ContentType.
 type CreateClusterRoleBindingJSONRequestBody = ClusterAuthzRoleBinding
 
@@ -5488,6 +6104,15 @@ type CreateNamespaceJSONRequestBody = Namespace
 // UpdateNamespaceJSONRequestBody defines body for UpdateNamespace for application/json ContentType.
 type UpdateNamespaceJSONRequestBody = Namespace
 
+// CreateAuthzAccessRequestJSONRequestBody defines body for CreateAuthzAccessRequest for application/json ContentType.
+type CreateAuthzAccessRequestJSONRequestBody = AuthzAccessRequest
+
+// ApproveAuthzAccessRequestJSONRequestBody defines body for ApproveAuthzAccessRequest for application/json ContentType.
+type ApproveAuthzAccessRequestJSONRequestBody = AuthzAccessRequestReview
+
+// DenyAuthzAccessRequestJSONRequestBody defines body for DenyAuthzAccessRequest for application/json ContentType.
+type DenyAuthzAccessRequestJSONRequestBody = AuthzAccessRequestReview
+
 // CreateNamespaceRoleBindingJSONRequestBody defines body for CreateNamespaceRoleBinding for application/json ContentType.
 type CreateNamespaceRoleBindingJSONRequestBody = AuthzRoleBinding
 
@@ -33,12 +33,21 @@ type ServerInterface interface {
 	// List actions
 	// (GET /api/v1/authz/actions)
 	ListActions(w http.ResponseWriter, r *http.Request)
+	// List logged authorization decisions
+	// (GET /api/v1/authz/decisions)
+	ListAuthzDecisions(w http.ResponseWriter, r *http.Request, params ListAuthzDecisionsParams)
 	// Evaluate authorization
 	// (POST /api/v1/authz/evaluates)
 	Evaluates(w http.ResponseWriter, r *http.Request)
+	// Explain an authorization decision
+	// (POST /api/v1/authz/explain)
+	ExplainAuthz(w http.ResponseWriter, r *http.Request)
 	// Get subject profile
 	// (GET /api/v1/authz/profile)
 	GetSubjectProfile(w http.ResponseWriter, r *http.Request, params GetSubjectProfileParams)
+	// Simulate an authorization decision
+	// (POST /api/v1/authz/simulate)
+	SimulateAuthz(w http.ResponseWriter, r *http.Request)
 	// List cluster role bindings
 	// (GET /api/v1/clusterauthzrolebindings)
 	ListClusterRoleBindings(w http.ResponseWriter, r *http.Request, params ListClusterRoleBindingsParams)
@@ -219,6 +228,21 @@ type ServerInterface interface {
 	// Update namespace
 	// (PUT /api/v1/namespaces/{namespaceName})
 	UpdateNamespace(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam)
+	// List access requests
+	// (GET /api/v1/namespaces/{namespaceName}/authzaccessrequests)
+	ListAuthzAccessRequests(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListAuthzAccessRequestsParams)
+	// Request access
+	// (POST /api/v1/namespaces/{namespaceName}/authzaccessrequests)
+	CreateAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam)
+	// Get access request
+	// (GET /api/v1/namespaces/{namespaceName}/authzaccessrequests/{name})
+	GetAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam)
+	// Approve access request
+	// (POST /api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/approve)
+	ApproveAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam)
+	// Deny access request
+	// (POST /api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/deny)
+	DenyAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam)
 	// List namespace role bindings
 	// (GET /api/v1/namespaces/{namespaceName}/authzrolebindings)
 	ListNamespaceRoleBindings(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListNamespaceRoleBindingsParams)
@@ -567,6 +591,12 @@ type ServerInterface interface {
 	// Update workflow plane
 	// (PUT /api/v1/namespaces/{namespaceName}/workflowplanes/{workflowPlaneName})
 	UpdateWorkflowPlane(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, workflowPlaneName WorkflowPlaneNameParam)
+	// List workflow run groups
+	// (GET /api/v1/namespaces/{namespaceName}/workflowrungroups)
+	ListWorkflowRunGroups(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListWorkflowRunGroupsParams)
+	// Get workflow run group
+	// (GET /api/v1/namespaces/{namespaceName}/workflowrungroups/{groupName})
+	GetWorkflowRunGroup(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam)
 	// List workflow runs
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns)
 	ListWorkflowRuns(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListWorkflowRunsParams)
@@ -582,12 +612,21 @@ type ServerInterface interface {
 	// Update workflow run
 	// (PUT /api/v1/namespaces/{namespaceName}/workflowruns/{runName})
 	UpdateWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam)
+	// Cancel workflow run
+	// (POST /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/cancel)
+	CancelWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam)
 	// Get workflow run events
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/events)
 	GetWorkflowRunEvents(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params GetWorkflowRunEventsParams)
 	// Get workflow run logs
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/logs)
 	GetWorkflowRunLogs(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params GetWorkflowRunLogsParams)
+	// Resume workflow run from failed tasks
+	// (POST /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/resume)
+	ResumeWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam)
+	// Retry workflow run
+	// (POST /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/retry)
+	RetryWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam)
 	// Get workflow run status
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/status)
 	GetWorkflowRunStatus(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam)
@@ -731,6 +770,79 @@ func (siw *ServerInterfaceWrapper) ListActions(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// ListAuthzDecisions operation middleware
+func (siw *ServerInterfaceWrapper) ListAuthzDecisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuthzDecisionsParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entitlementValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entitlementValue", r.URL.Query(), &params.EntitlementValue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entitlementValue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "decision" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "decision", r.URL.Query(), &params.Decision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "decision", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuthzDecisions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // Evaluates operation middleware
 func (siw *ServerInterfaceWrapper) Evaluates(w http.ResponseWriter, r *http.Request) {
 
@@ -751,6 +863,26 @@ func (siw *ServerInterfaceWrapper) Evaluates(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// ExplainAuthz operation middleware
+func (siw *ServerInterfaceWrapper) ExplainAuthz(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExplainAuthz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetSubjectProfile operation middleware
 func (siw *ServerInterfaceWrapper) GetSubjectProfile(w http.ResponseWriter, r *http.Request) {
 
@@ -808,6 +940,26 @@ func (siw *ServerInterfaceWrapper) GetSubjectProfile(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// SimulateAuthz operation middleware
+func (siw *ServerInterfaceWrapper) SimulateAuthz(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SimulateAuthz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListClusterRoleBindings operation middleware
 func (siw *ServerInterfaceWrapper) ListClusterRoleBindings(w http.ResponseWriter, r *http.Request) {
 
@@ -2745,8 +2897,8 @@ func (siw *ServerInterfaceWrapper) UpdateNamespace(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListNamespaceRoleBindings operation middleware
-func (siw *ServerInterfaceWrapper) ListNamespaceRoleBindings(w http.ResponseWriter, r *http.Request) {
+// ListAuthzAccessRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListAuthzAccessRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2766,7 +2918,7 @@ func (siw *ServerInterfaceWrapper) ListNamespaceRoleBindings(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNamespaceRoleBindingsParams
+	var params ListAuthzAccessRequestsParams
 
 	// ------------- Optional query parameter "labelSelector" -------------
 
@@ -2793,7 +2945,7 @@ func (siw *ServerInterfaceWrapper) ListNamespaceRoleBindings(w http.ResponseWrit
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListNamespaceRoleBindings(w, r, namespaceName, params)
+		siw.Handler.ListAuthzAccessRequests(w, r, namespaceName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2803,8 +2955,8 @@ func (siw *ServerInterfaceWrapper) ListNamespaceRoleBindings(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// CreateNamespaceRoleBinding operation middleware
-func (siw *ServerInterfaceWrapper) CreateNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
+// CreateAuthzAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateAuthzAccessRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2824,7 +2976,7 @@ func (siw *ServerInterfaceWrapper) CreateNamespaceRoleBinding(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateNamespaceRoleBinding(w, r, namespaceName)
+		siw.Handler.CreateAuthzAccessRequest(w, r, namespaceName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2834,8 +2986,8 @@ func (siw *ServerInterfaceWrapper) CreateNamespaceRoleBinding(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteNamespaceRoleBinding operation middleware
-func (siw *ServerInterfaceWrapper) DeleteNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
+// GetAuthzAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthzAccessRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2849,7 +3001,7 @@ func (siw *ServerInterfaceWrapper) DeleteNamespaceRoleBinding(w http.ResponseWri
 	}
 
 	// ------------- Path parameter "name" -------------
-	var name string
+	var name AuthzAccessRequestNameParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2864,7 +3016,7 @@ func (siw *ServerInterfaceWrapper) DeleteNamespaceRoleBinding(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteNamespaceRoleBinding(w, r, namespaceName, name)
+		siw.Handler.GetAuthzAccessRequest(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2874,8 +3026,8 @@ func (siw *ServerInterfaceWrapper) DeleteNamespaceRoleBinding(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetNamespaceRoleBinding operation middleware
-func (siw *ServerInterfaceWrapper) GetNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
+// ApproveAuthzAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveAuthzAccessRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2889,7 +3041,7 @@ func (siw *ServerInterfaceWrapper) GetNamespaceRoleBinding(w http.ResponseWriter
 	}
 
 	// ------------- Path parameter "name" -------------
-	var name string
+	var name AuthzAccessRequestNameParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2904,7 +3056,7 @@ func (siw *ServerInterfaceWrapper) GetNamespaceRoleBinding(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNamespaceRoleBinding(w, r, namespaceName, name)
+		siw.Handler.ApproveAuthzAccessRequest(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2914,8 +3066,8 @@ func (siw *ServerInterfaceWrapper) GetNamespaceRoleBinding(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateNamespaceRoleBinding operation middleware
-func (siw *ServerInterfaceWrapper) UpdateNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
+// DenyAuthzAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) DenyAuthzAccessRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2929,7 +3081,7 @@ func (siw *ServerInterfaceWrapper) UpdateNamespaceRoleBinding(w http.ResponseWri
 	}
 
 	// ------------- Path parameter "name" -------------
-	var name string
+	var name AuthzAccessRequestNameParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2944,7 +3096,7 @@ func (siw *ServerInterfaceWrapper) UpdateNamespaceRoleBinding(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateNamespaceRoleBinding(w, r, namespaceName, name)
+		siw.Handler.DenyAuthzAccessRequest(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2954,8 +3106,8 @@ func (siw *ServerInterfaceWrapper) UpdateNamespaceRoleBinding(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// ListNamespaceRoles operation middleware
-func (siw *ServerInterfaceWrapper) ListNamespaceRoles(w http.ResponseWriter, r *http.Request) {
+// ListNamespaceRoleBindings operation middleware
+func (siw *ServerInterfaceWrapper) ListNamespaceRoleBindings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2975,7 +3127,7 @@ func (siw *ServerInterfaceWrapper) ListNamespaceRoles(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNamespaceRolesParams
+	var params ListNamespaceRoleBindingsParams
 
 	// ------------- Optional query parameter "labelSelector" -------------
 
@@ -3002,7 +3154,7 @@ func (siw *ServerInterfaceWrapper) ListNamespaceRoles(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListNamespaceRoles(w, r, namespaceName, params)
+		siw.Handler.ListNamespaceRoleBindings(w, r, namespaceName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3012,8 +3164,8 @@ func (siw *ServerInterfaceWrapper) ListNamespaceRoles(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// CreateNamespaceRole operation middleware
-func (siw *ServerInterfaceWrapper) CreateNamespaceRole(w http.ResponseWriter, r *http.Request) {
+// CreateNamespaceRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) CreateNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3033,7 +3185,7 @@ func (siw *ServerInterfaceWrapper) CreateNamespaceRole(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateNamespaceRole(w, r, namespaceName)
+		siw.Handler.CreateNamespaceRoleBinding(w, r, namespaceName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3043,8 +3195,8 @@ func (siw *ServerInterfaceWrapper) CreateNamespaceRole(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteNamespaceRole operation middleware
-func (siw *ServerInterfaceWrapper) DeleteNamespaceRole(w http.ResponseWriter, r *http.Request) {
+// DeleteNamespaceRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3073,7 +3225,7 @@ func (siw *ServerInterfaceWrapper) DeleteNamespaceRole(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteNamespaceRole(w, r, namespaceName, name)
+		siw.Handler.DeleteNamespaceRoleBinding(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3083,8 +3235,8 @@ func (siw *ServerInterfaceWrapper) DeleteNamespaceRole(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetNamespaceRole operation middleware
-func (siw *ServerInterfaceWrapper) GetNamespaceRole(w http.ResponseWriter, r *http.Request) {
+// GetNamespaceRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) GetNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3113,7 +3265,7 @@ func (siw *ServerInterfaceWrapper) GetNamespaceRole(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNamespaceRole(w, r, namespaceName, name)
+		siw.Handler.GetNamespaceRoleBinding(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3123,8 +3275,8 @@ func (siw *ServerInterfaceWrapper) GetNamespaceRole(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateNamespaceRole operation middleware
-func (siw *ServerInterfaceWrapper) UpdateNamespaceRole(w http.ResponseWriter, r *http.Request) {
+// UpdateNamespaceRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNamespaceRoleBinding(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3153,7 +3305,7 @@ func (siw *ServerInterfaceWrapper) UpdateNamespaceRole(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateNamespaceRole(w, r, namespaceName, name)
+		siw.Handler.UpdateNamespaceRoleBinding(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3163,8 +3315,8 @@ func (siw *ServerInterfaceWrapper) UpdateNamespaceRole(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListComponentReleases operation middleware
-func (siw *ServerInterfaceWrapper) ListComponentReleases(w http.ResponseWriter, r *http.Request) {
+// ListNamespaceRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListNamespaceRoles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3184,15 +3336,7 @@ func (siw *ServerInterfaceWrapper) ListComponentReleases(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListComponentReleasesParams
-
-	// ------------- Optional query parameter "component" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "component", r.URL.Query(), &params.Component)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "component", Err: err})
-		return
-	}
+	var params ListNamespaceRolesParams
 
 	// ------------- Optional query parameter "labelSelector" -------------
 
@@ -3219,7 +3363,7 @@ func (siw *ServerInterfaceWrapper) ListComponentReleases(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListComponentReleases(w, r, namespaceName, params)
+		siw.Handler.ListNamespaceRoles(w, r, namespaceName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3229,8 +3373,8 @@ func (siw *ServerInterfaceWrapper) ListComponentReleases(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateComponentRelease operation middleware
-func (siw *ServerInterfaceWrapper) CreateComponentRelease(w http.ResponseWriter, r *http.Request) {
+// CreateNamespaceRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateNamespaceRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3250,7 +3394,7 @@ func (siw *ServerInterfaceWrapper) CreateComponentRelease(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateComponentRelease(w, r, namespaceName)
+		siw.Handler.CreateNamespaceRole(w, r, namespaceName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3260,8 +3404,8 @@ func (siw *ServerInterfaceWrapper) CreateComponentRelease(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteComponentRelease operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComponentRelease(w http.ResponseWriter, r *http.Request) {
+// DeleteNamespaceRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNamespaceRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3274,12 +3418,12 @@ func (siw *ServerInterfaceWrapper) DeleteComponentRelease(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "componentReleaseName" -------------
-	var componentReleaseName ComponentReleaseNameParam
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "componentReleaseName", r.PathValue("componentReleaseName"), &componentReleaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentReleaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
@@ -3290,7 +3434,7 @@ func (siw *ServerInterfaceWrapper) DeleteComponentRelease(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteComponentRelease(w, r, namespaceName, componentReleaseName)
+		siw.Handler.DeleteNamespaceRole(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3300,8 +3444,8 @@ func (siw *ServerInterfaceWrapper) DeleteComponentRelease(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetComponentRelease operation middleware
-func (siw *ServerInterfaceWrapper) GetComponentRelease(w http.ResponseWriter, r *http.Request) {
+// GetNamespaceRole operation middleware
+func (siw *ServerInterfaceWrapper) GetNamespaceRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3314,12 +3458,12 @@ func (siw *ServerInterfaceWrapper) GetComponentRelease(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "componentReleaseName" -------------
-	var componentReleaseName ComponentReleaseNameParam
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "componentReleaseName", r.PathValue("componentReleaseName"), &componentReleaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentReleaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
@@ -3330,7 +3474,7 @@ func (siw *ServerInterfaceWrapper) GetComponentRelease(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComponentRelease(w, r, namespaceName, componentReleaseName)
+		siw.Handler.GetNamespaceRole(w, r, namespaceName, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3340,8 +3484,48 @@ func (siw *ServerInterfaceWrapper) GetComponentRelease(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListComponents operation middleware
-func (siw *ServerInterfaceWrapper) ListComponents(w http.ResponseWriter, r *http.Request) {
+// UpdateNamespaceRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNamespaceRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNamespaceRole(w, r, namespaceName, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListComponentReleases operation middleware
+func (siw *ServerInterfaceWrapper) ListComponentReleases(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3361,13 +3545,13 @@ func (siw *ServerInterfaceWrapper) ListComponents(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListComponentsParams
+	var params ListComponentReleasesParams
 
-	// ------------- Optional query parameter "project" -------------
+	// ------------- Optional query parameter "component" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "project", r.URL.Query(), &params.Project)
+	err = runtime.BindQueryParameter("form", true, false, "component", r.URL.Query(), &params.Component)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "component", Err: err})
 		return
 	}
 
@@ -3396,7 +3580,7 @@ func (siw *ServerInterfaceWrapper) ListComponents(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListComponents(w, r, namespaceName, params)
+		siw.Handler.ListComponentReleases(w, r, namespaceName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3406,8 +3590,8 @@ func (siw *ServerInterfaceWrapper) ListComponents(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateComponent operation middleware
-func (siw *ServerInterfaceWrapper) CreateComponent(w http.ResponseWriter, r *http.Request) {
+// CreateComponentRelease operation middleware
+func (siw *ServerInterfaceWrapper) CreateComponentRelease(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3427,7 +3611,7 @@ func (siw *ServerInterfaceWrapper) CreateComponent(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateComponent(w, r, namespaceName)
+		siw.Handler.CreateComponentRelease(w, r, namespaceName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3437,8 +3621,185 @@ func (siw *ServerInterfaceWrapper) CreateComponent(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteComponent operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComponent(w http.ResponseWriter, r *http.Request) {
+// DeleteComponentRelease operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComponentRelease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "componentReleaseName" -------------
+	var componentReleaseName ComponentReleaseNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentReleaseName", r.PathValue("componentReleaseName"), &componentReleaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentReleaseName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComponentRelease(w, r, namespaceName, componentReleaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetComponentRelease operation middleware
+func (siw *ServerInterfaceWrapper) GetComponentRelease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "componentReleaseName" -------------
+	var componentReleaseName ComponentReleaseNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "componentReleaseName", r.PathValue("componentReleaseName"), &componentReleaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "componentReleaseName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComponentRelease(w, r, namespaceName, componentReleaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListComponents operation middleware
+func (siw *ServerInterfaceWrapper) ListComponents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComponentsParams
+
+	// ------------- Optional query parameter "project" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComponents(w, r, namespaceName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateComponent operation middleware
+func (siw *ServerInterfaceWrapper) CreateComponent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateComponent(w, r, namespaceName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteComponent operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComponent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -7737,8 +8098,8 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflowPlane(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflowRuns operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *http.Request) {
+// ListWorkflowRunGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflowRunGroups(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -7758,15 +8119,7 @@ func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWorkflowRunsParams
-
-	// ------------- Optional query parameter "workflow" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflow", r.URL.Query(), &params.Workflow)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow", Err: err})
-		return
-	}
+	var params ListWorkflowRunGroupsParams
 
 	// ------------- Optional query parameter "labelSelector" -------------
 
@@ -7793,7 +8146,7 @@ func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflowRuns(w, r, namespaceName, params)
+		siw.Handler.ListWorkflowRunGroups(w, r, namespaceName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -7803,8 +8156,8 @@ func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWorkflowRun operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflowRun(w http.ResponseWriter, r *http.Request) {
+// GetWorkflowRunGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowRunGroup(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -7817,6 +8170,15 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowRun(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Path parameter "groupName" -------------
+	var groupName WorkflowRunGroupNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupName", r.PathValue("groupName"), &groupName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupName", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -7824,7 +8186,7 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowRun(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflowRun(w, r, namespaceName)
+		siw.Handler.GetWorkflowRunGroup(w, r, namespaceName, groupName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -7834,8 +8196,8 @@ func (siw *ServerInterfaceWrapper) CreateWorkflowRun(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWorkflowRun operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWorkflowRun(w http.ResponseWriter, r *http.Request) {
+// ListWorkflowRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflowRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -7848,23 +8210,49 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflowRun(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Path parameter "runName" -------------
-	var runName WorkflowRunNameParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkflowRunsParams
+
+	// ------------- Optional query parameter "workflow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflow", r.URL.Query(), &params.Workflow)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "labelSelector" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWorkflowRun(w, r, namespaceName, runName)
+		siw.Handler.ListWorkflowRuns(w, r, namespaceName, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -7874,8 +8262,8 @@ func (siw *ServerInterfaceWrapper) DeleteWorkflowRun(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflowRun operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http.Request) {
+// CreateWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflowRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -7888,15 +8276,86 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "runName" -------------
-	var runName WorkflowRunNameParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
-		return
-	}
-
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflowRun(w, r, namespaceName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkflowRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runName" -------------
+	var runName WorkflowRunNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWorkflowRun(w, r, namespaceName, runName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runName" -------------
+	var runName WorkflowRunNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -7954,6 +8413,46 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflowRun(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// CancelWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) CancelWorkflowRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runName" -------------
+	var runName WorkflowRunNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelWorkflowRun(w, r, namespaceName, runName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetWorkflowRunEvents operation middleware
 func (siw *ServerInterfaceWrapper) GetWorkflowRunEvents(w http.ResponseWriter, r *http.Request) {
 
@@ -8064,6 +8563,86 @@ func (siw *ServerInterfaceWrapper) GetWorkflowRunLogs(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// ResumeWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) ResumeWorkflowRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runName" -------------
+	var runName WorkflowRunNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeWorkflowRun(w, r, namespaceName, runName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryWorkflowRun operation middleware
+func (siw *ServerInterfaceWrapper) RetryWorkflowRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespaceName" -------------
+	var namespaceName NamespaceNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespaceName", r.PathValue("namespaceName"), &namespaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespaceName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runName" -------------
+	var runName WorkflowRunNameParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runName", r.PathValue("runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryWorkflowRun(w, r, namespaceName, runName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetWorkflowRunStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetWorkflowRunStatus(w http.ResponseWriter, r *http.Request) {
 
@@ -9189,8 +9768,11 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/.well-known/oauth-protected-resource", wrapper.GetOAuthProtectedResourceMetadata)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/authn/subject-types", wrapper.ListSubjectTypes)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/authz/actions", wrapper.ListActions)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/authz/decisions", wrapper.ListAuthzDecisions)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/authz/evaluates", wrapper.Evaluates)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/authz/explain", wrapper.ExplainAuthz)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/authz/profile", wrapper.GetSubjectProfile)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/authz/simulate", wrapper.SimulateAuthz)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/clusterauthzrolebindings", wrapper.ListClusterRoleBindings)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/clusterauthzrolebindings", wrapper.CreateClusterRoleBinding)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/clusterauthzrolebindings/{name}", wrapper.DeleteClusterRoleBinding)
@@ -9251,6 +9833,11 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/namespaces/{namespaceName}", wrapper.DeleteNamespace)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}", wrapper.GetNamespace)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/namespaces/{namespaceName}", wrapper.UpdateNamespace)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzaccessrequests", wrapper.ListAuthzAccessRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzaccessrequests", wrapper.CreateAuthzAccessRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}", wrapper.GetAuthzAccessRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/approve", wrapper.ApproveAuthzAccessRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/deny", wrapper.DenyAuthzAccessRequest)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzrolebindings", wrapper.ListNamespaceRoleBindings)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzrolebindings", wrapper.CreateNamespaceRoleBinding)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/authzrolebindings/{name}", wrapper.DeleteNamespaceRoleBinding)
@@ -9367,13 +9954,18 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowplanes/{workflowPlaneName}", wrapper.DeleteWorkflowPlane)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowplanes/{workflowPlaneName}", wrapper.GetWorkflowPlane)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowplanes/{workflowPlaneName}", wrapper.UpdateWorkflowPlane)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowrungroups", wrapper.ListWorkflowRunGroups)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowrungroups/{groupName}", wrapper.GetWorkflowRunGroup)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns", wrapper.ListWorkflowRuns)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns", wrapper.CreateWorkflowRun)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}", wrapper.DeleteWorkflowRun)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}", wrapper.GetWorkflowRun)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}", wrapper.UpdateWorkflowRun)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}/cancel", wrapper.CancelWorkflowRun)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}/events", wrapper.GetWorkflowRunEvents)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}/logs", wrapper.GetWorkflowRunLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}/resume", wrapper.ResumeWorkflowRun)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}/retry", wrapper.RetryWorkflowRun)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflowruns/{runName}/status", wrapper.GetWorkflowRunStatus)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflows", wrapper.ListWorkflows)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/namespaces/{namespaceName}/workflows", wrapper.CreateWorkflow)
@@ -9513,168 +10105,327 @@ func (response ListActions500JSONResponse) VisitListActionsResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EvaluatesRequestObject struct {
-	Body *EvaluatesJSONRequestBody
+type ListAuthzDecisionsRequestObject struct {
+	Params ListAuthzDecisionsParams
 }
 
-type EvaluatesResponseObject interface {
-	VisitEvaluatesResponse(w http.ResponseWriter) error
+type ListAuthzDecisionsResponseObject interface {
+	VisitListAuthzDecisionsResponse(w http.ResponseWriter) error
 }
 
-type Evaluates200JSONResponse []Decision
+type ListAuthzDecisions200JSONResponse AuthzDecisionRecordList
 
-func (response Evaluates200JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
+func (response ListAuthzDecisions200JSONResponse) VisitListAuthzDecisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Evaluates400JSONResponse struct{ BadRequestJSONResponse }
+type ListAuthzDecisions400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response Evaluates400JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
+func (response ListAuthzDecisions400JSONResponse) VisitListAuthzDecisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Evaluates401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListAuthzDecisions401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response Evaluates401JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
+func (response ListAuthzDecisions401JSONResponse) VisitListAuthzDecisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Evaluates422JSONResponse struct {
-	UnprocessableContentJSONResponse
-}
+type ListAuthzDecisions403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response Evaluates422JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
+func (response ListAuthzDecisions403JSONResponse) VisitListAuthzDecisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Evaluates500JSONResponse struct{ InternalErrorJSONResponse }
+type ListAuthzDecisions500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response Evaluates500JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
+func (response ListAuthzDecisions500JSONResponse) VisitListAuthzDecisionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSubjectProfileRequestObject struct {
-	Params GetSubjectProfileParams
+type EvaluatesRequestObject struct {
+	Body *EvaluatesJSONRequestBody
 }
 
-type GetSubjectProfileResponseObject interface {
-	VisitGetSubjectProfileResponse(w http.ResponseWriter) error
+type EvaluatesResponseObject interface {
+	VisitEvaluatesResponse(w http.ResponseWriter) error
 }
 
-type GetSubjectProfile200JSONResponse UserCapabilitiesResponse
+type Evaluates200JSONResponse []Decision
 
-func (response GetSubjectProfile200JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+func (response Evaluates200JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSubjectProfile400JSONResponse struct{ BadRequestJSONResponse }
+type Evaluates400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetSubjectProfile400JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+func (response Evaluates400JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSubjectProfile401JSONResponse struct{ UnauthorizedJSONResponse }
+type Evaluates401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetSubjectProfile401JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+func (response Evaluates401JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSubjectProfile403JSONResponse struct{ ForbiddenJSONResponse }
+type Evaluates422JSONResponse struct {
+	UnprocessableContentJSONResponse
+}
 
-func (response GetSubjectProfile403JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+func (response Evaluates422JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(422)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSubjectProfile500JSONResponse struct{ InternalErrorJSONResponse }
+type Evaluates500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response GetSubjectProfile500JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+func (response Evaluates500JSONResponse) VisitEvaluatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListClusterRoleBindingsRequestObject struct {
-	Params ListClusterRoleBindingsParams
+type ExplainAuthzRequestObject struct {
+	Body *ExplainAuthzJSONRequestBody
 }
 
-type ListClusterRoleBindingsResponseObject interface {
-	VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error
+type ExplainAuthzResponseObject interface {
+	VisitExplainAuthzResponse(w http.ResponseWriter) error
 }
 
-type ListClusterRoleBindings200JSONResponse ClusterAuthzRoleBindingList
+type ExplainAuthz200JSONResponse AuthzExplanation
 
-func (response ListClusterRoleBindings200JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz200JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListClusterRoleBindings400JSONResponse struct{ BadRequestJSONResponse }
+type ExplainAuthz400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListClusterRoleBindings400JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz400JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListClusterRoleBindings401JSONResponse struct{ UnauthorizedJSONResponse }
+type ExplainAuthz401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListClusterRoleBindings401JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz401JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListClusterRoleBindings403JSONResponse struct{ ForbiddenJSONResponse }
+type ExplainAuthz403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListClusterRoleBindings403JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz403JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListClusterRoleBindings500JSONResponse struct{ InternalErrorJSONResponse }
+type ExplainAuthz500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ListClusterRoleBindings500JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+func (response ExplainAuthz500JSONResponse) VisitExplainAuthzResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateClusterRoleBindingRequestObject struct {
+type GetSubjectProfileRequestObject struct {
+	Params GetSubjectProfileParams
+}
+
+type GetSubjectProfileResponseObject interface {
+	VisitGetSubjectProfileResponse(w http.ResponseWriter) error
+}
+
+type GetSubjectProfile200JSONResponse UserCapabilitiesResponse
+
+func (response GetSubjectProfile200JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectProfile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSubjectProfile400JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectProfile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetSubjectProfile401JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectProfile403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetSubjectProfile403JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubjectProfile500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSubjectProfile500JSONResponse) VisitGetSubjectProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateAuthzRequestObject struct {
+	Body *SimulateAuthzJSONRequestBody
+}
+
+type SimulateAuthzResponseObject interface {
+	VisitSimulateAuthzResponse(w http.ResponseWriter) error
+}
+
+type SimulateAuthz200JSONResponse AuthzExplanation
+
+func (response SimulateAuthz200JSONResponse) VisitSimulateAuthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateAuthz400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SimulateAuthz400JSONResponse) VisitSimulateAuthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateAuthz401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SimulateAuthz401JSONResponse) VisitSimulateAuthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateAuthz403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SimulateAuthz403JSONResponse) VisitSimulateAuthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateAuthz500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SimulateAuthz500JSONResponse) VisitSimulateAuthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterRoleBindingsRequestObject struct {
+	Params ListClusterRoleBindingsParams
+}
+
+type ListClusterRoleBindingsResponseObject interface {
+	VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error
+}
+
+type ListClusterRoleBindings200JSONResponse ClusterAuthzRoleBindingList
+
+func (response ListClusterRoleBindings200JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterRoleBindings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListClusterRoleBindings400JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterRoleBindings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListClusterRoleBindings401JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterRoleBindings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListClusterRoleBindings403JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterRoleBindings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListClusterRoleBindings500JSONResponse) VisitListClusterRoleBindingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClusterRoleBindingRequestObject struct {
 	Body *CreateClusterRoleBindingJSONRequestBody
 }
 
@@ -12913,374 +13664,673 @@ type UpdateClusterWorkflow422JSONResponse struct {
 	UnprocessableContentJSONResponse
 }
 
-func (response UpdateClusterWorkflow422JSONResponse) VisitUpdateClusterWorkflowResponse(w http.ResponseWriter) error {
+func (response UpdateClusterWorkflow422JSONResponse) VisitUpdateClusterWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClusterWorkflow500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateClusterWorkflow500JSONResponse) VisitUpdateClusterWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterWorkflowSchemaRequestObject struct {
+	ClusterWorkflowName ClusterWorkflowNameParam `json:"clusterWorkflowName"`
+}
+
+type GetClusterWorkflowSchemaResponseObject interface {
+	VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error
+}
+
+type GetClusterWorkflowSchema200JSONResponse SchemaResponse
+
+func (response GetClusterWorkflowSchema200JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterWorkflowSchema401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetClusterWorkflowSchema401JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterWorkflowSchema403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetClusterWorkflowSchema403JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterWorkflowSchema404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetClusterWorkflowSchema404JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterWorkflowSchema500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetClusterWorkflowSchema500JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespacesRequestObject struct {
+	Params ListNamespacesParams
+}
+
+type ListNamespacesResponseObject interface {
+	VisitListNamespacesResponse(w http.ResponseWriter) error
+}
+
+type ListNamespaces200JSONResponse NamespaceList
+
+func (response ListNamespaces200JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaces400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListNamespaces400JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaces401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListNamespaces401JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaces403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListNamespaces403JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaces500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListNamespaces500JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespaceRequestObject struct {
+	Body *CreateNamespaceJSONRequestBody
+}
+
+type CreateNamespaceResponseObject interface {
+	VisitCreateNamespaceResponse(w http.ResponseWriter) error
+}
+
+type CreateNamespace201JSONResponse Namespace
+
+func (response CreateNamespace201JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespace400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateNamespace400JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateNamespace401JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateNamespace403JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespace409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateNamespace409JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespace422JSONResponse struct {
+	UnprocessableContentJSONResponse
+}
+
+func (response CreateNamespace422JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateNamespace500JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNamespaceRequestObject struct {
+	NamespaceName NamespaceNameParam `json:"namespaceName"`
+}
+
+type DeleteNamespaceResponseObject interface {
+	VisitDeleteNamespaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteNamespace204Response struct {
+}
+
+func (response DeleteNamespace204Response) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteNamespace401JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteNamespace403JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNamespace404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteNamespace404JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteNamespace500JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceRequestObject struct {
+	NamespaceName NamespaceNameParam `json:"namespaceName"`
+}
+
+type GetNamespaceResponseObject interface {
+	VisitGetNamespaceResponse(w http.ResponseWriter) error
+}
+
+type GetNamespace200JSONResponse Namespace
+
+func (response GetNamespace200JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetNamespace401JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetNamespace403JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespace404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetNamespace404JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateClusterWorkflow500JSONResponse struct{ InternalErrorJSONResponse }
+type GetNamespace500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UpdateClusterWorkflow500JSONResponse) VisitUpdateClusterWorkflowResponse(w http.ResponseWriter) error {
+func (response GetNamespace500JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClusterWorkflowSchemaRequestObject struct {
-	ClusterWorkflowName ClusterWorkflowNameParam `json:"clusterWorkflowName"`
+type UpdateNamespaceRequestObject struct {
+	NamespaceName NamespaceNameParam `json:"namespaceName"`
+	Body          *UpdateNamespaceJSONRequestBody
 }
 
-type GetClusterWorkflowSchemaResponseObject interface {
-	VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error
+type UpdateNamespaceResponseObject interface {
+	VisitUpdateNamespaceResponse(w http.ResponseWriter) error
 }
 
-type GetClusterWorkflowSchema200JSONResponse SchemaResponse
+type UpdateNamespace200JSONResponse Namespace
 
-func (response GetClusterWorkflowSchema200JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+func (response UpdateNamespace200JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClusterWorkflowSchema401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateNamespace400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetClusterWorkflowSchema401JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+func (response UpdateNamespace400JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateNamespace401JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClusterWorkflowSchema403JSONResponse struct{ ForbiddenJSONResponse }
+type UpdateNamespace403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetClusterWorkflowSchema403JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+func (response UpdateNamespace403JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClusterWorkflowSchema404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateNamespace404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetClusterWorkflowSchema404JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+func (response UpdateNamespace404JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetClusterWorkflowSchema500JSONResponse struct{ InternalErrorJSONResponse }
+type UpdateNamespace422JSONResponse struct {
+	UnprocessableContentJSONResponse
+}
 
-func (response GetClusterWorkflowSchema500JSONResponse) VisitGetClusterWorkflowSchemaResponse(w http.ResponseWriter) error {
+func (response UpdateNamespace422JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateNamespace500JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListNamespacesRequestObject struct {
-	Params ListNamespacesParams
+type ListAuthzAccessRequestsRequestObject struct {
+	NamespaceName NamespaceNameParam `json:"namespaceName"`
+	Params        ListAuthzAccessRequestsParams
 }
 
-type ListNamespacesResponseObject interface {
-	VisitListNamespacesResponse(w http.ResponseWriter) error
+type ListAuthzAccessRequestsResponseObject interface {
+	VisitListAuthzAccessRequestsResponse(w http.ResponseWriter) error
 }
 
-type ListNamespaces200JSONResponse NamespaceList
+type ListAuthzAccessRequests200JSONResponse AuthzAccessRequestList
 
-func (response ListNamespaces200JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+func (response ListAuthzAccessRequests200JSONResponse) VisitListAuthzAccessRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListNamespaces400JSONResponse struct{ BadRequestJSONResponse }
+type ListAuthzAccessRequests400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListNamespaces400JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+func (response ListAuthzAccessRequests400JSONResponse) VisitListAuthzAccessRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListNamespaces401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListAuthzAccessRequests401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListNamespaces401JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+func (response ListAuthzAccessRequests401JSONResponse) VisitListAuthzAccessRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListNamespaces403JSONResponse struct{ ForbiddenJSONResponse }
+type ListAuthzAccessRequests403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListNamespaces403JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+func (response ListAuthzAccessRequests403JSONResponse) VisitListAuthzAccessRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListNamespaces500JSONResponse struct{ InternalErrorJSONResponse }
+type ListAuthzAccessRequests500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ListNamespaces500JSONResponse) VisitListNamespacesResponse(w http.ResponseWriter) error {
+func (response ListAuthzAccessRequests500JSONResponse) VisitListAuthzAccessRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateNamespaceRequestObject struct {
-	Body *CreateNamespaceJSONRequestBody
+type CreateAuthzAccessRequestRequestObject struct {
+	NamespaceName NamespaceNameParam `json:"namespaceName"`
+	Body          *CreateAuthzAccessRequestJSONRequestBody
 }
 
-type CreateNamespaceResponseObject interface {
-	VisitCreateNamespaceResponse(w http.ResponseWriter) error
+type CreateAuthzAccessRequestResponseObject interface {
+	VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type CreateNamespace201JSONResponse Namespace
+type CreateAuthzAccessRequest201JSONResponse AuthzAccessRequest
 
-func (response CreateNamespace201JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+func (response CreateAuthzAccessRequest201JSONResponse) VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateNamespace400JSONResponse struct{ BadRequestJSONResponse }
+type CreateAuthzAccessRequest400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateNamespace400JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+func (response CreateAuthzAccessRequest400JSONResponse) VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateAuthzAccessRequest401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateNamespace401JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+func (response CreateAuthzAccessRequest401JSONResponse) VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+type CreateAuthzAccessRequest403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response CreateNamespace403JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+func (response CreateAuthzAccessRequest403JSONResponse) VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateNamespace409JSONResponse struct{ ConflictJSONResponse }
+type CreateAuthzAccessRequest409JSONResponse struct{ ConflictJSONResponse }
 
-func (response CreateNamespace409JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+func (response CreateAuthzAccessRequest409JSONResponse) VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateNamespace422JSONResponse struct {
-	UnprocessableContentJSONResponse
-}
-
-func (response CreateNamespace422JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+type CreateAuthzAccessRequest500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response CreateNamespace500JSONResponse) VisitCreateNamespaceResponse(w http.ResponseWriter) error {
+func (response CreateAuthzAccessRequest500JSONResponse) VisitCreateAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNamespaceRequestObject struct {
-	NamespaceName NamespaceNameParam `json:"namespaceName"`
+type GetAuthzAccessRequestRequestObject struct {
+	NamespaceName NamespaceNameParam          `json:"namespaceName"`
+	Name          AuthzAccessRequestNameParam `json:"name"`
 }
 
-type DeleteNamespaceResponseObject interface {
-	VisitDeleteNamespaceResponse(w http.ResponseWriter) error
+type GetAuthzAccessRequestResponseObject interface {
+	VisitGetAuthzAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type DeleteNamespace204Response struct {
-}
+type GetAuthzAccessRequest200JSONResponse AuthzAccessRequest
 
-func (response DeleteNamespace204Response) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response GetAuthzAccessRequest200JSONResponse) VisitGetAuthzAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAuthzAccessRequest401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteNamespace401JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+func (response GetAuthzAccessRequest401JSONResponse) VisitGetAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+type GetAuthzAccessRequest403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteNamespace403JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+func (response GetAuthzAccessRequest403JSONResponse) VisitGetAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNamespace404JSONResponse struct{ NotFoundJSONResponse }
+type GetAuthzAccessRequest404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteNamespace404JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+func (response GetAuthzAccessRequest404JSONResponse) VisitGetAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+type GetAuthzAccessRequest500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response DeleteNamespace500JSONResponse) VisitDeleteNamespaceResponse(w http.ResponseWriter) error {
+func (response GetAuthzAccessRequest500JSONResponse) VisitGetAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNamespaceRequestObject struct {
-	NamespaceName NamespaceNameParam `json:"namespaceName"`
+type ApproveAuthzAccessRequestRequestObject struct {
+	NamespaceName NamespaceNameParam          `json:"namespaceName"`
+	Name          AuthzAccessRequestNameParam `json:"name"`
+	Body          *ApproveAuthzAccessRequestJSONRequestBody
 }
 
-type GetNamespaceResponseObject interface {
-	VisitGetNamespaceResponse(w http.ResponseWriter) error
+type ApproveAuthzAccessRequestResponseObject interface {
+	VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type GetNamespace200JSONResponse Namespace
+type ApproveAuthzAccessRequest200JSONResponse AuthzAccessRequest
 
-func (response GetNamespace200JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+func (response ApproveAuthzAccessRequest200JSONResponse) VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+type ApproveAuthzAccessRequest401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetNamespace401JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+func (response ApproveAuthzAccessRequest401JSONResponse) VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+type ApproveAuthzAccessRequest403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetNamespace403JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+func (response ApproveAuthzAccessRequest403JSONResponse) VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNamespace404JSONResponse struct{ NotFoundJSONResponse }
+type ApproveAuthzAccessRequest404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetNamespace404JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+func (response ApproveAuthzAccessRequest404JSONResponse) VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+type ApproveAuthzAccessRequest409JSONResponse struct{ ConflictJSONResponse }
 
-func (response GetNamespace500JSONResponse) VisitGetNamespaceResponse(w http.ResponseWriter) error {
+func (response ApproveAuthzAccessRequest409JSONResponse) VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespaceRequestObject struct {
-	NamespaceName NamespaceNameParam `json:"namespaceName"`
-	Body          *UpdateNamespaceJSONRequestBody
-}
-
-type UpdateNamespaceResponseObject interface {
-	VisitUpdateNamespaceResponse(w http.ResponseWriter) error
-}
-
-type UpdateNamespace200JSONResponse Namespace
+type ApproveAuthzAccessRequest500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UpdateNamespace200JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+func (response ApproveAuthzAccessRequest500JSONResponse) VisitApproveAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespace400JSONResponse struct{ BadRequestJSONResponse }
+type DenyAuthzAccessRequestRequestObject struct {
+	NamespaceName NamespaceNameParam          `json:"namespaceName"`
+	Name          AuthzAccessRequestNameParam `json:"name"`
+	Body          *DenyAuthzAccessRequestJSONRequestBody
+}
 
-func (response UpdateNamespace400JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+type DenyAuthzAccessRequestResponseObject interface {
+	VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error
+}
+
+type DenyAuthzAccessRequest200JSONResponse AuthzAccessRequest
+
+func (response DenyAuthzAccessRequest200JSONResponse) VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+type DenyAuthzAccessRequest401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateNamespace401JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+func (response DenyAuthzAccessRequest401JSONResponse) VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+type DenyAuthzAccessRequest403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpdateNamespace403JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+func (response DenyAuthzAccessRequest403JSONResponse) VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespace404JSONResponse struct{ NotFoundJSONResponse }
+type DenyAuthzAccessRequest404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpdateNamespace404JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+func (response DenyAuthzAccessRequest404JSONResponse) VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespace422JSONResponse struct {
-	UnprocessableContentJSONResponse
-}
+type DenyAuthzAccessRequest409JSONResponse struct{ ConflictJSONResponse }
 
-func (response UpdateNamespace422JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+func (response DenyAuthzAccessRequest409JSONResponse) VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+type DenyAuthzAccessRequest500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UpdateNamespace500JSONResponse) VisitUpdateNamespaceResponse(w http.ResponseWriter) error {
+func (response DenyAuthzAccessRequest500JSONResponse) VisitDenyAuthzAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -20366,109 +21416,217 @@ func (response GetWorkflowPlane401JSONResponse) VisitGetWorkflowPlaneResponse(w
 
 type GetWorkflowPlane403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetWorkflowPlane403JSONResponse) VisitGetWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response GetWorkflowPlane403JSONResponse) VisitGetWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowPlane404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWorkflowPlane404JSONResponse) VisitGetWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowPlane500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetWorkflowPlane500JSONResponse) VisitGetWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowPlaneRequestObject struct {
+	NamespaceName     NamespaceNameParam     `json:"namespaceName"`
+	WorkflowPlaneName WorkflowPlaneNameParam `json:"workflowPlaneName"`
+	Body              *UpdateWorkflowPlaneJSONRequestBody
+}
+
+type UpdateWorkflowPlaneResponseObject interface {
+	VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkflowPlane200JSONResponse WorkflowPlane
+
+func (response UpdateWorkflowPlane200JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowPlane400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateWorkflowPlane400JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowPlane401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateWorkflowPlane401JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowPlane403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateWorkflowPlane403JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowPlane404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateWorkflowPlane404JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkflowPlane409JSONResponse struct{ ConflictJSONResponse }
+
+func (response UpdateWorkflowPlane409JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowPlane404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateWorkflowPlane422JSONResponse struct {
+	UnprocessableContentJSONResponse
+}
 
-func (response GetWorkflowPlane404JSONResponse) VisitGetWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response UpdateWorkflowPlane422JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(422)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetWorkflowPlane500JSONResponse struct{ InternalErrorJSONResponse }
+type UpdateWorkflowPlane500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response GetWorkflowPlane500JSONResponse) VisitGetWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response UpdateWorkflowPlane500JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlaneRequestObject struct {
-	NamespaceName     NamespaceNameParam     `json:"namespaceName"`
-	WorkflowPlaneName WorkflowPlaneNameParam `json:"workflowPlaneName"`
-	Body              *UpdateWorkflowPlaneJSONRequestBody
+type ListWorkflowRunGroupsRequestObject struct {
+	NamespaceName NamespaceNameParam `json:"namespaceName"`
+	Params        ListWorkflowRunGroupsParams
 }
 
-type UpdateWorkflowPlaneResponseObject interface {
-	VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error
+type ListWorkflowRunGroupsResponseObject interface {
+	VisitListWorkflowRunGroupsResponse(w http.ResponseWriter) error
 }
 
-type UpdateWorkflowPlane200JSONResponse WorkflowPlane
+type ListWorkflowRunGroups200JSONResponse WorkflowRunGroupList
 
-func (response UpdateWorkflowPlane200JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response ListWorkflowRunGroups200JSONResponse) VisitListWorkflowRunGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane400JSONResponse struct{ BadRequestJSONResponse }
+type ListWorkflowRunGroups400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateWorkflowPlane400JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response ListWorkflowRunGroups400JSONResponse) VisitListWorkflowRunGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListWorkflowRunGroups401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateWorkflowPlane401JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response ListWorkflowRunGroups401JSONResponse) VisitListWorkflowRunGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane403JSONResponse struct{ ForbiddenJSONResponse }
+type ListWorkflowRunGroups403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpdateWorkflowPlane403JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response ListWorkflowRunGroups403JSONResponse) VisitListWorkflowRunGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane404JSONResponse struct{ NotFoundJSONResponse }
+type ListWorkflowRunGroups500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UpdateWorkflowPlane404JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response ListWorkflowRunGroups500JSONResponse) VisitListWorkflowRunGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane409JSONResponse struct{ ConflictJSONResponse }
+type GetWorkflowRunGroupRequestObject struct {
+	NamespaceName NamespaceNameParam        `json:"namespaceName"`
+	GroupName     WorkflowRunGroupNameParam `json:"groupName"`
+}
 
-func (response UpdateWorkflowPlane409JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+type GetWorkflowRunGroupResponseObject interface {
+	VisitGetWorkflowRunGroupResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowRunGroup200JSONResponse WorkflowRunGroup
+
+func (response GetWorkflowRunGroup200JSONResponse) VisitGetWorkflowRunGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane422JSONResponse struct {
-	UnprocessableContentJSONResponse
+type GetWorkflowRunGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWorkflowRunGroup401JSONResponse) VisitGetWorkflowRunGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response UpdateWorkflowPlane422JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+type GetWorkflowRunGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetWorkflowRunGroup403JSONResponse) VisitGetWorkflowRunGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWorkflowPlane500JSONResponse struct{ InternalErrorJSONResponse }
+type GetWorkflowRunGroup404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpdateWorkflowPlane500JSONResponse) VisitUpdateWorkflowPlaneResponse(w http.ResponseWriter) error {
+func (response GetWorkflowRunGroup404JSONResponse) VisitGetWorkflowRunGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowRunGroup500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetWorkflowRunGroup500JSONResponse) VisitGetWorkflowRunGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -20785,6 +21943,69 @@ func (response UpdateWorkflowRun500JSONResponse) VisitUpdateWorkflowRunResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type CancelWorkflowRunRequestObject struct {
+	NamespaceName NamespaceNameParam   `json:"namespaceName"`
+	RunName       WorkflowRunNameParam `json:"runName"`
+}
+
+type CancelWorkflowRunResponseObject interface {
+	VisitCancelWorkflowRunResponse(w http.ResponseWriter) error
+}
+
+type CancelWorkflowRun200JSONResponse WorkflowRun
+
+func (response CancelWorkflowRun200JSONResponse) VisitCancelWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelWorkflowRun401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelWorkflowRun401JSONResponse) VisitCancelWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelWorkflowRun403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CancelWorkflowRun403JSONResponse) VisitCancelWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelWorkflowRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelWorkflowRun404JSONResponse) VisitCancelWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelWorkflowRun409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CancelWorkflowRun409JSONResponse) VisitCancelWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelWorkflowRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CancelWorkflowRun500JSONResponse) VisitCancelWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetWorkflowRunEventsRequestObject struct {
 	NamespaceName NamespaceNameParam   `json:"namespaceName"`
 	RunName       WorkflowRunNameParam `json:"runName"`
@@ -20895,6 +22116,132 @@ func (response GetWorkflowRunLogs500JSONResponse) VisitGetWorkflowRunLogsRespons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ResumeWorkflowRunRequestObject struct {
+	NamespaceName NamespaceNameParam   `json:"namespaceName"`
+	RunName       WorkflowRunNameParam `json:"runName"`
+}
+
+type ResumeWorkflowRunResponseObject interface {
+	VisitResumeWorkflowRunResponse(w http.ResponseWriter) error
+}
+
+type ResumeWorkflowRun200JSONResponse WorkflowRun
+
+func (response ResumeWorkflowRun200JSONResponse) VisitResumeWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkflowRun401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResumeWorkflowRun401JSONResponse) VisitResumeWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkflowRun403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ResumeWorkflowRun403JSONResponse) VisitResumeWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkflowRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResumeWorkflowRun404JSONResponse) VisitResumeWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkflowRun409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ResumeWorkflowRun409JSONResponse) VisitResumeWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkflowRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ResumeWorkflowRun500JSONResponse) VisitResumeWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWorkflowRunRequestObject struct {
+	NamespaceName NamespaceNameParam   `json:"namespaceName"`
+	RunName       WorkflowRunNameParam `json:"runName"`
+}
+
+type RetryWorkflowRunResponseObject interface {
+	VisitRetryWorkflowRunResponse(w http.ResponseWriter) error
+}
+
+type RetryWorkflowRun201JSONResponse WorkflowRun
+
+func (response RetryWorkflowRun201JSONResponse) VisitRetryWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWorkflowRun401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RetryWorkflowRun401JSONResponse) VisitRetryWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWorkflowRun403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RetryWorkflowRun403JSONResponse) VisitRetryWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWorkflowRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RetryWorkflowRun404JSONResponse) VisitRetryWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWorkflowRun409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RetryWorkflowRun409JSONResponse) VisitRetryWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryWorkflowRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RetryWorkflowRun500JSONResponse) VisitRetryWorkflowRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetWorkflowRunStatusRequestObject struct {
 	NamespaceName NamespaceNameParam   `json:"namespaceName"`
 	RunName       WorkflowRunNameParam `json:"runName"`
@@ -22374,12 +23721,21 @@ type StrictServerInterface interface {
 	// List actions
 	// (GET /api/v1/authz/actions)
 	ListActions(ctx context.Context, request ListActionsRequestObject) (ListActionsResponseObject, error)
+	// List logged authorization decisions
+	// (GET /api/v1/authz/decisions)
+	ListAuthzDecisions(ctx context.Context, request ListAuthzDecisionsRequestObject) (ListAuthzDecisionsResponseObject, error)
 	// Evaluate authorization
 	// (POST /api/v1/authz/evaluates)
 	Evaluates(ctx context.Context, request EvaluatesRequestObject) (EvaluatesResponseObject, error)
+	// Explain an authorization decision
+	// (POST /api/v1/authz/explain)
+	ExplainAuthz(ctx context.Context, request ExplainAuthzRequestObject) (ExplainAuthzResponseObject, error)
 	// Get subject profile
 	// (GET /api/v1/authz/profile)
 	GetSubjectProfile(ctx context.Context, request GetSubjectProfileRequestObject) (GetSubjectProfileResponseObject, error)
+	// Simulate an authorization decision
+	// (POST /api/v1/authz/simulate)
+	SimulateAuthz(ctx context.Context, request SimulateAuthzRequestObject) (SimulateAuthzResponseObject, error)
 	// List cluster role bindings
 	// (GET /api/v1/clusterauthzrolebindings)
 	ListClusterRoleBindings(ctx context.Context, request ListClusterRoleBindingsRequestObject) (ListClusterRoleBindingsResponseObject, error)
@@ -22560,6 +23916,21 @@ type StrictServerInterface interface {
 	// Update namespace
 	// (PUT /api/v1/namespaces/{namespaceName})
 	UpdateNamespace(ctx context.Context, request UpdateNamespaceRequestObject) (UpdateNamespaceResponseObject, error)
+	// List access requests
+	// (GET /api/v1/namespaces/{namespaceName}/authzaccessrequests)
+	ListAuthzAccessRequests(ctx context.Context, request ListAuthzAccessRequestsRequestObject) (ListAuthzAccessRequestsResponseObject, error)
+	// Request access
+	// (POST /api/v1/namespaces/{namespaceName}/authzaccessrequests)
+	CreateAuthzAccessRequest(ctx context.Context, request CreateAuthzAccessRequestRequestObject) (CreateAuthzAccessRequestResponseObject, error)
+	// Get access request
+	// (GET /api/v1/namespaces/{namespaceName}/authzaccessrequests/{name})
+	GetAuthzAccessRequest(ctx context.Context, request GetAuthzAccessRequestRequestObject) (GetAuthzAccessRequestResponseObject, error)
+	// Approve access request
+	// (POST /api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/approve)
+	ApproveAuthzAccessRequest(ctx context.Context, request ApproveAuthzAccessRequestRequestObject) (ApproveAuthzAccessRequestResponseObject, error)
+	// Deny access request
+	// (POST /api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/deny)
+	DenyAuthzAccessRequest(ctx context.Context, request DenyAuthzAccessRequestRequestObject) (DenyAuthzAccessRequestResponseObject, error)
 	// List namespace role bindings
 	// (GET /api/v1/namespaces/{namespaceName}/authzrolebindings)
 	ListNamespaceRoleBindings(ctx context.Context, request ListNamespaceRoleBindingsRequestObject) (ListNamespaceRoleBindingsResponseObject, error)
@@ -22908,6 +24279,12 @@ type StrictServerInterface interface {
 	// Update workflow plane
 	// (PUT /api/v1/namespaces/{namespaceName}/workflowplanes/{workflowPlaneName})
 	UpdateWorkflowPlane(ctx context.Context, request UpdateWorkflowPlaneRequestObject) (UpdateWorkflowPlaneResponseObject, error)
+	// List workflow run groups
+	// (GET /api/v1/namespaces/{namespaceName}/workflowrungroups)
+	ListWorkflowRunGroups(ctx context.Context, request ListWorkflowRunGroupsRequestObject) (ListWorkflowRunGroupsResponseObject, error)
+	// Get workflow run group
+	// (GET /api/v1/namespaces/{namespaceName}/workflowrungroups/{groupName})
+	GetWorkflowRunGroup(ctx context.Context, request GetWorkflowRunGroupRequestObject) (GetWorkflowRunGroupResponseObject, error)
 	// List workflow runs
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns)
 	ListWorkflowRuns(ctx context.Context, request ListWorkflowRunsRequestObject) (ListWorkflowRunsResponseObject, error)
@@ -22923,12 +24300,21 @@ type StrictServerInterface interface {
 	// Update workflow run
 	// (PUT /api/v1/namespaces/{namespaceName}/workflowruns/{runName})
 	UpdateWorkflowRun(ctx context.Context, request UpdateWorkflowRunRequestObject) (UpdateWorkflowRunResponseObject, error)
+	// Cancel workflow run
+	// (POST /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/cancel)
+	CancelWorkflowRun(ctx context.Context, request CancelWorkflowRunRequestObject) (CancelWorkflowRunResponseObject, error)
 	// Get workflow run events
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/events)
 	GetWorkflowRunEvents(ctx context.Context, request GetWorkflowRunEventsRequestObject) (GetWorkflowRunEventsResponseObject, error)
 	// Get workflow run logs
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/logs)
 	GetWorkflowRunLogs(ctx context.Context, request GetWorkflowRunLogsRequestObject) (GetWorkflowRunLogsResponseObject, error)
+	// Resume workflow run from failed tasks
+	// (POST /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/resume)
+	ResumeWorkflowRun(ctx context.Context, request ResumeWorkflowRunRequestObject) (ResumeWorkflowRunResponseObject, error)
+	// Retry workflow run
+	// (POST /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/retry)
+	RetryWorkflowRun(ctx context.Context, request RetryWorkflowRunRequestObject) (RetryWorkflowRunResponseObject, error)
 	// Get workflow run status
 	// (GET /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/status)
 	GetWorkflowRunStatus(ctx context.Context, request GetWorkflowRunStatusRequestObject) (GetWorkflowRunStatusResponseObject, error)
@@ -23091,18 +24477,75 @@ func (sh *strictHandler) ListActions(w http.ResponseWriter, r *http.Request) {
 	var request ListActionsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListActions(ctx, request.(ListActionsRequestObject))
+		return sh.ssi.ListActions(ctx, request.(ListActionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListActions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListActionsResponseObject); ok {
+		if err := validResponse.VisitListActionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAuthzDecisions operation middleware
+func (sh *strictHandler) ListAuthzDecisions(w http.ResponseWriter, r *http.Request, params ListAuthzDecisionsParams) {
+	var request ListAuthzDecisionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuthzDecisions(ctx, request.(ListAuthzDecisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuthzDecisions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuthzDecisionsResponseObject); ok {
+		if err := validResponse.VisitListAuthzDecisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Evaluates operation middleware
+func (sh *strictHandler) Evaluates(w http.ResponseWriter, r *http.Request) {
+	var request EvaluatesRequestObject
+
+	var body EvaluatesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Evaluates(ctx, request.(EvaluatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListActions")
+		handler = middleware(handler, "Evaluates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListActionsResponseObject); ok {
-		if err := validResponse.VisitListActionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(EvaluatesResponseObject); ok {
+		if err := validResponse.VisitEvaluatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -23110,11 +24553,11 @@ func (sh *strictHandler) ListActions(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Evaluates operation middleware
-func (sh *strictHandler) Evaluates(w http.ResponseWriter, r *http.Request) {
-	var request EvaluatesRequestObject
+// ExplainAuthz operation middleware
+func (sh *strictHandler) ExplainAuthz(w http.ResponseWriter, r *http.Request) {
+	var request ExplainAuthzRequestObject
 
-	var body EvaluatesJSONRequestBody
+	var body ExplainAuthzJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -23122,18 +24565,18 @@ func (sh *strictHandler) Evaluates(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Evaluates(ctx, request.(EvaluatesRequestObject))
+		return sh.ssi.ExplainAuthz(ctx, request.(ExplainAuthzRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Evaluates")
+		handler = middleware(handler, "ExplainAuthz")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(EvaluatesResponseObject); ok {
-		if err := validResponse.VisitEvaluatesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExplainAuthzResponseObject); ok {
+		if err := validResponse.VisitExplainAuthzResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -23167,6 +24610,37 @@ func (sh *strictHandler) GetSubjectProfile(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// SimulateAuthz operation middleware
+func (sh *strictHandler) SimulateAuthz(w http.ResponseWriter, r *http.Request) {
+	var request SimulateAuthzRequestObject
+
+	var body SimulateAuthzJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SimulateAuthz(ctx, request.(SimulateAuthzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SimulateAuthz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SimulateAuthzResponseObject); ok {
+		if err := validResponse.VisitSimulateAuthzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListClusterRoleBindings operation middleware
 func (sh *strictHandler) ListClusterRoleBindings(w http.ResponseWriter, r *http.Request, params ListClusterRoleBindingsParams) {
 	var request ListClusterRoleBindingsRequestObject
@@ -24859,6 +26333,161 @@ func (sh *strictHandler) UpdateNamespace(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// ListAuthzAccessRequests operation middleware
+func (sh *strictHandler) ListAuthzAccessRequests(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListAuthzAccessRequestsParams) {
+	var request ListAuthzAccessRequestsRequestObject
+
+	request.NamespaceName = namespaceName
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuthzAccessRequests(ctx, request.(ListAuthzAccessRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuthzAccessRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuthzAccessRequestsResponseObject); ok {
+		if err := validResponse.VisitListAuthzAccessRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAuthzAccessRequest operation middleware
+func (sh *strictHandler) CreateAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam) {
+	var request CreateAuthzAccessRequestRequestObject
+
+	request.NamespaceName = namespaceName
+
+	var body CreateAuthzAccessRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAuthzAccessRequest(ctx, request.(CreateAuthzAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAuthzAccessRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAuthzAccessRequestResponseObject); ok {
+		if err := validResponse.VisitCreateAuthzAccessRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthzAccessRequest operation middleware
+func (sh *strictHandler) GetAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam) {
+	var request GetAuthzAccessRequestRequestObject
+
+	request.NamespaceName = namespaceName
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthzAccessRequest(ctx, request.(GetAuthzAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthzAccessRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthzAccessRequestResponseObject); ok {
+		if err := validResponse.VisitGetAuthzAccessRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveAuthzAccessRequest operation middleware
+func (sh *strictHandler) ApproveAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam) {
+	var request ApproveAuthzAccessRequestRequestObject
+
+	request.NamespaceName = namespaceName
+	request.Name = name
+
+	var body ApproveAuthzAccessRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveAuthzAccessRequest(ctx, request.(ApproveAuthzAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveAuthzAccessRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveAuthzAccessRequestResponseObject); ok {
+		if err := validResponse.VisitApproveAuthzAccessRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DenyAuthzAccessRequest operation middleware
+func (sh *strictHandler) DenyAuthzAccessRequest(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, name AuthzAccessRequestNameParam) {
+	var request DenyAuthzAccessRequestRequestObject
+
+	request.NamespaceName = namespaceName
+	request.Name = name
+
+	var body DenyAuthzAccessRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DenyAuthzAccessRequest(ctx, request.(DenyAuthzAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DenyAuthzAccessRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DenyAuthzAccessRequestResponseObject); ok {
+		if err := validResponse.VisitDenyAuthzAccessRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListNamespaceRoleBindings operation middleware
 func (sh *strictHandler) ListNamespaceRoleBindings(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListNamespaceRoleBindingsParams) {
 	var request ListNamespaceRoleBindingsRequestObject
@@ -28265,6 +29894,60 @@ func (sh *strictHandler) UpdateWorkflowPlane(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// ListWorkflowRunGroups operation middleware
+func (sh *strictHandler) ListWorkflowRunGroups(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListWorkflowRunGroupsParams) {
+	var request ListWorkflowRunGroupsRequestObject
+
+	request.NamespaceName = namespaceName
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkflowRunGroups(ctx, request.(ListWorkflowRunGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkflowRunGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkflowRunGroupsResponseObject); ok {
+		if err := validResponse.VisitListWorkflowRunGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkflowRunGroup operation middleware
+func (sh *strictHandler) GetWorkflowRunGroup(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, groupName WorkflowRunGroupNameParam) {
+	var request GetWorkflowRunGroupRequestObject
+
+	request.NamespaceName = namespaceName
+	request.GroupName = groupName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkflowRunGroup(ctx, request.(GetWorkflowRunGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkflowRunGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkflowRunGroupResponseObject); ok {
+		if err := validResponse.VisitGetWorkflowRunGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListWorkflowRuns operation middleware
 func (sh *strictHandler) ListWorkflowRuns(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, params ListWorkflowRunsParams) {
 	var request ListWorkflowRunsRequestObject
@@ -28413,6 +30096,33 @@ func (sh *strictHandler) UpdateWorkflowRun(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// CancelWorkflowRun operation middleware
+func (sh *strictHandler) CancelWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) {
+	var request CancelWorkflowRunRequestObject
+
+	request.NamespaceName = namespaceName
+	request.RunName = runName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelWorkflowRun(ctx, request.(CancelWorkflowRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelWorkflowRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelWorkflowRunResponseObject); ok {
+		if err := validResponse.VisitCancelWorkflowRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetWorkflowRunEvents operation middleware
 func (sh *strictHandler) GetWorkflowRunEvents(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam, params GetWorkflowRunEventsParams) {
 	var request GetWorkflowRunEventsRequestObject
@@ -28469,6 +30179,60 @@ func (sh *strictHandler) GetWorkflowRunLogs(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// ResumeWorkflowRun operation middleware
+func (sh *strictHandler) ResumeWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) {
+	var request ResumeWorkflowRunRequestObject
+
+	request.NamespaceName = namespaceName
+	request.RunName = runName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeWorkflowRun(ctx, request.(ResumeWorkflowRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeWorkflowRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeWorkflowRunResponseObject); ok {
+		if err := validResponse.VisitResumeWorkflowRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RetryWorkflowRun operation middleware
+func (sh *strictHandler) RetryWorkflowRun(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) {
+	var request RetryWorkflowRunRequestObject
+
+	request.NamespaceName = namespaceName
+	request.RunName = runName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryWorkflowRun(ctx, request.(RetryWorkflowRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryWorkflowRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryWorkflowRunResponseObject); ok {
+		if err := validResponse.VisitRetryWorkflowRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetWorkflowRunStatus operation middleware
 func (sh *strictHandler) GetWorkflowRunStatus(w http.ResponseWriter, r *http.Request, namespaceName NamespaceNameParam, runName WorkflowRunNameParam) {
 	var request GetWorkflowRunStatusRequestObject
@@ -29212,622 +30976,712 @@ func (sh *strictHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9i3Ibt5Yo+isY3l0VaQ9JSXacna1U6l5FkhMltqRNyXHNhL422A2SiJtAB0BTZnJ8",
-	"f+f8x/myW3g2uhv9oqhY25M6Z2YcEQ0sLCwsrPf6YxDRVUoJIoIPjv8YpJDBFRKIqf86TTIuEDu1Q243",
-	"KbqEK3QtR8kBMeIRw6nAlAyOg8MBgSs0GA6wHJBCsRwMB+pPx4MoEpf6R4Z+yzBD8eBYsAwNBzxaohWU",
-	"C6APcJUmcvSCjjhiaxzJD8QmlX/jgmGyGHz8OLRrn0EBrxNIOoDphjaBGKc9QORLyFA8iqGAqZy4CdCr",
-	"mdwNnOEEi01HiKvfNIHetE6/DVF/jqZNXTP6K4o6kok3uGkbaR8iidEcZolognGCOM1YhLoB6Y9ugpL1",
-	"gXK14b8lTTDeMohFO3BqWDsJuNk6ggczQXkEE8SaYHxN2ft5Qu/awbQj2yH15+x64jR6j9holuEkDoNr",
-	"uVEToHZME4j+PF0xmeJmpmXn/FeG2KYGuOc4EYgBZiiRg9kGREGAf5OzBCAe3BO6CUoQ5KgTApke2wWR",
-	"3rT98TlaH40Px4fNgLfd8a4P1S7fqYxxymoAukrhbxkCKVxgAuXfQKSGgzmjKwBBytAa04xLYkgp4Wg8",
-	"JdeQcyCWCLwj6IPQ078Da5hkSH/mzbZCAsrXCQgK5khES/Wh/E6OkrPVkZKatkBH1a11eXu7PLq93lzD",
-	"8Vse3TOUJnSzQkRc4xQluBlGNxikZnQTtMGpe0Jv1wkCf07WmFGyauZh3qgGaBFZ9wJv3QZRX86FasAs",
-	"EZw3bNAPtu+xuEERQ024+h4LwNWgBlQt/Ik6v+yjBRYjPXcQvBdwhpIblKBI1LKBE5DIUYCbYeq6lnGZ",
-	"cUwW4KdshhhBAvHyN3xDBPwwnpKbLE0pExyg3zIoJbjRDHIUA7MfiWJ+DKaD92jzrWIb0wHYs2P3h/qX",
-	"/8h/wsT96M/OkaifGGAC9tYwORquYfJkX06jORQm8kO7CiBU1I0kVNjRhU19wFwgEiEQLVH03i4ov9MI",
-	"UQO4WuE/Cj/EFHE1qxohJ32ZJQKnCSrsAECG5Hu7giOOpHokUAwgicHJ5RmKgaALJJaI1fPOxD/x2qc4",
-	"/XbOKBGIxMPCFdEI4UIy8cXwN7g/FBix//h2BqP3cvB/xChlKJJQhekNr7CoobOX8ANeZStAstUMMUDn",
-	"AAu04pLcGBIZIyBFTL0MdVuTkxe2ZAXw4yeHw8FKzz84PjqU/4WJ+S8HJyYCLRBTgL6EaYrJ4iKuAXZC",
-	"EwRWehC4OAvf2ZWdpNt9PXrydDiYU7aCQkPz1ZeDIHCSBfAURk3PhhvTwFOIP093nuI+Cx5xQcU7SRAT",
-	"/JIKPMeRevVPl5AQlDRAXpgAQDWDojw7BYj0HA07o52B6L5ttII4GZm127feJnv0Up/pffRm+6y3K85G",
-	"CW6A2oxoADXN5+iOW/NRE1B9n/Y0AGmJYeSrbg+WURu+wyTGZNEBc1Ylmekv2jFZXaE7XmGajupEk+IG",
-	"ekDeFeL+oMJZdPTkaRO0LTpUNytOLyMOF5DEkMWNxNCZCiadT59te+y+Wlp39taQ1AipHtIIYj5LV+AI",
-	"TDYCR3xkzZOzRgD73nrmQw32VlBES8QBT1E0pncEsbEP9H4NY7BjBrvZRA/qMNCzHmRSt8b2J9JKNu08",
-	"o7KTzju4J+gNLKSjrbWjkXVHNlYpSDYBI+XMBiDM110RFq8wCYLRqqTetCmofAvttEEz1etN0BwxqU+1",
-	"Q8bs0FYYC5PuBNg2C3mbaVzs1ibewRjewQp+t4X5Gwoote7RCi+YkrQb4WsTkR2QaYt4fFeesKdkbL+v",
-	"N9lZUDq8R3YywDKi3qS7EK5LL44dUy+LeiPqwZtkpAs+WUaamIqeZAtxg2VkdPTk6Ze1MCYUxi0AyiEt",
-	"R21n2QJC+3kAwo9yNm3IVu7m72A8Qb9liAv5X5Eyh6h/wjRNjCJ58CuXgHuryZGxnPe7k7O3k/N/vTq/",
-	"uR0MBzESECd8cPzLH4M5Rkls1O/BcLBCnMOF0vE5cPv5+GY4QIxRNjgeXJA1TLA2ZSEujrVwUxjt7/xv",
-	"DM0Hx4P/6yB3ph/oX/nBuZxyYrapN108gtJawHPBK18GmSc42g4jp1eXz19cnEp02J1Z1eKLXNn6AsCE",
-	"IRhvjK1sh3tzQkl1heeUzXAcI7LVzp5fTb67ODs7v/S29l80AzFVJr0lXCOQIrbCnGNKgKDyv+aUrYBY",
-	"Yg5oigy33OU58mw+xxFWjgO3Ni8ujoprXxCBGIHJud7DFpi4uLw9n1yevHh7PplcTQY+DeupgbyJiAH9",
-	"913ut2b+Syqe04zEW23n8ur27fOrV5dnbTQrj3mulnkAci1MfknFhYRyhYhA2+/q4uX1i/OX55e35/7e",
-	"jCx1cn0h2UuMOZwlKAaSZiWhatzucIvPERQZQy2LvSIwE0vK8O9bbvjV5cmr2x+uJhf/XdjtSSaWiAhr",
-	"VnwAblqzAlBelPeIAKzZrd5lymgkH4NZgk7zLW6x2+vJ1en5zc3Jdy/O355eXd6eX9a9QVoxzkSaCf7L",
-	"4Zux8m4UHqWMxChKpHrlidiCgi8UMCj+ovBUBec7Bh0m2eG10S/XjMYbSVh3KElGkt+hGMwyAeYQSzJT",
-	"eDeczy2uHv6TSNmIYWpNpVVXvf0NIw7mlAGoLAx4jiMAIyP3pkzyVjlEHV2S0DtNvuFbzsHdEjFkvpeA",
-	"20+GA+UIaUNMDrCdUiLVSDmQMbgZKFwR3A8M88UOocj/QGfKpPZxaJB+QeY04IEkwDIAfY8McHdYLAEW",
-	"HPCIpsp7J180ZwJaYsQgi5abceU0IkpiLOfggdW+OzkFUAiGZ5lAHMA1xIm8k+qkT89fAPc1QB9ShszD",
-	"avmWBm4Mzlep2IAVgoQDQvOPtA+Pa5chisedMWsnOLGwhc5XkgwXNxIhAT10iYAeEMASSNAaJQAKcLfE",
-	"KhjDbUaSAZJXGUqAwRVBgM6BCZMaAucQGlqr+zCPCRpKZmdX035JRLLV4PgXG2dlhHvrUsrtrH7IkDPJ",
-	"SWbjRHt/REmetxpDCAd2V7HkzXOMGNhD48UYTPMJjyOGoEDTwb48oMCKZkBQ1cm1kl+slO+fy5sQ/S8Q",
-	"EaeUEKRguxFQZAHi1H/3sA+g/FBSl/mSh4hd/ha69a+Xyl0MINmUJsQcRBljiIhkA/IZHOQzShMEldTo",
-	"flV7CAB96Ty6hTVaVnAez+EggdziBsW3OHSsr5eIAEgM9PIDwLNIPqfzLCkt4HysMRRoJPAKhchHznGG",
-	"edRhXcl21JJ69dj7qtdyPyDIxAxB0bCWFAcYTYxNRK3KUITwGsUqMCAjVtrQYVoGJZ3hcC9/hS/Gmv3A",
-	"BGCi51K8eEYzUaFCwDUBh25HlfYzsXyJoiUkmK+kiokXofA4+feMmb3JR1c/C558tbKTVO6AHCS00Nwq",
-	"YORDDSwO5j+axTu3PJDDNU8Zgung1zsxHch/UAnvE/1vmOK3KgJkv8Bffr0TrSxF/Tos7OlNDVp/N1Gv",
-	"dQ8CZAvkPQb6IZXINTd1pP4SW0cEB3uOVR8YRp3jcD/Aeix/bo9y7RgK6j8W7VEP3qRRmN7tc9Pm6u7s",
-	"GK45B/t6B6hI3RiLaRtUkgsZUAgYLVV0D4CA+ZEnmHAcIwDt+YzBhbqFXDCIlUySbBR30i8eBwnmAsVW",
-	"VJoOzN+nA2AObqOiifJoJKIkH8qsfqa+k5THcigos+t/I4VWQPWbYpY0a9nBDK0gJiAjcD5XHBLMNlrW",
-	"cDvWUkJJfo5qxLUXmAv5tNjlilMBrWBwIOgYeGFaMBJAOQfdy28cVWYj+fOv8HGHkziCLOZ1w/8uBQUt",
-	"3Fg6+SU8pZJlit/K2+tEwCpDxuRC/3hUFfdyATRww85feAIqEEsowCrjwolykqAEy/SFz6lE/nlmDFZC",
-	"CXznek/HuRznR4VhAn6ZDmK01ozNRIdNB2+K+Bj0+3igdv4CkYVY+luv4YnQCT8eSt403EaBPojGRy7S",
-	"Y/RT46sfFdp0smmtVjWysrXTKhSPzfUIfSKhySM/LLwtatwp1+ZWIZCzWcjti/m7J/mOgeOZlgMVptTa",
-	"imO5o5ShOf6AYncRJF89uEOzEUzT6WD/m/LLEUrD0pNmpDJZPs+4wrztIiEm7gfo1j8KOfBCv3t5tDQo",
-	"BywX96foMwRT0FOeayvhMyt4mKtHlpupu56YP2G3A0spFwuGeMOJVScNHJg3TwA79tcQipw/q8FNVUGN",
-	"5+fqjh37UTfMqNyd0YI2YKY4YQAr3hwBrNhfu0gPtfKEL6UmEAdD8N0IEMkhIx26nELMFPvhmZrSIS+q",
-	"YUDh6X98faunrQpIC0azNHjo2rzYCKq1QJaiFkZq0lbRWANrF6rl/xOaNEmP9ryLViclee15Me6nkzP5",
-	"6J+hOSbyigCOSqIIFCCCRL6mkHO8IFqIM4jnYI2NPOfE6zsslpgAmJNpUBhK8c+IhV/9k+sLsNY/Slh8",
-	"i1gBqzRFJFpShug4RuuD9RFM0iU8UuIJjK9IsrE+1copvsckYEv4CZO4ccUc8x3WsMlBbdralULlSySg",
-	"MiGnKGr7woFxIweXCcit20g7JsyqAwn5xxsiHjkTt2K9EvDL11JzP0gAKl/o/xnUYnH9OIjGQHN/2pF6",
-	"S702Q5roqGric9pDJ0tyBbUBO3Kep9c223U+sowQDU1hsi6ouTEHUjJ9Gg+LZwBqRlPVCKQ0zkJiiPbL",
-	"DMo+pGua4GgD9AdgTw1SSjAim33Pgp1/TTZFy7T9JSCqdrZEhR96iWOaIJOh0qARy1EaL/rNNxq4UZEt",
-	"T1owSATv6oRwR2WWb1FQS/Tg7720i0a66HlXqs/2zm7Mo7kqFv9VsxXEzD0oubNV+cogATQ16q3CVS/H",
-	"2DViI0VTFROVEXUYkmQeibIz1Ik1ivBKBiz1Ajjz1TmMlp5erOxX2lDEa+xYWPCt7VhVA5bSKsDdkiY2",
-	"/7gzeeQWvgCNyE1P5AQd6UyOVV5pY7Zt/UgbeMtUZZdtJCUDV1lH9dz0kAA3WiLL6EG+QFcko+Y3XwvS",
-	"jTP6TNZfprJygekG4OroFZRymxNH9JddwqZ9XKs9m/kb8X2P563K2e5pKFVHoS19vGi8DDg68z+tMbpr",
-	"tlpW4w48WMqg/ZCtIBlJ8U5dTe/H2jM5Q2uUyH0DqLx8lsU0JyeGLIa1Z9XLZ1IVxcFexUGix/5JbpKH",
-	"d2zksR6n1uUQcv8W7dA8908ofqtPT9P7Aq+Ri+6Q/NshOYViOQYuJdqfDjIEriZfxNUoD29UK1TfWEgw",
-	"1yKRfF3myjFOCXImc25t5mVLf8C0/e23YCohiqcDL4ChOsTZvLf2AzQfzqTVPK2lAy9C1YaKBcQD/5y7",
-	"BQL5xKHEJbEMBM9nSVI87gJp5l5HbVg0NyuFm1Uw+iOIEfM6LHLPbgcvcyFkwdQUKPjZAwYzLFc4acPQ",
-	"zzDJ0HNGV83g1turTovWyT/dWvX5GBsCgsMnNDaUoelvbCjPUGuvKpFQV2uVvRTbWK0+X6p5FJaqGqB2",
-	"RkPNunhUT0/31cHrsP2JNfImfHcS8htQ9j/dglVgM7swX5UP68+wYpXX7HWBdm/Kqjx1j+z+7Maw1RTD",
-	"9pfR6883esEkuZqrzJMe5q8/aqxKlnfd1xhUlbrf9LK5FWIr+5jeggLeNo/Fn2gPMipXbg2yf1C2oPw/",
-	"Y5QggT6tcUgpk05xi1eYYKmBmtwRqebfyzoUCmnqWH/aS4Qoid6eiFv45LMTl4toewyycgEiLSgPB9xl",
-	"YHTjXcG59Bwf35R3uY0gXpg5LESY1xjF6qkIiBO5JVKusiNRonigj0OcqB5poLAqV7mY8qCV7R+CGgoN",
-	"ZvKpmh48aFNT8gA3aVSF6tinEw5ia7nmytqio7ulEu2W5foaYa5OycgHiAim8hmlrKN1bSX6TNV1nA6k",
-	"jH8HN7ywoI5enirz2XTgpCb15hcGjsHFHCCVsUYZoDrwdwgIBdCPiDUAmnBWVbZEG2BdsDDYU+ILWs1Q",
-	"HKPYjomV1UnJLipF1PvU4HO/kAjXx52k5vIkwj0V5DxDRUx4Oo//d4+I+viICqfqcbs+IcttDqPyNTKI",
-	"ctGHDU+6HlmOV8xxxE3ItwqV91lC4c23iC+XTvfKDfv1zqXA1vaBGpnC6L395s22h75EXqUVu68xeGnO",
-	"flqGYToYV0nAAXgvKvDw+6cQgudB0PbqVk59o/7vjc7N0izZ76zR71PKxQSRGLGfXQp12L9irOV5pjVg",
-	"WYK8VFIA50pCSwq8xOSEDwFcQEy4UKieY8mBmFoXxX6lYVf/ravich3YQPDZYmhX+5yhOWXIgK/yZBhK",
-	"EygvotxcXjXXm4QDnaTfcVc5kJMsrNXniKr6NNEqTbR7S+q0C0QQk69iCM0g3hC4whFMkk09y55TJp+t",
-	"1qwUyYfMcvJVWuVFj+1yptq8lGjU8y8EYnKi/3c6/dt0+scv0ymfTm/e/Od0+nE65X//W8hkhQOc5BXB",
-	"v2XITwJ2PJH5fjGjrVf4ZHUREiVZjF4vUXsyTizv3kq7QPG8tCpf0iyRRAO0shVvvW+d56DKYhWNhn6B",
-	"+qB7W6eXzpXF0CZJePzT/75QV1b/McROhaExJUM5oeLaoxot/5f4fZUCgZ1JC0AlR+4gwEDXkAUeS0pT",
-	"sIYMK7VS5XzcLRExpcwt/bbxbqwylu3WQty7MX9L1EiR1wyNIuOLtFIUkMwQqtfbiVfWvlShzpprGX46",
-	"uh+HFnh8FzZdI8ZwXDDzV3BgIb8MPqn2JppB+izcZVR7b3tRfaXU0nhBzBs2Co9aaC0IdVaGqhoSH4Mo",
-	"WX7B+56g+9rL7I0oiRgSSKdgcGCqXXh3a38QSlAJVDsonHcXkWa98yd2DM7cq3oMMo5A6D2XyoLI5FMG",
-	"0Ad5zHiN9se7e3Ntvbmwieia4RVkukKgqnuXs7hNippkdMuGfd6sFNl5lnBVpTJilPxKZ4PhQP/vlNEP",
-	"JQ9P4etmNlfYhy9KdNbBawpa6DLondTwunVcF5cOzdU8+9tEkgfXTRXKdhLVliZ/At355Bj77MxyORYf",
-	"g0nOQXNPc1w+zy5NcW7WLc1wOXntyASXH97jML8Vj6+H6c2nwnJUVR691dXHuSjU8FhAge7gpu3j7/Uw",
-	"S3jV1gsd4rhrWySauG519hdnIaF0ITUrw3squgkC6XLD1QiDD79RTIXbnU60jVGVx1afcyl4mNVL9QoG",
-	"GR/dIS5GKaPxKK/NVLn8uhDyjaCsCypuiqObQt3Kl7XPY1FPOLBYWanVsxcsxKTLHNV6iU91ISMDl+ci",
-	"Lsp4PpD9an6F7jU12PjeqM+hZyf/zYKyoqZikKq7ZOcIQdilFU3dUVYpv08f0fArXWKiK0qwoEzZskkM",
-	"ErpYaOf6nEEuWBaJjH1+3rMAYh/De10F654Pd2DCXb7g1el7heUUHoWdvuSB830cT/pV3TvYlDcE6u/4",
-	"XhmlJNns90wkChxDUZUPrGvdTVUlPoD6N11v4PZ6fwP7GwyDzYRX8IM1DHz1tGwn8OyEv8DR74ejf77Z",
-	"+2Vk/vV3+6f9//tv985nar75PWS+IEJ3LfzNMblKufrjq8mLKnjfQY7Aq8kLezrP1XigPtD1kLUZOERy",
-	"uayUH9dSiPT44GCOCU35SMkg48K3I/XtmK+j468Pvz4M0ZB5nFkngI1sxO4BrF2vN6APKs4GLkg/uTYX",
-	"FJqkWhbB7tQxOT25N2mwCG5FF72kri0k6Q7X8RGJ1EFoH6dsHQT1PkK21+2sU1/7+uAzjmeJigmdA++D",
-	"sf0PVcQPko2X3CivXx5ygT8/e5iP3E8qYXuAVGXq1jPXQ8FeXmpXRfns1++pxrLfRar2Fu5pGXPtGncY",
-	"l+af4OOQoSeNZeECg7pdWf+LMcgruf/Pu7QFBH/SW+tD0vHaFg7+T723/sp9L27BZbWjm1s4xsdxdbWH",
-	"t+7ois7bxuBuHW75uV0862T/9JYoBck9jU96jl3am9SMW3qLTIzITm6WPqdHdKX6GgssoZXsAypOKtR6",
-	"AN2Fg9gENcFVOugnjzRRIdY6AvHPj277c2PK/goX+9PDxRojxR5ZnK/unVzFxEsau7Q0dZFUGz1d292S",
-	"tY0grdahvm2MT+tzsRhKkb5XitQVvEEzmm1xF9jLjzdXl9eqDnw+Slmu07QpupWmAZOKnaAcpAPjWL2M",
-	"KuBX/WtF12GiD9dGkUCCa4qJlLcENfHQKFHlOVbyNDY9iu2qsiOqsAcSYE+lFcbxgQHPQ8N+hXhpOjAg",
-	"9o9zVGyivZiSoO4cixjX5X+DgpH6KSCkdBRxJoWYKw+AKkK3E8+qpa+XiKH2ev0UzE1HWZVIVHi7amAs",
-	"HZitmWwBNygI8p4dsP7CNbwH639I/qvpsMAUurDiv5Ie/m2THiSz5aFmSrQgiAkKdOqyToG4Q0xFjK4x",
-	"zXiyASmjcRbVvGeAMoAgS7Dkz+pMx+B1JabzvSqeo2vEnzkpaQhuTNzmDRJDcMoo+ZHO9kEECaEqlUlv",
-	"oXujOCUiT/Qj8z8m1PZjm57R3xFiVY26eV/XdjCoywtrNAy40X4hrmILBC9DFEaMctUjMrfvfX4FubwE",
-	"wk9vWbDA3NO44KbZpX3BTrqlieHO5ZTuxMrgju1xGBosOM1xaIVR3ULQTi8OTs+AymT93OPOijh8TNdx",
-	"F9Fmxbke4mL2jzFz2c27DC8rHuMjvJ49gsrKJNkncqyI3ErJgMLU+/V54/VRYmXgtggQsx6WEqwt0WE7",
-	"Ceqq3q0eJtrmc7l/KNe/X0R+8WnpF70U4U8Six/iiH2E52YieEQBRGVAH2fsUBnK+4QNFeTYLe51oM62",
-	"QIzAZILmgXM4N7+C04lfgESysUTuEBIpPP2qe4FiYuybps+66sCYSU1S/hUzgLvrwec5WOGXbmvTeEMl",
-	"Ba+BZMUBoYwMWmtWu1ZGZgATShaqjWuxpklGOu/UtcVraPzPMnK7e5dKaEPOFFjeS9XKJpKTucn0TFD4",
-	"ptziFRoJOkrwWlsZ/R6AeUa8NqpFbiKwF9sq3ppbggS/R+DoMD5aPj1c7Y+behL6j8r2cqSiuzfDJlmm",
-	"jg9VcfgFN3pGbrgcgzP96iu6Ck4j3/kYTK14MB1om6mp7zSuFi30iKSDeHCPd6FXEc6cBEdcbBKfm++A",
-	"YwdZZZeODL5ZJ7fMaHeEuSgRjZEuypm3Go0KNeZd4wgTAfcZaY4Oh59WXbR/2lpHdBPsRjG003W21TiQ",
-	"7qsDugP51IqfnXSiO2E3XDIzwr9rF6tVJpQXiBOY8iUtYskwHVWaV38r8Ap9htfKIu9x3C4DTWusY/lg",
-	"awIdhwC7YzZvO0OKonYdAlkCqPettGS2s9tpz/WRXdLu6kKVQGvaHV0zOsehziY3wYudS+zqSdXhWpGJ",
-	"jCkvsm19nNNCrRVvzaAAW1O+yZukWLmpu7hi3YvhgL2QzFLpFN99088Z/R2RklNTXv8yGw0hgd4RFHDY",
-	"X1hTCS/VT5Nn58L9dZCaXmCGlCoEBK0nmXAFqWvItGR1z2ZZjbOnW/bN8u+ev86wtKs3PQjMHJimLnlQ",
-	"PHBSjtKaCKE19MEWv9mKolzlnG7EVA44UpRVpmwPpEa+1Z9hVSWETNDvVKHSQPwAEksdjyVHraDQJRGB",
-	"YHixQEyraxxQopWANOOFllZzmPAc/TNKEwSVciJn0+EBhUAcM74jEFrd0EENaoJCzTalBOZxoA6mAkV4",
-	"IEXNlc6rKm05OKJTYeVABbfS+LCkVKyOBfY6rV4w6peWCULbvbhb6QXxEm5U3OIKimPwh19Q6+PBHwUM",
-	"S27wcRCu1HWwoB4f87K99/Ix/8urBPa/TB2w/yX/R9UA2z+4Z2J4rfOg5iG4kn/mS5wCTPT+bQRn4V2o",
-	"vuBNPNl3lBQek5waCs/Jvbl1aMP3ljFuCyKGLby3p6UAVzTbhBx5sSAVUu78cNyWKknq8uO601v5OHYi",
-	"qeRWtc4zWRuRdfl0ehWan4I+hqpagryXt6E/XhtcDMqaXK89X3j3DM5opqMJ9UcV8dw+BIFygxUMtDst",
-	"6xYJqrKrzSivKQ9n0dGTp8HUfD3HD5AHgqPlX9sWV4qsvzBfwifPvjquWzIkXe/Wq+NheDtXTvHW1Vxz",
-	"/3LDhmNtLs960VCX1Sxh0z38k5UCCY9gEnZcVh/7LnVanQNiT29QAlNukjwsVlRtrt9qFy3Xcc13UooC",
-	"bHv89aLOP1LVQxqxsqOirnxndVqLdHZB0ky0vSmK2FxTi+3JLlgVOFSQu6Ln/U+mPAfnp6E8I8I8AP2F",
-	"U+brmivZLrdO/8x9sBnXIpX8T8l7ASILTBBiyo22oGvESEGKXMI1puwzNCA/ggZMO+m89AAtl7bqtbTb",
-	"5kqPqqvSdu2UdtlHSTOaXJv/ExoqBZccWouKYheBLktj8JwyYK7bMfjDzncMpppbTgdDN1j+cbUZCf33",
-	"j3Kxwgf+yoHv7PNiv/93aePU7+U1am+Hx3OLKMswXdWn73U1hty/e5NLCMmB+3fv5FRqzeDN2qfLE9hr",
-	"QI0vY3nz76bh0909Oz391eLpr2zHv1o89S6C8W/fvemvSht/NWb6bBsz7cjCEha39x9S6msq0vBXf6W/",
-	"+is91v5KWzdWau2oVOOCq0Y/WEG4GMxsmtTbWcZAXXGpHSvWIUU/E9Q37uL+76gleI7RioD+5+oKkyZI",
-	"zN3dGac5s3aPUcroGstXx4uQs/71AHK6cZk3XeijxiPQQB75XbMBnZ8lJbyuO36PPfgq9w7p4hVHbGQt",
-	"NXnyaE/nUPj4rU+6R4pG5XgTyMUtg4Srn29xCH0voFQK8QoZ6d3MJUUF810xcmnw5PDJs9Hh0ejwq9uj",
-	"w+PDw+PDZ//tO1djKNCoGHTmG7g5h4sAGD9kK0hGDMFYyaJ2nL+wqTIMlAoA401DIf/OvmPLuvPShDkG",
-	"7iAH+gVqdRwrEzgPLfYSRktMUL4zPdALyskPL9/qBEkRBidhlaYu4ls/UC6p2Z/ZyXWZxOlzmKhg4Vfk",
-	"PaF3pOwMy4JHJ4IPv478mntoU2V3hmAij2i/tKvgqZULR+kX02xyGCJih+7Gq3MiBMOzTASgPiHg5LuT",
-	"UwDtEADXECfqgOZGWsx35MmNgBIACYDKgFN9WQurtJC496M9MgfOuIC3c0/XgJzTCCs5Ual+rZXY0CYQ",
-	"05olCYipMj+nUCwr65vaSVMnHo09fWc62C/CFxrUnh+PNqXHpeYwTSryOVl/Z9WrwC1LvTzXyH0EYBzL",
-	"o/Mye1QZRQ+hBfW36koyEwSSbclafutraio+TtCIJiOYymkYNiFKFhyNi/GUPKcM/HB7e30g/9fNwWv5",
-	"/2+OgRLH0fHBwZJycZxSJg6kunANxVJ/s5hcnx7cnl4fvDq7PgZulPKYVs7eftoB+F8zYxqU3yiaCE0o",
-	"1+szmRxfK4tR1msuOR6QbDULedXDgTtEQEwQuzLqecipbYYY/4xV5KtkgMi6sz/xnKx/hiykQ81xgrr7",
-	"JZ/jBAUnCu5WWcC8eKzfMhQ6LPODV5UXAoLuGmJHHj5KegeB0bWRwHvd44CLj5UJ/S1GAVeouJHh50D5",
-	"f/cXeQkxAZPzm1vV3SZfx2s8dXT45MvQwpinCdyErUnll0aPrcrFctGb0KJPnn21RRC2urSuwEumTVrG",
-	"NGwCfPcbUkUeqtvW8NNmKJXjgAtBWzsIBNaKYYDb5AKbtR7VaLfn15Pz05Pb87Nj8Ip78CjZTgKOYDwG",
-	"L9ACRptyDoByq4y3uDlbxyqb/XbWpBSX+x4LXZKllTHOaKwLK2ilmSwABAssgK7/UuGO+s/tkfOFKQrR",
-	"mwssRu6XmrIzYaZ3koklIsIUiC5b1GaQ42gEM/WUc77U/yyI+oUh1aX58qeQ9Hhz8wNIGV7Lx+M92oA9",
-	"ew4KbXal/fopL+LwpHKyizM1y8nrG3BKY/mgrbAAezQ1IRWtSwj6PuRXKuNKjipBnmMjOHHGEQtzwFfm",
-	"l3wW+fr5yzn491uLYfzUGmrWUKWqZFexNWzaa2m1FtEqwHjZ3X2/g0pa3hUr3IcQ4kKA1nOFe7CEGnZg",
-	"g/fCb8wfLQKE1GMkBvXk8j7oEtQJxLo+j/ZnjMHE0q0aEqMUSfIgIMdOgSX/MUgh53eUxXLtpwbynKAH",
-	"MMGFWjY5ohI4Qwm/x5ZeqAlsHAKA3PeD69kl5JJoVPWhZIPJYkrs0Rg5bgx+kju1/f+KkZxe3yXI0JQw",
-	"ZKw6kMSAIV3wqFTt64+BQHA1OB6kUPkNeHD3Xbl7mLN35erthcRcZGLRmd1YmzgfaiuQdbtU/hrDQX3g",
-	"prpBXomg3iqHX7RoZ0nlHUyyHg3I3UmN923GEkkLlIsFQ/y35PjgIKERTJSG/ezLp08OVpt4pmKQFtp2",
-	"+NbVqB+sn4yPxodBArIQ9OCYqs0DijJR4pYG1JGDoJOryy1ekILDB6rqYd/qpNoJ4iklPOh50b8YpWam",
-	"20Ig8COd5QlOOsxkBUkGE2AceDZfN9BTRq3cjiMDolvuDvLCkuULKCB/H7p+v3ZZTC8ERWUVH5QvOPiV",
-	"zlwlp8D6o6N/PDl69tXTJ4eHdRkGinUF4nyhgOb9zBmc6mgQQkCRWNJRnnw5KiR/xWjdSjgWPz54w8Ix",
-	"hQgo72gf3EpTtV/oPwq2Gqd8cZ0/Offwfj7pATnCPmlqgANj27SAfIKdpAS46bqmA8Tuotw3FSA/kU+c",
-	"BlA8ky4pAD4x7boO7AIKdAc3bR9/r4dZMtqqeuyfXDY2Z0z9asWmjMZ/brXY8iXrFIZSTxSPoS6sD90j",
-	"Kwbrg7ZV2vAZinDNe5SJJWX4dw1GbMcFUuClytdY99R+bOu3Viap80pPik5oD4icxKUkDZaQAxivMAGM",
-	"Jqib4yXuuHWGeJYIsCcfCPCtS2tp9waUWKpbL8hIndxwjVOU4KB0UhkTSnBMGV1RBXgKxZKDGRJ3CBHf",
-	"kcFLcTe50PIZNQwJYPTTii8VeLaWY6oz7UagqczbWbLJa5mm5tN7izjV4/vUsk74ADsJPSFarNS20df2",
-	"Wt7aUORq+7XunAzjr9XNb1tLc93e9/b9Nz3QL3QVjzz2xYhshVc6QIMahAcqCHxO4pRiIow0+WryIpyz",
-	"qmM9jGgK5DAdFCuPTs9QwcVSiLTde68/fjV5oUIehEh5z29E0u+LJizIAYFAL9P8Jpb71oFAWPCm+rXh",
-	"0I0fTIAGoAxcXNtomTof7ShG65Gx2o/NiHGkzC4d+2tKaE1wSb7CAUzxwfqoe5DIdSEUxE305ZdPi8La",
-	"0yfBUD0dZBMGTv8G9uSxD4E6/CEQUToEWZwOwR2X/yP/lPCiK1vTSZthRZ3Cm+bjrrv/juRzUgcck0Vi",
-	"i487W0kt/dv2AfZOdaFQ/xqqNJYdTLGm71GQsN0e02yW4EhRt8sdsNsaghgxvPatcS6V8Yfb2+sJLdtO",
-	"1eEcHxxsScthr5/dnQm4L6RsS5he+wUZK+CElUYFmsFMH4YTdA87AHWxPomaoQogG4LvGUyX/3oxBK/R",
-	"jNPoPRJDcHt6PQSvzq79AG35zWA4kB8NhgPz1WA4cJ8NhoPbUznk1dl10aNoPt0yS/ecCCwStArWhfd+",
-	"1LwvSiBeKW+P7sRbtYBAvAp0+319az6tRMbYfq5dW/36IFkYPB4qNahRzZwllGhY7UItuKlLGjmtJAOg",
-	"D4LBSDkvkQerWs2khSqfOO+KvFOHOJMiKWzIJYkLS5h44KnGKde1FVSVHj4d7Fexzgf3DHcqRGRadOaL",
-	"fF+zSM05+CuHT0NF+4UiGSsxptX8i1B8xc82IvU92hxUKPPs5Pbku5Ob87fy7vfpRW0mrVKn9XpVfV7K",
-	"4xVe4Tmjq26BkD+74aEQ4HqU/uwvE2qsbbI9/KoVodicn9Am2OpMG90aPg8ezo1zzXd/Kcw34UjYj6Ec",
-	"kRBKXLRkI6l5hotz3zDBrLPFl+e1q5fnnTGcu+fzMVecF+JMP6GdwgNkWwOFP8VOLBPehF1NEiW9+D6m",
-	"CP9oPrENonw4HYwPBBRJqxoI1LHNYsEU7mXM5X/zRGC34nhKLuaAUF2UHM9VkQ+/YJJn/Q90JzJCqvJx",
-	"+Lc+b/lBJXgcBf2tzXcx98eBvcaN+aKmb3EvjytKlv7ILRLSPegetMHjdk4zzK9V//Ow/8LF10tiwFz3",
-	"MjKj6yLqa8qft7wyPaxQzRfhPg6i4ryPzEVUBG4rJ9E5Y7Qh0uZGQBJDFgMkx0lq1YE3Zq0qpkM5LpUE",
-	"RD2ZGpzfvu9Ozt5Ozv/16vzmVipzlyevbn+4mlz89/nZYDh4fjX57uLs7PxyMBxcXt2+fX716lL+/fTq",
-	"8vmLi1P9xfXk6vT85ubkuxfnb0+vLm/PL+XfLy5vzyeXJy/enk8mVxPz/cXL6xfnL88vb9Xsry5/urx6",
-	"ffn2+4vbt9eTq58vzs4nxQvvrxmINhQQJ839AfWWzUirkHgVGNTvyqBTV4BHFQ+q5tHJP9su8qrapaQX",
-	"NVuBpdTlQNVmwyrCsEmwOfu3NYy8ICOTbAEFSBDkAhyBaAmlptc1TapSD0tB36ZjIR/AYJbuF3kA0hfq",
-	"mZrTjMStXNUiT9Fn8KU2dTJqww1vtE0MFnyNprqGdjvqDyvibQ3PPYlMxLgr0VFKfoTBJF3Pf9voWM/E",
-	"8vdTM9arK9X2nd+AkmcKO2+9JbvJkzf6Q7d8pYWiGeBvfgyuTCz7NwVxQ+WP5lHvKAYRTBLE2vog5k+w",
-	"OYDgoXsNRlt6VhOAvDaod0tqqpIDvF0nVLDAa0RMN9R7KkSuZILT0rYuwvUNmKGIrkzNMx/yQkLruDGv",
-	"6kklr+qNyaQa5TlVfxtsqYwFd2sfnFJ895bFhQKLgD2epSllgldq/oy7lbLyjnXYKuXZJM3A25BI0SHr",
-	"bf5RHwZNP7rEx3gDV0nwNZGLhfN9Xyo4VKo31tEiKu217IZJD/QSPexKClpFekQ8uLHI32PoMIwsbQ3f",
-	"YU3ODMoJxvoViiVUtnIemrmlLi3FSCvUd3Ii1nzbfgnKG+oZZnzpYot7zNfBxRncT7jIVg5dw6kWJqo9",
-	"1cSMajvMoDv0Z8xEBhOVt+4syHbGEBrsb+3R5A4uk+vSBcldvJ+t/s6P9Ri9ROKOsvdhhLpm4vqtNP9h",
-	"3e32zvBaH2NH8ijcVc+/uNXnDXttpppqo2CAyUJVjlDuDP1PovGlu8BVN76whSI6wO2jXu1664+DezYl",
-	"R01Xli6ZOa5KKSReP1DbQ861f3UeV9NEtNwMNhCBqGYIXxArSbp1dAY5zAQdWYBigLWZy5beKrqM1kfj",
-	"w/FhN1XHJQFLVlKvdtvq0HnKboOhs8unnQwXXoayASxsEkX1ZhT5a6VEhhf4IH+/wb+HOJX6SEKuYAUp",
-	"Ymq24DSCCpicyoc4kOwufzMwuOnCXKlqpX3TdGb15/W9Q7bPTfu2U9o2QbvPy1q/hp9n80D5waodx+AT",
-	"JP1WF24ysVYo4AcEE7G8IHMasEqo32xnYx0Tk5eB0yauIiHUmlwcL1oGK5FJRSKBuhCxKm3jr9ynSFcR",
-	"5D39n5shOEMLBmMUD8E1o+o1wGQxBKZE1xAgEY3323Ol9aqhm/TT19waDW4ZQh0S/IyeILecl/NkyFTC",
-	"h4lXQtx2Zgb0zvTRg3mzWV22KfA06I8ntqtzMBzIW1VypfKKYM/VYZZP9QFloFqMeb8rE3YPZo6nYNBi",
-	"0YJR2kYI+fJh0HyM1yO+6mwzb8i46/tzLSm1+F2nfWvQPrUT7qW+ag0GcSwZiLuS1iDe/ZI70g5ZLq9S",
-	"a/iXu0uQPAieRRHifJ7p+uzNl89OGtrbZZdnwnPen1IiGE3K+Z8cLGkSewXdE/weAWNz5UOvEctQSa5+",
-	"DMB4Sm6XiBdmg8wzKrn+lyotH7wrOesjDdJIgfStYBl6F/INbulB7+kKd0jbjSPcTdfVDZ7j8J5O8Jww",
-	"PvHtK2O0UwD6pSe3lOLul8GGmTmx6wG5RfAkEngt/3CrKv6rQhtFP5Ab0UFquKSSpHX5lfMVxEmPUDk5",
-	"XKocbgIQLSEhKKme9TwYn3SjngQzUTCoOkFM8P+nJe6Ur9otTv4+b17eXudJm36zga4zKEy5bHYl69cr",
-	"OQxFOMVS8ShsFBW2+ouqs1HYqd++pmrErG8VUCJrk/Cveg4rTLU0IajfZ9X2ofbT1mOhSAkzGm/qZlIF",
-	"ZNx0urtCdT6P0CV5HIO//aHoZCx5zUdbPQHFAAr3ExeQCX4iPgY9CcYxVAeW+RmolI4e4P3iVkdrxLDY",
-	"fHwDRiVoby207SKrAXKoUdh2dJLIT7KQkVv+Ui681GwFzKvi9LhkSlTy7NTFylBbT1PCiptzmEPZBTV1",
-	"bE4hR/HvNtMoNMjtw3XUgdQWCPXX9kqCehXjViJtTSgJGkP9qdUIb9pnX/9DOb/wSj4wXz179vSZ4i/6",
-	"v4+Cpo12s3Z567cvbmq6ZytkGMCHA1tlLeGdzjGftmpjeXETqPYuPwr1fUVRxtDNe5z+jBied6jhKccC",
-	"tYacR8GEgLzn7jXcI1QFxNDVSj50unpaHojkaamN0UbV61AXqlv08NqAt0gVjFNxq171kJrCXEFX209o",
-	"47cuCphm3N3byj0ZAqtI9aOIISV+w4T3F2zKTCSQ36XqCdGZ1ONd1bKaLIlyuHQ/Vma+a4X5NZotKX3f",
-	"XRy70x90FMiWCMaNRaO678tA+oOaUSG5Wt3MWY1+uL29BmZxiXLT5MrGWdpN5MEnFSSlcKPK09ZKJW6t",
-	"H2+uLoEZ3v5uVwsZsiQQWWgAdM5QlVioig1pYRXc4SQBM3lLSo2gbXaV/J6PeQKj95KJH5h0Jn5gh3re",
-	"qozhVsFAwvmmGzX5ZxSyuMW2X7IN1iJyJ66ZByZKBKIMrDHMbcl1iQE1rvALPcvSW+5eHvE2caGCmCv5",
-	"DF8zKlRcizVivfT08RJByfHgyfhQ1S3XwTDO0GfV5VJm2+T5KfjnP558HRQbXLzVW/0kN7XeLIRnmRdc",
-	"ZQgWlAeXuZeJ5bhoj2jWI8qa9AxBhtjbFRJLGvO3JkYEhSqR2p+A/sbUCjVflsBTZ90PknwXb6MEqzD6",
-	"wFVH5FSNUdFMRIUR7Vncg//zv5/sj4E+Pj1HUSBQBtopcYFQSsKxP5nwx9MXF/tj8Mq06DaQqALdmEd0",
-	"rYOfMJsS/dNbbMspmsqTOoNLG4A6GTryPZ2qGVtwowQXLDZvEYGzJHRQnZB0QWIlwXDJzFTsdFFDmBIV",
-	"Vj+nLEKxds5jbuhxDF4vEQFaSrKsW2fL0EyYfDldchJGEUqrVSbrqpn7UX7VJGQjPVQvZV1Sa+lmHKyi",
-	"YO6ineYt6ZxG1w0U7yRenl6rkuI1ZZEU0XS7fZq89ReD7hesJr7wrVE6/HjDIMdqYBUB+EPvk2fYrA/p",
-	"9kRD0xLbMdw9S2DvMYkP8mi0/fGUvIQiWpqgP26rAMhTkl+vj8b52i5+RQUNcykUUNV4DkP155Pri2CS",
-	"FyFU5O3r7lnHVv2si9S67FztPeKCqt9g9gEnGLKNyssIyUW2edUtXiEu4CoNCI1miOpjpMc0dyw67N6x",
-	"KEYJknN/z2CErhHDNL5BESUxb3Kjcz3E9vJTRWtnefeiFV27hr12Af2L4jFFd+lhpwZEdpoGNLmfbLsj",
-	"z0d7B73V5TMwQxqyhu5PT/ri8t7FhNvpirIFJPh332cZrNbfJbbUBpQWOxk4y/9+2Ylvwt17Rgl4nMCP",
-	"AugeHpB169q75y306uKsCP2zZ4fo6y8PD0foyT9noy+P4i9H8B9HX42+/PKrr549+/LLw8PDw+2z+QtF",
-	"/ZRxk/vC7alW5uo8Dm3fhYp1QashamaDlAdaazIFRZKPgYmeUT34lRmbxEGdUzvLHOv/fDJkO57OJ02e",
-	"7Qbjtnm1HWffiaex21pd3ZCFWAerqXezlPRzU3Ykkk/sw+xBJp0yfDtfDUqQobM08J794ZycisUM3tT0",
-	"vEOeo/LNx2HbZIZL1U53VzC1vZGEWwpZLTpGe3kJc0cjaqpN4L+oOWsrdGBTGleIZsEMJZQspFZaCh9b",
-	"B/Nm+DlZn1nbdudWVSaVVpc6072pgsBYeTrY5M7T7Zr7JIam9pzgmj6G+dH6+7Y/VuP0yjbVnibOGgdG",
-	"YKf3uHR9Eoo737tmYGqqkVfH1JQlX1GCrZ5CYpDQxUL+G5M5g7n29TlXzwig8/HIAfcqWh6Yaffve68y",
-	"5sW3fCf1zAPH95he6I4FMsoMoVxPIkikfQpWBDAP9nou6deyCAJUD+yb1hu3he8xtCfH5cBLmzeu0+DB",
-	"2eXN6OjoyVMd+jeuidZ+qA59PStr1DCB/hLdQ9XLn2NylXL1x2CZw+8gR8Cz9D5X44H6QHWCtH2OAmeY",
-	"F50vmoKPDw7mmNCUj1Rp93HhWx2zOebr6Pjrw68PGzpns04Am0eb3QNYu15vQB+mEUDgtvfrCKBGxSM6",
-	"C/pcWQS7k8Pk9OTetMAiuBUhfOx237YW5h5vN4IgmI+s5kwQxq1Kz1S8cTXe4ZB70dZZLjngyq5G39MY",
-	"YLLGq1iz8BO78sVZjQg8ihK83dNoZvZALabZh+c1nqg6cPXPuX9UhdJjbhYruo3lJlSpgZTROU6c6r+r",
-	"0Fjj68px7KAPPafXBfGvcmk4ZaMZ5KpVvB3onFXKg+y39BvJAWudO4RJ5nXb5FOioqvncxxhk65opxNL",
-	"RrPFEiSQ6bwOqYVzFG6bQNAHoeEK+YThb5nKTuVUM/45EtHSZm3JT1Vi4xhcQ871CenAEKhMAFPyTn/7",
-	"DvyWIbbJO8dZPqymMJ6SMTiZqZqK1p+iXMEMAULBijKk0x/LLwXa/Pjk4leKZ69/Pvyvm2fs6oeXGXz9",
-	"9Tr+9Ry/OP1xE+OLr17+/q/Dy6eH34bduCudlVWTg3mSpox+wCvJ5kqZmMB9a5xPCgEKIVNya4uKEYC4",
-	"0N+7EJnZxndZSm14BTcqL3eGAPoAIzGekle6OBV4dQGWWCrQKjtlOvj/nh16+JgOxuAl3CjxU6NPRSvM",
-	"cSJUeLNEPEZltH35ZEtOdw1FtOzVGD2VXwCY5zaPwUmSWEeqPF/bznUMzmG01L+AOU0SeifRyQSGyShL",
-	"YyjQlHC0gkTgiB8DaIaqKCTMbVkcv5C1hiJBcG3cvBFlOtFJuTAcTFMChWB4lgkEMhItIVmgeAxO8iPT",
-	"S+FC703Ts08FtyT0LmioKPV7LzfMZzThqrv7yK8kunVj98YO6uVG5vmPJjbDbnYIGEoTldKkbHQfMFe1",
-	"jv0vpuR8lYqN9R5iDoTp6gc5mA4IBRqL0wHYkweTe89tE+x9ja+dNWPvugn/k4fbxb27pN/urkm67pCu",
-	"PdGFHuktKCMCSx6sl9GWlb27JU3QSP3bDAZQo4UnOEIgQWuU7JsXQTI/hV/1sgJBpyRKENTprnraHjFP",
-	"HTu176AXepjtmcTAPkwvd2KXCpp2aKRUKLMZaBvSUm+z0bzQHBnQnXHs8v52U5+utfe5qN6Uz8FrhRm5",
-	"gSZalWZJbJ9aW8IsUP3R0EbzseiSz/l9GrTi2XWTaJzXxQ2b+jb912kIkahJht1+T5bIG7dkBulDoHeE",
-	"b7lYXRO2M/MWc3C33Bgu506+7tDbIzC8dExzkX1Yvd4gBq6gSkDjF3RxTgQLCAEntu1IQlUzAbax/X5T",
-	"Ggf72OliY806mR2m0a2zSVRBTczzhYpxMRAHb3NCF0HjkMsbz8uV5ZPdCCm1SblYCktRISyZEpVbBOos",
-	"UqJLyJWtA+VwpoOpnz59+s+8oGshzurL0eHR6Ojw9ujJ8dMvj599Nf7H1//sGmtVdgh7cXESPUPvWMLn",
-	"z8VEJbH+7KqkBq7l+QujGXq1VFmWIFcs0sa45Y+nEp+NQDoEcAHlm29kFF0JyNR38LQNP5CrlH5LmRTA",
-	"G3IlivkQYCMFId3BXwoE36iVPehVDF6q5alUKn0CrXT+pz48mub1FWc0I7HrxS/1SKYKNeR28On0b9Pp",
-	"H79Mp3w6vXnzn9Ppx+mU//1v9ygFy5f0jnjhez6yVfS28nV34ElZgoIH6iPrjsE01WH/f/tjPB5/HHoH",
-	"q5DiYuQULlSZUKkPraQs8Q1QxWntF0qSYzrtaCsMacYbejtdRRBbFMGq9fZUNb2ZOIIiBemmLEGPrPop",
-	"4B3t6FvNi5dIsVhQwFGi+XHL2Ui0qTjfQhBDSPI2pJdX/6UE+RVSLABUn4jGi8bjN4aIWKbqpgAiP1Wj",
-	"huU7MVf1lUO623o7h3bL/lXWUStxSlpXFgNwt8TR0j99D9XbkFqJd9q2PetiTdAQ29So9aIOzNkNXI2a",
-	"QfkItatBghzRFBnA9f6+cZkGWACo7/rKxH/nuzXoVa6J73/+CcCIUc4BWivrlVnTOiZ9OKplcoJFWNeh",
-	"4qYvCozQNdwx7FhyTZNt8o3XXBATQ3tjk1dGYrUpx0JjTZNuFq7aDwwqrsWT0X+/fWP+cTj659s3YYYh",
-	"J2t5GRaZKq+ev1bee6QR/AW3hXW/AXguT6LKbgOPCH+PJevcDQUazme49rCxzsx1nWRr63J7kS62bIzh",
-	"dLnCGQhpMck/1isPQ/rd5xP2cu1k508Y62KA2DbAxX6+k6gWM1nXUBaje9w3fMUewyeOWXFWFFXgq/Zq",
-	"md/9G5Z3U3EVNOnclmsaq7Lk8l6VSlnvmaiCfTPwdpMiNXhKjOFKyvOSF91BDqJMjMGl1AmSZCP/y1Zx",
-	"sjfe1G1KENPirkqoQVPiVHacZwdRkmx0HsV8Lq/0CK2wAClkWGzG4MbUUXcFQj+7G2/P+DFcfANL9f43",
-	"Up8tLBh5aQ2p2Ay9qq5aJ7N5Vfv1m/U6kvXlFAac70ztvxaozbDC44QJBxCUdqejwbyqZsPcMpO/VSbg",
-	"Y0r2zOdD/5N9ILI0QbpAmlMNlsikgcdTErqARQFTGSnyeE+gO9uj2DnCk83neje+c+UcH80VMSDd86Us",
-	"TbbLd7M4dc9XtFxIc0evauk4H9Ub6x9olz7wwa/HqlDMmN4RxNRdV//puSe1r76OL5rP0yIDMpkCuqs8",
-	"Aikmx1OSoLkAGeFIDGteXsARirl8slVbNWdRsh1y+JQkuhm7OexvAIzXkETKxyc0aHeQxcpDv4IkgwnY",
-	"kyxDe5mH4HssrlI+nJL32QxFIgEoxmI/xIQa8zVutXnbz8/QnsqLOjQFUjNaPQpuch0z2dPheI3YCBXa",
-	"q7r0T4+N14tR4yoA45CzUlFOoM6HjSzkJTcB5vaKepkr1dqt5oOwt+ka6lLeZtJKqazVZgTTtA3HpTvo",
-	"rxi6fGmbgIuJRGjpLdZ08cKjfSy00o5iJUpGqF4U9YyqQbpHsaHyZOMTvwopUzns72gUOTSZ6/hufxxA",
-	"1gjOoqMnT1vVbH3cxXym7qyqR9HMMLfq1TnvhUZablwx1pxCRKMhxi+4XnwMLnRRIg5uNhLDw7x85wTB",
-	"eDME1mbJzX9Lrqn+CfbgYsHQAgq0P95JXGSDu+/WdGkcVfx9tri0f9dKDCi1Xd1HlC1GhgJitB79Az6d",
-	"/3PWEPrcGKL5Mg/ItL0SlKBmj3fmPHiGwMfbRmYWqWNLWWG3MsLjEg62lAqan7Aisrbg/CXm+G/2AGwZ",
-	"+nPjWTXySEn7HjO6Ktk6cllW4BUKPrpp/lgHuk0x+jsiBWNKF9tJx3SgG+0ukT+CPV/1y/N+vL/6CT/e",
-	"n/NMH/+P3dubGSAcbcn1qwUzTRkZr+REi8zVQ6mSAAe7Nfl5OWbGN222AvuopkFkVK5437vdIUypYwPm",
-	"yndax49NQYlS5i+fEvk2+kZw27XBxMd71nRsqkoZHDQ3VLYuoypAFd+R67fcEmplOyxXZ9yu694Dh3Z1",
-	"rSqyLdP6uagu5HxL3wMQoyiBzFYD87lL2DI0BiZIIiQGmPZZiamfJ+iUKBd52WpnOFohNLPcpLXz7a0t",
-	"xFn0CfQRVnfa1zmf8/5ypFYfalUXX24r4TzKRN5a175TYeGcS0U/aA9QBWl1BoFyau7p1BiaxKpZiR4k",
-	"V5HkMIPR+/3qa7SEfBkOepNQy18rXoP/rNduQQRTkZk64f5zW6xAX6MTdbn/Nf6Oe6he5klRiAhd9Z0m",
-	"UeXUdx/5PCyghAzG4ym5Ph+l2SzBXIU024qtyuUfaxLybMlnaI0SSR/cc7hiUZWnxhK2z87MbISoT29c",
-	"zuWgVueLOu8az8vD+Ffkin11QznXjhRDdUiPQyu0D15b1fBWgd5dTE9TnBKbJ5UbsTA3LtTYJCPYLB5K",
-	"zA9DW2HRdcie2h7TJmV3ZO7+OzPgXQCebnJi8daEYz6UEiE/lcwlb9nt733PMaB4f/wwmo2tbO0aUwcF",
-	"xQeqKVArRZYvexflo5uSGTZzN7bhUv/3xmQJVETcXp/mQbO1B8G1iuNafzoSsNTpxeCuIMFzVf7WZpMZ",
-	"gg5Y53TsWdjDqx4AzBVJFgyJHQN7S1GAUrKy5jiyACubzp87a01ouuSF20fndquw6ITJvKpm3lzAZ8Lh",
-	"tu/aOPs6GLVW2nYsaWKlEznxvLQoX6rcgRlybOqeMbe9AhqNA0mbbiVGcm1xfL9IRL+hUXdtLxBH3tzZ",
-	"J2iV6hoFqQIYdSV+Q8Idm843ti5qyPxW9eRN4CHvEaLPvajHOGM6+ILEiBmLeidhIE8OmGQJ6lyLmdcx",
-	"4hWVc4Vb2LufdRv7GRJ3CJGCTaba0UIt54V+dLMFub7wuYvRXe20AEa3J/q8kBEblor9xQK2m/OgT6qP",
-	"0la3QNl1+wCGGs1FisfAu7ieue0so1HelSxvA+u1EmeQVupgD+2yLeKpPtRJ/okDCLwkdc+44LrdeIj5",
-	"fJS+xxRUtJtooocII9oufmjHcUOPK2Boy0ihCr3VZNFKkf78nnEq3vcjd4uL2fJ0jRjDcbhG+TaBOl0K",
-	"pdZ4N69UoBQu+jjz/v6QxEWPZ4mhFYq11mC1vYd4IVc1t1ameGTaCQ3q03nbZ8/dZd0Ktze4UYelXYVo",
-	"1FzAMFwFsSNHM3MRyDmI66Px4fiwrst4STR3XVJrSnfo1hHmQqhyY9YebupIlOIrQi1aXxEt1Xfrz2rK",
-	"PjzIdVIzF25BZOYOnMcdZe8TCuMrd+ta2NTrygfbBg1tHy3UyrHuGSVUnP8LDiLfe7MTH43NNKhp8Z6s",
-	"lW9sTd+rMnha6lNeMsnRYmCPDXjJ652AOjfjX01e5DXiqg4krtzOr1Qg5S0Omq4qadyQC6C9LaraSkMg",
-	"UOfuGA8ShjTo1DwkLZeo4EF/lP2xuS5FNztypShG4GjspP3gWsI1AjOpHPrd23tDOKksHtQh6m66DaW7",
-	"ZQg1JQczpM0k0FZVyB+AUtmzDh1L7JdV/ZDGIUvgSZJ3zwBqjC1zJuHqgyk5wyWNUfgYdUay56TtKkoX",
-	"P5RSdCkgKEsSUBoGTidgz3VT+k9gHKZajlcR0SHLVq0Nq4LcrU1YYaenD4k9qPALsqICOakhoAAoFmuU",
-	"Rt3DEBNV0MgWZzR/5YIy1K1D6jUUS0sSddN43VIZjQ8kWmaQo4Om3qlm6cCKN/Zl1zWrtm/PWpuc/nNR",
-	"ETa7EVSXCvTnbzV5SJyFz6pC8eGiBYGcwVNdmYO3FMXIDfJaFNMWeUXyheKw/HOyFRSx+omNBQVgtrcW",
-	"FKfZkbmgCls35biM4FqPVlinCSilnlPE1aWoajh1LT2IkGw11K9WFbSwv5uWckrGLa/j+YJ09sCz1RA8",
-	"PeSlBlirB9WUi7f9L1U5FParwyfJ4qLPoQsGCVeKR+7CaDj7o/K5Hx3yplaZvLFfW8WhpF/fNE021peQ",
-	"M+R6Z2cf72JzJRqDz97lGxMkUKjikg5/xcW6eTVRK8qNZX57UxvDmEuFu/Ut9pLLPL7jje2dHVRLzGGm",
-	"3lHbb2bBO1D3Cws8iL7fcHtchlE5jsCTXGxqGGa5Ymve1do7tIs6TksEE7GsO60f1K82yisgpxjye0Xe",
-	"E3on78t1ztMGQ/P9ZjAc3GRcKtxKFT1DCwbjQifZ5rADpzl6rEFVBZL8T0UFBvpebil6beFmdJzDCO0F",
-	"/ten5uNlucpjv5k9OawzJ1TKZPh88wrNoWW9OIHtpOoOVUS7GB4qBosqEdMk5m51ZXh7NXlRNEDkVSj/",
-	"KjL6b1NkNGNJD2uoIlXMsX4XAyqy+01XRwZQmDJrhWPQTYOdWc1ywFxG9OuRKrGNQNXtzlgSutlIOhc0",
-	"9XakEfKm4ZZYPnqViTQTDYZpqgaYGP+UplniZ3rYhG8/40NFjJrwGkwWU6LfXWMPVG4/Pefp+YtCyTH7",
-	"JJ5djziObctoPgbnH2CkYtgJmhI618AMjeniJ7SZoPkQqKQjMseLlzDVfzMl1Ib5A5GHt0yJznMxBmRS",
-	"AFCHl2sogwaE0kJdLYSnpc9qnxR9KibF3G96nifn5COqiTrFzRT741DeJV3Ow2zXzd343+jArAw1EFai",
-	"yuQlhrJcTU/z4Jj9YZ5vWclF79Tw43fjkhozlv/v2fZxsHYXDRKHeiX8pvmOyANPxRIjBlm03HRF3w/u",
-	"gzbJp9QSpkXjDffjLFTnLLZ/8ZhLS10+/Wm+0ya8nlZvTGO4uvNwvkeqVjD09TM3mSX9XCoZdzPs/oQ2",
-	"vm3VTVhEBRxHrOOrGnxQDZDqku6Z1tncFJNV3M8ozrqrZohHltR1SGCyETjiI9NvK56NhKpO2jtSbdhg",
-	"vTXBYOugpHPinwRaK4sP5zTCeV1c6At3Zc4ZbNpy6Rq1qFrN2m6kJ19CDmiktLRCL/6nIU/eHDMubusL",
-	"Uj+Xv+v6cd4S+iGPKNNKSddu/o0r+a7KnaxXWyK5vti/ExzXlXrfvmsQco4XBMU2XeNgtRmlVKmmhMZo",
-	"dDToUdb9ZkmZACsoH1yUQ6WH512EqxBFSxRnCQo6M+p4s4smKobyxzVr2NIY3KzFujNM28DYTQz2dNFB",
-	"KXe8hoxgsijeVf1zVy5q0Nlc3bRwM/lEtcUJu1f0L7asvOQvCmhuVR3LXWvvqR7eaP7zZizpc73cpprN",
-	"tMWnGniasPKD/+TWPHfusdLZh7ZyHRa6bZcXJZ7gNeLmfZkSOez3CU1cuNeBzViq/HI6OVO8XYWZf6Ov",
-	"vd7zlMQ0ynRkrytajIkKobeY1E3L+PGUjMA7I/K/03XZ/SLB7xxC30kCfGeR/87IvOpzbwwksT8IMgRW",
-	"mdD1hdCHKMm43P4ex7NE5ftmJEYsB2B/SqbE4hfbzJk1piqNQCwRL2xENYzM2/IQOtIFuGcbrQxIKep3",
-	"gMhCpc5D04gcEsCQXC7PPb/DDIXl71pFPGcJlXjAFkmpkzUmVJDE19K6q8HXDSVOat0MuXGxgciNvKHP",
-	"UjKt3Dejz9VM3ypbdDPN2HUvTPuiesjGU+Kye0dzqKu76TRvzZdWkMAFikelFuMxUgZDEm3AnvWvD6fk",
-	"twxJNTCC0RINjbao3PJwgfbHwEmUXBmWfdnK5T8W/uwSIP+dXcZgDyZ3cMPB1KF9OvDv0zeAI2SLPUhS",
-	"2S95mR3kn9S9XKSp7f3LpXl25GAuzto9Ir2uk0ffUPTSjfvkweiB0+rmcbcduUO1KlXebmONyntXrsqt",
-	"jspPbaDZbckqx1gfSdWq7QvA5Jm/BQNTUwGY8bb1XPwVbEGXkENS1JVUqrn6Hd2QdZSwAwek66xQLkuo",
-	"Sw1K8n+OCUzw732SEXdVJcbCN/GKtxRvB3jFtVznV4L1bGSlGaxcnGJii1tuWwPGgVAuAlMx3j58FZgy",
-	"noIvfshe8yfWhHmQcOkmEVCFwNZ3TCv7MJkfBly9alqDOAkJ+eYBAKIcme4dQzezyu485203VHvAL8ic",
-	"/pme6F35nXcVb6O8zKFYGzNZ+KGrzZr1hHzVxZP5Cb68ry0imCmb61y1GoBTvawaoPzl+S5DyMuCcU8X",
-	"Z10QvzM/u89xSh2eXKXDrC20ye5et03saZdK6KJilQo1Ukx0Q0Yciqp5YboKYlvwQr1MtHs2htfvsc0Q",
-	"5cHRhIsuPo4StXbjig/Xse3fi/f8W12fFkqpS2ko0UuIa1qfuSliAYFtpgxY1mbFqKWL2iNvPs1m/Hhr",
-	"F1HUjJzaDIKw+FXbdagoOza1HaoIk/V9h079nNFcJiz0HOKfb9eg8ik9CpNRx75BZQL61I2DwlpTK9z1",
-	"rYPKG6z0DlKXIIJMPZupbiphQmjyxPyx9n2Umvt8o/IejbW2gfo/W1J/JAU7QjDd11T6MAU8QnP3NZvu",
-	"vqJH8EwfiTF16wofoc930wyIlVhKtRuQrvfMp6TaxsR1LXHHaduWAMqA37bnUXbt6WZZzuWychLUg7fG",
-	"6WxmzvXZxoWY707s4Cnc1rRdAidcSKRFGjQdespPniaCkwopltroVAiy1EenjxUvB3YXJXQaWj1V41U7",
-	"tnViSKre1zTBUSjj2cgBVgDQxbuRkFQu+cBzmCQczGD0XgoUVSD82U05QGLaGOeF+BOkMm0mamwxI8n9",
-	"uJtmRY2PWi9XwCNoV1RuT6SjhLmNqB1WexUNH8SbYEITW4PGee48QH7LyjyK3BlrVFxCspEMspShNTaC",
-	"eW3A+bhvRYtS6Hvn5BKPCraVXHYssTwyUWVbGWX3rYnqn+HyE/HXc9z/OX64dkklI02Hfkn+a3uvhknl",
-	"lIneHZM6RBj5PZP8v+elxQt/7d01iflR/aHAMv5bspteST6cO2+WxMJIqPKdm1KayvYZBXqmXaUT3DSW",
-	"atkqm8AA+LCpBBEl5GFyCW4bs1Aerl9IgaF8Zg1DShzkERiiurQMKZz5n9MzxF+yt+S2i64hhZN6JDKb",
-	"hOWlKaLUr8oHQKbhhxHJg0/olKSMrrG8NogF+Cq4XXozzqjUZ7wWAEpxmRJJBBvVZMSwvBqOZ7NILRmM",
-	"/z7MJQw+/vtwSgLa8d+1euSKYIz/DvbSJHO1GcbT7PDwaYRj9X/lz1oZNjAFu0s3FDNBRLCNX7fAezFq",
-	"AusmuaAy2+QrK7CtjiVRscawDmh9xcZ/L5o0ogTiVftb1NiU4SrVYp85k9Edg6lk0MWGAqZJzBwm3DSG",
-	"MXjggL/H6gOJEIaSTRHEv/3hnaBI+DmRCkL8sSYZSWPmnlCqbOGYqdQPB+oXXGubeJbpmCNaZxQwuM5N",
-	"Ab8UVfY33wAqlojdYY6Ux0XxeB09BDBxjxcHGdd9K3x02ANWZ1dda4w+YC74XjQEJnT222/BF2rdL4Ak",
-	"hidf6f8NIqtTywG3LENf7AexuruOE/J+69RA7/7ybMYFFpmoaTvRu0+Ef3fq8tpvdCSaSS8u5IAXWtsU",
-	"76GXgA7ofEq6JqCvMq7Kg3IkxsZcY5PXpQQz1G00pUCqyv3xFjaX96wwDG9KajkeqGd4bZziEyS8GxZJ",
-	"/bz3IvOztYi1JOcyQjDiecWXX96MEVm7poVyr3Oc5F0M36MNf2Tp8C9MFjxl/pn7jOkVR4CSZKMeH0LJ",
-	"iCNV8mut39NviuVMdDa9KQvGbXWhyC/u0YmvSMR8vH86fdfuZL3Sczr0HCnJxg3J74HGYIVV6zqD7VR/",
-	"b+gNFlba/4TOYBWhvldrsGZzyg56g9UaoY1VXCd32NrZ6gnn2QopUakT96CswDzGfWNJvVcoKPI/RGuz",
-	"YIHUWvkS+CK6FOp52ADSe9tOr2hr3lT1ReX9n40fqExyakDukWrIOODFbmug4try/DHEdy7s2lnV3PhJ",
-	"n68fNNtd1vrx5uoS6AnkE6CDawvtt7X+OtSNArgSUmyEIPf5TLmgnJRCCify9eHXh6GKCwylCY4gLww+",
-	"6pY2UIOLm7ryXWanXP9uGo3RFJGT64ufn5pfTdh/xXFQHNbTcq2n1gva7vPgSk8Jfn4KDoB/FA6EqkRb",
-	"3bK2FTZdZT1kDF5jhgBfwhTpikaIg3cRZWh9NNZD3h2Dd/LqqixwCFYwVeWSpNgj37UZ5OirL0eIRDS2",
-	"okKH+sh+I46QmmBtSmF0/pHnYcw2IhiOW0pagSqG2dSlbobdr400JVV7rsGGrqXN0QoSgSOzZZ/0rXH2",
-	"eBD9fvlrtPr5cDAcZBwx/VwP/uv1h/S/nrz6Nki0LmgmULF1iUxyuyu0XYgEzbExozRBkPjmQK82hrUn",
-	"78im1yX/Tq+pLVYdInkdIA0ZeXrKMyjgTU0Kuzk29fwYGW0F0zTU+IPZevDtD1OxcLwvz4ct+UTXZVCn",
-	"VqGpQbl+qqTMUX0l9nKfNbf00NtCPba0AtExQLzRxeHqx/f3Z/Ba+mvPBWj+tmsmQN0s9Ry1AWulAb7n",
-	"4QzNMUGeJ0Exn1LpfyNbQsmNVWgGwKX+xp+Pk6GMzE/qZygBs22ka3manYS4libt6mcwr0JOb/d0NZTP",
-	"6xN7G0In1kWPrJJdESmWviqiQ2pKnpTEh3LzjgK+eyDWe7zadZs5Q3xZX879B3oH6FwgZVFmKKIkwgk6",
-	"MN/V9fw4WnboVNztHtzmHykjVaW9TLlsgioNKyi4W1Je0xDFA9uYSVW2TJopX66LByudrzG/q1DBYWCK",
-	"Fdyogky6o8umZmmGYLRU+pxYMpotllos9Hg5JjqQWVlMTSccz8jdQR6yoytlvOwPRh7uchl6RCG23Yd7",
-	"Rx+W78UOy6EnkEvIJVGH24u9drU/y0BI0lG9xlJGI8R5sQLg4Mnhk2ejw6PR4Ve3R0fHh4fHh4f/3Tnx",
-	"Wy92IymH10qiirC4UfxMH4/8DHowDrVOA1uuF2Tsl23SHwHn9lbcGDHlKkUMityc6k24RX+t6iQ9a3gH",
-	"MdEq0zY2bQqHZflMwegnZYnGIqFf+I2eshJYtdZVBZumrBF0K/PaOmJdC4zVhOPITdezoFuP55XgcTW3",
-	"cqEwS5TzKaQJFU/DF/xK8q0zDTgXvas/kxdtrNFQICFU5B3e68wMLWaFk3wWRVixa71Q1i1ybCVwhpL7",
-	"LPpCTdBxvY8NlXJyw+hVCn/LAr1BvPqUQZ3V2DPd5+/doDGmBzGN3iOmvXy/6kKUwQHzReWXGeQ4GsFM",
-	"LCs/cb4M/6Br1s4oFVwwmI5Lv9L3qGRpdWB3ZjPhiLOqicgWQG7GzzabbMWpxEKnXQ4HN5naniqI8yFU",
-	"lDcTSymrRfoi6dHK7SeHV90vAosErRARb3UkSGXC83wIUEOqXE9XIgh2M8in14a65vnNGG/uXwYwXmEy",
-	"skvEaG3+/cZ7dWtKt3o9doI0YHBZPvmMq+BTUyDyLYx0qeLCAZkxnSq6VpEcxEyQS2sIJQlr91hddenM",
-	"xC6Y+hnexlQEiRKXc8qQI5X/3y9iXmW3mVi+RNESEsxXIclIhyiguDz1yn2Uy/m8iOtOAtOJD4DZf+Bw",
-	"Y8zTBG7CQfOlmsjKomcfnBJM+emqj8ArHq4LnDJMWbBdxOkSRe8BZbFpU1U4hxgJ467YS+gdYuBbsMSL",
-	"parCqSfcD/dc9Hws7XTsh5Wp7LYhmCpqnQ7kv0pEPR0UY4H7kLWPdg8pwzLdhOhaK5xeUlxQrA1kc7Ja",
-	"xafq+vemr7yS1txVnLvSw+g8mFXW6sTv0JKeC7jQ1uwtvfIlnb1ZevaUdtUZmFp3MM/t7F1zHXxLofAb",
-	"kQXw99p4GG1XV6M5lP98BgUsDcn/VHS0eiO3sEHXwluuDN56Lm11S24ZxKEEZdWmPWBnVuyPKx4VMcr5",
-	"KMqEMPltEWKu1zQkYIb8nmE53/x8bM0aeZ/UwqxA2NaurD/eiTVZTdXVhqx9+/c0HGvkf2JzsQJiglZ0",
-	"HTQTUb+GoKAgVvEXJtIHcpAytMY048kGpIzGWZQHqbuy4DbCDEGWyNdSI28MblQWjBzuaEAJS4YxuT9W",
-	"+eWcsnMYhcpXFiL5TPB4inQspzEmqa3WGnRrHxkfC3qSb/IuR3nXT6iKZ0sk5VHWf2JFsWKgnQP14Upy",
-	"DQd3S8RQ61EICuY4EYiZtl45xhqALJG01U1Kdb+CvT930OuzSC9bN2EfDtaQhSro0RSo+v1OXNbJ+8rw",
-	"aSm8VUTURFt7szu7f+xLECoIGlBJLtFdqDiaOk39ke0vhbm+8CpARr+m9U01+1xsW16VLMAqSwROE7/7",
-	"rspFg4phD/qmWZQWk/oEW+naiXhuycLcM76kWRJLUcEUT+3gK/ozO88+YIqByyVSaQZFpPFgr8oHvAdN",
-	"WQrl93UHsbD3CCZNdQBVqHZwjOdGtTcuVMxF8XnJTbehV3Y3F6v0Yip4g7VoU1PeOLCXH2+uLq9VB5J8",
-	"lGtVXQ8mTUPpRLaKcsl8BON4oKMhoQmTUKw6RPQpFMswkOCaqj6RVnnTgWuCgpU8jU3w4QznFfys8wAo",
-	"4EiAPWUfiuMDA56Hhv1qFnY6MCCGqLfR5d1DaLHn+MlEkVpCekSSSA2Mj0AQsZA9ajmkwBS6sOKUcqHL",
-	"z/zsGkHx4BGOZpDrMFTbL0q1e/IztFQhE5gkRsNQsrgROYaFtqdzTGCS9+4PCTLdCxlXNxDcKEO72ucM",
-	"zbUnWE6HyeIbYJiMbViaMqS9EvkkXDO2rrvKgZxkCQoX6JbMlrfpjLyiNCKG7qU12qy0nLfJu8dNhbEz",
-	"JyUNwY2UbudZcoPEEJwySn6ks30QKeemFNj0FuLO+Ra+qhzAyHrnB6u2Y87yGGRcIa5CRWCv2ldsf7yr",
-	"k/5Yq1n0iKWxykVlpldpDAWyoTa/ZcHMePODTmk1AkqiW1nZYIUvuLasqhx35etewdQWS1S3fUoUPN/o",
-	"+DT5GCAibMixE7SMlXaWCQBnaoR8UhQjSVlGUBwy/bVF428VfZ8mECtXogu8n9h2dGqITqgClOj+bg4N",
-	"bit55Y1w2D1/avzUXtA9THAhUmb3fnlrT4Xc57p6dpsTlFcmm5JK1NqtcieZWeQhO94nGb/cy0jKX3rG",
-	"b6ZEIcscc8m+6rVchzqyRhMuJLFri1fBoEBwpYrLKCbDA8gqvYy1BsdXHLFTmOpXG6OGIv5yZKkPcsro",
-	"HGs+qz+qaO7ezE3H1ugWVDqLg3FTS7swsmn6hWUDm3bMLtRj5BZb4cOfRj8Z7sPacLTDvuFoygfXpr0V",
-	"owCC7LDEQrvzfo/1m2LyjvUHIn1qetGeM0YZMD8DvqR3JG/tXVhF8RVVFaJDgbS6nfiStC3sgInNpFZP",
-	"vErBt4sqvYqpEAsvg3Y6/dt0+scv0ymfTm/e/Od0+nE65X9vT51VYDV3bFVq2HNGV13j3CgDmCSYIM1p",
-	"K5jvk4oeyCCpVxgvvFXBHrVVM+YwSWYwer/fLfbGeJ3quceN5GrM6VGY6NsRCkSYZTiJwxGj38mf8uY/",
-	"XW5htfGPFJ90+mt1ge+xABFdrbAANz+cBJpGfRmckp6wkFnD6FCqeapAKr6uOOUq/qpmwqub2umMciMF",
-	"hQ0XaFWYMsEk+xCestYz+D1156KiRwQF6gyKUT/0aPzky/GT7p7Yk1RliCpGU3GI56/gCKa4lz5u9gHM",
-	"0EJA5uH4aHzYNVoyV5x9mhh6BGhOwp2wj8bQtX+NZktK36vWxh3a4Whd0cQ4mzYeegbXxLrk353PlUDg",
-	"9JNQ2LfxDuaMAdjPtHqDuV2lFHpVaJN7h2YjmPYMvKp9H7Scbh+IwpkZnOWh3oB7TcqDEdz69+a0S4tI",
-	"7R+smdpBUXA4ezmZguHFQqrnivPwpgb2imo4cF/40z8J5kUX8gPNnnIcVhcPUpyJrahaMf89YwHcfj5p",
-	"OICFYtuIAPf9ToIC7Gxd4wL8RP/7hAa4s/jE0QHF+KHqrfd/9oNtJsho2BycXhycnukrCkpNpk2+q19b",
-	"8rOJrClHXj2CK6VAue+90pPs9HKpKfveMG0e39U906f0mC5blxJOxeuXJx2Vaa9PsGERv30jDN80XYEt",
-	"wgiL0DxsIGH1mnSJm2jGtUlOP1mYJiqNGX3e2DwGu+Da8SmjmUeEPpLkLP99cRbs54gjaMqV+aHNrmf1",
-	"csPViDzf/qWNuijS4emEq+hJVeRYx77KEzVLlwxqgwiPzIwtGYOdtW83Oqguh/hYJxt280FDc2okL6TT",
-	"aFkrDrf8dNiYVXqqS/YaoPKR9rKUIdxB24kOHY+/97ocazhWeQ/kZJO3Pi6Dt1XbYzuJdS43FHYrxQhB",
-	"AnIbaLCxo07p8Ls5jvsUm61cGj9MyCvtYRcY3zcuSRnbbHAS4uDO6WD+yurf2tE5Hny6eKBdVBt1h5+R",
-	"z03pmqjWoY9ASJxk5L4iopxipwLiJCN1SVl2iKkDa7OzbPaKDmLKWaPtTrLGqqWNhtx52NRpyREqCqKx",
-	"O1uHrJiSgFSbGeO1xsh5j71Tew7yqni3H5DOqoJZj3SaSRMkxnIXCK3arjWJayIw0ueBYq+arhM7Ashp",
-	"ZSStEt4kI8pOqNtP1zfP95icMgraoNLGhr+1hrhSgpz3o+UQ1vKYs4dT3bUbMbCCmMiXn9WEmDIEebCA",
-	"35IyAVYwWmKCRsq1qqvpzZT3UH7kkF1d/6Z+wdwVUHVJKWT18hV089iFs/LMcuXcwks5ZdIeueSBKVxf",
-	"B5083ORn8oipt+7KMrIrzVU+HI9Eb5WYsD3d6y9VYjrHbzrdpoQuws3nQ/bsG4FScHQMThNKtDc1pRwL",
-	"yjbj8bgnDb9wYO6cjqvt7dvQ2lsbnQRQKURyIh+xUyq3EBbmb/EKjQQdqco/Tor1T8g+hG4SsBfbV1dv",
-	"ECT4PQJHh/HR8unhaj+I+DvPdt6Ryq1KXMLeXfWZC6NwC1UvhEWzcRvA0I1vNWl1+SMz4mKT+IrdTnS4",
-	"Qu3ini3vGkqasYwUKsr0ntC8ZX3QKCB/359D3kL+vltcW4VcGpzq2qumyKVwPbQCJ6+BFG245EgxEhAn",
-	"VYa/hPwFXqOCsabes6auZEIX/EA90ya61VWYcn0dqwa8Nk9bXd+gqzViMEmK+zODc8nzGtm+3JOMEP2v",
-	"myyKEIqV4PAc4kT9QwWqFC2E+RdVy49AKQ83S1VINf00ctz2ogn5UuRGlwplFNyDdsMaomH42Jq4T2/u",
-	"Xa0eYspMTdA8VNjDFqE6nfhVNF2DAtUgmuh4trxuptTPTbUSHXEn/4oZwN0DYs9zsP68guteYaOK5cEk",
-	"E6rd2LYbGwBVv0kco+L9MPadftKW1eHDHPF297aU0IaCD3OwV+NWb77HBgEmUltQ4ZW7fPd9Q/YW/qdw",
-	"7cRKIYZO/pEqNr/gXrZOsU9FcAKpb8ZgalX/6UDH31Hds2scCGLLCaWRb2whsvQqU/iwosfHxq05/tv0",
-	"tEr6i/Eaxxn0niHJiKsp4Zio5oWhuNK82qF8OezIJnH+qJdaWlPATi5Wib6KEkrQyGyhakxZQl43lf5t",
-	"i4f3Rjf9Cj/B/heBR9iT0ZpwmhsmHkJDMkjUCGi6MUrUq1c9pfx4oOB1kQeOqNAHFGXBoMitJH7PClRL",
-	"Ll1P3/p9HIiaFPJSK/x96+Fti/U6bCcUxmFrbCE3x6u7omhFP24RjdFQCR/KtjUEiMQpxUqoJXGhV4xx",
-	"yjjO83kFiCgsfnKzv4TiPjZ/9f3ODP5ytqIjtXybI/errsKqGvzlJPIFd/QUvMtqUG2Ib97GxXCdlkB5",
-	"r39Rh7fSwH3ufdRe3ErvRTslTIqMKAHbDqdpON6+7y94ocP6GFzMdYvYIYg9SSj365vBkNu+ZzxbIRYU",
-	"/9aY4zo992f3G0jQGiUACpOgq4Qz79DNErZfpDtq+zDarfplYN+0cTsflTZIOYe2eM4tpKu5WrCAoDHm",
-	"264RNeUA2YI3fQ3ZItPJR30ChCO6WsEQp8onVvZOi83uMyOyDlWbzGuy2ezizlLlOVn/DFlorTlOQkrh",
-	"c6yl19wF2Hkt+WnNYngVdORcnV4A9ZNSzjKpCeEF4iqTRMBFsdAfQwvMBduMzZ/GEV0d+AWGD2CKj9dH",
-	"48MO0fMaoCbyO7fXIVCxRUhhJ+cnzUQ4gxxdB6smfCdljxSKpX3e5BuLPqRUZThhWL6W1cTAbctINk2a",
-	"d88qmIsoEw42k2HszbKCH/BKMo2vnj17+kzxUP3fwZqQ3LWxqsoYsZRysNaG9bCAIibMw1Pr1+qQ7mPq",
-	"CQR3m9/kBHOBlA9E9Tbd8zm3/Mt+782HXW/XjAoa0eRAoGhJaEIXG+fMrDLmH25vrwfDwWJyfToYDr5n",
-	"MF3+68VA5W5wGr1X2ai3p3LIq7PrcAWDhgfEMww5GnfjpSg5QxtKYoBXaYIjLNzLVeDzjmc0vSZDhRlG",
-	"lHvRGufC1XaaTIvG3ahIt+lS9/EvqtaLO/AtKtH0ETgWJRxXpn0gb3xmRq4Xk8WD6zvIg7fRPdMtQpse",
-	"aIGoN2zIJa2B8MzqMJuQ5df+JsW5vBOr61esCM10Skaxlfm8UIhCS0moIukZiqckb4qkRCRT1dKKDVIu",
-	"XsvHmIM9T5zZd117wYpmUgnb87tw7o+nxDb4JFRo1qJyPhFWgvcKpjpWZkEoC2fIl4Tk7RPleaVxbY4x",
-	"HRMdedJMVQIxIu3tEk2J/vQLDrwyEmAv2Ge61It5Pxx1pxqf2Nr9BtW6LWCStxw20UbPGV3lJ6pxtoIf",
-	"fHw8OwzQmX8yfx4qFV2oN18n4HukaLE4JT4a8y7cORoD3bq/0cgYqW9sd31XoGNK1Lq6WoAS/MAMRTDj",
-	"ypDPVGgjoeDseqSM+9TUZqYa3O44ZaFQez8KfeJVUTLKx7hN46o0JJ03srhePiJjNtiSo1U1FUUeuc2l",
-	"gWPJZ5QSUNK4+RclCw4lDmc8wAzM0BA3N+92ru0pkaW8Xh+3Tcme0OYhr6lhVehfDM5htDTRIZ7DLb9P",
-	"UtTUMYQkVryZayeBZTrctwwpH124ny7wGXqVjU9JTz7eF2+B1+yjulOmIJl3nRqM/oUD36YORUW5qfCz",
-	"S+WCCas2wToU9C6ool/JP3vdkKzmcVd/6wy0l625LPSO6Ac5NzR4+eiFDOA6603nRXKhtdDVJv9zM7fy",
-	"lxuW9vimUxeVkl2wsw/LILm6AkdRxrDYKFexUVERZIidZFpJ1f/13Nq5f3x9W4m4/fH1LfhODQOq4Ump",
-	"ncJ4SqbkaibvGYBmhAqr2NCMmfB+sTHhw8Yha+L1Aba1hKbkpFCoZYlgjNgxeFf487GFY5odHj6N1Frq",
-	"n+idBEIVuTFlG3TJEOXafo+IbYz14+ufbvKYD2v5kHIZ55nthqnujwr2UIvleF0KkQ4+flT5BnPqXg9t",
-	"HjS1gK5SRE6VRXwwHGQsMZ/x44ODBRbLbKYsGbnd3Ptn9X5Ozm9ulZ1AXqh8ZnBh1CjgooHBdQLFnLKV",
-	"Po18qEG7XzdoJHWHNQJwxgWD5rnQtVLNbPo5Ss2UAJEFJggxPpwSqQaqtiA6+1+VkB3p9Ce/aoROZpDo",
-	"YdSmRyknhevXDjhKIbMUNBgOEhwhEzRkcHmSwmiJwBNl4ini8u7ubgzVz2PKFgfmW37w4uL0/PLmfCS/",
-	"UZGKIimeikSnV0nheKBNSLouJ4EpHhwPno4Px09NbUl1ZQ7GdyhJRu8JvSMHVJK/5AlChYaMmJdTEywq",
-	"OUEiY4SDK0nLcjfAfZxHLrhuU5Brq4hWFibPT8E///Hk6/GUvDLGmJen1yBKMLJSg4pKeXGhKsZhHknl",
-	"rVT1yNwJr4TJlMgv9SwlA2CJgHL1UCrsRFc7xSiJOdizwIH/87+f7B9PyQi8y6n5rYHx3bHZeHA1RXfK",
-	"XmL/YJqCnL64kE9vcUrLzd7+/+x9W3PcNrbuX0H1S+w6krqT8UzlaNc8OI7H8SRj68iePacqSpXQJNSN",
-	"mA30kGgpPar933fhQhIkQRJg89Ymn+K0SFy/hbW+D4sAIpyW+PfXIM7zyl3xgrm7f6ChFxNBHKlhkGBL",
-	"MhXe++JjLCbaeBPPS+zB/5FeFh0fGSUA8d1qlROnYHp2yPJ3lVKeKl+Vu0/VNYv1JucFxHhWgCiz9C+u",
-	"f/3tYhEddjsYHmVnQX0JFwsGOVf6NT1INlr8xstdwj1ePn675CNOluoKmUtxe3+tCeRWXf3+GbVnWXMJ",
-	"0FVh7n7BEdOuIYpOnSq7qxIL9x4VRaviWW7JOSfmAeBlvFp9W1Z30qvlP0k8JkiITX+WXax+KfYZMplB",
-	"ACSBhGhZti3p/Gc8cBEC/1kqF1I7+TAIkqUtu0CpEsyT+9qLw9Hu51XW9Z57d4cJjQeg6fy9Wv2p/qW/",
-	"0XCNfR+R9mYcJiNrPdfJoWjiS0JqEljfJuemUZk+tqMhyk14KM+mjOTd7yrPxINBUIRAUtxCBtsoYj9Q",
-	"/9j+3McVxQdqGgGQhvtil74PTP6IPHnOkwUis0G0r95MTnIUO8/y+i+174zJ/sDS6XgRv/Ir/g14NJS9",
-	"81WCqHjoV/zbSwlaCwj+wMlwMpzNjOO772xeUicm8bDgjRr+NuwkBkXhKjpri1FHTlq5RvNhlTGbhqar",
-	"E0W49smjewT+fUDhMfs1YBDQp3TmtxiFPEg/qiN0FQbikOOn5M8SejKiU6T2Xn4Rrc5SFZma98lo3nMz",
-	"v4+DCPFohJh4XXuGO3PtIRgiUDyCF7yI8DrAZBOp9OqkAS9FYLrD8tqpioLD2N/EfP4y4uPjxwNaEgEq",
-	"n36j5iubiP2rST2Qh6CKwsXe1uJ6IeYgzoW4zux9pWZfUBEM+4PCFVcVnYoSDgUnx7BVFq1rLQ6FJzKe",
-	"KDuZyMzRbmpSVeNfljRAy/wqr/+3DmPy0kNmDWtufLthbOh9ro39Bw6cPUS5Hluthuq4ErEohjRAa207",
-	"pjZsVC/HhszfB3EB5qhRpZzfUm3jp2DSpmFIH1mKw5c/oQB5jIY3/PcFR33dW3iHmfXTbw5hlBTeJaTj",
-	"c3L4+GujIjbJq8iKfC075F85xkXfzR0vh/pFSTj8Rt4FBSAg6KkKyEUcy1eLSD4hEm6AELvA99t+mpEb",
-	"W8McxRdKZU/OHDVgX63+b/0bbyh5CLDcJhg2JpawNBrIaa5g+cz9//9IGwqQ6dr/H8Xv3JpM1RdNSD5v",
-	"NKHK8M6ILJXgKiIWce9QJs5b5I1ED160LStxX7g2XrVhzSvDomJqXnx/YhH4PaH4Vf0bHyj7Gz2QdmQr",
-	"ObmuQLyoDjfUp7Fyby0Rv+3Q9g6x84baajSrePyF8teMXx5LO4N3fzCAV14IEwFI0ptM7CAr3zw71I4s",
-	"+hmP3RzEfJ5X9ONod2cWLkkLazFcakSZc/o7L6aWOM+MOWOKLlR5chS5dWpcBKwFQe6JGQ9NiWu9wcyB",
-	"++fADRfzxqTXguw6BXGtBG+xEYsgrhV2e26s1hnIXdDgLulvHe09B9Cthluap0hs2ye030Rx9oo6+yJ5",
-	"2YLijhShY4lbBjSOKbDXsZFRp7glqdAu3xMmH9nmovs0IUAUVElFM3dbz5y0MCS2vDQ35lNiqPmup5A3",
-	"Y6whZ81WU8NXM1V2S1yzVQ1DXg1tMDuC7CDOVLZnKpsdfgtLqXMSy2dPfhPnxnHNNhV/IlpDfvO25eYx",
-	"TIXwDpSu7+UcNlPG5HdonbF1Clm1XZRT9tozalZjWWKnQknhKUA00tRbtA+gZ+apJQvYC271iui8rCGr",
-	"3QNyTCHHaOxh3kMd+R5qhzHKMkVY7ecaia3FN2LJU1dbdkSfkoPRzsUdyRZX5cyXGJ4qfirSqLn3TdDs",
-	"QwbVzb71ksy+cAJaDqjpR/rVwsyPkMGb5D7hyYsyyXDYCjLaOE9JjNG7XQC7hqmGIkxafI0Ak1TVrfiS",
-	"VjOM8JKr37gQJ8/MckvPckuK1hpbqFr0l8+ev28usWiHstjJK7rlNIpKkgIayiopXqcuqVjjpw0ppWpp",
-	"TaPXntCxGnahnNo+vgPQGksl2kLkIpN0B7ixBAUDY30WREYuiJwQRVD9Qr72OGSmWBsymbkYcGaViaUW",
-	"x8WWXpqmYEo809j/gnmYcNeQeRoqrKGgxcq75aKG+oYhpWUNMTqi4sMzTe2ZphqgbWtKVi5n+eyVleHO",
-	"a02ttWS2RoNsFFOaO9KA6xrQP3XSewIa26DBVut8yocHw9Rq0FXbaIXTSzU4CavOTNo46C5cuk+wji7M",
-	"WY0tzJmJ98iJd6txkToV78TU+vh+rvrEenXM4JxWvywOiC3Jzoz2lNh1tuMFzGew1ZBP61XUEGmtum4Z",
-	"tF7RMNS50AJz9KUP3hToctuMVx+/WnhXr+XLZ29/QgZ8ZibtaGzWHBqFb1oRDYmrVsLkGasTmtrgqNVr",
-	"Z0pOe0TKagwr4fQIqCP0Gm/eZobZhXJ2C8HxRAKjwP/MKDsIHXKksJPQocPE9Aa+4rSk9P49hn1KesZa",
-	"JpaQbuq7O37j0/tP1DGSO6nqhQz9Qt5ZyciPiPW5dZkBn9QBdtmeFyCfxVfTs971SurOstMq7FbPyNQ0",
-	"jKBRbELJCTH6AM6SRoNT6vQBrEd5zcq+fPbCE1SN7GzayRo5s2gUe+hlNBQ2Mve/T/7UdTdQtaFt1Kyk",
-	"2nF0feJlNY51cXoChzMCG0sc2ZF20Ti6RuKI4oOR2MEsdHQvdHQVUHSodTTyHaepHQN4EHu5I2s0E9M7",
-	"jJ1vAGMWQsxOkDrk+5USx2dZxaxtqKGwFTXU1ExIzGAxUnIwVghqqF6IUmtUC1FDt3KFrGIYnUKr27yW",
-	"ijGKhYn5a4TuvkZgCmhlCC9boZOvDMSTzbULOdF2mkVsFI1Ch6SdDVQK8e7k5Yk6qLShR5SsjWks2TEG",
-	"VgOtdNOTGurR1FhbkEPqoim0j6oxuO2hwKz0gjm7fkTZ9S36+Q4lBbvl/zQNoU8nYC8eSMuZmGiQ6bQL",
-	"Np9o+OUhoE/WhyyUqAVxOTanKvxLPTsfqJCYUmZIbGWE3JhPSU/Id70A+RzGGgoM2WpqlIZMld0qDtmq",
-	"hlEeDG0wLsiZ5+YzEnpWJbIItrCTOheRhDGZN5vLFtkGWuoXeVOrvDmLt40vmzyKKh0Ww1VaZf2svF7r",
-	"lLsFs5YydZHEGbltqCZ1C34aP58zBFdD+YK8tU9PrGmA6sbqTW6wXWScM0P3mAKt1TgCrTnVZOQ6UouR",
-	"WQu83Y6xz2RdHw1Xnj5Jhl7BzU+m5ZaEvB8uPjANt4q65jSA3gh3Newr1vICwW6BW7ux6qb7AXqDG+QG",
-	"xK/PzNcKQm3SXRui2ykqVoMui9OlobXO+WTu2YR1tg21kfj+YUE+5xKMlwO2HCx0mFfg4jFOyy7o2W/Y",
-	"JxgkFjWxHIN8v20xyyPPaM8dRqM7HD7uEXmzpSGigE90SAOlZ6blCiAfIhSCLYwAFFEjYPTqjnwkwVF/",
-	"8AmzrXg6gGsUgHu6R8QThV/56HGpKrgUFfyVr+L3AIYIhKJ9yL+6I5+3OAIPOOBQBfTAQHSMGNrplbxA",
-	"V5urC5CWfZkp9wJ8OazRpXzvJYDEvyPaJTPhgTC807t3dUeM4syHdGAnLcsk41AnyGhInIASQ3R4xKaq",
-	"YcZWfKk3QGEW2v8DHAF4YHQHGfZgEByluXH3we3PwupMkJetSjrQkaqTlt+znpOruLjFIod2TqDoR88h",
-	"Gs6MxmP0cMvn5N8uso3ZrOpkG90U3Jb/D3ojXaSaFIdTFWlqcdFIl0mXUlNc3fVEr/pexKYiuFiAxUFh",
-	"KVklrBSWDiA0uO/tHbZT2FMfgzzSju9d8sH7T0gDtMbEx2RjwT+DIK08OZ2BBgjERVxVM7FbGqAf4tra",
-	"sLSLaVG513zKtEG0ZnTZWZoUvct1PTWZ16qdYiKs6V4l/q/qWJk2d2P2NHmc9U32zPWX+R19BmYC2DcB",
-	"zAx/hXk1dEryCUumaG5ULUFs2yovnu2wSmQ2pyH3k9TleaI/4G4f8Ed99IgC3r1LbQ6apNmXNLKcyX41",
-	"UV3r5NfWJk4jwzUg15nxBBG+GoM3yjD52V6M5N/eWIxigCRFWS3A1kRy5H8aVjKWcHEUBjp/BzDSHJCu",
-	"48uGagfUaxVNs9E8ZrHjFKt2UzkmqG50oGoUcW6lbZyFqDGYmmHhl2b5Ygj5okW3coJeYaVT9BKYthuQ",
-	"tiRITECI6P90cKNy0a1iUa9UfK0YXw3iUmYNwlKD6EJ7+CYC0ONP84d8oL1upUZ8RZYweEA3jPXNSRFD",
-	"6AUnB3RJM0IUIBg1TM5PSgFxMSLFFxM99rsAVJQlMoFl6jzywfqYvl1y+ED859u4if2IDEm9/++AwuM0",
-	"tYn82NeedVAAwuyOTacjFIdJ+4ymgHfr8xHyxRqssPSwhFytY1Y4Cm3t+8wFY/25mSnMxSx59HQEQ37k",
-	"a2yroaNcPnu5wpxS/fPoqDuboQvzdPCBWhedznQo9HOypzo4orLZuQ75Sszf554BllYDL9ZT+TSh48Xy",
-	"RDrhRCPUDfE1JKIv9qCuop+5A2HWpGEmC5VkwUgSmrCDBqzgLOjAYDyg2qfMgX/PgX+Znbg6Ly3EbxTb",
-	"28b0fQdgzaP4yUfv5UvwKeF6dZg+Knis+l49JxeJV3h5h4+E4+GzO3htLFAbPDjoHd5zYu5YD2frOppY",
-	"bhDhpoguY+p9/VwSyL9TTwojx7vdgfFOJ2JFROA+2lIGHkK6k+fvH8JQhJ4JziLGO/Ui6cHn4x5dAHkl",
-	"2AX4Fw2/BBT6L02eSNY9kFjU/QqR62BiUGe0pzBvtLdo/zEe7LSxVlYCh4MaPbpbY4L8shMbNc+fsXXw",
-	"f5Sxv6wONhue1ngeIafF6Y7pgjmRYx3zHW4H4+y4PzmXRJQB4CPEgXB3mAgLqBCtMkrvZ9GE+YOU5q6I",
-	"j6B9xoec8incbZHrssFiJPbclVleYBN5ltd3FhKtaOhQoVVaedmiL8Z/1mv7TtRgEr6lZtTE+SyfvWaq",
-	"rcCArXTbmuE5BEu8zuYSrujenIVRB7kT8y948dWB9iiRsxps0Z1ewkU9ApvovWIw3UTfsSBxFGHHcBYw",
-	"K8FjV4K7jVNava7D0RENo/r06I5clB9hjZOTf/RenwxxHzIoL4pvpAGl92CkGYCkTvj5ETKobkmdRR9n",
-	"A0lGr07w0eZmCmKP3t3ULDSs2Yo82t0uVpCWbycVjVndSRvZs7KTqzjH7eM/zoJOT4JOCvEyU3H1Hstn",
-	"f+8g4mg2ViPgtGtX9et4Up+rcJOieKqaTT2qGmk1abHG8HicAFn1vXRORZaxAZm9HKOtQ1ZSzGjANnhs",
-	"0DvAZ9VlpKpLa8EE2gf0uEOE7fEeBbgxJ03KAUlBVlu1gpsmL98kjZhJqrtNF4axlq0aZm0StNXUb82O",
-	"DHi0JrLFoh1SFoo1j5rZFlvbN8UtaUGeAhXnZGa9PbHe4tjXWlpj17V89gsFuhBkA07qmHI3BmsRpBo7",
-	"6sSdDb2dLItugNJmvLpYkZlgnwmuViNYyifDwhuB1IGXG8bWjqCPF6zjCXrGYCnzMZQ9sfPOgh5EHnFI",
-	"ya7x6TF6Afa7x2/1amdq7myy2vjVcfLMDE+Ai6MstGIjySDOlnxrZblsI2t1jZlu683smWcXqs7Ogvbn",
-	"mVj3RKxRBrQlZuPuVJbPiDzac2aSsbkasty2ndUv8FqNrvRYx/RUabEVxhrxYK1kI/8dL1RWQyyqU6G4",
-	"loCz57T66mTFZUcFvBHEEIPAfd52Hum2c4tBB11HKHyEaxxgdoQBCllEKOPOQYDL20JCUNCM5GbKBrJw",
-	"oJcO4uKt96g/6kW+FiV+0Ap8Ezd3JsfOC4Pd0NbxZvs5nwKrdhiN1I5tMW5Lx60b4bBDbtfGMdN4yx70",
-	"zPBdWpWd84/WszxLA/1IA9Z218j2W3Xvy2dqVbGLImG/7NToFT2uNfXu+KP1OLmoHPbGO1UNpFtjaiSe",
-	"WDfJKK18bahenZUPnIqS07XZ2EtA9u7ASiD6Csxn3DHtednznFLRj/I0upj2hA/4s33JfcnvJETNX/S3",
-	"sjZYfdpvmrXpSUmFj/1NeGwmEGU//3eUgkZ/DIChtUNKPKUf/xWfmnWbQXSb/Nd9ZkNr7LlyykvywWsz",
-	"lcXqWIGODNYxTG500IDBKmZBxB6lLcgc5YcRnAusVkOu5MpCpyk/2IK0qajgcJjBiME6nphnNXzMM6eg",
-	"jDQFpbsgSV2Rq64zWWPiY7JpxvBVUen95aqw1m7sVRfoqutwfojbOt/e2496YBz+OgGhDBRTEBFK+56a",
-	"bgmkbbWEkhoc9ARjA8YsKZgb3LOqUNGI7HTdlEzQBNSFtgSCEozbGNEpLnD5vDcV63CyQplx1ggG3Vmk",
-	"tZMrdtlFNijD/FS1gxMA3EhCKKnPKCOcF9hW41nAp6IpnARee2mhbK3MygvgnxHyAaMA+o+QeAjcc9Bf",
-	"ZRfqe/BCnIcf0h1lCDwE9OkloKHYKt3Er2g5/dxn4U10f6X+RJ8ICu8BJH7x2XsAQ5Ret1qmd4zeqkYV",
-	"lo3IqicggLQlSfQclrUiSXQlRcwaxDAahKP4MEXRoVxsaK4yGNQF8IGGO2FC3kF8Es9dcLzK8pkPaRCg",
-	"8L8A+mNPuRPfohCJK2row4M4pgftMAN7GGJ2tNMqzkekGFadsPF/sxzRVI6oNK9Gji4vPJyiOLgoDYPE",
-	"p6dqC7OmUI/CNkQEC/FgfPhZDbiiTlQfaG85PCngdzjl7Saubs4nbmoWlmF4NDPp8njdEKe7B+gOx7+p",
-	"Os4giB4oeq5a5Ofc4H5yg/cJSA2m4eZNkqi6QThtF0b3G/80DZwnHjCXrbLNI+SqyHhEkFj1uT5OLPgt",
-	"dd3O219W2bSjANfA7r5XOM9psSNNi20vPhD3rp+0xSRKsP6gVbVTXqE9M8+mVsvHz3YTSE7xhHaAmAJX",
-	"zjbia9vdqKW4C945rZTXdQYUUzRzGJqZVm32PWLc5+0Z5+0ZJpFXgn1337B83jehjmL67Phja7ZiHdPx",
-	"GhvySP7q5DdfqjF20rYLL7qKWY4QLKtBlsapUE1ojTp31ikG0oV6jgN9IwgHhsH8zEc7iB9yaY2dxQ/L",
-	"FA+V/kHkMMd2AORLImGqobf4JKv9Wn2G7N6tKr7WhFShU9md1/t8Iqjb+FL4lC+Ek3EwCyvDfBz8Jv51",
-	"wqm5bt8Fn9f3wAPlBlR8ONz0i+HmXwqfzyfCw34bXP/1ye30PgYeRTpB+acqTb9RKXwzHDb9WNjxI+FB",
-	"Pi077bPg2/lzYKEeuaCwkYZk893v2PGzGnA5noqk5AZEe1mp+hveEmVphIAcR2AypCXM53z3k8cwTGCy",
-	"/PJ9FKKIHkJeAnq0ul7958MahUQELfKNvCYVlwgwERpWrm/fROkTLETIwjv9/H10q155+9jjZeylq8NF",
-	"fnBe37wHm5Ae9twTy06rLr5Auz07goiF4gDFENAdZtyk+Kh5NEwfjV4uLhaYl/bvAwqPi4sFn9LF9UIU",
-	"vLjQjFxok9cLWShHlKk9jyiMMCWGFl1trsDjt2XVqfcW+ZXJqQE/Y+Lnay6p7wsm/mmV8ZmxrEz8x6Wy",
-	"biMTHdRV0mX8pDK5WSspBjM/f68tLJmVaQyLa0AtlFL+UEHhp34nC+kvdDO+ZVQ35D31S2x4T/0PrmZc",
-	"WRU3ZogJCgGj4AExb6umIqS7K/D+IV6zL9KfAQyC9L0oniI+W1Cs6XxG+RtPmG0Bgt4WIMLCI2Bws4l1",
-	"bPX2VUk/kwfc1v4Ph90ahbxvEfIo8SMQYeIh8LTF3pb3MNrSJ9GTknrF45/ku5mqH2i4g2xxvcCE/eXV",
-	"4mKxwwTvDrvF9eoibhcmDG1Q2NPKeUN9DuTKXR/qy87Oa2Zxd0iNzYgWSr6SWWwpbTEKYehtsQcD8IjR",
-	"k5BAggAE+BHpMWpSMvDRPqBHaXvachoB+kTiX3GUH4QLgIkXHKRMu8WBr5X4grNf7MFPiEUX4Ib60QX4",
-	"O11HL92W4s+8y1+xAJPrapWxZpy4gMJstdWRDh+kDs1X1tLOlq9q8Sl7v3EhZVu/8q/DbAHHtU96B9g0",
-	"AfU7wSXImEKufnnndfM149p+y9dch9Per6kJ494DNra4973g8laUUPz5pOgT9nfNY2hlSye5RB7Zmgp2",
-	"2gAuAUC8Eww+b9MfHzCBAf4PCgHCbItC4MHIgz6SeYMH4qMwOPIHbxH/N/Jjaf9FiDirvKEB9o5/ldWL",
-	"41G3NPCj3J9vxf+8LN+E7mxVsPe3p25Kl4z6dHenT7ChhtvV5hpLWNR5QW41JlcynY3tkzDsstNdMtJW",
-	"x1bnXIbVudX68nwPlrmSPh/36G2nJ1ufgf2NK5Yc1QIwH2/tsCXfdyzZjq7SnZ4yCylDCSmuCsoklZMK",
-	"xeQEqcT2qOtkybU/61omYtxTTwuBN4hwK0T3ABPw+O3Vdy8tFZkzkmIG1mCsHOYsujQWXarNsJlnLMgr",
-	"J+kqdZn17RuWc2h7sowxyxc2aGxFr7DRKUaIotWgC+xUpYg2V8fTCEN7d+HcJu2Zb8Hplx+8JxGDxLMm",
-	"CHMWVBWTMDGIBtTBfVf1HIL3GGpDRe/Z+ku8yxy2O4ftJZh39ERpgN4kMs/scCaTmW5xrgPqfYlkTIsp",
-	"AQfCcCDS/WTuXokQJ4TuvJcVMrcXIMhfPOzrWEDPgVvjuH/q8X7p0n1CgF8Z2I8JGKthVtupxfDl4YH7",
-	"hmFug/AfBwbFA/I+22T+gXdgcYCRW8nAI4Zl0mPd7t3A4B1LlDKQ3cy7cM67cK1EKc3P+E7TrcUh3/AR",
-	"4gCug+Qb15rDvm+17fn5tO8TzMvmuO/sXE1qJyx/4HcWd85E1vHIb722c2C0Qxz6Xay7xEfMx3433IXK",
-	"nduZN4EGHmP5HLImrNbm6O/WbcY+KGty+HcWnpPfY6rB2mm7S6Vnuo4ZM6uBVsrJbSfVQq8BJ7U/Bnxk",
-	"EBxDjDAU8uezwLs7C7yPoKLN48DdfEevB4IP4EHqTwTPWtJEjgQPTZ0+FdsR8kLEQvSAQkSaZibIQkBa",
-	"ivVtap/Em7dp9bPG4m4u2TGsk1kKkzUFpaXY6dRwChi01VvyhTpILrk6x6y65Jvas/BirD47K5/y8zAf",
-	"y93Psdx5A6g2qmYOafkcZYtyUHQKBloj6nRhlfWO4lOxfy7STgH9U1V33NDYSOPJV2EM1cePotWgq/NU",
-	"JB9XPNoLP4V1zUr7GSUuRxKvDGsR82nd/ZzW3UW8wkKIWTPaLF91Tkr4LGucmbKzbYqRq+PHakInQIpZ",
-	"DKTYCBSybPmveN+B9Irix0x1ZQN7JrhapdnBFn+YuWxPXJYpcBZswcUNLJ/Ffx0oqrShGl7anuHUL8af",
-	"4w64cFAJ1akSz1LoNOKYojQjsRwXDFZ9rYBT4YsVMLKnhnI9seKDg8NpUAfeG3znff6xeXzFBlv3+G1m",
-	"BNR4gV5TAPr0BfV7/9KqJrLnz/TONobqEw2/PAT0aR9A0nCLPy4CyDKMxyt9Pu6xJ04goASBPQrrlIx/",
-	"qUJvZLtmRcPZXDIjWKds5OZwChJHvsupCeWwZ6t5ZAt0ED8y9Y1ZBMk2tGcxxFB5djYyD8ziSE/iSBb1",
-	"VVbUxCEtn5/0YhzUk5w11sgo7ZtgvSf4V75nLrJKFuxTlVfswddIb8kWbwy5xw2cVf+rr7K3qSgzLgi0",
-	"l2pyi5eVZjM6JI4i/lgNFX/M2s5ItZ2uApbwQGz4c8yaxanAuo/h71tu88ctveVV9mvpEz6gTxt1azot",
-	"QDElMh1KSOZtqopFfw7xZoPCmEabDKOOOd8eyDnwZt7MgVhzUnVJ1BYeSEyZ5/SyDllyKJBqMg93b7N8",
-	"Dg+kCSXmk21JiNuyLHsPcyv71IQMi45NnguXQ+w0EmxchzUKPD6orAZZRidHfasA14Dz8jF0YryjAN4I",
-	"ooZh4D5nqPfMW7sJIZbokbeplsFq9/DLN/LpCS7+4q2sc0jjvch39G/iiPy4c+sjYDD6ImKlxcUC8yf+",
-	"zTnw4mIhfrte8L8vLjTLEidLXC8iFsq73E51TJihXeRgsmJU3xIWCjtUrYFhCI+1xqxA0NR8z89xxT3u",
-	"wKACanGtPn+oyoLAQ0h3QhPKbUaAX/ibMETgATFvK/IxHlHZ4/8FCAUw9Lb4kT8ZvxqKViBftICPpQyd",
-	"eUfqTJdXP0rDFZ1rw2wvzHMmKyDoCYWAbSERx8MFkPHR9w9yvAAmIEIeJX5UUnuEiYc+JY+krXig4Q6y",
-	"xfUCE/aXV4uLxQ4TvDvsFterxJYxYWiDwgGWll/optnCIoxhQstKQDedLCoRg+wQWeUR0kcUwiAA8hVx",
-	"cP4ehZcRQ/v4t+ZM75NsxwT4nuxpVdphBuhqgs4Vt1E8r6cj95TdEPdPH9N2zrmCjeFuu68xqT0N1/2M",
-	"bFZgYTvDPS/wHLY2htrXqFyP5xzAfnc32nEbac5fk70Ny32NniOXxjsaU9/N6GInozK2HRMwVv0ul1Pb",
-	"uGhz08Jpw2JgjA0dBfQM6zkTb+SZeJ2EDW1+cWnlOHr97rJn91H/6WVibRP5+vIp199TIRxQ6Df//FK8",
-	"7XL3c9LncjFFtqgfOL+Jf514eikfcxsNRs7NfL2cWbSJkatbpPzN5VNO/oajWMNfGbtYI9o4gFiT1lt0",
-	"HGKoZ7GmP7FGAdVkII4uS0Zd/J+OYo2YcwuxpjWbsguq4p64ijWiO1MWayog1Vis4QWUxtxjA8aq3+Vy",
-	"SmJNJbbcxBoxdtZizQgwNnQU0DOs52zS/rQXqygABvst/HYJD4yuDzjwee3mEPpGNhhFABOP7oTFofWW",
-	"0i9JpmhIdwCSI4gO+z0N+TxvMAP7kD5iH4WAUcDkx2CA17eDDHtA1Bpd3ZHPW5R9HEfpY4Lh+oghj5ea",
-	"ZMEp+wFbBH0URtd35BK8w+ynw/oa3P//y58O68tPeEMgO4To8rs//+VePfALlA+8wyyA68vP9Asi4m8/",
-	"YLY+eF8QE38WmZaXP6PjPXgR4Q1BkjEUir5/eUfuyNtHFB7zzd8iwpvPkH+tWiYydZJ6xJXwP/3j9ZvL",
-	"Tz+9/u7PfwFRXOgdeUQh95Uy5QxuICYRE932KHnAmwMn+/EUyAOuL1TnRKmYRSDaQv4U4x28uiPKfKSW",
-	"QA8MQPAIA+yntS7Fo0Ih4zUlQ550S+YV/i5+vbojhdX1J0j8AL0+MPqDwFNhec2iSo1J0o24HWpKwSES",
-	"zVcNEWMnWsxBrt6V6LuKM/Hki2kqngEGbnmBakjjJsoBsmsef6+2eToI3VqWoihjiZdf0LGkgekbtc1K",
-	"wH9qm4zoBi/uoy387s9/+evdYbX6k7dFf4h/oPuXSZuTkXRodWau69O2m7lf6PtY6m43IUc/w9ydcgd7",
-	"UcROajrxgOzhMV6bZZvomttT7w5bNkfMc6X2GzdbOYABvfcQrhV5hxCz4+L61990RyvXuazHUhOsOd10",
-	"HTQ43QoCvsFMrugWonEQiFao54HN5XvvsLqrJmpPz+oIpUlTeburYBoLqNpYnF1Omt72FETabFmnpSUF",
-	"CVeuro/0qI/0oATT0k/vkzrHLHjmmposL/3Kn1r95eh8l07IrIT2o4RCzQrKrKnZmrx83sSFOMiimk3W",
-	"CKPtGl+9OPFO742LNKqheqriaNsoC1GAYITWmPiYbKLls/rhB/lDfAK1oNHlZD31Bn+n65Qv+2gf0CPy",
-	"wZuQkr/T9TeRUGSvfqfrz2i3D4R0wBkuJIA+ERTqNyhC74ug8FsUv34h/ieCOwTWaAsfMT2EAEbg/sth",
-	"jTwWqKUO/E7X4PKSt+KvXkjJ73S9lFE/77sK+6/ARxIceTRDnziv3SKiuK6al2+iVOET9yDjCKjSrsDn",
-	"LYq1BeSLPr/gXIxT4D31o5cA7vcIhvHHBumFyiFCgrWJr74C/AUJAYOyLQrjXl7ykRCFFu1VHW5zm5kj",
-	"9V5v13Tn8dFDVKa6mHS/4tDuLRLzEXu9BIvxKM3b3Jll5R+QHITaFUtlwggkzuUeiloQgFoiMheB61Bw",
-	"XXmsCUfpPeAR2EECNzIHhbdb3Xf3+ua9tDwc3RHt2PC30NsCzNAOYOIFBx/JfC3tG3RVgA8ZTD6E5Qi6",
-	"I/xBBsMNYvEXs+8Z2kXgaUuj+C+X8nxFVcgWRoBQBo7cASNE7kh0JB7yhaRFd5hl4LmHG2TSt9K7y3v7",
-	"FGmcCS3aQNjQsgwl+5q+LOJvfWu1SLzf7QO0Q0QcwlV2U3nxfnLXa8mlN4w0y8GR1CgiTLknU05Qt547",
-	"AnkhRcvbBwf+h5tDtFW/sC1kgFtOBDCLA4JUkb4j6A85PnETIkZDdAVeg9xNi9KBS6+AY2dPWEiDuE0R",
-	"5b9Ehx0KI+BBokUjLO3i+gi+oKPJVvUb18fPYwclsWqQyu8snVlr+6y1jaUjIbsFCtKMfyQUN3Llt1lu",
-	"m3rSjFGLYDvjtytvZO/1quKG96+XM995T3tIy0gIeoVlXNSFugrUpXHthQpdMdkItqlHqncksYFspBoX",
-	"/2r1CuAHrcSMb9zhKOLF0lCPdlVMW/TU+fAWyOjW5BeTq+rHY16r/jzZQ/pZzdfDIdswmHeI1VlLTTaW",
-	"evkbZQdCShKR2oFPJ6dXWASGDDJ0BX5GRx6YoggRdkdUCJi/6n59YACu+SPFtI819Y+Cve3DA8nYW8E8",
-	"pFSVhrEX0hEVLU9kSdSap0+RtDbRXEBFugf/f7VQ3JHCSnEV/1uIV3k3KLqBd7sD46unyWj1q/wHtdv2",
-	"41+9a07xb4+rxpy5Nk4vrxLeauPfLYIB29aKWx9/jk0+QuGjTOOSrx6vwD8jdZZagB8RQZGg1WtkPkzt",
-	"J1lhLWYZ+oMt9wHEObSiPyDv9OJ68fHnNFUkSV8x4DTX3ur0BfEM8LbI0/MVPsa9iIeN7hGBe3wVW1Pt",
-	"x5Yf94i8vnkP/nS1SrK9ZQabzCnDUSwH/v3Txw9AnodmHEBV0qc98hYnWn62ueVN9Kl34Cgzp+aYS8mU",
-	"UDnm3L+a36qYgBBBudJWjvwtf6qIXPEyYBRAz0N7FjvOSIMyfwTXYVkU3waU44Ic0CwHoGpcb5Mu1ML5",
-	"EYURtkCyeg5gIgEqMjDX9CDzL8UEigYaR+u/VSUduitVRZXw+t/FLtSiUyHnMemAeSCzpTwv1giGKHx9",
-	"4Ovrr7/xKEEWZEr4/IV6MAA+ekQB3StbO4TB4nqxZWx/vVwG/IEtjdj196vvVyLmUK3IFyXXsIsUwjKo",
-	"i+cOEX9PsTz9U+UHat0oZi4mMZIK4lTj1KvJX02v3oSULxPai/GnhanSkhalnjYVlHwpayhqH7+WFJQ8",
-	"bSrqLXnEISU7c2GmdmlvmAr8ETIoLz/SiuNLyFP60co+oEfxu4xttcKTt01FZ+9WyhX/5v3yzY8yT5yD",
-	"OYQRCw+eyu9Upecu9ynW8HHNIQnXOMDsaKxmRwlmlK9H8YbwRu6uxdgplGCcwOAQMRReRh7dIx+Yxkyb",
-	"P/lw5dDkCiwbqUKhtSOSK7hygAqlNxqMBK6fOQNiKuEgAj56wESKK/wXvlwBRDaYIL6E5KvOlGJRq7w1",
-	"Oq0tPguXiggWeCGNokvvwATp9CjxUEiKtap7lysstmGn6npzYvPL250dpeTAg2xNwupik4i/xiAbcfpu",
-	"VIo5U33v8gflJRUVrdj0/i0N0OUa8rAFCgaW6MqqaYIrSU9tAu5r/YmFMcu/mKm9FUm+oTrCOffNSqZs",
-	"leVbLFfRx3TnytS4nLxQtkSKRVbP5RQgw9KhZUYxPkGg3L/EWQRGI4+fUgkFxvnIZiEYy8nnIxh8Suox",
-	"9niPAlyy7KTP3ajHahd5AAMUMqHKpAG+t4WEoMBYR+bt1+LlD9q7b+SrUQl2MkJx4lTKE2/TerVUsVL4",
-	"aMVCYfKpHXH4C7Utueg6AyoL279V2VAnLct6IWa8nFKJbekVYRN4obS5y2wQwaMWRHxEPIyil8UqK6ur",
-	"sqL4oUojypVTbU2Z8iqsKg5HbUpVzxYK/e1//jcAAP//J5jELxoeBQA=",
+	"H4sIAAAAAAAC/+z9i3IbN9YojL4KNs9UWZqPpORLMhmlps6vyEqiiW3pk+Tk7C/0icFuiETcBHoAtGTG",
+	"2+d1/vf4n+wUro3uRt9ISqItVe39jSOigQVgrYV1X58GEV2klCAi+ODg0yCFDC6QQEz912Em5n8dRhHi",
+	"/Bz9J0NcvIELdCbHyJ9jxCOGU4EpGRwM9DjA9EBA4AINhgMsf0qhmA+GA/Wng4H5RQ7EDMWDA8EyNBzw",
+	"aI4WUM6LPsJFmsihlEQwSUYRW4ymMPqASDz6+I8Pz9LBcCCWqRzBBcNkNvj8eTg4SjIuEDuyG7pcpqgB",
+	"3tDwBqijSLzpDviMjjhi1zhCTaC+hAKeJZB0ANMNbQIxTnuAyOeQoXgUQwFTOXEToKdTuRs4xQkWy44Q",
+	"V79pAr1pnX4bov4cTZs6Y/RPFHVEE29w0zbSPkgSoyuYJaIJxnPEacYi1A1If3QTlKwPlIsl/0/SBOMl",
+	"g1i0A6eGtaOAm60jeDATlEcwQawJxt8o+3CV0Jt2MO3Idkj9ObveOI0+IDaaZjiJw+BabtQEqB3TBKI/",
+	"T9eTTHEz07Jz/neG2LIGuB9xIhADzGAiB9MliIIA/0fOEoB4sCZ05yhBkKNOB8j02C4H6U3b/zxH10/H",
+	"++P9ZsDbaLzrQ7XJdypjnLIagE5T+J8MgRTOMIHybyBSw8EVowsAQcrQNaYZl8iQUsLReELOIOdAzBF4",
+	"T9BHoad/D65hkiH9mTfbAgkoXycgKLhCIpqrD+V3cpScrQ6V1LQFPKpurcvb2+XRjdP+HL/l0X2J0oQu",
+	"F4iIM5yiBDfD6AaD1IxugjY4dU/o7TpB4I/JNWaULJp5mDeqAVpErnuBd90GUV/OhWrALCGcN2zQD7af",
+	"sLhAEUNNZ/UTFoCrQQ1HNfMn6vyyj2ZYjPTcQfBewSlKLlCCIlHLBg5BIkcBboYpci2fZcYxmYFfsili",
+	"BAnEy9/wJRHw43hCLrI0pUxwgP6TQSnBjaaQoxiY/cgj5gdgMviAlv9SbGMyADt27O5Q//K/8p8wcT/6",
+	"s3Mk6icGmICda5g8HV7D5NmunEZzKEzkh3YVQKioG0mosKMLm/qIuUAkQiCao+iDXVB+pw9EDeBqhf9V",
+	"+CGmiKtZ1Qg56essEThNUGEHADIk39sFHHEklTmBYgBJDA7fvEQxEHSGxByxet6Z+Dde+xSn/7pilAhE",
+	"4mGBRPSBcCGZ+Gz4H7g7FBix//Uvo7wN/1eMUoYiCVUY3/ACixo8ew0/4kW2ACRbTBED9ApggRZcohtD",
+	"ImMEpIipl6Fua3LywpasAH7wbH84WOj5BwdP9+V/YWL+y8GJiUAzxBSgr2GaYjI7iWuAPacJAgs9CJy8",
+	"DNPswk7SjV6fPns+HFxRtoBCQ/Pti0EQOMkCeAqjpmfDjWlR1N083XmK+yx4xQUV7zBBTPA3VOArHKlX",
+	"/2gOCUFJA+SFCQBUMwDiTQEiPUfDzmhnILpvGy0gTkZm7fatt8kevdRnuo7ebJ/1dsXZKMENUJsRDaCm",
+	"+Rzdz9Z81ARU36c9DUBaYhj5qquDZdSGHzCJMZl1ODmrkkz1F+0nWV2h+7nCNB3ViSbFDfSAvCvE/UGF",
+	"0+jps+dN0LboUN2sOL2MOFxAEkMWNyJDZyw473z7bNVr99XSuru3hqRGSPWQRhDzWboCR2CyFDjiI2ue",
+	"nDYC2JfqmQ812FlAEc0RBzxF0ZjeEMTGPtC7NYzBjhlsZhM9sMNAz3qgSd0aq99IK9q084zKTjrvYE3Q",
+	"G1hIR1trRyPrhmysUpBsAkbKmQ1AmK+7Hli8wCQIRquSetGmoPIVtNMGzVSvd46uEEOkkVEZyJgd2gpj",
+	"YdKNANtmIW8zjYvN2sQ7GMM7WMFvVjB/QwGl1j1a4BlTknYjfG0isgMybRGPb8oT9pSM7ff1JjsLSof3",
+	"yE4GWEbUm3QTOuvSi2PH1Mui3oh68M4z8hOjWdrlUFlGwEwObrI62ck6HqhyfIz+ET27eor+OdqHT6fP",
+	"oudxG8hdoW3ggxnpAaUvIbGMjJ4+e/6iFsaEwrgFQDmkBTvtLCtAaD8PQPh5OLC2d+XP/wHGxo8v/ytS",
+	"Fhz1T5imidF99/7klBRWkyNjOe8Phy//OD/+77fHF5eD4SBGAuKEDw5+/zS4wiiJc9f+AnEOZ/ITzIHb",
+	"z+d3wwFijLLBweCEXMMExzZa4EDLY4XR/s7/xtDV4GDw/9rLoxX29K9871hOeW62qTddvILSWsCLcVDu",
+	"F3KV4Gi1Ezk6ffPjq5Ojy0G+M6sNPcn1wycAJgzBeGnMexvcm5Ojqiv8SNkUxzEiK+3sx9PzH05evjx+",
+	"423tf9MMxFRZIefwGoEUsQXmHFMijXApYtI4BcQcc0BTZBj8Ju+RZ1dXOMLK1+HW5sXFUXHtEyIQIzA5",
+	"1ntY4SRO3lwen785fPXH8fn56fnAx2E9NZCUiBjQf9/kfmvmf0PFjzQj8UrbeXN6+cePp2/fvGzDWXnN",
+	"V2qZW0DXwuRvqDiRUC4QEWj1XZ28Pnt1/Pr4zeWxvzcj/h2enUj2EmMOpwmKASUaUfXZbnCLPyIoMoZa",
+	"FntLYCbmlOG/Vtzw2zeHby9/Pj0/+Z/CbmXEFiLCfH8b3LRmBaAcPx8QAVizW73LlNFIPgbTBB3lW1xh",
+	"t2fnp0fHFxeHP7w6/uPo9M3l8Zu6N0jr8plIM8F/3383Vg6ZwqOUkRhFidQIPa1AUPBEAYPiJ4WnKjjf",
+	"AegwyQbJRr9cUxovJWLdoCQZSX6HYjDNBLiCWKKZOnfD+dziOpAvkn89gqm17lajC+xvGHFwRRmAyigi",
+	"TeIARkZUT5nkrXKIurokoTcors517iwuN3PEkPleAm4/GQ6U76btYHKA7ZSDz07KgYzB5UCdFcH9wDBf",
+	"bBCK/A90qqyAn4fm0E/IFQ04TQmwDEDTkQHuBos5wNJBGdFUORzli+asVnOMGGTRfDmu3EZESYzlHDyw",
+	"2g+HRwAKwfA0E4gDeA1xImlS3fTR8SvgvgboY8qQeVgt39LAjcHxIhVLsECQSI9L/pF2O3Lt5UTxuPPJ",
+	"2gkOLWyh+5Uow8WFPJCA6jxHQA8InBJI0DVKABTgZo6jub8ZiQZIkjKUAINTgqRH0UR2DYHzYQ2to2CY",
+	"hzENJbOzq2lXKiLSV/i7DQ0zwr31guWmYT/Kyc4weDfMWV5hREmetxpD6AzsrmJEpB8LMbCDxrMxmOQT",
+	"HkQMQYEmg93xILiiGRBUdXKt5Hcr5fv38i6E/zNExBElBCnYLgQUWQA59d+90wdQfggi9yUPIbv8LUT1",
+	"v82VhxtAsixNiLkMUGKIiGQJ8hkc5FNKEwSV1Oh+VXsIAP3GOaELa7Ss4Jy0w0ECuT0bFF/i0LX+NkcE",
+	"QGKglx8Anqkg66ssKS3g3MIxFGgk8AKF0EfO8RLzqMO6ku2oJfXqMearLfczgkxMERQNa0lxgNHEmHHU",
+	"qgxFCF+jWMUyZMRKGzqyzBxJZzjcy1/hi7FmPzABmOi5FC+e0kxUsBBwjcAh6qjiPhP4Cka5LbG6+Ctq",
+	"NkWv5GObpam80znEBEDzdQXv7X4rGzEfAEQiKg38Q6Con6fxx5EUqyTHkucmBOJCL5syFMtzRUBBHzg3",
+	"Vg+8WzAxuzALQgJOj048iUiuC96ev2plKvliQV6SiflrJJ3rmC+kyo5noQhJ+feMGVwxWy7Kqws7SeVs",
+	"5SChlZBWgS0famBxOPCpWVx2y6tD1zxaBvv8eSMmA/kPKuF9pv8NU/yHCgLaLfDrP29E62maK/X3VHes",
+	"xQSPwOuCF2g0lbqas6Bo+ZBJ38OOF9N1dP5yVz6lEQIwTRm9RvFQXoFaRXoqjPcJiDkUE4I+pphJaeRK",
+	"IGbkHDU/ikFsb1EybfUqxWpVOcrblH58S2Jpin9FjGNKqnuRWuC1/lGSnS9bFY6YpohEc8oQHcfoeu/6",
+	"KUzSOXyqHm0Yn5Jkaa1zFaL5gEngVfoFk7hxxcBNdFjMhsi2IeypuvHXSED5lRTs276ownMhv5Jfu3cc",
+	"JsnpldK9es6kZ/j8rrzDMha77XXD3Vc4hL/yr4rHFvKTqjKFk1g7ia7V1UOiax7N3DbfWT6yfAoansJk",
+	"3c7jHF1jdBOgAkWcMJHMOUYEy39dKV5ZOKKA1LWw3LESmr7Q4fQRZTGKtRqjcV2BoKLrXiEyE3MZX/fs",
+	"RbdHNIyDVQnSKKr5c9q6FctfqpP9TG9AQsnMzoA5mDFILAOiRe42ReIGIQKeLlSU5dNvv5sXyPrZPPSw",
+	"9nhq1Bn4700C8UI/zpCH4P9tvvRAJwjFEk77OGMSKRVBXr3A0QffMVoA/OTN0ejFs6dPD3SkqhSIlEGE",
+	"S500ZTTOrFGgcrMLTNwfglKFZvKsCvvJS8sjeaawQL0U3rOgNzYEHAktC8qx3gsvBUaYaL9nK/OU79c5",
+	"uup0A+dmrDKrGEW09SOtGlUEHTPVMEdCd5vd6LpOk9IhgyhWkirqRghTLyAkGCRqb6TyiJefZUsU3lKV",
+	"I28yUuSCxIiLZYJ888KO3v9PmhB3e9sXQrzZiB+HNSKP2pOlfHOI5pPOikc6hzwk+eMrFC2jBAE1IJcL",
+	"tHS1c4aIluEPHZ95qSxWkmqPFQzxbmg9zW2PcjZdMwLFetfddmG/+WHZmWIdLpgXBsX+Dgfdub9Jyqsz",
+	"/kA2Q57hRxvNJEIarXyk/hLbOCkOdpxZZs8YZXK82Q09ePqnDkl4HTPVfMNQe1C2N2lUg2J6F62RuJ3j",
+	"VmvuwVFSIO9b/sOetI15zw2KUAgYzVXygVUa7CBMOI4RgPZ+xuBEadxcMIiV/TFZat4SaT6QYPUMGLPo",
+	"ZGD+PhkAc3FL9QznyRJEWTkps74Yp0CwHArK7PrfSwM1oNp+ZJY0a9nBDC2khp4ReHWlrCEygkPZFd2O",
+	"g0pJVMP1culUL1ecCmhngnRxjoGXRSI1bxW76Kx8Jo7ObCQ39anzuMFJHEEW87rhf5dGwQnx8eT38JSD",
+	"Yfnvfx+889hxVUXB5ET/+DTIg42xOUBhx688Y7TmK4uMC2e2lQgln/b8BdJ2GkHB1DinhTLuHus9HeQ2",
+	"Wz9pBRPw+0QmaGml2ySvTAbviucx6PfxoEUOKgkF0Bk6vSN510qN54ir/JWA6yNLFF6Zw5Ko68jMx6+Z",
+	"JDQBoMebO2KupnuQQiEQIzqrM4S4XZCqEYMqSGNd+VXBV1F3jjMRzZJYMYIpyq39QYm8OyLSq+JW25Hk",
+	"f/1LsaqFenPiySD8yIZv8tjcn3K1yiFDQLWvYjKQyK/x7gomXKa7UQYm+nwmA+n1Ip1PJN+CmqvVwJTP",
+	"OXDAN+GrQB9FowE20mO0bcl3jVUw0p5xvcdvZP0+zuMlZ82RE7lDrX/yL4PmvGIStlOdzCuAgPsdQG6t",
+	"j395XpkxcG+8fTELU2pPmhMRRilDV/gjih3jVmh0g6YyH2Iy2P2+LOmEqproSTNSmSyfZ1wRNuwiYf3V",
+	"IXeDEJMDL7Sclicfg3L+b3F/ip+GYAoGnueetPCdFQK2q1eWh1B1vTF/wm4XllIuZgzxhhurThq4MG+e",
+	"wOnYX0NH5MJDG0IoK0fjxWB2Px37UbeT0RGhM9pwMsUJA6fizRE4FftrF2m3Vv59iSLM1YMrLVwhZ85s",
+	"huIi4wKx+ajmTS2GdOZeWE0moUtsVNZ947oNtAjrX98DpBz56oEgFKQ0wdFSC5SlxyBG1yMNTyiWfDiI",
+	"lGhf74WVq9pjADfQhEDFufHG/ahmCjpj7ZCwcyU/bv0KgR0lE/7LHsFueE5jennNG59cgRcqCGOBkwRz",
+	"JF8Q7p/P/vjFM98YQLNp4m1Cpyo3WevO1d+dBOthTC2TazN6+EEqRilv++RCD7PPtKSCZs9w4UoXMEYd",
+	"TSJlL5UeZKEcDlykkecj8c7EYFvh+t51I9ka30AT2W7ERVCEIhg4VLX11+6pYgiuIm8+AkRyyEgXJEgh",
+	"ZgrNrJ3G8vCoRg4KT//v3y71tFW7gsoFCL49CoJmUPWQYTkXaaQmbcUjDaxdqP70PsoIgxq7/2EQB3JP",
+	"hmKSSqOR/FVbwFEMcMC3sDa/cqegZPEQ/7LQhMokiGgu3wEHsLx1BKN5yVL3hPueVN7VpqpO8ky9GGqp",
+	"kIZWx+2UrKBgmPqPVYwEYgtMzCvlEbz/EKlXbLp0nz5RYSMwEpSNYkSWT8COMi49UdZK9mRXb9xDsica",
+	"RQ/yD/mTVtzyoDHb8k6/FtX8A6qigX1xb+aUu/sYlu2Y0nKjdmRtQ9riUzSkln3faWL+Wf8em8UFpR9A",
+	"CpmwJi6H88rHhQX3LfA3iNUojAXvioeijcJKxZtAmRX3DqvRAg7miEoEksJDRUoRCC5GZs2+rocfynYR",
+	"blQWu7zvgdLRLpYvYGbouB8BlS04ASJCysAYYJvq75aYzY6Hmn0YeW9Z5KRJMC1suGLEi3Wd9WAU8jY7",
+	"u86s/PKzjeKskKQPdxHPDGR2JXeIQ0cZLRR7bjYWyLItqkfQoGSFBMMhmn6ebgFvUSI/XtlPoH18jmSs",
+	"ZK8DdMoE1cGhEIr0rD2y8GFVFMKidBWOGpqQl+gKE6n5KuduwSIOBYggkRYryDmeEe1LMGyTg2ts3ArO",
+	"yyOJExMAc+3zKwsUMrd53/FBEgwdFtQ7cMdj8B1QyL/eEPLImbj1Lik/U1nMNVRLCgKBlmQfBLb84F7G",
+	"bUAaA836uNMc8kWa8Ggj6p1/tNsQ/1U+3JZYKf1ONB5T5ZR8ucSUT3MiRskFrYU2/QHYKUgou17SRP41",
+	"WRaTITYhutRGULUFgOi4VJs5kvMfrJJfZIwYYmBOCc2Y9DJeyGeL5I+QHx/j4iFKQYjjzqEk8kpM0bkG",
+	"L7IcpS9RK/zGa23cynYDKqqln7x6ni/f4tRtlNUKu2hE4p6EXZUxNkbeW0PX9vyroR4QM/f65aH3SkWB",
+	"BNDUuNisUNw9cewMsZHCqUpYB7eheRJFI1FOFnQymEK8UtCHT01jcCxNE25eHfOhgyt4TeyHVE1Xjf2o",
+	"Bn0oz4ZUxRPz7q+gxQWNIPcbXtiISgausi3aS2P1tYqQcl5Bo2YBRUv9jTP6L4K/TGXlwgsRgKtj1pwf",
+	"3MhoErA+tqpHas/DDlrSGm9xlbOtGVykrkJHx/BibEYgETD/k4rj7hWnUYClEmadLSAZSVlUkab3Y+2d",
+	"vLTasn1FDYtprjcairKpvatecYZVvQHsVIIK9dg7Ci3cbDDgsEOkRXVGymLEeF3Ju5pjx4ssgQLV5iCV",
+	"DedqWG6OdGFM8owpNxIszy2otSK/8Th7YjMPnp6e1YyuzNktGDls3gxRjgdVV3BWBiO0Pp4RytCxLCXj",
+	"BA4j6huPRNBjitw9KJOtBZMaO4182YdAzW3tqiZb1vkqgr5almNFo4nSAOGl47Bu15rT70oX2+VGWctV",
+	"FkHov3SrY9EeYoj1/SAjJM6kgkJgMNtT5sTI67KlpgBewBlSzl8ZXaFcFybzZ7p0I11dLiUpqoEhd5mX",
+	"k9q63UpWrURWCctLPAsyDv13ZyzXMCzgrMiz+Bw+++bbgxdXT6PxeBzihHxKFytBJ+2VqiDMSl/f5AE4",
+	"zQJM3XnL3+x+O3DjvNTFkY3CFrwtIpLnIds4z5vS4swMXyNX3EKxY/twpFDMx8AVsfengwyB0/MncbXI",
+	"hTeqFarvAxlc+ErVBaAk92VxG0ZcjlMNBHL+SwZyuhjO+iEuDHjl0Ojmyzlvf5eV8ucV6LKVcgLan3/P",
+	"3eqg+MihtGER8HL+mCVJ8boLNJcnYtjIWCU4pXC5CBa/CJ6IectmeSJ2h6TwQsUG0wWiUGYgICVgucJh",
+	"2wn9KuMPfmR00Qxuve/kqBgAd+eek6/H8B3QC+/R8F2Gpr/hu056bEOhrp6Tgmjb04Py9WLNVnhNaoDa",
+	"GA41m1qjenxa18TaQyO6S4Nr03l3suE0HNmjN+UL8qYUeOImXCllzLoLj0p5zV7Uvnm3Shfzw30S+2ac",
+	"LE05yI8OmLt3wPSoVJO7Yj7VeDgso13XMVFVEd718v8UcuP7uIGC0ugqL9sd+iaMfph7JuwfTH0Z+58x",
+	"SlDfhNJNOyqU5uu0TOlJwlJdNnU+5ZO1lqcilOLXsb25V7SypCd48njhk69Oti8e2zYI9gWIVq2yFZxr",
+	"I3W2QjOHhQjzGqNYPRUBccLBrarfbUiUKF7odogT1SutWqYRx6xQqwfUYGiw6rJqGcODBkAlD3Ajahea",
+	"rx+dc+BijrkyDenqHFLjd8tyTUaYq1sy8gEigqna01LWUdvVos9EkaPsUwqTG7jkhQV19YmJsvVNBk5q",
+	"0sUI/IFjcHJlQpcpA1QXbhhKxQD6GeIGQJPerbriaGuxK/YAdpT4ghZTFEt3hBkTKxOZkl1UOW/vU5t2",
+	"Uyha3Ce0Qc3lSYQ7qkjFFBVPwlPQ/L97SNQnXqFwqx6361Nyoi14oUxG5qBcNm7Dk65HlvN38zPiJuER",
+	"8/xSbRXS/M23B1/uzO91s/bb6X8etn+gRqYw+mC/ebfqpRd8LnZf0p+h735ShmEyGFdRwP64HhZ453sn",
+	"iOC5O7RxvZVTX6j/vdB1dDVLdi1Yen9KuThHJEbsV1fuPuwMMqb9vCo+YFmCuB82oGwSsuaPzxB0/f5h",
+	"wZl9hSUHYmpdFPuNrO2hdzYCnAU2EHy2GNrUPqfoijJkwFd1jhhKExiZZLy8KbM3CQe6oULHXeVAnmdh",
+	"rT4/qKqBCC3SRPvipE47QwQx+SqGjhnESwIXWJb5W9azbHmJy9/gNQpWv0yW4AZeo+9V5Xim/l24e3CF",
+	"GRfq8bLMfI5gIuZLe5Ty2Aj6KNS36lnUXw6KHZqfPwsW/76iTD6qraVmJJc0hyHfzEXe8dsehi6IreQt",
+	"JZwIgZic6P87mfxtMvn0+2TCJ5OLd/81mXyeTPjf/xYyhs0p/RDKI2UfeLH7gNLT+ZJEQH7iDsscyc4Z",
+	"QxdLEu2qms+KsnYkruu/6YlmWQJZgWQsRzQfD4YD+02QKeIAT35L8H8y5Je+d68L892hxu5ReXGqi5Ao",
+	"yWIks91br8jmrsr7wVelVflclduZImu+XPmOdDqi6l9XtBVLsd22pQsGrakf1Ynk5Ve8c/e/LzSA1n8M",
+	"3YEw1KrozIlnZx79aU2qrrxjfj52Ji1Klvz3g8BTdA1ZKImfpuAaMqwUdFVNRlW2yLi8FEtrba8glpfj",
+	"thZ6BxsrmYkaefyMoVFkXNBWHgXyWYFKDnKCqrXUVbCzhsGFH+Hu16FFR28WQK8RYzgueHcqZ2AhD2f1",
+	"Wko0g/RdOGJUex+01ofNBS+L4wWBedgohmvx3//ASaNVk+w2COVlWajvDbqvvRqXESURQwLpqgocUFam",
+	"rd1BqPRNoMdH4b67CIfXGxdWxuClk08OQMYRCElGUu0SmRQKAPoorxlfo93x5qQXG/oWNradMbyAbJkH",
+	"yOUsbpmiJm3HsmGfNyuTwFWWcCT/K2KU/Emng+FA/9+U0Y8lx17h62Y2V9iHL5R1tmZ0Lz5cZ9CoW+cl",
+	"FNB74gLWTDfCt2SeI4nXynBRsVDL5817At395Cf21Rk481PcBuOmg2ZNw2Y+zyaNmm7WFQ2aOXptyJiZ",
+	"X952GDKL19fDiOljYTDkXgXtdfUWzwqdVmZQoBu4bPv4Jz3MIl5BulWgdcjOMgCcBr+VVyL/ffIyWFlK",
+	"6qiG91R0EwTS+ZKrEeY8xhPigmEr3O7oXFtrVR979TmXgodZvVS5d5Dx0Q3iQmZ2xKO8I1k1qBlFDIkL",
+	"QVmXo7gojm6KcCwTa5/Hoh5xYLGfWKuPNNh+rKUSzJFJSNBw5SNLMp4P5PqV6Kk5jZ+Mqh96dvLfLCgL",
+	"avpkqW5jdo4QhL5F4tsXAYtEw1VWMb/2ca4OrXmlS0x0QQkWOidEWlwSOpPB0wCTKwa5YFkkMvb1+SED",
+	"B7sN73UVrDUf7sCEm3zBq9P3CnAqPAobfckD97sdT/pp3TvYlA0M6ml8p3ykJFnu9kwPDlxDUZUPrOvX",
+	"yysq8dXBwdCcIAWurvc3sL9S0Sdt1yh0zvn2edlO4NkJf4ejv/ZH/3y38/vI/Ovv9k+7/++/rZ2l3Ez5",
+	"PWS+4IFuWvi7wuQ05eqPsq1gtZYb5Eg2HLS386MaD9QHugu4NgOHUC6XlfLrmguRHuztXWFCUz5SMsi4",
+	"8O1IfTvm19HBd/vf7YdwSI9HrBPAp2bwGsDa9XoDeqvibIBA+sm1uaDQJNWyCHbHjvOjw7VRg0VwJbzo",
+	"JXWtIEl3IMctEqmD0G6nbB0EdR0h26TnNwbyeWMawvg4niYquvYKeB+M7X+odjYyAzLPN5bklwev4K/P",
+	"HuYf7r1K2B4gVZm69c71ULCTN5hW8VK79Xuqsex3kaq9hXtaxmy79E1G+Pk3uB0y9Hljw4nAoG4k638x",
+	"dv/1EIm2cMD3SrU+JB3JtnDxd0q3/sp9CbfgstoQ5RaucTtIV3t4666u6LxtDJPXgatfG+FZJ/v9W6IU",
+	"JGsan/Qcm7Q3qRlX9BaZGJGNUJa+py0iqb7GAotoJfsAQ1CEQgTfoJtwOKCgJrjK9juzkSbFqLzHOEEv",
+	"TvDLDgG8zei8x8C7Ow+8a4y527LYc9UhK9R0hcYuVVKxJGRqp3kkaAg00CvwsjHSrw8TYChF0HStwBwo",
+	"eIMGydQYPAJ7+ffF6Zsz+SHIR8ktKVZZDyZNA8YpO0E53AnGsZIxVBC6+teCXoeRPlxcSAIJzigmAjEJ",
+	"nI7RR4mqb7OQt7Hs0YlI1e2RX3IkwI48SBjHewY87xh2K8hL04EBsX/EqGIT7cUmBXX3WDxx3RspKGKq",
+	"nwLiXkdh8bwQveYBUD3Q1QTdyjw3c8RQK4oLCq5wkteRKEgBNTCWLsw2lLKAmyMI8p4NsP4CGa7B+m+T",
+	"/2o8LDCFLqz4MRHni03Ekcw22CSKFoRGQYFOp9dpOaoFVMrQNaYZT5Z5D7TQewZU0zGWYMTMnY7Bbzb6",
+	"0vG2D6r6lO7j+dJJSUNwYSJgL5AYgiNGyb/pdFdavUznYr2FuHN8r1I2ztVHDydo+XObxtbfpWSVtrp5",
+	"f6vtMluXq9hoYnGj/Up2xT5MXtYyjBjlXHERZyn9+iraeUmt92+jscCsaaZx02zSUmMnXdFYY7N7N2Sv",
+	"cde2HSYbC05zRF9hVLdgvqOTvaOXqvhs/LVH8BXPcJvIcRNxe8W5boMw+0fruYz7TQbqFa9xC8mzR3he",
+	"GSX7xOAVD7dSxqIw9W59LYP6eLsycCuE2llfVQnWlji7jYTHVWmrh7G7+V7WD4r78nIbik9LvziwCN9L",
+	"VkOII/YRnpuRYItCscqAbmcUVhnKdQKwCnLsCnRdvU1TzT9wCbbQPy8o0Cwjed0ly96GQL622nnjV5wx",
+	"BZBoJtJMuNtjGemsO1rALTChS0MfBWIEJufoKrCNY/MrODr3C/tIVpzIW5JOMUzkOavsdmOjlQa9iBIh",
+	"XWIZiXVHaswA7q7LH+dghV/rlc37DRVKDvMyVBUnijKUaM1f7VoZygGUJW05jlGlP0PvS3Ll/gPbZRm5",
+	"3LxbKLQhZ84s76VqKRTJ4ZXJ+01QmNplTeCRoKMEX2tL6W95qwuvPoI2DEZuIrAT21L+muODBH9A4Ol+",
+	"/HT+fH+xG2zecROQplaXhRXevRs2yWN1vLR6hk+40ZVy46s0HSnJReFVcBoCF0jWVTMizmSg7b6mbtq4",
+	"WgzUQ5IOIs4ab1uv4rY5Co64WCb+i7SBVyfI7rt03fJNU25F41LRv4CIxro/f+7aBVGh0YRrDmbiIb8i",
+	"7ded4f2qvPZPK+u5boLNKLd2us72JgfSunqs/dO9K6920nOUIMhRA5GZET6tnSwWmVCeLE5gyue0eEqG",
+	"6aiS1/pbgRfoKyQre3jbQV0GmtbI1/LF1oS9DgF212zedoYURm06ILYEUG+qtGi2Meq097plRNpd5aki",
+	"aE1LyzNGr3CovdFFkLBziV09qTp4LzLRPeVFVq2WdFSovOOtGRRga4p5eZMU63h1F1esizQcvhmSWaJy",
+	"ne/um/6R0b8QKTlmJfmX2WjoEHTbuPZegFKdg5gg5nUFTDExvaYgiHULvuqKxYuNazr4vYYEX8kJzDya",
+	"gVph26mbgoY6+v1z+gzuR0+vws1NzSTVNU/URvJVIDel7m2hNuR82YVVGZphLthybP40juhiD6bpwfXT",
+	"VjNkDs7QnkWIfOkNQYFQkBNrhOOlGoeSolxKjg5/1JcwRUpB1QdXQ8jhKm9nkGl5d802tY2zp6t0rC0d",
+	"qb/OsLSrdz3I3pCR+lmRDw9gs6P/JvJsDaqxVLUSnTuS7EbipdPSmFXmNx5Ija9J/2ekKrdlgqpWpKHI",
+	"FCTmOtJPjlpAoQvAAsHwbIaYVqI5oESrZmnGC90GTdfaam9ZOZsOPGnvclsLhFYCgQqXURMU6ioq1TyP",
+	"MHYwFTDCAylq7utQNTSUw246lZEPVFksjQ/Lr8UKdmCn0+oFd1FpmSC03Qswlt71nGdjFRG7gOIAfPKL",
+	"3n3e+1Q4YckNPg/C1fT2ZtTjY15Fhp18zP/xqvX9H1Or7//I/6/q9O3urVm8odYtVfMQnMo/8zlOpfdd",
+	"7d/GBhfehapc1cSTfRdc4THJsaHwnKzNrUMbXlvyuywIfrY45o6WzVyLABPM5kUZVVC5App992VHHUSE",
+	"bSNaRVaG1DsNEw4yrgUkI8EUr+cJN8KUgDMdh6ckAq6ZCXSMHXO/snDhQrUbLOg7ttcJwQdngRtjuqfL",
+	"6WvJ+09OCdDOMVAunPyEF3ruV+WfUZRvtFvdg7qn97JUL1e3q9AnV0bojUjgubW480zW9mndsZ3e1ebH",
+	"tI8Btpak1/IE9j/XBvef8pLUW4VOPE4FpzTTQr7+qKJ2FjoBFplQ5QTaAwrqFgmaaBbLkVtrBKfR02fP",
+	"w4qFmuNnyAOJC/KvbYsrA01Am6lbMkRQm/W4eie8mpu1SHU1ZO4TN2y41uYi1CcN1afNEjYVy79ZKdLx",
+	"CCbhoIKquNSlGrVzrO3oDUpgHOc0QU3DYt3o5irVdtFytep8J6UI3TbxSS/q/H5VTa7xVDZUuppvrBp1",
+	"Ec9OSJqJtjdFIZtrgrQ62gVrn4faDlQ05YeMeQ7O+8E8IwTeAv6FC4PUNeOzLdydBp/HFig5carKUAnJ",
+	"ewEiM0wQYkounNFrxEhBDp/Da0zZV+gY2YKGfRvp1HcLLfpW6s232WZ8W9WFb7X2e5vsu6fGefaQO2jA",
+	"F1xyaG1Sil0EuvKNwY+UAUNuB+CTne8ATDS3nAyGbrD842I5Evrvn+VihQ/8lQPf2efFfv+ltP3r9/Lm",
+	"dvy2x3OFCOgwXtWn1nY1J63f7c8O9YD70jv/lRrQeLP26QoIdhqOxpexvPk30yDwZs3OgI8tAR8zkR9b",
+	"Aj6W+nns9vdYT+ixkd9jI79SI78N2arCisvubcrPTaVoHvvxPfbj29Z+fCs34mvtwFfjzKxG4pjfS+kO",
+	"8kQ92/kYKBKX0pJiHZAhYMJ+x11CUTrqW56TvqLq3K3Wdd4EiaHdjXGal9aCJGMrrrF8dfKpXKxH4HC6",
+	"cZl3XfCjxrfSgB45rdmQ768SE36ru36PPfjGiw3ixVuO2MjavNwx9HWzha/fevd7JHFVrjeBXDrmCFc/",
+	"ywzAgAwIpXqNFzYgxswFhPuuGEU3eLb/7JvR/tPR/reXT/cP9vcP9r/5H1/liqFAo2IApO8q4Dwc5Zwt",
+	"IBkxBGMli9px/sKmKj1QKgCMlw2NXzp74c1wr5RtfgIyrlq/QK0ueOVM4KHFXsNojgnKd6YHegFi+eXl",
+	"Wz1HUoTBSVilqcsJ0Q+UK93gz+zkugwNhoMfYcLl/74lHwi9IWW3Yha8OhF8+HUU4pV3bKq42BCcyyva",
+	"Le0qeGslmjCCgdnkMITE7rgbSedQCIanmQjZHwg4/OHwCEA7BMBriBN1QVdGWsx35MmNgBLpD4DKFFZ9",
+	"WQurtKC496O9MgfOuHBux56uATmnEVZyolL9WutNomUgvjpLEhBTZciXtTQr6+tLBBMnHo09fWcy2B2X",
+	"otCqg9qrgKBl6XGpuUxTcOGYXP9g1asAlaVeJnzkPpJuDXl1Xu6fKhbrHWhB/a065cwEgXR8ci2/9TU1",
+	"FaspaESTEUzlNAybYC8Ljj6L8YRIF9DPl5dne/L/XOz9Jv/fxQFQ4jg62NubUy4OUsrEnlQXzqCY629m",
+	"52dHe5dHZ3tvX54dADdK+Z4rd28/7QD8n5kxsspvFE6ETVlc9JlMjq+VxSjrNZccD0i2mIbiE8IhUCZN",
+	"5tSo56HwADPE1ncwI6togMh1Z8/sMbn+FbKQDiWTtLp7eH/ECQpOFNytsoB5kW3/yYLZPeYHr4o7BATd",
+	"NETh3H7E/gaC9Guj0ne6x6QXHysThl6MSK9gcSPDz4Hy/+4v8hpiAs6PLy5VN7R8Ha9R4dP9Zy9CC2Oe",
+	"JnAZtiaVXxo9tioXy0UvQos+++bbFRICFNG6MlaZNmkZ07AJNt9tSFu6re6Mw/vNYSxHVBfC3zYQUq0V",
+	"wwC3yQU2az2q0W6Pz86Pjw4vj18egLccgQJlKMARjMfgFZrBaFnOR1EOqvEKlLNy1LfZb2dNSnG5n7C4",
+	"MPH5LYxxSmNdekUrzbJHMphhAXR4f03Uf3sWR2GKQhzsDIuR+6WmuFaY6RWzH8oWtSnkOJKxjvIp53yu",
+	"/1kQ9QtDqkvz+S8h6fHi4meQMnwtH48PaAl27D2oY7Mr7dZPeRKHJ5WTnbxUsxz+dgGOaCwftIW0WNPU",
+	"BKe0LiHoB0Taz0qOKkGen0Zw4owjFuaAb80v+SwAFpdz8O+2lsv5pTVor6EWX8muYit1tVcMbC0VWIDx",
+	"TfdAiA3UC/RIrEAPoYMLAVrPFdZgCTXswIZBht+YTy0ChNRj5AnqySU96EL7CcS6gpf2Z8hOdQZv1ZAY",
+	"pUiiBwH56RRYsszi5/yGsliu/dxAniP0ACa4UO0qP6gETlHC19jSKzWB82ZD7kcU6Nkl5BJpVH2yZInJ",
+	"bELs1Rg5bgx+kTu1/WKLMbFenz7I0IQwZKw60hzOkC6JVqpp+GkgEFwMDgYpVH4DHtx9V+4e5uxduXp7",
+	"uUQX41l0Zjd9eJkPtXUWuxGVv8ZwUB8CqyjIKyLWW+Xwy5ptrOxEB5OshwNyd1Lj/SNjicQFysWMIf6f",
+	"5GBvL6ERTJSG/c2L58/2Fst4qqK5Ztp2+IfrxDG4fjZ+Ot4PIpCFoAfHVM1sUJSJErc0oI4cBJ1cXW7x",
+	"ghQcvlBV9f9SJ3ifI55SwoOeF/2LUWqmuvkNAv+m0zxVTIfELCDJZECpduDZ3PFADzK1cvsZGRDdctJC",
+	"6y9ZJkAB+YcQ+f3ZZTG9EBSVVXxQnnDwJ526Wm+B9UdP//Hs6TffPn+2v1+Xq6FYVyBiGgpo3k83Cqi+",
+	"LaEDKCJLOsoTgUeFNLoYXbcijj0fH7xh4ZpCCCThrSlv7n6qqWkO/UfB1hyWL67zJ+ce3q8n0SI/sHtN",
+	"snBgrJpgkU+wkeQKN13XxIrYEcq6SRX5jdxzQkXxTrokU/jItOlq1zMo0A1ctn38kx5m0WilGtl3XBw7",
+	"Z0z9KmKnjMZ3WxO7TGSdwlDqkWIbql/70G1ZyWsftJUSsF+iCNe8R6qwFf5LgxHbcYFiAlLla6yMbD+2",
+	"FZ4rk9R5pc+LTmgPiBzFpSQN5jIoOV5gAhhNUDfHS9xx6wxx6QjYkQ8E+JdLEGr3BpRYqlsvyEid3HCG",
+	"U5TgoHRSGRNKFU0ZXVAFuHSPcTBF4gYh4jsyeCnuJhdavqK2SIETvV/xpQLPynJMdabNCDSVeTtLNu5L",
+	"kJpP1xZxqtd337JO+AI7CT0hXKzUWdJkKz3hwTDzdrLunFbkr9XNb1uLc93e9/b9Nz3Qr3Q9lDz2xYhs",
+	"hVc6gIMahFsqGX5M4pRiIow0+fb8VTj7V8d6GNEUyGE6KFZenZ6hchZzIdJ2773++O35KxXyIETKe34j",
+	"kn5fNJ2CHBAI9DItvmK5bx0IhAVvqnAdDt342QRoAMrAyZmNlqnz0UrbwchY7f2Km4POXYQltOoXf4U9",
+	"mOK966fdg0TOCqEgbqIXL553yvxSd4DCwOnfwI689iGQ/5cPgYjSIcjidAhuuPz/8k8JL7qy1dBWw4q6",
+	"hXfN111H/w7lc1QHMk8nse0JnK2kFv9tgxFLU10w1CdDlcaygSmu6QcURGy3xzSbJjhS2O1yB+y2hiBG",
+	"DF/71jiXFCrDqc5p2XaqLudgb29FXA57/ezuTMB9IfndL1hbrS4lwQkrjQo0czJ9GE7QPewA1IUj5dEM",
+	"VQDZEPzEYDr/71dD8BuachkcLYbg8uhsCN6+PPMDtOU3g+FAfjQYDsxXg+HAfTYYDi6P5JC3L8+KHkXz",
+	"6Yr5zsdEYJGgRbBzhPej5n1RAvFCeXt0v/GqBQTiRaCn+W+X5tNKZIztWt21obkPkoUhn01pUKOaOUtH",
+	"omG1C7WcTV3SyFElGQB9FAxGynmJPFjVaiaFVfnEedfDO3IHZ1IkhQ25JHFhCRMPPNFnynWVClXviE8G",
+	"u9VT54M1w50KEZn2OPNFfqpZpOYe/JXDt6Gi/UKRjJUY02r+RSi+4lczWjp39yqY+fLw8vCHw4vjPyTt",
+	"9+m4byatYqf1elV9XvG0doUfGV10C4T81Q0PhQDXH+mv/jLlzSQZsq1h/PofodicX9AyWJTT1Nqs/zx4",
+	"ORfONd/9pTDfhCNhP4dyREJHYrGpGdU8w8Wxb5hg1tniy/Pa1cvz3jnO3fP1mCuOC3Gm92in8ABZ1UDh",
+	"T7ERy4Q3YVeTREkvXscU4V/NPdsgypfTwfhAQBG1qoFAHZvJFkzhXsZc/jdPBHYrygY+V4BQXSAfX6ly",
+	"KX7pKc/6H+hfhknu4/CpPm8KRCV4HAX9rc20mPvjwE7jxnxR07e4l8cVJUt/5AoJ6R50t9rGNmb4SpzR",
+	"BEfLcFOPaA7JDHGwgDECMWYoEokJkZccGuVJ1HwIaCZUb0N6BU5TRI4UDx2qPN85JHESKEi9oHGp5MVh",
+	"JugRZUxXB6/Y5u1vgKGRjteytWk83JeZXSllQnIc+apQJjhQe1U1W1AS6/eEZmJCIj2hCRFZDJVweJgg",
+	"JryCY8lSonHeS0P/h4mclIdEUMInfhpbcR85PIPhQE0ezh71pj0yswYwJbQ4gHJWrdpp6OXZFvsGSsg9",
+	"8nzCXaaDmaWQi1+TXddkF1vNCYv5mWp6GvaHuXwNyVww193zzOi6DI3a1g4yNxoRSCJUh/Wq2QTIxwWa",
+	"HOliPxFkzNX5wUI5ZqcITGlGTCXyIjOsPkSymPuviLm7bDu2k8oHObkfCoG40H8NRrdhhlSN+IiyGBU3",
+	"6H0aOjOzwsUPp687Ta0GNk2EZwTFJ+FOQoH51DkBjmcEioyhQdDZ1k8+7WG/bn5C13EtF+fdMudyEbiV",
+	"3MvHjNGGGL0LAUkMWQyQHAeYGWgadAROOkYdUpf1ZGpwzot/OHz5x/nxf789vriUZqA3h28vfz49P/mf",
+	"45cy0fj0/IeTly+P3wyGgzenl3/8ePr2jfz70embH1+dHOkvzs5Pj44vLg5/eHX8x9Hpm8vjN/LvJ28u",
+	"j8/fHL764/j8/PTcfH/y+uzV8evjN5dq9rdvfnlz+tubP346ufzj7Pz015OXx+dFUcFfMxCnLCBOmnsP",
+	"6y2bkdaU4dVuUb/zXR/HikerXsRABq78sw4vjKDuTDE3B1wQRuqyJ2vz6BVi2PT5XHC0deTymW2aFhRA",
+	"Ml4BnsqHisFIdE2wLNOIhr7NOoN8AIP5/U/y0MUnSsC9kmy/VR6zh6fwMyjjmwo7tYHKF9qaDgtRCqYu",
+	"D1YBC/rDimJc87oeqr/L98pMgkpp0zCY3u9FfjSG5GRi/teRGevV9mv7zm9uzTN1On94S3bTRC/0h275",
+	"SntmM8Df/BicmiyY7wuKiso8z/NlUAxkzihibT2Wc+HdXEDw0r3m5c1qGJSJ/nmL9Zs5NZ0hAF6tyzqY",
+	"4WtETKf1NU0prtiKs+8MVi3f9z2YooguEK9AXkiFHzdmZD6rZGS+MzmYozwb82+DFc04wd3aB6eUGbJi",
+	"WbLAImCHZ6nWZ8rVwsbdiuB51zps1Q9tenfgbUik6JD1Nhz/iOuMxro40HgJF0nwNZGLhSsFvFZwqCIR",
+	"mOStJ8sO3HRPL9HDIq2glRMGq0Vs2Mzs7zF0GUZrsi6zsA3IDMoRxnoki8WXVgo7MHNLKxwiiFn1rVP4",
+	"Qc237URQ3lDPBIU39qc+83UIjgjuJ1yeL4eu4VYLE9XeamJGtV1mMJDiV8xk8T1V8cL5nuyMoWOwv7Xn",
+	"oTi4TJZcl0PuEjfRGinxuf5E3yAhow3CB2qfXPNWmv+wgTqWZnhtdEJH9CjQqheZsNLnDXttxpoCsphI",
+	"HDJTNWfk9pH+J9HnpXuZVjc+syVmOsDtH73a9cofB/esdVhkrDFdcvpcpWhIvF7jtmGeay3vYjVMg/Jy",
+	"o/lA7LKaIUwgVpJ06+jaEzATdGQBimXFYEIFsEX7is7m66fj/fF+N1XHlQ+QrKRe7bYV+vNk/wYXSZdP",
+	"OxkuvNoGBrCwMwXVm1Hkr5XiOl7IlPz9Av8V4lTqIwm5ghWkiKnZgtMIKmByJB/i6kSX8jdAitOFuVLV",
+	"v/Ou6c7q7+snd9g+N+3b0m7V0g59Xtb6NfJZbq2ygGqJNLiHcgHVhZucMxUM+FnVi5fNDgNWCfUb0B5Y",
+	"E03nliU0riJCrcnF8aJ5sIahVCQSqEuYi7mtYg9cfbvO5f2KIO/o/1wOwUs0YzCW7r8zRtVrgMlsCExx",
+	"vyFAIhrvtldZ0KuGKOkkZEwvyT/qV5R3Oy8ZlqVh9kqFDruODizjwlYwoEz+byKfSq2oqkMPGvUDB/NT",
+	"QqfAqKNc8Q61OEMp5VhQhnXVnWsJ43IMjlX/mwUU0Rxx1XlCT9zHQTMcGHgDjCzfmNrQTiR3oI5Ol1eL",
+	"d9W5IBbYYb55/6koDeI8C3YiPnl5BPSPlq70OkEk0/aZkPQGI2E+tJexbJjhHM2OP6YhCUF3I/CK+ecQ",
+	"uYm1u0fdRbuKrffdpQg3ozRYUv749QiRiErPx9Eh8C7GdL1QSygYJbX6vwdVGQYJTyFDJFq+orMzFbMq",
+	"61Q0r2xiWzXiXzlC8GcDCZ3xfv5C/6T09psAHPqoFjrRdIXNuJ1QdYSGrNZ0e/7yHbcWy0uGUIe6BMZI",
+	"IW/QcXTBkGmFJOndhQsbbsUBvTGtqPNWz6acfEAu1R8bEbkmUttbNUWssiLYce0jpJ6wRxmo9pDY7SoB",
+	"Omk9P6d2HClvI4QFUirVQhSvP/hqjJARYMddhd8zya6L33XatwbtvmOHXut3vsEbhxepJw9Yb1x3CcOh",
+	"dshtcppar6PcXYLkRfAsihDnV5lu0NPMV+2kob296SKjejGH0iHAaFIuW8HBnCZePAtI8AcEjMOHD71O",
+	"fDpExA9dHE/I5RzxwmyQeRZt10JeVRMC70sxhpEGaaRA+pdgGXofCmlaMfCvZwSfO7TNxO+56bpG7+Vn",
+	"uGbsnlv5vqmvfKKd8ubeeEpT8RTSebBjeo7seoAXixQJfC3/cKkaFan6YEUntBvRQWXxw4+OFxAnPSL8",
+	"5fBw8FRll1fBsOoL9SSYiYK5YAligv9fLekyfNFu7vb3efH68iyvNeH3SOo6gzopV4RHTkLrLSwMRTjF",
+	"iIjiRlFhq7+r8mCFnb5rkmMaOhyV0NrUKRJ0YE6qpXdS/T6rorvaT1trqCImyNp2dTPJ3/LpdFOo6nwe",
+	"okv0OAB/+6TwZCx5zWdb9Em6ToX7iQvIBD8Un8f9dBMFlvkZKHWyB3i/u9WRVAXF8vM7MCpBe2mhbdeX",
+	"DZBDfYRtVyeRXHrsA1T3+vKsXC+y2QWRF/PrQWRKVPKcZMWClitPUzoVN+cwh7LL0dSxOXU4in+3+WWg",
+	"Odw+XEddSG1dc39tr5J5jlCSfFvzYClrmVqN8Kb95rt/KM87XsgH5ttvvnn+jeIv+r+fBu2qCe+79ctX",
+	"F5bnhnJUDeDDgS0Om/BO95hPW7WLvLoINKmRH4Ua/6MoY+jiA06VZalD6XE5Fqg1PHPLtWe0AjuEqmg8",
+	"ulggEpuir3m8626n4MfhoEoOdRlGxfASG6evlGWASbHoWU090aCf/xe09DsuBuzCjvZWio0IgVXE+lHE",
+	"kBK/YcL7CzZlJhKMjhcU0KmA6pw0FDXJneUsr36szHzXCvNvaCq7cnYXx270Bx0FsjmCcWOty+77MpD+",
+	"rGZUh1wtyupM1jJLF5jF5ZGb3pw2PcRuIo98qxqK4FJV1a+VStxa/744fQPM8PZ3u1p/mSWBAHYDoIvE",
+	"UPUQVI1ELayCG5wkMs6Rl8LYXVK4/J6PeQKjD5KJ75ksbL5nh3qu8ozhVsFAwvmuGzb5dxQy90tpXCG9",
+	"jRRVUfCuBxkmSgSiDFxjmDuy6vIZa+JwTvQsc2+5tcJx2sSFysGcymf4jFGhguqsEeu1p4+XEEqOB8/G",
+	"+yC1H+WGPqsulxLyz388Av/8x7PvgmKDC/b8Qz/JTb3X/eH2BVeFDQrKg8UtOXxctEc06xFlTXqKIEPs",
+	"jwUScxrzP0yAGgoVULc/Af2NKXFuviyBp+66HyT5Lv6IEixvPETqNjNJhVISZfXfsWcP/p//+9nuGOjr",
+	"03MUBQJloJ0QF4WpJBz7k4m9Pnp1sjuWbQqU1cdAopKmMI/otY68xGxC9E9/YFsFWhMo0Inn2gDUydCR",
+	"7+lIzdhyNkpwwWL5ByLSCRiveEgnJDZ+iRuTolPUECZEJcBcURahWEcGYW7wcQxka2WgpSTLul1Slknz",
+	"15WyYRShtFocu64Jix9iXK2dYqSHKlHW1eIoUcbeIgqWXLDT/EE6Z/93A8W7iddHZ6oTSo2zTCFNN+rT",
+	"6K2/WMODU9jzsBDsHORYDawiAH/offIMm/X5JJ5oqL/MGe6ORTAZ+LqXh8LuynqbxtmqxFVbvEjekvz6",
+	"+uk4X9sFz5lUQvQxpapfLobqz4dnJ8HcdEKoyLvurll+X/2sfVquqIj2HnFB1W8w+4gTDNlSpZOG5CLb",
+	"c1M2rOMCLgIu0iMzBAg3prnR4n73RosxSpCc+ycGI3SGGKbxBYooiXlTDA/XQ/wO++aaVQz8gqoQeNV8",
+	"3i6gf1E8phirsd+pb6KdpuGY3E+2S6MXIHIDvdXlMzBFGrKGppXP+p7l2j0Q2vGKshkk+C/fZxlsMtQl",
+	"sN1GsxcbMDnL/245gsjk2vQMUfI4QT6qT2xS1ilbAex4C709eVmE/ptv9tF3L/b3R+jZP6ejF0/jFyP4",
+	"j6ffjl68+Pbbb7558WJ/f39/9SJEhVrEyrjJ31STiOs8Dm3fhWqMQqshamaDlAdaazIFRZKPgQndS5bW",
+	"jE3ioM6pnWWO9X89hT063s691vzoBuOq5UA6zr4RT2O3tbq6IQuxDlZT72Yp6eem7Igk9+zD7IEmnQqT",
+	"dCYNSpDBszTwnn1yTk7FYgbvalr1Is9R+e7zsG0yw6Vqp7spmNreScQtToiKjtFeXsLc0YiaSir5L2rO",
+	"2gqNY5XGFcJZMEUJJTOplZZiV6+DSXv8mFy/tLbtzh02TcUGXZ9DfREGxsrTwd68nm7X3N45NLXnBNf4",
+	"Mcyv1t+3/bEaJFy2qfY0cdY4MAI7XYPo+lQz6Ex3zcDUNFGpjqnpprKgBFs9hcQylnAm/43JFYO59vU1",
+	"F/0KHOf2yAFr9VoJzLT5971X95XiW76RNiyB69umF7pjXa8yQyiXwQoiaZ86W4GTBzs9l/RLcAUBqgf2",
+	"XSvFreB7DO3JcTnw2hat0DU4wMs3F6OnT58916F/45pUkdtqLNyzIFgNE+gv0d1Wm58rTE5Trv4YrM78",
+	"A+QIeJbeH9V4oD5QDaxte8bAHea9coqm4IO9vStMaMpHqiPNuPCtjtkc8+vo4Lv974L90/R4xDoBbB5t",
+	"tgawdr3egN5O/6IAtfdrZKRGxSM6DfpcWQS7o8P50eHauMAiuBIifO5GbysLc9vbRCkI5pYVvArCuFLd",
+	"q4o3rsY7HHIv2vYQJQdc2dXoexoDTNZ4FWsWfmZXPnlZIwKPogSv9jSamT1QC0vUzGs8UXXg6p9z/6gK",
+	"pcfcLFZ0G8tNYFNn8gonTvXfVGis8XXlZ+ygDz2nZwXxr0I0nLKRyrYDuWjnnFXKg+x3Ih7JAdeKvgQm",
+	"mdcknE+klxWgqyscYZMrbacTc0az2RwkkOm8DqmFcxTu9iT92hqukE8YSrN3pH5WeHqFRDS3KaPyU7ku",
+	"GoMzyLm+IR0YAuV/oQl5r799D/6TIbbMG95aPqymMJ6SMTicqlLQ1p+iXMEMAULBQldcRAtefinQ8t/P",
+	"Tv6kePrbr/v/++Ibdvrz6wz+9t11/OcxfnX072WMT759/dd/7795vv+vsBt3obOyahLAD9OU0Y94Idlc",
+	"KQ0cuG+N80kdgDoQmRxialcSgLjQ37sQmenSd1lKbXgBVblRKUUimeo4npC3ujIeeHsC5pgIk50yGfz/",
+	"vtn3zmMyGIPXUFalBFAfn4pWuMKJUOHN8uAxKh/bi2crcroz6TL1qmO2F2JI5RfShWA/GoPDJLGOVHm/",
+	"tgv9GBzDaK5/AVdUNl6Tx8kEhskoS2Mo0IRwtIBE4IgfAGiGmhxJW5PL77+hoUgQvDZu3ogyneikXBgO",
+	"pgmBQjA8zQQCGdElcOMxOMyvTC+FCy3D9Z6n8kJRQm+ChopMUN0OKRidJxiVdVxlfQi/ADp1xrOaaqd1",
+	"oRCFBVpCErwfTWyG3ewQMJQmMDJnhj5irorl+l9MiE5YNt5DzIEwzYghB5MBoaaQ8GQAduTF5N5zgAkX",
+	"CMa7+rzWaqpgxurSYB034X9ye7tYtVO4oy1l4/RmCRCjYBCLYK43xEIBCIncPxQCRnMU27J2Hik2HhkR",
+	"WPJgvYy2rOzczGmCRurfZjCA+lh4giMEEnSNkl3zIkjmp85XvaxAUBkAhaBOd9XT9oh5yo9GfnlC0iwY",
+	"9mSrNnSezpaNMDPWsj2TGNiH6eVO7FId9g79HwvVwQPdzlrKhDeaF5ojA7ozjk3Sbzf16Ux7n4vqTfke",
+	"vA7ekRtoolVplsT2qbX1E6sCtcWN5mvRnSpyehq0nrNrgtU4rx1li2v1X6chRKImGXb1PVkkb9ySGaQv",
+	"gd4QvuJidb1jX5q3WIYmLg2Xczdfd+ntERheOqYhZB9Wr6WZgSuoEtD4FZ0dE8ECQsCh7ZaWUNUDiS21",
+	"/AJBSuNg+11d6bBZJ7PD9HHrbBJVzRfzfKFiXAzEQWpO6CxoHHJ543mtxHyyCwGZemyVsBQVwpIpUblF",
+	"oM4iJbqEXJl95memg6mfP3/+z7yadCHO6oWMs3q6L+Osnr84+Obb8T+++2fXWKuyQ9iLi5PHM/SuJXz/",
+	"XJyrJNZfXYnmAFkevzKaoVfImWUJcpVqbYxb/ngq8dkIpMO8ho2SUXQZMlPfwdM2/ECuUvotZVIAb8iV",
+	"KOZDgKUUhNQ1K+Hge7WyB72KwUu1PJUiphQWnf+pL4+meXFXVUR/DEwxeKlHsvGgYAefTP42mXz6fTLh",
+	"k8nFu/+aTD5PJvzvf1ujDjWf0xvihe/5h62it5WvuwNPyhIUvFD/sG4YTFMd9v+3T+Px+PPQu1h1KPZm",
+	"8oYCSOpDqgzN97okjf1C/ihYhlY+Ic14Q2+nqwhi0MSp9fZWXQ8OWsEg3Usu6JFVPwW8ox19q3nxEikW",
+	"Cwo4SjQ/brkbeWwqzrcQxBCSvF0fAlt6nBLkV0ixAFB9I/pc9Dl+b5CIZapuCiDyUzVqWKaJK1XcPaS7",
+	"Xa/m0G7Zv8o6akVOievKYgBu5jia+7fvHfUqqFbinbbb4HWxIHGIbeqj9aIOzN0NXI2aSrcYNViBHNEU",
+	"GcD1/r53mQZYAKhp3RbbyndLr3LXxE+//gJgxCjnAF0r65VZ0zomfTiqZXKCFaCvQ5WVXxUYoesTaNgx",
+	"wMKYs/n3Xk9kTAzujU1eGYnVphwLjTVOulm46po0qLgWD0f/88c784/90T//eBdmGHKylpdhlqneDvlr",
+	"5b1H+oCfcFvV+3tZBROLALsNPCL8A5asczMYaDif4drDxjozZ3WSrfnBj3Qxf+KG0+UKZyCkRd+W88rD",
+	"kH739YS9nDnZ+R5jXQwQqwa42M83EtViJusaymJ0j3XDV+w13HPMirOiyEcW1ZKW+d2nsLwJnCvfS69s",
+	"uaaxRAJFV6U6+jsmqmDXDJR2NTVY2nzVYIEXqp2TzNqIMjEGb6ROIBuARZmwVZwsxZu6TYnsWCD/rhJq",
+	"0IQ4lR3n2UFUNiNTeRS6yuQISRNiChkWyzG4ME0cXHXir47i7R1vA+EbWKr034h9tqpp5KU1pGI5zC/N",
+	"6GQ2r2q3frNeo6q+nMKA84Op/dcCtRlWeJwwkcaw0u50NJhX1WyYW2byt8oEfEzIjvl86H+yC0SWJkgX",
+	"SPPb8+k08HhCQgRYFDCVkSKP9wSHKpcQxc4Rniy/Vtr4wZVz3BoSMSCt+VKWJtvku1mcuucrWi6kuaFX",
+	"tXSdW/XG+hfaIawPBL8eq0IxY3pDEFO0rv7Tc09qX30dXzSfp0UGZDIFUkYXVCCQYnIwIQm6EiAjHIlh",
+	"zcsLOEIxl0+26gbrLEq2PRefkAQKxN1lfw9gfA1JpHx8QoN2A1msPPQLSGSPjB3JMrSXeQh+wuI05cMJ",
+	"+ZBNUSQSgGIsdkNMqDFf41Kbt70xxlN5UndMgdSMVo+Cm1zHTPZ0OJ4hNkKFrvAu/dNj4/Vi1LgKwDjk",
+	"rFSYE6jzYSMLeclNgLklUS9zpVq71XwQ9jadQd1HwExaKZW1WMqOsm1nXKJBf8UQ8aVtAi4m8kBLb7HG",
+	"i1ce7mOhlXYUK1EyQvWiqGdUDeI9ig2WJ0sf+VVImcphf0+jyB2TIcf3u+PAYY3gNHr67Hmrmq2vu4Ce",
+	"PVhVj6KZYW7Vq23nK31ouXHFWHMKEY0GGZ9wvbgshqGKEnFwsZQnPMzLd54jGC9lR2Ij45j/llxT/RPs",
+	"wNmMoRkUaHe8kbjIBnffpWkuPar4+2xxaZ/WSgwoHRmz24iy2chgQIyuR/+Az6/+OW0IfW4M0XydB2Ta",
+	"Ri1KULPXO3UePIPg41UjM4vYsaKssFkZYbuEgxWlguYnrHhYK3D+EnP8wh6AFUN/LjyrhpvDvcfSKVy0",
+	"deSyrMALFHx00/yxDrS6Y/QvRArGlC62k47pQBfaXSJ/BDve917ej/dXP+HH+3Oe6eP/sXtvRQOEwy25",
+	"fgUJuCkj45WcaJG5eihVEuBgqzg/L8fM+K7NVmAf1TR4GBUS70vbHcKU2vPLJAq9rHyndfzYFJQoZf7y",
+	"CZFvo28Ety1jTHx8fr46chhze6chmTxHSOsyqgI0GNYo7m2hVgZJAzOu1vLzlkO7ulYVWZVp/VpUF3K+",
+	"pekAxChKILPVwHzuErYMjYEJkgiJAaZ3X2Lq58l4QuUiL1vtDEcrhGaW27J0pt7aQpxFn0AfYXWjTeXz",
+	"OdeXI7X6UKu6+HJb6cylqdz19bbvVFg451LRD9oDVEFanUGgnJo7OjWGJjFi7rGTq0h0mMLow271NZpD",
+	"Pg8HvUmo5a8Vr8F/1Wu3IIKpyEydcP+5LZBmnU7Uhf5r/B1rqF7mSVEHESL1jSZR5di3jnweFlBCBmNp",
+	"zD4eqf4+fI68iq3K5R9rFPJsyS/RNUokfnDP4YpFVZ4aS9i+OjOzEaLu37icy0Gtzhd13zWel9vxr8gV",
+	"++qGcq4NKYbqkrZDK7QPXlvV8FaB3hGmpylOiM2Tyo1YmBsXamySEWwWDyXmh6GtsOja809sg3uTsjsy",
+	"tP/eDHgfgKebnFikmnDMh1Ii5KeSuWiA5Jn4e99xDCjeHd+OZmMrW7uu+EFB8ZZqCtRKkWVi76J8dFMy",
+	"w2buxjZc6n8vTJZARcTt9WkeNFt7EaZXn+s77FDAYqcXg7uABF+p8rc2m8wgdMA6p2PPwh5e9QBgDoQ5",
+	"sprWjHKR5W/wGgXTG5MluIHX6HuQ0BtpSla5cl5gMbjCjAudpWgKXehmoUu/IKfK5JTfejly4y79vIf1",
+	"ccelIEUp+JnjlZtf2GoDuS/ZRM5LVr168LAqFVUVfSH7wIulLKF0qfMliYD8xB2XOZSdM4akZXhX8kfN",
+	"93ZkJLj+m55I96J08BdZhfp6oMPH1T9DzKFbtUonmOcVSvNGDf6DFmx8Y6rv/xaMACzdUYyEajglLwhf",
+	"lRblc5WHMUWO5a8Zv9wrONQ449SP6kRyzXu8XlSn3xyqu+YciMlv7pIUtPB1jShVwaC6q4Ght3Erm1e5",
+	"7o1toBqy6CVoNoiT90h34F4EaZwxHchCYsSMd6KTYJUnWpxnCepc15rXPWoLKuc6g6FOSe5nkEIxB1Mk",
+	"bhAiBftWtTuIWs4Lo+lmVzNY4k2dk3ZaAKObuHNcyC4Oaxj+YgE72HHQv9dHAa5boOwGvwWjl+YixWvg",
+	"Xdz43Hbp0UfeFS0vA+u1ImcQV+pgD+2yLXqsPmxM/kk+cl7Cv2eocZ2DvIP5ehTobQrQ2kxk1m2EZK0W",
+	"i7XhGKztCr5aMeqqgm81GclSPTpeM+bH+37kqLhYeYBeI8ZwHK73vkrQU5eiszWe4tObvDm78xe7A1Ga",
+	"ScF7XGJohcK3Naf6pr3OkZ/36zYCUzwyrZkG9anR7bPnrsduRfAbXNLD0q5COGoIMAxXQezIj5m5aO4c",
+	"xOun4/1xMI1XYXZR2nAdZ2vKoOg2HIYg5H843wJDuT8oj1UJtbt9S7RU363XrSmhcSvkpGYuUEFk5g7c",
+	"h6xZIRuQnTqqa2FTv1U+WDUAa/XIq1aOtWbEVXF+mTvme8I24u+yWRs17fJlmQCAyTX9oEoKaqlPeRwl",
+	"R4uBvTbgFQLoBNSxGf/2/FVeb6/qjOPKhf9WBaXK5PcuKfGQC6A9V6pyTUNQVedOI7cS0jXo1IglLZf7",
+	"4EHfnv2xucZHN5t8ecXQ1dhJ+8E1l+axKUKk0Am/N4TnlcWDOkQdpduwxEuGUFOiNUPaTAJthYr8ASgS",
+	"epfuL/bLqn5I45BVVZYIc1YRNcaWjJNw9TkpOcMbGqPwNersbs/h3VWULn4opehScFWWJKA0DBydgx3X",
+	"meq/gHE+azleRZeHLFu1NqzK4a5swgo7kH1I7EWFX5AFFchJDQEFQLFYozTqfpCYqOJQttCl+SsXlKFu",
+	"3WalLcaiRN00XudZRuM9eSzS5LTX1IfWLB1Y8cK+7Lr+1+qtbmsT/X8tKsJmN4Lqsov+/K0mD3lm4buq",
+	"YHy4AEQg//JIVznhLQVGcueGFsW0d0OhfKHQLv+abAXFU71nY0EBmNWtBcVpNmQuqMLWTTkuH3CtdzCs",
+	"0wSUUs8p4mp8VDWcuvYoREi2Gur9q4qD2N9Nez4l45bX8RxXOhPjm8UQPN/npWZii1vVlIvU/qgqh0Ko",
+	"dSgqmZ30uXTBIOFK8chdGA13/7R870/3eVPbUd7Y+67iUNKvr3L2Gl9CzpDrHcd9vIvNVX3MefYuhZkg",
+	"gULVq3QoMS7WIKyJAFJuLPPbu9p40Fwq3KxvsZdc5vEdb2zvTKtaZA4z9Y7afjML3oC6X1jgVvT9Bupx",
+	"2VrlmAxPcrFpdpjliq15V2tpaBM1sXS8Rd1t/ax+NYAEprPo95Z8IPSGDJRH0/K0wdB8vxwMBxcZlwq3",
+	"UkVfohmDcaErb3PYgdMcPdagKixJ/qciLAM9RFcUvVZwMzIHHqnyvz71M9+UK2b2m9mTwzpzQqVMhu83",
+	"r3YdWtaLE1hNqu5QkbWL4aFisKgiMZXlwO3qcrRq4lEwQOQVPR8Ltn4xBVszlvSwhipUxRzrdzGgIrvf",
+	"dKVpAIUpWVe4Bt2A2ZnVLAfMZUS/tqsS2whMlPhl/vluo8VhvR3pA3nXQCWWj55mIs1Eg2GaqgEmXyKl",
+	"aZb4WTM2ed7PnlFRaCa8BpPZhOh319gDldtPzykjj/zybfZJfHk24ji27bf5GBzLZgUyH4CgCaFXGpih",
+	"MV38gpbn6GoIKDO+j9cw1X8z5eiG+QORh7dMiM4ZMgZkUgBQh+prKIMGhNJCXS2ER6XPap8UfSsmXd9v",
+	"IJ8nOuUjqklPxc0Uew1R3iX10DvZrpu78L/RgVkZakCsBAvEYGIwy9VHNQ+O2R/m+ZaVXPReDT94Py6p",
+	"MdJDOP5m9Zhiu4sGiUO9EqpoEP5Lo41F8sBTMceIQRbNl12P72f3QZvkc/Kyj8Yb7m1aqHRamM5nLs1n",
+	"aT7Nd9p0rkdVimkM/Xcezg9I1V2Gvn7mJrOon0sl426G3V/Q0retugmLRwHHEev4qgYfVAOkItId04ac",
+	"m8K8ivsZxVl3KA3xyJK6DglMlgJHfGR6l8XTkUh4G4hhy3u99dYEg10HJZ1D/ybQtbL4cE4jnNcYhr5w",
+	"V+acwQY4b1zTG1X3WtuN9ORzyAGNlJYW+4fxPBjljRkXl/XFvX+Uv6s1/CX0Qx5RppWSbv7KBDau5Lsq",
+	"N7Jebbnp+sYJTnC8rtRO912DkHM8IzISVhsh9qShiyrVlNAYjZ4OepTIv5hTJsACygcX5VDp4XlH5ipE",
+	"0RzFWYKCzow63uyiiYppEXHNGrbMCDdrse4M0zaDdhODHV3AUcodv0Em7W9FWtU/d+Wi5jibK8UWKJOf",
+	"qxZDYfeK/sWW6Jf8RQHNrapjuWstnerhjeY/b8aSPtfLbao20xqfauBpOpWf/Se35rlzj5XO5LRVALHQ",
+	"LdC8KPEEywwV/b5MiBz21zlNXLjXns3+qvxydP5S8XYVZv69Jnu95wmJaZTpyF5XABoTFUJvT1I3gOMH",
+	"EzIC743I/17XuPcLLr93B/peIuB7e/jvjcyrPvfGSJu8NwgyBBaZ0LWa0EfpK5Pb3+F4mqjc6YzEiOUA",
+	"7E7IhNjzxTYL6RpTlUYg5ogXNiKn91ocETrSxcynS60MSCnqL4DIDBMEGDRN3SEBDMnl8jz+G8xQWP6u",
+	"VcRzllCJB2yRlDpZY0LFXXwtrbsafNZQLqbWzZAbFxuQ3Mgb+i4l08p9M/pezfStskU304xd98S0gqqH",
+	"bDwhLlN6dAV1pTydMq/50gISOEPxqNSuPUbKYEiiJdix/vXhhPwnQ1INjGA0R0OjLSq3PJyh3TFwEiVX",
+	"hmVftnK5pIU/u2TSL9llDHZgcgOXsrGY3dxk4NPT94AjZAtnSFTZLXmZHeT36l4u4tTq/uXSPBtyMBdn",
+	"7R6RXtcVpW8oeoni7j0YPXBb3Tzutrt5qO6nXAc01vtcuwpYbnXEPIdms+W/HGPdkgpgqxfTybOoCwam",
+	"pmI641Vr4/gr2OI4IYekqCtPVUP6Hd2QdZiwAQek61JRLvGoyzZK9P8RE5jgv/okI26q4o6F79wrhFOk",
+	"Dtk/ODbNd11VXc9GVprBysUpJrZQ6Kr1dBwI5YI6FePt7VfUKZ9T8MUP2WvusL7OrYRLN4mAKgS2vvtc",
+	"2YfJ/DDgKqlpDeIwJOSbBwCIcmS6dw3dzCqb85y3Uaj2gJ+QK3qXnuhN+Z03FW+jvMyhWBszWfihq82a",
+	"9YR81RGV+Qm+vK8tIpgpm+tctRqA/d6pAcpfnu8ydHhZMO7p5GWXg9+Yn93nOKVuWa5qZNYW2mR3r1tQ",
+	"9rRLJXRWsUqFmlImurklRjzcKRLpH/NIBT1Jt2wMr3dmmyHKg6PpLLr4OErY2o0r3l73uy+L93xR5NOC",
+	"KXUpDSV8CXFN6zM3RSwgsI2pAcvarBi1eFF75c232Xw+3trFI2o+nNoMgrD4VdvBqSg7NrVwqgiT9T2c",
+	"jvyc0VwmLPRv4l9vB6byLW2FyahjD6YyAt13E6aw1tQKd30bpvIGK32YFBFEkKlnM9UNOkwITZ6YP56Q",
+	"QKOk71Xeo7HWNmD/V4vqW1KwIwTTuqbS2yngEZq7r9l08xU9gne6JcbUlSt8hD7fTGMlVmIp1c5Kam4s",
+	"S61XWsK4DjDuOm0LGOmP8VsgbWUHpG6W5VwuKydB3Xqboc5m5lyfbVyI+e7EDp7CVU3bJXDChURapEHT",
+	"7aj85GkkOKygYqklUQUhd8dt+x3Vmw6ZJz4e317brGq8ascWWQwJiMkZTXAUynjWKzoBQK3FkEBE84Ef",
+	"YZJwIKuiS4GiCoQ/uykHSExL6LypQYIEGkhOJ8cWM5Lcj5tp/NT4qPVyBWxB66dyqycdJcxtRO2w2vdp",
+	"eCveBBOa2Bo0znPnAfLbf+ZR5M5Yo+ISkqVkkKUMrbERzGsDzsd9K1qUQt87J5d4WLCq5LJhiWXLRJVV",
+	"ZZTNt3mqf4bLT8Tjc9z/Ob691lMlI02H3lP+a7tW86lyykTv7lMdIoz8/lP+3/My7YW/9u5Axfyo/lBg",
+	"Gf9Pspm+Uz6cG288xcKHUOU7F6U0ldUzCvRMm0onuGgs1bJSNoEB8HZTCSJKyO3kElw2ZqHcXu+VAkP5",
+	"ypqvlDjIFhiiurRfKdz53fRf8ZfsLbltogNL4aa2RGaTsLw2RZT6VfkAyDRPMSJ58AmdkJRRmZFKCWIB",
+	"vgou596MUyr1Ga8FgFJcJkQiwVL+NzAsr4bj2SxSiwbjvw9zCYOP/z6ckIB2/He1CnBFMMZ/BztpkuWd",
+	"JSbZ/v7zCMfqf+XPWhk2MAU7dTcUM0FEsKVft8B7MWoC685zQWW6zFdWYFsdSx6FNGXUAK1JbPz3okkj",
+	"SiBetL9FjU0ZTlMt9pk7Gd0wmEoGXWwoYBruXMGEmyY75hw44B+w+kAeCEPJsgji3z55NygSfkykghB/",
+	"rklGipcbgFJlC8dMpX44UJ9wrW3iaaZjjmidUcCcdW4K+L2osr/7HlAxR+wGc6Q8LorH6+ghgIl7vDjI",
+	"OIrLx2EvWN1dda0x+oi54DvREJjQ2X/9CzxR6z4BEhmefav/L4jMx2rAJcvQk93gqW6u44Skb50a6NEv",
+	"z6ZcYJGJmrYTvftE+LRTl9d+oSPRTHpxIQe80CaoSIdeAjqgVxPSNQHdtrLhSIyNucYmr0sJZqhbkkqB",
+	"VJX74y1sLu9ZYRjehNRyPFDP8No4xT0kvBsWSf289yLzs7WItSTnMkIw4nnFl9/fSSOoawAp93qFk7wj",
+	"5Ae05FuWDv/KZMFT5t+5z5jecgQoSZbq8SGUjDgiHKt0NXnx3xfLmahlbFkwbqsLRX5xj058RR7M5/XT",
+	"6bt2euuVntOh50hJNm5Ifg80WSusWtdlbaP6e0OftbDSfgdd1ipCfa82a83mlA30Was1QhuruE7usLWz",
+	"1RPOswVSolIn7kFZgXmM+8aSeq9QUOS/jTZxwQKptfIl8EV0tJD8ImgA6b1tp1e0NW+q+qLyXtrGD1RG",
+	"OTUg90g1ZBzwYuc6UHFtef4Y4jsXNu2sam78pO/XD5rtLmv9++L0DdATAGZmKLYyV3vnQ90ogCshxUYI",
+	"cp/PlAvKSSmkcCPf7X+3H6q4wFCa4AjywuCn3dIGas7ioq58l9kp17+bRmM0ReTw7OTX5+ZXE/ZfcRwU",
+	"h/W0XOup9YK2kz841VOCX5+DPeBfhQOhKtFWt6xthU2krIeMwW+YIcDnMEW6ohHiMseboeunYz3k/QF4",
+	"L0lXZYHLbNpUlUuSYo9816aQo29fjBCJaGxFhQ71kf1GHCE1wdqUwsf5Kc/DmC5FMBy33ANRxTCbutTN",
+	"sPu1kSakas81p6FraXO0gETgyGzZR31rnD0YRH+9+TNa/Cq7jWQcMf1cD/73bx/T//3s7b+CSOuCZgIV",
+	"W+fIJLe7QtuFSND8NKaUJggS3xzo1caw9uQN2fS65N/pNbXFqkMkrwOkISNPT/kSCnhRk8Jurk09P0ZG",
+	"W8A0DTX+YLYefPvDVCwc78vzYUs+0XUZ1K1VcGpQrp8qMXNUX4m9dHb50kNvC/WnpRWIjgHijS4OVz++",
+	"vz+D1+Jfey5A87ddMwHqZqnnqA2nVhrgex5eoitMkOdJUMynVPrfyJaQIdWYVXVvKfaK/nqcDOXDvFc/",
+	"QwmYVSNdy9NsJMS1NGlXP4N5FXJ8W9PVUL6ve/Y2hG6six5ZRbvioVj8qogOqSl5UhIfShRcPO8eB+s9",
+	"Xu26zRVDfF5fzv1negPolUDKosxQREmEE7Rnvqvr+fF03qFTcTc6uMw/Ukaqd8PmqBpdGlZQcDOnvKYh",
+	"ige2MZOqbJk0U75cFw9Wul9jflehgsPAFAu4VAWZ1KNGljVLMwSjudLnxJzRbDbXYqHHyzHRgczKYmo6",
+	"4XhG7g7ykB1dpgc3jZGHuxBDjyjENnpYO/qwTBcbLIeeQC7ONVKH24v95mp/loGQqCM/l5aKCHFerAA4",
+	"eLb/7JvR/tPR/reXT58e7O8f7O//T+fEb73YhcQcXiuJKsTiRvEzfTzyO+jBONQ6DWy5XpCxX7ZJfwQc",
+	"W6q4MGLKaYoYFLk51Ztwhf5a1Ul61vAOnkSrTNvYtCkcluV9Aox+UpZo7CH0C7/RU1YCq651VcGmKWsE",
+	"3cq8elz3AmM14Thy0/Us6NLjeSV4XM2tXCjMEuV8CmlCxdvwBb+SfOtMA85F7+rP5EUbazQUSAgVeYf3",
+	"OjNDi1nhMJ9FIVbsWi+UdYv8tBI4Rck6i75SE3Rc73NDpZzcMHqawv9kgd4gXn3K0E1Ze6b7/IMbNMZ0",
+	"L6bRB8S0l+9PXYgyOOBqVvllCjmORrKkX+UnzufhH3TN2imlggsG03HpV/oBlSytDuzObCYccVY1EdkC",
+	"yM3ns8omW89UnkKnXcqeGWp7qiDOx1BR3kzMERE40oSkR4PIDK9IC9LT1zN490h948QFvcITidsfEBlK",
+	"jyXlJjqvUCM7l0wA5Pa7sYEg2PVZYJGgBSLiDzWqutnjfAhQQ6ocWVdJCHZayKfXRsTm+c0Yb+7fBzBe",
+	"YDKyS8To2vz7nScR1JSVzaWicJlZc89lrMw4YoPhwBSv/ANGuoxyAXnMmE7VZquHHDyZ4AuiIZTkpV13",
+	"dZWvMxNXYWp7eBtT0S1KlM+xVo5UsQk+8lSfgkzMX6NoDgnmi5DUpsMnUFyeeuE+ynUQXjzrTsLcoQ+A",
+	"2X/gcmPM0wQuwwH9pXrNytpoH8MSTPntqo/A2+Ady1PClAVbWRzNUfQBUBabFlqFe4iRMK6UnYTeIAb+",
+	"BeZ4NlcVQvWEu+F+kJ7/px2P/ZA3lXk3BBOFrZOB/FcJqSeDwpq90No/du9QhmW8CeG1Voa9hL2gyB3I",
+	"NGW1Slk1LMGbfjCsMcUV5670VzoOZry1Bhh0aJfPhbTlzFaPGCjZE5ole8+goLoWU+uq5rkPoGsehm/F",
+	"FH6TtMD5/Wa8n7bjrNFqyn+Whp7SkPxPRSewN3IF+3gtvOWq5a330lZT5ZJBHEqeln8O2cAV++OKR0WM",
+	"cj6KMiFM7l2EmOuDDYkMcfP6meV88+uxg+vDu1frtwJhVZu3/ngjlm41VVf7to47WNOorQ//nk3ZCgjp",
+	"TLwOmrCoX99QUBAj1VVSRyFJCyhD15hmPFlKY1acRXkAvStZbqPfEGQJRswc3hhcqAwdOdzhgBKWDGNy",
+	"f6zyyyvKjmEUKq1ZiDI0ge0p0nGmxtCltlprbK59ZPxT0JN8n3dgYnkDRIbMIeUR4HdY7awYBOhAvb1y",
+	"YcPBzRwx1HoVshM3TgRipuVYfmINQJZQ2uompZpkIbTeRB/SIr6s3CBeBsmyUHU/mgLVW8CJy7qwgDLK",
+	"WgxvFRE10tZSdmfXlH0JQsVKAyrJG3QTKtymblN/ZHtfYa4JXgXv6Ne0vuGnHLD8DYZ40aH8CdxASXda",
+	"rJf/1gRnX2nVJ0fxEBuirrMQlmCKrqwbh6CPQn1bhMivEPv82SDYhqcH27GFackMLKSpMU38vsUqiw+q",
+	"5yRYeZXSDyE/IPtQTgTnUgldkgjIT9w5mN3unDEkqyzsSu6rU9B3zigX+m96olmWQFYoLWoR3Xw8GA7s",
+	"N4N3fZNpSgcTI4HYQlfIxFeWwMxe+JxmiYTdIFDcwSN4l/2FbzGRxM6kk0mKhxY0J90mR2nKRSlLKhuI",
+	"eF4jZDjVYXIBSqGxDBjK7eIqiaj4UOcG+pC8Us+i1pA9FLwhrKapKWId2IuMwDyTH4J8lGtIXg8mTUNJ",
+	"Y2aCsiEOxvFAx7xCEwyjHr0Q0qdQzMNAgjOKiUDMqsE6PFFQsJC3sQyKIOHsEVXKX37JkQA7ytIWx3sG",
+	"PO8Ydqu59unAgBjC3sbAhh7in73HexPqahFpi2S6Ghi3QKSzkG21RFdgCl1YcUq50EWGfnXtvnjwCkdT",
+	"yHWwsRmmm3r5eXhKVoBJYnQ1X8gaFprbXmHp/WSmuFFQJOxerrq6geBGGdrUPo2cpMHHZPY9MEzGtqVN",
+	"GdK+p3wSrhlb113lQJ5nSTBwTTNb3qZ984r6jRhaS/+2uYc5b5O0x00duZdOShoCaWFBV1lygcQQHDFK",
+	"/k2nu9JERqiSsvUW4s5ZNb7RIXAi1xu/WLUdc5cHIOMIhLAI7FS7x+2ON3XTn2t1tB4RU1ZNq8z0No2h",
+	"QDag6j9ZsP6B+UEnLhsBJdENy2xIyhOubdSqkoH8lwxVtyUxFbVPiILnex2FmDLEERE2sNwJWno2MM0E",
+	"gFM1Yo6Y7nuUsozIPF1SG/+4YlxCOMciTSBWDmOXXnFumw6qITptDlCiu/i5Y3BbyeurhJMr+HMTjeCl",
+	"VsAEF+KhNh99YXU9yH2uq2e3mV95/bkJqcQmXirHnJlFXrLjfZLxy72MOBJmxu8nRB2WueaSpdprrA8V",
+	"2RnElZq4bX5YOUGB4EKVEFJMhgcOq/Qy1ppupf/wCKb61caooVWDHFny5KeMyoRIl2hWtYF4MzddW6OD",
+	"VeksDsZlLe7CyBZjKCwb2LRjdqFOMpfYCh/+NPrJcB/WBh3u9w06lMjSqr0VYz2C7LDEQrvzfo/1m5YB",
+	"jvUH4rlqOg4fM0YZMD9Lc8QNyRu4F1ZRfEXV/uhQBi9L2iVpW74DE5svr554ZcWyi8o1BVOBNF6e9GTy",
+	"t8nk0++TCZ9MLt7912TyeTLhf29PkFZgNfflVWrYj4wuukYzUgYwSTBBmtNWTr5PwYFAnlC9wnjirQp2",
+	"qK2NcgWTRNZ03e0WYWX8d/Xc40JyNeb0KEw0dYRCOqYZTuJwXPAP8qe8xVMXKqy2d5Lik05yri7wExbS",
+	"WbnAAlz8fBhoDfYiOCU9ZCGzhtGhVItcgVQUZXHKRfxtzYSnF7XTGeVGCgpLLtCiMGWCSfYxPGWtj/Un",
+	"6u4lMxFb6g4KE8/o0/GzF+Nn3X3a0upso20qoQX5KziCKe6lj5t9ADO0EHa7P3463u8aE5srzj5ODD0E",
+	"NDfhbtg/xhDZ/4am0pisGlh3aHqkdUUTyW6ategZXKvyko3/6koJBE4/CQX3Gz9rzhiA/UyrN5jbVUpB",
+	"bIVmyDdoOoJpzxC22vdBy+n2gSjcmTmzPKAfcK8VfQgzzO/NybX2ILWntWZqB0XBde9l3gqGZzPEUKw4",
+	"T8iZky2miMnzVljDgfvCn/5ZMPvdR0m7p/wMq4sHMc5EqYT8QV9iVIXbz70GVlgoVo2tcN9vJLzCznbI",
+	"BL6Coc7h9peCIaOl85MVT2vnUpn1mMyGQBk1eBp/HMmoZeURIwAKgbiwigCKJatH1TBF91n3h8OUfoIW",
+	"EG0OzJOPb3IcydfBC0M05TV0WZgz6yoJcA0z3ShB1yixFafycsRzmsTaD+HB5Mf4lYAYScQY1jHLOoqw",
+	"M3vG1ROzpYsfTl9LVyKj14hAveYFnhGoBIp3HV87czPl42hCuK4hPX79kHWieuy69x3YUwz9q0WYs0KE",
+	"3nhCzpEx6XBwdLJ39FK/CaDUu95gsl+y9qsJiisHTW4BD1egrMvI9SQb5eZqyr4Upv0xm6IzfUvbRGxd",
+	"KsMVyS/PZSzjXp844eL59g0OftdEAitEABehud0Y4CqZdAl5aj5rU/PicGZ6MzUmCntj8/SJgi/Rx4xm",
+	"HhH6SKKz/PfJy2CbWBxBUwXRz0pwrfDnS65G5GU8XtuQpCIeHp1zFfisaqerb7m8UbN0yYI7iPDIzNiS",
+	"iNzZ3ONGB+0zIT7WyWnSfNHQ3BrJ63M1mnKLwy0/HTYmqx/pSuAGqHykJZYyhBvoZtOhkXr+m4VjkbdW",
+	"l3Vh7VmWwVupm7qdxEYzNNSLLAXQSUE9T7UNBVDpbCxfVRj3qWFdIRo/Li0gtI8HawbCKeuujYaThnmn",
+	"9PsrY27M2CgOrnhHAWibKGLsLj8jX5uWf56RLqvcvpB4npF1RUQ5xUYFxPOM3JPG38PGW9DRdfHsPIyi",
+	"n6LO6itAuGUSqg3KxiYBCVDT+QVCJBXGeIZ4sUoOQzPMBVuOzZ/GEV3swWiB9mCa/l98Dp998+3Bi6un",
+	"0Xg83l7tPT+jFsypy8S1Q0BUSMm181o7hxtm2mVdY2UC0TjvggHU7uUIdfuN7UI7pEKWROvadEivV1OO",
+	"7pYb7zjIq4rBbkCur4r0PXIoz5sg8S+uGAW6Wq8s19VmpO8DxV55dyewBg6n9Qlq1Q3OM6JcGsdEsEBG",
+	"8SHguoa+9zwq/4WN1W/kSrU+g1JWtPejfVuskySncukih5ggBhYQEykzsppoeIYgD1aUnVMmwAJGc0zQ",
+	"SEWB6PKuUxXoID9yh11d/6J+wdxrWfWeq8Pq5dbsFlwQZlpmubKl9o2cMmkPsvTAdFwJagNvk0vcQ6af",
+	"wuG+pwSBxGhk2nKmIpKuVTl8z53lekQqDUu7a78y+5k7pG2RjhQ0GxCR9DyblpPUrL1taSwjOrx8U/Y0",
+	"d2tbYlKz8BjNocy1CwdR0PY99Cuey6Yi8AwNmGwlUNQHKvtpt5jJHXjKprrVoWRxkpkkWhp1R137NN94",
+	"ztSO921NVmGp7aYqUzTeUh/LTPdLs8dXFUXm4d7Z8s/+ye6cIaI9gecZIeofF9JfjWIZ0/4jxAmKpbx1",
+	"ods37Tad7XlG3rReZwAvhroRHMiIwImDDPP6Nz58JXrPXe6jtzG09gLMExUoiyP/bjfjqEKnvNgQnMpJ",
+	"XkGcZAyFeiRI8QeTQDEw+4NeRy2IpISkwv3kn0zaAjCTf69u9UfIVTWHCCX6IxipLjP6CxKrfl08h1/X",
+	"7ZDpoYQKezF+7wQPPjt/UNxewI9HlOgegKGe5K/hR7zIFoC46Ivq+Slg0EcUZQIBKHKBQXWOBW9JghdY",
+	"IlmxJ3kll3WBiVyrptiNXLdBy6qANbTHYsOB5aukqCdWtuCV3hvJNkMx88WgRcK7of0K1uEmzE8T5CJt",
+	"u0mtTdbgXMUYcbFMCgbhnTI4R3b1YQVSx792N2IyvlI8sLDF+lzoVHPTjqOZZrndR68ut3gmrOoWTW++",
+	"jnAYLO9z7dxeScclBBUw6TT2czParyI4blBk3CJp8RWdten4CZ2Zfo1dlPuEzoJet2Ak4IVAKXh6AI4S",
+	"qh8mlFKOBWXLOptcvUr9yoG5cbW6dMpyiy3HuookEcilkO9wbWKSfoa1V6Tglyp4dsCRSzxTLx6gqrKz",
+	"fvqq4Y8M8WyBGteEwkgNBSOYFs3057HGFCy4HScg/6ByiSQE06XfkC5BTMNkS80uEJhD7gsTVTCFSA6l",
+	"/GL4fVDnl/G8I0FHqmi481QVIDYmSzcJ2ImtfVTfPUjwBwSe7sdP58/3F7vj29UhuioPKz7YVYuJsWHz",
+	"evs7r2RKaqLvy/LsfCHWd5ciw0be/kL3tV6d0RqbMrCMFGpi955whSdYEWbvN+wS8g/dcjYrWNuQMKJ+",
+	"11hb5CvKVyypUdrCuXwzYiQgTqpP8hzyV/gaFeJC6qPGFWdI6IzvKbuuydx2NfKd1bEaK9QWRV7X+fxU",
+	"mTaT4v7MYK+YjBEXhwOjfUsHkpOVtCal/nGkXgj9b5WQVQxMyr+uil4CpQH4zuwBa5i8c+6FH/Jdr5Mr",
+	"y2HwdvMaomH4CpsYYu+3dn0+mCt53osyVM25q1hTiDLmnpVl3PdYm5iobYdxjq5C5YzNr+Do3O9r5FrG",
+	"StUeE517mncykqENpn60zo6Vf8UM4O7J68c5WHfXAtMrNV8J2jAl1NRubCPkJYAJJTOOY1QVoHo/dmbF",
+	"Gg5/ufkwlNCGgvLOOGRqXUmU8tg6wIQLqNBpo+KUHwO4QuhuuJtNpfxsp9DS6mk+4V5lnWLn4OAE0hQZ",
+	"g4k13E0G2gZFF1goGTdgu7nsEiG0iiTYq3HM7YpSnxu3hmotU56oIPEvxtc4zqD3rMrHpLLPK0wwn4dz",
+	"wPP+M/L1syObFMinvfyyNS1F5GKVTMkooQSNzBa6mvDVVPq3FQQJY8cPixH+FwFBwpM5m840t9rfhk7e",
+	"2davRNd6Y4eUh/cUvC5pwyGVNi2HEphX0mC8MIhadOl6+zZk1oGoUSEvMM0/tF7eqqded9qyWk7YUF6o",
+	"o+NVm1a4ov4IIhqjIYhscMcQIBKnFCshncSF7t0mntVxnq8rt0ad4r3HBEgo1okFUN9vLAZAzlaMQS9T",
+	"c+R+1X2xqNL3HHBPuMOnIC2rQbXp+BW3cktRC6+jfIe30sB97H3UXtJf70XBY8vZiBKw7XCmjKpTbt33",
+	"Ew7MWLXiGJxcaV/tEMSeJJSnRJjBkFvDn7TysaD4J3Py6/T2X91vQOtUUJhieko48y7dLKHX867aPox2",
+	"q35jrtbATP8obUGBHNriPbegruZqwbYp+ifXx7emCQqb8aavIZtlulBQn2R+6bWGJG6aWFnY7Wl2nxmR",
+	"61CPnbwTha0E2FmqPCbXv0IWWusKJyGl8EecoGIMbOe15Kc1i+nY5qr8fXRi4pQF1QEVOkBZahgCznbb",
+	"A5X9lm97MMUH10/H+x0qXWiAmtDv2JJDoE61kMJOzk+akVAWFzoLVjj9QcoeKRRz+7zJN9a2h7rGsEyW",
+	"1RCiVZvnNE2aUhby+lEmHGzTZXmWhQ48GBx8+803z79pCw7QGBOWMWIp5WCtDethAUVMmIenNrCzQ2ke",
+	"U/szuNucklUYgvK6yXMBOz7nln/Z7b35cOzpGaOCRjTZEyiaE5rQ2dJiRYAx/3x5eTYYDmbnZ0eD4eAn",
+	"BtP5f78aqDorXPZSk2Mvj+SQty/PwtVGGx4QzzDkcNyNx4iDKVpSaQpbpAmOsHAvV4HPO57R9JoM1clI",
+	"09cgN84FAW40j6ofDeo2EXUfj7YcvwlvtpxnG1zZEg5pWGc4RrzxmRm57vj2HAB1H4ao0T3TLUKbHmiB",
+	"qDdsyCWtgfCl1WGWIeu1/U2KcxDYb8bgVBerUIiWp98Ymc/LBbBfqHqmUBUhYCiekLxNvRKRTC8fKzZw",
+	"2R5KPsaysGkuzuwqpUtV/lvQjAgOduR/uJ/HE3JqzNuEChvhhAlAWAnesmCihAHPCGXhapYlIXn1opYc",
+	"wOLmaX5iOp088qSZqgRiRNpL2TZaf/qEA6/kK9hRyTBD4BdoGxrJ4jVM9R92wwmLqhW17aZqjlp1VgAJ",
+	"FirqXemy17aYXH6j+swW8KN/Ht/sB/DMv5m7O0qFF+rNV2fno6I9xQnxj9E2nfCPUe6+dJDf68MYqW+s",
+	"D8UV050Qta6u7Ck3Lll4BDOuDPlMZYUSCl6ejZRxn5qOdFSD2/1MWahKgR+/eu5VPDfKx7hN4yrbfNFV",
+	"I4vr5ecyZoMVOVpVU1HokdtcGjiWfEYpASWNmz8pWXAocWfGA8zADA1xc/2Tp+0pkaW8Xh+3Tcme0Obx",
+	"r6k375/PGMjy5SYeyXO45fQkRU2dREdixZu5+s/YMh3uW4aUjy53+ScIckviwGfoVTY+IT35eN9zC7xm",
+	"OpzXNA/4Zr98mp+DHV+9C1+lZmxFufk8DFBrXKPaBGvG0pugin4q/5zfqdM8buqpzkDbHgVPb4h+kHND",
+	"g1c7slCtr85603mRXGgt9BnP/9zMrfzlhqU9hrhYmmfDtlylqn3nJc82ClN9HWDmhqrpkRxFGcNiqfzM",
+	"Rr9FkCEm273m//WjNZL/+7fLSsz9v3+7BD+oYbolcqkD7XhCJuR0KokUQDNCRQssacZMWQWxNMm3xptr",
+	"6iQAbIuGT8hhoSLzHMEYsQPwvvDnAwvHJNvffx6ptdQ/0XsJxKUq3a3rs+rawMh0ceamAcO/f/vlIg9l",
+	"sGYTKdRxnqmqKAOj7arIF7VYfq5zIdLB58+qzsMVdU+Pti2aot+nKSJHypw+GA4ylpjP+MHe3gyLeTZV",
+	"ZpDc6O79s0rc58cXl8rIIKkxnxmcGB0MuFxacJZAIV0d+jbyoebY/QLhIwJV9gOccsGgeWt0UyQzm37L",
+	"UjMlQGSGCUKMDydE6pCqk7Iu86n6Wo102Rm/PKxOXzexkKYsjZxTVZPX/8lRCpnFoMFwkOAImQgqc5aH",
+	"KYzmCDwb71fO8ubmZgzVz2PKZnvmW7736uTo+M3F8Uh+IwkMi6R4K/I4vZKpBwNtf9INeAhM8eBg8Hy8",
+	"P35umsgoktkb36AkGX0g9IbsUYn+kqEIFVcyYl4tk2D3mHMkMkY4OJW4LHcD3Md52IP1DshXTplUtKZx",
+	"/uMR+Oc/nn03npC3xpLz+ugMRAlGVuRQIS2vTlRrCMwjqfmVypsbmvBqFU+I/FLPUrIelhAo1y2ltk90",
+	"WyOMZIXQHQsc+H/+72e7BxMyAu9zbP7DwPj+wGw8uJrCO2VssX8wfZSPXp3sjstTWm72ByJSp4nfHwAb",
+	"9Fbqio05QHK7kdUiMTfHoJHNhTmcxKoIjlAwntl7sc//a3MrylWlI/wUQjzb3y9ZtmBeJHjvT5OQnZvN",
+	"Gl1XzSsrflN6BdR5NiBRgfUPDn5/NxzwbLGAbKk3C9pnGA4ElIrW73nHKD54J+eVZtu966d78sTJnum6",
+	"PZIskreSQInr+i27jcOzpW/6uHJ30kTkdW7n615VJzGx2iq+avGqpoy6gsbhA5BzvNh/Wre229XeW2LP",
+	"BClL1Tf7++0f2TdDR0J8/uyjhIKsCEt+/4UXuIoCf+2ZJ6T18mWEqGVtRQZlZghf7mFkZdnbv1e91ol8",
+	"3XtcqD2AVe/vxf7z9o9+pGyK4xiRzd04dCfb+a5jFGHe6bZ1BbHSRbvPcyEMk9ECLShbuh9l7PIQEHSD",
+	"uNDtPMcTcpjIhp+xNwNkyg+bykQMGEWU6eK2tMJk1BjAVL+aGBHszzIhchqY3MAll+vOUDwGMnUIm7ai",
+	"H1AqnDLkzcqQRDsr/ppuMhykiC2w64x2jdENYDRBYGp0UZstOcPXiOSFU4fKRKRD7NQHE+K+UCF1hOaD",
+	"5eOmvh8De9gv9vfzNhX+OWpLkQDmwQw9forG5OW+dHdbjGD9vVrfIll6F0GJez/M/jAHRZfB4GDwnwyx",
+	"pXUxHxRcCjmRVpSzlqVdBKNG5Zq13I/rLWQYJPcKKSsxQwmZxtiqW16EgPDG/WqG9QUHGhrYESxDqqGr",
+	"Qecd1YdkN4e3Bgb7e2htF+bfehYLGKtEZNdQVp2DiawKLcsxKd1zt6Cs9mzpHCgVLSyJoQYGlSQ9KLoy",
+	"Tbb50/19zyf4dH9/v9klqEN9bkkaLFDiOZJsTRJo8PlR7Co/gyLP1A9Lh2fiB2nt0s3CvrQHTDPsujem",
+	"z7vmuvpIqFIa8joe2yGA6pjqBZVPR2FtZvMJlS3EBF/Kyh1V0cZNN9AWKMTFDzRebl6msQt5l1wVbHIb",
+	"mApduwtZyyJ5F0nrsEaKwCQPx1KVB2wwFibSo+OuY8d+8jt+ByLK9O5iwzbUoN/xu907pZlnz7p8ZFp+",
+	"yNf7yBz/JsjHIkURf3tRzEfVVK8LvThKCBKL6RCXK4hOflHpK9qShK4RW07IAoporixPiS3qFyOyBKmq",
+	"ITJUnxvBSU8rZS/dCsl0XB86m4n2WAJ6NSFIejVMWpA20uuEYB1yYuuMKGiVsOnkvmN9CGq5HG4duWv1",
+	"KWqcpFDjqqfholjxBmV31/LjhHQVIA04T3i5+n6Jy2gA1auyBqPpxV82z09aH0y1TRdy8blactDcjMIl",
+	"eXwOkRTmYKt8f7XPpcEDVUY5yEv7kL5pl9jJ2hNutGgVqiIxGIpRlHUR0RQBJcQVy0MqwndMf44Rk3bn",
+	"pWn/ati/taL97H7W6KKNlMbJ816n9mtE1erTe3ew7+UL/97qNWooR0J97o2RvMQbJBXGavtYsMPxNFHE",
+	"q9MNHQC7yta6wEJZ0xsmtuwRWv/WiMvzie2B1hg1jZnqTA9qU+veOAVTTb5Zxe1Mw904de6k6zGxayHW",
+	"OLXve+wxuXNrq7ndRRbakplLNcDv1gDgZULUr3+bikVtg9QAvzR4Y7HrK+eN0iDOSzvuwQ05XmQ29bVG",
+	"EtLMl1e5rxOBvHbqkUl6si+TiuVLII7166VYgArrnejCJ1dKQOC5vGNFhaH6gGZCJ8+aksiLMTh0U9jQ",
+	"Ib/l+YS4rsfmV/dqKparA8kcs/A4UUo5THi+ai6aTIjRtq19zv2Sx4Ook1jNmtZRGLowN3Wb0pCa2y60",
+	"xSKRBTG3Hzxc4ciexbrSkTHgKrYg8XTqhSu2ekbMx5acCngedoyYlOxz6gVGVp740KnkQ/ZUGdMLlKBI",
+	"UKaaqQ0+D9u/wgssOo8+yhh3k9/mE2db8Mjz906l1n5m/XGe3d0d+UMwn4U3Xo/qw5r37UhVOZXSKUE3",
+	"TYhcxWP9aRWTb4k712BINwb99G7AKJ1t4I5cWdlCF9itRtgX+/9s/0K60hMcifs3j2m0DBLIek/B3icp",
+	"oXzWNJQggUIhxwnS1BRavkpCenyQhBrVvSBmmQRQpcHI8Kei3jcoE4mvzHghnfECk5F3Xq1qzovBQSfw",
+	"9JmFEP+OsPhF+xdvqPiRZmQzkRn6cvsi4rBZ3DDlr3T4qIvv6oZtPyHxZaPa/tZwcXMNXzX+St26N/Km",
+	"WQB536Y6nhcS4PTTbiirv/zisHbLpJ/toZtM3eeXJf30pLsvTFzSFLZBcWkllblkWpPTtCrOjxpzgRT7",
+	"qMoPTkXeuGpcRdgOCvIdacb3rRK3vgaPOvDd68ArMvOVld4Oym4vIW4jwpslYiXEbUS7/dK02t6IfBtq",
+	"8G2qv21q75eAdPv3x5ofomK7eYX2iY1qt7Uh3ccdVNwtxdBtkVvukTgegva6bcpoL7nFLdgtpRG6IlQl",
+	"6d7NozPqGlVRF8RkUxgfddLCkXTVS0tn/pA01PLWc5QP49iKOmtxmRZ9tbDk7SquxaXuR3kNwBB+CIqH",
+	"+KjK3rEqWzz+DpTS9kjsfYp0zZh+Om6YpmwJpRblt0xb/V6M0CRyA7X8vV6HLczx4D20vXFrHWW1K1PO",
+	"tdc7xpr9bWGxD0UlhesgYlBNPbehwQE9tYaB7UiqN4rObouyevsIuU0ix9bQw6MPdct9qLcoo+zlGNaa",
+	"vuVozSRQ2dzGzT5EF65w+JfyHGmIm3JoagjPTP9QTKPh3a+CzTEUUBWO62KSSSsVwkuImtehazbMvIQC",
+	"nulVH40y3nF0Nch45/yQjDH+tivI7uHUikaYfPoWA4xb6naNL/ky92N4Ka0fZMRuzKO55Y7NLTm2ttBC",
+	"E9Pf+xTF6eomlhyGjuYVn3JWkkrcBCuaVXJ8fegmlc74swlTShNrzaXXO8KO/ftllA/Nj98D0VY2lXiM",
+	"qI+Z5PYQbluEgnvG9UeDyJYbRNaQIqjfdH9zOmRh2i7KZKH5/6NWyfdqz6Wrehm6goekZwb3XyGPEN6t",
+	"qHkGFmxRQauL364uGljvfpTSOkCCD1F18KOaesdqagC1u5JSpydn71NUN0d/vTYEbUfNNkiQK8mU4Y2s",
+	"oOsGsP+hK71rYOMm1OBOfD7Xh+8Np/bvlWsHqfDhhRqshau9NengoffRpe8SWbdOzNnfNjHnUfHecsV7",
+	"o3KRqZK5Zmi9maVDYL0pO/oYVr9XPZCuSnbhtB+Sdl3ceAXnC7i1oj7tL9GiSHvL3a4G7S90P6pzBYKw",
+	"9OUf3kNQlzet8frn14rezbx871OUrhEBX7jJbmpskRxWEt+8KVZUXL0ZHrzG2gubNqGjNvPOXDm9Q0zZ",
+	"3wZO+PAU0J6ot7LztnDMfVTO20XB7ZEEtgL/HzXKWxAdSkrhrYgOtxiYvsJbsV5Q+t2/GN1D0gvU8sAC",
+	"0kN774+/tpvHmnYMO00HQ4btRPJoydgLnEjnunWFA39QBeyKO6+gfBG/Vq317i/SVsvOW/B27RmFle7H",
+	"oFEFIcyZCwf4aNJYoUqdf4DtWN7C2fc+RWwNq0bxNruZNUpksZLs4c+xomHDn+Kx6no/pNqEbaOFk3rl",
+	"6O4SX/a3gy8+PANHbwxc2cRRPOk+No7bxsQtkg+2hA4eDR23b+i4LYHiFm0dK70d61k77uEF6W7uKBLN",
+	"A7N3BDe/AhoLBrFYw9Shv280cVzqJR5tG+Youho1zNU8IGOGsJhSQmODQStaL9SsLVYLtcLtmiv0Evdj",
+	"p/DWDvNSdUbWMPGYjXB72QjCIFodhtdxaJdloEaubrvQF93NZmGJYiXRwcG5gpVCffvgzRNtqLIJe0QN",
+	"b8xlyVvGgf174nQPz9TQjk0r2xb0kfaxKWweq7bh2b4vZDb2gsfo+i2Krt/gO3+LJoVu7H89G8JdPgLd",
+	"jQeach6Y0aCw6T64eUPZh6uE3nQuslBjLbDzdKmq8JsZ+1hQge+FjqSrGaF05g/JnlDeegXlSzi2ooGh",
+	"uEyLpaGw5O1aHIpL3Y/lIQBDkCEXxj3WSLhjq0QRgzvQSdsT4cSYwpermy2KAHa0X5RJrbFzloRNsk0p",
+	"RdUeS6CVVt0+G9trrdNbsEgpD91I0htzN2E1aWP4ufz8JaPg/n29BWVqf3jGmhWwemXrTemw+5hxvjDs",
+	"3iZBa387BK3HUJMttyNtUDLbgN7eTWN/VNb90+irpz9IDb1BN19bLe+okN+NLn7PangnqesxDODOFO5m",
+	"tG/g5RUFewO6dT+telV/gA/wCrEB9vNHzbcTCm1S3e2i6N4qVuzfK1t8uGpo6+O8tu65ita5aVTbkrf/",
+	"fpH8MZZge3XADQsLtxhX0OfFWC+64I7fje4BBo6iHliMQXnfXXGWwAXiqXwwVurhcJoicjSnDFEgL5rR",
+	"xNgz83kVImccMTCHHEAlNQJBxxNySpKlP/AGi7kanUi7BHhPU0QiNfk4Rtd7ZoGRWuBfkou/B5AhwBR8",
+	"KB5PyOUcc3CFE4EYBzQTgC+5QAt/kR00no2HIJ97VJh3CD5kUzTS3+0CSOIJ8ZrMsIwIvPC3N56QoHHm",
+	"jRvxsM0y7hzaDDIeJj4ASwzx0cOSqoczXY0v7QSoyML7b4A5gJmgCyhwBJNkqckNxZr+OlBdCOU1VG4D",
+	"t2TVyee/Y3tOaeGqi0Uf7WMAxd3Yc4iHZ0HiCb5we5/cv/uYbcJk1Wa28UmhH/t/4wPZx1ST4+FDNdK0",
+	"4sVKdpmclYbk6tu+6P27ZmIPxeDSAVl6WFhquEQnC8stoNC9v713jrYPwae+DeaRzby9e/Lw/tLaoMFW",
+	"3skkItWv0VSeiNUl7edKfsVECsb1DFsK34dy6UP18bldehOEN3xYml31GNtUvNKFPQQ9r7zlnGYODXzq",
+	"6Bv0PYuiAAJGE2RNgBFNkTRPgDjT6D0GUs0zCyEGsKYXeQyISFVPMkep8CE2VB/KX+UvIkELRARYZFyA",
+	"KQKUIPWbHgvmNIn59/ovCcQLEKMrmCWCA0G9cU94YTI1dAxOSYQATFNGr1EslwUKa85pgn7AJJYvp5hD",
+	"AdDHFDP5pF5J0IW3kXyDckdGwaqLLaii5Da/qAFo71itrYOgiIGHBRx+rKPYgfbNHg35N1D9yo+lHvO5",
+	"3WpLSjxoDAxwiHEQyZ+TG7jk4BqjG0l5mAF6Q+xoHtR5bonO2h+36sJ3pDutRCkPxnVd2PYtYvueeUok",
+	"yOHX8lAPUN4KpB+YMvpf5m8bQSjOn8nimgdmLQAj9fpQUnqX8ic4goRQYd+5KhGNgUGMLs/cEEQ0I/Iv",
+	"V4wuzKQwGQNL0S/2/wnwlf+1fBklAGbHVZI1p7LFZHsXr+s5kjxO0u3n++cURiYq085j8UCPtxw6Crwr",
+	"/hIjsqxnLi8RwffDWiRcIb6yBk94icjykSFsDUNQuPXIDpqN/GR5K7yA0QRNtTraIQwiSXIDkysSKjVz",
+	"O8W4OSDAU34f7U6r0pN3iJ0DC4q39KCiDEpb72+FKkYdNOL/uC04wLu7rTfP+LDeh3Gmsn6d+8O/gcc4",
+	"hLuOQygc/+YfJc/Y0x6wEAaqNU5h01Q5/NQNVwlc1KQgk7Z0Y/QRLtJEDo3RNUrk9kbeHaxS7aEGyPqA",
+	"iq/GubjxGIyuNLFeTEYLkvsBGg8Qw/e34TUqmEEf6SUYg9KdWIIxKdo3XwxJ6UoipRiUh0El2yIubgWB",
+	"Ppaj2NJUpNuWL1e0dkB/VQVaF5vHo7FjHaruZ+V4gNaNW7BqVPG8k23jizBq3Js1o8O79Gi+uA/zxQaf",
+	"lTXsFZ3sFHcimG5WIN2QQeIBGCLuw7EUsFzcrsWi3VLxteL4/r08KY82iI42iNuwPTzhJtKB64jl/PNO",
+	"1oiviBLuXaC7H+p7zM25D3vB2gKdA4OhBEG+Yo0INwuw0wQydWRFBjmXSkjXFRxQLHNs3dc1NTDtz+cW",
+	"xLsxMrh1/ztDbPkwbRPls28tuVlBhMfnOFSks3pMXjWXCr53LtNZnrZTvpyeo7zqNls4KrDedenP4Pql",
+	"m6ncxaPJ444qgZZPvoW2Vnwo9z5Fpcl6VZwoY0dbidDbIM8eb6C3xV6lRSv7fLDFRXti5WrlRcuLhMvE",
+	"fQG4tH/PzPqh5HXdMrNcU53opUakjP6JojYl4q60hzMNzaPuQERnpeFRWWhUFoJKwirawQpawRehDtyb",
+	"HtD8pjwK/ncs+NfRSd/HyxPxV5Ltu8r0dy2ArS7FP3jpvZ4FryOuN4vpW4Ue+3fNPR+cJN7wyveoVWeP",
+	"r1v9/21BtXsXDu4cvR8Dc7e1R8BtSxN7M0QkKaKRVb1rqxL8ZEYqIseLRSbkpp2xghOY8jkVupyIKtiV",
+	"MaZET4dnXMhN7bgdXC5TNAS6M/0QyNLxCYXxbugl0mvfk7Ho9jlEaYP3VKdrLZ/Co6N9g/Rv8aGbbWwj",
+	"nKBHv5CILqaYoLiucYj38hdoHfyXIfbdZmFzxaYhX4bI2aHJSM4wH0h3kfKGN4PjMjRq3VgSNQeA1xAn",
+	"6rnDuoZOS+nXAtY/JqSs8xTJE+we8aGv/CG0WC1tOUAxGvf6W2blhKuYZ+V6X4SJVgF6X6JVvngd01fn",
+	"/2ivvetADaHRt5aMVnl89j5Fq1ltFQ50Nd1ujPB6CEtyzdVNuGp7j1EYbSi3ZvyFnL5Z0N5KzNm/N6b7",
+	"8AIu2jFwFXuvOsx+Rt9twcStEDvujwIeLcHbbgm+XTllo11jez5E92P1ucPnqI/lR1HjgzP/+LteG8Vj",
+	"KKDqk7WaDShvx5pHAJI2w89LKOCZXvPR6NObQNzptRl8vLt5CMYef7s5WXi41tXIk0/UDaX1126hbbbu",
+	"5EDesWWntHBJt7c/Php07sigk6N4Han0fT32PsVpDyOOR2MtBpzN0lU7H3fr9TXc5Fj8UG027Vi1kq0m",
+	"nzYoHm8nguzfNet8KGaZLkjW3Rzj8aFOppitQbZ7lw3uHMEfrS5banXZmDCB0oQuF4iIFKcowSvrpG4e",
+	"4Cbq3I/2pfv4zAHxqKT2p+nKMbZqq4FbexBqa2jfHh0F8LGzIludukfIQnXlrdZsq9DetYpbA0FZBare",
+	"yaPWe0dab/XsWylt5adr71NcmbCPghzAkzZN+XYItoOQGtxoL905sNsHq0WvgKWr6dXVhcIK9heCV/tb",
+	"wMofjBa+EpL20MsDZ9tNQd9eZN0eoWcbKOWxDOUdaee3JvQgco0ZJYuVq8f4E3T3Hh/7yz6q5r1J1ju/",
+	"Np28cMMPQBdHRdSyRFLAuK7KtzdXHzeyt9Y2q9s+mHesZ1eWLt6C9/OjYn1HijUqIG0N2fR/VPY+IXLd",
+	"XWcmBZprUZY3TWftDN5bsa967OP0Q1WLO+HYSnqwN3NQ/91eVNm/D6b6UFTcjgjXXaf1uVMnXXarEG8L",
+	"ZIh7QfdHt/OWup03KHTQKUfsGk5xgsUSJogJTqjAVwa5ojkkBCWrKbmFuYGeHPizAzt9Zx/1qT/loZrx",
+	"jTfhkQX3UTnuzRi6HW2b3tz9zh+CVt3jNHI67orjXdXxzkD08JB3g3Gb1fiOO7hjDb8PVMU7P+18y4+m",
+	"gbsxDXSmu5Vof6PP+94n2mnhPhaJ7mynxV5xh7ym/Tk+7XxOfawc3Yn3odpAbpeYVjKedAYpaFr52rB6",
+	"/4t6Ax+KJee2yaa7Caj7c9DJQPQVkM92y7RfFj0/hlTcjeVp62TaNRL4i3spZfL3MkQ9ZvRvhDd0Su0P",
+	"3drDMyVVkv1D+LiagaiY/t/TFLT1ZQAC0N6niac2+a866tFucy92m3J2X5jQVn65SpYXl/C6mpWlU1mB",
+	"WyLYnmLySoUGAlTxaBDpjqUbMHPUFyP4UtBq/z45uaHQh2l+6IqkqxoVehQz2GJk3R6ZZ//+ZZ7HEJQt",
+	"DUG5PSHJtMg17UymmMSYzFbT8M1Uef9yM9nGOvaaBrqmHc4PFtbH7r13Yz0IHn+bAaEOKR6CEaF27znp",
+	"1qB0V1tCzQo97AlBALbZpBAG+I6tCg1AFK/rrOaCHoB1YVMGghoc70JE6zyBe5/S0LQ9KivUEWeLweD2",
+	"KLLzI1fdch+zQR3OP1TbwRoIvJIJoWa9oBnhy0K2/e1h4A/FprAW8nY3LdTxyqJ5AbzlKAaCAhhfQxIh",
+	"8F4i/bjIqN+DHVUPn9EFFQhcJfRmF1CmXKUz+4kX0y/fLDzj78fmJ3pDEHsPIImrY98DyFDebrXO3rH1",
+	"VLVVYtkWUfUDMIBsyiRxx2LZRkwSt2WKeLRB3I8Noqfx4SEaHeqNDatbGQLWBfCGsoUioShTKfHyCbZc",
+	"Vt48o0mC2PcAfUypfMTniCHVooZeXakyPWiBBUghw2LZzVbx5Rgp7tc60eX9ezRHrGqOaCSvlR66suFh",
+	"HYtDH0vDvcin69oWHm0K7Vi4CSNCB+PB9uHP/j1y1AdqH9gcO1xL4O9R5e3MLvcYT7wqWXQUw/mjJl0v",
+	"rwfk9P4Ceo/yb2aNL0CIvifpuYnJP8YG301scOqQNEAa/V4TJ1WvIE53E6PvVv5ZVXB+4AJzHZddXUJu",
+	"koy3CCX275I/PjDht/bp7u3+6hRNuxXIdc/P/Z2i82NY7JaGxW5OPlB919dyMakZOie0Gjh1C+1HzXNV",
+	"qpXn19UJpK/4AXmAhEGuEm3Ytu39VEs5Wf+wUrnWF6BiKjDvR83Mlw6/PercH90zvd0zQmNeDe73fxv2",
+	"PqWrqI7q+rrpjxujlc4ynVxxRT1SfvrgnS/NOLaW20VO3aRZbiGy7N8La3woqibsjHX9tU51kH1Uz+3A",
+	"vi0QB+4H5x/10VuQH0phjbcmP+zl+ND4PqgYZksHQH+kAqZWfC0u9LJf65uht3dupm8lITPpQ/HO+3te",
+	"E6k3kSm8ToawO4ewYeV+koOP7F8fcGhuv7zgLysf+J5iAxoSh1fNGF49U/jLSRG+39zg9uyT84eXDLwV",
+	"4QT1qSqr5qhUcobZqsnCPZOE7yW1bL204PPHdGBlPeqDhSvZkLrk/W47/uzfIzt+KCalfojY3azUnMNb",
+	"Y1naQoTcDsHkPinhsc733cQx3I9gsvfhO84QpxmTM6DrTu3Vf8mmiBEltOgvyjYpOyPARNmwSnt7wvMR",
+	"giHU4XX65Tt+bj45vr7DZuy13GFYPpzDsxMwYzRL5UusN222uIMWqVgCLpikJ8oAXWAhSUqeWkRZPpTv",
+	"DoYDLGf7j7QhDIYDeaWDg4GaeDD0iFzZJg8GetLB5zA814hxTEkAovFsDK6f1i1nvhuUOVMvAH7BJC6v",
+	"XLPeB0zi9RaTN9NxMfU/fRa7XcnER+om06UdaUju0VZSFWZ++c5jLAXOtA3MNaEdLKVyUMXCT+NbYaSv",
+	"6Gz72KhPyCmNa2g4pfGbvmTcuJQkZogJYrKyzBUS0dxcBaOLMTi5sjx7mP8ZwCTJv+P2iuRtQcXT5Y3K",
+	"L6R5DSAYzQEigi2BgLOZtWObr8c1+3QD+vH+N9liimRaPeAooiTmgGMSIXAzx9Fc7pDP6Y3aSc26aviF",
+	"/raw9BVlCygGBwNMxLcvBsPBAhO8yBaDg/2hhQsTgWaI3RHnPKOxRORGrw+N9WYfeWbVO0Rjn+lsA6MU",
+	"DKEOLqU5RgyyaI4jmIBrLLtqXCmaTPA18mVUNzOIUZrQpaY9j51yIOs9mb9iXj6EIcAkSjJtpp3jJPZm",
+	"3JHaL47gBRJ8CM5ozIfg33TKd/ux4kuG0NdsgClttYlYC4+4QoVHqm2WdOQh3SL56lU24/I1EK/j+7WT",
+	"1Ll+9a/34wK2qz9oD3DoAto9wTWY8RBi9es375NvGK+7u3zDa/Ty/YZA2G4fcBDiO/cF10NRo+I/Vope",
+	"w78bPsNOtLTWk7j3yf5wvroDuAYBrCcYXM7zP15hAhP8F2IAYTFHDESQRzBGOm4wIzFiyVIOPEfy3yi2",
+	"pv0dhqRWeUYTHC3/pZdX5VHnNIl56edz9R+79U7oW+MK3d/bdZ3SNaf+cL3Ta9DQiu7q8Io1WtSXhXL7",
+	"2/SUPBzH9lo43MfTXXPSncpWl56MTnWrffb8HuyVZpKRvMe3Wtn6C6C/7ZIlt4oBPJa37uGSv2tZcjN2",
+	"lduzpzwaUu7LkNLXgvIgLScNFpM1TCVdS107ltu91rUOxHhPI08EniEiqRC9lx7F66fjZ7sdLTJfkCnm",
+	"nm0wnR7MR6PLykaXZjJc7WWsmFfWsqu0RdZvnrB6i7ZrmzEezRddsHEj9ooudootxKL9e2WwD9UUsUnu",
+	"uJ7CsLleOOcOnscuOHerH5wQLiCJOisIj1FQTZpESINYQXXo71X9EoR3i2r3Jb0X1695XR7F9t5iew3O",
+	"93yJcgF9Fcm84OF0l5m7OKcJjT5wLdNiSkBGBE5UuJ+O3asxxClDd+k3rszcUYKg/DBL27SAOxbcVpb7",
+	"H7q8X8u61xDwGwX7bUKM/fvhtg9Nhq8XD/o7DEsOwteZgGqA7mfr7l+aGK2AUeJk4BrDOtNjm/funpF3",
+	"W6SUe6KbRy9cby/cRqSU1Wt85+HWcgoAryFOpJfc5v20FPs+99zzj9W+1yCvLuW+i3f1oDxh5YLfRbzr",
+	"rcj2LPntr/YlaLT3UfS7unbNG/FY9ntFL1SpbmeZBFZ4MfY+MbGKVtul9PfGaaa7ULZK8e8iej54H1ML",
+	"rq3nXaqt6brNOLN/T5zywbmTWlFvBZ20exnwLUPBbZAR7gvzH2uB314t8LsQKjZZDrzf23GnBcHv4QVp",
+	"rwhepKQHUhKchTa9Lm5zFDEkGLpCDJFVIxP0JCCfpXM3tQv15Xm+/KONpT+5FM+wzcxSuayHYGmpbjon",
+	"nAoOdrW3lCftYXIprbnNVpcyqHdseAkuX7yVi/I9PJblvpuy3GUCaCaq1R6kvU+8OFUPi06FQFuMOrdB",
+	"le0PxUV1f31MOxXsf6jWnX7YuJKNp7xEUFTffizav1fu/FBMPn3xsbvhp8LXOtl+thIvt0ReuV+KeKzW",
+	"fTfVum9DXhEMYrGa2qw/7R2UcKlXfNSUe9OmOrk2/dhc6ANQioVFJEsEBrO66r/q+x5Kr5p+m1VdDeAd",
+	"K7jeosXDVj886rJ3pMsKg5wVWujzDOx9Uv/bQ0XVNNSil26OcNqZ8aXdQB8dVKPqQ1U8a1FnJR1TzRZU",
+	"LLcLDfbvigM+FH2xAY26q4aan3TSB+8dne71Ab8z9H3082/bi2+0wY2/+JuMCGh5Be40BOAu34J237+m",
+	"qgfi8xf+ZldG1RvKPsiqhGkCyYoufjsF0HMEyytdLlPZ1iFZAkoQSBFrs2T8ZiY903A9WjR6k0vhBNss",
+	"G6U7fAgmjvKWcxIq4V5Xm0dxwh7Gj8J622wEKQJ6x8aQwOLF2ygMeDSO3JFxpIj1TVS0yoO09+nGn6aH",
+	"9aREjS1mlM2TYPtL8Ft5Z33MKkVkf6jmle7It5K9pTh9UOTebsTZv3vua+jtoVhm+mBgd1NNiXl1stls",
+	"HSZuhfyxf1/yx6NtZ0ttO7clsLCMqK7OvJORx0HBMqLbTPOqjx8cBsaBaYZlVxeOrhGDCcjPQXcxlVNw",
+	"uEDyhwUWQ6BKEmsb0oISylBKQZrxebPifZ6Rn/R2HnXvlXmFPcTO6neODQ9KBfe2XaXHNUhx75P6X6c2",
+	"tNi2AsSmev1KkoKzGUMzFSokoMiUqIgFl0N5o2RoceCOn2S77B3Lh263TSJifrwPTkx0W98Uonex2VpL",
+	"rapE7+s18vuOoWXeBfM7RuUHXBTWO/U+b8iDez3q3o06y+0lw7MZYtZ0GyKMNmvteUa+BFutBPOeLLVu",
+	"6YZnwJhpH0Oab9EyyzJSQx79X5u9Tywjq5hh5WV3NMJuirJ6CUurGmDVxh68/bUexdYzvAb5cFG43jJU",
+	"2b8XNvoQ5ej6J7+/nVWeYS8r61Yg3hZIDfeD7o9ZUXdsK70dEWIvgiRCiQQ1LKabe9ImUz04Ubil34vC",
+	"MwEui02y3Y+YA4HYwkQtQRKr2ZTwSRepFlScmcdu7UgvhmLAEOSUjIF9sV7s/xPgKzfHHHIAE4ZgvHTz",
+	"xQHdQc33+GwV6Vgde+FeDVND8VbT5t1XxFRndOv0iK4lTK0WpV+yKWJEEY7+ohyi2kd+O9Zr3ic5DMsb",
+	"/VG1SbKbk+0gIf+gdJfBcIDliP9Im9RgOFB/OxjI3wdDj0JUdbGDARdM9/Ndl+KwQAveg/TUqR4TwdS7",
+	"aKCBjMFlK1EaJFj1Of0CDbLIouDGCSqhs3ZykoOaKCj365UCUsArOtPNT66QiOYqJvca1Q3/HhAKIIvm",
+	"+FqOtJ8yBQWKFQTuBevk15DLbyXhqs1tgmyH4TvTCxB0gxgQc0hUiWD5gl0jEGf6vKRdnaOIkpjXrM4x",
+	"idCFG5JDcUXZAorBwQAT8e2LwXCwwAQvssXgYN/RMiYCzRC7B9byis5WYyyKGB4QW0no7FaYCkM8W6Am",
+	"qVn+zgEEVxBLEbYAFSZAacKaTUgXphkliYGPwaX8H4nVwsm1Ss1CsZSeGQIfUCqUGE1Johsl+xMYnjJC",
+	"H1GUSVEYXGRpSpkwHOaQzaiTsrmao1G2JlSY+YfgBnIrLcr/pMyMKALAUERZHBLC9cE8CuEBIVzj1KP4",
+	"HSZtjTiBR9lHvNshdcloaym9JgHB3aqLBbI/DfPsMt1KLZHeUg6gnKPw+Gt9miA9WwQZw0jr4TRFJJpT",
+	"hug4RtcaxJHUxwmhQj99KcVEGdig0J8wLLOGEj1xC7XnYCyRCBGxYMvtpeE7c6PZ6/Fv/ZFqi1Qr2PLW",
+	"dWYdDtQp6I+qiL3ERhBJ+ksRG3GBUi+qaEU9+kLD8QBeNL3TpkTQwvtmLuhLlSK5vdf1MXedWKH+xahy",
+	"OB8jSFdG965RPw8q4qdvtE9RTKoE+/TP1PwSAn/uK+qnkR8/ZmXebezPZp6NPAtzlcifjlE/dyy5rBzv",
+	"89BjfW4jzqdRtt0mxNi/W3b50MJ6NhnS0yuc555x7L6lgDtG68fcyC3PjbwVsWGTNbA6PRx3Wgnrjp+P",
+	"9mJYjtoeSD2sm9J+10XhhMJ49YJY6uuAZjkEVE2hamFdKW81iqWI7PZcb0zREN0NOh/Zvz7w5Ct55l1s",
+	"MPpuHhv+h402FnN9itR/61NcS37R01gjP9l2Y42C8R6MNfm61YdDHfWjsebujDUGUUME0vPJ2vtk/9nT",
+	"WKPuvIOxZmM01U2osjvpa6xR23nIxpoGlFrZWCMnqJW5tw0x9u+WXT4kY00jbvUz1qiz62ys2QIcu28p",
+	"4I7R+jHX6u5sL52kAJikc/h0D2aCqlJQ9eFhZxpgxAEmEV0oikPTOaUfXN4GowsAiQzxtEGaMyxAyug1",
+	"jhEDggKhSyUAud4CChyZAlTjCZEhSIXhmOfDlIYbI4EiOauLSTf0A+YIxojxgwkZgZ+w+DmbHoD3/5/R",
+	"z9l0dIFnBIqModGzb759bwa8gnrAT1gkcDq6pB8QUb/9gMU0iz4goX5WeQ+jX9DyPdjheEaQ1hgqU7/f",
+	"nZCJzJJgyzL4c0Qk+ALFBwYyFanj1gHXGIKfXx8ejS5+Pnz2zbeA20kn5BoxfGWIEcAZxITrCLiIkis8",
+	"y6Syb69Atxwbms2pWbHggM+hHCXkBscT4lLg5DZoJgAE1zDBcb7qnhqqLGRyJXfkbls6IvdP9dfxhFS4",
+	"68+QxAk6zAT9QeFThb0WscqciduGhcNcKci4At8Aos5OQSyR3HyrsW9s4+L1h3lgfAAN+kXpmyO1IOoD",
+	"6gbeK9gBPB8J+0GWY1GBEkcf0LIGwPyLVrAc8q8LUxC7wc57PofPvvn2X5Nsf/95NEcf1T/Q+10HszvJ",
+	"HlAX7ro9iWq15xfGMdZ2tzMmsV9gxPUDO6ziTk469kBSuLS8WcNEp5Ke7vzB1uCoe260/VqwzQNwj6/3",
+	"fTytKMoYFsvBwe/v/IdW8zkwC1yw9+jmfDDw6DYo4DMsNEfvYDROEgWFGQ/a7FnSjvYTNt2D+ebsWbeE",
+	"pQ5UCXcTmloDqncWX1xMmg97jkTebXUOS3MTqaec04xFUm6IkS+UYFpbmMqtuc0GzxKojr3crfnTW78e",
+	"O3/KL+TREno3llDoUUEdNa3Gk/c+zewkPcyiHk22GEY3S3ztxomf/N30MY16WP1QjaObxjKGEgQ5mmIS",
+	"YzLje5/MH37Qf9CDjBrdJZfr33Sa68sxShO6RDE4YpT8m06fcGWRHf9Jp5dokSbKdCA1XEgAvSGIec3D",
+	"pzD6oFT4ObKfD/OcsCmaw2tMMwYgB+8/ZFMUicSwOvAnnYLRSELxr4hR8ied7mmpX+7diP1jcCozQGGS",
+	"0Bup184RMbquuZcnPLfwSblZKthmNp1eZg4FxWrPO1IXkypwSmO+C2CaIshssgFD5kUUDCGduiZzsBP8",
+	"ASkDBhVzxOwuR/Ik1KRVejWlH88Ld2S+uyPiPa/gxx1IZWaLbvsNbdTmSN2HffUcLtpTenRzF9jKa0gy",
+	"Ze2ypjJFBBrPtQ/FMARgWITHdIqo0JfzdFY4AlEq5luwgATOdAyKhFuzQHB4dqIpD/MJ8SrSH8NoDrBA",
+	"C2lSTLLY5IR6FWHMBDEU0JWlkBg0IXKggGyGhK1fcSLQgoObOeX2l5H6xU5iU0KX8gFGiEwIX5LI5KHT",
+	"BRYF9EzhDIXsW1JO36Tu9MUGtHgH0UUtK6hkX1NmkfzqaScmcSJTkReIqIz4qvJXVfz6an16Bv0aco9y",
+	"MNc2Co6pfMnMI+hTz4SopO0q5aVJJn84y/jc/EUVc5CUwwEWViDILdITgj7q87EgcEGZaj9htSQrUagH",
+	"XL8K2D72RDCaWJg4lX/h2QIxVavBk0ZEvsXpEnxAyxCt6tP5UvTYe1VizSEFCPjiUWu9La11E6zDKbsV",
+	"FWQ1/cOpuLyvflvUbfOXtEDUumSi/27X6MB3qgCvpv1etGm+jz7t+6QMp6A3UMawTdQ1SF0r1w6N6Crd",
+	"4VLb9CXVCXE0UJRU85olL2zNksDbuMCcy2kp86VdI9NWX+qyeAu0dBt6F39CYtvIa//uXrKrPK3m69Eh",
+	"N0EwMh6rhVpaorHMx08MHeRlgzJ5nVK9UoV7uIACjcEvaCkFU8QRERNiREAXzmWfk0wAOJVDqmEfUxov",
+	"lfaWsowU6K1CHtpUlYuxQ1e7t0R5KkqilTxjijS1KXABVeEehDpGMSEVTjG2/1bGq/IzqLaBF4tMSO4Z",
+	"Ilod2bMFdLt5+dffWi/59w65xmPk2na+8ibgrVX+nSOYiHmrcev0F0vyHLFrHcalP12OwVtu6g7KyqgE",
+	"caVWT1G4tOnPesFWnBXoo9hLE4hL2Io+QrnpwcHg9JfBsBK+EsDTErzN4QtqDIjmKPLjFU7tLuyx0RQR",
+	"mOKxpabWZMvTFBFp73s+3nfR3mpGE1OGuTUH/vvi9A3Q1UmDB2hmukhRNFiT8ovg1oMY0yiTWBYOzQnP",
+	"Upih8czl+xr+quECVNHM1pM/l6OqmKs+ljYaGEUoFfbh5B4qyyG4DZfV9JtAZTtRD2zWB9B0ruduC63o",
+	"fI0Yxx0w2YwDmGgElf+GUxkxKQ9YXaACMHhav5pFbvG5Mks0GV5/rW6hFTsN5ly7DYQPsjjLp8EUQYbY",
+	"YSb56+/vpJSgJwoFfL6iEUxAjK5RQlNDaxlLBgeDuRDpwd5eIgfMKRcH3+1/t69kDgNFeSrNw4Y5Cmuh",
+	"zt4dIrEqHcnz+EBvG9XIRScjGSHOAGc+db+GPj1jVLIJ70ObWphbWvKpzOjQRC5TNjBVaj9zE7nRoamO",
+	"yTVmlCzCk4Xg8r4ITfgSCqjbUXvTSRZykyetSPey+ruWbb3J3dehqYvdrkvTH53sHb3UceISmRnkgmWR",
+	"ie80sxcmCK1wOpUoCac4wWIZXGZBCRaUqUKj2iE80941izuVGYIXmGRcIDbiEU1RDEJn5t2fHtx4NKUJ",
+	"606qMmnriZQmbjygyuwrHYZD10upAQkTcMBBjK4w0cYV+RfJrgAiM0wQYryydGGWDqteMoiFt5qtTE+V",
+	"BCtdq5yPokwopTOiJEKMVFdVszRS7IqbatvNmuDXw108JVfwoLiSojpLEjYbg8xcjeIwzoXW+6lcKM8t",
+	"VKXi0PfnNEGjKZRiC1QamLMrG9CUrqRf6hDiHvojBsEo/2qk9lwF+TJ9FuWclcLcJsq3Oq9RH3PPVQi4",
+	"knmhjkUqJuvHciokw/pBK5yirSBQ/77YKIIgkdtRJqAgeB/FKITgPOV4hMCbkr8YKU5RgmvYTj7uzAxr",
+	"ZfIAJogJZZXJBfxoDglBSXCNwteH6uM33rdH+lNegzsFQ7F7VOoDb/N1vVCxWvTxpoWK5HM6kuivrG2p",
+	"ZsMlpOpA++cmGmottuxPEsaXdRbpOnuD2AR29G/xqChESKkFkRiRCCO+W12ycbkmKrKDGomoNE8zNRXm",
+	"a6AqK452mdWMrUz67vP/fwAVRmlKb5sFAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
@@ -83,11 +84,15 @@ func (h *Handler) ListActions(
 // An explicit subject_context wins; otherwise it defaults to the authenticated caller.
 func resolveEvaluateSubject(reqSubject *gen.SubjectContext, caller *authz.SubjectContext) *authz.SubjectContext {
 	if reqSubject != nil {
-		return &authz.SubjectContext{
+		subject := &authz.SubjectContext{
 			Type:              string(reqSubject.Type),
 			EntitlementClaim:  reqSubject.EntitlementClaim,
 			EntitlementValues: reqSubject.EntitlementValues,
 		}
+		if reqSubject.Claims != nil {
+			subject.Claims = *reqSubject.Claims
+		}
+		return subject
 	}
 	if caller != nil {
 		return caller
@@ -95,6 +100,34 @@ func resolveEvaluateSubject(reqSubject *gen.SubjectContext, caller *authz.Subjec
 	return &authz.SubjectContext{}
 }
 
+// toEvaluateRequest converts an API evaluate request to the internal model, resolving the
+// subject against the authenticated caller.
+func toEvaluateRequest(req gen.EvaluateRequest, caller *authz.SubjectContext) (authz.EvaluateRequest, error) {
+	var authzCtx authz.Context
+	if req.Context != nil {
+		var err error
+		authzCtx, err = convert[gen.AuthzContext, authz.Context](*req.Context)
+		if err != nil {
+			return authz.EvaluateRequest{}, err
+		}
+	}
+	return authz.EvaluateRequest{
+		Action: req.Action,
+		Resource: authz.Resource{
+			Type: req.Resource.Type,
+			ID:   getStringValue(req.Resource.Id),
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: getStringValue(req.Resource.Hierarchy.Namespace),
+				Project:   getStringValue(req.Resource.Hierarchy.Project),
+				Component: getStringValue(req.Resource.Hierarchy.Component),
+				Resource:  getStringValue(req.Resource.Hierarchy.Resource),
+			},
+		},
+		SubjectContext: resolveEvaluateSubject(req.SubjectContext, caller),
+		Context:        authzCtx,
+	}, nil
+}
+
 // Evaluates evaluates one or more authorization requests.
 func (h *Handler) Evaluates(
 	ctx context.Context,
@@ -113,30 +146,12 @@ func (h *Handler) Evaluates(
 	// Convert API requests to internal model
 	internalRequests := make([]authz.EvaluateRequest, len(*request.Body))
 	for i, req := range *request.Body {
-		var authzCtx authz.Context
-		if req.Context != nil {
-			var err error
-			authzCtx, err = convert[gen.AuthzContext, authz.Context](*req.Context)
-			if err != nil {
-				h.logger.Error("Failed to convert request context", "error", err, "index", i)
-				return gen.Evaluates400JSONResponse{BadRequestJSONResponse: badRequest(fmt.Sprintf("Invalid context format at index %d", i))}, nil
-			}
-		}
-		internalRequests[i] = authz.EvaluateRequest{
-			Action: req.Action,
-			Resource: authz.Resource{
-				Type: req.Resource.Type,
-				ID:   getStringValue(req.Resource.Id),
-				Hierarchy: authz.ResourceHierarchy{
-					Namespace: getStringValue(req.Resource.Hierarchy.Namespace),
-					Project:   getStringValue(req.Resource.Hierarchy.Project),
-					Component: getStringValue(req.Resource.Hierarchy.Component),
-					Resource:  getStringValue(req.Resource.Hierarchy.Resource),
-				},
-			},
-			SubjectContext: resolveEvaluateSubject(req.SubjectContext, callerAuthzSubject),
-			Context:        authzCtx,
+		internalReq, err := toEvaluateRequest(req, callerAuthzSubject)
+		if err != nil {
+			h.logger.Error("Failed to convert request context", "error", err, "index", i)
+			return gen.Evaluates400JSONResponse{BadRequestJSONResponse: badRequest(fmt.Sprintf("Invalid context format at index %d", i))}, nil
 		}
+		internalRequests[i] = internalReq
 	}
 
 	decisions, err := h.services.AuthzService.Evaluate(ctx, internalRequests)
@@ -167,6 +182,170 @@ func (h *Handler) Evaluates(
 	return gen.Evaluates200JSONResponse(genDecisions), nil
 }
 
+// ExplainAuthz evaluates a single authorization request and explains which policies decided it.
+func (h *Handler) ExplainAuthz(
+	ctx context.Context,
+	request gen.ExplainAuthzRequestObject,
+) (gen.ExplainAuthzResponseObject, error) {
+	if request.Body == nil {
+		return gen.ExplainAuthz400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	callerSubject, _ := auth.GetSubjectContextFromContext(ctx)
+	evalReq, err := toEvaluateRequest(*request.Body, authz.GetAuthzSubjectContext(callerSubject))
+	if err != nil {
+		h.logger.Error("Failed to convert request context", "error", err)
+		return gen.ExplainAuthz400JSONResponse{BadRequestJSONResponse: badRequest("Invalid context format")}, nil
+	}
+
+	explanation, err := h.services.AuthzService.Explain(ctx, &evalReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcpkg.ErrForbidden):
+			return gen.ExplainAuthz403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, authz.ErrInvalidRequest), errors.Is(err, authzsvc.ErrExplainNotSupported):
+			return gen.ExplainAuthz400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		h.logger.Error("Failed to explain authorization decision", "error", err)
+		return gen.ExplainAuthz500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genExplanation, err := convert[authz.Explanation, gen.AuthzExplanation](*explanation)
+	if err != nil {
+		h.logger.Error("Failed to convert explanation", "error", err)
+		return gen.ExplainAuthz500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.ExplainAuthz200JSONResponse(genExplanation), nil
+}
+
+// SimulateAuthz explains an authorization request against the current policies overlaid
+// with proposed roles and role bindings, without applying them.
+func (h *Handler) SimulateAuthz(
+	ctx context.Context,
+	request gen.SimulateAuthzRequestObject,
+) (gen.SimulateAuthzResponseObject, error) {
+	if request.Body == nil {
+		return gen.SimulateAuthz400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	simReq, err := convert[gen.AuthzSimulateRequest, authz.SimulateRequest](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert simulate request", "error", err)
+		return gen.SimulateAuthz400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+	callerSubject, _ := auth.GetSubjectContextFromContext(ctx)
+	simReq.Request, err = toEvaluateRequest(request.Body.Request, authz.GetAuthzSubjectContext(callerSubject))
+	if err != nil {
+		h.logger.Error("Failed to convert request context", "error", err)
+		return gen.SimulateAuthz400JSONResponse{BadRequestJSONResponse: badRequest("Invalid context format")}, nil
+	}
+
+	// Namespaced proposals default to the namespace of the evaluated resource
+	namespace := simReq.Request.Resource.Hierarchy.Namespace
+	for i := range simReq.Roles {
+		if simReq.Roles[i].Namespace == "" {
+			simReq.Roles[i].Namespace = namespace
+		}
+	}
+	for i := range simReq.RoleBindings {
+		if simReq.RoleBindings[i].Namespace == "" {
+			simReq.RoleBindings[i].Namespace = namespace
+		}
+	}
+
+	explanation, err := h.services.AuthzService.Simulate(ctx, &simReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcpkg.ErrForbidden):
+			return gen.SimulateAuthz403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, authz.ErrInvalidRequest), errors.Is(err, authzsvc.ErrExplainNotSupported):
+			return gen.SimulateAuthz400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		h.logger.Error("Failed to simulate authorization decision", "error", err)
+		return gen.SimulateAuthz500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genExplanation, err := convert[authz.Explanation, gen.AuthzExplanation](*explanation)
+	if err != nil {
+		h.logger.Error("Failed to convert explanation", "error", err)
+		return gen.SimulateAuthz500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.SimulateAuthz200JSONResponse(genExplanation), nil
+}
+
+// defaultDecisionLogLimit is the number of decisions returned when no limit is requested
+const defaultDecisionLogLimit = 100
+
+// ListAuthzDecisions returns recent authorization decisions from the decision log.
+func (h *Handler) ListAuthzDecisions(
+	ctx context.Context,
+	request gen.ListAuthzDecisionsRequestObject,
+) (gen.ListAuthzDecisionsResponseObject, error) {
+	query := authz.DecisionLogQuery{
+		Namespace:        getStringValue(request.Params.Namespace),
+		Action:           getStringValue(request.Params.Action),
+		EntitlementValue: getStringValue(request.Params.EntitlementValue),
+		Decision:         request.Params.Decision,
+		Limit:            defaultDecisionLogLimit,
+	}
+	if request.Params.Since != nil {
+		query.Since = *request.Params.Since
+	}
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+
+	records, err := h.services.AuthzService.ListDecisions(ctx, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcpkg.ErrForbidden):
+			return gen.ListAuthzDecisions403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, authzsvc.ErrDecisionLogDisabled):
+			return gen.ListAuthzDecisions400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		h.logger.Error("Failed to list authorization decisions", "error", err)
+		return gen.ListAuthzDecisions500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.AuthzDecisionRecord, 0, len(records))
+	for _, rec := range records {
+		item, err := toGenDecisionRecord(rec)
+		if err != nil {
+			h.logger.Error("Failed to convert decision record", "error", err)
+			return gen.ListAuthzDecisions500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+		}
+		items = append(items, item)
+	}
+	return gen.ListAuthzDecisions200JSONResponse{Items: items}, nil
+}
+
+func toGenDecisionRecord(rec authz.DecisionRecord) (gen.AuthzDecisionRecord, error) {
+	subject, err := convert[authz.SubjectContext, gen.SubjectContext](rec.Subject)
+	if err != nil {
+		return gen.AuthzDecisionRecord{}, err
+	}
+	resource, err := convert[authz.Resource, gen.Resource](rec.Resource)
+	if err != nil {
+		return gen.AuthzDecisionRecord{}, err
+	}
+	item := gen.AuthzDecisionRecord{
+		Time:       rec.Time,
+		Subject:    subject,
+		Action:     rec.Action,
+		Resource:   resource,
+		Decision:   rec.Decision,
+		Cached:     rec.Cached,
+		DurationMs: float64(rec.Duration) / float64(time.Millisecond),
+	}
+	if rec.Reason != "" {
+		item.Reason = &rec.Reason
+	}
+	if rec.BindingName != "" {
+		item.BindingName = &rec.BindingName
+	}
+	return item, nil
+}
+
 // GetSubjectProfile returns the authorization profile for the authenticated subject.
 func (h *Handler) GetSubjectProfile(
 	ctx context.Context,
@@ -0,0 +1,190 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	authzaccessrequestsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/authzaccessrequest"
+)
+
+// ListAuthzAccessRequests returns a paginated list of access requests within a namespace.
+func (h *Handler) ListAuthzAccessRequests(
+	ctx context.Context,
+	request gen.ListAuthzAccessRequestsRequestObject,
+) (gen.ListAuthzAccessRequestsResponseObject, error) {
+	h.logger.Debug("ListAuthzAccessRequests called", "namespaceName", request.NamespaceName)
+
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+
+	result, err := h.services.AuthzAccessRequestService.ListAccessRequests(ctx, request.NamespaceName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListAuthzAccessRequests403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListAuthzAccessRequests400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list access requests", "error", err)
+		return gen.ListAuthzAccessRequests500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.AuthzAccessRequest, gen.AuthzAccessRequest](result.Items)
+	if err != nil {
+		h.logger.Error("Failed to convert access requests", "error", err)
+		return gen.ListAuthzAccessRequests500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ListAuthzAccessRequests200JSONResponse{
+		Items:      items,
+		Pagination: ToPagination(result),
+	}, nil
+}
+
+// CreateAuthzAccessRequest requests a role for the caller's entitlement within a namespace.
+func (h *Handler) CreateAuthzAccessRequest(
+	ctx context.Context,
+	request gen.CreateAuthzAccessRequestRequestObject,
+) (gen.CreateAuthzAccessRequestResponseObject, error) {
+	h.logger.Info("CreateAuthzAccessRequest called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.CreateAuthzAccessRequest400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	arCR, err := convert[gen.AuthzAccessRequest, openchoreov1alpha1.AuthzAccessRequest](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert create request", "error", err)
+		return gen.CreateAuthzAccessRequest400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+
+	created, err := h.services.AuthzAccessRequestService.CreateAccessRequest(ctx, request.NamespaceName, &arCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) || errors.Is(err, authzaccessrequestsvc.ErrUnauthenticated) {
+			return gen.CreateAuthzAccessRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, authzaccessrequestsvc.ErrAccessRequestAlreadyExists) {
+			return gen.CreateAuthzAccessRequest409JSONResponse{ConflictJSONResponse: conflict("Access request already exists")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateAuthzAccessRequest400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create access request", "error", err)
+		return gen.CreateAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genAR, err := convert[openchoreov1alpha1.AuthzAccessRequest, gen.AuthzAccessRequest](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert created access request", "error", err)
+		return gen.CreateAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Access request created successfully", "namespaceName", request.NamespaceName, "accessRequest", created.Name)
+	return gen.CreateAuthzAccessRequest201JSONResponse(genAR), nil
+}
+
+// GetAuthzAccessRequest returns details of a specific access request.
+func (h *Handler) GetAuthzAccessRequest(
+	ctx context.Context,
+	request gen.GetAuthzAccessRequestRequestObject,
+) (gen.GetAuthzAccessRequestResponseObject, error) {
+	h.logger.Debug("GetAuthzAccessRequest called", "namespaceName", request.NamespaceName, "name", request.Name)
+
+	ar, err := h.services.AuthzAccessRequestService.GetAccessRequest(ctx, request.NamespaceName, request.Name)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetAuthzAccessRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, authzaccessrequestsvc.ErrAccessRequestNotFound) {
+			return gen.GetAuthzAccessRequest404JSONResponse{NotFoundJSONResponse: notFound("AuthzAccessRequest")}, nil
+		}
+		h.logger.Error("Failed to get access request", "error", err)
+		return gen.GetAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genAR, err := convert[openchoreov1alpha1.AuthzAccessRequest, gen.AuthzAccessRequest](*ar)
+	if err != nil {
+		h.logger.Error("Failed to convert access request", "error", err)
+		return gen.GetAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetAuthzAccessRequest200JSONResponse(genAR), nil
+}
+
+// ApproveAuthzAccessRequest approves a pending access request, granting the requested role until it expires.
+func (h *Handler) ApproveAuthzAccessRequest(
+	ctx context.Context,
+	request gen.ApproveAuthzAccessRequestRequestObject,
+) (gen.ApproveAuthzAccessRequestResponseObject, error) {
+	h.logger.Info("ApproveAuthzAccessRequest called", "namespaceName", request.NamespaceName, "name", request.Name)
+
+	ar, err := h.services.AuthzAccessRequestService.ApproveAccessRequest(ctx, request.NamespaceName, request.Name, reviewComment(request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden), errors.Is(err, authzaccessrequestsvc.ErrUnauthenticated):
+			return gen.ApproveAuthzAccessRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, authzaccessrequestsvc.ErrSelfReview):
+			return gen.ApproveAuthzAccessRequest403JSONResponse{ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Code: gen.FORBIDDEN, Error: err.Error()}}, nil
+		case errors.Is(err, authzaccessrequestsvc.ErrAccessRequestNotFound):
+			return gen.ApproveAuthzAccessRequest404JSONResponse{NotFoundJSONResponse: notFound("AuthzAccessRequest")}, nil
+		case errors.Is(err, authzaccessrequestsvc.ErrAccessRequestNotPending):
+			return gen.ApproveAuthzAccessRequest409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to approve access request", "error", err)
+		return gen.ApproveAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genAR, err := convert[openchoreov1alpha1.AuthzAccessRequest, gen.AuthzAccessRequest](*ar)
+	if err != nil {
+		h.logger.Error("Failed to convert access request", "error", err)
+		return gen.ApproveAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Access request approved", "namespaceName", request.NamespaceName, "accessRequest", ar.Name)
+	return gen.ApproveAuthzAccessRequest200JSONResponse(genAR), nil
+}
+
+// DenyAuthzAccessRequest denies a pending access request.
+func (h *Handler) DenyAuthzAccessRequest(
+	ctx context.Context,
+	request gen.DenyAuthzAccessRequestRequestObject,
+) (gen.DenyAuthzAccessRequestResponseObject, error) {
+	h.logger.Info("DenyAuthzAccessRequest called", "namespaceName", request.NamespaceName, "name", request.Name)
+
+	ar, err := h.services.AuthzAccessRequestService.DenyAccessRequest(ctx, request.NamespaceName, request.Name, reviewComment(request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden), errors.Is(err, authzaccessrequestsvc.ErrUnauthenticated):
+			return gen.DenyAuthzAccessRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, authzaccessrequestsvc.ErrSelfReview):
+			return gen.DenyAuthzAccessRequest403JSONResponse{ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Code: gen.FORBIDDEN, Error: err.Error()}}, nil
+		case errors.Is(err, authzaccessrequestsvc.ErrAccessRequestNotFound):
+			return gen.DenyAuthzAccessRequest404JSONResponse{NotFoundJSONResponse: notFound("AuthzAccessRequest")}, nil
+		case errors.Is(err, authzaccessrequestsvc.ErrAccessRequestNotPending):
+			return gen.DenyAuthzAccessRequest409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to deny access request", "error", err)
+		return gen.DenyAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genAR, err := convert[openchoreov1alpha1.AuthzAccessRequest, gen.AuthzAccessRequest](*ar)
+	if err != nil {
+		h.logger.Error("Failed to convert access request", "error", err)
+		return gen.DenyAuthzAccessRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Access request denied", "namespaceName", request.NamespaceName, "accessRequest", ar.Name)
+	return gen.DenyAuthzAccessRequest200JSONResponse(genAR), nil
+}
+
+func reviewComment(body *gen.AuthzAccessRequestReview) string {
+	if body == nil || body.Comment == nil {
+		return ""
+	}
+	return *body.Comment
+}
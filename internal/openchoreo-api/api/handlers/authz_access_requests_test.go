@@ -0,0 +1,195 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	authzaccessrequestsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/authzaccessrequest"
+	authzaccessrequestmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/authzaccessrequest/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithAccessRequestService(svc authzaccessrequestsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{AuthzAccessRequestService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testAccessRequest(phase openchoreov1alpha1.AuthzAccessRequestPhase) *openchoreov1alpha1.AuthzAccessRequest {
+	return &openchoreov1alpha1.AuthzAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "ar-1", Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.AuthzAccessRequestSpec{
+			Requester:   "alice",
+			Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "groups", Value: "crm-oncall"},
+			RoleRef:     openchoreov1alpha1.RoleRef{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "prod-debugger"},
+			Duration:    metav1.Duration{Duration: 2 * time.Hour},
+			Reason:      "INC-4211",
+		},
+		Status: openchoreov1alpha1.AuthzAccessRequestStatus{Phase: phase},
+	}
+}
+
+func TestCreateAuthzAccessRequestHandler(t *testing.T) {
+	ctx := testContext()
+	body := &gen.AuthzAccessRequest{
+		Metadata: gen.ObjectMeta{Name: "ar-1"},
+		Spec: &gen.AuthzAccessRequestSpec{
+			RoleRef:  gen.AuthzRoleRef{Kind: gen.AuthzRoleRefKind("AuthzRole"), Name: "prod-debugger"},
+			Duration: "2h",
+			Reason:   "INC-4211",
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().CreateAccessRequest(mock.Anything, "test-ns", mock.MatchedBy(func(ar *openchoreov1alpha1.AuthzAccessRequest) bool {
+			return ar.Name == "ar-1" && ar.Spec.Duration.Duration == 2*time.Hour
+		})).Return(testAccessRequest(""), nil)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.CreateAuthzAccessRequest(ctx, gen.CreateAuthzAccessRequestRequestObject{NamespaceName: "test-ns", Body: body})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateAuthzAccessRequest201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "ar-1", typed.Metadata.Name)
+		assert.Equal(t, ptr.To("alice"), typed.Spec.Requester)
+	})
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithAccessRequestService(authzaccessrequestmocks.NewMockService(t))
+		resp, err := h.CreateAuthzAccessRequest(ctx, gen.CreateAuthzAccessRequestRequestObject{NamespaceName: "test-ns"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateAuthzAccessRequest400JSONResponse{}, resp)
+	})
+
+	t.Run("entitlement not held returns 400", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().CreateAccessRequest(mock.Anything, "test-ns", mock.Anything).
+			Return(nil, &svcpkg.ValidationError{Msg: "entitlement groups:admins is not held by the requester"})
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.CreateAuthzAccessRequest(ctx, gen.CreateAuthzAccessRequestRequestObject{NamespaceName: "test-ns", Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateAuthzAccessRequest400JSONResponse{}, resp)
+	})
+
+	t.Run("already exists returns 409", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().CreateAccessRequest(mock.Anything, "test-ns", mock.Anything).
+			Return(nil, authzaccessrequestsvc.ErrAccessRequestAlreadyExists)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.CreateAuthzAccessRequest(ctx, gen.CreateAuthzAccessRequestRequestObject{NamespaceName: "test-ns", Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateAuthzAccessRequest409JSONResponse{}, resp)
+	})
+}
+
+func TestGetAuthzAccessRequestHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().GetAccessRequest(mock.Anything, "test-ns", "ar-1").Return(nil, authzaccessrequestsvc.ErrAccessRequestNotFound)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.GetAuthzAccessRequest(ctx, gen.GetAuthzAccessRequestRequestObject{NamespaceName: "test-ns", Name: "ar-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetAuthzAccessRequest404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().GetAccessRequest(mock.Anything, "test-ns", "ar-1").Return(nil, svcpkg.ErrForbidden)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.GetAuthzAccessRequest(ctx, gen.GetAuthzAccessRequestRequestObject{NamespaceName: "test-ns", Name: "ar-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetAuthzAccessRequest403JSONResponse{}, resp)
+	})
+}
+
+func TestApproveAuthzAccessRequestHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.ApproveAuthzAccessRequestRequestObject{
+		NamespaceName: "test-ns",
+		Name:          "ar-1",
+		Body:          &gen.AuthzAccessRequestReview{Comment: ptr.To("approved for INC-4211")},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().ApproveAccessRequest(mock.Anything, "test-ns", "ar-1", "approved for INC-4211").
+			Return(testAccessRequest(openchoreov1alpha1.AuthzAccessRequestApproved), nil)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.ApproveAuthzAccessRequest(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ApproveAuthzAccessRequest200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, ptr.To("Approved"), typed.Status.Phase)
+	})
+
+	t.Run("self review returns 403", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().ApproveAccessRequest(mock.Anything, "test-ns", "ar-1", mock.Anything).Return(nil, authzaccessrequestsvc.ErrSelfReview)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.ApproveAuthzAccessRequest(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ApproveAuthzAccessRequest403JSONResponse)
+		require.True(t, ok, "expected 403 response, got %T", resp)
+		assert.Equal(t, authzaccessrequestsvc.ErrSelfReview.Error(), typed.Error)
+	})
+
+	t.Run("not pending returns 409", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().ApproveAccessRequest(mock.Anything, "test-ns", "ar-1", mock.Anything).Return(nil, authzaccessrequestsvc.ErrAccessRequestNotPending)
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.ApproveAuthzAccessRequest(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApproveAuthzAccessRequest409JSONResponse{}, resp)
+	})
+
+	t.Run("internal error returns 500", func(t *testing.T) {
+		svc := authzaccessrequestmocks.NewMockService(t)
+		svc.EXPECT().ApproveAccessRequest(mock.Anything, "test-ns", "ar-1", mock.Anything).Return(nil, errors.New("boom"))
+		h := newHandlerWithAccessRequestService(svc)
+
+		resp, err := h.ApproveAuthzAccessRequest(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApproveAuthzAccessRequest500JSONResponse{}, resp)
+	})
+}
+
+func TestDenyAuthzAccessRequestHandler(t *testing.T) {
+	ctx := testContext()
+
+	svc := authzaccessrequestmocks.NewMockService(t)
+	svc.EXPECT().DenyAccessRequest(mock.Anything, "test-ns", "ar-1", "").
+		Return(testAccessRequest(openchoreov1alpha1.AuthzAccessRequestDenied), nil)
+	h := newHandlerWithAccessRequestService(svc)
+
+	resp, err := h.DenyAuthzAccessRequest(ctx, gen.DenyAuthzAccessRequestRequestObject{NamespaceName: "test-ns", Name: "ar-1"})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.DenyAuthzAccessRequest200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	assert.Equal(t, ptr.To("Denied"), typed.Status.Phase)
+}
@@ -138,6 +138,7 @@ func TestEvaluatesHandler(t *testing.T) {
 					Type:              gen.SubjectContextType("user"),
 					EntitlementClaim:  "groups",
 					EntitlementValues: []string{"admin"},
+					Claims:            &map[string]interface{}{"department": "payments"},
 				},
 			},
 			{
@@ -166,6 +167,7 @@ func TestEvaluatesHandler(t *testing.T) {
 			assert.Equal(t, "user", reqs[0].SubjectContext.Type)
 			assert.Equal(t, "groups", reqs[0].SubjectContext.EntitlementClaim)
 			assert.Equal(t, []string{"admin"}, reqs[0].SubjectContext.EntitlementValues)
+			assert.Equal(t, map[string]any{"department": "payments"}, reqs[0].SubjectContext.Claims)
 
 			return []authzcore.Decision{
 				{Decision: true, Context: &authzcore.DecisionContext{Reason: reason}},
@@ -342,6 +344,229 @@ func TestEvaluatesHandler(t *testing.T) {
 	})
 }
 
+func TestExplainAuthzHandler(t *testing.T) {
+	ctx := testContext()
+	ns := "acme"
+	env := "acme/prod"
+	body := &gen.EvaluateRequest{
+		Action:   "releasebinding:create",
+		Resource: gen.Resource{Type: "releasebinding", Hierarchy: gen.ResourceHierarchy{Namespace: &ns}},
+		SubjectContext: &gen.SubjectContext{
+			Type:              gen.SubjectContextType("user"),
+			EntitlementClaim:  "groups",
+			EntitlementValues: []string{"dev"},
+		},
+		Context: &gen.AuthzContext{Resource: &struct {
+			ComponentType *string `json:"componentType,omitempty"`
+			Environment   *string `json:"environment,omitempty"`
+			ResourceType  *string `json:"resourceType,omitempty"`
+			Workflow      *string `json:"workflow,omitempty"`
+		}{Environment: &env}},
+	}
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithAuthzService(t, authzmocks.NewMockService(t), &config.Config{})
+		resp, err := h.ExplainAuthz(ctx, gen.ExplainAuthzRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExplainAuthz400JSONResponse{}, resp)
+	})
+
+	t.Run("success converts the explanation", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Explain(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, req *authzcore.EvaluateRequest) (*authzcore.Explanation, error) {
+			assert.Equal(t, "releasebinding:create", req.Action)
+			assert.Equal(t, ns, req.Resource.Hierarchy.Namespace)
+			assert.Equal(t, env, req.Context.Resource.Environment)
+			assert.Equal(t, []string{"dev"}, req.SubjectContext.EntitlementValues)
+			return &authzcore.Explanation{
+				Decision: false,
+				Reason:   "no allow policy applies: conditions of binding 'dev-deployer' (role 'deployer') are not satisfied",
+				Policies: []authzcore.PolicyMatch{{
+					Entitlement: authzcore.Entitlement{Claim: "groups", Value: "dev"},
+					BindingName: "dev-deployer",
+					Role:        authzcore.RoleRef{Name: "deployer", Namespace: ns},
+					Scope:       authzcore.ResourceHierarchy{Namespace: ns},
+					Effect:      authzcore.PolicyEffectAllow,
+					Conditions: []authzcore.ConditionResult{{
+						Expression: `resource.environment != "acme/prod"`,
+						Actions:    []string{"releasebinding:create"},
+						Result:     authzcore.ConditionResultFalse,
+					}},
+				}},
+			}, nil
+		})
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.ExplainAuthz(ctx, gen.ExplainAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ExplainAuthz200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.False(t, typed.Decision)
+		require.Len(t, typed.Policies, 1)
+		assert.Equal(t, "dev-deployer", typed.Policies[0].BindingName)
+		assert.Equal(t, "allow", typed.Policies[0].Effect)
+		require.NotNil(t, typed.Policies[0].Conditions)
+		assert.Equal(t, "false", (*typed.Policies[0].Conditions)[0].Result)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Explain(mock.Anything, mock.Anything).Return(nil, svcpkg.ErrForbidden)
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.ExplainAuthz(ctx, gen.ExplainAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExplainAuthz403JSONResponse{}, resp)
+	})
+
+	t.Run("unsupported authorizer returns 400", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Explain(mock.Anything, mock.Anything).Return(nil, authzsvc.ErrExplainNotSupported)
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.ExplainAuthz(ctx, gen.ExplainAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExplainAuthz400JSONResponse{}, resp)
+	})
+
+	t.Run("generic error returns 500", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Explain(mock.Anything, mock.Anything).Return(nil, errors.New("unexpected failure"))
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.ExplainAuthz(ctx, gen.ExplainAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExplainAuthz500JSONResponse{}, resp)
+	})
+}
+
+func TestListAuthzDecisionsHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success maps params and records", func(t *testing.T) {
+		ns := "acme"
+		denied := false
+		since := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().ListDecisions(mock.Anything, authzcore.DecisionLogQuery{
+			Namespace: ns,
+			Decision:  &denied,
+			Since:     since,
+			Limit:     100,
+		}).Return([]authzcore.DecisionRecord{{
+			Time:     since.Add(time.Minute),
+			Subject:  authzcore.SubjectContext{Type: "user", EntitlementClaim: "groups", EntitlementValues: []string{"dev"}},
+			Action:   "component:deploy",
+			Resource: authzcore.Resource{Type: "component", Hierarchy: authzcore.ResourceHierarchy{Namespace: ns}},
+			Reason:   "no matching policies found",
+			Duration: 1500 * time.Microsecond,
+		}}, nil)
+
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+		resp, err := h.ListAuthzDecisions(ctx, gen.ListAuthzDecisionsRequestObject{
+			Params: gen.ListAuthzDecisionsParams{Namespace: &ns, Decision: &denied, Since: &since},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListAuthzDecisions200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		item := typed.Items[0]
+		assert.Equal(t, "component:deploy", item.Action)
+		assert.False(t, item.Decision)
+		assert.Equal(t, []string{"dev"}, item.Subject.EntitlementValues)
+		assert.Equal(t, &ns, item.Resource.Hierarchy.Namespace)
+		assert.Equal(t, "no matching policies found", *item.Reason)
+		assert.Nil(t, item.BindingName)
+		assert.InDelta(t, 1.5, item.DurationMs, 1e-9)
+	})
+
+	t.Run("disabled log returns 400", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().ListDecisions(mock.Anything, mock.Anything).Return(nil, authzsvc.ErrDecisionLogDisabled)
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+		resp, err := h.ListAuthzDecisions(ctx, gen.ListAuthzDecisionsRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListAuthzDecisions400JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().ListDecisions(mock.Anything, mock.Anything).Return(nil, svcpkg.ErrForbidden)
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+		resp, err := h.ListAuthzDecisions(ctx, gen.ListAuthzDecisionsRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListAuthzDecisions403JSONResponse{}, resp)
+	})
+}
+
+func TestSimulateAuthzHandler(t *testing.T) {
+	ctx := testContext()
+	ns := "acme"
+	body := &gen.AuthzSimulateRequest{
+		Request: gen.EvaluateRequest{
+			Action:   "component:view",
+			Resource: gen.Resource{Type: "component", Hierarchy: gen.ResourceHierarchy{Namespace: &ns}},
+		},
+		RoleBindings: &[]gen.AuthzRoleBinding{{
+			Metadata: gen.ObjectMeta{Name: "dev-viewer"},
+			Spec: &gen.AuthzRoleBindingSpec{
+				Entitlement: gen.AuthzEntitlementClaim{Claim: "groups", Value: "dev"},
+				RoleMappings: []gen.AuthzRoleMapping{{
+					RoleRef: gen.AuthzRoleRef{Kind: gen.AuthzRoleRefKind("ClusterAuthzRole"), Name: "viewer"},
+				}},
+			},
+		}},
+	}
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithAuthzService(t, authzmocks.NewMockService(t), &config.Config{})
+		resp, err := h.SimulateAuthz(ctx, gen.SimulateAuthzRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SimulateAuthz400JSONResponse{}, resp)
+	})
+
+	t.Run("success defaults proposal namespace and caller subject", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Simulate(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, req *authzcore.SimulateRequest) (*authzcore.Explanation, error) {
+			assert.Equal(t, "component:view", req.Request.Action)
+			require.NotNil(t, req.Request.SubjectContext)
+			assert.Equal(t, "user", req.Request.SubjectContext.Type)
+			require.Len(t, req.RoleBindings, 1)
+			assert.Equal(t, ns, req.RoleBindings[0].Namespace)
+			assert.Equal(t, "viewer", req.RoleBindings[0].Spec.RoleMappings[0].RoleRef.Name)
+			assert.False(t, req.IgnoreExisting)
+			return &authzcore.Explanation{Decision: true, Reason: "allowed", Policies: []authzcore.PolicyMatch{}}, nil
+		})
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.SimulateAuthz(ctx, gen.SimulateAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.SimulateAuthz200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.True(t, typed.Decision)
+	})
+
+	t.Run("invalid proposal returns 400", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Simulate(mock.Anything, mock.Anything).Return(nil, authzcore.ErrInvalidRequest)
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.SimulateAuthz(ctx, gen.SimulateAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SimulateAuthz400JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := authzmocks.NewMockService(t)
+		svc.EXPECT().Simulate(mock.Anything, mock.Anything).Return(nil, svcpkg.ErrForbidden)
+		h := newHandlerWithAuthzService(t, svc, &config.Config{})
+
+		resp, err := h.SimulateAuthz(ctx, gen.SimulateAuthzRequestObject{Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SimulateAuthz403JSONResponse{}, resp)
+	})
+}
+
 func TestGetSubjectProfileHandler(t *testing.T) {
 	cfg := &config.Config{}
 
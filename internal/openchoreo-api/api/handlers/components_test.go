@@ -201,7 +201,7 @@ func TestToModelTraits(t *testing.T) {
 
 func newComponentService(t *testing.T, objects []client.Object, pdp authzcore.PDP) componentsvc.Service {
 	t.Helper()
-	return componentsvc.NewServiceWithAuthz(testutil.NewFakeClient(objects...), pdp, testutil.TestLogger())
+	return componentsvc.NewServiceWithAuthz(testutil.NewFakeClient(objects...), nil, pdp, testutil.TestLogger())
 }
 
 func newHandlerWithComponentService(svc componentsvc.Service) *Handler {
@@ -398,6 +398,110 @@ func (h *Handler) DeleteWorkflowRun(
 	return gen.DeleteWorkflowRun204Response{}, nil
 }
 
+// CancelWorkflowRun requests the cancellation of a workflow run.
+func (h *Handler) CancelWorkflowRun(
+	ctx context.Context,
+	request gen.CancelWorkflowRunRequestObject,
+) (gen.CancelWorkflowRunResponseObject, error) {
+	h.logger.Info("CancelWorkflowRun called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	wfRun, err := h.services.WorkflowRunService.CancelWorkflowRun(ctx, request.NamespaceName, request.RunName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.CancelWorkflowRun403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.CancelWorkflowRun404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunCompleted) {
+			return gen.CancelWorkflowRun409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to cancel workflow run", "error", err)
+		return gen.CancelWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genWfRun, err := convert[openchoreov1alpha1.WorkflowRun, gen.WorkflowRun](*wfRun)
+	if err != nil {
+		h.logger.Error("Failed to convert cancelled workflow run", "error", err)
+		return gen.CancelWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.CancelWorkflowRun200JSONResponse(genWfRun), nil
+}
+
+// RetryWorkflowRun creates a new workflow run with the same workflow and parameters as a completed run.
+func (h *Handler) RetryWorkflowRun(
+	ctx context.Context,
+	request gen.RetryWorkflowRunRequestObject,
+) (gen.RetryWorkflowRunResponseObject, error) {
+	h.logger.Info("RetryWorkflowRun called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	created, err := h.services.WorkflowRunService.RetryWorkflowRun(ctx, request.NamespaceName, request.RunName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.RetryWorkflowRun403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.RetryWorkflowRun404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowNotFound) {
+			return gen.RetryWorkflowRun404JSONResponse{NotFoundJSONResponse: notFound("Workflow")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotCompleted) {
+			return gen.RetryWorkflowRun409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to retry workflow run", "error", err)
+		return gen.RetryWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genWfRun, err := convert[openchoreov1alpha1.WorkflowRun, gen.WorkflowRun](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert retried workflow run", "error", err)
+		return gen.RetryWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("WorkflowRun retried successfully", "namespaceName", request.NamespaceName,
+		"runName", request.RunName, "workflowRun", created.Name)
+	return gen.RetryWorkflowRun201JSONResponse(genWfRun), nil
+}
+
+// ResumeWorkflowRun requests that a failed workflow run is resumed from its failed tasks.
+func (h *Handler) ResumeWorkflowRun(
+	ctx context.Context,
+	request gen.ResumeWorkflowRunRequestObject,
+) (gen.ResumeWorkflowRunResponseObject, error) {
+	h.logger.Info("ResumeWorkflowRun called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	wfRun, err := h.services.WorkflowRunService.ResumeWorkflowRun(ctx, request.NamespaceName, request.RunName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.ResumeWorkflowRun403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.ResumeWorkflowRun404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotResumable) {
+			return gen.ResumeWorkflowRun409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to resume workflow run", "error", err)
+		return gen.ResumeWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genWfRun, err := convert[openchoreov1alpha1.WorkflowRun, gen.WorkflowRun](*wfRun)
+	if err != nil {
+		h.logger.Error("Failed to convert resumed workflow run", "error", err)
+		return gen.ResumeWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ResumeWorkflowRun200JSONResponse(genWfRun), nil
+}
+
 // GetWorkflowRunStatus returns the status and per-step details of a specific workflow run
 func (h *Handler) GetWorkflowRunStatus(
 	ctx context.Context,
@@ -531,6 +635,69 @@ func (h *Handler) GetWorkflowRunEvents(
 	return result, nil
 }
 
+// ListWorkflowRunGroups returns a list of workflow run groups
+func (h *Handler) ListWorkflowRunGroups(
+	ctx context.Context,
+	request gen.ListWorkflowRunGroupsRequestObject,
+) (gen.ListWorkflowRunGroupsResponseObject, error) {
+	h.logger.Info("ListWorkflowRunGroups called", "namespaceName", request.NamespaceName)
+
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+
+	result, err := h.services.WorkflowRunService.ListWorkflowRunGroups(ctx, request.NamespaceName, opts)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.ListWorkflowRunGroups403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.ListWorkflowRunGroups400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list workflow run groups", "error", err)
+		return gen.ListWorkflowRunGroups500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.WorkflowRunGroup, gen.WorkflowRunGroup](result.Items)
+	if err != nil {
+		h.logger.Error("Failed to convert workflow run groups", "error", err)
+		return gen.ListWorkflowRunGroups500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ListWorkflowRunGroups200JSONResponse(gen.WorkflowRunGroupList{
+		Items:      items,
+		Pagination: ToPagination(result),
+	}), nil
+}
+
+// GetWorkflowRunGroup returns a specific workflow run group
+func (h *Handler) GetWorkflowRunGroup(
+	ctx context.Context,
+	request gen.GetWorkflowRunGroupRequestObject,
+) (gen.GetWorkflowRunGroupResponseObject, error) {
+	h.logger.Info("GetWorkflowRunGroup called",
+		"namespace", request.NamespaceName,
+		"groupName", request.GroupName)
+
+	group, err := h.services.WorkflowRunService.GetWorkflowRunGroup(ctx, request.NamespaceName, request.GroupName)
+	if err != nil {
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunGroupNotFound) {
+			return gen.GetWorkflowRunGroup404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRunGroup")}, nil
+		}
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetWorkflowRunGroup403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get workflow run group", "error", err)
+		return gen.GetWorkflowRunGroup500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genGroup, err := convert[openchoreov1alpha1.WorkflowRunGroup, gen.WorkflowRunGroup](*group)
+	if err != nil {
+		h.logger.Error("Failed to convert workflow run group", "error", err)
+		return gen.GetWorkflowRunGroup500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetWorkflowRunGroup200JSONResponse(genGroup), nil
+}
+
 // normalizeStepPhase maps a raw phase string from the WorkflowTask CRD to a
 // valid OpenAPI enum value. Argo's "Omitted" phase is mapped to "Skipped";
 // any other unrecognized value falls back to "Error".
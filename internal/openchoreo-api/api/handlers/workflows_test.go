@@ -6,6 +6,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -65,6 +66,26 @@ func testWorkflowRunObj() *openchoreov1alpha1.WorkflowRun {
 	}
 }
 
+func testWorkflowRunGroupObj() *openchoreov1alpha1.WorkflowRunGroup {
+	return &openchoreov1alpha1.WorkflowRunGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "build-abc1234",
+			Namespace: "test-ns",
+		},
+		Spec: openchoreov1alpha1.WorkflowRunGroupSpec{
+			Commit: "abc1234",
+			Runs: []openchoreov1alpha1.WorkflowRunGroupRun{
+				{
+					Name: "frontend",
+					WorkflowRunTemplate: openchoreov1alpha1.WorkflowRunTemplate{
+						Workflow: openchoreov1alpha1.ScheduledWorkflowConfig{Name: "wf-1"},
+					},
+				},
+			},
+		},
+	}
+}
+
 // --- DeleteWorkflowRun Handler ---
 
 func TestDeleteWorkflowRunHandler(t *testing.T) {
@@ -913,6 +934,213 @@ func TestGetWorkflowRunEventsHandler_MapsErrors(t *testing.T) {
 	}
 }
 
+// --- CancelWorkflowRun Handler ---
+
+func TestCancelWorkflowRunHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().CancelWorkflowRun(mock.Anything, ns, "run-1").Return(
+			&openchoreov1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{Name: "run-1", Namespace: ns}}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.CancelWorkflowRun(ctx, gen.CancelWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CancelWorkflowRun200JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.CancelWorkflowRun404JSONResponse{}},
+		{"completed -> 409", workflowrunsvc.ErrWorkflowRunCompleted, gen.CancelWorkflowRun409JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.CancelWorkflowRun403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.CancelWorkflowRun500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().CancelWorkflowRun(mock.Anything, ns, "run-1").Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.CancelWorkflowRun(ctx, gen.CancelWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+// --- RetryWorkflowRun Handler ---
+
+func TestRetryWorkflowRunHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().RetryWorkflowRun(mock.Anything, ns, "run-1").Return(
+			&openchoreov1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{Name: "run-1", Namespace: ns}}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.RetryWorkflowRun(ctx, gen.RetryWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RetryWorkflowRun201JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.RetryWorkflowRun404JSONResponse{}},
+		{"workflow not found -> 404", workflowrunsvc.ErrWorkflowNotFound, gen.RetryWorkflowRun404JSONResponse{}},
+		{"not completed -> 409", workflowrunsvc.ErrWorkflowRunNotCompleted, gen.RetryWorkflowRun409JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.RetryWorkflowRun403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.RetryWorkflowRun500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().RetryWorkflowRun(mock.Anything, ns, "run-1").Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.RetryWorkflowRun(ctx, gen.RetryWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+// --- ResumeWorkflowRun Handler ---
+
+func TestResumeWorkflowRunHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().ResumeWorkflowRun(mock.Anything, ns, "run-1").Return(
+			&openchoreov1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{Name: "run-1", Namespace: ns}}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.ResumeWorkflowRun(ctx, gen.ResumeWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ResumeWorkflowRun200JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.ResumeWorkflowRun404JSONResponse{}},
+		{"not resumable -> 409", fmt.Errorf("%w: workflow run has not failed", workflowrunsvc.ErrWorkflowRunNotResumable), gen.ResumeWorkflowRun409JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.ResumeWorkflowRun403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.ResumeWorkflowRun500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().ResumeWorkflowRun(mock.Anything, ns, "run-1").Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.ResumeWorkflowRun(ctx, gen.ResumeWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+// --- ListWorkflowRunGroups Handler ---
+
+func TestListWorkflowRunGroupsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := newWorkflowRunService(t, []client.Object{testWorkflowRunGroupObj()}, &allowAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+		resp, err := h.ListWorkflowRunGroups(ctx, gen.ListWorkflowRunGroupsRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListWorkflowRunGroups200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, "build-abc1234", typed.Items[0].Metadata.Name)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.ListWorkflowRunGroups403JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "bad request"}, gen.ListWorkflowRunGroups400JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.ListWorkflowRunGroups500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().ListWorkflowRunGroups(mock.Anything, ns, mock.Anything).Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.ListWorkflowRunGroups(ctx, gen.ListWorkflowRunGroupsRequestObject{NamespaceName: ns})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+// --- GetWorkflowRunGroup Handler ---
+
+func TestGetWorkflowRunGroupHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := newWorkflowRunService(t, []client.Object{testWorkflowRunGroupObj()}, &allowAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+		resp, err := h.GetWorkflowRunGroup(ctx, gen.GetWorkflowRunGroupRequestObject{NamespaceName: ns, GroupName: "build-abc1234"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetWorkflowRunGroup200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "build-abc1234", typed.Metadata.Name)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newWorkflowRunService(t, nil, &allowAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+		resp, err := h.GetWorkflowRunGroup(ctx, gen.GetWorkflowRunGroupRequestObject{NamespaceName: ns, GroupName: "nope"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetWorkflowRunGroup404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newWorkflowRunService(t, []client.Object{testWorkflowRunGroupObj()}, &denyAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+		resp, err := h.GetWorkflowRunGroup(ctx, gen.GetWorkflowRunGroupRequestObject{NamespaceName: ns, GroupName: "build-abc1234"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetWorkflowRunGroup403JSONResponse{}, resp)
+	})
+}
+
 // --- normalizeStepPhase ---
 
 func TestNormalizeStepPhase(t *testing.T) {
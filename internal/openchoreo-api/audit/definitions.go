@@ -188,5 +188,25 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "delete_authz_role_mapping",
 			Category: audit.CategoryAuth,
 		},
+
+		// Authorization access request operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/authzaccessrequests",
+			Action:   "request_access",
+			Category: audit.CategoryAuth,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/approve",
+			Action:   "approve_access_request",
+			Category: audit.CategoryAuth,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/authzaccessrequests/{name}/deny",
+			Action:   "deny_access_request",
+			Category: audit.CategoryAuth,
+		},
 	}
 }
@@ -0,0 +1,34 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	coreconfig "github.com/openchoreo/openchoreo/internal/config"
+)
+
+// AutoBuildConfig defines settings for builds triggered by git webhooks.
+type AutoBuildConfig struct {
+	// MaxConcurrency caps the number of workflow runs of one push that execute at the same
+	// time when the push affects several components of a namespace. 0 means unlimited.
+	MaxConcurrency int32 `koanf:"max_concurrency"`
+	// FailFast cancels the remaining builds of a push once one of them fails.
+	FailFast bool `koanf:"fail_fast"`
+}
+
+// AutoBuildDefaults returns the default auto-build configuration.
+func AutoBuildDefaults() AutoBuildConfig {
+	return AutoBuildConfig{
+		MaxConcurrency: 0,
+		FailFast:       false,
+	}
+}
+
+// Validate validates the auto-build configuration.
+func (c *AutoBuildConfig) Validate(path *coreconfig.Path) coreconfig.ValidationErrors {
+	var errs coreconfig.ValidationErrors
+	if err := coreconfig.MustBeNonNegative(path.Child("max_concurrency"), c.MaxConcurrency); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
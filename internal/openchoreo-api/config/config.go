@@ -27,6 +27,10 @@ type Config struct {
 	Logging LoggingConfig `koanf:"logging"`
 	// ClusterGateway defines cluster gateway connection settings.
 	ClusterGateway ClusterGatewayConfig `koanf:"cluster_gateway"`
+	// AutoBuild defines settings for builds triggered by git webhooks.
+	AutoBuild AutoBuildConfig `koanf:"auto_build"`
+	// ImagePinning defines how workload images are pinned to digests in component releases.
+	ImagePinning ImagePinningConfig `koanf:"image_pinning"`
 }
 
 // Defaults returns the default configuration.
@@ -39,6 +43,8 @@ func Defaults() Config {
 		SecretManagement: SecretManagementDefaults(),
 		Logging:          LoggingDefaults(),
 		ClusterGateway:   ClusterGatewayDefaults(),
+		AutoBuild:        AutoBuildDefaults(),
+		ImagePinning:     ImagePinningDefaults(),
 	}
 }
 
@@ -84,6 +90,7 @@ func (c *Config) Validate() error {
 	errs = append(errs, c.MCP.ValidateMCPConfig(coreconfig.NewPath("mcp"))...)
 	errs = append(errs, c.Logging.Validate(coreconfig.NewPath("logging"))...)
 	errs = append(errs, c.ClusterGateway.Validate(coreconfig.NewPath("cluster_gateway"))...)
+	errs = append(errs, c.AutoBuild.Validate(coreconfig.NewPath("auto_build"))...)
 
 	return errs.OrNil()
 }
@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// ImagePinningConfig defines how workload images are pinned to digests when component
+// releases are created.
+type ImagePinningConfig struct {
+	// Enabled resolves tagged workload images to the digests they point to. Pinning is
+	// best-effort: a release whose image cannot be resolved keeps the image as authored.
+	Enabled bool `koanf:"enabled"`
+	// InsecureRegistries lists the registries, as host[:port], that are reached over plain HTTP.
+	InsecureRegistries []string `koanf:"insecure_registries"`
+}
+
+// ImagePinningDefaults returns the default image pinning configuration.
+func ImagePinningDefaults() ImagePinningConfig {
+	return ImagePinningConfig{
+		Enabled: true,
+	}
+}
@@ -18,6 +18,30 @@ type MCPConfig struct {
 	Enabled bool `koanf:"enabled"`
 	// Toolsets is the list of enabled MCP toolsets.
 	Toolsets []string `koanf:"toolsets"`
+	// RequireConfirmation makes mutating tools return a plan that must be confirmed
+	// before the change is applied, unless the session sets ?confirmMutations=false.
+	RequireConfirmation bool `koanf:"require_confirmation"`
+	// ResourceSubscriptions lets clients subscribe to project, component and
+	// environment resources. It watches those kinds cluster-wide.
+	ResourceSubscriptions bool `koanf:"resource_subscriptions"`
+	// Clients registers MCP clients whose rights are narrowed below those of
+	// the user they act for.
+	Clients []MCPClientConfig `koanf:"clients"`
+}
+
+// MCPClientConfig registers an MCP client (agent) by its OAuth client ID.
+// Empty lists allow everything; the user's own permissions always apply.
+type MCPClientConfig struct {
+	// ID is the OAuth client ID, matched against the azp or client_id claim of the token.
+	ID string `koanf:"id"`
+	// ReadOnly restricts the client to tools that do not make changes.
+	ReadOnly bool `koanf:"read_only"`
+	// Tools is the allowlist of tool names.
+	Tools []string `koanf:"tools"`
+	// Namespaces is the allowlist of namespaces.
+	Namespaces []string `koanf:"namespaces"`
+	// Projects is the allowlist of projects, as namespace/project.
+	Projects []string `koanf:"projects"`
 }
 
 // MCPDefaults returns the default MCP configuration.
@@ -32,6 +56,8 @@ func MCPDefaults() MCPConfig {
 			string(tools.ToolsetBuild),
 			string(tools.ToolsetResource),
 		},
+		RequireConfirmation:   false,
+		ResourceSubscriptions: true,
 	}
 }
 
@@ -64,9 +90,53 @@ func (c *MCPConfig) ValidateMCPConfig(path *config.Path) config.ValidationErrors
 		}
 	}
 
+	seen := make(map[string]bool, len(c.Clients))
+	for i, client := range c.Clients {
+		clientPath := path.Child("clients").Index(i)
+		if err := config.MustNotBeEmpty(clientPath.Child("id"), client.ID); err != nil {
+			errs = append(errs, err)
+		} else if seen[client.ID] {
+			errs = append(errs, config.Invalid(clientPath.Child("id"), fmt.Sprintf("duplicate client %q", client.ID)))
+		}
+		seen[client.ID] = true
+		for j, project := range client.Projects {
+			if ns, name, ok := strings.Cut(project, "/"); !ok || ns == "" || name == "" || strings.Contains(name, "/") {
+				errs = append(errs, config.Invalid(clientPath.Child("projects").Index(j),
+					fmt.Sprintf("project %q must be namespace/project", project)))
+			}
+		}
+	}
+
 	return errs
 }
 
+// ClientScopes returns the scope of each registered MCP client by client ID.
+func (c *MCPConfig) ClientScopes() map[string]*tools.ClientScope {
+	scopes := make(map[string]*tools.ClientScope, len(c.Clients))
+	for _, client := range c.Clients {
+		scopes[client.ID] = &tools.ClientScope{
+			ClientID:   client.ID,
+			ReadOnly:   client.ReadOnly,
+			Tools:      allowlistSet(client.Tools),
+			Namespaces: allowlistSet(client.Namespaces),
+			Projects:   allowlistSet(client.Projects),
+		}
+	}
+	return scopes
+}
+
+// allowlistSet converts a list to a set; an empty list allows everything and yields nil.
+func allowlistSet(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
 // ParseToolsets converts the toolset strings to a map of ToolsetType for lookup.
 func (c *MCPConfig) ParseToolsets() map[tools.ToolsetType]bool {
 	result := make(map[tools.ToolsetType]bool, len(c.Toolsets))
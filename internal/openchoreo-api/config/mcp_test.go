@@ -23,6 +23,12 @@ func TestNewMCPConfig_Defaults(t *testing.T) {
 	if diff := cmp.Diff(expectedToolsets, cfg.Toolsets); diff != "" {
 		t.Errorf("default toolsets mismatch (-want +got):\n%s", diff)
 	}
+	if cfg.RequireConfirmation {
+		t.Error("expected RequireConfirmation to be false by default")
+	}
+	if !cfg.ResourceSubscriptions {
+		t.Error("expected ResourceSubscriptions to be true by default")
+	}
 }
 
 func TestNewMCPConfig_ValidateToolsets(t *testing.T) {
@@ -67,6 +73,32 @@ func TestNewMCPConfig_ValidateToolsets(t *testing.T) {
 				{Field: "mcp.toolsets[1]", Message: `unknown toolset "unknown"; valid toolsets: build, component, deployment, namespace, pe, project, resource`},
 			},
 		},
+		{
+			name: "valid clients",
+			cfg: MCPConfig{
+				Clients: []MCPClientConfig{
+					{ID: "agent", ReadOnly: true},
+					{ID: "deployer", Projects: []string{"default/shop"}},
+				},
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid clients",
+			cfg: MCPConfig{
+				Clients: []MCPClientConfig{
+					{ID: "", ReadOnly: true},
+					{ID: "agent"},
+					{ID: "agent", Projects: []string{"shop", "default/shop/api"}},
+				},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "mcp.clients[0].id", Message: "must not be empty"},
+				{Field: "mcp.clients[2].id", Message: `duplicate client "agent"`},
+				{Field: "mcp.clients[2].projects[0]", Message: `project "shop" must be namespace/project`},
+				{Field: "mcp.clients[2].projects[1]", Message: `project "default/shop/api" must be namespace/project`},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -102,3 +134,24 @@ func TestNewMCPConfig_ParseToolsets(t *testing.T) {
 		t.Errorf("expected empty map for empty toolsets, got %v", empty)
 	}
 }
+
+func TestMCPConfig_ClientScopes(t *testing.T) {
+	cfg := &MCPConfig{
+		Clients: []MCPClientConfig{
+			{ID: "agent", ReadOnly: true, Tools: []string{"list_projects"}},
+			{ID: "deployer", Namespaces: []string{"default"}, Projects: []string{"default/shop"}},
+		},
+	}
+
+	expected := map[string]*tools.ClientScope{
+		"agent": {ClientID: "agent", ReadOnly: true, Tools: map[string]bool{"list_projects": true}},
+		"deployer": {
+			ClientID:   "deployer",
+			Namespaces: map[string]bool{"default": true},
+			Projects:   map[string]bool{"default/shop": true},
+		},
+	}
+	if diff := cmp.Diff(expected, cfg.ClientScopes()); diff != "" {
+		t.Errorf("ClientScopes mismatch (-want +got):\n%s", diff)
+	}
+}
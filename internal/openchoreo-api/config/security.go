@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/authz"
+	"github.com/openchoreo/openchoreo/internal/authz/casbin"
 	"github.com/openchoreo/openchoreo/internal/config"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/subject"
@@ -285,6 +286,11 @@ type AuthorizationConfig struct {
 	// This triggers re-listing of CRDs and OnUpdate callbacks for reconciliation.
 	// Set to 0 to disable periodic resync (watch events still work).
 	ResyncInterval time.Duration `koanf:"resync_interval"`
+	// DecisionLog defines the in-memory log of authorization decisions.
+	DecisionLog AuthzDecisionLogConfig `koanf:"decision_log"`
+	// MetricsBindAddress is the address the authorization metrics are served on (e.g. ":8090").
+	// Empty or "0" disables the metrics endpoint.
+	MetricsBindAddress string `koanf:"metrics_bind_address"`
 }
 
 // AuthzCacheConfig defines caching settings for authorization.
@@ -295,6 +301,50 @@ type AuthzCacheConfig struct {
 	TTL time.Duration `koanf:"ttl"`
 }
 
+// AuthzDecisionLogConfig defines the in-memory log of authorization decisions.
+type AuthzDecisionLogConfig struct {
+	// Enabled enables the decision log.
+	Enabled bool `koanf:"enabled"`
+	// SampleRate is the fraction (0-1) of allowed decisions that are logged.
+	// Denied decisions are always logged.
+	SampleRate float64 `koanf:"sample_rate"`
+	// Retention is how long decisions are kept.
+	Retention time.Duration `koanf:"retention"`
+	// MaxEntries is the maximum number of decisions kept; the oldest are dropped first.
+	MaxEntries int `koanf:"max_entries"`
+}
+
+// AuthzDecisionLogDefaults returns the default decision log configuration.
+func AuthzDecisionLogDefaults() AuthzDecisionLogConfig {
+	return AuthzDecisionLogConfig{
+		Enabled:    false,
+		SampleRate: 1,
+		Retention:  time.Hour,
+		MaxEntries: 10000,
+	}
+}
+
+// Validate validates the decision log configuration.
+func (c *AuthzDecisionLogConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeInRange(path.Child("sample_rate"), c.SampleRate, 0, 1); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("retention"), c.Retention, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("max_entries"), c.MaxEntries, 0); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
 // AuthzCacheDefaults returns the default cache configuration.
 func AuthzCacheDefaults() AuthzCacheConfig {
 	return AuthzCacheConfig{
@@ -306,9 +356,11 @@ func AuthzCacheDefaults() AuthzCacheConfig {
 // AuthorizationDefaults returns the default authorization configuration.
 func AuthorizationDefaults() AuthorizationConfig {
 	return AuthorizationConfig{
-		Enabled:        false,
-		Cache:          AuthzCacheDefaults(),
-		ResyncInterval: 10 * time.Minute,
+		Enabled:            false,
+		Cache:              AuthzCacheDefaults(),
+		ResyncInterval:     10 * time.Minute,
+		DecisionLog:        AuthzDecisionLogDefaults(),
+		MetricsBindAddress: "0",
 	}
 }
 
@@ -321,6 +373,7 @@ func (c *AuthorizationConfig) Validate(path *config.Path) config.ValidationError
 	}
 
 	errs = append(errs, c.Cache.Validate(path.Child("cache"))...)
+	errs = append(errs, c.DecisionLog.Validate(path.Child("decision_log"))...)
 
 	if c.ResyncInterval < 0 {
 		errs = append(errs, config.Invalid(path.Child("resync_interval"), "must be non-negative"))
@@ -350,5 +403,11 @@ func (c *AuthorizationConfig) ToAuthzConfig(securityEnabled bool) authz.Config {
 		CacheEnabled:   c.Cache.Enabled,
 		CacheTTL:       c.Cache.TTL,
 		ResyncInterval: c.ResyncInterval,
+		DecisionLog: casbin.DecisionLogConfig{
+			Enabled:    c.DecisionLog.Enabled,
+			SampleRate: c.DecisionLog.SampleRate,
+			Retention:  c.DecisionLog.Retention,
+			MaxEntries: c.DecisionLog.MaxEntries,
+		},
 	}
 }
@@ -189,6 +189,31 @@ func TestAuthorizationConfig_Validate(t *testing.T) {
 			},
 			expectedErrors: nil,
 		},
+		{
+			name: "decision log enabled requires valid settings",
+			cfg: AuthorizationConfig{
+				Enabled: true,
+				DecisionLog: AuthzDecisionLogConfig{
+					Enabled:    true,
+					SampleRate: 1.5,
+					Retention:  0,
+					MaxEntries: 0,
+				},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "authz.decision_log.sample_rate", Message: "must be between 0 and 1"},
+				{Field: "authz.decision_log.retention", Message: "must be greater than 0s"},
+				{Field: "authz.decision_log.max_entries", Message: "must be greater than 0"},
+			},
+		},
+		{
+			name: "decision log disabled skips validation",
+			cfg: AuthorizationConfig{
+				Enabled:     true,
+				DecisionLog: AuthzDecisionLogConfig{Enabled: false, SampleRate: 5},
+			},
+			expectedErrors: nil,
+		},
 		{
 			name: "cache disabled allows zero ttl",
 			cfg: AuthorizationConfig{
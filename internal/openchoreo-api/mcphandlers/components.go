@@ -111,6 +111,16 @@ func (h *MCPHandler) GetComponent(
 	return componentDetail(component), nil
 }
 
+// ComponentProject returns the project that owns the component. It resolves
+// project restrictions of MCP client scopes for tools that only name a component.
+func (h *MCPHandler) ComponentProject(ctx context.Context, namespaceName, componentName string) (string, error) {
+	component, err := h.services.ComponentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return "", err
+	}
+	return component.Spec.Owner.ProjectName, nil
+}
+
 func (h *MCPHandler) ListWorkloads(
 	ctx context.Context, namespaceName, componentName string, opts tools.ListOpts,
 ) (any, error) {
@@ -618,6 +628,32 @@ func (h *MCPHandler) GetWorkflowRunEvents(ctx context.Context, namespaceName, ru
 	return map[string]any{"events": events}, nil
 }
 
+func (h *MCPHandler) CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error) {
+	wr, err := h.services.WorkflowRunService.CancelWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(wr, "cancelled"), nil
+}
+
+func (h *MCPHandler) RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error) {
+	wr, err := h.services.WorkflowRunService.RetryWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(wr, "created", map[string]any{
+		"retryOf": runName,
+	}), nil
+}
+
+func (h *MCPHandler) ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error) {
+	wr, err := h.services.WorkflowRunService.ResumeWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(wr, "resumed"), nil
+}
+
 // ClusterComponentType operations
 
 func (h *MCPHandler) ListClusterComponentTypes(ctx context.Context, opts tools.ListOpts) (any, error) {
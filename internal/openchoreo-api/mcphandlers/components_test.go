@@ -672,6 +672,33 @@ func TestDeleteComponent(t *testing.T) {
 	})
 }
 
+func TestComponentProject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the owner project", func(t *testing.T) {
+		compSvc := componentmocks.NewMockService(t)
+		compSvc.EXPECT().GetComponent(mock.Anything, testNS, testComponent).Return(&openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent, Namespace: testNS},
+			Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "shop"}},
+		}, nil)
+
+		h := newTestHandler(withComponentService(compSvc))
+		project, err := h.ComponentProject(ctx, testNS, testComponent)
+		require.NoError(t, err)
+		assert.Equal(t, "shop", project)
+	})
+
+	t.Run("service error propagated", func(t *testing.T) {
+		expected := errors.New("not found")
+		compSvc := componentmocks.NewMockService(t)
+		compSvc.EXPECT().GetComponent(mock.Anything, testNS, testComponent).Return(nil, expected)
+
+		h := newTestHandler(withComponentService(compSvc))
+		_, err := h.ComponentProject(ctx, testNS, testComponent)
+		require.ErrorIs(t, err, expected)
+	})
+}
+
 func TestDeleteWorkload(t *testing.T) {
 	ctx := context.Background()
 
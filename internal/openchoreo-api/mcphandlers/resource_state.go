@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+var _ tools.ResourceStateHandler = (*MCPHandler)(nil)
+
+// GetProjectState returns the project with the first page of its components.
+func (h *MCPHandler) GetProjectState(ctx context.Context, namespaceName, projectName string) (any, error) {
+	project, err := h.services.ProjectService.GetProject(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	components, err := h.services.ComponentService.ListComponents(ctx, namespaceName, projectName,
+		services.ListOptions{Limit: tools.DefaultPageSize})
+	if err != nil {
+		return nil, err
+	}
+
+	state := projectSummary(*project)
+	if conds := conditionsSummary(project.Status.Conditions); conds != nil {
+		state["conditions"] = conds
+	}
+	summaries := make([]map[string]any, 0, len(components.Items))
+	for _, c := range components.Items {
+		summaries = append(summaries, componentSummary(c))
+	}
+	state["components"] = summaries
+	if components.NextCursor != "" {
+		// Only the first page is inlined; list_components pages through the rest
+		state["moreComponents"] = true
+	}
+	return state, nil
+}
+
+// GetComponentState returns the component with its release binding in each environment.
+func (h *MCPHandler) GetComponentState(
+	ctx context.Context, namespaceName, projectName, componentName string,
+) (any, error) {
+	component, err := h.services.ComponentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if component.Spec.Owner.ProjectName != projectName {
+		return nil, componentsvc.ErrComponentNotFound
+	}
+	bindings, err := h.services.ReleaseBindingService.ListReleaseBindings(ctx, namespaceName, componentName,
+		services.ListOptions{Limit: tools.DefaultPageSize})
+	if err != nil {
+		return nil, err
+	}
+
+	state := componentDetail(component)
+	summaries := make([]map[string]any, 0, len(bindings.Items))
+	for _, rb := range bindings.Items {
+		summaries = append(summaries, releaseBindingSummary(rb))
+	}
+	state["releaseBindings"] = summaries
+	return state, nil
+}
+
+// GetEnvironmentState returns the environment.
+func (h *MCPHandler) GetEnvironmentState(ctx context.Context, namespaceName, environmentName string) (any, error) {
+	env, err := h.services.EnvironmentService.GetEnvironment(ctx, namespaceName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	state := environmentSummary(*env)
+	if conds := conditionsSummary(env.Status.Conditions); conds != nil {
+		state["conditions"] = conds
+	}
+	return state, nil
+}
+
+// ResourceURIsForObject returns the URIs of the MCP resources whose state
+// includes the object, so that their subscribers can be notified when it changes.
+func ResourceURIsForObject(obj client.Object) []string {
+	ns := obj.GetNamespace()
+	switch o := obj.(type) {
+	case *openchoreov1alpha1.Project:
+		return []string{tools.ProjectResourceURI(ns, o.Name)}
+	case *openchoreov1alpha1.Component:
+		// Project state lists the components of the project
+		return []string{
+			tools.ComponentResourceURI(ns, o.Spec.Owner.ProjectName, o.Name),
+			tools.ProjectResourceURI(ns, o.Spec.Owner.ProjectName),
+		}
+	case *openchoreov1alpha1.ReleaseBinding:
+		return []string{tools.ComponentResourceURI(ns, o.Spec.Owner.ProjectName, o.Spec.Owner.ComponentName)}
+	case *openchoreov1alpha1.Environment:
+		return []string{tools.EnvironmentResourceURI(ns, o.Name)}
+	default:
+		return nil
+	}
+}
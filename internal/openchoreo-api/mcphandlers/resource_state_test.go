@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	componentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component/mocks"
+	projectmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project/mocks"
+	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+func TestGetProjectState(t *testing.T) {
+	ctx := context.Background()
+	projSvc := projectmocks.NewMockService(t)
+	compSvc := componentmocks.NewMockService(t)
+	projSvc.EXPECT().GetProject(mock.Anything, testNS, "proj").Return(&openchoreov1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj", Namespace: testNS},
+	}, nil)
+	compSvc.EXPECT().ListComponents(mock.Anything, testNS, "proj", services.ListOptions{Limit: tools.DefaultPageSize}).
+		Return(&services.ListResult[openchoreov1alpha1.Component]{
+			Items: []openchoreov1alpha1.Component{
+				{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: testNS}},
+			},
+			NextCursor: "next",
+		}, nil)
+
+	h := newTestHandler(withProjectService(projSvc), withComponentService(compSvc))
+	result, err := h.GetProjectState(ctx, testNS, "proj")
+	require.NoError(t, err)
+
+	state, ok := result.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "proj", state["name"])
+	assert.Len(t, state["components"], 1)
+	assert.Equal(t, true, state["moreComponents"])
+}
+
+func TestGetComponentState(t *testing.T) {
+	ctx := context.Background()
+	component := &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: testNS},
+		Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "proj"}},
+	}
+
+	t.Run("includes release bindings", func(t *testing.T) {
+		compSvc := componentmocks.NewMockService(t)
+		rbSvc := releasebindingmocks.NewMockService(t)
+		compSvc.EXPECT().GetComponent(mock.Anything, testNS, "api").Return(component, nil)
+		rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, "api", services.ListOptions{Limit: tools.DefaultPageSize}).
+			Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{
+				Items: []openchoreov1alpha1.ReleaseBinding{
+					{ObjectMeta: metav1.ObjectMeta{Name: "api-dev", Namespace: testNS}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "api-prod", Namespace: testNS}},
+				},
+			}, nil)
+
+		h := newTestHandler(withComponentService(compSvc), withReleaseBindingService(rbSvc))
+		result, err := h.GetComponentState(ctx, testNS, "proj", "api")
+		require.NoError(t, err)
+
+		state, ok := result.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, "api", state["name"])
+		assert.Len(t, state["releaseBindings"], 2)
+	})
+
+	t.Run("component of another project is not found", func(t *testing.T) {
+		compSvc := componentmocks.NewMockService(t)
+		compSvc.EXPECT().GetComponent(mock.Anything, testNS, "api").Return(component, nil)
+
+		h := newTestHandler(withComponentService(compSvc))
+		_, err := h.GetComponentState(ctx, testNS, "other", "api")
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+}
+
+func TestResourceURIsForObject(t *testing.T) {
+	meta := func(name string) metav1.ObjectMeta { return metav1.ObjectMeta{Name: name, Namespace: testNS} }
+	tests := []struct {
+		name string
+		obj  client.Object
+		want []string
+	}{
+		{
+			name: "project",
+			obj:  &openchoreov1alpha1.Project{ObjectMeta: meta("proj")},
+			want: []string{tools.ProjectResourceURI(testNS, "proj")},
+		},
+		{
+			name: "component notifies the component and its project",
+			obj: &openchoreov1alpha1.Component{
+				ObjectMeta: meta("api"),
+				Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "proj"}},
+			},
+			want: []string{tools.ComponentResourceURI(testNS, "proj", "api"), tools.ProjectResourceURI(testNS, "proj")},
+		},
+		{
+			name: "release binding notifies its component",
+			obj: &openchoreov1alpha1.ReleaseBinding{
+				ObjectMeta: meta("api-dev"),
+				Spec: openchoreov1alpha1.ReleaseBindingSpec{
+					Owner: openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "proj", ComponentName: "api"},
+				},
+			},
+			want: []string{tools.ComponentResourceURI(testNS, "proj", "api")},
+		},
+		{
+			name: "environment",
+			obj:  &openchoreov1alpha1.Environment{ObjectMeta: meta("dev")},
+			want: []string{tools.EnvironmentResourceURI(testNS, "dev")},
+		},
+		{
+			name: "unrelated kind",
+			obj:  &openchoreov1alpha1.DataPlane{ObjectMeta: meta("default")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResourceURIsForObject(tt.obj))
+		})
+	}
+}
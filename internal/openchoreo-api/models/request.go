@@ -559,3 +559,18 @@ func (req *CreateWorkflowRunRequest) Validate() error {
 func (req *CreateWorkflowRunRequest) Sanitize() {
 	req.WorkflowName = strings.TrimSpace(req.WorkflowName)
 }
+
+// TriggerWorkflowGroupRequest represents a request to build several components of a namespace
+// from the same commit as one workflow run group
+type TriggerWorkflowGroupRequest struct {
+	Commit         string                   `json:"commit,omitempty"`
+	Components     []WorkflowGroupComponent `json:"components"`
+	MaxConcurrency *int32                   `json:"maxConcurrency,omitempty"`
+	FailFast       bool                     `json:"failFast,omitempty"`
+}
+
+// WorkflowGroupComponent identifies a component built by a workflow run group
+type WorkflowGroupComponent struct {
+	ProjectName   string `json:"projectName"`
+	ComponentName string `json:"componentName"`
+}
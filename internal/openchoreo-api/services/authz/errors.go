@@ -14,4 +14,6 @@ var (
 	ErrRoleInUse                = authzcore.ErrRoleInUse
 	ErrRoleBindingNotFound      = authzcore.ErrRoleMappingNotFound
 	ErrRoleBindingAlreadyExists = authzcore.ErrRoleMappingAlreadyExists
+	ErrExplainNotSupported      = authzcore.ErrExplainNotSupported
+	ErrDecisionLogDisabled      = authzcore.ErrDecisionLogDisabled
 )
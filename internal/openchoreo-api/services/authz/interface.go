@@ -46,4 +46,11 @@ type Service interface {
 	Evaluate(ctx context.Context, requests []authzcore.EvaluateRequest) ([]authzcore.Decision, error)
 	ListActions(ctx context.Context) ([]authzcore.Action, error)
 	GetSubjectProfile(ctx context.Context, request *authzcore.ProfileRequest) (*authzcore.UserCapabilitiesResponse, error)
+
+	// Explanation & Simulation
+	Explain(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Explanation, error)
+	Simulate(ctx context.Context, request *authzcore.SimulateRequest) (*authzcore.Explanation, error)
+
+	// Decision Log
+	ListDecisions(ctx context.Context, query authzcore.DecisionLogQuery) ([]authzcore.DecisionRecord, error)
 }
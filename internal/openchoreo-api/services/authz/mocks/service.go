@@ -513,6 +513,65 @@ func (_c *MockService_Evaluate_Call) RunAndReturn(run func(context.Context, []co
 	return _c
 }
 
+// Explain provides a mock function with given fields: ctx, request
+func (_m *MockService) Explain(ctx context.Context, request *core.EvaluateRequest) (*core.Explanation, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Explain")
+	}
+
+	var r0 *core.Explanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *core.EvaluateRequest) (*core.Explanation, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *core.EvaluateRequest) *core.Explanation); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Explanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *core.EvaluateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type MockService_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.EvaluateRequest
+func (_e *MockService_Expecter) Explain(ctx interface{}, request interface{}) *MockService_Explain_Call {
+	return &MockService_Explain_Call{Call: _e.mock.On("Explain", ctx, request)}
+}
+
+func (_c *MockService_Explain_Call) Run(run func(ctx context.Context, request *core.EvaluateRequest)) *MockService_Explain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*core.EvaluateRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_Explain_Call) Return(_a0 *core.Explanation, _a1 error) *MockService_Explain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Explain_Call) RunAndReturn(run func(context.Context, *core.EvaluateRequest) (*core.Explanation, error)) *MockService_Explain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClusterRole provides a mock function with given fields: ctx, name
 func (_m *MockService) GetClusterRole(ctx context.Context, name string) (*v1alpha1.ClusterAuthzRole, error) {
 	ret := _m.Called(ctx, name)
@@ -986,6 +1045,65 @@ func (_c *MockService_ListClusterRoles_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListDecisions provides a mock function with given fields: ctx, query
+func (_m *MockService) ListDecisions(ctx context.Context, query core.DecisionLogQuery) ([]core.DecisionRecord, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDecisions")
+	}
+
+	var r0 []core.DecisionRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, core.DecisionLogQuery) ([]core.DecisionRecord, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, core.DecisionLogQuery) []core.DecisionRecord); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.DecisionRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, core.DecisionLogQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListDecisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDecisions'
+type MockService_ListDecisions_Call struct {
+	*mock.Call
+}
+
+// ListDecisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query core.DecisionLogQuery
+func (_e *MockService_Expecter) ListDecisions(ctx interface{}, query interface{}) *MockService_ListDecisions_Call {
+	return &MockService_ListDecisions_Call{Call: _e.mock.On("ListDecisions", ctx, query)}
+}
+
+func (_c *MockService_ListDecisions_Call) Run(run func(ctx context.Context, query core.DecisionLogQuery)) *MockService_ListDecisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(core.DecisionLogQuery))
+	})
+	return _c
+}
+
+func (_c *MockService_ListDecisions_Call) Return(_a0 []core.DecisionRecord, _a1 error) *MockService_ListDecisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListDecisions_Call) RunAndReturn(run func(context.Context, core.DecisionLogQuery) ([]core.DecisionRecord, error)) *MockService_ListDecisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNamespaceRoleBindings provides a mock function with given fields: ctx, namespace, opts
 func (_m *MockService) ListNamespaceRoleBindings(ctx context.Context, namespace string, opts services.ListOptions) (*services.ListResult[v1alpha1.AuthzRoleBinding], error) {
 	ret := _m.Called(ctx, namespace, opts)
@@ -1106,6 +1224,65 @@ func (_c *MockService_ListNamespaceRoles_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// Simulate provides a mock function with given fields: ctx, request
+func (_m *MockService) Simulate(ctx context.Context, request *core.SimulateRequest) (*core.Explanation, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Simulate")
+	}
+
+	var r0 *core.Explanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *core.SimulateRequest) (*core.Explanation, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *core.SimulateRequest) *core.Explanation); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Explanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *core.SimulateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Simulate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Simulate'
+type MockService_Simulate_Call struct {
+	*mock.Call
+}
+
+// Simulate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.SimulateRequest
+func (_e *MockService_Expecter) Simulate(ctx interface{}, request interface{}) *MockService_Simulate_Call {
+	return &MockService_Simulate_Call{Call: _e.mock.On("Simulate", ctx, request)}
+}
+
+func (_c *MockService_Simulate_Call) Run(run func(ctx context.Context, request *core.SimulateRequest)) *MockService_Simulate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*core.SimulateRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_Simulate_Call) Return(_a0 *core.Explanation, _a1 error) *MockService_Simulate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Simulate_Call) RunAndReturn(run func(context.Context, *core.SimulateRequest) (*core.Explanation, error)) *MockService_Simulate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateClusterRole provides a mock function with given fields: ctx, role
 func (_m *MockService) UpdateClusterRole(ctx context.Context, role *v1alpha1.ClusterAuthzRole) (*v1alpha1.ClusterAuthzRole, error) {
 	ret := _m.Called(ctx, role)
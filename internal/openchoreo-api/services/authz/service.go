@@ -336,3 +336,35 @@ func (s *authzService) GetSubjectProfile(ctx context.Context, request *authzcore
 	s.logger.Debug("Getting subject profile")
 	return s.pdp.GetSubjectProfile(ctx, request)
 }
+
+// Explain evaluates a request and returns the decision along with the policies that matched it.
+func (s *authzService) Explain(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Explanation, error) {
+	s.logger.Debug("Explaining authorization request", "action", request.Action)
+	explainer, ok := s.pdp.(authzcore.PolicyExplainer)
+	if !ok {
+		return nil, ErrExplainNotSupported
+	}
+	return explainer.Explain(ctx, request)
+}
+
+// Simulate explains a request against the current policies overlaid with proposed roles and bindings.
+func (s *authzService) Simulate(ctx context.Context, request *authzcore.SimulateRequest) (*authzcore.Explanation, error) {
+	s.logger.Debug("Simulating authorization request", "action", request.Request.Action,
+		"clusterRoles", len(request.ClusterRoles), "roles", len(request.Roles),
+		"clusterRoleBindings", len(request.ClusterRoleBindings), "roleBindings", len(request.RoleBindings))
+	explainer, ok := s.pdp.(authzcore.PolicyExplainer)
+	if !ok {
+		return nil, ErrExplainNotSupported
+	}
+	return explainer.Simulate(ctx, request)
+}
+
+// ListDecisions returns the logged authorization decisions matching the query, newest first.
+func (s *authzService) ListDecisions(ctx context.Context, query authzcore.DecisionLogQuery) ([]authzcore.DecisionRecord, error) {
+	s.logger.Debug("Listing authorization decisions", "namespace", query.Namespace, "action", query.Action)
+	reader, ok := s.pdp.(authzcore.DecisionLogReader)
+	if !ok {
+		return nil, ErrDecisionLogDisabled
+	}
+	return reader.ListDecisions(ctx, query)
+}
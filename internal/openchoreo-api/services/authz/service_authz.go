@@ -6,10 +6,12 @@ package authz
 import (
 	"context"
 	"log/slog"
+	"slices"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 )
 
 const (
@@ -285,3 +287,66 @@ func (s *authzServiceWithAuthz) ListActions(ctx context.Context) ([]authzcore.Ac
 func (s *authzServiceWithAuthz) GetSubjectProfile(ctx context.Context, request *authzcore.ProfileRequest) (*authzcore.UserCapabilitiesResponse, error) {
 	return s.internal.GetSubjectProfile(ctx, request)
 }
+
+// --- Explanation & Simulation ---
+
+// Explain lets callers explain their own decisions. Explaining a decision for another subject
+// reveals its bindings and requires permission to view role bindings in the request's namespace.
+func (s *authzServiceWithAuthz) Explain(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Explanation, error) {
+	if !isCallerSubject(ctx, request.SubjectContext) {
+		if err := s.checkViewBindings(ctx, request.Resource.Hierarchy.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.Explain(ctx, request)
+}
+
+// Simulate requires permission to view role bindings in the request's namespace, since the
+// explanation includes the existing bindings alongside the proposed ones.
+func (s *authzServiceWithAuthz) Simulate(ctx context.Context, request *authzcore.SimulateRequest) (*authzcore.Explanation, error) {
+	if err := s.checkViewBindings(ctx, request.Request.Resource.Hierarchy.Namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.Simulate(ctx, request)
+}
+
+// --- Decision Log ---
+
+// ListDecisions requires permission to view role bindings in the queried namespace, or the
+// cluster role bindings when the query spans all namespaces, since decisions reveal bindings.
+func (s *authzServiceWithAuthz) ListDecisions(ctx context.Context, query authzcore.DecisionLogQuery) ([]authzcore.DecisionRecord, error) {
+	if err := s.checkViewBindings(ctx, query.Namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.ListDecisions(ctx, query)
+}
+
+// checkViewBindings checks that the caller may view the role bindings of a namespace, or the
+// cluster role bindings when no namespace is given.
+func (s *authzServiceWithAuthz) checkViewBindings(ctx context.Context, namespace string) error {
+	if namespace == "" {
+		return s.authz.Check(ctx, services.CheckRequest{
+			Action:       authzcore.ActionViewClusterAuthzRoleBinding,
+			ResourceType: resourceTypeClusterAuthzRoleBinding,
+		})
+	}
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       authzcore.ActionViewAuthzRoleBinding,
+		ResourceType: resourceTypeAuthzRoleBinding,
+		Hierarchy:    authzcore.ResourceHierarchy{Namespace: namespace},
+	})
+}
+
+// isCallerSubject reports whether subject only carries entitlements of the authenticated caller.
+func isCallerSubject(ctx context.Context, subject *authzcore.SubjectContext) bool {
+	caller, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok || caller == nil || subject == nil || subject.EntitlementClaim != caller.EntitlementClaim {
+		return false
+	}
+	for _, value := range subject.EntitlementValues {
+		if !slices.Contains(caller.EntitlementValues, value) {
+			return false
+		}
+	}
+	return true
+}
@@ -183,6 +183,24 @@ func (m *mockService) GetSubjectProfile(ctx context.Context, request *authzcore.
 	return res, args.Error(1)
 }
 
+func (m *mockService) Explain(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Explanation, error) {
+	args := m.Called(ctx, request)
+	res, _ := args.Get(0).(*authzcore.Explanation)
+	return res, args.Error(1)
+}
+
+func (m *mockService) Simulate(ctx context.Context, request *authzcore.SimulateRequest) (*authzcore.Explanation, error) {
+	args := m.Called(ctx, request)
+	res, _ := args.Get(0).(*authzcore.Explanation)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ListDecisions(ctx context.Context, query authzcore.DecisionLogQuery) ([]authzcore.DecisionRecord, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).([]authzcore.DecisionRecord)
+	return res, args.Error(1)
+}
+
 func testClusterAuthzRole(name string) *openchoreov1alpha1.ClusterAuthzRole {
 	return &openchoreov1alpha1.ClusterAuthzRole{ObjectMeta: metav1.ObjectMeta{Name: name}}
 }
@@ -675,3 +693,92 @@ func TestGetSubjectProfile_NoAuthzCheck(t *testing.T) {
 	require.Equal(t, profile, out)
 	require.Empty(t, pdp.Captured, "wrapper should not run PDP for GetSubjectProfile")
 }
+
+func explainRequest(namespace string, values ...string) *authzcore.EvaluateRequest {
+	return &authzcore.EvaluateRequest{
+		SubjectContext: &authzcore.SubjectContext{Type: "user", EntitlementClaim: "groups", EntitlementValues: values},
+		Resource:       authzcore.Resource{Type: "component", Hierarchy: authzcore.ResourceHierarchy{Namespace: namespace}},
+		Action:         authzcore.ActionViewComponent,
+	}
+}
+
+func TestExplain_OwnSubjectNoAuthzCheck(t *testing.T) {
+	pdp := testutil.DenyPDP()
+	svc, mockSvc := newTestAuthzService(t, pdp)
+	req := explainRequest("ns-1", "org-admins")
+	explanation := &authzcore.Explanation{Decision: true}
+	mockSvc.On("Explain", mock.Anything, req).Return(explanation, nil)
+
+	out, err := svc.Explain(testutil.AuthzContext(), req)
+	require.NoError(t, err)
+	require.Equal(t, explanation, out)
+	require.Empty(t, pdp.Captured, "wrapper should not run PDP when explaining the caller's own decision")
+}
+
+func TestExplain_OtherSubject(t *testing.T) {
+	t.Run("allowed with view binding permission", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		svc, mockSvc := newTestAuthzService(t, pdp)
+		req := explainRequest("ns-1", "contractors")
+		mockSvc.On("Explain", mock.Anything, req).Return(&authzcore.Explanation{}, nil)
+
+		_, err := svc.Explain(testutil.AuthzContext(), req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionViewAuthzRoleBinding, rtAuthzRoleBinding, "", nsHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc, _ := newTestAuthzService(t, testutil.DenyPDP())
+		_, err := svc.Explain(testutil.AuthzContext(), explainRequest("ns-1", "org-admins", "contractors"))
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestSimulate_AuthzCheck(t *testing.T) {
+	t.Run("namespaced request checks namespace bindings", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		svc, mockSvc := newTestAuthzService(t, pdp)
+		req := &authzcore.SimulateRequest{Request: *explainRequest("ns-1", "org-admins")}
+		mockSvc.On("Simulate", mock.Anything, req).Return(&authzcore.Explanation{}, nil)
+
+		_, err := svc.Simulate(testutil.AuthzContext(), req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionViewAuthzRoleBinding, rtAuthzRoleBinding, "", nsHierarchy)
+	})
+
+	t.Run("cluster request checks cluster bindings", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		svc, _ := newTestAuthzService(t, pdp)
+
+		_, err := svc.Simulate(testutil.AuthzContext(), &authzcore.SimulateRequest{Request: *explainRequest("", "org-admins")})
+		require.ErrorIs(t, err, services.ErrForbidden)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionViewClusterAuthzRoleBinding, rtClusterAuthzRoleBinding, "", emptyHierarchy)
+	})
+}
+
+func TestListDecisions_AuthzCheck(t *testing.T) {
+	t.Run("namespace query checks namespace bindings", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		svc, mockSvc := newTestAuthzService(t, pdp)
+		query := authzcore.DecisionLogQuery{Namespace: "ns-1"}
+		mockSvc.On("ListDecisions", mock.Anything, query).Return([]authzcore.DecisionRecord{}, nil)
+
+		_, err := svc.ListDecisions(testutil.AuthzContext(), query)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionViewAuthzRoleBinding, rtAuthzRoleBinding, "", nsHierarchy)
+	})
+
+	t.Run("query across namespaces checks cluster bindings", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		svc, _ := newTestAuthzService(t, pdp)
+
+		_, err := svc.ListDecisions(testutil.AuthzContext(), authzcore.DecisionLogQuery{})
+		require.ErrorIs(t, err, services.ErrForbidden)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionViewClusterAuthzRoleBinding, rtClusterAuthzRoleBinding, "", emptyHierarchy)
+	})
+}
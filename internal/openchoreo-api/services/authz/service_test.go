@@ -771,6 +771,87 @@ func TestGetSubjectProfile(t *testing.T) {
 	})
 }
 
+// explainingPDP is a PDP that can also explain its decisions.
+type explainingPDP struct {
+	*authzcoremocks.MockPDP
+	*authzcoremocks.MockPolicyExplainer
+}
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	req := &authzcore.EvaluateRequest{Action: "component:view"}
+
+	t.Run("delegates to explaining PDP", func(t *testing.T) {
+		explainer := authzcoremocks.NewMockPolicyExplainer(t)
+		svc := NewService(authzcoremocks.NewMockPAP(t),
+			explainingPDP{authzcoremocks.NewMockPDP(t), explainer}, testutil.TestLogger())
+		explanation := &authzcore.Explanation{Decision: true, Reason: "allowed"}
+		explainer.On("Explain", mock.Anything, req).Return(explanation, nil)
+
+		result, err := svc.Explain(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, explanation, result)
+	})
+
+	t.Run("pdp without explain support", func(t *testing.T) {
+		svc, _, _ := newService(t)
+		_, err := svc.Explain(ctx, req)
+		require.ErrorIs(t, err, ErrExplainNotSupported)
+	})
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	req := &authzcore.SimulateRequest{Request: authzcore.EvaluateRequest{Action: "component:view"}, IgnoreExisting: true}
+
+	t.Run("delegates to explaining PDP", func(t *testing.T) {
+		explainer := authzcoremocks.NewMockPolicyExplainer(t)
+		svc := NewService(authzcoremocks.NewMockPAP(t),
+			explainingPDP{authzcoremocks.NewMockPDP(t), explainer}, testutil.TestLogger())
+		explanation := &authzcore.Explanation{Reason: "no matching policies found"}
+		explainer.On("Simulate", mock.Anything, req).Return(explanation, nil)
+
+		result, err := svc.Simulate(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, explanation, result)
+	})
+
+	t.Run("pdp without explain support", func(t *testing.T) {
+		svc, _, _ := newService(t)
+		_, err := svc.Simulate(ctx, req)
+		require.ErrorIs(t, err, ErrExplainNotSupported)
+	})
+}
+
+// decisionLoggingPDP is a PDP that keeps a decision log.
+type decisionLoggingPDP struct {
+	*authzcoremocks.MockPDP
+	*authzcoremocks.MockDecisionLogReader
+}
+
+func TestListDecisions(t *testing.T) {
+	ctx := context.Background()
+	query := authzcore.DecisionLogQuery{Namespace: "acme", Limit: 10}
+
+	t.Run("delegates to decision logging PDP", func(t *testing.T) {
+		reader := authzcoremocks.NewMockDecisionLogReader(t)
+		svc := NewService(authzcoremocks.NewMockPAP(t),
+			decisionLoggingPDP{authzcoremocks.NewMockPDP(t), reader}, testutil.TestLogger())
+		records := []authzcore.DecisionRecord{{Action: "component:view", Decision: true}}
+		reader.On("ListDecisions", mock.Anything, query).Return(records, nil)
+
+		result, err := svc.ListDecisions(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, records, result)
+	})
+
+	t.Run("pdp without decision log", func(t *testing.T) {
+		svc, _, _ := newService(t)
+		_, err := svc.ListDecisions(ctx, query)
+		require.ErrorIs(t, err, ErrDecisionLogDisabled)
+	})
+}
+
 // TestNewServiceWithAuthz verifies the constructor returns a non-nil Service.
 func TestNewServiceWithAuthz(t *testing.T) {
 	pap := authzcoremocks.NewMockPAP(t)
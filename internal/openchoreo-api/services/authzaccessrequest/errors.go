@@ -0,0 +1,14 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import "errors"
+
+var (
+	ErrAccessRequestNotFound      = errors.New("access request not found")
+	ErrAccessRequestAlreadyExists = errors.New("access request already exists")
+	ErrAccessRequestNotPending    = errors.New("access request is not pending")
+	ErrSelfReview                 = errors.New("access requests cannot be reviewed by their requester")
+	ErrUnauthenticated            = errors.New("access requests require an authenticated subject")
+)
@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// Service defines the access request service interface.
+// Requests are made, approved and denied on behalf of the authenticated caller.
+type Service interface {
+	CreateAccessRequest(ctx context.Context, namespaceName string, ar *openchoreov1alpha1.AuthzAccessRequest) (*openchoreov1alpha1.AuthzAccessRequest, error)
+	ListAccessRequests(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.AuthzAccessRequest], error)
+	GetAccessRequest(ctx context.Context, namespaceName, name string) (*openchoreov1alpha1.AuthzAccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, namespaceName, name, comment string) (*openchoreov1alpha1.AuthzAccessRequest, error)
+	DenyAccessRequest(ctx context.Context, namespaceName, name, comment string) (*openchoreov1alpha1.AuthzAccessRequest, error)
+}
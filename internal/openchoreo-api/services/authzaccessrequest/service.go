@@ -0,0 +1,199 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// accessRequestService handles access request business logic without authorization checks.
+// Other services within this layer should use this directly to avoid double authz.
+type accessRequestService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var accessRequestTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "AuthzAccessRequest",
+}
+
+var _ Service = (*accessRequestService)(nil)
+
+// NewService creates a new access request service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &accessRequestService{
+		k8sClient: k8sClient,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (s *accessRequestService) CreateAccessRequest(ctx context.Context, namespaceName string, ar *openchoreov1alpha1.AuthzAccessRequest) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	if ar == nil {
+		return nil, fmt.Errorf("access request cannot be nil")
+	}
+	subject, err := requireSubject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The entitlement defaults to the caller's claim and must be one the caller holds,
+	// so a request cannot grant access to somebody else.
+	if ar.Spec.Entitlement.Claim == "" {
+		ar.Spec.Entitlement.Claim = subject.EntitlementClaim
+	}
+	if ar.Spec.Entitlement.Claim != subject.EntitlementClaim ||
+		!slices.Contains(subject.EntitlementValues, ar.Spec.Entitlement.Value) {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("entitlement %s:%s is not held by the requester",
+				ar.Spec.Entitlement.Claim, ar.Spec.Entitlement.Value),
+		}
+	}
+
+	s.logger.Debug("Creating access request", "namespace", namespaceName, "accessRequest", ar.Name, "requester", subject.ID)
+
+	ar.Namespace = namespaceName
+	if ar.Name == "" && ar.GenerateName == "" {
+		ar.GenerateName = ar.Spec.RoleRef.Name + "-"
+	}
+	ar.Spec.Requester = subject.ID
+	ar.Status = openchoreov1alpha1.AuthzAccessRequestStatus{}
+	if err := s.k8sClient.Create(ctx, ar); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			s.logger.Warn("Access request already exists", "namespace", namespaceName, "accessRequest", ar.Name)
+			return nil, ErrAccessRequestAlreadyExists
+		}
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to create access request CR", "error", err)
+		return nil, fmt.Errorf("failed to create access request: %w", err)
+	}
+
+	s.logger.Debug("Access request created successfully", "namespace", namespaceName, "accessRequest", ar.Name)
+	ar.TypeMeta = accessRequestTypeMeta
+	return ar, nil
+}
+
+func (s *accessRequestService) ListAccessRequests(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.AuthzAccessRequest], error) {
+	s.logger.Debug("Listing access requests", "namespace", namespaceName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	commonOpts, err := services.BuildListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := append([]client.ListOption{client.InNamespace(namespaceName)}, commonOpts...)
+
+	var arList openchoreov1alpha1.AuthzAccessRequestList
+	if err := s.k8sClient.List(ctx, &arList, listOpts...); err != nil {
+		s.logger.Error("Failed to list access requests", "error", err)
+		return nil, fmt.Errorf("failed to list access requests: %w", err)
+	}
+
+	for i := range arList.Items {
+		arList.Items[i].TypeMeta = accessRequestTypeMeta
+	}
+
+	result := &services.ListResult[openchoreov1alpha1.AuthzAccessRequest]{
+		Items:      arList.Items,
+		NextCursor: arList.Continue,
+	}
+	if arList.RemainingItemCount != nil {
+		remaining := *arList.RemainingItemCount
+		result.RemainingCount = &remaining
+	}
+
+	s.logger.Debug("Listed access requests", "namespace", namespaceName, "count", len(arList.Items))
+	return result, nil
+}
+
+func (s *accessRequestService) GetAccessRequest(ctx context.Context, namespaceName, name string) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	s.logger.Debug("Getting access request", "namespace", namespaceName, "accessRequest", name)
+
+	ar := &openchoreov1alpha1.AuthzAccessRequest{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespaceName}, ar); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Access request not found", "namespace", namespaceName, "accessRequest", name)
+			return nil, ErrAccessRequestNotFound
+		}
+		s.logger.Error("Failed to get access request", "error", err)
+		return nil, fmt.Errorf("failed to get access request: %w", err)
+	}
+
+	ar.TypeMeta = accessRequestTypeMeta
+	return ar, nil
+}
+
+func (s *accessRequestService) ApproveAccessRequest(ctx context.Context, namespaceName, name, comment string) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	return s.review(ctx, namespaceName, name, comment, openchoreov1alpha1.AuthzAccessRequestApproved)
+}
+
+func (s *accessRequestService) DenyAccessRequest(ctx context.Context, namespaceName, name, comment string) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	return s.review(ctx, namespaceName, name, comment, openchoreov1alpha1.AuthzAccessRequestDenied)
+}
+
+// review records the approval or denial of a pending request by the caller. The access of an
+// approved request expires after the requested duration, counted from the approval.
+func (s *accessRequestService) review(ctx context.Context, namespaceName, name, comment string, phase openchoreov1alpha1.AuthzAccessRequestPhase) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	subject, err := requireSubject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ar, err := s.GetAccessRequest(ctx, namespaceName, name)
+	if err != nil {
+		return nil, err
+	}
+	if ar.Status.Phase != "" && ar.Status.Phase != openchoreov1alpha1.AuthzAccessRequestPending {
+		return nil, ErrAccessRequestNotPending
+	}
+	if ar.Spec.Requester == subject.ID {
+		return nil, ErrSelfReview
+	}
+
+	s.logger.Debug("Reviewing access request", "namespace", namespaceName, "accessRequest", name, "phase", phase, "reviewer", subject.ID)
+
+	now := metav1.NewTime(s.now())
+	ar.Status.Phase = phase
+	ar.Status.ReviewedBy = subject.ID
+	ar.Status.ReviewedAt = &now
+	ar.Status.ReviewComment = comment
+	if phase == openchoreov1alpha1.AuthzAccessRequestApproved {
+		ar.Status.ExpiresAt = &metav1.Time{Time: now.Add(ar.Spec.Duration.Duration)}
+	}
+
+	// The update fails with a conflict if the request was reviewed concurrently.
+	if err := s.k8sClient.Status().Update(ctx, ar); err != nil {
+		if apierrors.IsConflict(err) {
+			return nil, ErrAccessRequestNotPending
+		}
+		s.logger.Error("Failed to update access request status", "error", err)
+		return nil, fmt.Errorf("failed to update access request status: %w", err)
+	}
+
+	ar.TypeMeta = accessRequestTypeMeta
+	return ar, nil
+}
+
+func requireSubject(ctx context.Context) (*auth.SubjectContext, error) {
+	subject, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok || subject == nil || subject.ID == "" {
+		return nil, ErrUnauthenticated
+	}
+	return subject, nil
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const (
+	resourceTypeAccessRequest = "authzAccessRequest"
+)
+
+// Audit actions emitted for every attempt to request, approve or deny access, including denied attempts.
+const (
+	AuditActionRequestAccess = "request_access"
+	AuditActionApprove       = "approve_access_request"
+	AuditActionDeny          = "deny_access_request"
+)
+
+// accessRequestServiceWithAuthz wraps a Service and adds authorization checks and audit logging.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type accessRequestServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+	audit    *audit.Logger
+}
+
+var _ Service = (*accessRequestServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates an access request service with authorization checks and audit logging.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &accessRequestServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+		audit:    audit.NewLogger(logger, "openchoreo-api"),
+	}
+}
+
+func (s *accessRequestServiceWithAuthz) CreateAccessRequest(ctx context.Context, namespaceName string, ar *openchoreov1alpha1.AuthzAccessRequest) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateAuthzAccessRequest,
+		ResourceType: resourceTypeAccessRequest,
+		ResourceID:   ar.Name,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	})
+	created := ar
+	if err == nil {
+		created, err = s.internal.CreateAccessRequest(ctx, namespaceName, ar)
+	}
+	s.logAudit(ctx, AuditActionRequestAccess, namespaceName, ar, created, err)
+	return created, err
+}
+
+func (s *accessRequestServiceWithAuthz) ListAccessRequests(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.AuthzAccessRequest], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.AuthzAccessRequest], error) {
+			return s.internal.ListAccessRequests(ctx, namespaceName, pageOpts)
+		},
+		func(ar openchoreov1alpha1.AuthzAccessRequest) services.CheckRequest {
+			return services.CheckRequest{
+				Action:       authz.ActionViewAuthzAccessRequest,
+				ResourceType: resourceTypeAccessRequest,
+				ResourceID:   ar.Name,
+				Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+			}
+		},
+	)
+}
+
+// GetAccessRequest returns a request to callers allowed to view requests, and to its requester.
+func (s *accessRequestServiceWithAuthz) GetAccessRequest(ctx context.Context, namespaceName, name string) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewAuthzAccessRequest,
+		ResourceType: resourceTypeAccessRequest,
+		ResourceID:   name,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	})
+	if err != nil && !errors.Is(err, services.ErrForbidden) {
+		return nil, err
+	}
+	ar, getErr := s.internal.GetAccessRequest(ctx, namespaceName, name)
+	if err != nil {
+		// Do not reveal whether a request exists to callers who cannot view it.
+		if getErr != nil || !isRequester(ctx, ar) {
+			return nil, services.ErrForbidden
+		}
+	}
+	return ar, getErr
+}
+
+func (s *accessRequestServiceWithAuthz) ApproveAccessRequest(ctx context.Context, namespaceName, name, comment string) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	reviewed, err := s.review(ctx, namespaceName, name, func(ctx context.Context) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+		return s.internal.ApproveAccessRequest(ctx, namespaceName, name, comment)
+	})
+	s.logAudit(ctx, AuditActionApprove, namespaceName, &openchoreov1alpha1.AuthzAccessRequest{}, reviewed, err,
+		withName(name), withComment(comment))
+	if err != nil {
+		return nil, err
+	}
+	return reviewed, nil
+}
+
+func (s *accessRequestServiceWithAuthz) DenyAccessRequest(ctx context.Context, namespaceName, name, comment string) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	reviewed, err := s.review(ctx, namespaceName, name, func(ctx context.Context) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+		return s.internal.DenyAccessRequest(ctx, namespaceName, name, comment)
+	})
+	s.logAudit(ctx, AuditActionDeny, namespaceName, &openchoreov1alpha1.AuthzAccessRequest{}, reviewed, err,
+		withName(name), withComment(comment))
+	if err != nil {
+		return nil, err
+	}
+	return reviewed, nil
+}
+
+// review checks that the caller may approve requests for the scope the request asks access to,
+// then applies the review.
+func (s *accessRequestServiceWithAuthz) review(ctx context.Context, namespaceName, name string,
+	apply func(context.Context) (*openchoreov1alpha1.AuthzAccessRequest, error)) (*openchoreov1alpha1.AuthzAccessRequest, error) {
+	ar, err := s.internal.GetAccessRequest(ctx, namespaceName, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionApproveAuthzAccessRequest,
+		ResourceType: resourceTypeAccessRequest,
+		ResourceID:   name,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   ar.Spec.Scope.Project,
+			Component: ar.Spec.Scope.Component,
+			Resource:  ar.Spec.Scope.Resource,
+		},
+	}); err != nil {
+		return ar, err
+	}
+	return apply(ctx)
+}
+
+type auditOption func(*openchoreov1alpha1.AuthzAccessRequest, map[string]any)
+
+func withName(name string) auditOption {
+	return func(ar *openchoreov1alpha1.AuthzAccessRequest, _ map[string]any) {
+		if ar.Name == "" {
+			ar.Name = name
+		}
+	}
+}
+
+func withComment(comment string) auditOption {
+	return func(_ *openchoreov1alpha1.AuthzAccessRequest, metadata map[string]any) {
+		if comment != "" {
+			metadata["comment"] = comment
+		}
+	}
+}
+
+// logAudit emits the audit event of an access request operation. requested is the request as
+// submitted; result, when set, is the request after the operation and takes precedence.
+func (s *accessRequestServiceWithAuthz) logAudit(ctx context.Context, action, namespaceName string,
+	requested, result *openchoreov1alpha1.AuthzAccessRequest, err error, opts ...auditOption) {
+	ar := requested
+	if result != nil {
+		ar = result
+	}
+	if ar == nil {
+		ar = &openchoreov1alpha1.AuthzAccessRequest{}
+	}
+	ar = ar.DeepCopy()
+
+	metadata := map[string]any{"namespace": namespaceName}
+	for _, opt := range opts {
+		opt(ar, metadata)
+	}
+	if ar.Spec.Requester != "" {
+		metadata["requester"] = ar.Spec.Requester
+	}
+	if ar.Spec.RoleRef.Name != "" {
+		metadata["role"] = string(ar.Spec.RoleRef.Kind) + "/" + ar.Spec.RoleRef.Name
+		metadata["entitlement"] = ar.Spec.Entitlement.Claim + ":" + ar.Spec.Entitlement.Value
+		metadata["scope"] = authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   ar.Spec.Scope.Project,
+			Component: ar.Spec.Scope.Component,
+			Resource:  ar.Spec.Scope.Resource,
+		}
+		metadata["duration"] = ar.Spec.Duration.Duration.String()
+		metadata["reason"] = ar.Spec.Reason
+	}
+	if ar.Status.ExpiresAt != nil {
+		metadata["expiresAt"] = ar.Status.ExpiresAt.UTC()
+	}
+
+	outcome := audit.ResultSuccess
+	switch {
+	case errors.Is(err, services.ErrForbidden), errors.Is(err, ErrSelfReview), errors.Is(err, ErrUnauthenticated):
+		outcome = audit.ResultDenied
+	case err != nil:
+		outcome = audit.ResultFailure
+	}
+	if err != nil {
+		metadata["error"] = err.Error()
+	}
+
+	s.audit.LogEvent(&audit.Event{
+		Actor:    audit.ActorFromContext(ctx),
+		Action:   action,
+		Category: audit.CategoryAuth,
+		Resource: &audit.Resource{
+			Type: "authzaccessrequest",
+			ID:   namespaceName + "/" + ar.Name,
+			Name: ar.Name,
+		},
+		Result:   outcome,
+		Metadata: metadata,
+	})
+}
+
+func isRequester(ctx context.Context, ar *openchoreov1alpha1.AuthzAccessRequest) bool {
+	subject, ok := auth.GetSubjectContextFromContext(ctx)
+	return ok && subject != nil && subject.ID != "" && ar.Spec.Requester == subject.ID
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// auditRecord is the subset of an audit log line asserted by the tests.
+type auditRecord struct {
+	Action string `json:"action"`
+	Result string `json:"result"`
+	Actor  struct {
+		ID string `json:"id"`
+	} `json:"actor"`
+	Resource struct {
+		Name string `json:"name"`
+	} `json:"resource"`
+	Metadata map[string]any `json:"metadata"`
+}
+
+func newAuthzService(t *testing.T, pdp authzcore.PDP, objs ...client.Object) (Service, *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	return &accessRequestServiceWithAuthz{
+		internal: newService(t, objs...),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+		audit:    audit.NewLogger(slog.New(slog.NewJSONHandler(&buf, nil)), "openchoreo-api"),
+	}, &buf
+}
+
+func auditRecords(t *testing.T, buf *bytes.Buffer) []auditRecord {
+	t.Helper()
+	var records []auditRecord
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var r auditRecord
+		require.NoError(t, dec.Decode(&r))
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestCreateAccessRequest_Authz(t *testing.T) {
+	t.Run("allowed is audited", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		svc, buf := newAuthzService(t, pdp)
+
+		_, err := svc.CreateAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, newAccessRequest())
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionCreateAuthzAccessRequest,
+			resourceTypeAccessRequest, testARName, authzcore.ResourceHierarchy{Namespace: testNamespace})
+
+		records := auditRecords(t, buf)
+		require.Len(t, records, 1)
+		assert.Equal(t, AuditActionRequestAccess, records[0].Action)
+		assert.Equal(t, string(audit.ResultSuccess), records[0].Result)
+		assert.Equal(t, "alice", records[0].Actor.ID)
+		assert.Equal(t, "AuthzRole/prod-debugger", records[0].Metadata["role"])
+		assert.Equal(t, "groups:crm-oncall", records[0].Metadata["entitlement"])
+		assert.Equal(t, "2h0m0s", records[0].Metadata["duration"])
+	})
+
+	t.Run("denied is audited", func(t *testing.T) {
+		svc, buf := newAuthzService(t, testutil.DenyPDP())
+
+		_, err := svc.CreateAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, newAccessRequest())
+		require.ErrorIs(t, err, services.ErrForbidden)
+
+		records := auditRecords(t, buf)
+		require.Len(t, records, 1)
+		assert.Equal(t, string(audit.ResultDenied), records[0].Result)
+	})
+}
+
+func TestGetAccessRequest_Authz(t *testing.T) {
+	t.Run("requester may view own request", func(t *testing.T) {
+		svc, _ := newAuthzService(t, testutil.DenyPDP(), newAccessRequest())
+		result, err := svc.GetAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, testARName)
+		require.NoError(t, err)
+		assert.Equal(t, testARName, result.Name)
+	})
+
+	t.Run("others are forbidden", func(t *testing.T) {
+		svc, _ := newAuthzService(t, testutil.DenyPDP(), newAccessRequest())
+		_, err := svc.GetAccessRequest(subjectContext("mallory"), testNamespace, testARName)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("missing request is forbidden without view access", func(t *testing.T) {
+		svc, _ := newAuthzService(t, testutil.DenyPDP())
+		_, err := svc.GetAccessRequest(subjectContext("mallory"), testNamespace, testARName)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestApproveAccessRequest_Authz(t *testing.T) {
+	t.Run("checks approve on the requested scope", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		svc, buf := newAuthzService(t, pdp, newAccessRequest())
+
+		result, err := svc.ApproveAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "ok")
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestApproved, result.Status.Phase)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], authzcore.ActionApproveAuthzAccessRequest,
+			resourceTypeAccessRequest, testARName,
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "crm", Component: "backend"})
+
+		records := auditRecords(t, buf)
+		require.Len(t, records, 1)
+		assert.Equal(t, AuditActionApprove, records[0].Action)
+		assert.Equal(t, string(audit.ResultSuccess), records[0].Result)
+		assert.Equal(t, "bob", records[0].Actor.ID)
+		assert.Equal(t, testARName, records[0].Resource.Name)
+		assert.Equal(t, "alice", records[0].Metadata["requester"])
+		assert.Equal(t, "ok", records[0].Metadata["comment"])
+		assert.Contains(t, records[0].Metadata, "expiresAt")
+	})
+
+	t.Run("forbidden is audited as denied", func(t *testing.T) {
+		svc, buf := newAuthzService(t, testutil.DenyPDP(), newAccessRequest())
+
+		result, err := svc.ApproveAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+		assert.Nil(t, result)
+
+		records := auditRecords(t, buf)
+		require.Len(t, records, 1)
+		assert.Equal(t, string(audit.ResultDenied), records[0].Result)
+		assert.Equal(t, "AuthzRole/prod-debugger", records[0].Metadata["role"])
+	})
+
+	t.Run("self review is audited as denied", func(t *testing.T) {
+		svc, buf := newAuthzService(t, testutil.AllowPDP(), newAccessRequest())
+
+		_, err := svc.ApproveAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, testARName, "")
+		require.ErrorIs(t, err, ErrSelfReview)
+
+		records := auditRecords(t, buf)
+		require.Len(t, records, 1)
+		assert.Equal(t, string(audit.ResultDenied), records[0].Result)
+	})
+}
+
+func TestDenyAccessRequest_Authz(t *testing.T) {
+	svc, buf := newAuthzService(t, testutil.AllowPDP(), newAccessRequest())
+
+	result, err := svc.DenyAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "no")
+	require.NoError(t, err)
+	assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestDenied, result.Status.Phase)
+
+	records := auditRecords(t, buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, AuditActionDeny, records[0].Action)
+	assert.Equal(t, string(audit.ResultSuccess), records[0].Result)
+}
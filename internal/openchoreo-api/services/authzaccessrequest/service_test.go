@@ -0,0 +1,186 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authzaccessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const (
+	testNamespace = "acme-org"
+	testARName    = "oncall-crm-backend"
+)
+
+var testNow = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func newService(t *testing.T, objs ...client.Object) Service {
+	t.Helper()
+	return &accessRequestService{
+		k8sClient: testutil.NewFakeClient(objs...),
+		logger:    testutil.TestLogger(),
+		now:       func() time.Time { return testNow },
+	}
+}
+
+func subjectContext(id string, groups ...string) context.Context {
+	return auth.SetSubjectContext(context.Background(), &auth.SubjectContext{
+		ID:                id,
+		Type:              "user",
+		EntitlementClaim:  "groups",
+		EntitlementValues: groups,
+	})
+}
+
+func newAccessRequest(mutate ...func(*openchoreov1alpha1.AuthzAccessRequest)) *openchoreov1alpha1.AuthzAccessRequest {
+	ar := &openchoreov1alpha1.AuthzAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: testARName, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.AuthzAccessRequestSpec{
+			Requester:   "alice",
+			Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "groups", Value: "crm-oncall"},
+			RoleRef:     openchoreov1alpha1.RoleRef{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "prod-debugger"},
+			Scope:       openchoreov1alpha1.TargetScope{Project: "crm", Component: "backend"},
+			Duration:    metav1.Duration{Duration: 2 * time.Hour},
+			Reason:      "INC-4211",
+		},
+	}
+	for _, m := range mutate {
+		m(ar)
+	}
+	return ar
+}
+
+func TestCreateAccessRequest(t *testing.T) {
+	t.Run("success sets requester and defaults claim", func(t *testing.T) {
+		svc := newService(t)
+		ar := newAccessRequest(func(ar *openchoreov1alpha1.AuthzAccessRequest) {
+			ar.Spec.Requester = "mallory"
+			ar.Spec.Entitlement.Claim = ""
+			ar.Status.Phase = openchoreov1alpha1.AuthzAccessRequestApproved
+		})
+
+		result, err := svc.CreateAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, ar)
+		require.NoError(t, err)
+		assert.Equal(t, accessRequestTypeMeta, result.TypeMeta)
+		assert.Equal(t, testNamespace, result.Namespace)
+		assert.Equal(t, "alice", result.Spec.Requester)
+		assert.Equal(t, "groups", result.Spec.Entitlement.Claim)
+		assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestStatus{}, result.Status)
+	})
+
+	t.Run("generates name from role", func(t *testing.T) {
+		svc := newService(t)
+		ar := newAccessRequest(func(ar *openchoreov1alpha1.AuthzAccessRequest) { ar.Name = "" })
+
+		result, err := svc.CreateAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, ar)
+		require.NoError(t, err)
+		assert.Equal(t, "prod-debugger-", result.GenerateName)
+	})
+
+	t.Run("entitlement not held by requester", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.CreateAccessRequest(subjectContext("alice", "crm-dev"), testNamespace, newAccessRequest())
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, "groups:crm-oncall")
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		svc := newService(t)
+		_, err := svc.CreateAccessRequest(context.Background(), testNamespace, newAccessRequest())
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		svc := newService(t, newAccessRequest())
+		_, err := svc.CreateAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, newAccessRequest())
+		require.ErrorIs(t, err, ErrAccessRequestAlreadyExists)
+	})
+}
+
+func TestGetAccessRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := newService(t, newAccessRequest())
+		result, err := svc.GetAccessRequest(context.Background(), testNamespace, testARName)
+		require.NoError(t, err)
+		assert.Equal(t, accessRequestTypeMeta, result.TypeMeta)
+		assert.Equal(t, "alice", result.Spec.Requester)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+		_, err := svc.GetAccessRequest(context.Background(), testNamespace, "missing")
+		require.ErrorIs(t, err, ErrAccessRequestNotFound)
+	})
+}
+
+func TestListAccessRequests(t *testing.T) {
+	other := newAccessRequest(func(ar *openchoreov1alpha1.AuthzAccessRequest) { ar.Namespace = "other-ns" })
+	other.Name = "other"
+	svc := newService(t, newAccessRequest(), other)
+
+	result, err := svc.ListAccessRequests(context.Background(), testNamespace, services.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, testARName, result.Items[0].Name)
+	assert.Equal(t, accessRequestTypeMeta, result.Items[0].TypeMeta)
+}
+
+func TestApproveAccessRequest(t *testing.T) {
+	t.Run("success records reviewer and expiry", func(t *testing.T) {
+		svc := newService(t, newAccessRequest())
+
+		result, err := svc.ApproveAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "go ahead")
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestApproved, result.Status.Phase)
+		assert.Equal(t, "bob", result.Status.ReviewedBy)
+		assert.Equal(t, "go ahead", result.Status.ReviewComment)
+		require.NotNil(t, result.Status.ReviewedAt)
+		assert.True(t, result.Status.ReviewedAt.Time.Equal(testNow))
+		require.NotNil(t, result.Status.ExpiresAt)
+		assert.True(t, result.Status.ExpiresAt.Time.Equal(testNow.Add(2*time.Hour)))
+	})
+
+	t.Run("requester cannot approve own request", func(t *testing.T) {
+		svc := newService(t, newAccessRequest())
+		_, err := svc.ApproveAccessRequest(subjectContext("alice", "crm-oncall"), testNamespace, testARName, "")
+		require.ErrorIs(t, err, ErrSelfReview)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		svc := newService(t, newAccessRequest(func(ar *openchoreov1alpha1.AuthzAccessRequest) {
+			ar.Status.Phase = openchoreov1alpha1.AuthzAccessRequestDenied
+		}))
+		_, err := svc.ApproveAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "")
+		require.ErrorIs(t, err, ErrAccessRequestNotPending)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+		_, err := svc.ApproveAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "")
+		require.ErrorIs(t, err, ErrAccessRequestNotFound)
+	})
+}
+
+func TestDenyAccessRequest(t *testing.T) {
+	svc := newService(t, newAccessRequest())
+
+	result, err := svc.DenyAccessRequest(subjectContext("bob", "sre"), testNamespace, testARName, "not during the freeze")
+	require.NoError(t, err)
+	assert.Equal(t, openchoreov1alpha1.AuthzAccessRequestDenied, result.Status.Phase)
+	assert.Equal(t, "bob", result.Status.ReviewedBy)
+	assert.Nil(t, result.Status.ExpiresAt)
+}
@@ -26,6 +26,16 @@ import (
 // authenticated via HMAC signature validation instead).
 type WorkflowRunTrigger interface {
 	TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error)
+	TriggerWorkflowGroup(ctx context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest) (*v1alpha1.WorkflowRunGroup, error)
+}
+
+// GroupOptions configures the WorkflowRunGroup created when a push affects several components
+// of a namespace.
+type GroupOptions struct {
+	// MaxConcurrency caps the number of runs of the group that execute at the same time. 0 means unlimited.
+	MaxConcurrency int32
+	// FailFast cancels the remaining runs of the group once a run fails.
+	FailFast bool
 }
 
 // webhookProcessor handles webhook processing for all git providers.
@@ -36,14 +46,16 @@ var sshGitURLRegex = regexp.MustCompile(`^git@([^:]+):(.+)$`)
 type webhookProcessor struct {
 	k8sClient       client.Client
 	workflowTrigger WorkflowRunTrigger
+	groupOptions    GroupOptions
 	logger          *slog.Logger
 }
 
 // NewWebhookProcessor creates a new webhookProcessor that implements WebhookProcessor.
-func NewWebhookProcessor(k8sClient client.Client, workflowTrigger WorkflowRunTrigger, logger *slog.Logger) WebhookProcessor {
+func NewWebhookProcessor(k8sClient client.Client, workflowTrigger WorkflowRunTrigger, groupOptions GroupOptions, logger *slog.Logger) WebhookProcessor {
 	return &webhookProcessor{
 		k8sClient:       k8sClient,
 		workflowTrigger: workflowTrigger,
+		groupOptions:    groupOptions,
 		logger:          logger,
 	}
 }
@@ -73,42 +85,88 @@ func (s *webhookProcessor) ProcessWebhook(ctx context.Context, provider git.Prov
 
 	s.logger.Info("Found affected components", "count", len(affectedComponents))
 
-	// Trigger builds for affected components
-	triggeredComponents := make([]string, 0)
+	triggeredComponents := s.triggerBuilds(ctx, event.Commit, affectedComponents)
+
+	s.logger.Info("Webhook processing completed",
+		"affectedComponents", len(affectedComponents),
+		"triggeredBuilds", len(triggeredComponents))
+
+	return triggeredComponents, nil
+}
+
+// triggerBuilds triggers the builds of the affected components and returns the triggered
+// components as namespace/component. The builds of several components of a namespace are
+// triggered as one WorkflowRunGroup, so that the push is tracked and throttled as a whole.
+func (s *webhookProcessor) triggerBuilds(ctx context.Context, commit string, affectedComponents []*v1alpha1.Component) []string {
+	byNamespace := make(map[string][]*v1alpha1.Component)
+	var namespaces []string
 	for _, comp := range affectedComponents {
-		namespaceName := comp.Namespace
-		projectName := comp.Spec.Owner.ProjectName
-		componentName := comp.Name
+		if _, ok := byNamespace[comp.Namespace]; !ok {
+			namespaces = append(namespaces, comp.Namespace)
+		}
+		byNamespace[comp.Namespace] = append(byNamespace[comp.Namespace], comp)
+	}
 
-		s.logger.Info("Triggering build for component",
+	triggeredComponents := make([]string, 0)
+	for _, namespaceName := range namespaces {
+		components := byNamespace[namespaceName]
+		if len(components) == 1 {
+			if s.triggerBuild(ctx, components[0], commit) {
+				triggeredComponents = append(triggeredComponents, fmt.Sprintf("%s/%s", namespaceName, components[0].Name))
+			}
+			continue
+		}
+
+		req := &models.TriggerWorkflowGroupRequest{
+			Commit:   commit,
+			FailFast: s.groupOptions.FailFast,
+		}
+		if s.groupOptions.MaxConcurrency > 0 {
+			maxConcurrency := s.groupOptions.MaxConcurrency
+			req.MaxConcurrency = &maxConcurrency
+		}
+		for _, comp := range components {
+			req.Components = append(req.Components, models.WorkflowGroupComponent{
+				ProjectName:   comp.Spec.Owner.ProjectName,
+				ComponentName: comp.Name,
+			})
+		}
+
+		s.logger.Info("Triggering build group for components",
 			"namespace", namespaceName,
-			"project", projectName,
-			"component", componentName,
-			"commit", event.Commit)
-
-		_, err := s.workflowTrigger.TriggerWorkflow(
-			ctx,
-			namespaceName,
-			projectName,
-			componentName,
-			event.Commit,
-		)
+			"components", len(components),
+			"commit", commit)
+
+		group, err := s.workflowTrigger.TriggerWorkflowGroup(ctx, namespaceName, req)
 		if err != nil {
-			// Log error but continue processing other components
-			s.logger.Error("Failed to trigger build for component",
+			// Log error but continue processing other namespaces
+			s.logger.Error("Failed to trigger build group",
 				"error", err,
-				"component", componentName)
+				"namespace", namespaceName)
 			continue
 		}
-
-		triggeredComponents = append(triggeredComponents, fmt.Sprintf("%s/%s", comp.Namespace, componentName))
+		for _, run := range group.Spec.Runs {
+			triggeredComponents = append(triggeredComponents, fmt.Sprintf("%s/%s", namespaceName, run.Name))
+		}
 	}
+	return triggeredComponents
+}
 
-	s.logger.Info("Webhook processing completed",
-		"affectedComponents", len(affectedComponents),
-		"triggeredBuilds", len(triggeredComponents))
-
-	return triggeredComponents, nil
+// triggerBuild triggers a single workflow run for a component and reports whether it was created.
+func (s *webhookProcessor) triggerBuild(ctx context.Context, comp *v1alpha1.Component, commit string) bool {
+	s.logger.Info("Triggering build for component",
+		"namespace", comp.Namespace,
+		"project", comp.Spec.Owner.ProjectName,
+		"component", comp.Name,
+		"commit", commit)
+
+	if _, err := s.workflowTrigger.TriggerWorkflow(ctx, comp.Namespace, comp.Spec.Owner.ProjectName, comp.Name, commit); err != nil {
+		s.logger.Error("Failed to trigger build for component",
+			"error", err,
+			"component", comp.Name)
+		return false
+	}
+	return true
 }
 
 // findAffectedComponents finds all components that should be built based on the webhook event.
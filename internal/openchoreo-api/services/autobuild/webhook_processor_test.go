@@ -6,16 +6,20 @@ package autobuild
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"log/slog"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
 )
 
@@ -790,3 +794,87 @@ func TestWebhookBranchFilter_NoConfiguredBranch(t *testing.T) {
 		})
 	}
 }
+
+// fakeWorkflowTrigger records the workflow runs and groups it is asked to trigger.
+type fakeWorkflowTrigger struct {
+	runs      []string
+	groups    map[string]*models.TriggerWorkflowGroupRequest
+	failGroup bool
+}
+
+func (f *fakeWorkflowTrigger) TriggerWorkflow(_ context.Context, namespaceName, _, componentName, _ string) (*models.WorkflowRunTriggerResponse, error) {
+	f.runs = append(f.runs, namespaceName+"/"+componentName)
+	return &models.WorkflowRunTriggerResponse{Name: componentName + "-run"}, nil
+}
+
+func (f *fakeWorkflowTrigger) TriggerWorkflowGroup(_ context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest) (*v1alpha1.WorkflowRunGroup, error) {
+	if f.failGroup {
+		return nil, errors.New("group rejected")
+	}
+	if f.groups == nil {
+		f.groups = make(map[string]*models.TriggerWorkflowGroupRequest)
+	}
+	f.groups[namespaceName] = req
+	group := &v1alpha1.WorkflowRunGroup{}
+	for _, comp := range req.Components {
+		group.Spec.Runs = append(group.Spec.Runs, v1alpha1.WorkflowRunGroupRun{Name: comp.ComponentName})
+	}
+	return group, nil
+}
+
+func TestTriggerBuilds(t *testing.T) {
+	component := func(name, ns string) *v1alpha1.Component {
+		return &v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       v1alpha1.ComponentSpec{Owner: v1alpha1.ComponentOwner{ProjectName: "shop"}},
+		}
+	}
+	affected := []*v1alpha1.Component{
+		component("api", "ns1"),
+		component("web", "ns1"),
+		component("worker", "ns2"),
+	}
+
+	t.Run("groups the components of a namespace", func(t *testing.T) {
+		trigger := &fakeWorkflowTrigger{}
+		svc := &webhookProcessor{
+			workflowTrigger: trigger,
+			groupOptions:    GroupOptions{MaxConcurrency: 2, FailFast: true},
+			logger:          discardLogger(),
+		}
+
+		triggered := svc.triggerBuilds(context.Background(), "abc1234", affected)
+
+		assert.Equal(t, []string{"ns1/api", "ns1/web", "ns2/worker"}, triggered)
+		assert.Equal(t, []string{"ns2/worker"}, trigger.runs)
+		require.Contains(t, trigger.groups, "ns1")
+		req := trigger.groups["ns1"]
+		assert.Equal(t, "abc1234", req.Commit)
+		assert.True(t, req.FailFast)
+		require.NotNil(t, req.MaxConcurrency)
+		assert.Equal(t, int32(2), *req.MaxConcurrency)
+		assert.Equal(t, []models.WorkflowGroupComponent{
+			{ProjectName: "shop", ComponentName: "api"},
+			{ProjectName: "shop", ComponentName: "web"},
+		}, req.Components)
+	})
+
+	t.Run("unlimited concurrency leaves the cap unset", func(t *testing.T) {
+		trigger := &fakeWorkflowTrigger{}
+		svc := &webhookProcessor{workflowTrigger: trigger, logger: discardLogger()}
+
+		svc.triggerBuilds(context.Background(), "abc1234", affected)
+
+		require.Contains(t, trigger.groups, "ns1")
+		assert.Nil(t, trigger.groups["ns1"].MaxConcurrency)
+	})
+
+	t.Run("a failed group does not stop other namespaces", func(t *testing.T) {
+		trigger := &fakeWorkflowTrigger{failGroup: true}
+		svc := &webhookProcessor{workflowTrigger: trigger, logger: discardLogger()}
+
+		triggered := svc.triggerBuilds(context.Background(), "abc1234", affected)
+
+		assert.Equal(t, []string{"ns2/worker"}, triggered)
+	})
+}
@@ -5,6 +5,7 @@ package component
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -38,6 +39,7 @@ var componentReleaseTypeMeta = metav1.TypeMeta{
 type componentService struct {
 	k8sClient      client.Client
 	projectService projectsvc.Service
+	imageResolver  componentrelease.ResolverFactory
 	logger         *slog.Logger
 }
 
@@ -46,10 +48,12 @@ var _ Service = (*componentService)(nil)
 // NewService creates a new component service without authorization.
 // It internally creates an unwrapped project service for project validation,
 // avoiding double authz when used within the authz-wrapped component service.
-func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+// imageResolver pins workload images of generated releases to digests; nil disables pinning.
+func NewService(k8sClient client.Client, imageResolver componentrelease.ResolverFactory, logger *slog.Logger) Service {
 	return &componentService{
 		k8sClient:      k8sClient,
 		projectService: projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
+		imageResolver:  imageResolver,
 		logger:         logger,
 	}
 }
@@ -292,9 +296,10 @@ func (s *componentService) GenerateRelease(ctx context.Context, namespaceName, c
 		Container:    workload.Spec.Container,
 		Endpoints:    workload.Spec.Endpoints,
 		Dependencies: workload.Spec.Dependencies,
+		Provenance:   workload.Spec.Provenance,
 	}
 
-	crSpec, err := componentrelease.BuildSpec(componentrelease.BuildInput{
+	crSpec, err := componentrelease.BuildSpec(ctx, componentrelease.BuildInput{
 		Component: component,
 		ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
 			Kind: component.Spec.ComponentType.Kind,
@@ -304,8 +309,14 @@ func (s *componentService) GenerateRelease(ctx context.Context, namespaceName, c
 		Traits:        traits,
 		ClusterTraits: clusterTraits,
 		Workload:      &workloadTemplateSpec,
+		ImageResolver: s.imageResolver,
 	})
-	if err != nil {
+	var pinErr *componentrelease.ImagePinError
+	if errors.As(err, &pinErr) {
+		// Pinning is best-effort; the release keeps the image as authored
+		s.logger.Warn("Workload image not pinned to a digest", "namespace", namespaceName,
+			"component", componentName, "image", pinErr.Image, "error", pinErr.Err)
+	} else if err != nil {
 		return nil, fmt.Errorf("failed to build ComponentReleaseSpec: %w", err)
 	}
 
@@ -13,6 +13,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/componentrelease"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -31,9 +32,11 @@ type componentServiceWithAuthz struct {
 var _ Service = (*componentServiceWithAuthz)(nil)
 
 // NewServiceWithAuthz creates a component service with authorization checks.
-func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+func NewServiceWithAuthz(
+	k8sClient client.Client, imageResolver componentrelease.ResolverFactory, authzPDP authz.PDP, logger *slog.Logger,
+) Service {
 	return &componentServiceWithAuthz{
-		internal: NewService(k8sClient, logger),
+		internal: NewService(k8sClient, imageResolver, logger),
 		authz:    services.NewAuthzChecker(authzPDP, logger),
 	}
 }
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/componentrelease"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
@@ -42,7 +43,21 @@ func newService(t *testing.T, objs ...client.Object) Service {
 	t.Helper()
 	scheme := newScheme(t)
 	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
-	return NewService(k8sClient, testLogger())
+	return newServiceWithClient(k8sClient)
+}
+
+// newServiceWithClient returns a service that does not resolve image digests against registries.
+func newServiceWithClient(k8sClient client.Client) Service {
+	return NewService(k8sClient, nil, testLogger())
+}
+
+type fakeDigestResolver struct {
+	digest string
+	err    error
+}
+
+func (f fakeDigestResolver) ResolveDigest(context.Context, string) (string, error) {
+	return f.digest, f.err
 }
 
 func testLogger() *slog.Logger {
@@ -508,6 +523,32 @@ func TestGenerateRelease(t *testing.T) {
 		assert.Equal(t, testComponentName, result.Labels[labels.LabelKeyComponentName])
 	})
 
+	t.Run("pins workload image to a digest", func(t *testing.T) {
+		svc := newService(t, tier3SeedObjects()...).(*componentService)
+		svc.imageResolver = func(context.Context, *openchoreov1alpha1.Component) (componentrelease.DigestResolver, error) {
+			return fakeDigestResolver{digest: "sha256:0123"}, nil
+		}
+
+		result, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.NoError(t, err)
+		assert.Equal(t, "nginx@sha256:0123", result.Spec.Workload.Container.Image)
+		require.NotNil(t, result.Spec.Image)
+		assert.Equal(t, "nginx:latest", result.Spec.Image.Reference)
+		assert.Equal(t, "sha256:0123", result.Spec.Image.Digest)
+	})
+
+	t.Run("image digest resolution failure keeps the tag", func(t *testing.T) {
+		svc := newService(t, tier3SeedObjects()...).(*componentService)
+		svc.imageResolver = func(context.Context, *openchoreov1alpha1.Component) (componentrelease.DigestResolver, error) {
+			return fakeDigestResolver{err: errors.New("manifest unknown")}, nil
+		}
+
+		result, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.NoError(t, err)
+		assert.Equal(t, "nginx:latest", result.Spec.Workload.Container.Image)
+		assert.Nil(t, result.Spec.Image)
+	})
+
 	t.Run("success with auto-generated name", func(t *testing.T) {
 		svc := newService(t, tier3SeedObjects()...)
 
@@ -584,7 +625,7 @@ func TestGenerateRelease(t *testing.T) {
 				},
 			}).
 			Build()
-		svc := NewService(k8sClient, testLogger())
+		svc := newServiceWithClient(k8sClient)
 
 		_, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
 		require.Error(t, err)
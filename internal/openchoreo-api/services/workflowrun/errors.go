@@ -11,4 +11,9 @@ var (
 	ErrWorkflowNotFound             = errors.New("workflow not found")
 	ErrWorkflowRunReferenceNotFound = errors.New("workflow run reference not found")
 	ErrInvalidCommitSHA             = errors.New("invalid commit SHA format")
+	ErrWorkflowRunCompleted         = errors.New("workflow run has already completed")
+	ErrWorkflowRunNotCompleted      = errors.New("workflow run has not completed yet")
+	ErrWorkflowRunNotResumable      = errors.New("workflow run cannot be resumed")
+	ErrWorkflowRunGroupNotFound     = errors.New("workflow run group not found")
+	ErrWorkflowRunGroupEmpty        = errors.New("workflow run group has no components to build")
 )
@@ -21,7 +21,18 @@ type Service interface {
 	GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error)
 	GetWorkflowRunEvents(ctx context.Context, namespaceName, runName, taskName string) ([]models.WorkflowRunEventEntry, error)
 	DeleteWorkflowRun(ctx context.Context, namespaceName, runName string) error
+	// CancelWorkflowRun requests the termination of a workflow run that has not completed yet.
+	CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error)
+	// RetryWorkflowRun creates a new workflow run with the same workflow and parameters as a completed run.
+	RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error)
+	// ResumeWorkflowRun requests that a failed workflow run is resumed in place from its failed tasks.
+	ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error)
 	// TriggerWorkflow creates a WorkflowRun from a component's workflow configuration.
 	// The authorized version is used by API handlers; the unauthz version is used by webhook processing.
 	TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error)
+	// TriggerWorkflowGroup creates a WorkflowRunGroup that runs the workflows of several components
+	// of a namespace for the same commit. Components whose workflow cannot be run are left out.
+	TriggerWorkflowGroup(ctx context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest) (*openchoreov1alpha1.WorkflowRunGroup, error)
+	ListWorkflowRunGroups(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowRunGroup], error)
+	GetWorkflowRunGroup(ctx context.Context, namespaceName, groupName string) (*openchoreov1alpha1.WorkflowRunGroup, error)
 }
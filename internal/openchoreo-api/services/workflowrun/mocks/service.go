@@ -26,6 +26,66 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// CancelWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockService) CancelWorkflowRun(ctx context.Context, namespaceName string, runName string) (*v1alpha1.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelWorkflowRun")
+	}
+
+	var r0 *v1alpha1.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CancelWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelWorkflowRun'
+type MockService_CancelWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// CancelWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockService_Expecter) CancelWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}) *MockService_CancelWorkflowRun_Call {
+	return &MockService_CancelWorkflowRun_Call{Call: _e.mock.On("CancelWorkflowRun", ctx, namespaceName, runName)}
+}
+
+func (_c *MockService_CancelWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockService_CancelWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_CancelWorkflowRun_Call) Return(_a0 *v1alpha1.WorkflowRun, _a1 error) *MockService_CancelWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CancelWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.WorkflowRun, error)) *MockService_CancelWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorkflowRun provides a mock function with given fields: ctx, namespaceName, wfRun
 func (_m *MockService) CreateWorkflowRun(ctx context.Context, namespaceName string, wfRun *v1alpha1.WorkflowRun) (*v1alpha1.WorkflowRun, error) {
 	ret := _m.Called(ctx, namespaceName, wfRun)
@@ -255,6 +315,66 @@ func (_c *MockService_GetWorkflowRunEvents_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetWorkflowRunGroup provides a mock function with given fields: ctx, namespaceName, groupName
+func (_m *MockService) GetWorkflowRunGroup(ctx context.Context, namespaceName string, groupName string) (*v1alpha1.WorkflowRunGroup, error) {
+	ret := _m.Called(ctx, namespaceName, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunGroup")
+	}
+
+	var r0 *v1alpha1.WorkflowRunGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.WorkflowRunGroup, error)); ok {
+		return rf(ctx, namespaceName, groupName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.WorkflowRunGroup); ok {
+		r0 = rf(ctx, namespaceName, groupName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowRunGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, groupName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetWorkflowRunGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunGroup'
+type MockService_GetWorkflowRunGroup_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - groupName string
+func (_e *MockService_Expecter) GetWorkflowRunGroup(ctx interface{}, namespaceName interface{}, groupName interface{}) *MockService_GetWorkflowRunGroup_Call {
+	return &MockService_GetWorkflowRunGroup_Call{Call: _e.mock.On("GetWorkflowRunGroup", ctx, namespaceName, groupName)}
+}
+
+func (_c *MockService_GetWorkflowRunGroup_Call) Run(run func(ctx context.Context, namespaceName string, groupName string)) *MockService_GetWorkflowRunGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunGroup_Call) Return(_a0 *v1alpha1.WorkflowRunGroup, _a1 error) *MockService_GetWorkflowRunGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunGroup_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.WorkflowRunGroup, error)) *MockService_GetWorkflowRunGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRunLogs provides a mock function with given fields: ctx, namespaceName, runName, taskName, sinceSeconds
 func (_m *MockService) GetWorkflowRunLogs(ctx context.Context, namespaceName string, runName string, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error) {
 	ret := _m.Called(ctx, namespaceName, runName, taskName, sinceSeconds)
@@ -377,6 +497,66 @@ func (_c *MockService_GetWorkflowRunStatus_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListWorkflowRunGroups provides a mock function with given fields: ctx, namespaceName, opts
+func (_m *MockService) ListWorkflowRunGroups(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[v1alpha1.WorkflowRunGroup], error) {
+	ret := _m.Called(ctx, namespaceName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowRunGroups")
+	}
+
+	var r0 *services.ListResult[v1alpha1.WorkflowRunGroup]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, services.ListOptions) (*services.ListResult[v1alpha1.WorkflowRunGroup], error)); ok {
+		return rf(ctx, namespaceName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, services.ListOptions) *services.ListResult[v1alpha1.WorkflowRunGroup]); ok {
+		r0 = rf(ctx, namespaceName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ListResult[v1alpha1.WorkflowRunGroup])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, services.ListOptions) error); ok {
+		r1 = rf(ctx, namespaceName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListWorkflowRunGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowRunGroups'
+type MockService_ListWorkflowRunGroups_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowRunGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - opts services.ListOptions
+func (_e *MockService_Expecter) ListWorkflowRunGroups(ctx interface{}, namespaceName interface{}, opts interface{}) *MockService_ListWorkflowRunGroups_Call {
+	return &MockService_ListWorkflowRunGroups_Call{Call: _e.mock.On("ListWorkflowRunGroups", ctx, namespaceName, opts)}
+}
+
+func (_c *MockService_ListWorkflowRunGroups_Call) Run(run func(ctx context.Context, namespaceName string, opts services.ListOptions)) *MockService_ListWorkflowRunGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(services.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockService_ListWorkflowRunGroups_Call) Return(_a0 *services.ListResult[v1alpha1.WorkflowRunGroup], _a1 error) *MockService_ListWorkflowRunGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListWorkflowRunGroups_Call) RunAndReturn(run func(context.Context, string, services.ListOptions) (*services.ListResult[v1alpha1.WorkflowRunGroup], error)) *MockService_ListWorkflowRunGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowRuns provides a mock function with given fields: ctx, namespaceName, projectName, componentName, workflowName, opts
 func (_m *MockService) ListWorkflowRuns(ctx context.Context, namespaceName string, projectName string, componentName string, workflowName string, opts services.ListOptions) (*services.ListResult[v1alpha1.WorkflowRun], error) {
 	ret := _m.Called(ctx, namespaceName, projectName, componentName, workflowName, opts)
@@ -440,6 +620,126 @@ func (_c *MockService_ListWorkflowRuns_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ResumeWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockService) ResumeWorkflowRun(ctx context.Context, namespaceName string, runName string) (*v1alpha1.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeWorkflowRun")
+	}
+
+	var r0 *v1alpha1.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ResumeWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeWorkflowRun'
+type MockService_ResumeWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// ResumeWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockService_Expecter) ResumeWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}) *MockService_ResumeWorkflowRun_Call {
+	return &MockService_ResumeWorkflowRun_Call{Call: _e.mock.On("ResumeWorkflowRun", ctx, namespaceName, runName)}
+}
+
+func (_c *MockService_ResumeWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockService_ResumeWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ResumeWorkflowRun_Call) Return(_a0 *v1alpha1.WorkflowRun, _a1 error) *MockService_ResumeWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ResumeWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.WorkflowRun, error)) *MockService_ResumeWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockService) RetryWorkflowRun(ctx context.Context, namespaceName string, runName string) (*v1alpha1.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryWorkflowRun")
+	}
+
+	var r0 *v1alpha1.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RetryWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryWorkflowRun'
+type MockService_RetryWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// RetryWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockService_Expecter) RetryWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}) *MockService_RetryWorkflowRun_Call {
+	return &MockService_RetryWorkflowRun_Call{Call: _e.mock.On("RetryWorkflowRun", ctx, namespaceName, runName)}
+}
+
+func (_c *MockService_RetryWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockService_RetryWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RetryWorkflowRun_Call) Return(_a0 *v1alpha1.WorkflowRun, _a1 error) *MockService_RetryWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RetryWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.WorkflowRun, error)) *MockService_RetryWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TriggerWorkflow provides a mock function with given fields: ctx, namespaceName, projectName, componentName, commit
 func (_m *MockService) TriggerWorkflow(ctx context.Context, namespaceName string, projectName string, componentName string, commit string) (*models.WorkflowRunTriggerResponse, error) {
 	ret := _m.Called(ctx, namespaceName, projectName, componentName, commit)
@@ -502,6 +802,66 @@ func (_c *MockService_TriggerWorkflow_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// TriggerWorkflowGroup provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) TriggerWorkflowGroup(ctx context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest) (*v1alpha1.WorkflowRunGroup, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TriggerWorkflowGroup")
+	}
+
+	var r0 *v1alpha1.WorkflowRunGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.TriggerWorkflowGroupRequest) (*v1alpha1.WorkflowRunGroup, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.TriggerWorkflowGroupRequest) *v1alpha1.WorkflowRunGroup); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowRunGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.TriggerWorkflowGroupRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_TriggerWorkflowGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerWorkflowGroup'
+type MockService_TriggerWorkflowGroup_Call struct {
+	*mock.Call
+}
+
+// TriggerWorkflowGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *models.TriggerWorkflowGroupRequest
+func (_e *MockService_Expecter) TriggerWorkflowGroup(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_TriggerWorkflowGroup_Call {
+	return &MockService_TriggerWorkflowGroup_Call{Call: _e.mock.On("TriggerWorkflowGroup", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_TriggerWorkflowGroup_Call) Run(run func(ctx context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest)) *MockService_TriggerWorkflowGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.TriggerWorkflowGroupRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_TriggerWorkflowGroup_Call) Return(_a0 *v1alpha1.WorkflowRunGroup, _a1 error) *MockService_TriggerWorkflowGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_TriggerWorkflowGroup_Call) RunAndReturn(run func(context.Context, string, *models.TriggerWorkflowGroupRequest) (*v1alpha1.WorkflowRunGroup, error)) *MockService_TriggerWorkflowGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWorkflowRun provides a mock function with given fields: ctx, namespaceName, wfRun
 func (_m *MockService) UpdateWorkflowRun(ctx context.Context, namespaceName string, wfRun *v1alpha1.WorkflowRun) (*v1alpha1.WorkflowRun, error) {
 	ret := _m.Called(ctx, namespaceName, wfRun)
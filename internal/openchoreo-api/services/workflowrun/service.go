@@ -50,6 +50,11 @@ var workflowRunTypeMeta = metav1.TypeMeta{
 	Kind:       "WorkflowRun",
 }
 
+var workflowRunGroupTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "WorkflowRunGroup",
+}
+
 // NewService creates a new workflow run service without authorization.
 func NewService(k8sClient client.Client, planeClientProvider kubernetesClient.WorkflowPlaneClientProvider, gwClient *gatewayClient.Client, logger *slog.Logger) Service {
 	return &workflowRunService{
@@ -261,6 +266,156 @@ func (s *workflowRunService) DeleteWorkflowRun(ctx context.Context, namespaceNam
 	return nil
 }
 
+// CancelWorkflowRun requests the termination of a workflow run. The workflow run controller
+// terminates the underlying workflow and completes the run as cancelled.
+func (s *workflowRunService) CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error) {
+	s.logger.Debug("Cancelling workflow run", "namespace", namespaceName, "run", runName)
+
+	wfRun, err := s.updateWorkflowRunSpec(ctx, namespaceName, runName, func(wfRun *openchoreov1alpha1.WorkflowRun) error {
+		if isWorkflowRunCompleted(wfRun.Status.Conditions) {
+			return ErrWorkflowRunCompleted
+		}
+		wfRun.Spec.Cancel = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Workflow run cancellation requested", "namespace", namespaceName, "run", runName)
+	return wfRun, nil
+}
+
+// RetryWorkflowRun creates a new workflow run with the same workflow, parameters and labels as a
+// completed run. The new run records the original run in the retry-of annotation.
+func (s *workflowRunService) RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error) {
+	s.logger.Debug("Retrying workflow run", "namespace", namespaceName, "run", runName)
+
+	source, err := s.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if !isWorkflowRunCompleted(source.Status.Conditions) {
+		return nil, ErrWorkflowRunNotCompleted
+	}
+
+	retryName, err := generateWorkflowRunName(retryBaseName(source))
+	if err != nil {
+		s.logger.Error("Failed to generate workflow run name", "error", err)
+		return nil, fmt.Errorf("failed to generate workflow run name: %w", err)
+	}
+
+	retryRun := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        retryName,
+			Namespace:   namespaceName,
+			Labels:      source.Labels,
+			Annotations: map[string]string{ocLabels.AnnotationKeyRetryOf: source.Name},
+		},
+		Spec: openchoreov1alpha1.WorkflowRunSpec{
+			Workflow:           *source.Spec.Workflow.DeepCopy(),
+			TTLAfterCompletion: source.Spec.TTLAfterCompletion,
+		},
+	}
+
+	created, err := s.CreateWorkflowRun(ctx, namespaceName, retryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Workflow run retried", "namespace", namespaceName, "run", runName, "retry", created.Name)
+	return created, nil
+}
+
+// ResumeWorkflowRun requests that a failed workflow run is resumed from its failed tasks. The
+// workflow run controller re-executes the failed tasks of the existing workflow and keeps the
+// tasks that already succeeded.
+func (s *workflowRunService) ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error) {
+	s.logger.Debug("Resuming workflow run", "namespace", namespaceName, "run", runName)
+
+	wfRun, err := s.updateWorkflowRunSpec(ctx, namespaceName, runName, func(wfRun *openchoreov1alpha1.WorkflowRun) error {
+		if reason := resumeBlockedReason(wfRun); reason != "" {
+			return fmt.Errorf("%w: %s", ErrWorkflowRunNotResumable, reason)
+		}
+		wfRun.Spec.Resume = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Workflow run resume requested", "namespace", namespaceName, "run", runName)
+	return wfRun, nil
+}
+
+// updateWorkflowRunSpec applies mutate to the spec of a workflow run, retrying on conflicts
+// caused by concurrent status updates from the controller.
+func (s *workflowRunService) updateWorkflowRunSpec(
+	ctx context.Context,
+	namespaceName, runName string,
+	mutate func(*openchoreov1alpha1.WorkflowRun) error,
+) (*openchoreov1alpha1.WorkflowRun, error) {
+	var wfRun *openchoreov1alpha1.WorkflowRun
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wfRun = &openchoreov1alpha1.WorkflowRun{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: runName, Namespace: namespaceName}, wfRun); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return ErrWorkflowRunNotFound
+			}
+			return fmt.Errorf("failed to get workflow run: %w", err)
+		}
+		if err := mutate(wfRun); err != nil {
+			return err
+		}
+		return s.k8sClient.Update(ctx, wfRun)
+	})
+	if err != nil {
+		if errors.Is(err, ErrWorkflowRunNotFound) || errors.Is(err, ErrWorkflowRunCompleted) ||
+			errors.Is(err, ErrWorkflowRunNotResumable) {
+			s.logger.Warn("Workflow run action rejected", "namespace", namespaceName, "run", runName, "reason", err)
+			return nil, err
+		}
+		s.logger.Error("Failed to update workflow run", "error", err)
+		return nil, fmt.Errorf("failed to update workflow run: %w", err)
+	}
+
+	wfRun.TypeMeta = workflowRunTypeMeta
+	return wfRun, nil
+}
+
+// resumeBlockedReason returns why a workflow run cannot be resumed, or an empty string if it can.
+// Only failed Argo Workflow runs with at least one failed task recorded can be resumed.
+func resumeBlockedReason(wfRun *openchoreov1alpha1.WorkflowRun) string {
+	switch {
+	case wfRun.Spec.Resume:
+		return "a resume is already in progress"
+	case computeWorkflowRunStatus(wfRun.Status.Conditions) != workflowRunStatusFailed:
+		return "only failed workflow runs can be resumed"
+	case wfRun.Status.RunReference == nil ||
+		wfRun.Status.RunReference.APIVersion != argoproj.SchemeGroupVersion.String() ||
+		wfRun.Status.RunReference.Kind != "Workflow":
+		return "resume is only supported for Argo Workflows"
+	}
+	for _, task := range wfRun.Status.Tasks {
+		if task.Phase == string(argoproj.NodeFailed) || task.Phase == string(argoproj.NodeError) {
+			return ""
+		}
+	}
+	return "no failed tasks are recorded"
+}
+
+// retryBaseName returns the base name for the run created by a retry: the component name for
+// component workflow runs, or the original run name without a generated suffix.
+func retryBaseName(source *openchoreov1alpha1.WorkflowRun) string {
+	if componentName := source.Labels[ocLabels.LabelKeyComponentName]; componentName != "" {
+		return componentName
+	}
+	return generatedRunNameSuffix.ReplaceAllString(source.Name, "")
+}
+
+// generatedRunNameSuffix matches the suffix added by generateWorkflowRunName.
+var generatedRunNameSuffix = regexp.MustCompile(`-run-[0-9a-f]{8}$`)
+
 // GetWorkflowRunLogs retrieves logs from a workflow run.
 func (s *workflowRunService) GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error) {
 	logger := s.logger.With("namespace", namespaceName, "run", runName, "task", taskName, "sinceSeconds", sinceSeconds)
@@ -708,6 +863,7 @@ const (
 	workflowRunStatusRunning   = "Running"
 	workflowRunStatusSucceeded = "Succeeded"
 	workflowRunStatusFailed    = "Failed"
+	workflowRunStatusCancelled = "Cancelled"
 )
 
 // GetWorkflowRunStatus retrieves the status and step information for a specific WorkflowRun.
@@ -799,6 +955,9 @@ func computeWorkflowRunStatus(conditions []metav1.Condition) string {
 
 	for _, condition := range conditions {
 		if condition.Type == "WorkflowFailed" && condition.Status == metav1.ConditionTrue {
+			if condition.Reason == "WorkflowCancelled" {
+				return workflowRunStatusCancelled
+			}
 			return workflowRunStatusFailed
 		}
 	}
@@ -818,11 +977,83 @@ func computeWorkflowRunStatus(conditions []metav1.Condition) string {
 	return workflowRunStatusPending
 }
 
+// isWorkflowRunCompleted reports whether the workflow run has completed, successfully or not.
+func isWorkflowRunCompleted(conditions []metav1.Condition) bool {
+	for _, condition := range conditions {
+		if condition.Type == "WorkflowCompleted" {
+			return condition.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
 // TriggerWorkflow creates a new WorkflowRun from a component's workflow configuration.
 // This is used by both the authorized API handler path and the webhook path (unauthz).
 func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
 	s.logger.Debug("Triggering component workflow", "namespace", namespaceName, "project", projectName, "component", componentName, "commit", commit)
 
+	template, err := s.componentWorkflowRunTemplate(ctx, namespaceName, projectName, componentName, commit)
+	if err != nil {
+		return nil, err
+	}
+	projectName = template.Labels[ocLabels.LabelKeyProjectName]
+
+	// Generate a unique workflow run name
+	workflowRunName, err := generateWorkflowRunName(componentName)
+	if err != nil {
+		s.logger.Error("Failed to generate workflow run name", "error", err)
+		return nil, fmt.Errorf("failed to generate workflow run name: %w", err)
+	}
+
+	// Create the WorkflowRun CR
+	workflowRun := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflowRunName,
+			Namespace: namespaceName,
+			Labels:    template.Labels,
+		},
+		Spec: openchoreov1alpha1.WorkflowRunSpec{
+			Workflow: openchoreov1alpha1.WorkflowRunConfig{
+				Kind:       template.Workflow.Kind,
+				Name:       template.Workflow.Name,
+				Parameters: template.Workflow.Parameters,
+			},
+		},
+	}
+
+	if err := s.k8sClient.Create(ctx, workflowRun); err != nil {
+		if apierrors.IsInvalid(err) {
+			var statusErr *apierrors.StatusError
+			if errors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil {
+				for _, cause := range statusErr.ErrStatus.Details.Causes {
+					if strings.Contains(cause.Field, "commit") {
+						s.logger.Warn("Commit SHA validation failed", "error", cause.Message, "field", cause.Field)
+						return nil, ErrInvalidCommitSHA
+					}
+				}
+			}
+		}
+		s.logger.Error("Failed to create workflow run", "error", err)
+		return nil, fmt.Errorf("failed to create workflow run: %w", err)
+	}
+
+	s.logger.Info("Workflow run created successfully", "workflow", workflowRunName, "component", componentName, "commit", commit)
+
+	return &models.WorkflowRunTriggerResponse{
+		Name:          workflowRun.Name,
+		UUID:          string(workflowRun.UID),
+		ComponentName: componentName,
+		ProjectName:   projectName,
+		NamespaceName: namespaceName,
+		Commit:        commit,
+		Status:        workflowRunStatusPending,
+		CreatedAt:     workflowRun.CreationTimestamp.Time,
+	}, nil
+}
+
+// componentWorkflowRunTemplate returns the labels and workflow configuration of a run of a
+// component's workflow, with the commit injected into the parameters when given.
+func (s *workflowRunService) componentWorkflowRunTemplate(ctx context.Context, namespaceName, projectName, componentName, commit string) (*openchoreov1alpha1.WorkflowRunTemplate, error) {
 	// Retrieve component
 	var component openchoreov1alpha1.Component
 	if err := s.k8sClient.Get(ctx, client.ObjectKey{
@@ -890,11 +1121,8 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 	}
 
 	// Validate commit SHA format if provided
-	if commit != "" {
-		commitPattern := regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
-		if !commitPattern.MatchString(commit) {
-			return nil, ErrInvalidCommitSHA
-		}
+	if commit != "" && !commitSHAPattern.MatchString(commit) {
+		return nil, ErrInvalidCommitSHA
 	}
 
 	// Start with the component's existing parameters
@@ -911,60 +1139,145 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		}
 	}
 
-	// Generate a unique workflow run name
-	workflowRunName, err := generateWorkflowRunName(componentName)
+	return &openchoreov1alpha1.WorkflowRunTemplate{
+		Labels: map[string]string{
+			ocLabels.LabelKeyProjectName:   projectName,
+			ocLabels.LabelKeyComponentName: componentName,
+		},
+		Workflow: openchoreov1alpha1.ScheduledWorkflowConfig{
+			Kind:       component.Spec.Workflow.Kind,
+			Name:       component.Spec.Workflow.Name,
+			Parameters: parameters,
+		},
+	}, nil
+}
+
+// commitSHAPattern matches abbreviated and full commit SHAs.
+var commitSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+
+// TriggerWorkflowGroup creates a WorkflowRunGroup with a run of each component's workflow for the
+// commit. The WorkflowRunGroup controller starts the runs within the group's concurrency cap.
+func (s *workflowRunService) TriggerWorkflowGroup(ctx context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest) (*openchoreov1alpha1.WorkflowRunGroup, error) {
+	s.logger.Debug("Triggering workflow run group", "namespace", namespaceName, "commit", req.Commit, "components", len(req.Components))
+
+	if req.Commit != "" && !commitSHAPattern.MatchString(req.Commit) {
+		return nil, ErrInvalidCommitSHA
+	}
+
+	runs := make([]openchoreov1alpha1.WorkflowRunGroupRun, 0, len(req.Components))
+	for _, comp := range req.Components {
+		template, err := s.componentWorkflowRunTemplate(ctx, namespaceName, comp.ProjectName, comp.ComponentName, req.Commit)
+		if err != nil {
+			// One misconfigured component must not block the builds of the others.
+			s.logger.Warn("Leaving component out of workflow run group", "error", err, "namespace", namespaceName, "component", comp.ComponentName)
+			continue
+		}
+		runs = append(runs, openchoreov1alpha1.WorkflowRunGroupRun{
+			Name:                comp.ComponentName,
+			WorkflowRunTemplate: *template,
+		})
+	}
+	if len(runs) == 0 {
+		return nil, ErrWorkflowRunGroupEmpty
+	}
+
+	groupName, err := generateWorkflowRunGroupName(req.Commit)
 	if err != nil {
-		s.logger.Error("Failed to generate workflow run name", "error", err)
-		return nil, fmt.Errorf("failed to generate workflow run name: %w", err)
+		s.logger.Error("Failed to generate workflow run group name", "error", err)
+		return nil, fmt.Errorf("failed to generate workflow run group name: %w", err)
 	}
 
-	// Create the WorkflowRun CR
-	workflowRun := &openchoreov1alpha1.WorkflowRun{
+	failurePolicy := openchoreov1alpha1.WorkflowRunGroupFailurePolicyContinue
+	if req.FailFast {
+		failurePolicy = openchoreov1alpha1.WorkflowRunGroupFailurePolicyFailFast
+	}
+	group := &openchoreov1alpha1.WorkflowRunGroup{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      workflowRunName,
+			Name:      groupName,
 			Namespace: namespaceName,
-			Labels: map[string]string{
-				ocLabels.LabelKeyProjectName:   projectName,
-				ocLabels.LabelKeyComponentName: componentName,
-			},
 		},
-		Spec: openchoreov1alpha1.WorkflowRunSpec{
-			Workflow: openchoreov1alpha1.WorkflowRunConfig{
-				Kind:       component.Spec.Workflow.Kind,
-				Name:       component.Spec.Workflow.Name,
-				Parameters: parameters,
-			},
+		Spec: openchoreov1alpha1.WorkflowRunGroupSpec{
+			Commit:         req.Commit,
+			MaxConcurrency: req.MaxConcurrency,
+			FailurePolicy:  failurePolicy,
+			Runs:           runs,
 		},
 	}
+	if err := s.k8sClient.Create(ctx, group); err != nil {
+		s.logger.Error("Failed to create workflow run group", "error", err)
+		return nil, fmt.Errorf("failed to create workflow run group: %w", err)
+	}
 
-	if err := s.k8sClient.Create(ctx, workflowRun); err != nil {
-		if apierrors.IsInvalid(err) {
-			var statusErr *apierrors.StatusError
-			if errors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil {
-				for _, cause := range statusErr.ErrStatus.Details.Causes {
-					if strings.Contains(cause.Field, "commit") {
-						s.logger.Warn("Commit SHA validation failed", "error", cause.Message, "field", cause.Field)
-						return nil, ErrInvalidCommitSHA
-					}
-				}
-			}
+	s.logger.Info("Workflow run group created successfully", "group", groupName, "runs", len(runs), "commit", req.Commit)
+	group.TypeMeta = workflowRunGroupTypeMeta
+	return group, nil
+}
+
+func (s *workflowRunService) ListWorkflowRunGroups(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowRunGroup], error) {
+	s.logger.Debug("Listing workflow run groups", "namespace", namespaceName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	commonOpts, err := services.BuildListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := append([]client.ListOption{client.InNamespace(namespaceName)}, commonOpts...)
+
+	var groupList openchoreov1alpha1.WorkflowRunGroupList
+	if err := s.k8sClient.List(ctx, &groupList, listOpts...); err != nil {
+		s.logger.Error("Failed to list workflow run groups", "error", err)
+		return nil, fmt.Errorf("failed to list workflow run groups: %w", err)
+	}
+
+	for i := range groupList.Items {
+		groupList.Items[i].TypeMeta = workflowRunGroupTypeMeta
+	}
+
+	result := &services.ListResult[openchoreov1alpha1.WorkflowRunGroup]{
+		Items:      groupList.Items,
+		NextCursor: groupList.Continue,
+	}
+	if groupList.RemainingItemCount != nil {
+		remaining := *groupList.RemainingItemCount
+		result.RemainingCount = &remaining
+	}
+	return result, nil
+}
+
+func (s *workflowRunService) GetWorkflowRunGroup(ctx context.Context, namespaceName, groupName string) (*openchoreov1alpha1.WorkflowRunGroup, error) {
+	s.logger.Debug("Getting workflow run group", "namespace", namespaceName, "group", groupName)
+
+	group := &openchoreov1alpha1.WorkflowRunGroup{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{
+		Name:      groupName,
+		Namespace: namespaceName,
+	}, group); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Workflow run group not found", "namespace", namespaceName, "group", groupName)
+			return nil, ErrWorkflowRunGroupNotFound
 		}
-		s.logger.Error("Failed to create workflow run", "error", err)
-		return nil, fmt.Errorf("failed to create workflow run: %w", err)
+		s.logger.Error("Failed to get workflow run group", "error", err)
+		return nil, fmt.Errorf("failed to get workflow run group: %w", err)
 	}
 
-	s.logger.Info("Workflow run created successfully", "workflow", workflowRunName, "component", componentName, "commit", commit)
+	group.TypeMeta = workflowRunGroupTypeMeta
+	return group, nil
+}
 
-	return &models.WorkflowRunTriggerResponse{
-		Name:          workflowRun.Name,
-		UUID:          string(workflowRun.UID),
-		ComponentName: componentName,
-		ProjectName:   projectName,
-		NamespaceName: namespaceName,
-		Commit:        commit,
-		Status:        workflowRunStatusPending,
-		CreatedAt:     workflowRun.CreationTimestamp.Time,
-	}, nil
+// generateWorkflowRunGroupName generates a unique name for a workflow run group of a commit.
+func generateWorkflowRunGroupName(commit string) (string, error) {
+	bytes := make([]byte, 4) // 8 characters hex string
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random suffix: %w", err)
+	}
+	suffix := hex.EncodeToString(bytes)
+
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	if commit == "" {
+		return fmt.Sprintf("build-%s", suffix), nil
+	}
+	return fmt.Sprintf("build-%s-%s", strings.ToLower(commit), suffix), nil
 }
 
 // generateWorkflowRunName generates a unique name for the workflow run.
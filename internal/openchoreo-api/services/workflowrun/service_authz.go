@@ -157,6 +157,48 @@ func (s *workflowRunServiceWithAuthz) DeleteWorkflowRun(ctx context.Context, nam
 	return s.internal.DeleteWorkflowRun(ctx, namespaceName, runName)
 }
 
+func (s *workflowRunServiceWithAuthz) CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error) {
+	if err := s.checkExistingRun(ctx, authz.ActionUpdateWorkflowRun, namespaceName, runName); err != nil {
+		return nil, err
+	}
+	return s.internal.CancelWorkflowRun(ctx, namespaceName, runName)
+}
+
+func (s *workflowRunServiceWithAuthz) RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error) {
+	// A retry creates a new run for the same workflow in the same scope as the original run
+	if err := s.checkExistingRun(ctx, authz.ActionCreateWorkflowRun, namespaceName, runName); err != nil {
+		return nil, err
+	}
+	return s.internal.RetryWorkflowRun(ctx, namespaceName, runName)
+}
+
+func (s *workflowRunServiceWithAuthz) ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error) {
+	if err := s.checkExistingRun(ctx, authz.ActionUpdateWorkflowRun, namespaceName, runName); err != nil {
+		return nil, err
+	}
+	return s.internal.ResumeWorkflowRun(ctx, namespaceName, runName)
+}
+
+// checkExistingRun authorizes a mutating action against an existing workflow run, using the
+// run's labels for the hierarchy and its workflow reference for the resource.workflow attribute.
+func (s *workflowRunServiceWithAuthz) checkExistingRun(ctx context.Context, action, namespaceName, runName string) error {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return err
+	}
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Workflow: formatWorkflowAttr(namespaceName, wr.Spec.Workflow.Kind, wr.Spec.Workflow.Name),
+			},
+		},
+	})
+}
+
 func (s *workflowRunServiceWithAuthz) GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error) {
 	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
 	if err != nil {
@@ -206,20 +248,38 @@ func (s *workflowRunServiceWithAuthz) GetWorkflowRunStatus(ctx context.Context,
 }
 
 func (s *workflowRunServiceWithAuthz) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
-	// Resolve the component's workflow reference for the authz check
+	if err := s.checkTriggerWorkflow(ctx, namespaceName, projectName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.TriggerWorkflow(ctx, namespaceName, projectName, componentName, commit)
+}
+
+func (s *workflowRunServiceWithAuthz) TriggerWorkflowGroup(ctx context.Context, namespaceName string, req *models.TriggerWorkflowGroupRequest) (*openchoreov1alpha1.WorkflowRunGroup, error) {
+	// The group creates a workflow run for each component, so each of them must be allowed
+	for _, comp := range req.Components {
+		if err := s.checkTriggerWorkflow(ctx, namespaceName, comp.ProjectName, comp.ComponentName); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.TriggerWorkflowGroup(ctx, namespaceName, req)
+}
+
+// checkTriggerWorkflow authorizes creating a workflow run for a component, resolving the
+// component's workflow reference so conditions on resource.workflow apply.
+func (s *workflowRunServiceWithAuthz) checkTriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName string) error {
 	var workflowAttr string
 	var comp openchoreov1alpha1.Component
 	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: componentName, Namespace: namespaceName}, &comp); err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, component.ErrComponentNotFound
+			return component.ErrComponentNotFound
 		}
-		return nil, fmt.Errorf("failed to resolve component %s/%s for authz check: %w", namespaceName, componentName, err)
+		return fmt.Errorf("failed to resolve component %s/%s for authz check: %w", namespaceName, componentName, err)
 	}
 	if comp.Spec.Workflow != nil {
 		workflowAttr = formatWorkflowAttr(namespaceName, comp.Spec.Workflow.Kind, comp.Spec.Workflow.Name)
 	}
 
-	if err := s.authz.Check(ctx, services.CheckRequest{
+	return s.authz.Check(ctx, services.CheckRequest{
 		Action:       authz.ActionCreateWorkflowRun,
 		ResourceType: resourceTypeWorkflowRun,
 		ResourceID:   componentName,
@@ -233,8 +293,34 @@ func (s *workflowRunServiceWithAuthz) TriggerWorkflow(ctx context.Context, names
 				Workflow: workflowAttr,
 			},
 		},
+	})
+}
+
+func (s *workflowRunServiceWithAuthz) ListWorkflowRunGroups(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowRunGroup], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowRunGroup], error) {
+			return s.internal.ListWorkflowRunGroups(ctx, namespaceName, pageOpts)
+		},
+		func(g openchoreov1alpha1.WorkflowRunGroup) services.CheckRequest {
+			return services.CheckRequest{
+				Action:       authz.ActionViewWorkflowRun,
+				ResourceType: resourceTypeWorkflowRun,
+				ResourceID:   g.Name,
+				Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+			}
+		},
+	)
+}
+
+func (s *workflowRunServiceWithAuthz) GetWorkflowRunGroup(ctx context.Context, namespaceName, groupName string) (*openchoreov1alpha1.WorkflowRunGroup, error) {
+	// A group spans components, so viewing it is authorized at the namespace level
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   groupName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
 	}); err != nil {
 		return nil, err
 	}
-	return s.internal.TriggerWorkflow(ctx, namespaceName, projectName, componentName, commit)
+	return s.internal.GetWorkflowRunGroup(ctx, namespaceName, groupName)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
@@ -417,6 +418,84 @@ func TestDeleteWorkflowRun_Authz(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// Cancel, retry and resume authz tests
+// ---------------------------------------------------------------------------
+
+func TestWorkflowRunActions_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	tests := []struct {
+		name   string
+		action string
+		expect func(*wfrmocks.MockService)
+		call   func(workflowrun.Service) (*openchoreov1alpha1.WorkflowRun, error)
+	}{
+		{
+			name:   "cancel",
+			action: authz.ActionUpdateWorkflowRun,
+			expect: func(m *wfrmocks.MockService) {
+				m.EXPECT().CancelWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+			},
+			call: func(svc workflowrun.Service) (*openchoreov1alpha1.WorkflowRun, error) {
+				return svc.CancelWorkflowRun(ctxWithSubject(), testNamespace, testRunName)
+			},
+		},
+		{
+			name:   "retry",
+			action: authz.ActionCreateWorkflowRun,
+			expect: func(m *wfrmocks.MockService) {
+				m.EXPECT().RetryWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+			},
+			call: func(svc workflowrun.Service) (*openchoreov1alpha1.WorkflowRun, error) {
+				return svc.RetryWorkflowRun(ctxWithSubject(), testNamespace, testRunName)
+			},
+		},
+		{
+			name:   "resume",
+			action: authz.ActionUpdateWorkflowRun,
+			expect: func(m *wfrmocks.MockService) {
+				m.EXPECT().ResumeWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+			},
+			call: func(svc workflowrun.Service) (*openchoreov1alpha1.WorkflowRun, error) {
+				return svc.ResumeWorkflowRun(ctxWithSubject(), testNamespace, testRunName)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" checks action with hierarchy from fetched labels", func(t *testing.T) {
+			mockSvc := wfrmocks.NewMockService(t)
+			mockPDP := authzmocks.NewMockPDP(t)
+
+			mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+			mockPDP.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(req *authz.EvaluateRequest) bool {
+				return req.Action == tt.action &&
+					req.Resource.Type == workflowrun.ExportResourceType &&
+					req.Resource.ID == testRunName &&
+					req.Resource.Hierarchy.Project == testProjectName &&
+					req.Resource.Hierarchy.Component == testComponentName &&
+					req.Context.Resource.Workflow == testNamespace+"/"+testWorkflowName
+			})).Return(allowDecision(), nil)
+			tt.expect(mockSvc)
+
+			_, err := tt.call(newAuthzService(t, mockSvc, mockPDP))
+			require.NoError(t, err)
+		})
+
+		t.Run(tt.name+" denied returns forbidden without delegating", func(t *testing.T) {
+			mockSvc := wfrmocks.NewMockService(t)
+			mockPDP := authzmocks.NewMockPDP(t)
+
+			mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+			mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+			_, err := tt.call(newAuthzService(t, mockSvc, mockPDP))
+			require.ErrorIs(t, err, services.ErrForbidden)
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // GetWorkflowRunLogs authz tests
 // ---------------------------------------------------------------------------
@@ -666,6 +745,151 @@ func TestTriggerWorkflow_Authz(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// WorkflowRunGroup authz tests
+// ---------------------------------------------------------------------------
+
+func TestTriggerWorkflowGroup_Authz(t *testing.T) {
+	req := &models.TriggerWorkflowGroupRequest{
+		Commit: "abc1234",
+		Components: []models.WorkflowGroupComponent{
+			{ProjectName: testProjectName, ComponentName: testComponentName},
+			{ProjectName: "proj-b", ComponentName: "comp-b"},
+		},
+	}
+	// Members resolve their workflow reference for the authz check like TriggerWorkflow does
+	groupComponents := []client.Object{
+		&openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponentName, Namespace: testNamespace},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Workflow: &openchoreov1alpha1.ComponentWorkflowConfig{Kind: openchoreov1alpha1.WorkflowRefKindClusterWorkflow, Name: "build-go"},
+			},
+		},
+		&openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "comp-b", Namespace: testNamespace},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Workflow: &openchoreov1alpha1.ComponentWorkflowConfig{Kind: openchoreov1alpha1.WorkflowRefKindWorkflow, Name: "build-privileged"},
+			},
+		},
+	}
+	newGroupService := func(mockSvc *wfrmocks.MockService, mockPDP *authzmocks.MockPDP, objs ...client.Object) workflowrun.Service {
+		return workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(objs...), mockPDP, testutil.TestLogger())
+	}
+
+	t.Run("allowed for every component delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(r *authz.EvaluateRequest) bool {
+			return r.Action == authz.ActionCreateWorkflowRun && r.Resource.Hierarchy.Component != ""
+		})).Return(allowDecision(), nil).Times(2)
+		mockSvc.EXPECT().TriggerWorkflowGroup(mock.Anything, testNamespace, req).
+			Return(&openchoreov1alpha1.WorkflowRunGroup{ObjectMeta: metav1.ObjectMeta{Name: "build-abc1234"}}, nil)
+
+		svc := newGroupService(mockSvc, mockPDP, groupComponents...)
+		group, err := svc.TriggerWorkflowGroup(ctxWithSubject(), testNamespace, req)
+		require.NoError(t, err)
+		assert.Equal(t, "build-abc1234", group.Name)
+	})
+
+	t.Run("denied for one component returns forbidden", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, r *authz.EvaluateRequest) (*authz.Decision, error) {
+				return &authz.Decision{Decision: r.Resource.Hierarchy.Project == testProjectName, Context: &authz.DecisionContext{Reason: "filtered"}}, nil
+			})
+		// TriggerWorkflowGroup should NOT be called
+
+		svc := newGroupService(mockSvc, mockPDP, groupComponents...)
+		_, err := svc.TriggerWorkflowGroup(ctxWithSubject(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("populates resource.workflow for each component", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		// A deny condition on the workflow of one member fails the whole group
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, r *authz.EvaluateRequest) (*authz.Decision, error) {
+				workflows := map[string]string{testComponentName: "build-go", "comp-b": testNamespace + "/build-privileged"}
+				if r.Context.Resource.Workflow != workflows[r.Resource.Hierarchy.Component] {
+					t.Errorf("resource.workflow = %q for %s", r.Context.Resource.Workflow, r.Resource.Hierarchy.Component)
+				}
+				return &authz.Decision{Decision: r.Context.Resource.Workflow != testNamespace+"/build-privileged", Context: &authz.DecisionContext{Reason: "filtered"}}, nil
+			}).Times(2)
+
+		svc := newGroupService(mockSvc, mockPDP, groupComponents...)
+		_, err := svc.TriggerWorkflowGroup(ctxWithSubject(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("missing component returns ErrComponentNotFound", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil).Maybe()
+
+		svc := newGroupService(mockSvc, mockPDP, groupComponents[0])
+		_, err := svc.TriggerWorkflowGroup(ctxWithSubject(), testNamespace, req)
+		require.ErrorIs(t, err, component.ErrComponentNotFound)
+	})
+}
+
+func TestWorkflowRunGroupView_Authz(t *testing.T) {
+	g1 := openchoreov1alpha1.WorkflowRunGroup{ObjectMeta: metav1.ObjectMeta{Name: "group-1", Namespace: testNamespace}}
+	g2 := openchoreov1alpha1.WorkflowRunGroup{ObjectMeta: metav1.ObjectMeta{Name: "group-2", Namespace: testNamespace}}
+	namespaceView := mock.MatchedBy(func(r *authz.EvaluateRequest) bool {
+		return r.Action == authz.ActionViewWorkflowRun &&
+			r.Resource.Hierarchy.Namespace == testNamespace &&
+			r.Resource.Hierarchy.Project == ""
+	})
+
+	t.Run("list filters denied groups", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().ListWorkflowRunGroups(mock.Anything, testNamespace, mock.Anything).
+			Return(&services.ListResult[openchoreov1alpha1.WorkflowRunGroup]{Items: []openchoreov1alpha1.WorkflowRunGroup{g1, g2}}, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, namespaceView).
+			RunAndReturn(func(_ context.Context, r *authz.EvaluateRequest) (*authz.Decision, error) {
+				return &authz.Decision{Decision: r.Resource.ID == "group-1", Context: &authz.DecisionContext{Reason: "filtered"}}, nil
+			}).Times(2)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.ListWorkflowRunGroups(ctxWithSubject(), testNamespace, services.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "group-1", result.Items[0].Name)
+	})
+
+	t.Run("get allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, namespaceView).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetWorkflowRunGroup(mock.Anything, testNamespace, "group-1").Return(&g1, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetWorkflowRunGroup(ctxWithSubject(), testNamespace, "group-1")
+		require.NoError(t, err)
+		assert.Equal(t, "group-1", result.Name)
+	})
+
+	t.Run("get denied returns forbidden", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, namespaceView).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetWorkflowRunGroup(ctxWithSubject(), testNamespace, "group-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 func TestFormatWorkflowAttr(t *testing.T) {
 	tests := []struct {
 		name      string
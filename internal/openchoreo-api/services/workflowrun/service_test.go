@@ -13,10 +13,12 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
 )
@@ -327,6 +329,14 @@ func TestComputeWorkflowRunStatus(t *testing.T) {
 		}
 		assert.Equal(t, workflowRunStatusPending, computeWorkflowRunStatus(conditions))
 	})
+
+	t.Run("workflow cancelled", func(t *testing.T) {
+		conditions := []metav1.Condition{
+			{Type: "WorkflowFailed", Status: metav1.ConditionTrue, Reason: "WorkflowCancelled"},
+			{Type: "WorkflowCompleted", Status: metav1.ConditionTrue, Reason: "WorkflowCancelled"},
+		}
+		assert.Equal(t, workflowRunStatusCancelled, computeWorkflowRunStatus(conditions))
+	})
 }
 
 func TestGenerateWorkflowRunName(t *testing.T) {
@@ -603,6 +613,108 @@ func TestTriggerWorkflow(t *testing.T) {
 	})
 }
 
+func TestTriggerWorkflowGroup(t *testing.T) {
+	ctx := context.Background()
+
+	schema, _ := json.Marshal(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"repoUrl": map[string]any{"type": "string", "x-openchoreo-component-parameter-repository-url": true},
+			"commit":  map[string]any{"type": "string", "x-openchoreo-component-parameter-repository-commit": true},
+		},
+	})
+	newWorkflow := func() *openchoreov1alpha1.Workflow {
+		wf := testutil.NewWorkflow(testNamespace, testWorkflowName)
+		wf.Spec.Parameters = &openchoreov1alpha1.SchemaSection{OpenAPIV3Schema: &runtime.RawExtension{Raw: schema}}
+		return wf
+	}
+	newComponent := func(name string) *openchoreov1alpha1.Component {
+		params, _ := json.Marshal(map[string]any{"repoUrl": "https://github.com/example/monorepo", "commit": ""})
+		comp := testutil.NewComponent(testNamespace, "proj", name)
+		comp.Spec.Workflow = &openchoreov1alpha1.ComponentWorkflowConfig{
+			Kind:       openchoreov1alpha1.WorkflowRefKindWorkflow,
+			Name:       testWorkflowName,
+			Parameters: &runtime.RawExtension{Raw: params},
+		}
+		return comp
+	}
+	request := func(components ...string) *models.TriggerWorkflowGroupRequest {
+		req := &models.TriggerWorkflowGroupRequest{Commit: "abc1234f", MaxConcurrency: ptr.To(int32(2)), FailFast: true}
+		for _, name := range components {
+			req.Components = append(req.Components, models.WorkflowGroupComponent{ProjectName: "proj", ComponentName: name})
+		}
+		return req
+	}
+
+	t.Run("creates a group with a run per component", func(t *testing.T) {
+		svc := newService(t, newWorkflow(), newComponent("api"), newComponent("web"))
+
+		group, err := svc.TriggerWorkflowGroup(ctx, testNamespace, request("api", "web"))
+		require.NoError(t, err)
+
+		assert.Contains(t, group.Name, "build-abc1234-")
+		assert.Equal(t, "abc1234f", group.Spec.Commit)
+		assert.Equal(t, ptr.To(int32(2)), group.Spec.MaxConcurrency)
+		assert.Equal(t, openchoreov1alpha1.WorkflowRunGroupFailurePolicyFailFast, group.Spec.FailurePolicy)
+		require.Len(t, group.Spec.Runs, 2)
+		run := group.Spec.Runs[0]
+		assert.Equal(t, "api", run.Name)
+		assert.Equal(t, "proj", run.Labels[ocLabels.LabelKeyProjectName])
+		assert.Equal(t, "api", run.Labels[ocLabels.LabelKeyComponentName])
+		assert.Equal(t, testWorkflowName, run.Workflow.Name)
+		assert.JSONEq(t, `{"repoUrl":"https://github.com/example/monorepo","commit":"abc1234f"}`, string(run.Workflow.Parameters.Raw))
+
+		got, err := svc.GetWorkflowRunGroup(ctx, testNamespace, group.Name)
+		require.NoError(t, err)
+		assert.Equal(t, "WorkflowRunGroup", got.Kind)
+	})
+
+	t.Run("leaves out components that cannot be built", func(t *testing.T) {
+		svc := newService(t, newWorkflow(), newComponent("api"))
+
+		group, err := svc.TriggerWorkflowGroup(ctx, testNamespace, request("api", "missing"))
+		require.NoError(t, err)
+		require.Len(t, group.Spec.Runs, 1)
+		assert.Equal(t, "api", group.Spec.Runs[0].Name)
+	})
+
+	t.Run("no buildable components", func(t *testing.T) {
+		svc := newService(t, newWorkflow())
+
+		_, err := svc.TriggerWorkflowGroup(ctx, testNamespace, request("missing"))
+		require.ErrorIs(t, err, ErrWorkflowRunGroupEmpty)
+	})
+
+	t.Run("invalid commit SHA", func(t *testing.T) {
+		svc := newService(t, newWorkflow(), newComponent("api"))
+		req := request("api")
+		req.Commit = "not-a-sha!"
+
+		_, err := svc.TriggerWorkflowGroup(ctx, testNamespace, req)
+		require.ErrorIs(t, err, ErrInvalidCommitSHA)
+	})
+}
+
+func TestListAndGetWorkflowRunGroups(t *testing.T) {
+	ctx := context.Background()
+	group := &openchoreov1alpha1.WorkflowRunGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-abc1234-0a1b2c3d", Namespace: testNamespace},
+	}
+	other := &openchoreov1alpha1.WorkflowRunGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-other", Namespace: "other-ns"},
+	}
+	svc := newService(t, group, other)
+
+	result, err := svc.ListWorkflowRunGroups(ctx, testNamespace, services.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, group.Name, result.Items[0].Name)
+	assert.Equal(t, "WorkflowRunGroup", result.Items[0].Kind)
+
+	_, err = svc.GetWorkflowRunGroup(ctx, testNamespace, "missing")
+	require.ErrorIs(t, err, ErrWorkflowRunGroupNotFound)
+}
+
 func TestGetWorkflowRunStatus(t *testing.T) {
 	ctx := context.Background()
 
@@ -1289,3 +1401,144 @@ func TestDeleteWorkflowRunVerifiesRemoval(t *testing.T) {
 		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
 	})
 }
+
+// withRunConditions sets the conditions of a completed or running workflow run for the action tests.
+func withRunConditions(run *openchoreov1alpha1.WorkflowRun, completed bool, failedReason string) *openchoreov1alpha1.WorkflowRun {
+	completedStatus := metav1.ConditionFalse
+	if completed {
+		completedStatus = metav1.ConditionTrue
+	}
+	run.Status.Conditions = []metav1.Condition{{Type: "WorkflowCompleted", Status: completedStatus, Reason: failedReason}}
+	if failedReason != "" {
+		run.Status.Conditions = append(run.Status.Conditions,
+			metav1.Condition{Type: "WorkflowFailed", Status: metav1.ConditionTrue, Reason: failedReason})
+	}
+	return run
+}
+
+func TestCancelWorkflowRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requests cancellation of a running run", func(t *testing.T) {
+		run := withRunConditions(testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName), false, "")
+		svc := newService(t, run)
+
+		result, err := svc.CancelWorkflowRun(ctx, testNamespace, testRunName)
+		require.NoError(t, err)
+		assert.True(t, result.Spec.Cancel)
+		assert.Equal(t, workflowRunTypeMeta, result.TypeMeta)
+	})
+
+	t.Run("completed run cannot be cancelled", func(t *testing.T) {
+		run := withRunConditions(testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName), true, "WorkflowFailed")
+		svc := newService(t, run)
+
+		_, err := svc.CancelWorkflowRun(ctx, testNamespace, testRunName)
+		require.ErrorIs(t, err, ErrWorkflowRunCompleted)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.CancelWorkflowRun(ctx, testNamespace, "nonexistent")
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+}
+
+func TestRetryWorkflowRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates a new run with the same workflow and parameters", func(t *testing.T) {
+		wf := testutil.NewWorkflow(testNamespace, testWorkflowName)
+		run := withRunConditions(testutil.NewWorkflowRun(testNamespace, testWorkflowName, "my-app-run-0a1b2c3d"), true, "WorkflowFailed")
+		run.Labels = map[string]string{ocLabels.LabelKeyProjectName: "proj", ocLabels.LabelKeyComponentName: "my-app"}
+		run.Spec.Workflow.Parameters = &runtime.RawExtension{Raw: []byte(`{"repository":{"revision":"main"}}`)}
+		run.Spec.TTLAfterCompletion = "1d"
+		svc := newService(t, wf, run)
+
+		result, err := svc.RetryWorkflowRun(ctx, testNamespace, run.Name)
+		require.NoError(t, err)
+		assert.NotEqual(t, run.Name, result.Name)
+		assert.Regexp(t, `^my-app-run-[0-9a-f]{8}$`, result.Name)
+		assert.Equal(t, run.Name, result.Annotations[ocLabels.AnnotationKeyRetryOf])
+		assert.Equal(t, run.Labels, result.Labels)
+		assert.Equal(t, run.Spec.Workflow, result.Spec.Workflow)
+		assert.Equal(t, "1d", result.Spec.TTLAfterCompletion)
+		assert.Equal(t, openchoreov1alpha1.WorkflowRunStatus{}, result.Status)
+	})
+
+	t.Run("running run cannot be retried", func(t *testing.T) {
+		run := withRunConditions(testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName), false, "")
+		svc := newService(t, run)
+
+		_, err := svc.RetryWorkflowRun(ctx, testNamespace, testRunName)
+		require.ErrorIs(t, err, ErrWorkflowRunNotCompleted)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.RetryWorkflowRun(ctx, testNamespace, "nonexistent")
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+}
+
+func TestRetryBaseName(t *testing.T) {
+	run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, "nightly-run-0a1b2c3d")
+	assert.Equal(t, "nightly", retryBaseName(run))
+
+	run.Name = "nightly-build"
+	assert.Equal(t, "nightly-build", retryBaseName(run))
+
+	run.Labels = map[string]string{ocLabels.LabelKeyComponentName: "my-app"}
+	assert.Equal(t, "my-app", retryBaseName(run))
+}
+
+func TestResumeWorkflowRun(t *testing.T) {
+	ctx := context.Background()
+
+	newFailedRun := func() *openchoreov1alpha1.WorkflowRun {
+		run := withRunConditions(testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName), true, "WorkflowFailed")
+		run.Status.RunReference = &openchoreov1alpha1.ResourceReference{
+			APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow", Name: testRunName, Namespace: "workflows-" + testNamespace,
+		}
+		run.Status.Tasks = []openchoreov1alpha1.WorkflowTask{
+			{Name: "checkout", Phase: "Succeeded"},
+			{Name: "build", Phase: "Failed"},
+		}
+		return run
+	}
+
+	t.Run("requests resume of a failed run", func(t *testing.T) {
+		svc := newService(t, newFailedRun())
+
+		result, err := svc.ResumeWorkflowRun(ctx, testNamespace, testRunName)
+		require.NoError(t, err)
+		assert.True(t, result.Spec.Resume)
+	})
+
+	tests := []struct {
+		name   string
+		modify func(*openchoreov1alpha1.WorkflowRun)
+	}{
+		{"succeeded run", func(run *openchoreov1alpha1.WorkflowRun) {
+			withRunConditions(run, true, "")
+			run.Status.Conditions = append(run.Status.Conditions,
+				metav1.Condition{Type: "WorkflowSucceeded", Status: metav1.ConditionTrue})
+		}},
+		{"cancelled run", func(run *openchoreov1alpha1.WorkflowRun) { withRunConditions(run, true, "WorkflowCancelled") }},
+		{"resume already requested", func(run *openchoreov1alpha1.WorkflowRun) { run.Spec.Resume = true }},
+		{"unsupported engine", func(run *openchoreov1alpha1.WorkflowRun) { run.Status.RunReference.Kind = "PipelineRun" }},
+		{"no failed tasks recorded", func(run *openchoreov1alpha1.WorkflowRun) { run.Status.Tasks = run.Status.Tasks[:1] }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := newFailedRun()
+			tt.modify(run)
+			svc := newService(t, run)
+
+			_, err := svc.ResumeWorkflowRun(ctx, testNamespace, testRunName)
+			require.ErrorIs(t, err, ErrWorkflowRunNotResumable)
+		})
+	}
+}
@@ -160,6 +160,8 @@ func (r *Renderer) renderSingleResource(
 		return nil, err
 	}
 
+	SetSyncAnnotations(cleaned, tmpl.ApplyWave, tmpl.Hook)
+
 	return cleaned, nil
 }
 
@@ -0,0 +1,39 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"strconv"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// SetSyncAnnotations records the apply wave and sync hook declared on a resource template
+// as annotations on the rendered resource, which is how they reach the RenderedRelease
+// controller. Zero values leave the resource untouched, so annotations written directly
+// in the template still apply.
+func SetSyncAnnotations(resource map[string]any, wave int32, hook v1alpha1.SyncPhase) {
+	if wave == 0 && hook == "" {
+		return
+	}
+
+	metadata, ok := resource["metadata"].(map[string]any)
+	if !ok {
+		metadata = map[string]any{}
+		resource["metadata"] = metadata
+	}
+	annotations, ok := metadata["annotations"].(map[string]any)
+	if !ok {
+		annotations = map[string]any{}
+		metadata["annotations"] = annotations
+	}
+
+	if wave != 0 {
+		annotations[labels.AnnotationKeyApplyWave] = strconv.FormatInt(int64(wave), 10)
+	}
+	if hook != "" {
+		annotations[labels.AnnotationKeySyncHook] = string(hook)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+func TestSetSyncAnnotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource map[string]any
+		wave     int32
+		hook     v1alpha1.SyncPhase
+		want     map[string]any
+	}{
+		{
+			name:     "zero values leave the resource untouched",
+			resource: map[string]any{"metadata": map[string]any{"name": "app"}},
+			want:     map[string]any{"metadata": map[string]any{"name": "app"}},
+		},
+		{
+			name:     "wave and hook are added next to existing annotations",
+			resource: map[string]any{"metadata": map[string]any{"name": "migrate", "annotations": map[string]any{"team": "payments"}}},
+			wave:     -1,
+			hook:     v1alpha1.SyncPhasePreSync,
+			want: map[string]any{"metadata": map[string]any{"name": "migrate", "annotations": map[string]any{
+				"team":                        "payments",
+				labels.AnnotationKeyApplyWave: "-1",
+				labels.AnnotationKeySyncHook:  "PreSync",
+			}}},
+		},
+		{
+			name:     "metadata is created when missing",
+			resource: map[string]any{},
+			wave:     2,
+			want: map[string]any{"metadata": map[string]any{"annotations": map[string]any{
+				labels.AnnotationKeyApplyWave: "2",
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetSyncAnnotations(tt.resource, tt.wave, tt.hook)
+			assert.Equal(t, tt.want, tt.resource)
+		})
+	}
+}
+
+func TestRenderResourcesSyncAnnotations(t *testing.T) {
+	var templates []v1alpha1.ResourceTemplate
+	require.NoError(t, yaml.Unmarshal([]byte(`
+- id: migrate
+  hook: PreSync
+  applyWave: 1
+  template:
+    apiVersion: batch/v1
+    kind: Job
+    metadata:
+      name: ${metadata.name}-migrate
+`), &templates))
+
+	rendered, err := NewRenderer(template.NewEngine()).RenderResources(templates, map[string]any{
+		"metadata": map[string]any{"name": "app"},
+	})
+	require.NoError(t, err)
+	require.Len(t, rendered, 1)
+
+	metadata := rendered[0].Resource["metadata"].(map[string]any)
+	assert.Equal(t, map[string]any{
+		labels.AnnotationKeyApplyWave: "1",
+		labels.AnnotationKeySyncHook:  "PreSync",
+	}, metadata["annotations"])
+}
@@ -147,6 +147,8 @@ func (p *Processor) renderSingleCreate(
 		return renderer.RenderedResource{}, fmt.Errorf("RemoveOmittedFields returned unexpected type %T for %s", cleanedAny, path)
 	}
 
+	renderer.SetSyncAnnotations(cleaned, create.ApplyWave, create.Hook)
+
 	return renderer.RenderedResource{
 		Resource:    cleaned,
 		TargetPlane: create.TargetPlane,
@@ -148,6 +148,7 @@ func (p *Pipeline) renderSingleTemplate(tmpl *v1alpha1.ResourceTemplate, ctx map
 	if err := validateRenderedManifest(object, tmpl.ID); err != nil {
 		return nil, err
 	}
+	renderer.SetSyncAnnotations(object, tmpl.ApplyWave, tmpl.Hook)
 	return object, nil
 }
 
@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package provenance derives the build provenance of workload images from the workflow runs
+// that built them. Provenance recorded on a Workload or ComponentRelease can be edited by
+// anyone who can edit those resources, so policy decisions use the provenance confirmed by
+// the named WorkflowRun, whose artifacts are recorded in its controller-owned status.
+package provenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/registry"
+)
+
+// ErrUnverified is returned by Resolve when the recorded provenance is not confirmed by the
+// workflow run it names.
+var ErrUnverified = errors.New("build provenance is not confirmed by its workflow run")
+
+// FromWorkflowRun assembles the build provenance of a workflow run from the artifacts in its
+// status. Returns nil when the run did not produce an image.
+func FromWorkflowRun(workflowRun *openchoreov1alpha1.WorkflowRun) *openchoreov1alpha1.BuildProvenance {
+	image := ImageArtifactReference(workflowRun.Status.Artifacts)
+	if image == "" {
+		return nil
+	}
+
+	_, _, digest := registry.SplitReference(image)
+	provenance := &openchoreov1alpha1.BuildProvenance{
+		WorkflowRun: workflowRun.Name,
+		ImageDigest: digest,
+	}
+	for _, artifact := range workflowRun.Status.Artifacts {
+		ref := &openchoreov1alpha1.ArtifactReference{Reference: artifact.Reference, Format: artifact.Format}
+		switch artifact.Type {
+		case openchoreov1alpha1.WorkflowArtifactTypeSBOM:
+			provenance.SBOM = ref
+		case openchoreov1alpha1.WorkflowArtifactTypeProvenance:
+			provenance.Attestation = ref
+		case openchoreov1alpha1.WorkflowArtifactTypeSignature:
+			provenance.Signature = ref
+		}
+	}
+	return provenance
+}
+
+// ImageArtifactReference returns the reference of the image artifact, or "" if there is none.
+func ImageArtifactReference(artifacts []openchoreov1alpha1.WorkflowRunArtifact) string {
+	for _, artifact := range artifacts {
+		if artifact.Type == openchoreov1alpha1.WorkflowArtifactTypeImage {
+			return artifact.Reference
+		}
+	}
+	return ""
+}
+
+// SameImage reports whether two image references point to the same image. The repositories
+// must match, and tags and digests are compared only when both references carry them.
+func SameImage(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	repoA, tagA, digestA := registry.SplitReference(a)
+	repoB, tagB, digestB := registry.SplitReference(b)
+	if repoA != repoB {
+		return false
+	}
+	if digestA != "" && digestB != "" {
+		return digestA == digestB
+	}
+	return tagA == "" || tagB == "" || tagA == tagB
+}
+
+// Resolve returns the build provenance of a component's image as recorded by the workflow
+// run named in the recorded provenance. The run must belong to the same component and must
+// have built the image. Returns nil when nothing is recorded, and an error wrapping
+// ErrUnverified when the workflow run does not confirm the record.
+func Resolve(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	owner openchoreov1alpha1.ComponentReleaseOwner,
+	image string,
+	recorded *openchoreov1alpha1.BuildProvenance,
+) (*openchoreov1alpha1.BuildProvenance, error) {
+	if recorded == nil {
+		return nil, nil
+	}
+	if recorded.WorkflowRun == "" {
+		return nil, fmt.Errorf("%w: no workflow run is recorded", ErrUnverified)
+	}
+
+	workflowRun := &openchoreov1alpha1.WorkflowRun{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: recorded.WorkflowRun}, workflowRun); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: workflow run %q not found", ErrUnverified, recorded.WorkflowRun)
+		}
+		return nil, fmt.Errorf("failed to get workflow run %q: %w", recorded.WorkflowRun, err)
+	}
+
+	if workflowRun.Labels[labels.LabelKeyProjectName] != owner.ProjectName ||
+		workflowRun.Labels[labels.LabelKeyComponentName] != owner.ComponentName {
+		return nil, fmt.Errorf("%w: workflow run %q does not belong to component %q",
+			ErrUnverified, recorded.WorkflowRun, owner.ComponentName)
+	}
+	provenance := FromWorkflowRun(workflowRun)
+	if provenance == nil || !SameImage(image, ImageArtifactReference(workflowRun.Status.Artifacts)) {
+		return nil, fmt.Errorf("%w: workflow run %q did not build image %q", ErrUnverified, recorded.WorkflowRun, image)
+	}
+	return provenance, nil
+}
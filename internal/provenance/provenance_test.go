@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package provenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const testBuiltImage = "registry.example.com/acme/app@sha256:0123abcd"
+
+func TestSameImage(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"registry.example.com/acme/app:v1", testBuiltImage, true},
+		{"registry.example.com/acme/app:v1@sha256:0123abcd", testBuiltImage, true},
+		{"registry.example.com/acme/app@sha256:ffff", testBuiltImage, false},
+		{"registry.example.com/acme/other:v1", testBuiltImage, false},
+		{"localhost:5000/app:v1", "localhost:5000/app:v2", false},
+		{"localhost:5000/app:v1", "localhost:5000/app@sha256:0123abcd", true},
+		{"", testBuiltImage, false},
+	}
+	for _, tt := range tests {
+		if got := SameImage(tt.a, tt.b); got != tt.want {
+			t.Errorf("SameImage(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app-run-1", Namespace: "default",
+			Labels: map[string]string{labels.LabelKeyProjectName: "acme", labels.LabelKeyComponentName: "app"},
+		},
+		Status: openchoreov1alpha1.WorkflowRunStatus{Artifacts: []openchoreov1alpha1.WorkflowRunArtifact{
+			{Name: "image", Type: openchoreov1alpha1.WorkflowArtifactTypeImage, Reference: testBuiltImage},
+			{Name: "sbom", Type: openchoreov1alpha1.WorkflowArtifactTypeSBOM, Reference: "sbom-ref", Format: "spdx-json"},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(run).Build()
+	owner := openchoreov1alpha1.ComponentReleaseOwner{ProjectName: "acme", ComponentName: "app"}
+
+	// A forged record is replaced by what the workflow run recorded
+	forged := &openchoreov1alpha1.BuildProvenance{
+		WorkflowRun: "app-run-1",
+		ImageDigest: "sha256:ffff",
+		Signature:   &openchoreov1alpha1.ArtifactReference{Reference: "forged"},
+	}
+	got, err := Resolve(context.Background(), c, "default", owner, "registry.example.com/acme/app:v1", forged)
+	if err != nil {
+		t.Fatalf("Resolve() error: %v", err)
+	}
+	if got.ImageDigest != "sha256:0123abcd" || got.Signature != nil || got.SBOM == nil || got.SBOM.Reference != "sbom-ref" {
+		t.Errorf("Resolve() = %+v, want the workflow run's provenance", got)
+	}
+
+	if got, err := Resolve(context.Background(), c, "default", owner, testBuiltImage, nil); got != nil || err != nil {
+		t.Errorf("Resolve() without a record = %+v, %v; want nil, nil", got, err)
+	}
+
+	tests := []struct {
+		name     string
+		owner    openchoreov1alpha1.ComponentReleaseOwner
+		image    string
+		recorded *openchoreov1alpha1.BuildProvenance
+	}{
+		{name: "no workflow run", owner: owner, image: testBuiltImage, recorded: &openchoreov1alpha1.BuildProvenance{}},
+		{name: "missing workflow run", owner: owner, image: testBuiltImage,
+			recorded: &openchoreov1alpha1.BuildProvenance{WorkflowRun: "app-run-2"}},
+		{name: "other component", owner: openchoreov1alpha1.ComponentReleaseOwner{ProjectName: "acme", ComponentName: "other"},
+			image: testBuiltImage, recorded: forged},
+		{name: "other image", owner: owner, image: "registry.example.com/acme/app@sha256:ffff", recorded: forged},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Resolve(context.Background(), c, "default", tt.owner, tt.image, tt.recorded)
+			if !errors.Is(err, ErrUnverified) {
+				t.Errorf("Resolve() error = %v, want ErrUnverified", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Credentials are registry credentials keyed by registry domain.
+type Credentials map[string]Credential
+
+// Credential is a username and password for a registry.
+type Credential struct {
+	Username string
+	Password string
+}
+
+// dockerConfig is the content of a kubernetes.io/dockerconfigjson Secret.
+type dockerConfig struct {
+	Auths map[string]struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	} `json:"auths"`
+}
+
+// CredentialsFromSecret reads registry credentials from a kubernetes.io/dockerconfigjson Secret.
+func CredentialsFromSecret(secret *corev1.Secret) (Credentials, error) {
+	data, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %q has no %s key", secret.Name, corev1.DockerConfigJsonKey)
+	}
+	var cfg dockerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s of secret %q: %w", corev1.DockerConfigJsonKey, secret.Name, err)
+	}
+
+	creds := make(Credentials, len(cfg.Auths))
+	for server, auth := range cfg.Auths {
+		cred := Credential{Username: auth.Username, Password: auth.Password}
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode auth for registry %q: %w", server, err)
+			}
+			user, pass, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return nil, fmt.Errorf("invalid auth for registry %q", server)
+			}
+			cred = Credential{Username: user, Password: pass}
+		}
+		creds[normalizeDomain(server)] = cred
+	}
+	return creds, nil
+}
+
+// lookup returns the credential for a registry domain.
+func (c Credentials) lookup(domain string) (Credential, bool) {
+	cred, ok := c[normalizeDomain(domain)]
+	return cred, ok
+}
+
+// normalizeDomain maps a docker config server entry, which may be a URL, to a registry domain.
+func normalizeDomain(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	server, _, _ = strings.Cut(server, "/")
+	switch server {
+	case "index.docker.io", dockerHubAPIHost:
+		return dockerHubDomain
+	}
+	return server
+}
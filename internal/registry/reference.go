@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registry resolves container image references against OCI distribution registries.
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// dockerHubDomain is the registry domain of images without an explicit registry.
+	dockerHubDomain = "docker.io"
+	// dockerHubAPIHost is the host serving the registry API of Docker Hub.
+	dockerHubAPIHost = "registry-1.docker.io"
+	defaultTag       = "latest"
+)
+
+// Reference is a parsed container image reference.
+type Reference struct {
+	// Domain is the registry domain, e.g. "registry.example.com:5000" or "docker.io".
+	Domain string
+	// Repository is the repository path within the registry, e.g. "library/nginx".
+	Repository string
+	// Tag is the image tag. Empty when the reference only carries a digest.
+	Tag string
+	// Digest is the manifest digest, e.g. "sha256:...".
+	Digest string
+}
+
+// ParseReference parses an image reference. References without a registry domain
+// refer to Docker Hub, and references without a tag or digest use the "latest" tag.
+func ParseReference(image string) (Reference, error) {
+	if image == "" || strings.ContainsAny(image, " \t\n") {
+		return Reference{}, fmt.Errorf("invalid image reference %q", image)
+	}
+
+	var ref Reference
+	var name string
+	name, ref.Tag, ref.Digest = SplitReference(image)
+	if ref.Digest != "" && !strings.Contains(ref.Digest, ":") {
+		return Reference{}, fmt.Errorf("invalid digest in image reference %q", image)
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	// The first path component is a registry domain if it looks like a host name.
+	if i := strings.Index(name, "/"); i >= 0 && (strings.ContainsAny(name[:i], ".:") || name[:i] == "localhost") {
+		ref.Domain, ref.Repository = name[:i], name[i+1:]
+	} else {
+		ref.Domain, ref.Repository = dockerHubDomain, name
+	}
+	if ref.Domain == dockerHubDomain && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+	if ref.Repository == "" {
+		return Reference{}, fmt.Errorf("invalid image reference %q", image)
+	}
+	return ref, nil
+}
+
+// Name returns the image name without tag or digest, e.g. "registry.example.com/app".
+func (r Reference) Name() string {
+	return r.Domain + "/" + r.Repository
+}
+
+// apiHost returns the host serving the registry API for the reference.
+func (r Reference) apiHost() string {
+	if r.Domain == dockerHubDomain {
+		return dockerHubAPIHost
+	}
+	return r.Domain
+}
+
+// SplitReference splits an image reference into its name, tag and digest as written,
+// without normalizing the registry domain or defaulting the tag.
+func SplitReference(ref string) (name, tag, digest string) {
+	name = ref
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, digest = name[:i], name[i+1:]
+	}
+	// A colon after the last slash separates the tag; earlier colons belong to a registry port.
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	return name, tag, digest
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// manifestMediaTypes are the manifest media types accepted when resolving a tag. Image indexes
+// are preferred so that multi-platform images resolve to the digest of the index.
+var manifestMediaTypes = strings.Join([]string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}, ", ")
+
+// maxManifestSize bounds the manifest body read when a registry omits the digest header.
+const maxManifestSize = 4 << 20
+
+// Resolver resolves image tags to manifest digests using the OCI distribution API.
+type Resolver struct {
+	// HTTPClient is used for registry requests. Defaults to a client with a 30 second timeout.
+	HTTPClient *http.Client
+	// Credentials authenticate to private registries.
+	Credentials Credentials
+	// InsecureRegistries lists the registries, as host[:port], that serve the distribution API
+	// over plain HTTP, such as a local k3d registry.
+	InsecureRegistries []string
+}
+
+// NewResolver creates a Resolver that authenticates with the given credentials and reaches
+// the listed insecure registries over plain HTTP.
+func NewResolver(creds Credentials, insecureRegistries []string) *Resolver {
+	return &Resolver{
+		HTTPClient:         &http.Client{Timeout: 30 * time.Second},
+		Credentials:        creds,
+		InsecureRegistries: insecureRegistries,
+	}
+}
+
+// ResolveDigest returns the digest of the manifest an image reference points to.
+// References that already carry a digest are returned without contacting the registry.
+func (r *Resolver) ResolveDigest(ctx context.Context, image string) (string, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	if ref.Digest != "" {
+		return ref.Digest, nil
+	}
+
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", r.scheme(ref), ref.apiHost(), ref.Repository, ref.Tag)
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		digest, err := r.fetchDigest(ctx, method, manifestURL, ref)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve image %q: %w", image, err)
+		}
+		if digest != "" {
+			return digest, nil
+		}
+	}
+	return "", fmt.Errorf("failed to resolve image %q: registry returned no digest", image)
+}
+
+// fetchDigest requests the manifest and returns its digest. The digest is taken from the
+// Docker-Content-Digest header, or computed from the body of a GET request. Returns an empty
+// digest when a HEAD response carries no digest header.
+func (r *Resolver) fetchDigest(ctx context.Context, method, manifestURL string, ref Reference) (string, error) {
+	resp, err := r.doAuthenticated(ctx, method, manifestURL, ref)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry returned %s for %s", resp.Status, manifestURL)
+	}
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+	if method == http.MethodHead {
+		return "", nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read manifest: %w", err)
+	}
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// doAuthenticated performs a registry request, answering a Basic or Bearer challenge
+// with the credentials of the registry.
+func (r *Resolver) doAuthenticated(ctx context.Context, method, rawURL string, ref Reference) (*http.Response, error) {
+	resp, err := r.do(ctx, method, rawURL, "")
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	_ = resp.Body.Close()
+
+	cred, hasCred := r.Credentials.lookup(ref.Domain)
+	scheme, params := parseChallenge(challenge)
+	var authorization string
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasCred {
+			return nil, fmt.Errorf("registry %s requires credentials", ref.Domain)
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(cred.Username, cred.Password)
+		authorization = req.Header.Get("Authorization")
+	case "bearer":
+		token, err := r.fetchToken(ctx, params, ref, cred, hasCred)
+		if err != nil {
+			return nil, err
+		}
+		authorization = "Bearer " + token
+	default:
+		return nil, fmt.Errorf("registry %s returned unsupported authentication challenge %q", ref.Domain, challenge)
+	}
+	return r.do(ctx, method, rawURL, authorization)
+}
+
+func (r *Resolver) do(ctx context.Context, method, rawURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", manifestMediaTypes)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return r.httpClient().Do(req)
+}
+
+// fetchToken obtains a pull token for the repository from the token service named in a Bearer challenge.
+func (r *Resolver) fetchToken(ctx context.Context, params map[string]string, ref Reference, cred Credential, hasCred bool) (string, error) {
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("registry %s returned a bearer challenge without a realm", ref.Domain)
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("invalid token realm %q: %w", realm, err)
+	}
+	query := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.Repository))
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if hasCred {
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+	resp, err := r.httpClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch registry token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token service returned %s", resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode registry token: %w", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", fmt.Errorf("token service returned no token")
+}
+
+// scheme returns the URL scheme used to reach the registry of a reference.
+func (r *Resolver) scheme(ref Reference) string {
+	if slices.Contains(r.InsecureRegistries, ref.Domain) {
+		return "http"
+	}
+	return "https"
+}
+
+func (r *Resolver) httpClient() *http.Client {
+	if r.HTTPClient != nil {
+		return r.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry.example.com"`.
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var pair string
+		rest = strings.TrimLeft(rest, ", ")
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				break
+			}
+			pair, rest = value[1:end+1], value[end+2:]
+		} else {
+			pair, rest, _ = strings.Cut(value, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = pair
+	}
+	return scheme, params
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testManifestDigest = "sha256:4f1c0a6e"
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		image string
+		want  Reference
+	}{
+		{"nginx", Reference{Domain: "docker.io", Repository: "library/nginx", Tag: "latest"}},
+		{"acme/app:v1", Reference{Domain: "docker.io", Repository: "acme/app", Tag: "v1"}},
+		{"registry.example.com/acme/app:v1", Reference{Domain: "registry.example.com", Repository: "acme/app", Tag: "v1"}},
+		{"localhost:5000/app", Reference{Domain: "localhost:5000", Repository: "app", Tag: "latest"}},
+		{"localhost/app@sha256:abc", Reference{Domain: "localhost", Repository: "app", Digest: "sha256:abc"}},
+		{"ghcr.io/acme/app:v1@sha256:abc", Reference{Domain: "ghcr.io", Repository: "acme/app", Tag: "v1", Digest: "sha256:abc"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseReference(tt.image)
+		if err != nil {
+			t.Errorf("ParseReference(%q) error = %v", tt.image, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseReference(%q) = %+v, want %+v", tt.image, got, tt.want)
+		}
+	}
+
+	for _, image := range []string{"", "app@nodigest", "bad image"} {
+		if _, err := ParseReference(image); err == nil {
+			t.Errorf("ParseReference(%q) expected error", image)
+		}
+	}
+}
+
+func TestSplitReference(t *testing.T) {
+	tests := []struct {
+		ref, name, tag, digest string
+	}{
+		{"registry.example.com/acme/app:v1", "registry.example.com/acme/app", "v1", ""},
+		{"localhost:5000/app@sha256:0123", "localhost:5000/app", "", "sha256:0123"},
+		{"localhost:5000/app:v1@sha256:0123", "localhost:5000/app", "v1", "sha256:0123"},
+		{"nginx", "nginx", "", ""},
+	}
+	for _, tt := range tests {
+		name, tag, digest := SplitReference(tt.ref)
+		if name != tt.name || tag != tt.tag || digest != tt.digest {
+			t.Errorf("SplitReference(%q) = %q, %q, %q", tt.ref, name, tag, digest)
+		}
+	}
+}
+
+func TestCredentialsFromSecret(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("robot:s3cret"))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-creds"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{
+			"https://index.docker.io/v1/":{"auth":"` + auth + `"},
+			"registry.example.com":{"username":"ci","password":"token"}}}`)},
+	}
+
+	creds, err := CredentialsFromSecret(secret)
+	if err != nil {
+		t.Fatalf("CredentialsFromSecret() error = %v", err)
+	}
+	if cred, ok := creds.lookup("docker.io"); !ok || cred.Username != "robot" || cred.Password != "s3cret" {
+		t.Errorf("unexpected Docker Hub credential: %+v", cred)
+	}
+	if cred, ok := creds.lookup("registry.example.com"); !ok || cred.Username != "ci" {
+		t.Errorf("unexpected registry credential: %+v", cred)
+	}
+
+	if _, err := CredentialsFromSecret(&corev1.Secret{}); err == nil {
+		t.Error("expected error for a secret without docker config")
+	}
+}
+
+// newTestRegistry serves a manifest for acme/app:v1 behind bearer token authentication.
+func newTestRegistry(t *testing.T, withDigestHeader bool) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/token":
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != "ci" || pass != "token" || r.URL.Query().Get("scope") != "repository:acme/app:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"pull-token"}`))
+		case "/v2/acme/app/manifests/v1":
+			if r.Header.Get("Authorization") != "Bearer pull-token" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="test-registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json") {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			if withDigestHeader {
+				w.Header().Set("Docker-Content-Digest", testManifestDigest)
+			}
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`{"schemaVersion":2}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newTestResolver(srv *httptest.Server, creds Credentials) *Resolver {
+	return &Resolver{HTTPClient: srv.Client(), Credentials: creds}
+}
+
+func TestResolveDigest(t *testing.T) {
+	ctx := context.Background()
+	creds := Credentials{}
+
+	t.Run("bearer token and digest header", func(t *testing.T) {
+		srv, requests := newTestRegistry(t, true)
+		host := strings.TrimPrefix(srv.URL, "https://")
+		creds[host] = Credential{Username: "ci", Password: "token"}
+
+		digest, err := newTestResolver(srv, creds).ResolveDigest(ctx, host+"/acme/app:v1")
+		if err != nil {
+			t.Fatalf("ResolveDigest() error = %v", err)
+		}
+		if digest != testManifestDigest {
+			t.Errorf("ResolveDigest() = %q, want %q", digest, testManifestDigest)
+		}
+		want := []string{"HEAD /v2/acme/app/manifests/v1", "GET /token", "HEAD /v2/acme/app/manifests/v1"}
+		if strings.Join(*requests, ",") != strings.Join(want, ",") {
+			t.Errorf("requests = %v, want %v", *requests, want)
+		}
+	})
+
+	t.Run("digest computed from manifest body", func(t *testing.T) {
+		srv, _ := newTestRegistry(t, false)
+		host := strings.TrimPrefix(srv.URL, "https://")
+		creds[host] = Credential{Username: "ci", Password: "token"}
+
+		digest, err := newTestResolver(srv, creds).ResolveDigest(ctx, host+"/acme/app:v1")
+		if err != nil {
+			t.Fatalf("ResolveDigest() error = %v", err)
+		}
+		sum := sha256.Sum256([]byte(`{"schemaVersion":2}`))
+		if want := "sha256:" + hex.EncodeToString(sum[:]); digest != want {
+			t.Errorf("ResolveDigest() = %q, want %q", digest, want)
+		}
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		srv, _ := newTestRegistry(t, true)
+		host := strings.TrimPrefix(srv.URL, "https://")
+		if _, err := newTestResolver(srv, nil).ResolveDigest(ctx, host+"/acme/app:v1"); err == nil {
+			t.Error("expected error without credentials")
+		}
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		srv, _ := newTestRegistry(t, true)
+		host := strings.TrimPrefix(srv.URL, "https://")
+		_, err := newTestResolver(srv, nil).ResolveDigest(ctx, host+"/acme/app:v2")
+		if err == nil || !strings.Contains(err.Error(), "404") {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("insecure registry over plain HTTP", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/app/manifests/v1" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", testManifestDigest)
+		}))
+		t.Cleanup(srv.Close)
+		host := strings.TrimPrefix(srv.URL, "http://")
+
+		if _, err := NewResolver(nil, nil).ResolveDigest(ctx, host+"/app:v1"); err == nil {
+			t.Error("expected error when the registry is not listed as insecure")
+		}
+		digest, err := NewResolver(nil, []string{host}).ResolveDigest(ctx, host+"/app:v1")
+		if err != nil || digest != testManifestDigest {
+			t.Errorf("ResolveDigest() = %q, %v; want %q", digest, err, testManifestDigest)
+		}
+	})
+
+	t.Run("pinned reference is not resolved", func(t *testing.T) {
+		digest, err := (&Resolver{}).ResolveDigest(ctx, "unreachable.invalid/app@sha256:abc")
+		if err != nil || digest != "sha256:abc" {
+			t.Errorf("ResolveDigest() = %q, %v", digest, err)
+		}
+	})
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:a/b:pull"`)
+	if scheme != "Bearer" || params["realm"] != "https://auth.example.com/token" ||
+		params["service"] != "registry.example.com" || params["scope"] != "repository:a/b:pull" {
+		t.Errorf("parseChallenge() = %q, %v", scheme, params)
+	}
+	if scheme, _ := parseChallenge(`Basic realm="registry"`); scheme != "Basic" {
+		t.Errorf("parseChallenge() scheme = %q", scheme)
+	}
+}
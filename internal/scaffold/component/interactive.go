@@ -0,0 +1,837 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	corev1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// noneOption is offered for optional choices that can be left unset.
+const noneOption = "(none)"
+
+// SchemaLoader returns the parameters schema of a referenced resource, such as a
+// Trait or Workflow, given its kind and name.
+type SchemaLoader func(kind, name string) (*extv1.JSONSchemaProps, error)
+
+// InteractiveOptions configures an interactive scaffolding session.
+type InteractiveOptions struct {
+	// ComponentName is the name for the generated Component and Workload
+	ComponentName string
+
+	// Namespace is the target namespace
+	Namespace string
+
+	// ProjectName is the owning project name
+	ProjectName string
+
+	// ComponentTypeName is the ComponentType name without the workload type prefix
+	ComponentTypeName string
+
+	// ComponentTypeKind is "ComponentType" or "ClusterComponentType"
+	ComponentTypeKind string
+
+	// ComponentType is the spec whose schemas, allowed traits, allowed workflows and
+	// validation rules drive the questions
+	ComponentType *corev1alpha1.ComponentTypeSpec
+
+	// LoadTraitSchema fetches the parameters schema of a selected trait
+	LoadTraitSchema SchemaLoader
+
+	// LoadWorkflowSchema fetches the parameters schema of a selected workflow
+	LoadWorkflowSchema SchemaLoader
+}
+
+// InteractiveResult holds the resources produced by an interactive session.
+type InteractiveResult struct {
+	Component map[string]any
+	Workload  map[string]any
+
+	header string
+}
+
+// YAML renders the Component and Workload as a multi-document YAML file.
+func (r *InteractiveResult) YAML() (string, error) {
+	component, err := yaml.Marshal(r.Component)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Component: %w", err)
+	}
+	workload, err := yaml.Marshal(r.Workload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Workload: %w", err)
+	}
+	return r.header + string(component) + "---\n" + string(workload), nil
+}
+
+// interactiveSession holds the state shared by the questions of one session.
+type interactiveSession struct {
+	p      *Prompter
+	opts   InteractiveOptions
+	engine *template.Engine
+}
+
+// RunInteractive asks for the values of a new Component: its parameters, the
+// traits and workflow allowed by the ComponentType, and the container image and
+// endpoint of its Workload. Answers are checked against the OpenAPI schemas as
+// they are entered, and the ComponentType validation rules are evaluated once
+// the parameters are complete.
+func RunInteractive(p *Prompter, opts InteractiveOptions) (*InteractiveResult, error) {
+	if opts.ComponentType == nil {
+		return nil, errors.New("component type spec is required")
+	}
+	s := &interactiveSession{p: p, opts: opts, engine: template.NewEngine()}
+	typeRef := fmt.Sprintf("%s/%s", opts.ComponentType.WorkloadType, opts.ComponentTypeName)
+
+	p.Printf("Scaffolding Component %q of type %s\n", opts.ComponentName, typeRef)
+
+	parameters, err := s.promptParameters()
+	if err != nil {
+		return nil, err
+	}
+	traits, err := s.promptTraits()
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := s.promptWorkflow()
+	if err != nil {
+		return nil, err
+	}
+	workloadSpec, err := s.promptWorkload()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]any{
+		"owner":         map[string]any{"projectName": opts.ProjectName},
+		"componentType": map[string]any{"kind": opts.ComponentTypeKind, "name": typeRef},
+	}
+	if len(parameters) > 0 {
+		spec["parameters"] = parameters
+	}
+	if len(traits) > 0 {
+		spec["traits"] = traits
+	}
+	if workflow != nil {
+		spec["workflow"] = workflow
+	}
+
+	return &InteractiveResult{
+		Component: map[string]any{
+			"apiVersion": "openchoreo.dev/v1alpha1",
+			"kind":       "Component",
+			"metadata":   map[string]any{"name": opts.ComponentName, "namespace": opts.Namespace},
+			"spec":       spec,
+		},
+		Workload: map[string]any{
+			"apiVersion": "openchoreo.dev/v1alpha1",
+			"kind":       "Workload",
+			"metadata":   map[string]any{"name": opts.ComponentName + "-workload", "namespace": opts.Namespace},
+			"spec":       workloadSpec,
+		},
+		header: fmt.Sprintf("# Generated by occ scaffold component --interactive\n# Component: %s\n# Type: %s\n",
+			opts.ComponentName, typeRef),
+	}, nil
+}
+
+// promptParameters asks for the component parameters until they satisfy the
+// parameters schema and the ComponentType validation rules.
+func (s *interactiveSession) promptParameters() (map[string]any, error) {
+	spec := s.opts.ComponentType
+	paramsSchema, err := openchoreoschema.SectionToJSONSchema(spec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("processing component schema: %w", err)
+	}
+	paramsStructural, err := openchoreoschema.ResolveSectionToStructural(spec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("processing component schema: %w", err)
+	}
+	envSchema, err := openchoreoschema.SectionToJSONSchema(spec.EnvironmentConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("processing environment configs schema: %w", err)
+	}
+	envStructural, err := openchoreoschema.ResolveSectionToStructural(spec.EnvironmentConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("processing environment configs schema: %w", err)
+	}
+
+	rules := spec.EffectivePreRenderValidations()
+	if len(paramsSchema.Properties) == 0 && len(rules) == 0 {
+		return nil, nil
+	}
+
+	// Environment configs are set per environment on a ReleaseBinding, so they
+	// are only asked for to check the rules that read them
+	checkEnv := false
+	if len(rules) > 0 && len(envSchema.Properties) > 0 {
+		s.p.Printf("\n")
+		checkEnv, err = s.p.Confirm("Enter sample environment configs to check the validation rules against", false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var params, envConfigs map[string]any
+	for {
+		if len(paramsSchema.Properties) > 0 {
+			s.p.Printf("\nParameters for %s\n", s.opts.ComponentTypeName)
+			if params, err = s.promptObject("parameters", paramsSchema, params); err != nil {
+				return nil, err
+			}
+		}
+		if checkEnv {
+			s.p.Printf("\nEnvironment configs (not written to the Component)\n")
+			if envConfigs, err = s.promptObject("environmentConfigs", envSchema, envConfigs); err != nil {
+				return nil, err
+			}
+		}
+
+		var problems []string
+		if err := openchoreoschema.ValidateWithJSONSchema(orEmpty(params), paramsSchema); err != nil {
+			problems = append(problems, err.Error())
+		}
+		problems = append(problems, s.checkRules(rules, params, paramsStructural, envConfigs, envStructural)...)
+		if len(problems) == 0 {
+			return params, nil
+		}
+
+		s.p.Printf("\nThe parameters are not valid for %s:\n", s.opts.ComponentTypeName)
+		for _, problem := range problems {
+			s.p.Printf("  - %s\n", problem)
+		}
+		retry, err := s.p.Confirm("Edit the answers", true)
+		if err != nil {
+			return nil, err
+		}
+		if !retry {
+			return nil, fmt.Errorf("parameters are not valid for %s: %s", s.opts.ComponentTypeName, strings.Join(problems, "; "))
+		}
+	}
+}
+
+// checkRules evaluates the validation rules against the defaulted answers and
+// returns the messages of the rules that do not hold. Rules that depend on
+// context only known at deploy time, such as the data plane, are reported as
+// unchecked instead.
+func (s *interactiveSession) checkRules(
+	rules []corev1alpha1.ValidationRule,
+	params map[string]any, paramsStructural *apiextschema.Structural,
+	envConfigs map[string]any, envStructural *apiextschema.Structural,
+) []string {
+	if len(rules) == 0 {
+		return nil
+	}
+	ctx := map[string]any{
+		"metadata": map[string]any{
+			"name":               s.opts.ComponentName,
+			"namespace":          s.opts.Namespace,
+			"componentName":      s.opts.ComponentName,
+			"componentNamespace": s.opts.Namespace,
+			"projectName":        s.opts.ProjectName,
+		},
+		"parameters":         openchoreoschema.ApplyDefaults(runtime.DeepCopyJSON(orEmpty(params)), paramsStructural),
+		"environmentConfigs": openchoreoschema.ApplyDefaults(runtime.DeepCopyJSON(orEmpty(envConfigs)), envStructural),
+	}
+
+	var failures []string
+	for _, rule := range rules {
+		result, err := s.engine.Render(rule.Rule, ctx)
+		if err != nil {
+			// Keep only the first line of multi-line CEL compilation errors
+			reason, _, _ := strings.Cut(err.Error(), "\n")
+			s.p.Printf("  note: rule %s could not be checked locally: %s\n", rule.Rule, reason)
+			continue
+		}
+		if ok, isBool := result.(bool); !isBool {
+			s.p.Printf("  note: rule %s does not evaluate to a boolean\n", rule.Rule)
+		} else if !ok {
+			failures = append(failures, rule.Message)
+		}
+	}
+	return failures
+}
+
+// promptTraits asks which of the allowed traits to attach and for their parameters.
+func (s *interactiveSession) promptTraits() ([]any, error) {
+	allowed := s.opts.ComponentType.AllowedTraits
+	if len(allowed) == 0 {
+		return nil, nil
+	}
+
+	labels := make([]string, len(allowed))
+	for i, ref := range allowed {
+		labels[i] = fmt.Sprintf("%s (%s)", ref.Name, traitKind(ref.Kind))
+	}
+	s.p.Printf("\n")
+	selected, err := s.p.MultiSelect(fmt.Sprintf("Traits allowed by %s", s.opts.ComponentTypeName), labels)
+	if err != nil {
+		return nil, err
+	}
+
+	var traits []any
+	instanceNames := map[string]bool{}
+	for _, label := range selected {
+		ref := allowed[slices.Index(labels, label)]
+		kind := traitKind(ref.Kind)
+		s.p.Printf("\nTrait %s\n", ref.Name)
+
+		instanceName, err := s.p.Input("Instance name", uniqueName(ref.Name, instanceNames), func(answer string) error {
+			if instanceNames[answer] {
+				return fmt.Errorf("instance name %q is already used", answer)
+			}
+			return validateName(answer)
+		})
+		if err != nil {
+			return nil, err
+		}
+		instanceNames[instanceName] = true
+
+		trait := map[string]any{"kind": kind, "name": ref.Name, "instanceName": instanceName}
+		params, err := s.promptReferencedParameters(s.opts.LoadTraitSchema, kind, ref.Name, instanceName+".parameters")
+		if err != nil {
+			return nil, err
+		}
+		if len(params) > 0 {
+			trait["parameters"] = params
+		}
+		traits = append(traits, trait)
+	}
+	return traits, nil
+}
+
+// promptWorkflow asks which of the allowed workflows builds the component, if any.
+func (s *interactiveSession) promptWorkflow() (map[string]any, error) {
+	allowed := s.opts.ComponentType.AllowedWorkflows
+	if len(allowed) == 0 {
+		return nil, nil
+	}
+
+	labels := make([]string, 0, len(allowed)+1)
+	for _, ref := range allowed {
+		labels = append(labels, fmt.Sprintf("%s (%s)", ref.Name, workflowKind(ref.Kind)))
+	}
+	labels = append(labels, noneOption)
+	s.p.Printf("\n")
+	choice, err := s.p.Select(fmt.Sprintf("Workflow to build %s", s.opts.ComponentName), labels, noneOption)
+	if err != nil {
+		return nil, err
+	}
+	if choice == noneOption {
+		return nil, nil
+	}
+
+	ref := allowed[slices.Index(labels, choice)]
+	kind := workflowKind(ref.Kind)
+	workflow := map[string]any{"kind": kind, "name": ref.Name}
+	params, err := s.promptReferencedParameters(s.opts.LoadWorkflowSchema, kind, ref.Name, "workflow.parameters")
+	if err != nil {
+		return nil, err
+	}
+	if len(params) > 0 {
+		workflow["parameters"] = params
+	}
+	return workflow, nil
+}
+
+// promptReferencedParameters loads the schema of a trait or workflow and asks
+// for its parameters until they are valid.
+func (s *interactiveSession) promptReferencedParameters(load SchemaLoader, kind, name, path string) (map[string]any, error) {
+	if load == nil {
+		return nil, nil
+	}
+	schema, err := load(kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil || len(schema.Properties) == 0 {
+		return nil, nil
+	}
+
+	var params map[string]any
+	for {
+		if params, err = s.promptObject(path, schema, params); err != nil {
+			return nil, err
+		}
+		err := openchoreoschema.ValidateWithJSONSchema(orEmpty(params), schema)
+		if err == nil {
+			return params, nil
+		}
+		s.p.Printf("  ! %s parameters are not valid: %v\n", name, err)
+	}
+}
+
+// promptWorkload asks for the container image and the endpoint of the Workload.
+func (s *interactiveSession) promptWorkload() (map[string]any, error) {
+	s.p.Printf("\nWorkload\n")
+	image, err := s.p.Input("Container image", "", func(answer string) error {
+		if answer == "" {
+			return errors.New("a value is required")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]any{
+		"owner":     map[string]any{"projectName": s.opts.ProjectName, "componentName": s.opts.ComponentName},
+		"container": map[string]any{"image": image},
+	}
+
+	// Jobs rarely serve traffic, so only long-running workloads default to an endpoint
+	workloadType := s.opts.ComponentType.WorkloadType
+	addEndpoint, err := s.p.Confirm("Expose an endpoint", workloadType == "deployment" || workloadType == "statefulset")
+	if err != nil || !addEndpoint {
+		return spec, err
+	}
+
+	name, err := s.p.Input("Endpoint name", "http", validateName)
+	if err != nil {
+		return nil, err
+	}
+	endpointTypes := []string{
+		string(corev1alpha1.EndpointTypeHTTP), string(corev1alpha1.EndpointTypeGraphQL),
+		string(corev1alpha1.EndpointTypeWebsocket), string(corev1alpha1.EndpointTypeGRPC),
+		string(corev1alpha1.EndpointTypeTCP), string(corev1alpha1.EndpointTypeUDP),
+	}
+	endpointType, err := s.p.Select("Endpoint type", endpointTypes, string(corev1alpha1.EndpointTypeHTTP))
+	if err != nil {
+		return nil, err
+	}
+	portStr, err := s.p.Input("Port", "8080", func(answer string) error {
+		port, err := strconv.Atoi(answer)
+		if err != nil || port < 1 || port > 65535 {
+			return errors.New("port must be a number between 1 and 65535")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	port, _ := strconv.ParseInt(portStr, 10, 32)
+
+	spec["endpoints"] = map[string]any{name: map[string]any{"type": endpointType, "port": port}}
+	return spec, nil
+}
+
+// promptObject asks for each property of an object schema, required properties
+// first. Previous answers take precedence over schema defaults when a question
+// is asked again. Optional values equal to their default are left out, since
+// the default is applied when the Component is rendered.
+func (s *interactiveSession) promptObject(path string, schema *extv1.JSONSchemaProps, previous map[string]any) (map[string]any, error) {
+	result := map[string]any{}
+	for _, name := range orderedProperties(schema) {
+		prop := schema.Properties[name]
+		required := slices.Contains(schema.Required, name)
+
+		var def any
+		hasDef := prop.Default != nil && json.Unmarshal(prop.Default.Raw, &def) == nil
+		prev, hasPrev := previous[name]
+		current, hasCurrent := prev, hasPrev
+		if !hasCurrent {
+			current, hasCurrent = def, hasDef
+		}
+
+		if prop.Description != "" {
+			s.p.Printf("# %s\n", prop.Description)
+		}
+		f := field{path: path + "." + name, prop: &prop, required: required, current: current, hasCurrent: hasCurrent}
+		value, set, err := s.promptField(f, prev)
+		if err != nil {
+			return nil, err
+		}
+		if !set || (!required && hasDef && jsonEqual(value, def)) {
+			continue
+		}
+		result[name] = value
+	}
+	return result, nil
+}
+
+// field describes a single question. current is the previous answer or, when
+// there is none, the schema default.
+type field struct {
+	path       string
+	prop       *extv1.JSONSchemaProps
+	required   bool
+	current    any
+	hasCurrent bool
+}
+
+// textCodec converts between a typed value and the text typed as an answer.
+type textCodec struct {
+	hint   string
+	parse  func(answer string, prop *extv1.JSONSchemaProps) (any, error)
+	format func(value any) string
+}
+
+var (
+	scalarCodec = textCodec{parse: parseScalar, format: formatValue}
+	listCodec   = textCodec{hint: "comma-separated", parse: parseList, format: formatValue}
+	mapCodec    = textCodec{hint: "key=value, comma-separated", parse: parseMap, format: formatValue}
+	jsonCodec   = textCodec{hint: "JSON", parse: parseJSON, format: formatJSON}
+)
+
+// promptField asks for a single value of any schema type. It reports false when
+// an optional value was left unset. previous is the answer given to the same
+// question before, used to pre-fill nested objects and lists.
+func (s *interactiveSession) promptField(f field, previous any) (any, bool, error) {
+	prop := f.prop
+	switch {
+	case prop.Type == typeObject && len(prop.Properties) > 0:
+		if !f.required {
+			configure, err := s.p.Confirm(fmt.Sprintf("Configure %s", f.path), previous != nil)
+			if err != nil || !configure {
+				return nil, false, err
+			}
+		}
+		previousMap, _ := previous.(map[string]any)
+		value, err := s.promptObject(f.path, prop, previousMap)
+		if err != nil {
+			return nil, false, err
+		}
+		return value, f.required || len(value) > 0, nil
+
+	case prop.Type == typeArray && itemSchema(prop) != nil && itemSchema(prop).Type == typeObject &&
+		len(itemSchema(prop).Properties) > 0:
+		previousItems, _ := previous.([]any)
+		return s.promptObjectList(f, previousItems)
+
+	case prop.Type == typeArray && isPrimitive(itemSchema(prop)):
+		return s.promptText(f, listCodec)
+
+	case prop.Type == typeObject && prop.AdditionalProperties != nil && isPrimitive(prop.AdditionalProperties.Schema):
+		return s.promptText(f, mapCodec)
+
+	case isPrimitive(prop) && len(prop.Enum) > 0:
+		return s.promptEnum(f)
+
+	case isPrimitive(prop):
+		codec := scalarCodec
+		codec.hint = prop.Type
+		return s.promptText(f, codec)
+
+	default:
+		// Free-form values (nested maps, untyped fields) are entered as JSON
+		return s.promptText(f, jsonCodec)
+	}
+}
+
+// promptEnum asks for one of the values allowed by an enum.
+func (s *interactiveSession) promptEnum(f field) (any, bool, error) {
+	values := make([]any, 0, len(f.prop.Enum))
+	labels := make([]string, 0, len(f.prop.Enum)+1)
+	for _, raw := range f.prop.Enum {
+		var value any
+		if err := json.Unmarshal(raw.Raw, &value); err != nil {
+			return nil, false, fmt.Errorf("invalid enum value for %s: %w", f.path, err)
+		}
+		values = append(values, value)
+		labels = append(labels, formatValue(value))
+	}
+
+	def := ""
+	if f.hasCurrent {
+		def = formatValue(f.current)
+	}
+	if !f.required {
+		labels = append(labels, noneOption)
+		if !f.hasCurrent {
+			def = noneOption
+		}
+	}
+
+	choice, err := s.p.Select(f.path, labels, def)
+	if err != nil || choice == noneOption {
+		return nil, false, err
+	}
+	return values[slices.Index(labels, choice)], true, nil
+}
+
+// promptText asks for a value typed as text. The parsed value is validated
+// against the field schema before it is accepted.
+func (s *interactiveSession) promptText(f field, codec textCodec) (any, bool, error) {
+	label := fmt.Sprintf("%s (%s)", f.path, codec.hint)
+	if f.required {
+		label = fmt.Sprintf("%s (%s, required)", f.path, codec.hint)
+	}
+	def := ""
+	if f.hasCurrent {
+		def = codec.format(f.current)
+	}
+	collection := f.prop.Type == typeArray || f.prop.Type == typeObject
+
+	var value any
+	answer, err := s.p.Input(label, def, func(answer string) error {
+		if answer == "" {
+			if f.required && !collection {
+				return errors.New("a value is required")
+			}
+			return nil
+		}
+		v, err := codec.parse(answer, f.prop)
+		if err != nil {
+			return err
+		}
+		if err := validateValue(v, f.prop); err != nil {
+			return err
+		}
+		value = v
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if answer == "" {
+		if !f.required {
+			return nil, false, nil
+		}
+		// Required collections may be empty
+		if f.prop.Type == typeArray {
+			return []any{}, true, nil
+		}
+		return map[string]any{}, true, nil
+	}
+	return value, true, nil
+}
+
+// promptObjectList asks for the items of an array of objects one by one.
+func (s *interactiveSession) promptObjectList(f field, previous []any) (any, bool, error) {
+	items := []any{}
+	for i := 0; ; i++ {
+		add, err := s.p.Confirm(fmt.Sprintf("Add an item to %s", f.path), i < len(previous))
+		if err != nil {
+			return nil, false, err
+		}
+		if !add {
+			break
+		}
+		var previousItem map[string]any
+		if i < len(previous) {
+			previousItem, _ = previous[i].(map[string]any)
+		}
+		item, err := s.promptObject(fmt.Sprintf("%s[%d]", f.path, i), itemSchema(f.prop), previousItem)
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 && !f.required {
+		return nil, false, nil
+	}
+	return items, true, nil
+}
+
+// orderedProperties returns the property names of an object schema, required
+// properties first, each group sorted alphabetically.
+func orderedProperties(schema *extv1.JSONSchemaProps) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		ri, rj := slices.Contains(schema.Required, names[i]), slices.Contains(schema.Required, names[j])
+		if ri != rj {
+			return ri
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// itemSchema returns the item schema of an array, or nil when it is not declared.
+func itemSchema(prop *extv1.JSONSchemaProps) *extv1.JSONSchemaProps {
+	if prop.Items == nil {
+		return nil
+	}
+	return prop.Items.Schema
+}
+
+// isPrimitive reports whether a schema describes a string, number, integer or boolean.
+func isPrimitive(prop *extv1.JSONSchemaProps) bool {
+	if prop == nil {
+		return false
+	}
+	switch prop.Type {
+	case typeString, typeInteger, typeNumber, typeBoolean:
+		return true
+	}
+	return false
+}
+
+// parseScalar parses a primitive answer according to the field type.
+func parseScalar(answer string, prop *extv1.JSONSchemaProps) (any, error) {
+	switch prop.Type {
+	case typeInteger:
+		v, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", answer)
+		}
+		return v, nil
+	case typeNumber:
+		v, err := strconv.ParseFloat(answer, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", answer)
+		}
+		return v, nil
+	case typeBoolean:
+		v, err := strconv.ParseBool(answer)
+		if err != nil {
+			return nil, errors.New("answer true or false")
+		}
+		return v, nil
+	default:
+		return answer, nil
+	}
+}
+
+// parseList parses a comma-separated list of primitive items.
+func parseList(answer string, prop *extv1.JSONSchemaProps) (any, error) {
+	items := []any{}
+	for _, item := range splitList(answer) {
+		v, err := parseScalar(item, itemSchema(prop))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+	return items, nil
+}
+
+// parseMap parses comma-separated key=value pairs with primitive values.
+func parseMap(answer string, prop *extv1.JSONSchemaProps) (any, error) {
+	result := map[string]any{}
+	for _, pair := range splitList(answer) {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%q is not a key=value pair", pair)
+		}
+		v, err := parseScalar(strings.TrimSpace(value), prop.AdditionalProperties.Schema)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = v
+	}
+	return result, nil
+}
+
+// parseJSON parses a free-form JSON value.
+func parseJSON(answer string, _ *extv1.JSONSchemaProps) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(answer), &v); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	return v, nil
+}
+
+// validateValue checks a single answer against its field schema.
+func validateValue(value any, prop *extv1.JSONSchemaProps) error {
+	wrapper := &extv1.JSONSchemaProps{
+		Type:       typeObject,
+		Properties: map[string]extv1.JSONSchemaProps{"value": *prop},
+	}
+	return openchoreoschema.ValidateWithJSONSchema(map[string]any{"value": value}, wrapper)
+}
+
+// validateName checks that a name can be used as a Kubernetes resource name.
+func validateName(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid name %q: %s", name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// uniqueName returns name, or name with a numeric suffix when it is already used.
+func uniqueName(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+	return candidate
+}
+
+// formatValue renders a value the way it is typed back in as an answer.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = formatValue(item)
+		}
+		return strings.Join(items, ", ")
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, len(keys))
+		for i, key := range keys {
+			pairs[i] = key + "=" + formatValue(v[key])
+		}
+		return strings.Join(pairs, ", ")
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatJSON renders a free-form value as compact JSON.
+func formatJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// jsonEqual reports whether two values have the same JSON encoding, so that an
+// int64 answer equals a float64 default decoded from the schema.
+func jsonEqual(a, b any) bool {
+	aj, errA := json.Marshal(a)
+	bj, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aj) == string(bj)
+}
+
+// orEmpty returns m, or an empty map when m is nil.
+func orEmpty(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
+
+// traitKind returns the kind of a trait reference, defaulting to Trait.
+func traitKind(kind corev1alpha1.TraitRefKind) string {
+	if kind == "" {
+		return "Trait"
+	}
+	return string(kind)
+}
+
+// workflowKind returns the kind of a workflow reference, defaulting to ClusterWorkflow.
+func workflowKind(kind corev1alpha1.WorkflowRefKind) string {
+	if kind == "" {
+		return "ClusterWorkflow"
+	}
+	return string(kind)
+}
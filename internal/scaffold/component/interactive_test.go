@@ -0,0 +1,198 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	corev1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func interactiveTestSpec() *corev1alpha1.ComponentTypeSpec {
+	return &corev1alpha1.ComponentTypeSpec{
+		WorkloadType: "deployment",
+		Parameters: &corev1alpha1.SchemaSection{OpenAPIV3Schema: &runtime.RawExtension{Raw: []byte(`{
+			"type": "object",
+			"required": ["port", "tier"],
+			"properties": {
+				"port": {"type": "integer", "minimum": 1, "maximum": 65535},
+				"tier": {"type": "string", "enum": ["small", "large"]},
+				"replicas": {"type": "integer", "default": 1},
+				"tags": {"type": "array", "items": {"type": "string"}},
+				"database": {"type": "object", "properties": {"host": {"type": "string"}}}
+			}
+		}`)}},
+		PreRenderValidations: []corev1alpha1.ValidationRule{
+			{Rule: `${parameters.tier != "small" || parameters.replicas <= 2}`, Message: "small tier supports at most 2 replicas"},
+			{Rule: `${has(dataplane.secretStore)}`, Message: "data plane needs a secret store"},
+		},
+		AllowedTraits: []corev1alpha1.TraitRef{
+			{Name: "autoscaler"},
+			{Kind: "ClusterTrait", Name: "ingress"},
+		},
+		AllowedWorkflows: []corev1alpha1.WorkflowRef{{Name: "docker"}},
+	}
+}
+
+func interactiveTestOptions(spec *corev1alpha1.ComponentTypeSpec) InteractiveOptions {
+	return InteractiveOptions{
+		ComponentName:     "api",
+		Namespace:         "default",
+		ProjectName:       "shop",
+		ComponentTypeName: "web-service",
+		ComponentTypeKind: "ComponentType",
+		ComponentType:     spec,
+		LoadTraitSchema: func(kind, name string) (*extv1.JSONSchemaProps, error) {
+			if kind != "ClusterTrait" || name != "ingress" {
+				return nil, nil
+			}
+			return &extv1.JSONSchemaProps{
+				Type:       "object",
+				Required:   []string{"host"},
+				Properties: map[string]extv1.JSONSchemaProps{"host": {Type: "string"}},
+			}, nil
+		},
+		LoadWorkflowSchema: func(kind, name string) (*extv1.JSONSchemaProps, error) {
+			return nil, nil
+		},
+	}
+}
+
+func TestRunInteractive(t *testing.T) {
+	answers := strings.Join([]string{
+		// Parameters, first pass: an out-of-range port is asked again, and the
+		// answers break the tier rule
+		"0", "8080", "1", "n", "3", "a, b",
+		// Edit the answers and switch to the large tier
+		"", "", "large", "", "", "",
+		// Traits, workflow and workload
+		"2", "", "example.com",
+		"1",
+		"ghcr.io/acme/api:1.0", "", "", "", "9090",
+	}, "\n") + "\n"
+
+	var out bytes.Buffer
+	result, err := RunInteractive(NewPrompter(strings.NewReader(answers), &out), interactiveTestOptions(interactiveTestSpec()))
+	if err != nil {
+		t.Fatalf("RunInteractive() error: %v\noutput:\n%s", err, out.String())
+	}
+
+	for _, want := range []string{
+		"should be greater than or equal to 1",
+		"small tier supports at most 2 replicas",
+		"could not be checked locally",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("prompt output does not contain %q:\n%s", want, out.String())
+		}
+	}
+
+	got, err := result.YAML()
+	if err != nil {
+		t.Fatalf("YAML() error: %v", err)
+	}
+	assertYAMLEqual(t, dedent(`
+		# Generated by occ scaffold component --interactive
+		# Component: api
+		# Type: deployment/web-service
+		apiVersion: openchoreo.dev/v1alpha1
+		kind: Component
+		metadata:
+		  name: api
+		  namespace: default
+		spec:
+		  componentType:
+		    kind: ComponentType
+		    name: deployment/web-service
+		  owner:
+		    projectName: shop
+		  parameters:
+		    port: 8080
+		    replicas: 3
+		    tags:
+		    - a
+		    - b
+		    tier: large
+		  traits:
+		  - instanceName: ingress
+		    kind: ClusterTrait
+		    name: ingress
+		    parameters:
+		      host: example.com
+		  workflow:
+		    kind: ClusterWorkflow
+		    name: docker
+		---
+		apiVersion: openchoreo.dev/v1alpha1
+		kind: Workload
+		metadata:
+		  name: api-workload
+		  namespace: default
+		spec:
+		  container:
+		    image: ghcr.io/acme/api:1.0
+		  endpoints:
+		    http:
+		      port: 9090
+		      type: HTTP
+		  owner:
+		    componentName: api
+		    projectName: shop
+	`), got)
+}
+
+func TestRunInteractive_OmitsDefaultsAndUnsetFields(t *testing.T) {
+	spec := interactiveTestSpec()
+	spec.PreRenderValidations = nil
+	spec.AllowedTraits = nil
+	spec.AllowedWorkflows = nil
+
+	// Accept the replicas default, leave the optional fields unset and skip the endpoint
+	answers := "80\nlarge\n\n\n\nnginx\nn\n"
+	result, err := RunInteractive(NewPrompter(strings.NewReader(answers), &bytes.Buffer{}), interactiveTestOptions(spec))
+	if err != nil {
+		t.Fatalf("RunInteractive() error: %v", err)
+	}
+
+	params := result.Component["spec"].(map[string]any)["parameters"]
+	want := map[string]any{"port": int64(80), "tier": "large"}
+	if !jsonEqual(params, want) {
+		t.Errorf("parameters = %v, want %v", params, want)
+	}
+	if _, ok := result.Workload["spec"].(map[string]any)["endpoints"]; ok {
+		t.Errorf("workload has endpoints although none were requested")
+	}
+}
+
+func TestRunInteractive_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers string
+		wantErr string
+	}{
+		{
+			name:    "input ends early",
+			answers: "8080\n",
+			wantErr: `input ended before "parameters.tier" was answered`,
+		},
+		{
+			name:    "rule failure not edited",
+			answers: "8080\nsmall\nn\n5\n\nn\n",
+			wantErr: "parameters are not valid for web-service: small tier supports at most 2 replicas",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RunInteractive(NewPrompter(strings.NewReader(tt.answers), &bytes.Buffer{}), interactiveTestOptions(interactiveTestSpec()))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("RunInteractive() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
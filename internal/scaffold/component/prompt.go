@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Prompter asks questions on a line-oriented terminal. Invalid answers are
+// reported and the question is asked again; running out of input is an error.
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewPrompter creates a Prompter that reads answers from in and writes questions to out.
+func NewPrompter(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: bufio.NewReader(in), out: out}
+}
+
+// Printf writes a message to the prompter's output.
+func (p *Prompter) Printf(format string, args ...any) {
+	fmt.Fprintf(p.out, format, args...)
+}
+
+// Input asks for a free-form value. An empty answer selects def. When validate
+// is set, the question is repeated until it accepts the answer.
+func (p *Prompter) Input(label, def string, validate func(string) error) (string, error) {
+	return p.ask(label, label, def, validate)
+}
+
+// Select asks for one of options, by number or by value. An empty answer selects def.
+func (p *Prompter) Select(label string, options []string, def string) (string, error) {
+	p.printOptions(label, options)
+	var choice string
+	_, err := p.ask("Choose", label, def, func(answer string) error {
+		option, ok := pickOption(options, answer)
+		if !ok {
+			return fmt.Errorf("choose a number between 1 and %d or one of the listed values", len(options))
+		}
+		choice = option
+		return nil
+	})
+	return choice, err
+}
+
+// MultiSelect asks for any number of options as a comma-separated list of
+// numbers or values. The selection is returned in the order of options.
+func (p *Prompter) MultiSelect(label string, options []string) ([]string, error) {
+	p.printOptions(label, options)
+	var selected []string
+	_, err := p.ask("Choose (comma-separated, empty for none)", label, "", func(answer string) error {
+		selected = nil
+		for _, item := range splitList(answer) {
+			option, ok := pickOption(options, item)
+			if !ok {
+				return fmt.Errorf("%q is not one of the listed options", item)
+			}
+			if !slices.Contains(selected, option) {
+				selected = append(selected, option)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(selected, func(a, b string) int {
+		return slices.Index(options, a) - slices.Index(options, b)
+	})
+	return selected, nil
+}
+
+// Confirm asks a yes/no question. An empty answer selects def.
+func (p *Prompter) Confirm(label string, def bool) (bool, error) {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+	var result bool
+	_, err := p.Input(fmt.Sprintf("%s (%s)", label, hint), "", func(answer string) error {
+		switch strings.ToLower(answer) {
+		case "":
+			result = def
+		case "y", "yes":
+			result = true
+		case "n", "no":
+			result = false
+		default:
+			return errors.New("answer y or n")
+		}
+		return nil
+	})
+	return result, err
+}
+
+// ask prints prompt and reads answers until validate accepts one. name
+// identifies the question in errors.
+func (p *Prompter) ask(prompt, name, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			p.Printf("%s [%s]: ", prompt, def)
+		} else {
+			p.Printf("%s: ", prompt)
+		}
+		answer, err := p.readLine(name)
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = def
+		}
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				p.Printf("  ! %v\n", err)
+				continue
+			}
+		}
+		return answer, nil
+	}
+}
+
+// printOptions lists numbered options under the question label.
+func (p *Prompter) printOptions(label string, options []string) {
+	p.Printf("%s:\n", label)
+	for i, option := range options {
+		p.Printf("  %d) %s\n", i+1, option)
+	}
+}
+
+// readLine reads one trimmed answer. A final line without a newline is still
+// accepted; reaching the end of input before any answer is an error.
+func (p *Prompter) readLine(name string) (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && line != "" {
+			p.Printf("\n")
+			return strings.TrimSpace(line), nil
+		}
+		p.Printf("\n")
+		if errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("input ended before %q was answered", name)
+		}
+		return "", fmt.Errorf("failed to read answer: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// pickOption resolves an answer given as a 1-based number or an option value.
+func pickOption(options []string, answer string) (string, bool) {
+	if slices.Contains(options, answer) {
+		return answer, true
+	}
+	if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(options) {
+		return options[n-1], true
+	}
+	return "", false
+}
+
+// splitList splits a comma-separated answer, dropping empty items.
+func splitList(answer string) []string {
+	var items []string
+	for _, item := range strings.Split(answer, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
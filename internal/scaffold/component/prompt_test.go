@@ -0,0 +1,43 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPrompter(t *testing.T) {
+	var out bytes.Buffer
+	p := NewPrompter(strings.NewReader("maybe\ny\n\n4\nlarge\nsmall, 1, large\n"), &out)
+
+	confirmed, err := p.Confirm("Continue", false)
+	if err != nil || !confirmed {
+		t.Fatalf("Confirm() = %v, %v; want true", confirmed, err)
+	}
+	if !strings.Contains(out.String(), "answer y or n") {
+		t.Errorf("invalid confirmation was not reported:\n%s", out.String())
+	}
+
+	options := []string{"small", "large"}
+	choice, err := p.Select("Size", options, "small")
+	if err != nil || choice != "small" {
+		t.Fatalf("Select() with empty answer = %q, %v; want default", choice, err)
+	}
+	choice, err = p.Select("Size", options, "")
+	if err != nil || choice != "large" {
+		t.Fatalf("Select() after out-of-range number = %q, %v; want large", choice, err)
+	}
+
+	selected, err := p.MultiSelect("Sizes", options)
+	if err != nil || !slices.Equal(selected, options) {
+		t.Fatalf("MultiSelect() = %v, %v; want %v in option order without duplicates", selected, err, options)
+	}
+
+	if _, err := p.Input("Name", "", nil); err == nil || !strings.Contains(err.Error(), `input ended before "Name" was answered`) {
+		t.Fatalf("Input() at end of input error = %v", err)
+	}
+}
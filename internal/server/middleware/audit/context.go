@@ -5,6 +5,8 @@ package audit
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 )
 
 // getAuditData retrieves or creates the audit data container from context
@@ -72,3 +74,21 @@ func GetMetadata(ctx context.Context) map[string]any {
 	}
 	return nil
 }
+
+// ActorFromContext returns the actor of the authenticated subject in the context,
+// or the anonymous actor when there is none
+func ActorFromContext(ctx context.Context) Actor {
+	subject, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok || subject == nil {
+		return Actor{Type: "anonymous", ID: "anonymous"}
+	}
+	actorType := subject.Type
+	if actorType == "" {
+		actorType = "user"
+	}
+	return Actor{
+		Type:         actorType,
+		ID:           subject.ID,
+		Entitlements: map[string]any{subject.EntitlementClaim: subject.EntitlementValues},
+	}
+}
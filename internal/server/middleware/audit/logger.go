@@ -65,6 +65,9 @@ func (l *Logger) LogEvent(event *Event) {
 		}
 		actorAttrs = append(actorAttrs, slog.Group("entitlements", entitlementAttrs...))
 	}
+	if event.Actor.Client != "" {
+		actorAttrs = append(actorAttrs, slog.String("client", event.Actor.Client))
+	}
 	attrs = append(attrs, slog.Group("actor", actorAttrs...))
 
 	// Add remaining attributes
@@ -12,6 +12,7 @@ type Actor struct {
 	Type         string                 `json:"type"`                   // e.g., "user", "service_account", "anonymous"
 	ID           string                 `json:"id"`                     // User ID, service account ID, or "anonymous"
 	Entitlements map[string]interface{} `json:"entitlements,omitempty"` // Optional entitlements associated with the actor
+	Client       string                 `json:"client,omitempty"`       // OAuth client (e.g. MCP agent) acting for the actor, if known
 }
 
 // ActionCategory represents the category of audit action
@@ -21,6 +22,7 @@ const (
 	CategoryResource      ActionCategory = "resource"
 	CategoryAuth          ActionCategory = "auth"
 	CategoryObservability ActionCategory = "observability"
+	CategoryMCP           ActionCategory = "mcp"
 )
 
 // Resource represents the target resource of an action
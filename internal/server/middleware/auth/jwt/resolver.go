@@ -92,6 +92,7 @@ func (d *Resolver) ResolveUserType(jwtToken string) (*auth.SubjectContext, error
 				Type:              userTypeConfig.Type,
 				EntitlementClaim:  jwtMechanism.Entitlement.Claim,
 				EntitlementValues: entitlements,
+				Claims:            map[string]any(claims),
 			}, nil
 		}
 	}
@@ -185,13 +185,20 @@ func TestValidateCondition(t *testing.T) {
 			wantErr: "expression must return bool",
 		},
 		{
-			name: "action set with empty attribute intersection",
+			name: "attribute outside the action set intersection",
 			cond: openchoreodevv1alpha1.AuthzCondition{
-				// ActionViewLogs has resource.environment; ActionViewProject does not — intersection is empty.
+				// ActionViewLogs has resource.environment; ActionViewProject only shares the common attributes.
 				Actions:    []string{authzcore.ActionViewLogs, authzcore.ActionViewProject},
 				Expression: `resource.environment == "prod"`,
 			},
-			wantErr: "conditions are not supported for the specified action set",
+			wantErr: `attribute "resource.environment" is not supported`,
+		},
+		{
+			name: "common subject and request attributes are allowed for any action",
+			cond: openchoreodevv1alpha1.AuthzCondition{
+				Actions:    []string{authzcore.ActionViewLogs, authzcore.ActionViewProject},
+				Expression: `"platform-admins" in subject.entitlements && request.time.getHours("UTC") >= 9`,
+			},
 		},
 	}
 
@@ -6,9 +6,15 @@ package releasebinding
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -16,6 +22,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/imageverify"
+	"github.com/openchoreo/openchoreo/internal/provenance"
 )
 
 // nolint:unused
@@ -111,8 +119,12 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 	// Note: Required field validations (owner, environment) are enforced by the CRD schema
 	// Note: spec.environment, spec.owner immutability is enforced by CEL rules in the CRD schema
 	// Note: Cross-resource validation (ComponentRelease, schema validation) is handled by the controller
+	binding, ok := obj.(*openchoreodevv1alpha1.ReleaseBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ReleaseBinding object but got %T", obj)
+	}
 
-	return nil, nil
+	return nil, v.validateProvenancePolicy(ctx, binding)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ReleaseBinding.
@@ -120,8 +132,21 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 	// Note: Required field validations (owner, environment) are enforced by the CRD schema
 	// Note: spec.environment, spec.owner immutability is enforced by CEL rules in the CRD schema
 	// Note: Cross-resource validation (ComponentRelease, schema validation) is handled by the controller
+	oldBinding, ok := oldObj.(*openchoreodevv1alpha1.ReleaseBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ReleaseBinding object for the old object but got %T", oldObj)
+	}
+	newBinding, ok := newObj.(*openchoreodevv1alpha1.ReleaseBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ReleaseBinding object for the new object but got %T", newObj)
+	}
 
-	return nil, nil
+	// The provenance policy gates which release is bound, so bindings keep working
+	// when the policy of their environment is tightened later.
+	if oldBinding.Spec.ReleaseName == newBinding.Spec.ReleaseName {
+		return nil, nil
+	}
+	return nil, v.validateProvenancePolicy(ctx, newBinding)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ReleaseBinding.
@@ -129,3 +154,93 @@ func (v *Validator) ValidateDelete(ctx context.Context, obj runtime.Object) (adm
 	// No special validation needed for deletion
 	return nil, nil
 }
+
+// validateProvenancePolicy checks that the bound ComponentRelease carries the build provenance
+// required by the provenance policy of the target environment and that its image passes the
+// image verification policies that apply to the environment.
+func (v *Validator) validateProvenancePolicy(ctx context.Context, binding *openchoreodevv1alpha1.ReleaseBinding) error {
+	if binding.Spec.ReleaseName == "" {
+		return nil
+	}
+
+	env := &openchoreodevv1alpha1.Environment{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Namespace: binding.Namespace, Name: binding.Spec.Environment}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			// A missing environment is reported by the controller
+			return nil
+		}
+		return fmt.Errorf("failed to get environment %q: %w", binding.Spec.Environment, err)
+	}
+	policy := env.Spec.ProvenancePolicy
+	imagePolicies, err := imageverify.PoliciesForEnvironment(ctx, v.Client, env)
+	if err != nil {
+		return err
+	}
+	if policy == nil && len(imagePolicies) == 0 {
+		return nil
+	}
+
+	releasePath := field.NewPath("spec", "releaseName")
+	release := &openchoreodevv1alpha1.ComponentRelease{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Namespace: binding.Namespace, Name: binding.Spec.ReleaseName}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return apierrors.NewInvalid(binding.GroupVersionKind().GroupKind(), binding.Name, field.ErrorList{
+				field.NotFound(releasePath, binding.Spec.ReleaseName),
+			})
+		}
+		return fmt.Errorf("failed to get component release %q: %w", binding.Spec.ReleaseName, err)
+	}
+
+	// Only provenance confirmed by the workflow run that built the image counts; the copy on
+	// the release can be set by anyone who can edit the Workload.
+	workload := release.Spec.Workload
+	buildProvenance, err := provenance.Resolve(ctx, v.Client, binding.Namespace, release.Spec.Owner,
+		workload.Container.Image, workload.Provenance)
+	unverified := ""
+	if errors.Is(err, provenance.ErrUnverified) {
+		unverified = "; " + err.Error()
+	} else if err != nil {
+		return err
+	}
+
+	if policy != nil {
+		missing := missingProvenance(policy, buildProvenance)
+		if len(missing) > 0 {
+			releasebindinglog.Info("rejected release binding by provenance policy",
+				"name", binding.Name, "namespace", binding.Namespace, "environment", env.Name, "missing", missing)
+			return apierrors.NewInvalid(binding.GroupVersionKind().GroupKind(), binding.Name, field.ErrorList{
+				field.Forbidden(releasePath, fmt.Sprintf(
+					"environment %q requires releases with %s, but component release %q has none recorded by its workflow run%s",
+					env.Name, strings.Join(missing, " and "), release.Name, unverified)),
+			})
+		}
+	}
+
+	if err := imageverify.Verify(imagePolicies, workload.Container.Image, buildProvenance); err != nil {
+		releasebindinglog.Info("rejected release binding by image verification",
+			"name", binding.Name, "namespace", binding.Namespace, "environment", env.Name, "error", err.Error())
+		return apierrors.NewInvalid(binding.GroupVersionKind().GroupKind(), binding.Name, field.ErrorList{
+			field.Forbidden(releasePath, fmt.Sprintf(
+				"image of component release %q failed verification: %v%s", release.Name, err, unverified)),
+		})
+	}
+	return nil
+}
+
+// missingProvenance returns the provenance required by the policy that is not recorded.
+func missingProvenance(policy *openchoreodevv1alpha1.ProvenancePolicy, provenance *openchoreodevv1alpha1.BuildProvenance) []string {
+	if provenance == nil {
+		provenance = &openchoreodevv1alpha1.BuildProvenance{}
+	}
+	var missing []string
+	if policy.RequireSignedImage && provenance.Signature == nil {
+		missing = append(missing, "an image signature")
+	}
+	if policy.RequireSBOM && provenance.SBOM == nil {
+		missing = append(missing, "an SBOM")
+	}
+	if policy.RequireAttestation && provenance.Attestation == nil {
+		missing = append(missing, "a provenance attestation")
+	}
+	return missing
+}
@@ -4,18 +4,56 @@
 package releasebinding
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
+// workflowRunFor returns a workflow run of component acme/app whose status records that it
+// built image with the artifacts of the given provenance.
+func workflowRunFor(namespace, image string, provenance *openchoreodevv1alpha1.BuildProvenance) *openchoreodevv1alpha1.WorkflowRun {
+	run := &openchoreodevv1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{
+		Name: provenance.WorkflowRun, Namespace: namespace,
+		Labels: map[string]string{labels.LabelKeyProjectName: "acme", labels.LabelKeyComponentName: "app"},
+	}}
+	run.Status.Artifacts = []openchoreodevv1alpha1.WorkflowRunArtifact{
+		{Name: "image", Type: openchoreodevv1alpha1.WorkflowArtifactTypeImage, Reference: image},
+	}
+	for _, artifact := range []struct {
+		ref  *openchoreodevv1alpha1.ArtifactReference
+		kind openchoreodevv1alpha1.WorkflowArtifactType
+	}{
+		{provenance.SBOM, openchoreodevv1alpha1.WorkflowArtifactTypeSBOM},
+		{provenance.Attestation, openchoreodevv1alpha1.WorkflowArtifactTypeProvenance},
+		{provenance.Signature, openchoreodevv1alpha1.WorkflowArtifactTypeSignature},
+	} {
+		if artifact.ref != nil {
+			run.Status.Artifacts = append(run.Status.Artifacts, openchoreodevv1alpha1.WorkflowRunArtifact{
+				Name: string(artifact.kind), Type: artifact.kind, Reference: artifact.ref.Reference, Format: artifact.ref.Format,
+			})
+		}
+	}
+	return run
+}
+
 var _ = Describe("ReleaseBinding Webhook", func() {
 	var (
 		validator Validator
@@ -148,4 +186,208 @@ var _ = Describe("ReleaseBinding Webhook", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Context("Validator provenance policy", func() {
+		const ns = "default"
+
+		newEnvironment := func(policy *openchoreodevv1alpha1.ProvenancePolicy) *openchoreodevv1alpha1.Environment {
+			return &openchoreodevv1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: ns},
+				Spec:       openchoreodevv1alpha1.EnvironmentSpec{IsProduction: true, ProvenancePolicy: policy},
+			}
+		}
+		newRelease := func(name string, provenance *openchoreodevv1alpha1.BuildProvenance) *openchoreodevv1alpha1.ComponentRelease {
+			release := &openchoreodevv1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}}
+			release.Spec.Owner = openchoreodevv1alpha1.ComponentReleaseOwner{ProjectName: "acme", ComponentName: "app"}
+			release.Spec.Workload.Container.Image = "registry.example.com/app@sha256:abc"
+			release.Spec.Workload.Provenance = provenance
+			return release
+		}
+		newBinding := func(releaseName string) *openchoreodevv1alpha1.ReleaseBinding {
+			binding := &openchoreodevv1alpha1.ReleaseBinding{ObjectMeta: metav1.ObjectMeta{Name: "app-production", Namespace: ns}}
+			binding.Spec.Environment = "production"
+			binding.Spec.ReleaseName = releaseName
+			return binding
+		}
+		newValidator := func(objs ...runtime.Object) Validator {
+			return Validator{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build()}
+		}
+		strictPolicy := &openchoreodevv1alpha1.ProvenancePolicy{RequireSignedImage: true, RequireSBOM: true}
+		fullProvenance := &openchoreodevv1alpha1.BuildProvenance{
+			WorkflowRun: "app-run-0a1b2c3d",
+			ImageDigest: "sha256:abc",
+			SBOM:        &openchoreodevv1alpha1.ArtifactReference{Reference: "registry.example.com/app:sha256-abc.sbom", Format: "spdx-json"},
+			Signature:   &openchoreodevv1alpha1.ArtifactReference{Reference: "registry.example.com/app:sha256-abc.sig"},
+		}
+
+		It("should admit a release that satisfies the environment policy", func() {
+			v := newValidator(newEnvironment(strictPolicy), newRelease("app-v1", fullProvenance),
+				workflowRunFor(ns, "registry.example.com/app@sha256:abc", fullProvenance))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a release without the required provenance", func() {
+			partial := &openchoreodevv1alpha1.BuildProvenance{WorkflowRun: fullProvenance.WorkflowRun, SBOM: fullProvenance.SBOM}
+			v := newValidator(newEnvironment(strictPolicy), newRelease("app-v1", partial),
+				workflowRunFor(ns, "registry.example.com/app@sha256:abc", partial))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("an image signature"))
+			Expect(err.Error()).NotTo(ContainSubstring("an SBOM"))
+		})
+
+		It("should reject provenance that is not recorded by the workflow run it names", func() {
+			// The workflow run produced an SBOM only; the signature was added to the Workload by hand
+			v := newValidator(newEnvironment(strictPolicy), newRelease("app-v1", fullProvenance),
+				workflowRunFor(ns, "registry.example.com/app@sha256:abc", &openchoreodevv1alpha1.BuildProvenance{
+					WorkflowRun: fullProvenance.WorkflowRun, SBOM: fullProvenance.SBOM,
+				}))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("an image signature"))
+
+			// Without the workflow run nothing is trusted
+			v = newValidator(newEnvironment(strictPolicy), newRelease("app-v1", fullProvenance))
+			_, err = v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`workflow run "app-run-0a1b2c3d" not found`))
+		})
+
+		It("should reject a release that does not exist when the environment has a policy", func() {
+			v := newValidator(newEnvironment(strictPolicy))
+			_, err := v.ValidateCreate(ctx, newBinding("missing"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should admit any release when the environment has no policy", func() {
+			v := newValidator(newEnvironment(nil), newRelease("app-v1", nil))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should validate updates only when the release changes", func() {
+			v := newValidator(newEnvironment(strictPolicy), newRelease("app-v1", nil), newRelease("app-v2", nil))
+			_, err := v.ValidateUpdate(ctx, newBinding("app-v1"), newBinding("app-v1"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = v.ValidateUpdate(ctx, newBinding("app-v1"), newBinding("app-v2"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+
+	Context("Validator image verification", func() {
+		const (
+			ns     = "default"
+			image  = "registry.example.com/app@sha256:abc"
+			digest = "sha256:abc"
+		)
+
+		signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		keyDER, err := x509.MarshalPKIXPublicKey(&signingKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: keyDER}))
+
+		signedProvenance := func() *openchoreodevv1alpha1.BuildProvenance {
+			payload := []byte(`{"critical":{"identity":{"docker-reference":"registry.example.com/app"},` +
+				`"image":{"docker-manifest-digest":"` + digest + `"},"type":"cosign container image signature"}}`)
+			hash := sha256.Sum256(payload)
+			signature, err := ecdsa.SignASN1(rand.Reader, signingKey, hash[:])
+			Expect(err).NotTo(HaveOccurred())
+			bundle, err := json.Marshal(map[string]string{
+				"base64Signature": base64.StdEncoding.EncodeToString(signature),
+				"payload":         base64.StdEncoding.EncodeToString(payload),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return &openchoreodevv1alpha1.BuildProvenance{
+				WorkflowRun: "app-run-1",
+				ImageDigest: digest,
+				Signature: &openchoreodevv1alpha1.ArtifactReference{
+					Reference: base64.StdEncoding.EncodeToString(bundle),
+					Format:    openchoreodevv1alpha1.SignatureFormatCosignBundle,
+				},
+			}
+		}
+		newRelease := func(name string, provenance *openchoreodevv1alpha1.BuildProvenance) *openchoreodevv1alpha1.ComponentRelease {
+			release := &openchoreodevv1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}}
+			release.Spec.Owner = openchoreodevv1alpha1.ComponentReleaseOwner{ProjectName: "acme", ComponentName: "app"}
+			release.Spec.Workload.Container.Image = image
+			release.Spec.Workload.Provenance = provenance
+			return release
+		}
+		newEnvironment := func() *openchoreodevv1alpha1.Environment {
+			return &openchoreodevv1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{
+				Name: "production", Namespace: ns, Labels: map[string]string{"tier": "production"},
+			}}
+		}
+		newClusterPolicy := func(selector map[string]string) *openchoreodevv1alpha1.ClusterImageVerificationPolicy {
+			return &openchoreodevv1alpha1.ClusterImageVerificationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "ci-signed"},
+				Spec: openchoreodevv1alpha1.ClusterImageVerificationPolicySpec{
+					EnvironmentSelector: &metav1.LabelSelector{MatchLabels: selector},
+					Verification:        openchoreodevv1alpha1.ImageVerification{PublicKeys: []string{publicKey}},
+				},
+			}
+		}
+		newBinding := func(releaseName string) *openchoreodevv1alpha1.ReleaseBinding {
+			binding := &openchoreodevv1alpha1.ReleaseBinding{ObjectMeta: metav1.ObjectMeta{Name: "app-production", Namespace: ns}}
+			binding.Spec.Environment = "production"
+			binding.Spec.ReleaseName = releaseName
+			return binding
+		}
+		newValidator := func(objs ...runtime.Object) Validator {
+			return Validator{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objs...).Build()}
+		}
+
+		It("should admit a release whose image is signed by a trusted key", func() {
+			signed := signedProvenance()
+			v := newValidator(newEnvironment(), newClusterPolicy(map[string]string{"tier": "production"}),
+				newRelease("app-v1", signed), workflowRunFor(ns, image, signed))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not trust a signature that its workflow run did not record", func() {
+			v := newValidator(newEnvironment(), newClusterPolicy(map[string]string{"tier": "production"}),
+				newRelease("app-v1", signedProvenance()),
+				workflowRunFor(ns, image, &openchoreodevv1alpha1.BuildProvenance{WorkflowRun: "app-run-1"}))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("no image signature is recorded"))
+		})
+
+		It("should reject a release whose image has no signature", func() {
+			v := newValidator(newEnvironment(), newClusterPolicy(map[string]string{"tier": "production"}),
+				newRelease("app-v1", &openchoreodevv1alpha1.BuildProvenance{ImageDigest: digest}))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`ClusterImageVerificationPolicy "ci-signed"`))
+		})
+
+		It("should reject a release signed by an untrusted key set on the environment", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			otherDER, err := x509.MarshalPKIXPublicKey(&otherKey.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+			env := newEnvironment()
+			env.Spec.ProvenancePolicy = &openchoreodevv1alpha1.ProvenancePolicy{
+				ImageVerification: &openchoreodevv1alpha1.ImageVerification{
+					PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: otherDER}))},
+				},
+			}
+			signed := signedProvenance()
+			v := newValidator(env, newRelease("app-v1", signed), workflowRunFor(ns, image, signed))
+			_, err = v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("does not match any trusted public key"))
+		})
+
+		It("should ignore cluster policies that do not select the environment", func() {
+			v := newValidator(newEnvironment(), newClusterPolicy(map[string]string{"tier": "development"}),
+				newRelease("app-v1", nil))
+			_, err := v.ValidateCreate(ctx, newBinding("app-v1"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -7,12 +7,14 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
@@ -23,6 +25,10 @@ const (
 	DirName      = ".occ"
 	IndexFile    = "index.json"
 	MetadataFile = "metadata.json"
+
+	// FormatVersion is the version of the on-disk cache format. Caches written
+	// with a different version are discarded and rebuilt.
+	FormatVersion = 3
 )
 
 // FileState tracks the state of a single file for change detection
@@ -30,14 +36,19 @@ type FileState struct {
 	ModTime time.Time `json:"modTime"`
 	Size    int64     `json:"size"`
 	Hash    string    `json:"hash"`
+	// ParseError is why documents of the file could not be parsed, if any
+	ParseError string `json:"parseError,omitempty"`
 }
 
 // CacheMetadata tracks cache state for invalidation
 type CacheMetadata struct {
+	// Format version of the cache files
+	Version int `json:"version"`
+
 	// Directory-level hash for fast "anything changed?" check
 	DirectoryHash string `json:"directoryHash"`
 
-	// Per-file state for incremental updates
+	// Per-file state for incremental updates, keyed by path relative to the repository
 	FileStates map[string]FileState `json:"fileStates"`
 
 	// Time-based metadata
@@ -56,7 +67,10 @@ type PersistentIndex struct {
 	metadata *CacheMetadata
 }
 
-// LoadOrBuild loads existing index from cache or builds a new one
+// LoadOrBuild loads existing index from cache or builds a new one.
+// Files whose size and modification time are unchanged are not read again;
+// files whose content hash changed are reparsed and the rest of the cached
+// index is kept.
 func LoadOrBuild(repoPath string) (*PersistentIndex, error) {
 	pi := &PersistentIndex{
 		Index:    index.New(repoPath),
@@ -67,15 +81,21 @@ func LoadOrBuild(repoPath string) (*PersistentIndex, error) {
 	// Try to load from cache
 	if pi.isCacheValid() {
 		if err := pi.loadFromDisk(); err == nil {
-			// Check if incremental update is needed
 			changedFiles, err := pi.getChangedFiles()
-			if err == nil && len(changedFiles) > 0 {
-				if err := pi.incrementalUpdate(changedFiles); err != nil {
-					// If incremental update fails, do full rebuild
-					return pi.fullRebuild()
+			if err != nil {
+				return pi.fullRebuild()
+			}
+			if len(changedFiles) == 0 {
+				// Only the metadata needs to be written to update LastUsed
+				if err := pi.saveMetadata(); err != nil {
+					return nil, fmt.Errorf("failed to save index metadata: %w", err)
 				}
+				return pi, nil
+			}
+			if err := pi.incrementalUpdate(changedFiles); err != nil {
+				// If incremental update fails, do full rebuild
+				return pi.fullRebuild()
 			}
-			// Always save to update LastUsed timestamp
 			if err := pi.saveToDisk(); err != nil {
 				return nil, fmt.Errorf("failed to save updated index: %w", err)
 			}
@@ -89,8 +109,21 @@ func LoadOrBuild(repoPath string) (*PersistentIndex, error) {
 
 // fullRebuild performs a complete scan and builds the index from scratch
 func (pi *PersistentIndex) fullRebuild() (*PersistentIndex, error) {
-	// Use scanner to build index
-	s := scanner.New(scanner.DefaultScanOptions())
+	// Use scanner to build index, recording the files that are not valid YAML
+	var mu sync.Mutex
+	parseErrors := map[string]string{}
+	opts := scanner.DefaultScanOptions()
+	opts.ErrorHandler = func(path string, err error) {
+		if !errors.Is(err, scanner.ErrInvalidYAML) {
+			return
+		}
+		if relPath, relErr := filepath.Rel(pi.repoPath, path); relErr == nil {
+			mu.Lock()
+			parseErrors[relPath] = err.Error()
+			mu.Unlock()
+		}
+	}
+	s := scanner.New(opts)
 	idx, err := s.Scan(pi.repoPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan repository: %w", err)
@@ -104,8 +137,15 @@ func (pi *PersistentIndex) fullRebuild() (*PersistentIndex, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to compute directory state: %w", err)
 	}
+	for relPath, parseErr := range parseErrors {
+		if state, ok := fileStates[relPath]; ok {
+			state.ParseError = parseErr
+			fileStates[relPath] = state
+		}
+	}
 
 	pi.metadata = &CacheMetadata{
+		Version:       FormatVersion,
 		DirectoryHash: dirHash,
 		FileStates:    fileStates,
 		CreatedAt:     time.Now(),
@@ -121,7 +161,8 @@ func (pi *PersistentIndex) fullRebuild() (*PersistentIndex, error) {
 	return pi, nil
 }
 
-// isCacheValid checks if the cached index can be used
+// isCacheValid checks if the cached index can be used as the base for an
+// incremental update. Whether files changed is checked by getChangedFiles.
 func (pi *PersistentIndex) isCacheValid() bool {
 	metaPath := filepath.Join(pi.cacheDir, MetadataFile)
 	data, err := os.ReadFile(metaPath)
@@ -134,21 +175,11 @@ func (pi *PersistentIndex) isCacheValid() bool {
 		return false
 	}
 
-	// Assign metadata to pi so computeCurrentDirectoryHash can use its FileStates
-	pi.metadata = &meta
-
-	// Compute current directory hash and compare with cached hash (data changes)
-	currentDirHash, err := pi.computeCurrentDirectoryHash()
-	if err != nil {
-		// If there's an error computing the current hash, assume cache is invalid
-		return false
-	}
-	if currentDirHash != meta.DirectoryHash {
-		// Directory hash mismatch, something changed in the files
+	if meta.Version != FormatVersion || meta.FileStates == nil {
 		return false
 	}
 
-	// If both version and directory hash match, the cache is considered valid
+	pi.metadata = &meta
 	return true
 }
 
@@ -165,13 +196,18 @@ func (pi *PersistentIndex) loadFromDisk() error {
 		return fmt.Errorf("failed to unmarshal index: %w", err)
 	}
 
+	// File paths in the index are rooted at the repository path it was built for
+	if serializable.RepoPath != pi.repoPath {
+		return fmt.Errorf("index was built for %q, not %q", serializable.RepoPath, pi.repoPath)
+	}
+
 	// Convert back to generic Index
 	pi.Index = serializable.ToIndex(pi.repoPath)
 
 	return nil
 }
 
-// saveToDisk persists the index to the filesystem
+// saveToDisk persists the index and its metadata to the filesystem
 func (pi *PersistentIndex) saveToDisk() error {
 	// Create cache directory
 	if err := os.MkdirAll(pi.cacheDir, 0755); err != nil {
@@ -181,15 +217,20 @@ func (pi *PersistentIndex) saveToDisk() error {
 	// Save index
 	indexPath := filepath.Join(pi.cacheDir, IndexFile)
 	serializable := pi.Index.ToSerializable()
-	indexData, err := json.MarshalIndent(serializable, "", "  ")
+	indexData, err := json.Marshal(serializable)
 	if err != nil {
 		return fmt.Errorf("failed to marshal index: %w", err)
 	}
-	if err := os.WriteFile(indexPath, indexData, 0600); err != nil {
+	if err := writeFileAtomic(indexPath, indexData); err != nil {
 		return fmt.Errorf("failed to write index file: %w", err)
 	}
 
-	// Update and save metadata
+	return pi.saveMetadata()
+}
+
+// saveMetadata updates and persists the cache metadata
+func (pi *PersistentIndex) saveMetadata() error {
+	pi.metadata.Version = FormatVersion
 	pi.metadata.LastUsed = time.Now()
 	pi.metadata.ResourceCount = pi.Index.Stats().TotalResources
 
@@ -198,13 +239,37 @@ func (pi *PersistentIndex) saveToDisk() error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
-	if err := os.WriteFile(metaPath, metaData, 0600); err != nil {
+	if err := writeFileAtomic(metaPath, metaData); err != nil {
 		return fmt.Errorf("failed to write metadata file: %w", err)
 	}
 
 	return nil
 }
 
+// writeFileAtomic writes data to a temporary file and renames it into place,
+// so that concurrent runs never read a partially written cache file
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
 // isYAMLFile checks if a file has a YAML extension
 func isYAMLFile(path string) bool {
 	ext := strings.ToLower(filepath.Ext(path))
@@ -221,211 +286,155 @@ func hashFileContent(path string) (string, error) {
 	return hex.EncodeToString(hash[:]), nil
 }
 
-// computeDirectoryState computes the directory hash and per-file states
-// This is the core of the cache invalidation mechanism
-func (pi *PersistentIndex) computeDirectoryState() (string, map[string]FileState, error) {
-	dirHasher := sha256.New()
-	fileStates := make(map[string]FileState)
+// listYAMLFiles returns the paths, relative to the repository, of the YAML
+// files the scanner indexes
+func (pi *PersistentIndex) listYAMLFiles() ([]string, error) {
+	filter := scanner.DefaultFilter()
 
-	// Collect all YAML files
-	var paths []string
+	var relPaths []string
 	err := filepath.WalkDir(pi.repoPath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return nil // Skip errors
 		}
 
-		if d.IsDir() && path != pi.repoPath {
-			// Skip cache directory
-			if d.Name() == DirName {
-				return filepath.SkipDir
-			}
-			// Skip hidden directories
-			if strings.HasPrefix(d.Name(), ".") {
+		if d.IsDir() {
+			if path != pi.repoPath && (d.Name() == DirName || !filter.ShouldDescendIntoDir(d.Name())) {
 				return filepath.SkipDir
 			}
+			return nil
 		}
 
-		if !d.IsDir() && isYAMLFile(path) {
-			paths = append(paths, path)
+		if !isYAMLFile(path) || !filter.ShouldScan(path) {
+			return nil
 		}
+		relPath, err := filepath.Rel(pi.repoPath, path)
+		if err != nil {
+			return nil
+		}
+		relPaths = append(relPaths, relPath)
 		return nil
 	})
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to walk directory: %w", err)
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
 	}
 
-	// Sort for deterministic hash
-	sort.Strings(paths)
-
-	// Process each file
-	for _, path := range paths {
-		relPath, err := filepath.Rel(pi.repoPath, path)
-		if err != nil {
-			continue
-		}
-
-		info, err := os.Stat(path)
-		if err != nil {
-			continue
-		}
-
-		// Compute content hash
-		contentHash, err := hashFileContent(path)
-		if err != nil {
-			continue
-		}
+	sort.Strings(relPaths)
+	return relPaths, nil
+}
 
-		// Store file state
-		fileStates[relPath] = FileState{
-			ModTime: info.ModTime(),
-			Size:    info.Size(),
-			Hash:    contentHash,
-		}
+// fileStateFor returns the state of a file, reusing the cached content hash
+// when its size and modification time are unchanged
+func (pi *PersistentIndex) fileStateFor(relPath string, cached map[string]FileState) (FileState, error) {
+	info, err := os.Stat(filepath.Join(pi.repoPath, relPath))
+	if err != nil {
+		return FileState{}, err
+	}
 
-		// Add to directory hash: "relPath|size|hash\n"
-		entry := fmt.Sprintf("%s|%d|%s\n", relPath, info.Size(), contentHash)
-		dirHasher.Write([]byte(entry))
+	if state, ok := cached[relPath]; ok && state.Size == info.Size() && state.ModTime.Equal(info.ModTime()) {
+		return state, nil
 	}
 
-	return hex.EncodeToString(dirHasher.Sum(nil)), fileStates, nil
+	hash, err := hashFileContent(filepath.Join(pi.repoPath, relPath))
+	if err != nil {
+		return FileState{}, fmt.Errorf("failed to hash file %s: %w", relPath, err)
+	}
+	return FileState{ModTime: info.ModTime(), Size: info.Size(), Hash: hash}, nil
 }
 
-// computeCurrentDirectoryHash quickly computes the current directory hash
-// using only metadata (size + mtime) for speed, falling back to content hash
-// only when metadata suggests a change
-func (pi *PersistentIndex) computeCurrentDirectoryHash() (string, error) {
+// directoryHash combines the per-file states into a single hash.
+// Each file contributes "relPath|size|hash\n", in path order.
+func directoryHash(fileStates map[string]FileState) string {
+	relPaths := make([]string, 0, len(fileStates))
+	for relPath := range fileStates {
+		relPaths = append(relPaths, relPath)
+	}
+	sort.Strings(relPaths)
+
 	dirHasher := sha256.New()
+	for _, relPath := range relPaths {
+		state := fileStates[relPath]
+		fmt.Fprintf(dirHasher, "%s|%d|%s\n", relPath, state.Size, state.Hash)
+	}
+	return hex.EncodeToString(dirHasher.Sum(nil))
+}
 
-	var paths []string
-	err := filepath.WalkDir(pi.repoPath, func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return nil
-		}
-		if d.IsDir() && path != pi.repoPath && (d.Name() == DirName || strings.HasPrefix(d.Name(), ".")) {
-			return filepath.SkipDir
-		}
-		if !d.IsDir() && isYAMLFile(path) {
-			paths = append(paths, path)
-		}
-		return nil
-	})
+// computeDirectoryState hashes every YAML file and returns the directory
+// hash and per-file states. This is the core of the cache invalidation mechanism.
+func (pi *PersistentIndex) computeDirectoryState() (string, map[string]FileState, error) {
+	fileStates, err := pi.currentFileStates(nil)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
+	return directoryHash(fileStates), fileStates, nil
+}
 
-	sort.Strings(paths)
+// currentFileStates returns the state of every YAML file in the repository.
+// Content hashes are taken from cached when a file's size and modification
+// time match, so unchanged files are not read.
+func (pi *PersistentIndex) currentFileStates(cached map[string]FileState) (map[string]FileState, error) {
+	relPaths, err := pi.listYAMLFiles()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, path := range paths {
-		relPath, _ := filepath.Rel(pi.repoPath, path)
-		info, err := os.Stat(path)
+	fileStates := make(map[string]FileState, len(relPaths))
+	for _, relPath := range relPaths {
+		state, err := pi.fileStateFor(relPath, cached)
 		if err != nil {
-			continue
-		}
-
-		// Use cached hash if file hasn't changed (size + mtime match)
-		var contentHash string
-		if cached, ok := pi.metadata.FileStates[relPath]; ok {
-			if cached.Size == info.Size() && cached.ModTime.Equal(info.ModTime()) {
-				// File unchanged - use cached hash (fast path)
-				contentHash = cached.Hash
-			} else {
-				// File changed - recompute hash
-				var err error
-				contentHash, err = hashFileContent(path)
-				if err != nil {
-					return "", fmt.Errorf("failed to hash changed file %s: %w", relPath, err)
-				}
-			}
-		} else {
-			// New file - compute hash
-			var err error
-			contentHash, err = hashFileContent(path)
-			if err != nil {
-				return "", fmt.Errorf("failed to hash new file %s: %w", relPath, err)
+			if os.IsNotExist(err) {
+				continue // Removed while walking
 			}
+			return nil, err
 		}
-
-		entry := fmt.Sprintf("%s|%d|%s\n", relPath, info.Size(), contentHash)
-		dirHasher.Write([]byte(entry))
+		fileStates[relPath] = state
 	}
+	return fileStates, nil
+}
 
-	return hex.EncodeToString(dirHasher.Sum(nil)), nil
+// computeCurrentDirectoryHash computes the current directory hash using the
+// cached hashes of files whose size and modification time are unchanged
+func (pi *PersistentIndex) computeCurrentDirectoryHash() (string, error) {
+	fileStates, err := pi.currentFileStates(pi.metadata.FileStates)
+	if err != nil {
+		return "", err
+	}
+	return directoryHash(fileStates), nil
 }
 
-// getChangedFiles detects which files have changed using hash comparison
+// getChangedFiles returns the files that were added, deleted or whose content
+// hash changed since the index was cached. Files that were only touched keep
+// their hash, and their new size and modification time are recorded so they
+// are not read again on the next run.
 func (pi *PersistentIndex) getChangedFiles() ([]string, error) {
 	if pi.metadata == nil || pi.metadata.FileStates == nil {
 		return nil, nil
 	}
 
-	// Quick check: compute current directory hash
-	currentDirHash, err := pi.computeCurrentDirectoryHash()
+	current, err := pi.currentFileStates(pi.metadata.FileStates)
 	if err != nil {
 		return nil, err
 	}
 
-	// If directory hash matches, nothing changed
-	if currentDirHash == pi.metadata.DirectoryHash {
-		return nil, nil
-	}
-
-	// Something changed - find what
 	var changedFiles []string
-	currentFiles := make(map[string]bool)
-
-	// Walk current files
-	err = filepath.WalkDir(pi.repoPath, func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return nil
-		}
-		if d.IsDir() && path != pi.repoPath && (d.Name() == DirName || strings.HasPrefix(d.Name(), ".")) {
-			return filepath.SkipDir
-		}
-		if d.IsDir() || !isYAMLFile(path) {
-			return nil
-		}
-
-		relPath, _ := filepath.Rel(pi.repoPath, path)
-		currentFiles[relPath] = true
-
-		info, err := os.Stat(path)
-		if err != nil {
-			return nil
-		}
-
+	for relPath, state := range current {
 		cached, exists := pi.metadata.FileStates[relPath]
-		if !exists {
-			// New file
+		switch {
+		case !exists || cached.Hash != state.Hash:
 			changedFiles = append(changedFiles, relPath)
-			return nil
+		case !cached.ModTime.Equal(state.ModTime) || cached.Size != state.Size:
+			state.ParseError = cached.ParseError
+			pi.metadata.FileStates[relPath] = state
 		}
-
-		// Fast check: size and mtime
-		if cached.Size != info.Size() || !cached.ModTime.Equal(info.ModTime()) {
-			// Potentially changed - verify with hash
-			currentHash, err := hashFileContent(path)
-			if err != nil {
-				changedFiles = append(changedFiles, relPath)
-				return nil
-			}
-			if currentHash != cached.Hash {
-				changedFiles = append(changedFiles, relPath)
-			}
-		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, err
 	}
 
 	// Check for deleted files
 	for cachedPath := range pi.metadata.FileStates {
-		if !currentFiles[cachedPath] {
+		if _, ok := current[cachedPath]; !ok {
 			changedFiles = append(changedFiles, cachedPath)
 		}
 	}
 
+	sort.Strings(changedFiles)
 	return changedFiles, nil
 }
 
@@ -434,67 +443,57 @@ func (pi *PersistentIndex) incrementalUpdate(changedFiles []string) error {
 	for _, file := range changedFiles {
 		fullPath := filepath.Join(pi.repoPath, file)
 
-		// Check if file was deleted
-		if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-			pi.Index.RemoveEntriesForFile(fullPath)
-			delete(pi.metadata.FileStates, file)
-			continue
-		}
+		// Entries of the previous version of the file are always dropped, so
+		// a file that no longer parses does not leave stale resources behind
+		pi.Index.RemoveEntriesForFile(fullPath)
 
-		// Re-parse the file
-		entries, err := scanner.ParseYAMLFile(fullPath)
+		state, err := pi.fileStateFor(file, nil)
 		if err != nil {
-			// Skip invalid files but still update state
-			info, statErr := os.Stat(fullPath)
-			if statErr == nil {
-				hash, hashErr := hashFileContent(fullPath)
-				if hashErr != nil {
-					// If we can't hash the file, skip updating its state
-					continue
-				}
-				pi.metadata.FileStates[file] = FileState{
-					ModTime: info.ModTime(),
-					Size:    info.Size(),
-					Hash:    hash,
-				}
+			if os.IsNotExist(err) {
+				delete(pi.metadata.FileStates, file)
+				continue
 			}
-			continue
+			return err
 		}
 
-		// Remove old entries and add new ones
-		pi.Index.RemoveEntriesForFile(fullPath)
+		// Re-parse the file, skipping invalid files like the scanner does but
+		// recording documents that are not valid YAML
+		entries, err := scanner.ParseYAMLFile(fullPath)
+		if errors.Is(err, scanner.ErrInvalidYAML) {
+			state.ParseError = err.Error()
+		}
+		pi.metadata.FileStates[file] = state
+		if err != nil && state.ParseError == "" {
+			continue
+		}
 		for _, entry := range entries {
+			if scanner.ValidateResource(entry) != nil {
+				continue
+			}
 			if err := pi.Index.Add(entry); err != nil {
 				return fmt.Errorf("failed to add entry from %s: %w", file, err)
 			}
 		}
-
-		// Update file state
-		info, err := os.Stat(fullPath)
-		if err == nil {
-			hash, hashErr := hashFileContent(fullPath)
-			if hashErr != nil {
-				return fmt.Errorf("failed to hash file %s: %w", file, hashErr)
-			}
-			pi.metadata.FileStates[file] = FileState{
-				ModTime: info.ModTime(),
-				Size:    info.Size(),
-				Hash:    hash,
-			}
-		}
 	}
 
-	// Recompute directory hash
-	dirHash, _, err := pi.computeDirectoryState()
-	if err != nil {
-		return fmt.Errorf("failed to recompute directory hash: %w", err)
-	}
-	pi.metadata.DirectoryHash = dirHash
+	pi.metadata.DirectoryHash = directoryHash(pi.metadata.FileStates)
 	pi.metadata.LastUsed = time.Now()
 
 	return nil
 }
 
+// ParseErrors returns the files with documents that are not valid YAML, keyed
+// by path relative to the repository. Their other documents are indexed.
+func (pi *PersistentIndex) ParseErrors() map[string]string {
+	out := map[string]string{}
+	for relPath, state := range pi.metadata.FileStates {
+		if state.ParseError != "" {
+			out[relPath] = state.ParseError
+		}
+	}
+	return out
+}
+
 // ForceRebuild forces a full rebuild of the index, ignoring cache
 func ForceRebuild(repoPath string) (*PersistentIndex, error) {
 	pi := &PersistentIndex{
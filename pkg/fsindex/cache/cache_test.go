@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
 )
@@ -227,7 +228,7 @@ func TestCacheMetadataVersionCheck(t *testing.T) {
 	repoPath := setupTestRepo(t)
 
 	// Build initial index
-	_, err := LoadOrBuild(repoPath)
+	pi1, err := LoadOrBuild(repoPath)
 	if err != nil {
 		t.Fatalf("LoadOrBuild() error: %v", err)
 	}
@@ -238,22 +239,30 @@ func TestCacheMetadataVersionCheck(t *testing.T) {
 
 	var meta CacheMetadata
 	_ = json.Unmarshal(data, &meta)
+	meta.Version = FormatVersion - 1
 
 	newData, _ := json.MarshalIndent(meta, "", "  ")
 	_ = os.WriteFile(metaFile, newData, 0600)
 
+	time.Sleep(10 * time.Millisecond)
+
 	// Load again - should rebuild due to version mismatch
-	pi, err := LoadOrBuild(repoPath)
+	pi2, err := LoadOrBuild(repoPath)
 	if err != nil {
 		t.Fatalf("LoadOrBuild() error: %v", err)
 	}
 
+	if !pi2.metadata.CreatedAt.After(pi1.metadata.CreatedAt) {
+		t.Error("version mismatch should trigger a full rebuild")
+	}
+
 	// Verify version is now correct
 	metaData, _ := os.ReadFile(metaFile)
 	var newMeta CacheMetadata
 	_ = json.Unmarshal(metaData, &newMeta)
-
-	_ = pi // use variable
+	if newMeta.Version != FormatVersion {
+		t.Errorf("Version = %d, want %d", newMeta.Version, FormatVersion)
+	}
 }
 
 func TestCacheInvalidJSON(t *testing.T) {
@@ -864,6 +873,41 @@ func TestIncrementalUpdate_InvalidYAML(t *testing.T) {
 	if state.Hash == "" {
 		t.Error("FileState hash should not be empty")
 	}
+	if _, ok := pi.ParseErrors()["components/component.yaml"]; !ok {
+		t.Error("the invalid file should be reported by ParseErrors")
+	}
+}
+
+func TestLoadOrBuild_ParseErrors(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	brokenFile := filepath.Join(repoPath, "components", "broken.yaml")
+	if err := os.WriteFile(brokenFile, []byte("kind: Component\nmetadata: [broken\n"), 0600); err != nil {
+		t.Fatalf("failed to write invalid YAML: %v", err)
+	}
+
+	// Reported by a full rebuild and kept while the file is unchanged
+	for i := range 2 {
+		pi, err := LoadOrBuild(repoPath)
+		if err != nil {
+			t.Fatalf("LoadOrBuild() #%d error: %v", i+1, err)
+		}
+		if got := pi.ParseErrors(); len(got) != 1 || got[filepath.Join("components", "broken.yaml")] == "" {
+			t.Fatalf("LoadOrBuild() #%d ParseErrors() = %v, want the broken file", i+1, got)
+		}
+	}
+
+	// Cleared once the file is fixed
+	time.Sleep(10 * time.Millisecond)
+	if err := os.WriteFile(brokenFile, []byte("kind: Component\nmetadata: {}\n"), 0600); err != nil {
+		t.Fatalf("failed to fix file: %v", err)
+	}
+	pi, err := LoadOrBuild(repoPath)
+	if err != nil {
+		t.Fatalf("LoadOrBuild() error: %v", err)
+	}
+	if got := pi.ParseErrors(); len(got) != 0 {
+		t.Errorf("ParseErrors() = %v, want none", got)
+	}
 }
 
 func TestIncrementalUpdate_MultipleChanges(t *testing.T) {
@@ -1129,3 +1173,136 @@ spec:
 		t.Error("hash should change after modifying a file")
 	}
 }
+
+// --- Tests for incremental loading ---
+
+func TestLoadOrBuild_UnchangedRepoKeepsIndexFile(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	buildPersistentIndex(t, repoPath)
+
+	indexFile := filepath.Join(repoPath, DirName, IndexFile)
+	before, err := os.Stat(indexFile)
+	if err != nil {
+		t.Fatalf("failed to stat index file: %v", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	pi := buildPersistentIndex(t, repoPath)
+	if pi.Index.Stats().TotalResources != 1 {
+		t.Errorf("TotalResources = %d, want 1", pi.Index.Stats().TotalResources)
+	}
+
+	after, err := os.Stat(indexFile)
+	if err != nil {
+		t.Fatalf("failed to stat index file: %v", err)
+	}
+	if !after.ModTime().Equal(before.ModTime()) {
+		t.Error("index file should not be rewritten when no files changed")
+	}
+}
+
+func TestLoadOrBuild_IncrementalKeepsCreatedAt(t *testing.T) {
+	repoPath := setupTestRepoMultiFile(t)
+	pi1 := buildPersistentIndex(t, repoPath)
+
+	time.Sleep(10 * time.Millisecond)
+	modifiedYAML := `apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: comp1-renamed
+  namespace: default
+`
+	if err := os.WriteFile(filepath.Join(repoPath, "components", "comp1.yaml"), []byte(modifiedYAML), 0600); err != nil {
+		t.Fatalf("failed to modify file: %v", err)
+	}
+
+	pi2 := buildPersistentIndex(t, repoPath)
+
+	if !pi2.metadata.CreatedAt.Equal(pi1.metadata.CreatedAt) {
+		t.Error("a changed file should be applied incrementally, not by a full rebuild")
+	}
+	gvk := openchoreoGVK("Component")
+	if _, ok := pi2.Index.Get(gvk, "default", "comp1-renamed"); !ok {
+		t.Error("modified resource should be indexed under its new name")
+	}
+	if _, ok := pi2.Index.Get(gvk, "default", "comp1"); ok {
+		t.Error("old version of the modified resource should be removed")
+	}
+	if _, ok := pi2.Index.Get(gvk, "default", "comp2"); !ok {
+		t.Error("unchanged resources should be kept from the cache")
+	}
+
+	// The directory hash must match the one computed from scratch
+	dirHash, _, err := pi2.computeDirectoryState()
+	if err != nil {
+		t.Fatalf("computeDirectoryState() error: %v", err)
+	}
+	if pi2.metadata.DirectoryHash != dirHash {
+		t.Error("incrementally updated DirectoryHash should match a full computation")
+	}
+}
+
+func TestGetChangedFiles_TouchedFileNotChanged(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	pi := buildPersistentIndex(t, repoPath)
+
+	componentFile := filepath.Join(repoPath, "components", "component.yaml")
+	touched := time.Now().Add(time.Hour)
+	if err := os.Chtimes(componentFile, touched, touched); err != nil {
+		t.Fatalf("failed to touch file: %v", err)
+	}
+
+	changed, err := pi.getChangedFiles()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("expected touched file with same content not to be changed, got %v", changed)
+	}
+
+	// The new modification time is recorded so the file is not hashed again
+	if !pi.metadata.FileStates["components/component.yaml"].ModTime.Equal(touched) {
+		t.Error("FileState modification time should be refreshed for touched files")
+	}
+}
+
+func TestLoadOrBuild_SkipsScannerExcludedDirectories(t *testing.T) {
+	repoPath := setupTestRepo(t)
+
+	vendorDir := filepath.Join(repoPath, "node_modules", "pkg")
+	if err := os.MkdirAll(vendorDir, 0755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(vendorDir, "chart.yaml"), []byte("name: chart\n"), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	pi := buildPersistentIndex(t, repoPath)
+	if _, ok := pi.metadata.FileStates["node_modules/pkg/chart.yaml"]; ok {
+		t.Error("files the scanner does not index should not be tracked")
+	}
+}
+
+func TestLoadOrBuild_RebuildsWhenRepoMoved(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	buildPersistentIndex(t, repoPath)
+
+	movedPath := filepath.Join(t.TempDir(), "moved")
+	if err := os.Rename(repoPath, movedPath); err != nil {
+		t.Fatalf("failed to move repo: %v", err)
+	}
+
+	pi := buildPersistentIndex(t, movedPath)
+	entries := pi.Index.List(openchoreoGVK("Component"))
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 component, got %d", len(entries))
+	}
+	if want := filepath.Join(movedPath, "components", "component.yaml"); entries[0].FilePath != want {
+		t.Errorf("FilePath = %q, want %q", entries[0].FilePath, want)
+	}
+}
+
+func openchoreoGVK(kind string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: kind}
+}
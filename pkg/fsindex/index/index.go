@@ -18,6 +18,10 @@ type Index struct {
 	byGVK      map[schema.GroupVersionKind]map[string]*ResourceEntry // GVK -> "ns/name" -> entry
 	byFilePath map[string][]*ResourceEntry                           // path -> entries
 
+	// Reverse references, maintained when an extractor is set
+	extractRefs  ReferenceExtractor
+	referencedBy map[Reference][]*ResourceEntry // referenced resource -> referrers
+
 	// Metadata
 	repoPath  string
 	commitSHA string
@@ -26,9 +30,10 @@ type Index struct {
 // New creates a new empty index
 func New(repoPath string) *Index {
 	return &Index{
-		byGVK:      make(map[schema.GroupVersionKind]map[string]*ResourceEntry),
-		byFilePath: make(map[string][]*ResourceEntry),
-		repoPath:   repoPath,
+		byGVK:        make(map[schema.GroupVersionKind]map[string]*ResourceEntry),
+		byFilePath:   make(map[string][]*ResourceEntry),
+		referencedBy: make(map[Reference][]*ResourceEntry),
+		repoPath:     repoPath,
 	}
 }
 
@@ -48,7 +53,11 @@ func (idx *Index) Add(entry *ResourceEntry) error {
 	if idx.byGVK[gvk] == nil {
 		idx.byGVK[gvk] = make(map[string]*ResourceEntry)
 	}
+	if existing, ok := idx.byGVK[gvk][nsName]; ok {
+		idx.removeReferencesUnsafe(existing)
+	}
 	idx.byGVK[gvk][nsName] = entry
+	idx.addReferencesUnsafe(entry)
 
 	// Add to file path index
 	idx.byFilePath[entry.FilePath] = append(idx.byFilePath[entry.FilePath], entry)
@@ -131,8 +140,9 @@ func (idx *Index) RemoveEntriesForFile(filePath string) {
 		gvk := entry.Resource.GroupVersionKind()
 		nsName := entry.NamespacedName()
 
-		if gvkMap, ok := idx.byGVK[gvk]; ok {
+		if gvkMap, ok := idx.byGVK[gvk]; ok && gvkMap[nsName] == entry {
 			delete(gvkMap, nsName)
+			idx.removeReferencesUnsafe(entry)
 		}
 	}
 
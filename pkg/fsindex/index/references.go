@@ -0,0 +1,108 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package index
+
+import (
+	"sort"
+)
+
+// Reference identifies a resource that another resource points at
+type Reference struct {
+	Kind      string
+	Namespace string // empty for cluster-scoped resources
+	Name      string
+}
+
+// ReferenceExtractor returns the resources referenced by an entry.
+// The index is generic, so the caller decides which fields are references.
+type ReferenceExtractor func(entry *ResourceEntry) []Reference
+
+// SetReferenceExtractor sets the function used to find the references of
+// each entry and rebuilds the reverse reference index from all resources.
+// Entries added or removed afterwards keep the reverse index up to date.
+func (idx *Index) SetReferenceExtractor(extract ReferenceExtractor) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.extractRefs = extract
+	idx.referencedBy = make(map[Reference][]*ResourceEntry)
+	if extract == nil {
+		return
+	}
+	for _, gvkMap := range idx.byGVK {
+		for _, entry := range gvkMap {
+			idx.addReferencesUnsafe(entry)
+		}
+	}
+}
+
+// ReferencedBy returns the resources that reference the given resource,
+// sorted by kind and namespaced name. It returns nil when no reference
+// extractor has been set.
+func (idx *Index) ReferencedBy(ref Reference) []*ResourceEntry {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	referrers := idx.referencedBy[ref]
+	if len(referrers) == 0 {
+		return nil
+	}
+
+	entries := make([]*ResourceEntry, len(referrers))
+	copy(entries, referrers)
+	sort.Slice(entries, func(i, j int) bool {
+		ki, kj := entries[i].Resource.GetKind(), entries[j].Resource.GetKind()
+		if ki != kj {
+			return ki < kj
+		}
+		return entries[i].NamespacedName() < entries[j].NamespacedName()
+	})
+	return entries
+}
+
+// addReferencesUnsafe records the references of an entry (caller must hold lock)
+func (idx *Index) addReferencesUnsafe(entry *ResourceEntry) {
+	if idx.extractRefs == nil {
+		return
+	}
+	for _, ref := range uniqueReferences(idx.extractRefs(entry)) {
+		idx.referencedBy[ref] = append(idx.referencedBy[ref], entry)
+	}
+}
+
+// removeReferencesUnsafe forgets the references of an entry (caller must hold lock)
+func (idx *Index) removeReferencesUnsafe(entry *ResourceEntry) {
+	if idx.extractRefs == nil {
+		return
+	}
+	for _, ref := range uniqueReferences(idx.extractRefs(entry)) {
+		referrers := idx.referencedBy[ref]
+		for i, referrer := range referrers {
+			if referrer == entry {
+				referrers = append(referrers[:i], referrers[i+1:]...)
+				break
+			}
+		}
+		if len(referrers) == 0 {
+			delete(idx.referencedBy, ref)
+		} else {
+			idx.referencedBy[ref] = referrers
+		}
+	}
+}
+
+// uniqueReferences drops empty and duplicate references so that each
+// referrer is recorded once per referenced resource
+func uniqueReferences(refs []Reference) []Reference {
+	seen := make(map[Reference]bool, len(refs))
+	unique := make([]Reference, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Kind == "" || ref.Name == "" || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		unique = append(unique, ref)
+	}
+	return unique
+}
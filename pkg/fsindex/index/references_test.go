@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package index
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// releaseRefExtractor treats spec.releaseName as a reference to a ComponentRelease
+// in the same namespace
+func releaseRefExtractor(entry *ResourceEntry) []Reference {
+	release := entry.GetNestedString("spec", "releaseName")
+	return []Reference{
+		{Kind: "ComponentRelease", Namespace: entry.Namespace(), Name: release},
+		{Kind: "ComponentRelease", Namespace: entry.Namespace(), Name: release},
+	}
+}
+
+func createBindingEntry(namespace, name, releaseName, filePath string) *ResourceEntry {
+	entry := createTestEntry("openchoreo.dev", "v1alpha1", "ReleaseBinding", namespace, name, filePath)
+	entry.Resource.Object["spec"] = map[string]interface{}{"releaseName": releaseName}
+	return entry
+}
+
+func referrerNames(entries []*ResourceEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.NamespacedName())
+	}
+	return names
+}
+
+func assertReferrers(t *testing.T, idx *Index, ref Reference, want ...string) {
+	t.Helper()
+	got := referrerNames(idx.ReferencedBy(ref))
+	if len(got) != len(want) {
+		t.Fatalf("ReferencedBy(%v) = %v, want %v", ref, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ReferencedBy(%v) = %v, want %v", ref, got, want)
+		}
+	}
+}
+
+func TestReferencedBy(t *testing.T) {
+	release1 := Reference{Kind: "ComponentRelease", Namespace: "default", Name: "api-1"}
+	release2 := Reference{Kind: "ComponentRelease", Namespace: "default", Name: "api-2"}
+
+	t.Run("no extractor", func(t *testing.T) {
+		idx := New("/repo")
+		if err := idx.Add(createBindingEntry("default", "api-dev", "api-1", "/repo/api-dev.yaml")); err != nil {
+			t.Fatal(err)
+		}
+		if got := idx.ReferencedBy(release1); got != nil {
+			t.Errorf("ReferencedBy() without extractor = %v, want nil", referrerNames(got))
+		}
+	})
+
+	t.Run("extractor set after entries were added", func(t *testing.T) {
+		idx := New("/repo")
+		for _, entry := range []*ResourceEntry{
+			createBindingEntry("default", "api-staging", "api-1", "/repo/api-staging.yaml"),
+			createBindingEntry("default", "api-dev", "api-1", "/repo/api-dev.yaml"),
+			createBindingEntry("other", "api-dev", "api-1", "/repo/other/api-dev.yaml"),
+		} {
+			if err := idx.Add(entry); err != nil {
+				t.Fatal(err)
+			}
+		}
+		idx.SetReferenceExtractor(releaseRefExtractor)
+
+		assertReferrers(t, idx, release1, "default/api-dev", "default/api-staging")
+		assertReferrers(t, idx, release2)
+	})
+
+	t.Run("kept up to date on add, replace and remove", func(t *testing.T) {
+		idx := New("/repo")
+		idx.SetReferenceExtractor(releaseRefExtractor)
+
+		if err := idx.Add(createBindingEntry("default", "api-dev", "api-1", "/repo/api-dev.yaml")); err != nil {
+			t.Fatal(err)
+		}
+		if err := idx.Add(createBindingEntry("default", "api-staging", "api-1", "/repo/api-staging.yaml")); err != nil {
+			t.Fatal(err)
+		}
+		assertReferrers(t, idx, release1, "default/api-dev", "default/api-staging")
+
+		// Re-adding a resource replaces the references of the previous version
+		idx.RemoveEntriesForFile("/repo/api-dev.yaml")
+		if err := idx.Add(createBindingEntry("default", "api-dev", "api-2", "/repo/api-dev.yaml")); err != nil {
+			t.Fatal(err)
+		}
+		assertReferrers(t, idx, release1, "default/api-staging")
+		assertReferrers(t, idx, release2, "default/api-dev")
+
+		idx.RemoveEntriesForFile("/repo/api-staging.yaml")
+		assertReferrers(t, idx, release1)
+	})
+
+	t.Run("rebuilt when deserialized", func(t *testing.T) {
+		idx := New("/repo")
+		if err := idx.Add(createBindingEntry("default", "api-dev", "api-1", "/repo/api-dev.yaml")); err != nil {
+			t.Fatal(err)
+		}
+
+		restored := idx.ToSerializable().ToIndex("/repo")
+		restored.SetReferenceExtractor(releaseRefExtractor)
+		assertReferrers(t, restored, release1, "default/api-dev")
+	})
+}
+
+func TestRemoveEntriesForFileKeepsDuplicateFromOtherFile(t *testing.T) {
+	idx := New("/repo")
+	idx.SetReferenceExtractor(releaseRefExtractor)
+
+	if err := idx.Add(createBindingEntry("default", "api-dev", "api-1", "/repo/old.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	if err := idx.Add(createBindingEntry("default", "api-dev", "api-1", "/repo/new.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	idx.RemoveEntriesForFile("/repo/old.yaml")
+
+	gvk := schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "ReleaseBinding"}
+	entry, ok := idx.Get(gvk, "default", "api-dev")
+	if !ok || entry.FilePath != "/repo/new.yaml" {
+		t.Fatalf("expected the binding from new.yaml to remain indexed, got %v", entry)
+	}
+	assertReferrers(t, idx, Reference{Kind: "ComponentRelease", Namespace: "default", Name: "api-1"}, "default/api-dev")
+}
@@ -5,6 +5,7 @@ package scanner
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -27,7 +28,13 @@ func ParseYAMLFile(path string) ([]*index.ResourceEntry, error) {
 	return ParseYAML(data, path)
 }
 
-// ParseYAML parses YAML data and returns all resources found
+// ErrInvalidYAML is returned, wrapped, for documents that are not valid YAML.
+var ErrInvalidYAML = errors.New("invalid YAML")
+
+// ParseYAML parses YAML data and returns all resources found. Documents that
+// are not Kubernetes resources are skipped. Documents that are not valid YAML
+// are skipped as well, but reported in an error wrapping ErrInvalidYAML, which
+// is returned together with the resources of the valid documents.
 func ParseYAML(data []byte, sourcePath string) ([]*index.ResourceEntry, error) {
 	if len(data) == 0 {
 		return nil, nil
@@ -35,14 +42,27 @@ func ParseYAML(data []byte, sourcePath string) ([]*index.ResourceEntry, error) {
 
 	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
 	var entries []*index.ResourceEntry
+	var errs []error
 
-	for {
-		obj := &unstructured.Unstructured{}
-		if err := decoder.Decode(obj); err != nil {
+	for doc := 1; ; doc++ {
+		// Documents are decoded in two steps so that only syntax errors are
+		// reported; valid documents that are not resources are skipped below
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			// Skip invalid documents but continue parsing
+			errs = append(errs, fmt.Errorf("%w: document %d: %w", ErrInvalidYAML, doc, err))
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				// A JSON stream cannot be resumed after a syntax error
+				break
+			}
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(raw); err != nil {
 			continue
 		}
 
@@ -62,7 +82,7 @@ func ParseYAML(data []byte, sourcePath string) ([]*index.ResourceEntry, error) {
 		})
 	}
 
-	return entries, nil
+	return entries, errors.Join(errs...)
 }
 
 // ValidateResource checks if a resource is valid for indexing
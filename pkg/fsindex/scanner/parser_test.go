@@ -4,8 +4,10 @@
 package scanner
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/openchoreo/openchoreo/pkg/fsindex/index"
@@ -177,6 +179,38 @@ metadata:
 	}
 }
 
+func TestParseYAMLInvalidDocument(t *testing.T) {
+	data := []byte(`apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: valid-component
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: [broken
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: valid-trait
+`)
+
+	entries, err := ParseYAML(data, "/test/source.yaml")
+	if !errors.Is(err, ErrInvalidYAML) {
+		t.Fatalf("error = %v, want ErrInvalidYAML", err)
+	}
+	if !strings.Contains(err.Error(), "document 2") {
+		t.Errorf("error = %q, want it to name document 2", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want the 2 valid documents", len(entries))
+	}
+	if entries[0].Name() != "valid-component" || entries[1].Name() != "valid-trait" {
+		t.Errorf("entries = %s, %s, want valid-component, valid-trait", entries[0].Name(), entries[1].Name())
+	}
+}
+
 func TestParseYAMLFile(t *testing.T) {
 	// Create a temporary test file
 	tmpDir := t.TempDir()
@@ -4,6 +4,7 @@
 package scanner
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -155,7 +156,10 @@ func (s *Scanner) processFiles(files <-chan string, results chan<- *index.Resour
 			if s.opts.ErrorHandler != nil {
 				s.opts.ErrorHandler(path, err)
 			}
-			continue
+			// The valid documents of a file with invalid ones are still indexed
+			if !errors.Is(err, ErrInvalidYAML) {
+				continue
+			}
 		}
 
 		for _, entry := range entries {
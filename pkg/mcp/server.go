@@ -4,13 +4,18 @@
 package mcp
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
@@ -36,55 +41,155 @@ const (
 	// deprecation banner and a structured _meta marker — until they are removed
 	// in v1.3.
 	QueryParamIncludeDeprecatedTools = "includeDeprecatedTools"
+	// QueryParamConfirmMutations controls whether mutating tools return a plan
+	// that must be confirmed before the change is applied (e.g.
+	// ?confirmMutations=true). Defaults to the server's RequireConfirmation
+	// option.
+	QueryParamConfirmMutations = "confirmMutations"
 )
 
+// ServerOptions configures the optional features of the MCP servers.
+type ServerOptions struct {
+	// RequireConfirmation makes mutating tools return a plan that must be
+	// confirmed before the change is applied, for sessions that do not set
+	// ?confirmMutations. Mutating tools always accept dry_run.
+	RequireConfirmation bool
+	// Notifier, when set, lets clients subscribe to resources and delivers the
+	// change events published on it.
+	Notifier *ResourceNotifier
+	// Clients maps OAuth client IDs to the scope of the registered MCP client.
+	// The scope of a session is that of its token's client, narrowed further
+	// by the token's openchoreo:mcp:* scopes. Only used by the HTTP server.
+	Clients map[string]*tools.ClientScope
+	// ResolveComponentProject lets project restrictions of client scopes apply
+	// to tools that only name a component.
+	ResolveComponentProject tools.ComponentProjectResolver
+	// AuditLogger, when set, records every tool call in the audit log.
+	AuditLogger *audit.Logger
+}
+
+// ResourceNotifier publishes resource change events to the MCP servers it is
+// attached to, which forward them to the sessions subscribed to the resource.
+type ResourceNotifier struct {
+	mu      sync.RWMutex
+	servers []*mcp.Server
+}
+
+// NewResourceNotifier creates a ResourceNotifier
+func NewResourceNotifier() *ResourceNotifier {
+	return &ResourceNotifier{}
+}
+
+func (n *ResourceNotifier) attach(s *mcp.Server) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.servers = append(n.servers, s)
+}
+
+// ResourceChanged notifies the sessions subscribed to the resource with the given URI
+func (n *ResourceNotifier) ResourceChanged(ctx context.Context, uri string) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, s := range n.servers {
+		_ = s.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+	}
+}
+
 // NewHTTPServer creates an MCP HTTP handler backed by a single shared server.
 //
-// All configured toolsets are registered up front. Per-session narrowing
-// happens via query parameters parsed from the initialize request:
+// All configured toolsets, resources and prompts are registered up front.
+// Per-session narrowing happens via query parameters parsed from the initialize
+// request:
 //   - ?toolsets=ns1,ns2              — only show tools from those toolsets in tools/list
 //   - ?filterByAuthz=false           — disable MCP-layer authz filtering for the session
 //   - ?includeDeprecatedTools=true   — list the deprecated cluster-prefixed alias tools
 //     (hidden by default as of v1.2; they remain callable and are removed in v1.3)
+//   - ?confirmMutations=true         — require confirmation of mutating tool calls
 //
 // When pdp is non-nil the server installs a receiving middleware that filters
 // tools/list results and guards tools/call invocations based on the
 // authenticated user's permissions derived from their JWT token. When pdp is
 // nil (authz disabled) all registered tools are visible and callable — the
 // service layer still enforces authz independently. The toolset filter is
-// always applied when the client requests it, regardless of pdp.
-func NewHTTPServer(toolsets *tools.Toolsets, pdp authzcore.PDP) http.Handler {
-	server := mcp.NewServer(&mcp.Implementation{
-		Name:    "openchoreo-api",
-		Version: "1.0.0",
-	}, nil)
-	perms, toolToToolsets := toolsets.Register(server)
+// always applied when the client requests it, regardless of pdp. Authz is
+// checked before the confirmation protocol, so plans are only returned for
+// calls the user may make.
+func NewHTTPServer(toolsets *tools.Toolsets, pdp authzcore.PDP, opts ServerOptions) (http.Handler, error) {
+	server, perms, toolToToolsets, err := newServer("openchoreo-api", toolsets, opts)
+	if err != nil {
+		return nil, err
+	}
+	// Middlewares added later run first: audit sees every call, including those
+	// rejected by the client scope and authz, which both run before the
+	// confirmation protocol.
 	server.AddReceivingMiddleware(tools.NewToolFilterMiddleware(pdp, perms, toolToToolsets))
+	server.AddReceivingMiddleware(tools.NewClientScopeMiddleware(perms, opts.ResolveComponentProject))
+	if opts.AuditLogger != nil {
+		server.AddReceivingMiddleware(tools.NewAuditMiddleware(opts.AuditLogger, perms))
+	}
 	streamable := mcp.NewStreamableHTTPHandler(func(r *http.Request) *mcp.Server {
 		return server
 	}, nil)
-	return withSessionQueryParams(streamable)
+	return withClientScope(withSessionQueryParams(streamable), opts.Clients), nil
 }
 
 // NewSTDIO creates an MCP server for STDIO transport (local CLI usage).
 // Permission filtering is intentionally skipped for STDIO: there is no
 // HTTP request, no JWT token, and no authenticated user identity available.
 // The service layer still enforces authz for any operations that require it.
-func NewSTDIO(toolsets *tools.Toolsets) *mcp.Server {
+func NewSTDIO(toolsets *tools.Toolsets, opts ServerOptions) (*mcp.Server, error) {
+	server, _, _, err := newServer("openchoreo-cli", toolsets, opts)
+	if err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// newServer creates an MCP server with the tools, resources and prompts of the
+// toolsets registered and the confirmation protocol installed.
+func newServer(name string, toolsets *tools.Toolsets, opts ServerOptions) (
+	*mcp.Server, map[string]tools.ToolPermission, map[string]map[tools.ToolsetType]bool, error,
+) {
+	confirmer, err := tools.NewConfirmer()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	serverOpts := &mcp.ServerOptions{}
+	if opts.Notifier != nil && toolsets.ResourceState != nil {
+		serverOpts.SubscribeHandler = func(_ context.Context, req *mcp.SubscribeRequest) error {
+			if _, err := tools.ParseResourceURI(req.Params.URI); err != nil {
+				return fmt.Errorf("cannot subscribe: %w", err)
+			}
+			return nil
+		}
+		serverOpts.UnsubscribeHandler = func(context.Context, *mcp.UnsubscribeRequest) error {
+			return nil
+		}
+	}
+
 	server := mcp.NewServer(&mcp.Implementation{
-		Name:    "openchoreo-cli",
+		Name:    name,
 		Version: "1.0.0",
-	}, nil)
-	toolsets.Register(server)
-	return server
+	}, serverOpts)
+	perms, toolToToolsets := toolsets.Register(server)
+	if toolsets.ResourceState != nil {
+		toolsets.RegisterResources(server)
+		if opts.Notifier != nil {
+			opts.Notifier.attach(server)
+		}
+	}
+	toolsets.RegisterPrompts(server)
+	server.AddReceivingMiddleware(tools.NewConfirmationMiddleware(perms, confirmer, opts.RequireConfirmation))
+	return server, perms, toolToToolsets, nil
 }
 
 // withSessionQueryParams returns an http.Handler that extracts the optional
 // MCP session-scoping query parameters (toolsets, filterByAuthz,
-// includeDeprecatedTools) from the request URL and stores them on the request
-// context. The MCP SDK propagates
-// the initialize request's context into the long-lived session, so the values
-// set here become the per-session scope used by the tool-filter middleware.
+// includeDeprecatedTools, confirmMutations) from the request URL and stores
+// them on the request context. The MCP SDK propagates the initialize request's
+// context into the long-lived session, so the values set here become the
+// per-session scope used by the tool-filter and confirmation middlewares.
 //
 // Subsequent requests in a stateful session do not re-read these params — the
 // session is bound to the values supplied at session creation. In stateless
@@ -113,10 +218,75 @@ func withSessionQueryParams(next http.Handler) http.Handler {
 			}
 		}
 
+		if raw := q.Get(QueryParamConfirmMutations); raw != "" {
+			if v, err := strconv.ParseBool(raw); err == nil {
+				ctx = tools.WithConfirmMutations(ctx, v)
+			}
+		}
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// withClientScope returns an http.Handler that stores the ClientScope of the
+// authenticated token on the request context. The client is identified by the
+// token's azp or client_id claim and its scope is the registration in clients,
+// if any, narrowed by the token's openchoreo:mcp:* scopes. Like the query
+// params, the scope of the session-creation request applies to the session.
+// The scope only narrows MCP calls; the REST API does not enforce it, so a
+// token carrying openchoreo:mcp:* scopes keeps the full rights of its user there.
+func withClientScope(next http.Handler, clients map[string]*tools.ClientScope) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject, ok := auth.GetSubjectContextFromContext(r.Context())
+		if !ok || subject == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		clientID := claimString(subject.Claims, "azp")
+		if clientID == "" {
+			clientID = claimString(subject.Claims, "client_id")
+		}
+		scope := clients[clientID].Intersect(tools.ClientScopeFromOAuthScopes(tokenScopes(subject.Claims)))
+		if clientID != "" {
+			// Copy, as the scope may be the shared registration
+			identified := tools.ClientScope{}
+			if scope != nil {
+				identified = *scope
+			}
+			identified.ClientID = clientID
+			scope = &identified
+		}
+		next.ServeHTTP(w, r.WithContext(tools.WithClientScope(r.Context(), scope)))
+	})
+}
+
+func claimString(claims map[string]any, name string) string {
+	v, _ := claims[name].(string)
+	return v
+}
+
+// tokenScopes returns the scopes of a token from its space-delimited scope
+// claim (RFC 8693) or its scp array claim.
+func tokenScopes(claims map[string]any) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+	var scopes []string
+	switch scp := claims["scp"].(type) {
+	case string:
+		scopes = strings.Fields(scp)
+	case []any:
+		for _, v := range scp {
+			if s, ok := v.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+	case []string:
+		scopes = scp
+	}
+	return scopes
+}
+
 // parseRequestedToolsets parses a comma-separated list of toolset names into a
 // set. Empty entries (from `,,` or trailing commas) are skipped. Unknown
 // toolset names are kept in the set as-is — the filter middleware silently
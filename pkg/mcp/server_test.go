@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
@@ -20,11 +21,14 @@ type captureHandler struct {
 	hasRequested      bool
 	filterByAuthz     bool
 	hasFilter         bool
+	confirmMutations  bool
+	hasConfirm        bool
 }
 
 func (h *captureHandler) ServeHTTP(_ http.ResponseWriter, r *http.Request) {
 	h.requestedToolsets, h.hasRequested = tools.RequestedToolsetsFromContext(r.Context())
 	h.filterByAuthz, h.hasFilter = tools.FilterByAuthzFromContext(r.Context())
+	h.confirmMutations, h.hasConfirm = tools.ConfirmMutationsFromContext(r.Context())
 }
 
 func TestWithSessionQueryParamsParsesToolsets(t *testing.T) {
@@ -135,6 +139,35 @@ func TestWithSessionQueryParamsParsesFilterByAuthz(t *testing.T) {
 	}
 }
 
+func TestWithSessionQueryParamsParsesConfirmMutations(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		wantSet   bool
+		wantValue bool
+	}{
+		{name: "true", url: "/mcp?confirmMutations=true", wantSet: true, wantValue: true},
+		{name: "false", url: "/mcp?confirmMutations=false", wantSet: true, wantValue: false},
+		{name: "absent", url: "/mcp", wantSet: false},
+		{name: "invalid value treated as absent", url: "/mcp?confirmMutations=maybe", wantSet: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cap := &captureHandler{}
+			h := withSessionQueryParams(cap)
+			req := httptest.NewRequest(http.MethodPost, tt.url, http.NoBody)
+			h.ServeHTTP(httptest.NewRecorder(), req)
+			if cap.hasConfirm != tt.wantSet {
+				t.Fatalf("hasConfirm = %v, want %v", cap.hasConfirm, tt.wantSet)
+			}
+			if tt.wantSet && cap.confirmMutations != tt.wantValue {
+				t.Errorf("confirmMutations = %v, want %v", cap.confirmMutations, tt.wantValue)
+			}
+		})
+	}
+}
+
 func TestWithSessionQueryParamsCombined(t *testing.T) {
 	cap := &captureHandler{}
 	h := withSessionQueryParams(cap)
@@ -160,3 +193,58 @@ func TestWithSessionQueryParamsCombined(t *testing.T) {
 		t.Errorf("requested toolsets = %v, want %v", cap.requestedToolsets, want)
 	}
 }
+
+func TestWithClientScope(t *testing.T) {
+	clients := map[string]*tools.ClientScope{
+		"agent": {ClientID: "agent", Namespaces: map[string]bool{"default": true}},
+	}
+	tests := []struct {
+		name   string
+		claims map[string]any
+		want   *tools.ClientScope
+	}{
+		{
+			name:   "unknown client without scopes",
+			claims: map[string]any{"sub": "user-1"},
+			want:   nil,
+		},
+		{
+			name:   "unregistered client is identified",
+			claims: map[string]any{"azp": "other"},
+			want:   &tools.ClientScope{ClientID: "other"},
+		},
+		{
+			name:   "registered client",
+			claims: map[string]any{"client_id": "agent"},
+			want:   &tools.ClientScope{ClientID: "agent", Namespaces: map[string]bool{"default": true}},
+		},
+		{
+			name:   "token scopes narrow the registration",
+			claims: map[string]any{"azp": "agent", "scope": "openid " + tools.OAuthScopeReadOnly},
+			want:   &tools.ClientScope{ClientID: "agent", ReadOnly: true, Namespaces: map[string]bool{"default": true}},
+		},
+		{
+			name:   "scp array claim",
+			claims: map[string]any{"scp": []any{tools.OAuthScopeToolPrefix + "list_projects"}},
+			want:   &tools.ClientScope{Tools: map[string]bool{"list_projects": true}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *tools.ClientScope
+			h := withClientScope(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = tools.ClientScopeFromContext(r.Context())
+			}), clients)
+			req := httptest.NewRequest(http.MethodPost, "/mcp", http.NoBody)
+			req = req.WithContext(auth.SetSubjectContext(req.Context(), &auth.SubjectContext{ID: "user-1", Claims: tt.claims}))
+			h.ServeHTTP(httptest.NewRecorder(), req)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("client scope = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if clients["agent"].ReadOnly {
+		t.Error("token scopes must not modify the registration")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// NewAuditMiddleware returns an MCP receiving middleware that records every
+// tools/call in the audit log, including rejected calls, with the user and the
+// MCP client that made it. The event action is the tool name.
+func NewAuditMiddleware(logger *audit.Logger, perms map[string]ToolPermission) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+			// Read before next, which strips the confirmation arguments
+			metadata := callToolAuditMetadata(req, perms)
+			target := callToolScope(req)
+
+			result, err := next(ctx, method, req)
+
+			outcome := audit.ResultSuccess
+			switch {
+			case errors.Is(err, ErrToolNotAuthorized), errors.Is(err, ErrOutsideClientScope):
+				outcome = audit.ResultDenied
+			case err != nil:
+				outcome = audit.ResultFailure
+			default:
+				if r, ok := result.(*mcp.CallToolResult); ok && r != nil && r.IsError {
+					outcome = audit.ResultFailure
+				}
+			}
+			if err != nil {
+				metadata["error"] = err.Error()
+			}
+			if session := req.GetSession(); session != nil && session.ID() != "" {
+				metadata["session_id"] = session.ID()
+			}
+
+			actor := audit.ActorFromContext(ctx)
+			if scope := ClientScopeFromContext(ctx); scope != nil {
+				actor.Client = scope.ClientID
+			}
+			logger.LogEvent(&audit.Event{
+				Actor:    actor,
+				Action:   callToolName(req),
+				Category: audit.CategoryMCP,
+				Resource: auditResource(target),
+				Result:   outcome,
+				Metadata: metadata,
+			})
+			return result, err
+		}
+	}
+}
+
+func callToolAuditMetadata(req mcp.Request, perms map[string]ToolPermission) map[string]any {
+	toolName := callToolName(req)
+	perm, hasPerm := perms[toolName]
+	metadata := map[string]any{"mutating": !hasPerm || perm.Mutating()}
+	if scope := callToolScopeArg(req); scope != "" {
+		metadata["scope"] = scope
+	}
+	if p, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok && p != nil && len(p.Arguments) > 0 {
+		var args map[string]any
+		if err := json.Unmarshal(p.Arguments, &args); err == nil {
+			if dryRun, _ := args[ArgDryRun].(bool); dryRun {
+				metadata["dry_run"] = true
+			}
+			if token, _ := args[ArgConfirmationToken].(string); token != "" {
+				metadata["confirmed"] = true
+			}
+		}
+	}
+	return metadata
+}
+
+// auditResource returns the most specific resource of the hierarchy, or nil
+// for calls that do not target a namespace.
+func auditResource(h authzcore.ResourceHierarchy) *audit.Resource {
+	var resource *audit.Resource
+	var path []string
+	for _, level := range []struct{ kind, name string }{
+		{"namespace", h.Namespace},
+		{"project", h.Project},
+		{"component", h.Component},
+		{"resource", h.Resource},
+	} {
+		if level.name == "" {
+			continue
+		}
+		path = append(path, level.name)
+		resource = &audit.Resource{Type: level.kind, ID: strings.Join(path, "/"), Name: level.name}
+	}
+	return resource
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+type auditRecord struct {
+	Action   string `json:"action"`
+	Category string `json:"category"`
+	Result   string `json:"result"`
+	Actor    struct {
+		Type   string `json:"type"`
+		ID     string `json:"id"`
+		Client string `json:"client"`
+	} `json:"actor"`
+	Resource struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"resource"`
+	Metadata map[string]any `json:"metadata"`
+}
+
+func TestAuditMiddlewareRecordsToolCalls(t *testing.T) {
+	var buf bytes.Buffer
+	logger := audit.NewLogger(slog.New(slog.NewJSONHandler(&buf, nil)), "openchoreo-api")
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	schema := createSchema(map[string]any{
+		"namespace_name": stringProperty("Namespace"),
+		"project_name":   stringProperty("Project"),
+	}, nil)
+	for _, name := range []string{"list_projects", "delete_project"} {
+		mcp.AddTool(server, &mcp.Tool{Name: name, InputSchema: schema}, nopToolHandler)
+	}
+	perms := map[string]ToolPermission{
+		"list_projects":  {ToolName: "list_projects", Action: "project:view"},
+		"delete_project": {ToolName: "delete_project", Action: "project:delete"},
+	}
+	server.AddReceivingMiddleware(NewClientScopeMiddleware(perms, nil))
+	server.AddReceivingMiddleware(NewAuditMiddleware(logger, perms))
+
+	ctx := WithClientScope(ctxWithSubject(context.Background()), &ClientScope{ClientID: "agent", ReadOnly: true})
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.ListTools(ctx, nil); err != nil {
+		t.Fatalf("ListTools: %v", err)
+	}
+	if _, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "list_projects",
+		Arguments: map[string]any{"namespace_name": "default"},
+	}); err != nil {
+		t.Fatalf("CallTool list_projects: %v", err)
+	}
+	if _, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "delete_project",
+		Arguments: map[string]any{"namespace_name": "default", "project_name": "shop"},
+	}); err == nil {
+		t.Fatal("expected delete_project to be rejected for a read-only client")
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected an audit event per tool call, got %d:\n%s", len(lines), buf.String())
+	}
+	records := make([]auditRecord, len(lines))
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &records[i]); err != nil {
+			t.Fatalf("audit event is not JSON: %v", err)
+		}
+	}
+
+	list, del := records[0], records[1]
+	if list.Action != "list_projects" || list.Category != string(audit.CategoryMCP) ||
+		list.Result != string(audit.ResultSuccess) {
+		t.Errorf("list event = %+v", list)
+	}
+	if list.Actor.ID != "user-1" || list.Actor.Client != "agent" {
+		t.Errorf("list actor = %+v, want user-1 acting through agent", list.Actor)
+	}
+	if list.Resource.Type != "namespace" || list.Resource.ID != "default" {
+		t.Errorf("list resource = %+v", list.Resource)
+	}
+	if del.Action != "delete_project" || del.Result != string(audit.ResultDenied) {
+		t.Errorf("delete event = %+v, want denied", del)
+	}
+	if del.Resource.Type != "project" || del.Resource.ID != "default/shop" {
+		t.Errorf("delete resource = %+v", del.Resource)
+	}
+	if del.Metadata["mutating"] != true || del.Metadata["error"] == nil {
+		t.Errorf("delete metadata = %v", del.Metadata)
+	}
+}
@@ -7,7 +7,7 @@ import "testing"
 
 // buildToolSpecs returns test specs for build toolset
 func buildToolSpecs() []toolTestSpec {
-	specs := make([]toolTestSpec, 0, 12)
+	specs := make([]toolTestSpec, 0, 15)
 	specs = append(specs, buildWorkflowRunSpecs()...)
 	specs = append(specs, buildWorkflowSpecs()...)
 	specs = append(specs, buildClusterWorkflowSpecs()...)
@@ -143,6 +143,57 @@ func buildWorkflowRunSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "cancel_workflow_run",
+			toolset:             "build",
+			descriptionKeywords: []string{"cancel", "workflow", "run"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "run_name"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"run_name":       testWorkflowRunName,
+			},
+			expectedMethod: "CancelWorkflowRun",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testWorkflowRunName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testWorkflowRunName, args[0], args[1])
+				}
+			},
+		},
+		{
+			name:                "retry_workflow_run",
+			toolset:             "build",
+			descriptionKeywords: []string{"retry", "workflow", "run"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "run_name"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"run_name":       testWorkflowRunName,
+			},
+			expectedMethod: "RetryWorkflowRun",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testWorkflowRunName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testWorkflowRunName, args[0], args[1])
+				}
+			},
+		},
+		{
+			name:                "resume_workflow_run",
+			toolset:             "build",
+			descriptionKeywords: []string{"resume", "workflow", "run"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "run_name"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"run_name":       testWorkflowRunName,
+			},
+			expectedMethod: "ResumeWorkflowRun",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testWorkflowRunName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testWorkflowRunName, args[0], args[1])
+				}
+			},
+		},
 	}
 }
 
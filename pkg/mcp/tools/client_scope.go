@@ -0,0 +1,323 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+const (
+	// methodReadResource is the MCP method name for reading a resource.
+	methodReadResource = "resources/read"
+	// methodSubscribe is the MCP method name for subscribing to a resource.
+	methodSubscribe = "resources/subscribe"
+)
+
+// OAuth scopes that narrow what an MCP client may do. A token that carries
+// none of them is not narrowed by its scopes. They only narrow calls made
+// through the MCP endpoint: the REST API ignores them and grants the same
+// token the full rights of its user.
+const (
+	// OAuthScopePrefix is the common prefix of the MCP client scopes.
+	OAuthScopePrefix = "openchoreo:mcp:"
+	// OAuthScopeReadOnly restricts the client to read-only tools.
+	OAuthScopeReadOnly = OAuthScopePrefix + "read"
+	// OAuthScopeToolPrefix followed by a tool name allows that tool.
+	OAuthScopeToolPrefix = OAuthScopePrefix + "tool:"
+	// OAuthScopeNamespacePrefix followed by a namespace allows that namespace.
+	OAuthScopeNamespacePrefix = OAuthScopePrefix + "namespace:"
+	// OAuthScopeProjectPrefix followed by namespace/project allows that project.
+	OAuthScopeProjectPrefix = OAuthScopePrefix + "project:"
+)
+
+// ErrOutsideClientScope is returned for a request the user may make but the
+// MCP client acting for them may not.
+var ErrOutsideClientScope = errors.New("outside the scope of the MCP client")
+
+// ClientScope narrows what an MCP client may do below the rights of the user
+// it acts for. Nil maps allow everything; a non-nil map allows only its keys.
+type ClientScope struct {
+	// ClientID is the OAuth client of the session's token, if known.
+	ClientID string
+	// ReadOnly hides and rejects every mutating tool.
+	ReadOnly bool
+	// Tools is the allowlist of tool names.
+	Tools map[string]bool
+	// Namespaces is the allowlist of namespaces.
+	Namespaces map[string]bool
+	// Projects is the allowlist of projects, keyed by ProjectScopeKey.
+	Projects map[string]bool
+}
+
+// ProjectScopeKey returns the key of a project in ClientScope.Projects.
+func ProjectScopeKey(namespaceName, projectName string) string {
+	return namespaceName + "/" + projectName
+}
+
+// ClientScopeFromOAuthScopes returns the restrictions expressed by the
+// openchoreo:mcp:* scopes of a token, or nil when it carries none.
+func ClientScopeFromOAuthScopes(scopes []string) *ClientScope {
+	var scope *ClientScope
+	get := func() *ClientScope {
+		if scope == nil {
+			scope = &ClientScope{}
+		}
+		return scope
+	}
+	add := func(m *map[string]bool, key string) {
+		if *m == nil {
+			*m = map[string]bool{}
+		}
+		(*m)[key] = true
+	}
+	for _, s := range scopes {
+		switch {
+		case s == OAuthScopeReadOnly:
+			get().ReadOnly = true
+		case strings.HasPrefix(s, OAuthScopeToolPrefix):
+			add(&get().Tools, strings.TrimPrefix(s, OAuthScopeToolPrefix))
+		case strings.HasPrefix(s, OAuthScopeNamespacePrefix):
+			add(&get().Namespaces, strings.TrimPrefix(s, OAuthScopeNamespacePrefix))
+		case strings.HasPrefix(s, OAuthScopeProjectPrefix):
+			add(&get().Projects, strings.TrimPrefix(s, OAuthScopeProjectPrefix))
+		}
+	}
+	return scope
+}
+
+// Intersect returns a scope that only allows what both scopes allow. Either
+// scope may be nil, which allows everything.
+func (s *ClientScope) Intersect(other *ClientScope) *ClientScope {
+	if s == nil {
+		return other
+	}
+	if other == nil {
+		return s
+	}
+	clientID := s.ClientID
+	if clientID == "" {
+		clientID = other.ClientID
+	}
+	return &ClientScope{
+		ClientID:   clientID,
+		ReadOnly:   s.ReadOnly || other.ReadOnly,
+		Tools:      intersectAllowlists(s.Tools, other.Tools),
+		Namespaces: intersectAllowlists(s.Namespaces, other.Namespaces),
+		Projects:   intersectAllowlists(s.Projects, other.Projects),
+	}
+}
+
+func intersectAllowlists(a, b map[string]bool) map[string]bool {
+	if a == nil {
+		return maps.Clone(b)
+	}
+	if b == nil {
+		return maps.Clone(a)
+	}
+	out := map[string]bool{}
+	for k := range a {
+		if b[k] {
+			out[k] = true
+		}
+	}
+	return out
+}
+
+// restricted reports whether the scope narrows anything.
+func (s *ClientScope) restricted() bool {
+	return s != nil && (s.ReadOnly || s.Tools != nil || s.Namespaces != nil || s.Projects != nil)
+}
+
+func (s *ClientScope) name() string {
+	if s.ClientID == "" {
+		return "the MCP client"
+	}
+	return fmt.Sprintf("MCP client %q", s.ClientID)
+}
+
+// checkTool reports whether the client may use the tool at all. Tools without
+// a permission entry count as mutating.
+func (s *ClientScope) checkTool(toolName string, perm ToolPermission, hasPerm bool) error {
+	if s.Tools != nil && !s.Tools[toolName] {
+		return fmt.Errorf("%w: tool %q is not allowed for %s", ErrOutsideClientScope, toolName, s.name())
+	}
+	if s.ReadOnly && (!hasPerm || perm.Mutating()) {
+		return fmt.Errorf("%w: %s is read-only and tool %q makes changes", ErrOutsideClientScope, s.name(), toolName)
+	}
+	return nil
+}
+
+// checkTarget reports whether the client may act on the resources under the
+// hierarchy. With a namespace or project allowlist every call must name an
+// allowed namespace, since reads without one (namespace lists, cluster-scoped
+// calls) would return resources of other namespaces. Under a project allowlist
+// changes and calls of project-owned tools must name an allowed project, since
+// a project-owned list without one would return resources of other projects;
+// other reads are allowed in the namespaces of the allowed projects.
+func (s *ClientScope) checkTarget(target authzcore.ResourceHierarchy, mutating, ownedByProject bool) error {
+	if s.Namespaces != nil {
+		switch {
+		case target.Namespace == "":
+			return fmt.Errorf("%w: %s may only access resources in namespaces %s",
+				ErrOutsideClientScope, s.name(), allowlist(s.Namespaces))
+		case !s.Namespaces[target.Namespace]:
+			return fmt.Errorf("%w: namespace %q is not allowed for %s", ErrOutsideClientScope, target.Namespace, s.name())
+		}
+	}
+	if s.Projects != nil {
+		switch {
+		case target.Namespace == "":
+			return fmt.Errorf("%w: %s may only access resources of projects %s",
+				ErrOutsideClientScope, s.name(), allowlist(s.Projects))
+		case target.Project != "":
+			if !s.Projects[ProjectScopeKey(target.Namespace, target.Project)] {
+				return fmt.Errorf("%w: project %q of namespace %q is not allowed for %s",
+					ErrOutsideClientScope, target.Project, target.Namespace, s.name())
+			}
+		case mutating:
+			return fmt.Errorf("%w: %s may only change resources of projects %s",
+				ErrOutsideClientScope, s.name(), allowlist(s.Projects))
+		case ownedByProject:
+			return fmt.Errorf("%w: %s may only access resources of projects %s; name one with project_name",
+				ErrOutsideClientScope, s.name(), allowlist(s.Projects))
+		case !s.allowsProjectNamespace(target.Namespace):
+			return fmt.Errorf("%w: namespace %q is not allowed for %s", ErrOutsideClientScope, target.Namespace, s.name())
+		}
+	}
+	return nil
+}
+
+// allowsProjectNamespace reports whether an allowed project lives in the namespace.
+func (s *ClientScope) allowsProjectNamespace(namespaceName string) bool {
+	for key := range s.Projects {
+		if strings.HasPrefix(key, namespaceName+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// actionScopes maps each action to the lowest hierarchy level it applies to.
+var actionScopes = func() map[string]authzcore.ActionScope {
+	out := map[string]authzcore.ActionScope{}
+	for _, action := range authzcore.AllActions() {
+		out[action.Name] = action.LowestScope
+	}
+	return out
+}()
+
+// projectOwned reports whether the tool acts on resources that belong to a
+// project, such as components or releases, rather than to a namespace.
+func projectOwned(perm ToolPermission) bool {
+	for _, action := range perm.Actions() {
+		switch actionScopes[action] {
+		case authzcore.ScopeProject, authzcore.ScopeComponent, authzcore.ScopeResource:
+			return true
+		}
+	}
+	return false
+}
+
+func allowlist(m map[string]bool) string {
+	return strings.Join(slices.Sorted(maps.Keys(m)), ", ")
+}
+
+// ComponentProjectResolver returns the project a component belongs to. It lets
+// a project allowlist apply to tools that only name the component.
+type ComponentProjectResolver func(ctx context.Context, namespaceName, componentName string) (string, error)
+
+// NewClientScopeMiddleware returns an MCP receiving middleware that enforces
+// the ClientScope of the session (see WithClientScope):
+//
+//   - tools/list hides the tools the client may not use.
+//   - tools/call rejects disallowed tools and calls outside the allowed
+//     namespaces and projects.
+//   - resources/read and resources/subscribe reject resources outside the
+//     allowed namespaces and projects.
+//
+// Sessions without a restricting scope are not affected. The scope only
+// narrows: the user's own permissions are enforced by the tool filter and the
+// service layer as usual. It does not apply outside the MCP endpoint.
+func NewClientScopeMiddleware(perms map[string]ToolPermission, resolveProject ComponentProjectResolver) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			scope := ClientScopeFromContext(ctx)
+			if !scope.restricted() {
+				return next(ctx, method, req)
+			}
+			switch method {
+			case methodListTools:
+				result, err := next(ctx, method, req)
+				if err != nil {
+					return result, err
+				}
+				if listResult, ok := result.(*mcp.ListToolsResult); ok && listResult != nil {
+					listResult.Tools = slices.DeleteFunc(listResult.Tools, func(tool *mcp.Tool) bool {
+						perm, hasPerm := perms[tool.Name]
+						return scope.checkTool(tool.Name, perm, hasPerm) != nil
+					})
+				}
+				return result, nil
+			case methodCallTool:
+				toolName := callToolName(req)
+				perm, hasPerm := perms[toolName]
+				if err := scope.checkTool(toolName, perm, hasPerm); err != nil {
+					return nil, err
+				}
+				target := callToolScope(req)
+				if callToolScopeArg(req) == ScopeCluster {
+					// Cluster-scoped calls name no namespace and are rejected under an allowlist
+					target = authzcore.ResourceHierarchy{}
+				}
+				if scope.Projects != nil && target.Project == "" && target.Component != "" &&
+					target.Namespace != "" && resolveProject != nil {
+					// An unresolved project leaves the call to the checks for calls without one
+					if project, err := resolveProject(ctx, target.Namespace, target.Component); err == nil {
+						target.Project = project
+					}
+				}
+				mutating := !hasPerm || perm.Mutating()
+				if err := scope.checkTarget(target, mutating, hasPerm && projectOwned(perm)); err != nil {
+					return nil, fmt.Errorf("cannot call tool %q: %w", toolName, err)
+				}
+				return next(ctx, method, req)
+			case methodReadResource, methodSubscribe:
+				// Resource URIs of project-owned resources always name the project
+				if err := scope.checkTarget(resourceRequestTarget(req), false, false); err != nil {
+					return nil, err
+				}
+				return next(ctx, method, req)
+			default:
+				return next(ctx, method, req)
+			}
+		}
+	}
+}
+
+// resourceRequestTarget returns the hierarchy of the resource addressed by a
+// resources/read or resources/subscribe request. Unparsable URIs yield an
+// empty hierarchy, which an allowlist rejects.
+func resourceRequestTarget(req mcp.Request) authzcore.ResourceHierarchy {
+	var uri string
+	switch p := req.GetParams().(type) {
+	case *mcp.ReadResourceParams:
+		uri = p.URI
+	case *mcp.SubscribeParams:
+		uri = p.URI
+	}
+	ref, err := ParseResourceURI(uri)
+	if err != nil {
+		return authzcore.ResourceHierarchy{}
+	}
+	return authzcore.ResourceHierarchy{Namespace: ref.Namespace, Project: ref.Project, Component: ref.Component}
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestClientScopeFromOAuthScopes(t *testing.T) {
+	if got := ClientScopeFromOAuthScopes([]string{"openid", "profile"}); got != nil {
+		t.Errorf("expected no scope without openchoreo:mcp:* scopes, got %+v", got)
+	}
+
+	got := ClientScopeFromOAuthScopes([]string{
+		"openid",
+		OAuthScopeReadOnly,
+		OAuthScopeToolPrefix + "list_projects",
+		OAuthScopeNamespacePrefix + "default",
+		OAuthScopeProjectPrefix + "default/shop",
+	})
+	want := &ClientScope{
+		ReadOnly:   true,
+		Tools:      map[string]bool{"list_projects": true},
+		Namespaces: map[string]bool{"default": true},
+		Projects:   map[string]bool{"default/shop": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ClientScopeFromOAuthScopes = %+v, want %+v", got, want)
+	}
+}
+
+func TestClientScopeIntersect(t *testing.T) {
+	registered := &ClientScope{
+		ClientID:   "agent",
+		Tools:      map[string]bool{"list_projects": true, "create_project": true},
+		Namespaces: map[string]bool{"default": true},
+	}
+	fromToken := &ClientScope{
+		ReadOnly: true,
+		Tools:    map[string]bool{"list_projects": true, "delete_project": true},
+	}
+
+	got := registered.Intersect(fromToken)
+	want := &ClientScope{
+		ClientID:   "agent",
+		ReadOnly:   true,
+		Tools:      map[string]bool{"list_projects": true},
+		Namespaces: map[string]bool{"default": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect = %+v, want %+v", got, want)
+	}
+
+	var unrestricted *ClientScope
+	if got := unrestricted.Intersect(fromToken); got != fromToken {
+		t.Errorf("nil.Intersect(s) = %+v, want s", got)
+	}
+	if got := registered.Intersect(nil); got != registered {
+		t.Errorf("s.Intersect(nil) = %+v, want s", got)
+	}
+}
+
+// setupClientScopeServer serves list/get/create project tools and the resource
+// templates behind the client scope middleware, for a session with the given scope.
+func setupClientScopeServer(
+	t *testing.T, scope *ClientScope, resolveProject ComponentProjectResolver,
+) (*mcp.ClientSession, *[]string) {
+	t.Helper()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	var called []string
+	record := func(name string) mcp.ToolHandlerFor[map[string]any, any] {
+		return func(context.Context, *mcp.CallToolRequest, map[string]any) (*mcp.CallToolResult, any, error) {
+			called = append(called, name)
+			return &mcp.CallToolResult{}, nil, nil
+		}
+	}
+	schema := createSchema(map[string]any{
+		"namespace_name": stringProperty("Namespace"),
+		"project_name":   stringProperty("Project"),
+		"component_name": stringProperty("Component"),
+		"scope":          stringProperty("Scope"),
+		"name":           stringProperty("Name"),
+	}, nil)
+	for _, name := range []string{
+		"list_projects", "list_components", "list_environments", "get_component", "create_project", "update_component",
+	} {
+		mcp.AddTool(server, &mcp.Tool{Name: name, InputSchema: schema}, record(name))
+	}
+	(&Toolsets{ResourceState: &fakeResourceState{}}).RegisterResources(server)
+
+	perms := map[string]ToolPermission{
+		"list_projects":     {ToolName: "list_projects", Action: "project:view"},
+		"list_components":   {ToolName: "list_components", Action: "component:view"},
+		"list_environments": {ToolName: "list_environments", Action: "environment:view"},
+		"get_component":     {ToolName: "get_component", Action: "component:view"},
+		"create_project":    {ToolName: "create_project", Action: "project:create"},
+		"update_component":  {ToolName: "update_component", Action: "component:update"},
+	}
+	server.AddReceivingMiddleware(NewClientScopeMiddleware(perms, resolveProject))
+
+	ctx := WithClientScope(context.Background(), scope)
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { clientSession.Close() })
+	return clientSession, &called
+}
+
+func listedTools(t *testing.T, session *mcp.ClientSession) map[string]bool {
+	t.Helper()
+	result, err := session.ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListTools: %v", err)
+	}
+	found := map[string]bool{}
+	for _, tool := range result.Tools {
+		found[tool.Name] = true
+	}
+	return found
+}
+
+func TestClientScopeMiddlewareListTools(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope *ClientScope
+		want  map[string]bool
+	}{
+		{
+			name:  "no scope",
+			scope: nil,
+			want: map[string]bool{
+				"list_projects": true, "list_components": true, "list_environments": true,
+				"get_component": true, "create_project": true, "update_component": true,
+			},
+		},
+		{
+			name:  "read-only",
+			scope: &ClientScope{ClientID: "agent", ReadOnly: true},
+			want: map[string]bool{
+				"list_projects": true, "list_components": true, "list_environments": true, "get_component": true,
+			},
+		},
+		{
+			name:  "tool allowlist",
+			scope: &ClientScope{Tools: map[string]bool{"list_projects": true, "create_project": true}},
+			want:  map[string]bool{"list_projects": true, "create_project": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, _ := setupClientScopeServer(t, tt.scope, nil)
+			if got := listedTools(t, session); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("listed tools = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientScopeMiddlewareCallTool(t *testing.T) {
+	resolveProject := func(_ context.Context, namespaceName, componentName string) (string, error) {
+		if namespaceName == "default" && componentName == "api" {
+			return "shop", nil
+		}
+		return "", errors.New("component not found")
+	}
+	tests := []struct {
+		name    string
+		scope   *ClientScope
+		tool    string
+		args    map[string]any
+		allowed bool
+	}{
+		{
+			name:    "read-only allows reads",
+			scope:   &ClientScope{ReadOnly: true},
+			tool:    "list_projects",
+			args:    map[string]any{"namespace_name": "default"},
+			allowed: true,
+		},
+		{
+			name:  "read-only rejects changes",
+			scope: &ClientScope{ReadOnly: true},
+			tool:  "create_project",
+			args:  map[string]any{"namespace_name": "default", "name": "shop"},
+		},
+		{
+			name:  "tool outside the allowlist",
+			scope: &ClientScope{Tools: map[string]bool{"list_projects": true}},
+			tool:  "get_component",
+			args:  map[string]any{"namespace_name": "default", "component_name": "api"},
+		},
+		{
+			name:    "allowed namespace",
+			scope:   &ClientScope{Namespaces: map[string]bool{"default": true}},
+			tool:    "create_project",
+			args:    map[string]any{"namespace_name": "default", "name": "shop"},
+			allowed: true,
+		},
+		{
+			name:  "other namespace",
+			scope: &ClientScope{Namespaces: map[string]bool{"default": true}},
+			tool:  "list_projects",
+			args:  map[string]any{"namespace_name": "other"},
+		},
+		{
+			name:  "change without a namespace",
+			scope: &ClientScope{Namespaces: map[string]bool{"default": true}},
+			tool:  "create_project",
+			args:  map[string]any{"name": "shop"},
+		},
+		{
+			name:  "read without a namespace",
+			scope: &ClientScope{Namespaces: map[string]bool{"default": true}},
+			tool:  "list_projects",
+			args:  map[string]any{},
+		},
+		{
+			name:  "cluster-scoped read",
+			scope: &ClientScope{Namespaces: map[string]bool{"default": true}},
+			tool:  "list_projects",
+			args:  map[string]any{"namespace_name": "default", "scope": ScopeCluster},
+		},
+		{
+			name:    "allowed project",
+			scope:   &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:    "update_component",
+			args:    map[string]any{"namespace_name": "default", "project_name": "shop", "component_name": "api"},
+			allowed: true,
+		},
+		{
+			name:    "project resolved from the component",
+			scope:   &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:    "update_component",
+			args:    map[string]any{"namespace_name": "default", "component_name": "api"},
+			allowed: true,
+		},
+		{
+			name:  "component of another project",
+			scope: &ClientScope{Projects: map[string]bool{"default/billing": true}},
+			tool:  "update_component",
+			args:  map[string]any{"namespace_name": "default", "component_name": "api"},
+		},
+		{
+			name:  "change outside any project",
+			scope: &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:  "create_project",
+			args:  map[string]any{"namespace_name": "default", "name": "new"},
+		},
+		{
+			name:    "namespace-level read in the namespace of an allowed project",
+			scope:   &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:    "list_environments",
+			args:    map[string]any{"namespace_name": "default"},
+			allowed: true,
+		},
+		{
+			name:    "project-owned read in an allowed project",
+			scope:   &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:    "list_components",
+			args:    map[string]any{"namespace_name": "default", "project_name": "shop"},
+			allowed: true,
+		},
+		{
+			name:  "project-owned read without a project",
+			scope: &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:  "list_components",
+			args:  map[string]any{"namespace_name": "default"},
+		},
+		{
+			name:  "project list of a project allowlist",
+			scope: &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:  "list_projects",
+			args:  map[string]any{"namespace_name": "default"},
+		},
+		{
+			name:  "read without a namespace of a project allowlist",
+			scope: &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:  "list_projects",
+			args:  map[string]any{},
+		},
+		{
+			name:  "read in another namespace",
+			scope: &ClientScope{Projects: map[string]bool{"default/shop": true}},
+			tool:  "list_projects",
+			args:  map[string]any{"namespace_name": "other"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, called := setupClientScopeServer(t, tt.scope, resolveProject)
+			_, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: tt.tool, Arguments: tt.args})
+			if tt.allowed {
+				if err != nil {
+					t.Fatalf("CallTool: %v", err)
+				}
+				if len(*called) != 1 {
+					t.Errorf("expected the tool to be called, got %v", *called)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected the call to be rejected")
+			}
+			if len(*called) != 0 {
+				t.Errorf("expected no calls, got %v", *called)
+			}
+		})
+	}
+}
+
+func TestClientScopeMiddlewareReadResource(t *testing.T) {
+	session, _ := setupClientScopeServer(t, &ClientScope{Projects: map[string]bool{"default/shop": true}}, nil)
+	ctx := context.Background()
+
+	if _, err := session.ReadResource(ctx, &mcp.ReadResourceParams{
+		URI: ComponentResourceURI("default", "shop", "api"),
+	}); err != nil {
+		t.Errorf("ReadResource in an allowed project: %v", err)
+	}
+	if _, err := session.ReadResource(ctx, &mcp.ReadResourceParams{
+		URI: ProjectResourceURI("default", "billing"),
+	}); err == nil {
+		t.Error("expected reading another project to be rejected")
+	}
+	if _, err := session.ReadResource(ctx, &mcp.ReadResourceParams{
+		URI: EnvironmentResourceURI("other", "dev"),
+	}); err == nil {
+		t.Error("expected reading another namespace to be rejected")
+	}
+}
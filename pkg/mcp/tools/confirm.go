@@ -0,0 +1,336 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Arguments of the change-confirmation protocol accepted by every mutating tool.
+// They are consumed by the confirmation middleware and never reach the tool handler.
+const (
+	// ArgDryRun makes a mutating tool return the planned change without applying it.
+	ArgDryRun = "dry_run"
+	// ArgConfirmationToken carries the token of an approved plan.
+	ArgConfirmationToken = "confirmation_token"
+)
+
+// Plan statuses returned instead of executing a mutating tool
+const (
+	PlanStatusDryRun               = "dry_run"
+	PlanStatusConfirmationRequired = "confirmation_required"
+)
+
+const (
+	// confirmationTokenTTL is how long a plan can be confirmed after it was issued
+	confirmationTokenTTL = 10 * time.Minute
+	// confirmationMetaKey marks mutating tools in tools/list
+	confirmationMetaKey = deprecatedMetaPrefix + "confirmation"
+)
+
+var (
+	errInvalidConfirmationToken = errors.New("invalid confirmation token")
+	errExpiredConfirmationToken = errors.New("confirmation token has expired")
+	errUsedConfirmationToken    = errors.New("confirmation token has already been used")
+)
+
+// Confirmer issues and redeems confirmation tokens. A token is bound to the tool
+// and the exact arguments of the plan it was issued for, expires after
+// confirmationTokenTTL and can be redeemed once. Tokens are signed with a key
+// generated at startup, so they are only valid on the server that issued them.
+type Confirmer struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+
+	mu   sync.Mutex
+	used map[string]time.Time // redeemed tokens and their expiry
+}
+
+// NewConfirmer creates a Confirmer with a random signing key
+func NewConfirmer() (*Confirmer, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate confirmation key: %w", err)
+	}
+	return &Confirmer{
+		key:  key,
+		ttl:  confirmationTokenTTL,
+		now:  time.Now,
+		used: make(map[string]time.Time),
+	}, nil
+}
+
+// issue returns a token confirming a call of the tool with the given arguments
+func (c *Confirmer) issue(toolName string, args map[string]any) (string, time.Time, error) {
+	expiresAt := c.now().Add(c.ttl).Truncate(time.Second)
+	mac, err := c.sign(toolName, args, expiresAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	token := make([]byte, 8, 8+len(mac))
+	binary.BigEndian.PutUint64(token, uint64(expiresAt.Unix()))
+	token = append(token, mac...)
+	return base64.RawURLEncoding.EncodeToString(token), expiresAt, nil
+}
+
+// redeem checks that the token was issued for the tool and arguments, has not
+// expired and has not been used before, and marks it as used.
+func (c *Confirmer) redeem(token, toolName string, args map[string]any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != 8+sha256.Size {
+		return errInvalidConfirmationToken
+	}
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(raw[:8])), 0)
+	mac, err := c.sign(toolName, args, expiresAt)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(raw[8:], mac) {
+		return errInvalidConfirmationToken
+	}
+
+	now := c.now()
+	if !now.Before(expiresAt) {
+		return errExpiredConfirmationToken
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for t, exp := range c.used {
+		if !now.Before(exp) {
+			delete(c.used, t)
+		}
+	}
+	if _, ok := c.used[token]; ok {
+		return errUsedConfirmationToken
+	}
+	c.used[token] = expiresAt
+	return nil
+}
+
+func (c *Confirmer) sign(toolName string, args map[string]any, expiresAt time.Time) ([]byte, error) {
+	// Maps are marshaled with sorted keys, so equal arguments always sign the same
+	canonical, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize arguments: %w", err)
+	}
+	h := hmac.New(sha256.New, c.key)
+	h.Write([]byte(toolName))
+	h.Write([]byte{0})
+	h.Write(canonical)
+	h.Write([]byte{0})
+	_ = binary.Write(h, binary.BigEndian, expiresAt.Unix())
+	return h.Sum(nil), nil
+}
+
+// Mutating reports whether the tool changes state, that is, whether any of its
+// actions is not a view action.
+func (p ToolPermission) Mutating() bool {
+	for _, action := range p.Actions() {
+		if !strings.HasSuffix(action, ":view") {
+			return true
+		}
+	}
+	return false
+}
+
+// NewConfirmationMiddleware returns an MCP receiving middleware that implements
+// the change-confirmation protocol for mutating tools:
+//
+//   - tools/list advertises the dry_run and confirmation_token arguments on
+//     every mutating tool and marks it with a structured _meta entry.
+//   - A tools/call with dry_run=true returns the planned change together with a
+//     confirmation token, without calling the tool.
+//   - When the session requires confirmation (?confirmMutations=true, or
+//     requireByDefault when the session did not choose), a call without a token
+//     returns the plan instead of applying it. The agent shows the plan to a
+//     human and, once approved, repeats the call with the same arguments and the
+//     confirmation_token.
+//   - A call carrying a confirmation_token is only applied when the token is
+//     valid for the tool and arguments.
+//
+// Read-only tools are not affected.
+func NewConfirmationMiddleware(
+	perms map[string]ToolPermission, confirmer *Confirmer, requireByDefault bool,
+) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			switch method {
+			case methodListTools:
+				result, err := next(ctx, method, req)
+				if err != nil {
+					return result, err
+				}
+				if listResult, ok := result.(*mcp.ListToolsResult); ok && listResult != nil {
+					for i, tool := range listResult.Tools {
+						if perm, ok := perms[tool.Name]; ok && perm.Mutating() {
+							listResult.Tools[i] = withConfirmationArguments(tool)
+						}
+					}
+				}
+				return result, nil
+			case methodCallTool:
+				return confirmCallTool(ctx, next, method, req, perms, confirmer, requireByDefault)
+			default:
+				return next(ctx, method, req)
+			}
+		}
+	}
+}
+
+func confirmCallTool(
+	ctx context.Context,
+	next mcp.MethodHandler,
+	method string,
+	req mcp.Request,
+	perms map[string]ToolPermission,
+	confirmer *Confirmer,
+	requireByDefault bool,
+) (mcp.Result, error) {
+	params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+	if !ok || params == nil {
+		return next(ctx, method, req)
+	}
+	perm, ok := perms[params.Name]
+	if !ok || !perm.Mutating() {
+		return next(ctx, method, req)
+	}
+
+	args := map[string]any{}
+	if len(params.Arguments) > 0 {
+		if err := json.Unmarshal(params.Arguments, &args); err != nil {
+			// Leave malformed arguments to the tool's own validation
+			return next(ctx, method, req)
+		}
+	}
+	dryRun, _ := args[ArgDryRun].(bool)
+	token, _ := args[ArgConfirmationToken].(string)
+	_, hasDryRun := args[ArgDryRun]
+	_, hasToken := args[ArgConfirmationToken]
+	if hasDryRun || hasToken {
+		delete(args, ArgDryRun)
+		delete(args, ArgConfirmationToken)
+		raw, err := json.Marshal(args)
+		if err != nil {
+			return nil, err
+		}
+		params.Arguments = raw
+	}
+
+	required := requireByDefault
+	if v, set := ConfirmMutationsFromContext(ctx); set {
+		required = v
+	}
+
+	switch {
+	case dryRun:
+		return planResult(PlanStatusDryRun, params.Name, perm, req, args, confirmer)
+	case token != "":
+		if err := confirmer.redeem(token, params.Name, args); err != nil {
+			return nil, fmt.Errorf("cannot apply %q: %w; call it with %s=true to get a new plan", params.Name, err, ArgDryRun)
+		}
+		return next(ctx, method, req)
+	case required:
+		return planResult(PlanStatusConfirmationRequired, params.Name, perm, req, args, confirmer)
+	default:
+		return next(ctx, method, req)
+	}
+}
+
+// planResult describes the change a mutating tool call would make, with the
+// token that confirms it.
+func planResult(
+	status, toolName string, perm ToolPermission, req mcp.Request, args map[string]any, confirmer *Confirmer,
+) (*mcp.CallToolResult, error) {
+	token, expiresAt, err := confirmer.issue(toolName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := callToolScopeArg(req)
+	plan := map[string]any{
+		"status":             status,
+		"tool":               toolName,
+		"action":             perm.ActionForScope(scope),
+		"arguments":          args,
+		"confirmation_token": token,
+		"expires_at":         expiresAt.UTC().Format(time.RFC3339),
+		"message": fmt.Sprintf("No changes were made. Show this plan to the user; once they approve it, call %s "+
+			"again with the same arguments and %s set to the returned token.", toolName, ArgConfirmationToken),
+	}
+	target := map[string]any{}
+	hierarchy := callToolScope(req)
+	setIfSet := func(key, value string) {
+		if value != "" {
+			target[key] = value
+		}
+	}
+	setIfSet("scope", scope)
+	setIfSet("namespace", hierarchy.Namespace)
+	setIfSet("project", hierarchy.Project)
+	setIfSet("component", hierarchy.Component)
+	setIfSet("resource", hierarchy.Resource)
+	if len(target) > 0 {
+		plan["target"] = target
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+	return &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: string(data)}},
+		StructuredContent: plan,
+	}, nil
+}
+
+// withConfirmationArguments returns a copy of the tool whose input schema
+// declares the confirmation arguments. The registered tool is left unchanged.
+func withConfirmationArguments(tool *mcp.Tool) *mcp.Tool {
+	out := *tool
+	out.Meta = maps.Clone(tool.Meta)
+	if out.Meta == nil {
+		out.Meta = mcp.Meta{}
+	}
+	out.Meta[confirmationMetaKey] = map[string]any{
+		"dry_run_argument": ArgDryRun,
+		"token_argument":   ArgConfirmationToken,
+	}
+
+	schema, ok := tool.InputSchema.(map[string]any)
+	if !ok {
+		return &out
+	}
+	properties, _ := schema["properties"].(map[string]any)
+	properties = maps.Clone(properties)
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	properties[ArgDryRun] = map[string]any{
+		"type": "boolean",
+		"description": "Return the planned change and a confirmation token without applying it. " +
+			"Show the plan to the user before confirming.",
+	}
+	properties[ArgConfirmationToken] = stringProperty(
+		"Token from a previous plan for the same arguments; applies the change once the user has approved it.")
+	schema = maps.Clone(schema)
+	schema["properties"] = properties
+	out.InputSchema = schema
+	return &out
+}
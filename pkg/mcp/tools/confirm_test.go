@@ -0,0 +1,350 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func newTestConfirmer(t *testing.T, now *time.Time) *Confirmer {
+	t.Helper()
+	c, err := NewConfirmer()
+	if err != nil {
+		t.Fatalf("NewConfirmer: %v", err)
+	}
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func TestConfirmerRedeem(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := newTestConfirmer(t, &now)
+	args := map[string]any{"namespace_name": "ns", "name": "proj"}
+
+	token, expiresAt, err := c.issue("create_project", args)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if want := now.Add(confirmationTokenTTL); !expiresAt.Equal(want) {
+		t.Errorf("expiresAt = %v, want %v", expiresAt, want)
+	}
+
+	other := map[string]any{"namespace_name": "ns", "name": "other"}
+	if err := c.redeem(token, "create_project", other); !errors.Is(err, errInvalidConfirmationToken) {
+		t.Errorf("redeem with different arguments: got %v, want %v", err, errInvalidConfirmationToken)
+	}
+	if err := c.redeem(token, "delete_project", args); !errors.Is(err, errInvalidConfirmationToken) {
+		t.Errorf("redeem for a different tool: got %v, want %v", err, errInvalidConfirmationToken)
+	}
+	if err := c.redeem("not-a-token", "create_project", args); !errors.Is(err, errInvalidConfirmationToken) {
+		t.Errorf("redeem malformed token: got %v, want %v", err, errInvalidConfirmationToken)
+	}
+
+	if err := c.redeem(token, "create_project", args); err != nil {
+		t.Fatalf("redeem: %v", err)
+	}
+	if err := c.redeem(token, "create_project", args); !errors.Is(err, errUsedConfirmationToken) {
+		t.Errorf("second redeem: got %v, want %v", err, errUsedConfirmationToken)
+	}
+}
+
+func TestConfirmerRedeemExpired(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := newTestConfirmer(t, &now)
+	args := map[string]any{"name": "proj"}
+
+	token, _, err := c.issue("create_project", args)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	now = now.Add(confirmationTokenTTL)
+	if err := c.redeem(token, "create_project", args); !errors.Is(err, errExpiredConfirmationToken) {
+		t.Errorf("redeem expired token: got %v, want %v", err, errExpiredConfirmationToken)
+	}
+}
+
+func TestConfirmerTokensFromAnotherServerAreInvalid(t *testing.T) {
+	now := time.Now()
+	issuer := newTestConfirmer(t, &now)
+	other := newTestConfirmer(t, &now)
+	args := map[string]any{"name": "proj"}
+
+	token, _, err := issuer.issue("create_project", args)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if err := other.redeem(token, "create_project", args); !errors.Is(err, errInvalidConfirmationToken) {
+		t.Errorf("redeem on another server: got %v, want %v", err, errInvalidConfirmationToken)
+	}
+}
+
+func TestToolPermissionMutating(t *testing.T) {
+	tests := []struct {
+		perm ToolPermission
+		want bool
+	}{
+		{ToolPermission{ToolName: "list_projects", Action: "project:view"}, false},
+		{ToolPermission{ToolName: "create_project", Action: "project:create"}, true},
+		{ToolPermission{ToolName: "get_trait", ScopedActions: map[string]string{
+			ScopeNamespace: "trait:view", ScopeCluster: "clustertrait:view",
+		}}, false},
+		{ToolPermission{ToolName: "delete_trait", ScopedActions: map[string]string{
+			ScopeNamespace: "trait:delete", ScopeCluster: "clustertrait:delete",
+		}}, true},
+		{ToolPermission{ToolName: "apply_resource"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.perm.Mutating(); got != tt.want {
+			t.Errorf("%s: Mutating() = %v, want %v", tt.perm.ToolName, got, tt.want)
+		}
+	}
+}
+
+type projectArgs struct {
+	NamespaceName string `json:"namespace_name"`
+	Name          string `json:"name"`
+}
+
+// setupConfirmationServer serves a read-only list_projects and a mutating
+// create_project tool behind the confirmation middleware. The returned slice
+// records the arguments each create_project call reached the handler with.
+func setupConfirmationServer(
+	ctx context.Context, t *testing.T, requireByDefault bool,
+) (*mcp.ClientSession, *[]projectArgs) {
+	t.Helper()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "list_projects"}, nopToolHandler)
+	var calls []projectArgs
+	// Registered tools declare their input schema as a map, like createSchema builds
+	createProject := &mcp.Tool{Name: "create_project", InputSchema: createSchema(map[string]any{
+		"namespace_name": stringProperty("Namespace"),
+		"name":           stringProperty("Project name"),
+	}, []string{"namespace_name", "name"})}
+	mcp.AddTool(server, createProject, func(
+		ctx context.Context, req *mcp.CallToolRequest, args projectArgs,
+	) (*mcp.CallToolResult, any, error) {
+		calls = append(calls, args)
+		return &mcp.CallToolResult{}, nil, nil
+	})
+
+	perms := map[string]ToolPermission{
+		"list_projects":  {ToolName: "list_projects", Action: "project:view"},
+		"create_project": {ToolName: "create_project", Action: "project:create"},
+	}
+	confirmer, err := NewConfirmer()
+	if err != nil {
+		t.Fatalf("NewConfirmer: %v", err)
+	}
+	server.AddReceivingMiddleware(NewConfirmationMiddleware(perms, confirmer, requireByDefault))
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { clientSession.Close() })
+	return clientSession, &calls
+}
+
+func decodePlan(t *testing.T, result *mcp.CallToolResult) map[string]any {
+	t.Helper()
+	if len(result.Content) != 1 {
+		t.Fatalf("expected 1 content item, got %d", len(result.Content))
+	}
+	text, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		t.Fatalf("expected text content, got %T", result.Content[0])
+	}
+	var plan map[string]any
+	if err := json.Unmarshal([]byte(text.Text), &plan); err != nil {
+		t.Fatalf("plan is not JSON: %v", err)
+	}
+	return plan
+}
+
+func TestConfirmationMiddlewareListTools(t *testing.T) {
+	ctx := context.Background()
+	session, _ := setupConfirmationServer(ctx, t, false)
+
+	result, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTools: %v", err)
+	}
+	for _, tool := range result.Tools {
+		props := toolProperties(t, tool)
+		_, hasDryRun := props[ArgDryRun]
+		_, hasToken := props[ArgConfirmationToken]
+		_, hasMeta := tool.Meta[confirmationMetaKey]
+		mutating := tool.Name == "create_project"
+		if hasDryRun != mutating || hasToken != mutating || hasMeta != mutating {
+			t.Errorf("%s: dry_run=%v confirmation_token=%v meta=%v, want all %v",
+				tool.Name, hasDryRun, hasToken, hasMeta, mutating)
+		}
+	}
+
+	// Listing again must not stack the arguments on the registered tool
+	again, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTools: %v", err)
+	}
+	if len(again.Tools) != len(result.Tools) {
+		t.Errorf("expected %d tools, got %d", len(result.Tools), len(again.Tools))
+	}
+}
+
+func toolProperties(t *testing.T, tool *mcp.Tool) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(tool.InputSchema)
+	if err != nil {
+		t.Fatalf("marshal schema: %v", err)
+	}
+	var schema struct {
+		Properties map[string]any `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("unmarshal schema: %v", err)
+	}
+	return schema.Properties
+}
+
+func TestConfirmationMiddlewareDryRun(t *testing.T) {
+	ctx := context.Background()
+	session, calls := setupConfirmationServer(ctx, t, false)
+
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "create_project",
+		Arguments: map[string]any{"namespace_name": "ns", "name": "proj", ArgDryRun: true},
+	})
+	if err != nil {
+		t.Fatalf("CallTool: %v", err)
+	}
+	if len(*calls) != 0 {
+		t.Fatalf("dry run must not call the tool, got %d calls", len(*calls))
+	}
+	plan := decodePlan(t, result)
+	if plan["status"] != PlanStatusDryRun {
+		t.Errorf("status = %v, want %q", plan["status"], PlanStatusDryRun)
+	}
+	if plan["action"] != "project:create" {
+		t.Errorf("action = %v, want project:create", plan["action"])
+	}
+	target, _ := plan["target"].(map[string]any)
+	if target["namespace"] != "ns" {
+		t.Errorf("target namespace = %v, want ns", target["namespace"])
+	}
+	planArgs, _ := plan["arguments"].(map[string]any)
+	if _, ok := planArgs[ArgDryRun]; ok {
+		t.Error("plan arguments must not include dry_run")
+	}
+	token, _ := plan["confirmation_token"].(string)
+	if token == "" {
+		t.Fatal("expected a confirmation token")
+	}
+
+	// Confirming applies the change with the confirmation arguments stripped
+	if _, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "create_project",
+		Arguments: map[string]any{"namespace_name": "ns", "name": "proj", ArgConfirmationToken: token},
+	}); err != nil {
+		t.Fatalf("CallTool with token: %v", err)
+	}
+	if len(*calls) != 1 || (*calls)[0] != (projectArgs{NamespaceName: "ns", Name: "proj"}) {
+		t.Fatalf("expected one call with the planned arguments, got %+v", *calls)
+	}
+
+	// The token cannot be replayed
+	result, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "create_project",
+		Arguments: map[string]any{"namespace_name": "ns", "name": "proj", ArgConfirmationToken: token},
+	})
+	if err == nil && !result.IsError {
+		t.Error("expected replayed token to be rejected")
+	}
+	if len(*calls) != 1 {
+		t.Errorf("replayed token must not call the tool, got %d calls", len(*calls))
+	}
+}
+
+func TestConfirmationMiddlewareTokenForOtherArguments(t *testing.T) {
+	ctx := context.Background()
+	session, calls := setupConfirmationServer(ctx, t, false)
+
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "create_project",
+		Arguments: map[string]any{"namespace_name": "ns", "name": "proj", ArgDryRun: true},
+	})
+	if err != nil {
+		t.Fatalf("CallTool: %v", err)
+	}
+	token, _ := decodePlan(t, result)["confirmation_token"].(string)
+
+	result, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "create_project",
+		Arguments: map[string]any{"namespace_name": "ns", "name": "other", ArgConfirmationToken: token},
+	})
+	if err == nil && !result.IsError {
+		t.Error("expected token for other arguments to be rejected")
+	}
+	if len(*calls) != 0 {
+		t.Errorf("expected no calls, got %d", len(*calls))
+	}
+}
+
+func TestConfirmationMiddlewareRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		requireByDefault bool
+		session          *bool
+		wantPlan         bool
+	}{
+		{name: "not required", wantPlan: false},
+		{name: "required by default", requireByDefault: true, wantPlan: true},
+		{name: "required by session", session: ptr(true), wantPlan: true},
+		{name: "session opts out of default", requireByDefault: true, session: ptr(false), wantPlan: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.session != nil {
+				ctx = WithConfirmMutations(ctx, *tt.session)
+			}
+			session, calls := setupConfirmationServer(ctx, t, tt.requireByDefault)
+
+			result, err := session.CallTool(ctx, &mcp.CallToolParams{
+				Name:      "create_project",
+				Arguments: map[string]any{"namespace_name": "ns", "name": "proj"},
+			})
+			if err != nil {
+				t.Fatalf("CallTool: %v", err)
+			}
+			if tt.wantPlan {
+				if len(*calls) != 0 {
+					t.Errorf("expected no calls, got %d", len(*calls))
+				}
+				if status := decodePlan(t, result)["status"]; status != PlanStatusConfirmationRequired {
+					t.Errorf("status = %v, want %q", status, PlanStatusConfirmationRequired)
+				}
+			} else if len(*calls) != 1 {
+				t.Errorf("expected the tool to be called once, got %d", len(*calls))
+			}
+
+			// Read-only tools are never held back
+			if _, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "list_projects"}); err != nil {
+				t.Errorf("CallTool list_projects: %v", err)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -6,6 +6,7 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -21,6 +22,10 @@ const (
 	methodListTools = "tools/list"
 )
 
+// ErrToolNotAuthorized is returned for a tools/call the user lacks the
+// permission for.
+var ErrToolNotAuthorized = errors.New("not authorized")
+
 // NewToolFilterMiddleware returns an MCP receiving middleware that filters
 // tools/list and tools/call results along two independent axes:
 //
@@ -152,7 +157,7 @@ func filterCallTool(
 
 	subjectCtx, _ := auth.GetSubjectContextFromContext(ctx)
 	if subjectCtx == nil {
-		return nil, fmt.Errorf("not authorized to call tool %q: no authenticated user", toolName)
+		return nil, fmt.Errorf("%w to call tool %q: no authenticated user", ErrToolNotAuthorized, toolName)
 	}
 
 	requiredAction := perm.ActionForScope(callToolScopeArg(req))
@@ -167,11 +172,11 @@ func filterCallTool(
 		Scope:          callToolScope(req),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("not authorized to call tool %q: could not evaluate permissions", toolName)
+		return nil, fmt.Errorf("%w to call tool %q: could not evaluate permissions", ErrToolNotAuthorized, toolName)
 	}
 
 	if !hasActionCapability(requiredAction, profile) {
-		return nil, fmt.Errorf("not authorized to call tool %q: missing permission %q", toolName, requiredAction)
+		return nil, fmt.Errorf("%w to call tool %q: missing permission %q", ErrToolNotAuthorized, toolName, requiredAction)
 	}
 
 	return next(ctx, method, req)
@@ -348,6 +348,21 @@ func (m *MockCoreToolsetHandler) GetWorkflowRunEvents(
 	return `{"events":[]}`, nil
 }
 
+func (m *MockCoreToolsetHandler) CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error) {
+	m.recordCall("CancelWorkflowRun", namespaceName, runName)
+	return `{"name":"workflow-run-1","action":"cancelled"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error) {
+	m.recordCall("RetryWorkflowRun", namespaceName, runName)
+	return `{"name":"workflow-run-2","action":"created"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error) {
+	m.recordCall("ResumeWorkflowRun", namespaceName, runName)
+	return `{"name":"workflow-run-1","action":"resumed"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) ListClusterComponentTypes(ctx context.Context, opts ListOpts) (any, error) {
 	m.recordCall("ListClusterComponentTypes", opts)
 	return `[{"name":"go-service"}]`, nil
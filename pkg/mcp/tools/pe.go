@@ -725,6 +725,66 @@ func (t *Toolsets) RegisterGetWorkflowRunEvents(s *mcp.Server, perms map[string]
 	})
 }
 
+func (t *Toolsets) RegisterCancelWorkflowRun(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "cancel_workflow_run"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateWorkflowRun}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Cancel a running workflow run. Running tasks are terminated and the run completes " +
+			"with the Cancelled status. Completed runs cannot be cancelled.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"run_name":       stringProperty("Use list_workflow_runs to discover valid names"),
+		}, []string{"namespace_name", "run_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		RunName       string `json:"run_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.BuildToolset.CancelWorkflowRun(ctx, args.NamespaceName, args.RunName)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRetryWorkflowRun(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "retry_workflow_run"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateWorkflowRun}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Retry a completed workflow run by starting a new workflow run with the same workflow " +
+			"and parameters. Returns the name of the new run.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"run_name":       stringProperty("Use list_workflow_runs to discover valid names"),
+		}, []string{"namespace_name", "run_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		RunName       string `json:"run_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.BuildToolset.RetryWorkflowRun(ctx, args.NamespaceName, args.RunName)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterResumeWorkflowRun(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "resume_workflow_run"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateWorkflowRun}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Resume a failed workflow run from its failed tasks. Tasks that already succeeded are kept " +
+			"and only the failed tasks are re-executed. Supported for runs executed by Argo Workflows.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"run_name":       stringProperty("Use list_workflow_runs to discover valid names"),
+		}, []string{"namespace_name", "run_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		RunName       string `json:"run_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.BuildToolset.ResumeWorkflowRun(ctx, args.NamespaceName, args.RunName)
+		return handleToolResult(result, err)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // PE Toolset — Deployment pipeline write operations
 // ---------------------------------------------------------------------------
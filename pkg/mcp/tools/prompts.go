@@ -0,0 +1,140 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Names of the curated MCP prompts
+const (
+	PromptPromoteComponent          = "promote_component"
+	PromptDiagnoseFailingDeployment = "diagnose_failing_deployment"
+)
+
+// RegisterPrompts registers curated prompts for common workflows. Prompts only
+// reference tools of enabled toolsets, so they are registered when the
+// toolsets they rely on are enabled.
+func (t *Toolsets) RegisterPrompts(s *mcp.Server) {
+	if t.DeploymentToolset == nil {
+		return
+	}
+
+	s.AddPrompt(&mcp.Prompt{
+		Name:  PromptPromoteComponent,
+		Title: "Promote component",
+		Description: "Promote the release of a component deployed in one environment to the next " +
+			"environment of its deployment pipeline.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "namespace_name", Description: "Namespace of the component", Required: true},
+			{Name: "project_name", Description: "Project of the component", Required: true},
+			{Name: "component_name", Description: "Component to promote", Required: true},
+			{Name: "source_environment", Description: "Environment to promote from", Required: true},
+			{
+				Name:        "target_environment",
+				Description: "Environment to promote to; defaults to the next environment of the pipeline",
+			},
+		},
+	}, t.promoteComponentPrompt)
+
+	s.AddPrompt(&mcp.Prompt{
+		Name:        PromptDiagnoseFailingDeployment,
+		Title:       "Diagnose failing deployment",
+		Description: "Find out why a component is not running correctly in an environment and suggest a fix.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "namespace_name", Description: "Namespace of the component", Required: true},
+			{Name: "project_name", Description: "Project of the component", Required: true},
+			{Name: "component_name", Description: "Component that is failing", Required: true},
+			{Name: "environment", Description: "Environment in which the deployment is failing", Required: true},
+		},
+	}, t.diagnoseFailingDeploymentPrompt)
+}
+
+func (t *Toolsets) promoteComponentPrompt(_ context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	err := requirePromptArguments(args, "namespace_name", "project_name", "component_name", "source_environment")
+	if err != nil {
+		return nil, err
+	}
+	ns, project, component := args["namespace_name"], args["project_name"], args["component_name"]
+	source := args["source_environment"]
+	target := args["target_environment"]
+	if target == "" {
+		target = "the environment that follows " + source + " in the deployment pipeline"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Promote component %q of project %q in namespace %q from %s to %s.\n\n",
+		component, project, ns, source, target)
+	fmt.Fprintf(&b, "The current state of the component, including its release binding in each environment, "+
+		"is available as the resource %s.\n\n", ComponentResourceURI(ns, project, component))
+	b.WriteString("Follow these steps:\n")
+	fmt.Fprintf(&b, "1. Call get_deployment_pipeline for the project's pipeline and check that %s is a valid "+
+		"promotion target of %s. Stop and explain if it is not.\n", target, source)
+	fmt.Fprintf(&b, "2. Call list_release_bindings for the component and find the release bound in %s. "+
+		"Stop if the binding is missing or not ready.\n", source)
+	b.WriteString("3. If the target environment already has a binding, plan update_release_binding with that release; " +
+		"otherwise plan create_release_binding. Keep any environment-specific overrides of the existing binding.\n")
+	b.WriteString("4. Call the tool with dry_run set to true and show the returned plan to the user. " +
+		"Only after the user approves, call it again with the same arguments and the returned confirmation_token.\n")
+	b.WriteString("5. Call get_release_binding for the target binding until it is ready and report the outcome.\n")
+
+	return &mcp.GetPromptResult{
+		Description: fmt.Sprintf("Promote %s from %s", component, source),
+		Messages:    []*mcp.PromptMessage{{Role: "user", Content: &mcp.TextContent{Text: b.String()}}},
+	}, nil
+}
+
+func (t *Toolsets) diagnoseFailingDeploymentPrompt(
+	_ context.Context, req *mcp.GetPromptRequest,
+) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	if err := requirePromptArguments(args, "namespace_name", "project_name", "component_name", "environment"); err != nil {
+		return nil, err
+	}
+	ns, project, component := args["namespace_name"], args["project_name"], args["component_name"]
+	env := args["environment"]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Diagnose why component %q of project %q in namespace %q is failing in environment %q.\n\n",
+		component, project, ns, env)
+	fmt.Fprintf(&b, "The current state of the component is available as the resource %s and the environment as %s.\n\n",
+		ComponentResourceURI(ns, project, component), EnvironmentResourceURI(ns, env))
+	b.WriteString("Follow these steps, stopping as soon as you find the cause:\n")
+	fmt.Fprintf(&b, "1. Call list_release_bindings for the component and get_release_binding for the binding in %s. "+
+		"Read its conditions.\n", env)
+	b.WriteString("2. Check that the bound component release exists and belongs to the latest successful build; ")
+	if t.BuildToolset != nil {
+		b.WriteString("use list_workflow_runs and get_workflow_run_logs to inspect failed builds.\n")
+	} else {
+		b.WriteString("a failed build leaves the component without a new release.\n")
+	}
+	if t.PEToolset != nil {
+		b.WriteString("3. Call get_resource_tree for the binding to find unhealthy resources, then get_resource_events " +
+			"and get_resource_logs for them.\n")
+	} else {
+		b.WriteString("3. Compare the binding's overrides with the component type parameters for misconfiguration.\n")
+	}
+	b.WriteString("4. Summarize the root cause with the evidence, and propose a fix. Do not change anything " +
+		"until the user approves; mutating tools accept dry_run to show the planned change first.\n")
+
+	return &mcp.GetPromptResult{
+		Description: fmt.Sprintf("Diagnose %s in %s", component, env),
+		Messages:    []*mcp.PromptMessage{{Role: "user", Content: &mcp.TextContent{Text: b.String()}}},
+	}, nil
+}
+
+// requirePromptArguments returns an error naming the first missing required argument
+func requirePromptArguments(args map[string]string, names ...string) error {
+	for _, name := range names {
+		if args[name] == "" {
+			return fmt.Errorf("argument %q is required", name)
+		}
+	}
+	return nil
+}
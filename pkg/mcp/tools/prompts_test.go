@@ -0,0 +1,156 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestPromptsListed(t *testing.T) {
+	session := setupPromptServer(t, allToolsets())
+
+	result, err := session.ListPrompts(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListPrompts: %v", err)
+	}
+	found := map[string]bool{}
+	for _, p := range result.Prompts {
+		found[p.Name] = true
+	}
+	for _, want := range []string{PromptPromoteComponent, PromptDiagnoseFailingDeployment} {
+		if !found[want] {
+			t.Errorf("expected prompt %s", want)
+		}
+	}
+}
+
+func TestPromptsNotRegisteredWithoutDeploymentToolset(t *testing.T) {
+	session := setupPromptServer(t, &Toolsets{ProjectToolset: NewMockCoreToolsetHandler()})
+
+	result, err := session.ListPrompts(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListPrompts: %v", err)
+	}
+	if len(result.Prompts) != 0 {
+		t.Errorf("expected no prompts without the deployment toolset, got %d", len(result.Prompts))
+	}
+}
+
+func TestGetPromotePrompt(t *testing.T) {
+	session := setupPromptServer(t, allToolsets())
+
+	result, err := session.GetPrompt(context.Background(), &mcp.GetPromptParams{
+		Name: PromptPromoteComponent,
+		Arguments: map[string]string{
+			"namespace_name":     testNamespaceName,
+			"project_name":       testProjectName,
+			"component_name":     testComponentName,
+			"source_environment": testEnvName,
+			"target_environment": "staging",
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetPrompt: %v", err)
+	}
+	text := promptText(t, result)
+	for _, want := range []string{
+		ComponentResourceURI(testNamespaceName, testProjectName, testComponentName),
+		"from dev to staging",
+		"get_deployment_pipeline",
+		ArgDryRun,
+		ArgConfirmationToken,
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected prompt to mention %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestGetDiagnosePrompt(t *testing.T) {
+	session := setupPromptServer(t, allToolsets())
+
+	result, err := session.GetPrompt(context.Background(), &mcp.GetPromptParams{
+		Name: PromptDiagnoseFailingDeployment,
+		Arguments: map[string]string{
+			"namespace_name": testNamespaceName,
+			"project_name":   testProjectName,
+			"component_name": testComponentName,
+			"environment":    testEnvName,
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetPrompt: %v", err)
+	}
+	text := promptText(t, result)
+	for _, want := range []string{
+		EnvironmentResourceURI(testNamespaceName, testEnvName),
+		"get_release_binding",
+		"list_workflow_runs",
+		"get_resource_tree",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected prompt to mention %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestGetPromptMissingArgument(t *testing.T) {
+	session := setupPromptServer(t, allToolsets())
+
+	_, err := session.GetPrompt(context.Background(), &mcp.GetPromptParams{
+		Name: PromptDiagnoseFailingDeployment,
+		Arguments: map[string]string{
+			"namespace_name": testNamespaceName,
+			"project_name":   testProjectName,
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "component_name") {
+		t.Errorf("expected an error naming component_name, got %v", err)
+	}
+}
+
+func allToolsets() *Toolsets {
+	mockHandler := NewMockCoreToolsetHandler()
+	return &Toolsets{
+		ProjectToolset:    mockHandler,
+		DeploymentToolset: mockHandler,
+		BuildToolset:      mockHandler,
+		PEToolset:         mockHandler,
+	}
+}
+
+func setupPromptServer(t *testing.T, toolsets *Toolsets) *mcp.ClientSession {
+	t.Helper()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	toolsets.RegisterPrompts(server)
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { clientSession.Close() })
+	return clientSession
+}
+
+func promptText(t *testing.T, result *mcp.GetPromptResult) string {
+	t.Helper()
+	if len(result.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(result.Messages))
+	}
+	text, ok := result.Messages[0].Content.(*mcp.TextContent)
+	if !ok {
+		t.Fatalf("expected text content, got %T", result.Messages[0].Content)
+	}
+	return text.Text
+}
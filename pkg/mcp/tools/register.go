@@ -150,6 +150,9 @@ func (t *Toolsets) buildToolRegistrations() []RegisterFunc {
 		t.RegisterGetWorkflowRunStatus,
 		t.RegisterGetWorkflowRunLogs,
 		t.RegisterGetWorkflowRunEvents,
+		t.RegisterCancelWorkflowRun,
+		t.RegisterRetryWorkflowRun,
+		t.RegisterResumeWorkflowRun,
 		// Workflow read. Scope-collapsed: pass scope="cluster" for a platform-wide ClusterWorkflow.
 		t.RegisterListWorkflows,
 		t.RegisterGetWorkflow,
@@ -0,0 +1,146 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ResourceURIScheme is the URI scheme of the MCP resources served by this package.
+const ResourceURIScheme = "openchoreo"
+
+// URI templates of the MCP resources. Projects and components are addressed by
+// their place in the hierarchy; environments belong directly to a namespace.
+const (
+	ProjectResourceTemplate     = "openchoreo://namespaces/{namespace}/projects/{project}"
+	ComponentResourceTemplate   = "openchoreo://namespaces/{namespace}/projects/{project}/components/{component}"
+	EnvironmentResourceTemplate = "openchoreo://namespaces/{namespace}/environments/{environment}"
+)
+
+// Kinds of MCP resources
+const (
+	ResourceKindProject     = "project"
+	ResourceKindComponent   = "component"
+	ResourceKindEnvironment = "environment"
+)
+
+// ResourceStateHandler reads the state exposed as MCP resources. Each method
+// returns a JSON-serializable snapshot of the resource and its related state.
+type ResourceStateHandler interface {
+	// GetProjectState returns a project and a summary of its components.
+	GetProjectState(ctx context.Context, namespaceName, projectName string) (any, error)
+	// GetComponentState returns a component and its release bindings across environments.
+	GetComponentState(ctx context.Context, namespaceName, projectName, componentName string) (any, error)
+	// GetEnvironmentState returns an environment.
+	GetEnvironmentState(ctx context.Context, namespaceName, environmentName string) (any, error)
+}
+
+// ResourceRef identifies an MCP resource parsed from its URI
+type ResourceRef struct {
+	Kind        string
+	Namespace   string
+	Project     string
+	Component   string
+	Environment string
+}
+
+// ProjectResourceURI returns the URI of a project resource
+func ProjectResourceURI(namespaceName, projectName string) string {
+	return fmt.Sprintf("%s://namespaces/%s/projects/%s", ResourceURIScheme, namespaceName, projectName)
+}
+
+// ComponentResourceURI returns the URI of a component resource
+func ComponentResourceURI(namespaceName, projectName, componentName string) string {
+	return ProjectResourceURI(namespaceName, projectName) + "/components/" + componentName
+}
+
+// EnvironmentResourceURI returns the URI of an environment resource
+func EnvironmentResourceURI(namespaceName, environmentName string) string {
+	return fmt.Sprintf("%s://namespaces/%s/environments/%s", ResourceURIScheme, namespaceName, environmentName)
+}
+
+// ParseResourceURI parses the URI of a project, component or environment resource.
+func ParseResourceURI(uri string) (ResourceRef, error) {
+	rest, ok := strings.CutPrefix(uri, ResourceURIScheme+"://")
+	if !ok {
+		return ResourceRef{}, fmt.Errorf("unsupported resource URI %q: scheme must be %q", uri, ResourceURIScheme)
+	}
+	parts := strings.Split(rest, "/")
+	for _, part := range parts {
+		if part == "" {
+			return ResourceRef{}, fmt.Errorf("unsupported resource URI %q: empty path segment", uri)
+		}
+	}
+
+	switch {
+	case len(parts) == 4 && parts[0] == "namespaces" && parts[2] == "projects":
+		return ResourceRef{Kind: ResourceKindProject, Namespace: parts[1], Project: parts[3]}, nil
+	case len(parts) == 6 && parts[0] == "namespaces" && parts[2] == "projects" && parts[4] == "components":
+		return ResourceRef{Kind: ResourceKindComponent, Namespace: parts[1], Project: parts[3], Component: parts[5]}, nil
+	case len(parts) == 4 && parts[0] == "namespaces" && parts[2] == "environments":
+		return ResourceRef{Kind: ResourceKindEnvironment, Namespace: parts[1], Environment: parts[3]}, nil
+	default:
+		return ResourceRef{}, fmt.Errorf("unsupported resource URI %q", uri)
+	}
+}
+
+// RegisterResources registers the project, component and environment resource
+// templates. Reads go through the service layer, which enforces authz.
+func (t *Toolsets) RegisterResources(s *mcp.Server) {
+	s.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "project",
+		Title:       "Project",
+		URITemplate: ProjectResourceTemplate,
+		Description: "A project with its deployment pipeline, status and a summary of its components.",
+		MIMEType:    "application/json",
+	}, t.readResource)
+	s.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "component",
+		Title:       "Component",
+		URITemplate: ComponentResourceTemplate,
+		Description: "A component with its configuration, status and its release bindings in each environment.",
+		MIMEType:    "application/json",
+	}, t.readResource)
+	s.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "environment",
+		Title:       "Environment",
+		URITemplate: EnvironmentResourceTemplate,
+		Description: "An environment with its data plane and status.",
+		MIMEType:    "application/json",
+	}, t.readResource)
+}
+
+func (t *Toolsets) readResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	ref, err := ParseResourceURI(uri)
+	if err != nil {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	var state any
+	switch ref.Kind {
+	case ResourceKindProject:
+		state, err = t.ResourceState.GetProjectState(ctx, ref.Namespace, ref.Project)
+	case ResourceKindComponent:
+		state, err = t.ResourceState.GetComponentState(ctx, ref.Namespace, ref.Project, ref.Component)
+	case ResourceKindEnvironment:
+		state, err = t.ResourceState.GetEnvironmentState(ctx, ref.Namespace, ref.Environment)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{URI: uri, MIMEType: "application/json", Text: string(data)}},
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestParseResourceURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    ResourceRef
+		wantErr bool
+	}{
+		{
+			uri:  ProjectResourceURI(testNamespaceName, testProjectName),
+			want: ResourceRef{Kind: ResourceKindProject, Namespace: testNamespaceName, Project: testProjectName},
+		},
+		{
+			uri: ComponentResourceURI(testNamespaceName, testProjectName, testComponentName),
+			want: ResourceRef{
+				Kind: ResourceKindComponent, Namespace: testNamespaceName, Project: testProjectName, Component: testComponentName,
+			},
+		},
+		{
+			uri:  EnvironmentResourceURI(testNamespaceName, testEnvName),
+			want: ResourceRef{Kind: ResourceKindEnvironment, Namespace: testNamespaceName, Environment: testEnvName},
+		},
+		{uri: "https://namespaces/ns/projects/p", wantErr: true},
+		{uri: "openchoreo://namespaces/ns", wantErr: true},
+		{uri: "openchoreo://namespaces/ns/projects/", wantErr: true},
+		{uri: "openchoreo://namespaces/ns/dataplanes/dp", wantErr: true},
+		{uri: "openchoreo://namespaces/ns/projects/p/components/c/extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			got, err := ParseResourceURI(tt.uri)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseResourceURI: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseResourceURI = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeResourceState returns the arguments it was called with as the state.
+type fakeResourceState struct {
+	err error
+}
+
+func (f *fakeResourceState) GetProjectState(_ context.Context, namespaceName, projectName string) (any, error) {
+	return map[string]any{"namespace": namespaceName, "project": projectName}, f.err
+}
+
+func (f *fakeResourceState) GetComponentState(
+	_ context.Context, namespaceName, projectName, componentName string,
+) (any, error) {
+	return map[string]any{"namespace": namespaceName, "project": projectName, "component": componentName}, f.err
+}
+
+func (f *fakeResourceState) GetEnvironmentState(_ context.Context, namespaceName, environmentName string) (any, error) {
+	return map[string]any{"namespace": namespaceName, "environment": environmentName}, f.err
+}
+
+func setupResourceServer(t *testing.T, state ResourceStateHandler) *mcp.ClientSession {
+	t.Helper()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	(&Toolsets{ResourceState: state}).RegisterResources(server)
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { clientSession.Close() })
+	return clientSession
+}
+
+func TestResourceTemplatesListed(t *testing.T) {
+	session := setupResourceServer(t, &fakeResourceState{})
+
+	result, err := session.ListResourceTemplates(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListResourceTemplates: %v", err)
+	}
+	found := map[string]bool{}
+	for _, tmpl := range result.ResourceTemplates {
+		found[tmpl.URITemplate] = true
+	}
+	for _, want := range []string{ProjectResourceTemplate, ComponentResourceTemplate, EnvironmentResourceTemplate} {
+		if !found[want] {
+			t.Errorf("expected resource template %s", want)
+		}
+	}
+}
+
+func TestReadResource(t *testing.T) {
+	session := setupResourceServer(t, &fakeResourceState{})
+
+	tests := []struct {
+		uri  string
+		want map[string]any
+	}{
+		{
+			uri:  ProjectResourceURI(testNamespaceName, testProjectName),
+			want: map[string]any{"namespace": testNamespaceName, "project": testProjectName},
+		},
+		{
+			uri: ComponentResourceURI(testNamespaceName, testProjectName, testComponentName),
+			want: map[string]any{
+				"namespace": testNamespaceName, "project": testProjectName, "component": testComponentName,
+			},
+		},
+		{
+			uri:  EnvironmentResourceURI(testNamespaceName, testEnvName),
+			want: map[string]any{"namespace": testNamespaceName, "environment": testEnvName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			result, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: tt.uri})
+			if err != nil {
+				t.Fatalf("ReadResource: %v", err)
+			}
+			if len(result.Contents) != 1 {
+				t.Fatalf("expected 1 content, got %d", len(result.Contents))
+			}
+			content := result.Contents[0]
+			if content.URI != tt.uri || content.MIMEType != "application/json" {
+				t.Errorf("content URI = %q, MIME type = %q", content.URI, content.MIMEType)
+			}
+			var got map[string]any
+			if err := json.Unmarshal([]byte(content.Text), &got); err != nil {
+				t.Fatalf("content is not JSON: %v", err)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("%s = %v, want %v", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestReadResourceErrors(t *testing.T) {
+	session := setupResourceServer(t, &fakeResourceState{err: errors.New("project not found")})
+
+	if _, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{
+		URI: ProjectResourceURI(testNamespaceName, testProjectName),
+	}); err == nil {
+		t.Error("expected the handler error to be returned")
+	}
+	if _, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{
+		URI: "openchoreo://namespaces/ns/projects/p/components",
+	}); err == nil {
+		t.Error("expected an error for an unsupported URI")
+	}
+}
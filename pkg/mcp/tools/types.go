@@ -36,6 +36,14 @@ type filterByAuthzCtxKey struct{}
 // includeDeprecatedTools flag from the ?includeDeprecatedTools= query param.
 type includeDeprecatedToolsCtxKey struct{}
 
+// confirmMutationsCtxKey is the context key used to carry the per-session
+// confirmMutations flag from the ?confirmMutations= query param.
+type confirmMutationsCtxKey struct{}
+
+// clientScopeCtxKey is the context key used to carry the ClientScope of the
+// MCP client that opened the session.
+type clientScopeCtxKey struct{}
+
 // WithRequestedToolsets returns a copy of ctx that carries the set of toolsets
 // the client requested. Empty or nil set means "no narrowing" — the middleware
 // will not apply a toolset filter.
@@ -92,6 +100,38 @@ func IncludeDeprecatedToolsFromContext(ctx context.Context) bool {
 	return v
 }
 
+// WithConfirmMutations returns a copy of ctx carrying the per-session decision of
+// whether mutating tools must be confirmed before they are applied (see
+// NewConfirmationMiddleware).
+func WithConfirmMutations(ctx context.Context, confirm bool) context.Context {
+	return context.WithValue(ctx, confirmMutationsCtxKey{}, confirm)
+}
+
+// ConfirmMutationsFromContext returns the per-session confirmMutations flag if the
+// client explicitly supplied one. The second return value reports whether a
+// value was set; callers should fall back to the server default when not set.
+func ConfirmMutationsFromContext(ctx context.Context) (bool, bool) {
+	v, ok := ctx.Value(confirmMutationsCtxKey{}).(bool)
+	return v, ok
+}
+
+// WithClientScope returns a copy of ctx carrying the scope of the MCP client
+// that opened the session (see NewClientScopeMiddleware). A nil scope leaves
+// ctx unchanged.
+func WithClientScope(ctx context.Context, scope *ClientScope) context.Context {
+	if scope == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, clientScopeCtxKey{}, scope)
+}
+
+// ClientScopeFromContext returns the scope of the MCP client of the session,
+// or nil when the client is unknown and unrestricted.
+func ClientScopeFromContext(ctx context.Context) *ClientScope {
+	v, _ := ctx.Value(clientScopeCtxKey{}).(*ClientScope)
+	return v
+}
+
 // DefaultPageSize is the default number of items per page for MCP list operations.
 const DefaultPageSize = 100
 
@@ -120,6 +160,9 @@ type Toolsets struct {
 	BuildToolset      BuildToolsetHandler
 	PEToolset         PEToolsetHandler
 	ResourceToolset   ResourceToolsetHandler
+	// ResourceState backs the project, component and environment MCP resources.
+	// Resources are not registered when it is nil.
+	ResourceState ResourceStateHandler
 }
 
 // PEToolsetHandler handles platform engineering operations on openchoreo
@@ -503,6 +546,9 @@ type BuildToolsetHandler interface {
 		ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64,
 	) (any, error)
 	GetWorkflowRunEvents(ctx context.Context, namespaceName, runName, taskName string) (any, error)
+	CancelWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error)
+	RetryWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error)
+	ResumeWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error)
 	ListWorkflows(ctx context.Context, namespaceName string, opts ListOpts) (any, error)
 	GetWorkflow(ctx context.Context, namespaceName, workflowName string) (any, error)
 	GetWorkflowSchema(ctx context.Context, namespaceName, workflowName string) (any, error)
@@ -21,11 +21,14 @@ type ComponentApplicationLogsParams struct {
 	StartTime     time.Time `json:"startTime"`
 	EndTime       time.Time `json:"endTime"`
 	SearchPhrase  string    `json:"searchPhrase"`
-	LogLevels     []string  `json:"logLevels"`
-	Versions      []string  `json:"versions"`
-	VersionIDs    []string  `json:"versionIds"`
-	Limit         int       `json:"limit"`
-	SortOrder     string    `json:"sortOrder"`
+	// TraceID and SpanID restrict the query to the logs emitted within a trace or span
+	TraceID    string   `json:"traceId,omitempty"`
+	SpanID     string   `json:"spanId,omitempty"`
+	LogLevels  []string `json:"logLevels"`
+	Versions   []string `json:"versions"`
+	VersionIDs []string `json:"versionIds"`
+	Limit      int      `json:"limit"`
+	SortOrder  string   `json:"sortOrder"`
 }
 
 // WorkflowLogsParams holds parameters for workflow log queries
@@ -62,6 +65,9 @@ type LogEntry struct {
 	NamespaceName   string `json:"namespaceName,omitempty"`
 	PodNamespace    string `json:"podNamespace,omitempty"`
 	PodName         string `json:"podName,omitempty"`
+	// Trace context of the request that emitted the log, if known
+	TraceID string `json:"traceId,omitempty"`
+	SpanID  string `json:"spanId,omitempty"`
 }
 
 // WorkflowLogEntry represents a parsed log entry for workflow logs